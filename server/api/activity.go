@@ -0,0 +1,217 @@
+// Copyright (c) 2020-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package api
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/gorilla/mux"
+	"github.com/mattermost/mattermost-plugin-boards/server/model"
+
+	"github.com/mattermost/mattermost/server/public/shared/mlog"
+)
+
+func (a *API) registerActivityRoutes(r *mux.Router) {
+	// Activity APIs
+	r.HandleFunc("/boards/{boardID}/activity", a.sessionRequired(a.handleGetBoardActivity)).Methods("GET")
+	r.HandleFunc("/cards/{cardID}/activity", a.sessionRequired(a.handleGetCardActivity)).Methods("GET")
+}
+
+func (a *API) handleGetBoardActivity(w http.ResponseWriter, r *http.Request) {
+	// swagger:operation GET /boards/{boardID}/activity getBoardActivity
+	//
+	// Returns the changes of a board and its cards, newest first
+	//
+	// ---
+	// produces:
+	// - application/json
+	// parameters:
+	// - name: boardID
+	//   in: path
+	//   description: Board ID
+	//   required: true
+	//   type: string
+	// - name: user_id
+	//   in: query
+	//   description: Only the changes made by this user
+	//   required: false
+	//   type: string
+	// - name: types
+	//   in: query
+	//   description: Comma separated types of the events to return. If empty then all types are included.
+	//   required: false
+	//   type: string
+	// - name: cursor
+	//   in: query
+	//   description: The nextCursor of the previous page
+	//   required: false
+	//   type: string
+	// - name: per_page
+	//   in: query
+	//   description: Number of events to return per page (default=50)
+	//   required: false
+	//   type: integer
+	// security:
+	// - BearerAuth: []
+	// responses:
+	//   '200':
+	//     description: success
+	//     schema:
+	//       "$ref": "#/definitions/ActivityFeed"
+	//   '404':
+	//     description: board not found
+	//   default:
+	//     description: internal error
+	//     schema:
+	//       "$ref": "#/definitions/ErrorResponse"
+
+	boardID := mux.Vars(r)["boardID"]
+	userID := getUserID(r)
+
+	if !a.permissions.HasPermissionToBoard(userID, boardID, model.PermissionViewBoard) {
+		a.errorResponse(w, r, model.NewErrPermission("access denied to board"))
+		return
+	}
+
+	opts, err := activityOptionsFromQuery(r.URL.Query())
+	if err != nil {
+		a.errorResponse(w, r, err)
+		return
+	}
+
+	feed, err := a.app.GetBoardActivity(r.Context(), boardID, opts)
+	if err != nil {
+		a.errorResponse(w, r, err)
+		return
+	}
+
+	a.requestLogger(r).Debug("GetBoardActivity",
+		mlog.String("boardID", boardID),
+		mlog.Int("eventsCount", len(feed.Events)),
+	)
+
+	data, err := json.Marshal(feed)
+	if err != nil {
+		a.errorResponse(w, r, err)
+		return
+	}
+
+	jsonBytesResponse(w, http.StatusOK, data)
+}
+
+func (a *API) handleGetCardActivity(w http.ResponseWriter, r *http.Request) {
+	// swagger:operation GET /cards/{cardID}/activity getCardActivity
+	//
+	// Returns the changes of a card and its contents, newest first
+	//
+	// ---
+	// produces:
+	// - application/json
+	// parameters:
+	// - name: cardID
+	//   in: path
+	//   description: Card ID
+	//   required: true
+	//   type: string
+	// - name: user_id
+	//   in: query
+	//   description: Only the changes made by this user
+	//   required: false
+	//   type: string
+	// - name: types
+	//   in: query
+	//   description: Comma separated types of the events to return. If empty then all types are included.
+	//   required: false
+	//   type: string
+	// - name: cursor
+	//   in: query
+	//   description: The nextCursor of the previous page
+	//   required: false
+	//   type: string
+	// - name: per_page
+	//   in: query
+	//   description: Number of events to return per page (default=50)
+	//   required: false
+	//   type: integer
+	// security:
+	// - BearerAuth: []
+	// responses:
+	//   '200':
+	//     description: success
+	//     schema:
+	//       "$ref": "#/definitions/ActivityFeed"
+	//   '404':
+	//     description: card not found
+	//   default:
+	//     description: internal error
+	//     schema:
+	//       "$ref": "#/definitions/ErrorResponse"
+
+	cardID := mux.Vars(r)["cardID"]
+	userID := getUserID(r)
+
+	card, err := a.app.GetCardByID(r.Context(), cardID)
+	if err != nil {
+		a.errorResponse(w, r, err)
+		return
+	}
+
+	if !a.permissions.HasPermissionToBoard(userID, card.BoardID, model.PermissionViewBoard) {
+		a.errorResponse(w, r, model.NewErrPermission("access denied to card"))
+		return
+	}
+
+	opts, err := activityOptionsFromQuery(r.URL.Query())
+	if err != nil {
+		a.errorResponse(w, r, err)
+		return
+	}
+
+	feed, err := a.app.GetCardActivity(r.Context(), cardID, opts)
+	if err != nil {
+		a.errorResponse(w, r, err)
+		return
+	}
+
+	a.requestLogger(r).Debug("GetCardActivity",
+		mlog.String("cardID", cardID),
+		mlog.Int("eventsCount", len(feed.Events)),
+	)
+
+	data, err := json.Marshal(feed)
+	if err != nil {
+		a.errorResponse(w, r, err)
+		return
+	}
+
+	jsonBytesResponse(w, http.StatusOK, data)
+}
+
+func activityOptionsFromQuery(query url.Values) (model.QueryActivityOptions, error) {
+	opts := model.QueryActivityOptions{
+		UserID: query.Get("user_id"),
+		Cursor: query.Get("cursor"),
+	}
+
+	if types := query.Get("types"); types != "" {
+		for _, eventType := range strings.Split(types, ",") {
+			opts.EventTypes = append(opts.EventTypes, model.ActivityEventType(strings.TrimSpace(eventType)))
+		}
+	}
+
+	if strPerPage := query.Get("per_page"); strPerPage != "" {
+		perPage, err := strconv.Atoi(strPerPage)
+		if err != nil {
+			return opts, model.NewErrBadRequest(fmt.Sprintf("invalid `per_page` parameter: %s", err))
+		}
+		opts.PerPage = perPage
+	}
+
+	return opts, opts.IsValid()
+}
@@ -4,17 +4,27 @@
 package api
 
 import (
+	"context"
 	"encoding/json"
 	"errors"
 	"fmt"
 	"net/http"
 	"runtime/debug"
+	"time"
 
 	"github.com/gorilla/mux"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/trace"
+
 	"github.com/mattermost/mattermost-plugin-boards/server/app"
 	"github.com/mattermost/mattermost-plugin-boards/server/model"
 	"github.com/mattermost/mattermost-plugin-boards/server/services/audit"
+	"github.com/mattermost/mattermost-plugin-boards/server/services/metrics"
 	"github.com/mattermost/mattermost-plugin-boards/server/services/permissions"
+	"github.com/mattermost/mattermost-plugin-boards/server/services/tracing"
 
 	"github.com/mattermost/mattermost/server/public/shared/mlog"
 )
@@ -22,6 +32,7 @@ import (
 const (
 	HeaderRequestedWith    = "X-Requested-With"
 	HeaderRequestedWithXML = "XMLHttpRequest"
+	HeaderSharePassword    = "X-Share-Password"
 	UploadFormFileKey      = "file"
 	True                   = "true"
 
@@ -42,6 +53,7 @@ type API struct {
 	permissions permissions.PermissionsService
 	logger      mlog.LoggerIFace
 	audit       *audit.Audit
+	metrics     *metrics.Metrics
 }
 
 func NewAPI(
@@ -51,6 +63,7 @@ func NewAPI(
 	permissions permissions.PermissionsService,
 	logger mlog.LoggerIFace,
 	audit *audit.Audit,
+	metrics *metrics.Metrics,
 ) *API {
 	return &API{
 		app:         app,
@@ -58,11 +71,14 @@ func NewAPI(
 		permissions: permissions,
 		logger:      logger,
 		audit:       audit,
+		metrics:     metrics,
 	}
 }
 
 func (a *API) RegisterRoutes(r *mux.Router) {
 	apiv2 := r.PathPrefix("/api/v2").Subrouter()
+	apiv2.Use(a.requestTracing)
+	apiv2.Use(a.requestMetrics)
 	apiv2.Use(a.panicHandler)
 	apiv2.Use(a.requireCSRFToken)
 
@@ -77,10 +93,20 @@ func (a *API) RegisterRoutes(r *mux.Router) {
 	a.registerMembersRoutes(apiv2)
 	a.registerCategoriesRoutes(apiv2)
 	a.registerSharingRoutes(apiv2)
+	a.registerFormsRoutes(apiv2)
 	a.registerTeamsRoutes(apiv2)
 	a.registerAchivesRoutes(apiv2)
 	a.registerSubscriptionsRoutes(apiv2)
+	a.registerNotificationPreferencesRoutes(apiv2)
+	a.registerNotificationsRoutes(apiv2)
+	a.registerCommentsRoutes(apiv2)
 	a.registerFilesRoutes(apiv2)
+	a.registerStorageQuotasRoutes(apiv2)
+	a.registerAttachmentVersionsRoutes(apiv2)
+	a.registerActivityRoutes(apiv2)
+	a.registerBlockChangesRoutes(apiv2)
+	a.registerBoardRestoreRoutes(apiv2)
+	a.registerFlowMetricsRoutes(apiv2)
 	a.registerOnboardingRoutes(apiv2)
 	a.registerSearchRoutes(apiv2)
 	a.registerConfigRoutes(apiv2)
@@ -92,6 +118,7 @@ func (a *API) RegisterRoutes(r *mux.Router) {
 	a.registerContentBlocksRoutes(apiv2)
 	a.registerStatisticsRoutes(apiv2)
 	a.registerComplianceRoutes(apiv2)
+	a.registerAuditRoutes(apiv2)
 
 	// V3 routes
 	a.registerCardsRoutes(apiv2)
@@ -114,11 +141,12 @@ func (a *API) panicHandler(next http.Handler) http.Handler {
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		defer func() {
 			if p := recover(); p != nil {
-				a.logger.Error("Http handler panic",
+				fields := []mlog.Field{
 					mlog.Any("panic", p),
 					mlog.String("stack", string(debug.Stack())),
 					mlog.String("uri", r.URL.Path),
-				)
+				}
+				a.requestLogger(r).Error("Http handler panic", fields...)
 				a.errorResponse(w, r, ErrHandlerPanic)
 			}
 		}()
@@ -127,10 +155,82 @@ func (a *API) panicHandler(next http.Handler) http.Handler {
 	})
 }
 
+// statusRecorder keeps the status code written to a response.
+type statusRecorder struct {
+	http.ResponseWriter
+	status int
+}
+
+func (w *statusRecorder) WriteHeader(status int) {
+	w.status = status
+	w.ResponseWriter.WriteHeader(status)
+}
+
+func (a *API) requestMetrics(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if a.metrics == nil {
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		start := time.Now()
+		recorder := &statusRecorder{ResponseWriter: w, status: http.StatusOK}
+		next.ServeHTTP(recorder, r)
+		a.metrics.ObserveAPIRequest(r.Method, routeTemplate(r), recorder.status, time.Since(start).Seconds())
+	})
+}
+
+func (a *API) requestTracing(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if !tracing.Enabled() {
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		route := routeTemplate(r)
+		ctx := otel.GetTextMapPropagator().Extract(r.Context(), propagation.HeaderCarrier(r.Header))
+		ctx, span := tracing.Tracer().Start(ctx, r.Method+" "+route,
+			trace.WithSpanKind(trace.SpanKindServer),
+			trace.WithAttributes(
+				attribute.String("http.request.method", r.Method),
+				attribute.String("http.route", route),
+				attribute.String("url.path", r.URL.Path),
+			),
+		)
+		defer span.End()
+		ctx = tracing.ContextWithLogger(ctx, a.logger)
+
+		recorder := &statusRecorder{ResponseWriter: w, status: http.StatusOK}
+		next.ServeHTTP(recorder, r.WithContext(ctx))
+
+		span.SetAttributes(attribute.Int("http.response.status_code", recorder.status))
+		if recorder.status >= http.StatusInternalServerError {
+			span.SetStatus(codes.Error, http.StatusText(recorder.status))
+		}
+	})
+}
+
+// requestLogger returns the logger of a request, which logs the fields identifying the span of
+// the request when tracing is enabled.
+func (a *API) requestLogger(r *http.Request) mlog.LoggerIFace {
+	return tracing.Logger(r.Context(), a.logger)
+}
+
+// routeTemplate returns the template of the route matching a request. Requests are labeled by
+// their route template to keep the cardinality of the metrics and span names bounded.
+func routeTemplate(r *http.Request) string {
+	if current := mux.CurrentRoute(r); current != nil {
+		if template, err := current.GetPathTemplate(); err == nil {
+			return template
+		}
+	}
+	return "unknown"
+}
+
 func (a *API) requireCSRFToken(next http.Handler) http.Handler {
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		if !a.checkCSRFToken(r) {
-			a.logger.Error("checkCSRFToken FAILED")
+			a.requestLogger(r).Error("checkCSRFToken FAILED")
 			a.errorResponse(w, r, model.NewErrBadRequest("checkCSRFToken FAILED"))
 			return
 		}
@@ -145,24 +245,78 @@ func (a *API) checkCSRFToken(r *http.Request) bool {
 }
 
 func (a *API) hasValidReadTokenForBoard(r *http.Request, boardID string) bool {
+	return a.getShareLinkForRequest(r, boardID) != nil
+}
+
+// hasValidReadTokenForFile returns true if the request's `read_token` belongs to a share link of
+// the board whose scope includes a block referencing the file.
+func (a *API) hasValidReadTokenForFile(r *http.Request, boardID, filename string) bool {
+	link := a.getShareLinkForRequest(r, boardID)
+	if link == nil {
+		return false
+	}
+
+	isValid, err := a.app.IsFileInShareLinkScope(r.Context(), link, filename)
+	if err != nil {
+		a.requestLogger(r).Error("IsValidReadTokenForFile ERROR", mlog.Err(err))
+		return false
+	}
+	return isValid
+}
+
+// readTokenFromRequest returns the request's `read_token` and share link password. The password
+// is read from the X-Share-Password header, or the `share_password` query parameter for requests
+// that cannot set headers (e.g. images).
+func readTokenFromRequest(r *http.Request) (readToken string, password string) {
 	query := r.URL.Query()
-	readToken := query.Get("read_token")
+	password = r.Header.Get(HeaderSharePassword)
+	if password == "" {
+		password = query.Get("share_password")
+	}
+	return query.Get("read_token"), password
+}
+
+// getShareLinkForRequest returns the active share link of the board granted by the request's
+// `read_token`, or nil if there is none.
+func (a *API) getShareLinkForRequest(r *http.Request, boardID string) *model.ShareLink {
+	readToken, password := readTokenFromRequest(r)
+	if len(readToken) < 1 {
+		return nil
+	}
+
+	link, err := a.app.GetShareLinkForReadToken(r.Context(), boardID, readToken, password)
+	if err != nil {
+		a.requestLogger(r).Error("IsValidReadTokenForBoard ERROR", mlog.Err(err))
+		return nil
+	}
+
+	return link
+}
 
+// hasValidReadTokenForComments returns true if the request's `read_token` belongs to a share link
+// allowing comments on the specified cards.
+func (a *API) hasValidReadTokenForComments(r *http.Request, boardID string, cardIDs []string) bool {
+	readToken, password := readTokenFromRequest(r)
 	if len(readToken) < 1 {
 		return false
 	}
 
-	isValid, err := a.app.IsValidReadToken(boardID, readToken)
+	access := model.ReadTokenAccess{
+		Password:   password,
+		BlockIDs:   cardIDs,
+		Permission: model.ShareLinkPermissionComment,
+	}
+	isValid, err := a.app.IsValidReadToken(r.Context(), boardID, readToken, access)
 	if err != nil {
-		a.logger.Error("IsValidReadTokenForBoard ERROR", mlog.Err(err))
+		a.requestLogger(r).Error("IsValidReadTokenForComments ERROR", mlog.Err(err))
 		return false
 	}
 
 	return isValid
 }
 
-func (a *API) userIsGuest(userID string) (bool, error) {
-	return a.app.UserIsGuest(userID)
+func (a *API) userIsGuest(ctx context.Context, userID string) (bool, error) {
+	return a.app.UserIsGuest(ctx, userID)
 }
 
 // Response helpers
@@ -181,6 +335,8 @@ func (a *API) errorResponse(w http.ResponseWriter, r *http.Request, err error) {
 		errorResponse.ErrorCode = http.StatusNotFound
 	case model.IsErrRequestEntityTooLarge(err):
 		errorResponse.ErrorCode = http.StatusRequestEntityTooLarge
+	case model.IsErrTooManyRequests(err):
+		errorResponse.ErrorCode = http.StatusTooManyRequests
 	case model.IsErrNotImplemented(err):
 		errorResponse.ErrorCode = http.StatusNotImplemented
 	default:
@@ -188,11 +344,12 @@ func (a *API) errorResponse(w http.ResponseWriter, r *http.Request, err error) {
 		errorResponse.ErrorCode = http.StatusInternalServerError
 	}
 
-	a.logger.Warn("api error response",
+	fields := []mlog.Field{
 		mlog.Int("code", errorResponse.ErrorCode),
 		mlog.Err(err),
 		mlog.String("api", r.URL.Path),
-	)
+	}
+	a.requestLogger(r).Warn("api error response", fields...)
 
 	setResponseHeader(w, "Content-Type", "application/json")
 	data, err := json.Marshal(errorResponse)
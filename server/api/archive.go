@@ -63,7 +63,7 @@ func (a *API) handleArchiveExportBoard(w http.ResponseWriter, r *http.Request) {
 	if !a.permissions.HasPermissionToBoard(userID, boardID, model.PermissionViewBoard) {
 		// if this user has `manage_system` permission and there is a license with the compliance
 		// feature enabled, then we will allow the export.
-		license := a.app.GetLicense()
+		license := a.app.GetLicense(r.Context())
 		if !a.permissions.HasPermissionTo(userID, mmModel.PermissionManageSystem) || license == nil || !(*license.Features.Compliance) {
 			a.errorResponse(w, r, model.NewErrPermission("access denied to board"))
 			return
@@ -74,7 +74,7 @@ func (a *API) handleArchiveExportBoard(w http.ResponseWriter, r *http.Request) {
 	defer a.audit.LogRecord(audit.LevelRead, auditRec)
 	auditRec.AddMeta("BoardID", boardID)
 
-	board, err := a.app.GetBoard(boardID)
+	board, err := a.app.GetBoard(r.Context(), boardID)
 	if err != nil {
 		a.errorResponse(w, r, err)
 		return
@@ -90,7 +90,7 @@ func (a *API) handleArchiveExportBoard(w http.ResponseWriter, r *http.Request) {
 	w.Header().Set("Content-Disposition", "attachment; filename="+filename)
 	w.Header().Set("Content-Transfer-Encoding", "binary")
 
-	if err := a.app.ExportArchive(w, opts); err != nil {
+	if err := a.app.ExportArchive(r.Context(), w, opts); err != nil {
 		a.errorResponse(w, r, err)
 	}
 
@@ -140,7 +140,7 @@ func (a *API) handleArchiveImport(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	isGuest, err := a.userIsGuest(userID)
+	isGuest, err := a.userIsGuest(ctx, userID)
 	if err != nil {
 		a.errorResponse(w, r, err)
 		return
@@ -167,8 +167,8 @@ func (a *API) handleArchiveImport(w http.ResponseWriter, r *http.Request) {
 		ModifiedBy: userID,
 	}
 
-	if err := a.app.ImportArchive(file, opt); err != nil {
-		a.logger.Debug("Error importing archive",
+	if err := a.app.ImportArchive(ctx, file, opt); err != nil {
+		a.requestLogger(r).Debug("Error importing archive",
 			mlog.String("team_id", teamID),
 			mlog.Err(err),
 		)
@@ -0,0 +1,273 @@
+// Copyright (c) 2020-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package api
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/gorilla/mux"
+	"github.com/mattermost/mattermost-plugin-boards/server/model"
+	"github.com/mattermost/mattermost-plugin-boards/server/services/audit"
+
+	"github.com/mattermost/mattermost/server/public/shared/mlog"
+)
+
+func (a *API) registerAttachmentVersionsRoutes(r *mux.Router) {
+	// Attachment versions APIs
+	r.HandleFunc("/boards/{boardID}/blocks/{blockID}/versions", a.sessionRequired(a.handleGetAttachmentVersions)).Methods("GET")
+	r.HandleFunc("/boards/{boardID}/blocks/{blockID}/versions", a.sessionRequired(a.handleAddAttachmentVersion)).Methods("POST")
+	r.HandleFunc("/boards/{boardID}/blocks/{blockID}/versions/{version}/restore", a.sessionRequired(a.handleRestoreAttachmentVersion)).Methods("POST")
+}
+
+func (a *API) handleGetAttachmentVersions(w http.ResponseWriter, r *http.Request) {
+	// swagger:operation GET /boards/{boardID}/blocks/{blockID}/versions getAttachmentVersions
+	//
+	// Returns the file versions of an attachment block, oldest first. The file of each version
+	// can be downloaded with the files API
+	//
+	// ---
+	// produces:
+	// - application/json
+	// parameters:
+	// - name: boardID
+	//   in: path
+	//   description: Board ID
+	//   required: true
+	//   type: string
+	// - name: blockID
+	//   in: path
+	//   description: ID of the attachment block
+	//   required: true
+	//   type: string
+	// security:
+	// - BearerAuth: []
+	// responses:
+	//   '200':
+	//     description: success
+	//     schema:
+	//       type: array
+	//       items:
+	//         "$ref": "#/definitions/AttachmentVersion"
+	//   '400':
+	//     description: the block is not an attachment
+	//   '404':
+	//     description: block not found
+	//   default:
+	//     description: internal error
+	//     schema:
+	//       "$ref": "#/definitions/ErrorResponse"
+
+	vars := mux.Vars(r)
+	boardID := vars["boardID"]
+	blockID := vars["blockID"]
+	userID := getUserID(r)
+
+	if !a.permissions.HasPermissionToBoard(userID, boardID, model.PermissionViewBoard) {
+		a.errorResponse(w, r, model.NewErrPermission("access denied to board"))
+		return
+	}
+
+	versions, err := a.app.GetAttachmentVersions(r.Context(), boardID, blockID)
+	if err != nil {
+		a.errorResponse(w, r, err)
+		return
+	}
+
+	data, err := json.Marshal(versions)
+	if err != nil {
+		a.errorResponse(w, r, err)
+		return
+	}
+
+	jsonBytesResponse(w, http.StatusOK, data)
+}
+
+func (a *API) handleAddAttachmentVersion(w http.ResponseWriter, r *http.Request) {
+	// swagger:operation POST /boards/{boardID}/blocks/{blockID}/versions addAttachmentVersion
+	//
+	// Uploads a file as the new version of an attachment block
+	//
+	// ---
+	// consumes:
+	// - multipart/form-data
+	// produces:
+	// - application/json
+	// parameters:
+	// - name: boardID
+	//   in: path
+	//   description: Board ID
+	//   required: true
+	//   type: string
+	// - name: blockID
+	//   in: path
+	//   description: ID of the attachment block
+	//   required: true
+	//   type: string
+	// - name: uploaded file
+	//   in: formData
+	//   type: file
+	//   description: The file to upload
+	// security:
+	// - BearerAuth: []
+	// responses:
+	//   '200':
+	//     description: success
+	//     schema:
+	//       "$ref": "#/definitions/AttachmentVersion"
+	//   '400':
+	//     description: the block is not an attachment, or malware was found in the file
+	//   '404':
+	//     description: block not found
+	//   '413':
+	//     description: the file is too large
+	//   default:
+	//     description: internal error
+	//     schema:
+	//       "$ref": "#/definitions/ErrorResponse"
+
+	vars := mux.Vars(r)
+	boardID := vars["boardID"]
+	blockID := vars["blockID"]
+	userID := getUserID(r)
+
+	if !a.permissions.HasPermissionToBoard(userID, boardID, model.PermissionManageBoardCards) {
+		a.errorResponse(w, r, model.NewErrPermission("access denied to make board changes"))
+		return
+	}
+
+	if a.app.GetConfig().MaxFileSize > 0 {
+		r.Body = http.MaxBytesReader(w, r.Body, a.app.GetConfig().MaxFileSize)
+	}
+
+	file, handle, err := r.FormFile(UploadFormFileKey)
+	if err != nil {
+		if strings.HasSuffix(err.Error(), "http: request body too large") {
+			a.errorResponse(w, r, model.ErrRequestEntityTooLarge)
+			return
+		}
+		a.errorResponse(w, r, model.NewErrBadRequest(err.Error()))
+		return
+	}
+	defer file.Close()
+
+	auditRec := a.makeAuditRecord(r, "addAttachmentVersion", audit.Fail)
+	defer a.audit.LogRecord(audit.LevelModify, auditRec)
+	auditRec.AddMeta("boardID", boardID)
+	auditRec.AddMeta("blockID", blockID)
+	auditRec.AddMeta("filename", handle.Filename)
+
+	version, err := a.app.AddAttachmentVersion(r.Context(), boardID, blockID, file, handle.Filename, userID)
+	var infected *model.ErrFileInfected
+	if errors.As(err, &infected) {
+		auditRec.AddMeta("scanStatus", model.FileScanInfected)
+		auditRec.AddMeta("scanSignature", infected.Signature)
+	}
+	if err != nil {
+		a.errorResponse(w, r, err)
+		return
+	}
+
+	a.requestLogger(r).Debug("AddAttachmentVersion",
+		mlog.String("blockID", blockID),
+		mlog.Int("version", version.Version),
+		mlog.String("fileID", version.FileID),
+	)
+
+	data, err := json.Marshal(version)
+	if err != nil {
+		a.errorResponse(w, r, err)
+		return
+	}
+
+	jsonBytesResponse(w, http.StatusOK, data)
+
+	auditRec.AddMeta("version", version.Version)
+	auditRec.AddMeta("fileID", version.FileID)
+	auditRec.Success()
+}
+
+func (a *API) handleRestoreAttachmentVersion(w http.ResponseWriter, r *http.Request) {
+	// swagger:operation POST /boards/{boardID}/blocks/{blockID}/versions/{version}/restore restoreAttachmentVersion
+	//
+	// Makes an earlier file version of an attachment block current again, by adding a version
+	// with its file
+	//
+	// ---
+	// produces:
+	// - application/json
+	// parameters:
+	// - name: boardID
+	//   in: path
+	//   description: Board ID
+	//   required: true
+	//   type: string
+	// - name: blockID
+	//   in: path
+	//   description: ID of the attachment block
+	//   required: true
+	//   type: string
+	// - name: version
+	//   in: path
+	//   description: Number of the version to restore
+	//   required: true
+	//   type: integer
+	// security:
+	// - BearerAuth: []
+	// responses:
+	//   '200':
+	//     description: success
+	//     schema:
+	//       "$ref": "#/definitions/AttachmentVersion"
+	//   '400':
+	//     description: the block is not an attachment
+	//   '404':
+	//     description: block or version not found
+	//   default:
+	//     description: internal error
+	//     schema:
+	//       "$ref": "#/definitions/ErrorResponse"
+
+	vars := mux.Vars(r)
+	boardID := vars["boardID"]
+	blockID := vars["blockID"]
+	userID := getUserID(r)
+
+	versionNumber, err := strconv.Atoi(vars["version"])
+	if err != nil {
+		a.errorResponse(w, r, model.NewErrBadRequest("invalid version number"))
+		return
+	}
+
+	if !a.permissions.HasPermissionToBoard(userID, boardID, model.PermissionManageBoardCards) {
+		a.errorResponse(w, r, model.NewErrPermission("access denied to make board changes"))
+		return
+	}
+
+	auditRec := a.makeAuditRecord(r, "restoreAttachmentVersion", audit.Fail)
+	defer a.audit.LogRecord(audit.LevelModify, auditRec)
+	auditRec.AddMeta("boardID", boardID)
+	auditRec.AddMeta("blockID", blockID)
+	auditRec.AddMeta("restoredVersion", versionNumber)
+
+	version, err := a.app.RestoreAttachmentVersion(r.Context(), boardID, blockID, versionNumber, userID)
+	if err != nil {
+		a.errorResponse(w, r, err)
+		return
+	}
+
+	data, err := json.Marshal(version)
+	if err != nil {
+		a.errorResponse(w, r, err)
+		return
+	}
+
+	jsonBytesResponse(w, http.StatusOK, data)
+
+	auditRec.AddMeta("version", version.Version)
+	auditRec.Success()
+}
@@ -4,10 +4,18 @@
 package api
 
 import (
+	"encoding/json"
+	"fmt"
 	"net/http"
+	"strconv"
 
+	"github.com/gorilla/mux"
 	"github.com/mattermost/mattermost-plugin-boards/server/model"
 	"github.com/mattermost/mattermost-plugin-boards/server/services/audit"
+	"github.com/mattermost/mattermost-plugin-boards/server/services/tracing"
+
+	mm_model "github.com/mattermost/mattermost/server/public/model"
+	"github.com/mattermost/mattermost/server/public/shared/mlog"
 )
 
 // makeAuditRecord creates an audit record pre-populated with data from the request.
@@ -31,6 +39,159 @@ func (a *API) makeAuditRecord(r *http.Request, event string, initialStatus strin
 		IPAddress: r.RemoteAddr,
 		Meta:      []audit.Meta{{K: audit.KeyTeamID, V: teamID}},
 	}
+	if traceID := tracing.TraceID(ctx); traceID != "" {
+		rec.AddMeta(audit.KeyTraceID, traceID)
+	}
 
 	return rec
 }
+
+func (a *API) registerAuditRoutes(r *mux.Router) {
+	// Audit log APIs
+	r.HandleFunc("/admin/audit", a.sessionRequired(a.handleGetAuditEntries)).Methods("GET")
+}
+
+func (a *API) handleGetAuditEntries(w http.ResponseWriter, r *http.Request) {
+	// swagger:operation GET /admin/audit getAuditEntries
+	//
+	// Returns the persisted audit log entries, newest first. Caller must have `manage_system`
+	// permissions.
+	//
+	// ---
+	// produces:
+	// - application/json
+	// parameters:
+	// - name: user_id
+	//   in: query
+	//   description: User ID. If empty then entries of all users are included.
+	//   required: false
+	//   type: string
+	// - name: board_id
+	//   in: query
+	//   description: Board ID. If empty then entries of all boards are included.
+	//   required: false
+	//   type: string
+	// - name: event
+	//   in: query
+	//   description: Name of the API event. If empty then all events are included.
+	//   required: false
+	//   type: string
+	// - name: since
+	//   in: query
+	//   description: Only entries created at or after this time, in milliseconds since the epoch
+	//   required: false
+	//   type: integer
+	// - name: until
+	//   in: query
+	//   description: Only entries created before this time, in milliseconds since the epoch
+	//   required: false
+	//   type: integer
+	// - name: page
+	//   in: query
+	//   description: The page to select (default=0)
+	//   required: false
+	//   type: integer
+	// - name: per_page
+	//   in: query
+	//   description: Number of entries to return per page(default=60, max=200)
+	//   required: false
+	//   type: integer
+	// security:
+	// - BearerAuth: []
+	// responses:
+	//   '200':
+	//     description: success
+	//     schema:
+	//       "$ref": "#/definitions/AuditEntriesResponse"
+	//   '403':
+	//     description: the user is not a system administrator
+	//   '501':
+	//     description: the audit log is not persisted
+	//   default:
+	//     description: internal error
+	//     schema:
+	//       "$ref": "#/definitions/ErrorResponse"
+
+	query := r.URL.Query()
+
+	userID := getUserID(r)
+	if !a.permissions.HasPermissionTo(userID, mm_model.PermissionManageSystem) {
+		a.errorResponse(w, r, model.NewErrPermission("access denied to audit log"))
+		return
+	}
+
+	opts := model.QueryAuditEntriesOptions{
+		UserID:  query.Get("user_id"),
+		BoardID: query.Get("board_id"),
+		Event:   query.Get("event"),
+	}
+
+	if strSince := query.Get("since"); strSince != "" {
+		since, err := strconv.ParseInt(strSince, 10, 64)
+		if err != nil {
+			message := fmt.Sprintf("invalid `since` parameter: %s", err)
+			a.errorResponse(w, r, model.NewErrBadRequest(message))
+			return
+		}
+		opts.Since = since
+	}
+	if strUntil := query.Get("until"); strUntil != "" {
+		until, err := strconv.ParseInt(strUntil, 10, 64)
+		if err != nil {
+			message := fmt.Sprintf("invalid `until` parameter: %s", err)
+			a.errorResponse(w, r, model.NewErrBadRequest(message))
+			return
+		}
+		opts.Until = until
+	}
+
+	strPage := query.Get("page")
+	if strPage == "" {
+		strPage = complianceDefaultPage
+	}
+	strPerPage := query.Get("per_page")
+	if strPerPage == "" {
+		strPerPage = complianceDefaultPerPage
+	}
+	page, err := strconv.Atoi(strPage)
+	if err != nil {
+		message := fmt.Sprintf("invalid `page` parameter: %s", err)
+		a.errorResponse(w, r, model.NewErrBadRequest(message))
+		return
+	}
+	perPage, err := strconv.Atoi(strPerPage)
+	if err != nil {
+		message := fmt.Sprintf("invalid `per_page` parameter: %s", err)
+		a.errorResponse(w, r, model.NewErrBadRequest(message))
+		return
+	}
+	if page < 0 || perPage < 1 {
+		a.errorResponse(w, r, model.NewErrBadRequest("`page` must not be negative and `per_page` must be positive"))
+		return
+	}
+	opts.Page = page
+	opts.PerPage = min(perPage, model.AuditEntriesMaxPerPage)
+
+	entries, more, err := a.app.GetAuditEntries(r.Context(), opts)
+	if err != nil {
+		a.errorResponse(w, r, err)
+		return
+	}
+
+	a.requestLogger(r).Debug("GetAuditEntries",
+		mlog.Int("entriesCount", len(entries)),
+		mlog.Bool("hasNext", more),
+	)
+
+	response := model.AuditEntriesResponse{
+		HasNext: more,
+		Results: entries,
+	}
+	data, err := json.Marshal(response)
+	if err != nil {
+		a.errorResponse(w, r, err)
+		return
+	}
+
+	jsonBytesResponse(w, http.StatusOK, data)
+}
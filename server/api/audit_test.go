@@ -0,0 +1,79 @@
+// Copyright (c) 2020-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package api
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mattermost/mattermost-plugin-boards/server/app"
+	"github.com/mattermost/mattermost-plugin-boards/server/model"
+	"github.com/mattermost/mattermost-plugin-boards/server/services/config"
+	"github.com/mattermost/mattermost-plugin-boards/server/services/permissions/mmpermissions"
+	mmpermissionsMocks "github.com/mattermost/mattermost-plugin-boards/server/services/permissions/mmpermissions/mocks"
+	permissionsMocks "github.com/mattermost/mattermost-plugin-boards/server/services/permissions/mocks"
+	"github.com/mattermost/mattermost-plugin-boards/server/services/store/mockstore"
+
+	mm_model "github.com/mattermost/mattermost/server/public/model"
+	"github.com/mattermost/mattermost/server/public/shared/mlog"
+)
+
+func TestGetAuditEntries(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	store := mockstore.NewMockStore(ctrl)
+	pluginAPI := mmpermissionsMocks.NewMockAPI(ctrl)
+	logger := mlog.CreateConsoleTestLogger(t)
+	cfg := &config.Configuration{AuditLogPersisted: true}
+
+	testApp := app.New(cfg, nil, app.Services{
+		Store:            store,
+		Logger:           logger,
+		SkipTemplateInit: true,
+	})
+	t.Cleanup(testApp.Shutdown)
+
+	testAPI := &API{
+		app:         testApp,
+		permissions: mmpermissions.New(permissionsMocks.NewMockStore(ctrl), pluginAPI, logger),
+		logger:      logger,
+	}
+
+	pluginAPI.EXPECT().HasPermissionTo("admin-id", mm_model.PermissionManageSystem).Return(true).AnyTimes()
+	pluginAPI.EXPECT().HasPermissionTo("user-id", mm_model.PermissionManageSystem).Return(false).AnyTimes()
+
+	getAuditEntries := func(userID, query string) *httptest.ResponseRecorder {
+		request, _ := http.NewRequest(http.MethodGet, "/admin/audit?"+query, nil)
+		request = request.WithContext(context.WithValue(request.Context(), sessionContextKey, &model.Session{UserID: userID}))
+		response := httptest.NewRecorder()
+
+		testAPI.handleGetAuditEntries(response, request)
+		return response
+	}
+
+	t.Run("non administrators are forbidden", func(t *testing.T) {
+		assert.Equal(t, http.StatusForbidden, getAuditEntries("user-id", "").Code)
+	})
+
+	t.Run("large pages are clamped", func(t *testing.T) {
+		store.EXPECT().GetAuditEntries(gomock.Any(), model.QueryAuditEntriesOptions{Page: 1, PerPage: model.AuditEntriesMaxPerPage}).Return(nil, false, nil)
+
+		response := getAuditEntries("admin-id", "page=1&per_page=100000")
+		require.Equal(t, http.StatusOK, response.Code)
+
+		entries, err := model.AuditEntriesResponseFromJSON(response.Body)
+		require.NoError(t, err)
+		assert.False(t, entries.HasNext)
+	})
+
+	t.Run("pages without a limit are rejected", func(t *testing.T) {
+		assert.Equal(t, http.StatusBadRequest, getAuditEntries("admin-id", "per_page=0").Code)
+		assert.Equal(t, http.StatusBadRequest, getAuditEntries("admin-id", "page=-1").Code)
+	})
+}
@@ -0,0 +1,101 @@
+// Copyright (c) 2020-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package api
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/gorilla/mux"
+	"github.com/mattermost/mattermost-plugin-boards/server/model"
+
+	"github.com/mattermost/mattermost/server/public/shared/mlog"
+)
+
+func (a *API) registerBlockChangesRoutes(r *mux.Router) {
+	// Block changes APIs
+	r.HandleFunc("/boards/{boardID}/changes", a.sessionRequired(a.handleGetBlockChanges)).Methods("GET")
+}
+
+func (a *API) handleGetBlockChanges(w http.ResponseWriter, r *http.Request) {
+	// swagger:operation GET /boards/{boardID}/changes getBlockChanges
+	//
+	// Returns the blocks of a board changed or deleted after a cursor, oldest first. The changes
+	// of the last seconds are only returned once settled
+	//
+	// ---
+	// produces:
+	// - application/json
+	// parameters:
+	// - name: boardID
+	//   in: path
+	//   description: Board ID
+	//   required: true
+	//   type: string
+	// - name: since
+	//   in: query
+	//   description: The cursor of the previous changes, omit to return the changes from the start
+	//   required: false
+	//   type: string
+	// - name: per_page
+	//   in: query
+	//   description: Number of changes to return (default=100)
+	//   required: false
+	//   type: integer
+	// security:
+	// - BearerAuth: []
+	// responses:
+	//   '200':
+	//     description: success
+	//     schema:
+	//       "$ref": "#/definitions/BlockChanges"
+	//   '404':
+	//     description: board not found
+	//   default:
+	//     description: internal error
+	//     schema:
+	//       "$ref": "#/definitions/ErrorResponse"
+
+	boardID := mux.Vars(r)["boardID"]
+	userID := getUserID(r)
+
+	if !a.permissions.HasPermissionToBoard(userID, boardID, model.PermissionViewBoard) {
+		a.errorResponse(w, r, model.NewErrPermission("access denied to board"))
+		return
+	}
+
+	query := r.URL.Query()
+	strPerPage := query.Get("per_page")
+	if strPerPage == "" {
+		strPerPage = defaultPerPage
+	}
+	perPage, err := strconv.Atoi(strPerPage)
+	if err != nil || perPage <= 0 {
+		a.errorResponse(w, r, model.NewErrBadRequest(fmt.Sprintf("invalid `per_page` parameter: %s", strPerPage)))
+		return
+	}
+
+	changes, err := a.app.GetBlockChanges(r.Context(), boardID, query.Get("since"), perPage)
+	if err != nil {
+		a.errorResponse(w, r, err)
+		return
+	}
+
+	a.requestLogger(r).Debug("GetBlockChanges",
+		mlog.String("boardID", boardID),
+		mlog.Int("blocksCount", len(changes.Blocks)),
+		mlog.Int("deletedCount", len(changes.Deleted)),
+		mlog.Bool("hasMore", changes.HasMore),
+	)
+
+	data, err := json.Marshal(changes)
+	if err != nil {
+		a.errorResponse(w, r, err)
+		return
+	}
+
+	jsonBytesResponse(w, http.StatusOK, data)
+}
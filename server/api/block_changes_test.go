@@ -0,0 +1,131 @@
+// Copyright (c) 2020-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package api
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mattermost/mattermost-plugin-boards/server/app"
+	"github.com/mattermost/mattermost-plugin-boards/server/model"
+	"github.com/mattermost/mattermost-plugin-boards/server/services/audit"
+	"github.com/mattermost/mattermost-plugin-boards/server/services/config"
+	"github.com/mattermost/mattermost-plugin-boards/server/services/permissions/mmpermissions"
+	mmpermissionsMocks "github.com/mattermost/mattermost-plugin-boards/server/services/permissions/mmpermissions/mocks"
+	permissionsMocks "github.com/mattermost/mattermost-plugin-boards/server/services/permissions/mocks"
+	"github.com/mattermost/mattermost-plugin-boards/server/services/store/mockstore"
+
+	"github.com/mattermost/mattermost/server/public/shared/mlog"
+)
+
+const (
+	testReaderBoardID = "board-id"
+	testReaderTeamID  = "team-id"
+	testReaderUserID  = "viewer-id"
+)
+
+// setupBoardReaderAPI returns an API whose board can be viewed by a member, and the store the
+// blocks of the board are read from.
+func setupBoardReaderAPI(t *testing.T) (*API, *mockstore.MockStore) {
+	ctrl := gomock.NewController(t)
+	store := mockstore.NewMockStore(ctrl)
+	permissionsStore := permissionsMocks.NewMockStore(ctrl)
+	pluginAPI := mmpermissionsMocks.NewMockAPI(ctrl)
+	logger := mlog.CreateConsoleTestLogger(t)
+
+	testApp := app.New(&config.Configuration{}, nil, app.Services{
+		Store:            store,
+		Logger:           logger,
+		SkipTemplateInit: true,
+	})
+	t.Cleanup(testApp.Shutdown)
+
+	testAudit, err := audit.NewAudit()
+	require.NoError(t, err)
+
+	board := &model.Board{ID: testReaderBoardID, TeamID: testReaderTeamID, Type: model.BoardTypeOpen}
+	store.EXPECT().GetBoard(gomock.Any(), testReaderBoardID).Return(board, nil).AnyTimes()
+	permissionsStore.EXPECT().GetBoard(gomock.Any(), testReaderBoardID).Return(board, nil).AnyTimes()
+	permissionsStore.EXPECT().GetMemberForBoard(gomock.Any(), testReaderBoardID, testReaderUserID).
+		Return(&model.BoardMember{BoardID: testReaderBoardID, UserID: testReaderUserID, SchemeViewer: true}, nil).AnyTimes()
+	pluginAPI.EXPECT().HasPermissionToTeam(testReaderUserID, testReaderTeamID, model.PermissionViewTeam).Return(true).AnyTimes()
+	pluginAPI.EXPECT().HasPermissionToTeam(testReaderUserID, testReaderTeamID, model.PermissionManageTeam).Return(false).AnyTimes()
+
+	return &API{
+		app:         testApp,
+		permissions: mmpermissions.New(permissionsStore, pluginAPI, logger),
+		logger:      logger,
+		audit:       testAudit,
+	}, store
+}
+
+// readBoard serves a request of the board member with a handler.
+func readBoard(handler http.HandlerFunc, url string) *httptest.ResponseRecorder {
+	request, _ := http.NewRequest(http.MethodGet, url, nil)
+	request = mux.SetURLVars(request, map[string]string{"boardID": testReaderBoardID})
+	request = request.WithContext(context.WithValue(request.Context(), sessionContextKey, &model.Session{UserID: testReaderUserID}))
+	response := httptest.NewRecorder()
+
+	handler(response, request)
+	return response
+}
+
+func TestGetBlockChanges(t *testing.T) {
+	testAPI, store := setupBoardReaderAPI(t)
+
+	blocks := []*model.Block{
+		{ID: "block1", BoardID: testReaderBoardID, UpdateAt: 100},
+		{ID: "block3", BoardID: testReaderBoardID, UpdateAt: 300},
+	}
+	tombstones := []*model.BlockTombstone{
+		{ID: "block2", BoardID: testReaderBoardID, DeleteAt: 200},
+	}
+
+	t.Run("from the start", func(t *testing.T) {
+		store.EXPECT().GetChangedBlocks(gomock.Any(), gomock.Any()).Return(blocks, nil)
+		store.EXPECT().GetBlockTombstones(gomock.Any(), gomock.Any()).Return(tombstones, nil)
+
+		response := readBoard(testAPI.handleGetBlockChanges, "/boards/"+testReaderBoardID+"/changes?per_page=2")
+		require.Equal(t, http.StatusOK, response.Code)
+
+		changes, err := model.BlockChangesFromJSON(response.Body)
+		require.NoError(t, err)
+		assert.Equal(t, blocks[:1], changes.Blocks)
+		assert.Equal(t, tombstones, changes.Deleted)
+		assert.True(t, changes.HasMore)
+		assert.Equal(t, model.BlockCursor{UpdateAt: 200, ID: "block2"}.Encode(), changes.Cursor)
+	})
+
+	t.Run("since a cursor", func(t *testing.T) {
+		since := model.BlockCursor{UpdateAt: 200, ID: "block2"}.Encode()
+		store.EXPECT().GetChangedBlocks(gomock.Any(), gomock.Any()).DoAndReturn(
+			func(_ context.Context, opts model.QueryBlockChangesOptions) ([]*model.Block, error) {
+				assert.Equal(t, int64(200), opts.AfterUpdateAt)
+				assert.Equal(t, "block2", opts.AfterID)
+				return blocks[1:], nil
+			})
+		store.EXPECT().GetBlockTombstones(gomock.Any(), gomock.Any()).Return([]*model.BlockTombstone{}, nil)
+
+		response := readBoard(testAPI.handleGetBlockChanges, "/boards/"+testReaderBoardID+"/changes?since="+since)
+		require.Equal(t, http.StatusOK, response.Code)
+
+		changes, err := model.BlockChangesFromJSON(response.Body)
+		require.NoError(t, err)
+		assert.Equal(t, blocks[1:], changes.Blocks)
+		assert.Empty(t, changes.Deleted)
+		assert.False(t, changes.HasMore)
+	})
+
+	t.Run("invalid parameters", func(t *testing.T) {
+		assert.Equal(t, http.StatusBadRequest, readBoard(testAPI.handleGetBlockChanges, "/boards/"+testReaderBoardID+"/changes?since=invalid").Code)
+		assert.Equal(t, http.StatusBadRequest, readBoard(testAPI.handleGetBlockChanges, "/boards/"+testReaderBoardID+"/changes?per_page=0").Code)
+	})
+}
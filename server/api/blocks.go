@@ -52,11 +52,25 @@ func (a *API) handleGetBlocks(w http.ResponseWriter, r *http.Request) {
 	//   description: Type of blocks to return, omit to specify all types
 	//   required: false
 	//   type: string
+	// - name: cursor
+	//   in: query
+	//   description: The X-Next-Cursor header of the previous page, to return the blocks by page
+	//   required: false
+	//   type: string
+	// - name: per_page
+	//   in: query
+	//   description: Number of blocks to return per page (default=100), to return the blocks by page
+	//   required: false
+	//   type: integer
 	// security:
 	// - BearerAuth: []
 	// responses:
 	//   '200':
 	//     description: success
+	//     headers:
+	//       X-Next-Cursor:
+	//         description: The cursor of the next page, absent on the last page
+	//         type: string
 	//     schema:
 	//       type: array
 	//       items:
@@ -73,22 +87,41 @@ func (a *API) handleGetBlocks(w http.ResponseWriter, r *http.Request) {
 	blockType := query.Get("type")
 	all := query.Get("all")
 	blockID := query.Get("block_id")
+	cursor := query.Get("cursor")
+	strPerPage := query.Get("per_page")
 	boardID := mux.Vars(r)["boardID"]
 
+	// the blocks are returned by page, in ID order, if a page is requested.
+	paginated := blockID == "" && (cursor != "" || strPerPage != "")
+	if strPerPage == "" {
+		strPerPage = defaultPerPage
+	}
+	perPage, err := strconv.Atoi(strPerPage)
+	if err != nil || perPage <= 0 {
+		a.errorResponse(w, r, model.NewErrBadRequest(fmt.Sprintf("invalid `per_page` parameter: %s", strPerPage)))
+		return
+	}
+
 	userID := getUserID(r)
 
-	hasValidReadToken := a.hasValidReadTokenForBoard(r, boardID)
+	shareLink := a.getShareLinkForRequest(r, boardID)
+	hasValidReadToken := shareLink != nil
 	if userID == "" && !hasValidReadToken {
 		a.errorResponse(w, r, model.NewErrUnauthorized("access denied to board"))
 		return
 	}
 
-	board, err := a.app.GetBoard(boardID)
+	board, err := a.app.GetBoard(r.Context(), boardID)
 	if err != nil {
 		a.errorResponse(w, r, err)
 		return
 	}
 
+	if hasValidReadToken && userID != "" && a.permissions.HasPermissionToBoard(userID, boardID, model.PermissionViewBoard) {
+		// members are not limited by the scope of a share link they happen to use.
+		shareLink = nil
+	}
+
 	if !hasValidReadToken {
 		if board.IsTemplate && board.Type == model.BoardTypeOpen {
 			if board.TeamID != model.GlobalTeamID && !a.permissions.HasPermissionToTeam(userID, board.TeamID, model.PermissionViewTeam) {
@@ -103,7 +136,7 @@ func (a *API) handleGetBlocks(w http.ResponseWriter, r *http.Request) {
 		}
 		if board.IsTemplate {
 			var isGuest bool
-			isGuest, err = a.userIsGuest(userID)
+			isGuest, err = a.userIsGuest(r.Context(), userID)
 			if err != nil {
 				a.errorResponse(w, r, err)
 				return
@@ -126,15 +159,30 @@ func (a *API) handleGetBlocks(w http.ResponseWriter, r *http.Request) {
 
 	var blocks []*model.Block
 	var block *model.Block
+	var nextCursor string
 	switch {
+	case paginated:
+		opts := model.QueryBlocksOptions{
+			BoardID: boardID,
+			PerPage: perPage,
+		}
+		if all == "" {
+			opts.ParentID = parentID
+			opts.BlockType = model.BlockType(blockType)
+		}
+		blocks, nextCursor, err = a.app.GetBlocksPage(r.Context(), opts, cursor)
+		if err != nil {
+			a.errorResponse(w, r, err)
+			return
+		}
 	case all != "":
-		blocks, err = a.app.GetBlocksForBoard(boardID)
+		blocks, err = a.app.GetBlocksForBoard(r.Context(), boardID)
 		if err != nil {
 			a.errorResponse(w, r, err)
 			return
 		}
 	case blockID != "":
-		block, err = a.app.GetBlockByID(blockID)
+		block, err = a.app.GetBlockByID(r.Context(), blockID)
 		if err != nil {
 			a.errorResponse(w, r, err)
 			return
@@ -147,14 +195,22 @@ func (a *API) handleGetBlocks(w http.ResponseWriter, r *http.Request) {
 
 		blocks = append(blocks, block)
 	default:
-		blocks, err = a.app.GetBlocks(boardID, parentID, blockType)
+		blocks, err = a.app.GetBlocks(r.Context(), boardID, parentID, blockType)
+		if err != nil {
+			a.errorResponse(w, r, err)
+			return
+		}
+	}
+
+	if shareLink != nil && shareLink.Scope != model.ShareLinkScopeBoard {
+		blocks, err = a.app.FilterBlocksForShareLink(r.Context(), shareLink, blocks)
 		if err != nil {
 			a.errorResponse(w, r, err)
 			return
 		}
 	}
 
-	a.logger.Debug("GetBlocks",
+	a.requestLogger(r).Debug("GetBlocks",
 		mlog.String("boardID", boardID),
 		mlog.String("parentID", parentID),
 		mlog.String("blockType", blockType),
@@ -168,6 +224,9 @@ func (a *API) handleGetBlocks(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	if nextCursor != "" {
+		w.Header().Set(model.NextCursorHeader, nextCursor)
+	}
 	jsonBytesResponse(w, http.StatusOK, json)
 
 	auditRec.AddMeta("blockCount", len(blocks))
@@ -284,7 +343,8 @@ func (a *API) handlePostBlocks(w http.ResponseWriter, r *http.Request) {
 		}
 	}
 	if hasComments {
-		if !a.permissions.HasPermissionToBoard(userID, boardID, model.PermissionCommentBoardCards) {
+		if !a.permissions.HasPermissionToBoard(userID, boardID, model.PermissionCommentBoardCards) &&
+			(hasContents || !a.hasValidReadTokenForComments(r, boardID, commentParentIDs(blocks))) {
 			a.errorResponse(w, r, model.NewErrPermission("access denied to post card comments"))
 			return
 		}
@@ -304,19 +364,19 @@ func (a *API) handlePostBlocks(w http.ResponseWriter, r *http.Request) {
 	// this query param exists when creating template from board, or board from template
 	sourceBoardID := r.URL.Query().Get("sourceBoardID")
 	if sourceBoardID != "" {
-		if updateFileIDsErr := a.app.CopyAndUpdateCardFiles(sourceBoardID, userID, blocks, false); updateFileIDsErr != nil {
+		if updateFileIDsErr := a.app.CopyAndUpdateCardFiles(ctx, sourceBoardID, userID, blocks, false); updateFileIDsErr != nil {
 			a.errorResponse(w, r, updateFileIDsErr)
 			return
 		}
 	}
 
-	newBlocks, err := a.app.InsertBlocksAndNotify(blocks, session.UserID, disableNotify)
+	newBlocks, err := a.app.InsertBlocksAndNotify(ctx, blocks, session.UserID, disableNotify)
 	if err != nil {
 		a.errorResponse(w, r, err)
 		return
 	}
 
-	a.logger.Debug("POST Blocks",
+	a.requestLogger(r).Debug("POST Blocks",
 		mlog.Int("block_count", len(blocks)),
 		mlog.Bool("disable_notify", disableNotify),
 	)
@@ -382,7 +442,7 @@ func (a *API) handleDeleteBlock(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	block, err := a.app.GetBlockByID(blockID)
+	block, err := a.app.GetBlockByID(r.Context(), blockID)
 	if err != nil {
 		a.errorResponse(w, r, err)
 		return
@@ -406,13 +466,13 @@ func (a *API) handleDeleteBlock(w http.ResponseWriter, r *http.Request) {
 	auditRec.AddMeta("boardID", boardID)
 	auditRec.AddMeta("blockID", blockID)
 
-	err = a.app.DeleteBlockAndNotify(blockID, userID, disableNotify)
+	err = a.app.DeleteBlockAndNotify(r.Context(), blockID, userID, disableNotify)
 	if err != nil {
 		a.errorResponse(w, r, err)
 		return
 	}
 
-	a.logger.Debug("DELETE Block", mlog.String("boardID", boardID), mlog.String("blockID", blockID))
+	a.requestLogger(r).Debug("DELETE Block", mlog.String("boardID", boardID), mlog.String("blockID", blockID))
 	jsonStringResponse(w, http.StatusOK, "{}")
 
 	auditRec.Success()
@@ -459,13 +519,13 @@ func (a *API) handleUndeleteBlock(w http.ResponseWriter, r *http.Request) {
 	blockID := vars["blockID"]
 	boardID := vars["boardID"]
 
-	board, err := a.app.GetBoard(boardID)
+	board, err := a.app.GetBoard(ctx, boardID)
 	if err != nil {
 		a.errorResponse(w, r, err)
 		return
 	}
 
-	block, err := a.app.GetLastBlockHistoryEntry(blockID)
+	block, err := a.app.GetLastBlockHistoryEntry(ctx, blockID)
 	if err != nil {
 		a.errorResponse(w, r, err)
 		return
@@ -486,7 +546,7 @@ func (a *API) handleUndeleteBlock(w http.ResponseWriter, r *http.Request) {
 	defer a.audit.LogRecord(audit.LevelModify, auditRec)
 	auditRec.AddMeta("blockID", blockID)
 
-	undeletedBlock, err := a.app.UndeleteBlock(blockID, userID)
+	undeletedBlock, err := a.app.UndeleteBlock(ctx, blockID, userID)
 	if err != nil {
 		a.errorResponse(w, r, err)
 		return
@@ -498,7 +558,7 @@ func (a *API) handleUndeleteBlock(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	a.logger.Debug("UNDELETE Block", mlog.String("blockID", blockID))
+	a.requestLogger(r).Debug("UNDELETE Block", mlog.String("blockID", blockID))
 	jsonBytesResponse(w, http.StatusOK, undeletedBlockData)
 
 	auditRec.Success()
@@ -559,7 +619,7 @@ func (a *API) handlePatchBlock(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	block, err := a.app.GetBlockByID(blockID)
+	block, err := a.app.GetBlockByID(r.Context(), blockID)
 	if err != nil {
 		a.errorResponse(w, r, err)
 		return
@@ -588,12 +648,12 @@ func (a *API) handlePatchBlock(w http.ResponseWriter, r *http.Request) {
 	auditRec.AddMeta("boardID", boardID)
 	auditRec.AddMeta("blockID", blockID)
 
-	if _, err = a.app.PatchBlockAndNotify(blockID, patch, userID, disableNotify); err != nil {
+	if _, err = a.app.PatchBlockAndNotify(r.Context(), blockID, patch, userID, disableNotify); err != nil {
 		a.errorResponse(w, r, err)
 		return
 	}
 
-	a.logger.Debug("PATCH Block", mlog.String("boardID", boardID), mlog.String("blockID", blockID))
+	a.requestLogger(r).Debug("PATCH Block", mlog.String("boardID", boardID), mlog.String("blockID", blockID))
 	jsonStringResponse(w, http.StatusOK, "{}")
 
 	auditRec.Success()
@@ -665,7 +725,7 @@ func (a *API) handlePatchBlocks(w http.ResponseWriter, r *http.Request) {
 
 	for _, blockID := range patches.BlockIDs {
 		var block *model.Block
-		block, err = a.app.GetBlockByID(blockID)
+		block, err = a.app.GetBlockByID(ctx, blockID)
 		if err != nil {
 			a.errorResponse(w, r, model.NewErrForbidden("access denied to make board changes"))
 			return
@@ -676,13 +736,13 @@ func (a *API) handlePatchBlocks(w http.ResponseWriter, r *http.Request) {
 		}
 	}
 
-	err = a.app.PatchBlocksAndNotify(teamID, patches, userID, disableNotify)
+	err = a.app.PatchBlocksAndNotify(ctx, teamID, patches, userID, disableNotify)
 	if err != nil {
 		a.errorResponse(w, r, err)
 		return
 	}
 
-	a.logger.Debug("PATCH Blocks", mlog.String("patches", strconv.Itoa(len(patches.BlockIDs))))
+	a.requestLogger(r).Debug("PATCH Blocks", mlog.String("patches", strconv.Itoa(len(patches.BlockIDs))))
 	jsonStringResponse(w, http.StatusOK, "{}")
 
 	auditRec.Success()
@@ -729,7 +789,7 @@ func (a *API) handleDuplicateBlock(w http.ResponseWriter, r *http.Request) {
 	query := r.URL.Query()
 	asTemplate := query.Get("asTemplate")
 
-	board, err := a.app.GetBoard(boardID)
+	board, err := a.app.GetBoard(r.Context(), boardID)
 	if err != nil {
 		a.errorResponse(w, r, err)
 		return
@@ -740,7 +800,7 @@ func (a *API) handleDuplicateBlock(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	block, err := a.app.GetBlockByID(blockID)
+	block, err := a.app.GetBlockByID(r.Context(), blockID)
 	if err != nil {
 		a.errorResponse(w, r, err)
 		return
@@ -769,12 +829,12 @@ func (a *API) handleDuplicateBlock(w http.ResponseWriter, r *http.Request) {
 	auditRec.AddMeta("boardID", boardID)
 	auditRec.AddMeta("blockID", blockID)
 
-	a.logger.Debug("DuplicateBlock",
+	a.requestLogger(r).Debug("DuplicateBlock",
 		mlog.String("boardID", boardID),
 		mlog.String("blockID", blockID),
 	)
 
-	blocks, err := a.app.DuplicateBlock(boardID, blockID, userID, asTemplate == True)
+	blocks, err := a.app.DuplicateBlock(r.Context(), boardID, blockID, userID, asTemplate == True)
 	if err != nil {
 		a.errorResponse(w, r, err)
 		return
@@ -791,3 +851,14 @@ func (a *API) handleDuplicateBlock(w http.ResponseWriter, r *http.Request) {
 
 	auditRec.Success()
 }
+
+// commentParentIDs returns the IDs of the cards commented on by the comment blocks.
+func commentParentIDs(blocks []*model.Block) []string {
+	ids := make([]string, 0, len(blocks))
+	for _, block := range blocks {
+		if block.Type == model.TypeComment {
+			ids = append(ids, block.ParentID)
+		}
+	}
+	return ids
+}
@@ -0,0 +1,54 @@
+// Copyright (c) 2020-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package api
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mattermost/mattermost-plugin-boards/server/model"
+)
+
+func TestGetBlocksPage(t *testing.T) {
+	testAPI, store := setupBoardReaderAPI(t)
+
+	blocks := []*model.Block{
+		{ID: "block1", BoardID: testReaderBoardID},
+		{ID: "block2", BoardID: testReaderBoardID},
+		{ID: "block3", BoardID: testReaderBoardID},
+	}
+
+	getBlocks := func(query string) ([]*model.Block, string) {
+		response := readBoard(testAPI.handleGetBlocks, "/boards/"+testReaderBoardID+"/blocks?"+query)
+		require.Equal(t, http.StatusOK, response.Code)
+		return model.BlocksFromJSON(response.Body), response.Header().Get(model.NextCursorHeader)
+	}
+
+	t.Run("first page", func(t *testing.T) {
+		opts := model.QueryBlocksOptions{BoardID: testReaderBoardID, PerPage: 3}
+		store.EXPECT().GetBlocks(gomock.Any(), opts).Return(blocks, nil)
+
+		page, nextCursor := getBlocks("per_page=2")
+		assert.Equal(t, blocks[:2], page)
+		assert.Equal(t, model.BlockCursor{ID: "block2"}.Encode(), nextCursor)
+	})
+
+	t.Run("last page", func(t *testing.T) {
+		opts := model.QueryBlocksOptions{BoardID: testReaderBoardID, AfterID: "block2", PerPage: 3}
+		store.EXPECT().GetBlocks(gomock.Any(), opts).Return(blocks[2:], nil)
+
+		page, nextCursor := getBlocks("per_page=2&cursor=" + model.BlockCursor{ID: "block2"}.Encode())
+		assert.Equal(t, blocks[2:], page)
+		assert.Empty(t, nextCursor)
+	})
+
+	t.Run("invalid cursor", func(t *testing.T) {
+		response := readBoard(testAPI.handleGetBlocks, "/boards/"+testReaderBoardID+"/blocks?cursor=invalid")
+		assert.Equal(t, http.StatusBadRequest, response.Code)
+	})
+}
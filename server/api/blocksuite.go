@@ -63,7 +63,7 @@ func (a *API) handleGetCardBlockSuiteContent(w http.ResponseWriter, r *http.Requ
 	auditRec.AddMeta("cardID", cardID)
 
 	// Get card to check board permissions
-	card, err := a.app.GetCardByID(cardID)
+	card, err := a.app.GetCardByID(r.Context(), cardID)
 	if err != nil {
 		a.errorResponse(w, r, err)
 		return
@@ -75,13 +75,13 @@ func (a *API) handleGetCardBlockSuiteContent(w http.ResponseWriter, r *http.Requ
 	}
 
 	// Get BlockSuite document
-	doc, err := a.app.GetBlockSuiteDocByCardID(cardID)
+	doc, err := a.app.GetBlockSuiteDocByCardID(r.Context(), cardID)
 	if err != nil {
 		a.errorResponse(w, r, err)
 		return
 	}
 
-	a.logger.Debug("GetCardBlockSuiteContent",
+	a.requestLogger(r).Debug("GetCardBlockSuiteContent",
 		mlog.String("cardID", cardID),
 		mlog.String("docID", doc.DocID),
 		mlog.String("userID", userID),
@@ -139,7 +139,7 @@ func (a *API) handleSaveCardBlockSuiteContent(w http.ResponseWriter, r *http.Req
 	auditRec.AddMeta("cardID", cardID)
 
 	// Get card to check board permissions
-	card, err := a.app.GetCardByID(cardID)
+	card, err := a.app.GetCardByID(r.Context(), cardID)
 	if err != nil {
 		a.errorResponse(w, r, err)
 		return
@@ -170,13 +170,13 @@ func (a *API) handleSaveCardBlockSuiteContent(w http.ResponseWriter, r *http.Req
 		UpdatedBy: userID,
 	}
 
-	err = a.app.UpsertBlockSuiteDoc(doc)
+	err = a.app.UpsertBlockSuiteDoc(r.Context(), doc)
 	if err != nil {
 		a.errorResponse(w, r, err)
 		return
 	}
 
-	a.logger.Debug("SaveCardBlockSuiteContent",
+	a.requestLogger(r).Debug("SaveCardBlockSuiteContent",
 		mlog.String("cardID", cardID),
 		mlog.String("docID", doc.DocID),
 		mlog.String("userID", userID),
@@ -233,7 +233,7 @@ func (a *API) handleGetCardBlockSuiteInfo(w http.ResponseWriter, r *http.Request
 	auditRec.AddMeta("cardID", cardID)
 
 	// Get card to check board permissions
-	card, err := a.app.GetCardByID(cardID)
+	card, err := a.app.GetCardByID(r.Context(), cardID)
 	if err != nil {
 		a.errorResponse(w, r, err)
 		return
@@ -245,13 +245,13 @@ func (a *API) handleGetCardBlockSuiteInfo(w http.ResponseWriter, r *http.Request
 	}
 
 	// Get BlockSuite document info
-	info, err := a.app.GetBlockSuiteDocInfoByCardID(cardID)
+	info, err := a.app.GetBlockSuiteDocInfoByCardID(r.Context(), cardID)
 	if err != nil {
 		a.errorResponse(w, r, err)
 		return
 	}
 
-	a.logger.Debug("GetCardBlockSuiteInfo",
+	a.requestLogger(r).Debug("GetCardBlockSuiteInfo",
 		mlog.String("cardID", cardID),
 		mlog.String("docID", info.DocID),
 		mlog.String("userID", userID),
@@ -303,7 +303,7 @@ func (a *API) handleDeleteCardBlockSuiteDoc(w http.ResponseWriter, r *http.Reque
 	auditRec.AddMeta("cardID", cardID)
 
 	// Get card to check board permissions
-	card, err := a.app.GetCardByID(cardID)
+	card, err := a.app.GetCardByID(r.Context(), cardID)
 	if err != nil {
 		a.errorResponse(w, r, err)
 		return
@@ -315,13 +315,13 @@ func (a *API) handleDeleteCardBlockSuiteDoc(w http.ResponseWriter, r *http.Reque
 	}
 
 	// Delete BlockSuite document
-	err = a.app.DeleteBlockSuiteDocByCardID(cardID)
+	err = a.app.DeleteBlockSuiteDocByCardID(r.Context(), cardID)
 	if err != nil {
 		a.errorResponse(w, r, err)
 		return
 	}
 
-	a.logger.Debug("DeleteCardBlockSuiteDoc",
+	a.requestLogger(r).Debug("DeleteCardBlockSuiteDoc",
 		mlog.String("cardID", cardID),
 		mlog.String("userID", userID),
 	)
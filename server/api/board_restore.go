@@ -0,0 +1,138 @@
+// Copyright (c) 2020-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package api
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/gorilla/mux"
+	"github.com/mattermost/mattermost-plugin-boards/server/model"
+	"github.com/mattermost/mattermost-plugin-boards/server/services/audit"
+
+	"github.com/mattermost/mattermost/server/public/shared/mlog"
+)
+
+func (a *API) registerBoardRestoreRoutes(r *mux.Router) {
+	// Board restore APIs
+	r.HandleFunc("/boards/{boardID}/restore", a.sessionRequired(a.handleRestoreBoard)).Methods("POST")
+}
+
+func (a *API) handleRestoreBoard(w http.ResponseWriter, r *http.Request) {
+	// swagger:operation POST /boards/{boardID}/restore restoreBoard
+	//
+	// Restores the properties, views, cards and content blocks of a board to how they were at
+	// the given time, and returns the changes made. With preview, returns the changes without
+	// making them.
+	//
+	// ---
+	// produces:
+	// - application/json
+	// parameters:
+	// - name: boardID
+	//   in: path
+	//   description: Board ID
+	//   required: true
+	//   type: string
+	// - name: at
+	//   in: query
+	//   description: Time to restore the board to, in milliseconds since the current epoch
+	//   required: true
+	//   type: integer
+	// - name: preview
+	//   in: query
+	//   description: If true, returns the changes without making them
+	//   required: false
+	//   type: boolean
+	// security:
+	// - BearerAuth: []
+	// responses:
+	//   '200':
+	//     description: success
+	//     schema:
+	//       "$ref": "#/definitions/BoardRestorePlan"
+	//   '404':
+	//     description: board not found, or did not exist at the given time
+	//   default:
+	//     description: internal error
+	//     schema:
+	//       "$ref": "#/definitions/ErrorResponse"
+
+	boardID := mux.Vars(r)["boardID"]
+	userID := getUserID(r)
+	query := r.URL.Query()
+
+	at, err := strconv.ParseInt(query.Get("at"), 10, 64)
+	if err != nil {
+		message := fmt.Sprintf("invalid `at` parameter: %s", err)
+		a.errorResponse(w, r, model.NewErrBadRequest(message))
+		return
+	}
+
+	preview := false
+	if strPreview := query.Get("preview"); strPreview != "" {
+		preview, err = strconv.ParseBool(strPreview)
+		if err != nil {
+			message := fmt.Sprintf("invalid `preview` parameter: %s", err)
+			a.errorResponse(w, r, model.NewErrBadRequest(message))
+			return
+		}
+	}
+
+	if preview {
+		if !a.permissions.HasPermissionToBoard(userID, boardID, model.PermissionViewBoard) {
+			a.errorResponse(w, r, model.NewErrPermission("access denied to board"))
+			return
+		}
+
+		plan, err := a.app.PreviewBoardRestore(r.Context(), boardID, at)
+		if err != nil {
+			a.errorResponse(w, r, err)
+			return
+		}
+
+		data, err := json.Marshal(plan)
+		if err != nil {
+			a.errorResponse(w, r, err)
+			return
+		}
+		jsonBytesResponse(w, http.StatusOK, data)
+		return
+	}
+
+	if !a.permissions.HasPermissionToBoard(userID, boardID, model.PermissionManageBoardProperties) ||
+		!a.permissions.HasPermissionToBoard(userID, boardID, model.PermissionManageBoardCards) {
+		a.errorResponse(w, r, model.NewErrPermission("access denied to restore board"))
+		return
+	}
+
+	auditRec := a.makeAuditRecord(r, "restoreBoard", audit.Fail)
+	defer a.audit.LogRecord(audit.LevelModify, auditRec)
+	auditRec.AddMeta("boardID", boardID)
+	auditRec.AddMeta("at", at)
+
+	plan, err := a.app.RestoreBoard(r.Context(), boardID, at, userID)
+	if err != nil {
+		a.errorResponse(w, r, err)
+		return
+	}
+
+	data, err := json.Marshal(plan)
+	if err != nil {
+		a.errorResponse(w, r, err)
+		return
+	}
+
+	a.requestLogger(r).Debug("RestoreBoard",
+		mlog.String("boardID", boardID),
+		mlog.Int("at", at),
+		mlog.Int("blocks", len(plan.Blocks)),
+	)
+	jsonBytesResponse(w, http.StatusOK, data)
+
+	auditRec.AddMeta("blocks", len(plan.Blocks))
+	auditRec.Success()
+}
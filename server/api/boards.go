@@ -67,20 +67,20 @@ func (a *API) handleGetBoards(w http.ResponseWriter, r *http.Request) {
 	defer a.audit.LogRecord(audit.LevelRead, auditRec)
 	auditRec.AddMeta("teamID", teamID)
 
-	isGuest, err := a.userIsGuest(userID)
+	isGuest, err := a.userIsGuest(r.Context(), userID)
 	if err != nil {
 		a.errorResponse(w, r, err)
 		return
 	}
 
 	// retrieve boards list
-	boards, err := a.app.GetBoardsForUserAndTeam(userID, teamID, !isGuest)
+	boards, err := a.app.GetBoardsForUserAndTeam(r.Context(), userID, teamID, !isGuest)
 	if err != nil {
 		a.errorResponse(w, r, err)
 		return
 	}
 
-	a.logger.Debug("GetBoards",
+	a.requestLogger(r).Debug("GetBoards",
 		mlog.String("teamID", teamID),
 		mlog.Int("boardsCount", len(boards)),
 	)
@@ -156,7 +156,7 @@ func (a *API) handleCreateBoard(w http.ResponseWriter, r *http.Request) {
 		}
 	}
 
-	isGuest, err := a.userIsGuest(userID)
+	isGuest, err := a.userIsGuest(r.Context(), userID)
 	if err != nil {
 		a.errorResponse(w, r, err)
 		return
@@ -177,13 +177,13 @@ func (a *API) handleCreateBoard(w http.ResponseWriter, r *http.Request) {
 	auditRec.AddMeta("boardType", newBoard.Type)
 
 	// create board
-	board, err := a.app.CreateBoard(newBoard, userID, true)
+	board, err := a.app.CreateBoard(r.Context(), newBoard, userID, true)
 	if err != nil {
 		a.errorResponse(w, r, err)
 		return
 	}
 
-	a.logger.Debug("CreateBoard",
+	a.requestLogger(r).Debug("CreateBoard",
 		mlog.String("teamID", board.TeamID),
 		mlog.String("boardID", board.ID),
 		mlog.String("boardType", string(board.Type)),
@@ -239,7 +239,7 @@ func (a *API) handleGetBoard(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	board, err := a.app.GetBoard(boardID)
+	board, err := a.app.GetBoard(r.Context(), boardID)
 	if err != nil {
 		a.errorResponse(w, r, err)
 		return
@@ -253,7 +253,7 @@ func (a *API) handleGetBoard(w http.ResponseWriter, r *http.Request) {
 			}
 		} else {
 			var isGuest bool
-			isGuest, err = a.userIsGuest(userID)
+			isGuest, err = a.userIsGuest(r.Context(), userID)
 			if err != nil {
 				a.errorResponse(w, r, err)
 				return
@@ -275,7 +275,7 @@ func (a *API) handleGetBoard(w http.ResponseWriter, r *http.Request) {
 	defer a.audit.LogRecord(audit.LevelRead, auditRec)
 	auditRec.AddMeta("boardID", boardID)
 
-	a.logger.Debug("GetBoard",
+	a.requestLogger(r).Debug("GetBoard",
 		mlog.String("boardID", boardID),
 	)
 
@@ -326,7 +326,7 @@ func (a *API) handlePatchBoard(w http.ResponseWriter, r *http.Request) {
 	//       "$ref": "#/definitions/ErrorResponse"
 
 	boardID := mux.Vars(r)["boardID"]
-	if _, err := a.app.GetBoard(boardID); err != nil {
+	if _, err := a.app.GetBoard(r.Context(), boardID); err != nil {
 		a.errorResponse(w, r, err)
 		return
 	}
@@ -374,13 +374,13 @@ func (a *API) handlePatchBoard(w http.ResponseWriter, r *http.Request) {
 	auditRec.AddMeta("userID", userID)
 
 	// patch board
-	updatedBoard, err := a.app.PatchBoard(patch, boardID, userID)
+	updatedBoard, err := a.app.PatchBoard(r.Context(), patch, boardID, userID)
 	if err != nil {
 		a.errorResponse(w, r, err)
 		return
 	}
 
-	a.logger.Debug("PatchBoard",
+	a.requestLogger(r).Debug("PatchBoard",
 		mlog.String("boardID", boardID),
 		mlog.String("userID", userID),
 	)
@@ -427,7 +427,7 @@ func (a *API) handleDeleteBoard(w http.ResponseWriter, r *http.Request) {
 	userID := getUserID(r)
 
 	// Check if board exists
-	if _, err := a.app.GetBoard(boardID); err != nil {
+	if _, err := a.app.GetBoard(r.Context(), boardID); err != nil {
 		a.errorResponse(w, r, err)
 		return
 	}
@@ -441,12 +441,12 @@ func (a *API) handleDeleteBoard(w http.ResponseWriter, r *http.Request) {
 	defer a.audit.LogRecord(audit.LevelModify, auditRec)
 	auditRec.AddMeta("boardID", boardID)
 
-	if err := a.app.DeleteBoard(boardID, userID); err != nil {
+	if err := a.app.DeleteBoard(r.Context(), boardID, userID); err != nil {
 		a.errorResponse(w, r, err)
 		return
 	}
 
-	a.logger.Debug("DELETE Board", mlog.String("boardID", boardID))
+	a.requestLogger(r).Debug("DELETE Board", mlog.String("boardID", boardID))
 	jsonStringResponse(w, http.StatusOK, "{}")
 
 	auditRec.Success()
@@ -491,7 +491,7 @@ func (a *API) handleDuplicateBoard(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	board, err := a.app.GetBoard(boardID)
+	board, err := a.app.GetBoard(r.Context(), boardID)
 	if err != nil {
 		a.errorResponse(w, r, err)
 		return
@@ -523,7 +523,7 @@ func (a *API) handleDuplicateBoard(w http.ResponseWriter, r *http.Request) {
 		}
 	}
 
-	isGuest, err := a.userIsGuest(userID)
+	isGuest, err := a.userIsGuest(r.Context(), userID)
 	if err != nil {
 		a.errorResponse(w, r, err)
 		return
@@ -537,11 +537,11 @@ func (a *API) handleDuplicateBoard(w http.ResponseWriter, r *http.Request) {
 	defer a.audit.LogRecord(audit.LevelRead, auditRec)
 	auditRec.AddMeta("boardID", boardID)
 
-	a.logger.Debug("DuplicateBoard",
+	a.requestLogger(r).Debug("DuplicateBoard",
 		mlog.String("boardID", boardID),
 	)
 
-	boardsAndBlocks, _, err := a.app.DuplicateBoard(boardID, userID, toTeam, asTemplate == True)
+	boardsAndBlocks, _, err := a.app.DuplicateBoard(r.Context(), boardID, userID, toTeam, asTemplate == True)
 	if err != nil {
 		a.errorResponse(w, r, err)
 		return
@@ -599,13 +599,13 @@ func (a *API) handleUndeleteBoard(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	err := a.app.UndeleteBoard(boardID, userID)
+	err := a.app.UndeleteBoard(ctx, boardID, userID)
 	if err != nil {
 		a.errorResponse(w, r, err)
 		return
 	}
 
-	a.logger.Debug("UNDELETE Board", mlog.String("boardID", boardID))
+	a.requestLogger(r).Debug("UNDELETE Board", mlog.String("boardID", boardID))
 	jsonStringResponse(w, http.StatusOK, "{}")
 
 	auditRec.Success()
@@ -644,7 +644,7 @@ func (a *API) handleGetBoardMetadata(w http.ResponseWriter, r *http.Request) {
 	boardID := mux.Vars(r)["boardID"]
 	userID := getUserID(r)
 
-	board, boardMetadata, err := a.app.GetBoardMetadata(boardID)
+	board, boardMetadata, err := a.app.GetBoardMetadata(r.Context(), boardID)
 	if err != nil {
 		a.errorResponse(w, r, err)
 		return
@@ -702,7 +702,7 @@ func (a *API) handleSendBoardNotification(w http.ResponseWriter, r *http.Request
 	}
 
 	// 실제 카드 정보로 알림 전송
-	err := a.app.SendCardNotification(boardID, userID, requestBody.CardID)
+	err := a.app.SendCardNotification(r.Context(), boardID, userID, requestBody.CardID)
 	if err != nil {
 		a.errorResponse(w, r, err)
 		return
@@ -100,7 +100,7 @@ func (a *API) handleCreateBoardsAndBlocks(w http.ResponseWriter, r *http.Request
 		return
 	}
 
-	isGuest, err := a.userIsGuest(userID)
+	isGuest, err := a.userIsGuest(r.Context(), userID)
 	if err != nil {
 		a.errorResponse(w, r, err)
 		return
@@ -153,13 +153,13 @@ func (a *API) handleCreateBoardsAndBlocks(w http.ResponseWriter, r *http.Request
 	auditRec.AddMeta("blocksCount", len(newBab.Blocks))
 
 	// create boards and blocks
-	bab, err := a.app.CreateBoardsAndBlocks(newBab, userID, true)
+	bab, err := a.app.CreateBoardsAndBlocks(r.Context(), newBab, userID, true)
 	if err != nil {
 		a.errorResponse(w, r, err)
 		return
 	}
 
-	a.logger.Debug("CreateBoardsAndBlocks",
+	a.requestLogger(r).Debug("CreateBoardsAndBlocks",
 		mlog.String("teamID", teamID),
 		mlog.String("userID", userID),
 		mlog.Int("boardCount", len(bab.Boards)),
@@ -247,7 +247,7 @@ func (a *API) handlePatchBoardsAndBlocks(w http.ResponseWriter, r *http.Request)
 			}
 		}
 
-		board, err2 := a.app.GetBoard(boardID)
+		board, err2 := a.app.GetBoard(r.Context(), boardID)
 		if err2 != nil {
 			a.errorResponse(w, r, err2)
 			return
@@ -263,7 +263,7 @@ func (a *API) handlePatchBoardsAndBlocks(w http.ResponseWriter, r *http.Request)
 	}
 
 	for _, blockID := range pbab.BlockIDs {
-		block, err2 := a.app.GetBlockByID(blockID)
+		block, err2 := a.app.GetBlockByID(r.Context(), blockID)
 		if err2 != nil {
 			a.errorResponse(w, r, err2)
 			return
@@ -285,13 +285,13 @@ func (a *API) handlePatchBoardsAndBlocks(w http.ResponseWriter, r *http.Request)
 	auditRec.AddMeta("boardsCount", len(pbab.BoardIDs))
 	auditRec.AddMeta("blocksCount", len(pbab.BlockIDs))
 
-	bab, err := a.app.PatchBoardsAndBlocks(pbab, userID)
+	bab, err := a.app.PatchBoardsAndBlocks(r.Context(), pbab, userID)
 	if err != nil {
 		a.errorResponse(w, r, err)
 		return
 	}
 
-	a.logger.Debug("PATCH BoardsAndBlocks",
+	a.requestLogger(r).Debug("PATCH BoardsAndBlocks",
 		mlog.Int("boardsCount", len(pbab.BoardIDs)),
 		mlog.Int("blocksCount", len(pbab.BlockIDs)),
 	)
@@ -354,7 +354,7 @@ func (a *API) handleDeleteBoardsAndBlocks(w http.ResponseWriter, r *http.Request
 	for _, boardID := range dbab.Boards {
 		boardIDMap[boardID] = true
 		// all boards in the request should belong to the same team
-		board, err := a.app.GetBoard(boardID)
+		board, err := a.app.GetBoard(r.Context(), boardID)
 		if err != nil {
 			a.errorResponse(w, r, err)
 			return
@@ -375,7 +375,7 @@ func (a *API) handleDeleteBoardsAndBlocks(w http.ResponseWriter, r *http.Request
 	}
 
 	for _, blockID := range dbab.Blocks {
-		block, err2 := a.app.GetBlockByID(blockID)
+		block, err2 := a.app.GetBlockByID(r.Context(), blockID)
 		if err2 != nil {
 			a.errorResponse(w, r, err2)
 			return
@@ -402,12 +402,12 @@ func (a *API) handleDeleteBoardsAndBlocks(w http.ResponseWriter, r *http.Request
 	auditRec.AddMeta("boardsCount", len(dbab.Boards))
 	auditRec.AddMeta("blocksCount", len(dbab.Blocks))
 
-	if err := a.app.DeleteBoardsAndBlocks(dbab, userID); err != nil {
+	if err := a.app.DeleteBoardsAndBlocks(r.Context(), dbab, userID); err != nil {
 		a.errorResponse(w, r, err)
 		return
 	}
 
-	a.logger.Debug("DELETE BoardsAndBlocks",
+	a.requestLogger(r).Debug("DELETE BoardsAndBlocks",
 		mlog.Int("boardsCount", len(dbab.Boards)),
 		mlog.Int("blocksCount", len(dbab.Blocks)),
 	)
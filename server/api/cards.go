@@ -13,6 +13,7 @@ import (
 	"github.com/gorilla/mux"
 	"github.com/mattermost/mattermost-plugin-boards/server/model"
 	"github.com/mattermost/mattermost-plugin-boards/server/services/audit"
+	"github.com/mattermost/mattermost-plugin-boards/server/utils"
 
 	"github.com/mattermost/mattermost/server/public/shared/mlog"
 )
@@ -28,6 +29,7 @@ func (a *API) registerCardsRoutes(r *mux.Router) {
 	r.HandleFunc("/boards/{boardID}/cards", a.sessionRequired(a.handleGetCards)).Methods("GET")
 	r.HandleFunc("/cards/{cardID}", a.sessionRequired(a.handlePatchCard)).Methods("PATCH")
 	r.HandleFunc("/cards/{cardID}", a.sessionRequired(a.handleGetCard)).Methods("GET")
+	r.HandleFunc("/cards/{cardID}/diff", a.sessionRequired(a.handleGetCardDiff)).Methods("GET")
 }
 
 func (a *API) handleCreateCard(w http.ResponseWriter, r *http.Request) {
@@ -106,13 +108,13 @@ func (a *API) handleCreateCard(w http.ResponseWriter, r *http.Request) {
 	auditRec.AddMeta("boardID", boardID)
 
 	// create card
-	card, err := a.app.CreateCard(newCard, boardID, userID, disableNotify)
+	card, err := a.app.CreateCard(r.Context(), newCard, boardID, userID, disableNotify)
 	if err != nil {
 		a.errorResponse(w, r, err)
 		return
 	}
 
-	a.logger.Debug("CreateCard",
+	a.requestLogger(r).Debug("CreateCard",
 		mlog.String("boardID", boardID),
 		mlog.String("cardID", card.ID),
 		mlog.String("userID", userID),
@@ -144,6 +146,11 @@ func (a *API) handleGetCards(w http.ResponseWriter, r *http.Request) {
 	//   description: Board ID
 	//   required: true
 	//   type: string
+	// - name: cursor
+	//   in: query
+	//   description: The X-Next-Cursor header of the previous page, empty for the first page, to return the cards by cursor instead of offset
+	//   required: false
+	//   type: string
 	// - name: page
 	//   in: query
 	//   description: The page to select (default=0)
@@ -159,6 +166,10 @@ func (a *API) handleGetCards(w http.ResponseWriter, r *http.Request) {
 	// responses:
 	//   '200':
 	//     description: success
+	//     headers:
+	//       X-Next-Cursor:
+	//         description: The cursor of the next page, absent on the last page
+	//         type: string
 	//     schema:
 	//       type: array
 	//       items:
@@ -173,12 +184,19 @@ func (a *API) handleGetCards(w http.ResponseWriter, r *http.Request) {
 	query := r.URL.Query()
 	strPage := query.Get("page")
 	strPerPage := query.Get("per_page")
+	cursor := query.Get("cursor")
 
 	if !a.permissions.HasPermissionToBoard(userID, boardID, model.PermissionViewBoard) {
 		a.errorResponse(w, r, model.NewErrPermission("access denied to fetch cards"))
 		return
 	}
 
+	// the cards are paginated by cursor if the parameter is given, and by offset otherwise.
+	byCursor := query.Has("cursor")
+	if byCursor && strPage != "" {
+		a.errorResponse(w, r, model.NewErrBadRequest("the `page` and `cursor` parameters are exclusive"))
+		return
+	}
 	if strPage == "" {
 		strPage = defaultPage
 	}
@@ -190,12 +208,14 @@ func (a *API) handleGetCards(w http.ResponseWriter, r *http.Request) {
 	if err != nil {
 		message := fmt.Sprintf("invalid `page` parameter: %s", err)
 		a.errorResponse(w, r, model.NewErrBadRequest(message))
+		return
 	}
 
 	perPage, err := strconv.Atoi(strPerPage)
 	if err != nil {
 		message := fmt.Sprintf("invalid `per_page` parameter: %s", err)
 		a.errorResponse(w, r, model.NewErrBadRequest(message))
+		return
 	}
 
 	auditRec := a.makeAuditRecord(r, "getCards", audit.Fail)
@@ -204,17 +224,24 @@ func (a *API) handleGetCards(w http.ResponseWriter, r *http.Request) {
 	auditRec.AddMeta("page", page)
 	auditRec.AddMeta("per_page", perPage)
 
-	cards, err := a.app.GetCardsForBoard(boardID, page, perPage)
+	var cards []*model.Card
+	var nextCursor string
+	if byCursor {
+		cards, nextCursor, err = a.app.GetCardsPage(r.Context(), boardID, cursor, perPage)
+	} else {
+		cards, err = a.app.GetCardsForBoard(r.Context(), boardID, page, perPage)
+	}
 	if err != nil {
 		a.errorResponse(w, r, err)
 		return
 	}
 
-	a.logger.Debug("GetCards",
+	a.requestLogger(r).Debug("GetCards",
 		mlog.String("boardID", boardID),
 		mlog.String("userID", userID),
 		mlog.Int("page", page),
 		mlog.Int("per_page", perPage),
+		mlog.Bool("hasNext", nextCursor != ""),
 		mlog.Int("count", len(cards)),
 	)
 
@@ -225,6 +252,9 @@ func (a *API) handleGetCards(w http.ResponseWriter, r *http.Request) {
 	}
 
 	// response
+	if nextCursor != "" {
+		w.Header().Set(model.NextCursorHeader, nextCursor)
+	}
 	jsonBytesResponse(w, http.StatusOK, data)
 
 	auditRec.Success()
@@ -279,7 +309,7 @@ func (a *API) handlePatchCard(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	card, err := a.app.GetCardByID(cardID)
+	card, err := a.app.GetCardByID(r.Context(), cardID)
 	if err != nil {
 		message := fmt.Sprintf("could not fetch card %s: %s", cardID, err)
 		a.errorResponse(w, r, model.NewErrBadRequest(message))
@@ -303,13 +333,13 @@ func (a *API) handlePatchCard(w http.ResponseWriter, r *http.Request) {
 	auditRec.AddMeta("cardID", card.ID)
 
 	// patch card
-	cardPatched, err := a.app.PatchCard(patch, card.ID, userID, disableNotify)
+	cardPatched, err := a.app.PatchCard(r.Context(), patch, card.ID, userID, disableNotify)
 	if err != nil {
 		a.errorResponse(w, r, err)
 		return
 	}
 
-	a.logger.Debug("PatchCard",
+	a.requestLogger(r).Debug("PatchCard",
 		mlog.String("boardID", cardPatched.BoardID),
 		mlog.String("cardID", cardPatched.ID),
 		mlog.String("userID", userID),
@@ -356,7 +386,7 @@ func (a *API) handleGetCard(w http.ResponseWriter, r *http.Request) {
 	userID := getUserID(r)
 	cardID := mux.Vars(r)["cardID"]
 
-	card, err := a.app.GetCardByID(cardID)
+	card, err := a.app.GetCardByID(r.Context(), cardID)
 	if err != nil {
 		message := fmt.Sprintf("could not fetch card %s: %s", cardID, err)
 		a.errorResponse(w, r, model.NewErrBadRequest(message))
@@ -373,7 +403,7 @@ func (a *API) handleGetCard(w http.ResponseWriter, r *http.Request) {
 	auditRec.AddMeta("boardID", card.BoardID)
 	auditRec.AddMeta("cardID", card.ID)
 
-	a.logger.Debug("GetCard",
+	a.requestLogger(r).Debug("GetCard",
 		mlog.String("boardID", card.BoardID),
 		mlog.String("cardID", card.ID),
 		mlog.String("userID", userID),
@@ -390,3 +420,95 @@ func (a *API) handleGetCard(w http.ResponseWriter, r *http.Request) {
 
 	auditRec.Success()
 }
+
+func (a *API) handleGetCardDiff(w http.ResponseWriter, r *http.Request) {
+	// swagger:operation GET /cards/{cardID}/diff getCardDiff
+	//
+	// Returns the differences between the versions of a card at two times: its title, its
+	// properties, and the content blocks added, removed or with a changed text.
+	//
+	// ---
+	// produces:
+	// - application/json
+	// parameters:
+	// - name: cardID
+	//   in: path
+	//   description: Card ID
+	//   required: true
+	//   type: string
+	// - name: from
+	//   in: query
+	//   description: Time of the older version, in milliseconds since the current epoch
+	//   required: true
+	//   type: integer
+	// - name: to
+	//   in: query
+	//   description: Time of the newer version, in milliseconds since the current epoch. Defaults to now
+	//   required: false
+	//   type: integer
+	// security:
+	// - BearerAuth: []
+	// responses:
+	//   '200':
+	//     description: success
+	//     schema:
+	//       "$ref": "#/definitions/CardDiff"
+	//   '404':
+	//     description: card not found
+	//   default:
+	//     description: internal error
+	//     schema:
+	//       "$ref": "#/definitions/ErrorResponse"
+
+	cardID := mux.Vars(r)["cardID"]
+	userID := getUserID(r)
+	query := r.URL.Query()
+
+	from, err := strconv.ParseInt(query.Get("from"), 10, 64)
+	if err != nil {
+		message := fmt.Sprintf("invalid `from` parameter: %s", err)
+		a.errorResponse(w, r, model.NewErrBadRequest(message))
+		return
+	}
+
+	to := utils.GetMillis()
+	if strTo := query.Get("to"); strTo != "" {
+		to, err = strconv.ParseInt(strTo, 10, 64)
+		if err != nil {
+			message := fmt.Sprintf("invalid `to` parameter: %s", err)
+			a.errorResponse(w, r, model.NewErrBadRequest(message))
+			return
+		}
+	}
+
+	card, err := a.app.GetCardByID(r.Context(), cardID)
+	if err != nil {
+		a.errorResponse(w, r, err)
+		return
+	}
+
+	if !a.permissions.HasPermissionToBoard(userID, card.BoardID, model.PermissionViewBoard) {
+		a.errorResponse(w, r, model.NewErrPermission("access denied to card"))
+		return
+	}
+
+	diff, err := a.app.GetCardDiff(r.Context(), cardID, from, to)
+	if err != nil {
+		a.errorResponse(w, r, err)
+		return
+	}
+
+	a.requestLogger(r).Debug("GetCardDiff",
+		mlog.String("cardID", cardID),
+		mlog.Int("from", from),
+		mlog.Int("to", to),
+	)
+
+	data, err := json.Marshal(diff)
+	if err != nil {
+		a.errorResponse(w, r, err)
+		return
+	}
+
+	jsonBytesResponse(w, http.StatusOK, data)
+}
@@ -0,0 +1,69 @@
+// Copyright (c) 2020-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package api
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mattermost/mattermost-plugin-boards/server/model"
+)
+
+func TestGetCards(t *testing.T) {
+	testAPI, store := setupBoardReaderAPI(t)
+
+	cards := []*model.Block{
+		{ID: "card1", BoardID: testReaderBoardID, Type: model.TypeCard},
+		{ID: "card2", BoardID: testReaderBoardID, Type: model.TypeCard},
+		{ID: "card3", BoardID: testReaderBoardID, Type: model.TypeCard},
+	}
+
+	getCards := func(query string) ([]*model.Card, string) {
+		response := readBoard(testAPI.handleGetCards, "/boards/"+testReaderBoardID+"/cards?"+query)
+		require.Equal(t, http.StatusOK, response.Code)
+
+		var page []*model.Card
+		require.NoError(t, json.NewDecoder(response.Body).Decode(&page))
+		return page, response.Header().Get(model.NextCursorHeader)
+	}
+
+	t.Run("by offset by default", func(t *testing.T) {
+		opts := model.QueryBlocksOptions{BoardID: testReaderBoardID, BlockType: model.TypeCard, Page: 1, PerPage: 2}
+		store.EXPECT().GetBlocks(gomock.Any(), opts).Return(cards[2:], nil)
+
+		page, nextCursor := getCards("page=1&per_page=2")
+		require.Len(t, page, 1)
+		assert.Equal(t, "card3", page[0].ID)
+		assert.Empty(t, nextCursor)
+	})
+
+	t.Run("by cursor from the first page", func(t *testing.T) {
+		opts := model.QueryBlocksOptions{BoardID: testReaderBoardID, BlockType: model.TypeCard, PerPage: 3}
+		store.EXPECT().GetBlocks(gomock.Any(), opts).Return(cards, nil)
+
+		page, nextCursor := getCards("cursor=&per_page=2")
+		require.Len(t, page, 2)
+		assert.Equal(t, model.BlockCursor{ID: "card2"}.Encode(), nextCursor)
+	})
+
+	t.Run("by cursor to the last page", func(t *testing.T) {
+		opts := model.QueryBlocksOptions{BoardID: testReaderBoardID, BlockType: model.TypeCard, AfterID: "card2", PerPage: 3}
+		store.EXPECT().GetBlocks(gomock.Any(), opts).Return(cards[2:], nil)
+
+		page, nextCursor := getCards("cursor=" + model.BlockCursor{ID: "card2"}.Encode() + "&per_page=2")
+		require.Len(t, page, 1)
+		assert.Equal(t, "card3", page[0].ID)
+		assert.Empty(t, nextCursor)
+	})
+
+	t.Run("page and cursor are exclusive", func(t *testing.T) {
+		response := readBoard(testAPI.handleGetCards, "/boards/"+testReaderBoardID+"/cards?page=1&cursor=")
+		assert.Equal(t, http.StatusBadRequest, response.Code)
+	})
+}
@@ -99,7 +99,7 @@ func (a *API) handleCreateCategory(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	createdCategory, err := a.app.CreateCategory(&category)
+	createdCategory, err := a.app.CreateCategory(ctx, &category)
 	if err != nil {
 		a.errorResponse(w, r, err)
 		return
@@ -197,7 +197,7 @@ func (a *API) handleUpdateCategory(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	updatedCategory, err := a.app.UpdateCategory(&category)
+	updatedCategory, err := a.app.UpdateCategory(ctx, &category)
 	if err != nil {
 		a.errorResponse(w, r, err)
 		return
@@ -258,7 +258,7 @@ func (a *API) handleDeleteCategory(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	deletedCategory, err := a.app.DeleteCategory(categoryID, userID, teamID)
+	deletedCategory, err := a.app.DeleteCategory(ctx, categoryID, userID, teamID)
 	if err != nil {
 		a.errorResponse(w, r, err)
 		return
@@ -317,7 +317,7 @@ func (a *API) handleGetUserCategoryBoards(w http.ResponseWriter, r *http.Request
 		return
 	}
 
-	categoryBlocks, err := a.app.GetUserCategoryBoards(userID, teamID)
+	categoryBlocks, err := a.app.GetUserCategoryBoards(ctx, userID, teamID)
 	if err != nil {
 		a.errorResponse(w, r, err)
 		return
@@ -385,7 +385,7 @@ func (a *API) handleUpdateCategoryBoard(w http.ResponseWriter, r *http.Request)
 	}
 
 	// TODO: Check the category and the team matches
-	err := a.app.AddUpdateUserCategoryBoard(teamID, userID, categoryID, []string{boardID})
+	err := a.app.AddUpdateUserCategoryBoard(ctx, teamID, userID, categoryID, []string{boardID})
 	if err != nil {
 		a.errorResponse(w, r, err)
 		return
@@ -451,7 +451,7 @@ func (a *API) handleReorderCategories(w http.ResponseWriter, r *http.Request) {
 	auditRec.AddMeta("TeamID", teamID)
 	auditRec.AddMeta("CategoryCount", len(newCategoryOrder))
 
-	updatedCategoryOrder, err := a.app.ReorderCategories(userID, teamID, newCategoryOrder)
+	updatedCategoryOrder, err := a.app.ReorderCategories(ctx, userID, teamID, newCategoryOrder)
 	if err != nil {
 		a.errorResponse(w, r, err)
 		return
@@ -509,7 +509,7 @@ func (a *API) handleReorderCategoryBoards(w http.ResponseWriter, r *http.Request
 		return
 	}
 
-	category, err := a.app.GetCategory(categoryID)
+	category, err := a.app.GetCategory(ctx, categoryID)
 	if err != nil {
 		a.errorResponse(w, r, err)
 		return
@@ -536,7 +536,7 @@ func (a *API) handleReorderCategoryBoards(w http.ResponseWriter, r *http.Request
 	auditRec := a.makeAuditRecord(r, "reorderCategoryBoards", audit.Fail)
 	defer a.audit.LogRecord(audit.LevelModify, auditRec)
 
-	updatedBoardsOrder, err := a.app.ReorderCategoryBoards(userID, teamID, categoryID, newBoardsOrder)
+	updatedBoardsOrder, err := a.app.ReorderCategoryBoards(ctx, userID, teamID, categoryID, newBoardsOrder)
 	if err != nil {
 		a.errorResponse(w, r, err)
 		return
@@ -605,7 +605,7 @@ func (a *API) handleHideBoard(w http.ResponseWriter, r *http.Request) {
 	auditRec.AddMeta("team_id", teamID)
 	auditRec.AddMeta("category_id", categoryID)
 
-	if err := a.app.SetBoardVisibility(teamID, userID, categoryID, boardID, false); err != nil {
+	if err := a.app.SetBoardVisibility(r.Context(), teamID, userID, categoryID, boardID, false); err != nil {
 		a.errorResponse(w, r, err)
 		return
 	}
@@ -665,7 +665,7 @@ func (a *API) handleUnhideBoard(w http.ResponseWriter, r *http.Request) {
 	defer a.audit.LogRecord(audit.LevelModify, auditRec)
 	auditRec.AddMeta("boardID", boardID)
 
-	if err := a.app.SetBoardVisibility(teamID, userID, categoryID, boardID, true); err != nil {
+	if err := a.app.SetBoardVisibility(r.Context(), teamID, userID, categoryID, boardID, true); err != nil {
 		a.errorResponse(w, r, err)
 		return
 	}
@@ -72,13 +72,13 @@ func (a *API) handleGetChannel(w http.ResponseWriter, r *http.Request) {
 	auditRec.AddMeta("teamID", teamID)
 	auditRec.AddMeta("channelID", teamID)
 
-	channel, err := a.app.GetChannel(teamID, channelID)
+	channel, err := a.app.GetChannel(r.Context(), teamID, channelID)
 	if err != nil {
 		a.errorResponse(w, r, err)
 		return
 	}
 
-	a.logger.Debug("GetChannel",
+	a.requestLogger(r).Debug("GetChannel",
 		mlog.String("teamID", teamID),
 		mlog.String("channelID", channelID),
 	)
@@ -0,0 +1,488 @@
+// Copyright (c) 2020-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package api
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+
+	"github.com/gorilla/mux"
+	"github.com/mattermost/mattermost-plugin-boards/server/model"
+	"github.com/mattermost/mattermost-plugin-boards/server/services/audit"
+
+	"github.com/mattermost/mattermost/server/public/shared/mlog"
+)
+
+func (a *API) registerCommentsRoutes(r *mux.Router) {
+	// Comment APIs
+	r.HandleFunc("/boards/{boardID}/comments/{commentID}", a.sessionRequired(a.handleEditComment)).Methods("PATCH")
+	r.HandleFunc("/boards/{boardID}/comments/{commentID}/history", a.sessionRequired(a.handleGetCommentHistory)).Methods("GET")
+	r.HandleFunc("/boards/{boardID}/comments/{commentID}/reactions", a.sessionRequired(a.handleGetCommentReactions)).Methods("GET")
+	r.HandleFunc("/boards/{boardID}/comments/{commentID}/reactions", a.sessionRequired(a.handleAddCommentReaction)).Methods("POST")
+	r.HandleFunc("/boards/{boardID}/comments/{commentID}/reactions/{emojiName}", a.sessionRequired(a.handleDeleteCommentReaction)).Methods("DELETE")
+	r.HandleFunc("/boards/{boardID}/reactions", a.sessionRequired(a.handleGetBoardCommentReactions)).Methods("GET")
+}
+
+func (a *API) handleEditComment(w http.ResponseWriter, r *http.Request) {
+	// swagger:operation PATCH /boards/{boardID}/comments/{commentID} editComment
+	//
+	// Edits the text of a comment. Only the author of a comment can edit it.
+	//
+	// ---
+	// produces:
+	// - application/json
+	// parameters:
+	// - name: boardID
+	//   in: path
+	//   description: Board ID
+	//   required: true
+	//   type: string
+	// - name: commentID
+	//   in: path
+	//   description: ID of comment to edit
+	//   required: true
+	//   type: string
+	// - name: Body
+	//   in: body
+	//   description: the new comment text
+	//   required: true
+	//   schema:
+	//     "$ref": "#/definitions/CommentEdit"
+	// security:
+	// - BearerAuth: []
+	// responses:
+	//   '200':
+	//     description: success
+	//     schema:
+	//       $ref: '#/definitions/Block'
+	//   '404':
+	//     description: comment not found
+	//   default:
+	//     description: internal error
+	//     schema:
+	//       "$ref": "#/definitions/ErrorResponse"
+
+	userID := getUserID(r)
+	vars := mux.Vars(r)
+	boardID := vars["boardID"]
+	commentID := vars["commentID"]
+
+	if !a.permissions.HasPermissionToBoard(userID, boardID, model.PermissionCommentBoardCards) {
+		a.errorResponse(w, r, model.NewErrPermission("access denied to comment on board cards"))
+		return
+	}
+
+	comment, err := a.app.GetComment(r.Context(), boardID, commentID)
+	if err != nil {
+		a.errorResponse(w, r, err)
+		return
+	}
+
+	if comment.CreatedBy != userID {
+		a.errorResponse(w, r, model.NewErrPermission("access denied to edit other users' comments"))
+		return
+	}
+
+	requestBody, err := io.ReadAll(r.Body)
+	if err != nil {
+		a.errorResponse(w, r, err)
+		return
+	}
+
+	var edit model.CommentEdit
+	if err = json.Unmarshal(requestBody, &edit); err != nil {
+		a.errorResponse(w, r, model.NewErrBadRequest(err.Error()))
+		return
+	}
+
+	auditRec := a.makeAuditRecord(r, "editComment", audit.Fail)
+	defer a.audit.LogRecord(audit.LevelModify, auditRec)
+	auditRec.AddMeta("boardID", boardID)
+	auditRec.AddMeta("commentID", commentID)
+
+	updated, err := a.app.EditComment(r.Context(), comment, edit.Title, userID)
+	if err != nil {
+		a.errorResponse(w, r, err)
+		return
+	}
+
+	a.requestLogger(r).Debug("EditComment", mlog.String("boardID", boardID), mlog.String("commentID", commentID))
+
+	data, err := json.Marshal(updated)
+	if err != nil {
+		a.errorResponse(w, r, err)
+		return
+	}
+
+	jsonBytesResponse(w, http.StatusOK, data)
+	auditRec.Success()
+}
+
+func (a *API) handleGetCommentHistory(w http.ResponseWriter, r *http.Request) {
+	// swagger:operation GET /boards/{boardID}/comments/{commentID}/history getCommentHistory
+	//
+	// Returns every saved version of a comment, newest first
+	//
+	// ---
+	// produces:
+	// - application/json
+	// parameters:
+	// - name: boardID
+	//   in: path
+	//   description: Board ID
+	//   required: true
+	//   type: string
+	// - name: commentID
+	//   in: path
+	//   description: Comment ID
+	//   required: true
+	//   type: string
+	// security:
+	// - BearerAuth: []
+	// responses:
+	//   '200':
+	//     description: success
+	//     schema:
+	//       type: array
+	//       items:
+	//         "$ref": "#/definitions/Block"
+	//   '404':
+	//     description: comment not found
+	//   default:
+	//     description: internal error
+	//     schema:
+	//       "$ref": "#/definitions/ErrorResponse"
+
+	userID := getUserID(r)
+	vars := mux.Vars(r)
+	boardID := vars["boardID"]
+	commentID := vars["commentID"]
+
+	if !a.permissions.HasPermissionToBoard(userID, boardID, model.PermissionViewBoard) {
+		a.errorResponse(w, r, model.NewErrPermission("access denied to board"))
+		return
+	}
+
+	if _, err := a.app.GetComment(r.Context(), boardID, commentID); err != nil {
+		a.errorResponse(w, r, err)
+		return
+	}
+
+	auditRec := a.makeAuditRecord(r, "getCommentHistory", audit.Fail)
+	defer a.audit.LogRecord(audit.LevelRead, auditRec)
+	auditRec.AddMeta("boardID", boardID)
+	auditRec.AddMeta("commentID", commentID)
+
+	versions, err := a.app.GetCommentHistory(r.Context(), commentID)
+	if err != nil {
+		a.errorResponse(w, r, err)
+		return
+	}
+
+	a.requestLogger(r).Debug("GetCommentHistory",
+		mlog.String("boardID", boardID),
+		mlog.String("commentID", commentID),
+		mlog.Int("version_count", len(versions)),
+	)
+
+	data, err := json.Marshal(versions)
+	if err != nil {
+		a.errorResponse(w, r, err)
+		return
+	}
+
+	jsonBytesResponse(w, http.StatusOK, data)
+	auditRec.Success()
+}
+
+func (a *API) handleGetCommentReactions(w http.ResponseWriter, r *http.Request) {
+	// swagger:operation GET /boards/{boardID}/comments/{commentID}/reactions getCommentReactions
+	//
+	// Returns the reactions to a comment
+	//
+	// ---
+	// produces:
+	// - application/json
+	// parameters:
+	// - name: boardID
+	//   in: path
+	//   description: Board ID
+	//   required: true
+	//   type: string
+	// - name: commentID
+	//   in: path
+	//   description: Comment ID
+	//   required: true
+	//   type: string
+	// security:
+	// - BearerAuth: []
+	// responses:
+	//   '200':
+	//     description: success
+	//     schema:
+	//       type: array
+	//       items:
+	//         "$ref": "#/definitions/CommentReaction"
+	//   '404':
+	//     description: comment not found
+	//   default:
+	//     description: internal error
+	//     schema:
+	//       "$ref": "#/definitions/ErrorResponse"
+
+	userID := getUserID(r)
+	vars := mux.Vars(r)
+	boardID := vars["boardID"]
+	commentID := vars["commentID"]
+
+	if !a.permissions.HasPermissionToBoard(userID, boardID, model.PermissionViewBoard) {
+		a.errorResponse(w, r, model.NewErrPermission("access denied to board"))
+		return
+	}
+
+	if _, err := a.app.GetComment(r.Context(), boardID, commentID); err != nil {
+		a.errorResponse(w, r, err)
+		return
+	}
+
+	reactions, err := a.app.GetCommentReactions(r.Context(), commentID)
+	if err != nil {
+		a.errorResponse(w, r, err)
+		return
+	}
+
+	data, err := json.Marshal(reactions)
+	if err != nil {
+		a.errorResponse(w, r, err)
+		return
+	}
+
+	jsonBytesResponse(w, http.StatusOK, data)
+}
+
+func (a *API) handleGetBoardCommentReactions(w http.ResponseWriter, r *http.Request) {
+	// swagger:operation GET /boards/{boardID}/reactions getBoardCommentReactions
+	//
+	// Returns the reactions to all comments of a board
+	//
+	// ---
+	// produces:
+	// - application/json
+	// parameters:
+	// - name: boardID
+	//   in: path
+	//   description: Board ID
+	//   required: true
+	//   type: string
+	// security:
+	// - BearerAuth: []
+	// responses:
+	//   '200':
+	//     description: success
+	//     schema:
+	//       type: array
+	//       items:
+	//         "$ref": "#/definitions/CommentReaction"
+	//   default:
+	//     description: internal error
+	//     schema:
+	//       "$ref": "#/definitions/ErrorResponse"
+
+	userID := getUserID(r)
+	boardID := mux.Vars(r)["boardID"]
+
+	if !a.permissions.HasPermissionToBoard(userID, boardID, model.PermissionViewBoard) {
+		a.errorResponse(w, r, model.NewErrPermission("access denied to board"))
+		return
+	}
+
+	reactions, err := a.app.GetCommentReactionsForBoard(r.Context(), boardID)
+	if err != nil {
+		a.errorResponse(w, r, err)
+		return
+	}
+
+	data, err := json.Marshal(reactions)
+	if err != nil {
+		a.errorResponse(w, r, err)
+		return
+	}
+
+	jsonBytesResponse(w, http.StatusOK, data)
+}
+
+func (a *API) handleAddCommentReaction(w http.ResponseWriter, r *http.Request) {
+	// swagger:operation POST /boards/{boardID}/comments/{commentID}/reactions addCommentReaction
+	//
+	// Adds a reaction of the current user to a comment
+	//
+	// ---
+	// produces:
+	// - application/json
+	// parameters:
+	// - name: boardID
+	//   in: path
+	//   description: Board ID
+	//   required: true
+	//   type: string
+	// - name: commentID
+	//   in: path
+	//   description: Comment ID
+	//   required: true
+	//   type: string
+	// - name: Body
+	//   in: body
+	//   description: the reaction; only emojiName is used
+	//   required: true
+	//   schema:
+	//     "$ref": "#/definitions/CommentReaction"
+	// security:
+	// - BearerAuth: []
+	// responses:
+	//   '200':
+	//     description: success
+	//     schema:
+	//       "$ref": "#/definitions/CommentReaction"
+	//   '404':
+	//     description: comment not found
+	//   default:
+	//     description: internal error
+	//     schema:
+	//       "$ref": "#/definitions/ErrorResponse"
+
+	userID := getUserID(r)
+	vars := mux.Vars(r)
+	boardID := vars["boardID"]
+	commentID := vars["commentID"]
+
+	if !a.permissions.HasPermissionToBoard(userID, boardID, model.PermissionCommentBoardCards) {
+		a.errorResponse(w, r, model.NewErrPermission("access denied to comment on board cards"))
+		return
+	}
+
+	if _, err := a.app.GetComment(r.Context(), boardID, commentID); err != nil {
+		a.errorResponse(w, r, err)
+		return
+	}
+
+	requestBody, err := io.ReadAll(r.Body)
+	if err != nil {
+		a.errorResponse(w, r, err)
+		return
+	}
+
+	var reaction model.CommentReaction
+	if err = json.Unmarshal(requestBody, &reaction); err != nil {
+		a.errorResponse(w, r, model.NewErrBadRequest(err.Error()))
+		return
+	}
+
+	reaction.BlockID = commentID
+	reaction.BoardID = boardID
+	reaction.UserID = userID
+
+	if err = reaction.IsValid(); err != nil {
+		a.errorResponse(w, r, model.NewErrBadRequest(err.Error()))
+		return
+	}
+
+	auditRec := a.makeAuditRecord(r, "addCommentReaction", audit.Fail)
+	defer a.audit.LogRecord(audit.LevelModify, auditRec)
+	auditRec.AddMeta("boardID", boardID)
+	auditRec.AddMeta("commentID", commentID)
+	auditRec.AddMeta("emojiName", reaction.EmojiName)
+
+	reactionNew, err := a.app.AddCommentReaction(r.Context(), &reaction)
+	if err != nil {
+		a.errorResponse(w, r, err)
+		return
+	}
+
+	a.requestLogger(r).Debug("AddCommentReaction",
+		mlog.String("commentID", commentID),
+		mlog.String("emojiName", reactionNew.EmojiName),
+	)
+
+	data, err := json.Marshal(reactionNew)
+	if err != nil {
+		a.errorResponse(w, r, err)
+		return
+	}
+
+	jsonBytesResponse(w, http.StatusOK, data)
+	auditRec.Success()
+}
+
+func (a *API) handleDeleteCommentReaction(w http.ResponseWriter, r *http.Request) {
+	// swagger:operation DELETE /boards/{boardID}/comments/{commentID}/reactions/{emojiName} deleteCommentReaction
+	//
+	// Removes a reaction of the current user from a comment
+	//
+	// ---
+	// produces:
+	// - application/json
+	// parameters:
+	// - name: boardID
+	//   in: path
+	//   description: Board ID
+	//   required: true
+	//   type: string
+	// - name: commentID
+	//   in: path
+	//   description: Comment ID
+	//   required: true
+	//   type: string
+	// - name: emojiName
+	//   in: path
+	//   description: Name of the emoji to remove
+	//   required: true
+	//   type: string
+	// security:
+	// - BearerAuth: []
+	// responses:
+	//   '200':
+	//     description: success
+	//   '404':
+	//     description: reaction not found
+	//   default:
+	//     description: internal error
+	//     schema:
+	//       "$ref": "#/definitions/ErrorResponse"
+
+	userID := getUserID(r)
+	vars := mux.Vars(r)
+	boardID := vars["boardID"]
+	commentID := vars["commentID"]
+	emojiName := vars["emojiName"]
+
+	if !a.permissions.HasPermissionToBoard(userID, boardID, model.PermissionCommentBoardCards) {
+		a.errorResponse(w, r, model.NewErrPermission("access denied to comment on board cards"))
+		return
+	}
+
+	if _, err := a.app.GetComment(r.Context(), boardID, commentID); err != nil {
+		a.errorResponse(w, r, err)
+		return
+	}
+
+	auditRec := a.makeAuditRecord(r, "deleteCommentReaction", audit.Fail)
+	defer a.audit.LogRecord(audit.LevelModify, auditRec)
+	auditRec.AddMeta("boardID", boardID)
+	auditRec.AddMeta("commentID", commentID)
+	auditRec.AddMeta("emojiName", emojiName)
+
+	if _, err := a.app.DeleteCommentReaction(r.Context(), boardID, commentID, userID, emojiName); err != nil {
+		a.errorResponse(w, r, err)
+		return
+	}
+
+	a.requestLogger(r).Debug("DeleteCommentReaction",
+		mlog.String("commentID", commentID),
+		mlog.String("emojiName", emojiName),
+	)
+
+	jsonStringResponse(w, http.StatusOK, "{}")
+	auditRec.Success()
+}
@@ -81,7 +81,7 @@ func (a *API) handleGetBoardsForCompliance(w http.ResponseWriter, r *http.Reques
 	}
 
 	// check for valid license feature: compliance
-	license := a.app.GetLicense()
+	license := a.app.GetLicense(r.Context())
 	if license == nil || !(*license.Features.Compliance) {
 		a.errorResponse(w, r, model.NewErrNotImplemented("insufficient license Compliance Export getAllBoards"))
 		return
@@ -89,7 +89,7 @@ func (a *API) handleGetBoardsForCompliance(w http.ResponseWriter, r *http.Reques
 
 	// check for valid team if specified
 	if teamID != "" {
-		_, err := a.app.GetTeam(teamID)
+		_, err := a.app.GetTeam(r.Context(), teamID)
 		if err != nil {
 			a.errorResponse(w, r, model.NewErrBadRequest("invalid team id: "+teamID))
 			return
@@ -121,13 +121,13 @@ func (a *API) handleGetBoardsForCompliance(w http.ResponseWriter, r *http.Reques
 		PerPage: perPage,
 	}
 
-	boards, more, err := a.app.GetBoardsForCompliance(opts)
+	boards, more, err := a.app.GetBoardsForCompliance(r.Context(), opts)
 	if err != nil {
 		a.errorResponse(w, r, err)
 		return
 	}
 
-	a.logger.Debug("GetBoardsForCompliance",
+	a.requestLogger(r).Debug("GetBoardsForCompliance",
 		mlog.String("teamID", teamID),
 		mlog.Int("boardsCount", len(boards)),
 		mlog.Bool("hasNext", more),
@@ -216,7 +216,7 @@ func (a *API) handleGetBoardsComplianceHistory(w http.ResponseWriter, r *http.Re
 	}
 
 	// check for valid license feature: compliance
-	license := a.app.GetLicense()
+	license := a.app.GetLicense(r.Context())
 	if license == nil || !(*license.Features.Compliance) {
 		a.errorResponse(w, r, model.NewErrNotImplemented("insufficient license Compliance Export getBoardsHistory"))
 		return
@@ -224,7 +224,7 @@ func (a *API) handleGetBoardsComplianceHistory(w http.ResponseWriter, r *http.Re
 
 	// check for valid team if specified
 	if teamID != "" {
-		_, err := a.app.GetTeam(teamID)
+		_, err := a.app.GetTeam(r.Context(), teamID)
 		if err != nil {
 			a.errorResponse(w, r, model.NewErrBadRequest("invalid team id: "+teamID))
 			return
@@ -264,13 +264,13 @@ func (a *API) handleGetBoardsComplianceHistory(w http.ResponseWriter, r *http.Re
 		PerPage:        perPage,
 	}
 
-	boards, more, err := a.app.GetBoardsComplianceHistory(opts)
+	boards, more, err := a.app.GetBoardsComplianceHistory(r.Context(), opts)
 	if err != nil {
 		a.errorResponse(w, r, err)
 		return
 	}
 
-	a.logger.Debug("GetBoardsComplianceHistory",
+	a.requestLogger(r).Debug("GetBoardsComplianceHistory",
 		mlog.String("teamID", teamID),
 		mlog.Int("boardsCount", len(boards)),
 		mlog.Bool("hasNext", more),
@@ -365,7 +365,7 @@ func (a *API) handleGetBlocksComplianceHistory(w http.ResponseWriter, r *http.Re
 	}
 
 	// check for valid license feature: compliance
-	license := a.app.GetLicense()
+	license := a.app.GetLicense(r.Context())
 	if license == nil || !(*license.Features.Compliance) {
 		a.errorResponse(w, r, model.NewErrNotImplemented("insufficient license Compliance Export getBlocksHistory"))
 		return
@@ -373,7 +373,7 @@ func (a *API) handleGetBlocksComplianceHistory(w http.ResponseWriter, r *http.Re
 
 	// check for valid team if specified
 	if teamID != "" {
-		_, err := a.app.GetTeam(teamID)
+		_, err := a.app.GetTeam(r.Context(), teamID)
 		if err != nil {
 			a.errorResponse(w, r, model.NewErrBadRequest("invalid team id: "+teamID))
 			return
@@ -382,7 +382,7 @@ func (a *API) handleGetBlocksComplianceHistory(w http.ResponseWriter, r *http.Re
 
 	// check for valid board if specified
 	if boardID != "" {
-		_, err := a.app.GetBoard(boardID)
+		_, err := a.app.GetBoard(r.Context(), boardID)
 		if err != nil {
 			a.errorResponse(w, r, model.NewErrBadRequest("invalid board id: "+boardID))
 			return
@@ -423,13 +423,13 @@ func (a *API) handleGetBlocksComplianceHistory(w http.ResponseWriter, r *http.Re
 		PerPage:        perPage,
 	}
 
-	blocks, more, err := a.app.GetBlocksComplianceHistory(opts)
+	blocks, more, err := a.app.GetBlocksComplianceHistory(r.Context(), opts)
 	if err != nil {
 		a.errorResponse(w, r, err)
 		return
 	}
 
-	a.logger.Debug("GetBlocksComplianceHistory",
+	a.requestLogger(r).Debug("GetBlocksComplianceHistory",
 		mlog.String("teamID", teamID),
 		mlog.String("boardID", boardID),
 		mlog.Int("blocksCount", len(blocks)),
@@ -61,13 +61,13 @@ func (a *API) handleMoveBlockTo(w http.ResponseWriter, r *http.Request) {
 	where := mux.Vars(r)["where"]
 	userID := getUserID(r)
 
-	block, err := a.app.GetBlockByID(blockID)
+	block, err := a.app.GetBlockByID(r.Context(), blockID)
 	if err != nil {
 		a.errorResponse(w, r, err)
 		return
 	}
 
-	dstBlock, err := a.app.GetBlockByID(dstBlockID)
+	dstBlock, err := a.app.GetBlockByID(r.Context(), dstBlockID)
 	if err != nil {
 		a.errorResponse(w, r, err)
 		return
@@ -93,7 +93,7 @@ func (a *API) handleMoveBlockTo(w http.ResponseWriter, r *http.Request) {
 	auditRec.AddMeta("blockID", blockID)
 	auditRec.AddMeta("dstBlockID", dstBlockID)
 
-	err = a.app.MoveContentBlock(block, dstBlock, where, userID)
+	err = a.app.MoveContentBlock(r.Context(), block, dstBlock, where, userID)
 	if err != nil {
 		a.errorResponse(w, r, err)
 		return
@@ -65,8 +65,18 @@ func FileUploadResponseFromJSON(data io.Reader) (*FileUploadResponse, error) {
 	return &fileUploadResponse, nil
 }
 
-func FileInfoResponseFromJSON(data io.Reader) (*mmModel.FileInfo, error) {
-	var fileInfo mmModel.FileInfo
+// FileInfoResponse is the metadata of an uploaded file
+// swagger:model
+type FileInfoResponse struct {
+	*mmModel.FileInfo
+
+	// Malware scan status of the file, empty if it was not scanned
+	// required: false
+	ScanStatus model.FileScanStatus `json:"scanStatus,omitempty"`
+}
+
+func FileInfoResponseFromJSON(data io.Reader) (*FileInfoResponse, error) {
+	fileInfo := FileInfoResponse{FileInfo: &mmModel.FileInfo{}}
 
 	if err := json.NewDecoder(data).Decode(&fileInfo); err != nil {
 		return nil, err
@@ -78,7 +88,9 @@ func (a *API) registerFilesRoutes(r *mux.Router) {
 	// Files API
 	r.HandleFunc("/files/teams/{teamID}/{boardID}/{filename}", a.attachSession(a.handleServeFile)).Methods("GET")
 	r.HandleFunc("/files/teams/{teamID}/{boardID}/{filename}/info", a.attachSession(a.getFileInfo)).Methods("GET")
+	r.HandleFunc("/files/teams/{teamID}/{boardID}/{filename}/signed-url", a.sessionRequired(a.handleGetSignedFileURL)).Methods("GET")
 	r.HandleFunc("/teams/{teamID}/{boardID}/files", a.sessionRequired(a.handleUploadFile)).Methods("POST")
+	r.HandleFunc("/files/orphans", a.sessionRequired(a.handleGetOrphanedFiles)).Methods("GET")
 }
 
 func (a *API) handleServeFile(w http.ResponseWriter, r *http.Request) {
@@ -108,11 +120,29 @@ func (a *API) handleServeFile(w http.ResponseWriter, r *http.Request) {
 	//   description: name of the file
 	//   required: true
 	//   type: string
+	// - name: size
+	//   in: query
+	//   description: serve the thumbnail or preview of an image instead of the original, falling back to the original if the derivative does not exist
+	//   required: false
+	//   type: string
+	//   enum: [thumb, preview]
+	// - name: expires
+	//   in: query
+	//   description: expiry of a signed URL, as returned by getSignedFileURL
+	//   required: false
+	//   type: integer
+	// - name: signature
+	//   in: query
+	//   description: signature of a signed URL, as returned by getSignedFileURL. Grants access without a session or read token
+	//   required: false
+	//   type: string
 	// security:
 	// - BearerAuth: []
 	// responses:
 	//   '200':
 	//     description: success
+	//   '403':
+	//     description: file is quarantined by the malware scanner
 	//   '404':
 	//     description: file not found
 	//   default:
@@ -121,22 +151,30 @@ func (a *API) handleServeFile(w http.ResponseWriter, r *http.Request) {
 	//       "$ref": "#/definitions/ErrorResponse"
 
 	vars := mux.Vars(r)
+	teamID := vars["teamID"]
 	boardID := vars["boardID"]
 	filename := vars["filename"]
 	userID := getUserID(r)
 
-	hasValidReadToken := a.hasValidReadTokenForBoard(r, boardID)
-	if userID == "" && !hasValidReadToken {
+	size := model.FileSize(r.URL.Query().Get("size"))
+	if !size.IsValid() {
+		a.errorResponse(w, r, model.NewErrBadRequest("invalid file size "+string(size)))
+		return
+	}
+
+	hasValidSignature := a.hasValidFileSignature(r, teamID, boardID, filename)
+	hasValidToken := hasValidSignature || a.hasValidReadTokenForFile(r, boardID, filename)
+	if userID == "" && !hasValidToken {
 		a.errorResponse(w, r, model.NewErrUnauthorized("access denied to board"))
 		return
 	}
 
-	if !hasValidReadToken && !a.permissions.HasPermissionToBoard(userID, boardID, model.PermissionViewBoard) {
+	if !hasValidToken && !a.permissions.HasPermissionToBoard(userID, boardID, model.PermissionViewBoard) {
 		a.errorResponse(w, r, model.NewErrPermission("access denied to board"))
 		return
 	}
 
-	board, err := a.app.GetBoard(boardID)
+	board, err := a.app.GetBoard(r.Context(), boardID)
 	if err != nil {
 		a.errorResponse(w, r, err)
 		return
@@ -147,8 +185,20 @@ func (a *API) handleServeFile(w http.ResponseWriter, r *http.Request) {
 	auditRec.AddMeta("boardID", boardID)
 	auditRec.AddMeta("teamID", board.TeamID)
 	auditRec.AddMeta("filename", filename)
+	auditRec.AddMeta("signed", hasValidSignature)
+
+	scan, err := a.app.GetFileScan(r.Context(), filename)
+	if err != nil {
+		a.errorResponse(w, r, err)
+		return
+	}
+	if scan.IsQuarantined() {
+		auditRec.AddMeta("scanStatus", scan.Status)
+		a.errorResponse(w, r, model.NewErrForbidden("file is quarantined"))
+		return
+	}
 
-	fileInfo, fileReader, err := a.app.GetFile(board.TeamID, boardID, filename)
+	fileInfo, fileReader, err := a.app.GetFile(r.Context(), board.TeamID, boardID, filename)
 	if err != nil && !model.IsErrNotFound(err) {
 		a.errorResponse(w, r, err)
 		return
@@ -159,7 +209,7 @@ func (a *API) handleServeFile(w http.ResponseWriter, r *http.Request) {
 		// workspaceID, which is the channel ID in plugin mode.
 		// If a file is not found from team ID as we tried above, try looking for it via
 		// channel ID.
-		fileReader, err = a.app.GetFileReader(board.ChannelID, boardID, filename)
+		fileReader, err = a.app.GetFileReader(r.Context(), board.ChannelID, boardID, filename)
 		if err != nil {
 			a.errorResponse(w, r, err)
 			return
@@ -183,10 +233,130 @@ func (a *API) handleServeFile(w http.ResponseWriter, r *http.Request) {
 		mimeType = fileInfo.MimeType
 		fileSize = fileInfo.Size
 	}
+
+	if size != model.FileSizeOriginal {
+		auditRec.AddMeta("size", size)
+		derivativeReader, err := a.app.GetFileDerivative(r.Context(), board.TeamID, boardID, filename, size)
+		if err != nil {
+			a.errorResponse(w, r, err)
+			return
+		}
+		if derivativeReader != nil {
+			defer derivativeReader.Close()
+			fileReader = derivativeReader
+			mimeType = "image/jpeg"
+			fileSize = 0
+		}
+	}
+
 	writeFileResponse(filename, mimeType, fileSize, time.Now(), "", fileReader, false, w, r)
 	auditRec.Success()
 }
 
+// hasValidFileSignature returns true if the request carries a valid, unexpired signature for
+// the file, as minted by handleGetSignedFileURL.
+func (a *API) hasValidFileSignature(r *http.Request, teamID, boardID, filename string) bool {
+	query := r.URL.Query()
+	signature := query.Get(model.SignedFileURLSignatureParam)
+	if signature == "" {
+		return false
+	}
+
+	expiresAt, err := strconv.ParseInt(query.Get(model.SignedFileURLExpiresParam), 10, 64)
+	if err != nil {
+		return false
+	}
+	return a.app.IsValidFileSignature(r.Context(), teamID, boardID, filename, expiresAt, signature)
+}
+
+func (a *API) handleGetSignedFileURL(w http.ResponseWriter, r *http.Request) {
+	// swagger:operation GET /files/teams/{teamID}/{boardID}/{filename}/signed-url getSignedFileURL
+	//
+	// Returns a URL granting read access to a single file until it expires, for embedding in
+	// notifications, emails and exports
+	//
+	// ---
+	// produces:
+	// - application/json
+	// parameters:
+	// - name: teamID
+	//   in: path
+	//   description: Team ID
+	//   required: true
+	//   type: string
+	// - name: boardID
+	//   in: path
+	//   description: Board ID
+	//   required: true
+	//   type: string
+	// - name: filename
+	//   in: path
+	//   description: name of the file
+	//   required: true
+	//   type: string
+	// - name: expires_in
+	//   in: query
+	//   description: minutes the URL is valid for, defaults to 15 and is at most 10080 (7 days)
+	//   required: false
+	//   type: integer
+	// security:
+	// - BearerAuth: []
+	// responses:
+	//   '200':
+	//     description: success
+	//     schema:
+	//       "$ref": "#/definitions/SignedFileURL"
+	//   '404':
+	//     description: file not found
+	//   default:
+	//     description: internal error
+	//     schema:
+	//       "$ref": "#/definitions/ErrorResponse"
+
+	vars := mux.Vars(r)
+	teamID := vars["teamID"]
+	boardID := vars["boardID"]
+	filename := vars["filename"]
+	userID := getUserID(r)
+
+	var expiresIn time.Duration
+	if expiresInStr := r.URL.Query().Get("expires_in"); expiresInStr != "" {
+		minutes, err := strconv.Atoi(expiresInStr)
+		if err != nil || minutes <= 0 {
+			a.errorResponse(w, r, model.NewErrBadRequest("invalid expires_in"))
+			return
+		}
+		expiresIn = time.Duration(minutes) * time.Minute
+	}
+
+	if !a.permissions.HasPermissionToBoard(userID, boardID, model.PermissionViewBoard) {
+		a.errorResponse(w, r, model.NewErrPermission("access denied to board"))
+		return
+	}
+
+	auditRec := a.makeAuditRecord(r, "getSignedFileURL", audit.Fail)
+	defer a.audit.LogRecord(audit.LevelRead, auditRec)
+	auditRec.AddMeta("boardID", boardID)
+	auditRec.AddMeta("teamID", teamID)
+	auditRec.AddMeta("filename", filename)
+
+	signedURL, err := a.app.SignFileURL(r.Context(), teamID, boardID, filename, expiresIn)
+	if err != nil {
+		a.errorResponse(w, r, err)
+		return
+	}
+
+	data, err := json.Marshal(signedURL)
+	if err != nil {
+		a.errorResponse(w, r, err)
+		return
+	}
+
+	jsonBytesResponse(w, http.StatusOK, data)
+	auditRec.AddMeta("expiresAt", signedURL.ExpiresAt)
+	auditRec.Success()
+}
+
 func writeFileResponse(filename string, contentType string, contentSize int64,
 	lastModification time.Time, webserverMode string, fileReader io.ReadSeeker, forceDownload bool, w http.ResponseWriter, r *http.Request) {
 	w.Header().Set("Cache-Control", "private, no-cache")
@@ -274,6 +444,8 @@ func (a *API) getFileInfo(w http.ResponseWriter, r *http.Request) {
 	// responses:
 	//   '200':
 	//     description: success
+	//     schema:
+	//       "$ref": "#/definitions/FileInfoResponse"
 	//   '404':
 	//     description: file not found
 	//   default:
@@ -287,7 +459,7 @@ func (a *API) getFileInfo(w http.ResponseWriter, r *http.Request) {
 	filename := vars["filename"]
 	userID := getUserID(r)
 
-	hasValidReadToken := a.hasValidReadTokenForBoard(r, boardID)
+	hasValidReadToken := a.hasValidReadTokenForFile(r, boardID, filename)
 	if userID == "" && !hasValidReadToken {
 		a.errorResponse(w, r, model.NewErrUnauthorized("access denied to board"))
 		return
@@ -305,18 +477,32 @@ func (a *API) getFileInfo(w http.ResponseWriter, r *http.Request) {
 	auditRec.AddMeta("filename", filename)
 
 	// Validate that the file belongs to the specified board and team
-	if err := a.app.ValidateFileOwnership(teamID, boardID, filename); err != nil {
+	if err := a.app.ValidateFileOwnership(r.Context(), teamID, boardID, filename); err != nil {
 		a.errorResponse(w, r, model.NewErrPermission(fmt.Sprintf("access denied to file, error: %s", err)))
 		return
 	}
 
-	fileInfo, err := a.app.GetFileInfo(filename)
+	fileInfo, err := a.app.GetFileInfo(r.Context(), filename)
 	if err != nil && !model.IsErrNotFound(err) {
 		a.errorResponse(w, r, err)
 		return
 	}
 
-	data, err := json.Marshal(fileInfo)
+	scan, err := a.app.GetFileScan(r.Context(), filename)
+	if err != nil {
+		a.errorResponse(w, r, err)
+		return
+	}
+
+	var response *FileInfoResponse
+	if fileInfo != nil || scan != nil {
+		response = &FileInfoResponse{FileInfo: fileInfo}
+		if scan != nil {
+			response.ScanStatus = scan.Status
+		}
+	}
+
+	data, err := json.Marshal(response)
 	if err != nil {
 		a.errorResponse(w, r, err)
 		return
@@ -358,6 +544,8 @@ func (a *API) handleUploadFile(w http.ResponseWriter, r *http.Request) {
 	//     description: success
 	//     schema:
 	//       "$ref": "#/definitions/FileUploadResponse"
+	//   '400':
+	//     description: malware was found in the file
 	//   '404':
 	//     description: board not found
 	//   default:
@@ -374,7 +562,7 @@ func (a *API) handleUploadFile(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	board, err := a.app.GetBoard(boardID)
+	board, err := a.app.GetBoard(r.Context(), boardID)
 	if err != nil {
 		a.errorResponse(w, r, err)
 		return
@@ -401,13 +589,28 @@ func (a *API) handleUploadFile(w http.ResponseWriter, r *http.Request) {
 	auditRec.AddMeta("teamID", board.TeamID)
 	auditRec.AddMeta("filename", handle.Filename)
 
-	fileID, err := a.app.SaveFile(file, board.TeamID, boardID, handle.Filename, board.IsTemplate)
+	fileID, err := a.app.SaveFile(r.Context(), file, board.TeamID, boardID, handle.Filename, board.IsTemplate)
+	var infected *model.ErrFileInfected
+	if errors.As(err, &infected) {
+		auditRec.AddMeta("scanStatus", model.FileScanInfected)
+		auditRec.AddMeta("scanSignature", infected.Signature)
+	}
 	if err != nil {
 		a.errorResponse(w, r, err)
 		return
 	}
 
-	a.logger.Debug("uploadFile",
+	scan, err := a.app.GetFileScan(r.Context(), fileID)
+	if err != nil {
+		a.errorResponse(w, r, err)
+		return
+	}
+	if scan != nil {
+		auditRec.AddMeta("scanStatus", scan.Status)
+		auditRec.AddMeta("scanner", scan.Scanner)
+	}
+
+	a.requestLogger(r).Debug("uploadFile",
 		mlog.String("filename", handle.Filename),
 		mlog.String("fileID", fileID),
 	)
@@ -422,3 +625,67 @@ func (a *API) handleUploadFile(w http.ResponseWriter, r *http.Request) {
 	auditRec.AddMeta("fileID", fileID)
 	auditRec.Success()
 }
+
+func (a *API) handleGetOrphanedFiles(w http.ResponseWriter, r *http.Request) {
+	// swagger:operation GET /files/orphans getOrphanedFiles
+	//
+	// Reports the stored files that no block references and would be removed by the next garbage
+	// collection, without removing them. Only available to system administrators
+	//
+	// ---
+	// produces:
+	// - application/json
+	// parameters:
+	// - name: grace_period_hours
+	//   in: query
+	//   description: files modified more recently are not reported, defaults to the configured grace period
+	//   required: false
+	//   type: integer
+	// security:
+	// - BearerAuth: []
+	// responses:
+	//   '200':
+	//     description: success
+	//     schema:
+	//       "$ref": "#/definitions/FileGCReport"
+	//   default:
+	//     description: internal error
+	//     schema:
+	//       "$ref": "#/definitions/ErrorResponse"
+
+	userID := getUserID(r)
+	if !a.permissions.HasPermissionTo(userID, mmModel.PermissionManageSystem) {
+		a.errorResponse(w, r, model.NewErrPermission("access denied to orphaned files"))
+		return
+	}
+
+	gracePeriodHours := a.app.GetConfig().FileGCGracePeriodHours
+	if gracePeriodStr := r.URL.Query().Get("grace_period_hours"); gracePeriodStr != "" {
+		hours, err := strconv.Atoi(gracePeriodStr)
+		if err != nil || hours < 0 {
+			a.errorResponse(w, r, model.NewErrBadRequest("invalid grace_period_hours"))
+			return
+		}
+		gracePeriodHours = hours
+	}
+
+	auditRec := a.makeAuditRecord(r, "getOrphanedFiles", audit.Fail)
+	defer a.audit.LogRecord(audit.LevelRead, auditRec)
+	auditRec.AddMeta("gracePeriodHours", gracePeriodHours)
+
+	report, err := a.app.CollectOrphanedFiles(r.Context(), time.Duration(gracePeriodHours)*time.Hour, true)
+	if err != nil {
+		a.errorResponse(w, r, err)
+		return
+	}
+
+	data, err := json.Marshal(report)
+	if err != nil {
+		a.errorResponse(w, r, err)
+		return
+	}
+
+	jsonBytesResponse(w, http.StatusOK, data)
+	auditRec.AddMeta("orphanedFiles", len(report.OrphanedFiles))
+	auditRec.Success()
+}
@@ -0,0 +1,224 @@
+// Copyright (c) 2020-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package api
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/gorilla/mux"
+	"github.com/mattermost/mattermost-plugin-boards/server/model"
+
+	"github.com/mattermost/mattermost/server/public/shared/mlog"
+)
+
+func (a *API) registerFlowMetricsRoutes(r *mux.Router) {
+	// Flow metrics APIs
+	r.HandleFunc("/boards/{boardID}/metrics/flow", a.sessionRequired(a.handleGetFlowMetrics)).Methods("GET")
+	r.HandleFunc("/boards/{boardID}/metrics/cumulative-flow", a.sessionRequired(a.handleGetCumulativeFlow)).Methods("GET")
+}
+
+func (a *API) handleGetFlowMetrics(w http.ResponseWriter, r *http.Request) {
+	// swagger:operation GET /boards/{boardID}/metrics/flow getFlowMetrics
+	//
+	// Returns the time in status, cycle and lead times and weekly throughput of the cards of a
+	// board over a date range, computed from the changes of a status property.
+	//
+	// ---
+	// produces:
+	// - application/json
+	// parameters:
+	// - name: boardID
+	//   in: path
+	//   description: Board ID
+	//   required: true
+	//   type: string
+	// - name: property_id
+	//   in: query
+	//   description: ID of the select property holding the status of the cards
+	//   required: true
+	//   type: string
+	// - name: from
+	//   in: query
+	//   description: Start of the date range, in milliseconds since the current epoch. Defaults to 90 days before `to`
+	//   required: false
+	//   type: integer
+	// - name: to
+	//   in: query
+	//   description: End of the date range, in milliseconds since the current epoch. Defaults to now
+	//   required: false
+	//   type: integer
+	// - name: started
+	//   in: query
+	//   description: Comma separated options of the property a card is started in. Defaults to all but the first and the done ones
+	//   required: false
+	//   type: string
+	// - name: done
+	//   in: query
+	//   description: Comma separated options of the property a card is done in. Defaults to the last one
+	//   required: false
+	//   type: string
+	// security:
+	// - BearerAuth: []
+	// responses:
+	//   '200':
+	//     description: success
+	//     schema:
+	//       "$ref": "#/definitions/FlowMetrics"
+	//   '404':
+	//     description: board not found
+	//   default:
+	//     description: internal error
+	//     schema:
+	//       "$ref": "#/definitions/ErrorResponse"
+
+	boardID := mux.Vars(r)["boardID"]
+	userID := getUserID(r)
+
+	if !a.permissions.HasPermissionToBoard(userID, boardID, model.PermissionViewBoard) {
+		a.errorResponse(w, r, model.NewErrPermission("access denied to board"))
+		return
+	}
+
+	opts, err := flowMetricsOptionsFromQuery(r.URL.Query())
+	if err != nil {
+		a.errorResponse(w, r, err)
+		return
+	}
+
+	metrics, err := a.app.GetFlowMetrics(r.Context(), boardID, opts)
+	if err != nil {
+		a.errorResponse(w, r, err)
+		return
+	}
+
+	data, err := json.Marshal(metrics)
+	if err != nil {
+		a.errorResponse(w, r, err)
+		return
+	}
+
+	a.requestLogger(r).Debug("GetFlowMetrics",
+		mlog.String("boardID", boardID),
+		mlog.String("propertyID", opts.PropertyID),
+		mlog.Int("cards", len(metrics.Cards)),
+	)
+	jsonBytesResponse(w, http.StatusOK, data)
+}
+
+func (a *API) handleGetCumulativeFlow(w http.ResponseWriter, r *http.Request) {
+	// swagger:operation GET /boards/{boardID}/metrics/cumulative-flow getCumulativeFlow
+	//
+	// Returns the number of cards of a board in each status of a status property at the end of
+	// each day of a date range.
+	//
+	// ---
+	// produces:
+	// - application/json
+	// parameters:
+	// - name: boardID
+	//   in: path
+	//   description: Board ID
+	//   required: true
+	//   type: string
+	// - name: property_id
+	//   in: query
+	//   description: ID of the select property holding the status of the cards
+	//   required: true
+	//   type: string
+	// - name: from
+	//   in: query
+	//   description: Start of the date range, in milliseconds since the current epoch. Defaults to 90 days before `to`
+	//   required: false
+	//   type: integer
+	// - name: to
+	//   in: query
+	//   description: End of the date range, in milliseconds since the current epoch. Defaults to now
+	//   required: false
+	//   type: integer
+	// security:
+	// - BearerAuth: []
+	// responses:
+	//   '200':
+	//     description: success
+	//     schema:
+	//       "$ref": "#/definitions/CumulativeFlow"
+	//   '404':
+	//     description: board not found
+	//   default:
+	//     description: internal error
+	//     schema:
+	//       "$ref": "#/definitions/ErrorResponse"
+
+	boardID := mux.Vars(r)["boardID"]
+	userID := getUserID(r)
+
+	if !a.permissions.HasPermissionToBoard(userID, boardID, model.PermissionViewBoard) {
+		a.errorResponse(w, r, model.NewErrPermission("access denied to board"))
+		return
+	}
+
+	opts, err := flowMetricsOptionsFromQuery(r.URL.Query())
+	if err != nil {
+		a.errorResponse(w, r, err)
+		return
+	}
+
+	flow, err := a.app.GetCumulativeFlow(r.Context(), boardID, opts)
+	if err != nil {
+		a.errorResponse(w, r, err)
+		return
+	}
+
+	data, err := json.Marshal(flow)
+	if err != nil {
+		a.errorResponse(w, r, err)
+		return
+	}
+
+	a.requestLogger(r).Debug("GetCumulativeFlow",
+		mlog.String("boardID", boardID),
+		mlog.String("propertyID", opts.PropertyID),
+		mlog.Int("points", len(flow.Points)),
+	)
+	jsonBytesResponse(w, http.StatusOK, data)
+}
+
+func flowMetricsOptionsFromQuery(query url.Values) (model.FlowMetricsOptions, error) {
+	opts := model.FlowMetricsOptions{
+		PropertyID:      query.Get("property_id"),
+		StartedStatuses: splitQueryList(query.Get("started")),
+		DoneStatuses:    splitQueryList(query.Get("done")),
+	}
+	if opts.PropertyID == "" {
+		return opts, model.NewErrBadRequest("missing `property_id` parameter")
+	}
+
+	var err error
+	if strFrom := query.Get("from"); strFrom != "" {
+		if opts.From, err = strconv.ParseInt(strFrom, 10, 64); err != nil {
+			return opts, model.NewErrBadRequest(fmt.Sprintf("invalid `from` parameter: %s", err))
+		}
+	}
+	if strTo := query.Get("to"); strTo != "" {
+		if opts.To, err = strconv.ParseInt(strTo, 10, 64); err != nil {
+			return opts, model.NewErrBadRequest(fmt.Sprintf("invalid `to` parameter: %s", err))
+		}
+	}
+	return opts, nil
+}
+
+func splitQueryList(value string) []string {
+	var items []string
+	for _, item := range strings.Split(value, ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			items = append(items, item)
+		}
+	}
+	return items
+}
@@ -0,0 +1,302 @@
+// Copyright (c) 2020-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package api
+
+import (
+	"encoding/json"
+	"io"
+	"net"
+	"net/http"
+
+	"github.com/gorilla/mux"
+	"github.com/mattermost/mattermost-plugin-boards/server/model"
+	"github.com/mattermost/mattermost-plugin-boards/server/services/audit"
+
+	"github.com/mattermost/mattermost/server/public/shared/mlog"
+)
+
+func (a *API) registerFormsRoutes(r *mux.Router) {
+	// Form APIs
+	r.HandleFunc("/boards/{boardID}/form", a.sessionRequired(a.handleGetBoardForm)).Methods("GET")
+	r.HandleFunc("/boards/{boardID}/form", a.sessionRequired(a.handlePostBoardForm)).Methods("POST")
+
+	// Public form APIs, authenticated by the form token
+	r.HandleFunc("/boards/{boardID}/form/public", a.attachSession(a.handleGetPublicForm)).Methods("GET")
+	r.HandleFunc("/boards/{boardID}/form/submit", a.attachSession(a.handleSubmitForm)).Methods("POST")
+}
+
+func (a *API) handleGetBoardForm(w http.ResponseWriter, r *http.Request) {
+	// swagger:operation GET /boards/{boardID}/form getBoardForm
+	//
+	// Returns the form definition of a board
+	//
+	// ---
+	// produces:
+	// - application/json
+	// parameters:
+	// - name: boardID
+	//   in: path
+	//   description: Board ID
+	//   required: true
+	//   type: string
+	// security:
+	// - BearerAuth: []
+	// responses:
+	//   '200':
+	//     description: success
+	//     schema:
+	//       "$ref": "#/definitions/BoardForm"
+	//   '404':
+	//     description: the board has no form
+	//   default:
+	//     description: internal error
+	//     schema:
+	//       "$ref": "#/definitions/ErrorResponse"
+
+	boardID := mux.Vars(r)["boardID"]
+
+	userID := getUserID(r)
+	if !a.permissions.HasPermissionToBoard(userID, boardID, model.PermissionManageBoardProperties) {
+		a.errorResponse(w, r, model.NewErrPermission("access denied to board form"))
+		return
+	}
+
+	auditRec := a.makeAuditRecord(r, "getBoardForm", audit.Fail)
+	defer a.audit.LogRecord(audit.LevelRead, auditRec)
+	auditRec.AddMeta("boardID", boardID)
+
+	form, err := a.app.GetBoardForm(r.Context(), boardID)
+	if err != nil {
+		a.errorResponse(w, r, err)
+		return
+	}
+
+	data, err := json.Marshal(form)
+	if err != nil {
+		a.errorResponse(w, r, err)
+		return
+	}
+
+	jsonBytesResponse(w, http.StatusOK, data)
+	auditRec.Success()
+}
+
+func (a *API) handlePostBoardForm(w http.ResponseWriter, r *http.Request) {
+	// swagger:operation POST /boards/{boardID}/form postBoardForm
+	//
+	// Creates or replaces the form definition of a board
+	//
+	// ---
+	// produces:
+	// - application/json
+	// parameters:
+	// - name: boardID
+	//   in: path
+	//   description: Board ID
+	//   required: true
+	//   type: string
+	// - name: regenerate_token
+	//   in: query
+	//   description: Replace the form token, invalidating the previous form link
+	//   required: false
+	//   type: boolean
+	// - name: Body
+	//   in: body
+	//   description: form definition
+	//   required: true
+	//   schema:
+	//     "$ref": "#/definitions/BoardForm"
+	// security:
+	// - BearerAuth: []
+	// responses:
+	//   '200':
+	//     description: success
+	//     schema:
+	//       "$ref": "#/definitions/BoardForm"
+	//   default:
+	//     description: internal error
+	//     schema:
+	//       "$ref": "#/definitions/ErrorResponse"
+
+	boardID := mux.Vars(r)["boardID"]
+	regenerateToken := r.URL.Query().Get("regenerate_token") == True
+
+	userID := getUserID(r)
+	if !a.permissions.HasPermissionToBoard(userID, boardID, model.PermissionManageBoardProperties) {
+		a.errorResponse(w, r, model.NewErrPermission("access denied to board form"))
+		return
+	}
+
+	if !a.app.GetClientConfig().EnablePublicSharedBoards {
+		a.errorResponse(w, r, ErrTurningOnSharing)
+		return
+	}
+
+	requestBody, err := io.ReadAll(r.Body)
+	if err != nil {
+		a.errorResponse(w, r, err)
+		return
+	}
+
+	var form model.BoardForm
+	if err = json.Unmarshal(requestBody, &form); err != nil {
+		a.errorResponse(w, r, model.NewErrBadRequest(err.Error()))
+		return
+	}
+
+	auditRec := a.makeAuditRecord(r, "postBoardForm", audit.Fail)
+	defer a.audit.LogRecord(audit.LevelModify, auditRec)
+	auditRec.AddMeta("boardID", boardID)
+	auditRec.AddMeta("enabled", form.Enabled)
+	auditRec.AddMeta("regenerateToken", regenerateToken)
+
+	saved, err := a.app.SaveBoardForm(r.Context(), boardID, &form, regenerateToken, userID)
+	if err != nil {
+		a.errorResponse(w, r, err)
+		return
+	}
+
+	data, err := json.Marshal(saved)
+	if err != nil {
+		a.errorResponse(w, r, err)
+		return
+	}
+
+	jsonBytesResponse(w, http.StatusOK, data)
+
+	a.requestLogger(r).Debug("POST board form",
+		mlog.String("boardID", boardID),
+		mlog.Bool("enabled", saved.Enabled),
+	)
+	auditRec.Success()
+}
+
+func (a *API) handleGetPublicForm(w http.ResponseWriter, r *http.Request) {
+	// swagger:operation GET /boards/{boardID}/form/public getPublicForm
+	//
+	// Returns the public form of a board. No session is required
+	//
+	// ---
+	// produces:
+	// - application/json
+	// parameters:
+	// - name: boardID
+	//   in: path
+	//   description: Board ID
+	//   required: true
+	//   type: string
+	// - name: form_token
+	//   in: query
+	//   description: Form token
+	//   required: true
+	//   type: string
+	// responses:
+	//   '200':
+	//     description: success
+	//     schema:
+	//       "$ref": "#/definitions/PublicForm"
+	//   '404':
+	//     description: form not found
+	//   default:
+	//     description: internal error
+	//     schema:
+	//       "$ref": "#/definitions/ErrorResponse"
+
+	boardID := mux.Vars(r)["boardID"]
+	token := r.URL.Query().Get("form_token")
+
+	form, err := a.app.GetPublicForm(r.Context(), boardID, token)
+	if err != nil {
+		a.errorResponse(w, r, err)
+		return
+	}
+
+	data, err := json.Marshal(form)
+	if err != nil {
+		a.errorResponse(w, r, err)
+		return
+	}
+
+	jsonBytesResponse(w, http.StatusOK, data)
+}
+
+func (a *API) handleSubmitForm(w http.ResponseWriter, r *http.Request) {
+	// swagger:operation POST /boards/{boardID}/form/submit submitForm
+	//
+	// Creates a card from a submission to the public form of a board. No session is required
+	//
+	// ---
+	// produces:
+	// - application/json
+	// parameters:
+	// - name: boardID
+	//   in: path
+	//   description: Board ID
+	//   required: true
+	//   type: string
+	// - name: form_token
+	//   in: query
+	//   description: Form token
+	//   required: true
+	//   type: string
+	// - name: Body
+	//   in: body
+	//   description: the form submission
+	//   required: true
+	//   schema:
+	//     "$ref": "#/definitions/FormSubmission"
+	// responses:
+	//   '200':
+	//     description: success
+	//   '404':
+	//     description: form not found
+	//   '429':
+	//     description: too many submissions
+	//   default:
+	//     description: internal error
+	//     schema:
+	//       "$ref": "#/definitions/ErrorResponse"
+
+	boardID := mux.Vars(r)["boardID"]
+	token := r.URL.Query().Get("form_token")
+
+	requestBody, err := io.ReadAll(io.LimitReader(r.Body, model.FormValueMaxLength*model.FormMaxFields))
+	if err != nil {
+		a.errorResponse(w, r, err)
+		return
+	}
+
+	var submission model.FormSubmission
+	if err = json.Unmarshal(requestBody, &submission); err != nil {
+		a.errorResponse(w, r, model.NewErrBadRequest(err.Error()))
+		return
+	}
+
+	auditRec := a.makeAuditRecord(r, "submitForm", audit.Fail)
+	defer a.audit.LogRecord(audit.LevelModify, auditRec)
+	auditRec.AddMeta("boardID", boardID)
+
+	card, err := a.app.SubmitForm(r.Context(), boardID, token, &submission, clientAddress(r))
+	if err != nil {
+		a.errorResponse(w, r, err)
+		return
+	}
+
+	// submissions caught by the honeypot get the same response as accepted ones.
+	jsonStringResponse(w, http.StatusOK, "{}")
+
+	if card != nil {
+		auditRec.AddMeta("cardID", card.ID)
+	}
+	auditRec.Success()
+}
+
+// clientAddress returns the address of the client making the request, without the port.
+func clientAddress(r *http.Request) string {
+	host, _, err := net.SplitHostPort(r.RemoteAddr)
+	if err != nil {
+		return r.RemoteAddr
+	}
+	return host
+}
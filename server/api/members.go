@@ -64,13 +64,13 @@ func (a *API) handleGetMembersForBoard(w http.ResponseWriter, r *http.Request) {
 	defer a.audit.LogRecord(audit.LevelModify, auditRec)
 	auditRec.AddMeta("boardID", boardID)
 
-	members, err := a.app.GetMembersForBoard(boardID)
+	members, err := a.app.GetMembersForBoard(r.Context(), boardID)
 	if err != nil {
 		a.errorResponse(w, r, err)
 		return
 	}
 
-	a.logger.Debug("GetMembersForBoard",
+	a.requestLogger(r).Debug("GetMembersForBoard",
 		mlog.String("boardID", boardID),
 		mlog.Int("membersCount", len(members)),
 	)
@@ -124,7 +124,7 @@ func (a *API) handleAddMember(w http.ResponseWriter, r *http.Request) {
 	boardID := mux.Vars(r)["boardID"]
 	userID := getUserID(r)
 
-	board, err := a.app.GetBoard(boardID)
+	board, err := a.app.GetBoard(r.Context(), boardID)
 	if err != nil {
 		a.errorResponse(w, r, err)
 		return
@@ -172,13 +172,13 @@ func (a *API) handleAddMember(w http.ResponseWriter, r *http.Request) {
 	auditRec.AddMeta("boardID", boardID)
 	auditRec.AddMeta("addedUserID", reqBoardMember.UserID)
 
-	member, err := a.app.AddMemberToBoard(newBoardMember)
+	member, err := a.app.AddMemberToBoard(r.Context(), newBoardMember)
 	if err != nil {
 		a.errorResponse(w, r, err)
 		return
 	}
 
-	a.logger.Debug("AddMember",
+	a.requestLogger(r).Debug("AddMember",
 		mlog.String("boardID", board.ID),
 		mlog.String("addedUserID", reqBoardMember.UserID),
 	)
@@ -240,7 +240,7 @@ func (a *API) handleJoinBoard(w http.ResponseWriter, r *http.Request) {
 	}
 
 	boardID := mux.Vars(r)["boardID"]
-	board, err := a.app.GetBoard(boardID)
+	board, err := a.app.GetBoard(r.Context(), boardID)
 	if err != nil {
 		a.errorResponse(w, r, err)
 		return
@@ -260,7 +260,7 @@ func (a *API) handleJoinBoard(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	isGuest, err := a.userIsGuest(userID)
+	isGuest, err := a.userIsGuest(r.Context(), userID)
 	if err != nil {
 		a.errorResponse(w, r, err)
 		return
@@ -284,13 +284,13 @@ func (a *API) handleJoinBoard(w http.ResponseWriter, r *http.Request) {
 	auditRec.AddMeta("boardID", boardID)
 	auditRec.AddMeta("addedUserID", userID)
 
-	member, err := a.app.AddMemberToBoard(newBoardMember)
+	member, err := a.app.AddMemberToBoard(r.Context(), newBoardMember)
 	if err != nil {
 		a.errorResponse(w, r, err)
 		return
 	}
 
-	a.logger.Debug("JoinBoard",
+	a.requestLogger(r).Debug("JoinBoard",
 		mlog.String("boardID", board.ID),
 		mlog.String("addedUserID", userID),
 	)
@@ -348,7 +348,7 @@ func (a *API) handleLeaveBoard(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	board, err := a.app.GetBoard(boardID)
+	board, err := a.app.GetBoard(r.Context(), boardID)
 	if err != nil {
 		a.errorResponse(w, r, err)
 		return
@@ -359,13 +359,13 @@ func (a *API) handleLeaveBoard(w http.ResponseWriter, r *http.Request) {
 	auditRec.AddMeta("boardID", boardID)
 	auditRec.AddMeta("addedUserID", userID)
 
-	err = a.app.DeleteBoardMember(boardID, userID)
+	err = a.app.DeleteBoardMember(r.Context(), boardID, userID)
 	if err != nil {
 		a.errorResponse(w, r, err)
 		return
 	}
 
-	a.logger.Debug("LeaveBoard",
+	a.requestLogger(r).Debug("LeaveBoard",
 		mlog.String("boardID", board.ID),
 		mlog.String("addedUserID", userID),
 	)
@@ -437,7 +437,7 @@ func (a *API) handleUpdateMember(w http.ResponseWriter, r *http.Request) {
 		SchemeViewer:    reqBoardMember.SchemeViewer,
 	}
 
-	isGuest, err := a.userIsGuest(paramsUserID)
+	isGuest, err := a.userIsGuest(r.Context(), paramsUserID)
 	if err != nil {
 		a.errorResponse(w, r, err)
 		return
@@ -457,13 +457,13 @@ func (a *API) handleUpdateMember(w http.ResponseWriter, r *http.Request) {
 	auditRec.AddMeta("boardID", boardID)
 	auditRec.AddMeta("patchedUserID", paramsUserID)
 
-	member, err := a.app.UpdateBoardMember(newBoardMember)
+	member, err := a.app.UpdateBoardMember(r.Context(), newBoardMember)
 	if err != nil {
 		a.errorResponse(w, r, err)
 		return
 	}
 
-	a.logger.Debug("PatchMember",
+	a.requestLogger(r).Debug("PatchMember",
 		mlog.String("boardID", boardID),
 		mlog.String("patchedUserID", paramsUserID),
 	)
@@ -515,7 +515,7 @@ func (a *API) handleDeleteMember(w http.ResponseWriter, r *http.Request) {
 	paramsUserID := mux.Vars(r)["userID"]
 	userID := getUserID(r)
 
-	if _, err := a.app.GetBoard(boardID); err != nil {
+	if _, err := a.app.GetBoard(r.Context(), boardID); err != nil {
 		a.errorResponse(w, r, err)
 		return
 	}
@@ -530,13 +530,13 @@ func (a *API) handleDeleteMember(w http.ResponseWriter, r *http.Request) {
 	auditRec.AddMeta("boardID", boardID)
 	auditRec.AddMeta("addedUserID", paramsUserID)
 
-	deleteErr := a.app.DeleteBoardMember(boardID, paramsUserID)
+	deleteErr := a.app.DeleteBoardMember(r.Context(), boardID, paramsUserID)
 	if deleteErr != nil {
 		a.errorResponse(w, r, deleteErr)
 		return
 	}
 
-	a.logger.Debug("DeleteMember",
+	a.requestLogger(r).Debug("DeleteMember",
 		mlog.String("boardID", boardID),
 		mlog.String("addedUserID", paramsUserID),
 	)
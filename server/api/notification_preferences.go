@@ -0,0 +1,201 @@
+// Copyright (c) 2020-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package api
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+
+	"github.com/gorilla/mux"
+	"github.com/mattermost/mattermost-plugin-boards/server/model"
+	"github.com/mattermost/mattermost-plugin-boards/server/services/audit"
+
+	"github.com/mattermost/mattermost/server/public/shared/mlog"
+)
+
+func (a *API) registerNotificationPreferencesRoutes(r *mux.Router) {
+	// Notification preferences APIs
+	r.HandleFunc("/boards/{boardID}/notifications/preferences", a.sessionRequired(a.handleGetNotificationPreferences)).Methods("GET")
+	r.HandleFunc("/boards/{boardID}/notifications/preferences", a.sessionRequired(a.handlePatchNotificationPreferences)).Methods("PATCH")
+	r.HandleFunc("/boards/{boardID}/notifications/preferences", a.sessionRequired(a.handleResetNotificationPreferences)).Methods("DELETE")
+}
+
+func (a *API) handleGetNotificationPreferences(w http.ResponseWriter, r *http.Request) {
+	// swagger:operation GET /boards/{boardID}/notifications/preferences getNotificationPreferences
+	//
+	// Returns the current user's notification preferences for a board
+	//
+	// ---
+	// produces:
+	// - application/json
+	// parameters:
+	// - name: boardID
+	//   in: path
+	//   description: Board ID
+	//   required: true
+	//   type: string
+	// security:
+	// - BearerAuth: []
+	// responses:
+	//   '200':
+	//     description: success
+	//     schema:
+	//       "$ref": "#/definitions/NotificationPreferences"
+	//   default:
+	//     description: internal error
+	//     schema:
+	//       "$ref": "#/definitions/ErrorResponse"
+
+	boardID := mux.Vars(r)["boardID"]
+	userID := getUserID(r)
+
+	if !a.permissions.HasPermissionToBoard(userID, boardID, model.PermissionViewBoard) {
+		a.errorResponse(w, r, model.NewErrPermission("access denied to board"))
+		return
+	}
+
+	auditRec := a.makeAuditRecord(r, "getNotificationPreferences", audit.Fail)
+	defer a.audit.LogRecord(audit.LevelRead, auditRec)
+	auditRec.AddMeta("boardID", boardID)
+
+	prefs, err := a.app.GetNotificationPreferences(r.Context(), userID, boardID)
+	if err != nil {
+		a.errorResponse(w, r, err)
+		return
+	}
+
+	data, err := json.Marshal(prefs)
+	if err != nil {
+		a.errorResponse(w, r, err)
+		return
+	}
+
+	jsonBytesResponse(w, http.StatusOK, data)
+	auditRec.Success()
+}
+
+func (a *API) handlePatchNotificationPreferences(w http.ResponseWriter, r *http.Request) {
+	// swagger:operation PATCH /boards/{boardID}/notifications/preferences patchNotificationPreferences
+	//
+	// Updates the current user's notification preferences for a board
+	//
+	// ---
+	// produces:
+	// - application/json
+	// parameters:
+	// - name: boardID
+	//   in: path
+	//   description: Board ID
+	//   required: true
+	//   type: string
+	// - name: Body
+	//   in: body
+	//   description: notification preferences patch
+	//   required: true
+	//   schema:
+	//     "$ref": "#/definitions/NotificationPreferencesPatch"
+	// security:
+	// - BearerAuth: []
+	// responses:
+	//   '200':
+	//     description: success
+	//     schema:
+	//       "$ref": "#/definitions/NotificationPreferences"
+	//   default:
+	//     description: internal error
+	//     schema:
+	//       "$ref": "#/definitions/ErrorResponse"
+
+	boardID := mux.Vars(r)["boardID"]
+	userID := getUserID(r)
+
+	if !a.permissions.HasPermissionToBoard(userID, boardID, model.PermissionViewBoard) {
+		a.errorResponse(w, r, model.NewErrPermission("access denied to board"))
+		return
+	}
+
+	requestBody, err := io.ReadAll(r.Body)
+	if err != nil {
+		a.errorResponse(w, r, err)
+		return
+	}
+
+	var patch *model.NotificationPreferencesPatch
+	if err = json.Unmarshal(requestBody, &patch); err != nil || patch == nil {
+		a.errorResponse(w, r, model.NewErrBadRequest("invalid notification preferences patch"))
+		return
+	}
+
+	auditRec := a.makeAuditRecord(r, "patchNotificationPreferences", audit.Fail)
+	defer a.audit.LogRecord(audit.LevelModify, auditRec)
+	auditRec.AddMeta("boardID", boardID)
+
+	prefs, err := a.app.PatchNotificationPreferences(r.Context(), userID, boardID, patch)
+	if err != nil {
+		a.errorResponse(w, r, err)
+		return
+	}
+
+	a.requestLogger(r).Debug("PatchNotificationPreferences",
+		mlog.String("boardID", boardID),
+		mlog.String("userID", userID),
+		mlog.Bool("muted", prefs.Muted),
+		mlog.Bool("mentionsOnly", prefs.MentionsOnly),
+	)
+
+	data, err := json.Marshal(prefs)
+	if err != nil {
+		a.errorResponse(w, r, err)
+		return
+	}
+
+	jsonBytesResponse(w, http.StatusOK, data)
+	auditRec.Success()
+}
+
+func (a *API) handleResetNotificationPreferences(w http.ResponseWriter, r *http.Request) {
+	// swagger:operation DELETE /boards/{boardID}/notifications/preferences resetNotificationPreferences
+	//
+	// Reverts the current user's notification preferences for a board to the defaults
+	//
+	// ---
+	// produces:
+	// - application/json
+	// parameters:
+	// - name: boardID
+	//   in: path
+	//   description: Board ID
+	//   required: true
+	//   type: string
+	// security:
+	// - BearerAuth: []
+	// responses:
+	//   '200':
+	//     description: success
+	//   default:
+	//     description: internal error
+	//     schema:
+	//       "$ref": "#/definitions/ErrorResponse"
+
+	boardID := mux.Vars(r)["boardID"]
+	userID := getUserID(r)
+
+	if !a.permissions.HasPermissionToBoard(userID, boardID, model.PermissionViewBoard) {
+		a.errorResponse(w, r, model.NewErrPermission("access denied to board"))
+		return
+	}
+
+	auditRec := a.makeAuditRecord(r, "resetNotificationPreferences", audit.Fail)
+	defer a.audit.LogRecord(audit.LevelModify, auditRec)
+	auditRec.AddMeta("boardID", boardID)
+
+	if err := a.app.ResetNotificationPreferences(r.Context(), userID, boardID); err != nil {
+		a.errorResponse(w, r, err)
+		return
+	}
+
+	jsonStringResponse(w, http.StatusOK, "{}")
+	auditRec.Success()
+}
@@ -0,0 +1,183 @@
+// Copyright (c) 2020-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package api
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/gorilla/mux"
+	"github.com/mattermost/mattermost-plugin-boards/server/model"
+	"github.com/mattermost/mattermost-plugin-boards/server/services/audit"
+
+	"github.com/mattermost/mattermost/server/public/shared/mlog"
+)
+
+func (a *API) registerNotificationsRoutes(r *mux.Router) {
+	// In-app notifications APIs
+	r.HandleFunc("/users/me/notifications", a.sessionRequired(a.handleGetMyNotifications)).Methods("GET")
+	r.HandleFunc("/users/me/notifications/read", a.sessionRequired(a.handleMarkAllNotificationsRead)).Methods("POST")
+	r.HandleFunc("/users/me/notifications/{notificationID}/read", a.sessionRequired(a.handleMarkNotificationRead)).Methods("POST")
+}
+
+func (a *API) handleGetMyNotifications(w http.ResponseWriter, r *http.Request) {
+	// swagger:operation GET /users/me/notifications getMyNotifications
+	//
+	// Returns the current user's in-app notifications, newest first, along with their unread count
+	//
+	// ---
+	// produces:
+	// - application/json
+	// parameters:
+	// - name: unread
+	//   in: query
+	//   description: Only return unread notifications
+	//   required: false
+	//   type: boolean
+	// - name: page
+	//   in: query
+	//   description: The page to select (default=0)
+	//   required: false
+	//   type: integer
+	// - name: per_page
+	//   in: query
+	//   description: Number of notifications to return per page (default=50, max=200)
+	//   required: false
+	//   type: integer
+	// security:
+	// - BearerAuth: []
+	// responses:
+	//   '200':
+	//     description: success
+	//     schema:
+	//       "$ref": "#/definitions/NotificationList"
+	//   default:
+	//     description: internal error
+	//     schema:
+	//       "$ref": "#/definitions/ErrorResponse"
+
+	userID := getUserID(r)
+	query := r.URL.Query()
+
+	opts := model.QueryNotificationsOptions{
+		UnreadOnly: query.Get("unread") == "true",
+	}
+
+	var err error
+	if strPage := query.Get("page"); strPage != "" {
+		if opts.Page, err = strconv.Atoi(strPage); err != nil || opts.Page < 0 {
+			a.errorResponse(w, r, model.NewErrBadRequest(fmt.Sprintf("invalid `page` parameter: %s", strPage)))
+			return
+		}
+	}
+	if strPerPage := query.Get("per_page"); strPerPage != "" {
+		if opts.PerPage, err = strconv.Atoi(strPerPage); err != nil || opts.PerPage < 0 {
+			a.errorResponse(w, r, model.NewErrBadRequest(fmt.Sprintf("invalid `per_page` parameter: %s", strPerPage)))
+			return
+		}
+	}
+
+	auditRec := a.makeAuditRecord(r, "getMyNotifications", audit.Fail)
+	defer a.audit.LogRecord(audit.LevelRead, auditRec)
+	auditRec.AddMeta("userID", userID)
+
+	list, err := a.app.GetNotificationsForUser(r.Context(), userID, opts)
+	if err != nil {
+		a.errorResponse(w, r, err)
+		return
+	}
+
+	a.requestLogger(r).Debug("GetMyNotifications",
+		mlog.String("userID", userID),
+		mlog.Int("page", opts.Page),
+		mlog.Int("count", len(list.Notifications)),
+		mlog.Int("unreadCount", list.UnreadCount),
+	)
+
+	data, err := json.Marshal(list)
+	if err != nil {
+		a.errorResponse(w, r, err)
+		return
+	}
+
+	jsonBytesResponse(w, http.StatusOK, data)
+	auditRec.Success()
+}
+
+func (a *API) handleMarkNotificationRead(w http.ResponseWriter, r *http.Request) {
+	// swagger:operation POST /users/me/notifications/{notificationID}/read markNotificationRead
+	//
+	// Marks one of the current user's notifications as read
+	//
+	// ---
+	// produces:
+	// - application/json
+	// parameters:
+	// - name: notificationID
+	//   in: path
+	//   description: Notification ID
+	//   required: true
+	//   type: string
+	// security:
+	// - BearerAuth: []
+	// responses:
+	//   '200':
+	//     description: success
+	//   '404':
+	//     description: notification not found
+	//   default:
+	//     description: internal error
+	//     schema:
+	//       "$ref": "#/definitions/ErrorResponse"
+
+	userID := getUserID(r)
+	notificationID := mux.Vars(r)["notificationID"]
+
+	auditRec := a.makeAuditRecord(r, "markNotificationRead", audit.Fail)
+	defer a.audit.LogRecord(audit.LevelModify, auditRec)
+	auditRec.AddMeta("notificationID", notificationID)
+
+	if err := a.app.MarkNotificationRead(r.Context(), userID, notificationID); err != nil {
+		a.errorResponse(w, r, err)
+		return
+	}
+
+	jsonStringResponse(w, http.StatusOK, "{}")
+	auditRec.Success()
+}
+
+func (a *API) handleMarkAllNotificationsRead(w http.ResponseWriter, r *http.Request) {
+	// swagger:operation POST /users/me/notifications/read markAllNotificationsRead
+	//
+	// Marks all of the current user's notifications as read
+	//
+	// ---
+	// produces:
+	// - application/json
+	// security:
+	// - BearerAuth: []
+	// responses:
+	//   '200':
+	//     description: success
+	//   default:
+	//     description: internal error
+	//     schema:
+	//       "$ref": "#/definitions/ErrorResponse"
+
+	userID := getUserID(r)
+
+	auditRec := a.makeAuditRecord(r, "markAllNotificationsRead", audit.Fail)
+	defer a.audit.LogRecord(audit.LevelModify, auditRec)
+	auditRec.AddMeta("userID", userID)
+
+	if err := a.app.MarkAllNotificationsRead(r.Context(), userID); err != nil {
+		a.errorResponse(w, r, err)
+		return
+	}
+
+	jsonStringResponse(w, http.StatusOK, "{}")
+	auditRec.Success()
+}
@@ -69,13 +69,13 @@ func (a *API) handleSearchMyChannels(w http.ResponseWriter, r *http.Request) {
 	defer a.audit.LogRecord(audit.LevelRead, auditRec)
 	auditRec.AddMeta("teamID", teamID)
 
-	channels, err := a.app.SearchUserChannels(teamID, userID, searchQuery)
+	channels, err := a.app.SearchUserChannels(r.Context(), teamID, userID, searchQuery)
 	if err != nil {
 		a.errorResponse(w, r, err)
 		return
 	}
 
-	a.logger.Debug("GetUserChannels",
+	a.requestLogger(r).Debug("GetUserChannels",
 		mlog.String("teamID", teamID),
 		mlog.Int("channelsCount", len(channels)),
 	)
@@ -159,20 +159,20 @@ func (a *API) handleSearchBoards(w http.ResponseWriter, r *http.Request) {
 	defer a.audit.LogRecord(audit.LevelRead, auditRec)
 	auditRec.AddMeta("teamID", teamID)
 
-	isGuest, err := a.userIsGuest(userID)
+	isGuest, err := a.userIsGuest(r.Context(), userID)
 	if err != nil {
 		a.errorResponse(w, r, err)
 		return
 	}
 
 	// retrieve boards list
-	boards, err := a.app.SearchBoardsForUser(term, searchField, userID, !isGuest)
+	boards, err := a.app.SearchBoardsForUser(r.Context(), term, searchField, userID, !isGuest)
 	if err != nil {
 		a.errorResponse(w, r, err)
 		return
 	}
 
-	a.logger.Debug("SearchBoards",
+	a.requestLogger(r).Debug("SearchBoards",
 		mlog.String("teamID", teamID),
 		mlog.Int("boardsCount", len(boards)),
 	)
@@ -243,7 +243,7 @@ func (a *API) handleSearchLinkableBoards(w http.ResponseWriter, r *http.Request)
 	auditRec.AddMeta("teamID", teamID)
 
 	// retrieve boards list
-	boards, err := a.app.SearchBoardsForUserInTeam(teamID, term, userID)
+	boards, err := a.app.SearchBoardsForUserInTeam(r.Context(), teamID, term, userID)
 	if err != nil {
 		a.errorResponse(w, r, err)
 		return
@@ -256,7 +256,7 @@ func (a *API) handleSearchLinkableBoards(w http.ResponseWriter, r *http.Request)
 		}
 	}
 
-	a.logger.Debug("SearchLinkableBoards",
+	a.requestLogger(r).Debug("SearchLinkableBoards",
 		mlog.String("teamID", teamID),
 		mlog.Int("boardsCount", len(linkableBoards)),
 	)
@@ -313,20 +313,20 @@ func (a *API) handleSearchAllBoards(w http.ResponseWriter, r *http.Request) {
 	auditRec := a.makeAuditRecord(r, "searchAllBoards", audit.Fail)
 	defer a.audit.LogRecord(audit.LevelRead, auditRec)
 
-	isGuest, err := a.userIsGuest(userID)
+	isGuest, err := a.userIsGuest(r.Context(), userID)
 	if err != nil {
 		a.errorResponse(w, r, err)
 		return
 	}
 
 	// retrieve boards list
-	boards, err := a.app.SearchBoardsForUser(term, model.BoardSearchFieldTitle, userID, !isGuest)
+	boards, err := a.app.SearchBoardsForUser(r.Context(), term, model.BoardSearchFieldTitle, userID, !isGuest)
 	if err != nil {
 		a.errorResponse(w, r, err)
 		return
 	}
 
-	a.logger.Debug("SearchAllBoards",
+	a.requestLogger(r).Debug("SearchAllBoards",
 		mlog.Int("boardsCount", len(boards)),
 	)
 
@@ -22,6 +22,9 @@ func (a *API) registerSharingRoutes(r *mux.Router) {
 	// Sharing APIs
 	r.HandleFunc("/boards/{boardID}/sharing", a.sessionRequired(a.handlePostSharing)).Methods("POST")
 	r.HandleFunc("/boards/{boardID}/sharing", a.sessionRequired(a.handleGetSharing)).Methods("GET")
+	r.HandleFunc("/boards/{boardID}/sharing/links", a.sessionRequired(a.handleGetShareLinks)).Methods("GET")
+	r.HandleFunc("/boards/{boardID}/sharing/links", a.sessionRequired(a.handleCreateShareLink)).Methods("POST")
+	r.HandleFunc("/boards/{boardID}/sharing/links/{linkID}", a.sessionRequired(a.handleRevokeShareLink)).Methods("DELETE")
 }
 
 func (a *API) handleGetSharing(w http.ResponseWriter, r *http.Request) {
@@ -65,7 +68,7 @@ func (a *API) handleGetSharing(w http.ResponseWriter, r *http.Request) {
 	defer a.audit.LogRecord(audit.LevelRead, auditRec)
 	auditRec.AddMeta("boardID", boardID)
 
-	sharing, err := a.app.GetSharing(boardID)
+	sharing, err := a.app.GetSharing(r.Context(), boardID)
 	if err != nil {
 		a.errorResponse(w, r, err)
 		return
@@ -79,7 +82,7 @@ func (a *API) handleGetSharing(w http.ResponseWriter, r *http.Request) {
 
 	jsonBytesResponse(w, http.StatusOK, sharingData)
 
-	a.logger.Debug("GET sharing",
+	a.requestLogger(r).Debug("GET sharing",
 		mlog.String("boardID", boardID),
 		mlog.String("shareID", sharing.ID),
 		mlog.Bool("enabled", sharing.Enabled),
@@ -152,7 +155,7 @@ func (a *API) handlePostSharing(w http.ResponseWriter, r *http.Request) {
 	modifiedBy := userID
 	sharing.ModifiedBy = modifiedBy
 	if !a.app.GetClientConfig().EnablePublicSharedBoards {
-		a.logger.Warn(
+		a.requestLogger(r).Warn(
 			"Attempt to turn on sharing for board via API failed, sharing off in configuration.",
 			mlog.String("boardID", sharing.ID),
 			mlog.String("userID", userID))
@@ -162,7 +165,7 @@ func (a *API) handlePostSharing(w http.ResponseWriter, r *http.Request) {
 
 	sharing.ModifiedBy = userID
 
-	err = a.app.UpsertSharing(sharing)
+	err = a.app.UpsertSharing(r.Context(), sharing)
 	if err != nil {
 		a.errorResponse(w, r, err)
 		return
@@ -170,6 +173,213 @@ func (a *API) handlePostSharing(w http.ResponseWriter, r *http.Request) {
 
 	jsonStringResponse(w, http.StatusOK, "{}")
 
-	a.logger.Debug("POST sharing", mlog.String("sharingID", sharing.ID))
+	a.requestLogger(r).Debug("POST sharing", mlog.String("sharingID", sharing.ID))
+	auditRec.Success()
+}
+
+func (a *API) handleGetShareLinks(w http.ResponseWriter, r *http.Request) {
+	// swagger:operation GET /boards/{boardID}/sharing/links getShareLinks
+	//
+	// Returns the share links of a board, including revoked ones
+	//
+	// ---
+	// produces:
+	// - application/json
+	// parameters:
+	// - name: boardID
+	//   in: path
+	//   description: Board ID
+	//   required: true
+	//   type: string
+	// security:
+	// - BearerAuth: []
+	// responses:
+	//   '200':
+	//     description: success
+	//     schema:
+	//       type: array
+	//       items:
+	//         "$ref": "#/definitions/ShareLink"
+	//   default:
+	//     description: internal error
+	//     schema:
+	//       "$ref": "#/definitions/ErrorResponse"
+
+	boardID := mux.Vars(r)["boardID"]
+
+	userID := getUserID(r)
+	if !a.permissions.HasPermissionToBoard(userID, boardID, model.PermissionShareBoard) {
+		a.errorResponse(w, r, model.NewErrPermission("access denied to sharing the board"))
+		return
+	}
+
+	auditRec := a.makeAuditRecord(r, "getShareLinks", audit.Fail)
+	defer a.audit.LogRecord(audit.LevelRead, auditRec)
+	auditRec.AddMeta("boardID", boardID)
+
+	links, err := a.app.GetShareLinks(r.Context(), boardID)
+	if err != nil {
+		a.errorResponse(w, r, err)
+		return
+	}
+
+	data, err := json.Marshal(links)
+	if err != nil {
+		a.errorResponse(w, r, err)
+		return
+	}
+
+	jsonBytesResponse(w, http.StatusOK, data)
+
+	a.requestLogger(r).Debug("GET share links",
+		mlog.String("boardID", boardID),
+		mlog.Int("link_count", len(links)),
+	)
+	auditRec.Success()
+}
+
+func (a *API) handleCreateShareLink(w http.ResponseWriter, r *http.Request) {
+	// swagger:operation POST /boards/{boardID}/sharing/links createShareLink
+	//
+	// Creates a share link for a board, or a view or card of the board
+	//
+	// ---
+	// produces:
+	// - application/json
+	// parameters:
+	// - name: boardID
+	//   in: path
+	//   description: Board ID
+	//   required: true
+	//   type: string
+	// - name: Body
+	//   in: body
+	//   description: share link definition
+	//   required: true
+	//   schema:
+	//     "$ref": "#/definitions/ShareLinkCreate"
+	// security:
+	// - BearerAuth: []
+	// responses:
+	//   '200':
+	//     description: success
+	//     schema:
+	//       "$ref": "#/definitions/ShareLink"
+	//   default:
+	//     description: internal error
+	//     schema:
+	//       "$ref": "#/definitions/ErrorResponse"
+
+	boardID := mux.Vars(r)["boardID"]
+
+	userID := getUserID(r)
+	if !a.permissions.HasPermissionToBoard(userID, boardID, model.PermissionShareBoard) {
+		a.errorResponse(w, r, model.NewErrPermission("access denied to sharing the board"))
+		return
+	}
+
+	if !a.app.GetClientConfig().EnablePublicSharedBoards {
+		a.errorResponse(w, r, ErrTurningOnSharing)
+		return
+	}
+
+	requestBody, err := io.ReadAll(r.Body)
+	if err != nil {
+		a.errorResponse(w, r, err)
+		return
+	}
+
+	var create model.ShareLinkCreate
+	if err = json.Unmarshal(requestBody, &create); err != nil {
+		a.errorResponse(w, r, model.NewErrBadRequest(err.Error()))
+		return
+	}
+
+	auditRec := a.makeAuditRecord(r, "createShareLink", audit.Fail)
+	defer a.audit.LogRecord(audit.LevelModify, auditRec)
+	auditRec.AddMeta("boardID", boardID)
+	auditRec.AddMeta("scope", create.Scope)
+	auditRec.AddMeta("scopeID", create.ScopeID)
+	auditRec.AddMeta("permission", create.Permission)
+
+	link, err := a.app.CreateShareLink(r.Context(), boardID, &create, userID)
+	if err != nil {
+		a.errorResponse(w, r, err)
+		return
+	}
+
+	data, err := json.Marshal(link)
+	if err != nil {
+		a.errorResponse(w, r, err)
+		return
+	}
+
+	jsonBytesResponse(w, http.StatusOK, data)
+
+	a.requestLogger(r).Debug("POST share link",
+		mlog.String("boardID", boardID),
+		mlog.String("linkID", link.ID),
+	)
+	auditRec.AddMeta("linkID", link.ID)
+	auditRec.Success()
+}
+
+func (a *API) handleRevokeShareLink(w http.ResponseWriter, r *http.Request) {
+	// swagger:operation DELETE /boards/{boardID}/sharing/links/{linkID} revokeShareLink
+	//
+	// Revokes a share link of a board
+	//
+	// ---
+	// produces:
+	// - application/json
+	// parameters:
+	// - name: boardID
+	//   in: path
+	//   description: Board ID
+	//   required: true
+	//   type: string
+	// - name: linkID
+	//   in: path
+	//   description: Share link ID
+	//   required: true
+	//   type: string
+	// security:
+	// - BearerAuth: []
+	// responses:
+	//   '200':
+	//     description: success
+	//   '404':
+	//     description: share link not found
+	//   default:
+	//     description: internal error
+	//     schema:
+	//       "$ref": "#/definitions/ErrorResponse"
+
+	vars := mux.Vars(r)
+	boardID := vars["boardID"]
+	linkID := vars["linkID"]
+
+	userID := getUserID(r)
+	if !a.permissions.HasPermissionToBoard(userID, boardID, model.PermissionShareBoard) {
+		a.errorResponse(w, r, model.NewErrPermission("access denied to sharing the board"))
+		return
+	}
+
+	auditRec := a.makeAuditRecord(r, "revokeShareLink", audit.Fail)
+	defer a.audit.LogRecord(audit.LevelModify, auditRec)
+	auditRec.AddMeta("boardID", boardID)
+	auditRec.AddMeta("linkID", linkID)
+
+	if err := a.app.RevokeShareLink(r.Context(), boardID, linkID); err != nil {
+		a.errorResponse(w, r, err)
+		return
+	}
+
+	jsonStringResponse(w, http.StatusOK, "{}")
+
+	a.requestLogger(r).Debug("DELETE share link",
+		mlog.String("boardID", boardID),
+		mlog.String("linkID", linkID),
+	)
 	auditRec.Success()
 }
@@ -0,0 +1,153 @@
+// Copyright (c) 2020-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package api
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mattermost/mattermost-plugin-boards/server/app"
+	"github.com/mattermost/mattermost-plugin-boards/server/auth"
+	"github.com/mattermost/mattermost-plugin-boards/server/model"
+	"github.com/mattermost/mattermost-plugin-boards/server/services/audit"
+	"github.com/mattermost/mattermost-plugin-boards/server/services/config"
+	"github.com/mattermost/mattermost-plugin-boards/server/services/store/mockstore"
+
+	mm_model "github.com/mattermost/mattermost/server/public/model"
+	"github.com/mattermost/mattermost/server/public/shared/mlog"
+)
+
+const (
+	testShareBoardID  = "board-id"
+	testShareTeamID   = "team-id"
+	testShareToken    = "read-token"
+	testSharedCardID  = "card-shared"
+	testPrivateCardID = "card-private"
+)
+
+var (
+	testSharedFile  = "7" + mm_model.NewId() + ".png"
+	testPrivateFile = "7" + mm_model.NewId() + ".png"
+)
+
+// setupCardShareLinkAPI returns an API whose board has a link shared with the scope of a card,
+// and a card and an image outside of it.
+func setupCardShareLinkAPI(t *testing.T) *API {
+	ctrl := gomock.NewController(t)
+	store := mockstore.NewMockStore(ctrl)
+	logger := mlog.CreateConsoleTestLogger(t)
+	cfg := &config.Configuration{EnablePublicSharedBoards: true}
+
+	testApp := app.New(cfg, nil, app.Services{
+		Auth:             auth.New(cfg, store, nil),
+		Store:            store,
+		Logger:           logger,
+		SkipTemplateInit: true,
+	})
+	t.Cleanup(testApp.Shutdown)
+
+	testAudit, err := audit.NewAudit()
+	require.NoError(t, err)
+
+	link := &model.ShareLink{
+		ID:         "link-id",
+		BoardID:    testShareBoardID,
+		Token:      testShareToken,
+		Scope:      model.ShareLinkScopeCard,
+		ScopeID:    testSharedCardID,
+		Permission: model.ShareLinkPermissionView,
+		LastUsedAt: model.GetMillis(),
+	}
+	blocks := map[string]*model.Block{
+		testSharedCardID:  {ID: testSharedCardID, BoardID: testShareBoardID, Type: model.TypeCard},
+		testPrivateCardID: {ID: testPrivateCardID, BoardID: testShareBoardID, Type: model.TypeCard},
+	}
+	images := []*model.Block{
+		{
+			ID: "image-shared", ParentID: testSharedCardID, BoardID: testShareBoardID, Type: model.TypeImage,
+			Fields: map[string]interface{}{model.BlockFieldFileId: testSharedFile},
+		},
+		{
+			ID: "image-private", ParentID: testPrivateCardID, BoardID: testShareBoardID, Type: model.TypeImage,
+			Fields: map[string]interface{}{model.BlockFieldFileId: testPrivateFile},
+		},
+	}
+
+	store.EXPECT().GetSharing(gomock.Any(), testShareBoardID).Return(nil, model.NewErrNotFound("sharing")).AnyTimes()
+	store.EXPECT().GetShareLinkByToken(gomock.Any(), testShareBoardID, testShareToken).Return(link, nil).AnyTimes()
+	store.EXPECT().GetBoard(gomock.Any(), testShareBoardID).Return(&model.Board{ID: testShareBoardID, TeamID: testShareTeamID}, nil).AnyTimes()
+	store.EXPECT().GetBlock(gomock.Any(), gomock.Any()).DoAndReturn(func(_ interface{}, blockID string) (*model.Block, error) {
+		if block, ok := blocks[blockID]; ok {
+			return block, nil
+		}
+		return nil, model.NewErrNotFound(blockID)
+	}).AnyTimes()
+	store.EXPECT().GetBlocksWithType(gomock.Any(), testShareBoardID, model.TypeImage).Return(images, nil).AnyTimes()
+	store.EXPECT().GetBlocksWithType(gomock.Any(), testShareBoardID, model.TypeAttachment).Return(nil, nil).AnyTimes()
+	store.EXPECT().GetFileInfo(gomock.Any(), gomock.Any()).Return(&mm_model.FileInfo{Id: "file-info-id"}, nil).AnyTimes()
+	store.EXPECT().GetFileScan(gomock.Any(), gomock.Any()).Return(&model.FileScan{Status: model.FileScanClean}, nil).AnyTimes()
+
+	return &API{app: testApp, logger: logger, audit: testAudit}
+}
+
+func TestCardShareLinkScope(t *testing.T) {
+	testAPI := setupCardShareLinkAPI(t)
+
+	getBlock := func(blockID string) []*model.Block {
+		request, _ := http.NewRequest(http.MethodGet, "/boards/"+testShareBoardID+"/blocks?read_token="+testShareToken+"&block_id="+blockID, nil)
+		request = mux.SetURLVars(request, map[string]string{"boardID": testShareBoardID})
+		response := httptest.NewRecorder()
+
+		testAPI.handleGetBlocks(response, request)
+		require.Equal(t, http.StatusOK, response.Code)
+
+		var blocks []*model.Block
+		require.NoError(t, json.NewDecoder(response.Body).Decode(&blocks))
+		return blocks
+	}
+
+	getFileInfo := func(filename string) *httptest.ResponseRecorder {
+		request, _ := http.NewRequest(http.MethodGet, "/files/teams/"+testShareTeamID+"/"+testShareBoardID+"/"+filename+"/info?read_token="+testShareToken, nil)
+		request = mux.SetURLVars(request, map[string]string{
+			"teamID":   testShareTeamID,
+			"boardID":  testShareBoardID,
+			"filename": filename,
+		})
+		response := httptest.NewRecorder()
+
+		testAPI.getFileInfo(response, request)
+		return response
+	}
+
+	t.Run("the shared card is returned", func(t *testing.T) {
+		blocks := getBlock(testSharedCardID)
+		require.Len(t, blocks, 1)
+		assert.Equal(t, testSharedCardID, blocks[0].ID)
+	})
+
+	t.Run("a card out of scope is not returned", func(t *testing.T) {
+		assert.Empty(t, getBlock(testPrivateCardID))
+	})
+
+	t.Run("a file of the shared card is returned", func(t *testing.T) {
+		response := getFileInfo(testSharedFile)
+		require.Equal(t, http.StatusOK, response.Code)
+
+		fileInfo, err := FileInfoResponseFromJSON(response.Body)
+		require.NoError(t, err)
+		assert.Equal(t, "file-info-id", fileInfo.Id)
+		assert.Equal(t, model.FileScanClean, fileInfo.ScanStatus)
+	})
+
+	t.Run("a file out of scope is denied", func(t *testing.T) {
+		assert.Equal(t, http.StatusUnauthorized, getFileInfo(testPrivateFile).Code)
+	})
+}
@@ -5,7 +5,9 @@ package api
 
 import (
 	"encoding/json"
+	"fmt"
 	"net/http"
+	"strconv"
 
 	"github.com/gorilla/mux"
 	"github.com/mattermost/mattermost-plugin-boards/server/model"
@@ -20,11 +22,39 @@ func (a *API) registerStatisticsRoutes(r *mux.Router) {
 func (a *API) handleStatistics(w http.ResponseWriter, r *http.Request) {
 	// swagger:operation GET /statistics handleStatistics
 	//
-	// Fetches the statistic  of the server.
+	// Fetches the statistic  of the server, with the activity over a date range broken down by
+	// team and by board.
 	//
 	// ---
 	// produces:
 	// - application/json
+	// parameters:
+	// - name: team_id
+	//   in: query
+	//   description: Team ID to limit the statistics to
+	//   required: false
+	//   type: string
+	// - name: from
+	//   in: query
+	//   description: Start of the date range, in milliseconds since the current epoch. Defaults to 30 days before `to`
+	//   required: false
+	//   type: integer
+	// - name: to
+	//   in: query
+	//   description: End of the date range, in milliseconds since the current epoch. Defaults to now
+	//   required: false
+	//   type: integer
+	// - name: top
+	//   in: query
+	//   description: Number of the most active boards to return, up to 100. Defaults to 10
+	//   required: false
+	//   type: integer
+	// - name: period
+	//   in: query
+	//   description: Length of the periods the activity is broken down into, in UTC. Defaults to day
+	//   required: false
+	//   type: string
+	//   enum: [day, week, month]
 	// security:
 	// - BearerAuth: []
 	// responses:
@@ -44,21 +74,38 @@ func (a *API) handleStatistics(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	boardCount, err := a.app.GetBoardCount(false)
-	if err != nil {
-		a.errorResponse(w, r, err)
-		return
+	query := r.URL.Query()
+	opts := model.StatisticsOptions{
+		TeamID: query.Get("team_id"),
+		Period: model.StatisticsPeriod(query.Get("period")),
+	}
+
+	var err error
+	if strFrom := query.Get("from"); strFrom != "" {
+		if opts.From, err = strconv.ParseInt(strFrom, 10, 64); err != nil {
+			a.errorResponse(w, r, model.NewErrBadRequest(fmt.Sprintf("invalid `from` parameter: %s", err)))
+			return
+		}
 	}
-	cardCount, err := a.app.GetUsedCardsCount()
+	if strTo := query.Get("to"); strTo != "" {
+		if opts.To, err = strconv.ParseInt(strTo, 10, 64); err != nil {
+			a.errorResponse(w, r, model.NewErrBadRequest(fmt.Sprintf("invalid `to` parameter: %s", err)))
+			return
+		}
+	}
+	if strTop := query.Get("top"); strTop != "" {
+		if opts.TopBoards, err = strconv.Atoi(strTop); err != nil {
+			a.errorResponse(w, r, model.NewErrBadRequest(fmt.Sprintf("invalid `top` parameter: %s", err)))
+			return
+		}
+	}
+
+	stats, err := a.app.GetStatistics(r.Context(), opts)
 	if err != nil {
 		a.errorResponse(w, r, err)
 		return
 	}
 
-	stats := model.BoardsStatistics{
-		Boards: boardCount,
-		Cards:  cardCount,
-	}
 	data, err := json.Marshal(stats)
 	if err != nil {
 		a.errorResponse(w, r, err)
@@ -0,0 +1,322 @@
+// Copyright (c) 2020-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package api
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/gorilla/mux"
+	"github.com/mattermost/mattermost-plugin-boards/server/model"
+	"github.com/mattermost/mattermost-plugin-boards/server/services/audit"
+
+	mmModel "github.com/mattermost/mattermost/server/public/model"
+)
+
+func (a *API) registerStorageQuotasRoutes(r *mux.Router) {
+	// Storage usage APIs
+	r.HandleFunc("/teams/{teamID}/storage", a.sessionRequired(a.handleGetTeamFileUsage)).Methods("GET")
+	r.HandleFunc("/boards/{boardID}/storage", a.sessionRequired(a.handleGetBoardFileUsage)).Methods("GET")
+
+	// Storage quota APIs, for system administrators
+	r.HandleFunc("/storage-quotas/{scope}/{scopeID}", a.sessionRequired(a.handleGetStorageQuota)).Methods("GET")
+	r.HandleFunc("/storage-quotas/{scope}/{scopeID}", a.sessionRequired(a.handlePutStorageQuota)).Methods("PUT")
+	r.HandleFunc("/storage-quotas/{scope}/{scopeID}", a.sessionRequired(a.handleDeleteStorageQuota)).Methods("DELETE")
+}
+
+func (a *API) handleGetTeamFileUsage(w http.ResponseWriter, r *http.Request) {
+	// swagger:operation GET /teams/{teamID}/storage getTeamFileUsage
+	//
+	// Returns the storage used by the files of the boards of a team, and its quota
+	//
+	// ---
+	// produces:
+	// - application/json
+	// parameters:
+	// - name: teamID
+	//   in: path
+	//   description: Team ID
+	//   required: true
+	//   type: string
+	// security:
+	// - BearerAuth: []
+	// responses:
+	//   '200':
+	//     description: success
+	//     schema:
+	//       "$ref": "#/definitions/FileUsage"
+	//   default:
+	//     description: internal error
+	//     schema:
+	//       "$ref": "#/definitions/ErrorResponse"
+
+	teamID := mux.Vars(r)["teamID"]
+
+	userID := getUserID(r)
+	if !a.permissions.HasPermissionToTeam(userID, teamID, model.PermissionViewTeam) {
+		a.errorResponse(w, r, model.NewErrPermission("access denied to team"))
+		return
+	}
+
+	usage, err := a.app.GetTeamFileUsage(r.Context(), teamID)
+	if err != nil {
+		a.errorResponse(w, r, err)
+		return
+	}
+
+	data, err := json.Marshal(usage)
+	if err != nil {
+		a.errorResponse(w, r, err)
+		return
+	}
+
+	jsonBytesResponse(w, http.StatusOK, data)
+}
+
+func (a *API) handleGetBoardFileUsage(w http.ResponseWriter, r *http.Request) {
+	// swagger:operation GET /boards/{boardID}/storage getBoardFileUsage
+	//
+	// Returns the storage used by the files of a board, and its quota
+	//
+	// ---
+	// produces:
+	// - application/json
+	// parameters:
+	// - name: boardID
+	//   in: path
+	//   description: Board ID
+	//   required: true
+	//   type: string
+	// security:
+	// - BearerAuth: []
+	// responses:
+	//   '200':
+	//     description: success
+	//     schema:
+	//       "$ref": "#/definitions/FileUsage"
+	//   default:
+	//     description: internal error
+	//     schema:
+	//       "$ref": "#/definitions/ErrorResponse"
+
+	boardID := mux.Vars(r)["boardID"]
+
+	userID := getUserID(r)
+	if !a.permissions.HasPermissionToBoard(userID, boardID, model.PermissionViewBoard) {
+		a.errorResponse(w, r, model.NewErrPermission("access denied to board"))
+		return
+	}
+
+	usage, err := a.app.GetBoardFileUsage(r.Context(), boardID)
+	if err != nil {
+		a.errorResponse(w, r, err)
+		return
+	}
+
+	data, err := json.Marshal(usage)
+	if err != nil {
+		a.errorResponse(w, r, err)
+		return
+	}
+
+	jsonBytesResponse(w, http.StatusOK, data)
+}
+
+func (a *API) handleGetStorageQuota(w http.ResponseWriter, r *http.Request) {
+	// swagger:operation GET /storage-quotas/{scope}/{scopeID} getStorageQuota
+	//
+	// Returns the storage quota of a team or board. Teams and boards without a quota of their
+	// own have the default quota of the server. Only available to system administrators
+	//
+	// ---
+	// produces:
+	// - application/json
+	// parameters:
+	// - name: scope
+	//   in: path
+	//   description: team or board
+	//   required: true
+	//   type: string
+	// - name: scopeID
+	//   in: path
+	//   description: Team or board ID
+	//   required: true
+	//   type: string
+	// security:
+	// - BearerAuth: []
+	// responses:
+	//   '200':
+	//     description: success
+	//     schema:
+	//       "$ref": "#/definitions/StorageQuota"
+	//   default:
+	//     description: internal error
+	//     schema:
+	//       "$ref": "#/definitions/ErrorResponse"
+
+	vars := mux.Vars(r)
+	scope := model.StorageQuotaScope(vars["scope"])
+	scopeID := vars["scopeID"]
+
+	userID := getUserID(r)
+	if !a.permissions.HasPermissionTo(userID, mmModel.PermissionManageSystem) {
+		a.errorResponse(w, r, model.NewErrPermission("access denied to storage quotas"))
+		return
+	}
+
+	quota := &model.StorageQuota{Scope: scope, ScopeID: scopeID}
+	if err := quota.IsValid(); err != nil {
+		a.errorResponse(w, r, err)
+		return
+	}
+
+	quota, err := a.app.GetStorageQuota(r.Context(), scope, scopeID)
+	if err != nil {
+		a.errorResponse(w, r, err)
+		return
+	}
+
+	data, err := json.Marshal(quota)
+	if err != nil {
+		a.errorResponse(w, r, err)
+		return
+	}
+
+	jsonBytesResponse(w, http.StatusOK, data)
+}
+
+func (a *API) handlePutStorageQuota(w http.ResponseWriter, r *http.Request) {
+	// swagger:operation PUT /storage-quotas/{scope}/{scopeID} putStorageQuota
+	//
+	// Sets the storage quota of a team or board, overriding the default quota of the server. A
+	// quota of 0 bytes is unlimited. Only available to system administrators
+	//
+	// ---
+	// produces:
+	// - application/json
+	// parameters:
+	// - name: scope
+	//   in: path
+	//   description: team or board
+	//   required: true
+	//   type: string
+	// - name: scopeID
+	//   in: path
+	//   description: Team or board ID
+	//   required: true
+	//   type: string
+	// - name: Body
+	//   in: body
+	//   description: the quota, only maxBytes is used
+	//   required: true
+	//   schema:
+	//     "$ref": "#/definitions/StorageQuota"
+	// security:
+	// - BearerAuth: []
+	// responses:
+	//   '200':
+	//     description: success
+	//     schema:
+	//       "$ref": "#/definitions/StorageQuota"
+	//   default:
+	//     description: internal error
+	//     schema:
+	//       "$ref": "#/definitions/ErrorResponse"
+
+	vars := mux.Vars(r)
+	userID := getUserID(r)
+	if !a.permissions.HasPermissionTo(userID, mmModel.PermissionManageSystem) {
+		a.errorResponse(w, r, model.NewErrPermission("access denied to storage quotas"))
+		return
+	}
+
+	quota, err := model.StorageQuotaFromJSON(r.Body)
+	if err != nil {
+		a.errorResponse(w, r, model.NewErrBadRequest(err.Error()))
+		return
+	}
+	quota.Scope = model.StorageQuotaScope(vars["scope"])
+	quota.ScopeID = vars["scopeID"]
+
+	auditRec := a.makeAuditRecord(r, "putStorageQuota", audit.Fail)
+	defer a.audit.LogRecord(audit.LevelModify, auditRec)
+	auditRec.AddMeta("scope", quota.Scope)
+	auditRec.AddMeta("scopeID", quota.ScopeID)
+	auditRec.AddMeta("maxBytes", quota.MaxBytes)
+
+	quota, err = a.app.SetStorageQuota(r.Context(), quota, userID)
+	if err != nil {
+		a.errorResponse(w, r, err)
+		return
+	}
+
+	data, err := json.Marshal(quota)
+	if err != nil {
+		a.errorResponse(w, r, err)
+		return
+	}
+
+	jsonBytesResponse(w, http.StatusOK, data)
+	auditRec.Success()
+}
+
+func (a *API) handleDeleteStorageQuota(w http.ResponseWriter, r *http.Request) {
+	// swagger:operation DELETE /storage-quotas/{scope}/{scopeID} deleteStorageQuota
+	//
+	// Removes the storage quota of a team or board, which reverts to the default quota of the
+	// server. Only available to system administrators
+	//
+	// ---
+	// produces:
+	// - application/json
+	// parameters:
+	// - name: scope
+	//   in: path
+	//   description: team or board
+	//   required: true
+	//   type: string
+	// - name: scopeID
+	//   in: path
+	//   description: Team or board ID
+	//   required: true
+	//   type: string
+	// security:
+	// - BearerAuth: []
+	// responses:
+	//   '200':
+	//     description: success
+	//   default:
+	//     description: internal error
+	//     schema:
+	//       "$ref": "#/definitions/ErrorResponse"
+
+	vars := mux.Vars(r)
+	scope := model.StorageQuotaScope(vars["scope"])
+	scopeID := vars["scopeID"]
+
+	userID := getUserID(r)
+	if !a.permissions.HasPermissionTo(userID, mmModel.PermissionManageSystem) {
+		a.errorResponse(w, r, model.NewErrPermission("access denied to storage quotas"))
+		return
+	}
+
+	quota := &model.StorageQuota{Scope: scope, ScopeID: scopeID}
+	if err := quota.IsValid(); err != nil {
+		a.errorResponse(w, r, err)
+		return
+	}
+
+	auditRec := a.makeAuditRecord(r, "deleteStorageQuota", audit.Fail)
+	defer a.audit.LogRecord(audit.LevelModify, auditRec)
+	auditRec.AddMeta("scope", scope)
+	auditRec.AddMeta("scopeID", scopeID)
+
+	if err := a.app.DeleteStorageQuota(r.Context(), scope, scopeID); err != nil {
+		a.errorResponse(w, r, err)
+		return
+	}
+
+	jsonStringResponse(w, http.StatusOK, "{}")
+	auditRec.Success()
+}
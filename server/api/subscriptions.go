@@ -80,7 +80,7 @@ func (a *API) handleCreateSubscription(w http.ResponseWriter, r *http.Request) {
 	}
 
 	// check for valid block and permissions to view its board
-	block, bErr := a.app.GetBlockByID(sub.BlockID)
+	block, bErr := a.app.GetBlockByID(ctx, sub.BlockID)
 	if bErr != nil {
 		message := fmt.Sprintf("invalid blockID: %s", bErr)
 		a.errorResponse(w, r, model.NewErrBadRequest(message))
@@ -97,13 +97,13 @@ func (a *API) handleCreateSubscription(w http.ResponseWriter, r *http.Request) {
 	auditRec.AddMeta("subscriber_id", sub.SubscriberID)
 	auditRec.AddMeta("block_id", sub.BlockID)
 
-	subNew, err := a.app.CreateSubscription(&sub)
+	subNew, err := a.app.CreateSubscription(ctx, &sub)
 	if err != nil {
 		a.errorResponse(w, r, err)
 		return
 	}
 
-	a.logger.Debug("CREATE subscription",
+	a.requestLogger(r).Debug("CREATE subscription",
 		mlog.String("subscriber_id", subNew.SubscriberID),
 		mlog.String("block_id", subNew.BlockID),
 	)
@@ -165,12 +165,12 @@ func (a *API) handleDeleteSubscription(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	if _, err := a.app.DeleteSubscription(blockID, subscriberID); err != nil {
+	if _, err := a.app.DeleteSubscription(ctx, blockID, subscriberID); err != nil {
 		a.errorResponse(w, r, err)
 		return
 	}
 
-	a.logger.Debug("DELETE subscription",
+	a.requestLogger(r).Debug("DELETE subscription",
 		mlog.String("blockID", blockID),
 		mlog.String("subscriberID", subscriberID),
 	)
@@ -222,13 +222,13 @@ func (a *API) handleGetSubscriptions(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	subs, err := a.app.GetSubscriptions(subscriberID)
+	subs, err := a.app.GetSubscriptions(ctx, subscriberID)
 	if err != nil {
 		a.errorResponse(w, r, err)
 		return
 	}
 
-	a.logger.Debug("GET subscriptions",
+	a.requestLogger(r).Debug("GET subscriptions",
 		mlog.String("subscriberID", subscriberID),
 		mlog.Int("count", len(subs)),
 	)
@@ -46,7 +46,7 @@ func (a *API) handleGetTeams(w http.ResponseWriter, r *http.Request) {
 
 	userID := getUserID(r)
 
-	teams, err := a.app.GetTeamsForUser(userID)
+	teams, err := a.app.GetTeamsForUser(r.Context(), userID)
 	if err != nil {
 		a.errorResponse(w, r, err)
 	}
@@ -103,7 +103,7 @@ func (a *API) handleGetTeam(w http.ResponseWriter, r *http.Request) {
 	var team *model.Team
 	var err error
 
-	team, err = a.app.GetTeam(teamID)
+	team, err = a.app.GetTeam(r.Context(), teamID)
 	if model.IsErrNotFound(err) {
 		a.errorResponse(w, r, model.NewErrUnauthorized("invalid team"))
 	}
@@ -178,7 +178,7 @@ func (a *API) handleGetTeamUsers(w http.ResponseWriter, r *http.Request) {
 	auditRec := a.makeAuditRecord(r, "getUsers", audit.Fail)
 	defer a.audit.LogRecord(audit.LevelRead, auditRec)
 
-	isGuest, err := a.userIsGuest(userID)
+	isGuest, err := a.userIsGuest(r.Context(), userID)
 	if err != nil {
 		a.errorResponse(w, r, err)
 		return
@@ -188,7 +188,7 @@ func (a *API) handleGetTeamUsers(w http.ResponseWriter, r *http.Request) {
 		asGuestUser = userID
 	}
 
-	users, err := a.app.SearchTeamUsers(teamID, searchQuery, asGuestUser, excludeBots)
+	users, err := a.app.SearchTeamUsers(r.Context(), teamID, searchQuery, asGuestUser, excludeBots)
 	if err != nil {
 		a.errorResponse(w, r, err)
 		return
@@ -271,7 +271,7 @@ func (a *API) handleGetTeamUsersByID(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	users, error = a.app.GetUsersList(userIDs)
+	users, error = a.app.GetUsersList(r.Context(), userIDs)
 	if error != nil {
 		a.errorResponse(w, r, error)
 		return
@@ -54,7 +54,7 @@ func (a *API) handleGetTemplates(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	isGuest, err := a.userIsGuest(userID)
+	isGuest, err := a.userIsGuest(r.Context(), userID)
 	if err != nil {
 		a.errorResponse(w, r, err)
 		return
@@ -69,7 +69,7 @@ func (a *API) handleGetTemplates(w http.ResponseWriter, r *http.Request) {
 	auditRec.AddMeta("teamID", teamID)
 
 	// retrieve boards list
-	boards, err := a.app.GetTemplateBoards(teamID, userID)
+	boards, err := a.app.GetTemplateBoards(r.Context(), teamID, userID)
 	if err != nil {
 		a.errorResponse(w, r, err)
 		return
@@ -84,7 +84,7 @@ func (a *API) handleGetTemplates(w http.ResponseWriter, r *http.Request) {
 		}
 	}
 
-	a.logger.Debug("GetTemplates",
+	a.requestLogger(r).Debug("GetTemplates",
 		mlog.String("teamID", teamID),
 		mlog.Int("boardsCount", len(results)),
 	)
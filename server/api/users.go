@@ -72,7 +72,7 @@ func (a *API) handleGetUsersList(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	users, error = a.app.GetUsersList(userIDs)
+	users, error = a.app.GetUsersList(r.Context(), userIDs)
 	if error != nil {
 		a.errorResponse(w, r, error)
 		return
@@ -84,7 +84,7 @@ func (a *API) handleGetUsersList(w http.ResponseWriter, r *http.Request) {
 
 	sanitizedUsers := make([]*model.User, 0)
 	for _, user := range users {
-		canSeeUser, err2 := a.app.CanSeeUser(session.UserID, user.ID)
+		canSeeUser, err2 := a.app.CanSeeUser(ctx, session.UserID, user.ID)
 		if err2 != nil {
 			a.errorResponse(w, r, err2)
 			return
@@ -152,7 +152,7 @@ func (a *API) handleGetMe(w http.ResponseWriter, r *http.Request) {
 	auditRec := a.makeAuditRecord(r, "getMe", audit.Fail)
 	defer a.audit.LogRecord(audit.LevelRead, auditRec)
 
-	user, err = a.app.GetUser(userID)
+	user, err = a.app.GetUser(r.Context(), userID)
 	if err != nil {
 		// ToDo: wrap with an invalid token error
 		a.errorResponse(w, r, err)
@@ -209,7 +209,7 @@ func (a *API) handleGetMyMemberships(w http.ResponseWriter, r *http.Request) {
 	auditRec.AddMeta("userID", userID)
 	defer a.audit.LogRecord(audit.LevelRead, auditRec)
 
-	members, err := a.app.GetMembersForUser(userID)
+	members, err := a.app.GetMembersForUser(r.Context(), userID)
 	if err != nil {
 		a.errorResponse(w, r, err)
 		return
@@ -259,7 +259,7 @@ func (a *API) handleGetUser(w http.ResponseWriter, r *http.Request) {
 	defer a.audit.LogRecord(audit.LevelRead, auditRec)
 	auditRec.AddMeta("userID", userID)
 
-	user, err := a.app.GetUser(userID)
+	user, err := a.app.GetUser(r.Context(), userID)
 	if err != nil {
 		a.errorResponse(w, r, err)
 		return
@@ -268,7 +268,7 @@ func (a *API) handleGetUser(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 	session := ctx.Value(sessionContextKey).(*model.Session)
 
-	canSeeUser, err := a.app.CanSeeUser(session.UserID, userID)
+	canSeeUser, err := a.app.CanSeeUser(ctx, session.UserID, userID)
 	if err != nil {
 		a.errorResponse(w, r, err)
 		return
@@ -352,7 +352,7 @@ func (a *API) handleUpdateUserConfig(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	updatedConfig, err := a.app.UpdateUserConfig(userID, *patch)
+	updatedConfig, err := a.app.UpdateUserConfig(ctx, userID, *patch)
 	if err != nil {
 		a.errorResponse(w, r, err)
 		return
@@ -393,7 +393,7 @@ func (a *API) handleGetUserPreferences(w http.ResponseWriter, r *http.Request) {
 	auditRec := a.makeAuditRecord(r, "getUserConfig", audit.Fail)
 	defer a.audit.LogRecord(audit.LevelRead, auditRec)
 
-	preferences, err := a.app.GetUserPreferences(userID)
+	preferences, err := a.app.GetUserPreferences(r.Context(), userID)
 	if err != nil {
 		a.errorResponse(w, r, err)
 		return
@@ -0,0 +1,430 @@
+// Copyright (c) 2020-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package app
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"sort"
+	"strconv"
+
+	"github.com/mattermost/mattermost-plugin-boards/server/model"
+	"github.com/mattermost/mattermost-plugin-boards/server/services/notify/notifysubscriptions"
+)
+
+const (
+	activityDefaultPerPage   = 50
+	activityHistoryBatchSize = 200
+	activityUnknownUser      = "unknown_user"
+	activityUntitled         = "Untitled"
+)
+
+// GetBoardActivity returns a page of the changes of a board and its cards, newest first.
+func (a *App) GetBoardActivity(ctx context.Context, boardID string, opts model.QueryActivityOptions) (*model.ActivityFeed, error) {
+	ctx, span := startSpan(ctx, "GetBoardActivity")
+	defer span.End()
+
+	board, err := a.GetBoard(ctx, boardID)
+	if err != nil {
+		return nil, err
+	}
+	return a.getActivity(ctx, board, "", opts)
+}
+
+// GetCardActivity returns a page of the changes of a card and its contents, newest first.
+func (a *App) GetCardActivity(ctx context.Context, cardID string, opts model.QueryActivityOptions) (*model.ActivityFeed, error) {
+	ctx, span := startSpan(ctx, "GetCardActivity")
+	defer span.End()
+
+	card, err := a.GetCardByID(ctx, cardID)
+	if err != nil {
+		return nil, err
+	}
+	board, err := a.GetBoard(ctx, card.BoardID)
+	if err != nil {
+		return nil, err
+	}
+	return a.getActivity(ctx, board, cardID, opts)
+}
+
+// activityRow is a version of a block or of the board in history.
+type activityRow struct {
+	block *model.Block
+	board *model.Board
+}
+
+func (r activityRow) updateAt() int64 {
+	if r.board != nil {
+		return r.board.UpdateAt
+	}
+	return r.block.UpdateAt
+}
+
+// activityBuilder turns the history of a board, or of one of its cards, into activity events.
+type activityBuilder struct {
+	ctx        context.Context
+	app        *App
+	board      *model.Board
+	cardID     string
+	schema     model.PropSchema
+	opts       model.QueryActivityOptions
+	eventTypes map[model.ActivityEventType]bool
+	usernames  map[string]string
+	cardTitles map[string]string
+}
+
+// getActivity walks the history newest first, in batches, until a page of events is built. The
+// cursor of the next page is the update time of the last version read: the versions of a same
+// time are always read together, so none is skipped or repeated across pages.
+func (a *App) getActivity(ctx context.Context, board *model.Board, cardID string, opts model.QueryActivityOptions) (*model.ActivityFeed, error) {
+	if err := opts.IsValid(); err != nil {
+		return nil, err
+	}
+
+	var before int64
+	if opts.Cursor != "" {
+		cursor, err := strconv.ParseInt(opts.Cursor, 10, 64)
+		if err != nil || cursor <= 0 {
+			return nil, model.NewErrBadRequest("invalid activity cursor")
+		}
+		before = cursor
+	}
+
+	perPage := opts.PerPage
+	if perPage == 0 {
+		perPage = activityDefaultPerPage
+	}
+
+	schema, err := model.ParsePropertySchema(board)
+	if err != nil {
+		return nil, fmt.Errorf("could not parse property schema for board %s: %w", board.ID, err)
+	}
+
+	b := &activityBuilder{
+		ctx:        ctx,
+		app:        a,
+		board:      board,
+		cardID:     cardID,
+		schema:     schema,
+		opts:       opts,
+		eventTypes: map[model.ActivityEventType]bool{},
+		usernames:  map[string]string{},
+		cardTitles: map[string]string{},
+	}
+	for _, eventType := range opts.EventTypes {
+		b.eventTypes[eventType] = true
+	}
+
+	feed := &model.ActivityFeed{Events: []*model.ActivityEvent{}}
+	batchSize := activityHistoryBatchSize
+	for {
+		rows, horizon, err := b.getHistory(before, batchSize)
+		if err != nil {
+			return nil, err
+		}
+		if len(rows) == 0 {
+			return feed, nil
+		}
+
+		read := 0
+		for read < len(rows) {
+			updateAt := rows[read].updateAt()
+			// older versions may be missing from the batch.
+			if horizon != 0 && updateAt <= horizon {
+				break
+			}
+
+			for ; read < len(rows) && rows[read].updateAt() == updateAt; read++ {
+				events, err := b.eventsForRow(rows[read])
+				if err != nil {
+					return nil, err
+				}
+				feed.Events = append(feed.Events, events...)
+			}
+			before = updateAt
+
+			if len(feed.Events) >= perPage {
+				feed.NextCursor = strconv.FormatInt(updateAt, 10)
+				return feed, nil
+			}
+		}
+
+		if horizon == 0 {
+			return feed, nil
+		}
+		if read == 0 {
+			// every version of the batch has the same update time.
+			batchSize *= 2
+		}
+	}
+}
+
+// getHistory returns the versions updated before a time, newest first, and the update time at
+// or before which versions may be missing because a batch was full, or 0 if none is.
+func (b *activityBuilder) getHistory(before int64, batchSize int) ([]activityRow, int64, error) {
+	var horizon int64
+
+	blocks, err := b.app.store.GetBlockHistoryDescendants(b.ctx, b.board.ID, model.QueryBlockHistoryOptions{
+		BeforeUpdateAt: before,
+		Limit:          uint64(batchSize),
+		Descending:     true,
+	})
+	if err != nil {
+		return nil, 0, fmt.Errorf("could not get block history for board %s: %w", b.board.ID, err)
+	}
+
+	rows := make([]activityRow, 0, len(blocks))
+	for _, block := range blocks {
+		if len(blocks) == batchSize && (horizon == 0 || block.UpdateAt < horizon) {
+			horizon = block.UpdateAt
+		}
+		if b.cardID == "" || block.ID == b.cardID || block.ParentID == b.cardID {
+			rows = append(rows, activityRow{block: block})
+		}
+	}
+
+	if b.cardID == "" {
+		boards, err := b.app.store.GetBoardHistory(b.ctx, b.board.ID, model.QueryBoardHistoryOptions{
+			BeforeUpdateAt: before,
+			Limit:          uint64(batchSize),
+			Descending:     true,
+		})
+		if err != nil {
+			return nil, 0, fmt.Errorf("could not get history of board %s: %w", b.board.ID, err)
+		}
+
+		var boardsHorizon int64
+		for _, board := range boards {
+			if len(boards) == batchSize && (boardsHorizon == 0 || board.UpdateAt < boardsHorizon) {
+				boardsHorizon = board.UpdateAt
+			}
+			rows = append(rows, activityRow{board: board})
+		}
+		horizon = max(horizon, boardsHorizon)
+	}
+
+	sort.SliceStable(rows, func(i, j int) bool {
+		return rows[i].updateAt() > rows[j].updateAt()
+	})
+	return rows, horizon, nil
+}
+
+func (b *activityBuilder) eventsForRow(row activityRow) ([]*model.ActivityEvent, error) {
+	var events []*model.ActivityEvent
+	var err error
+	if row.board != nil {
+		events, err = b.boardEvents(row.board)
+	} else {
+		events, err = b.blockEvents(row.block)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	filtered := events[:0]
+	for _, event := range events {
+		if b.opts.UserID != "" && event.UserID != b.opts.UserID {
+			continue
+		}
+		if len(b.eventTypes) != 0 && !b.eventTypes[event.Type] {
+			continue
+		}
+		filtered = append(filtered, event)
+	}
+	return filtered, nil
+}
+
+func (b *activityBuilder) boardEvents(board *model.Board) ([]*model.ActivityEvent, error) {
+	history, err := b.app.store.GetBoardHistory(b.ctx, board.ID, model.QueryBoardHistoryOptions{
+		BeforeUpdateAt: board.UpdateAt,
+		Limit:          1,
+		Descending:     true,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("could not get history of board %s: %w", board.ID, err)
+	}
+	var previous *model.Board
+	if len(history) != 0 {
+		previous = history[0]
+	}
+
+	user := b.username(board.ModifiedBy)
+	event := &model.ActivityEvent{
+		ID:       fmt.Sprintf("%s-%d", board.ID, board.UpdateAt),
+		BoardID:  board.ID,
+		UserID:   board.ModifiedBy,
+		Title:    board.Title,
+		CreateAt: board.UpdateAt,
+	}
+
+	switch {
+	case board.DeleteAt != 0:
+		if previous != nil && previous.DeleteAt != 0 {
+			return nil, nil
+		}
+		event.Type = model.ActivityBoardDeleted
+		event.Message = fmt.Sprintf("%s deleted board %s", user, titleOrUntitled(board.Title))
+	case previous == nil || previous.DeleteAt != 0:
+		event.Type = model.ActivityBoardCreated
+		event.Message = fmt.Sprintf("%s created board %s", user, titleOrUntitled(board.Title))
+	case previous.Title != board.Title:
+		event.Type = model.ActivityBoardRenamed
+		event.OldValue = previous.Title
+		event.NewValue = board.Title
+		event.Message = fmt.Sprintf("%s renamed board %s to %s", user, titleOrUntitled(previous.Title), titleOrUntitled(board.Title))
+	default:
+		return nil, nil
+	}
+	return []*model.ActivityEvent{event}, nil
+}
+
+func (b *activityBuilder) blockEvents(block *model.Block) ([]*model.ActivityEvent, error) {
+	// only cards and their contents have activity.
+	if block.Type != model.TypeCard && (block.ParentID == "" || block.ParentID == block.BoardID) {
+		return nil, nil
+	}
+
+	history, err := b.app.store.GetBlockHistory(b.ctx, block.ID, model.QueryBlockHistoryOptions{
+		BeforeUpdateAt: block.UpdateAt,
+		Limit:          1,
+		Descending:     true,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("could not get history of block %s: %w", block.ID, err)
+	}
+	var previous *model.Block
+	if len(history) != 0 {
+		previous = history[0]
+	}
+	if block.DeleteAt != 0 && previous != nil && previous.DeleteAt != 0 {
+		return nil, nil
+	}
+	isNew := previous == nil || previous.DeleteAt != 0
+
+	user := b.username(block.ModifiedBy)
+	newEvent := func(eventType model.ActivityEventType, message string) *model.ActivityEvent {
+		event := &model.ActivityEvent{
+			Type:      eventType,
+			BoardID:   block.BoardID,
+			CardID:    block.ParentID,
+			BlockID:   block.ID,
+			BlockType: block.Type,
+			UserID:    block.ModifiedBy,
+			Message:   message,
+			CreateAt:  block.UpdateAt,
+		}
+		if block.Type == model.TypeCard {
+			event.CardID = block.ID
+			event.BlockID = ""
+			event.Title = block.Title
+		} else {
+			event.Title = b.cardTitle(block.ParentID)
+		}
+		return event
+	}
+
+	var events []*model.ActivityEvent
+	switch block.Type {
+	case model.TypeCard:
+		card := titleOrUntitled(block.Title)
+		switch {
+		case block.DeleteAt != 0:
+			events = append(events, newEvent(model.ActivityCardDeleted, fmt.Sprintf("%s deleted card %s", user, card)))
+		case isNew:
+			events = append(events, newEvent(model.ActivityCardCreated, fmt.Sprintf("%s created card %s", user, card)))
+		default:
+			if previous.Title != block.Title {
+				event := newEvent(model.ActivityCardRenamed,
+					fmt.Sprintf("%s renamed card %s to %s", user, titleOrUntitled(previous.Title), card))
+				event.OldValue = previous.Title
+				event.NewValue = block.Title
+				events = append(events, event)
+			}
+			for _, propDiff := range notifysubscriptions.GeneratePropDiffs(previous, block, b.schema, diffStore{ctx: b.ctx, store: b.app.store}, b.app.logger) {
+				event := newEvent(model.ActivityCardPropertyChanged, b.propertyMessage(user, card, propDiff))
+				event.PropertyID = propDiff.ID
+				event.PropertyName = propDiff.Name
+				event.OldValue = propDiff.OldValue
+				event.NewValue = propDiff.NewValue
+				event.AddedUserIDs = propDiff.AddedUserIDs
+				event.RemovedUserIDs = propDiff.RemovedUserIDs
+				events = append(events, event)
+			}
+		}
+	case model.TypeComment:
+		card := titleOrUntitled(b.cardTitle(block.ParentID))
+		switch {
+		case block.DeleteAt != 0:
+			events = append(events, newEvent(model.ActivityCommentDeleted, fmt.Sprintf("%s deleted a comment on %s", user, card)))
+		case isNew:
+			events = append(events, newEvent(model.ActivityCommentAdded, fmt.Sprintf("%s commented on %s", user, card)))
+		case previous.Title != block.Title:
+			events = append(events, newEvent(model.ActivityCommentEdited, fmt.Sprintf("%s edited a comment on %s", user, card)))
+		}
+	default:
+		card := titleOrUntitled(b.cardTitle(block.ParentID))
+		switch {
+		case block.DeleteAt != 0:
+			events = append(events, newEvent(model.ActivityContentDeleted, fmt.Sprintf("%s removed %s from %s", user, block.Type, card)))
+		case isNew:
+			events = append(events, newEvent(model.ActivityContentAdded, fmt.Sprintf("%s added %s to %s", user, block.Type, card)))
+		case previous.Title != block.Title || !reflect.DeepEqual(previous.Fields, block.Fields):
+			events = append(events, newEvent(model.ActivityContentChanged, fmt.Sprintf("%s changed %s on %s", user, block.Type, card)))
+		}
+	}
+
+	for i, event := range events {
+		event.ID = fmt.Sprintf("%s-%d-%d", block.ID, block.UpdateAt, i)
+	}
+	return events, nil
+}
+
+func (b *activityBuilder) propertyMessage(user, card string, propDiff notifysubscriptions.PropDiff) string {
+	if propDef, ok := b.schema[propDiff.ID]; ok && propDef.Type == "select" && propDiff.OldValue != "" && propDiff.NewValue != "" {
+		return fmt.Sprintf("%s moved %s from %s to %s", user, card, propDiff.OldValue, propDiff.NewValue)
+	}
+	switch {
+	case propDiff.OldValue == "":
+		return fmt.Sprintf("%s set %s of %s to %s", user, propDiff.Name, card, propDiff.NewValue)
+	case propDiff.NewValue == "":
+		return fmt.Sprintf("%s cleared %s of %s", user, propDiff.Name, card)
+	default:
+		return fmt.Sprintf("%s changed %s of %s from %s to %s", user, propDiff.Name, card, propDiff.OldValue, propDiff.NewValue)
+	}
+}
+
+func (b *activityBuilder) username(userID string) string {
+	if username, ok := b.usernames[userID]; ok {
+		return username
+	}
+	username := activityUnknownUser
+	if user, err := b.app.store.GetUserByID(b.ctx, userID); err == nil && user != nil {
+		username = user.Username
+	}
+	b.usernames[userID] = username
+	return username
+}
+
+// cardTitle returns the title of a card, or of its last version if it was deleted.
+func (b *activityBuilder) cardTitle(cardID string) string {
+	if title, ok := b.cardTitles[cardID]; ok {
+		return title
+	}
+	var title string
+	if card, err := b.app.store.GetBlock(b.ctx, cardID); err == nil {
+		title = card.Title
+	} else if history, err := b.app.store.GetBlockHistory(b.ctx, cardID, model.QueryBlockHistoryOptions{Limit: 1, Descending: true}); err == nil && len(history) != 0 {
+		title = history[0].Title
+	}
+	b.cardTitles[cardID] = title
+	return title
+}
+
+func titleOrUntitled(title string) string {
+	if title == "" {
+		return activityUntitled
+	}
+	return title
+}
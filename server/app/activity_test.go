@@ -0,0 +1,186 @@
+// Copyright (c) 2020-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package app
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mattermost/mattermost-plugin-boards/server/model"
+)
+
+// setupActivityHistory makes the store return a board created by alice, with a card alice created
+// and moved from Todo to Doing, on which bob commented.
+func setupActivityHistory(th *TestHelper) {
+	board := &model.Board{
+		ID:         testBoardID,
+		Title:      "Roadmap",
+		ModifiedBy: "alice",
+		UpdateAt:   100,
+		CardProperties: []map[string]interface{}{{
+			"id":   "status",
+			"name": "Status",
+			"type": "select",
+			"options": []interface{}{
+				map[string]interface{}{"id": "todo", "value": "Todo"},
+				map[string]interface{}{"id": "doing", "value": "Doing"},
+			},
+		}},
+	}
+	card := func(updateAt int64, status string) *model.Block {
+		return &model.Block{
+			ID:         "card1",
+			BoardID:    testBoardID,
+			ParentID:   testBoardID,
+			Type:       model.TypeCard,
+			Title:      "Launch",
+			ModifiedBy: "alice",
+			UpdateAt:   updateAt,
+			Fields:     map[string]interface{}{"properties": map[string]interface{}{"status": status}},
+		}
+	}
+	blocks := []*model.Block{
+		{
+			ID:         "comment1",
+			BoardID:    testBoardID,
+			ParentID:   "card1",
+			Type:       model.TypeComment,
+			Title:      "ship it",
+			ModifiedBy: "bob",
+			UpdateAt:   400,
+		},
+		card(300, "doing"),
+		card(200, "todo"),
+		{
+			ID:         "view1",
+			BoardID:    testBoardID,
+			ParentID:   testBoardID,
+			Type:       model.TypeView,
+			ModifiedBy: "alice",
+			UpdateAt:   150,
+		},
+	}
+
+	before := func(updateAt int64, limit uint64) []*model.Block {
+		result := []*model.Block{}
+		for _, block := range blocks {
+			if (updateAt == 0 || block.UpdateAt < updateAt) && (limit == 0 || uint64(len(result)) < limit) {
+				result = append(result, block)
+			}
+		}
+		return result
+	}
+
+	th.Store.EXPECT().GetBoard(gomock.Any(), testBoardID).Return(board, nil).AnyTimes()
+	th.Store.EXPECT().GetBoardHistory(gomock.Any(), testBoardID, gomock.Any()).DoAndReturn(
+		func(_ context.Context, _ string, opts model.QueryBoardHistoryOptions) ([]*model.Board, error) {
+			if opts.BeforeUpdateAt != 0 && opts.BeforeUpdateAt <= board.UpdateAt {
+				return []*model.Board{}, nil
+			}
+			return []*model.Board{board}, nil
+		}).AnyTimes()
+	th.Store.EXPECT().GetBlockHistoryDescendants(gomock.Any(), testBoardID, gomock.Any()).DoAndReturn(
+		func(_ context.Context, _ string, opts model.QueryBlockHistoryOptions) ([]*model.Block, error) {
+			return before(opts.BeforeUpdateAt, opts.Limit), nil
+		}).AnyTimes()
+	th.Store.EXPECT().GetBlockHistory(gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, blockID string, opts model.QueryBlockHistoryOptions) ([]*model.Block, error) {
+			result := []*model.Block{}
+			for _, block := range before(opts.BeforeUpdateAt, 0) {
+				if block.ID == blockID && (opts.Limit == 0 || uint64(len(result)) < opts.Limit) {
+					result = append(result, block)
+				}
+			}
+			return result, nil
+		}).AnyTimes()
+	th.Store.EXPECT().GetBlock(gomock.Any(), "card1").Return(card(300, "doing"), nil).AnyTimes()
+	th.Store.EXPECT().GetUserByID(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, userID string) (*model.User, error) {
+		return &model.User{ID: userID, Username: userID}, nil
+	}).AnyTimes()
+}
+
+func eventMessages(events []*model.ActivityEvent) []string {
+	messages := make([]string, 0, len(events))
+	for _, event := range events {
+		messages = append(messages, event.Message)
+	}
+	return messages
+}
+
+func TestGetBoardActivity(t *testing.T) {
+	th, tearDown := SetupTestHelper(t)
+	defer tearDown()
+	setupActivityHistory(th)
+
+	t.Run("all events", func(t *testing.T) {
+		feed, err := th.App.GetBoardActivity(context.Background(), testBoardID, model.QueryActivityOptions{})
+		require.NoError(t, err)
+		assert.Empty(t, feed.NextCursor)
+		assert.Equal(t, []string{
+			"bob commented on Launch",
+			"alice moved Launch from TODO to DOING",
+			"alice created card Launch",
+			"alice created board Roadmap",
+		}, eventMessages(feed.Events))
+
+		moved := feed.Events[1]
+		assert.Equal(t, model.ActivityCardPropertyChanged, moved.Type)
+		assert.Equal(t, "card1", moved.CardID)
+		assert.Equal(t, "status", moved.PropertyID)
+		assert.Equal(t, "TODO", moved.OldValue)
+		assert.Equal(t, "DOING", moved.NewValue)
+		assert.EqualValues(t, 300, moved.CreateAt)
+	})
+
+	t.Run("pagination", func(t *testing.T) {
+		feed, err := th.App.GetBoardActivity(context.Background(), testBoardID, model.QueryActivityOptions{PerPage: 2})
+		require.NoError(t, err)
+		require.Len(t, feed.Events, 2)
+		assert.Equal(t, "300", feed.NextCursor)
+
+		feed, err = th.App.GetBoardActivity(context.Background(), testBoardID, model.QueryActivityOptions{PerPage: 2, Cursor: feed.NextCursor})
+		require.NoError(t, err)
+		assert.Equal(t, []string{"alice created card Launch", "alice created board Roadmap"}, eventMessages(feed.Events))
+	})
+
+	t.Run("filters", func(t *testing.T) {
+		feed, err := th.App.GetBoardActivity(context.Background(), testBoardID, model.QueryActivityOptions{UserID: "bob"})
+		require.NoError(t, err)
+		assert.Equal(t, []string{"bob commented on Launch"}, eventMessages(feed.Events))
+
+		feed, err = th.App.GetBoardActivity(context.Background(), testBoardID, model.QueryActivityOptions{
+			EventTypes: []model.ActivityEventType{model.ActivityCardCreated, model.ActivityBoardCreated},
+		})
+		require.NoError(t, err)
+		assert.Equal(t, []string{"alice created card Launch", "alice created board Roadmap"}, eventMessages(feed.Events))
+	})
+
+	t.Run("invalid options", func(t *testing.T) {
+		_, err := th.App.GetBoardActivity(context.Background(), testBoardID, model.QueryActivityOptions{Cursor: "yesterday"})
+		assert.True(t, model.IsErrBadRequest(err))
+
+		_, err = th.App.GetBoardActivity(context.Background(), testBoardID, model.QueryActivityOptions{EventTypes: []model.ActivityEventType{"card_moved"}})
+		assert.True(t, model.IsErrBadRequest(err))
+	})
+}
+
+func TestGetCardActivity(t *testing.T) {
+	th, tearDown := SetupTestHelper(t)
+	defer tearDown()
+	setupActivityHistory(th)
+
+	feed, err := th.App.GetCardActivity(context.Background(), "card1", model.QueryActivityOptions{})
+	require.NoError(t, err)
+	assert.Equal(t, []string{
+		"bob commented on Launch",
+		"alice moved Launch from TODO to DOING",
+		"alice created card Launch",
+	}, eventMessages(feed.Events))
+	assert.Equal(t, "comment1", feed.Events[0].BlockID)
+	assert.Equal(t, "card1", feed.Events[0].CardID)
+}
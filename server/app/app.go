@@ -4,12 +4,14 @@
 package app
 
 import (
+	"context"
 	"io"
 	"sync"
 	"time"
 
 	"github.com/mattermost/mattermost-plugin-boards/server/auth"
 	"github.com/mattermost/mattermost-plugin-boards/server/services/config"
+	"github.com/mattermost/mattermost-plugin-boards/server/services/filescanner"
 	"github.com/mattermost/mattermost-plugin-boards/server/services/metrics"
 	"github.com/mattermost/mattermost-plugin-boards/server/services/notify"
 	"github.com/mattermost/mattermost-plugin-boards/server/services/permissions"
@@ -27,6 +29,7 @@ const (
 	blockChangeNotifierQueueSize       = 1000
 	blockChangeNotifierPoolSize        = 10
 	blockChangeNotifierShutdownTimeout = time.Second * 10
+	formSubmissionsWindow              = time.Hour
 )
 
 type servicesAPI interface {
@@ -42,12 +45,16 @@ type fileBackend interface {
 	MoveFile(oldPath, newPath string) error
 	WriteFile(fr io.Reader, path string) (int64, error)
 	RemoveFile(path string) error
+	FileSize(path string) (int64, error)
+	FileModTime(path string) (time.Time, error)
+	ListDirectory(path string) ([]string, error)
 }
 
 type Services struct {
 	Auth             *auth.Auth
 	Store            store.Store
 	FilesBackend     fileBackend
+	FileScanner      filescanner.Scanner
 	Webhook          *webhook.Client
 	Metrics          *metrics.Metrics
 	Notifications    *notify.Service
@@ -55,6 +62,7 @@ type Services struct {
 	Permissions      permissions.PermissionsService
 	SkipTemplateInit bool
 	ServicesAPI      servicesAPI
+	NewMutexFn       MutexFactory
 }
 
 type App struct {
@@ -63,6 +71,7 @@ type App struct {
 	auth                *auth.Auth
 	wsAdapter           ws.Adapter
 	filesBackend        fileBackend
+	fileScanner         filescanner.Scanner
 	webhook             *webhook.Client
 	metrics             *metrics.Metrics
 	notifications       *notify.Service
@@ -70,9 +79,18 @@ type App struct {
 	permissions         permissions.PermissionsService
 	blockChangeNotifier *utils.CallbackQueue
 	servicesAPI         servicesAPI
+	formLimiter         *utils.RateLimiter
+	newMutexFn          MutexFactory
+	localMutexes        *utils.KeyedMutex
 
 	cardLimitMux sync.RWMutex
 	cardLimit    int
+
+	fileURLKeyMux sync.Mutex
+	fileURLKey    []byte
+
+	flowMetricsMux   sync.Mutex
+	flowMetricsCache map[string]*flowMetricsCacheEntry
 }
 
 func (a *App) SetConfig(config *config.Configuration) {
@@ -90,6 +108,7 @@ func New(config *config.Configuration, wsAdapter ws.Adapter, services Services)
 		auth:                services.Auth,
 		wsAdapter:           wsAdapter,
 		filesBackend:        services.FilesBackend,
+		fileScanner:         services.FileScanner,
 		webhook:             services.Webhook,
 		metrics:             services.Metrics,
 		notifications:       services.Notifications,
@@ -97,8 +116,14 @@ func New(config *config.Configuration, wsAdapter ws.Adapter, services Services)
 		permissions:         services.Permissions,
 		blockChangeNotifier: utils.NewCallbackQueue("blockChangeNotifier", blockChangeNotifierQueueSize, blockChangeNotifierPoolSize, services.Logger),
 		servicesAPI:         services.ServicesAPI,
+		formLimiter:         utils.NewRateLimiter(formSubmissionsWindow),
+		newMutexFn:          services.NewMutexFn,
+		localMutexes:        utils.NewKeyedMutex(),
 	}
-	app.initialize(services.SkipTemplateInit)
+	if app.fileScanner == nil {
+		app.fileScanner = filescanner.Noop{}
+	}
+	app.initialize(context.Background(), services.SkipTemplateInit)
 	return app
 }
 
@@ -114,6 +139,9 @@ func (a *App) SetCardLimit(cardLimit int) {
 	a.cardLimit = cardLimit
 }
 
-func (a *App) GetLicense() *mm_model.License {
-	return a.store.GetLicense()
+func (a *App) GetLicense(ctx context.Context) *mm_model.License {
+	ctx, span := startSpan(ctx, "GetLicense")
+	defer span.End()
+
+	return a.store.GetLicense(ctx)
 }
@@ -0,0 +1,158 @@
+// Copyright (c) 2020-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package app
+
+import (
+	"context"
+	"fmt"
+	"io"
+
+	"github.com/mattermost/mattermost-plugin-boards/server/model"
+	"github.com/mattermost/mattermost-plugin-boards/server/utils"
+)
+
+// GetAttachmentVersions returns the file versions of an attachment block of a board, oldest first.
+func (a *App) GetAttachmentVersions(ctx context.Context, boardID, blockID string) ([]*model.AttachmentVersion, error) {
+	ctx, span := startSpan(ctx, "GetAttachmentVersions")
+	defer span.End()
+
+	block, err := a.getAttachmentBlock(ctx, boardID, blockID)
+	if err != nil {
+		return nil, err
+	}
+	return model.GetAttachmentVersions(block)
+}
+
+// AddAttachmentVersion stores a file as the new version of an attachment block.
+func (a *App) AddAttachmentVersion(ctx context.Context, boardID, blockID string, reader io.Reader, filename, userID string) (*model.AttachmentVersion, error) {
+	ctx, span := startSpan(ctx, "AddAttachmentVersion")
+	defer span.End()
+
+	block, err := a.getAttachmentBlock(ctx, boardID, blockID)
+	if err != nil {
+		return nil, err
+	}
+	versions, err := model.GetAttachmentVersions(block)
+	if err != nil {
+		return nil, err
+	}
+
+	board, err := a.GetBoard(ctx, boardID)
+	if err != nil {
+		return nil, err
+	}
+	fileID, err := a.SaveFile(ctx, reader, board.TeamID, boardID, filename, board.IsTemplate)
+	if err != nil {
+		return nil, err
+	}
+
+	version := &model.AttachmentVersion{
+		Version:    nextAttachmentVersion(versions),
+		FileID:     fileID,
+		Name:       filename,
+		UploadedBy: userID,
+		UploadedAt: utils.GetMillis(),
+	}
+	return a.setAttachmentVersion(ctx, block, append(versions, version), userID)
+}
+
+// RestoreAttachmentVersion makes an earlier version of an attachment block current again, by
+// adding a version with its file.
+func (a *App) RestoreAttachmentVersion(ctx context.Context, boardID, blockID string, versionNumber int, userID string) (*model.AttachmentVersion, error) {
+	ctx, span := startSpan(ctx, "RestoreAttachmentVersion")
+	defer span.End()
+
+	block, err := a.getAttachmentBlock(ctx, boardID, blockID)
+	if err != nil {
+		return nil, err
+	}
+	versions, err := model.GetAttachmentVersions(block)
+	if err != nil {
+		return nil, err
+	}
+
+	var restored *model.AttachmentVersion
+	for _, version := range versions {
+		if version.Version == versionNumber {
+			restored = version
+			break
+		}
+	}
+	if restored == nil {
+		return nil, model.NewErrNotFound(fmt.Sprintf("version %d of attachment %s", versionNumber, blockID))
+	}
+
+	version := &model.AttachmentVersion{
+		Version:      nextAttachmentVersion(versions),
+		FileID:       restored.FileID,
+		Name:         restored.Name,
+		UploadedBy:   userID,
+		UploadedAt:   utils.GetMillis(),
+		RestoredFrom: restored.Version,
+	}
+	return a.setAttachmentVersion(ctx, block, append(versions, version), userID)
+}
+
+// setAttachmentVersion records the versions of an attachment block and makes the last one its
+// current file. Returns the last version.
+func (a *App) setAttachmentVersion(ctx context.Context, block *model.Block, versions []*model.AttachmentVersion, userID string) (*model.AttachmentVersion, error) {
+	current := versions[len(versions)-1]
+	patch := &model.BlockPatch{
+		UpdatedFields: map[string]interface{}{
+			model.BlockFieldFileId:   current.FileID,
+			model.BlockFieldVersions: model.AttachmentVersionsField(versions),
+		},
+		DeletedFields: []string{model.BlockFieldAttachmentId},
+	}
+	if current.Name != "" {
+		patch.Title = &current.Name
+	}
+	if _, err := a.PatchBlock(ctx, block.ID, patch, userID); err != nil {
+		return nil, err
+	}
+	return current, nil
+}
+
+func (a *App) getAttachmentBlock(ctx context.Context, boardID, blockID string) (*model.Block, error) {
+	block, err := a.GetBlockByID(ctx, blockID)
+	if err != nil {
+		return nil, err
+	}
+	if block.BoardID != boardID || block.DeleteAt != 0 {
+		return nil, model.NewErrNotFound("block ID=" + blockID)
+	}
+	if block.Type != model.TypeAttachment {
+		return nil, model.NewErrBadRequest(fmt.Sprintf("block %s is not an attachment", blockID))
+	}
+	return block, nil
+}
+
+func nextAttachmentVersion(versions []*model.AttachmentVersion) int {
+	next := 1
+	for _, version := range versions {
+		if version.Version >= next {
+			next = version.Version + 1
+		}
+	}
+	return next
+}
+
+// remapAttachmentVersionFiles returns the versions field of an attachment block with the files
+// of its versions renamed by fileNames, for blocks whose files were copied. Files missing from
+// fileNames are kept. Returns false if the block records no versions.
+func remapAttachmentVersionFiles(block *model.Block, fileNames map[string]string) ([]interface{}, bool) {
+	if _, ok := block.Fields[model.BlockFieldVersions]; !ok {
+		return nil, false
+	}
+	versions, err := model.GetAttachmentVersions(block)
+	if err != nil {
+		return nil, false
+	}
+	for _, version := range versions {
+		if newFileName, ok := fileNames[version.FileID]; ok && newFileName != "" {
+			version.FileID = newFileName
+		}
+	}
+	return model.AttachmentVersionsField(versions), true
+}
@@ -0,0 +1,127 @@
+// Copyright (c) 2020-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package app
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mattermost/mattermost-plugin-boards/server/model"
+	"github.com/mattermost/mattermost-plugin-boards/server/utils"
+)
+
+func newTestAttachmentBlock(fields map[string]interface{}) *model.Block {
+	return &model.Block{
+		ID:        utils.NewID(utils.IDTypeBlock),
+		BoardID:   testBoardID,
+		Type:      model.TypeAttachment,
+		Title:     "report.pdf",
+		CreatedBy: "user-1",
+		CreateAt:  1000,
+		Fields:    fields,
+	}
+}
+
+func TestGetAttachmentVersions(t *testing.T) {
+	th, tearDown := SetupTestHelper(t)
+	defer tearDown()
+
+	fileID := "7" + utils.NewID(utils.IDTypeNone) + ".pdf"
+
+	t.Run("attachment without recorded versions", func(t *testing.T) {
+		block := newTestAttachmentBlock(map[string]interface{}{model.BlockFieldAttachmentId: fileID})
+		th.Store.EXPECT().GetBlock(gomock.Any(), block.ID).Return(block, nil)
+
+		versions, err := th.App.GetAttachmentVersions(context.Background(), testBoardID, block.ID)
+		require.NoError(t, err)
+		require.Len(t, versions, 1)
+		assert.Equal(t, 1, versions[0].Version)
+		assert.Equal(t, fileID, versions[0].FileID)
+		assert.Equal(t, "user-1", versions[0].UploadedBy)
+		assert.EqualValues(t, 1000, versions[0].UploadedAt)
+	})
+
+	t.Run("block of another board", func(t *testing.T) {
+		block := newTestAttachmentBlock(map[string]interface{}{model.BlockFieldFileId: fileID})
+		block.BoardID = utils.NewID(utils.IDTypeBoard)
+		th.Store.EXPECT().GetBlock(gomock.Any(), block.ID).Return(block, nil)
+
+		_, err := th.App.GetAttachmentVersions(context.Background(), testBoardID, block.ID)
+		assert.True(t, model.IsErrNotFound(err))
+	})
+
+	t.Run("block that is not an attachment", func(t *testing.T) {
+		block := newTestAttachmentBlock(map[string]interface{}{model.BlockFieldFileId: fileID})
+		block.Type = model.TypeImage
+		th.Store.EXPECT().GetBlock(gomock.Any(), block.ID).Return(block, nil)
+
+		_, err := th.App.GetAttachmentVersions(context.Background(), testBoardID, block.ID)
+		assert.True(t, model.IsErrBadRequest(err))
+	})
+}
+
+func TestAddAndRestoreAttachmentVersion(t *testing.T) {
+	th, tearDown := SetupTestHelper(t)
+	defer tearDown()
+
+	teamID := "abcdefghijklmnopqrstuvwxyz"
+	board := &model.Board{ID: testBoardID, TeamID: teamID}
+	expectUnlimitedStorage(th)
+	th.App.filesBackend = newMemoryFileBackend()
+
+	firstFileID := "7" + utils.NewID(utils.IDTypeNone) + ".pdf"
+	block := newTestAttachmentBlock(map[string]interface{}{model.BlockFieldAttachmentId: firstFileID})
+
+	th.Store.EXPECT().GetBlock(gomock.Any(), block.ID).DoAndReturn(func(context.Context, string) (*model.Block, error) {
+		return block, nil
+	}).AnyTimes()
+	th.Store.EXPECT().GetBoard(gomock.Any(), testBoardID).Return(board, nil).AnyTimes()
+	th.Store.EXPECT().GetMembersForBoard(gomock.Any(), testBoardID).Return([]*model.BoardMember{}, nil).AnyTimes()
+	th.Store.EXPECT().SaveFileInfo(gomock.Any(), gomock.Any()).Return(nil)
+	th.Store.EXPECT().PatchBlock(gomock.Any(), block.ID, gomock.Any(), "user-2").DoAndReturn(func(_ context.Context, _ string, patch *model.BlockPatch, _ string) error {
+		block = patch.Patch(block)
+		return nil
+	}).Times(2)
+
+	version, err := th.App.AddAttachmentVersion(context.Background(), testBoardID, block.ID, bytes.NewReader([]byte("v2")), "report v2.pdf", "user-2")
+	require.NoError(t, err)
+	assert.Equal(t, 2, version.Version)
+	assert.Equal(t, "user-2", version.UploadedBy)
+	assert.Equal(t, version.FileID, block.Fields[model.BlockFieldFileId])
+	assert.NotContains(t, block.Fields, model.BlockFieldAttachmentId)
+	assert.Equal(t, "report v2.pdf", block.Title)
+	assert.Equal(t, []string{firstFileID, version.FileID}, model.GetAttachmentVersionFileIDs(block))
+
+	restored, err := th.App.RestoreAttachmentVersion(context.Background(), testBoardID, block.ID, 1, "user-2")
+	require.NoError(t, err)
+	assert.Equal(t, 3, restored.Version)
+	assert.Equal(t, 1, restored.RestoredFrom)
+	assert.Equal(t, firstFileID, restored.FileID)
+	assert.Equal(t, firstFileID, block.Fields[model.BlockFieldFileId])
+
+	_, err = th.App.RestoreAttachmentVersion(context.Background(), testBoardID, block.ID, 7, "user-2")
+	assert.True(t, model.IsErrNotFound(err))
+}
+
+func TestRemapAttachmentVersionFiles(t *testing.T) {
+	block := newTestAttachmentBlock(map[string]interface{}{
+		model.BlockFieldVersions: model.AttachmentVersionsField([]*model.AttachmentVersion{
+			{Version: 1, FileID: "7old1.pdf", UploadedBy: "user-1"},
+			{Version: 2, FileID: "7old2.pdf", UploadedBy: "user-1"},
+		}),
+	})
+
+	versions, ok := remapAttachmentVersionFiles(block, map[string]string{"7old1.pdf": "7new1.pdf"})
+	require.True(t, ok)
+	block.Fields[model.BlockFieldVersions] = versions
+	assert.Equal(t, []string{"7new1.pdf", "7old2.pdf"}, model.GetAttachmentVersionFileIDs(block))
+
+	_, ok = remapAttachmentVersionFiles(newTestAttachmentBlock(map[string]interface{}{}), map[string]string{})
+	assert.False(t, ok)
+}
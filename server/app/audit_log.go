@@ -0,0 +1,103 @@
+// Copyright (c) 2020-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package app
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/mattermost/mattermost-plugin-boards/server/model"
+	"github.com/mattermost/mattermost-plugin-boards/server/services/audit"
+	"github.com/mattermost/mattermost-plugin-boards/server/utils"
+
+	"github.com/mattermost/mattermost/server/public/shared/mlog"
+)
+
+// auditStoreSink persists the records of the audit service in the database, so they can be
+// queried later.
+type auditStoreSink struct {
+	app *App
+}
+
+// NewAuditStoreSink returns an audit sink persisting every record with the store.
+func (a *App) NewAuditStoreSink() audit.Sink {
+	return &auditStoreSink{app: a}
+}
+
+func (s *auditStoreSink) WriteRecord(level mlog.Level, rec *audit.Record) {
+	entry := auditEntryFromRecord(level, rec)
+	if err := s.app.store.InsertAuditEntry(context.Background(), entry); err != nil {
+		s.app.logger.Error("Cannot persist audit record", mlog.String("event", rec.Event), mlog.Err(err))
+	}
+}
+
+// auditEntryFromRecord converts an audit record to an entry. The team, board and card of the
+// record are taken from its metadata.
+func auditEntryFromRecord(level mlog.Level, rec *audit.Record) *model.AuditEntry {
+	entry := &model.AuditEntry{
+		ID:        utils.NewID(utils.IDTypeNone),
+		Level:     level.Name,
+		APIPath:   rec.APIPath,
+		Event:     rec.Event,
+		Status:    rec.Status,
+		UserID:    rec.UserID,
+		SessionID: rec.SessionID,
+		Client:    rec.Client,
+		IPAddress: rec.IPAddress,
+		Meta:      map[string]interface{}{},
+		CreateAt:  utils.GetMillis(),
+	}
+
+	for _, meta := range rec.Meta {
+		value, isString := meta.V.(string)
+		switch {
+		case meta.K == audit.KeyTeamID && isString:
+			// records start with an unknown team, which the handlers may set.
+			if entry.TeamID == "" && value != "unknown" {
+				entry.TeamID = value
+			}
+		case meta.K == "teamID" && isString:
+			entry.TeamID = value
+		case meta.K == "boardID" && isString:
+			entry.BoardID = value
+		case meta.K == "cardID" && isString:
+			entry.CardID = value
+		default:
+			entry.Meta[meta.K] = meta.V
+		}
+	}
+	return entry
+}
+
+// GetAuditEntries returns a page of the persisted audit entries matching opts, newest first.
+func (a *App) GetAuditEntries(ctx context.Context, opts model.QueryAuditEntriesOptions) ([]*model.AuditEntry, bool, error) {
+	ctx, span := startSpan(ctx, "GetAuditEntries")
+	defer span.End()
+
+	if !a.config.AuditLogPersisted {
+		return nil, false, model.NewErrNotImplemented("the audit log is not persisted")
+	}
+	return a.store.GetAuditEntries(ctx, opts)
+}
+
+// CleanUpAuditLog removes the persisted audit entries older than the retention period of the
+// configuration, and returns how many were removed.
+func (a *App) CleanUpAuditLog(ctx context.Context) (int64, error) {
+	ctx, span := startSpan(ctx, "CleanUpAuditLog")
+	defer span.End()
+
+	if a.config.AuditLogRetentionDays <= 0 {
+		return 0, nil
+	}
+
+	retention := time.Duration(a.config.AuditLogRetentionDays) * 24 * time.Hour
+	deleted, err := a.store.DeleteAuditEntriesBefore(ctx, time.Now().Add(-retention).UnixMilli())
+	if err != nil {
+		return 0, fmt.Errorf("cannot clean up the audit log: %w", err)
+	}
+
+	a.logger.Debug("Cleaned up the audit log", mlog.Int("deleted_entries", deleted))
+	return deleted, nil
+}
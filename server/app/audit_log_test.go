@@ -0,0 +1,106 @@
+// Copyright (c) 2020-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package app
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mattermost/mattermost-plugin-boards/server/model"
+	"github.com/mattermost/mattermost-plugin-boards/server/services/audit"
+)
+
+func TestAuditStoreSink(t *testing.T) {
+	th, tearDown := SetupTestHelper(t)
+	defer tearDown()
+
+	rec := &audit.Record{
+		APIPath:   "/api/v2/boards/board-id/blocks/card-id",
+		Event:     "patchBlocks",
+		Status:    audit.Fail,
+		UserID:    "user-id",
+		IPAddress: "127.0.0.1",
+		Meta:      []audit.Meta{{K: audit.KeyTeamID, V: "unknown"}},
+	}
+	rec.AddMeta("boardID", "board-id")
+	rec.AddMeta("cardID", "card-id")
+	rec.AddMeta("blocksCount", 2)
+	rec.Success()
+
+	var entry *model.AuditEntry
+	th.Store.EXPECT().InsertAuditEntry(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, e *model.AuditEntry) error {
+		entry = e
+		return nil
+	})
+
+	th.App.NewAuditStoreSink().WriteRecord(audit.LevelModify, rec)
+
+	require.NotNil(t, entry)
+	assert.NotEmpty(t, entry.ID)
+	assert.Equal(t, "mod", entry.Level)
+	assert.Equal(t, "patchBlocks", entry.Event)
+	assert.Equal(t, audit.Success, entry.Status)
+	assert.Equal(t, "user-id", entry.UserID)
+	assert.Empty(t, entry.TeamID)
+	assert.Equal(t, "board-id", entry.BoardID)
+	assert.Equal(t, "card-id", entry.CardID)
+	assert.Equal(t, map[string]interface{}{"blocksCount": 2}, entry.Meta)
+	assert.NotZero(t, entry.CreateAt)
+}
+
+func TestGetAuditEntries(t *testing.T) {
+	th, tearDown := SetupTestHelper(t)
+	defer tearDown()
+
+	opts := model.QueryAuditEntriesOptions{BoardID: testBoardID, PerPage: 10}
+
+	t.Run("audit log not persisted", func(t *testing.T) {
+		_, _, err := th.App.GetAuditEntries(context.Background(), opts)
+		assert.True(t, model.IsErrNotImplemented(err))
+	})
+
+	t.Run("audit log persisted", func(t *testing.T) {
+		th.App.config.AuditLogPersisted = true
+		defer func() { th.App.config.AuditLogPersisted = false }()
+
+		entries := []*model.AuditEntry{{ID: "entry-id", BoardID: testBoardID}}
+		th.Store.EXPECT().GetAuditEntries(gomock.Any(), opts).Return(entries, true, nil)
+
+		result, hasNext, err := th.App.GetAuditEntries(context.Background(), opts)
+		require.NoError(t, err)
+		assert.True(t, hasNext)
+		assert.Equal(t, entries, result)
+	})
+}
+
+func TestCleanUpAuditLog(t *testing.T) {
+	th, tearDown := SetupTestHelper(t)
+	defer tearDown()
+
+	t.Run("audit log kept forever", func(t *testing.T) {
+		deleted, err := th.App.CleanUpAuditLog(context.Background())
+		require.NoError(t, err)
+		assert.Zero(t, deleted)
+	})
+
+	t.Run("entries older than the retention period are deleted", func(t *testing.T) {
+		th.App.config.AuditLogRetentionDays = 30
+		defer func() { th.App.config.AuditLogRetentionDays = 0 }()
+
+		expectedCutoff := time.Now().Add(-30 * 24 * time.Hour).UnixMilli()
+		th.Store.EXPECT().DeleteAuditEntriesBefore(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, before int64) (int64, error) {
+			assert.InDelta(t, expectedCutoff, before, float64(time.Minute.Milliseconds()))
+			return 3, nil
+		})
+
+		deleted, err := th.App.CleanUpAuditLog(context.Background())
+		require.NoError(t, err)
+		assert.EqualValues(t, 3, deleted)
+	})
+}
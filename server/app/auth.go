@@ -4,6 +4,8 @@
 package app
 
 import (
+	"context"
+
 	"github.com/mattermost/mattermost-plugin-boards/server/model"
 
 	"github.com/pkg/errors"
@@ -17,53 +19,82 @@ const (
 	SecondsPerMinute = 60
 )
 
-// IsValidReadToken validates the read token for a block.
-func (a *App) IsValidReadToken(boardID string, readToken string) (bool, error) {
-	return a.auth.IsValidReadToken(boardID, readToken)
+// IsValidReadToken validates the read token for a board and the access requested with it.
+func (a *App) IsValidReadToken(ctx context.Context, boardID string, readToken string, access model.ReadTokenAccess) (bool, error) {
+	ctx, span := startSpan(ctx, "IsValidReadToken")
+	defer span.End()
+
+	return a.auth.IsValidReadToken(ctx, boardID, readToken, access)
+}
+
+// GetShareLinkForReadToken returns the active share link of a board for a read token, if any.
+func (a *App) GetShareLinkForReadToken(ctx context.Context, boardID string, readToken string, password string) (*model.ShareLink, error) {
+	ctx, span := startSpan(ctx, "GetShareLinkForReadToken")
+	defer span.End()
+
+	return a.auth.GetShareLinkForReadToken(ctx, boardID, readToken, password)
 }
 
 // GetRegisteredUserCount returns the number of registered users.
-func (a *App) GetRegisteredUserCount() (int, error) {
-	return a.store.GetRegisteredUserCount()
+func (a *App) GetRegisteredUserCount(ctx context.Context) (int, error) {
+	ctx, span := startSpan(ctx, "GetRegisteredUserCount")
+	defer span.End()
+
+	return a.store.GetRegisteredUserCount(ctx)
 }
 
 // GetDailyActiveUsers returns the number of daily active users.
-func (a *App) GetDailyActiveUsers() (int, error) {
+func (a *App) GetDailyActiveUsers(ctx context.Context) (int, error) {
+	ctx, span := startSpan(ctx, "GetDailyActiveUsers")
+	defer span.End()
+
 	secondsAgo := int64(SecondsPerMinute * MinutesPerHour * HoursPerDay)
-	return a.store.GetActiveUserCount(secondsAgo)
+	return a.store.GetActiveUserCount(ctx, secondsAgo)
 }
 
 // GetWeeklyActiveUsers returns the number of weekly active users.
-func (a *App) GetWeeklyActiveUsers() (int, error) {
+func (a *App) GetWeeklyActiveUsers(ctx context.Context) (int, error) {
+	ctx, span := startSpan(ctx, "GetWeeklyActiveUsers")
+	defer span.End()
+
 	secondsAgo := int64(SecondsPerMinute * MinutesPerHour * HoursPerDay * DaysPerWeek)
-	return a.store.GetActiveUserCount(secondsAgo)
+	return a.store.GetActiveUserCount(ctx, secondsAgo)
 }
 
 // GetMonthlyActiveUsers returns the number of monthly active users.
-func (a *App) GetMonthlyActiveUsers() (int, error) {
+func (a *App) GetMonthlyActiveUsers(ctx context.Context) (int, error) {
+	ctx, span := startSpan(ctx, "GetMonthlyActiveUsers")
+	defer span.End()
+
 	secondsAgo := int64(SecondsPerMinute * MinutesPerHour * HoursPerDay * DaysPerMonth)
-	return a.store.GetActiveUserCount(secondsAgo)
+	return a.store.GetActiveUserCount(ctx, secondsAgo)
 }
 
 // GetUser gets an existing active user by id.
-func (a *App) GetUser(id string) (*model.User, error) {
+func (a *App) GetUser(ctx context.Context, id string) (*model.User, error) {
+	ctx, span := startSpan(ctx, "GetUser")
+	defer span.End()
+
 	if len(id) < 1 {
 		return nil, errors.New("no user ID")
 	}
 
-	user, err := a.store.GetUserByID(id)
+	user, err := a.store.GetUserByID(ctx, id)
 	if err != nil {
 		return nil, errors.Wrap(err, "unable to find user")
 	}
 	return user, nil
 }
 
-func (a *App) GetUsersList(userIDs []string) ([]*model.User, error) {
+func (a *App) GetUsersList(ctx context.Context, userIDs []string) ([]*model.User, error) {
+	ctx, span := startSpan(ctx, "GetUsersList")
+	defer span.End()
+
 	if len(userIDs) == 0 {
 		return nil, errors.New("No User IDs")
 	}
 
-	users, err := a.store.GetUsersList(userIDs, a.config.ShowEmailAddress, a.config.ShowFullName)
+	users, err := a.store.GetUsersList(ctx, userIDs, a.config.ShowEmailAddress, a.config.ShowFullName)
 	if err != nil {
 		return nil, errors.Wrap(err, "unable to find users")
 	}
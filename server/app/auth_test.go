@@ -4,8 +4,10 @@
 package app
 
 import (
+	"context"
 	"testing"
 
+	"github.com/golang/mock/gomock"
 	"github.com/mattermost/mattermost-plugin-boards/server/model"
 	"github.com/mattermost/mattermost-plugin-boards/server/utils"
 	"github.com/pkg/errors"
@@ -33,12 +35,12 @@ func TestGetUser(t *testing.T) {
 		{"success", "goodID", false},
 	}
 
-	th.Store.EXPECT().GetUserByID("badID").Return(nil, errors.New("Bad Id"))
-	th.Store.EXPECT().GetUserByID("goodID").Return(mockUser, nil)
+	th.Store.EXPECT().GetUserByID(gomock.Any(), "badID").Return(nil, errors.New("Bad Id"))
+	th.Store.EXPECT().GetUserByID(gomock.Any(), "goodID").Return(mockUser, nil)
 
 	for _, test := range testcases {
 		t.Run(test.title, func(t *testing.T) {
-			token, err := th.App.GetUser(test.id)
+			token, err := th.App.GetUser(context.Background(), test.id)
 			if test.isError {
 				require.Error(t, err)
 			} else {
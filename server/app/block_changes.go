@@ -0,0 +1,95 @@
+// Copyright (c) 2020-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package app
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/mattermost/mattermost-plugin-boards/server/model"
+	"github.com/mattermost/mattermost-plugin-boards/server/utils"
+)
+
+// blockChangesSettleTime is how long the changes are held back before being returned. The update
+// time of a block is set before its transaction commits, so a change committed late could
+// otherwise be older than a cursor already returned, and never be read.
+const blockChangesSettleTime = 10 * time.Second
+
+// GetBlockChanges returns up to perPage changes of the blocks of a board following a cursor,
+// oldest first. The updated blocks and the deleted ones are read separately, in the same order,
+// then merged: the cursor of the next changes is the position of the last change returned. The
+// changes of the last blockChangesSettleTime are only returned once settled.
+func (a *App) GetBlockChanges(ctx context.Context, boardID string, since string, perPage int) (*model.BlockChanges, error) {
+	ctx, span := startSpan(ctx, "GetBlockChanges")
+	defer span.End()
+
+	if perPage <= 0 {
+		return nil, model.NewErrBadRequest("invalid number of changes per page")
+	}
+
+	opts := model.QueryBlockChangesOptions{
+		BoardID:        boardID,
+		BeforeUpdateAt: utils.GetMillis() - blockChangesSettleTime.Milliseconds(),
+		Limit:          uint64(perPage + 1),
+	}
+	if since != "" {
+		cursor, err := model.DecodeBlockCursor(since)
+		if err != nil {
+			return nil, err
+		}
+		opts.AfterUpdateAt = cursor.UpdateAt
+		opts.AfterID = cursor.ID
+	}
+
+	blocks, err := a.store.GetChangedBlocks(ctx, opts)
+	if err != nil {
+		return nil, fmt.Errorf("could not get changed blocks for board %s: %w", boardID, err)
+	}
+	tombstones, err := a.store.GetBlockTombstones(ctx, opts)
+	if err != nil {
+		return nil, fmt.Errorf("could not get deleted blocks for board %s: %w", boardID, err)
+	}
+
+	changes := &model.BlockChanges{
+		Blocks:  []*model.Block{},
+		Deleted: []*model.BlockTombstone{},
+		Cursor:  since,
+	}
+
+	var last model.BlockCursor
+	i, j := 0, 0
+	for count := 0; count < perPage && (i < len(blocks) || j < len(tombstones)); count++ {
+		var blockCursor, tombstoneCursor model.BlockCursor
+		if i < len(blocks) {
+			blockCursor = model.BlockCursor{UpdateAt: blocks[i].UpdateAt, ID: blocks[i].ID}
+		}
+		if j < len(tombstones) {
+			tombstoneCursor = model.BlockCursor{UpdateAt: tombstones[j].DeleteAt, ID: tombstones[j].ID}
+		}
+
+		if j == len(tombstones) || (i < len(blocks) && cursorBefore(blockCursor, tombstoneCursor)) {
+			changes.Blocks = append(changes.Blocks, blocks[i])
+			last = blockCursor
+			i++
+		} else {
+			changes.Deleted = append(changes.Deleted, tombstones[j])
+			last = tombstoneCursor
+			j++
+		}
+	}
+
+	if last.ID != "" {
+		changes.Cursor = last.Encode()
+	}
+	changes.HasMore = i < len(blocks) || j < len(tombstones)
+	return changes, nil
+}
+
+func cursorBefore(a, b model.BlockCursor) bool {
+	if a.UpdateAt != b.UpdateAt {
+		return a.UpdateAt < b.UpdateAt
+	}
+	return a.ID < b.ID
+}
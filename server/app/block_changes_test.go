@@ -0,0 +1,154 @@
+// Copyright (c) 2020-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package app
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mattermost/mattermost-plugin-boards/server/model"
+	"github.com/mattermost/mattermost-plugin-boards/server/utils"
+)
+
+// settledChangesMatcher matches the options of a query for the changes of a board held back by
+// blockChangesSettleTime.
+type settledChangesMatcher struct {
+	opts model.QueryBlockChangesOptions
+}
+
+func settledChanges(opts model.QueryBlockChangesOptions) gomock.Matcher {
+	return settledChangesMatcher{opts: opts}
+}
+
+func (m settledChangesMatcher) Matches(x interface{}) bool {
+	opts, ok := x.(model.QueryBlockChangesOptions)
+	if !ok {
+		return false
+	}
+	settled := utils.GetMillis() - blockChangesSettleTime.Milliseconds()
+	if opts.BeforeUpdateAt > settled || opts.BeforeUpdateAt < settled-1000 {
+		return false
+	}
+	opts.BeforeUpdateAt = 0
+	return opts == m.opts
+}
+
+func (m settledChangesMatcher) String() string {
+	return fmt.Sprintf("settled changes of %v", m.opts)
+}
+
+func TestGetBlockChanges(t *testing.T) {
+	th, tearDown := SetupTestHelper(t)
+	defer tearDown()
+
+	blocks := []*model.Block{
+		{ID: "block1", BoardID: testBoardID, UpdateAt: 100},
+		{ID: "block3", BoardID: testBoardID, UpdateAt: 200},
+		{ID: "block4", BoardID: testBoardID, UpdateAt: 300},
+	}
+	tombstones := []*model.BlockTombstone{
+		{ID: "block2", BoardID: testBoardID, DeleteAt: 200},
+		{ID: "block5", BoardID: testBoardID, DeleteAt: 400},
+	}
+
+	t.Run("merges the changed and deleted blocks", func(t *testing.T) {
+		opts := model.QueryBlockChangesOptions{BoardID: testBoardID, Limit: 11}
+		th.Store.EXPECT().GetChangedBlocks(gomock.Any(), settledChanges(opts)).Return(blocks, nil)
+		th.Store.EXPECT().GetBlockTombstones(gomock.Any(), settledChanges(opts)).Return(tombstones, nil)
+
+		changes, err := th.App.GetBlockChanges(context.Background(), testBoardID, "", 10)
+		require.NoError(t, err)
+		assert.Equal(t, blocks, changes.Blocks)
+		assert.Equal(t, tombstones, changes.Deleted)
+		assert.False(t, changes.HasMore)
+
+		cursor, err := model.DecodeBlockCursor(changes.Cursor)
+		require.NoError(t, err)
+		assert.Equal(t, model.BlockCursor{UpdateAt: 400, ID: "block5"}, cursor)
+	})
+
+	t.Run("returns a page of the changes", func(t *testing.T) {
+		opts := model.QueryBlockChangesOptions{BoardID: testBoardID, Limit: 4}
+		th.Store.EXPECT().GetChangedBlocks(gomock.Any(), settledChanges(opts)).Return(blocks, nil)
+		th.Store.EXPECT().GetBlockTombstones(gomock.Any(), settledChanges(opts)).Return(tombstones, nil)
+
+		changes, err := th.App.GetBlockChanges(context.Background(), testBoardID, "", 3)
+		require.NoError(t, err)
+		assert.Equal(t, blocks[:2], changes.Blocks)
+		assert.Equal(t, tombstones[:1], changes.Deleted)
+		assert.True(t, changes.HasMore)
+
+		// the block of the same time and the next ID follows the cursor.
+		cursor, err := model.DecodeBlockCursor(changes.Cursor)
+		require.NoError(t, err)
+		assert.Equal(t, model.BlockCursor{UpdateAt: 200, ID: "block3"}, cursor)
+
+		next := model.QueryBlockChangesOptions{BoardID: testBoardID, AfterUpdateAt: 200, AfterID: "block3", Limit: 4}
+		th.Store.EXPECT().GetChangedBlocks(gomock.Any(), settledChanges(next)).Return(blocks[2:], nil)
+		th.Store.EXPECT().GetBlockTombstones(gomock.Any(), settledChanges(next)).Return(tombstones[1:], nil)
+
+		changes, err = th.App.GetBlockChanges(context.Background(), testBoardID, changes.Cursor, 3)
+		require.NoError(t, err)
+		assert.Equal(t, blocks[2:], changes.Blocks)
+		assert.Equal(t, tombstones[1:], changes.Deleted)
+		assert.False(t, changes.HasMore)
+	})
+
+	t.Run("keeps the cursor without changes", func(t *testing.T) {
+		since := model.BlockCursor{UpdateAt: 400, ID: "block5"}.Encode()
+		opts := model.QueryBlockChangesOptions{BoardID: testBoardID, AfterUpdateAt: 400, AfterID: "block5", Limit: 11}
+		th.Store.EXPECT().GetChangedBlocks(gomock.Any(), settledChanges(opts)).Return([]*model.Block{}, nil)
+		th.Store.EXPECT().GetBlockTombstones(gomock.Any(), settledChanges(opts)).Return([]*model.BlockTombstone{}, nil)
+
+		changes, err := th.App.GetBlockChanges(context.Background(), testBoardID, since, 10)
+		require.NoError(t, err)
+		assert.Empty(t, changes.Blocks)
+		assert.Empty(t, changes.Deleted)
+		assert.Equal(t, since, changes.Cursor)
+		assert.False(t, changes.HasMore)
+	})
+
+	t.Run("invalid cursor", func(t *testing.T) {
+		_, err := th.App.GetBlockChanges(context.Background(), testBoardID, "not a cursor", 10)
+		require.True(t, model.IsErrBadRequest(err))
+	})
+}
+
+func TestGetCardsPage(t *testing.T) {
+	th, tearDown := SetupTestHelper(t)
+	defer tearDown()
+
+	cards := []*model.Block{
+		{ID: "card1", BoardID: testBoardID, Type: model.TypeCard},
+		{ID: "card2", BoardID: testBoardID, Type: model.TypeCard},
+		{ID: "card3", BoardID: testBoardID, Type: model.TypeCard},
+	}
+
+	t.Run("first page", func(t *testing.T) {
+		opts := model.QueryBlocksOptions{BoardID: testBoardID, BlockType: model.TypeCard, PerPage: 3}
+		th.Store.EXPECT().GetBlocks(gomock.Any(), opts).Return(cards, nil)
+
+		page, nextCursor, err := th.App.GetCardsPage(context.Background(), testBoardID, "", 2)
+		require.NoError(t, err)
+		require.Len(t, page, 2)
+		assert.Equal(t, "card2", page[1].ID)
+		assert.Equal(t, model.BlockCursor{ID: "card2"}.Encode(), nextCursor)
+	})
+
+	t.Run("last page", func(t *testing.T) {
+		opts := model.QueryBlocksOptions{BoardID: testBoardID, BlockType: model.TypeCard, AfterID: "card2", PerPage: 3}
+		th.Store.EXPECT().GetBlocks(gomock.Any(), opts).Return(cards[2:], nil)
+
+		page, nextCursor, err := th.App.GetCardsPage(context.Background(), testBoardID, model.BlockCursor{ID: "card2"}.Encode(), 2)
+		require.NoError(t, err)
+		require.Len(t, page, 1)
+		assert.Equal(t, "card3", page[0].ID)
+		assert.Empty(t, nextCursor)
+	})
+}
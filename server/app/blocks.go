@@ -4,36 +4,73 @@
 package app
 
 import (
+	"context"
 	"errors"
 	"fmt"
 
 	"github.com/mattermost/mattermost-plugin-boards/server/model"
 	"github.com/mattermost/mattermost-plugin-boards/server/services/notify"
-	"github.com/mattermost/mattermost-plugin-boards/server/utils"
 
 	"github.com/mattermost/mattermost/server/public/shared/mlog"
 )
 
 var ErrBlocksFromMultipleBoards = errors.New("the block set contain blocks from multiple boards")
 
-func (a *App) GetBlocks(boardID, parentID string, blockType string) ([]*model.Block, error) {
+func (a *App) GetBlocks(ctx context.Context, boardID, parentID string, blockType string) ([]*model.Block, error) {
+	ctx, span := startSpan(ctx, "GetBlocks")
+	defer span.End()
+
 	if boardID == "" {
 		return []*model.Block{}, nil
 	}
 
 	if blockType != "" && parentID != "" {
-		return a.store.GetBlocksWithParentAndType(boardID, parentID, blockType)
+		return a.store.GetBlocksWithParentAndType(ctx, boardID, parentID, blockType)
 	}
 
 	if blockType != "" {
-		return a.store.GetBlocksWithType(boardID, blockType)
+		return a.store.GetBlocksWithType(ctx, boardID, blockType)
 	}
 
-	return a.store.GetBlocksWithParent(boardID, parentID)
+	return a.store.GetBlocksWithParent(ctx, boardID, parentID)
 }
 
-func (a *App) DuplicateBlock(boardID string, blockID string, userID string, asTemplate bool) ([]*model.Block, error) {
-	board, err := a.GetBoard(boardID)
+// GetBlocksPage returns a page of opts.PerPage blocks matching the options, in ID order, following
+// the block of a cursor. The cursor of the next page is empty if this is the last page.
+func (a *App) GetBlocksPage(ctx context.Context, opts model.QueryBlocksOptions, cursor string) ([]*model.Block, string, error) {
+	ctx, span := startSpan(ctx, "GetBlocksPage")
+	defer span.End()
+
+	if opts.PerPage <= 0 || opts.Page != 0 {
+		return nil, "", model.NewErrBadRequest("invalid number of blocks per page")
+	}
+	if cursor != "" {
+		c, err := model.DecodeBlockCursor(cursor)
+		if err != nil {
+			return nil, "", err
+		}
+		opts.AfterID = c.ID
+	}
+
+	// one more block tells if there is a next page.
+	perPage := opts.PerPage
+	opts.PerPage++
+	blocks, err := a.store.GetBlocks(ctx, opts)
+	if err != nil {
+		return nil, "", err
+	}
+	if len(blocks) <= perPage {
+		return blocks, "", nil
+	}
+	blocks = blocks[:perPage]
+	return blocks, model.BlockCursor{ID: blocks[perPage-1].ID}.Encode(), nil
+}
+
+func (a *App) DuplicateBlock(ctx context.Context, boardID string, blockID string, userID string, asTemplate bool) ([]*model.Block, error) {
+	ctx, span := startSpan(ctx, "DuplicateBlock")
+	defer span.End()
+
+	board, err := a.GetBoard(ctx, boardID)
 	if err != nil {
 		return nil, err
 	}
@@ -41,22 +78,24 @@ func (a *App) DuplicateBlock(boardID string, blockID string, userID string, asTe
 		return nil, fmt.Errorf("cannot fetch board %s for DuplicateBlock: %w", boardID, err)
 	}
 
-	blocks, err := a.store.DuplicateBlock(boardID, blockID, userID, asTemplate)
+	blocks, err := a.store.DuplicateBlock(ctx, boardID, blockID, userID, asTemplate)
 	if err != nil {
 		return nil, err
 	}
 
-	err = a.CopyAndUpdateCardFiles(boardID, userID, blocks, asTemplate)
+	err = a.CopyAndUpdateCardFiles(ctx, boardID, userID, blocks, asTemplate)
 	if err != nil {
 		return nil, err
 	}
 
 	a.blockChangeNotifier.Enqueue(func() error {
+		// the changes are notified after the request has returned and its context is cancelled.
+		ctx := context.WithoutCancel(ctx)
 		for _, block := range blocks {
 			a.wsAdapter.BroadcastBlockChange(board.TeamID, block)
 			a.webhook.NotifyUpdate(block)
 			// 템플릿에서 카드 생성 시에도 구독 알림이 전송되도록 notifyBlockChanged 호출
-			a.notifyBlockChanged(notify.Add, block, nil, userID)
+			a.notifyBlockChanged(ctx, notify.Add, block, nil, userID)
 		}
 		return nil
 	})
@@ -64,36 +103,43 @@ func (a *App) DuplicateBlock(boardID string, blockID string, userID string, asTe
 	return blocks, err
 }
 
-func (a *App) PatchBlock(blockID string, blockPatch *model.BlockPatch, modifiedByID string) (*model.Block, error) {
-	return a.PatchBlockAndNotify(blockID, blockPatch, modifiedByID, false)
+func (a *App) PatchBlock(ctx context.Context, blockID string, blockPatch *model.BlockPatch, modifiedByID string) (*model.Block, error) {
+	ctx, span := startSpan(ctx, "PatchBlock")
+	defer span.End()
+
+	return a.PatchBlockAndNotify(ctx, blockID, blockPatch, modifiedByID, false)
 }
 
-func (a *App) PatchBlockAndNotify(blockID string, blockPatch *model.BlockPatch, modifiedByID string, disableNotify bool) (*model.Block, error) {
+func (a *App) PatchBlockAndNotify(ctx context.Context, blockID string, blockPatch *model.BlockPatch, modifiedByID string, disableNotify bool) (*model.Block, error) {
+	ctx, span := startSpan(ctx, "PatchBlockAndNotify")
+	defer span.End()
+
 	if err := model.ValidateBlockPatch(blockPatch); err != nil {
 		return nil, err
 	}
 
-	oldBlock, err := a.store.GetBlock(blockID)
+	oldBlock, err := a.store.GetBlock(ctx, blockID)
 	if err != nil {
 		return nil, err
 	}
 
-	board, err := a.store.GetBoard(oldBlock.BoardID)
+	board, err := a.store.GetBoard(ctx, oldBlock.BoardID)
 	if err != nil {
 		return nil, err
 	}
 
-	err = a.store.PatchBlock(blockID, blockPatch, modifiedByID)
+	err = a.store.PatchBlock(ctx, blockID, blockPatch, modifiedByID)
 	if err != nil {
 		return nil, err
 	}
 
 	a.metrics.IncrementBlocksPatched(1)
-	block, err := a.store.GetBlock(blockID)
+	block, err := a.store.GetBlock(ctx, blockID)
 	if err != nil {
 		return nil, err
 	}
 	a.blockChangeNotifier.Enqueue(func() error {
+		ctx := context.WithoutCancel(ctx)
 		// broadcast on websocket
 		a.wsAdapter.BroadcastBlockChange(board.TeamID, block)
 
@@ -102,44 +148,51 @@ func (a *App) PatchBlockAndNotify(blockID string, blockPatch *model.BlockPatch,
 
 		// send notifications
 		if !disableNotify {
-			a.notifyBlockChanged(notify.Update, block, oldBlock, modifiedByID)
+			a.notifyBlockChanged(ctx, notify.Update, block, oldBlock, modifiedByID)
 		}
 		return nil
 	})
 	return block, nil
 }
 
-func (a *App) PatchBlocks(teamID string, blockPatches *model.BlockPatchBatch, modifiedByID string) error {
+func (a *App) PatchBlocks(ctx context.Context, teamID string, blockPatches *model.BlockPatchBatch, modifiedByID string) error {
+	ctx, span := startSpan(ctx, "PatchBlocks")
+	defer span.End()
+
 	for _, patch := range blockPatches.BlockPatches {
 		err := model.ValidateBlockPatch(&patch)
 		if err != nil {
 			return err
 		}
 	}
-	return a.PatchBlocksAndNotify(teamID, blockPatches, modifiedByID, false)
+	return a.PatchBlocksAndNotify(ctx, teamID, blockPatches, modifiedByID, false)
 }
 
-func (a *App) PatchBlocksAndNotify(teamID string, blockPatches *model.BlockPatchBatch, modifiedByID string, disableNotify bool) error {
-	oldBlocks, err := a.store.GetBlocksByIDs(blockPatches.BlockIDs)
+func (a *App) PatchBlocksAndNotify(ctx context.Context, teamID string, blockPatches *model.BlockPatchBatch, modifiedByID string, disableNotify bool) error {
+	ctx, span := startSpan(ctx, "PatchBlocksAndNotify")
+	defer span.End()
+
+	oldBlocks, err := a.store.GetBlocksByIDs(ctx, blockPatches.BlockIDs)
 	if err != nil {
 		return err
 	}
 
-	if err := a.store.PatchBlocks(blockPatches, modifiedByID); err != nil {
+	if err := a.store.PatchBlocks(ctx, blockPatches, modifiedByID); err != nil {
 		return err
 	}
 
 	a.blockChangeNotifier.Enqueue(func() error {
+		ctx := context.WithoutCancel(ctx)
 		a.metrics.IncrementBlocksPatched(len(oldBlocks))
 		for i, blockID := range blockPatches.BlockIDs {
-			newBlock, err := a.store.GetBlock(blockID)
+			newBlock, err := a.store.GetBlock(ctx, blockID)
 			if err != nil {
 				return err
 			}
 			a.wsAdapter.BroadcastBlockChange(teamID, newBlock)
 			a.webhook.NotifyUpdate(newBlock)
 			if !disableNotify {
-				a.notifyBlockChanged(notify.Update, newBlock, oldBlocks[i], modifiedByID)
+				a.notifyBlockChanged(ctx, notify.Update, newBlock, oldBlocks[i], modifiedByID)
 			}
 		}
 		return nil
@@ -147,24 +200,35 @@ func (a *App) PatchBlocksAndNotify(teamID string, blockPatches *model.BlockPatch
 	return nil
 }
 
-func (a *App) InsertBlock(block *model.Block, modifiedByID string) error {
-	return a.InsertBlockAndNotify(block, modifiedByID, false)
+func (a *App) InsertBlock(ctx context.Context, block *model.Block, modifiedByID string) error {
+	ctx, span := startSpan(ctx, "InsertBlock")
+	defer span.End()
+
+	return a.InsertBlockAndNotify(ctx, block, modifiedByID, false)
 }
 
-func (a *App) InsertBlockAndNotify(block *model.Block, modifiedByID string, disableNotify bool) error {
-	board, bErr := a.store.GetBoard(block.BoardID)
+func (a *App) InsertBlockAndNotify(ctx context.Context, block *model.Block, modifiedByID string, disableNotify bool) error {
+	ctx, span := startSpan(ctx, "InsertBlockAndNotify")
+	defer span.End()
+
+	board, bErr := a.store.GetBoard(ctx, block.BoardID)
 	if bErr != nil {
 		return bErr
 	}
 
-	err := a.store.InsertBlock(block, modifiedByID)
+	if err := a.validateCommentReply(ctx, block); err != nil {
+		return err
+	}
+
+	err := a.store.InsertBlock(ctx, block, modifiedByID)
 	if err == nil {
 		a.blockChangeNotifier.Enqueue(func() error {
+			ctx := context.WithoutCancel(ctx)
 			a.wsAdapter.BroadcastBlockChange(board.TeamID, block)
 			a.metrics.IncrementBlocksInserted(1)
 			a.webhook.NotifyUpdate(block)
 			if !disableNotify {
-				a.notifyBlockChanged(notify.Add, block, nil, modifiedByID)
+				a.notifyBlockChanged(ctx, notify.Add, block, nil, modifiedByID)
 			}
 			return nil
 		})
@@ -173,11 +237,17 @@ func (a *App) InsertBlockAndNotify(block *model.Block, modifiedByID string, disa
 	return err
 }
 
-func (a *App) InsertBlocks(blocks []*model.Block, modifiedByID string) ([]*model.Block, error) {
-	return a.InsertBlocksAndNotify(blocks, modifiedByID, false)
+func (a *App) InsertBlocks(ctx context.Context, blocks []*model.Block, modifiedByID string) ([]*model.Block, error) {
+	ctx, span := startSpan(ctx, "InsertBlocks")
+	defer span.End()
+
+	return a.InsertBlocksAndNotify(ctx, blocks, modifiedByID, false)
 }
 
-func (a *App) InsertBlocksAndNotify(blocks []*model.Block, modifiedByID string, disableNotify bool) ([]*model.Block, error) {
+func (a *App) InsertBlocksAndNotify(ctx context.Context, blocks []*model.Block, modifiedByID string, disableNotify bool) ([]*model.Block, error) {
+	ctx, span := startSpan(ctx, "InsertBlocksAndNotify")
+	defer span.End()
+
 	if len(blocks) == 0 {
 		return []*model.Block{}, nil
 	}
@@ -190,7 +260,7 @@ func (a *App) InsertBlocksAndNotify(blocks []*model.Block, modifiedByID string,
 		}
 	}
 
-	board, err := a.store.GetBoard(boardID)
+	board, err := a.store.GetBoard(ctx, boardID)
 	if err != nil {
 		return nil, err
 	}
@@ -199,17 +269,17 @@ func (a *App) InsertBlocksAndNotify(blocks []*model.Block, modifiedByID string,
 	for i := range blocks {
 		block := blocks[i]
 
-		existingBlock, checkErr := a.store.GetBlock(block.ID)
+		existingBlock, checkErr := a.store.GetBlock(ctx, block.ID)
 		if checkErr != nil && !model.IsErrNotFound(checkErr) {
 			return nil, checkErr
 		}
 
 		if existingBlock == nil && (block.Type == "image" || block.Type == "attachment") {
-			fileIDsToRestore := extractFileIDsFromBlock(block)
+			fileIDsToRestore := model.GetBlockFileIDs(block)
 			if len(fileIDsToRestore) > 0 {
 				// Only restore files that were previously associated with this board
 				// to prevent unauthorized restoration of files from other boards
-				authorizedFileIDs, authErr := a.filterAuthorizedFilesForBoard(block.BoardID, fileIDsToRestore)
+				authorizedFileIDs, authErr := a.filterAuthorizedFilesForBoard(ctx, block.BoardID, fileIDsToRestore)
 				if authErr != nil {
 					a.logger.Error(
 						"Failed to validate file authorization for block",
@@ -221,7 +291,7 @@ func (a *App) InsertBlocksAndNotify(blocks []*model.Block, modifiedByID string,
 				}
 
 				if len(authorizedFileIDs) > 0 {
-					if restoreErr := a.store.RestoreFiles(authorizedFileIDs); restoreErr != nil {
+					if restoreErr := a.store.RestoreFiles(ctx, block.BoardID, authorizedFileIDs); restoreErr != nil {
 						a.logger.Error(
 							"Failed to restore files for block",
 							mlog.String("block_id", block.ID),
@@ -240,7 +310,13 @@ func (a *App) InsertBlocksAndNotify(blocks []*model.Block, modifiedByID string,
 			}
 		}
 
-		err := a.store.InsertBlock(block, modifiedByID)
+		if existingBlock == nil {
+			if err := a.validateCommentReply(ctx, block); err != nil {
+				return nil, err
+			}
+		}
+
+		err := a.store.InsertBlock(ctx, block, modifiedByID)
 		if err != nil {
 			return nil, err
 		}
@@ -251,11 +327,12 @@ func (a *App) InsertBlocksAndNotify(blocks []*model.Block, modifiedByID string,
 	}
 
 	a.blockChangeNotifier.Enqueue(func() error {
+		ctx := context.WithoutCancel(ctx)
 		for _, b := range needsNotify {
 			block := b
 			a.webhook.NotifyUpdate(block)
 			if !disableNotify {
-				a.notifyBlockChanged(notify.Add, block, nil, modifiedByID)
+				a.notifyBlockChanged(ctx, notify.Add, block, nil, modifiedByID)
 			}
 		}
 		return nil
@@ -264,21 +341,30 @@ func (a *App) InsertBlocksAndNotify(blocks []*model.Block, modifiedByID string,
 	return blocks, nil
 }
 
-func (a *App) GetBlockByID(blockID string) (*model.Block, error) {
-	return a.store.GetBlock(blockID)
+func (a *App) GetBlockByID(ctx context.Context, blockID string) (*model.Block, error) {
+	ctx, span := startSpan(ctx, "GetBlockByID")
+	defer span.End()
+
+	return a.store.GetBlock(ctx, blockID)
 }
 
-func (a *App) DeleteBlock(blockID string, modifiedBy string) error {
-	return a.DeleteBlockAndNotify(blockID, modifiedBy, false)
+func (a *App) DeleteBlock(ctx context.Context, blockID string, modifiedBy string) error {
+	ctx, span := startSpan(ctx, "DeleteBlock")
+	defer span.End()
+
+	return a.DeleteBlockAndNotify(ctx, blockID, modifiedBy, false)
 }
 
-func (a *App) DeleteBlockAndNotify(blockID string, modifiedBy string, disableNotify bool) error {
-	block, err := a.store.GetBlock(blockID)
+func (a *App) DeleteBlockAndNotify(ctx context.Context, blockID string, modifiedBy string, disableNotify bool) error {
+	ctx, span := startSpan(ctx, "DeleteBlockAndNotify")
+	defer span.End()
+
+	block, err := a.store.GetBlock(ctx, blockID)
 	if err != nil {
 		return err
 	}
 
-	board, err := a.store.GetBoard(block.BoardID)
+	board, err := a.store.GetBoard(ctx, block.BoardID)
 	if err != nil {
 		return err
 	}
@@ -288,7 +374,7 @@ func (a *App) DeleteBlockAndNotify(blockID string, modifiedBy string, disableNot
 		return nil
 	}
 
-	err = a.store.DeleteBlock(blockID, modifiedBy)
+	err = a.store.DeleteBlock(ctx, blockID, modifiedBy)
 	if err != nil {
 		return err
 	}
@@ -296,14 +382,15 @@ func (a *App) DeleteBlockAndNotify(blockID string, modifiedBy string, disableNot
 	// If this is a card, also delete its BlockSuite document
 	if block.Type == model.TypeCard {
 		// Ignore error if BlockSuite doc doesn't exist (not all cards have one)
-		_ = a.store.DeleteBlockSuiteDocByCardID(blockID)
+		_ = a.store.DeleteBlockSuiteDocByCardID(ctx, blockID)
 	}
 
 	a.blockChangeNotifier.Enqueue(func() error {
+		ctx := context.WithoutCancel(ctx)
 		a.wsAdapter.BroadcastBlockDelete(board.TeamID, blockID, block.BoardID)
 		a.metrics.IncrementBlocksDeleted(1)
 		if !disableNotify {
-			a.notifyBlockChanged(notify.Delete, block, block, modifiedBy)
+			a.notifyBlockChanged(ctx, notify.Delete, block, block, modifiedBy)
 		}
 		return nil
 	})
@@ -311,8 +398,11 @@ func (a *App) DeleteBlockAndNotify(blockID string, modifiedBy string, disableNot
 	return nil
 }
 
-func (a *App) GetLastBlockHistoryEntry(blockID string) (*model.Block, error) {
-	blocks, err := a.store.GetBlockHistory(blockID, model.QueryBlockHistoryOptions{Limit: 1, Descending: true})
+func (a *App) GetLastBlockHistoryEntry(ctx context.Context, blockID string) (*model.Block, error) {
+	ctx, span := startSpan(ctx, "GetLastBlockHistoryEntry")
+	defer span.End()
+
+	blocks, err := a.store.GetBlockHistory(ctx, blockID, model.QueryBlockHistoryOptions{Limit: 1, Descending: true})
 	if err != nil {
 		return nil, err
 	}
@@ -322,8 +412,11 @@ func (a *App) GetLastBlockHistoryEntry(blockID string) (*model.Block, error) {
 	return blocks[0], nil
 }
 
-func (a *App) UndeleteBlock(blockID string, modifiedBy string) (*model.Block, error) {
-	blocks, err := a.store.GetBlockHistory(blockID, model.QueryBlockHistoryOptions{Limit: 1, Descending: true})
+func (a *App) UndeleteBlock(ctx context.Context, blockID string, modifiedBy string) (*model.Block, error) {
+	ctx, span := startSpan(ctx, "UndeleteBlock")
+	defer span.End()
+
+	blocks, err := a.store.GetBlockHistory(ctx, blockID, model.QueryBlockHistoryOptions{Limit: 1, Descending: true})
 	if err != nil {
 		return nil, err
 	}
@@ -333,12 +426,12 @@ func (a *App) UndeleteBlock(blockID string, modifiedBy string) (*model.Block, er
 		return nil, nil
 	}
 
-	err = a.store.UndeleteBlock(blockID, modifiedBy)
+	err = a.store.UndeleteBlock(ctx, blockID, modifiedBy)
 	if err != nil {
 		return nil, err
 	}
 
-	block, err := a.store.GetBlock(blockID)
+	block, err := a.store.GetBlock(ctx, blockID)
 	if model.IsErrNotFound(err) {
 		a.logger.Error("Error loading the block after a successful undelete, not propagating through websockets or notifications", mlog.String("blockID", blockID))
 		return nil, err
@@ -347,16 +440,17 @@ func (a *App) UndeleteBlock(blockID string, modifiedBy string) (*model.Block, er
 		return nil, err
 	}
 
-	board, err := a.store.GetBoard(block.BoardID)
+	board, err := a.store.GetBoard(ctx, block.BoardID)
 	if err != nil {
 		return nil, err
 	}
 
 	a.blockChangeNotifier.Enqueue(func() error {
+		ctx := context.WithoutCancel(ctx)
 		a.wsAdapter.BroadcastBlockChange(board.TeamID, block)
 		a.metrics.IncrementBlocksInserted(1)
 		a.webhook.NotifyUpdate(block)
-		a.notifyBlockChanged(notify.Add, block, nil, modifiedBy)
+		a.notifyBlockChanged(ctx, notify.Add, block, nil, modifiedBy)
 
 		return nil
 	})
@@ -364,28 +458,34 @@ func (a *App) UndeleteBlock(blockID string, modifiedBy string) (*model.Block, er
 	return block, nil
 }
 
-func (a *App) GetBlockCountsByType() (map[string]int64, error) {
-	return a.store.GetBlockCountsByType()
+func (a *App) GetBlockCountsByType(ctx context.Context) (map[string]int64, error) {
+	ctx, span := startSpan(ctx, "GetBlockCountsByType")
+	defer span.End()
+
+	return a.store.GetBlockCountsByType(ctx)
 }
 
-func (a *App) GetBlocksForBoard(boardID string) ([]*model.Block, error) {
-	return a.store.GetBlocksForBoard(boardID)
+func (a *App) GetBlocksForBoard(ctx context.Context, boardID string) ([]*model.Block, error) {
+	ctx, span := startSpan(ctx, "GetBlocksForBoard")
+	defer span.End()
+
+	return a.store.GetBlocksForBoard(ctx, boardID)
 }
 
-func (a *App) notifyBlockChanged(action notify.Action, block *model.Block, oldBlock *model.Block, modifiedByID string) {
+func (a *App) notifyBlockChanged(ctx context.Context, action notify.Action, block *model.Block, oldBlock *model.Block, modifiedByID string) {
 	// don't notify if notifications service disabled, or block change is generated via system user.
 	if a.notifications == nil || modifiedByID == model.SystemUserID {
 		return
 	}
 
 	// find card and board for the changed block.
-	board, card, err := a.getBoardAndCard(block)
+	board, card, err := a.getBoardAndCard(ctx, block)
 	if err != nil {
 		a.logger.Error("Error notifying for block change; cannot determine board or card", mlog.Err(err))
 		return
 	}
 
-	boardMember, _ := a.GetMemberForBoard(board.ID, modifiedByID)
+	boardMember, _ := a.GetMemberForBoard(ctx, board.ID, modifiedByID)
 	if boardMember == nil {
 		// create temporary guest board member
 		boardMember = &model.BoardMember{
@@ -403,7 +503,7 @@ func (a *App) notifyBlockChanged(action notify.Action, block *model.Block, oldBl
 		BlockOld:     oldBlock,
 		ModifiedBy:   boardMember,
 	}
-	a.notifications.BlockChanged(evt)
+	a.notifications.BlockChanged(ctx, evt)
 }
 
 const (
@@ -412,8 +512,8 @@ const (
 
 // getBoardAndCard returns the first parent of type `card` its board for the specified block.
 // `board` and/or `card` may return nil without error if the block does not belong to a board or card.
-func (a *App) getBoardAndCard(block *model.Block) (board *model.Board, card *model.Block, err error) {
-	board, err = a.store.GetBoard(block.BoardID)
+func (a *App) getBoardAndCard(ctx context.Context, block *model.Block) (board *model.Board, card *model.Block, err error) {
+	board, err = a.store.GetBoard(ctx, block.BoardID)
 	if err != nil {
 		return board, card, err
 	}
@@ -430,7 +530,7 @@ func (a *App) getBoardAndCard(block *model.Block) (board *model.Board, card *mod
 			break
 		}
 
-		iter, err = a.store.GetBlock(iter.ParentID)
+		iter, err = a.store.GetBlock(ctx, iter.ParentID)
 		if model.IsErrNotFound(err) {
 			return board, card, nil
 		}
@@ -441,33 +541,17 @@ func (a *App) getBoardAndCard(block *model.Block) (board *model.Board, card *mod
 	return board, card, nil
 }
 
-// extractFileIDsFromBlock extracts file IDs from image and attachment blocks.
-func extractFileIDsFromBlock(block *model.Block) []string {
-	fileIDsToRestore := make([]string, 0, 2)
-
-	if fileIDVal, exists := block.Fields["fileId"]; exists {
-		if fileIDStr, ok := fileIDVal.(string); ok && fileIDStr != "" {
-			fileID := utils.RetrieveFileIDFromBlockFieldStorage(fileIDStr)
-			if fileID != "" {
-				fileIDsToRestore = append(fileIDsToRestore, fileID)
-			}
-		}
-	}
-
-	return fileIDsToRestore
-}
-
 // filterAuthorizedFilesForBoard filters the provided file IDs to only include files
 // that were previously associated with blocks on the specified board. This prevents
 // unauthorized restoration of files from other boards that the user doesn't have access to.
-func (a *App) filterAuthorizedFilesForBoard(boardID string, fileIDs []string) ([]string, error) {
+func (a *App) filterAuthorizedFilesForBoard(ctx context.Context, boardID string, fileIDs []string) ([]string, error) {
 	if len(fileIDs) == 0 {
 		return []string{}, nil
 	}
 
 	boardFileIDs := make(map[string]bool)
 
-	historyBlocks, err := a.store.GetBlockHistoryDescendants(boardID, model.QueryBlockHistoryOptions{})
+	historyBlocks, err := a.store.GetBlockHistoryDescendants(ctx, boardID, model.QueryBlockHistoryOptions{})
 	if err != nil {
 		return nil, fmt.Errorf("failed to query block history for board %s: %w", boardID, err)
 	}
@@ -477,11 +561,8 @@ func (a *App) filterAuthorizedFilesForBoard(boardID string, fileIDs []string) ([
 			continue
 		}
 
-		if fileID, ok := block.Fields[model.BlockFieldFileId].(string); ok && fileID != "" {
-			cleanFileID := utils.RetrieveFileIDFromBlockFieldStorage(fileID)
-			if cleanFileID != "" {
-				boardFileIDs[cleanFileID] = true
-			}
+		for _, fileID := range model.GetBlockFileIDs(block) {
+			boardFileIDs[fileID] = true
 		}
 	}
 
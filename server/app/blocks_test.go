@@ -4,6 +4,7 @@
 package app
 
 import (
+	"context"
 	"database/sql"
 	"testing"
 
@@ -31,10 +32,10 @@ func TestInsertBlock(t *testing.T) {
 		boardID := testBoardID
 		block := &model.Block{BoardID: boardID}
 		board := &model.Board{ID: boardID}
-		th.Store.EXPECT().GetBoard(boardID).Return(board, nil)
-		th.Store.EXPECT().InsertBlock(block, "user-id-1").Return(nil)
-		th.Store.EXPECT().GetMembersForBoard(boardID).Return([]*model.BoardMember{}, nil)
-		err := th.App.InsertBlock(block, "user-id-1")
+		th.Store.EXPECT().GetBoard(gomock.Any(), boardID).Return(board, nil)
+		th.Store.EXPECT().InsertBlock(gomock.Any(), block, "user-id-1").Return(nil)
+		th.Store.EXPECT().GetMembersForBoard(gomock.Any(), boardID).Return([]*model.BoardMember{}, nil)
+		err := th.App.InsertBlock(context.Background(), block, "user-id-1")
 		require.NoError(t, err)
 	})
 
@@ -42,9 +43,9 @@ func TestInsertBlock(t *testing.T) {
 		boardID := testBoardID
 		block := &model.Block{BoardID: boardID}
 		board := &model.Board{ID: boardID}
-		th.Store.EXPECT().GetBoard(boardID).Return(board, nil)
-		th.Store.EXPECT().InsertBlock(block, "user-id-1").Return(blockError{"error"})
-		err := th.App.InsertBlock(block, "user-id-1")
+		th.Store.EXPECT().GetBoard(gomock.Any(), boardID).Return(board, nil)
+		th.Store.EXPECT().InsertBlock(gomock.Any(), block, "user-id-1").Return(blockError{"error"})
+		err := th.App.InsertBlock(context.Background(), block, "user-id-1")
 		require.Error(t, err, "error")
 	})
 }
@@ -62,19 +63,19 @@ func TestPatchBlocks(t *testing.T) {
 		}
 
 		block1 := &model.Block{ID: "block1"}
-		th.Store.EXPECT().GetBlocksByIDs([]string{"block1"}).Return([]*model.Block{block1}, nil)
-		th.Store.EXPECT().PatchBlocks(gomock.Eq(&blockPatches), gomock.Eq("user-id-1")).Return(nil)
-		th.Store.EXPECT().GetBlock("block1").Return(block1, nil)
+		th.Store.EXPECT().GetBlocksByIDs(gomock.Any(), []string{"block1"}).Return([]*model.Block{block1}, nil)
+		th.Store.EXPECT().PatchBlocks(gomock.Any(), gomock.Eq(&blockPatches), gomock.Eq("user-id-1")).Return(nil)
+		th.Store.EXPECT().GetBlock(gomock.Any(), "block1").Return(block1, nil)
 		// this call comes from the WS server notification
-		th.Store.EXPECT().GetMembersForBoard(gomock.Any()).Times(1)
-		err := th.App.PatchBlocks("team-id", &blockPatches, "user-id-1")
+		th.Store.EXPECT().GetMembersForBoard(gomock.Any(), gomock.Any()).Times(1)
+		err := th.App.PatchBlocks(context.Background(), "team-id", &blockPatches, "user-id-1")
 		require.NoError(t, err)
 	})
 
 	t.Run("patchBlocks error scenario", func(t *testing.T) {
 		blockPatches := model.BlockPatchBatch{BlockIDs: []string{}}
-		th.Store.EXPECT().GetBlocksByIDs([]string{}).Return(nil, sql.ErrNoRows)
-		err := th.App.PatchBlocks("team-id", &blockPatches, "user-id-1")
+		th.Store.EXPECT().GetBlocksByIDs(gomock.Any(), []string{}).Return(nil, sql.ErrNoRows)
+		err := th.App.PatchBlocks(context.Background(), "team-id", &blockPatches, "user-id-1")
 		require.ErrorIs(t, err, sql.ErrNoRows)
 	})
 
@@ -107,11 +108,11 @@ func TestPatchBlocks(t *testing.T) {
 			Type: model.BoardTypeOpen,
 		}
 
-		th.Store.EXPECT().GetBlocksByIDs([]string{"block1"}).Return([]*model.Block{block1}, nil)
-		th.Store.EXPECT().GetBoard("board-id").Return(board1, nil)
-		th.Store.EXPECT().GetLicense().Return(fakeLicense)
-		th.Store.EXPECT().GetCardLimitTimestamp().Return(int64(150), nil)
-		err := th.App.PatchBlocks("team-id", &blockPatches, "user-id-1")
+		th.Store.EXPECT().GetBlocksByIDs(gomock.Any(), []string{"block1"}).Return([]*model.Block{block1}, nil)
+		th.Store.EXPECT().GetBoard(gomock.Any(), "board-id").Return(board1, nil)
+		th.Store.EXPECT().GetLicense(gomock.Any()).Return(fakeLicense)
+		th.Store.EXPECT().GetCardLimitTimestamp(gomock.Any()).Return(int64(150), nil)
+		err := th.App.PatchBlocks(context.Background(), "team-id", &blockPatches, "user-id-1")
 		require.ErrorIs(t, err, model.ErrPatchUpdatesLimitedCards)
 	})
 }
@@ -127,11 +128,11 @@ func TestDeleteBlock(t *testing.T) {
 			ID:      "block-id",
 			BoardID: board.ID,
 		}
-		th.Store.EXPECT().GetBlock(gomock.Eq("block-id")).Return(block, nil)
-		th.Store.EXPECT().DeleteBlock(gomock.Eq("block-id"), gomock.Eq("user-id-1")).Return(nil)
-		th.Store.EXPECT().GetBoard(gomock.Eq(testBoardID)).Return(board, nil)
-		th.Store.EXPECT().GetMembersForBoard(boardID).Return([]*model.BoardMember{}, nil)
-		err := th.App.DeleteBlock("block-id", "user-id-1")
+		th.Store.EXPECT().GetBlock(gomock.Any(), gomock.Eq("block-id")).Return(block, nil)
+		th.Store.EXPECT().DeleteBlock(gomock.Any(), gomock.Eq("block-id"), gomock.Eq("user-id-1")).Return(nil)
+		th.Store.EXPECT().GetBoard(gomock.Any(), gomock.Eq(testBoardID)).Return(board, nil)
+		th.Store.EXPECT().GetMembersForBoard(gomock.Any(), boardID).Return([]*model.BoardMember{}, nil)
+		err := th.App.DeleteBlock(context.Background(), "block-id", "user-id-1")
 		require.NoError(t, err)
 	})
 
@@ -142,10 +143,10 @@ func TestDeleteBlock(t *testing.T) {
 			ID:      "block-id",
 			BoardID: board.ID,
 		}
-		th.Store.EXPECT().GetBlock(gomock.Eq("block-id")).Return(block, nil)
-		th.Store.EXPECT().DeleteBlock(gomock.Eq("block-id"), gomock.Eq("user-id-1")).Return(blockError{"error"})
-		th.Store.EXPECT().GetBoard(gomock.Eq(testBoardID)).Return(board, nil)
-		err := th.App.DeleteBlock("block-id", "user-id-1")
+		th.Store.EXPECT().GetBlock(gomock.Any(), gomock.Eq("block-id")).Return(block, nil)
+		th.Store.EXPECT().DeleteBlock(gomock.Any(), gomock.Eq("block-id"), gomock.Eq("user-id-1")).Return(blockError{"error"})
+		th.Store.EXPECT().GetBoard(gomock.Any(), gomock.Eq(testBoardID)).Return(board, nil)
+		err := th.App.DeleteBlock(context.Background(), "block-id", "user-id-1")
 		require.Error(t, err, "error")
 	})
 }
@@ -162,14 +163,15 @@ func TestUndeleteBlock(t *testing.T) {
 			BoardID: board.ID,
 		}
 		th.Store.EXPECT().GetBlockHistory(
+			gomock.Any(),
 			gomock.Eq("block-id"),
 			gomock.Eq(model.QueryBlockHistoryOptions{Limit: 1, Descending: true}),
 		).Return([]*model.Block{block}, nil)
-		th.Store.EXPECT().UndeleteBlock(gomock.Eq("block-id"), gomock.Eq("user-id-1")).Return(nil)
-		th.Store.EXPECT().GetBlock(gomock.Eq("block-id")).Return(block, nil)
-		th.Store.EXPECT().GetBoard(boardID).Return(board, nil)
-		th.Store.EXPECT().GetMembersForBoard(boardID).Return([]*model.BoardMember{}, nil)
-		_, err := th.App.UndeleteBlock("block-id", "user-id-1")
+		th.Store.EXPECT().UndeleteBlock(gomock.Any(), gomock.Eq("block-id"), gomock.Eq("user-id-1")).Return(nil)
+		th.Store.EXPECT().GetBlock(gomock.Any(), gomock.Eq("block-id")).Return(block, nil)
+		th.Store.EXPECT().GetBoard(gomock.Any(), boardID).Return(board, nil)
+		th.Store.EXPECT().GetMembersForBoard(gomock.Any(), boardID).Return([]*model.BoardMember{}, nil)
+		_, err := th.App.UndeleteBlock(context.Background(), "block-id", "user-id-1")
 		require.NoError(t, err)
 	})
 
@@ -178,11 +180,12 @@ func TestUndeleteBlock(t *testing.T) {
 			ID: "block-id",
 		}
 		th.Store.EXPECT().GetBlockHistory(
+			gomock.Any(),
 			gomock.Eq("block-id"),
 			gomock.Eq(model.QueryBlockHistoryOptions{Limit: 1, Descending: true}),
 		).Return([]*model.Block{block}, nil)
-		th.Store.EXPECT().UndeleteBlock(gomock.Eq("block-id"), gomock.Eq("user-id-1")).Return(blockError{"error"})
-		_, err := th.App.UndeleteBlock("block-id", "user-id-1")
+		th.Store.EXPECT().UndeleteBlock(gomock.Any(), gomock.Eq("block-id"), gomock.Eq("user-id-1")).Return(blockError{"error"})
+		_, err := th.App.UndeleteBlock(context.Background(), "block-id", "user-id-1")
 		require.Error(t, err, "error")
 	})
 }
@@ -195,11 +198,11 @@ func TestInsertBlocks(t *testing.T) {
 		boardID := testBoardID
 		block := &model.Block{BoardID: boardID}
 		board := &model.Board{ID: boardID}
-		th.Store.EXPECT().GetBoard(boardID).Return(board, nil)
-		th.Store.EXPECT().GetBlock(gomock.Any()).Return(nil, model.NewErrNotFound("block not found"))
-		th.Store.EXPECT().InsertBlock(block, "user-id-1").Return(nil)
-		th.Store.EXPECT().GetMembersForBoard(boardID).Return([]*model.BoardMember{}, nil)
-		_, err := th.App.InsertBlocks([]*model.Block{block}, "user-id-1")
+		th.Store.EXPECT().GetBoard(gomock.Any(), boardID).Return(board, nil)
+		th.Store.EXPECT().GetBlock(gomock.Any(), gomock.Any()).Return(nil, model.NewErrNotFound("block not found"))
+		th.Store.EXPECT().InsertBlock(gomock.Any(), block, "user-id-1").Return(nil)
+		th.Store.EXPECT().GetMembersForBoard(gomock.Any(), boardID).Return([]*model.BoardMember{}, nil)
+		_, err := th.App.InsertBlocks(context.Background(), []*model.Block{block}, "user-id-1")
 		require.NoError(t, err)
 	})
 
@@ -207,10 +210,10 @@ func TestInsertBlocks(t *testing.T) {
 		boardID := testBoardID
 		block := &model.Block{BoardID: boardID}
 		board := &model.Board{ID: boardID}
-		th.Store.EXPECT().GetBoard(boardID).Return(board, nil)
-		th.Store.EXPECT().GetBlock(gomock.Any()).Return(nil, model.NewErrNotFound("block not found"))
-		th.Store.EXPECT().InsertBlock(block, "user-id-1").Return(blockError{"error"})
-		_, err := th.App.InsertBlocks([]*model.Block{block}, "user-id-1")
+		th.Store.EXPECT().GetBoard(gomock.Any(), boardID).Return(board, nil)
+		th.Store.EXPECT().GetBlock(gomock.Any(), gomock.Any()).Return(nil, model.NewErrNotFound("block not found"))
+		th.Store.EXPECT().InsertBlock(gomock.Any(), block, "user-id-1").Return(blockError{"error"})
+		_, err := th.App.InsertBlocks(context.Background(), []*model.Block{block}, "user-id-1")
 		require.Error(t, err, "error")
 	})
 
@@ -224,22 +227,22 @@ func TestInsertBlocks(t *testing.T) {
 			BoardID:  boardID,
 		}
 		board := &model.Board{ID: boardID}
-		th.Store.EXPECT().GetBoard(boardID).Return(board, nil)
-		th.Store.EXPECT().GetBlock(gomock.Any()).Return(nil, model.NewErrNotFound("block not found"))
-		th.Store.EXPECT().InsertBlock(block, "user-id-1").Return(nil)
-		th.Store.EXPECT().GetMembersForBoard(boardID).Return([]*model.BoardMember{}, nil)
+		th.Store.EXPECT().GetBoard(gomock.Any(), boardID).Return(board, nil)
+		th.Store.EXPECT().GetBlock(gomock.Any(), gomock.Any()).Return(nil, model.NewErrNotFound("block not found"))
+		th.Store.EXPECT().InsertBlock(gomock.Any(), block, "user-id-1").Return(nil)
+		th.Store.EXPECT().GetMembersForBoard(gomock.Any(), boardID).Return([]*model.BoardMember{}, nil)
 
 		// setting up mocks for limits
 		fakeLicense := &mmModel.License{
 			Features: &mmModel.Features{Cloud: mmModel.NewPointer(true)},
 		}
-		th.Store.EXPECT().GetLicense().Return(fakeLicense)
+		th.Store.EXPECT().GetLicense(gomock.Any()).Return(fakeLicense)
 
-		th.Store.EXPECT().GetUsedCardsCount().Return(1, nil)
-		th.Store.EXPECT().GetCardLimitTimestamp().Return(int64(1), nil)
-		th.Store.EXPECT().GetBlocksWithParentAndType("test-board-id", "parent_id", "view").Return([]*model.Block{{}}, nil)
+		th.Store.EXPECT().GetUsedCardsCount(gomock.Any()).Return(1, nil)
+		th.Store.EXPECT().GetCardLimitTimestamp(gomock.Any()).Return(int64(1), nil)
+		th.Store.EXPECT().GetBlocksWithParentAndType(gomock.Any(), "test-board-id", "parent_id", "view").Return([]*model.Block{{}}, nil)
 
-		_, err := th.App.InsertBlocks([]*model.Block{block}, "user-id-1")
+		_, err := th.App.InsertBlocks(context.Background(), []*model.Block{block}, "user-id-1")
 		require.NoError(t, err)
 	})
 
@@ -253,20 +256,20 @@ func TestInsertBlocks(t *testing.T) {
 			BoardID:  boardID,
 		}
 		board := &model.Board{ID: boardID}
-		th.Store.EXPECT().GetBoard(boardID).Return(board, nil)
-		th.Store.EXPECT().GetBlock(gomock.Any()).Return(nil, model.NewErrNotFound("block not found"))
+		th.Store.EXPECT().GetBoard(gomock.Any(), boardID).Return(board, nil)
+		th.Store.EXPECT().GetBlock(gomock.Any(), gomock.Any()).Return(nil, model.NewErrNotFound("block not found"))
 
 		// setting up mocks for limits
 		fakeLicense := &mmModel.License{
 			Features: &mmModel.Features{Cloud: mmModel.NewPointer(true)},
 		}
-		th.Store.EXPECT().GetLicense().Return(fakeLicense)
+		th.Store.EXPECT().GetLicense(gomock.Any()).Return(fakeLicense)
 
-		th.Store.EXPECT().GetUsedCardsCount().Return(1, nil)
-		th.Store.EXPECT().GetCardLimitTimestamp().Return(int64(1), nil)
-		th.Store.EXPECT().GetBlocksWithParentAndType("test-board-id", "parent_id", "view").Return([]*model.Block{{}, {}}, nil)
+		th.Store.EXPECT().GetUsedCardsCount(gomock.Any()).Return(1, nil)
+		th.Store.EXPECT().GetCardLimitTimestamp(gomock.Any()).Return(int64(1), nil)
+		th.Store.EXPECT().GetBlocksWithParentAndType(gomock.Any(), "test-board-id", "parent_id", "view").Return([]*model.Block{{}, {}}, nil)
 
-		_, err := th.App.InsertBlocks([]*model.Block{block}, "user-id-1")
+		_, err := th.App.InsertBlocks(context.Background(), []*model.Block{block}, "user-id-1")
 		require.Error(t, err)
 	})
 
@@ -287,22 +290,22 @@ func TestInsertBlocks(t *testing.T) {
 		}
 
 		board := &model.Board{ID: boardID}
-		th.Store.EXPECT().GetBoard(boardID).Return(board, nil)
-		th.Store.EXPECT().GetBlock(gomock.Any()).Return(nil, model.NewErrNotFound("block not found")).Times(2)
-		th.Store.EXPECT().InsertBlock(view1, "user-id-1").Return(nil).Times(2)
-		th.Store.EXPECT().GetMembersForBoard(boardID).Return([]*model.BoardMember{}, nil).Times(2)
+		th.Store.EXPECT().GetBoard(gomock.Any(), boardID).Return(board, nil)
+		th.Store.EXPECT().GetBlock(gomock.Any(), gomock.Any()).Return(nil, model.NewErrNotFound("block not found")).Times(2)
+		th.Store.EXPECT().InsertBlock(gomock.Any(), view1, "user-id-1").Return(nil).Times(2)
+		th.Store.EXPECT().GetMembersForBoard(gomock.Any(), boardID).Return([]*model.BoardMember{}, nil).Times(2)
 
 		// setting up mocks for limits
 		fakeLicense := &mmModel.License{
 			Features: &mmModel.Features{Cloud: mmModel.NewPointer(true)},
 		}
-		th.Store.EXPECT().GetLicense().Return(fakeLicense).Times(2)
+		th.Store.EXPECT().GetLicense(gomock.Any()).Return(fakeLicense).Times(2)
 
-		th.Store.EXPECT().GetUsedCardsCount().Return(1, nil).Times(2)
-		th.Store.EXPECT().GetCardLimitTimestamp().Return(int64(1), nil).Times(2)
-		th.Store.EXPECT().GetBlocksWithParentAndType("test-board-id", "parent_id", "view").Return([]*model.Block{{}}, nil).Times(2)
+		th.Store.EXPECT().GetUsedCardsCount(gomock.Any()).Return(1, nil).Times(2)
+		th.Store.EXPECT().GetCardLimitTimestamp(gomock.Any()).Return(int64(1), nil).Times(2)
+		th.Store.EXPECT().GetBlocksWithParentAndType(gomock.Any(), "test-board-id", "parent_id", "view").Return([]*model.Block{{}}, nil).Times(2)
 
-		_, err := th.App.InsertBlocks([]*model.Block{view1, view2}, "user-id-1")
+		_, err := th.App.InsertBlocks(context.Background(), []*model.Block{view1, view2}, "user-id-1")
 		require.Error(t, err)
 	})
 }
@@ -326,9 +329,9 @@ func TestFilterAuthorizedFilesForBoard(t *testing.T) {
 			},
 		}
 
-		th.Store.EXPECT().GetBlockHistoryDescendants(boardID, gomock.Any()).Return([]*model.Block{historicalBlock}, nil)
+		th.Store.EXPECT().GetBlockHistoryDescendants(gomock.Any(), boardID, gomock.Any()).Return([]*model.Block{historicalBlock}, nil)
 
-		authorized, err := th.App.filterAuthorizedFilesForBoard(boardID, []string{fileID})
+		authorized, err := th.App.filterAuthorizedFilesForBoard(context.Background(), boardID, []string{fileID})
 		require.NoError(t, err)
 		require.Len(t, authorized, 1)
 		require.Equal(t, fileID, authorized[0])
@@ -339,9 +342,9 @@ func TestFilterAuthorizedFilesForBoard(t *testing.T) {
 		fileID := "file-from-other-board"
 
 		// Mock: No blocks in history reference this file (file belongs to different board)
-		th.Store.EXPECT().GetBlockHistoryDescendants(boardID, gomock.Any()).Return([]*model.Block{}, nil)
+		th.Store.EXPECT().GetBlockHistoryDescendants(gomock.Any(), boardID, gomock.Any()).Return([]*model.Block{}, nil)
 
-		authorized, err := th.App.filterAuthorizedFilesForBoard(boardID, []string{fileID})
+		authorized, err := th.App.filterAuthorizedFilesForBoard(context.Background(), boardID, []string{fileID})
 		require.NoError(t, err)
 		require.Len(t, authorized, 0) // File should be blocked
 	})
@@ -360,9 +363,9 @@ func TestFilterAuthorizedFilesForBoard(t *testing.T) {
 				model.BlockFieldFileId: storedFileID,
 			},
 		}
-		th.Store.EXPECT().GetBlockHistoryDescendants(boardID, gomock.Any()).Return([]*model.Block{historicalBlock}, nil)
+		th.Store.EXPECT().GetBlockHistoryDescendants(gomock.Any(), boardID, gomock.Any()).Return([]*model.Block{historicalBlock}, nil)
 
-		authorized, err := th.App.filterAuthorizedFilesForBoard(boardID, []string{fileID})
+		authorized, err := th.App.filterAuthorizedFilesForBoard(context.Background(), boardID, []string{fileID})
 		require.NoError(t, err)
 		require.Len(t, authorized, 1)
 		require.Equal(t, fileID, authorized[0])
@@ -371,7 +374,7 @@ func TestFilterAuthorizedFilesForBoard(t *testing.T) {
 	t.Run("should handle empty file list", func(t *testing.T) {
 		boardID := "board-1"
 
-		authorized, err := th.App.filterAuthorizedFilesForBoard(boardID, []string{})
+		authorized, err := th.App.filterAuthorizedFilesForBoard(context.Background(), boardID, []string{})
 		require.NoError(t, err)
 		require.Len(t, authorized, 0)
 	})
@@ -392,9 +395,9 @@ func TestFilterAuthorizedFilesForBoard(t *testing.T) {
 			},
 		}
 
-		th.Store.EXPECT().GetBlockHistoryDescendants(boardID, gomock.Any()).Return([]*model.Block{imageBlock}, nil)
+		th.Store.EXPECT().GetBlockHistoryDescendants(gomock.Any(), boardID, gomock.Any()).Return([]*model.Block{imageBlock}, nil)
 
-		authorized, err := th.App.filterAuthorizedFilesForBoard(boardID, []string{authorizedFile, unauthorizedFile})
+		authorized, err := th.App.filterAuthorizedFilesForBoard(context.Background(), boardID, []string{authorizedFile, unauthorizedFile})
 		require.NoError(t, err)
 		require.Len(t, authorized, 1)
 		require.Equal(t, authorizedFile, authorized[0])
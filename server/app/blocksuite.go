@@ -4,26 +4,139 @@
 package app
 
 import (
+	"context"
+
 	"github.com/mattermost/mattermost-plugin-boards/server/model"
+	"github.com/mattermost/mattermost-plugin-boards/server/services/blocksuite"
+	"github.com/mattermost/mattermost-plugin-boards/server/services/notify"
+
+	"github.com/mattermost/mattermost/server/public/shared/mlog"
 )
 
 // GetBlockSuiteDocByCardID retrieves a BlockSuite document by card_id.
-func (a *App) GetBlockSuiteDocByCardID(cardID string) (*model.BlockSuiteDoc, error) {
-	return a.store.GetBlockSuiteDocByCardID(cardID)
+func (a *App) GetBlockSuiteDocByCardID(ctx context.Context, cardID string) (*model.BlockSuiteDoc, error) {
+	ctx, span := startSpan(ctx, "GetBlockSuiteDocByCardID")
+	defer span.End()
+
+	return a.store.GetBlockSuiteDocByCardID(ctx, cardID)
 }
 
 // GetBlockSuiteDocInfoByCardID retrieves metadata (without snapshot) by card_id.
-func (a *App) GetBlockSuiteDocInfoByCardID(cardID string) (*model.BlockSuiteDocInfo, error) {
-	return a.store.GetBlockSuiteDocInfoByCardID(cardID)
+func (a *App) GetBlockSuiteDocInfoByCardID(ctx context.Context, cardID string) (*model.BlockSuiteDocInfo, error) {
+	ctx, span := startSpan(ctx, "GetBlockSuiteDocInfoByCardID")
+	defer span.End()
+
+	return a.store.GetBlockSuiteDocInfoByCardID(ctx, cardID)
 }
 
 // UpsertBlockSuiteDoc inserts or updates a BlockSuite document.
-func (a *App) UpsertBlockSuiteDoc(doc *model.BlockSuiteDoc) error {
-	return a.store.UpsertBlockSuiteDoc(doc)
+func (a *App) UpsertBlockSuiteDoc(ctx context.Context, doc *model.BlockSuiteDoc) error {
+	ctx, span := startSpan(ctx, "UpsertBlockSuiteDoc")
+	defer span.End()
+
+	var oldSnapshot []byte
+	if a.notifications != nil {
+		oldDoc, err := a.store.GetBlockSuiteDocByCardID(ctx, doc.CardID)
+		if err != nil && !model.IsErrNotFound(err) {
+			return err
+		}
+		if oldDoc != nil {
+			oldSnapshot = oldDoc.Snapshot
+		}
+	}
+
+	if err := a.store.UpsertBlockSuiteDoc(ctx, doc); err != nil {
+		return err
+	}
+
+	// decoding the snapshots is left off the save path.
+	a.blockChangeNotifier.Enqueue(func() error {
+		ctx := context.WithoutCancel(ctx)
+		a.notifyBlockSuiteDocChanged(ctx, doc, oldSnapshot)
+		return nil
+	})
+	return nil
 }
 
 // DeleteBlockSuiteDocByCardID deletes a BlockSuite document by card_id.
-func (a *App) DeleteBlockSuiteDocByCardID(cardID string) error {
-	return a.store.DeleteBlockSuiteDocByCardID(cardID)
+func (a *App) DeleteBlockSuiteDocByCardID(ctx context.Context, cardID string) error {
+	ctx, span := startSpan(ctx, "DeleteBlockSuiteDocByCardID")
+	defer span.End()
+
+	return a.store.DeleteBlockSuiteDocByCardID(ctx, cardID)
 }
 
+func (a *App) notifyBlockSuiteDocChanged(ctx context.Context, doc *model.BlockSuiteDoc, oldSnapshot []byte) {
+	// don't notify if notifications service disabled, or the change is generated via system user.
+	if a.notifications == nil || doc.UpdatedBy == model.SystemUserID {
+		return
+	}
+
+	// the snapshots are saved whatever their size, but the mentions of the ones too large to
+	// decode are not extracted.
+	if len(doc.Snapshot) > blocksuite.MaxSnapshotSize || len(oldSnapshot) > blocksuite.MaxSnapshotSize {
+		a.logger.Info("BlockSuite document too large to extract mentions; not notifying",
+			mlog.String("card_id", doc.CardID),
+			mlog.Int("snapshot_size", len(doc.Snapshot)),
+			mlog.Int("old_snapshot_size", len(oldSnapshot)),
+		)
+		return
+	}
+
+	paragraphs, err := blocksuite.ExtractText(doc.Snapshot)
+	if err != nil {
+		a.logger.Warn("Cannot extract text from BlockSuite document; not notifying",
+			mlog.String("card_id", doc.CardID),
+			mlog.Err(err),
+		)
+		return
+	}
+
+	// an unreadable old snapshot is not treated as empty, otherwise every existing mention would
+	// be notified again.
+	oldParagraphs, err := blocksuite.ExtractText(oldSnapshot)
+	if err != nil {
+		a.logger.Warn("Cannot extract text from previous BlockSuite document; not notifying",
+			mlog.String("card_id", doc.CardID),
+			mlog.Err(err),
+		)
+		return
+	}
+
+	card, err := a.store.GetBlock(ctx, doc.CardID)
+	if err != nil {
+		a.logger.Error("Error notifying for BlockSuite document change; cannot find card",
+			mlog.String("card_id", doc.CardID),
+			mlog.Err(err),
+		)
+		return
+	}
+
+	board, err := a.store.GetBoard(ctx, card.BoardID)
+	if err != nil {
+		a.logger.Error("Error notifying for BlockSuite document change; cannot find board",
+			mlog.String("board_id", card.BoardID),
+			mlog.Err(err),
+		)
+		return
+	}
+
+	boardMember, _ := a.GetMemberForBoard(ctx, board.ID, doc.UpdatedBy)
+	if boardMember == nil {
+		// create temporary guest board member
+		boardMember = &model.BoardMember{
+			BoardID: board.ID,
+			UserID:  doc.UpdatedBy,
+		}
+	}
+
+	evt := notify.ContentChangeEvent{
+		TeamID:        board.TeamID,
+		Board:         board,
+		Card:          card,
+		Paragraphs:    paragraphs,
+		OldParagraphs: oldParagraphs,
+		ModifiedBy:    boardMember,
+	}
+	a.notifications.ContentChanged(ctx, evt)
+}
@@ -0,0 +1,99 @@
+// Copyright (c) 2020-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package app
+
+import (
+	"context"
+
+	"github.com/mattermost/mattermost-plugin-boards/server/model"
+	"github.com/mattermost/mattermost-plugin-boards/server/utils"
+
+	"github.com/mattermost/mattermost/server/public/shared/mlog"
+)
+
+// PreviewBoardRestore returns the changes restoring a board to how it was at the given time
+// would make, without making them.
+func (a *App) PreviewBoardRestore(ctx context.Context, boardID string, at int64) (*model.BoardRestorePlan, error) {
+	ctx, span := startSpan(ctx, "PreviewBoardRestore")
+	defer span.End()
+
+	if err := validateBoardRestoreTime(at); err != nil {
+		return nil, err
+	}
+	return a.store.GetBoardRestorePlan(ctx, boardID, at)
+}
+
+// RestoreBoard restores the properties, views, cards and content blocks of a board to how they
+// were at the given time, in a single transaction, and returns the changes made. Subscribers are
+// not notified of the individual changes.
+func (a *App) RestoreBoard(ctx context.Context, boardID string, at int64, modifiedBy string) (*model.BoardRestorePlan, error) {
+	ctx, span := startSpan(ctx, "RestoreBoard")
+	defer span.End()
+
+	if err := validateBoardRestoreTime(at); err != nil {
+		return nil, err
+	}
+
+	plan, err := a.store.RestoreBoardToTime(ctx, boardID, at, modifiedBy)
+	if err != nil {
+		return nil, err
+	}
+	if plan.IsEmpty() {
+		return plan, nil
+	}
+
+	board, err := a.store.GetBoard(ctx, boardID)
+	if err != nil {
+		return nil, err
+	}
+
+	changedIDs := []string{}
+	deletedIDs := []string{}
+	for _, change := range plan.Blocks {
+		if change.Action == model.BlockRestoreDelete {
+			deletedIDs = append(deletedIDs, change.BlockID())
+		} else {
+			changedIDs = append(changedIDs, change.BlockID())
+		}
+	}
+
+	var changedBlocks []*model.Block
+	if len(changedIDs) > 0 {
+		changedBlocks, err = a.store.GetBlocksByIDs(ctx, changedIDs)
+		if err != nil && !model.IsErrNotFound(err) {
+			return nil, err
+		}
+	}
+
+	a.logger.Info("Restored board",
+		mlog.String("board_id", boardID),
+		mlog.Int("at", at),
+		mlog.Int("changed_blocks", len(changedIDs)),
+		mlog.Int("deleted_blocks", len(deletedIDs)),
+	)
+
+	a.blockChangeNotifier.Enqueue(func() error {
+		if plan.Board != nil {
+			a.wsAdapter.BroadcastBoardChange(board.TeamID, board)
+		}
+		for _, block := range changedBlocks {
+			a.wsAdapter.BroadcastBlockChange(board.TeamID, block)
+		}
+		for _, blockID := range deletedIDs {
+			a.wsAdapter.BroadcastBlockDelete(board.TeamID, blockID, boardID)
+		}
+		a.metrics.IncrementBlocksInserted(len(changedIDs))
+		a.metrics.IncrementBlocksDeleted(len(deletedIDs))
+		return nil
+	})
+
+	return plan, nil
+}
+
+func validateBoardRestoreTime(at int64) error {
+	if at <= 0 || at > utils.GetMillis() {
+		return model.NewErrBadRequest("restore time must be a past timestamp in milliseconds")
+	}
+	return nil
+}
@@ -0,0 +1,71 @@
+// Copyright (c) 2020-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package app
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mattermost/mattermost-plugin-boards/server/model"
+	"github.com/mattermost/mattermost-plugin-boards/server/utils"
+)
+
+func TestRestoreBoard(t *testing.T) {
+	th, tearDown := SetupTestHelper(t)
+	defer tearDown()
+
+	board := &model.Board{ID: testBoardID, TeamID: "team-id", Title: "Board"}
+	at := utils.GetMillis() - 1000
+
+	t.Run("invalid restore time", func(t *testing.T) {
+		_, err := th.App.RestoreBoard(context.Background(), testBoardID, utils.GetMillis()+60000, "user-id")
+		require.True(t, model.IsErrBadRequest(err))
+
+		_, err = th.App.PreviewBoardRestore(context.Background(), testBoardID, 0)
+		require.True(t, model.IsErrBadRequest(err))
+	})
+
+	t.Run("preview", func(t *testing.T) {
+		plan := &model.BoardRestorePlan{BoardID: testBoardID, At: at, Blocks: []*model.BlockRestoreChange{}}
+		th.Store.EXPECT().GetBoardRestorePlan(gomock.Any(), testBoardID, at).Return(plan, nil)
+
+		preview, err := th.App.PreviewBoardRestore(context.Background(), testBoardID, at)
+		require.NoError(t, err)
+		assert.Equal(t, plan, preview)
+	})
+
+	t.Run("restore", func(t *testing.T) {
+		restored := &model.Block{ID: "card-1", BoardID: testBoardID, Type: model.TypeCard, Title: "Card 1"}
+		plan := &model.BoardRestorePlan{
+			BoardID: testBoardID,
+			At:      at,
+			Board:   &model.BoardRestoreChange{Current: board, Restored: board},
+			Blocks: []*model.BlockRestoreChange{
+				{Action: model.BlockRestoreRecreate, Restored: restored},
+				{Action: model.BlockRestoreDelete, Current: &model.Block{ID: "card-2", BoardID: testBoardID}},
+			},
+		}
+		th.Store.EXPECT().RestoreBoardToTime(gomock.Any(), testBoardID, at, "user-id").Return(plan, nil)
+		th.Store.EXPECT().GetBoard(gomock.Any(), testBoardID).Return(board, nil)
+		th.Store.EXPECT().GetBlocksByIDs(gomock.Any(), []string{"card-1"}).Return([]*model.Block{restored}, nil)
+		th.Store.EXPECT().GetMembersForBoard(gomock.Any(), testBoardID).Return([]*model.BoardMember{}, nil).AnyTimes()
+
+		result, err := th.App.RestoreBoard(context.Background(), testBoardID, at, "user-id")
+		require.NoError(t, err)
+		assert.Equal(t, plan, result)
+	})
+
+	t.Run("nothing to restore", func(t *testing.T) {
+		plan := &model.BoardRestorePlan{BoardID: testBoardID, At: at, Blocks: []*model.BlockRestoreChange{}}
+		th.Store.EXPECT().RestoreBoardToTime(gomock.Any(), testBoardID, at, "user-id").Return(plan, nil)
+
+		result, err := th.App.RestoreBoard(context.Background(), testBoardID, at, "user-id")
+		require.NoError(t, err)
+		assert.True(t, result.IsEmpty())
+	})
+}
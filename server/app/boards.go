@@ -4,6 +4,7 @@
 package app
 
 import (
+	"context"
 	"errors"
 	"fmt"
 
@@ -24,28 +25,37 @@ const cardNotifyMessage = "@%s님이 보드 [%s](%s)의 카드 [%s](%s)에 대
 
 var errNoDefaultCategoryFound = errors.New("no default category found for user")
 
-func (a *App) GetBoard(boardID string) (*model.Board, error) {
-	board, err := a.store.GetBoard(boardID)
+func (a *App) GetBoard(ctx context.Context, boardID string) (*model.Board, error) {
+	ctx, span := startSpan(ctx, "GetBoard")
+	defer span.End()
+
+	board, err := a.store.GetBoard(ctx, boardID)
 	if err != nil {
 		return nil, err
 	}
 	return board, nil
 }
 
-func (a *App) GetBoardCount(includeDeleted bool) (int64, error) {
-	return a.store.GetBoardCount(includeDeleted)
+func (a *App) GetBoardCount(ctx context.Context, includeDeleted bool) (int64, error) {
+	ctx, span := startSpan(ctx, "GetBoardCount")
+	defer span.End()
+
+	return a.store.GetBoardCount(ctx, includeDeleted)
 }
 
-func (a *App) GetBoardMetadata(boardID string) (*model.Board, *model.BoardMetadata, error) {
-	license := a.store.GetLicense()
+func (a *App) GetBoardMetadata(ctx context.Context, boardID string) (*model.Board, *model.BoardMetadata, error) {
+	ctx, span := startSpan(ctx, "GetBoardMetadata")
+	defer span.End()
+
+	license := a.store.GetLicense(ctx)
 	if license == nil || !(*license.Features.Compliance) {
 		return nil, nil, model.ErrInsufficientLicense
 	}
 
-	board, err := a.GetBoard(boardID)
+	board, err := a.GetBoard(ctx, boardID)
 	if model.IsErrNotFound(err) {
 		// Board may have been deleted, retrieve most recent history instead
-		board, err = a.getBoardHistory(boardID, true)
+		board, err = a.getBoardHistory(ctx, boardID, true)
 		if err != nil {
 			return nil, nil, err
 		}
@@ -54,12 +64,12 @@ func (a *App) GetBoardMetadata(boardID string) (*model.Board, *model.BoardMetada
 		return nil, nil, err
 	}
 
-	earliestTime, _, err := a.getBoardDescendantModifiedInfo(boardID, false)
+	earliestTime, _, err := a.getBoardDescendantModifiedInfo(ctx, boardID, false)
 	if err != nil {
 		return nil, nil, err
 	}
 
-	latestTime, lastModifiedBy, err := a.getBoardDescendantModifiedInfo(boardID, true)
+	latestTime, lastModifiedBy, err := a.getBoardDescendantModifiedInfo(ctx, boardID, true)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -75,13 +85,13 @@ func (a *App) GetBoardMetadata(boardID string) (*model.Board, *model.BoardMetada
 }
 
 // getBoardForBlock returns the board that owns the specified block.
-func (a *App) getBoardForBlock(blockID string) (*model.Board, error) {
-	block, err := a.GetBlockByID(blockID)
+func (a *App) getBoardForBlock(ctx context.Context, blockID string) (*model.Board, error) {
+	block, err := a.GetBlockByID(ctx, blockID)
 	if err != nil {
 		return nil, fmt.Errorf("cannot get block %s: %w", blockID, err)
 	}
 
-	board, err := a.GetBoard(block.BoardID)
+	board, err := a.GetBoard(ctx, block.BoardID)
 	if err != nil {
 		return nil, fmt.Errorf("cannot get board %s: %w", block.BoardID, err)
 	}
@@ -89,12 +99,12 @@ func (a *App) getBoardForBlock(blockID string) (*model.Board, error) {
 	return board, nil
 }
 
-func (a *App) getBoardHistory(boardID string, latest bool) (*model.Board, error) {
+func (a *App) getBoardHistory(ctx context.Context, boardID string, latest bool) (*model.Board, error) {
 	opts := model.QueryBoardHistoryOptions{
 		Limit:      1,
 		Descending: latest,
 	}
-	boards, err := a.store.GetBoardHistory(boardID, opts)
+	boards, err := a.store.GetBoardHistory(ctx, boardID, opts)
 	if err != nil {
 		return nil, fmt.Errorf("could not get history for board: %w", err)
 	}
@@ -105,8 +115,8 @@ func (a *App) getBoardHistory(boardID string, latest bool) (*model.Board, error)
 	return boards[0], nil
 }
 
-func (a *App) getBoardDescendantModifiedInfo(boardID string, latest bool) (int64, string, error) {
-	board, err := a.getBoardHistory(boardID, latest)
+func (a *App) getBoardDescendantModifiedInfo(ctx context.Context, boardID string, latest bool) (int64, string, error) {
+	board, err := a.getBoardHistory(ctx, boardID, latest)
 	if err != nil {
 		return 0, "", err
 	}
@@ -127,7 +137,7 @@ func (a *App) getBoardDescendantModifiedInfo(boardID string, latest bool) (int64
 		Limit:      1,
 		Descending: latest,
 	}
-	blocks, err := a.store.GetBlockHistoryDescendants(boardID, opts)
+	blocks, err := a.store.GetBlockHistoryDescendants(ctx, boardID, opts)
 	if err != nil {
 		return 0, "", fmt.Errorf("could not get blocks history descendants for board: %w", err)
 	}
@@ -145,9 +155,9 @@ func (a *App) getBoardDescendantModifiedInfo(boardID string, latest bool) (int64
 	return timestamp, modifiedBy, nil
 }
 
-func (a *App) setBoardCategoryFromSource(sourceBoardID, destinationBoardID, userID, teamID string, asTemplate bool) error {
+func (a *App) setBoardCategoryFromSource(ctx context.Context, sourceBoardID, destinationBoardID, userID, teamID string, asTemplate bool) error {
 	// find source board's category ID for the user
-	userCategoryBoards, err := a.GetUserCategoryBoards(userID, teamID)
+	userCategoryBoards, err := a.GetUserCategoryBoards(ctx, userID, teamID)
 	if err != nil {
 		return err
 	}
@@ -168,7 +178,7 @@ func (a *App) setBoardCategoryFromSource(sourceBoardID, destinationBoardID, user
 		// if source board is not mapped to a category for this user,
 		// then move new board to default category
 		if !asTemplate {
-			return a.addBoardsToDefaultCategory(userID, teamID, []*model.Board{{ID: destinationBoardID}})
+			return a.addBoardsToDefaultCategory(ctx, userID, teamID, []*model.Board{{ID: destinationBoardID}})
 		} else {
 			return nil
 		}
@@ -176,20 +186,23 @@ func (a *App) setBoardCategoryFromSource(sourceBoardID, destinationBoardID, user
 
 	// now that we have source board's category,
 	// we send destination board to the same category
-	return a.AddUpdateUserCategoryBoard(teamID, userID, destinationCategoryID, []string{destinationBoardID})
+	return a.AddUpdateUserCategoryBoard(ctx, teamID, userID, destinationCategoryID, []string{destinationBoardID})
 }
 
-func (a *App) DuplicateBoard(boardID, userID, toTeam string, asTemplate bool) (*model.BoardsAndBlocks, []*model.BoardMember, error) {
-	bab, members, err := a.store.DuplicateBoard(boardID, userID, toTeam, asTemplate)
+func (a *App) DuplicateBoard(ctx context.Context, boardID, userID, toTeam string, asTemplate bool) (*model.BoardsAndBlocks, []*model.BoardMember, error) {
+	ctx, span := startSpan(ctx, "DuplicateBoard")
+	defer span.End()
+
+	bab, members, err := a.store.DuplicateBoard(ctx, boardID, userID, toTeam, asTemplate)
 	if err != nil {
 		return nil, nil, err
 	}
 
 	// copy any file attachments from the duplicated blocks.
-	err = a.CopyAndUpdateCardFiles(boardID, userID, bab.Blocks, asTemplate)
+	err = a.CopyAndUpdateCardFiles(ctx, boardID, userID, bab.Blocks, asTemplate)
 	if err != nil {
 		dbab := model.NewDeleteBoardsAndBlocksFromBabs(bab)
-		if dErr := a.store.DeleteBoardsAndBlocks(dbab, userID); dErr != nil {
+		if dErr := a.store.DeleteBoardsAndBlocks(ctx, dbab, userID); dErr != nil {
 			a.logger.Error("Cannot delete board after duplication error when updating block's file info", mlog.String("boardID", bab.Boards[0].ID), mlog.Err(dErr))
 		}
 		return nil, nil, fmt.Errorf("could not patch file IDs while duplicating board %s: %w", boardID, err)
@@ -197,13 +210,14 @@ func (a *App) DuplicateBoard(boardID, userID, toTeam string, asTemplate bool) (*
 
 	if !asTemplate {
 		for _, board := range bab.Boards {
-			if categoryErr := a.setBoardCategoryFromSource(boardID, board.ID, userID, toTeam, asTemplate); categoryErr != nil {
+			if categoryErr := a.setBoardCategoryFromSource(ctx, boardID, board.ID, userID, toTeam, asTemplate); categoryErr != nil {
 				return nil, nil, categoryErr
 			}
 		}
 	}
 
 	a.blockChangeNotifier.Enqueue(func() error {
+		ctx := context.WithoutCancel(ctx)
 		teamID := ""
 		for _, board := range bab.Boards {
 			teamID = board.TeamID
@@ -212,7 +226,7 @@ func (a *App) DuplicateBoard(boardID, userID, toTeam string, asTemplate bool) (*
 		for _, block := range bab.Blocks {
 			blk := block
 			a.wsAdapter.BroadcastBlockChange(teamID, blk)
-			a.notifyBlockChanged(notify.Add, blk, nil, userID)
+			a.notifyBlockChanged(ctx, notify.Add, blk, nil, userID)
 		}
 		for _, member := range members {
 			a.wsAdapter.BroadcastMemberChange(teamID, member.BoardID, member)
@@ -223,15 +237,24 @@ func (a *App) DuplicateBoard(boardID, userID, toTeam string, asTemplate bool) (*
 	return bab, members, err
 }
 
-func (a *App) GetBoardsForUserAndTeam(userID, teamID string, includePublicBoards bool) ([]*model.Board, error) {
-	return a.store.GetBoardsForUserAndTeam(userID, teamID, includePublicBoards)
+func (a *App) GetBoardsForUserAndTeam(ctx context.Context, userID, teamID string, includePublicBoards bool) ([]*model.Board, error) {
+	ctx, span := startSpan(ctx, "GetBoardsForUserAndTeam")
+	defer span.End()
+
+	return a.store.GetBoardsForUserAndTeam(ctx, userID, teamID, includePublicBoards)
 }
 
-func (a *App) GetTemplateBoards(teamID, userID string) ([]*model.Board, error) {
-	return a.store.GetTemplateBoards(teamID, userID)
+func (a *App) GetTemplateBoards(ctx context.Context, teamID, userID string) ([]*model.Board, error) {
+	ctx, span := startSpan(ctx, "GetTemplateBoards")
+	defer span.End()
+
+	return a.store.GetTemplateBoards(ctx, teamID, userID)
 }
 
-func (a *App) CreateBoard(board *model.Board, userID string, addMember bool) (*model.Board, error) {
+func (a *App) CreateBoard(ctx context.Context, board *model.Board, userID string, addMember bool) (*model.Board, error) {
+	ctx, span := startSpan(ctx, "CreateBoard")
+	defer span.End()
+
 	if board.ID != "" {
 		return nil, ErrNewBoardCannotHaveID
 	}
@@ -241,9 +264,9 @@ func (a *App) CreateBoard(board *model.Board, userID string, addMember bool) (*m
 	var member *model.BoardMember
 	var err error
 	if addMember {
-		newBoard, member, err = a.store.InsertBoardWithAdmin(board, userID)
+		newBoard, member, err = a.store.InsertBoardWithAdmin(ctx, board, userID)
 	} else {
-		newBoard, err = a.store.InsertBoard(board, userID)
+		newBoard, err = a.store.InsertBoard(ctx, board, userID)
 	}
 
 	if err != nil {
@@ -251,10 +274,11 @@ func (a *App) CreateBoard(board *model.Board, userID string, addMember bool) (*m
 	}
 
 	a.blockChangeNotifier.Enqueue(func() error {
+		ctx := context.WithoutCancel(ctx)
 		a.wsAdapter.BroadcastBoardChange(newBoard.TeamID, newBoard)
 
 		if newBoard.ChannelID != "" {
-			members, err := a.GetMembersForBoard(board.ID)
+			members, err := a.GetMembersForBoard(ctx, board.ID)
 			if err != nil {
 				a.logger.Error("Unable to get the board members", mlog.Err(err))
 			}
@@ -268,7 +292,7 @@ func (a *App) CreateBoard(board *model.Board, userID string, addMember bool) (*m
 	})
 
 	if !board.IsTemplate {
-		if err := a.addBoardsToDefaultCategory(userID, newBoard.TeamID, []*model.Board{newBoard}); err != nil {
+		if err := a.addBoardsToDefaultCategory(ctx, userID, newBoard.TeamID, []*model.Board{newBoard}); err != nil {
 			return nil, err
 		}
 	}
@@ -276,8 +300,8 @@ func (a *App) CreateBoard(board *model.Board, userID string, addMember bool) (*m
 	return newBoard, nil
 }
 
-func (a *App) addBoardsToDefaultCategory(userID, teamID string, boards []*model.Board) error {
-	userCategoryBoards, err := a.GetUserCategoryBoards(userID, teamID)
+func (a *App) addBoardsToDefaultCategory(ctx context.Context, userID, teamID string, boards []*model.Board) error {
+	userCategoryBoards, err := a.GetUserCategoryBoards(ctx, userID, teamID)
 	if err != nil {
 		return err
 	}
@@ -299,14 +323,17 @@ func (a *App) addBoardsToDefaultCategory(userID, teamID string, boards []*model.
 		boardIDs[i] = boards[i].ID
 	}
 
-	if err := a.AddUpdateUserCategoryBoard(teamID, userID, defaultCategoryID, boardIDs); err != nil {
+	if err := a.AddUpdateUserCategoryBoard(ctx, teamID, userID, defaultCategoryID, boardIDs); err != nil {
 		return err
 	}
 
 	return nil
 }
 
-func (a *App) PatchBoard(patch *model.BoardPatch, boardID, userID string) (*model.Board, error) {
+func (a *App) PatchBoard(ctx context.Context, patch *model.BoardPatch, boardID, userID string) (*model.Board, error) {
+	ctx, span := startSpan(ctx, "PatchBoard")
+	defer span.End()
+
 	var oldChannelID string
 	var isTemplate bool
 	var oldMembers []*model.BoardMember
@@ -315,7 +342,7 @@ func (a *App) PatchBoard(patch *model.BoardPatch, boardID, userID string) (*mode
 		testChannel := ""
 		if patch.ChannelID != nil && *patch.ChannelID == "" {
 			var err error
-			oldMembers, err = a.GetMembersForBoard(boardID)
+			oldMembers, err = a.GetMembersForBoard(ctx, boardID)
 			if err != nil {
 				a.logger.Error("Unable to get the board members", mlog.Err(err))
 			}
@@ -323,7 +350,7 @@ func (a *App) PatchBoard(patch *model.BoardPatch, boardID, userID string) (*mode
 			testChannel = *patch.ChannelID
 		}
 
-		board, err := a.store.GetBoard(boardID)
+		board, err := a.store.GetBoard(ctx, boardID)
 		if model.IsErrNotFound(err) {
 			return nil, model.NewErrNotFound("board ID=" + boardID)
 		}
@@ -343,7 +370,7 @@ func (a *App) PatchBoard(patch *model.BoardPatch, boardID, userID string) (*mode
 		}
 	}
 
-	updatedBoard, err := a.store.PatchBoard(boardID, patch, userID)
+	updatedBoard, err := a.store.PatchBoard(ctx, boardID, patch, userID)
 	if err != nil {
 		return nil, err
 	}
@@ -352,7 +379,7 @@ func (a *App) PatchBoard(patch *model.BoardPatch, boardID, userID string) (*mode
 	if patch.ChannelID != nil {
 		var username string
 
-		user, err := a.store.GetUserByID(userID)
+		user, err := a.store.GetUserByID(ctx, userID)
 		if err != nil {
 			a.logger.Error("Unable to get the board updater", mlog.Err(err))
 			username = "unknown"
@@ -366,19 +393,20 @@ func (a *App) PatchBoard(patch *model.BoardPatch, boardID, userID string) (*mode
 			title = "Untitled board" // todo: localize this when server has i18n
 		}
 		if *patch.ChannelID != "" {
-			a.postChannelMessage(fmt.Sprintf(linkBoardMessage, username, title, boardLink), updatedBoard.ChannelID)
+			a.postChannelMessage(ctx, fmt.Sprintf(linkBoardMessage, username, title, boardLink), updatedBoard.ChannelID)
 		} else if *patch.ChannelID == "" {
-			a.postChannelMessage(fmt.Sprintf(unlinkBoardMessage, username, title, boardLink), oldChannelID)
+			a.postChannelMessage(ctx, fmt.Sprintf(unlinkBoardMessage, username, title, boardLink), oldChannelID)
 		}
 	}
 
 	// Broadcast Messages to affected users
 	a.blockChangeNotifier.Enqueue(func() error {
+		ctx := context.WithoutCancel(ctx)
 		a.wsAdapter.BroadcastBoardChange(updatedBoard.TeamID, updatedBoard)
 
 		if patch.ChannelID != nil {
 			if *patch.ChannelID != "" {
-				members, err := a.GetMembersForBoard(updatedBoard.ID)
+				members, err := a.GetMembersForBoard(ctx, updatedBoard.ID)
 				if err != nil {
 					a.logger.Error("Unable to get the board members", mlog.Err(err))
 				}
@@ -397,11 +425,11 @@ func (a *App) PatchBoard(patch *model.BoardPatch, boardID, userID string) (*mode
 		}
 
 		if patch.Type != nil && isTemplate {
-			members, err := a.GetMembersForBoard(updatedBoard.ID)
+			members, err := a.GetMembersForBoard(ctx, updatedBoard.ID)
 			if err != nil {
 				a.logger.Error("Unable to get the board members", mlog.Err(err))
 			}
-			a.broadcastTeamUsers(updatedBoard.TeamID, updatedBoard.ID, *patch.Type, members)
+			a.broadcastTeamUsers(ctx, updatedBoard.TeamID, updatedBoard.ID, *patch.Type, members)
 		}
 		return nil
 	})
@@ -409,15 +437,18 @@ func (a *App) PatchBoard(patch *model.BoardPatch, boardID, userID string) (*mode
 	return updatedBoard, nil
 }
 
-func (a *App) postChannelMessage(message, channelID string) {
-	err := a.store.PostMessage(message, "", channelID)
+func (a *App) postChannelMessage(ctx context.Context, message, channelID string) {
+	err := a.store.PostMessage(ctx, message, "", channelID)
 	if err != nil {
 		a.logger.Error("Unable to post the link message to channel", mlog.Err(err))
 	}
 }
 
-func (a *App) SendCardNotification(boardID, userID, cardID string) error {
-	board, err := a.GetBoard(boardID)
+func (a *App) SendCardNotification(ctx context.Context, boardID, userID, cardID string) error {
+	ctx, span := startSpan(ctx, "SendCardNotification")
+	defer span.End()
+
+	board, err := a.GetBoard(ctx, boardID)
 	if err != nil {
 		return err
 	}
@@ -427,12 +458,12 @@ func (a *App) SendCardNotification(boardID, userID, cardID string) error {
 	}
 
 	// 카드 정보 가져오기
-	card, err := a.GetBlockByID(cardID)
+	card, err := a.GetBlockByID(ctx, cardID)
 	if err != nil {
 		return err
 	}
 
-	user, err := a.store.GetUserByID(userID)
+	user, err := a.store.GetUserByID(ctx, userID)
 	if err != nil {
 		return err
 	}
@@ -451,15 +482,15 @@ func (a *App) SendCardNotification(boardID, userID, cardID string) error {
 
 	// 기존 방식과 동일하게 메시지 전송
 	message := fmt.Sprintf(cardNotifyMessage, user.Username, cardTitle, cardLink, title, boardLink)
-	a.postChannelMessage(message, board.ChannelID)
+	a.postChannelMessage(ctx, message, board.ChannelID)
 	
 	return nil
 }
 
 // broadcastTeamUsers notifies the members of a team when a template changes its type
 // from public to private or viceversa.
-func (a *App) broadcastTeamUsers(teamID, boardID string, boardType model.BoardType, members []*model.BoardMember) {
-	users, err := a.GetTeamUsers(teamID, "")
+func (a *App) broadcastTeamUsers(ctx context.Context, teamID, boardID string, boardType model.BoardType, members []*model.BoardMember) {
+	users, err := a.GetTeamUsers(ctx, teamID, "")
 	if err != nil {
 		a.logger.Error("Unable to get the team users", mlog.Err(err))
 	}
@@ -481,8 +512,11 @@ func (a *App) broadcastTeamUsers(teamID, boardID string, boardType model.BoardTy
 	}
 }
 
-func (a *App) DeleteBoard(boardID, userID string) error {
-	board, err := a.store.GetBoard(boardID)
+func (a *App) DeleteBoard(ctx context.Context, boardID, userID string) error {
+	ctx, span := startSpan(ctx, "DeleteBoard")
+	defer span.End()
+
+	board, err := a.store.GetBoard(ctx, boardID)
 	if model.IsErrNotFound(err) {
 		return nil
 	}
@@ -490,7 +524,7 @@ func (a *App) DeleteBoard(boardID, userID string) error {
 		return err
 	}
 
-	if err := a.store.DeleteBoard(boardID, userID); err != nil {
+	if err := a.store.DeleteBoard(ctx, boardID, userID); err != nil {
 		return err
 	}
 
@@ -502,13 +536,16 @@ func (a *App) DeleteBoard(boardID, userID string) error {
 	return nil
 }
 
-func (a *App) GetMembersForBoard(boardID string) ([]*model.BoardMember, error) {
-	members, err := a.store.GetMembersForBoard(boardID)
+func (a *App) GetMembersForBoard(ctx context.Context, boardID string) ([]*model.BoardMember, error) {
+	ctx, span := startSpan(ctx, "GetMembersForBoard")
+	defer span.End()
+
+	members, err := a.store.GetMembersForBoard(ctx, boardID)
 	if err != nil {
 		return nil, err
 	}
 
-	board, err := a.store.GetBoard(boardID)
+	board, err := a.store.GetBoard(ctx, boardID)
 	if err != nil && !model.IsErrNotFound(err) {
 		return nil, err
 	}
@@ -524,15 +561,18 @@ func (a *App) GetMembersForBoard(boardID string) ([]*model.BoardMember, error) {
 	return members, nil
 }
 
-func (a *App) GetMembersForUser(userID string) ([]*model.BoardMember, error) {
-	members, err := a.store.GetMembersForUser(userID)
+func (a *App) GetMembersForUser(ctx context.Context, userID string) ([]*model.BoardMember, error) {
+	ctx, span := startSpan(ctx, "GetMembersForUser")
+	defer span.End()
+
+	members, err := a.store.GetMembersForUser(ctx, userID)
 	if err != nil {
 		return nil, err
 	}
 
 	for i, m := range members {
 		if !m.SchemeAdmin {
-			board, err := a.store.GetBoard(m.BoardID)
+			board, err := a.store.GetBoard(ctx, m.BoardID)
 			if err != nil && !model.IsErrNotFound(err) {
 				return nil, err
 			}
@@ -547,12 +587,18 @@ func (a *App) GetMembersForUser(userID string) ([]*model.BoardMember, error) {
 	return members, nil
 }
 
-func (a *App) GetMemberForBoard(boardID string, userID string) (*model.BoardMember, error) {
-	return a.store.GetMemberForBoard(boardID, userID)
+func (a *App) GetMemberForBoard(ctx context.Context, boardID string, userID string) (*model.BoardMember, error) {
+	ctx, span := startSpan(ctx, "GetMemberForBoard")
+	defer span.End()
+
+	return a.store.GetMemberForBoard(ctx, boardID, userID)
 }
 
-func (a *App) AddMemberToBoard(member *model.BoardMember) (*model.BoardMember, error) {
-	board, err := a.store.GetBoard(member.BoardID)
+func (a *App) AddMemberToBoard(ctx context.Context, member *model.BoardMember) (*model.BoardMember, error) {
+	ctx, span := startSpan(ctx, "AddMemberToBoard")
+	defer span.End()
+
+	board, err := a.store.GetBoard(ctx, member.BoardID)
 	if model.IsErrNotFound(err) {
 		return nil, nil
 	}
@@ -560,7 +606,7 @@ func (a *App) AddMemberToBoard(member *model.BoardMember) (*model.BoardMember, e
 		return nil, err
 	}
 
-	existingMembership, err := a.store.GetMemberForBoard(member.BoardID, member.UserID)
+	existingMembership, err := a.store.GetMemberForBoard(ctx, member.BoardID, member.UserID)
 	if err != nil && !model.IsErrNotFound(err) {
 		return nil, err
 	}
@@ -569,7 +615,7 @@ func (a *App) AddMemberToBoard(member *model.BoardMember) (*model.BoardMember, e
 		return existingMembership, nil
 	}
 
-	newMember, err := a.store.SaveMember(member)
+	newMember, err := a.store.SaveMember(ctx, member)
 	if err != nil {
 		return nil, err
 	}
@@ -583,7 +629,7 @@ func (a *App) AddMemberToBoard(member *model.BoardMember) (*model.BoardMember, e
 	}
 
 	if !board.IsTemplate {
-		if err = a.addBoardsToDefaultCategory(member.UserID, board.TeamID, []*model.Board{board}); err != nil {
+		if err = a.addBoardsToDefaultCategory(ctx, member.UserID, board.TeamID, []*model.Board{board}); err != nil {
 			return nil, err
 		}
 	}
@@ -596,8 +642,11 @@ func (a *App) AddMemberToBoard(member *model.BoardMember) (*model.BoardMember, e
 	return newMember, nil
 }
 
-func (a *App) UpdateBoardMember(member *model.BoardMember) (*model.BoardMember, error) {
-	board, bErr := a.store.GetBoard(member.BoardID)
+func (a *App) UpdateBoardMember(ctx context.Context, member *model.BoardMember) (*model.BoardMember, error) {
+	ctx, span := startSpan(ctx, "UpdateBoardMember")
+	defer span.End()
+
+	board, bErr := a.store.GetBoard(ctx, member.BoardID)
 	if model.IsErrNotFound(bErr) {
 		return nil, nil
 	}
@@ -605,7 +654,7 @@ func (a *App) UpdateBoardMember(member *model.BoardMember) (*model.BoardMember,
 		return nil, bErr
 	}
 
-	oldMember, err := a.store.GetMemberForBoard(member.BoardID, member.UserID)
+	oldMember, err := a.store.GetMemberForBoard(ctx, member.BoardID, member.UserID)
 	if model.IsErrNotFound(err) {
 		return nil, nil
 	}
@@ -616,7 +665,7 @@ func (a *App) UpdateBoardMember(member *model.BoardMember) (*model.BoardMember,
 	// if we're updating an admin, we need to check that there is at
 	// least still another admin on the board
 	if oldMember.SchemeAdmin && !member.SchemeAdmin {
-		isLastAdmin, err2 := a.isLastAdmin(member.UserID, member.BoardID)
+		isLastAdmin, err2 := a.isLastAdmin(ctx, member.UserID, member.BoardID)
 		if err2 != nil {
 			return nil, err2
 		}
@@ -625,7 +674,7 @@ func (a *App) UpdateBoardMember(member *model.BoardMember) (*model.BoardMember,
 		}
 	}
 
-	newMember, err := a.store.SaveMember(member)
+	newMember, err := a.store.SaveMember(ctx, member)
 	if err != nil {
 		return nil, err
 	}
@@ -638,8 +687,8 @@ func (a *App) UpdateBoardMember(member *model.BoardMember) (*model.BoardMember,
 	return newMember, nil
 }
 
-func (a *App) isLastAdmin(userID, boardID string) (bool, error) {
-	members, err := a.store.GetMembersForBoard(boardID)
+func (a *App) isLastAdmin(ctx context.Context, userID, boardID string) (bool, error) {
+	members, err := a.store.GetMembersForBoard(ctx, boardID)
 	if err != nil {
 		return false, err
 	}
@@ -652,8 +701,11 @@ func (a *App) isLastAdmin(userID, boardID string) (bool, error) {
 	return true, nil
 }
 
-func (a *App) DeleteBoardMember(boardID, userID string) error {
-	board, bErr := a.store.GetBoard(boardID)
+func (a *App) DeleteBoardMember(ctx context.Context, boardID, userID string) error {
+	ctx, span := startSpan(ctx, "DeleteBoardMember")
+	defer span.End()
+
+	board, bErr := a.store.GetBoard(ctx, boardID)
 	if model.IsErrNotFound(bErr) {
 		return nil
 	}
@@ -661,7 +713,7 @@ func (a *App) DeleteBoardMember(boardID, userID string) error {
 		return bErr
 	}
 
-	oldMember, err := a.store.GetMemberForBoard(boardID, userID)
+	oldMember, err := a.store.GetMemberForBoard(ctx, boardID, userID)
 	if model.IsErrNotFound(err) {
 		return nil
 	}
@@ -672,7 +724,7 @@ func (a *App) DeleteBoardMember(boardID, userID string) error {
 	// if we're removing an admin, we need to check that there is at
 	// least still another admin on the board
 	if oldMember.SchemeAdmin {
-		isLastAdmin, err := a.isLastAdmin(userID, boardID)
+		isLastAdmin, err := a.isLastAdmin(ctx, userID, boardID)
 		if err != nil {
 			return err
 		}
@@ -681,12 +733,13 @@ func (a *App) DeleteBoardMember(boardID, userID string) error {
 		}
 	}
 
-	if err := a.store.DeleteMember(boardID, userID); err != nil {
+	if err := a.store.DeleteMember(ctx, boardID, userID); err != nil {
 		return err
 	}
 
 	a.blockChangeNotifier.Enqueue(func() error {
-		if syntheticMember, _ := a.GetMemberForBoard(boardID, userID); syntheticMember != nil {
+		ctx := context.WithoutCancel(ctx)
+		if syntheticMember, _ := a.GetMemberForBoard(ctx, boardID, userID); syntheticMember != nil {
 			a.wsAdapter.BroadcastMemberChange(board.TeamID, boardID, syntheticMember)
 		} else {
 			a.wsAdapter.BroadcastMemberDelete(board.TeamID, boardID, userID)
@@ -697,16 +750,25 @@ func (a *App) DeleteBoardMember(boardID, userID string) error {
 	return nil
 }
 
-func (a *App) SearchBoardsForUser(term string, searchField model.BoardSearchField, userID string, includePublicBoards bool) ([]*model.Board, error) {
-	return a.store.SearchBoardsForUser(term, searchField, userID, includePublicBoards)
+func (a *App) SearchBoardsForUser(ctx context.Context, term string, searchField model.BoardSearchField, userID string, includePublicBoards bool) ([]*model.Board, error) {
+	ctx, span := startSpan(ctx, "SearchBoardsForUser")
+	defer span.End()
+
+	return a.store.SearchBoardsForUser(ctx, term, searchField, userID, includePublicBoards)
 }
 
-func (a *App) SearchBoardsForUserInTeam(teamID, term, userID string) ([]*model.Board, error) {
-	return a.store.SearchBoardsForUserInTeam(teamID, term, userID)
+func (a *App) SearchBoardsForUserInTeam(ctx context.Context, teamID, term, userID string) ([]*model.Board, error) {
+	ctx, span := startSpan(ctx, "SearchBoardsForUserInTeam")
+	defer span.End()
+
+	return a.store.SearchBoardsForUserInTeam(ctx, teamID, term, userID)
 }
 
-func (a *App) UndeleteBoard(boardID string, modifiedBy string) error {
-	boards, err := a.store.GetBoardHistory(boardID, model.QueryBoardHistoryOptions{Limit: 1, Descending: true})
+func (a *App) UndeleteBoard(ctx context.Context, boardID string, modifiedBy string) error {
+	ctx, span := startSpan(ctx, "UndeleteBoard")
+	defer span.End()
+
+	boards, err := a.store.GetBoardHistory(ctx, boardID, model.QueryBoardHistoryOptions{Limit: 1, Descending: true})
 	if err != nil {
 		return err
 	}
@@ -716,12 +778,12 @@ func (a *App) UndeleteBoard(boardID string, modifiedBy string) error {
 		return nil
 	}
 
-	err = a.store.UndeleteBoard(boardID, modifiedBy)
+	err = a.store.UndeleteBoard(ctx, boardID, modifiedBy)
 	if err != nil {
 		return err
 	}
 
-	board, err := a.store.GetBoard(boardID)
+	board, err := a.store.GetBoard(ctx, boardID)
 	if err != nil {
 		return err
 	}
@@ -4,21 +4,26 @@
 package app
 
 import (
+	"context"
+
 	"github.com/mattermost/mattermost-plugin-boards/server/model"
 	"github.com/mattermost/mattermost-plugin-boards/server/services/notify"
 
 	"github.com/mattermost/mattermost/server/public/shared/mlog"
 )
 
-func (a *App) CreateBoardsAndBlocks(bab *model.BoardsAndBlocks, userID string, addMember bool) (*model.BoardsAndBlocks, error) {
+func (a *App) CreateBoardsAndBlocks(ctx context.Context, bab *model.BoardsAndBlocks, userID string, addMember bool) (*model.BoardsAndBlocks, error) {
+	ctx, span := startSpan(ctx, "CreateBoardsAndBlocks")
+	defer span.End()
+
 	var newBab *model.BoardsAndBlocks
 	var members []*model.BoardMember
 	var err error
 
 	if addMember {
-		newBab, members, err = a.store.CreateBoardsAndBlocksWithAdmin(bab, userID)
+		newBab, members, err = a.store.CreateBoardsAndBlocksWithAdmin(ctx, bab, userID)
 	} else {
-		newBab, err = a.store.CreateBoardsAndBlocks(bab, userID)
+		newBab, err = a.store.CreateBoardsAndBlocks(ctx, bab, userID)
 	}
 
 	if err != nil {
@@ -38,7 +43,7 @@ func (a *App) CreateBoardsAndBlocks(bab *model.BoardsAndBlocks, userID string, a
 		a.wsAdapter.BroadcastBlockChange(teamID, b)
 		a.metrics.IncrementBlocksInserted(1)
 		a.webhook.NotifyUpdate(b)
-		a.notifyBlockChanged(notify.Add, b, nil, userID)
+		a.notifyBlockChanged(ctx, notify.Add, b, nil, userID)
 	}
 
 	if addMember {
@@ -49,7 +54,7 @@ func (a *App) CreateBoardsAndBlocks(bab *model.BoardsAndBlocks, userID string, a
 
 	for _, board := range newBab.Boards {
 		if !board.IsTemplate {
-			if err := a.addBoardsToDefaultCategory(userID, board.TeamID, []*model.Board{board}); err != nil {
+			if err := a.addBoardsToDefaultCategory(ctx, userID, board.TeamID, []*model.Board{board}); err != nil {
 				return nil, err
 			}
 		}
@@ -58,8 +63,11 @@ func (a *App) CreateBoardsAndBlocks(bab *model.BoardsAndBlocks, userID string, a
 	return newBab, nil
 }
 
-func (a *App) PatchBoardsAndBlocks(pbab *model.PatchBoardsAndBlocks, userID string) (*model.BoardsAndBlocks, error) {
-	oldBlocks, err := a.store.GetBlocksByIDs(pbab.BlockIDs)
+func (a *App) PatchBoardsAndBlocks(ctx context.Context, pbab *model.PatchBoardsAndBlocks, userID string) (*model.BoardsAndBlocks, error) {
+	ctx, span := startSpan(ctx, "PatchBoardsAndBlocks")
+	defer span.End()
+
+	oldBlocks, err := a.store.GetBlocksByIDs(ctx, pbab.BlockIDs)
 	if err != nil {
 		return nil, err
 	}
@@ -69,12 +77,13 @@ func (a *App) PatchBoardsAndBlocks(pbab *model.PatchBoardsAndBlocks, userID stri
 		oldBlocksMap[block.ID] = block
 	}
 
-	bab, err := a.store.PatchBoardsAndBlocks(pbab, userID)
+	bab, err := a.store.PatchBoardsAndBlocks(ctx, pbab, userID)
 	if err != nil {
 		return nil, err
 	}
 
 	a.blockChangeNotifier.Enqueue(func() error {
+		ctx := context.WithoutCancel(ctx)
 		teamID := bab.Boards[0].TeamID
 
 		for _, block := range bab.Blocks {
@@ -88,7 +97,7 @@ func (a *App) PatchBoardsAndBlocks(pbab *model.PatchBoardsAndBlocks, userID stri
 			a.metrics.IncrementBlocksPatched(1)
 			a.wsAdapter.BroadcastBlockChange(teamID, b)
 			a.webhook.NotifyUpdate(b)
-			a.notifyBlockChanged(notify.Update, b, oldBlock, userID)
+			a.notifyBlockChanged(ctx, notify.Update, b, oldBlock, userID)
 		}
 
 		for _, board := range bab.Boards {
@@ -100,8 +109,11 @@ func (a *App) PatchBoardsAndBlocks(pbab *model.PatchBoardsAndBlocks, userID stri
 	return bab, nil
 }
 
-func (a *App) DeleteBoardsAndBlocks(dbab *model.DeleteBoardsAndBlocks, userID string) error {
-	firstBoard, err := a.store.GetBoard(dbab.Boards[0])
+func (a *App) DeleteBoardsAndBlocks(ctx context.Context, dbab *model.DeleteBoardsAndBlocks, userID string) error {
+	ctx, span := startSpan(ctx, "DeleteBoardsAndBlocks")
+	defer span.End()
+
+	firstBoard, err := a.store.GetBoard(ctx, dbab.Boards[0])
 	if err != nil {
 		return err
 	}
@@ -110,22 +122,23 @@ func (a *App) DeleteBoardsAndBlocks(dbab *model.DeleteBoardsAndBlocks, userID st
 	// fetch and store the blocks first
 	blocks := []*model.Block{}
 	for _, blockID := range dbab.Blocks {
-		block, err := a.store.GetBlock(blockID)
+		block, err := a.store.GetBlock(ctx, blockID)
 		if err != nil {
 			return err
 		}
 		blocks = append(blocks, block)
 	}
 
-	if err := a.store.DeleteBoardsAndBlocks(dbab, userID); err != nil {
+	if err := a.store.DeleteBoardsAndBlocks(ctx, dbab, userID); err != nil {
 		return err
 	}
 
 	a.blockChangeNotifier.Enqueue(func() error {
+		ctx := context.WithoutCancel(ctx)
 		for _, block := range blocks {
 			a.wsAdapter.BroadcastBlockDelete(firstBoard.TeamID, block.ID, block.BoardID)
 			a.metrics.IncrementBlocksDeleted(1)
-			a.notifyBlockChanged(notify.Update, block, block, userID)
+			a.notifyBlockChanged(ctx, notify.Update, block, block, userID)
 		}
 
 		for _, boardID := range dbab.Boards {
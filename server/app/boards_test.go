@@ -4,10 +4,12 @@
 package app
 
 import (
+	"context"
 	"testing"
 
 	"github.com/mattermost/mattermost-plugin-boards/server/utils"
 
+	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/assert"
 
 	"github.com/mattermost/mattermost-plugin-boards/server/model"
@@ -29,14 +31,14 @@ func TestAddMemberToBoard(t *testing.T) {
 			SchemeEditor: true,
 		}
 
-		th.Store.EXPECT().GetBoard(boardID).Return(&model.Board{
+		th.Store.EXPECT().GetBoard(gomock.Any(), boardID).Return(&model.Board{
 			ID:     "board_id_1",
 			TeamID: "team_id_1",
 		}, nil)
 
-		th.Store.EXPECT().GetMemberForBoard(boardID, userID).Return(nil, nil)
+		th.Store.EXPECT().GetMemberForBoard(gomock.Any(), boardID, userID).Return(nil, nil)
 
-		th.Store.EXPECT().SaveMember(mock.MatchedBy(func(i interface{}) bool {
+		th.Store.EXPECT().SaveMember(gomock.Any(), mock.MatchedBy(func(i interface{}) bool {
 			p := i.(*model.BoardMember)
 			return p.BoardID == boardID && p.UserID == userID
 		})).Return(&model.BoardMember{
@@ -44,9 +46,9 @@ func TestAddMemberToBoard(t *testing.T) {
 		}, nil)
 
 		// for WS change broadcast
-		th.Store.EXPECT().GetMembersForBoard(boardID).Return([]*model.BoardMember{}, nil)
+		th.Store.EXPECT().GetMembersForBoard(gomock.Any(), boardID).Return([]*model.BoardMember{}, nil)
 
-		th.Store.EXPECT().GetUserCategoryBoards("user_id_1", "team_id_1").Return([]model.CategoryBoards{
+		th.Store.EXPECT().GetUserCategoryBoards(gomock.Any(), "user_id_1", "team_id_1").Return([]model.CategoryBoards{
 			{
 				Category: model.Category{
 					ID:   "default_category_id",
@@ -55,9 +57,9 @@ func TestAddMemberToBoard(t *testing.T) {
 				},
 			},
 		}, nil).Times(2)
-		th.Store.EXPECT().AddUpdateCategoryBoard("user_id_1", "default_category_id", []string{"board_id_1"}).Return(nil)
+		th.Store.EXPECT().AddUpdateCategoryBoard(gomock.Any(), "user_id_1", "default_category_id", []string{"board_id_1"}).Return(nil)
 
-		addedBoardMember, err := th.App.AddMemberToBoard(boardMember)
+		addedBoardMember, err := th.App.AddMemberToBoard(context.Background(), boardMember)
 		require.NoError(t, err)
 		require.Equal(t, boardID, addedBoardMember.BoardID)
 	})
@@ -72,17 +74,17 @@ func TestAddMemberToBoard(t *testing.T) {
 			SchemeEditor: true,
 		}
 
-		th.Store.EXPECT().GetBoard(boardID).Return(&model.Board{
+		th.Store.EXPECT().GetBoard(gomock.Any(), boardID).Return(&model.Board{
 			TeamID: "team_id_1",
 		}, nil)
 
-		th.Store.EXPECT().GetMemberForBoard(boardID, userID).Return(&model.BoardMember{
+		th.Store.EXPECT().GetMemberForBoard(gomock.Any(), boardID, userID).Return(&model.BoardMember{
 			UserID:    userID,
 			BoardID:   boardID,
 			Synthetic: false,
 		}, nil)
 
-		addedBoardMember, err := th.App.AddMemberToBoard(boardMember)
+		addedBoardMember, err := th.App.AddMemberToBoard(context.Background(), boardMember)
 		require.NoError(t, err)
 		require.Equal(t, boardID, addedBoardMember.BoardID)
 	})
@@ -97,18 +99,18 @@ func TestAddMemberToBoard(t *testing.T) {
 			SchemeEditor: true,
 		}
 
-		th.Store.EXPECT().GetBoard(boardID).Return(&model.Board{
+		th.Store.EXPECT().GetBoard(gomock.Any(), boardID).Return(&model.Board{
 			ID:     "board_id_1",
 			TeamID: "team_id_1",
 		}, nil)
 
-		th.Store.EXPECT().GetMemberForBoard(boardID, userID).Return(&model.BoardMember{
+		th.Store.EXPECT().GetMemberForBoard(gomock.Any(), boardID, userID).Return(&model.BoardMember{
 			UserID:    userID,
 			BoardID:   boardID,
 			Synthetic: true,
 		}, nil)
 
-		th.Store.EXPECT().SaveMember(mock.MatchedBy(func(i interface{}) bool {
+		th.Store.EXPECT().SaveMember(gomock.Any(), mock.MatchedBy(func(i interface{}) bool {
 			p := i.(*model.BoardMember)
 			return p.BoardID == boardID && p.UserID == userID
 		})).Return(&model.BoardMember{
@@ -118,9 +120,9 @@ func TestAddMemberToBoard(t *testing.T) {
 		}, nil)
 
 		// for WS change broadcast
-		th.Store.EXPECT().GetMembersForBoard(boardID).Return([]*model.BoardMember{}, nil)
+		th.Store.EXPECT().GetMembersForBoard(gomock.Any(), boardID).Return([]*model.BoardMember{}, nil)
 
-		th.Store.EXPECT().GetUserCategoryBoards("user_id_1", "team_id_1").Return([]model.CategoryBoards{
+		th.Store.EXPECT().GetUserCategoryBoards(gomock.Any(), "user_id_1", "team_id_1").Return([]model.CategoryBoards{
 			{
 				Category: model.Category{
 					ID:   "default_category_id",
@@ -129,10 +131,10 @@ func TestAddMemberToBoard(t *testing.T) {
 				},
 			},
 		}, nil).Times(2)
-		th.Store.EXPECT().AddUpdateCategoryBoard("user_id_1", "default_category_id", []string{"board_id_1"}).Return(nil)
+		th.Store.EXPECT().AddUpdateCategoryBoard(gomock.Any(), "user_id_1", "default_category_id", []string{"board_id_1"}).Return(nil)
 		th.API.EXPECT().HasPermissionToTeam("user_id_1", "team_id_1", model.PermissionManageTeam).Return(false).Times(1)
 
-		addedBoardMember, err := th.App.AddMemberToBoard(boardMember)
+		addedBoardMember, err := th.App.AddMemberToBoard(context.Background(), boardMember)
 		require.NoError(t, err)
 		require.Equal(t, boardID, addedBoardMember.BoardID)
 	})
@@ -152,7 +154,7 @@ func TestPatchBoard(t *testing.T) {
 			Title: &patchTitle,
 		}
 
-		th.Store.EXPECT().PatchBoard(boardID, patch, userID).Return(
+		th.Store.EXPECT().PatchBoard(gomock.Any(), boardID, patch, userID).Return(
 			&model.Board{
 				ID:     boardID,
 				TeamID: teamID,
@@ -161,9 +163,9 @@ func TestPatchBoard(t *testing.T) {
 			nil)
 
 		// for WS BroadcastBoardChange
-		th.Store.EXPECT().GetMembersForBoard(boardID).Return([]*model.BoardMember{}, nil).Times(1)
+		th.Store.EXPECT().GetMembersForBoard(gomock.Any(), boardID).Return([]*model.BoardMember{}, nil).Times(1)
 
-		patchedBoard, err := th.App.PatchBoard(patch, boardID, userID)
+		patchedBoard, err := th.App.PatchBoard(context.Background(), patch, boardID, userID)
 		require.NoError(t, err)
 		require.Equal(t, patchTitle, patchedBoard.Title)
 	})
@@ -180,17 +182,17 @@ func TestPatchBoard(t *testing.T) {
 
 		// Type not nil, will cause board to be reteived
 		// to check isTemplate
-		th.Store.EXPECT().GetBoard(boardID).Return(&model.Board{
+		th.Store.EXPECT().GetBoard(gomock.Any(), boardID).Return(&model.Board{
 			ID:         boardID,
 			TeamID:     teamID,
 			IsTemplate: true,
 		}, nil).Times(2)
 
 		// Type not null will retrieve team members
-		th.Store.EXPECT().GetUsersByTeam(teamID, "", false, false).Return([]*model.User{}, nil)
-		th.Store.EXPECT().GetUserByID(userID).Return(&model.User{ID: userID, Username: "UserName"}, nil)
+		th.Store.EXPECT().GetUsersByTeam(gomock.Any(), teamID, "", false, false).Return([]*model.User{}, nil)
+		th.Store.EXPECT().GetUserByID(gomock.Any(), userID).Return(&model.User{ID: userID, Username: "UserName"}, nil)
 
-		th.Store.EXPECT().PatchBoard(boardID, patch, userID).Return(
+		th.Store.EXPECT().PatchBoard(gomock.Any(), boardID, patch, userID).Return(
 			&model.Board{
 				ID:     boardID,
 				TeamID: teamID,
@@ -200,9 +202,9 @@ func TestPatchBoard(t *testing.T) {
 		// Should call GetMembersForBoard 2 times
 		// - for WS BroadcastBoardChange
 		// - for AddTeamMembers check
-		th.Store.EXPECT().GetMembersForBoard(boardID).Return([]*model.BoardMember{}, nil).Times(2)
+		th.Store.EXPECT().GetMembersForBoard(gomock.Any(), boardID).Return([]*model.BoardMember{}, nil).Times(2)
 
-		patchedBoard, err := th.App.PatchBoard(patch, boardID, userID)
+		patchedBoard, err := th.App.PatchBoard(context.Background(), patch, boardID, userID)
 		require.NoError(t, err)
 		require.Equal(t, boardID, patchedBoard.ID)
 	})
@@ -219,16 +221,16 @@ func TestPatchBoard(t *testing.T) {
 
 		// Type not nil, will cause board to be reteived
 		// to check isTemplate
-		th.Store.EXPECT().GetBoard(boardID).Return(&model.Board{
+		th.Store.EXPECT().GetBoard(gomock.Any(), boardID).Return(&model.Board{
 			ID:         boardID,
 			TeamID:     teamID,
 			IsTemplate: true,
 		}, nil).Times(2)
 
 		// Type not null will retrieve team members
-		th.Store.EXPECT().GetUsersByTeam(teamID, "", false, false).Return([]*model.User{}, nil)
+		th.Store.EXPECT().GetUsersByTeam(gomock.Any(), teamID, "", false, false).Return([]*model.User{}, nil)
 
-		th.Store.EXPECT().PatchBoard(boardID, patch, userID).Return(
+		th.Store.EXPECT().PatchBoard(gomock.Any(), boardID, patch, userID).Return(
 			&model.Board{
 				ID:     boardID,
 				TeamID: teamID,
@@ -238,9 +240,9 @@ func TestPatchBoard(t *testing.T) {
 		// Should call GetMembersForBoard 2 times
 		// - for WS BroadcastBoardChange
 		// - for AddTeamMembers check
-		th.Store.EXPECT().GetMembersForBoard(boardID).Return([]*model.BoardMember{}, nil).Times(2)
+		th.Store.EXPECT().GetMembersForBoard(gomock.Any(), boardID).Return([]*model.BoardMember{}, nil).Times(2)
 
-		patchedBoard, err := th.App.PatchBoard(patch, boardID, userID)
+		patchedBoard, err := th.App.PatchBoard(context.Background(), patch, boardID, userID)
 		require.NoError(t, err)
 		require.Equal(t, boardID, patchedBoard.ID)
 	})
@@ -257,15 +259,15 @@ func TestPatchBoard(t *testing.T) {
 
 		// Type not nil, will cause board to be reteived
 		// to check isTemplate
-		th.Store.EXPECT().GetBoard(boardID).Return(&model.Board{
+		th.Store.EXPECT().GetBoard(gomock.Any(), boardID).Return(&model.Board{
 			ID:         boardID,
 			TeamID:     teamID,
 			IsTemplate: true,
 		}, nil).Times(2)
 		// Type not null will retrieve team members
-		th.Store.EXPECT().GetUsersByTeam(teamID, "", false, false).Return([]*model.User{{ID: userID}}, nil)
+		th.Store.EXPECT().GetUsersByTeam(gomock.Any(), teamID, "", false, false).Return([]*model.User{{ID: userID}}, nil)
 
-		th.Store.EXPECT().PatchBoard(boardID, patch, userID).Return(
+		th.Store.EXPECT().PatchBoard(gomock.Any(), boardID, patch, userID).Return(
 			&model.Board{
 				ID:     boardID,
 				TeamID: teamID,
@@ -276,9 +278,9 @@ func TestPatchBoard(t *testing.T) {
 		// for WS BroadcastBoardChange
 		// for AddTeamMembers check
 		// for WS BroadcastMemberChange
-		th.Store.EXPECT().GetMembersForBoard(boardID).Return([]*model.BoardMember{}, nil).Times(3)
+		th.Store.EXPECT().GetMembersForBoard(gomock.Any(), boardID).Return([]*model.BoardMember{}, nil).Times(3)
 
-		patchedBoard, err := th.App.PatchBoard(patch, boardID, userID)
+		patchedBoard, err := th.App.PatchBoard(context.Background(), patch, boardID, userID)
 		require.NoError(t, err)
 		require.Equal(t, boardID, patchedBoard.ID)
 	})
@@ -295,15 +297,15 @@ func TestPatchBoard(t *testing.T) {
 
 		// Type not nil, will cause board to be reteived
 		// to check isTemplate
-		th.Store.EXPECT().GetBoard(boardID).Return(&model.Board{
+		th.Store.EXPECT().GetBoard(gomock.Any(), boardID).Return(&model.Board{
 			ID:         boardID,
 			TeamID:     teamID,
 			IsTemplate: true,
 		}, nil).Times(2)
 		// Type not null will retrieve team members
-		th.Store.EXPECT().GetUsersByTeam(teamID, "", false, false).Return([]*model.User{{ID: userID}}, nil)
+		th.Store.EXPECT().GetUsersByTeam(gomock.Any(), teamID, "", false, false).Return([]*model.User{{ID: userID}}, nil)
 
-		th.Store.EXPECT().PatchBoard(boardID, patch, userID).Return(
+		th.Store.EXPECT().PatchBoard(gomock.Any(), boardID, patch, userID).Return(
 			&model.Board{
 				ID:     boardID,
 				TeamID: teamID,
@@ -314,9 +316,9 @@ func TestPatchBoard(t *testing.T) {
 		// for WS BroadcastBoardChange
 		// for AddTeamMembers check
 		// for WS BroadcastMemberChange
-		th.Store.EXPECT().GetMembersForBoard(boardID).Return([]*model.BoardMember{}, nil).Times(3)
+		th.Store.EXPECT().GetMembersForBoard(gomock.Any(), boardID).Return([]*model.BoardMember{}, nil).Times(3)
 
-		patchedBoard, err := th.App.PatchBoard(patch, boardID, userID)
+		patchedBoard, err := th.App.PatchBoard(context.Background(), patch, boardID, userID)
 		require.NoError(t, err)
 		require.Equal(t, boardID, patchedBoard.ID)
 	})
@@ -333,7 +335,7 @@ func TestPatchBoard(t *testing.T) {
 
 		// Type not nil, will cause board to be reteived
 		// to check isTemplate
-		th.Store.EXPECT().GetBoard(boardID).Return(&model.Board{
+		th.Store.EXPECT().GetBoard(gomock.Any(), boardID).Return(&model.Board{
 			ID:         boardID,
 			TeamID:     teamID,
 			IsTemplate: true,
@@ -342,9 +344,9 @@ func TestPatchBoard(t *testing.T) {
 		th.API.EXPECT().HasPermissionToTeam(userID, teamID, model.PermissionManageTeam).Return(false).Times(1)
 
 		// Type not null will retrieve team members
-		th.Store.EXPECT().GetUsersByTeam(teamID, "", false, false).Return([]*model.User{{ID: userID}}, nil)
+		th.Store.EXPECT().GetUsersByTeam(gomock.Any(), teamID, "", false, false).Return([]*model.User{{ID: userID}}, nil)
 
-		th.Store.EXPECT().PatchBoard(boardID, patch, userID).Return(
+		th.Store.EXPECT().PatchBoard(gomock.Any(), boardID, patch, userID).Return(
 			&model.Board{
 				ID:     boardID,
 				TeamID: teamID,
@@ -355,9 +357,9 @@ func TestPatchBoard(t *testing.T) {
 		// for WS BroadcastBoardChange
 		// for AddTeamMembers check
 		// We are returning the user as a direct Board Member, so BroadcastMemberDelete won't be called
-		th.Store.EXPECT().GetMembersForBoard(boardID).Return([]*model.BoardMember{{BoardID: boardID, UserID: userID, SchemeEditor: true}}, nil).Times(2)
+		th.Store.EXPECT().GetMembersForBoard(gomock.Any(), boardID).Return([]*model.BoardMember{{BoardID: boardID, UserID: userID, SchemeEditor: true}}, nil).Times(2)
 
-		patchedBoard, err := th.App.PatchBoard(patch, boardID, userID)
+		patchedBoard, err := th.App.PatchBoard(context.Background(), patch, boardID, userID)
 		require.NoError(t, err)
 		require.Equal(t, boardID, patchedBoard.ID)
 	})
@@ -374,7 +376,7 @@ func TestPatchBoard(t *testing.T) {
 
 		// Type not nil, will cause board to be reteived
 		// to check isTemplate
-		th.Store.EXPECT().GetBoard(boardID).Return(&model.Board{
+		th.Store.EXPECT().GetBoard(gomock.Any(), boardID).Return(&model.Board{
 			ID:         boardID,
 			TeamID:     teamID,
 			IsTemplate: true,
@@ -384,9 +386,9 @@ func TestPatchBoard(t *testing.T) {
 		th.API.EXPECT().HasPermissionToTeam(userID, teamID, model.PermissionManageTeam).Return(false).Times(1)
 
 		// Type not null will retrieve team members
-		th.Store.EXPECT().GetUsersByTeam(teamID, "", false, false).Return([]*model.User{{ID: userID}}, nil)
+		th.Store.EXPECT().GetUsersByTeam(gomock.Any(), teamID, "", false, false).Return([]*model.User{{ID: userID}}, nil)
 
-		th.Store.EXPECT().PatchBoard(boardID, patch, userID).Return(
+		th.Store.EXPECT().PatchBoard(gomock.Any(), boardID, patch, userID).Return(
 			&model.Board{
 				ID:     boardID,
 				TeamID: teamID,
@@ -397,9 +399,9 @@ func TestPatchBoard(t *testing.T) {
 		// for WS BroadcastBoardChange
 		// for AddTeamMembers check
 		// We are returning the user as a direct Board Member, so BroadcastMemberDelete won't be called
-		th.Store.EXPECT().GetMembersForBoard(boardID).Return([]*model.BoardMember{{BoardID: boardID, UserID: userID, SchemeEditor: true}}, nil).Times(2)
+		th.Store.EXPECT().GetMembersForBoard(gomock.Any(), boardID).Return([]*model.BoardMember{{BoardID: boardID, UserID: userID, SchemeEditor: true}}, nil).Times(2)
 
-		patchedBoard, err := th.App.PatchBoard(patch, boardID, userID)
+		patchedBoard, err := th.App.PatchBoard(context.Background(), patch, boardID, userID)
 		require.NoError(t, err)
 		require.Equal(t, boardID, patchedBoard.ID)
 	})
@@ -418,14 +420,14 @@ func TestPatchBoard(t *testing.T) {
 
 		// Type not nil, will cause board to be reteived
 		// to check isTemplate
-		th.Store.EXPECT().GetBoard(boardID).Return(&model.Board{
+		th.Store.EXPECT().GetBoard(gomock.Any(), boardID).Return(&model.Board{
 			ID:         boardID,
 			TeamID:     teamID,
 			IsTemplate: true,
 		}, nil).Times(1)
 
 		th.API.EXPECT().HasPermissionToChannel(userID, channelID, model.PermissionCreatePost).Return(false).Times(1)
-		_, err := th.App.PatchBoard(patch, boardID, userID)
+		_, err := th.App.PatchBoard(context.Background(), patch, boardID, userID)
 		require.Error(t, err)
 	})
 
@@ -441,14 +443,14 @@ func TestPatchBoard(t *testing.T) {
 
 		// Type not nil, will cause board to be reteived
 		// to check isTemplate
-		th.Store.EXPECT().GetBoard(boardID).Return(&model.Board{
+		th.Store.EXPECT().GetBoard(gomock.Any(), boardID).Return(&model.Board{
 			ID:     boardID,
 			TeamID: teamID,
 		}, nil).Times(2)
 
 		th.API.EXPECT().HasPermissionToChannel(userID, channelID, model.PermissionCreatePost).Return(true).Times(1)
 
-		th.Store.EXPECT().PatchBoard(boardID, patch, userID).Return(
+		th.Store.EXPECT().PatchBoard(gomock.Any(), boardID, patch, userID).Return(
 			&model.Board{
 				ID:     boardID,
 				TeamID: teamID,
@@ -458,11 +460,11 @@ func TestPatchBoard(t *testing.T) {
 		// Should call GetMembersForBoard 2 times
 		// - for WS BroadcastBoardChange
 		// - for AddTeamMembers check
-		th.Store.EXPECT().GetMembersForBoard(boardID).Return([]*model.BoardMember{}, nil).Times(2)
+		th.Store.EXPECT().GetMembersForBoard(gomock.Any(), boardID).Return([]*model.BoardMember{}, nil).Times(2)
 
-		th.Store.EXPECT().PostMessage(utils.Anything, "", "").Times(1)
+		th.Store.EXPECT().PostMessage(gomock.Any(), utils.Anything, "", "").Times(1)
 
-		patchedBoard, err := th.App.PatchBoard(patch, boardID, userID)
+		patchedBoard, err := th.App.PatchBoard(context.Background(), patch, boardID, userID)
 		require.NoError(t, err)
 		require.Equal(t, boardID, patchedBoard.ID)
 	})
@@ -482,7 +484,7 @@ func TestPatchBoard(t *testing.T) {
 
 		// Type not nil, will cause board to be reteived
 		// to check isTemplate
-		th.Store.EXPECT().GetBoard(boardID).Return(&model.Board{
+		th.Store.EXPECT().GetBoard(gomock.Any(), boardID).Return(&model.Board{
 			ID:         boardID,
 			TeamID:     teamID,
 			IsTemplate: true,
@@ -496,9 +498,9 @@ func TestPatchBoard(t *testing.T) {
 		// for WS BroadcastBoardChange
 		// for AddTeamMembers check
 		// We are returning the user as a direct Board Member, so BroadcastMemberDelete won't be called
-		th.Store.EXPECT().GetMembersForBoard(boardID).Return([]*model.BoardMember{{BoardID: boardID, UserID: userID, SchemeEditor: true}}, nil).Times(1)
+		th.Store.EXPECT().GetMembersForBoard(gomock.Any(), boardID).Return([]*model.BoardMember{{BoardID: boardID, UserID: userID, SchemeEditor: true}}, nil).Times(1)
 
-		_, err := th.App.PatchBoard(patch, boardID, userID)
+		_, err := th.App.PatchBoard(context.Background(), patch, boardID, userID)
 		require.Error(t, err)
 	})
 }
@@ -509,17 +511,17 @@ func TestGetBoardCount(t *testing.T) {
 
 	t.Run("base case", func(t *testing.T) {
 		boardCount := int64(100)
-		th.Store.EXPECT().GetBoardCount(false).Return(boardCount, nil)
+		th.Store.EXPECT().GetBoardCount(gomock.Any(), false).Return(boardCount, nil)
 
-		count, err := th.App.GetBoardCount(false)
+		count, err := th.App.GetBoardCount(context.Background(), false)
 		require.NoError(t, err)
 		require.Equal(t, boardCount, count)
 	})
 	t.Run("include deleted", func(t *testing.T) {
 		boardCount := int64(100)
-		th.Store.EXPECT().GetBoardCount(true).Return(boardCount, nil)
+		th.Store.EXPECT().GetBoardCount(gomock.Any(), true).Return(boardCount, nil)
 
-		count, err := th.App.GetBoardCount(true)
+		count, err := th.App.GetBoardCount(context.Background(), true)
 		require.NoError(t, err)
 		require.Equal(t, boardCount, count)
 	})
@@ -530,7 +532,7 @@ func TestBoardCategory(t *testing.T) {
 	defer tearDown()
 
 	t.Run("no boards default category exists", func(t *testing.T) {
-		th.Store.EXPECT().GetUserCategoryBoards("user_id", "team_id").Return([]model.CategoryBoards{
+		th.Store.EXPECT().GetUserCategoryBoards(gomock.Any(), "user_id", "team_id").Return([]model.CategoryBoards{
 			{
 				Category: model.Category{ID: "category_id_1", Name: "Category 1"},
 				BoardMetadata: []model.CategoryBoardMetadata{
@@ -551,7 +553,7 @@ func TestBoardCategory(t *testing.T) {
 		}, nil).Times(1)
 
 		// when this function is called the second time, the default category is created
-		th.Store.EXPECT().GetUserCategoryBoards("user_id", "team_id").Return([]model.CategoryBoards{
+		th.Store.EXPECT().GetUserCategoryBoards(gomock.Any(), "user_id", "team_id").Return([]model.CategoryBoards{
 			{
 				Category: model.Category{ID: "category_id_1", Name: "Category 1"},
 				BoardMetadata: []model.CategoryBoardMetadata{
@@ -574,14 +576,14 @@ func TestBoardCategory(t *testing.T) {
 			},
 		}, nil).Times(1)
 
-		th.Store.EXPECT().CreateCategory(utils.Anything).Return(nil)
-		th.Store.EXPECT().GetCategory(utils.Anything).Return(&model.Category{
+		th.Store.EXPECT().CreateCategory(gomock.Any(), utils.Anything).Return(nil)
+		th.Store.EXPECT().GetCategory(gomock.Any(), utils.Anything).Return(&model.Category{
 			ID:   "default_category_id",
 			Name: "Boards",
 		}, nil)
-		th.Store.EXPECT().GetMembersForUser("user_id").Return([]*model.BoardMember{}, nil)
-		th.Store.EXPECT().GetBoardsForUserAndTeam("user_id", "team_id", false).Return([]*model.Board{}, nil)
-		th.Store.EXPECT().AddUpdateCategoryBoard("user_id", "default_category_id", []string{
+		th.Store.EXPECT().GetMembersForUser(gomock.Any(), "user_id").Return([]*model.BoardMember{}, nil)
+		th.Store.EXPECT().GetBoardsForUserAndTeam(gomock.Any(), "user_id", "team_id", false).Return([]*model.Board{}, nil)
+		th.Store.EXPECT().AddUpdateCategoryBoard(gomock.Any(), "user_id", "default_category_id", []string{
 			"board_id_1",
 			"board_id_2",
 			"board_id_3",
@@ -593,7 +595,7 @@ func TestBoardCategory(t *testing.T) {
 			{ID: "board_id_3"},
 		}
 
-		err := th.App.addBoardsToDefaultCategory("user_id", "team_id", boards)
+		err := th.App.addBoardsToDefaultCategory(context.Background(), "user_id", "team_id", boards)
 		assert.NoError(t, err)
 	})
 }
@@ -613,7 +615,7 @@ func TestDuplicateBoard(t *testing.T) {
 			Type: "image",
 		}
 
-		th.Store.EXPECT().DuplicateBoard("board_id_1", "user_id_1", "team_id_1", false).Return(
+		th.Store.EXPECT().DuplicateBoard(gomock.Any(), "board_id_1", "user_id_1", "team_id_1", false).Return(
 			&model.BoardsAndBlocks{
 				Boards: []*model.Board{
 					board,
@@ -626,9 +628,9 @@ func TestDuplicateBoard(t *testing.T) {
 			nil,
 		)
 
-		th.Store.EXPECT().GetBoard("board_id_1").Return(&model.Board{}, nil)
+		th.Store.EXPECT().GetBoard(gomock.Any(), "board_id_1").Return(&model.Board{}, nil)
 
-		th.Store.EXPECT().GetUserCategoryBoards("user_id_1", "team_id_1").Return([]model.CategoryBoards{
+		th.Store.EXPECT().GetUserCategoryBoards(gomock.Any(), "user_id_1", "team_id_1").Return([]model.CategoryBoards{
 			{
 				Category: model.Category{
 					ID:   "category_id_1",
@@ -638,12 +640,12 @@ func TestDuplicateBoard(t *testing.T) {
 			},
 		}, nil).Times(3)
 
-		th.Store.EXPECT().AddUpdateCategoryBoard("user_id_1", "category_id_1", utils.Anything).Return(nil)
+		th.Store.EXPECT().AddUpdateCategoryBoard(gomock.Any(), "user_id_1", "category_id_1", utils.Anything).Return(nil)
 
 		// for WS change broadcast
-		th.Store.EXPECT().GetMembersForBoard(utils.Anything).Return([]*model.BoardMember{}, nil).Times(2)
+		th.Store.EXPECT().GetMembersForBoard(gomock.Any(), utils.Anything).Return([]*model.BoardMember{}, nil).Times(2)
 
-		bab, members, err := th.App.DuplicateBoard("board_id_1", "user_id_1", "team_id_1", false)
+		bab, members, err := th.App.DuplicateBoard(context.Background(), "board_id_1", "user_id_1", "team_id_1", false)
 		assert.NoError(t, err)
 		assert.NotNil(t, bab)
 		assert.NotNil(t, members)
@@ -660,7 +662,7 @@ func TestDuplicateBoard(t *testing.T) {
 			Type: "image",
 		}
 
-		th.Store.EXPECT().DuplicateBoard("board_id_1", "user_id_1", "team_id_1", true).Return(
+		th.Store.EXPECT().DuplicateBoard(gomock.Any(), "board_id_1", "user_id_1", "team_id_1", true).Return(
 			&model.BoardsAndBlocks{
 				Boards: []*model.Board{
 					board,
@@ -673,12 +675,12 @@ func TestDuplicateBoard(t *testing.T) {
 			nil,
 		)
 
-		th.Store.EXPECT().GetBoard("board_id_1").Return(&model.Board{}, nil)
+		th.Store.EXPECT().GetBoard(gomock.Any(), "board_id_1").Return(&model.Board{}, nil)
 
 		// for WS change broadcast
-		th.Store.EXPECT().GetMembersForBoard(utils.Anything).Return([]*model.BoardMember{}, nil).Times(2)
+		th.Store.EXPECT().GetMembersForBoard(gomock.Any(), utils.Anything).Return([]*model.BoardMember{}, nil).Times(2)
 
-		bab, members, err := th.App.DuplicateBoard("board_id_1", "user_id_1", "team_id_1", true)
+		bab, members, err := th.App.DuplicateBoard(context.Background(), "board_id_1", "user_id_1", "team_id_1", true)
 		assert.NoError(t, err)
 		assert.NotNil(t, bab)
 		assert.NotNil(t, members)
@@ -693,16 +695,16 @@ func TestGetMembersForBoard(t *testing.T) {
 	const userID = "user_id_1"
 	const teamID = "team_id_1"
 
-	th.Store.EXPECT().GetMembersForBoard(boardID).Return([]*model.BoardMember{
+	th.Store.EXPECT().GetMembersForBoard(gomock.Any(), boardID).Return([]*model.BoardMember{
 		{
 			BoardID:      boardID,
 			UserID:       userID,
 			SchemeEditor: true,
 		},
 	}, nil).Times(3)
-	th.Store.EXPECT().GetBoard(boardID).Return(nil, nil).Times(1)
+	th.Store.EXPECT().GetBoard(gomock.Any(), boardID).Return(nil, nil).Times(1)
 	t.Run("-base case", func(t *testing.T) {
-		members, err := th.App.GetMembersForBoard(boardID)
+		members, err := th.App.GetMembersForBoard(context.Background(), boardID)
 		assert.NoError(t, err)
 		assert.NotNil(t, members)
 		assert.False(t, members[0].SchemeAdmin)
@@ -712,11 +714,11 @@ func TestGetMembersForBoard(t *testing.T) {
 		ID:     boardID,
 		TeamID: teamID,
 	}
-	th.Store.EXPECT().GetBoard(boardID).Return(board, nil).Times(2)
+	th.Store.EXPECT().GetBoard(gomock.Any(), boardID).Return(board, nil).Times(2)
 	th.API.EXPECT().HasPermissionToTeam(userID, teamID, model.PermissionManageTeam).Return(false).Times(1)
 
 	t.Run("-team check false ", func(t *testing.T) {
-		members, err := th.App.GetMembersForBoard(boardID)
+		members, err := th.App.GetMembersForBoard(context.Background(), boardID)
 		assert.NoError(t, err)
 		assert.NotNil(t, members)
 
@@ -725,7 +727,7 @@ func TestGetMembersForBoard(t *testing.T) {
 
 	th.API.EXPECT().HasPermissionToTeam(userID, teamID, model.PermissionManageTeam).Return(true).Times(1)
 	t.Run("-team check true", func(t *testing.T) {
-		members, err := th.App.GetMembersForBoard(boardID)
+		members, err := th.App.GetMembersForBoard(context.Background(), boardID)
 		assert.NoError(t, err)
 		assert.NotNil(t, members)
 
@@ -741,16 +743,16 @@ func TestGetMembersForUser(t *testing.T) {
 	const userID = "user_id_1"
 	const teamID = "team_id_1"
 
-	th.Store.EXPECT().GetMembersForUser(userID).Return([]*model.BoardMember{
+	th.Store.EXPECT().GetMembersForUser(gomock.Any(), userID).Return([]*model.BoardMember{
 		{
 			BoardID:      boardID,
 			UserID:       userID,
 			SchemeEditor: true,
 		},
 	}, nil).Times(3)
-	th.Store.EXPECT().GetBoard(boardID).Return(nil, nil)
+	th.Store.EXPECT().GetBoard(gomock.Any(), boardID).Return(nil, nil)
 	t.Run("-base case", func(t *testing.T) {
-		members, err := th.App.GetMembersForUser(userID)
+		members, err := th.App.GetMembersForUser(context.Background(), userID)
 		assert.NoError(t, err)
 		assert.NotNil(t, members)
 		assert.False(t, members[0].SchemeAdmin)
@@ -760,11 +762,11 @@ func TestGetMembersForUser(t *testing.T) {
 		ID:     boardID,
 		TeamID: teamID,
 	}
-	th.Store.EXPECT().GetBoard(boardID).Return(board, nil).Times(2)
+	th.Store.EXPECT().GetBoard(gomock.Any(), boardID).Return(board, nil).Times(2)
 
 	th.API.EXPECT().HasPermissionToTeam(userID, teamID, model.PermissionManageTeam).Return(false).Times(1)
 	t.Run("-team check false ", func(t *testing.T) {
-		members, err := th.App.GetMembersForUser(userID)
+		members, err := th.App.GetMembersForUser(context.Background(), userID)
 		assert.NoError(t, err)
 		assert.NotNil(t, members)
 
@@ -773,7 +775,7 @@ func TestGetMembersForUser(t *testing.T) {
 
 	th.API.EXPECT().HasPermissionToTeam(userID, teamID, model.PermissionManageTeam).Return(true).Times(1)
 	t.Run("-team check true", func(t *testing.T) {
-		members, err := th.App.GetMembersForUser(userID)
+		members, err := th.App.GetMembersForUser(context.Background(), userID)
 		assert.NoError(t, err)
 		assert.NotNil(t, members)
 
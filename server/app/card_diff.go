@@ -0,0 +1,52 @@
+// Copyright (c) 2020-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package app
+
+import (
+	"context"
+
+	"github.com/mattermost/mattermost-plugin-boards/server/model"
+	"github.com/mattermost/mattermost-plugin-boards/server/services/notify/notifysubscriptions"
+	"github.com/mattermost/mattermost-plugin-boards/server/services/store"
+)
+
+// GetCardDiff returns the differences between the versions of a card, and of its content blocks,
+// at two times. Property values are displayed with the current property schema of the board.
+func (a *App) GetCardDiff(ctx context.Context, cardID string, from, to int64) (*model.CardDiff, error) {
+	ctx, span := startSpan(ctx, "GetCardDiff")
+	defer span.End()
+
+	if from < 0 || to <= from {
+		return nil, model.NewErrBadRequest("`from` must be a timestamp before `to`")
+	}
+
+	card, err := a.GetCardByID(ctx, cardID)
+	if err != nil {
+		return nil, err
+	}
+	board, err := a.GetBoard(ctx, card.BoardID)
+	if err != nil {
+		return nil, err
+	}
+
+	return notifysubscriptions.GenerateCardDiff(diffStore{ctx: ctx, store: a.store}, board, cardID, from, to, a.logger)
+}
+
+// diffStore binds the context of a request to the store methods used to compute diffs.
+type diffStore struct {
+	ctx   context.Context
+	store store.Store
+}
+
+func (d diffStore) GetBlockHistory(blockID string, opts model.QueryBlockHistoryOptions) ([]*model.Block, error) {
+	return d.store.GetBlockHistory(d.ctx, blockID, opts)
+}
+
+func (d diffStore) GetBlockHistoryNewestChildren(parentID string, opts model.QueryBlockHistoryChildOptions) ([]*model.Block, bool, error) {
+	return d.store.GetBlockHistoryNewestChildren(d.ctx, parentID, opts)
+}
+
+func (d diffStore) GetUserByID(userID string) (*model.User, error) {
+	return d.store.GetUserByID(d.ctx, userID)
+}
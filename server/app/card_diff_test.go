@@ -0,0 +1,50 @@
+// Copyright (c) 2020-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package app
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mattermost/mattermost-plugin-boards/server/model"
+)
+
+func TestGetCardDiff(t *testing.T) {
+	th, tearDown := SetupTestHelper(t)
+	defer tearDown()
+
+	t.Run("invalid times", func(t *testing.T) {
+		_, err := th.App.GetCardDiff(context.Background(), "card-id", 200, 100)
+		require.True(t, model.IsErrBadRequest(err))
+
+		_, err = th.App.GetCardDiff(context.Background(), "card-id", -1, 100)
+		require.True(t, model.IsErrBadRequest(err))
+	})
+
+	t.Run("title changed", func(t *testing.T) {
+		board := &model.Board{ID: testBoardID}
+		oldCard := &model.Block{ID: "card-id", BoardID: testBoardID, ParentID: testBoardID, Type: model.TypeCard, Title: "Old", ModifiedBy: "user-1", UpdateAt: 50}
+		newCard := &model.Block{ID: "card-id", BoardID: testBoardID, ParentID: testBoardID, Type: model.TypeCard, Title: "New", ModifiedBy: "user-2", UpdateAt: 150}
+
+		th.Store.EXPECT().GetBlock(gomock.Any(), "card-id").Return(newCard, nil)
+		th.Store.EXPECT().GetBoard(gomock.Any(), testBoardID).Return(board, nil)
+		th.Store.EXPECT().GetBlockHistory(gomock.Any(), "card-id", model.QueryBlockHistoryOptions{BeforeUpdateAt: 201, Limit: 1, Descending: true}).Return([]*model.Block{newCard}, nil)
+		th.Store.EXPECT().GetBlockHistory(gomock.Any(), "card-id", model.QueryBlockHistoryOptions{BeforeUpdateAt: 101, Limit: 1, Descending: true}).Return([]*model.Block{oldCard}, nil)
+		th.Store.EXPECT().GetBlockHistory(gomock.Any(), "card-id", model.QueryBlockHistoryOptions{AfterUpdateAt: 100, BeforeUpdateAt: 201, Descending: true}).Return([]*model.Block{newCard}, nil)
+		th.Store.EXPECT().GetBlockHistoryNewestChildren(gomock.Any(), "card-id", gomock.Any()).Return([]*model.Block{}, false, nil)
+		th.Store.EXPECT().GetUserByID(gomock.Any(), "user-2").Return(&model.User{ID: "user-2", Username: "bob"}, nil)
+
+		diff, err := th.App.GetCardDiff(context.Background(), "card-id", 100, 200)
+		require.NoError(t, err)
+		assert.Equal(t, []string{"user-2"}, diff.Authors)
+		require.NotNil(t, diff.Title)
+		assert.Equal(t, "Old", diff.Title.OldValue)
+		assert.Equal(t, "New", diff.Title.NewValue)
+		assert.Empty(t, diff.Content)
+	})
+}
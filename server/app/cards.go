@@ -4,13 +4,17 @@
 package app
 
 import (
+	"context"
 	"fmt"
 
 	"github.com/mattermost/mattermost-plugin-boards/server/model"
 	"github.com/mattermost/mattermost-plugin-boards/server/utils"
 )
 
-func (a *App) CreateCard(card *model.Card, boardID string, userID string, disableNotify bool) (*model.Card, error) {
+func (a *App) CreateCard(ctx context.Context, card *model.Card, boardID string, userID string, disableNotify bool) (*model.Card, error) {
+	ctx, span := startSpan(ctx, "CreateCard")
+	defer span.End()
+
 	// Convert the card struct to a block and insert the block.
 	now := utils.GetMillis()
 
@@ -24,7 +28,7 @@ func (a *App) CreateCard(card *model.Card, boardID string, userID string, disabl
 
 	block := model.Card2Block(card)
 
-	newBlocks, err := a.InsertBlocksAndNotify([]*model.Block{block}, userID, disableNotify)
+	newBlocks, err := a.InsertBlocksAndNotify(ctx, []*model.Block{block}, userID, disableNotify)
 	if err != nil {
 		return nil, fmt.Errorf("cannot create card: %w", err)
 	}
@@ -37,7 +41,10 @@ func (a *App) CreateCard(card *model.Card, boardID string, userID string, disabl
 	return newCard, nil
 }
 
-func (a *App) GetCardsForBoard(boardID string, page int, perPage int) ([]*model.Card, error) {
+func (a *App) GetCardsForBoard(ctx context.Context, boardID string, page int, perPage int) ([]*model.Card, error) {
+	ctx, span := startSpan(ctx, "GetCardsForBoard")
+	defer span.End()
+
 	opts := model.QueryBlocksOptions{
 		BoardID:   boardID,
 		BlockType: model.TypeCard,
@@ -45,7 +52,7 @@ func (a *App) GetCardsForBoard(boardID string, page int, perPage int) ([]*model.
 		PerPage:   perPage,
 	}
 
-	blocks, err := a.store.GetBlocks(opts)
+	blocks, err := a.store.GetBlocks(ctx, opts)
 	if err != nil {
 		return nil, err
 	}
@@ -62,13 +69,44 @@ func (a *App) GetCardsForBoard(boardID string, page int, perPage int) ([]*model.
 	return cards, nil
 }
 
-func (a *App) PatchCard(cardPatch *model.CardPatch, cardID string, userID string, disableNotify bool) (*model.Card, error) {
+// GetCardsPage returns a page of the cards of a board, in ID order, following the card of a
+// cursor. The cursor of the next page is empty if this is the last page.
+func (a *App) GetCardsPage(ctx context.Context, boardID string, cursor string, perPage int) ([]*model.Card, string, error) {
+	ctx, span := startSpan(ctx, "GetCardsPage")
+	defer span.End()
+
+	opts := model.QueryBlocksOptions{
+		BoardID:   boardID,
+		BlockType: model.TypeCard,
+		PerPage:   perPage,
+	}
+
+	blocks, nextCursor, err := a.GetBlocksPage(ctx, opts, cursor)
+	if err != nil {
+		return nil, "", err
+	}
+
+	cards := make([]*model.Card, 0, len(blocks))
+	for _, blk := range blocks {
+		card, err := model.Block2Card(blk)
+		if err != nil {
+			return nil, "", fmt.Errorf("Block2Card fail: %w", err)
+		}
+		cards = append(cards, card)
+	}
+	return cards, nextCursor, nil
+}
+
+func (a *App) PatchCard(ctx context.Context, cardPatch *model.CardPatch, cardID string, userID string, disableNotify bool) (*model.Card, error) {
+	ctx, span := startSpan(ctx, "PatchCard")
+	defer span.End()
+
 	blockPatch, err := model.CardPatch2BlockPatch(cardPatch)
 	if err != nil {
 		return nil, err
 	}
 
-	newBlock, err := a.PatchBlockAndNotify(cardID, blockPatch, userID, disableNotify)
+	newBlock, err := a.PatchBlockAndNotify(ctx, cardID, blockPatch, userID, disableNotify)
 	if err != nil {
 		return nil, fmt.Errorf("cannot patch card %s: %w", cardID, err)
 	}
@@ -81,8 +119,11 @@ func (a *App) PatchCard(cardPatch *model.CardPatch, cardID string, userID string
 	return newCard, nil
 }
 
-func (a *App) GetCardByID(cardID string) (*model.Card, error) {
-	cardBlock, err := a.GetBlockByID(cardID)
+func (a *App) GetCardByID(ctx context.Context, cardID string) (*model.Card, error) {
+	ctx, span := startSpan(ctx, "GetCardByID")
+	defer span.End()
+
+	cardBlock, err := a.GetBlockByID(ctx, cardID)
 	if err != nil {
 		return nil, err
 	}
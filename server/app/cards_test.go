@@ -4,6 +4,7 @@
 package app
 
 import (
+	"context"
 	"fmt"
 	"reflect"
 	"testing"
@@ -37,11 +38,11 @@ func TestCreateCard(t *testing.T) {
 	block := model.Card2Block(card)
 
 	t.Run("success scenario", func(t *testing.T) {
-		th.Store.EXPECT().GetBoard(board.ID).Return(board, nil)
-		th.Store.EXPECT().InsertBlock(gomock.AssignableToTypeOf(reflect.TypeOf(block)), userID).Return(nil)
-		th.Store.EXPECT().GetMembersForBoard(board.ID).Return([]*model.BoardMember{}, nil)
+		th.Store.EXPECT().GetBoard(gomock.Any(), board.ID).Return(board, nil)
+		th.Store.EXPECT().InsertBlock(gomock.Any(), gomock.AssignableToTypeOf(reflect.TypeOf(block)), userID).Return(nil)
+		th.Store.EXPECT().GetMembersForBoard(gomock.Any(), board.ID).Return([]*model.BoardMember{}, nil)
 
-		newCard, err := th.App.CreateCard(card, board.ID, userID, false)
+		newCard, err := th.App.CreateCard(context.Background(), card, board.ID, userID, false)
 
 		require.NoError(t, err)
 		require.Equal(t, card.BoardID, newCard.BoardID)
@@ -51,10 +52,10 @@ func TestCreateCard(t *testing.T) {
 	})
 
 	t.Run("error scenario", func(t *testing.T) {
-		th.Store.EXPECT().GetBoard(board.ID).Return(board, nil)
-		th.Store.EXPECT().InsertBlock(gomock.AssignableToTypeOf(reflect.TypeOf(block)), userID).Return(blockError{"error"})
+		th.Store.EXPECT().GetBoard(gomock.Any(), board.ID).Return(board, nil)
+		th.Store.EXPECT().InsertBlock(gomock.Any(), gomock.AssignableToTypeOf(reflect.TypeOf(block)), userID).Return(blockError{"error"})
 
-		newCard, err := th.App.CreateCard(card, board.ID, userID, false)
+		newCard, err := th.App.CreateCard(context.Background(), card, board.ID, userID, false)
 
 		require.Error(t, err, "error")
 		require.Nil(t, newCard)
@@ -91,9 +92,9 @@ func TestGetCards(t *testing.T) {
 			BlockType: model.TypeCard,
 		}
 
-		th.Store.EXPECT().GetBlocks(opts).Return(blocks, nil)
+		th.Store.EXPECT().GetBlocks(gomock.Any(), opts).Return(blocks, nil)
 
-		cards, err := th.App.GetCardsForBoard(board.ID, 0, 0)
+		cards, err := th.App.GetCardsForBoard(context.Background(), board.ID, 0, 0)
 		require.NoError(t, err)
 		assert.Len(t, cards, cardCount)
 	})
@@ -104,9 +105,9 @@ func TestGetCards(t *testing.T) {
 			BlockType: model.TypeCard,
 		}
 
-		th.Store.EXPECT().GetBlocks(opts).Return(nil, blockError{"error"})
+		th.Store.EXPECT().GetBlocks(gomock.Any(), opts).Return(nil, blockError{"error"})
 
-		cards, err := th.App.GetCardsForBoard(board.ID, 0, 0)
+		cards, err := th.App.GetCardsForBoard(context.Background(), board.ID, 0, 0)
 		require.Error(t, err)
 		require.Nil(t, cards)
 	})
@@ -148,12 +149,12 @@ func TestPatchCard(t *testing.T) {
 		expectedPatchedBlock := model.Card2Block(expectedPatchedCard)
 
 		var blockPatch *model.BlockPatch
-		th.Store.EXPECT().GetBoard(board.ID).Return(board, nil)
-		th.Store.EXPECT().PatchBlock(card.ID, gomock.AssignableToTypeOf(reflect.TypeOf(blockPatch)), userID).Return(nil)
-		th.Store.EXPECT().GetMembersForBoard(board.ID).Return([]*model.BoardMember{}, nil)
-		th.Store.EXPECT().GetBlock(card.ID).Return(expectedPatchedBlock, nil).AnyTimes()
+		th.Store.EXPECT().GetBoard(gomock.Any(), board.ID).Return(board, nil)
+		th.Store.EXPECT().PatchBlock(gomock.Any(), card.ID, gomock.AssignableToTypeOf(reflect.TypeOf(blockPatch)), userID).Return(nil)
+		th.Store.EXPECT().GetMembersForBoard(gomock.Any(), board.ID).Return([]*model.BoardMember{}, nil)
+		th.Store.EXPECT().GetBlock(gomock.Any(), card.ID).Return(expectedPatchedBlock, nil).AnyTimes()
 
-		patchedCard, err := th.App.PatchCard(cardPatch, card.ID, userID, false)
+		patchedCard, err := th.App.PatchCard(context.Background(), cardPatch, card.ID, userID, false)
 
 		require.NoError(t, err)
 		require.Equal(t, board.ID, patchedCard.BoardID)
@@ -165,10 +166,10 @@ func TestPatchCard(t *testing.T) {
 
 	t.Run("error scenario", func(t *testing.T) {
 		var blockPatch *model.BlockPatch
-		th.Store.EXPECT().GetBoard(board.ID).Return(board, nil)
-		th.Store.EXPECT().PatchBlock(card.ID, gomock.AssignableToTypeOf(reflect.TypeOf(blockPatch)), userID).Return(blockError{"error"})
+		th.Store.EXPECT().GetBoard(gomock.Any(), board.ID).Return(board, nil)
+		th.Store.EXPECT().PatchBlock(gomock.Any(), card.ID, gomock.AssignableToTypeOf(reflect.TypeOf(blockPatch)), userID).Return(blockError{"error"})
 
-		patchedCard, err := th.App.PatchCard(cardPatch, card.ID, userID, false)
+		patchedCard, err := th.App.PatchCard(context.Background(), cardPatch, card.ID, userID, false)
 
 		require.Error(t, err, "error")
 		require.Nil(t, patchedCard)
@@ -201,9 +202,9 @@ func TestGetCard(t *testing.T) {
 	}
 
 	t.Run("success scenario", func(t *testing.T) {
-		th.Store.EXPECT().GetBlock(block.ID).Return(block, nil)
+		th.Store.EXPECT().GetBlock(gomock.Any(), block.ID).Return(block, nil)
 
-		card, err := th.App.GetCardByID(block.ID)
+		card, err := th.App.GetCardByID(context.Background(), block.ID)
 
 		require.NoError(t, err)
 		require.Equal(t, boardID, card.BoardID)
@@ -216,9 +217,9 @@ func TestGetCard(t *testing.T) {
 
 	t.Run("not found", func(t *testing.T) {
 		bogusID := utils.NewID(utils.IDTypeBlock)
-		th.Store.EXPECT().GetBlock(bogusID).Return(nil, model.NewErrNotFound(bogusID))
+		th.Store.EXPECT().GetBlock(gomock.Any(), bogusID).Return(nil, model.NewErrNotFound(bogusID))
 
-		card, err := th.App.GetCardByID(bogusID)
+		card, err := th.App.GetCardByID(context.Background(), bogusID)
 
 		require.Error(t, err, "error")
 		require.True(t, model.IsErrNotFound(err))
@@ -226,9 +227,9 @@ func TestGetCard(t *testing.T) {
 	})
 
 	t.Run("error scenario", func(t *testing.T) {
-		th.Store.EXPECT().GetBlock(block.ID).Return(nil, blockError{"error"})
+		th.Store.EXPECT().GetBlock(gomock.Any(), block.ID).Return(nil, blockError{"error"})
 
-		card, err := th.App.GetCardByID(block.ID)
+		card, err := th.App.GetCardByID(context.Background(), block.ID)
 
 		require.Error(t, err, "error")
 		require.Nil(t, card)
@@ -4,6 +4,7 @@
 package app
 
 import (
+	"context"
 	"errors"
 	"fmt"
 
@@ -16,22 +17,28 @@ var errCategoriesLengthMismatch = errors.New("cannot update category order, pass
 var ErrCannotDeleteSystemCategory = errors.New("cannot delete a system category")
 var ErrCannotUpdateSystemCategory = errors.New("cannot update a system category")
 
-func (a *App) GetCategory(categoryID string) (*model.Category, error) {
-	return a.store.GetCategory(categoryID)
+func (a *App) GetCategory(ctx context.Context, categoryID string) (*model.Category, error) {
+	ctx, span := startSpan(ctx, "GetCategory")
+	defer span.End()
+
+	return a.store.GetCategory(ctx, categoryID)
 }
 
-func (a *App) CreateCategory(category *model.Category) (*model.Category, error) {
+func (a *App) CreateCategory(ctx context.Context, category *model.Category) (*model.Category, error) {
+	ctx, span := startSpan(ctx, "CreateCategory")
+	defer span.End()
+
 	category.PreCreate()
 	category.Hydrate()
 	if err := category.IsValid(); err != nil {
 		return nil, err
 	}
 
-	if err := a.store.CreateCategory(*category); err != nil {
+	if err := a.store.CreateCategory(ctx, *category); err != nil {
 		return nil, err
 	}
 
-	createdCategory, err := a.store.GetCategory(category.ID)
+	createdCategory, err := a.store.GetCategory(ctx, category.ID)
 	if err != nil {
 		return nil, err
 	}
@@ -43,7 +50,10 @@ func (a *App) CreateCategory(category *model.Category) (*model.Category, error)
 	return createdCategory, nil
 }
 
-func (a *App) UpdateCategory(category *model.Category) (*model.Category, error) {
+func (a *App) UpdateCategory(ctx context.Context, category *model.Category) (*model.Category, error) {
+	ctx, span := startSpan(ctx, "UpdateCategory")
+	defer span.End()
+
 	category.Hydrate()
 
 	if err := category.IsValid(); err != nil {
@@ -51,7 +61,7 @@ func (a *App) UpdateCategory(category *model.Category) (*model.Category, error)
 	}
 
 	// verify if category belongs to the user
-	existingCategory, err := a.store.GetCategory(category.ID)
+	existingCategory, err := a.store.GetCategory(ctx, category.ID)
 	if err != nil {
 		return nil, err
 	}
@@ -81,11 +91,11 @@ func (a *App) UpdateCategory(category *model.Category) (*model.Category, error)
 	if err = category.IsValid(); err != nil {
 		return nil, err
 	}
-	if err = a.store.UpdateCategory(*category); err != nil {
+	if err = a.store.UpdateCategory(ctx, *category); err != nil {
 		return nil, err
 	}
 
-	updatedCategory, err := a.store.GetCategory(category.ID)
+	updatedCategory, err := a.store.GetCategory(ctx, category.ID)
 	if err != nil {
 		return nil, err
 	}
@@ -97,8 +107,11 @@ func (a *App) UpdateCategory(category *model.Category) (*model.Category, error)
 	return updatedCategory, nil
 }
 
-func (a *App) DeleteCategory(categoryID, userID, teamID string) (*model.Category, error) {
-	existingCategory, err := a.store.GetCategory(categoryID)
+func (a *App) DeleteCategory(ctx context.Context, categoryID, userID, teamID string) (*model.Category, error) {
+	ctx, span := startSpan(ctx, "DeleteCategory")
+	defer span.End()
+
+	existingCategory, err := a.store.GetCategory(ctx, categoryID)
 	if err != nil {
 		return nil, err
 	}
@@ -123,15 +136,15 @@ func (a *App) DeleteCategory(categoryID, userID, teamID string) (*model.Category
 		return nil, ErrCannotDeleteSystemCategory
 	}
 
-	if err = a.moveBoardsToDefaultCategory(userID, teamID, categoryID); err != nil {
+	if err = a.moveBoardsToDefaultCategory(ctx, userID, teamID, categoryID); err != nil {
 		return nil, err
 	}
 
-	if err = a.store.DeleteCategory(categoryID, userID, teamID); err != nil {
+	if err = a.store.DeleteCategory(ctx, categoryID, userID, teamID); err != nil {
 		return nil, err
 	}
 
-	deletedCategory, err := a.store.GetCategory(categoryID)
+	deletedCategory, err := a.store.GetCategory(ctx, categoryID)
 	if err != nil {
 		return nil, err
 	}
@@ -143,10 +156,10 @@ func (a *App) DeleteCategory(categoryID, userID, teamID string) (*model.Category
 	return deletedCategory, nil
 }
 
-func (a *App) moveBoardsToDefaultCategory(userID, teamID, sourceCategoryID string) error {
+func (a *App) moveBoardsToDefaultCategory(ctx context.Context, userID, teamID, sourceCategoryID string) error {
 	// we need a list of boards associated to this category
 	// so we can move them to user's default Boards category
-	categoryBoards, err := a.GetUserCategoryBoards(userID, teamID)
+	categoryBoards, err := a.GetUserCategoryBoards(ctx, userID, teamID)
 	if err != nil {
 		return err
 	}
@@ -187,19 +200,22 @@ func (a *App) moveBoardsToDefaultCategory(userID, teamID, sourceCategoryID strin
 		boardIDs[i] = sourceCategoryBoards.BoardMetadata[i].BoardID
 	}
 
-	if err := a.AddUpdateUserCategoryBoard(teamID, userID, defaultCategoryID, boardIDs); err != nil {
+	if err := a.AddUpdateUserCategoryBoard(ctx, teamID, userID, defaultCategoryID, boardIDs); err != nil {
 		return fmt.Errorf("moveBoardsToDefaultCategory: %w", err)
 	}
 
 	return nil
 }
 
-func (a *App) ReorderCategories(userID, teamID string, newCategoryOrder []string) ([]string, error) {
-	if err := a.verifyNewCategoriesMatchExisting(userID, teamID, newCategoryOrder); err != nil {
+func (a *App) ReorderCategories(ctx context.Context, userID, teamID string, newCategoryOrder []string) ([]string, error) {
+	ctx, span := startSpan(ctx, "ReorderCategories")
+	defer span.End()
+
+	if err := a.verifyNewCategoriesMatchExisting(ctx, userID, teamID, newCategoryOrder); err != nil {
 		return nil, err
 	}
 
-	newOrder, err := a.store.ReorderCategories(userID, teamID, newCategoryOrder)
+	newOrder, err := a.store.ReorderCategories(ctx, userID, teamID, newCategoryOrder)
 	if err != nil {
 		return nil, err
 	}
@@ -211,8 +227,8 @@ func (a *App) ReorderCategories(userID, teamID string, newCategoryOrder []string
 	return newOrder, nil
 }
 
-func (a *App) verifyNewCategoriesMatchExisting(userID, teamID string, newCategoryOrder []string) error {
-	existingCategories, err := a.store.GetUserCategories(userID, teamID)
+func (a *App) verifyNewCategoriesMatchExisting(ctx context.Context, userID, teamID string, newCategoryOrder []string) error {
+	existingCategories, err := a.store.GetUserCategories(ctx, userID, teamID)
 	if err != nil {
 		return err
 	}
@@ -4,6 +4,7 @@
 package app
 
 import (
+	"context"
 	"errors"
 	"fmt"
 
@@ -16,13 +17,16 @@ var errCategoryBoardsLengthMismatch = errors.New("cannot update category boards
 var errBoardNotFoundInCategory = errors.New("specified board ID not found in specified category ID")
 var errBoardMembershipNotFound = errors.New("board membership not found for user's board")
 
-func (a *App) GetUserCategoryBoards(userID, teamID string) ([]model.CategoryBoards, error) {
-	categoryBoards, err := a.store.GetUserCategoryBoards(userID, teamID)
+func (a *App) GetUserCategoryBoards(ctx context.Context, userID, teamID string) ([]model.CategoryBoards, error) {
+	ctx, span := startSpan(ctx, "GetUserCategoryBoards")
+	defer span.End()
+
+	categoryBoards, err := a.store.GetUserCategoryBoards(ctx, userID, teamID)
 	if err != nil {
 		return nil, err
 	}
 
-	createdCategoryBoards, err := a.createDefaultCategoriesIfRequired(categoryBoards, userID, teamID)
+	createdCategoryBoards, err := a.createDefaultCategoriesIfRequired(ctx, categoryBoards, userID, teamID)
 	if err != nil {
 		return nil, err
 	}
@@ -31,7 +35,7 @@ func (a *App) GetUserCategoryBoards(userID, teamID string) ([]model.CategoryBoar
 	return categoryBoards, nil
 }
 
-func (a *App) createDefaultCategoriesIfRequired(existingCategoryBoards []model.CategoryBoards, userID, teamID string) ([]model.CategoryBoards, error) {
+func (a *App) createDefaultCategoriesIfRequired(ctx context.Context, existingCategoryBoards []model.CategoryBoards, userID, teamID string) ([]model.CategoryBoards, error) {
 	createdCategories := []model.CategoryBoards{}
 
 	boardsCategoryExist := false
@@ -42,7 +46,7 @@ func (a *App) createDefaultCategoriesIfRequired(existingCategoryBoards []model.C
 	}
 
 	if !boardsCategoryExist {
-		createdCategoryBoards, err := a.createBoardsCategory(userID, teamID, existingCategoryBoards)
+		createdCategoryBoards, err := a.createBoardsCategory(ctx, userID, teamID, existingCategoryBoards)
 		if err != nil {
 			return nil, err
 		}
@@ -53,7 +57,7 @@ func (a *App) createDefaultCategoriesIfRequired(existingCategoryBoards []model.C
 	return createdCategories, nil
 }
 
-func (a *App) createBoardsCategory(userID, teamID string, existingCategoryBoards []model.CategoryBoards) (*model.CategoryBoards, error) {
+func (a *App) createBoardsCategory(ctx context.Context, userID, teamID string, existingCategoryBoards []model.CategoryBoards) (*model.CategoryBoards, error) {
 	// create the category
 	category := model.Category{
 		Name:      defaultCategoryBoards,
@@ -63,7 +67,7 @@ func (a *App) createBoardsCategory(userID, teamID string, existingCategoryBoards
 		Type:      model.CategoryTypeSystem,
 		SortOrder: len(existingCategoryBoards) * model.CategoryBoardsSortOrderGap,
 	}
-	createdCategory, err := a.CreateCategory(&category)
+	createdCategory, err := a.CreateCategory(ctx, &category)
 	if err != nil {
 		return nil, fmt.Errorf("createBoardsCategory default category creation failed: %w", err)
 	}
@@ -71,7 +75,7 @@ func (a *App) createBoardsCategory(userID, teamID string, existingCategoryBoards
 	// once the category is created, we need to move all boards which do not
 	// belong to any category, into this category.
 
-	boardMembers, err := a.GetMembersForUser(userID)
+	boardMembers, err := a.GetMembersForUser(ctx, userID)
 	if err != nil {
 		return nil, fmt.Errorf("createBoardsCategory error fetching user's board memberships: %w", err)
 	}
@@ -87,7 +91,7 @@ func (a *App) createBoardsCategory(userID, teamID string, existingCategoryBoards
 	}
 
 	// get user's current team's baords
-	userTeamBoards, err := a.GetBoardsForUserAndTeam(userID, teamID, false)
+	userTeamBoards, err := a.GetBoardsForUserAndTeam(ctx, userID, teamID, false)
 	if err != nil {
 		return nil, fmt.Errorf("createBoardsCategory error fetching user's team's boards: %w", err)
 	}
@@ -137,7 +141,7 @@ func (a *App) createBoardsCategory(userID, teamID string, existingCategoryBoards
 	}
 
 	if len(boardIDsToAdd) > 0 {
-		if err := a.AddUpdateUserCategoryBoard(teamID, userID, createdCategory.ID, boardIDsToAdd); err != nil {
+		if err := a.AddUpdateUserCategoryBoard(ctx, teamID, userID, createdCategory.ID, boardIDsToAdd); err != nil {
 			return nil, fmt.Errorf("createBoardsCategory failed to add category-less board to the default category, defaultCategoryID: %s, error: %w", createdCategory.ID, err)
 		}
 	}
@@ -145,17 +149,20 @@ func (a *App) createBoardsCategory(userID, teamID string, existingCategoryBoards
 	return createdCategoryBoards, nil
 }
 
-func (a *App) AddUpdateUserCategoryBoard(teamID, userID, categoryID string, boardIDs []string) error {
+func (a *App) AddUpdateUserCategoryBoard(ctx context.Context, teamID, userID, categoryID string, boardIDs []string) error {
+	ctx, span := startSpan(ctx, "AddUpdateUserCategoryBoard")
+	defer span.End()
+
 	if len(boardIDs) == 0 {
 		return nil
 	}
 
-	err := a.store.AddUpdateCategoryBoard(userID, categoryID, boardIDs)
+	err := a.store.AddUpdateCategoryBoard(ctx, userID, categoryID, boardIDs)
 	if err != nil {
 		return err
 	}
 
-	userCategoryBoards, err := a.GetUserCategoryBoards(userID, teamID)
+	userCategoryBoards, err := a.GetUserCategoryBoards(ctx, userID, teamID)
 	if err != nil {
 		return err
 	}
@@ -195,12 +202,15 @@ func (a *App) AddUpdateUserCategoryBoard(teamID, userID, categoryID string, boar
 	return nil
 }
 
-func (a *App) ReorderCategoryBoards(userID, teamID, categoryID string, newBoardsOrder []string) ([]string, error) {
-	if err := a.verifyNewCategoryBoardsMatchExisting(userID, teamID, categoryID, newBoardsOrder); err != nil {
+func (a *App) ReorderCategoryBoards(ctx context.Context, userID, teamID, categoryID string, newBoardsOrder []string) ([]string, error) {
+	ctx, span := startSpan(ctx, "ReorderCategoryBoards")
+	defer span.End()
+
+	if err := a.verifyNewCategoryBoardsMatchExisting(ctx, userID, teamID, categoryID, newBoardsOrder); err != nil {
 		return nil, err
 	}
 
-	newOrder, err := a.store.ReorderCategoryBoards(categoryID, newBoardsOrder)
+	newOrder, err := a.store.ReorderCategoryBoards(ctx, categoryID, newBoardsOrder)
 	if err != nil {
 		return nil, err
 	}
@@ -212,10 +222,10 @@ func (a *App) ReorderCategoryBoards(userID, teamID, categoryID string, newBoards
 	return newOrder, nil
 }
 
-func (a *App) verifyNewCategoryBoardsMatchExisting(userID, teamID, categoryID string, newBoardsOrder []string) error {
+func (a *App) verifyNewCategoryBoardsMatchExisting(ctx context.Context, userID, teamID, categoryID string, newBoardsOrder []string) error {
 	// this function is to ensure that we don't miss specifying
 	// all boards of the category while reordering.
-	existingCategoryBoards, err := a.GetUserCategoryBoards(userID, teamID)
+	existingCategoryBoards, err := a.GetUserCategoryBoards(ctx, userID, teamID)
 	if err != nil {
 		return err
 	}
@@ -265,8 +275,11 @@ func (a *App) verifyNewCategoryBoardsMatchExisting(userID, teamID, categoryID st
 	return nil
 }
 
-func (a *App) SetBoardVisibility(teamID, userID, categoryID, boardID string, visible bool) error {
-	if err := a.store.SetBoardVisibility(userID, categoryID, boardID, visible); err != nil {
+func (a *App) SetBoardVisibility(ctx context.Context, teamID, userID, categoryID, boardID string, visible bool) error {
+	ctx, span := startSpan(ctx, "SetBoardVisibility")
+	defer span.End()
+
+	if err := a.store.SetBoardVisibility(ctx, userID, categoryID, boardID, visible); err != nil {
 		return fmt.Errorf("SetBoardVisibility: failed to update board visibility: %w", err)
 	}
 
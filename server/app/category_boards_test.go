@@ -4,10 +4,12 @@
 package app
 
 import (
+	"context"
 	"testing"
 
 	"github.com/mattermost/mattermost-plugin-boards/server/utils"
 
+	"github.com/golang/mock/gomock"
 	"github.com/mattermost/mattermost-plugin-boards/server/model"
 	"github.com/stretchr/testify/assert"
 )
@@ -17,8 +19,8 @@ func TestGetUserCategoryBoards(t *testing.T) {
 	defer tearDown()
 
 	t.Run("user had no default category and had boards", func(t *testing.T) {
-		th.Store.EXPECT().GetUserCategoryBoards("user_id", "team_id").Return([]model.CategoryBoards{}, nil).Times(1)
-		th.Store.EXPECT().GetUserCategoryBoards("user_id", "team_id").Return([]model.CategoryBoards{
+		th.Store.EXPECT().GetUserCategoryBoards(gomock.Any(), "user_id", "team_id").Return([]model.CategoryBoards{}, nil).Times(1)
+		th.Store.EXPECT().GetUserCategoryBoards(gomock.Any(), "user_id", "team_id").Return([]model.CategoryBoards{
 			{
 				Category: model.Category{
 					ID:   "boards_category_id",
@@ -27,8 +29,8 @@ func TestGetUserCategoryBoards(t *testing.T) {
 				},
 			},
 		}, nil).Times(1)
-		th.Store.EXPECT().CreateCategory(utils.Anything).Return(nil)
-		th.Store.EXPECT().GetCategory(utils.Anything).Return(&model.Category{
+		th.Store.EXPECT().CreateCategory(gomock.Any(), utils.Anything).Return(nil)
+		th.Store.EXPECT().GetCategory(gomock.Any(), utils.Anything).Return(&model.Category{
 			ID:   "boards_category_id",
 			Name: "Boards",
 		}, nil)
@@ -45,9 +47,9 @@ func TestGetUserCategoryBoards(t *testing.T) {
 			ID: "board_id_3",
 		}
 
-		th.Store.EXPECT().GetBoardsForUserAndTeam("user_id", "team_id", false).Return([]*model.Board{board1, board2, board3}, nil)
+		th.Store.EXPECT().GetBoardsForUserAndTeam(gomock.Any(), "user_id", "team_id", false).Return([]*model.Board{board1, board2, board3}, nil)
 
-		th.Store.EXPECT().GetMembersForUser("user_id").Return([]*model.BoardMember{
+		th.Store.EXPECT().GetMembersForUser(gomock.Any(), "user_id").Return([]*model.BoardMember{
 			{
 				BoardID:   "board_id_1",
 				Synthetic: false,
@@ -61,10 +63,10 @@ func TestGetUserCategoryBoards(t *testing.T) {
 				Synthetic: false,
 			},
 		}, nil)
-		th.Store.EXPECT().GetBoard(utils.Anything).Return(nil, nil).Times(3)
-		th.Store.EXPECT().AddUpdateCategoryBoard("user_id", "boards_category_id", []string{"board_id_1", "board_id_2", "board_id_3"}).Return(nil)
+		th.Store.EXPECT().GetBoard(gomock.Any(), utils.Anything).Return(nil, nil).Times(3)
+		th.Store.EXPECT().AddUpdateCategoryBoard(gomock.Any(), "user_id", "boards_category_id", []string{"board_id_1", "board_id_2", "board_id_3"}).Return(nil)
 
-		categoryBoards, err := th.App.GetUserCategoryBoards("user_id", "team_id")
+		categoryBoards, err := th.App.GetUserCategoryBoards(context.Background(), "user_id", "team_id")
 		assert.NoError(t, err)
 		assert.Equal(t, 1, len(categoryBoards))
 		assert.Equal(t, "Boards", categoryBoards[0].Name)
@@ -75,17 +77,17 @@ func TestGetUserCategoryBoards(t *testing.T) {
 	})
 
 	t.Run("user had no default category BUT had no boards", func(t *testing.T) {
-		th.Store.EXPECT().GetUserCategoryBoards("user_id", "team_id").Return([]model.CategoryBoards{}, nil)
-		th.Store.EXPECT().CreateCategory(utils.Anything).Return(nil)
-		th.Store.EXPECT().GetCategory(utils.Anything).Return(&model.Category{
+		th.Store.EXPECT().GetUserCategoryBoards(gomock.Any(), "user_id", "team_id").Return([]model.CategoryBoards{}, nil)
+		th.Store.EXPECT().CreateCategory(gomock.Any(), utils.Anything).Return(nil)
+		th.Store.EXPECT().GetCategory(gomock.Any(), utils.Anything).Return(&model.Category{
 			ID:   "boards_category_id",
 			Name: "Boards",
 		}, nil)
 
-		th.Store.EXPECT().GetMembersForUser("user_id").Return([]*model.BoardMember{}, nil)
-		th.Store.EXPECT().GetBoardsForUserAndTeam("user_id", "team_id", false).Return([]*model.Board{}, nil)
+		th.Store.EXPECT().GetMembersForUser(gomock.Any(), "user_id").Return([]*model.BoardMember{}, nil)
+		th.Store.EXPECT().GetBoardsForUserAndTeam(gomock.Any(), "user_id", "team_id", false).Return([]*model.Board{}, nil)
 
-		categoryBoards, err := th.App.GetUserCategoryBoards("user_id", "team_id")
+		categoryBoards, err := th.App.GetUserCategoryBoards(context.Background(), "user_id", "team_id")
 		assert.NoError(t, err)
 		assert.Equal(t, 1, len(categoryBoards))
 		assert.Equal(t, "Boards", categoryBoards[0].Name)
@@ -93,7 +95,7 @@ func TestGetUserCategoryBoards(t *testing.T) {
 	})
 
 	t.Run("user already had a default Boards category with boards in it", func(t *testing.T) {
-		th.Store.EXPECT().GetUserCategoryBoards("user_id", "team_id").Return([]model.CategoryBoards{
+		th.Store.EXPECT().GetUserCategoryBoards(gomock.Any(), "user_id", "team_id").Return([]model.CategoryBoards{
 			{
 				Category: model.Category{Name: "Boards"},
 				BoardMetadata: []model.CategoryBoardMetadata{
@@ -103,7 +105,7 @@ func TestGetUserCategoryBoards(t *testing.T) {
 			},
 		}, nil)
 
-		categoryBoards, err := th.App.GetUserCategoryBoards("user_id", "team_id")
+		categoryBoards, err := th.App.GetUserCategoryBoards(context.Background(), "user_id", "team_id")
 		assert.NoError(t, err)
 		assert.Equal(t, 1, len(categoryBoards))
 		assert.Equal(t, "Boards", categoryBoards[0].Name)
@@ -116,17 +118,17 @@ func TestCreateBoardsCategory(t *testing.T) {
 	defer tearDown()
 
 	t.Run("user doesn't have any boards - implicit or explicit", func(t *testing.T) {
-		th.Store.EXPECT().CreateCategory(utils.Anything).Return(nil)
-		th.Store.EXPECT().GetCategory(utils.Anything).Return(&model.Category{
+		th.Store.EXPECT().CreateCategory(gomock.Any(), utils.Anything).Return(nil)
+		th.Store.EXPECT().GetCategory(gomock.Any(), utils.Anything).Return(&model.Category{
 			ID:   "boards_category_id",
 			Type: "system",
 			Name: "Boards",
 		}, nil)
-		th.Store.EXPECT().GetBoardsForUserAndTeam("user_id", "team_id", false).Return([]*model.Board{}, nil)
-		th.Store.EXPECT().GetMembersForUser("user_id").Return([]*model.BoardMember{}, nil)
+		th.Store.EXPECT().GetBoardsForUserAndTeam(gomock.Any(), "user_id", "team_id", false).Return([]*model.Board{}, nil)
+		th.Store.EXPECT().GetMembersForUser(gomock.Any(), "user_id").Return([]*model.BoardMember{}, nil)
 
 		existingCategoryBoards := []model.CategoryBoards{}
-		boardsCategory, err := th.App.createBoardsCategory("user_id", "team_id", existingCategoryBoards)
+		boardsCategory, err := th.App.createBoardsCategory(context.Background(), "user_id", "team_id", existingCategoryBoards)
 		assert.NoError(t, err)
 		assert.NotNil(t, boardsCategory)
 		assert.Equal(t, "Boards", boardsCategory.Name)
@@ -134,14 +136,14 @@ func TestCreateBoardsCategory(t *testing.T) {
 	})
 
 	t.Run("user has implicit access to some board", func(t *testing.T) {
-		th.Store.EXPECT().CreateCategory(utils.Anything).Return(nil)
-		th.Store.EXPECT().GetCategory(utils.Anything).Return(&model.Category{
+		th.Store.EXPECT().CreateCategory(gomock.Any(), utils.Anything).Return(nil)
+		th.Store.EXPECT().GetCategory(gomock.Any(), utils.Anything).Return(&model.Category{
 			ID:   "boards_category_id",
 			Type: "system",
 			Name: "Boards",
 		}, nil)
-		th.Store.EXPECT().GetBoardsForUserAndTeam("user_id", "team_id", false).Return([]*model.Board{}, nil)
-		th.Store.EXPECT().GetMembersForUser("user_id").Return([]*model.BoardMember{
+		th.Store.EXPECT().GetBoardsForUserAndTeam(gomock.Any(), "user_id", "team_id", false).Return([]*model.Board{}, nil)
+		th.Store.EXPECT().GetMembersForUser(gomock.Any(), "user_id").Return([]*model.BoardMember{
 			{
 				BoardID:   "board_id_1",
 				Synthetic: true,
@@ -155,10 +157,10 @@ func TestCreateBoardsCategory(t *testing.T) {
 				Synthetic: true,
 			},
 		}, nil)
-		th.Store.EXPECT().GetBoard(utils.Anything).Return(nil, nil).Times(3)
+		th.Store.EXPECT().GetBoard(gomock.Any(), utils.Anything).Return(nil, nil).Times(3)
 
 		existingCategoryBoards := []model.CategoryBoards{}
-		boardsCategory, err := th.App.createBoardsCategory("user_id", "team_id", existingCategoryBoards)
+		boardsCategory, err := th.App.createBoardsCategory(context.Background(), "user_id", "team_id", existingCategoryBoards)
 		assert.NoError(t, err)
 		assert.NotNil(t, boardsCategory)
 		assert.Equal(t, "Boards", boardsCategory.Name)
@@ -169,8 +171,8 @@ func TestCreateBoardsCategory(t *testing.T) {
 	})
 
 	t.Run("user has explicit access to some board", func(t *testing.T) {
-		th.Store.EXPECT().CreateCategory(utils.Anything).Return(nil)
-		th.Store.EXPECT().GetCategory(utils.Anything).Return(&model.Category{
+		th.Store.EXPECT().CreateCategory(gomock.Any(), utils.Anything).Return(nil)
+		th.Store.EXPECT().GetCategory(gomock.Any(), utils.Anything).Return(&model.Category{
 			ID:   "boards_category_id",
 			Type: "system",
 			Name: "Boards",
@@ -185,8 +187,8 @@ func TestCreateBoardsCategory(t *testing.T) {
 		board3 := &model.Board{
 			ID: "board_id_3",
 		}
-		th.Store.EXPECT().GetBoardsForUserAndTeam("user_id", "team_id", false).Return([]*model.Board{board1, board2, board3}, nil)
-		th.Store.EXPECT().GetMembersForUser("user_id").Return([]*model.BoardMember{
+		th.Store.EXPECT().GetBoardsForUserAndTeam(gomock.Any(), "user_id", "team_id", false).Return([]*model.Board{board1, board2, board3}, nil)
+		th.Store.EXPECT().GetMembersForUser(gomock.Any(), "user_id").Return([]*model.BoardMember{
 			{
 				BoardID:   "board_id_1",
 				Synthetic: false,
@@ -200,10 +202,10 @@ func TestCreateBoardsCategory(t *testing.T) {
 				Synthetic: false,
 			},
 		}, nil)
-		th.Store.EXPECT().GetBoard(utils.Anything).Return(nil, nil).Times(3)
-		th.Store.EXPECT().AddUpdateCategoryBoard("user_id", "boards_category_id", []string{"board_id_1", "board_id_2", "board_id_3"}).Return(nil)
+		th.Store.EXPECT().GetBoard(gomock.Any(), utils.Anything).Return(nil, nil).Times(3)
+		th.Store.EXPECT().AddUpdateCategoryBoard(gomock.Any(), "user_id", "boards_category_id", []string{"board_id_1", "board_id_2", "board_id_3"}).Return(nil)
 
-		th.Store.EXPECT().GetUserCategoryBoards("user_id", "team_id").Return([]model.CategoryBoards{
+		th.Store.EXPECT().GetUserCategoryBoards(gomock.Any(), "user_id", "team_id").Return([]model.CategoryBoards{
 			{
 				Category: model.Category{
 					Type: model.CategoryTypeSystem,
@@ -214,7 +216,7 @@ func TestCreateBoardsCategory(t *testing.T) {
 		}, nil)
 
 		existingCategoryBoards := []model.CategoryBoards{}
-		boardsCategory, err := th.App.createBoardsCategory("user_id", "team_id", existingCategoryBoards)
+		boardsCategory, err := th.App.createBoardsCategory(context.Background(), "user_id", "team_id", existingCategoryBoards)
 		assert.NoError(t, err)
 		assert.NotNil(t, boardsCategory)
 		assert.Equal(t, "Boards", boardsCategory.Name)
@@ -225,8 +227,8 @@ func TestCreateBoardsCategory(t *testing.T) {
 	})
 
 	t.Run("user has both implicit and explicit access to some board", func(t *testing.T) {
-		th.Store.EXPECT().CreateCategory(utils.Anything).Return(nil)
-		th.Store.EXPECT().GetCategory(utils.Anything).Return(&model.Category{
+		th.Store.EXPECT().CreateCategory(gomock.Any(), utils.Anything).Return(nil)
+		th.Store.EXPECT().GetCategory(gomock.Any(), utils.Anything).Return(&model.Category{
 			ID:   "boards_category_id",
 			Type: "system",
 			Name: "Boards",
@@ -235,8 +237,8 @@ func TestCreateBoardsCategory(t *testing.T) {
 		board1 := &model.Board{
 			ID: "board_id_1",
 		}
-		th.Store.EXPECT().GetBoardsForUserAndTeam("user_id", "team_id", false).Return([]*model.Board{board1}, nil)
-		th.Store.EXPECT().GetMembersForUser("user_id").Return([]*model.BoardMember{
+		th.Store.EXPECT().GetBoardsForUserAndTeam(gomock.Any(), "user_id", "team_id", false).Return([]*model.Board{board1}, nil)
+		th.Store.EXPECT().GetMembersForUser(gomock.Any(), "user_id").Return([]*model.BoardMember{
 			{
 				BoardID:   "board_id_1",
 				Synthetic: false,
@@ -250,10 +252,10 @@ func TestCreateBoardsCategory(t *testing.T) {
 				Synthetic: true,
 			},
 		}, nil)
-		th.Store.EXPECT().GetBoard(utils.Anything).Return(nil, nil).Times(3)
-		th.Store.EXPECT().AddUpdateCategoryBoard("user_id", "boards_category_id", []string{"board_id_1"}).Return(nil)
+		th.Store.EXPECT().GetBoard(gomock.Any(), utils.Anything).Return(nil, nil).Times(3)
+		th.Store.EXPECT().AddUpdateCategoryBoard(gomock.Any(), "user_id", "boards_category_id", []string{"board_id_1"}).Return(nil)
 
-		th.Store.EXPECT().GetUserCategoryBoards("user_id", "team_id").Return([]model.CategoryBoards{
+		th.Store.EXPECT().GetUserCategoryBoards(gomock.Any(), "user_id", "team_id").Return([]model.CategoryBoards{
 			{
 				Category: model.Category{
 					Type: model.CategoryTypeSystem,
@@ -264,7 +266,7 @@ func TestCreateBoardsCategory(t *testing.T) {
 		}, nil)
 
 		existingCategoryBoards := []model.CategoryBoards{}
-		boardsCategory, err := th.App.createBoardsCategory("user_id", "team_id", existingCategoryBoards)
+		boardsCategory, err := th.App.createBoardsCategory(context.Background(), "user_id", "team_id", existingCategoryBoards)
 		assert.NoError(t, err)
 		assert.NotNil(t, boardsCategory)
 		assert.Equal(t, "Boards", boardsCategory.Name)
@@ -281,7 +283,7 @@ func TestReorderCategoryBoards(t *testing.T) {
 	defer tearDown()
 
 	t.Run("base case", func(t *testing.T) {
-		th.Store.EXPECT().GetUserCategoryBoards("user_id", "team_id").Return([]model.CategoryBoards{
+		th.Store.EXPECT().GetUserCategoryBoards(gomock.Any(), "user_id", "team_id").Return([]model.CategoryBoards{
 			{
 				Category: model.Category{ID: "category_id_1", Name: "Category 1"},
 				BoardMetadata: []model.CategoryBoardMetadata{
@@ -301,9 +303,9 @@ func TestReorderCategoryBoards(t *testing.T) {
 			},
 		}, nil)
 
-		th.Store.EXPECT().ReorderCategoryBoards("category_id_1", []string{"board_id_2", "board_id_1"}).Return([]string{"board_id_2", "board_id_1"}, nil)
+		th.Store.EXPECT().ReorderCategoryBoards(gomock.Any(), "category_id_1", []string{"board_id_2", "board_id_1"}).Return([]string{"board_id_2", "board_id_1"}, nil)
 
-		newOrder, err := th.App.ReorderCategoryBoards("user_id", "team_id", "category_id_1", []string{"board_id_2", "board_id_1"})
+		newOrder, err := th.App.ReorderCategoryBoards(context.Background(), "user_id", "team_id", "category_id_1", []string{"board_id_2", "board_id_1"})
 		assert.NoError(t, err)
 		assert.Equal(t, 2, len(newOrder))
 		assert.Equal(t, "board_id_2", newOrder[0])
@@ -311,7 +313,7 @@ func TestReorderCategoryBoards(t *testing.T) {
 	})
 
 	t.Run("not specifying all boards", func(t *testing.T) {
-		th.Store.EXPECT().GetUserCategoryBoards("user_id", "team_id").Return([]model.CategoryBoards{
+		th.Store.EXPECT().GetUserCategoryBoards(gomock.Any(), "user_id", "team_id").Return([]model.CategoryBoards{
 			{
 				Category: model.Category{ID: "category_id_1", Name: "Category 1"},
 				BoardMetadata: []model.CategoryBoardMetadata{
@@ -332,7 +334,7 @@ func TestReorderCategoryBoards(t *testing.T) {
 			},
 		}, nil)
 
-		newOrder, err := th.App.ReorderCategoryBoards("user_id", "team_id", "category_id_1", []string{"board_id_2", "board_id_1"})
+		newOrder, err := th.App.ReorderCategoryBoards(context.Background(), "user_id", "team_id", "category_id_1", []string{"board_id_2", "board_id_1"})
 		assert.Error(t, err)
 		assert.Nil(t, newOrder)
 	})
@@ -4,8 +4,10 @@
 package app
 
 import (
+	"context"
 	"testing"
 
+	"github.com/golang/mock/gomock"
 	"github.com/mattermost/mattermost-plugin-boards/server/model"
 	"github.com/mattermost/mattermost-plugin-boards/server/utils"
 	"github.com/stretchr/testify/assert"
@@ -16,9 +18,9 @@ func TestCreateCategory(t *testing.T) {
 	defer tearDown()
 
 	t.Run("base case", func(t *testing.T) {
-		th.Store.EXPECT().CreateCategory(utils.Anything).Return(nil)
+		th.Store.EXPECT().CreateCategory(gomock.Any(), utils.Anything).Return(nil)
 
-		th.Store.EXPECT().GetCategory(utils.Anything).Return(&model.Category{
+		th.Store.EXPECT().GetCategory(gomock.Any(), utils.Anything).Return(&model.Category{
 			ID: "category_id_1",
 		}, nil)
 
@@ -28,7 +30,7 @@ func TestCreateCategory(t *testing.T) {
 			TeamID: "team_id",
 			Type:   "custom",
 		}
-		createdCategory, err := th.App.CreateCategory(category)
+		createdCategory, err := th.App.CreateCategory(context.Background(), category)
 		assert.NotNil(t, createdCategory)
 		assert.NoError(t, err)
 	})
@@ -40,24 +42,24 @@ func TestCreateCategory(t *testing.T) {
 			TeamID: "team_id",
 			Type:   "custom",
 		}
-		createdCategory, err := th.App.CreateCategory(category)
+		createdCategory, err := th.App.CreateCategory(context.Background(), category)
 		assert.Nil(t, createdCategory)
 		assert.Error(t, err)
 
 		category.Name = "Name"
 		category.UserID = "" // empty creator user id shouldn't be allowed
-		createdCategory, err = th.App.CreateCategory(category)
+		createdCategory, err = th.App.CreateCategory(context.Background(), category)
 		assert.Nil(t, createdCategory)
 		assert.Error(t, err)
 
 		category.UserID = "user_id"
 		category.TeamID = "" // empty TeamID shouldn't be allowed
-		createdCategory, err = th.App.CreateCategory(category)
+		createdCategory, err = th.App.CreateCategory(context.Background(), category)
 		assert.Nil(t, createdCategory)
 		assert.Error(t, err)
 
 		category.Type = "invalid" // unknown type shouldn't be allowed
-		createdCategory, err = th.App.CreateCategory(category)
+		createdCategory, err = th.App.CreateCategory(context.Background(), category)
 		assert.Nil(t, createdCategory)
 		assert.Error(t, err)
 	})
@@ -68,7 +70,7 @@ func TestUpdateCategory(t *testing.T) {
 	defer tearDown()
 
 	t.Run("base case", func(t *testing.T) {
-		th.Store.EXPECT().GetCategory(utils.Anything).Return(&model.Category{
+		th.Store.EXPECT().GetCategory(gomock.Any(), utils.Anything).Return(&model.Category{
 			ID:     "category_id_1",
 			Name:   "Category",
 			TeamID: "team_id_1",
@@ -76,8 +78,8 @@ func TestUpdateCategory(t *testing.T) {
 			Type:   "custom",
 		}, nil)
 
-		th.Store.EXPECT().UpdateCategory(utils.Anything).Return(nil)
-		th.Store.EXPECT().GetCategory("category_id_1").Return(&model.Category{
+		th.Store.EXPECT().UpdateCategory(gomock.Any(), utils.Anything).Return(nil)
+		th.Store.EXPECT().GetCategory(gomock.Any(), "category_id_1").Return(&model.Category{
 			ID:   "category_id_1",
 			Name: "Category",
 		}, nil)
@@ -89,13 +91,13 @@ func TestUpdateCategory(t *testing.T) {
 			TeamID: "team_id_1",
 			Type:   "custom",
 		}
-		updatedCategory, err := th.App.UpdateCategory(category)
+		updatedCategory, err := th.App.UpdateCategory(context.Background(), category)
 		assert.NotNil(t, updatedCategory)
 		assert.NoError(t, err)
 	})
 
 	t.Run("updating invalid category", func(t *testing.T) {
-		th.Store.EXPECT().GetCategory(utils.Anything).Return(&model.Category{
+		th.Store.EXPECT().GetCategory(gomock.Any(), utils.Anything).Return(&model.Category{
 			ID:     "category_id_1",
 			Name:   "Category",
 			TeamID: "team_id_1",
@@ -112,36 +114,36 @@ func TestUpdateCategory(t *testing.T) {
 		}
 
 		category.ID = ""
-		createdCategory, err := th.App.UpdateCategory(category)
+		createdCategory, err := th.App.UpdateCategory(context.Background(), category)
 		assert.Nil(t, createdCategory)
 		assert.Error(t, err)
 
 		category.ID = "category_id_1"
 		category.Name = ""
-		createdCategory, err = th.App.UpdateCategory(category)
+		createdCategory, err = th.App.UpdateCategory(context.Background(), category)
 		assert.Nil(t, createdCategory)
 		assert.Error(t, err)
 
 		category.Name = "Name"
 		category.UserID = "" // empty creator user id shouldn't be allowed
-		createdCategory, err = th.App.UpdateCategory(category)
+		createdCategory, err = th.App.UpdateCategory(context.Background(), category)
 		assert.Nil(t, createdCategory)
 		assert.Error(t, err)
 
 		category.UserID = "user_id"
 		category.TeamID = "" // empty TeamID shouldn't be allowed
-		createdCategory, err = th.App.UpdateCategory(category)
+		createdCategory, err = th.App.UpdateCategory(context.Background(), category)
 		assert.Nil(t, createdCategory)
 		assert.Error(t, err)
 
 		category.Type = "invalid" // unknown type shouldn't be allowed
-		createdCategory, err = th.App.UpdateCategory(category)
+		createdCategory, err = th.App.UpdateCategory(context.Background(), category)
 		assert.Nil(t, createdCategory)
 		assert.Error(t, err)
 	})
 
 	t.Run("trying to update someone else's category", func(t *testing.T) {
-		th.Store.EXPECT().GetCategory(utils.Anything).Return(&model.Category{
+		th.Store.EXPECT().GetCategory(gomock.Any(), utils.Anything).Return(&model.Category{
 			ID:     "category_id_1",
 			Name:   "Category",
 			TeamID: "team_id_1",
@@ -156,13 +158,13 @@ func TestUpdateCategory(t *testing.T) {
 			TeamID: "team_id_1",
 			Type:   "custom",
 		}
-		updatedCategory, err := th.App.UpdateCategory(category)
+		updatedCategory, err := th.App.UpdateCategory(context.Background(), category)
 		assert.Nil(t, updatedCategory)
 		assert.Error(t, err)
 	})
 
 	t.Run("trying to update some other team's category", func(t *testing.T) {
-		th.Store.EXPECT().GetCategory(utils.Anything).Return(&model.Category{
+		th.Store.EXPECT().GetCategory(gomock.Any(), utils.Anything).Return(&model.Category{
 			ID:     "category_id_1",
 			Name:   "Category",
 			TeamID: "team_id_1",
@@ -177,13 +179,13 @@ func TestUpdateCategory(t *testing.T) {
 			TeamID: "team_id_2",
 			Type:   "custom",
 		}
-		updatedCategory, err := th.App.UpdateCategory(category)
+		updatedCategory, err := th.App.UpdateCategory(context.Background(), category)
 		assert.Nil(t, updatedCategory)
 		assert.Error(t, err)
 	})
 
 	t.Run("should not be allowed to rename system category", func(t *testing.T) {
-		th.Store.EXPECT().GetCategory(utils.Anything).Return(&model.Category{
+		th.Store.EXPECT().GetCategory(gomock.Any(), utils.Anything).Return(&model.Category{
 			ID:     "category_id_1",
 			Name:   "Category",
 			TeamID: "team_id_1",
@@ -191,9 +193,9 @@ func TestUpdateCategory(t *testing.T) {
 			Type:   "system",
 		}, nil).Times(1)
 
-		th.Store.EXPECT().UpdateCategory(utils.Anything).Return(nil)
+		th.Store.EXPECT().UpdateCategory(gomock.Any(), utils.Anything).Return(nil)
 
-		th.Store.EXPECT().GetCategory(utils.Anything).Return(&model.Category{
+		th.Store.EXPECT().GetCategory(gomock.Any(), utils.Anything).Return(&model.Category{
 			ID:        "category_id_1",
 			Name:      "Category",
 			TeamID:    "team_id_1",
@@ -209,14 +211,14 @@ func TestUpdateCategory(t *testing.T) {
 			TeamID: "team_id_1",
 			Type:   "system",
 		}
-		updatedCategory, err := th.App.UpdateCategory(category)
+		updatedCategory, err := th.App.UpdateCategory(context.Background(), category)
 		assert.NotNil(t, updatedCategory)
 		assert.NoError(t, err)
 		assert.Equal(t, "Category", updatedCategory.Name)
 	})
 
 	t.Run("should be allowed to collapse and expand any category type", func(t *testing.T) {
-		th.Store.EXPECT().GetCategory(utils.Anything).Return(&model.Category{
+		th.Store.EXPECT().GetCategory(gomock.Any(), utils.Anything).Return(&model.Category{
 			ID:        "category_id_1",
 			Name:      "Category",
 			TeamID:    "team_id_1",
@@ -225,9 +227,9 @@ func TestUpdateCategory(t *testing.T) {
 			Collapsed: false,
 		}, nil).Times(1)
 
-		th.Store.EXPECT().UpdateCategory(utils.Anything).Return(nil)
+		th.Store.EXPECT().UpdateCategory(gomock.Any(), utils.Anything).Return(nil)
 
-		th.Store.EXPECT().GetCategory(utils.Anything).Return(&model.Category{
+		th.Store.EXPECT().GetCategory(gomock.Any(), utils.Anything).Return(&model.Category{
 			ID:        "category_id_1",
 			Name:      "Category",
 			TeamID:    "team_id_1",
@@ -244,7 +246,7 @@ func TestUpdateCategory(t *testing.T) {
 			Type:      "system",
 			Collapsed: true,
 		}
-		updatedCategory, err := th.App.UpdateCategory(category)
+		updatedCategory, err := th.App.UpdateCategory(context.Background(), category)
 		assert.NotNil(t, updatedCategory)
 		assert.NoError(t, err)
 		assert.Equal(t, "Category", updatedCategory.Name, "The name should have not been updated")
@@ -257,7 +259,7 @@ func TestDeleteCategory(t *testing.T) {
 	defer tearDown()
 
 	t.Run("base case", func(t *testing.T) {
-		th.Store.EXPECT().GetCategory("category_id_1").Return(&model.Category{
+		th.Store.EXPECT().GetCategory(gomock.Any(), "category_id_1").Return(&model.Category{
 			ID:       "category_id_1",
 			DeleteAt: 0,
 			UserID:   "user_id_1",
@@ -265,13 +267,13 @@ func TestDeleteCategory(t *testing.T) {
 			Type:     "custom",
 		}, nil)
 
-		th.Store.EXPECT().DeleteCategory("category_id_1", "user_id_1", "team_id_1").Return(nil)
+		th.Store.EXPECT().DeleteCategory(gomock.Any(), "category_id_1", "user_id_1", "team_id_1").Return(nil)
 
-		th.Store.EXPECT().GetCategory("category_id_1").Return(&model.Category{
+		th.Store.EXPECT().GetCategory(gomock.Any(), "category_id_1").Return(&model.Category{
 			DeleteAt: 10000,
 		}, nil)
 
-		th.Store.EXPECT().GetUserCategoryBoards("user_id_1", "team_id_1").Return([]model.CategoryBoards{
+		th.Store.EXPECT().GetUserCategoryBoards(gomock.Any(), "user_id_1", "team_id_1").Return([]model.CategoryBoards{
 			{
 				Category: model.Category{
 					ID:       "category_id_default",
@@ -296,13 +298,13 @@ func TestDeleteCategory(t *testing.T) {
 			},
 		}, nil)
 
-		deletedCategory, err := th.App.DeleteCategory("category_id_1", "user_id_1", "team_id_1")
+		deletedCategory, err := th.App.DeleteCategory(context.Background(), "category_id_1", "user_id_1", "team_id_1")
 		assert.NotNil(t, deletedCategory)
 		assert.NoError(t, err)
 	})
 
 	t.Run("trying to delete already deleted category", func(t *testing.T) {
-		th.Store.EXPECT().GetCategory("category_id_1").Return(&model.Category{
+		th.Store.EXPECT().GetCategory(gomock.Any(), "category_id_1").Return(&model.Category{
 			ID:       "category_id_1",
 			DeleteAt: 1000,
 			UserID:   "user_id_1",
@@ -310,13 +312,13 @@ func TestDeleteCategory(t *testing.T) {
 			Type:     "custom",
 		}, nil)
 
-		deletedCategory, err := th.App.DeleteCategory("category_id_1", "user_id_1", "team_id_1")
+		deletedCategory, err := th.App.DeleteCategory(context.Background(), "category_id_1", "user_id_1", "team_id_1")
 		assert.NotNil(t, deletedCategory)
 		assert.NoError(t, err)
 	})
 
 	t.Run("trying to delete system category", func(t *testing.T) {
-		th.Store.EXPECT().GetCategory("category_id_1").Return(&model.Category{
+		th.Store.EXPECT().GetCategory(gomock.Any(), "category_id_1").Return(&model.Category{
 			ID:       "category_id_1",
 			DeleteAt: 0,
 			UserID:   "user_id_1",
@@ -324,7 +326,7 @@ func TestDeleteCategory(t *testing.T) {
 			Type:     "system",
 		}, nil)
 
-		deletedCategory, err := th.App.DeleteCategory("category_id_1", "user_id_1", "team_id_1")
+		deletedCategory, err := th.App.DeleteCategory(context.Background(), "category_id_1", "user_id_1", "team_id_1")
 		assert.Nil(t, deletedCategory)
 		assert.Error(t, err)
 	})
@@ -335,7 +337,7 @@ func TestMoveBoardsToDefaultCategory(t *testing.T) {
 	defer tearDown()
 
 	t.Run("When default category already exists", func(t *testing.T) {
-		th.Store.EXPECT().GetUserCategoryBoards("user_id", "team_id").Return([]model.CategoryBoards{
+		th.Store.EXPECT().GetUserCategoryBoards(gomock.Any(), "user_id", "team_id").Return([]model.CategoryBoards{
 			{
 				Category: model.Category{
 					ID:   "category_id_1",
@@ -352,12 +354,12 @@ func TestMoveBoardsToDefaultCategory(t *testing.T) {
 			},
 		}, nil)
 
-		err := th.App.moveBoardsToDefaultCategory("user_id", "team_id", "category_id_2")
+		err := th.App.moveBoardsToDefaultCategory(context.Background(), "user_id", "team_id", "category_id_2")
 		assert.NoError(t, err)
 	})
 
 	t.Run("When default category doesn't already exists", func(t *testing.T) {
-		th.Store.EXPECT().GetUserCategoryBoards("user_id", "team_id").Return([]model.CategoryBoards{
+		th.Store.EXPECT().GetUserCategoryBoards(gomock.Any(), "user_id", "team_id").Return([]model.CategoryBoards{
 			{
 				Category: model.Category{
 					ID:   "category_id_2",
@@ -367,16 +369,16 @@ func TestMoveBoardsToDefaultCategory(t *testing.T) {
 			},
 		}, nil)
 
-		th.Store.EXPECT().CreateCategory(utils.Anything).Return(nil)
-		th.Store.EXPECT().GetCategory(utils.Anything).Return(&model.Category{
+		th.Store.EXPECT().CreateCategory(gomock.Any(), utils.Anything).Return(nil)
+		th.Store.EXPECT().GetCategory(gomock.Any(), utils.Anything).Return(&model.Category{
 			ID:   "default_category_id",
 			Name: "Boards",
 			Type: "system",
 		}, nil)
-		th.Store.EXPECT().GetMembersForUser("user_id").Return([]*model.BoardMember{}, nil)
-		th.Store.EXPECT().GetBoardsForUserAndTeam("user_id", "team_id", false).Return([]*model.Board{}, nil)
+		th.Store.EXPECT().GetMembersForUser(gomock.Any(), "user_id").Return([]*model.BoardMember{}, nil)
+		th.Store.EXPECT().GetBoardsForUserAndTeam(gomock.Any(), "user_id", "team_id", false).Return([]*model.Board{}, nil)
 
-		err := th.App.moveBoardsToDefaultCategory("user_id", "team_id", "category_id_2")
+		err := th.App.moveBoardsToDefaultCategory(context.Background(), "user_id", "team_id", "category_id_2")
 		assert.NoError(t, err)
 	})
 }
@@ -386,7 +388,7 @@ func TestReorderCategories(t *testing.T) {
 	defer tearDown()
 
 	t.Run("base case", func(t *testing.T) {
-		th.Store.EXPECT().GetUserCategories("user_id", "team_id").Return([]model.Category{
+		th.Store.EXPECT().GetUserCategories(gomock.Any(), "user_id", "team_id").Return([]model.Category{
 			{
 				ID:   "category_id_1",
 				Name: "Boards",
@@ -404,16 +406,16 @@ func TestReorderCategories(t *testing.T) {
 			},
 		}, nil)
 
-		th.Store.EXPECT().ReorderCategories("user_id", "team_id", []string{"category_id_2", "category_id_3", "category_id_1"}).
+		th.Store.EXPECT().ReorderCategories(gomock.Any(), "user_id", "team_id", []string{"category_id_2", "category_id_3", "category_id_1"}).
 			Return([]string{"category_id_2", "category_id_3", "category_id_1"}, nil)
 
-		newOrder, err := th.App.ReorderCategories("user_id", "team_id", []string{"category_id_2", "category_id_3", "category_id_1"})
+		newOrder, err := th.App.ReorderCategories(context.Background(), "user_id", "team_id", []string{"category_id_2", "category_id_3", "category_id_1"})
 		assert.NoError(t, err)
 		assert.Equal(t, 3, len(newOrder))
 	})
 
 	t.Run("not specifying all categories should fail", func(t *testing.T) {
-		th.Store.EXPECT().GetUserCategories("user_id", "team_id").Return([]model.Category{
+		th.Store.EXPECT().GetUserCategories(gomock.Any(), "user_id", "team_id").Return([]model.Category{
 			{
 				ID:   "category_id_1",
 				Name: "Boards",
@@ -431,7 +433,7 @@ func TestReorderCategories(t *testing.T) {
 			},
 		}, nil)
 
-		newOrder, err := th.App.ReorderCategories("user_id", "team_id", []string{"category_id_2", "category_id_3"})
+		newOrder, err := th.App.ReorderCategories(context.Background(), "user_id", "team_id", []string{"category_id_2", "category_id_3"})
 		assert.Error(t, err)
 		assert.Nil(t, newOrder)
 	})
@@ -442,7 +444,7 @@ func TestVerifyNewCategoriesMatchExisting(t *testing.T) {
 	defer tearDown()
 
 	t.Run("base case", func(t *testing.T) {
-		th.Store.EXPECT().GetUserCategories("user_id", "team_id").Return([]model.Category{
+		th.Store.EXPECT().GetUserCategories(gomock.Any(), "user_id", "team_id").Return([]model.Category{
 			{
 				ID:   "category_id_1",
 				Name: "Boards",
@@ -460,7 +462,7 @@ func TestVerifyNewCategoriesMatchExisting(t *testing.T) {
 			},
 		}, nil)
 
-		err := th.App.verifyNewCategoriesMatchExisting("user_id", "team_id", []string{
+		err := th.App.verifyNewCategoriesMatchExisting(context.Background(), "user_id", "team_id", []string{
 			"category_id_2",
 			"category_id_3",
 			"category_id_1",
@@ -469,7 +471,7 @@ func TestVerifyNewCategoriesMatchExisting(t *testing.T) {
 	})
 
 	t.Run("different category counts", func(t *testing.T) {
-		th.Store.EXPECT().GetUserCategories("user_id", "team_id").Return([]model.Category{
+		th.Store.EXPECT().GetUserCategories(gomock.Any(), "user_id", "team_id").Return([]model.Category{
 			{
 				ID:   "category_id_1",
 				Name: "Boards",
@@ -487,7 +489,7 @@ func TestVerifyNewCategoriesMatchExisting(t *testing.T) {
 			},
 		}, nil)
 
-		err := th.App.verifyNewCategoriesMatchExisting("user_id", "team_id", []string{
+		err := th.App.verifyNewCategoriesMatchExisting(context.Background(), "user_id", "team_id", []string{
 			"category_id_2",
 			"category_id_3",
 		})
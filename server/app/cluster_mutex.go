@@ -0,0 +1,32 @@
+// Copyright (c) 2020-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package app
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/mattermost/mattermost/server/public/pluginapi/cluster"
+)
+
+// MutexFactory creates the named mutexes shared by the nodes of a cluster.
+type MutexFactory func(name string) (*cluster.Mutex, error)
+
+// lockCluster locks the named mutex, so that the work done while holding it is not run
+// concurrently by any node of the cluster, and returns the function unlocking it. Without a
+// mutex factory, as on a single server, the work is only serialized within this server.
+func (a *App) lockCluster(ctx context.Context, name string) (func(), error) {
+	if a.newMutexFn == nil {
+		return a.localMutexes.Lock(name), nil
+	}
+
+	mutex, err := a.newMutexFn(name)
+	if err != nil {
+		return nil, fmt.Errorf("cannot create cluster mutex %s: %w", name, err)
+	}
+	if err := mutex.LockWithContext(ctx); err != nil {
+		return nil, fmt.Errorf("cannot lock cluster mutex %s: %w", name, err)
+	}
+	return mutex.Unlock, nil
+}
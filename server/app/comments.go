@@ -0,0 +1,138 @@
+// Copyright (c) 2020-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package app
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/mattermost/mattermost-plugin-boards/server/model"
+	"github.com/mattermost/mattermost-plugin-boards/server/utils"
+
+	"github.com/mattermost/mattermost/server/public/shared/mlog"
+)
+
+// validateCommentReply ensures a comment replying to another comment references a comment on the
+// same card.
+func (a *App) validateCommentReply(ctx context.Context, block *model.Block) error {
+	parentID := model.CommentParentID(block)
+	if parentID == "" {
+		return nil
+	}
+
+	parent, err := a.store.GetBlock(ctx, parentID)
+	if model.IsErrNotFound(err) {
+		return model.NewErrBadRequest(fmt.Sprintf("parent comment %s not found", parentID))
+	}
+	if err != nil {
+		return err
+	}
+
+	if parent.Type != model.TypeComment || parent.BoardID != block.BoardID || parent.ParentID != block.ParentID {
+		return model.NewErrBadRequest(fmt.Sprintf("block %s is not a comment on the same card", parentID))
+	}
+	return nil
+}
+
+// GetComment returns the comment block with the specified ID on the specified board.
+func (a *App) GetComment(ctx context.Context, boardID, commentID string) (*model.Block, error) {
+	ctx, span := startSpan(ctx, "GetComment")
+	defer span.End()
+
+	comment, err := a.store.GetBlock(ctx, commentID)
+	if err != nil {
+		return nil, err
+	}
+
+	if comment.Type != model.TypeComment || comment.BoardID != boardID {
+		return nil, model.NewErrNotFound(fmt.Sprintf("comment ID=%s on BoardID=%s", commentID, boardID))
+	}
+	return comment, nil
+}
+
+// EditComment replaces the text of a comment and records when it was edited. Prior versions
+// remain available via GetCommentHistory.
+func (a *App) EditComment(ctx context.Context, comment *model.Block, title string, modifiedByID string) (*model.Block, error) {
+	ctx, span := startSpan(ctx, "EditComment")
+	defer span.End()
+
+	patch := &model.BlockPatch{
+		Title: &title,
+		UpdatedFields: map[string]interface{}{
+			model.CommentFieldEditedAt: utils.GetMillis(),
+		},
+	}
+	return a.PatchBlockAndNotify(ctx, comment.ID, patch, modifiedByID, false)
+}
+
+// GetCommentHistory returns every saved version of a comment, newest first.
+func (a *App) GetCommentHistory(ctx context.Context, commentID string) ([]*model.Block, error) {
+	ctx, span := startSpan(ctx, "GetCommentHistory")
+	defer span.End()
+
+	return a.store.GetBlockHistory(ctx, commentID, model.QueryBlockHistoryOptions{Descending: true})
+}
+
+func (a *App) GetCommentReactions(ctx context.Context, commentID string) ([]*model.CommentReaction, error) {
+	ctx, span := startSpan(ctx, "GetCommentReactions")
+	defer span.End()
+
+	return a.store.GetCommentReactions(ctx, commentID)
+}
+
+func (a *App) GetCommentReactionsForBoard(ctx context.Context, boardID string) ([]*model.CommentReaction, error) {
+	ctx, span := startSpan(ctx, "GetCommentReactionsForBoard")
+	defer span.End()
+
+	return a.store.GetCommentReactionsForBoard(ctx, boardID)
+}
+
+func (a *App) AddCommentReaction(ctx context.Context, reaction *model.CommentReaction) (*model.CommentReaction, error) {
+	ctx, span := startSpan(ctx, "AddCommentReaction")
+	defer span.End()
+
+	reaction, err := a.store.AddCommentReaction(ctx, reaction)
+	if err != nil {
+		return nil, err
+	}
+	a.notifyCommentReactionChanged(ctx, reaction)
+
+	return reaction, nil
+}
+
+func (a *App) DeleteCommentReaction(ctx context.Context, boardID, commentID, userID, emojiName string) (*model.CommentReaction, error) {
+	ctx, span := startSpan(ctx, "DeleteCommentReaction")
+	defer span.End()
+
+	if err := a.store.DeleteCommentReaction(ctx, commentID, userID, emojiName); err != nil {
+		return nil, err
+	}
+
+	reaction := &model.CommentReaction{
+		BlockID:   commentID,
+		BoardID:   boardID,
+		UserID:    userID,
+		EmojiName: emojiName,
+		DeleteAt:  utils.GetMillis(),
+	}
+	a.notifyCommentReactionChanged(ctx, reaction)
+
+	return reaction, nil
+}
+
+func (a *App) notifyCommentReactionChanged(ctx context.Context, reaction *model.CommentReaction) {
+	board, err := a.store.GetBoard(ctx, reaction.BoardID)
+	if err != nil {
+		a.logger.Error("Error notifying comment reaction change",
+			mlog.String("board_id", reaction.BoardID),
+			mlog.String("block_id", reaction.BlockID),
+			mlog.Err(err),
+		)
+		return
+	}
+
+	go func() {
+		a.wsAdapter.BroadcastCommentReactionChange(board.TeamID, reaction)
+	}()
+}
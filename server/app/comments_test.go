@@ -0,0 +1,105 @@
+// Copyright (c) 2020-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package app
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mattermost/mattermost-plugin-boards/server/model"
+)
+
+func TestValidateCommentReply(t *testing.T) {
+	th, tearDown := SetupTestHelper(t)
+	defer tearDown()
+
+	reply := &model.Block{
+		ID:       "reply-id",
+		BoardID:  "board-id",
+		ParentID: "card-id",
+		Type:     model.TypeComment,
+		Fields:   map[string]interface{}{model.CommentFieldParentID: "comment-id"},
+	}
+
+	t.Run("not a reply", func(t *testing.T) {
+		require.NoError(t, th.App.validateCommentReply(context.Background(), &model.Block{Type: model.TypeComment}))
+	})
+
+	t.Run("valid parent", func(t *testing.T) {
+		parent := &model.Block{ID: "comment-id", BoardID: "board-id", ParentID: "card-id", Type: model.TypeComment}
+		th.Store.EXPECT().GetBlock(gomock.Any(), "comment-id").Return(parent, nil)
+
+		require.NoError(t, th.App.validateCommentReply(context.Background(), reply))
+	})
+
+	t.Run("parent not found", func(t *testing.T) {
+		th.Store.EXPECT().GetBlock(gomock.Any(), "comment-id").Return(nil, model.NewErrNotFound("comment-id"))
+
+		err := th.App.validateCommentReply(context.Background(), reply)
+		require.True(t, model.IsErrBadRequest(err))
+	})
+
+	t.Run("parent on another card", func(t *testing.T) {
+		parent := &model.Block{ID: "comment-id", BoardID: "board-id", ParentID: "other-card-id", Type: model.TypeComment}
+		th.Store.EXPECT().GetBlock(gomock.Any(), "comment-id").Return(parent, nil)
+
+		err := th.App.validateCommentReply(context.Background(), reply)
+		require.True(t, model.IsErrBadRequest(err))
+	})
+
+	t.Run("parent not a comment", func(t *testing.T) {
+		parent := &model.Block{ID: "comment-id", BoardID: "board-id", ParentID: "card-id", Type: model.TypeText}
+		th.Store.EXPECT().GetBlock(gomock.Any(), "comment-id").Return(parent, nil)
+
+		err := th.App.validateCommentReply(context.Background(), reply)
+		require.True(t, model.IsErrBadRequest(err))
+	})
+}
+
+func TestGetComment(t *testing.T) {
+	th, tearDown := SetupTestHelper(t)
+	defer tearDown()
+
+	t.Run("comment on board", func(t *testing.T) {
+		comment := &model.Block{ID: "comment-id", BoardID: "board-id", Type: model.TypeComment}
+		th.Store.EXPECT().GetBlock(gomock.Any(), "comment-id").Return(comment, nil)
+
+		got, err := th.App.GetComment(context.Background(), "board-id", "comment-id")
+		require.NoError(t, err)
+		require.Equal(t, comment, got)
+	})
+
+	t.Run("comment on another board", func(t *testing.T) {
+		comment := &model.Block{ID: "comment-id", BoardID: "other-board-id", Type: model.TypeComment}
+		th.Store.EXPECT().GetBlock(gomock.Any(), "comment-id").Return(comment, nil)
+
+		_, err := th.App.GetComment(context.Background(), "board-id", "comment-id")
+		require.True(t, model.IsErrNotFound(err))
+	})
+
+	t.Run("not a comment", func(t *testing.T) {
+		block := &model.Block{ID: "block-id", BoardID: "board-id", Type: model.TypeText}
+		th.Store.EXPECT().GetBlock(gomock.Any(), "block-id").Return(block, nil)
+
+		_, err := th.App.GetComment(context.Background(), "board-id", "block-id")
+		require.True(t, model.IsErrNotFound(err))
+	})
+}
+
+func TestDeleteCommentReaction(t *testing.T) {
+	th, tearDown := SetupTestHelper(t)
+	defer tearDown()
+
+	th.Store.EXPECT().DeleteCommentReaction(gomock.Any(), "comment-id", "user-id", "smile").Return(nil)
+	th.Store.EXPECT().GetBoard(gomock.Any(), "board-id").Return(&model.Board{ID: "board-id", TeamID: "team-id"}, nil)
+	th.Store.EXPECT().GetMembersForBoard(gomock.Any(), "board-id").Return([]*model.BoardMember{}, nil).AnyTimes()
+
+	reaction, err := th.App.DeleteCommentReaction(context.Background(), "board-id", "comment-id", "user-id", "smile")
+	require.NoError(t, err)
+	require.Equal(t, "smile", reaction.EmojiName)
+	require.NotZero(t, reaction.DeleteAt)
+}
@@ -3,16 +3,29 @@
 
 package app
 
-import "github.com/mattermost/mattermost-plugin-boards/server/model"
+import (
+	"context"
 
-func (a *App) GetBoardsForCompliance(opts model.QueryBoardsForComplianceOptions) ([]*model.Board, bool, error) {
-	return a.store.GetBoardsForCompliance(opts)
+	"github.com/mattermost/mattermost-plugin-boards/server/model"
+)
+
+func (a *App) GetBoardsForCompliance(ctx context.Context, opts model.QueryBoardsForComplianceOptions) ([]*model.Board, bool, error) {
+	ctx, span := startSpan(ctx, "GetBoardsForCompliance")
+	defer span.End()
+
+	return a.store.GetBoardsForCompliance(ctx, opts)
 }
 
-func (a *App) GetBoardsComplianceHistory(opts model.QueryBoardsComplianceHistoryOptions) ([]*model.BoardHistory, bool, error) {
-	return a.store.GetBoardsComplianceHistory(opts)
+func (a *App) GetBoardsComplianceHistory(ctx context.Context, opts model.QueryBoardsComplianceHistoryOptions) ([]*model.BoardHistory, bool, error) {
+	ctx, span := startSpan(ctx, "GetBoardsComplianceHistory")
+	defer span.End()
+
+	return a.store.GetBoardsComplianceHistory(ctx, opts)
 }
 
-func (a *App) GetBlocksComplianceHistory(opts model.QueryBlocksComplianceHistoryOptions) ([]*model.BlockHistory, bool, error) {
-	return a.store.GetBlocksComplianceHistory(opts)
+func (a *App) GetBlocksComplianceHistory(ctx context.Context, opts model.QueryBlocksComplianceHistoryOptions) ([]*model.BlockHistory, bool, error) {
+	ctx, span := startSpan(ctx, "GetBlocksComplianceHistory")
+	defer span.End()
+
+	return a.store.GetBlocksComplianceHistory(ctx, opts)
 }
@@ -4,19 +4,23 @@
 package app
 
 import (
+	"context"
 	"fmt"
 
 	"github.com/mattermost/mattermost-plugin-boards/server/model"
 	"github.com/pkg/errors"
 )
 
-func (a *App) MoveContentBlock(block *model.Block, dstBlock *model.Block, where string, userID string) error {
+func (a *App) MoveContentBlock(ctx context.Context, block *model.Block, dstBlock *model.Block, where string, userID string) error {
+	ctx, span := startSpan(ctx, "MoveContentBlock")
+	defer span.End()
+
 	if block.ParentID != dstBlock.ParentID {
 		message := fmt.Sprintf("not matching parent %s and %s", block.ParentID, dstBlock.ParentID)
 		return model.NewErrBadRequest(message)
 	}
 
-	card, err := a.GetBlockByID(block.ParentID)
+	card, err := a.GetBlockByID(ctx, block.ParentID)
 	if err != nil {
 		return err
 	}
@@ -73,7 +77,7 @@ func (a *App) MoveContentBlock(block *model.Block, dstBlock *model.Block, where
 		},
 	}
 
-	_, err = a.PatchBlock(block.ParentID, patch, userID)
+	_, err = a.PatchBlock(ctx, block.ParentID, patch, userID)
 	if errors.Is(err, model.ErrPatchUpdatesLimitedCards) {
 		return err
 	}
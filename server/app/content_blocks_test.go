@@ -4,6 +4,7 @@
 package app
 
 import (
+	"context"
 	"fmt"
 	"testing"
 
@@ -166,25 +167,25 @@ func TestMoveContentBlock(t *testing.T) {
 			tc := tc
 			if tc.parentBlock != nil {
 				if tc.parentBlock.ID == "invalid-card" {
-					th.Store.EXPECT().GetBlock(tc.srcBlock.ParentID).Return(nil, model.NewErrNotFound("test"))
+					th.Store.EXPECT().GetBlock(gomock.Any(), tc.srcBlock.ParentID).Return(nil, model.NewErrNotFound("test"))
 				} else {
-					th.Store.EXPECT().GetBlock(tc.parentBlock.ID).Return(tc.parentBlock, nil)
+					th.Store.EXPECT().GetBlock(gomock.Any(), tc.parentBlock.ID).Return(tc.parentBlock, nil)
 					if tc.mockPatch {
 						if tc.mockPatchError != nil {
-							th.Store.EXPECT().GetBlock(tc.parentBlock.ID).Return(nil, tc.mockPatchError)
+							th.Store.EXPECT().GetBlock(gomock.Any(), tc.parentBlock.ID).Return(nil, tc.mockPatchError)
 						} else {
-							th.Store.EXPECT().GetBlock(tc.parentBlock.ID).Return(tc.parentBlock, nil)
-							th.Store.EXPECT().PatchBlock(tc.parentBlock.ID, NewContentOrderMatcher(tc.expectedContentOrder), gomock.Eq("user-id")).Return(nil)
-							th.Store.EXPECT().GetBlock(tc.parentBlock.ID).Return(tc.parentBlock, nil)
-							th.Store.EXPECT().GetBoard(tc.parentBlock.BoardID).Return(&model.Board{ID: "test-board"}, nil)
+							th.Store.EXPECT().GetBlock(gomock.Any(), tc.parentBlock.ID).Return(tc.parentBlock, nil)
+							th.Store.EXPECT().PatchBlock(gomock.Any(), tc.parentBlock.ID, NewContentOrderMatcher(tc.expectedContentOrder), gomock.Eq("user-id")).Return(nil)
+							th.Store.EXPECT().GetBlock(gomock.Any(), tc.parentBlock.ID).Return(tc.parentBlock, nil)
+							th.Store.EXPECT().GetBoard(gomock.Any(), tc.parentBlock.BoardID).Return(&model.Board{ID: "test-board"}, nil)
 							// this call comes from the WS server notification
-							th.Store.EXPECT().GetMembersForBoard(gomock.Any()).Times(1)
+							th.Store.EXPECT().GetMembersForBoard(gomock.Any(), gomock.Any()).Times(1)
 						}
 					}
 				}
 			}
 
-			err := th.App.MoveContentBlock(&tc.srcBlock, &tc.dstBlock, tc.where, tc.userID)
+			err := th.App.MoveContentBlock(context.Background(), &tc.srcBlock, &tc.dstBlock, tc.where, tc.userID)
 			if tc.errorMessage == "" {
 				require.NoError(t, err)
 			} else {
@@ -5,13 +5,16 @@ package app
 
 import (
 	"archive/zip"
+	"context"
 	"encoding/json"
 	"fmt"
 	"io"
+	"slices"
 
 	"github.com/mattermost/mattermost-plugin-boards/server/model"
 	"github.com/wiggin77/merror"
 
+	mm_model "github.com/mattermost/mattermost/server/public/model"
 	"github.com/mattermost/mattermost/server/public/shared/mlog"
 )
 
@@ -19,8 +22,11 @@ var (
 	newline = []byte{'\n'}
 )
 
-func (a *App) ExportArchive(w io.Writer, opt model.ExportArchiveOptions) (errs error) {
-	boards, err := a.getBoardsForArchive(opt.BoardIDs)
+func (a *App) ExportArchive(ctx context.Context, w io.Writer, opt model.ExportArchiveOptions) (errs error) {
+	ctx, span := startSpan(ctx, "ExportArchive")
+	defer span.End()
+
+	boards, err := a.getBoardsForArchive(ctx, opt.BoardIDs)
 	if err != nil {
 		return err
 	}
@@ -42,7 +48,7 @@ func (a *App) ExportArchive(w io.Writer, opt model.ExportArchiveOptions) (errs e
 	}
 
 	for _, board := range boards {
-		if err := a.writeArchiveBoard(zw, board, opt); err != nil {
+		if err := a.writeArchiveBoard(ctx, zw, board, opt); err != nil {
 			merr.Append(fmt.Errorf("cannot export board %s: %w", board.ID, err))
 			return
 		}
@@ -70,7 +76,7 @@ func (a *App) writeArchiveVersion(zw *zip.Writer) error {
 }
 
 // writeArchiveBoard writes a single board to the archive in a zip directory.
-func (a *App) writeArchiveBoard(zw *zip.Writer, board model.Board, opt model.ExportArchiveOptions) error {
+func (a *App) writeArchiveBoard(ctx context.Context, zw *zip.Writer, board model.Board, opt model.ExportArchiveOptions) error {
 	// create a directory per board
 	w, err := zw.Create(board.ID + "/board.jsonl")
 	if err != nil {
@@ -85,7 +91,7 @@ func (a *App) writeArchiveBoard(zw *zip.Writer, board model.Board, opt model.Exp
 	var files []string
 	// write the board's blocks
 	// TODO: paginate this
-	blocks, err := a.GetBlocksForBoard(board.ID)
+	blocks, err := a.GetBlocksForBoard(ctx, board.ID)
 	if err != nil {
 		return err
 	}
@@ -100,10 +106,17 @@ func (a *App) writeArchiveBoard(zw *zip.Writer, board model.Board, opt model.Exp
 				return err2
 			}
 			files = append(files, filename)
+
+			// the current version is already exported.
+			for _, versionFileID := range model.GetAttachmentVersionFileIDs(block) {
+				if versionFileID != filename && !slices.Contains(files, versionFileID) {
+					files = append(files, versionFileID)
+				}
+			}
 		}
 	}
 
-	boardMembers, err := a.GetMembersForBoard(board.ID)
+	boardMembers, err := a.GetMembersForBoard(ctx, board.ID)
 	if err != nil {
 		return err
 	}
@@ -116,7 +129,7 @@ func (a *App) writeArchiveBoard(zw *zip.Writer, board model.Board, opt model.Exp
 
 	// write the files
 	for _, filename := range files {
-		if err := a.writeArchiveFile(zw, filename, board.ID, opt); err != nil {
+		if err := a.writeArchiveFile(ctx, zw, filename, board.ID, opt); err != nil {
 			return fmt.Errorf("cannot write file %s to archive: %w", filename, err)
 		}
 	}
@@ -201,13 +214,13 @@ func (a *App) writeArchiveBoardLine(w io.Writer, board model.Board) error {
 }
 
 // writeArchiveFile writes a single file to the archive.
-func (a *App) writeArchiveFile(zw *zip.Writer, filename string, boardID string, opt model.ExportArchiveOptions) error {
+func (a *App) writeArchiveFile(ctx context.Context, zw *zip.Writer, filename string, boardID string, opt model.ExportArchiveOptions) error {
 	dest, err := zw.Create(boardID + "/" + filename)
 	if err != nil {
 		return err
 	}
 
-	_, fileReader, err := a.GetFile(opt.TeamID, boardID, filename)
+	fileInfo, fileReader, err := a.GetFile(ctx, opt.TeamID, boardID, filename)
 	if err != nil && !model.IsErrNotFound(err) {
 		return err
 	}
@@ -222,16 +235,50 @@ func (a *App) writeArchiveFile(zw *zip.Writer, filename string, boardID string,
 	}
 	defer fileReader.Close()
 
-	_, err = io.Copy(dest, fileReader)
-	return err
+	if _, err = io.Copy(dest, fileReader); err != nil {
+		return err
+	}
+
+	return a.writeArchiveFileDerivatives(zw, filename, boardID, fileInfo)
+}
+
+// writeArchiveFileDerivatives writes the thumbnail and preview of a file to the archive, next to
+// the file.
+func (a *App) writeArchiveFileDerivatives(zw *zip.Writer, filename string, boardID string, fileInfo *mm_model.FileInfo) error {
+	for _, size := range model.FileDerivativeSizes {
+		path := model.GetFileDerivativePath(fileInfo, size)
+		if path == "" {
+			continue
+		}
+
+		reader, err := a.filesBackend.Reader(path)
+		if err != nil {
+			a.logger.Warn("file derivative missing for export",
+				mlog.String("filename", filename),
+				mlog.String("size", string(size)),
+				mlog.Err(err),
+			)
+			continue
+		}
+
+		dest, err := zw.Create(boardID + "/" + model.FileDerivativePath(filename, size))
+		if err == nil {
+			_, err = io.Copy(dest, reader)
+		}
+		reader.Close()
+		if err != nil {
+			return err
+		}
+	}
+	return nil
 }
 
 // getBoardsForArchive fetches all the specified boards.
-func (a *App) getBoardsForArchive(boardIDs []string) ([]model.Board, error) {
+func (a *App) getBoardsForArchive(ctx context.Context, boardIDs []string) ([]model.Board, error) {
 	boards := make([]model.Board, 0, len(boardIDs))
 
 	for _, id := range boardIDs {
-		b, err := a.GetBoard(id)
+		b, err := a.GetBoard(ctx, id)
 		if err != nil {
 			return nil, fmt.Errorf("could not fetch board %s: %w", id, err)
 		}
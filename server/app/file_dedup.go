@@ -0,0 +1,229 @@
+// Copyright (c) 2020-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package app
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"path/filepath"
+	"strings"
+
+	"github.com/mattermost/mattermost-plugin-boards/server/model"
+	mm_model "github.com/mattermost/mattermost/server/public/model"
+
+	"github.com/mattermost/mattermost/server/public/shared/mlog"
+)
+
+// contentAddressedFilesDir holds the files stored under the hash of their content when file
+// deduplication is enabled. Each stored file is shared by every file info with its path, and is
+// removed with the last of them.
+const contentAddressedFilesDir = "boards/objects"
+
+func contentAddressedPath(hash, extension string) string {
+	return filepath.Join(contentAddressedFilesDir, hash[:2], hash+strings.ToLower(extension))
+}
+
+func isContentAddressedPath(path string) bool {
+	return strings.HasPrefix(path, contentAddressedFilesDir+"/")
+}
+
+// contentAddressedMutexName is the cluster mutex held while a content addressed file is shared
+// by a new file info or collected, so that a file is not removed while being shared.
+func contentAddressedMutexName(objectPath string) string {
+	hash := strings.TrimSuffix(filepath.Base(objectPath), filepath.Ext(objectPath))
+	return "Boards_fileObjectMutex_" + hash
+}
+
+// storeContentAddressed moves a file just written to path to objectPath, the path of its content,
+// unless the same content is already stored, in which case the file is removed. The mutex of the
+// content must be held until its file info is saved.
+func (a *App) storeContentAddressed(path, objectPath string) error {
+	exists, err := a.filesBackend.FileExists(objectPath)
+	if err != nil {
+		return err
+	}
+
+	if exists {
+		if err := a.filesBackend.RemoveFile(path); err != nil {
+			a.logger.Warn("Cannot remove duplicate file", mlog.String("path", path), mlog.Err(err))
+		}
+		return nil
+	}
+
+	if err := a.filesBackend.MoveFile(path, objectPath); err != nil {
+		return fmt.Errorf("cannot move file to %s: %w", objectPath, err)
+	}
+	return nil
+}
+
+// removeUnreferencedContentAddressed removes a content addressed file unless a file info
+// references it, in which case it was stored before and is shared. The mutex of the content must be
+// held.
+func (a *App) removeUnreferencedContentAddressed(ctx context.Context, objectPath string) {
+	references, err := a.store.CountFileInfosWithPath(ctx, objectPath)
+	if err != nil {
+		a.logger.Error("Cannot count the file infos of a content addressed file", mlog.String("path", objectPath), mlog.Err(err))
+		return
+	}
+	if references > 0 {
+		return
+	}
+
+	if err := a.filesBackend.RemoveFile(objectPath); err != nil {
+		a.logger.Error("Cannot remove unreferenced content addressed file", mlog.String("path", objectPath), mlog.Err(err))
+	}
+}
+
+// moveToContentAddressed moves a file of a board stored at path, and its derivatives, under the
+// hash of its content, and points its file info to them, so that its copies share the content
+// instead of storing it once more. Returns the path the content is stored at, whose mutex is held
+// until unlocked with the returned function.
+func (a *App) moveToContentAddressed(ctx context.Context, fileInfo *mm_model.FileInfo, path, boardID string) (string, func(), error) {
+	reader, err := a.filesBackend.Reader(path)
+	if err != nil {
+		return "", nil, err
+	}
+	defer reader.Close()
+
+	hash := sha256.New()
+	if _, err := io.Copy(hash, reader); err != nil {
+		return "", nil, fmt.Errorf("cannot hash file %s: %w", path, err)
+	}
+
+	objectPath := contentAddressedPath(hex.EncodeToString(hash.Sum(nil)), filepath.Ext(path))
+	unlock, err := a.lockCluster(ctx, contentAddressedMutexName(objectPath))
+	if err != nil {
+		return "", nil, err
+	}
+
+	exists, err := a.filesBackend.FileExists(objectPath)
+	if err != nil {
+		unlock()
+		return "", nil, err
+	}
+	if !exists {
+		if err := a.filesBackend.CopyFile(path, objectPath); err != nil {
+			unlock()
+			return "", nil, fmt.Errorf("cannot copy file to %s: %w", objectPath, err)
+		}
+	}
+
+	// the file is only removed once its file info points to its content, so that it can be read
+	// meanwhile.
+	oldDerivativePaths := make([]string, 0, len(model.FileDerivativeSizes))
+	for _, size := range model.FileDerivativeSizes {
+		if derivativePath := model.GetFileDerivativePath(fileInfo, size); derivativePath != "" {
+			oldDerivativePaths = append(oldDerivativePaths, derivativePath)
+		}
+	}
+	a.copyFileDerivatives(fileInfo, objectPath)
+	fileInfo.Path = objectPath
+	if err := a.store.UpdateFileInfoPaths(ctx, fileInfo); err != nil {
+		unlock()
+		return "", nil, err
+	}
+	if err := a.store.SaveFileBoard(ctx, fileInfo.Id, boardID); err != nil {
+		unlock()
+		return "", nil, err
+	}
+
+	for _, oldPath := range append(oldDerivativePaths, path) {
+		if err := a.filesBackend.RemoveFile(oldPath); err != nil {
+			a.logger.Warn("Cannot remove deduplicated file", mlog.String("path", oldPath), mlog.Err(err))
+		}
+	}
+	return objectPath, unlock, nil
+}
+
+// collectOrphanedContentAddressedFiles removes the file infos of content addressed files no block
+// references, and the stored files once no file info references them anymore.
+func (a *App) collectOrphanedContentAddressedFiles(ctx context.Context, fileInfos []*mm_model.FileInfo, referenced map[string]bool, report *model.FileGCReport) error {
+	orphans := map[string][]*mm_model.FileInfo{}
+	orphanIDs := []string{}
+	paths := []string{}
+	for _, fileInfo := range fileInfos {
+		if referenced[fileInfo.Id] {
+			continue
+		}
+		orphanIDs = append(orphanIDs, fileInfo.Id)
+		if _, ok := orphans[fileInfo.Path]; !ok {
+			paths = append(paths, fileInfo.Path)
+		}
+		orphans[fileInfo.Path] = append(orphans[fileInfo.Path], fileInfo)
+	}
+
+	// the storage of content addressed files is charged to the boards recorded for them.
+	boardIDs, err := a.store.GetFileBoardIDs(ctx, orphanIDs)
+	if err != nil {
+		return err
+	}
+
+	for _, path := range paths {
+		report.ScannedFiles++
+		if err := a.collectOrphanedContentAddressedFile(ctx, path, orphans[path], boardIDs, report); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// collectOrphanedContentAddressedFile removes the orphaned file infos of a content addressed file,
+// releasing their storage usage, and the stored file if no other file info references it.
+func (a *App) collectOrphanedContentAddressedFile(ctx context.Context, path string, orphans []*mm_model.FileInfo, boardIDs map[string]string, report *model.FileGCReport) error {
+	if !report.DryRun {
+		unlock, err := a.lockCluster(ctx, contentAddressedMutexName(path))
+		if err != nil {
+			return err
+		}
+		defer unlock()
+	}
+
+	references, err := a.store.CountFileInfosWithPath(ctx, path)
+	if err != nil {
+		return err
+	}
+	isOrphaned := references <= int64(len(orphans))
+	if isOrphaned {
+		report.OrphanedFiles = append(report.OrphanedFiles, model.OrphanedFile{
+			Path:       path,
+			Size:       orphans[0].Size,
+			ModifiedAt: orphans[0].CreateAt,
+		})
+	}
+	if report.DryRun {
+		return nil
+	}
+
+	for _, fileInfo := range orphans {
+		// the usage of the file infos whose blocks were deleted was released already.
+		if boardID, ok := boardIDs[fileInfo.Id]; ok {
+			if err := a.store.DeleteFiles(ctx, boardID, []string{fileInfo.Id}); err != nil {
+				return err
+			}
+		}
+		if err := a.store.DeleteFileInfo(ctx, fileInfo.Id); err != nil {
+			return err
+		}
+	}
+	if !isOrphaned {
+		return nil
+	}
+
+	if err := a.filesBackend.RemoveFile(path); err != nil {
+		a.logger.Error("Cannot remove orphaned file", mlog.String("path", path), mlog.Err(err))
+		return nil
+	}
+	for _, size := range model.FileDerivativeSizes {
+		derivativePath := model.FileDerivativePath(path, size)
+		if err := a.filesBackend.RemoveFile(derivativePath); err != nil {
+			a.logger.Warn("Cannot remove orphaned file derivative", mlog.String("path", derivativePath), mlog.Err(err))
+		}
+	}
+	report.RemovedFiles++
+	report.ReclaimedBytes += orphans[0].Size
+	return nil
+}
@@ -0,0 +1,205 @@
+// Copyright (c) 2020-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package app
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mattermost/mattermost-plugin-boards/server/model"
+	"github.com/mattermost/mattermost-plugin-boards/server/utils"
+	mm_model "github.com/mattermost/mattermost/server/public/model"
+)
+
+func testContentPath(content, extension string) string {
+	hash := sha256.Sum256([]byte(content))
+	return contentAddressedPath(hex.EncodeToString(hash[:]), extension)
+}
+
+func TestSaveFileDeduplication(t *testing.T) {
+	th, tearDown := SetupTestHelper(t)
+	defer tearDown()
+
+	teamID := "abcdefghijklmnopqrstuvwxyz"
+	th.App.config.FileDeduplication = true
+	expectUnlimitedStorage(th)
+
+	backend := newMemoryFileBackend()
+	th.App.filesBackend = backend
+
+	var fileInfos []*mm_model.FileInfo
+	th.Store.EXPECT().SaveFileInfo(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, fileInfo *mm_model.FileInfo) error {
+		fileInfos = append(fileInfos, fileInfo)
+		return nil
+	}).Times(3)
+	th.Store.EXPECT().SaveFileBoard(gomock.Any(), gomock.Any(), testBoardID).Return(nil).Times(3)
+
+	fileName1, err := th.App.SaveFile(context.Background(), bytes.NewReader([]byte("hello")), teamID, testBoardID, "a.txt", false)
+	require.NoError(t, err)
+	fileName2, err := th.App.SaveFile(context.Background(), bytes.NewReader([]byte("hello")), teamID, testBoardID, "b.TXT", false)
+	require.NoError(t, err)
+	_, err = th.App.SaveFile(context.Background(), bytes.NewReader([]byte("world")), teamID, testBoardID, "c.txt", false)
+	require.NoError(t, err)
+
+	assert.NotEqual(t, fileName1, fileName2)
+	require.Len(t, fileInfos, 3)
+	assert.Equal(t, testContentPath("hello", ".txt"), fileInfos[0].Path)
+	assert.Equal(t, fileInfos[0].Path, fileInfos[1].Path)
+	assert.NotEqual(t, fileInfos[0].Id, fileInfos[1].Id)
+	assert.Equal(t, testContentPath("world", ".txt"), fileInfos[2].Path)
+
+	assert.Len(t, backend.files, 2)
+	assert.Equal(t, []byte("hello"), backend.files[fileInfos[0].Path])
+}
+
+func TestCopyCardFilesDeduplication(t *testing.T) {
+	th, tearDown := SetupTestHelper(t)
+	defer tearDown()
+
+	teamID := "abcdefghijklmnopqrstuvwxyz"
+	boardID := utils.NewID(utils.IDTypeBoard)
+	th.App.config.FileDeduplication = true
+	th.Store.EXPECT().AddFileUsage(gomock.Any(), teamID, boardID, int64(5), int64(1)).Return(nil).AnyTimes()
+
+	copyFile := func(sourcePath string) *mm_model.FileInfo {
+		fileID := utils.NewID(utils.IDTypeNone)
+		block := &model.Block{
+			Type:    model.TypeImage,
+			BoardID: boardID,
+			Fields:  map[string]any{model.BlockFieldFileId: fileID + ".txt"},
+		}
+
+		var saved *mm_model.FileInfo
+		th.Store.EXPECT().GetBoard(gomock.Any(), boardID).Return(&model.Board{ID: boardID, TeamID: teamID}, nil)
+		th.Store.EXPECT().GetFileInfo(gomock.Any(), getFileInfoID(fileID)).Return(&mm_model.FileInfo{Id: getFileInfoID(fileID), Path: sourcePath, Size: 5}, nil)
+		th.Store.EXPECT().SaveFileInfo(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, fileInfo *mm_model.FileInfo) error {
+			saved = fileInfo
+			return nil
+		})
+		th.Store.EXPECT().SaveFileBoard(gomock.Any(), gomock.Any(), boardID).DoAndReturn(func(_ context.Context, fileID, _ string) error {
+			assert.Equal(t, saved.Id, fileID)
+			return nil
+		})
+
+		newFileNames, err := th.App.CopyCardFiles(context.Background(), boardID, []*model.Block{block}, false)
+		require.NoError(t, err)
+		require.Len(t, newFileNames, 1)
+		return saved
+	}
+
+	t.Run("content addressed file is shared", func(t *testing.T) {
+		backend := newMemoryFileBackend()
+		th.App.filesBackend = backend
+		path := testContentPath("hello", ".txt")
+		backend.files[path] = []byte("hello")
+
+		fileInfo := copyFile(path)
+		assert.Equal(t, path, fileInfo.Path)
+		assert.Len(t, backend.files, 1)
+	})
+
+	t.Run("legacy file is moved to the content addressed files", func(t *testing.T) {
+		backend := newMemoryFileBackend()
+		th.App.filesBackend = backend
+		backend.files["boards/20240101/7legacy.txt"] = []byte("hello")
+
+		// the original points to the moved content and is charged to its board.
+		th.Store.EXPECT().UpdateFileInfoPaths(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, fileInfo *mm_model.FileInfo) error {
+			assert.Equal(t, testContentPath("hello", ".txt"), fileInfo.Path)
+			return nil
+		})
+		th.Store.EXPECT().SaveFileBoard(gomock.Any(), gomock.Any(), boardID).Return(nil)
+
+		fileInfo := copyFile("boards/20240101/7legacy.txt")
+		assert.Equal(t, testContentPath("hello", ".txt"), fileInfo.Path)
+		assert.Equal(t, map[string][]byte{fileInfo.Path: []byte("hello")}, backend.files)
+	})
+}
+
+func TestCollectOrphanedContentAddressedFiles(t *testing.T) {
+	th, tearDown := SetupTestHelper(t)
+	defer tearDown()
+
+	sharedPath := testContentPath("shared", ".png")
+	orphanPath := testContentPath("orphan", ".png")
+
+	fileInfos := []*mm_model.FileInfo{
+		{Id: "orphan1", Path: orphanPath, Size: 6},
+		{Id: "orphan2", Path: orphanPath, Size: 6},
+		{Id: "shared1", Path: sharedPath, Size: 6},
+		{Id: "shared2", Path: sharedPath, Size: 6},
+	}
+	referenced := map[string]bool{"shared1": true}
+
+	setup := func() *memoryFileBackend {
+		backend := newMemoryFileBackend()
+		backend.files[sharedPath] = []byte("shared")
+		backend.files[orphanPath] = []byte("orphan")
+		backend.files[model.FileDerivativePath(orphanPath, model.FileSizeThumb)] = []byte("thumb")
+		th.App.filesBackend = backend
+
+		th.Store.EXPECT().GetFileBoardIDs(gomock.Any(), []string{"orphan1", "orphan2", "shared2"}).Return(map[string]string{"orphan1": testBoardID}, nil)
+		th.Store.EXPECT().CountFileInfosWithPath(gomock.Any(), orphanPath).Return(int64(2), nil)
+		th.Store.EXPECT().CountFileInfosWithPath(gomock.Any(), sharedPath).Return(int64(2), nil)
+		return backend
+	}
+
+	t.Run("dry run", func(t *testing.T) {
+		backend := setup()
+
+		report := &model.FileGCReport{DryRun: true}
+		require.NoError(t, th.App.collectOrphanedContentAddressedFiles(context.Background(), fileInfos, referenced, report))
+		assert.Equal(t, 2, report.ScannedFiles)
+		require.Len(t, report.OrphanedFiles, 1)
+		assert.Equal(t, orphanPath, report.OrphanedFiles[0].Path)
+		assert.Len(t, backend.files, 3)
+	})
+
+	t.Run("collection", func(t *testing.T) {
+		backend := setup()
+		// only the file infos charged to a board release their usage.
+		th.Store.EXPECT().DeleteFiles(gomock.Any(), testBoardID, []string{"orphan1"}).Return(nil)
+		th.Store.EXPECT().DeleteFileInfo(gomock.Any(), "orphan1").Return(nil)
+		th.Store.EXPECT().DeleteFileInfo(gomock.Any(), "orphan2").Return(nil)
+		th.Store.EXPECT().DeleteFileInfo(gomock.Any(), "shared2").Return(nil)
+
+		report := &model.FileGCReport{}
+		require.NoError(t, th.App.collectOrphanedContentAddressedFiles(context.Background(), fileInfos, referenced, report))
+		assert.Equal(t, 1, report.RemovedFiles)
+		assert.Equal(t, int64(6), report.ReclaimedBytes)
+		assert.Equal(t, map[string][]byte{sharedPath: []byte("shared")}, backend.files)
+	})
+	t.Run("a file being shared is not collected meanwhile", func(t *testing.T) {
+		unlock, err := th.App.lockCluster(context.Background(), contentAddressedMutexName(orphanPath))
+		require.NoError(t, err)
+
+		setup()
+		th.Store.EXPECT().DeleteFiles(gomock.Any(), testBoardID, []string{"orphan1"}).Return(nil)
+		th.Store.EXPECT().DeleteFileInfo(gomock.Any(), gomock.Any()).Return(nil).Times(3)
+
+		done := make(chan struct{})
+		go func() {
+			defer close(done)
+			report := &model.FileGCReport{}
+			assert.NoError(t, th.App.collectOrphanedContentAddressedFiles(context.Background(), fileInfos, referenced, report))
+		}()
+
+		select {
+		case <-done:
+			t.Fatal("the file was collected while being shared")
+		case <-time.After(50 * time.Millisecond):
+		}
+
+		unlock()
+		<-done
+	})
+}
@@ -0,0 +1,247 @@
+// Copyright (c) 2020-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package app
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"image"
+	"image/color"
+	"image/draw"
+	"image/jpeg"
+	"io"
+
+	// register the decoders of the image formats derivatives are generated for.
+	_ "image/gif"
+	_ "image/png"
+
+	"github.com/mattermost/mattermost-plugin-boards/server/model"
+	mm_model "github.com/mattermost/mattermost/server/public/model"
+
+	"github.com/mattermost/mattermost/server/public/shared/mlog"
+	"github.com/mattermost/mattermost/server/v8/platform/shared/filestore"
+)
+
+const derivativeJPEGQuality = 90
+
+var errImageTooLarge = errors.New("image resolution too large")
+
+// imageExtensions are the extensions of the uploads derivatives are generated for.
+var imageExtensions = map[string]bool{
+	".jpg":  true,
+	".jpeg": true,
+	".png":  true,
+	".gif":  true,
+}
+
+func hasImageExtension(fileInfo *mm_model.FileInfo) bool {
+	return imageExtensions[fileInfo.Extension]
+}
+
+// generateFileDerivatives generates the thumbnail and preview of an uploaded image, stores them
+// next to the original and records them on the file info. Failures are logged and leave the file
+// without derivatives; the original is always served as a fallback.
+func (a *App) generateFileDerivatives(fileInfo *mm_model.FileInfo) {
+	if !hasImageExtension(fileInfo) {
+		return
+	}
+
+	if err := a.writeFileDerivatives(fileInfo); err != nil {
+		a.logger.Warn("Cannot generate image derivatives",
+			mlog.String("path", fileInfo.Path),
+			mlog.Err(err),
+		)
+		for _, size := range model.FileDerivativeSizes {
+			model.SetFileDerivativePath(fileInfo, size, "")
+		}
+	}
+}
+
+func (a *App) writeFileDerivatives(fileInfo *mm_model.FileInfo) error {
+	reader, err := a.filesBackend.Reader(fileInfo.Path)
+	if err != nil {
+		return err
+	}
+	defer reader.Close()
+
+	img, err := decodeImage(reader)
+	if err != nil {
+		return err
+	}
+
+	bounds := img.Bounds()
+	fileInfo.Width = bounds.Dx()
+	fileInfo.Height = bounds.Dy()
+
+	for _, size := range model.FileDerivativeSizes {
+		width, height := derivativeDimensions(bounds.Dx(), bounds.Dy(), size)
+
+		var buf bytes.Buffer
+		if err := jpeg.Encode(&buf, resizeImage(img, width, height), &jpeg.Options{Quality: derivativeJPEGQuality}); err != nil {
+			return fmt.Errorf("cannot encode %s: %w", size, err)
+		}
+
+		path := model.FileDerivativePath(fileInfo.Path, size)
+		if _, err := a.filesBackend.WriteFile(&buf, path); err != nil {
+			return fmt.Errorf("cannot store %s: %w", size, err)
+		}
+		model.SetFileDerivativePath(fileInfo, size, path)
+	}
+	return nil
+}
+
+// decodeImage decodes an image, refusing images whose resolution is over MaxImageResolution
+// before allocating them.
+func decodeImage(reader filestore.ReadCloseSeeker) (image.Image, error) {
+	config, _, err := image.DecodeConfig(reader)
+	if err != nil {
+		return nil, err
+	}
+	if config.Width*config.Height > model.MaxImageResolution {
+		return nil, fmt.Errorf("%w: %dx%d", errImageTooLarge, config.Width, config.Height)
+	}
+
+	if _, err := reader.Seek(0, io.SeekStart); err != nil {
+		return nil, err
+	}
+
+	img, _, err := image.Decode(reader)
+	return img, err
+}
+
+// derivativeDimensions returns the dimensions of a derivative of an image, preserving its aspect
+// ratio. Images are never upscaled.
+func derivativeDimensions(width, height int, size model.FileSize) (int, int) {
+	maxWidth, maxHeight := model.PreviewWidth, 0
+	if size == model.FileSizeThumb {
+		maxWidth, maxHeight = model.ThumbnailWidth, model.ThumbnailHeight
+	}
+
+	scale := 1.0
+	if width > maxWidth {
+		scale = float64(maxWidth) / float64(width)
+	}
+	if maxHeight > 0 && float64(height)*scale > float64(maxHeight) {
+		scale = float64(maxHeight) / float64(height)
+	}
+
+	return max(1, int(float64(width)*scale+0.5)), max(1, int(float64(height)*scale+0.5))
+}
+
+// resizeImage scales an image to the specified dimensions by averaging the source pixels covered
+// by each destination pixel. Transparent areas are flattened onto white since derivatives are
+// stored as JPEG.
+func resizeImage(img image.Image, width, height int) *image.RGBA {
+	bounds := img.Bounds()
+	src := image.NewRGBA(image.Rect(0, 0, bounds.Dx(), bounds.Dy()))
+	draw.Draw(src, src.Bounds(), image.NewUniform(color.White), image.Point{}, draw.Src)
+	draw.Draw(src, src.Bounds(), img, bounds.Min, draw.Over)
+
+	srcWidth, srcHeight := src.Bounds().Dx(), src.Bounds().Dy()
+	if srcWidth == width && srcHeight == height {
+		return src
+	}
+
+	dst := image.NewRGBA(image.Rect(0, 0, width, height))
+	for y := 0; y < height; y++ {
+		sy0 := y * srcHeight / height
+		sy1 := max(sy0+1, (y+1)*srcHeight/height)
+
+		for x := 0; x < width; x++ {
+			sx0 := x * srcWidth / width
+			sx1 := max(sx0+1, (x+1)*srcWidth/width)
+
+			var r, g, b, count uint64
+			for sy := sy0; sy < sy1; sy++ {
+				offset := src.PixOffset(sx0, sy)
+				for sx := sx0; sx < sx1; sx++ {
+					r += uint64(src.Pix[offset])
+					g += uint64(src.Pix[offset+1])
+					b += uint64(src.Pix[offset+2])
+					offset += 4
+					count++
+				}
+			}
+
+			offset := dst.PixOffset(x, y)
+			dst.Pix[offset] = uint8(r / count)
+			dst.Pix[offset+1] = uint8(g / count)
+			dst.Pix[offset+2] = uint8(b / count)
+			dst.Pix[offset+3] = 0xff
+		}
+	}
+	return dst
+}
+
+// GetFileDerivative returns a reader for the thumbnail or preview of a file, or nil if the file
+// has no such derivative.
+func (a *App) GetFileDerivative(ctx context.Context, teamID, boardID, fileName string, size model.FileSize) (filestore.ReadCloseSeeker, error) {
+	ctx, span := startSpan(ctx, "GetFileDerivative")
+	defer span.End()
+
+	fileInfo, filePath, err := a.GetFilePath(ctx, teamID, boardID, fileName)
+	if err != nil {
+		return nil, err
+	}
+
+	path := model.GetFileDerivativePath(fileInfo, size)
+	if path == "" || fileInfo == nil || fileInfo.Path == "" || fileInfo.Path == emptyString {
+		// files without a file info are stored at a computed path; look for derivatives next to it.
+		path = model.FileDerivativePath(filePath, size)
+	}
+
+	exists, err := a.filesBackend.FileExists(path)
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		return nil, nil
+	}
+
+	return a.filesBackend.Reader(path)
+}
+
+// copyFileDerivatives copies the derivatives recorded on a file info next to the copy of the
+// original, and records the copies on the file info.
+func (a *App) copyFileDerivatives(fileInfo *mm_model.FileInfo, destinationFilePath string) {
+	for _, size := range model.FileDerivativeSizes {
+		sourcePath := model.GetFileDerivativePath(fileInfo, size)
+		if sourcePath == "" {
+			continue
+		}
+
+		destinationPath := model.FileDerivativePath(destinationFilePath, size)
+		if err := a.filesBackend.CopyFile(sourcePath, destinationPath); err != nil {
+			a.logger.Error("Cannot copy file derivative",
+				mlog.String("sourcePath", sourcePath),
+				mlog.String("destinationPath", destinationPath),
+				mlog.Err(err),
+			)
+			destinationPath = ""
+		}
+		model.SetFileDerivativePath(fileInfo, size, destinationPath)
+	}
+}
+
+// moveFileDerivatives moves the derivatives of a file stored next to it, if any.
+func (a *App) moveFileDerivatives(oldPath, newPath string) {
+	for _, size := range model.FileDerivativeSizes {
+		oldDerivativePath := model.FileDerivativePath(oldPath, size)
+		exists, err := a.filesBackend.FileExists(oldDerivativePath)
+		if err != nil || !exists {
+			continue
+		}
+
+		newDerivativePath := model.FileDerivativePath(newPath, size)
+		if err := a.filesBackend.MoveFile(oldDerivativePath, newDerivativePath); err != nil {
+			a.logger.Error("ERROR moving file derivative",
+				mlog.String("old", oldDerivativePath),
+				mlog.String("new", newDerivativePath),
+				mlog.Err(err),
+			)
+		}
+	}
+}
@@ -0,0 +1,241 @@
+// Copyright (c) 2020-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package app
+
+import (
+	"bytes"
+	"image"
+	"image/color"
+	"image/jpeg"
+	"image/png"
+	"io"
+	"sort"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mattermost/mattermost-plugin-boards/server/model"
+	mm_model "github.com/mattermost/mattermost/server/public/model"
+)
+
+type memoryReadCloseSeeker struct {
+	*bytes.Reader
+}
+
+func (memoryReadCloseSeeker) Close() error { return nil }
+
+// memoryFileBackend is an in-memory file backend. Files added directly to files have a zero
+// modification time.
+type memoryFileBackend struct {
+	files    map[string][]byte
+	modTimes map[string]time.Time
+}
+
+func newMemoryFileBackend() *memoryFileBackend {
+	return &memoryFileBackend{files: map[string][]byte{}, modTimes: map[string]time.Time{}}
+}
+
+func (b *memoryFileBackend) Reader(path string) (ReadCloseSeeker, error) {
+	data, ok := b.files[path]
+	if !ok {
+		return nil, ErrFileNotFound
+	}
+	return memoryReadCloseSeeker{bytes.NewReader(data)}, nil
+}
+
+func (b *memoryFileBackend) FileExists(path string) (bool, error) {
+	_, ok := b.files[path]
+	return ok, nil
+}
+
+func (b *memoryFileBackend) CopyFile(oldPath, newPath string) error {
+	data, ok := b.files[oldPath]
+	if !ok {
+		return ErrFileNotFound
+	}
+	b.files[newPath] = data
+	return nil
+}
+
+func (b *memoryFileBackend) MoveFile(oldPath, newPath string) error {
+	if err := b.CopyFile(oldPath, newPath); err != nil {
+		return err
+	}
+	delete(b.files, oldPath)
+	return nil
+}
+
+func (b *memoryFileBackend) WriteFile(fr io.Reader, path string) (int64, error) {
+	data, err := io.ReadAll(fr)
+	if err != nil {
+		return 0, err
+	}
+	b.files[path] = data
+	b.modTimes[path] = time.Now()
+	return int64(len(data)), nil
+}
+
+func (b *memoryFileBackend) RemoveFile(path string) error {
+	delete(b.files, path)
+	delete(b.modTimes, path)
+	return nil
+}
+
+func (b *memoryFileBackend) FileSize(path string) (int64, error) {
+	data, ok := b.files[path]
+	if !ok {
+		return 0, ErrFileNotFound
+	}
+	return int64(len(data)), nil
+}
+
+func (b *memoryFileBackend) FileModTime(path string) (time.Time, error) {
+	if _, ok := b.files[path]; !ok {
+		return time.Time{}, ErrFileNotFound
+	}
+	return b.modTimes[path], nil
+}
+
+// ListDirectory returns the paths of the files and directories directly under path.
+func (b *memoryFileBackend) ListDirectory(path string) ([]string, error) {
+	prefix := ""
+	if path != "" {
+		prefix = path + "/"
+	}
+
+	entries := map[string]bool{}
+	for filePath := range b.files {
+		if !strings.HasPrefix(filePath, prefix) {
+			continue
+		}
+		entries[prefix+strings.SplitN(strings.TrimPrefix(filePath, prefix), "/", 2)[0]] = true
+	}
+
+	results := make([]string, 0, len(entries))
+	for entry := range entries {
+		results = append(results, entry)
+	}
+	sort.Strings(results)
+	return results, nil
+}
+
+func encodeTestPNG(t *testing.T, width, height int) []byte {
+	img := image.NewNRGBA(image.Rect(0, 0, width, height))
+	for y := 0; y < height; y++ {
+		for x := 0; x < width; x++ {
+			img.Set(x, y, color.NRGBA{R: 200, G: 10, B: 10, A: 255})
+		}
+	}
+	var buf bytes.Buffer
+	require.NoError(t, png.Encode(&buf, img))
+	return buf.Bytes()
+}
+
+func decodeTestJPEG(t *testing.T, data []byte) image.Image {
+	img, err := jpeg.Decode(bytes.NewReader(data))
+	require.NoError(t, err)
+	return img
+}
+
+func TestGenerateFileDerivatives(t *testing.T) {
+	th, tearDown := SetupTestHelper(t)
+	defer tearDown()
+
+	t.Run("image upload", func(t *testing.T) {
+		backend := newMemoryFileBackend()
+		th.App.filesBackend = backend
+		backend.files["boards/20240101/7abc.png"] = encodeTestPNG(t, 2400, 1200)
+
+		fileInfo := model.NewFileInfo("picture.png")
+		fileInfo.Path = "boards/20240101/7abc.png"
+		th.App.generateFileDerivatives(fileInfo)
+
+		assert.Equal(t, 2400, fileInfo.Width)
+		assert.Equal(t, 1200, fileInfo.Height)
+		assert.Equal(t, "boards/20240101/7abc_thumb.jpg", fileInfo.ThumbnailPath)
+		assert.Equal(t, "boards/20240101/7abc_preview.jpg", fileInfo.PreviewPath)
+		assert.True(t, fileInfo.HasPreviewImage)
+
+		thumb := decodeTestJPEG(t, backend.files[fileInfo.ThumbnailPath])
+		assert.Equal(t, image.Rect(0, 0, 120, 60), thumb.Bounds())
+		r, g, b, _ := thumb.At(10, 10).RGBA()
+		assert.InDelta(t, 200, r>>8, 8)
+		assert.InDelta(t, 10, g>>8, 8)
+		assert.InDelta(t, 10, b>>8, 8)
+
+		preview := decodeTestJPEG(t, backend.files[fileInfo.PreviewPath])
+		assert.Equal(t, image.Rect(0, 0, 1920, 960), preview.Bounds())
+	})
+
+	t.Run("not an image", func(t *testing.T) {
+		backend := newMemoryFileBackend()
+		th.App.filesBackend = backend
+		backend.files["boards/20240101/7abc.txt"] = []byte("hello")
+
+		fileInfo := model.NewFileInfo("notes.txt")
+		fileInfo.Path = "boards/20240101/7abc.txt"
+		th.App.generateFileDerivatives(fileInfo)
+
+		assert.Empty(t, fileInfo.ThumbnailPath)
+		assert.Len(t, backend.files, 1)
+	})
+
+	t.Run("corrupt image", func(t *testing.T) {
+		backend := newMemoryFileBackend()
+		th.App.filesBackend = backend
+		backend.files["boards/20240101/7abc.jpg"] = []byte("not a jpeg")
+
+		fileInfo := model.NewFileInfo("photo.jpg")
+		fileInfo.Path = "boards/20240101/7abc.jpg"
+		th.App.generateFileDerivatives(fileInfo)
+
+		assert.Empty(t, fileInfo.ThumbnailPath)
+		assert.Empty(t, fileInfo.PreviewPath)
+		assert.False(t, fileInfo.HasPreviewImage)
+	})
+}
+
+func TestCopyAndMoveFileDerivatives(t *testing.T) {
+	th, tearDown := SetupTestHelper(t)
+	defer tearDown()
+
+	backend := newMemoryFileBackend()
+	th.App.filesBackend = backend
+	backend.files["a/7abc_thumb.jpg"] = []byte("thumb")
+	backend.files["a/7abc_preview.jpg"] = []byte("preview")
+
+	fileInfo := &mm_model.FileInfo{Path: "a/7abc.png", ThumbnailPath: "a/7abc_thumb.jpg", PreviewPath: "a/7abc_preview.jpg"}
+	th.App.copyFileDerivatives(fileInfo, "b/7def.png")
+	assert.Equal(t, "b/7def_thumb.jpg", fileInfo.ThumbnailPath)
+	assert.Equal(t, "b/7def_preview.jpg", fileInfo.PreviewPath)
+	assert.Equal(t, []byte("thumb"), backend.files["b/7def_thumb.jpg"])
+
+	th.App.moveFileDerivatives("b/7def.png", "c/7def.png")
+	assert.Equal(t, []byte("preview"), backend.files["c/7def_preview.jpg"])
+	assert.NotContains(t, backend.files, "b/7def_preview.jpg")
+}
+
+func TestDerivativeDimensions(t *testing.T) {
+	testCases := []struct {
+		width, height int
+		size          model.FileSize
+		expectedW     int
+		expectedH     int
+	}{
+		{2400, 1200, model.FileSizeThumb, 120, 60},
+		{1200, 2400, model.FileSizeThumb, 50, 100},
+		{60, 40, model.FileSizeThumb, 60, 40},
+		{3840, 2160, model.FileSizePreview, 1920, 1080},
+		{800, 600, model.FileSizePreview, 800, 600},
+		{10000, 1, model.FileSizeThumb, 120, 1},
+	}
+	for _, tc := range testCases {
+		w, h := derivativeDimensions(tc.width, tc.height, tc.size)
+		assert.Equal(t, tc.expectedW, w, "%dx%d %s", tc.width, tc.height, tc.size)
+		assert.Equal(t, tc.expectedH, h, "%dx%d %s", tc.width, tc.height, tc.size)
+	}
+}
@@ -0,0 +1,194 @@
+// Copyright (c) 2020-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package app
+
+import (
+	"context"
+	"fmt"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/mattermost/mattermost-plugin-boards/server/model"
+	"github.com/mattermost/mattermost-plugin-boards/server/utils"
+
+	"github.com/mattermost/mattermost/server/public/shared/mlog"
+)
+
+// fileGCMutexName is the cluster mutex held while collecting orphaned files, so that a single
+// node collects them at a time.
+const fileGCMutexName = "Boards_fileGCMutex"
+
+// storedBoardFile is a file stored under the directory of a board.
+type storedBoardFile struct {
+	path    string
+	teamID  string
+	boardID string
+	fileID  string
+}
+
+// CollectOrphanedFiles finds the files stored under teamID/boardID/ that no block of any board
+// references, live or in history, and removes them unless dryRun is set. Files modified within
+// the grace period are kept, since uploads are stored before the blocks referencing them are
+// created. Content addressed files are removed with the last file info referencing them.
+func (a *App) CollectOrphanedFiles(ctx context.Context, gracePeriod time.Duration, dryRun bool) (*model.FileGCReport, error) {
+	ctx, span := startSpan(ctx, "CollectOrphanedFiles")
+	defer span.End()
+
+	if !dryRun {
+		unlock, err := a.lockCluster(ctx, fileGCMutexName)
+		if err != nil {
+			return nil, err
+		}
+		defer unlock()
+	}
+
+	report := &model.FileGCReport{
+		DryRun:        dryRun,
+		OrphanedFiles: []model.OrphanedFile{},
+	}
+	cutoff := time.Now().Add(-gracePeriod)
+
+	files, err := a.listStoredBoardFiles()
+	if err != nil {
+		return nil, err
+	}
+
+	fileInfos, err := a.store.GetFileInfosWithPathPrefix(ctx, contentAddressedFilesDir+"/", cutoff.UnixMilli())
+	if err != nil {
+		return nil, err
+	}
+
+	// the references of all the files are looked up at once, as this reads the blocks of every
+	// board and their history.
+	fileIDs := make([]string, 0, len(files)+len(fileInfos))
+	for _, file := range files {
+		fileIDs = append(fileIDs, file.fileID)
+	}
+	for _, fileInfo := range fileInfos {
+		fileIDs = append(fileIDs, fileInfo.Id)
+	}
+	referenced, err := a.store.GetFileIDsReferencedByBlocks(ctx, fileIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	a.collectOrphanedBoardFiles(ctx, files, referenced, cutoff, report)
+
+	if err := a.collectOrphanedContentAddressedFiles(ctx, fileInfos, referenced, report); err != nil {
+		return nil, err
+	}
+
+	a.metrics.IncrementOrphanedFilesRemoved(report.RemovedFiles, report.ReclaimedBytes)
+
+	a.logger.Info("Collected orphaned files",
+		mlog.Bool("dry_run", dryRun),
+		mlog.Int("scanned_files", report.ScannedFiles),
+		mlog.Int("orphaned_files", len(report.OrphanedFiles)),
+		mlog.Int("removed_files", report.RemovedFiles),
+		mlog.Int("reclaimed_bytes", report.ReclaimedBytes),
+	)
+	return report, nil
+}
+
+// listStoredBoardFiles returns the files stored under the directories of the boards.
+func (a *App) listStoredBoardFiles() ([]storedBoardFile, error) {
+	teamDirs, err := a.filesBackend.ListDirectory("")
+	if err != nil {
+		return nil, fmt.Errorf("cannot list files storage: %w", err)
+	}
+
+	var files []storedBoardFile
+	for _, teamDir := range teamDirs {
+		// other directories of the files storage belong to the server.
+		if model.ValidateTeamID(filepath.Base(teamDir), true) != nil {
+			continue
+		}
+
+		boardDirs, err := a.filesBackend.ListDirectory(teamDir)
+		if err != nil {
+			return nil, fmt.Errorf("cannot list directory %s: %w", teamDir, err)
+		}
+
+		for _, boardDir := range boardDirs {
+			boardID := filepath.Base(boardDir)
+			if model.IsValidId(boardID) != nil {
+				continue
+			}
+
+			paths, err := a.filesBackend.ListDirectory(boardDir)
+			if err != nil {
+				return nil, fmt.Errorf("cannot list directory %s: %w", boardDir, err)
+			}
+			for _, path := range paths {
+				files = append(files, storedBoardFile{
+					path:    path,
+					teamID:  filepath.Base(teamDir),
+					boardID: boardID,
+					fileID:  storedFileID(filepath.Base(path)),
+				})
+			}
+		}
+	}
+	return files, nil
+}
+
+func (a *App) collectOrphanedBoardFiles(ctx context.Context, files []storedBoardFile, referenced map[string]bool, cutoff time.Time, report *model.FileGCReport) {
+	for _, file := range files {
+		report.ScannedFiles++
+
+		if file.fileID == "" || referenced[file.fileID] {
+			continue
+		}
+
+		modTime, err := a.filesBackend.FileModTime(file.path)
+		if err != nil {
+			a.logger.Warn("Cannot get modification time of file", mlog.String("path", file.path), mlog.Err(err))
+			continue
+		}
+		if modTime.After(cutoff) {
+			continue
+		}
+
+		size, err := a.filesBackend.FileSize(file.path)
+		if err != nil {
+			a.logger.Warn("Cannot get size of file", mlog.String("path", file.path), mlog.Err(err))
+			continue
+		}
+
+		report.OrphanedFiles = append(report.OrphanedFiles, model.OrphanedFile{
+			Path:       file.path,
+			Size:       size,
+			ModifiedAt: modTime.UnixMilli(),
+		})
+		if report.DryRun {
+			continue
+		}
+
+		if err := a.filesBackend.RemoveFile(file.path); err != nil {
+			a.logger.Error("Cannot remove orphaned file", mlog.String("path", file.path), mlog.Err(err))
+			continue
+		}
+		report.RemovedFiles++
+		report.ReclaimedBytes += size
+
+		// only the originals have a file info, whose storage usage is released unless its
+		// blocks were deleted already.
+		if !model.IsFileDerivativeName(filepath.Base(file.path)) {
+			if err := a.store.DeleteFiles(ctx, file.boardID, []string{file.fileID}); err != nil {
+				a.logger.Error("Cannot delete file info of orphaned file", mlog.String("path", file.path), mlog.Err(err))
+			}
+		}
+	}
+}
+
+// storedFileID returns the ID of a stored file as blocks reference it. Derivatives have the ID of
+// their original.
+func storedFileID(name string) string {
+	base := strings.TrimSuffix(name, filepath.Ext(name))
+	for _, size := range model.FileDerivativeSizes {
+		base = strings.TrimSuffix(base, "_"+string(size))
+	}
+	return utils.RetrieveFileIDFromBlockFieldStorage(base)
+}
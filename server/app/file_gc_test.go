@@ -0,0 +1,113 @@
+// Copyright (c) 2020-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package app
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mattermost/mattermost-plugin-boards/server/model"
+	"github.com/mattermost/mattermost-plugin-boards/server/utils"
+)
+
+func TestCollectOrphanedFiles(t *testing.T) {
+	th, tearDown := SetupTestHelper(t)
+	defer tearDown()
+
+	teamID := "abcdefghijklmnopqrstuvwxyz"
+	boardID := utils.NewID(utils.IDTypeBoard)
+	dir := teamID + "/" + boardID + "/"
+
+	setup := func() *memoryFileBackend {
+		backend := newMemoryFileBackend()
+		backend.files[dir+"7live.png"] = []byte("live")
+		backend.files[dir+"7live_thumb.jpg"] = []byte("thumb")
+		backend.files[dir+"7deleted.pdf"] = []byte("in history")
+		backend.files[dir+"7attachment.txt"] = []byte("attachment")
+		backend.files[dir+"7moved.png"] = []byte("moved")
+		backend.files[dir+"7orphan.png"] = []byte("orphan")
+		backend.files[dir+"7orphan_preview.jpg"] = []byte("orphan preview")
+		backend.files[dir+"7recent.png"] = []byte("recent")
+		backend.modTimes[dir+"7recent.png"] = time.Now()
+		backend.files["boards/20240101/7other.png"] = []byte("not a board directory")
+		th.App.filesBackend = backend
+
+		th.Store.EXPECT().GetFileIDsReferencedByBlocks(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, fileIDs []string) (map[string]bool, error) {
+			assert.ElementsMatch(t, []string{"live", "live", "deleted", "attachment", "moved", "orphan", "orphan", "recent"}, fileIDs)
+			return map[string]bool{"live": true, "deleted": true, "attachment": true, "moved": true}, nil
+		})
+		th.Store.EXPECT().GetFileInfosWithPathPrefix(gomock.Any(), contentAddressedFilesDir+"/", gomock.Any()).Return(nil, nil)
+		th.Store.EXPECT().GetFileBoardIDs(gomock.Any(), []string{}).Return(map[string]string{}, nil)
+		return backend
+	}
+
+	t.Run("dry run", func(t *testing.T) {
+		backend := setup()
+
+		report, err := th.App.CollectOrphanedFiles(context.Background(), time.Hour, true)
+		require.NoError(t, err)
+		assert.True(t, report.DryRun)
+		assert.Equal(t, 8, report.ScannedFiles)
+		assert.Equal(t, []model.OrphanedFile{
+			{Path: dir + "7orphan.png", Size: 6, ModifiedAt: time.Time{}.UnixMilli()},
+			{Path: dir + "7orphan_preview.jpg", Size: 14, ModifiedAt: time.Time{}.UnixMilli()},
+		}, report.OrphanedFiles)
+		assert.Zero(t, report.RemovedFiles)
+		assert.Len(t, backend.files, 9)
+	})
+
+	t.Run("collection", func(t *testing.T) {
+		backend := setup()
+		th.Store.EXPECT().DeleteFiles(gomock.Any(), boardID, []string{"orphan"}).Return(nil)
+
+		report, err := th.App.CollectOrphanedFiles(context.Background(), time.Hour, false)
+		require.NoError(t, err)
+		assert.False(t, report.DryRun)
+		assert.Equal(t, 2, report.RemovedFiles)
+		assert.Equal(t, int64(20), report.ReclaimedBytes)
+
+		assert.NotContains(t, backend.files, dir+"7orphan.png")
+		assert.NotContains(t, backend.files, dir+"7orphan_preview.jpg")
+		for _, kept := range []string{"7live.png", "7live_thumb.jpg", "7deleted.pdf", "7attachment.txt", "7moved.png", "7recent.png"} {
+			assert.Contains(t, backend.files, dir+kept)
+		}
+		assert.Contains(t, backend.files, "boards/20240101/7other.png")
+	})
+
+	t.Run("a single collection runs at a time", func(t *testing.T) {
+		unlock, err := th.App.lockCluster(context.Background(), fileGCMutexName)
+		require.NoError(t, err)
+
+		setup()
+		th.Store.EXPECT().DeleteFiles(gomock.Any(), boardID, []string{"orphan"}).Return(nil)
+
+		done := make(chan struct{})
+		go func() {
+			defer close(done)
+			_, err := th.App.CollectOrphanedFiles(context.Background(), time.Hour, false)
+			assert.NoError(t, err)
+		}()
+
+		select {
+		case <-done:
+			t.Fatal("the collection ran while another one was running")
+		case <-time.After(50 * time.Millisecond):
+		}
+
+		unlock()
+		<-done
+	})
+}
+
+func TestStoredFileID(t *testing.T) {
+	assert.Equal(t, "abc", storedFileID("7abc.png"))
+	assert.Equal(t, "abc", storedFileID("7abc_thumb.jpg"))
+	assert.Equal(t, "abc", storedFileID("7abc_preview.jpg"))
+	assert.Equal(t, "", storedFileID("7.png"))
+}
@@ -0,0 +1,91 @@
+// Copyright (c) 2020-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package app
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/mattermost/mattermost-plugin-boards/server/model"
+	"github.com/mattermost/mattermost-plugin-boards/server/services/filescanner"
+	"github.com/mattermost/mattermost-plugin-boards/server/utils"
+
+	mm_model "github.com/mattermost/mattermost/server/public/model"
+	"github.com/mattermost/mattermost/server/public/shared/mlog"
+)
+
+// scanFile scans a stored upload for malware before its name is handed out. The file is recorded
+// as pending while it is scanned, and stays pending if it cannot be scanned, so it is never served
+// unscanned. Returns an ErrFileInfected if malware was found; the file is then kept quarantined.
+// Uploads are neither scanned nor recorded when scanning is disabled.
+func (a *App) scanFile(ctx context.Context, fileInfo *mm_model.FileInfo) error {
+	if _, ok := a.fileScanner.(filescanner.Noop); ok {
+		return nil
+	}
+
+	scan := &model.FileScan{
+		FileID:    fileInfo.Id,
+		Status:    model.FileScanPending,
+		Scanner:   a.fileScanner.Name(),
+		ScannedAt: utils.GetMillis(),
+	}
+	if err := a.store.UpsertFileScan(ctx, scan); err != nil {
+		return err
+	}
+
+	reader, err := a.filesBackend.Reader(fileInfo.Path)
+	if err != nil {
+		return err
+	}
+	defer reader.Close()
+
+	result, err := a.fileScanner.Scan(reader)
+	if err != nil {
+		a.logger.Error("Cannot scan uploaded file",
+			mlog.String("file_id", fileInfo.Id),
+			mlog.String("scanner", scan.Scanner),
+			mlog.Err(err),
+		)
+		return fmt.Errorf("cannot scan file: %w", err)
+	}
+
+	scan.Status = model.FileScanClean
+	if result.Infected {
+		scan.Status = model.FileScanInfected
+		scan.Signature = result.Signature
+	}
+	scan.ScannedAt = utils.GetMillis()
+	if err := a.store.UpsertFileScan(ctx, scan); err != nil {
+		return err
+	}
+
+	if result.Infected {
+		a.logger.Warn("Malware found in uploaded file",
+			mlog.String("file_id", fileInfo.Id),
+			mlog.String("path", fileInfo.Path),
+			mlog.String("signature", result.Signature),
+		)
+		return model.NewErrFileInfected(result.Signature)
+	}
+	return nil
+}
+
+// GetFileScan returns the malware scan result of a file, or nil if the file was not scanned. The
+// scan is looked up from the file name alone, since quarantined files have no file info.
+func (a *App) GetFileScan(ctx context.Context, fileName string) (*model.FileScan, error) {
+	ctx, span := startSpan(ctx, "GetFileScan")
+	defer span.End()
+
+	name := strings.Split(fileName, ".")[0]
+	if len(name) <= 1 {
+		return nil, nil
+	}
+
+	scan, err := a.store.GetFileScan(ctx, getFileInfoID(name))
+	if model.IsErrNotFound(err) {
+		return nil, nil
+	}
+	return scan, err
+}
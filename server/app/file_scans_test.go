@@ -0,0 +1,162 @@
+// Copyright (c) 2020-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package app
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mattermost/mattermost-plugin-boards/server/model"
+	"github.com/mattermost/mattermost-plugin-boards/server/services/filescanner"
+)
+
+// testScanner reports files containing "EICAR" as infected.
+type testScanner struct {
+	err error
+}
+
+func (s testScanner) Name() string { return "test" }
+
+func (s testScanner) Scan(reader io.Reader) (*filescanner.Result, error) {
+	if s.err != nil {
+		return nil, s.err
+	}
+	data, err := io.ReadAll(reader)
+	if err != nil {
+		return nil, err
+	}
+	if bytes.Contains(data, []byte("EICAR")) {
+		return &filescanner.Result{Infected: true, Signature: "Eicar-Test-Signature"}, nil
+	}
+	return &filescanner.Result{}, nil
+}
+
+func TestSaveFileScan(t *testing.T) {
+	th, tearDown := SetupTestHelper(t)
+	defer tearDown()
+
+	teamID := "abcdefghijklmnopqrstuvwxyz"
+	boardID := testBoardID
+
+	expectUnlimitedStorage(th)
+
+	var scans []model.FileScan
+	recordScans := func(_ context.Context, scan *model.FileScan) error {
+		scans = append(scans, *scan)
+		return nil
+	}
+
+	t.Run("clean file", func(t *testing.T) {
+		scans = nil
+		th.App.filesBackend = newMemoryFileBackend()
+		th.App.fileScanner = testScanner{}
+		th.Store.EXPECT().UpsertFileScan(gomock.Any(), gomock.Any()).DoAndReturn(recordScans).Times(2)
+		th.Store.EXPECT().SaveFileInfo(gomock.Any(), gomock.Any()).Return(nil)
+
+		fileName, err := th.App.SaveFile(context.Background(), bytes.NewReader([]byte("hello")), teamID, boardID, "hello.txt", false)
+		require.NoError(t, err)
+		assert.NotEmpty(t, fileName)
+
+		require.Len(t, scans, 2)
+		assert.Equal(t, model.FileScanPending, scans[0].Status)
+		assert.Equal(t, model.FileScanClean, scans[1].Status)
+		assert.Equal(t, "test", scans[1].Scanner)
+		assert.Equal(t, getFileInfoID(fileName[:len(fileName)-len(".txt")]), scans[1].FileID)
+	})
+
+	t.Run("infected file is quarantined", func(t *testing.T) {
+		scans = nil
+		th.App.filesBackend = newMemoryFileBackend()
+		th.App.fileScanner = testScanner{}
+		th.Store.EXPECT().UpsertFileScan(gomock.Any(), gomock.Any()).DoAndReturn(recordScans).Times(2)
+
+		fileName, err := th.App.SaveFile(context.Background(), bytes.NewReader([]byte("X5O EICAR")), teamID, boardID, "virus.txt", false)
+		var infected *model.ErrFileInfected
+		require.ErrorAs(t, err, &infected)
+		assert.Equal(t, "Eicar-Test-Signature", infected.Signature)
+		assert.True(t, model.IsErrBadRequest(err))
+		assert.Empty(t, fileName)
+
+		require.Len(t, scans, 2)
+		assert.Equal(t, model.FileScanInfected, scans[1].Status)
+		assert.Equal(t, "Eicar-Test-Signature", scans[1].Signature)
+		assert.True(t, scans[1].IsQuarantined())
+	})
+
+	t.Run("file stays pending when it cannot be scanned", func(t *testing.T) {
+		scans = nil
+		th.App.filesBackend = newMemoryFileBackend()
+		th.App.fileScanner = testScanner{err: errors.New("clamd unavailable")}
+		th.Store.EXPECT().UpsertFileScan(gomock.Any(), gomock.Any()).DoAndReturn(recordScans)
+
+		_, err := th.App.SaveFile(context.Background(), bytes.NewReader([]byte("hello")), teamID, boardID, "hello.txt", false)
+		require.Error(t, err)
+
+		require.Len(t, scans, 1)
+		assert.Equal(t, model.FileScanPending, scans[0].Status)
+	})
+
+	t.Run("content of a rejected upload is removed unless shared", func(t *testing.T) {
+		th.App.config.FileDeduplication = true
+		defer func() { th.App.config.FileDeduplication = false }()
+
+		backend := newMemoryFileBackend()
+		th.App.filesBackend = backend
+		th.App.fileScanner = testScanner{}
+		objectPath := testContentPath("X5O EICAR", ".txt")
+		th.Store.EXPECT().UpsertFileScan(gomock.Any(), gomock.Any()).Return(nil).Times(4)
+		th.Store.EXPECT().CountFileInfosWithPath(gomock.Any(), objectPath).Return(int64(0), nil)
+
+		_, err := th.App.SaveFile(context.Background(), bytes.NewReader([]byte("X5O EICAR")), teamID, boardID, "virus.txt", false)
+		var infected *model.ErrFileInfected
+		require.ErrorAs(t, err, &infected)
+		assert.Empty(t, backend.files)
+
+		backend.files[objectPath] = []byte("X5O EICAR")
+		th.Store.EXPECT().CountFileInfosWithPath(gomock.Any(), objectPath).Return(int64(1), nil)
+
+		_, err = th.App.SaveFile(context.Background(), bytes.NewReader([]byte("X5O EICAR")), teamID, boardID, "virus.txt", false)
+		require.ErrorAs(t, err, &infected)
+		assert.Contains(t, backend.files, objectPath)
+	})
+
+	t.Run("files are not scanned when scanning is disabled", func(t *testing.T) {
+		th.App.filesBackend = newMemoryFileBackend()
+		th.App.fileScanner = filescanner.Noop{}
+		th.Store.EXPECT().SaveFileInfo(gomock.Any(), gomock.Any()).Return(nil)
+
+		_, err := th.App.SaveFile(context.Background(), bytes.NewReader([]byte("X5O EICAR")), teamID, boardID, "virus.txt", false)
+		require.NoError(t, err)
+	})
+}
+
+func TestGetFileScan(t *testing.T) {
+	th, tearDown := SetupTestHelper(t)
+	defer tearDown()
+
+	t.Run("scanned file", func(t *testing.T) {
+		scan := &model.FileScan{FileID: "xhwgf5r15fr3dryfozf1dmy41r", Status: model.FileScanInfected}
+		th.Store.EXPECT().GetFileScan(gomock.Any(), "xhwgf5r15fr3dryfozf1dmy41r").Return(scan, nil)
+
+		got, err := th.App.GetFileScan(context.Background(), "7xhwgf5r15fr3dryfozf1dmy41r.png")
+		require.NoError(t, err)
+		assert.Equal(t, scan, got)
+	})
+
+	t.Run("file uploaded while scanning was disabled", func(t *testing.T) {
+		th.Store.EXPECT().GetFileScan(gomock.Any(), "xhwgf5r15fr3dryfozf1dmy41r").Return(nil, model.NewErrNotFound("scan"))
+
+		got, err := th.App.GetFileScan(context.Background(), "7xhwgf5r15fr3dryfozf1dmy41r.png")
+		require.NoError(t, err)
+		assert.Nil(t, got)
+		assert.False(t, got.IsQuarantined())
+	})
+}
@@ -0,0 +1,123 @@
+// Copyright (c) 2020-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package app
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/mattermost/mattermost-plugin-boards/server/model"
+	"github.com/mattermost/mattermost-plugin-boards/server/utils"
+)
+
+const (
+	fileURLSigningKeySetting = "FileURLSigningKey"
+	fileURLSigningKeySize    = 32
+)
+
+// SignFileURL mints a URL granting read access to a single file of a board until it expires. The
+// signature does not cover the size parameter, so the same URL serves the derivatives of images.
+func (a *App) SignFileURL(ctx context.Context, teamID, boardID, fileName string, expiresIn time.Duration) (*model.SignedFileURL, error) {
+	ctx, span := startSpan(ctx, "SignFileURL")
+	defer span.End()
+
+	if expiresIn <= 0 {
+		expiresIn = model.SignedFileURLDefaultExpiry
+	}
+	if expiresIn > model.SignedFileURLMaxExpiry {
+		return nil, model.NewErrBadRequest(fmt.Sprintf("signed file URLs cannot be valid for more than %s", model.SignedFileURLMaxExpiry))
+	}
+
+	if err := a.ValidateFileOwnership(ctx, teamID, boardID, fileName); err != nil {
+		return nil, err
+	}
+
+	key, err := a.getFileURLSigningKey(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	expiresAt := utils.GetMillis() + expiresIn.Milliseconds()
+	query := url.Values{}
+	query.Set(model.SignedFileURLExpiresParam, strconv.FormatInt(expiresAt, 10))
+	query.Set(model.SignedFileURLSignatureParam, fileURLSignature(key, teamID, boardID, fileName, expiresAt))
+
+	return &model.SignedFileURL{
+		URL: fmt.Sprintf("%s/api/v2/files/teams/%s/%s/%s?%s", a.config.ServerRoot,
+			url.PathEscape(teamID), url.PathEscape(boardID), url.PathEscape(fileName), query.Encode()),
+		ExpiresAt: expiresAt,
+	}, nil
+}
+
+// IsValidFileSignature returns true if the signature was minted by SignFileURL for the file and
+// has not expired.
+func (a *App) IsValidFileSignature(ctx context.Context, teamID, boardID, fileName string, expiresAt int64, signature string) bool {
+	ctx, span := startSpan(ctx, "IsValidFileSignature")
+	defer span.End()
+
+	if signature == "" || expiresAt < utils.GetMillis() {
+		return false
+	}
+
+	key, err := a.getFileURLSigningKey(ctx)
+	if err != nil {
+		return false
+	}
+
+	expected := fileURLSignature(key, teamID, boardID, fileName, expiresAt)
+	return hmac.Equal([]byte(expected), []byte(signature))
+}
+
+func fileURLSignature(key []byte, teamID, boardID, fileName string, expiresAt int64) string {
+	mac := hmac.New(sha256.New, key)
+	// the components are separated by a character none of them can contain.
+	fmt.Fprintf(mac, "%s/%s/%s/%d", teamID, boardID, fileName, expiresAt)
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+// getFileURLSigningKey returns the key signing file URLs, generating it on first use. The key is
+// stored as a system setting so that URLs minted by one node are accepted by the others.
+func (a *App) getFileURLSigningKey(ctx context.Context) ([]byte, error) {
+	a.fileURLKeyMux.Lock()
+	defer a.fileURLKeyMux.Unlock()
+
+	if a.fileURLKey != nil {
+		return a.fileURLKey, nil
+	}
+
+	value, err := a.store.GetSystemSetting(ctx, fileURLSigningKeySetting)
+	if err != nil {
+		return nil, err
+	}
+
+	if value == "" {
+		key := make([]byte, fileURLSigningKeySize)
+		if _, err = rand.Read(key); err != nil {
+			return nil, fmt.Errorf("cannot generate file URL signing key: %w", err)
+		}
+		if err = a.store.SetSystemSettingIfAbsent(ctx, fileURLSigningKeySetting, hex.EncodeToString(key)); err != nil {
+			return nil, err
+		}
+
+		// another node may have stored its own key first, in which case it is kept; use it.
+		if value, err = a.store.GetSystemSetting(ctx, fileURLSigningKeySetting); err != nil {
+			return nil, err
+		}
+	}
+
+	key, err := hex.DecodeString(value)
+	if err != nil {
+		return nil, fmt.Errorf("invalid file URL signing key: %w", err)
+	}
+
+	a.fileURLKey = key
+	return key, nil
+}
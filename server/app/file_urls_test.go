@@ -0,0 +1,108 @@
+// Copyright (c) 2020-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package app
+
+import (
+	"context"
+	"encoding/hex"
+	"net/url"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mattermost/mattermost-plugin-boards/server/model"
+	"github.com/mattermost/mattermost-plugin-boards/server/utils"
+
+	mm_model "github.com/mattermost/mattermost/server/public/model"
+)
+
+func TestSignFileURL(t *testing.T) {
+	th, tearDown := SetupTestHelper(t)
+	defer tearDown()
+
+	teamID := "team-id"
+	boardID := utils.NewID(utils.IDTypeBoard)
+	fileName := "7xhwgf5r15fr3dryfozf1dmy41r.png"
+
+	th.Store.EXPECT().GetFileInfo(gomock.Any(), "xhwgf5r15fr3dryfozf1dmy41r").Return(&mm_model.FileInfo{
+		Path: filepath.Join(teamID, boardID, fileName),
+	}, nil).AnyTimes()
+
+	var storedKey string
+	th.Store.EXPECT().GetSystemSetting(gomock.Any(), fileURLSigningKeySetting).DoAndReturn(func(context.Context, string) (string, error) {
+		return storedKey, nil
+	}).Times(2)
+	th.Store.EXPECT().SetSystemSettingIfAbsent(gomock.Any(), fileURLSigningKeySetting, gomock.Any()).DoAndReturn(func(_ context.Context, _, value string) error {
+		storedKey = value
+		return nil
+	})
+
+	signedURL, err := th.App.SignFileURL(context.Background(), teamID, boardID, fileName, time.Hour)
+	require.NoError(t, err)
+	assert.NotEmpty(t, storedKey)
+	assert.InDelta(t, utils.GetMillis()+time.Hour.Milliseconds(), signedURL.ExpiresAt, float64(time.Minute.Milliseconds()))
+
+	parsed, err := url.Parse(signedURL.URL)
+	require.NoError(t, err)
+	assert.Equal(t, "/api/v2/files/teams/"+teamID+"/"+boardID+"/"+fileName, parsed.Path)
+	expiresAt, err := strconv.ParseInt(parsed.Query().Get(model.SignedFileURLExpiresParam), 10, 64)
+	require.NoError(t, err)
+	assert.Equal(t, signedURL.ExpiresAt, expiresAt)
+	signature := parsed.Query().Get(model.SignedFileURLSignatureParam)
+
+	t.Run("valid signature", func(t *testing.T) {
+		assert.True(t, th.App.IsValidFileSignature(context.Background(), teamID, boardID, fileName, expiresAt, signature))
+	})
+
+	t.Run("signature is scoped to the file", func(t *testing.T) {
+		assert.False(t, th.App.IsValidFileSignature(context.Background(), teamID, boardID, "7other.png", expiresAt, signature))
+		assert.False(t, th.App.IsValidFileSignature(context.Background(), teamID, utils.NewID(utils.IDTypeBoard), fileName, expiresAt, signature))
+		assert.False(t, th.App.IsValidFileSignature(context.Background(), "other-team", boardID, fileName, expiresAt, signature))
+	})
+
+	t.Run("tampered expiry", func(t *testing.T) {
+		assert.False(t, th.App.IsValidFileSignature(context.Background(), teamID, boardID, fileName, expiresAt+1, signature))
+		assert.False(t, th.App.IsValidFileSignature(context.Background(), teamID, boardID, fileName, expiresAt, ""))
+	})
+
+	t.Run("expired signature", func(t *testing.T) {
+		expired := utils.GetMillis() - 1
+		key, err := th.App.getFileURLSigningKey(context.Background())
+		require.NoError(t, err)
+		assert.False(t, th.App.IsValidFileSignature(context.Background(), teamID, boardID, fileName, expired,
+			fileURLSignature(key, teamID, boardID, fileName, expired)))
+	})
+
+	t.Run("default and maximum expiry", func(t *testing.T) {
+		signedURL, err := th.App.SignFileURL(context.Background(), teamID, boardID, fileName, 0)
+		require.NoError(t, err)
+		assert.InDelta(t, utils.GetMillis()+model.SignedFileURLDefaultExpiry.Milliseconds(), signedURL.ExpiresAt, float64(time.Minute.Milliseconds()))
+
+		_, err = th.App.SignFileURL(context.Background(), teamID, boardID, fileName, model.SignedFileURLMaxExpiry+time.Minute)
+		require.True(t, model.IsErrBadRequest(err))
+	})
+}
+
+func TestFileURLSigningKeyStoredByAnotherNode(t *testing.T) {
+	th, tearDown := SetupTestHelper(t)
+	defer tearDown()
+
+	otherKey := strings.Repeat("ab", fileURLSigningKeySize)
+	gomock.InOrder(
+		th.Store.EXPECT().GetSystemSetting(gomock.Any(), fileURLSigningKeySetting).Return("", nil),
+		// the key of the other node is kept.
+		th.Store.EXPECT().SetSystemSettingIfAbsent(gomock.Any(), fileURLSigningKeySetting, gomock.Any()).Return(nil),
+		th.Store.EXPECT().GetSystemSetting(gomock.Any(), fileURLSigningKeySetting).Return(otherKey, nil),
+	)
+
+	key, err := th.App.getFileURLSigningKey(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, otherKey, hex.EncodeToString(key))
+}
@@ -4,8 +4,12 @@
 package app
 
 import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
 	"errors"
 	"fmt"
+	"hash"
 	"io"
 	"path/filepath"
 	"regexp"
@@ -25,7 +29,10 @@ var errEmptyFilename = errors.New("IsFileArchived: empty filename not allowed")
 var ErrFileNotFound = errors.New("file not found")
 var ErrFileNotReferencedByBoard = errors.New("file not referenced by board")
 
-func (a *App) SaveFile(reader io.Reader, teamID, boardID, filename string, asTemplate bool) (string, error) {
+func (a *App) SaveFile(ctx context.Context, reader io.Reader, teamID, boardID, filename string, asTemplate bool) (string, error) {
+	ctx, span := startSpan(ctx, "SaveFile")
+	defer span.End()
+
 	// NOTE: File extension includes the dot
 	fileExtension := strings.ToLower(filepath.Ext(filename))
 	if fileExtension == ".jpeg" {
@@ -42,25 +49,87 @@ func (a *App) SaveFile(reader io.Reader, teamID, boardID, filename string, asTem
 		return "", fmt.Errorf("invalid file path parameters: %w", pathErr)
 	}
 
+	remaining, err := a.getRemainingStorage(ctx, teamID, boardID)
+	if err != nil {
+		return "", err
+	}
+	if remaining >= 0 {
+		// read one byte more than allowed, so that the files exceeding the quota fail to be reserved.
+		reader = io.LimitReader(reader, remaining+1)
+	}
+
+	// template files keep their names, so they are not deduplicated.
+	var contentHash hash.Hash
+	if a.config.FileDeduplication && !asTemplate {
+		contentHash = sha256.New()
+		reader = io.TeeReader(reader, contentHash)
+	}
+
 	fileSize, appErr := a.filesBackend.WriteFile(reader, filePath)
 	if appErr != nil {
 		return "", fmt.Errorf("unable to store the file in the files storage: %w", appErr)
 	}
 
+	// the quotas are enforced when reserving the storage, as other files may have been stored
+	// since the remaining storage was read.
+	if err = a.reserveStorage(ctx, teamID, boardID, fileSize); err != nil {
+		if removeErr := a.filesBackend.RemoveFile(filePath); removeErr != nil {
+			a.logger.Error("Cannot remove file exceeding the storage quota", mlog.String("path", filePath), mlog.Err(removeErr))
+		}
+		return "", err
+	}
+
+	if contentHash != nil {
+		objectPath := contentAddressedPath(hex.EncodeToString(contentHash.Sum(nil)), fileExtension)
+		unlock, lockErr := a.lockCluster(ctx, contentAddressedMutexName(objectPath))
+		if lockErr != nil {
+			a.addFileUsage(ctx, teamID, boardID, -fileSize, -1)
+			return "", lockErr
+		}
+		defer unlock()
+
+		if err = a.storeContentAddressed(filePath, objectPath); err != nil {
+			a.addFileUsage(ctx, teamID, boardID, -fileSize, -1)
+			return "", err
+		}
+		filePath = objectPath
+	}
+
 	fileInfo := model.NewFileInfo(filename)
 	fileInfo.Id = getFileInfoID(createdFilename)
 	fileInfo.Path = filePath
 	fileInfo.Size = fileSize
 
-	err := a.store.SaveFileInfo(fileInfo)
+	if err := a.scanFile(ctx, fileInfo); err != nil {
+		a.addFileUsage(ctx, teamID, boardID, -fileSize, -1)
+		// no file info references the content of a rejected upload, so it would never be collected.
+		if contentHash != nil {
+			a.removeUnreferencedContentAddressed(ctx, filePath)
+		}
+		return "", err
+	}
+
+	a.generateFileDerivatives(fileInfo)
+
+	err = a.store.SaveFileInfo(ctx, fileInfo)
 	if err != nil {
+		a.addFileUsage(ctx, teamID, boardID, -fileSize, -1)
 		return "", err
 	}
 
+	if contentHash != nil {
+		if err := a.store.SaveFileBoard(ctx, fileInfo.Id, boardID); err != nil {
+			a.logger.Error("Cannot record the board of a deduplicated file", mlog.String("fileID", fileInfo.Id), mlog.Err(err))
+		}
+	}
+
 	return newFileName, nil
 }
 
-func (a *App) GetFileInfo(filename string) (*mm_model.FileInfo, error) {
+func (a *App) GetFileInfo(ctx context.Context, filename string) (*mm_model.FileInfo, error) {
+	ctx, span := startSpan(ctx, "GetFileInfo")
+	defer span.End()
+
 	if len(filename) == 0 {
 		return nil, errEmptyFilename
 	}
@@ -79,7 +148,7 @@ func (a *App) GetFileInfo(filename string) (*mm_model.FileInfo, error) {
 		return nil, model.NewErrNotFound("No file found with name" + filename)
 	}
 
-	fileInfo, err := a.store.GetFileInfo(fileInfoID)
+	fileInfo, err := a.store.GetFileInfo(ctx, fileInfoID)
 	if err != nil {
 		return nil, err
 	}
@@ -88,8 +157,11 @@ func (a *App) GetFileInfo(filename string) (*mm_model.FileInfo, error) {
 }
 
 // ValidateFileOwnership checks if a file belongs to the specified board and team.
-func (a *App) ValidateFileOwnership(teamID, boardID, filename string) error {
-	fileInfo, err := a.GetFileInfo(filename)
+func (a *App) ValidateFileOwnership(ctx context.Context, teamID, boardID, filename string) error {
+	ctx, span := startSpan(ctx, "ValidateFileOwnership")
+	defer span.End()
+
+	fileInfo, err := a.GetFileInfo(ctx, filename)
 	if err != nil {
 		return err
 	}
@@ -98,11 +170,11 @@ func (a *App) ValidateFileOwnership(teamID, boardID, filename string) error {
 		if fileInfo.Path == expectedPath {
 			return nil
 		}
-		if err := a.validateFileReferencedByBoard(boardID, filename); err != nil {
+		if err := a.validateFileReferencedByBoard(ctx, boardID, filename); err != nil {
 			return model.NewErrPermission("file does not belong to the specified board")
 		}
 	} else {
-		if err := a.validateFileReferencedByBoard(boardID, filename); err != nil {
+		if err := a.validateFileReferencedByBoard(ctx, boardID, filename); err != nil {
 			return model.NewErrPermission("file does not belong to the specified board")
 		}
 	}
@@ -112,42 +184,52 @@ func (a *App) ValidateFileOwnership(teamID, boardID, filename string) error {
 // validateFileReferencedByBoard checks if a file is referenced by blocks in the specified board.
 // Files use different storage patterns (teamID/boardID/filename for templates, boards/YYYYMMDD/filename for regular files).
 // Path mismatches don't indicate malicious files, just different storage patterns.
-func (a *App) validateFileReferencedByBoard(boardID, filename string) error {
-	imageBlocks, err := a.store.GetBlocksWithType(boardID, model.TypeImage)
+func (a *App) validateFileReferencedByBoard(ctx context.Context, boardID, filename string) error {
+	blocks, err := a.getBlocksReferencingFile(ctx, boardID, filename)
 	if err != nil {
 		return err
 	}
+	if len(blocks) == 0 {
+		return fmt.Errorf("%w: file %s is not referenced by any block in board %s", ErrFileNotReferencedByBoard, filename, boardID)
+	}
+	return nil
+}
 
-	attachmentBlocks, err := a.store.GetBlocksWithType(boardID, model.TypeAttachment)
+// getBlocksReferencingFile returns the image and attachment blocks of a board referencing a file,
+// including the earlier versions of attachments.
+func (a *App) getBlocksReferencingFile(ctx context.Context, boardID, filename string) ([]*model.Block, error) {
+	imageBlocks, err := a.store.GetBlocksWithType(ctx, boardID, model.TypeImage)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
-	// Check image blocks
-	for _, block := range imageBlocks {
-		if fileID, ok := block.Fields[model.BlockFieldFileId].(string); ok && fileID == filename {
-			return nil
-		}
-		if attachmentID, ok := block.Fields[model.BlockFieldAttachmentId].(string); ok && attachmentID == filename {
-			return nil
-		}
+	attachmentBlocks, err := a.store.GetBlocksWithType(ctx, boardID, model.TypeAttachment)
+	if err != nil {
+		return nil, err
 	}
 
-	// Check attachment blocks
-	for _, block := range attachmentBlocks {
-		if fileID, ok := block.Fields[model.BlockFieldFileId].(string); ok && fileID == filename {
-			return nil
-		}
-		if attachmentID, ok := block.Fields[model.BlockFieldAttachmentId].(string); ok && attachmentID == filename {
-			return nil
-		}
+	fileID := utils.RetrieveFileIDFromBlockFieldStorage(filename)
+	if fileID == "" {
+		return nil, nil
 	}
 
-	return fmt.Errorf("%w: file %s is not referenced by any block in board %s", ErrFileNotReferencedByBoard, filename, boardID)
+	var blocks []*model.Block
+	for _, block := range append(imageBlocks, attachmentBlocks...) {
+		for _, blockFileID := range model.GetBlockFileIDs(block) {
+			if blockFileID == fileID {
+				blocks = append(blocks, block)
+				break
+			}
+		}
+	}
+	return blocks, nil
 }
 
-func (a *App) GetFile(teamID, boardID, fileName string) (*mm_model.FileInfo, filestore.ReadCloseSeeker, error) {
-	if err := a.ValidateFileOwnership(teamID, boardID, fileName); err != nil {
+func (a *App) GetFile(ctx context.Context, teamID, boardID, fileName string) (*mm_model.FileInfo, filestore.ReadCloseSeeker, error) {
+	ctx, span := startSpan(ctx, "GetFile")
+	defer span.End()
+
+	if err := a.ValidateFileOwnership(ctx, teamID, boardID, fileName); err != nil {
 		a.logger.Error("GetFile: File ownership validation failed",
 			mlog.String("Team", teamID),
 			mlog.String("board", boardID),
@@ -156,7 +238,7 @@ func (a *App) GetFile(teamID, boardID, fileName string) (*mm_model.FileInfo, fil
 		return nil, nil, err
 	}
 
-	fileInfo, filePath, err := a.GetFilePath(teamID, boardID, fileName)
+	fileInfo, filePath, err := a.GetFilePath(ctx, teamID, boardID, fileName)
 	if err != nil {
 		a.logger.Error("GetFile: Failed to GetFilePath.", mlog.String("Team", teamID), mlog.String("board", boardID), mlog.String("filename", fileName), mlog.Err(err))
 		return nil, nil, err
@@ -181,8 +263,11 @@ func (a *App) GetFile(teamID, boardID, fileName string) (*mm_model.FileInfo, fil
 	return fileInfo, reader, nil
 }
 
-func (a *App) GetFilePath(teamID, boardID, fileName string) (*mm_model.FileInfo, string, error) {
-	fileInfo, err := a.GetFileInfo(fileName)
+func (a *App) GetFilePath(ctx context.Context, teamID, boardID, fileName string) (*mm_model.FileInfo, string, error) {
+	ctx, span := startSpan(ctx, "GetFilePath")
+	defer span.End()
+
+	fileInfo, err := a.GetFileInfo(ctx, fileName)
 	if err != nil && !model.IsErrNotFound(err) {
 		return nil, "", err
 	}
@@ -196,7 +281,7 @@ func (a *App) GetFilePath(teamID, boardID, fileName string) (*mm_model.FileInfo,
 		// For GlobalTeamID, we need to check if the board is actually a template
 		isTemplate := false
 		if teamID == model.GlobalTeamID {
-			board, err := a.GetBoard(boardID)
+			board, err := a.GetBoard(ctx, boardID)
 			if err != nil {
 				return nil, "", fmt.Errorf("GetFilePath: cannot validate board for GlobalTeamID: %w", err)
 			}
@@ -257,12 +342,15 @@ func getFileInfoID(fileName string) string {
 	return fileName[1:]
 }
 
-func (a *App) GetFileReader(teamID, boardID, filename string) (filestore.ReadCloseSeeker, error) {
+func (a *App) GetFileReader(ctx context.Context, teamID, boardID, filename string) (filestore.ReadCloseSeeker, error) {
+	ctx, span := startSpan(ctx, "GetFileReader")
+	defer span.End()
+
 	// Validate path components - be restrictive here since this can be called from API handlers
 	// For GlobalTeamID, we need to check if the board is actually a template
 	isTemplate := false
 	if teamID == model.GlobalTeamID {
-		board, err := a.GetBoard(boardID)
+		board, err := a.GetBoard(ctx, boardID)
 		if err != nil {
 			return nil, fmt.Errorf("GetFileReader: cannot validate board for GlobalTeamID: %w", err)
 		}
@@ -343,11 +431,15 @@ func (a *App) MoveFile(channelID, teamID, boardID, filename string) error {
 		)
 		return err
 	}
+	a.moveFileDerivatives(oldPath, newPath)
 	return nil
 }
 
-func (a *App) CopyAndUpdateCardFiles(boardID, userID string, blocks []*model.Block, asTemplate bool) error {
-	newFileNames, err := a.CopyCardFiles(boardID, blocks, asTemplate)
+func (a *App) CopyAndUpdateCardFiles(ctx context.Context, boardID, userID string, blocks []*model.Block, asTemplate bool) error {
+	ctx, span := startSpan(ctx, "CopyAndUpdateCardFiles")
+	defer span.End()
+
+	newFileNames, err := a.CopyCardFiles(ctx, boardID, blocks, asTemplate)
 	if err != nil {
 		a.logger.Error("Could not copy files while duplicating board", mlog.String("BoardID", boardID), mlog.Err(err))
 	}
@@ -359,11 +451,15 @@ func (a *App) CopyAndUpdateCardFiles(boardID, userID string, blocks []*model.Blo
 		if block.Type == model.TypeImage || block.Type == model.TypeAttachment {
 			if fileID, ok := block.Fields[model.BlockFieldFileId].(string); ok {
 				if err = model.ValidateFileId(fileID); err == nil {
+					updatedFields := map[string]interface{}{
+						model.BlockFieldFileId: newFileNames[fileID],
+					}
+					if versions, ok := remapAttachmentVersionFiles(block, newFileNames); ok {
+						updatedFields[model.BlockFieldVersions] = versions
+					}
 					blockIDs = append(blockIDs, block.ID)
 					blockPatches = append(blockPatches, model.BlockPatch{
-						UpdatedFields: map[string]interface{}{
-							model.BlockFieldFileId: newFileNames[fileID],
-						},
+						UpdatedFields: updatedFields,
 						DeletedFields: []string{model.BlockFieldAttachmentId},
 					})
 				} else {
@@ -395,7 +491,7 @@ func (a *App) CopyAndUpdateCardFiles(boardID, userID string, blocks []*model.Blo
 			BlockIDs:     blockIDs,
 			BlockPatches: blockPatches,
 		}
-		if err := a.store.PatchBlocks(patches, userID); err != nil {
+		if err := a.store.PatchBlocks(ctx, patches, userID); err != nil {
 			return fmt.Errorf("could not patch file IDs while duplicating board %s: %w", boardID, err)
 		}
 	}
@@ -403,7 +499,10 @@ func (a *App) CopyAndUpdateCardFiles(boardID, userID string, blocks []*model.Blo
 	return nil
 }
 
-func (a *App) CopyCardFiles(sourceBoardID string, copiedBlocks []*model.Block, asTemplate bool) (map[string]string, error) {
+func (a *App) CopyCardFiles(ctx context.Context, sourceBoardID string, copiedBlocks []*model.Block, asTemplate bool) (map[string]string, error) {
+	ctx, span := startSpan(ctx, "CopyCardFiles")
+	defer span.End()
+
 	// Images attached in cards have a path comprising the card's board ID.
 	// When we create a template from this board, we need to copy the files
 	// with the new board ID in path.
@@ -411,7 +510,7 @@ func (a *App) CopyCardFiles(sourceBoardID string, copiedBlocks []*model.Block, a
 	// template) to fail to load.
 
 	// look up ID of source sourceBoard, which may be different than the blocks.
-	sourceBoard, err := a.GetBoard(sourceBoardID)
+	sourceBoard, err := a.GetBoard(ctx, sourceBoardID)
 	if err != nil || sourceBoard == nil {
 		return nil, fmt.Errorf("cannot fetch source board %s for CopyCardFiles: %w", sourceBoardID, err)
 	}
@@ -431,63 +530,118 @@ func (a *App) CopyCardFiles(sourceBoardID string, copiedBlocks []*model.Block, a
 			}
 		}
 
-		if err = model.ValidateFileId(fileID); err != nil {
-			errMessage := fmt.Sprintf("Could not validate file ID while duplicating board with fileId: %s", fileID)
-			return nil, model.NewErrBadRequest(errMessage)
-		}
-
-		// create unique filename
-		ext := filepath.Ext(fileID)
-		fileInfoID := utils.NewID(utils.IDTypeNone)
-		destFilename := fileInfoID + ext
-
 		if destBoard == nil || block.BoardID != destBoard.ID {
 			destBoard = sourceBoard
 			if block.BoardID != destBoard.ID {
-				destBoard, err = a.GetBoard(block.BoardID)
+				destBoard, err = a.GetBoard(ctx, block.BoardID)
 				if err != nil {
 					return nil, fmt.Errorf("cannot fetch destination board %s for CopyCardFiles: %w", sourceBoardID, err)
 				}
 			}
 		}
 
-		// GetFilePath will retrieve the correct path
-		// depending on whether FileInfo table is used for the file.
-		fileInfo, sourceFilePath, err := a.GetFilePath(sourceBoard.TeamID, sourceBoard.ID, fileID)
-		if err != nil {
-			return nil, fmt.Errorf("cannot fetch destination board %s for CopyCardFiles: %w", sourceBoardID, err)
-		}
-		destinationFilePath, pathErr := getDestinationFilePath(asTemplate, destBoard.TeamID, destBoard.ID, destFilename)
-		if pathErr != nil {
-			return nil, fmt.Errorf("invalid destination file path: %w", pathErr)
+		// earlier versions of attachments are copied along with the current file.
+		fileIDs := append([]string{fileID}, model.GetAttachmentVersionFileIDs(block)...)
+		copied := make(map[string]bool, len(fileIDs))
+		for _, fileID := range fileIDs {
+			if copied[fileID] {
+				continue
+			}
+			copied[fileID] = true
+			destFilename, err := a.copyCardFile(ctx, sourceBoard, destBoard, fileID, asTemplate)
+			if err != nil {
+				return nil, err
+			}
+			newFileNames[fileID] = destFilename
 		}
+	}
 
-		if fileInfo == nil {
-			fileInfo = model.NewFileInfo(destFilename)
-		}
-		fileInfo.Id = getFileInfoID(fileInfoID)
-		fileInfo.Path = destinationFilePath
-		err = a.store.SaveFileInfo(fileInfo)
-		if err != nil {
-			return nil, fmt.Errorf("CopyCardFiles: cannot create fileinfo: %w", err)
-		}
+	return newFileNames, nil
+}
 
-		a.logger.Debug(
-			"Copying card file",
-			mlog.String("sourceFilePath", sourceFilePath),
-			mlog.String("destinationFilePath", destinationFilePath),
-		)
+// copyCardFile copies a file of a card of sourceBoard to destBoard, and returns the name of the
+// copy.
+func (a *App) copyCardFile(ctx context.Context, sourceBoard, destBoard *model.Board, fileID string, asTemplate bool) (string, error) {
+	if err := model.ValidateFileId(fileID); err != nil {
+		errMessage := fmt.Sprintf("Could not validate file ID while duplicating board with fileId: %s", fileID)
+		return "", model.NewErrBadRequest(errMessage)
+	}
+
+	// create unique filename
+	ext := filepath.Ext(fileID)
+	fileInfoID := utils.NewID(utils.IDTypeNone)
+	destFilename := fileInfoID + ext
 
-		if err := a.filesBackend.CopyFile(sourceFilePath, destinationFilePath); err != nil {
-			a.logger.Error(
-				"CopyCardFiles failed to copy file",
+	// GetFilePath will retrieve the correct path
+	// depending on whether FileInfo table is used for the file.
+	fileInfo, sourceFilePath, err := a.GetFilePath(ctx, sourceBoard.TeamID, sourceBoard.ID, fileID)
+	if err != nil {
+		return "", fmt.Errorf("cannot fetch destination board %s for CopyCardFiles: %w", sourceBoard.ID, err)
+	}
+	destinationFilePath, pathErr := getDestinationFilePath(asTemplate, destBoard.TeamID, destBoard.ID, destFilename)
+	if pathErr != nil {
+		return "", fmt.Errorf("invalid destination file path: %w", pathErr)
+	}
+
+	// with deduplication, the copy shares the stored content of the original, which is moved
+	// under the hash of its content first if needed.
+	isShared := false
+	if a.config.FileDeduplication && fileInfo != nil {
+		contentPath := sourceFilePath
+		var unlock func()
+		if isContentAddressedPath(sourceFilePath) {
+			unlock, err = a.lockCluster(ctx, contentAddressedMutexName(sourceFilePath))
+		} else {
+			contentPath, unlock, err = a.moveToContentAddressed(ctx, fileInfo, sourceFilePath, sourceBoard.ID)
+		}
+		if err != nil {
+			a.logger.Warn("CopyCardFiles cannot deduplicate file, copying it",
 				mlog.String("sourceFilePath", sourceFilePath),
-				mlog.String("destinationFilePath", destinationFilePath),
 				mlog.Err(err),
 			)
+		} else {
+			defer unlock()
+			destinationFilePath = contentPath
+			isShared = true
 		}
-		newFileNames[fileID] = destFilename
 	}
 
-	return newFileNames, nil
+	if fileInfo == nil {
+		fileInfo = model.NewFileInfo(destFilename)
+	}
+	fileInfo.Id = getFileInfoID(fileInfoID)
+	fileInfo.Path = destinationFilePath
+	if !isShared {
+		a.copyFileDerivatives(fileInfo, destinationFilePath)
+	}
+	err = a.store.SaveFileInfo(ctx, fileInfo)
+	if err != nil {
+		return "", fmt.Errorf("CopyCardFiles: cannot create fileinfo: %w", err)
+	}
+	if isShared {
+		if err := a.store.SaveFileBoard(ctx, fileInfo.Id, destBoard.ID); err != nil {
+			a.logger.Error("CopyCardFiles cannot record the board of a deduplicated file", mlog.String("fileID", fileInfo.Id), mlog.Err(err))
+		}
+	}
+
+	a.logger.Debug(
+		"Copying card file",
+		mlog.String("sourceFilePath", sourceFilePath),
+		mlog.String("destinationFilePath", destinationFilePath),
+		mlog.Bool("shared", isShared),
+	)
+
+	if isShared {
+		a.addFileUsage(ctx, destBoard.TeamID, destBoard.ID, fileInfo.Size, 1)
+	} else if err := a.filesBackend.CopyFile(sourceFilePath, destinationFilePath); err != nil {
+		a.logger.Error(
+			"CopyCardFiles failed to copy file",
+			mlog.String("sourceFilePath", sourceFilePath),
+			mlog.String("destinationFilePath", destinationFilePath),
+			mlog.Err(err),
+		)
+	} else {
+		a.addFileUsage(ctx, destBoard.TeamID, destBoard.ID, fileInfo.Size, 1)
+	}
+	return destFilename, nil
 }
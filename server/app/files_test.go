@@ -4,6 +4,7 @@
 package app
 
 import (
+	"context"
 	"errors"
 	"io"
 	"os"
@@ -63,7 +64,7 @@ func TestGetFileReader(t *testing.T) {
 
 		mockedFileBackend.On("Reader", testFilePath).Return(readerFunc, readerErrorFunc)
 		mockedFileBackend.On("FileExists", testFilePath).Return(fileExistsFunc, fileExistsErrorFunc)
-		actual, _ := th.App.GetFileReader(validTeamID, testBoardID, testFileName)
+		actual, _ := th.App.GetFileReader(context.Background(), validTeamID, testBoardID, testFileName)
 		assert.Equal(t, mockedReadCloseSeek, actual)
 	})
 
@@ -89,7 +90,7 @@ func TestGetFileReader(t *testing.T) {
 
 		mockedFileBackend.On("Reader", testFilePath).Return(readerFunc, readerErrorFunc)
 		mockedFileBackend.On("FileExists", testFilePath).Return(fileExistsFunc, fileExistsErrorFunc)
-		actual, err := th.App.GetFileReader(validTeamID, testBoardID, testFileName)
+		actual, err := th.App.GetFileReader(context.Background(), validTeamID, testBoardID, testFileName)
 		assert.Error(t, err, mockedError)
 		assert.Nil(t, actual)
 	})
@@ -116,7 +117,7 @@ func TestGetFileReader(t *testing.T) {
 
 		mockedFileBackend.On("Reader", testFilePath).Return(readerFunc, readerErrorFunc)
 		mockedFileBackend.On("FileExists", testFilePath).Return(fileExistsFunc, fileExistsErrorFunc)
-		actual, err := th.App.GetFileReader(validTeamID, testBoardID, testFileName)
+		actual, err := th.App.GetFileReader(context.Background(), validTeamID, testBoardID, testFileName)
 		assert.Error(t, err, mockedError)
 		assert.Nil(t, actual)
 	})
@@ -148,7 +149,7 @@ func TestGetFileReader(t *testing.T) {
 		}
 
 		// Add mock for GetBoard call since workspaceid is "0" (model.GlobalTeamID)
-		th.Store.EXPECT().GetBoard(testBoardID).Return(&model.Board{
+		th.Store.EXPECT().GetBoard(gomock.Any(), testBoardID).Return(&model.Board{
 			ID:         testBoardID,
 			IsTemplate: true, // Set to true since it's using GlobalTeamID
 		}, nil)
@@ -158,7 +159,7 @@ func TestGetFileReader(t *testing.T) {
 		mockedFileBackend.On("MoveFile", testFileName, filePath).Return(moveFileFunc)
 		mockedFileBackend.On("Reader", filePath).Return(readerFunc, readerErrorFunc)
 
-		actual, _ := th.App.GetFileReader(workspaceid, testBoardID, testFileName)
+		actual, _ := th.App.GetFileReader(context.Background(), workspaceid, testBoardID, testFileName)
 		assert.Equal(t, mockedReadCloseSeek, actual)
 	})
 
@@ -190,7 +191,7 @@ func TestGetFileReader(t *testing.T) {
 		}
 
 		// Add mock for GetBoard call since workspaceid is "0" (model.GlobalTeamID)
-		th.Store.EXPECT().GetBoard(testBoardID).Return(&model.Board{
+		th.Store.EXPECT().GetBoard(gomock.Any(), testBoardID).Return(&model.Board{
 			ID:         testBoardID,
 			IsTemplate: true, // Set to true since it's using GlobalTeamID
 		}, nil)
@@ -200,7 +201,7 @@ func TestGetFileReader(t *testing.T) {
 		mockedFileBackend.On("MoveFile", fileName, filePath).Return(moveFileFunc)
 		mockedFileBackend.On("Reader", filePath).Return(readerFunc, readerErrorFunc)
 
-		actual, _ := th.App.GetFileReader(workspaceid, testBoardID, testFileName)
+		actual, _ := th.App.GetFileReader(context.Background(), workspaceid, testBoardID, testFileName)
 		assert.Equal(t, mockedReadCloseSeek, actual)
 	})
 }
@@ -212,7 +213,7 @@ func TestSaveFile(t *testing.T) {
 		fileName := "temp-file-name.txt"
 		mockedFileBackend := &mocks.FileBackend{}
 		th.App.filesBackend = mockedFileBackend
-		th.Store.EXPECT().SaveFileInfo(gomock.Any()).Return(nil)
+		th.Store.EXPECT().SaveFileInfo(gomock.Any(), gomock.Any()).Return(nil)
 
 		writeFileFunc := func(reader io.Reader, path string) int64 {
 			paths := strings.Split(path, string(os.PathSeparator))
@@ -227,7 +228,7 @@ func TestSaveFile(t *testing.T) {
 		}
 
 		mockedFileBackend.On("WriteFile", mockedReadCloseSeek, mock.Anything).Return(writeFileFunc, writeFileErrorFunc)
-		actual, err := th.App.SaveFile(mockedReadCloseSeek, "1", testBoardID, fileName, false)
+		actual, err := th.App.SaveFile(context.Background(), mockedReadCloseSeek, "1", testBoardID, fileName, false)
 		assert.Equal(t, fileName, actual)
 		assert.Nil(t, err)
 	})
@@ -236,7 +237,7 @@ func TestSaveFile(t *testing.T) {
 		fileName := "temp-file-name.jpeg"
 		mockedFileBackend := &mocks.FileBackend{}
 		th.App.filesBackend = mockedFileBackend
-		th.Store.EXPECT().SaveFileInfo(gomock.Any()).Return(nil)
+		th.Store.EXPECT().SaveFileInfo(gomock.Any(), gomock.Any()).Return(nil)
 
 		writeFileFunc := func(reader io.Reader, path string) int64 {
 			paths := strings.Split(path, string(os.PathSeparator))
@@ -251,7 +252,7 @@ func TestSaveFile(t *testing.T) {
 		}
 
 		mockedFileBackend.On("WriteFile", mockedReadCloseSeek, mock.Anything).Return(writeFileFunc, writeFileErrorFunc)
-		actual, err := th.App.SaveFile(mockedReadCloseSeek, "1", "test-board-id", fileName, false)
+		actual, err := th.App.SaveFile(context.Background(), mockedReadCloseSeek, "1", "test-board-id", fileName, false)
 		assert.Nil(t, err)
 		assert.NotNil(t, actual)
 	})
@@ -275,7 +276,7 @@ func TestSaveFile(t *testing.T) {
 		}
 
 		mockedFileBackend.On("WriteFile", mockedReadCloseSeek, mock.Anything).Return(writeFileFunc, writeFileErrorFunc)
-		actual, err := th.App.SaveFile(mockedReadCloseSeek, "1", "test-board-id", fileName, false)
+		actual, err := th.App.SaveFile(context.Background(), mockedReadCloseSeek, "1", "test-board-id", fileName, false)
 		assert.Equal(t, "", actual)
 		assert.Equal(t, "unable to store the file in the files storage: Mocked File backend error", err.Error())
 	})
@@ -290,14 +291,14 @@ func TestGetFileInfo(t *testing.T) {
 			Archived: false,
 		}
 
-		th.Store.EXPECT().GetFileInfo("filename").Return(fileInfo, nil).Times(2)
+		th.Store.EXPECT().GetFileInfo(gomock.Any(), "filename").Return(fileInfo, nil).Times(2)
 
-		fetchedFileInfo, err := th.App.GetFileInfo("Afilename")
+		fetchedFileInfo, err := th.App.GetFileInfo(context.Background(), "Afilename")
 		assert.NoError(t, err)
 		assert.Equal(t, "file_info_id", fetchedFileInfo.Id)
 		assert.False(t, fetchedFileInfo.Archived)
 
-		fetchedFileInfo, err = th.App.GetFileInfo("Afilename.txt")
+		fetchedFileInfo, err = th.App.GetFileInfo(context.Background(), "Afilename.txt")
 		assert.NoError(t, err)
 		assert.Equal(t, "file_info_id", fetchedFileInfo.Id)
 		assert.False(t, fetchedFileInfo.Archived)
@@ -309,18 +310,18 @@ func TestGetFileInfo(t *testing.T) {
 			Archived: true,
 		}
 
-		th.Store.EXPECT().GetFileInfo("filename").Return(fileInfo, nil)
+		th.Store.EXPECT().GetFileInfo(gomock.Any(), "filename").Return(fileInfo, nil)
 
-		fetchedFileInfo, err := th.App.GetFileInfo("Afilename")
+		fetchedFileInfo, err := th.App.GetFileInfo(context.Background(), "Afilename")
 		assert.NoError(t, err)
 		assert.Equal(t, "file_info_id", fetchedFileInfo.Id)
 		assert.True(t, fetchedFileInfo.Archived)
 	})
 
 	t.Run("should return archived file infoerror", func(t *testing.T) {
-		th.Store.EXPECT().GetFileInfo("filename").Return(nil, errDummy)
+		th.Store.EXPECT().GetFileInfo(gomock.Any(), "filename").Return(nil, errDummy)
 
-		fetchedFileInfo, err := th.App.GetFileInfo("Afilename")
+		fetchedFileInfo, err := th.App.GetFileInfo(context.Background(), "Afilename")
 		assert.Error(t, err)
 		assert.Nil(t, fetchedFileInfo)
 	})
@@ -331,7 +332,7 @@ func TestGetFile(t *testing.T) {
 	validTeamID := "abcdefghijklmnopqrstuvwxyz" // 26 chars (valid Mattermost ID)
 
 	t.Run("happy path, no errors", func(t *testing.T) {
-		th.Store.EXPECT().GetFileInfo("fileInfoID").Return(&mm_model.FileInfo{
+		th.Store.EXPECT().GetFileInfo(gomock.Any(), "fileInfoID").Return(&mm_model.FileInfo{
 			Id:   "fileInfoID",
 			Path: testPath,
 		}, nil)
@@ -350,24 +351,24 @@ func TestGetFile(t *testing.T) {
 		mockedFileBackend.On("FileExists", testPath).Return(true, nil)
 
 		validBoardID := "bvalidboard1234567890123456"
-		fileInfo, seeker, err := th.App.GetFile(validTeamID, validBoardID, "7fileInfoID.txt")
+		fileInfo, seeker, err := th.App.GetFile(context.Background(), validTeamID, validBoardID, "7fileInfoID.txt")
 		assert.NoError(t, err)
 		assert.NotNil(t, fileInfo)
 		assert.NotNil(t, seeker)
 	})
 
 	t.Run("when GetFilePath() throws error", func(t *testing.T) {
-		th.Store.EXPECT().GetFileInfo("fileInfoID").Return(nil, errDummy)
+		th.Store.EXPECT().GetFileInfo(gomock.Any(), "fileInfoID").Return(nil, errDummy)
 
 		validBoardID := "bvalidboard1234567890123456"
-		fileInfo, seeker, err := th.App.GetFile(validTeamID, validBoardID, "7fileInfoID.txt")
+		fileInfo, seeker, err := th.App.GetFile(context.Background(), validTeamID, validBoardID, "7fileInfoID.txt")
 		assert.Error(t, err)
 		assert.Nil(t, fileInfo)
 		assert.Nil(t, seeker)
 	})
 
 	t.Run("when FileExists returns false", func(t *testing.T) {
-		th.Store.EXPECT().GetFileInfo("fileInfoID").Return(&mm_model.FileInfo{
+		th.Store.EXPECT().GetFileInfo(gomock.Any(), "fileInfoID").Return(&mm_model.FileInfo{
 			Id:   "fileInfoID",
 			Path: testPath,
 		}, nil)
@@ -377,13 +378,13 @@ func TestGetFile(t *testing.T) {
 		mockedFileBackend.On("FileExists", testPath).Return(false, nil)
 
 		validBoardID := "bvalidboard1234567890123456"
-		fileInfo, seeker, err := th.App.GetFile(validTeamID, validBoardID, "7fileInfoID.txt")
+		fileInfo, seeker, err := th.App.GetFile(context.Background(), validTeamID, validBoardID, "7fileInfoID.txt")
 		assert.Error(t, err)
 		assert.Nil(t, fileInfo)
 		assert.Nil(t, seeker)
 	})
 	t.Run("when FileReader throws error", func(t *testing.T) {
-		th.Store.EXPECT().GetFileInfo("fileInfoID").Return(&mm_model.FileInfo{
+		th.Store.EXPECT().GetFileInfo(gomock.Any(), "fileInfoID").Return(&mm_model.FileInfo{
 			Id:   "fileInfoID",
 			Path: testPath,
 		}, nil)
@@ -394,7 +395,7 @@ func TestGetFile(t *testing.T) {
 		mockedFileBackend.On("FileExists", testPath).Return(true, nil)
 
 		validBoardID := "bvalidboard1234567890123456"
-		fileInfo, seeker, err := th.App.GetFile(validTeamID, validBoardID, "7fileInfoID.txt")
+		fileInfo, seeker, err := th.App.GetFile(context.Background(), validTeamID, validBoardID, "7fileInfoID.txt")
 		assert.Error(t, err)
 		assert.Nil(t, fileInfo)
 		assert.Nil(t, seeker)
@@ -405,39 +406,39 @@ func TestGetFilePath(t *testing.T) {
 	th, _ := SetupTestHelper(t)
 
 	t.Run("when FileInfo exists", func(t *testing.T) {
-		th.Store.EXPECT().GetFileInfo("fileInfoID").Return(&mm_model.FileInfo{
+		th.Store.EXPECT().GetFileInfo(gomock.Any(), "fileInfoID").Return(&mm_model.FileInfo{
 			Id:   "fileInfoID",
 			Path: testPath,
 		}, nil)
 
 		validTeamID := "abcdefghijklmnopqrstuvwxyz" // 26 chars - valid Mattermost ID
 		validBoardID := "bvalidboard1234567890123456"
-		fileInfo, filePath, err := th.App.GetFilePath(validTeamID, validBoardID, "7fileInfoID.txt")
+		fileInfo, filePath, err := th.App.GetFilePath(context.Background(), validTeamID, validBoardID, "7fileInfoID.txt")
 		assert.NoError(t, err)
 		assert.NotNil(t, fileInfo)
 		assert.Equal(t, testPath, filePath)
 	})
 
 	t.Run("when FileInfo doesn't exist", func(t *testing.T) {
-		th.Store.EXPECT().GetFileInfo("fileInfoID").Return(nil, nil)
+		th.Store.EXPECT().GetFileInfo(gomock.Any(), "fileInfoID").Return(nil, nil)
 
 		validTeamID := "abcdefghijklmnopqrstuvwxyz" // 26 chars - valid Mattermost ID
 		validBoardID := "bvalidboard1234567890123456"
-		fileInfo, filePath, err := th.App.GetFilePath(validTeamID, validBoardID, "7fileInfoID.txt")
+		fileInfo, filePath, err := th.App.GetFilePath(context.Background(), validTeamID, validBoardID, "7fileInfoID.txt")
 		assert.NoError(t, err)
 		assert.Nil(t, fileInfo)
 		assert.Equal(t, validTeamID+"/"+validBoardID+"/7fileInfoID.txt", filePath)
 	})
 
 	t.Run("when FileInfo exists but FileInfo.Path is not set", func(t *testing.T) {
-		th.Store.EXPECT().GetFileInfo("fileInfoID").Return(&mm_model.FileInfo{
+		th.Store.EXPECT().GetFileInfo(gomock.Any(), "fileInfoID").Return(&mm_model.FileInfo{
 			Id:   "fileInfoID",
 			Path: "",
 		}, nil)
 
 		validTeamID := "abcdefghijklmnopqrstuvwxyz" // 26 chars - valid Mattermost ID
 		validBoardID := "bvalidboard1234567890123456"
-		fileInfo, filePath, err := th.App.GetFilePath(validTeamID, validBoardID, "7fileInfoID.txt")
+		fileInfo, filePath, err := th.App.GetFilePath(context.Background(), validTeamID, validBoardID, "7fileInfoID.txt")
 		assert.NoError(t, err)
 		assert.NotNil(t, fileInfo)
 		assert.Equal(t, validTeamID+"/"+validBoardID+"/7fileInfoID.txt", filePath)
@@ -463,8 +464,8 @@ func TestCopyCard(t *testing.T) {
 	validTestBoardID := "bvalidtestboard123456789012" // 27-char valid board ID
 
 	t.Run("Board doesn't exist", func(t *testing.T) {
-		th.Store.EXPECT().GetBoard(validTestBoardID).Return(nil, errDummy)
-		_, err := th.App.CopyCardFiles(validTestBoardID, []*model.Block{}, false)
+		th.Store.EXPECT().GetBoard(gomock.Any(), validTestBoardID).Return(nil, errDummy)
+		_, err := th.App.CopyCardFiles(context.Background(), validTestBoardID, []*model.Block{}, false)
 		assert.Error(t, err)
 	})
 
@@ -473,19 +474,20 @@ func TestCopyCard(t *testing.T) {
 			Id:   "imageBlock",
 			Path: testPath,
 		}
-		th.Store.EXPECT().GetBoard(validTestBoardID).Return(&model.Board{
+		th.Store.EXPECT().GetBoard(gomock.Any(), validTestBoardID).Return(&model.Board{
 			ID:         validTestBoardID,
 			TeamID:     "validteam12345678901234567",
 			IsTemplate: false,
 		}, nil)
-		th.Store.EXPECT().GetFileInfo("fileName123456789012345678").Return(fileInfo, nil)
-		th.Store.EXPECT().SaveFileInfo(fileInfo).Return(nil)
+		th.Store.EXPECT().GetFileInfo(gomock.Any(), "fileName123456789012345678").Return(fileInfo, nil)
+		th.Store.EXPECT().SaveFileInfo(gomock.Any(), fileInfo).Return(nil)
+		th.Store.EXPECT().AddFileUsage(gomock.Any(), "validteam12345678901234567", gomock.Any(), int64(0), int64(1)).Return(nil)
 
 		mockedFileBackend := &mocks.FileBackend{}
 		th.App.filesBackend = mockedFileBackend
 		mockedFileBackend.On("CopyFile", mock.Anything, mock.Anything).Return(nil)
 
-		updatedFileNames, err := th.App.CopyCardFiles(validTestBoardID, []*model.Block{imageBlock}, false)
+		updatedFileNames, err := th.App.CopyCardFiles(context.Background(), validTestBoardID, []*model.Block{imageBlock}, false)
 		assert.NoError(t, err)
 		assert.Equal(t, "7fileName123456789012345678.jpg", imageBlock.Fields["fileId"])
 		assert.NotNil(t, updatedFileNames["7fileName123456789012345678.jpg"])
@@ -512,37 +514,38 @@ func TestCopyCard(t *testing.T) {
 			Id:   "attachmentBlock",
 			Path: testPath,
 		}
-		th.Store.EXPECT().GetBoard(validTestBoardID).Return(&model.Board{
+		th.Store.EXPECT().GetBoard(gomock.Any(), validTestBoardID).Return(&model.Board{
 			ID:         validTestBoardID,
 			TeamID:     "validteam12345678901234567",
 			IsTemplate: false,
 		}, nil)
-		th.Store.EXPECT().GetFileInfo("fileName123456789012345678").Return(fileInfo, nil)
-		th.Store.EXPECT().SaveFileInfo(fileInfo).Return(nil)
+		th.Store.EXPECT().GetFileInfo(gomock.Any(), "fileName123456789012345678").Return(fileInfo, nil)
+		th.Store.EXPECT().SaveFileInfo(gomock.Any(), fileInfo).Return(nil)
+		th.Store.EXPECT().AddFileUsage(gomock.Any(), "validteam12345678901234567", gomock.Any(), int64(0), int64(1)).Return(nil)
 
 		mockedFileBackend := &mocks.FileBackend{}
 		th.App.filesBackend = mockedFileBackend
 		mockedFileBackend.On("CopyFile", mock.Anything, mock.Anything).Return(nil)
 
-		updatedFileNames, err := th.App.CopyCardFiles(validTestBoardID, []*model.Block{attachmentBlock}, false)
+		updatedFileNames, err := th.App.CopyCardFiles(context.Background(), validTestBoardID, []*model.Block{attachmentBlock}, false)
 		assert.NoError(t, err)
 		assert.NotNil(t, updatedFileNames[imageBlock.Fields["fileId"].(string)])
 	})
 
 	t.Run("Board exists, image block, without FileInfo", func(t *testing.T) {
-		th.Store.EXPECT().GetBoard("boardID").Return(&model.Board{
+		th.Store.EXPECT().GetBoard(gomock.Any(), "boardID").Return(&model.Board{
 			ID:         "boardID",
 			TeamID:     "validteam12345678901234567",
 			IsTemplate: false,
 		}, nil)
-		th.Store.EXPECT().GetFileInfo(gomock.Any()).Return(nil, nil)
-		th.Store.EXPECT().SaveFileInfo(gomock.Any()).Return(nil)
+		th.Store.EXPECT().GetFileInfo(gomock.Any(), gomock.Any()).Return(nil, nil)
+		th.Store.EXPECT().SaveFileInfo(gomock.Any(), gomock.Any()).Return(nil)
 
 		mockedFileBackend := &mocks.FileBackend{}
 		th.App.filesBackend = mockedFileBackend
 		mockedFileBackend.On("CopyFile", mock.Anything, mock.Anything).Return(nil)
 
-		updatedFileNames, err := th.App.CopyCardFiles("boardID", []*model.Block{imageBlock}, false)
+		updatedFileNames, err := th.App.CopyCardFiles(context.Background(), "boardID", []*model.Block{imageBlock}, false)
 		assert.NoError(t, err)
 		assert.NotNil(t, imageBlock.Fields["fileId"].(string))
 		assert.NotNil(t, updatedFileNames[imageBlock.Fields["fileId"].(string)])
@@ -618,20 +621,21 @@ func TestCopyAndUpdateCardFiles(t *testing.T) {
 			Id:   "imageBlock",
 			Path: testPath,
 		}
-		th.Store.EXPECT().GetBoard("bvalidtestboard123456789012").Return(&model.Board{
+		th.Store.EXPECT().GetBoard(gomock.Any(), "bvalidtestboard123456789012").Return(&model.Board{
 			ID:         "bvalidtestboard123456789012",
 			TeamID:     "validteam12345678901234567",
 			IsTemplate: false,
 		}, nil)
-		th.Store.EXPECT().GetFileInfo("fileName123456789012345678").Return(fileInfo, nil)
-		th.Store.EXPECT().SaveFileInfo(fileInfo).Return(nil)
-		th.Store.EXPECT().PatchBlocks(gomock.Any(), "userID").Return(nil)
+		th.Store.EXPECT().GetFileInfo(gomock.Any(), "fileName123456789012345678").Return(fileInfo, nil)
+		th.Store.EXPECT().SaveFileInfo(gomock.Any(), fileInfo).Return(nil)
+		th.Store.EXPECT().AddFileUsage(gomock.Any(), "validteam12345678901234567", gomock.Any(), int64(0), int64(1)).Return(nil)
+		th.Store.EXPECT().PatchBlocks(gomock.Any(), gomock.Any(), "userID").Return(nil)
 
 		mockedFileBackend := &mocks.FileBackend{}
 		th.App.filesBackend = mockedFileBackend
 		mockedFileBackend.On("CopyFile", mock.Anything, mock.Anything).Return(nil)
 
-		err := th.App.CopyAndUpdateCardFiles("bvalidtestboard123456789012", "userID", []*model.Block{imageBlock}, false)
+		err := th.App.CopyAndUpdateCardFiles(context.Background(), "bvalidtestboard123456789012", "userID", []*model.Block{imageBlock}, false)
 		assert.NoError(t, err)
 
 		assert.NotEqual(t, testPath, imageBlock.Fields["fileId"])
@@ -642,28 +646,29 @@ func TestCopyAndUpdateCardFiles(t *testing.T) {
 			Id:   "validImageBlock",
 			Path: testPath,
 		}
-		th.Store.EXPECT().GetBoard(validTestBoardID2).Return(&model.Board{ID: validTestBoardID2, TeamID: "validteam12345678901234567", IsTemplate: false}, nil)
-		th.Store.EXPECT().GetFileInfo("xhwgf5r15fr3dryfozf1dmy41r").Return(fileInfo, nil)
-		th.Store.EXPECT().SaveFileInfo(fileInfo).Return(nil)
-		th.Store.EXPECT().PatchBlocks(gomock.Any(), "userID").Return(nil)
+		th.Store.EXPECT().GetBoard(gomock.Any(), validTestBoardID2).Return(&model.Board{ID: validTestBoardID2, TeamID: "validteam12345678901234567", IsTemplate: false}, nil)
+		th.Store.EXPECT().GetFileInfo(gomock.Any(), "xhwgf5r15fr3dryfozf1dmy41r").Return(fileInfo, nil)
+		th.Store.EXPECT().SaveFileInfo(gomock.Any(), fileInfo).Return(nil)
+		th.Store.EXPECT().AddFileUsage(gomock.Any(), "validteam12345678901234567", gomock.Any(), int64(0), int64(1)).Return(nil)
+		th.Store.EXPECT().PatchBlocks(gomock.Any(), gomock.Any(), "userID").Return(nil)
 
 		mockedFileBackend := &mocks.FileBackend{}
 		th.App.filesBackend = mockedFileBackend
 		mockedFileBackend.On("CopyFile", mock.Anything, mock.Anything).Return(nil)
 
-		err := th.App.CopyAndUpdateCardFiles(validTestBoardID2, "userID", []*model.Block{validImageBlock}, false)
+		err := th.App.CopyAndUpdateCardFiles(context.Background(), validTestBoardID2, "userID", []*model.Block{validImageBlock}, false)
 		assert.NoError(t, err)
 	})
 
 	t.Run("Invalid file ID length", func(t *testing.T) {
-		th.Store.EXPECT().GetBoard(validTestBoardID2).Return(&model.Board{ID: validTestBoardID2, TeamID: "validteam12345678901234567", IsTemplate: false}, nil)
-		err := th.App.CopyAndUpdateCardFiles(validTestBoardID2, "userID", []*model.Block{invalidShortFileIDBlock}, false)
+		th.Store.EXPECT().GetBoard(gomock.Any(), validTestBoardID2).Return(&model.Board{ID: validTestBoardID2, TeamID: "validteam12345678901234567", IsTemplate: false}, nil)
+		err := th.App.CopyAndUpdateCardFiles(context.Background(), validTestBoardID2, "userID", []*model.Block{invalidShortFileIDBlock}, false)
 		assert.ErrorIs(t, err, model.NewErrBadRequest("Invalid Block ID"))
 	})
 
 	t.Run("Empty file ID", func(t *testing.T) {
-		th.Store.EXPECT().GetBoard(validTestBoardID2).Return(&model.Board{ID: validTestBoardID2, TeamID: "validteam12345678901234567", IsTemplate: false}, nil)
-		err := th.App.CopyAndUpdateCardFiles(validTestBoardID2, "userID", []*model.Block{emptyFileBlock}, false)
+		th.Store.EXPECT().GetBoard(gomock.Any(), validTestBoardID2).Return(&model.Board{ID: validTestBoardID2, TeamID: "validteam12345678901234567", IsTemplate: false}, nil)
+		err := th.App.CopyAndUpdateCardFiles(context.Background(), validTestBoardID2, "userID", []*model.Block{emptyFileBlock}, false)
 		assert.ErrorIs(t, err, model.NewErrBadRequest("Block ID cannot be empty"))
 	})
 }
@@ -682,19 +687,19 @@ func TestCopyCardFiles(t *testing.T) {
 			},
 		}
 
-		th.Store.EXPECT().GetBoard(sourceBoardID).Return(&model.Board{
+		th.Store.EXPECT().GetBoard(gomock.Any(), sourceBoardID).Return(&model.Board{
 			ID:         sourceBoardID,
 			TeamID:     "validteam12345678901234567",
 			IsTemplate: false,
 		}, nil)
-		th.Store.EXPECT().GetFileInfo("validFileID12345678901234").Return(nil, nil)
-		th.Store.EXPECT().SaveFileInfo(gomock.Any()).Return(nil)
+		th.Store.EXPECT().GetFileInfo(gomock.Any(), "validFileID12345678901234").Return(nil, nil)
+		th.Store.EXPECT().SaveFileInfo(gomock.Any(), gomock.Any()).Return(nil)
 
 		mockedFileBackend := &mocks.FileBackend{}
 		th.App.filesBackend = mockedFileBackend
 		mockedFileBackend.On("CopyFile", mock.Anything, mock.Anything).Return(nil)
 
-		newFileNames, err := th.App.CopyCardFiles(sourceBoardID, copiedBlocks, false)
+		newFileNames, err := th.App.CopyCardFiles(context.Background(), sourceBoardID, copiedBlocks, false)
 
 		assert.NoError(t, err)
 		assert.NotNil(t, newFileNames)
@@ -711,13 +716,13 @@ func TestCopyCardFiles(t *testing.T) {
 			},
 		}
 
-		th.Store.EXPECT().GetBoard(sourceBoardID).Return(&model.Board{
+		th.Store.EXPECT().GetBoard(gomock.Any(), sourceBoardID).Return(&model.Board{
 			ID:         sourceBoardID,
 			TeamID:     "validteam12345678901234567",
 			IsTemplate: false,
 		}, nil)
 
-		newFileNames, err := th.App.CopyCardFiles(sourceBoardID, copiedBlocks, false)
+		newFileNames, err := th.App.CopyCardFiles(context.Background(), sourceBoardID, copiedBlocks, false)
 
 		assert.Error(t, err)
 		assert.Nil(t, newFileNames)
@@ -943,9 +948,9 @@ func TestValidateFileOwnership(t *testing.T) {
 			Id:   "validfile1234567890123456",
 			Path: filepath.Join(validTeamID, validBoardID, filename),
 		}
-		th.Store.EXPECT().GetFileInfo("validfile1234567890123456").Return(fileInfo, nil)
+		th.Store.EXPECT().GetFileInfo(gomock.Any(), "validfile1234567890123456").Return(fileInfo, nil)
 
-		err := th.App.ValidateFileOwnership(validTeamID, validBoardID, filename)
+		err := th.App.ValidateFileOwnership(context.Background(), validTeamID, validBoardID, filename)
 		assert.NoError(t, err)
 	})
 
@@ -955,7 +960,7 @@ func TestValidateFileOwnership(t *testing.T) {
 			Id:   "validfile1234567890123456",
 			Path: filepath.Join(utils.GetBaseFilePath(), filename),
 		}
-		th.Store.EXPECT().GetFileInfo("validfile1234567890123456").Return(fileInfo, nil)
+		th.Store.EXPECT().GetFileInfo(gomock.Any(), "validfile1234567890123456").Return(fileInfo, nil)
 
 		// Mock block that references the file
 		block := &model.Block{
@@ -964,9 +969,9 @@ func TestValidateFileOwnership(t *testing.T) {
 			Type:    model.TypeImage,
 			Fields:  map[string]interface{}{model.BlockFieldFileId: filename},
 		}
-		th.Store.EXPECT().GetBlocksForBoard(validBoardID).Return([]*model.Block{block}, nil)
+		th.Store.EXPECT().GetBlocksForBoard(gomock.Any(), validBoardID).Return([]*model.Block{block}, nil)
 
-		err := th.App.ValidateFileOwnership(validTeamID, validBoardID, filename)
+		err := th.App.ValidateFileOwnership(context.Background(), validTeamID, validBoardID, filename)
 		assert.NoError(t, err)
 	})
 
@@ -976,12 +981,12 @@ func TestValidateFileOwnership(t *testing.T) {
 			Id:   "validfile1234567890123456",
 			Path: filepath.Join(validTeamID, otherBoardID, filename),
 		}
-		th.Store.EXPECT().GetFileInfo("validfile1234567890123456").Return(fileInfo, nil)
+		th.Store.EXPECT().GetFileInfo(gomock.Any(), "validfile1234567890123456").Return(fileInfo, nil)
 
 		// Mock empty blocks for the requested board (file not referenced)
-		th.Store.EXPECT().GetBlocksForBoard(validBoardID).Return([]*model.Block{}, nil)
+		th.Store.EXPECT().GetBlocksForBoard(gomock.Any(), validBoardID).Return([]*model.Block{}, nil)
 
-		err := th.App.ValidateFileOwnership(validTeamID, validBoardID, filename)
+		err := th.App.ValidateFileOwnership(context.Background(), validTeamID, validBoardID, filename)
 		assert.Error(t, err)
 		assert.Contains(t, err.Error(), "file does not belong to the specified board")
 	})
@@ -991,7 +996,7 @@ func TestValidateFileOwnership(t *testing.T) {
 			Id:   "validfile1234567890123456",
 			Path: filepath.Join(utils.GetBaseFilePath(), filename),
 		}
-		th.Store.EXPECT().GetFileInfo("validfile1234567890123456").Return(fileInfo, nil)
+		th.Store.EXPECT().GetFileInfo(gomock.Any(), "validfile1234567890123456").Return(fileInfo, nil)
 
 		block := &model.Block{
 			ID:      "blockid1234567890123456789",
@@ -999,9 +1004,9 @@ func TestValidateFileOwnership(t *testing.T) {
 			Type:    model.TypeImage,
 			Fields:  map[string]interface{}{model.BlockFieldFileId: "different_file.txt"},
 		}
-		th.Store.EXPECT().GetBlocksForBoard(validBoardID).Return([]*model.Block{block}, nil)
+		th.Store.EXPECT().GetBlocksForBoard(gomock.Any(), validBoardID).Return([]*model.Block{block}, nil)
 
-		err := th.App.ValidateFileOwnership(validTeamID, validBoardID, filename)
+		err := th.App.ValidateFileOwnership(context.Background(), validTeamID, validBoardID, filename)
 		assert.Error(t, err)
 		assert.Contains(t, err.Error(), "file does not belong to the specified board")
 	})
@@ -1011,7 +1016,7 @@ func TestValidateFileOwnership(t *testing.T) {
 			Id:   "validfile1234567890123456",
 			Path: filepath.Join(utils.GetBaseFilePath(), filename),
 		}
-		th.Store.EXPECT().GetFileInfo("validfile1234567890123456").Return(fileInfo, nil)
+		th.Store.EXPECT().GetFileInfo(gomock.Any(), "validfile1234567890123456").Return(fileInfo, nil)
 
 		block := &model.Block{
 			ID:      "blockid1234567890123456789",
@@ -1019,16 +1024,16 @@ func TestValidateFileOwnership(t *testing.T) {
 			Type:    model.TypeAttachment,
 			Fields:  map[string]interface{}{model.BlockFieldAttachmentId: filename},
 		}
-		th.Store.EXPECT().GetBlocksForBoard(validBoardID).Return([]*model.Block{block}, nil)
+		th.Store.EXPECT().GetBlocksForBoard(gomock.Any(), validBoardID).Return([]*model.Block{block}, nil)
 
-		err := th.App.ValidateFileOwnership(validTeamID, validBoardID, filename)
+		err := th.App.ValidateFileOwnership(context.Background(), validTeamID, validBoardID, filename)
 		assert.NoError(t, err)
 	})
 
 	t.Run("Should handle file info not found", func(t *testing.T) {
-		th.Store.EXPECT().GetFileInfo("validfile1234567890123456").Return(nil, model.NewErrNotFound("file not found"))
+		th.Store.EXPECT().GetFileInfo(gomock.Any(), "validfile1234567890123456").Return(nil, model.NewErrNotFound("file not found"))
 
-		err := th.App.ValidateFileOwnership(validTeamID, validBoardID, filename)
+		err := th.App.ValidateFileOwnership(context.Background(), validTeamID, validBoardID, filename)
 		assert.Error(t, err)
 		assert.Contains(t, err.Error(), "file not found")
 	})
@@ -1038,28 +1043,28 @@ func TestGetFilePathWithGlobalTeamID(t *testing.T) {
 	th, _ := SetupTestHelper(t)
 
 	t.Run("when TeamID is GlobalTeamID and board is template", func(t *testing.T) {
-		th.Store.EXPECT().GetFileInfo("fileInfoID").Return(nil, nil)
-		th.Store.EXPECT().GetBoard("bvalidboard1234567890123456").Return(&model.Board{
+		th.Store.EXPECT().GetFileInfo(gomock.Any(), "fileInfoID").Return(nil, nil)
+		th.Store.EXPECT().GetBoard(gomock.Any(), "bvalidboard1234567890123456").Return(&model.Board{
 			ID:         "bvalidboard1234567890123456",
 			TeamID:     model.GlobalTeamID,
 			IsTemplate: true,
 		}, nil)
 
-		fileInfo, filePath, err := th.App.GetFilePath(model.GlobalTeamID, "bvalidboard1234567890123456", "7fileInfoID.txt")
+		fileInfo, filePath, err := th.App.GetFilePath(context.Background(), model.GlobalTeamID, "bvalidboard1234567890123456", "7fileInfoID.txt")
 		assert.NoError(t, err)
 		assert.Nil(t, fileInfo)
 		assert.Equal(t, "0/bvalidboard1234567890123456/7fileInfoID.txt", filePath)
 	})
 
 	t.Run("when TeamID is GlobalTeamID and board is not template", func(t *testing.T) {
-		th.Store.EXPECT().GetFileInfo("fileInfoID").Return(nil, nil)
-		th.Store.EXPECT().GetBoard("bvalidboard1234567890123456").Return(&model.Board{
+		th.Store.EXPECT().GetFileInfo(gomock.Any(), "fileInfoID").Return(nil, nil)
+		th.Store.EXPECT().GetBoard(gomock.Any(), "bvalidboard1234567890123456").Return(&model.Board{
 			ID:         "bvalidboard1234567890123456",
 			TeamID:     model.GlobalTeamID,
 			IsTemplate: false,
 		}, nil)
 
-		fileInfo, filePath, err := th.App.GetFilePath(model.GlobalTeamID, "bvalidboard1234567890123456", "7fileInfoID.txt")
+		fileInfo, filePath, err := th.App.GetFilePath(context.Background(), model.GlobalTeamID, "bvalidboard1234567890123456", "7fileInfoID.txt")
 		assert.Error(t, err)
 		assert.Contains(t, err.Error(), "invalid teamID in ValidateTeamID")
 		assert.Nil(t, fileInfo)
@@ -0,0 +1,471 @@
+// Copyright (c) 2020-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package app
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/mattermost/mattermost-plugin-boards/server/model"
+	"github.com/mattermost/mattermost-plugin-boards/server/utils"
+)
+
+const (
+	flowMetricsDefaultRange = 90 * 24 * time.Hour
+	flowMetricsCacheTTL     = 5 * time.Minute
+	flowMetricsCacheSize    = 100
+
+	oneDay  = 24 * time.Hour
+	oneWeek = 7 * oneDay
+)
+
+type flowMetricsCacheEntry struct {
+	// the time of the latest change of the board when the entry was computed
+	changedAt  int64
+	computedAt time.Time
+	value      interface{}
+}
+
+// flowStatusConfig is the status property of flow metrics, resolved against the board.
+type flowStatusConfig struct {
+	opts     model.FlowMetricsOptions
+	statuses []model.FlowStatus
+	known    map[string]bool
+	started  map[string]bool
+	done     map[string]bool
+}
+
+type statusTransition struct {
+	at     int64
+	status string
+}
+
+// cardTimeline is the history of the status of a card.
+type cardTimeline struct {
+	cardID      string
+	title       string
+	createdAt   int64
+	deletedAt   int64
+	transitions []statusTransition
+}
+
+// GetFlowMetrics returns the time in status, cycle and lead times and throughput of the cards
+// of a board over a date range.
+func (a *App) GetFlowMetrics(ctx context.Context, boardID string, opts model.FlowMetricsOptions) (*model.FlowMetrics, error) {
+	ctx, span := startSpan(ctx, "GetFlowMetrics")
+	defer span.End()
+
+	cfg, err := a.getFlowStatusConfig(ctx, boardID, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	value, err := a.getCachedFlowMetrics(ctx, "flow", boardID, cfg, func(timelines []*cardTimeline) interface{} {
+		return computeFlowMetrics(boardID, cfg, timelines)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return value.(*model.FlowMetrics), nil
+}
+
+// GetCumulativeFlow returns the daily number of cards of a board in each status over a date
+// range.
+func (a *App) GetCumulativeFlow(ctx context.Context, boardID string, opts model.FlowMetricsOptions) (*model.CumulativeFlow, error) {
+	ctx, span := startSpan(ctx, "GetCumulativeFlow")
+	defer span.End()
+
+	cfg, err := a.getFlowStatusConfig(ctx, boardID, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	value, err := a.getCachedFlowMetrics(ctx, "cumulative", boardID, cfg, func(timelines []*cardTimeline) interface{} {
+		return computeCumulativeFlow(boardID, cfg, timelines)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return value.(*model.CumulativeFlow), nil
+}
+
+// getFlowStatusConfig checks the status property and fills in the default date range and
+// started and done statuses.
+func (a *App) getFlowStatusConfig(ctx context.Context, boardID string, opts model.FlowMetricsOptions) (*flowStatusConfig, error) {
+	if opts.To == 0 {
+		// rounded down so that repeated requests can be served from the cache
+		opts.To = utils.GetMillis() / time.Minute.Milliseconds() * time.Minute.Milliseconds()
+	}
+	if opts.From == 0 {
+		opts.From = opts.To - flowMetricsDefaultRange.Milliseconds()
+	}
+	if err := opts.IsValid(); err != nil {
+		return nil, err
+	}
+
+	board, err := a.GetBoard(ctx, boardID)
+	if err != nil {
+		return nil, err
+	}
+	schema, err := model.ParsePropertySchema(board)
+	if err != nil {
+		return nil, fmt.Errorf("could not parse property schema for board %s: %w", boardID, err)
+	}
+	prop, ok := schema[opts.PropertyID]
+	if !ok {
+		return nil, model.NewErrBadRequest("status property not found: " + opts.PropertyID)
+	}
+	if prop.Type != "select" {
+		return nil, model.NewErrBadRequest("status property must be a select property: " + opts.PropertyID)
+	}
+	if len(prop.Options) == 0 {
+		return nil, model.NewErrBadRequest("status property has no options: " + opts.PropertyID)
+	}
+
+	options := make([]model.PropDefOption, 0, len(prop.Options))
+	for _, option := range prop.Options {
+		options = append(options, option)
+	}
+	sort.Slice(options, func(i, j int) bool {
+		return options[i].Index < options[j].Index
+	})
+
+	cfg := &flowStatusConfig{
+		statuses: make([]model.FlowStatus, 0, len(options)),
+		known:    make(map[string]bool, len(options)),
+		started:  make(map[string]bool),
+		done:     make(map[string]bool),
+	}
+	for _, option := range options {
+		cfg.statuses = append(cfg.statuses, model.FlowStatus{ID: option.ID, Value: option.Value})
+		cfg.known[option.ID] = true
+	}
+
+	if len(opts.DoneStatuses) == 0 {
+		opts.DoneStatuses = []string{options[len(options)-1].ID}
+	}
+	for _, status := range opts.DoneStatuses {
+		if !cfg.known[status] {
+			return nil, model.NewErrBadRequest("done status is not an option of the status property: " + status)
+		}
+		cfg.done[status] = true
+	}
+
+	if len(opts.StartedStatuses) == 0 {
+		for _, option := range options[1:] {
+			if !cfg.done[option.ID] {
+				opts.StartedStatuses = append(opts.StartedStatuses, option.ID)
+			}
+		}
+	}
+	for _, status := range opts.StartedStatuses {
+		if !cfg.known[status] {
+			return nil, model.NewErrBadRequest("started status is not an option of the status property: " + status)
+		}
+		cfg.started[status] = true
+	}
+
+	sort.Strings(opts.StartedStatuses)
+	sort.Strings(opts.DoneStatuses)
+	cfg.opts = opts
+	return cfg, nil
+}
+
+// getCachedFlowMetrics returns the cached metrics of a board, or computes them from the card
+// history if the board changed since they were cached.
+func (a *App) getCachedFlowMetrics(ctx context.Context, kind, boardID string, cfg *flowStatusConfig, compute func([]*cardTimeline) interface{}) (interface{}, error) {
+	changedAt, _, err := a.getBoardDescendantModifiedInfo(ctx, boardID, true)
+	if err != nil {
+		return nil, err
+	}
+
+	key := strings.Join([]string{
+		kind,
+		boardID,
+		cfg.opts.PropertyID,
+		fmt.Sprintf("%d-%d", cfg.opts.From, cfg.opts.To),
+		strings.Join(cfg.opts.StartedStatuses, ","),
+		strings.Join(cfg.opts.DoneStatuses, ","),
+	}, "|")
+
+	a.flowMetricsMux.Lock()
+	entry, ok := a.flowMetricsCache[key]
+	a.flowMetricsMux.Unlock()
+	if ok && entry.changedAt == changedAt && time.Since(entry.computedAt) < flowMetricsCacheTTL {
+		return entry.value, nil
+	}
+
+	timelines, err := a.getCardTimelines(ctx, boardID, cfg)
+	if err != nil {
+		return nil, err
+	}
+	value := compute(timelines)
+
+	a.flowMetricsMux.Lock()
+	defer a.flowMetricsMux.Unlock()
+	if a.flowMetricsCache == nil {
+		a.flowMetricsCache = make(map[string]*flowMetricsCacheEntry)
+	}
+	if len(a.flowMetricsCache) >= flowMetricsCacheSize {
+		a.evictFlowMetrics()
+	}
+	a.flowMetricsCache[key] = &flowMetricsCacheEntry{
+		changedAt:  changedAt,
+		computedAt: time.Now(),
+		value:      value,
+	}
+	return value, nil
+}
+
+// evictFlowMetrics removes the expired entries of the cache, or the oldest one if none
+// expired. Must be called with flowMetricsMux locked.
+func (a *App) evictFlowMetrics() {
+	var oldestKey string
+	var oldest time.Time
+	for key, entry := range a.flowMetricsCache {
+		if time.Since(entry.computedAt) >= flowMetricsCacheTTL {
+			delete(a.flowMetricsCache, key)
+			continue
+		}
+		if oldestKey == "" || entry.computedAt.Before(oldest) {
+			oldestKey = key
+			oldest = entry.computedAt
+		}
+	}
+	if len(a.flowMetricsCache) >= flowMetricsCacheSize {
+		delete(a.flowMetricsCache, oldestKey)
+	}
+}
+
+// getCardTimelines reads the status transitions of the cards of a board from the block history,
+// up to the end of the date range.
+func (a *App) getCardTimelines(ctx context.Context, boardID string, cfg *flowStatusConfig) ([]*cardTimeline, error) {
+	opts := model.QueryBlockHistoryOptions{
+		BeforeUpdateAt: cfg.opts.To + 1,
+	}
+	blocks, err := a.store.GetBlockHistoryDescendants(ctx, boardID, opts)
+	if err != nil {
+		return nil, fmt.Errorf("could not get block history for board %s: %w", boardID, err)
+	}
+
+	timelinesByID := map[string]*cardTimeline{}
+	var timelines []*cardTimeline
+	for _, block := range blocks {
+		if block.Type != model.TypeCard {
+			continue
+		}
+
+		timeline, ok := timelinesByID[block.ID]
+		if !ok {
+			timeline = &cardTimeline{cardID: block.ID, createdAt: block.CreateAt}
+			timelinesByID[block.ID] = timeline
+			timelines = append(timelines, timeline)
+		}
+		timeline.title = block.Title
+		timeline.deletedAt = block.DeleteAt
+
+		status := cfg.cardStatus(block)
+		last := len(timeline.transitions) - 1
+		if last < 0 {
+			timeline.transitions = append(timeline.transitions, statusTransition{at: timeline.createdAt, status: status})
+		} else if timeline.transitions[last].status != status {
+			timeline.transitions = append(timeline.transitions, statusTransition{at: block.UpdateAt, status: status})
+		}
+	}
+	return timelines, nil
+}
+
+// cardStatus returns the option of the status property of a card version, or an empty string
+// if it has none, or one the property no longer has.
+func (cfg *flowStatusConfig) cardStatus(card *model.Block) string {
+	properties, ok := card.Fields["properties"].(map[string]interface{})
+	if !ok {
+		return ""
+	}
+	status, ok := properties[cfg.opts.PropertyID].(string)
+	if !ok || !cfg.known[status] {
+		return ""
+	}
+	return status
+}
+
+func (t *cardTimeline) aliveAt(at int64) bool {
+	return t.createdAt <= at && (t.deletedAt == 0 || t.deletedAt > at)
+}
+
+func (t *cardTimeline) statusAt(at int64) string {
+	status := ""
+	for _, transition := range t.transitions {
+		if transition.at > at {
+			break
+		}
+		status = transition.status
+	}
+	return status
+}
+
+// flow computes the flow of the card up to the given time.
+func (t *cardTimeline) flow(cfg *flowStatusConfig, until int64) *model.CardFlow {
+	flow := &model.CardFlow{
+		CardID:       t.cardID,
+		Title:        t.title,
+		Status:       t.statusAt(until),
+		CreatedAt:    t.createdAt,
+		TimeInStatus: map[string]int64{},
+	}
+
+	for i, transition := range t.transitions {
+		if transition.at > until {
+			break
+		}
+		end := until
+		if i+1 < len(t.transitions) && t.transitions[i+1].at < until {
+			end = t.transitions[i+1].at
+		}
+		flow.TimeInStatus[transition.status] += end - transition.at
+
+		if flow.StartedAt == 0 && (cfg.started[transition.status] || cfg.done[transition.status]) {
+			flow.StartedAt = transition.at
+		}
+		if cfg.done[transition.status] {
+			if flow.DoneAt == 0 {
+				flow.DoneAt = transition.at
+			}
+		} else {
+			flow.DoneAt = 0
+		}
+	}
+
+	if flow.DoneAt != 0 {
+		flow.LeadTime = flow.DoneAt - flow.CreatedAt
+		flow.CycleTime = flow.DoneAt - flow.StartedAt
+	}
+	return flow
+}
+
+func computeFlowMetrics(boardID string, cfg *flowStatusConfig, timelines []*cardTimeline) *model.FlowMetrics {
+	from, to := cfg.opts.From, cfg.opts.To
+	metrics := &model.FlowMetrics{
+		BoardID:         boardID,
+		PropertyID:      cfg.opts.PropertyID,
+		From:            from,
+		To:              to,
+		Statuses:        cfg.statuses,
+		StartedStatuses: cfg.opts.StartedStatuses,
+		DoneStatuses:    cfg.opts.DoneStatuses,
+		Cards:           []*model.CardFlow{},
+		Throughput:      []model.WeeklyThroughput{},
+	}
+
+	firstWeek := startOfWeek(from)
+	for weekStart := firstWeek; weekStart <= to; weekStart += oneWeek.Milliseconds() {
+		metrics.Throughput = append(metrics.Throughput, model.WeeklyThroughput{WeekStart: weekStart})
+	}
+
+	var cycleTimes, leadTimes []int64
+	for _, timeline := range timelines {
+		if !timeline.aliveAt(to) {
+			continue
+		}
+		flow := timeline.flow(cfg, to)
+		if flow.DoneAt != 0 && flow.DoneAt < from {
+			// done before the date range
+			continue
+		}
+		metrics.Cards = append(metrics.Cards, flow)
+
+		if flow.DoneAt == 0 {
+			continue
+		}
+		cycleTimes = append(cycleTimes, flow.CycleTime)
+		leadTimes = append(leadTimes, flow.LeadTime)
+		metrics.Throughput[(flow.DoneAt-firstWeek)/oneWeek.Milliseconds()].Count++
+	}
+
+	sort.Slice(metrics.Cards, func(i, j int) bool {
+		return metrics.Cards[i].CardID < metrics.Cards[j].CardID
+	})
+	metrics.CycleTime = durationDistribution(cycleTimes)
+	metrics.LeadTime = durationDistribution(leadTimes)
+	return metrics
+}
+
+func computeCumulativeFlow(boardID string, cfg *flowStatusConfig, timelines []*cardTimeline) *model.CumulativeFlow {
+	from, to := cfg.opts.From, cfg.opts.To
+	flow := &model.CumulativeFlow{
+		BoardID:    boardID,
+		PropertyID: cfg.opts.PropertyID,
+		From:       from,
+		To:         to,
+		Statuses:   cfg.statuses,
+		Points:     []model.CumulativeFlowPoint{},
+	}
+
+	for date := startOfDay(from); date <= to; date += oneDay.Milliseconds() {
+		// sampled at the end of the day
+		at := date + oneDay.Milliseconds() - 1
+		if at > to {
+			at = to
+		}
+
+		point := model.CumulativeFlowPoint{Date: date, Counts: make(map[string]int, len(cfg.statuses))}
+		for _, status := range cfg.statuses {
+			point.Counts[status.ID] = 0
+		}
+		for _, timeline := range timelines {
+			if timeline.aliveAt(at) {
+				point.Counts[timeline.statusAt(at)]++
+			}
+		}
+		flow.Points = append(flow.Points, point)
+	}
+	return flow
+}
+
+// durationDistribution summarizes durations, with nearest-rank percentiles.
+func durationDistribution(durations []int64) model.DurationDistribution {
+	if len(durations) == 0 {
+		return model.DurationDistribution{}
+	}
+	sort.Slice(durations, func(i, j int) bool {
+		return durations[i] < durations[j]
+	})
+
+	var sum int64
+	for _, duration := range durations {
+		sum += duration
+	}
+	percentile := func(p int) int64 {
+		rank := (p*len(durations) + 99) / 100
+		if rank < 1 {
+			rank = 1
+		}
+		return durations[rank-1]
+	}
+
+	return model.DurationDistribution{
+		Count:  len(durations),
+		Min:    durations[0],
+		Max:    durations[len(durations)-1],
+		Mean:   sum / int64(len(durations)),
+		Median: percentile(50),
+		P85:    percentile(85),
+		P95:    percentile(95),
+	}
+}
+
+func startOfDay(millis int64) int64 {
+	t := time.UnixMilli(millis).UTC()
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC).UnixMilli()
+}
+
+// startOfWeek returns the start of the monday of the week of the given time, in UTC.
+func startOfWeek(millis int64) int64 {
+	t := time.UnixMilli(millis).UTC()
+	offset := (int(t.Weekday()) + 6) % 7
+	return startOfDay(millis) - int64(offset)*oneDay.Milliseconds()
+}
@@ -0,0 +1,151 @@
+// Copyright (c) 2020-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package app
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mattermost/mattermost-plugin-boards/server/model"
+)
+
+func TestGetFlowMetrics(t *testing.T) {
+	th, tearDown := SetupTestHelper(t)
+	defer tearDown()
+
+	// monday, 1 January 2024, midnight UTC
+	base := time.Date(2024, time.January, 1, 0, 0, 0, 0, time.UTC).UnixMilli()
+	d := oneDay.Milliseconds()
+
+	board := &model.Board{
+		ID:       testBoardID,
+		UpdateAt: base,
+		CardProperties: []map[string]interface{}{
+			{
+				"id":   "status",
+				"name": "Status",
+				"type": "select",
+				"options": []interface{}{
+					map[string]interface{}{"id": "todo", "value": "To Do"},
+					map[string]interface{}{"id": "doing", "value": "Doing"},
+					map[string]interface{}{"id": "done", "value": "Done"},
+				},
+			},
+			{"id": "owner", "name": "Owner", "type": "person"},
+		},
+	}
+	cardVersion := func(id string, createAt, updateAt, deleteAt int64, status string) *model.Block {
+		return &model.Block{
+			ID: id, BoardID: testBoardID, ParentID: testBoardID, Type: model.TypeCard, Title: "Card " + id,
+			CreateAt: createAt, UpdateAt: updateAt, DeleteAt: deleteAt,
+			Fields: map[string]interface{}{"properties": map[string]interface{}{"status": status}},
+		}
+	}
+	history := []*model.Block{
+		cardVersion("a", base, base, 0, "todo"),
+		cardVersion("c", base, base, 0, "todo"),
+		cardVersion("a", base, base+d, 0, "doing"),
+		cardVersion("b", base+d, base+d, 0, "todo"),
+		cardVersion("b", base+d, base+2*d, 0, "doing"),
+		cardVersion("c", base, base+2*d, base+2*d, "todo"),
+		cardVersion("a", base, base+3*d, 0, "done"),
+	}
+
+	from := base
+	to := base + 6*d
+	opts := model.FlowMetricsOptions{PropertyID: "status", From: from, To: to}
+
+	th.Store.EXPECT().GetBoard(gomock.Any(), testBoardID).Return(board, nil).AnyTimes()
+	th.Store.EXPECT().GetBoardHistory(gomock.Any(), testBoardID, model.QueryBoardHistoryOptions{Limit: 1, Descending: true}).
+		Return([]*model.Board{board}, nil).AnyTimes()
+	th.Store.EXPECT().GetBlockHistoryDescendants(gomock.Any(), testBoardID, model.QueryBlockHistoryOptions{Limit: 1, Descending: true}).
+		Return([]*model.Block{history[len(history)-1]}, nil).AnyTimes()
+
+	t.Run("flow metrics", func(t *testing.T) {
+		th.Store.EXPECT().GetBlockHistoryDescendants(gomock.Any(), testBoardID, model.QueryBlockHistoryOptions{BeforeUpdateAt: to + 1}).
+			Return(history, nil).Times(1)
+
+		metrics, err := th.App.GetFlowMetrics(context.Background(), testBoardID, opts)
+		require.NoError(t, err)
+
+		assert.Equal(t, []model.FlowStatus{{ID: "todo", Value: "To Do"}, {ID: "doing", Value: "Doing"}, {ID: "done", Value: "Done"}}, metrics.Statuses)
+		assert.Equal(t, []string{"doing"}, metrics.StartedStatuses)
+		assert.Equal(t, []string{"done"}, metrics.DoneStatuses)
+
+		require.Len(t, metrics.Cards, 2)
+		cardA := metrics.Cards[0]
+		assert.Equal(t, "a", cardA.CardID)
+		assert.Equal(t, "done", cardA.Status)
+		assert.Equal(t, base+d, cardA.StartedAt)
+		assert.Equal(t, base+3*d, cardA.DoneAt)
+		assert.Equal(t, 2*d, cardA.CycleTime)
+		assert.Equal(t, 3*d, cardA.LeadTime)
+		assert.Equal(t, map[string]int64{"todo": d, "doing": 2 * d, "done": 3 * d}, cardA.TimeInStatus)
+
+		cardB := metrics.Cards[1]
+		assert.Equal(t, "b", cardB.CardID)
+		assert.Equal(t, "doing", cardB.Status)
+		assert.Zero(t, cardB.DoneAt)
+		assert.Equal(t, map[string]int64{"todo": d, "doing": 4 * d}, cardB.TimeInStatus)
+
+		assert.Equal(t, model.DurationDistribution{Count: 1, Min: 2 * d, Max: 2 * d, Mean: 2 * d, Median: 2 * d, P85: 2 * d, P95: 2 * d}, metrics.CycleTime)
+		assert.Equal(t, 3*d, metrics.LeadTime.Median)
+		assert.Equal(t, []model.WeeklyThroughput{{WeekStart: base, Count: 1}}, metrics.Throughput)
+	})
+
+	t.Run("served from the cache", func(t *testing.T) {
+		metrics, err := th.App.GetFlowMetrics(context.Background(), testBoardID, opts)
+		require.NoError(t, err)
+		require.Len(t, metrics.Cards, 2)
+	})
+
+	t.Run("cumulative flow", func(t *testing.T) {
+		th.Store.EXPECT().GetBlockHistoryDescendants(gomock.Any(), testBoardID, model.QueryBlockHistoryOptions{BeforeUpdateAt: to + 1}).
+			Return(history, nil).Times(1)
+
+		flow, err := th.App.GetCumulativeFlow(context.Background(), testBoardID, opts)
+		require.NoError(t, err)
+
+		require.Len(t, flow.Points, 7)
+		assert.Equal(t, base, flow.Points[0].Date)
+		assert.Equal(t, map[string]int{"todo": 2, "doing": 0, "done": 0}, flow.Points[0].Counts)
+		assert.Equal(t, map[string]int{"todo": 2, "doing": 1, "done": 0}, flow.Points[1].Counts)
+		assert.Equal(t, map[string]int{"todo": 0, "doing": 2, "done": 0}, flow.Points[2].Counts)
+		assert.Equal(t, map[string]int{"todo": 0, "doing": 1, "done": 1}, flow.Points[6].Counts)
+	})
+
+	t.Run("invalid options", func(t *testing.T) {
+		_, err := th.App.GetFlowMetrics(context.Background(), testBoardID, model.FlowMetricsOptions{PropertyID: "owner", From: from, To: to})
+		require.True(t, model.IsErrBadRequest(err))
+
+		_, err = th.App.GetFlowMetrics(context.Background(), testBoardID, model.FlowMetricsOptions{PropertyID: "status", From: from, To: to, DoneStatuses: []string{"unknown"}})
+		require.True(t, model.IsErrBadRequest(err))
+
+		_, err = th.App.GetFlowMetrics(context.Background(), testBoardID, model.FlowMetricsOptions{PropertyID: "status", From: to, To: from})
+		require.True(t, model.IsErrBadRequest(err))
+	})
+}
+
+func TestDurationDistribution(t *testing.T) {
+	assert.Equal(t, model.DurationDistribution{}, durationDistribution(nil))
+
+	durations := make([]int64, 0, 20)
+	for i := int64(20); i > 0; i-- {
+		durations = append(durations, i)
+	}
+	assert.Equal(t, model.DurationDistribution{
+		Count:  20,
+		Min:    1,
+		Max:    20,
+		Mean:   10,
+		Median: 10,
+		P85:    17,
+		P95:    19,
+	}, durationDistribution(durations))
+}
@@ -0,0 +1,171 @@
+// Copyright (c) 2020-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package app
+
+import (
+	"context"
+	"crypto/subtle"
+	"fmt"
+
+	"github.com/mattermost/mattermost-plugin-boards/server/model"
+	"github.com/mattermost/mattermost-plugin-boards/server/utils"
+
+	"github.com/mattermost/mattermost/server/public/shared/mlog"
+)
+
+func (a *App) GetBoardForm(ctx context.Context, boardID string) (*model.BoardForm, error) {
+	ctx, span := startSpan(ctx, "GetBoardForm")
+	defer span.End()
+
+	return a.store.GetBoardForm(ctx, boardID)
+}
+
+// SaveBoardForm creates or replaces the form of a board. The token of an existing form is kept
+// unless a new one is requested.
+func (a *App) SaveBoardForm(ctx context.Context, boardID string, form *model.BoardForm, regenerateToken bool, userID string) (*model.BoardForm, error) {
+	ctx, span := startSpan(ctx, "SaveBoardForm")
+	defer span.End()
+
+	board, err := a.store.GetBoard(ctx, boardID)
+	if err != nil {
+		return nil, err
+	}
+
+	schema, err := model.ParsePropertySchema(board)
+	if err != nil {
+		return nil, err
+	}
+
+	formSave := *form
+	formSave.BoardID = boardID
+	formSave.ModifiedBy = userID
+	formSave.Token = ""
+
+	existing, err := a.store.GetBoardForm(ctx, boardID)
+	if err != nil && !model.IsErrNotFound(err) {
+		return nil, err
+	}
+	if existing != nil && !regenerateToken {
+		formSave.Token = existing.Token
+	}
+	if formSave.Token == "" {
+		formSave.Token = utils.NewID(utils.IDTypeToken)
+	}
+
+	if err = formSave.IsValid(schema); err != nil {
+		return nil, model.NewErrBadRequest(err.Error())
+	}
+
+	if formSave.AuthorID != "" {
+		author, err := a.store.GetUserByID(ctx, formSave.AuthorID)
+		if model.IsErrNotFound(err) {
+			return nil, model.NewErrBadRequest(fmt.Sprintf("author %s not found", formSave.AuthorID))
+		}
+		if err != nil {
+			return nil, err
+		}
+		if !author.IsBot {
+			return nil, model.NewErrBadRequest("the author of form submissions must be a bot")
+		}
+	}
+
+	return a.store.UpsertBoardForm(ctx, &formSave)
+}
+
+// getEnabledBoardForm returns the form of a board if it accepts submissions with the specified
+// token. Any mismatch is reported as not found so tokens cannot be probed.
+func (a *App) getEnabledBoardForm(ctx context.Context, boardID, token string) (*model.BoardForm, error) {
+	notFound := model.NewErrNotFound("form for board ID=" + boardID)
+
+	if !a.config.EnablePublicSharedBoards || token == "" {
+		return nil, notFound
+	}
+
+	form, err := a.store.GetBoardForm(ctx, boardID)
+	if model.IsErrNotFound(err) {
+		return nil, notFound
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if !form.Enabled || subtle.ConstantTimeCompare([]byte(form.Token), []byte(token)) != 1 {
+		return nil, notFound
+	}
+	return form, nil
+}
+
+// GetPublicForm returns the form of a board as shown to people outside the board.
+func (a *App) GetPublicForm(ctx context.Context, boardID, token string) (*model.PublicForm, error) {
+	ctx, span := startSpan(ctx, "GetPublicForm")
+	defer span.End()
+
+	form, err := a.getEnabledBoardForm(ctx, boardID, token)
+	if err != nil {
+		return nil, err
+	}
+
+	board, err := a.store.GetBoard(ctx, boardID)
+	if err != nil {
+		return nil, err
+	}
+
+	schema, err := model.ParsePropertySchema(board)
+	if err != nil {
+		return nil, err
+	}
+
+	return form.Public(schema), nil
+}
+
+// SubmitForm creates a card from a submission to the form of a board. Submissions with a valid
+// token are rate limited per form and client address, and submissions caught by the honeypot are
+// dropped without error, in which case no card is returned.
+func (a *App) SubmitForm(ctx context.Context, boardID, token string, submission *model.FormSubmission, clientAddress string) (*model.Card, error) {
+	ctx, span := startSpan(ctx, "SubmitForm")
+	defer span.End()
+
+	form, err := a.getEnabledBoardForm(ctx, boardID, token)
+	if err != nil {
+		return nil, err
+	}
+
+	// the token is checked first so that requests without it cannot use up the quota of the
+	// people the form is shared with. The limit is read on each submission to follow the
+	// configuration.
+	limit := a.config.FormSubmissionsPerHour
+	if limit > 0 && !a.formLimiter.Allow(boardID+":"+clientAddress, limit) {
+		return nil, model.ErrTooManyRequests
+	}
+
+	if form.IsSpam(submission) {
+		a.logger.Debug("Dropping form submission caught by honeypot",
+			mlog.String("board_id", boardID),
+			mlog.String("client_address", clientAddress),
+		)
+		return nil, nil
+	}
+
+	board, err := a.store.GetBoard(ctx, boardID)
+	if err != nil {
+		return nil, err
+	}
+
+	schema, err := model.ParsePropertySchema(board)
+	if err != nil {
+		return nil, err
+	}
+
+	card, err := form.CardFromSubmission(submission, schema)
+	if err != nil {
+		return nil, model.NewErrBadRequest(err.Error())
+	}
+
+	authorID := form.AuthorID
+	if authorID == "" {
+		authorID = model.SystemUserID
+	}
+
+	return a.CreateCard(ctx, card, boardID, authorID, false)
+}
@@ -0,0 +1,216 @@
+// Copyright (c) 2020-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package app
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mattermost/mattermost-plugin-boards/server/model"
+	"github.com/mattermost/mattermost-plugin-boards/server/utils"
+)
+
+func formTestBoard() *model.Board {
+	return &model.Board{
+		ID:     utils.NewID(utils.IDTypeBoard),
+		TeamID: "team-id",
+		CardProperties: []map[string]interface{}{
+			{"id": "text", "name": "Description", "type": "text"},
+			{"id": "owner", "name": "Owner", "type": "person"},
+		},
+	}
+}
+
+func TestSaveBoardForm(t *testing.T) {
+	th, tearDown := SetupTestHelper(t)
+	defer tearDown()
+
+	board := formTestBoard()
+	userID := utils.NewID(utils.IDTypeUser)
+
+	t.Run("new form gets a token", func(t *testing.T) {
+		th.Store.EXPECT().GetBoard(gomock.Any(), board.ID).Return(board, nil)
+		th.Store.EXPECT().GetBoardForm(gomock.Any(), board.ID).Return(nil, model.NewErrNotFound("form"))
+		th.Store.EXPECT().UpsertBoardForm(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, form *model.BoardForm) (*model.BoardForm, error) {
+			return form, nil
+		})
+
+		form, err := th.App.SaveBoardForm(context.Background(), board.ID, &model.BoardForm{
+			Enabled: true,
+			Token:   "ignored",
+			Fields:  []model.BoardFormField{{PropertyID: "text", Required: true}},
+		}, false, userID)
+		require.NoError(t, err)
+		assert.Equal(t, board.ID, form.BoardID)
+		assert.Equal(t, userID, form.ModifiedBy)
+		assert.NotEmpty(t, form.Token)
+		assert.NotEqual(t, "ignored", form.Token)
+	})
+
+	t.Run("existing token is kept unless regenerated", func(t *testing.T) {
+		existing := &model.BoardForm{BoardID: board.ID, Token: "existing-token"}
+		th.Store.EXPECT().GetBoard(gomock.Any(), board.ID).Return(board, nil).Times(2)
+		th.Store.EXPECT().GetBoardForm(gomock.Any(), board.ID).Return(existing, nil).Times(2)
+		th.Store.EXPECT().UpsertBoardForm(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, form *model.BoardForm) (*model.BoardForm, error) {
+			return form, nil
+		}).Times(2)
+
+		form, err := th.App.SaveBoardForm(context.Background(), board.ID, &model.BoardForm{}, false, userID)
+		require.NoError(t, err)
+		assert.Equal(t, "existing-token", form.Token)
+
+		form, err = th.App.SaveBoardForm(context.Background(), board.ID, &model.BoardForm{}, true, userID)
+		require.NoError(t, err)
+		assert.NotEqual(t, "existing-token", form.Token)
+	})
+
+	t.Run("invalid fields", func(t *testing.T) {
+		th.Store.EXPECT().GetBoard(gomock.Any(), board.ID).Return(board, nil)
+		th.Store.EXPECT().GetBoardForm(gomock.Any(), board.ID).Return(nil, model.NewErrNotFound("form"))
+
+		_, err := th.App.SaveBoardForm(context.Background(), board.ID, &model.BoardForm{
+			Fields: []model.BoardFormField{{PropertyID: "owner"}},
+		}, false, userID)
+		require.True(t, model.IsErrBadRequest(err))
+	})
+
+	t.Run("author must be a bot", func(t *testing.T) {
+		th.Store.EXPECT().GetBoard(gomock.Any(), board.ID).Return(board, nil)
+		th.Store.EXPECT().GetBoardForm(gomock.Any(), board.ID).Return(nil, model.NewErrNotFound("form"))
+		th.Store.EXPECT().GetUserByID(gomock.Any(), "user-id").Return(&model.User{ID: "user-id"}, nil)
+
+		_, err := th.App.SaveBoardForm(context.Background(), board.ID, &model.BoardForm{AuthorID: "user-id"}, false, userID)
+		require.True(t, model.IsErrBadRequest(err))
+	})
+}
+
+func TestSubmitForm(t *testing.T) {
+	th, tearDown := SetupTestHelper(t)
+	defer tearDown()
+
+	th.App.config.EnablePublicSharedBoards = true
+	th.App.config.FormSubmissionsPerHour = 2
+
+	board := formTestBoard()
+	form := &model.BoardForm{
+		BoardID:  board.ID,
+		Token:    "form-token",
+		Enabled:  true,
+		AuthorID: "bot-id",
+		Honeypot: true,
+		Fields:   []model.BoardFormField{{PropertyID: "text", Required: true}},
+	}
+	th.Store.EXPECT().GetMembersForBoard(gomock.Any(), board.ID).Return([]*model.BoardMember{}, nil).AnyTimes()
+
+	t.Run("invalid token", func(t *testing.T) {
+		th.Store.EXPECT().GetBoardForm(gomock.Any(), board.ID).Return(form, nil)
+
+		_, err := th.App.SubmitForm(context.Background(), board.ID, "bad-token", &model.FormSubmission{Title: "title"}, "10.0.0.1")
+		require.True(t, model.IsErrNotFound(err))
+	})
+
+	t.Run("creates a card authored by the form bot", func(t *testing.T) {
+		th.Store.EXPECT().GetBoardForm(gomock.Any(), board.ID).Return(form, nil)
+		th.Store.EXPECT().GetBoard(gomock.Any(), board.ID).Return(board, nil).Times(2)
+		th.Store.EXPECT().GetBlock(gomock.Any(), gomock.Any()).Return(nil, model.NewErrNotFound("block"))
+		th.Store.EXPECT().InsertBlock(gomock.Any(), gomock.Any(), "bot-id").Return(nil)
+
+		card, err := th.App.SubmitForm(context.Background(), board.ID, "form-token", &model.FormSubmission{
+			Title:      "Request",
+			Properties: map[string]any{"text": "Please help"},
+		}, "10.0.0.2")
+		require.NoError(t, err)
+		assert.Equal(t, "bot-id", card.CreatedBy)
+		assert.Equal(t, "Request", card.Title)
+		assert.Equal(t, "Please help", card.Properties["text"])
+	})
+
+	t.Run("invalid submission", func(t *testing.T) {
+		th.Store.EXPECT().GetBoardForm(gomock.Any(), board.ID).Return(form, nil)
+		th.Store.EXPECT().GetBoard(gomock.Any(), board.ID).Return(board, nil)
+
+		_, err := th.App.SubmitForm(context.Background(), board.ID, "form-token", &model.FormSubmission{Title: "Request"}, "10.0.0.3")
+		require.True(t, model.IsErrBadRequest(err))
+	})
+
+	t.Run("honeypot submissions are dropped", func(t *testing.T) {
+		th.Store.EXPECT().GetBoardForm(gomock.Any(), board.ID).Return(form, nil)
+
+		card, err := th.App.SubmitForm(context.Background(), board.ID, "form-token", &model.FormSubmission{
+			Title:      "Request",
+			Properties: map[string]any{"text": "spam"},
+			Website:    "http://spam.example.com",
+		}, "10.0.0.4")
+		require.NoError(t, err)
+		require.Nil(t, card)
+	})
+
+	t.Run("rate limited", func(t *testing.T) {
+		th.Store.EXPECT().GetBoardForm(gomock.Any(), board.ID).Return(form, nil).Times(3)
+
+		spam := &model.FormSubmission{Website: "http://spam.example.com"}
+		for i := 0; i < 2; i++ {
+			_, err := th.App.SubmitForm(context.Background(), board.ID, "form-token", spam, "10.0.0.5")
+			require.NoError(t, err)
+		}
+		_, err := th.App.SubmitForm(context.Background(), board.ID, "form-token", spam, "10.0.0.5")
+		require.True(t, model.IsErrTooManyRequests(err))
+	})
+
+	t.Run("invalid tokens do not use up the quota", func(t *testing.T) {
+		th.Store.EXPECT().GetBoardForm(gomock.Any(), board.ID).Return(form, nil).Times(4)
+
+		for i := 0; i < 3; i++ {
+			_, err := th.App.SubmitForm(context.Background(), board.ID, "bad-token", &model.FormSubmission{}, "10.0.0.6")
+			require.True(t, model.IsErrNotFound(err))
+		}
+		card, err := th.App.SubmitForm(context.Background(), board.ID, "form-token", &model.FormSubmission{Website: "http://spam.example.com"}, "10.0.0.6")
+		require.NoError(t, err)
+		require.Nil(t, card)
+	})
+
+	t.Run("limit changed at runtime", func(t *testing.T) {
+		th.Store.EXPECT().GetBoardForm(gomock.Any(), board.ID).Return(form, nil).AnyTimes()
+		defer th.App.SetConfig(th.App.GetConfig())
+
+		spam := &model.FormSubmission{Website: "http://spam.example.com"}
+		submit := func() error {
+			_, err := th.App.SubmitForm(context.Background(), board.ID, "form-token", spam, "10.0.0.7")
+			return err
+		}
+		setLimit := func(limit int) {
+			cfg := *th.App.GetConfig()
+			cfg.FormSubmissionsPerHour = limit
+			th.App.SetConfig(&cfg)
+		}
+
+		// without a limit, submissions are not counted.
+		setLimit(0)
+		for i := 0; i < 3; i++ {
+			require.NoError(t, submit())
+		}
+
+		setLimit(2)
+		require.NoError(t, submit())
+		require.NoError(t, submit())
+		require.True(t, model.IsErrTooManyRequests(submit()))
+
+		// raising the limit applies to the current window.
+		setLimit(3)
+		require.NoError(t, submit())
+		require.True(t, model.IsErrTooManyRequests(submit()))
+	})
+
+	t.Run("public sharing disabled", func(t *testing.T) {
+		th.App.config.EnablePublicSharedBoards = false
+		defer func() { th.App.config.EnablePublicSharedBoards = true }()
+
+		_, err := th.App.GetPublicForm(context.Background(), board.ID, "form-token")
+		require.True(t, model.IsErrNotFound(err))
+	})
+}
@@ -6,6 +6,7 @@ package app
 import (
 	"bufio"
 	"bytes"
+	"context"
 	"encoding/json"
 	"errors"
 	"fmt"
@@ -38,13 +39,16 @@ var (
 //
 // Archives are ZIP files containing a `version.json` file and zero or more
 // directories, each containing a `board.jsonl` and zero or more image files.
-func (a *App) ImportArchive(r io.Reader, opt model.ImportArchiveOptions) error {
+func (a *App) ImportArchive(ctx context.Context, r io.Reader, opt model.ImportArchiveOptions) error {
+	ctx, span := startSpan(ctx, "ImportArchive")
+	defer span.End()
+
 	// peek at the first bytes to see if this is a legacy archive format
 	br := bufio.NewReader(r)
 	peek, err := br.Peek(len(legacyFileBegin))
 	if err == nil && string(peek) == legacyFileBegin {
 		a.logger.Debug("importing legacy archive")
-		_, errImport := a.ImportBoardJSONL(br, opt)
+		_, errImport := a.ImportBoardJSONL(ctx, br, opt)
 
 		return errImport
 	}
@@ -58,7 +62,7 @@ func (a *App) ImportArchive(r io.Reader, opt model.ImportArchiveOptions) error {
 		hdr, err := zr.Next()
 		if err != nil {
 			if errors.Is(err, io.EOF) {
-				a.fixImagesAttachments(boardMap, fileMap, opt.TeamID, opt.ModifiedBy)
+				a.fixImagesAttachments(ctx, boardMap, fileMap, opt.TeamID, opt.ModifiedBy)
 				a.logger.Debug("import archive - done", mlog.Int("boards_imported", len(boardMap)))
 				return nil
 			}
@@ -78,12 +82,17 @@ func (a *App) ImportArchive(r io.Reader, opt model.ImportArchiveOptions) error {
 				return model.NewErrUnsupportedArchiveVersion(ver, archiveVersion)
 			}
 		case "board.jsonl":
-			board, err := a.ImportBoardJSONL(zr, opt)
+			board, err := a.ImportBoardJSONL(ctx, zr, opt)
 			if err != nil {
 				return fmt.Errorf("cannot import board %s: %w", dir, err)
 			}
 			boardMap[dir] = board
 		default:
+			// derivatives are regenerated when the file they belong to is imported.
+			if model.IsFileDerivativeName(filename) {
+				continue
+			}
+
 			// import file/image;  dir is the old board id
 
 			board, ok := boardMap[dir]
@@ -94,7 +103,16 @@ func (a *App) ImportArchive(r io.Reader, opt model.ImportArchiveOptions) error {
 				)
 				continue
 			}
-			newFileName, err := a.SaveFile(zr, opt.TeamID, board.ID, filename, board.IsTemplate)
+			newFileName, err := a.SaveFile(ctx, zr, opt.TeamID, board.ID, filename, board.IsTemplate)
+			var infected *model.ErrFileInfected
+			if errors.As(err, &infected) {
+				a.logger.Warn("skipping infected file in archive",
+					mlog.String("dir", dir),
+					mlog.String("filename", filename),
+					mlog.String("signature", infected.Signature),
+				)
+				continue
+			}
 			if err != nil {
 				return fmt.Errorf("cannot import file %s for board %s: %w", filename, dir, err)
 			}
@@ -111,7 +129,7 @@ func (a *App) ImportArchive(r io.Reader, opt model.ImportArchiveOptions) error {
 }
 
 // Update image and attachment blocks.
-func (a *App) fixImagesAttachments(boardMap map[string]*model.Board, fileMap map[string]string, teamID string, userID string) {
+func (a *App) fixImagesAttachments(ctx context.Context, boardMap map[string]*model.Board, fileMap map[string]string, teamID string, userID string) {
 	blockIDs := make([]string, 0)
 	blockPatches := make([]model.BlockPatch, 0)
 	for _, board := range boardMap {
@@ -122,7 +140,7 @@ func (a *App) fixImagesAttachments(boardMap map[string]*model.Board, fileMap map
 		opts := model.QueryBlocksOptions{
 			BoardID: board.ID,
 		}
-		newBlocks, err := a.store.GetBlocks(opts)
+		newBlocks, err := a.store.GetBlocks(ctx, opts)
 		if err != nil {
 			a.logger.Info("cannot retrieve imported blocks for board", mlog.String("BoardID", board.ID), mlog.Err(err))
 			return
@@ -134,16 +152,20 @@ func (a *App) fixImagesAttachments(boardMap map[string]*model.Board, fileMap map
 				oldID := block.Fields[fieldName]
 				blockIDs = append(blockIDs, block.ID)
 
+				updatedFields := map[string]interface{}{
+					fieldName: fileMap[oldID.(string)],
+				}
+				if versions, ok := remapAttachmentVersionFiles(block, fileMap); ok {
+					updatedFields[model.BlockFieldVersions] = versions
+				}
 				blockPatches = append(blockPatches, model.BlockPatch{
-					UpdatedFields: map[string]interface{}{
-						fieldName: fileMap[oldID.(string)],
-					},
+					UpdatedFields: updatedFields,
 				})
 			}
 		}
 
 		blockPatchBatch := model.BlockPatchBatch{BlockIDs: blockIDs, BlockPatches: blockPatches}
-		err = a.PatchBlocks(teamID, &blockPatchBatch, userID)
+		err = a.PatchBlocks(ctx, teamID, &blockPatchBatch, userID)
 		if err != nil {
 			a.logger.Info("Error patching blocks for image import", mlog.Err(err))
 		}
@@ -152,7 +174,10 @@ func (a *App) fixImagesAttachments(boardMap map[string]*model.Board, fileMap map
 
 // ImportBoardJSONL imports a JSONL file containing blocks for one board. The resulting
 // board id is returned.
-func (a *App) ImportBoardJSONL(r io.Reader, opt model.ImportArchiveOptions) (*model.Board, error) {
+func (a *App) ImportBoardJSONL(ctx context.Context, r io.Reader, opt model.ImportArchiveOptions) (*model.Board, error) {
+	ctx, span := startSpan(ctx, "ImportBoardJSONL")
+	defer span.End()
+
 	// TODO: Stream this once `model.GenerateBlockIDs` can take a stream of blocks.
 	//       We don't want to load the whole file in memory, even though it's a single board.
 	boardsAndBlocks := &model.BoardsAndBlocks{
@@ -255,7 +280,7 @@ func (a *App) ImportBoardJSONL(r io.Reader, opt model.ImportArchiveOptions) (*mo
 
 	// loop to remove the people how are not part of the team and system
 	for i := len(boardMembers) - 1; i >= 0; i-- {
-		if _, err := a.GetUser(boardMembers[i].UserID); err != nil {
+		if _, err := a.GetUser(ctx, boardMembers[i].UserID); err != nil {
 			boardMembers = append(boardMembers[:i], boardMembers[i+1:]...)
 		}
 	}
@@ -268,12 +293,12 @@ func (a *App) ImportBoardJSONL(r io.Reader, opt model.ImportArchiveOptions) (*mo
 		return nil, fmt.Errorf("error generating archive block IDs: %w", err)
 	}
 
-	boardsAndBlocks, err = a.CreateBoardsAndBlocks(boardsAndBlocks, opt.ModifiedBy, false)
+	boardsAndBlocks, err = a.CreateBoardsAndBlocks(ctx, boardsAndBlocks, opt.ModifiedBy, false)
 	if err != nil {
 		return nil, fmt.Errorf("error inserting archive blocks: %w", err)
 	}
 
-	if err := a.addUserToNewBoard(boardsAndBlocks, opt, boardMembers); err != nil {
+	if err := a.addUserToNewBoard(ctx, boardsAndBlocks, opt, boardMembers); err != nil {
 		return nil, err
 	}
 
@@ -284,7 +309,7 @@ func (a *App) ImportBoardJSONL(r io.Reader, opt model.ImportArchiveOptions) (*mo
 	return nil, fmt.Errorf("missing board in archive: %w", model.ErrInvalidBoardBlock)
 }
 
-func (a *App) addUserToNewBoard(boardsAndBlocks *model.BoardsAndBlocks, opt model.ImportArchiveOptions, boardMembers []*model.BoardMember) error {
+func (a *App) addUserToNewBoard(ctx context.Context, boardsAndBlocks *model.BoardsAndBlocks, opt model.ImportArchiveOptions, boardMembers []*model.BoardMember) error {
 	// add users to all the new boards (if not the fake system user).
 	for _, board := range boardsAndBlocks.Boards {
 		// make sure an admin user gets added
@@ -293,7 +318,7 @@ func (a *App) addUserToNewBoard(boardsAndBlocks *model.BoardsAndBlocks, opt mode
 			UserID:      opt.ModifiedBy,
 			SchemeAdmin: true,
 		}
-		if _, err2 := a.AddMemberToBoard(adminMember); err2 != nil {
+		if _, err2 := a.AddMemberToBoard(ctx, adminMember); err2 != nil {
 			return fmt.Errorf("cannot add adminMember to board: %w", err2)
 		}
 		for _, boardMember := range boardMembers {
@@ -308,7 +333,7 @@ func (a *App) addUserToNewBoard(boardsAndBlocks *model.BoardsAndBlocks, opt mode
 				SchemeViewer:    boardMember.SchemeViewer,
 				Synthetic:       boardMember.Synthetic,
 			}
-			if _, err2 := a.AddMemberToBoard(bm); err2 != nil {
+			if _, err2 := a.AddMemberToBoard(ctx, bm); err2 != nil {
 				return fmt.Errorf("cannot add member to board: %w", err2)
 			}
 		}
@@ -5,6 +5,7 @@ package app
 
 import (
 	"bytes"
+	"context"
 	"testing"
 
 	"github.com/mattermost/mattermost-plugin-boards/server/utils"
@@ -48,11 +49,11 @@ func TestApp_ImportArchive(t *testing.T) {
 			ModifiedBy: "user",
 		}
 
-		th.Store.EXPECT().CreateBoardsAndBlocks(gomock.AssignableToTypeOf(&model.BoardsAndBlocks{}), "user").Return(babs, nil)
-		th.Store.EXPECT().GetMembersForBoard(board.ID).AnyTimes().Return([]*model.BoardMember{boardMember}, nil)
-		th.Store.EXPECT().GetBoard(board.ID).Return(board, nil)
-		th.Store.EXPECT().GetMemberForBoard(board.ID, "user").Return(boardMember, nil)
-		th.Store.EXPECT().GetUserCategoryBoards("user", "test-team").Return([]model.CategoryBoards{
+		th.Store.EXPECT().CreateBoardsAndBlocks(gomock.Any(), gomock.AssignableToTypeOf(&model.BoardsAndBlocks{}), "user").Return(babs, nil)
+		th.Store.EXPECT().GetMembersForBoard(gomock.Any(), board.ID).AnyTimes().Return([]*model.BoardMember{boardMember}, nil)
+		th.Store.EXPECT().GetBoard(gomock.Any(), board.ID).Return(board, nil)
+		th.Store.EXPECT().GetMemberForBoard(gomock.Any(), board.ID, "user").Return(boardMember, nil)
+		th.Store.EXPECT().GetUserCategoryBoards(gomock.Any(), "user", "test-team").Return([]model.CategoryBoards{
 			{
 				Category: model.Category{
 					Type: "default",
@@ -61,17 +62,17 @@ func TestApp_ImportArchive(t *testing.T) {
 				},
 			},
 		}, nil)
-		th.Store.EXPECT().GetUserCategoryBoards("user", "test-team")
-		th.Store.EXPECT().CreateCategory(utils.Anything).Return(nil)
-		th.Store.EXPECT().GetCategory(utils.Anything).Return(&model.Category{
+		th.Store.EXPECT().GetUserCategoryBoards(gomock.Any(), "user", "test-team")
+		th.Store.EXPECT().CreateCategory(gomock.Any(), utils.Anything).Return(nil)
+		th.Store.EXPECT().GetCategory(gomock.Any(), utils.Anything).Return(&model.Category{
 			ID:   "boards_category_id",
 			Name: "Boards",
 		}, nil)
-		th.Store.EXPECT().GetBoardsForUserAndTeam("user", "test-team", false).Return([]*model.Board{}, nil)
-		th.Store.EXPECT().GetMembersForUser("user").Return([]*model.BoardMember{}, nil)
-		th.Store.EXPECT().AddUpdateCategoryBoard("user", utils.Anything, utils.Anything).Return(nil)
+		th.Store.EXPECT().GetBoardsForUserAndTeam(gomock.Any(), "user", "test-team", false).Return([]*model.Board{}, nil)
+		th.Store.EXPECT().GetMembersForUser(gomock.Any(), "user").Return([]*model.BoardMember{}, nil)
+		th.Store.EXPECT().AddUpdateCategoryBoard(gomock.Any(), "user", utils.Anything, utils.Anything).Return(nil)
 
-		err := th.App.ImportArchive(r, opts)
+		err := th.App.ImportArchive(context.Background(), r, opts)
 		require.NoError(t, err, "import archive should not fail")
 	})
 
@@ -109,10 +110,10 @@ func TestApp_ImportArchive(t *testing.T) {
 			ID: "nto73edn5ir6ifimo5a53y1dwa",
 		}
 
-		th.Store.EXPECT().CreateBoardsAndBlocks(gomock.AssignableToTypeOf(&model.BoardsAndBlocks{}), "f1tydgc697fcbp8ampr6881jea").Return(babs, nil)
-		th.Store.EXPECT().GetMembersForBoard(board.ID).AnyTimes().Return([]*model.BoardMember{bm1, bm2, bm3}, nil)
-		th.Store.EXPECT().GetUserCategoryBoards("f1tydgc697fcbp8ampr6881jea", "test-team").Return([]model.CategoryBoards{}, nil)
-		th.Store.EXPECT().GetUserCategoryBoards("f1tydgc697fcbp8ampr6881jea", "test-team").Return([]model.CategoryBoards{
+		th.Store.EXPECT().CreateBoardsAndBlocks(gomock.Any(), gomock.AssignableToTypeOf(&model.BoardsAndBlocks{}), "f1tydgc697fcbp8ampr6881jea").Return(babs, nil)
+		th.Store.EXPECT().GetMembersForBoard(gomock.Any(), board.ID).AnyTimes().Return([]*model.BoardMember{bm1, bm2, bm3}, nil)
+		th.Store.EXPECT().GetUserCategoryBoards(gomock.Any(), "f1tydgc697fcbp8ampr6881jea", "test-team").Return([]model.CategoryBoards{}, nil)
+		th.Store.EXPECT().GetUserCategoryBoards(gomock.Any(), "f1tydgc697fcbp8ampr6881jea", "test-team").Return([]model.CategoryBoards{
 			{
 				Category: model.Category{
 					ID:   "boards_category_id",
@@ -121,23 +122,23 @@ func TestApp_ImportArchive(t *testing.T) {
 				},
 			},
 		}, nil)
-		th.Store.EXPECT().CreateCategory(utils.Anything).Return(nil)
-		th.Store.EXPECT().GetCategory(utils.Anything).Return(&model.Category{
+		th.Store.EXPECT().CreateCategory(gomock.Any(), utils.Anything).Return(nil)
+		th.Store.EXPECT().GetCategory(gomock.Any(), utils.Anything).Return(&model.Category{
 			ID:   "boards_category_id",
 			Name: "Boards",
 		}, nil)
-		th.Store.EXPECT().GetMembersForUser("f1tydgc697fcbp8ampr6881jea").Return([]*model.BoardMember{}, nil)
-		th.Store.EXPECT().GetBoardsForUserAndTeam("f1tydgc697fcbp8ampr6881jea", "test-team", false).Return([]*model.Board{}, nil)
-		th.Store.EXPECT().AddUpdateCategoryBoard("f1tydgc697fcbp8ampr6881jea", utils.Anything, utils.Anything).Return(nil)
-		th.Store.EXPECT().GetBoard(board.ID).AnyTimes().Return(board, nil)
-		th.Store.EXPECT().GetMemberForBoard(board.ID, "f1tydgc697fcbp8ampr6881jea").AnyTimes().Return(bm1, nil)
-		th.Store.EXPECT().GetMemberForBoard(board.ID, "hxxzooc3ff8cubsgtcmpn8733e").AnyTimes().Return(bm2, nil)
-		th.Store.EXPECT().GetMemberForBoard(board.ID, "nto73edn5ir6ifimo5a53y1dwa").AnyTimes().Return(bm3, nil)
-		th.Store.EXPECT().GetUserByID("f1tydgc697fcbp8ampr6881jea").AnyTimes().Return(user1, nil)
-		th.Store.EXPECT().GetUserByID("hxxzooc3ff8cubsgtcmpn8733e").AnyTimes().Return(user2, nil)
-		th.Store.EXPECT().GetUserByID("nto73edn5ir6ifimo5a53y1dwa").AnyTimes().Return(user3, nil)
-
-		newBoard, err := th.App.ImportBoardJSONL(r, opts)
+		th.Store.EXPECT().GetMembersForUser(gomock.Any(), "f1tydgc697fcbp8ampr6881jea").Return([]*model.BoardMember{}, nil)
+		th.Store.EXPECT().GetBoardsForUserAndTeam(gomock.Any(), "f1tydgc697fcbp8ampr6881jea", "test-team", false).Return([]*model.Board{}, nil)
+		th.Store.EXPECT().AddUpdateCategoryBoard(gomock.Any(), "f1tydgc697fcbp8ampr6881jea", utils.Anything, utils.Anything).Return(nil)
+		th.Store.EXPECT().GetBoard(gomock.Any(), board.ID).AnyTimes().Return(board, nil)
+		th.Store.EXPECT().GetMemberForBoard(gomock.Any(), board.ID, "f1tydgc697fcbp8ampr6881jea").AnyTimes().Return(bm1, nil)
+		th.Store.EXPECT().GetMemberForBoard(gomock.Any(), board.ID, "hxxzooc3ff8cubsgtcmpn8733e").AnyTimes().Return(bm2, nil)
+		th.Store.EXPECT().GetMemberForBoard(gomock.Any(), board.ID, "nto73edn5ir6ifimo5a53y1dwa").AnyTimes().Return(bm3, nil)
+		th.Store.EXPECT().GetUserByID(gomock.Any(), "f1tydgc697fcbp8ampr6881jea").AnyTimes().Return(user1, nil)
+		th.Store.EXPECT().GetUserByID(gomock.Any(), "hxxzooc3ff8cubsgtcmpn8733e").AnyTimes().Return(user2, nil)
+		th.Store.EXPECT().GetUserByID(gomock.Any(), "nto73edn5ir6ifimo5a53y1dwa").AnyTimes().Return(user3, nil)
+
+		newBoard, err := th.App.ImportBoardJSONL(context.Background(), r, opts)
 		require.NoError(t, err, "import archive should not fail")
 		require.Equal(t, board.ID, newBoard.ID, "Board ID should be same")
 	})
@@ -199,14 +200,14 @@ func TestApp_ImportArchive(t *testing.T) {
 		opts := model.QueryBlocksOptions{
 			BoardID: board.ID,
 		}
-		th.Store.EXPECT().GetBlocks(opts).Return([]*model.Block{imageBlock, attachmentBlock}, nil)
-		th.Store.EXPECT().GetBlocksByIDs(blockIDs).Return([]*model.Block{imageBlock, attachmentBlock}, nil)
-		th.Store.EXPECT().GetBlock(blockIDs[0]).Return(imageBlock, nil)
-		th.Store.EXPECT().GetBlock(blockIDs[1]).Return(attachmentBlock, nil)
-		th.Store.EXPECT().GetMembersForBoard("board-id").AnyTimes().Return([]*model.BoardMember{}, nil)
-
-		th.Store.EXPECT().PatchBlocks(&blockPatchesBatch, "my-userid")
-		th.App.fixImagesAttachments(boardMap, fileMap, "test-team", "my-userid")
+		th.Store.EXPECT().GetBlocks(gomock.Any(), opts).Return([]*model.Block{imageBlock, attachmentBlock}, nil)
+		th.Store.EXPECT().GetBlocksByIDs(gomock.Any(), blockIDs).Return([]*model.Block{imageBlock, attachmentBlock}, nil)
+		th.Store.EXPECT().GetBlock(gomock.Any(), blockIDs[0]).Return(imageBlock, nil)
+		th.Store.EXPECT().GetBlock(gomock.Any(), blockIDs[1]).Return(attachmentBlock, nil)
+		th.Store.EXPECT().GetMembersForBoard(gomock.Any(), "board-id").AnyTimes().Return([]*model.BoardMember{}, nil)
+
+		th.Store.EXPECT().PatchBlocks(gomock.Any(), &blockPatchesBatch, "my-userid")
+		th.App.fixImagesAttachments(context.Background(), boardMap, fileMap, "test-team", "my-userid")
 	})
 }
 
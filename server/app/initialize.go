@@ -10,9 +10,9 @@ import (
 )
 
 // initialize is called when the App is first created.
-func (a *App) initialize(skipTemplateInit bool) {
+func (a *App) initialize(ctx context.Context, skipTemplateInit bool) {
 	if !skipTemplateInit {
-		if err := a.InitTemplates(); err != nil {
+		if err := a.InitTemplates(ctx); err != nil {
 			a.logger.Error(`InitializeTemplates failed`, mlog.Err(err))
 		}
 	}
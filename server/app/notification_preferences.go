@@ -0,0 +1,46 @@
+// Copyright (c) 2020-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package app
+
+import (
+	"context"
+
+	"github.com/mattermost/mattermost-plugin-boards/server/model"
+)
+
+// GetNotificationPreferences returns the notification preferences a user has for a board, or
+// the defaults if the user has never changed them.
+func (a *App) GetNotificationPreferences(ctx context.Context, userID, boardID string) (*model.NotificationPreferences, error) {
+	ctx, span := startSpan(ctx, "GetNotificationPreferences")
+	defer span.End()
+
+	prefs, err := a.store.GetNotificationPreferences(ctx, userID, boardID)
+	if model.IsErrNotFound(err) {
+		return model.NewDefaultNotificationPreferences(userID, boardID), nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return prefs, nil
+}
+
+// PatchNotificationPreferences applies a patch to the notification preferences a user has for a board.
+func (a *App) PatchNotificationPreferences(ctx context.Context, userID, boardID string, patch *model.NotificationPreferencesPatch) (*model.NotificationPreferences, error) {
+	ctx, span := startSpan(ctx, "PatchNotificationPreferences")
+	defer span.End()
+
+	prefs, err := a.GetNotificationPreferences(ctx, userID, boardID)
+	if err != nil {
+		return nil, err
+	}
+	return a.store.UpsertNotificationPreferences(ctx, patch.Patch(prefs))
+}
+
+// ResetNotificationPreferences reverts the notification preferences a user has for a board to the defaults.
+func (a *App) ResetNotificationPreferences(ctx context.Context, userID, boardID string) error {
+	ctx, span := startSpan(ctx, "ResetNotificationPreferences")
+	defer span.End()
+
+	return a.store.DeleteNotificationPreferences(ctx, userID, boardID)
+}
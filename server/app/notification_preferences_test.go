@@ -0,0 +1,66 @@
+// Copyright (c) 2020-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package app
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mattermost/mattermost-plugin-boards/server/model"
+	"github.com/mattermost/mattermost-plugin-boards/server/utils"
+)
+
+func TestGetNotificationPreferences(t *testing.T) {
+	th, tearDown := SetupTestHelper(t)
+	defer tearDown()
+
+	boardID := utils.NewID(utils.IDTypeBoard)
+
+	t.Run("should return defaults when none saved", func(t *testing.T) {
+		th.Store.EXPECT().GetNotificationPreferences(gomock.Any(), "user-id", boardID).Return(nil, model.NewErrNotFound("prefs"))
+
+		prefs, err := th.App.GetNotificationPreferences(context.Background(), "user-id", boardID)
+		require.NoError(t, err)
+		require.True(t, prefs.IsDefault())
+		require.Equal(t, boardID, prefs.BoardID)
+	})
+
+	t.Run("should return saved preferences", func(t *testing.T) {
+		saved := model.NewDefaultNotificationPreferences("user-id", boardID)
+		saved.Muted = true
+		th.Store.EXPECT().GetNotificationPreferences(gomock.Any(), "user-id", boardID).Return(saved, nil)
+
+		prefs, err := th.App.GetNotificationPreferences(context.Background(), "user-id", boardID)
+		require.NoError(t, err)
+		require.True(t, prefs.Muted)
+	})
+}
+
+func TestPatchNotificationPreferences(t *testing.T) {
+	th, tearDown := SetupTestHelper(t)
+	defer tearDown()
+
+	boardID := utils.NewID(utils.IDTypeBoard)
+	disabled := false
+	mentionsOnly := true
+
+	th.Store.EXPECT().GetNotificationPreferences(gomock.Any(), "user-id", boardID).Return(nil, model.NewErrNotFound("prefs"))
+	th.Store.EXPECT().UpsertNotificationPreferences(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, prefs *model.NotificationPreferences) (*model.NotificationPreferences, error) {
+			return prefs, nil
+		},
+	)
+
+	prefs, err := th.App.PatchNotificationPreferences(context.Background(), "user-id", boardID, &model.NotificationPreferencesPatch{
+		Comments:     &disabled,
+		MentionsOnly: &mentionsOnly,
+	})
+	require.NoError(t, err)
+	require.False(t, prefs.Comments)
+	require.True(t, prefs.MentionsOnly)
+	require.True(t, prefs.Properties)
+}
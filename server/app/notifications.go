@@ -0,0 +1,96 @@
+// Copyright (c) 2020-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package app
+
+import (
+	"context"
+
+	"github.com/mattermost/mattermost-plugin-boards/server/model"
+
+	"github.com/mattermost/mattermost/server/public/shared/mlog"
+)
+
+// CreateNotification adds a notification to a user's in-app inbox and pushes their new unread
+// count to connected clients.
+func (a *App) CreateNotification(ctx context.Context, notification *model.Notification) (*model.Notification, error) {
+	ctx, span := startSpan(ctx, "CreateNotification")
+	defer span.End()
+
+	notification, err := a.store.CreateNotification(ctx, notification)
+	if err != nil {
+		return nil, err
+	}
+
+	a.broadcastNotificationsUnreadCount(ctx, notification.UserID)
+	return notification, nil
+}
+
+// GetNotificationsForUser returns a page of a user's notifications along with their unread count.
+func (a *App) GetNotificationsForUser(ctx context.Context, userID string, opts model.QueryNotificationsOptions) (*model.NotificationList, error) {
+	ctx, span := startSpan(ctx, "GetNotificationsForUser")
+	defer span.End()
+
+	if opts.PerPage <= 0 {
+		opts.PerPage = model.NotificationsDefaultPerPage
+	}
+	if opts.PerPage > model.NotificationsMaxPerPage {
+		opts.PerPage = model.NotificationsMaxPerPage
+	}
+
+	notifications, err := a.store.GetNotificationsForUser(ctx, userID, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	unreadCount, err := a.store.GetUnreadNotificationCount(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &model.NotificationList{
+		Notifications: notifications,
+		UnreadCount:   unreadCount,
+	}, nil
+}
+
+// MarkNotificationRead marks one of a user's notifications as read.
+func (a *App) MarkNotificationRead(ctx context.Context, userID, notificationID string) error {
+	ctx, span := startSpan(ctx, "MarkNotificationRead")
+	defer span.End()
+
+	if err := a.store.MarkNotificationRead(ctx, userID, notificationID); err != nil {
+		return err
+	}
+
+	a.broadcastNotificationsUnreadCount(ctx, userID)
+	return nil
+}
+
+// MarkAllNotificationsRead marks all of a user's notifications as read.
+func (a *App) MarkAllNotificationsRead(ctx context.Context, userID string) error {
+	ctx, span := startSpan(ctx, "MarkAllNotificationsRead")
+	defer span.End()
+
+	if err := a.store.MarkAllNotificationsRead(ctx, userID); err != nil {
+		return err
+	}
+
+	a.broadcastNotificationsUnreadCount(ctx, userID)
+	return nil
+}
+
+func (a *App) broadcastNotificationsUnreadCount(ctx context.Context, userID string) {
+	unreadCount, err := a.store.GetUnreadNotificationCount(ctx, userID)
+	if err != nil {
+		a.logger.Error("Cannot count unread notifications; not broadcasting",
+			mlog.String("user_id", userID),
+			mlog.Err(err),
+		)
+		return
+	}
+
+	go func() {
+		a.wsAdapter.BroadcastNotificationsUnreadCount(userID, unreadCount)
+	}()
+}
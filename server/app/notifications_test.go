@@ -0,0 +1,92 @@
+// Copyright (c) 2020-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package app
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mattermost/mattermost-plugin-boards/server/model"
+)
+
+func TestGetNotificationsForUser(t *testing.T) {
+	th, tearDown := SetupTestHelper(t)
+	defer tearDown()
+
+	t.Run("should apply default page size", func(t *testing.T) {
+		notifications := []*model.Notification{{ID: "notification-id", UserID: "user-id"}}
+		th.Store.EXPECT().GetNotificationsForUser(gomock.Any(), "user-id", model.QueryNotificationsOptions{
+			PerPage: model.NotificationsDefaultPerPage,
+		}).Return(notifications, nil)
+		th.Store.EXPECT().GetUnreadNotificationCount(gomock.Any(), "user-id").Return(int64(1), nil)
+
+		list, err := th.App.GetNotificationsForUser(context.Background(), "user-id", model.QueryNotificationsOptions{})
+		require.NoError(t, err)
+		require.Equal(t, notifications, list.Notifications)
+		require.EqualValues(t, 1, list.UnreadCount)
+	})
+
+	t.Run("should clamp page size", func(t *testing.T) {
+		th.Store.EXPECT().GetNotificationsForUser(gomock.Any(), "user-id", model.QueryNotificationsOptions{
+			UnreadOnly: true,
+			Page:       2,
+			PerPage:    model.NotificationsMaxPerPage,
+		}).Return([]*model.Notification{}, nil)
+		th.Store.EXPECT().GetUnreadNotificationCount(gomock.Any(), "user-id").Return(int64(0), nil)
+
+		list, err := th.App.GetNotificationsForUser(context.Background(), "user-id", model.QueryNotificationsOptions{
+			UnreadOnly: true,
+			Page:       2,
+			PerPage:    model.NotificationsMaxPerPage + 1,
+		})
+		require.NoError(t, err)
+		require.Empty(t, list.Notifications)
+	})
+}
+
+func TestMarkNotificationRead(t *testing.T) {
+	th, tearDown := SetupTestHelper(t)
+	defer tearDown()
+
+	t.Run("should mark read and count unread", func(t *testing.T) {
+		th.Store.EXPECT().MarkNotificationRead(gomock.Any(), "user-id", "notification-id").Return(nil)
+		th.Store.EXPECT().GetUnreadNotificationCount(gomock.Any(), "user-id").Return(int64(3), nil)
+
+		require.NoError(t, th.App.MarkNotificationRead(context.Background(), "user-id", "notification-id"))
+	})
+
+	t.Run("should return not found", func(t *testing.T) {
+		th.Store.EXPECT().MarkNotificationRead(gomock.Any(), "user-id", "missing").Return(model.NewErrNotFound("notification"))
+
+		err := th.App.MarkNotificationRead(context.Background(), "user-id", "missing")
+		require.True(t, model.IsErrNotFound(err))
+	})
+
+	t.Run("should mark all read", func(t *testing.T) {
+		th.Store.EXPECT().MarkAllNotificationsRead(gomock.Any(), "user-id").Return(nil)
+		th.Store.EXPECT().GetUnreadNotificationCount(gomock.Any(), "user-id").Return(int64(0), nil)
+
+		require.NoError(t, th.App.MarkAllNotificationsRead(context.Background(), "user-id"))
+	})
+}
+
+func TestCreateNotification(t *testing.T) {
+	th, tearDown := SetupTestHelper(t)
+	defer tearDown()
+
+	notification := &model.Notification{
+		UserID:  "user-id",
+		Type:    model.NotificationTypeMention,
+		BoardID: "board-id",
+	}
+	th.Store.EXPECT().CreateNotification(gomock.Any(), gomock.Any()).Return(notification, nil)
+	th.Store.EXPECT().GetUnreadNotificationCount(gomock.Any(), "user-id").Return(int64(1), nil)
+
+	created, err := th.App.CreateNotification(context.Background(), notification)
+	require.NoError(t, err)
+	require.Equal(t, notification, created)
+}
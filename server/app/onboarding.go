@@ -4,6 +4,7 @@
 package app
 
 import (
+	"context"
 	"errors"
 
 	"github.com/mattermost/mattermost-plugin-boards/server/model"
@@ -27,9 +28,12 @@ var (
 	errCannotCreateBoard        = errors.New("new board wasn't created")
 )
 
-func (a *App) PrepareOnboardingTour(userID string, teamID string) (string, string, error) {
+func (a *App) PrepareOnboardingTour(ctx context.Context, userID string, teamID string) (string, string, error) {
+	ctx, span := startSpan(ctx, "PrepareOnboardingTour")
+	defer span.End()
+
 	// copy the welcome board into this workspace
-	boardID, err := a.createWelcomeBoard(userID, teamID)
+	boardID, err := a.createWelcomeBoard(ctx, userID, teamID)
 	if err != nil {
 		return "", "", err
 	}
@@ -42,15 +46,15 @@ func (a *App) PrepareOnboardingTour(userID string, teamID string) (string, strin
 			KeyOnboardingTourCategory: ValueTourCategoryOnboarding,
 		},
 	}
-	if _, err := a.store.PatchUserPreferences(userID, userPreferencesPatch); err != nil {
+	if _, err := a.store.PatchUserPreferences(ctx, userID, userPreferencesPatch); err != nil {
 		return "", "", err
 	}
 
 	return teamID, boardID, nil
 }
 
-func (a *App) getOnboardingBoardID() (string, error) {
-	boards, err := a.store.GetTemplateBoards(model.GlobalTeamID, "")
+func (a *App) getOnboardingBoardID(ctx context.Context) (string, error) {
+	boards, err := a.store.GetTemplateBoards(ctx, model.GlobalTeamID, "")
 	if err != nil {
 		return "", err
 	}
@@ -70,13 +74,13 @@ func (a *App) getOnboardingBoardID() (string, error) {
 	return onboardingBoardID, nil
 }
 
-func (a *App) createWelcomeBoard(userID, teamID string) (string, error) {
-	onboardingBoardID, err := a.getOnboardingBoardID()
+func (a *App) createWelcomeBoard(ctx context.Context, userID, teamID string) (string, error) {
+	onboardingBoardID, err := a.getOnboardingBoardID(ctx)
 	if err != nil {
 		return "", err
 	}
 
-	bab, _, err := a.DuplicateBoard(onboardingBoardID, userID, teamID, false)
+	bab, _, err := a.DuplicateBoard(ctx, onboardingBoardID, userID, teamID, false)
 	if err != nil {
 		return "", err
 	}
@@ -93,7 +97,7 @@ func (a *App) createWelcomeBoard(userID, teamID string) (string, error) {
 		Type: &newType,
 	}
 
-	if _, err := a.PatchBoard(patch, bab.Boards[0].ID, userID); err != nil {
+	if _, err := a.PatchBoard(ctx, patch, bab.Boards[0].ID, userID); err != nil {
 		return "", err
 	}
 
@@ -4,10 +4,12 @@
 package app
 
 import (
+	"context"
 	"testing"
 
 	"github.com/mattermost/mattermost-plugin-boards/server/utils"
 
+	"github.com/golang/mock/gomock"
 	"github.com/mattermost/mattermost-plugin-boards/server/model"
 	"github.com/stretchr/testify/assert"
 )
@@ -30,8 +32,8 @@ func TestPrepareOnboardingTour(t *testing.T) {
 			IsTemplate: true,
 		}
 
-		th.Store.EXPECT().GetTemplateBoards("0", "").Return([]*model.Board{&welcomeBoard}, nil)
-		th.Store.EXPECT().DuplicateBoard(welcomeBoard.ID, userID, teamID, false).Return(&model.BoardsAndBlocks{Boards: []*model.Board{
+		th.Store.EXPECT().GetTemplateBoards(gomock.Any(), "0", "").Return([]*model.Board{&welcomeBoard}, nil)
+		th.Store.EXPECT().DuplicateBoard(gomock.Any(), welcomeBoard.ID, userID, teamID, false).Return(&model.BoardsAndBlocks{Boards: []*model.Board{
 			{
 				ID:         "board_id_2",
 				Title:      "Boards에 오신 것을 환영합니다!",
@@ -40,11 +42,11 @@ func TestPrepareOnboardingTour(t *testing.T) {
 			},
 		}},
 			nil, nil)
-		th.Store.EXPECT().GetMembersForBoard(welcomeBoard.ID).Return([]*model.BoardMember{}, nil).Times(2)
-		th.Store.EXPECT().GetMembersForBoard("board_id_2").Return([]*model.BoardMember{}, nil).Times(1)
-		th.Store.EXPECT().GetBoard(welcomeBoard.ID).Return(&welcomeBoard, nil).Times(2)
-		th.Store.EXPECT().GetBoard("board_id_2").Return(&welcomeBoard, nil).Times(1)
-		th.Store.EXPECT().GetUsersByTeam("0", "", false, false).Return([]*model.User{}, nil)
+		th.Store.EXPECT().GetMembersForBoard(gomock.Any(), welcomeBoard.ID).Return([]*model.BoardMember{}, nil).Times(2)
+		th.Store.EXPECT().GetMembersForBoard(gomock.Any(), "board_id_2").Return([]*model.BoardMember{}, nil).Times(1)
+		th.Store.EXPECT().GetBoard(gomock.Any(), welcomeBoard.ID).Return(&welcomeBoard, nil).Times(2)
+		th.Store.EXPECT().GetBoard(gomock.Any(), "board_id_2").Return(&welcomeBoard, nil).Times(1)
+		th.Store.EXPECT().GetUsersByTeam(gomock.Any(), "0", "", false, false).Return([]*model.User{}, nil)
 
 		privateWelcomeBoard := model.Board{
 			ID:         "board_id_1",
@@ -54,8 +56,8 @@ func TestPrepareOnboardingTour(t *testing.T) {
 			Type:       model.BoardTypePrivate,
 		}
 		newType := model.BoardTypePrivate
-		th.Store.EXPECT().PatchBoard("board_id_2", &model.BoardPatch{Type: &newType}, "user_id_1").Return(&privateWelcomeBoard, nil)
-		th.Store.EXPECT().GetMembersForUser("user_id_1").Return([]*model.BoardMember{}, nil)
+		th.Store.EXPECT().PatchBoard(gomock.Any(), "board_id_2", &model.BoardPatch{Type: &newType}, "user_id_1").Return(&privateWelcomeBoard, nil)
+		th.Store.EXPECT().GetMembersForUser(gomock.Any(), "user_id_1").Return([]*model.BoardMember{}, nil)
 
 		userPreferencesPatch := model.UserPreferencesPatch{
 			UpdatedFields: map[string]string{
@@ -65,25 +67,25 @@ func TestPrepareOnboardingTour(t *testing.T) {
 			},
 		}
 
-		th.Store.EXPECT().PatchUserPreferences(userID, userPreferencesPatch).Return(nil, nil)
-		th.Store.EXPECT().GetUserCategoryBoards(userID, "team_id").Return([]model.CategoryBoards{}, nil).Times(1)
+		th.Store.EXPECT().PatchUserPreferences(gomock.Any(), userID, userPreferencesPatch).Return(nil, nil)
+		th.Store.EXPECT().GetUserCategoryBoards(gomock.Any(), userID, "team_id").Return([]model.CategoryBoards{}, nil).Times(1)
 
 		// when this is called the second time, the default category is created so we need to include that in the response list
-		th.Store.EXPECT().GetUserCategoryBoards(userID, "team_id").Return([]model.CategoryBoards{
+		th.Store.EXPECT().GetUserCategoryBoards(gomock.Any(), userID, "team_id").Return([]model.CategoryBoards{
 			{
 				Category: model.Category{ID: "boards_category_id", Name: "Boards"},
 			},
 		}, nil).Times(2)
 
-		th.Store.EXPECT().CreateCategory(utils.Anything).Return(nil).Times(1)
-		th.Store.EXPECT().GetCategory(utils.Anything).Return(&model.Category{
+		th.Store.EXPECT().CreateCategory(gomock.Any(), utils.Anything).Return(nil).Times(1)
+		th.Store.EXPECT().GetCategory(gomock.Any(), utils.Anything).Return(&model.Category{
 			ID:   "boards_category",
 			Name: "Boards",
 		}, nil)
-		th.Store.EXPECT().GetBoardsForUserAndTeam("user_id_1", teamID, false).Return([]*model.Board{}, nil)
-		th.Store.EXPECT().AddUpdateCategoryBoard("user_id_1", "boards_category_id", []string{"board_id_2"}).Return(nil)
+		th.Store.EXPECT().GetBoardsForUserAndTeam(gomock.Any(), "user_id_1", teamID, false).Return([]*model.Board{}, nil)
+		th.Store.EXPECT().AddUpdateCategoryBoard(gomock.Any(), "user_id_1", "boards_category_id", []string{"board_id_2"}).Return(nil)
 
-		teamID, boardID, err := th.App.PrepareOnboardingTour(userID, teamID)
+		teamID, boardID, err := th.App.PrepareOnboardingTour(context.Background(), userID, teamID)
 		assert.NoError(t, err)
 		assert.Equal(t, testTeamID, teamID)
 		assert.NotEmpty(t, boardID)
@@ -103,12 +105,12 @@ func TestCreateWelcomeBoard(t *testing.T) {
 			TeamID:     "0",
 			IsTemplate: true,
 		}
-		th.Store.EXPECT().GetTemplateBoards("0", "").Return([]*model.Board{&welcomeBoard}, nil)
-		th.Store.EXPECT().DuplicateBoard(welcomeBoard.ID, userID, teamID, false).
+		th.Store.EXPECT().GetTemplateBoards(gomock.Any(), "0", "").Return([]*model.Board{&welcomeBoard}, nil)
+		th.Store.EXPECT().DuplicateBoard(gomock.Any(), welcomeBoard.ID, userID, teamID, false).
 			Return(&model.BoardsAndBlocks{Boards: []*model.Board{&welcomeBoard}}, nil, nil)
-		th.Store.EXPECT().GetMembersForBoard(welcomeBoard.ID).Return([]*model.BoardMember{}, nil).Times(3)
-		th.Store.EXPECT().GetBoard(welcomeBoard.ID).Return(&welcomeBoard, nil).AnyTimes()
-		th.Store.EXPECT().GetUsersByTeam("0", "", false, false).Return([]*model.User{}, nil)
+		th.Store.EXPECT().GetMembersForBoard(gomock.Any(), welcomeBoard.ID).Return([]*model.BoardMember{}, nil).Times(3)
+		th.Store.EXPECT().GetBoard(gomock.Any(), welcomeBoard.ID).Return(&welcomeBoard, nil).AnyTimes()
+		th.Store.EXPECT().GetUsersByTeam(gomock.Any(), "0", "", false, false).Return([]*model.User{}, nil)
 
 		privateWelcomeBoard := model.Board{
 			ID:         "board_id_1",
@@ -118,23 +120,23 @@ func TestCreateWelcomeBoard(t *testing.T) {
 			Type:       model.BoardTypePrivate,
 		}
 		newType := model.BoardTypePrivate
-		th.Store.EXPECT().PatchBoard("board_id_1", &model.BoardPatch{Type: &newType}, "user_id_1").Return(&privateWelcomeBoard, nil)
-		th.Store.EXPECT().GetUserCategoryBoards(userID, "team_id").Return([]model.CategoryBoards{
+		th.Store.EXPECT().PatchBoard(gomock.Any(), "board_id_1", &model.BoardPatch{Type: &newType}, "user_id_1").Return(&privateWelcomeBoard, nil)
+		th.Store.EXPECT().GetUserCategoryBoards(gomock.Any(), userID, "team_id").Return([]model.CategoryBoards{
 			{
 				Category: model.Category{ID: "boards_category_id", Name: "Boards"},
 			},
 		}, nil).Times(3)
-		th.Store.EXPECT().AddUpdateCategoryBoard("user_id_1", "boards_category_id", []string{"board_id_1"}).Return(nil)
+		th.Store.EXPECT().AddUpdateCategoryBoard(gomock.Any(), "user_id_1", "boards_category_id", []string{"board_id_1"}).Return(nil)
 
-		boardID, err := th.App.createWelcomeBoard(userID, teamID)
+		boardID, err := th.App.createWelcomeBoard(context.Background(), userID, teamID)
 		assert.Nil(t, err)
 		assert.NotEmpty(t, boardID)
 	})
 
 	t.Run("template doesn't contain a board", func(t *testing.T) {
 		teamID := testTeamID
-		th.Store.EXPECT().GetTemplateBoards("0", "").Return([]*model.Board{}, nil)
-		boardID, err := th.App.createWelcomeBoard("user_id_1", teamID)
+		th.Store.EXPECT().GetTemplateBoards(gomock.Any(), "0", "").Return([]*model.Board{}, nil)
+		boardID, err := th.App.createWelcomeBoard(context.Background(), "user_id_1", teamID)
 		assert.Error(t, err)
 		assert.Empty(t, boardID)
 	})
@@ -147,8 +149,8 @@ func TestCreateWelcomeBoard(t *testing.T) {
 			TeamID:     teamID,
 			IsTemplate: true,
 		}
-		th.Store.EXPECT().GetTemplateBoards("0", "").Return([]*model.Board{&welcomeBoard}, nil)
-		boardID, err := th.App.createWelcomeBoard("user_id_1", "workspace_id_1")
+		th.Store.EXPECT().GetTemplateBoards(gomock.Any(), "0", "").Return([]*model.Board{&welcomeBoard}, nil)
+		boardID, err := th.App.createWelcomeBoard(context.Background(), "user_id_1", "workspace_id_1")
 		assert.Error(t, err)
 		assert.Empty(t, boardID)
 	})
@@ -165,17 +167,17 @@ func TestGetOnboardingBoardID(t *testing.T) {
 			TeamID:     "0",
 			IsTemplate: true,
 		}
-		th.Store.EXPECT().GetTemplateBoards("0", "").Return([]*model.Board{&welcomeBoard}, nil)
+		th.Store.EXPECT().GetTemplateBoards(gomock.Any(), "0", "").Return([]*model.Board{&welcomeBoard}, nil)
 
-		onboardingBoardID, err := th.App.getOnboardingBoardID()
+		onboardingBoardID, err := th.App.getOnboardingBoardID(context.Background())
 		assert.NoError(t, err)
 		assert.Equal(t, "board_id_1", onboardingBoardID)
 	})
 
 	t.Run("no blocks found", func(t *testing.T) {
-		th.Store.EXPECT().GetTemplateBoards("0", "").Return([]*model.Board{}, nil)
+		th.Store.EXPECT().GetTemplateBoards(gomock.Any(), "0", "").Return([]*model.Board{}, nil)
 
-		onboardingBoardID, err := th.App.getOnboardingBoardID()
+		onboardingBoardID, err := th.App.getOnboardingBoardID(context.Background())
 		assert.Error(t, err)
 		assert.Empty(t, onboardingBoardID)
 	})
@@ -187,9 +189,9 @@ func TestGetOnboardingBoardID(t *testing.T) {
 			TeamID:     "0",
 			IsTemplate: true,
 		}
-		th.Store.EXPECT().GetTemplateBoards("0", "").Return([]*model.Board{&welcomeBoard}, nil)
+		th.Store.EXPECT().GetTemplateBoards(gomock.Any(), "0", "").Return([]*model.Board{&welcomeBoard}, nil)
 
-		onboardingBoardID, err := th.App.getOnboardingBoardID()
+		onboardingBoardID, err := th.App.getOnboardingBoardID(context.Background())
 		assert.Error(t, err)
 		assert.Empty(t, onboardingBoardID)
 	})
@@ -4,17 +4,163 @@
 package app
 
 import (
+	"context"
+	"fmt"
+
 	"github.com/mattermost/mattermost-plugin-boards/server/model"
+	"github.com/mattermost/mattermost-plugin-boards/server/utils"
 )
 
-func (a *App) GetSharing(boardID string) (*model.Sharing, error) {
-	sharing, err := a.store.GetSharing(boardID)
+func (a *App) GetSharing(ctx context.Context, boardID string) (*model.Sharing, error) {
+	ctx, span := startSpan(ctx, "GetSharing")
+	defer span.End()
+
+	sharing, err := a.store.GetSharing(ctx, boardID)
 	if err != nil {
 		return nil, err
 	}
 	return sharing, nil
 }
 
-func (a *App) UpsertSharing(sharing model.Sharing) error {
-	return a.store.UpsertSharing(sharing)
+func (a *App) UpsertSharing(ctx context.Context, sharing model.Sharing) error {
+	ctx, span := startSpan(ctx, "UpsertSharing")
+	defer span.End()
+
+	return a.store.UpsertSharing(ctx, sharing)
+}
+
+func (a *App) GetShareLinks(ctx context.Context, boardID string) ([]*model.ShareLink, error) {
+	ctx, span := startSpan(ctx, "GetShareLinks")
+	defer span.End()
+
+	return a.store.GetShareLinksForBoard(ctx, boardID)
+}
+
+// CreateShareLink creates a named share link for a board, or a view or card of the board.
+func (a *App) CreateShareLink(ctx context.Context, boardID string, create *model.ShareLinkCreate, userID string) (*model.ShareLink, error) {
+	ctx, span := startSpan(ctx, "CreateShareLink")
+	defer span.End()
+
+	link := &model.ShareLink{
+		BoardID:    boardID,
+		Name:       create.Name,
+		Token:      utils.NewID(utils.IDTypeToken),
+		Scope:      create.Scope,
+		ScopeID:    create.ScopeID,
+		Permission: create.Permission,
+		ExpiresAt:  create.ExpiresAt,
+		CreatedBy:  userID,
+	}
+	if link.Scope == "" {
+		link.Scope = model.ShareLinkScopeBoard
+	}
+	if link.Permission == "" {
+		link.Permission = model.ShareLinkPermissionView
+	}
+
+	if err := link.IsValid(); err != nil {
+		return nil, model.NewErrBadRequest(err.Error())
+	}
+
+	if link.ExpiresAt != 0 && link.ExpiresAt <= utils.GetMillis() {
+		return nil, model.NewErrBadRequest("share link expiry must be in the future")
+	}
+
+	if link.Scope != model.ShareLinkScopeBoard {
+		block, err := a.store.GetBlock(ctx, link.ScopeID)
+		if model.IsErrNotFound(err) {
+			return nil, model.NewErrBadRequest(fmt.Sprintf("%s %s not found", link.Scope, link.ScopeID))
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		expectedType := model.BlockType(model.TypeView)
+		if link.Scope == model.ShareLinkScopeCard {
+			expectedType = model.TypeCard
+		}
+		if block.BoardID != boardID || block.Type != expectedType {
+			return nil, model.NewErrBadRequest(fmt.Sprintf("block %s is not a %s of board %s", link.ScopeID, link.Scope, boardID))
+		}
+	}
+
+	if err := link.SetPassword(create.Password); err != nil {
+		return nil, model.NewErrBadRequest(err.Error())
+	}
+
+	return a.store.CreateShareLink(ctx, link)
+}
+
+// RevokeShareLink revokes a share link of a board so its token can no longer be used.
+func (a *App) RevokeShareLink(ctx context.Context, boardID, linkID string) error {
+	ctx, span := startSpan(ctx, "RevokeShareLink")
+	defer span.End()
+
+	link, err := a.store.GetShareLink(ctx, linkID)
+	if err != nil {
+		return err
+	}
+
+	if link.BoardID != boardID {
+		return model.NewErrNotFound(fmt.Sprintf("share link ID=%s on BoardID=%s", linkID, boardID))
+	}
+
+	return a.store.RevokeShareLink(ctx, linkID)
+}
+
+// FilterBlocksForShareLink returns the blocks within the scope of a share link.
+func (a *App) FilterBlocksForShareLink(ctx context.Context, link *model.ShareLink, blocks []*model.Block) ([]*model.Block, error) {
+	ctx, span := startSpan(ctx, "FilterBlocksForShareLink")
+	defer span.End()
+
+	filter, err := a.auth.GetShareLinkScopeFilter(ctx, link)
+	if err != nil {
+		return nil, err
+	}
+
+	// the cards of the blocks are looked up among the blocks first.
+	cards := make(map[string]*model.Block, len(blocks))
+	for _, block := range blocks {
+		cards[block.ID] = block
+	}
+
+	filtered := make([]*model.Block, 0, len(blocks))
+	for _, block := range blocks {
+		var card *model.Block
+		if filter.NeedsCard(block) && block.ParentID != "" {
+			var ok bool
+			if card, ok = cards[block.ParentID]; !ok {
+				card, err = a.store.GetBlock(ctx, block.ParentID)
+				if err != nil && !model.IsErrNotFound(err) {
+					return nil, err
+				}
+				cards[block.ParentID] = card
+			}
+		}
+		if filter.AllowsBlock(block, card) {
+			filtered = append(filtered, block)
+		}
+	}
+	return filtered, nil
+}
+
+// IsFileInShareLinkScope returns true if a block referencing the file is within the scope of a
+// share link.
+func (a *App) IsFileInShareLinkScope(ctx context.Context, link *model.ShareLink, filename string) (bool, error) {
+	ctx, span := startSpan(ctx, "IsFileInShareLinkScope")
+	defer span.End()
+
+	if link.Scope == model.ShareLinkScopeBoard {
+		return true, nil
+	}
+
+	blocks, err := a.getBlocksReferencingFile(ctx, link.BoardID, filename)
+	if err != nil {
+		return false, err
+	}
+	allowed, err := a.FilterBlocksForShareLink(ctx, link, blocks)
+	if err != nil {
+		return false, err
+	}
+	return len(allowed) > 0, nil
 }
@@ -4,9 +4,11 @@
 package app
 
 import (
+	"context"
 	"database/sql"
 	"testing"
 
+	"github.com/golang/mock/gomock"
 	"github.com/mattermost/mattermost-plugin-boards/server/model"
 	"github.com/mattermost/mattermost-plugin-boards/server/utils"
 	"github.com/pkg/errors"
@@ -25,9 +27,9 @@ func TestGetSharing(t *testing.T) {
 			ModifiedBy: "otherid",
 			UpdateAt:   utils.GetMillis(),
 		}
-		th.Store.EXPECT().GetSharing("test-id").Return(want, nil)
+		th.Store.EXPECT().GetSharing(gomock.Any(), "test-id").Return(want, nil)
 
-		result, err := th.App.GetSharing("test-id")
+		result, err := th.App.GetSharing(context.Background(), "test-id")
 		require.NoError(t, err)
 
 		require.Equal(t, result, want)
@@ -35,11 +37,11 @@ func TestGetSharing(t *testing.T) {
 	})
 
 	t.Run("should fail to get a sharing", func(t *testing.T) {
-		th.Store.EXPECT().GetSharing("test-id").Return(
+		th.Store.EXPECT().GetSharing(gomock.Any(), "test-id").Return(
 			nil,
 			errors.New("sharing not found"),
 		)
-		result, err := th.App.GetSharing("test-id")
+		result, err := th.App.GetSharing(context.Background(), "test-id")
 
 		require.Nil(t, result)
 		require.Error(t, err)
@@ -47,11 +49,11 @@ func TestGetSharing(t *testing.T) {
 	})
 
 	t.Run("should return a not found error", func(t *testing.T) {
-		th.Store.EXPECT().GetSharing("test-id").Return(
+		th.Store.EXPECT().GetSharing(gomock.Any(), "test-id").Return(
 			nil,
 			sql.ErrNoRows,
 		)
-		result, err := th.App.GetSharing("test-id")
+		result, err := th.App.GetSharing(context.Background(), "test-id")
 		require.Error(t, err)
 		require.True(t, model.IsErrNotFound(err))
 		require.Nil(t, result)
@@ -71,15 +73,15 @@ func TestUpsertSharing(t *testing.T) {
 	}
 
 	t.Run("should success to upsert sharing", func(t *testing.T) {
-		th.Store.EXPECT().UpsertSharing(sharing).Return(nil)
-		err := th.App.UpsertSharing(sharing)
+		th.Store.EXPECT().UpsertSharing(gomock.Any(), sharing).Return(nil)
+		err := th.App.UpsertSharing(context.Background(), sharing)
 
 		require.NoError(t, err)
 	})
 
 	t.Run("should fail to upsert a sharing", func(t *testing.T) {
-		th.Store.EXPECT().UpsertSharing(sharing).Return(errors.New("sharing not found"))
-		err := th.App.UpsertSharing(sharing)
+		th.Store.EXPECT().UpsertSharing(gomock.Any(), sharing).Return(errors.New("sharing not found"))
+		err := th.App.UpsertSharing(context.Background(), sharing)
 
 		require.Error(t, err)
 		require.Equal(t, "sharing not found", err.Error())
@@ -3,6 +3,211 @@
 
 package app
 
-func (a *App) GetUsedCardsCount() (int64, error) {
-	return a.store.GetUsedCardsCount()
+import (
+	"context"
+	"fmt"
+
+	"github.com/mattermost/mattermost-plugin-boards/server/model"
+	"github.com/mattermost/mattermost-plugin-boards/server/utils"
+)
+
+func (a *App) GetUsedCardsCount(ctx context.Context) (int64, error) {
+	ctx, span := startSpan(ctx, "GetUsedCardsCount")
+	defer span.End()
+
+	return a.store.GetUsedCardsCount(ctx)
+}
+
+// GetStatistics returns the statistics of the boards of the server, or of a team, with the
+// activity over a date range broken down by team and by board.
+func (a *App) GetStatistics(ctx context.Context, opts model.StatisticsOptions) (*model.BoardsStatistics, error) {
+	ctx, span := startSpan(ctx, "GetStatistics")
+	defer span.End()
+
+	if opts.To == 0 {
+		opts.To = utils.GetMillis()
+	}
+	if opts.From == 0 {
+		opts.From = opts.To - model.StatisticsDefaultRange.Milliseconds()
+	}
+	if opts.TopBoards == 0 {
+		opts.TopBoards = model.StatisticsDefaultTopBoards
+	}
+	if opts.Period == "" {
+		opts.Period = model.StatisticsPeriodDay
+	}
+	if err := opts.IsValid(); err != nil {
+		return nil, err
+	}
+
+	stats := &model.BoardsStatistics{
+		TeamID:           opts.TeamID,
+		From:             opts.From,
+		To:               opts.To,
+		TeamFileUsage:    []*model.FileUsage{},
+		TopBoards:        []*model.BoardActivity{},
+		Period:           opts.Period,
+		ActivityByPeriod: model.StatisticsPeriods(opts),
+	}
+
+	teams, err := a.store.GetTeamBoardAndCardCounts(ctx, opts.TeamID)
+	if err != nil {
+		return nil, fmt.Errorf("could not get board and card counts by team: %w", err)
+	}
+	teamsByID := make(map[string]*model.TeamStatistics, len(teams))
+	for _, team := range teams {
+		teamsByID[team.TeamID] = team
+	}
+
+	if opts.TeamID == "" {
+		if stats.Boards, err = a.GetBoardCount(ctx, false); err != nil {
+			return nil, err
+		}
+		if stats.Cards, err = a.GetUsedCardsCount(ctx); err != nil {
+			return nil, err
+		}
+	} else if team, ok := teamsByID[opts.TeamID]; ok {
+		stats.Boards = team.Boards
+		stats.Cards = team.Cards
+	}
+
+	teamFileUsage, err := a.GetFileUsageByTeam(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for _, usage := range teamFileUsage {
+		if opts.TeamID != "" && usage.TeamID != opts.TeamID {
+			continue
+		}
+		stats.TeamFileUsage = append(stats.TeamFileUsage, usage)
+		stats.FileBytes += usage.Bytes
+		stats.FileCount += usage.FileCount
+		if team, ok := teamsByID[usage.TeamID]; ok {
+			team.FileBytes = usage.Bytes
+			team.FileCount = usage.FileCount
+		}
+	}
+
+	activeUsers, activeUsersByTeam, err := a.store.GetActiveUserCountsByTeam(ctx, opts.TeamID, opts.To)
+	if err != nil {
+		return nil, fmt.Errorf("could not get active users by team: %w", err)
+	}
+	stats.ActiveUsers = activeUsers
+	for teamID, counts := range activeUsersByTeam {
+		if team, ok := teamsByID[teamID]; ok {
+			team.ActiveUsers = counts
+		}
+	}
+
+	boards, err := a.store.GetBoardActivity(ctx, opts)
+	if err != nil {
+		return nil, fmt.Errorf("could not get board activity: %w", err)
+	}
+	for _, board := range boards {
+		if board.CardChanges != 0 {
+			completions, err := a.getCardCompletions(ctx, board.BoardID, opts.From, opts.To)
+			if err != nil {
+				return nil, err
+			}
+			board.Activity.CardsCompleted = int64(len(completions))
+			for _, at := range completions {
+				model.FindPeriod(stats.ActivityByPeriod, at).Activity.CardsCompleted++
+			}
+		}
+		stats.Activity.Add(board.Activity)
+		if team, ok := teamsByID[board.TeamID]; ok {
+			team.Activity.Add(board.Activity)
+		}
+	}
+
+	days, err := a.store.GetDailyActivity(ctx, opts)
+	if err != nil {
+		return nil, fmt.Errorf("could not get daily activity: %w", err)
+	}
+	for day, counts := range days {
+		model.FindPeriod(stats.ActivityByPeriod, day).Activity.Add(counts)
+	}
+
+	if len(boards) > opts.TopBoards {
+		boards = boards[:opts.TopBoards]
+	}
+	for _, board := range boards {
+		usage, err := a.store.GetBoardFileUsage(ctx, board.BoardID)
+		if err != nil {
+			return nil, fmt.Errorf("could not get file usage of board %s: %w", board.BoardID, err)
+		}
+		board.FileBytes = usage.Bytes
+		board.FileCount = usage.FileCount
+	}
+	stats.TopBoards = boards
+	stats.Teams = teams
+
+	if stats.BlockCounts, err = a.store.GetBlockCountsByTypeWithOptions(ctx, opts); err != nil {
+		return nil, fmt.Errorf("could not get block counts by type: %w", err)
+	}
+	return stats, nil
+}
+
+// getCardCompletions returns the times the cards of a board were moved to the done option of its
+// status property in a date range, once per card. Cards created in the done option count as
+// completed. Boards without a done option have no completions.
+func (a *App) getCardCompletions(ctx context.Context, boardID string, from, to int64) ([]int64, error) {
+	board, err := a.GetBoard(ctx, boardID)
+	if err != nil {
+		return nil, err
+	}
+	schema, err := model.ParsePropertySchema(board)
+	if err != nil {
+		return nil, nil
+	}
+	status, ok := schema.DoneStatus()
+	if !ok {
+		return nil, nil
+	}
+
+	opts := model.QueryBlockHistoryOptions{
+		AfterUpdateAt:  from - 1,
+		BeforeUpdateAt: to + 1,
+	}
+	blocks, err := a.store.GetBlockHistoryDescendants(ctx, boardID, opts)
+	if err != nil {
+		return nil, fmt.Errorf("could not get block history for board %s: %w", boardID, err)
+	}
+
+	isDone := func(card *model.Block) bool {
+		properties, _ := card.Fields["properties"].(map[string]interface{})
+		value, _ := properties[status.ID].(string)
+		return value == status.DoneOptionID
+	}
+
+	wasDone := map[string]bool{}
+	completed := map[string]bool{}
+	completions := []int64{}
+	for _, block := range blocks {
+		if block.Type != model.TypeCard || completed[block.ID] {
+			continue
+		}
+
+		done := isDone(block)
+		previous, seen := wasDone[block.ID]
+		if !seen && done && block.CreateAt < block.UpdateAt {
+			// the card may already have been done before the date range
+			history, err := a.store.GetBlockHistory(ctx, block.ID, model.QueryBlockHistoryOptions{
+				BeforeUpdateAt: block.UpdateAt,
+				Limit:          1,
+				Descending:     true,
+			})
+			if err != nil {
+				return nil, fmt.Errorf("could not get block history for card %s: %w", block.ID, err)
+			}
+			previous = len(history) > 0 && isDone(history[0])
+		}
+
+		if done && !previous {
+			completed[block.ID] = true
+			completions = append(completions, block.UpdateAt)
+		}
+		wasDone[block.ID] = done
+	}
+	return completions, nil
 }
@@ -0,0 +1,140 @@
+// Copyright (c) 2020-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package app
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mattermost/mattermost-plugin-boards/server/model"
+)
+
+func TestGetStatistics(t *testing.T) {
+	th, tearDown := SetupTestHelper(t)
+	defer tearDown()
+
+	// from Thursday to Thursday, with a week starting on Monday 1970-01-05.
+	from := int64(1000000)
+	to := from + 7*oneDay.Milliseconds()
+	monday := 4 * oneDay.Milliseconds()
+	opts := model.StatisticsOptions{From: from, To: to, TopBoards: 1, Period: model.StatisticsPeriodWeek}
+
+	board := &model.Board{
+		ID: testBoardID,
+		CardProperties: []map[string]interface{}{
+			{"id": "owner", "name": "Owner", "type": "person"},
+			{
+				"id":   "priority",
+				"name": "Priority",
+				"type": "select",
+				"options": []interface{}{
+					map[string]interface{}{"id": "low", "value": "Low"},
+					map[string]interface{}{"id": "high", "value": "High"},
+				},
+			},
+			{
+				"id":           "status",
+				"name":         "Status",
+				"type":         "select",
+				"doneOptionId": "done",
+				"options": []interface{}{
+					map[string]interface{}{"id": "todo", "value": "To Do"},
+					map[string]interface{}{"id": "done", "value": "Done"},
+					map[string]interface{}{"id": "archived", "value": "Archived"},
+				},
+			},
+		},
+	}
+	cardVersion := func(id string, createAt, updateAt int64, status string) *model.Block {
+		return &model.Block{
+			ID: id, BoardID: testBoardID, Type: model.TypeCard, CreateAt: createAt, UpdateAt: updateAt,
+			Fields: map[string]interface{}{"properties": map[string]interface{}{"status": status, "priority": "high"}},
+		}
+	}
+
+	teams := []*model.TeamStatistics{
+		{TeamID: "team-1", Boards: 2, Cards: 10},
+		{TeamID: "team-2", Boards: 1, Cards: 3},
+	}
+	boards := []*model.BoardActivity{
+		{BoardID: testBoardID, TeamID: "team-1", Changes: 8, CardChanges: 5, Activity: model.ActivityCounts{CardsCreated: 2, Comments: 3}},
+		{BoardID: "board-2", TeamID: "team-2", Changes: 2, Activity: model.ActivityCounts{Comments: 1}},
+	}
+
+	th.Store.EXPECT().GetTeamBoardAndCardCounts(gomock.Any(), "").Return(teams, nil)
+	th.Store.EXPECT().GetBoardCount(gomock.Any(), false).Return(int64(3), nil)
+	th.Store.EXPECT().GetUsedCardsCount(gomock.Any()).Return(int64(13), nil)
+	th.Store.EXPECT().GetFileUsageByTeam(gomock.Any()).Return([]*model.FileUsage{{TeamID: "team-1", Bytes: 100, FileCount: 2}}, nil)
+	th.Store.EXPECT().GetStorageQuota(gomock.Any(), model.StorageQuotaScopeTeam, "team-1").Return(nil, model.NewErrNotFound("quota")).AnyTimes()
+	th.Store.EXPECT().GetActiveUserCountsByTeam(gomock.Any(), "", to).Return(
+		model.ActiveUserCounts{Day: 1, Week: 3, Month: 4},
+		map[string]model.ActiveUserCounts{"team-1": {Day: 1, Week: 2, Month: 3}, "team-2": {Week: 1, Month: 1}},
+		nil,
+	)
+	th.Store.EXPECT().GetBoardActivity(gomock.Any(), opts).Return(boards, nil)
+	th.Store.EXPECT().GetBoard(gomock.Any(), testBoardID).Return(board, nil)
+	th.Store.EXPECT().GetBlockHistoryDescendants(gomock.Any(), testBoardID, model.QueryBlockHistoryOptions{AfterUpdateAt: from - 1, BeforeUpdateAt: to + 1}).
+		Return([]*model.Block{
+			// created done in the date range
+			cardVersion("card-1", from+1, from+1, "done"),
+			// moved to done in the date range, in the second week
+			cardVersion("card-2", from+2, from+2, "todo"),
+			cardVersion("card-2", from+2, monday+3, "done"),
+			// already done before the date range
+			cardVersion("card-3", from-10, from+4, "done"),
+			// moved to done before the date range
+			cardVersion("card-4", from-10, from+5, "done"),
+			// moved to the last option, which is not the done option
+			cardVersion("card-5", from+6, from+6, "archived"),
+		}, nil)
+	th.Store.EXPECT().GetBlockHistory(gomock.Any(), "card-3", gomock.Any()).Return([]*model.Block{cardVersion("card-3", from-10, from-5, "done")}, nil)
+	th.Store.EXPECT().GetBlockHistory(gomock.Any(), "card-4", gomock.Any()).Return([]*model.Block{cardVersion("card-4", from-10, from-5, "todo")}, nil)
+	th.Store.EXPECT().GetDailyActivity(gomock.Any(), opts).Return(map[int64]model.ActivityCounts{
+		0:                              {CardsCreated: 1, Comments: 2},
+		monday + oneDay.Milliseconds(): {CardsCreated: 1, Comments: 2},
+	}, nil)
+	th.Store.EXPECT().GetBoardFileUsage(gomock.Any(), testBoardID).Return(&model.FileUsage{BoardID: testBoardID, Bytes: 60, FileCount: 1}, nil)
+	th.Store.EXPECT().GetBlockCountsByTypeWithOptions(gomock.Any(), opts).Return(map[string]int64{"card": 2, "comment": 4}, nil)
+
+	stats, err := th.App.GetStatistics(context.Background(), opts)
+	require.NoError(t, err)
+
+	assert.Equal(t, int64(3), stats.Boards)
+	assert.Equal(t, int64(13), stats.Cards)
+	assert.Equal(t, int64(100), stats.FileBytes)
+	assert.Equal(t, model.ActiveUserCounts{Day: 1, Week: 3, Month: 4}, stats.ActiveUsers)
+	assert.Equal(t, model.ActivityCounts{CardsCreated: 2, CardsCompleted: 3, Comments: 4}, stats.Activity)
+	assert.Equal(t, map[string]int64{"card": 2, "comment": 4}, stats.BlockCounts)
+
+	assert.Equal(t, model.StatisticsPeriodWeek, stats.Period)
+	assert.Equal(t, []*model.PeriodActivity{
+		{Start: from, Activity: model.ActivityCounts{CardsCreated: 1, CardsCompleted: 2, Comments: 2}},
+		{Start: monday, Activity: model.ActivityCounts{CardsCreated: 1, CardsCompleted: 1, Comments: 2}},
+	}, stats.ActivityByPeriod)
+
+	require.Len(t, stats.Teams, 2)
+	assert.Equal(t, model.ActiveUserCounts{Day: 1, Week: 2, Month: 3}, stats.Teams[0].ActiveUsers)
+	assert.Equal(t, model.ActivityCounts{CardsCreated: 2, CardsCompleted: 3, Comments: 3}, stats.Teams[0].Activity)
+	assert.Equal(t, int64(100), stats.Teams[0].FileBytes)
+	assert.Equal(t, model.ActivityCounts{Comments: 1}, stats.Teams[1].Activity)
+
+	require.Len(t, stats.TopBoards, 1)
+	assert.Equal(t, testBoardID, stats.TopBoards[0].BoardID)
+	assert.Equal(t, int64(60), stats.TopBoards[0].FileBytes)
+
+	t.Run("invalid options", func(t *testing.T) {
+		_, err := th.App.GetStatistics(context.Background(), model.StatisticsOptions{From: to, To: from})
+		require.True(t, model.IsErrBadRequest(err))
+
+		_, err = th.App.GetStatistics(context.Background(), model.StatisticsOptions{From: from, To: to, TopBoards: 1000})
+		require.True(t, model.IsErrBadRequest(err))
+
+		_, err = th.App.GetStatistics(context.Background(), model.StatisticsOptions{From: from, To: to, Period: "year"})
+		require.True(t, model.IsErrBadRequest(err))
+	})
+}
@@ -0,0 +1,205 @@
+// Copyright (c) 2020-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package app
+
+import (
+	"context"
+
+	"github.com/mattermost/mattermost-plugin-boards/server/model"
+	"github.com/mattermost/mattermost-plugin-boards/server/utils"
+
+	"github.com/mattermost/mattermost/server/public/shared/mlog"
+)
+
+const bytesPerMB = 1024 * 1024
+
+// GetStorageQuota returns the storage quota of a team or board: the one set by an administrator,
+// or the default quota of the server for the scope.
+func (a *App) GetStorageQuota(ctx context.Context, scope model.StorageQuotaScope, scopeID string) (*model.StorageQuota, error) {
+	ctx, span := startSpan(ctx, "GetStorageQuota")
+	defer span.End()
+
+	quota, err := a.store.GetStorageQuota(ctx, scope, scopeID)
+	if err == nil {
+		return quota, nil
+	}
+	if !model.IsErrNotFound(err) {
+		return nil, err
+	}
+
+	defaultMB := a.config.TeamStorageQuotaMB
+	if scope == model.StorageQuotaScopeBoard {
+		defaultMB = a.config.BoardStorageQuotaMB
+	}
+	return &model.StorageQuota{
+		Scope:    scope,
+		ScopeID:  scopeID,
+		MaxBytes: int64(defaultMB) * bytesPerMB,
+	}, nil
+}
+
+// SetStorageQuota overrides the default storage quota of a team or board.
+func (a *App) SetStorageQuota(ctx context.Context, quota *model.StorageQuota, userID string) (*model.StorageQuota, error) {
+	ctx, span := startSpan(ctx, "SetStorageQuota")
+	defer span.End()
+
+	if err := quota.IsValid(); err != nil {
+		return nil, err
+	}
+
+	quota.ModifiedBy = userID
+	quota.UpdateAt = utils.GetMillis()
+	if err := a.store.UpsertStorageQuota(ctx, quota); err != nil {
+		return nil, err
+	}
+	return quota, nil
+}
+
+// DeleteStorageQuota reverts a team or board to the default storage quota of the server.
+func (a *App) DeleteStorageQuota(ctx context.Context, scope model.StorageQuotaScope, scopeID string) error {
+	ctx, span := startSpan(ctx, "DeleteStorageQuota")
+	defer span.End()
+
+	return a.store.DeleteStorageQuota(ctx, scope, scopeID)
+}
+
+// GetTeamFileUsage returns the storage used by the boards of a team, and its quota.
+func (a *App) GetTeamFileUsage(ctx context.Context, teamID string) (*model.FileUsage, error) {
+	ctx, span := startSpan(ctx, "GetTeamFileUsage")
+	defer span.End()
+
+	usage, err := a.store.GetTeamFileUsage(ctx, teamID)
+	if err != nil {
+		return nil, err
+	}
+	quota, err := a.GetStorageQuota(ctx, model.StorageQuotaScopeTeam, teamID)
+	if err != nil {
+		return nil, err
+	}
+	usage.QuotaBytes = quota.MaxBytes
+	return usage, nil
+}
+
+// GetBoardFileUsage returns the storage used by a board, and its quota.
+func (a *App) GetBoardFileUsage(ctx context.Context, boardID string) (*model.FileUsage, error) {
+	ctx, span := startSpan(ctx, "GetBoardFileUsage")
+	defer span.End()
+
+	usage, err := a.store.GetBoardFileUsage(ctx, boardID)
+	if err != nil {
+		return nil, err
+	}
+	quota, err := a.GetStorageQuota(ctx, model.StorageQuotaScopeBoard, boardID)
+	if err != nil {
+		return nil, err
+	}
+	usage.QuotaBytes = quota.MaxBytes
+	return usage, nil
+}
+
+// GetFileUsageByTeam returns the storage used by the boards of every team with stored files,
+// and their quotas.
+func (a *App) GetFileUsageByTeam(ctx context.Context) ([]*model.FileUsage, error) {
+	ctx, span := startSpan(ctx, "GetFileUsageByTeam")
+	defer span.End()
+
+	usages, err := a.store.GetFileUsageByTeam(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for _, usage := range usages {
+		quota, err := a.GetStorageQuota(ctx, model.StorageQuotaScopeTeam, usage.TeamID)
+		if err != nil {
+			return nil, err
+		}
+		usage.QuotaBytes = quota.MaxBytes
+	}
+	return usages, nil
+}
+
+// getRemainingStorage returns the number of bytes that can still be stored for a board within
+// the quotas of the board and its team, or -1 if no quota applies. An ErrStorageQuotaExceeded is
+// returned if a quota is already used up.
+func (a *App) getRemainingStorage(ctx context.Context, teamID, boardID string) (int64, error) {
+	usages, err := a.getLimitedFileUsages(ctx, teamID, boardID)
+	if err != nil {
+		return 0, err
+	}
+
+	remaining := int64(-1)
+	for _, usage := range usages {
+		if usage.Bytes >= usage.QuotaBytes {
+			return 0, newErrStorageQuotaExceeded(usage)
+		}
+		if left := usage.QuotaBytes - usage.Bytes; remaining < 0 || left < remaining {
+			remaining = left
+		}
+	}
+	return remaining, nil
+}
+
+// reserveStorage charges a file of the given size to the storage usage of a board, failing with
+// an ErrStorageQuotaExceeded if it does not fit within the quotas of the board and its team.
+func (a *App) reserveStorage(ctx context.Context, teamID, boardID string, bytes int64) error {
+	// the files of the built-in templates have no quota.
+	if teamID == model.GlobalTeamID {
+		return a.store.AddFileUsage(ctx, teamID, boardID, bytes, 1)
+	}
+
+	teamQuota, err := a.GetStorageQuota(ctx, model.StorageQuotaScopeTeam, teamID)
+	if err != nil {
+		return err
+	}
+	boardQuota, err := a.GetStorageQuota(ctx, model.StorageQuotaScopeBoard, boardID)
+	if err != nil {
+		return err
+	}
+	return a.store.ReserveFileUsage(ctx, teamID, boardID, bytes, teamQuota.MaxBytes, boardQuota.MaxBytes)
+}
+
+// getLimitedFileUsages returns the storage usages of the board and its team that have a quota.
+func (a *App) getLimitedFileUsages(ctx context.Context, teamID, boardID string) ([]*model.FileUsage, error) {
+	// the files of the built-in templates are not charged to any team.
+	if teamID == model.GlobalTeamID {
+		return nil, nil
+	}
+
+	teamUsage, err := a.GetTeamFileUsage(ctx, teamID)
+	if err != nil {
+		return nil, err
+	}
+	boardUsage, err := a.GetBoardFileUsage(ctx, boardID)
+	if err != nil {
+		return nil, err
+	}
+
+	usages := make([]*model.FileUsage, 0, 2)
+	for _, usage := range []*model.FileUsage{teamUsage, boardUsage} {
+		if usage.QuotaBytes > 0 {
+			usages = append(usages, usage)
+		}
+	}
+	return usages, nil
+}
+
+func newErrStorageQuotaExceeded(usage *model.FileUsage) *model.ErrStorageQuotaExceeded {
+	if usage.BoardID != "" {
+		return model.NewErrStorageQuotaExceeded(model.StorageQuotaScopeBoard, usage.BoardID, usage.Bytes, usage.QuotaBytes)
+	}
+	return model.NewErrStorageQuotaExceeded(model.StorageQuotaScopeTeam, usage.TeamID, usage.Bytes, usage.QuotaBytes)
+}
+
+// addFileUsage records that bytes and fileCount, which may be negative, were added to the files
+// stored for a board. The files are stored before their usage is recorded, so failures are only
+// logged.
+func (a *App) addFileUsage(ctx context.Context, teamID, boardID string, bytes, fileCount int64) {
+	if err := a.store.AddFileUsage(ctx, teamID, boardID, bytes, fileCount); err != nil {
+		a.logger.Error("Cannot update file usage",
+			mlog.String("teamID", teamID),
+			mlog.String("boardID", boardID),
+			mlog.Int("bytes", bytes),
+			mlog.Err(err),
+		)
+	}
+}
@@ -0,0 +1,168 @@
+// Copyright (c) 2020-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package app
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mattermost/mattermost-plugin-boards/server/model"
+)
+
+// expectUnlimitedStorage makes every team and board of the store unlimited and empty.
+func expectUnlimitedStorage(th *TestHelper) {
+	th.Store.EXPECT().GetStorageQuota(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, model.NewErrNotFound("storage quota")).AnyTimes()
+	th.Store.EXPECT().GetTeamFileUsage(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, teamID string) (*model.FileUsage, error) {
+		return &model.FileUsage{TeamID: teamID}, nil
+	}).AnyTimes()
+	th.Store.EXPECT().GetBoardFileUsage(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, boardID string) (*model.FileUsage, error) {
+		return &model.FileUsage{BoardID: boardID}, nil
+	}).AnyTimes()
+	th.Store.EXPECT().AddFileUsage(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+	th.Store.EXPECT().ReserveFileUsage(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+}
+
+func TestGetStorageQuota(t *testing.T) {
+	th, tearDown := SetupTestHelper(t)
+	defer tearDown()
+
+	teamID := "abcdefghijklmnopqrstuvwxyz"
+	th.App.config.TeamStorageQuotaMB = 2
+	th.App.config.BoardStorageQuotaMB = 1
+
+	t.Run("default quota", func(t *testing.T) {
+		th.Store.EXPECT().GetStorageQuota(gomock.Any(), model.StorageQuotaScopeTeam, teamID).Return(nil, model.NewErrNotFound("storage quota"))
+		th.Store.EXPECT().GetStorageQuota(gomock.Any(), model.StorageQuotaScopeBoard, testBoardID).Return(nil, model.NewErrNotFound("storage quota"))
+
+		quota, err := th.App.GetStorageQuota(context.Background(), model.StorageQuotaScopeTeam, teamID)
+		require.NoError(t, err)
+		assert.EqualValues(t, 2*1024*1024, quota.MaxBytes)
+
+		quota, err = th.App.GetStorageQuota(context.Background(), model.StorageQuotaScopeBoard, testBoardID)
+		require.NoError(t, err)
+		assert.EqualValues(t, 1024*1024, quota.MaxBytes)
+	})
+
+	t.Run("quota set by an administrator", func(t *testing.T) {
+		th.Store.EXPECT().GetStorageQuota(gomock.Any(), model.StorageQuotaScopeTeam, teamID).Return(&model.StorageQuota{
+			Scope:    model.StorageQuotaScopeTeam,
+			ScopeID:  teamID,
+			MaxBytes: 0,
+		}, nil)
+
+		quota, err := th.App.GetStorageQuota(context.Background(), model.StorageQuotaScopeTeam, teamID)
+		require.NoError(t, err)
+		assert.Zero(t, quota.MaxBytes)
+	})
+}
+
+func TestSetStorageQuota(t *testing.T) {
+	th, tearDown := SetupTestHelper(t)
+	defer tearDown()
+
+	t.Run("valid quota", func(t *testing.T) {
+		th.Store.EXPECT().UpsertStorageQuota(gomock.Any(), gomock.Any()).Return(nil)
+
+		quota, err := th.App.SetStorageQuota(context.Background(), &model.StorageQuota{
+			Scope:    model.StorageQuotaScopeBoard,
+			ScopeID:  testBoardID,
+			MaxBytes: 1000,
+		}, "user-id")
+		require.NoError(t, err)
+		assert.Equal(t, "user-id", quota.ModifiedBy)
+		assert.NotZero(t, quota.UpdateAt)
+	})
+
+	t.Run("invalid quotas", func(t *testing.T) {
+		for _, quota := range []*model.StorageQuota{
+			{Scope: "channel", ScopeID: testBoardID, MaxBytes: 1000},
+			{Scope: model.StorageQuotaScopeTeam, ScopeID: "../team", MaxBytes: 1000},
+			{Scope: model.StorageQuotaScopeBoard, ScopeID: testBoardID, MaxBytes: -1},
+		} {
+			_, err := th.App.SetStorageQuota(context.Background(), quota, "user-id")
+			assert.True(t, model.IsErrBadRequest(err), "%+v", quota)
+		}
+	})
+}
+
+func TestSaveFileStorageQuota(t *testing.T) {
+	th, tearDown := SetupTestHelper(t)
+	defer tearDown()
+
+	teamID := "abcdefghijklmnopqrstuvwxyz"
+	boardID := testBoardID
+
+	expectUsage := func(teamBytes, teamQuota, boardBytes, boardQuota int64) {
+		th.Store.EXPECT().GetTeamFileUsage(gomock.Any(), teamID).Return(&model.FileUsage{TeamID: teamID, Bytes: teamBytes}, nil)
+		th.Store.EXPECT().GetStorageQuota(gomock.Any(), model.StorageQuotaScopeTeam, teamID).Return(&model.StorageQuota{MaxBytes: teamQuota}, nil)
+		th.Store.EXPECT().GetBoardFileUsage(gomock.Any(), boardID).Return(&model.FileUsage{TeamID: teamID, BoardID: boardID, Bytes: boardBytes}, nil)
+		th.Store.EXPECT().GetStorageQuota(gomock.Any(), model.StorageQuotaScopeBoard, boardID).Return(&model.StorageQuota{MaxBytes: boardQuota}, nil)
+	}
+	expectReservation := func(bytes, teamQuota, boardQuota int64, err error) {
+		th.Store.EXPECT().GetStorageQuota(gomock.Any(), model.StorageQuotaScopeTeam, teamID).Return(&model.StorageQuota{MaxBytes: teamQuota}, nil)
+		th.Store.EXPECT().GetStorageQuota(gomock.Any(), model.StorageQuotaScopeBoard, boardID).Return(&model.StorageQuota{MaxBytes: boardQuota}, nil)
+		th.Store.EXPECT().ReserveFileUsage(gomock.Any(), teamID, boardID, bytes, teamQuota, boardQuota).Return(err)
+	}
+
+	t.Run("file within the quotas", func(t *testing.T) {
+		backend := newMemoryFileBackend()
+		th.App.filesBackend = backend
+		expectUsage(4, 10, 0, 0)
+		expectReservation(6, 10, 0, nil)
+		th.Store.EXPECT().SaveFileInfo(gomock.Any(), gomock.Any()).Return(nil)
+
+		fileName, err := th.App.SaveFile(context.Background(), bytes.NewReader([]byte("123456")), teamID, boardID, "file.txt", false)
+		require.NoError(t, err)
+		assert.NotEmpty(t, fileName)
+		assert.Len(t, backend.files, 1)
+	})
+
+	t.Run("file exceeding the team quota", func(t *testing.T) {
+		backend := newMemoryFileBackend()
+		th.App.filesBackend = backend
+		expectUsage(4, 10, 0, 100)
+		// only one byte more than the remaining storage is read.
+		expectReservation(7, 10, 100, model.NewErrStorageQuotaExceeded(model.StorageQuotaScopeTeam, teamID, 4, 10))
+
+		_, err := th.App.SaveFile(context.Background(), bytes.NewReader([]byte("12345678")), teamID, boardID, "file.txt", false)
+		var quotaErr *model.ErrStorageQuotaExceeded
+		require.ErrorAs(t, err, &quotaErr)
+		assert.Equal(t, model.StorageQuotaScopeTeam, quotaErr.Scope)
+		assert.Equal(t, teamID, quotaErr.ScopeID)
+		assert.EqualValues(t, 10, quotaErr.MaxBytes)
+		assert.True(t, model.IsErrRequestEntityTooLarge(err))
+		assert.Empty(t, backend.files)
+	})
+
+	t.Run("board quota used up", func(t *testing.T) {
+		backend := newMemoryFileBackend()
+		th.App.filesBackend = backend
+		expectUsage(4, 0, 10, 10)
+
+		_, err := th.App.SaveFile(context.Background(), bytes.NewReader([]byte("1")), teamID, boardID, "file.txt", false)
+		var quotaErr *model.ErrStorageQuotaExceeded
+		require.ErrorAs(t, err, &quotaErr)
+		assert.Equal(t, model.StorageQuotaScopeBoard, quotaErr.Scope)
+		assert.Equal(t, boardID, quotaErr.ScopeID)
+		assert.Empty(t, backend.files)
+	})
+
+	t.Run("reservation released when the file info cannot be saved", func(t *testing.T) {
+		backend := newMemoryFileBackend()
+		th.App.filesBackend = backend
+		expectUsage(0, 10, 0, 0)
+		expectReservation(6, 10, 0, nil)
+		th.Store.EXPECT().SaveFileInfo(gomock.Any(), gomock.Any()).Return(errors.New("save failed"))
+		th.Store.EXPECT().AddFileUsage(gomock.Any(), teamID, boardID, int64(-6), int64(-1)).Return(nil)
+
+		_, err := th.App.SaveFile(context.Background(), bytes.NewReader([]byte("123456")), teamID, boardID, "file.txt", false)
+		require.Error(t, err)
+	})
+}
@@ -4,46 +4,57 @@
 package app
 
 import (
+	"context"
+
 	"github.com/mattermost/mattermost-plugin-boards/server/model"
 	"github.com/mattermost/mattermost-plugin-boards/server/utils"
 
 	"github.com/mattermost/mattermost/server/public/shared/mlog"
 )
 
-func (a *App) CreateSubscription(sub *model.Subscription) (*model.Subscription, error) {
-	sub, err := a.store.CreateSubscription(sub)
+func (a *App) CreateSubscription(ctx context.Context, sub *model.Subscription) (*model.Subscription, error) {
+	ctx, span := startSpan(ctx, "CreateSubscription")
+	defer span.End()
+
+	sub, err := a.store.CreateSubscription(ctx, sub)
 	if err != nil {
 		return nil, err
 	}
-	a.notifySubscriptionChanged(sub)
+	a.notifySubscriptionChanged(ctx, sub)
 
 	return sub, nil
 }
 
-func (a *App) DeleteSubscription(blockID string, subscriberID string) (*model.Subscription, error) {
-	sub, err := a.store.GetSubscription(blockID, subscriberID)
+func (a *App) DeleteSubscription(ctx context.Context, blockID string, subscriberID string) (*model.Subscription, error) {
+	ctx, span := startSpan(ctx, "DeleteSubscription")
+	defer span.End()
+
+	sub, err := a.store.GetSubscription(ctx, blockID, subscriberID)
 	if err != nil {
 		return nil, err
 	}
-	if err := a.store.DeleteSubscription(blockID, subscriberID); err != nil {
+	if err := a.store.DeleteSubscription(ctx, blockID, subscriberID); err != nil {
 		return nil, err
 	}
 	sub.DeleteAt = utils.GetMillis()
-	a.notifySubscriptionChanged(sub)
+	a.notifySubscriptionChanged(ctx, sub)
 
 	return sub, nil
 }
 
-func (a *App) GetSubscriptions(subscriberID string) ([]*model.Subscription, error) {
-	return a.store.GetSubscriptions(subscriberID)
+func (a *App) GetSubscriptions(ctx context.Context, subscriberID string) ([]*model.Subscription, error) {
+	ctx, span := startSpan(ctx, "GetSubscriptions")
+	defer span.End()
+
+	return a.store.GetSubscriptions(ctx, subscriberID)
 }
 
-func (a *App) notifySubscriptionChanged(subscription *model.Subscription) {
+func (a *App) notifySubscriptionChanged(ctx context.Context, subscription *model.Subscription) {
 	if a.notifications == nil {
 		return
 	}
 
-	board, err := a.getBoardForBlock(subscription.BlockID)
+	board, err := a.getBoardForBlock(ctx, subscription.BlockID)
 	if err != nil {
 		a.logger.Error("Error notifying subscription change",
 			mlog.String("subscriber_id", subscription.SubscriberID),
@@ -4,27 +4,32 @@
 package app
 
 import (
+	"context"
+
 	"github.com/mattermost/mattermost-plugin-boards/server/model"
 	"github.com/mattermost/mattermost-plugin-boards/server/utils"
 
 	"github.com/mattermost/mattermost/server/public/shared/mlog"
 )
 
-func (a *App) GetRootTeam() (*model.Team, error) {
+func (a *App) GetRootTeam(ctx context.Context) (*model.Team, error) {
+	ctx, span := startSpan(ctx, "GetRootTeam")
+	defer span.End()
+
 	teamID := "0"
-	team, _ := a.store.GetTeam(teamID)
+	team, _ := a.store.GetTeam(ctx, teamID)
 	if team == nil {
 		team = &model.Team{
 			ID:          teamID,
 			SignupToken: utils.NewID(utils.IDTypeToken),
 		}
-		err := a.store.UpsertTeamSignupToken(*team)
+		err := a.store.UpsertTeamSignupToken(ctx, *team)
 		if err != nil {
 			a.logger.Error("Unable to initialize team", mlog.Err(err))
 			return nil, err
 		}
 
-		team, err = a.store.GetTeam(teamID)
+		team, err = a.store.GetTeam(ctx, teamID)
 		if err != nil {
 			a.logger.Error("Unable to get initialized team", mlog.Err(err))
 			return nil, err
@@ -36,8 +41,11 @@ func (a *App) GetRootTeam() (*model.Team, error) {
 	return team, nil
 }
 
-func (a *App) GetTeam(id string) (*model.Team, error) {
-	team, err := a.store.GetTeam(id)
+func (a *App) GetTeam(ctx context.Context, id string) (*model.Team, error) {
+	ctx, span := startSpan(ctx, "GetTeam")
+	defer span.End()
+
+	team, err := a.store.GetTeam(ctx, id)
 	if model.IsErrNotFound(err) {
 		return nil, nil
 	}
@@ -47,22 +55,34 @@ func (a *App) GetTeam(id string) (*model.Team, error) {
 	return team, nil
 }
 
-func (a *App) GetTeamsForUser(userID string) ([]*model.Team, error) {
-	return a.store.GetTeamsForUser(userID)
+func (a *App) GetTeamsForUser(ctx context.Context, userID string) ([]*model.Team, error) {
+	ctx, span := startSpan(ctx, "GetTeamsForUser")
+	defer span.End()
+
+	return a.store.GetTeamsForUser(ctx, userID)
 }
 
 func (a *App) DoesUserHaveTeamAccess(userID string, teamID string) bool {
 	return a.auth.DoesUserHaveTeamAccess(userID, teamID)
 }
 
-func (a *App) UpsertTeamSettings(team model.Team) error {
-	return a.store.UpsertTeamSettings(team)
+func (a *App) UpsertTeamSettings(ctx context.Context, team model.Team) error {
+	ctx, span := startSpan(ctx, "UpsertTeamSettings")
+	defer span.End()
+
+	return a.store.UpsertTeamSettings(ctx, team)
 }
 
-func (a *App) UpsertTeamSignupToken(team model.Team) error {
-	return a.store.UpsertTeamSignupToken(team)
+func (a *App) UpsertTeamSignupToken(ctx context.Context, team model.Team) error {
+	ctx, span := startSpan(ctx, "UpsertTeamSignupToken")
+	defer span.End()
+
+	return a.store.UpsertTeamSignupToken(ctx, team)
 }
 
-func (a *App) GetTeamCount() (int64, error) {
-	return a.store.GetTeamCount()
+func (a *App) GetTeamCount(ctx context.Context) (int64, error) {
+	ctx, span := startSpan(ctx, "GetTeamCount")
+	defer span.End()
+
+	return a.store.GetTeamCount(ctx)
 }
@@ -5,6 +5,7 @@ package app
 
 import (
 	"bytes"
+	"context"
 	"fmt"
 	"strings"
 
@@ -18,14 +19,17 @@ const (
 	defaultTemplateVersion = 7 // bump this number to force default templates to be re-imported
 )
 
-func (a *App) InitTemplates() error {
-	_, err := a.initializeTemplates()
+func (a *App) InitTemplates(ctx context.Context) error {
+	ctx, span := startSpan(ctx, "InitTemplates")
+	defer span.End()
+
+	_, err := a.initializeTemplates(ctx)
 	return err
 }
 
 // initializeTemplates imports default templates if the boards table is empty.
-func (a *App) initializeTemplates() (bool, error) {
-	boards, err := a.store.GetTemplateBoards(model.GlobalTeamID, "")
+func (a *App) initializeTemplates(ctx context.Context) (bool, error) {
+	boards, err := a.store.GetTemplateBoards(ctx, model.GlobalTeamID, "")
 	if err != nil {
 		return false, fmt.Errorf("cannot initialize templates: %w", err)
 	}
@@ -44,7 +48,7 @@ func (a *App) initializeTemplates() (bool, error) {
 	)
 
 	// Remove in case of newer Templates
-	if err = a.store.RemoveDefaultTemplates(boards); err != nil {
+	if err = a.store.RemoveDefaultTemplates(ctx, boards); err != nil {
 		return false, fmt.Errorf("cannot remove old template boards: %w", err)
 	}
 
@@ -56,7 +60,7 @@ func (a *App) initializeTemplates() (bool, error) {
 		BlockModifier: fixTemplateBlock,
 		BoardModifier: fixTemplateBoard,
 	}
-	if err = a.ImportArchive(r, opt); err != nil {
+	if err = a.ImportArchive(ctx, r, opt); err != nil {
 		return false, fmt.Errorf("cannot initialize global templates for team %s: %w", model.GlobalTeamID, err)
 	}
 	return true, nil
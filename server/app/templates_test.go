@@ -4,6 +4,7 @@
 package app
 
 import (
+	"context"
 	"testing"
 
 	"github.com/golang/mock/gomock"
@@ -46,17 +47,17 @@ func TestApp_initializeTemplates(t *testing.T) {
 		th, tearDown := SetupTestHelper(t)
 		defer tearDown()
 
-		th.Store.EXPECT().GetTemplateBoards(model.GlobalTeamID, "").Return([]*model.Board{}, nil)
-		th.Store.EXPECT().RemoveDefaultTemplates([]*model.Board{}).Return(nil)
-		th.Store.EXPECT().CreateBoardsAndBlocks(gomock.Any(), gomock.Any()).AnyTimes().Return(boardsAndBlocks, nil)
-		th.Store.EXPECT().GetMembersForBoard(board.ID).AnyTimes().Return([]*model.BoardMember{}, nil)
-		th.Store.EXPECT().GetBoard(board.ID).AnyTimes().Return(board, nil)
-		th.Store.EXPECT().GetMemberForBoard(gomock.Any(), gomock.Any()).AnyTimes().Return(boardMember, nil)
-		th.Store.EXPECT().SaveFileInfo(gomock.Any()).Return(nil).AnyTimes()
+		th.Store.EXPECT().GetTemplateBoards(gomock.Any(), model.GlobalTeamID, "").Return([]*model.Board{}, nil)
+		th.Store.EXPECT().RemoveDefaultTemplates(gomock.Any(), []*model.Board{}).Return(nil)
+		th.Store.EXPECT().CreateBoardsAndBlocks(gomock.Any(), gomock.Any(), gomock.Any()).AnyTimes().Return(boardsAndBlocks, nil)
+		th.Store.EXPECT().GetMembersForBoard(gomock.Any(), board.ID).AnyTimes().Return([]*model.BoardMember{}, nil)
+		th.Store.EXPECT().GetBoard(gomock.Any(), board.ID).AnyTimes().Return(board, nil)
+		th.Store.EXPECT().GetMemberForBoard(gomock.Any(), gomock.Any(), gomock.Any()).AnyTimes().Return(boardMember, nil)
+		th.Store.EXPECT().SaveFileInfo(gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
 
 		th.FilesBackend.On("WriteFile", mock.Anything, mock.Anything).Return(int64(1), nil)
 
-		done, err := th.App.initializeTemplates()
+		done, err := th.App.initializeTemplates(context.Background())
 		require.NoError(t, err, "initializeTemplates should not error")
 		require.True(t, done, "initialization was needed")
 	})
@@ -65,9 +66,9 @@ func TestApp_initializeTemplates(t *testing.T) {
 		th, tearDown := SetupTestHelper(t)
 		defer tearDown()
 
-		th.Store.EXPECT().GetTemplateBoards(model.GlobalTeamID, "").Return([]*model.Board{board}, nil)
+		th.Store.EXPECT().GetTemplateBoards(gomock.Any(), model.GlobalTeamID, "").Return([]*model.Board{board}, nil)
 
-		done, err := th.App.initializeTemplates()
+		done, err := th.App.initializeTemplates(context.Background())
 		require.NoError(t, err, "initializeTemplates should not error")
 		require.False(t, done, "initialization was not needed")
 	})
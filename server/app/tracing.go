@@ -0,0 +1,22 @@
+// Copyright (c) 2020-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package app
+
+import (
+	"context"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/mattermost/mattermost-plugin-boards/server/services/tracing"
+)
+
+// startSpan starts the span of an App method, child of the span of the request or of the job
+// calling it. The context is returned unchanged when tracing is disabled.
+func startSpan(ctx context.Context, method string) (context.Context, trace.Span) {
+	if !tracing.Enabled() {
+		return ctx, trace.SpanFromContext(context.Background())
+	}
+	return tracing.StartSpan(ctx, "app."+method, attribute.String("app.method", method))
+}
@@ -4,16 +4,24 @@
 package app
 
 import (
+	"context"
+
 	"github.com/mattermost/mattermost-plugin-boards/server/model"
 	mmModel "github.com/mattermost/mattermost/server/public/model"
 )
 
-func (a *App) GetTeamUsers(teamID string, asGuestID string) ([]*model.User, error) {
-	return a.store.GetUsersByTeam(teamID, asGuestID, a.config.ShowEmailAddress, a.config.ShowFullName)
+func (a *App) GetTeamUsers(ctx context.Context, teamID string, asGuestID string) ([]*model.User, error) {
+	ctx, span := startSpan(ctx, "GetTeamUsers")
+	defer span.End()
+
+	return a.store.GetUsersByTeam(ctx, teamID, asGuestID, a.config.ShowEmailAddress, a.config.ShowFullName)
 }
 
-func (a *App) SearchTeamUsers(teamID string, searchQuery string, asGuestID string, excludeBots bool) ([]*model.User, error) {
-	users, err := a.store.SearchUsersByTeam(teamID, searchQuery, asGuestID, excludeBots, a.config.ShowEmailAddress, a.config.ShowFullName)
+func (a *App) SearchTeamUsers(ctx context.Context, teamID string, searchQuery string, asGuestID string, excludeBots bool) ([]*model.User, error) {
+	ctx, span := startSpan(ctx, "SearchTeamUsers")
+	defer span.End()
+
+	users, err := a.store.SearchUsersByTeam(ctx, teamID, searchQuery, asGuestID, excludeBots, a.config.ShowEmailAddress, a.config.ShowFullName)
 	if err != nil {
 		return nil, err
 	}
@@ -29,8 +37,11 @@ func (a *App) SearchTeamUsers(teamID string, searchQuery string, asGuestID strin
 	return users, nil
 }
 
-func (a *App) UpdateUserConfig(userID string, patch model.UserPreferencesPatch) ([]mmModel.Preference, error) {
-	updatedPreferences, err := a.store.PatchUserPreferences(userID, patch)
+func (a *App) UpdateUserConfig(ctx context.Context, userID string, patch model.UserPreferencesPatch) ([]mmModel.Preference, error) {
+	ctx, span := startSpan(ctx, "UpdateUserConfig")
+	defer span.End()
+
+	updatedPreferences, err := a.store.PatchUserPreferences(ctx, userID, patch)
 	if err != nil {
 		return nil, err
 	}
@@ -38,25 +49,34 @@ func (a *App) UpdateUserConfig(userID string, patch model.UserPreferencesPatch)
 	return updatedPreferences, nil
 }
 
-func (a *App) GetUserPreferences(userID string) ([]mmModel.Preference, error) {
-	return a.store.GetUserPreferences(userID)
+func (a *App) GetUserPreferences(ctx context.Context, userID string) ([]mmModel.Preference, error) {
+	ctx, span := startSpan(ctx, "GetUserPreferences")
+	defer span.End()
+
+	return a.store.GetUserPreferences(ctx, userID)
 }
 
-func (a *App) UserIsGuest(userID string) (bool, error) {
-	user, err := a.store.GetUserByID(userID)
+func (a *App) UserIsGuest(ctx context.Context, userID string) (bool, error) {
+	ctx, span := startSpan(ctx, "UserIsGuest")
+	defer span.End()
+
+	user, err := a.store.GetUserByID(ctx, userID)
 	if err != nil {
 		return false, err
 	}
 	return user.IsGuest, nil
 }
 
-func (a *App) CanSeeUser(seerUser string, seenUser string) (bool, error) {
-	isGuest, err := a.UserIsGuest(seerUser)
+func (a *App) CanSeeUser(ctx context.Context, seerUser string, seenUser string) (bool, error) {
+	ctx, span := startSpan(ctx, "CanSeeUser")
+	defer span.End()
+
+	isGuest, err := a.UserIsGuest(ctx, seerUser)
 	if err != nil {
 		return false, err
 	}
 	if isGuest {
-		hasSharedChannels, err := a.store.CanSeeUser(seerUser, seenUser)
+		hasSharedChannels, err := a.store.CanSeeUser(ctx, seerUser, seenUser)
 		if err != nil {
 			return false, err
 		}
@@ -65,8 +85,11 @@ func (a *App) CanSeeUser(seerUser string, seenUser string) (bool, error) {
 	return true, nil
 }
 
-func (a *App) SearchUserChannels(teamID string, userID string, query string) ([]*mmModel.Channel, error) {
-	channels, err := a.store.SearchUserChannels(teamID, userID, query)
+func (a *App) SearchUserChannels(ctx context.Context, teamID string, userID string, query string) ([]*mmModel.Channel, error) {
+	ctx, span := startSpan(ctx, "SearchUserChannels")
+	defer span.End()
+
+	channels, err := a.store.SearchUserChannels(ctx, teamID, userID, query)
 	if err != nil {
 		return nil, err
 	}
@@ -80,8 +103,11 @@ func (a *App) SearchUserChannels(teamID string, userID string, query string) ([]
 	return writeableChannels, nil
 }
 
-func (a *App) GetChannel(teamID string, channelID string) (*mmModel.Channel, error) {
-	return a.store.GetChannel(teamID, channelID)
+func (a *App) GetChannel(ctx context.Context, teamID string, channelID string) (*mmModel.Channel, error) {
+	ctx, span := startSpan(ctx, "GetChannel")
+	defer span.End()
+
+	return a.store.GetChannel(ctx, teamID, channelID)
 }
 
 func (a *App) SanitizeProfile(user *model.User, isAdmin bool) {
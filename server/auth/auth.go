@@ -5,6 +5,8 @@
 package auth
 
 import (
+	"context"
+
 	"github.com/mattermost/mattermost-plugin-boards/server/model"
 	"github.com/mattermost/mattermost-plugin-boards/server/services/config"
 	"github.com/mattermost/mattermost-plugin-boards/server/services/permissions"
@@ -12,8 +14,15 @@ import (
 	"github.com/pkg/errors"
 )
 
+const (
+	// lastUsedResolutionMillis is how often the last used time of a share link is updated.
+	lastUsedResolutionMillis = 60 * 1000
+)
+
 type AuthInterface interface {
-	IsValidReadToken(boardID string, readToken string) (bool, error)
+	IsValidReadToken(ctx context.Context, boardID string, readToken string, access model.ReadTokenAccess) (bool, error)
+	GetShareLinkForReadToken(ctx context.Context, boardID string, readToken string, password string) (*model.ShareLink, error)
+	GetShareLinkScopeFilter(ctx context.Context, link *model.ShareLink) (*model.ShareLinkScopeFilter, error)
 	DoesUserHaveTeamAccess(userID string, teamID string) bool
 }
 
@@ -29,25 +38,130 @@ func New(config *config.Configuration, store store.Store, permissions permission
 	return &Auth{config: config, store: store, permissions: permissions}
 }
 
-// IsValidReadToken validates the read token for a board.
-func (a *Auth) IsValidReadToken(boardID string, readToken string) (bool, error) {
-	sharing, err := a.store.GetSharing(boardID)
-	if model.IsErrNotFound(err) {
+// IsValidReadToken validates the read token for a board. The token must belong to an active share
+// link of the board granting the requested permission, and every requested block must be within
+// the scope of the link.
+func (a *Auth) IsValidReadToken(ctx context.Context, boardID string, readToken string, access model.ReadTokenAccess) (bool, error) {
+	link, err := a.GetShareLinkForReadToken(ctx, boardID, readToken, access.Password)
+	if err != nil || link == nil {
+		return false, err
+	}
+
+	permission := access.Permission
+	if permission == "" {
+		permission = model.ShareLinkPermissionView
+	}
+	if !link.Permission.Includes(permission) {
 		return false, nil
 	}
+
+	if len(access.BlockIDs) == 0 {
+		return true, nil
+	}
+
+	filter, err := a.GetShareLinkScopeFilter(ctx, link)
 	if err != nil {
 		return false, err
 	}
+	for _, blockID := range access.BlockIDs {
+		block, err := a.store.GetBlock(ctx, blockID)
+		if model.IsErrNotFound(err) {
+			return false, nil
+		}
+		if err != nil {
+			return false, err
+		}
+		allowed, err := a.isBlockInShareLinkScope(ctx, filter, block)
+		if err != nil || !allowed {
+			return false, err
+		}
+	}
+	return true, nil
+}
+
+// GetShareLinkScopeFilter returns the filter of the blocks within the scope of a share link.
+func (a *Auth) GetShareLinkScopeFilter(ctx context.Context, link *model.ShareLink) (*model.ShareLinkScopeFilter, error) {
+	if link.Scope != model.ShareLinkScopeView {
+		return model.NewShareLinkScopeFilter(link, nil, nil)
+	}
+
+	board, err := a.store.GetBoard(ctx, link.BoardID)
+	if err != nil {
+		return nil, err
+	}
+	view, err := a.store.GetBlock(ctx, link.ScopeID)
+	if err != nil && !model.IsErrNotFound(err) {
+		return nil, err
+	}
+	return model.NewShareLinkScopeFilter(link, board, view)
+}
+
+// isBlockInShareLinkScope returns true if the block is within the scope of the share link,
+// fetching the card the block belongs to if needed.
+func (a *Auth) isBlockInShareLinkScope(ctx context.Context, filter *model.ShareLinkScopeFilter, block *model.Block) (bool, error) {
+	var card *model.Block
+	if filter.NeedsCard(block) && block.ParentID != "" {
+		var err error
+		if card, err = a.store.GetBlock(ctx, block.ParentID); err != nil && !model.IsErrNotFound(err) {
+			return false, err
+		}
+	}
+	return filter.AllowsBlock(block, card), nil
+}
+
+// GetShareLinkForReadToken returns the active share link of a board with the specified token, or
+// nil if there is none or the password does not match. The legacy per-board sharing token is
+// returned as a board scoped, view only link. Using a share link records its last used time.
+func (a *Auth) GetShareLinkForReadToken(ctx context.Context, boardID string, readToken string, password string) (*model.ShareLink, error) {
+	if readToken == "" {
+		return nil, nil
+	}
+
+	sharing, err := a.store.GetSharing(ctx, boardID)
+	if err != nil && !model.IsErrNotFound(err) {
+		return nil, err
+	}
+
+	link, err := a.store.GetShareLinkByToken(ctx, boardID, readToken)
+	if err != nil && !model.IsErrNotFound(err) {
+		return nil, err
+	}
+
+	if sharing == nil && link == nil {
+		return nil, nil
+	}
 
 	if !a.config.EnablePublicSharedBoards {
-		return false, errors.New("public shared boards disabled")
+		return nil, errors.New("public shared boards disabled")
 	}
 
-	if sharing != nil && (sharing.ID == boardID && sharing.Enabled && sharing.Token == readToken) {
-		return true, nil
+	if sharing != nil && sharing.ID == boardID && sharing.Enabled && sharing.Token == readToken {
+		return &model.ShareLink{
+			ID:         sharing.ID,
+			BoardID:    boardID,
+			Token:      sharing.Token,
+			Scope:      model.ShareLinkScopeBoard,
+			Permission: model.ShareLinkPermissionView,
+		}, nil
 	}
 
-	return false, nil
+	if link == nil {
+		return nil, nil
+	}
+
+	now := model.GetMillis()
+	if !link.IsActive(now) || !link.CheckPassword(password) {
+		return nil, nil
+	}
+
+	// avoid a write on every request made with the link.
+	if now-link.LastUsedAt >= lastUsedResolutionMillis {
+		if err := a.store.UpdateShareLinkLastUsed(ctx, link.ID, now); err != nil {
+			return nil, err
+		}
+		link.LastUsedAt = now
+	}
+	return link, nil
 }
 
 func (a *Auth) DoesUserHaveTeamAccess(userID string, teamID string) bool {
@@ -4,10 +4,16 @@
 package auth
 
 import (
+	"context"
 	"testing"
 
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+
 	"github.com/mattermost/mattermost-plugin-boards/server/model"
+	"github.com/mattermost/mattermost-plugin-boards/server/services/config"
 	"github.com/mattermost/mattermost-plugin-boards/server/services/store/mockstore"
+	"github.com/mattermost/mattermost-plugin-boards/server/utils"
 )
 
 type TestHelper struct {
@@ -16,58 +22,110 @@ type TestHelper struct {
 	Store   *mockstore.MockStore
 }
 
+func setupTestHelper(t *testing.T) *TestHelper {
+	ctrl := gomock.NewController(t)
+	mockStore := mockstore.NewMockStore(ctrl)
+	cfg := &config.Configuration{EnablePublicSharedBoards: true}
+
+	return &TestHelper{
+		Auth:  New(cfg, mockStore, nil),
+		Store: mockStore,
+	}
+}
+
 func TestIsValidReadToken(t *testing.T) {
-	// ToDo: reimplement
-
-	// th := setupTestHelper(t)
-
-	// validBlockID := "testBlockID"
-	// mockContainer := store.Container{
-	// 	TeamID: "testTeamID",
-	// }
-	// validReadToken := "testReadToken"
-	// mockSharing := model.Sharing{
-	// 	ID:      "testRootID",
-	// 	Enabled: true,
-	// 	Token:   validReadToken,
-	// }
-
-	// testcases := []struct {
-	// 	title     string
-	// 	container store.Container
-	// 	blockID   string
-	// 	readToken string
-	// 	isError   bool
-	// 	isSuccess bool
-	// }{
-	// 	{"fail, error GetRootID", mockContainer, "badBlock", "", true, false},
-	// 	{"fail, rootID not found", mockContainer, "goodBlockID", "", false, false},
-	// 	{"fail, sharing throws error", mockContainer, "goodBlockID2", "", true, false},
-	// 	{"fail, bad readToken", mockContainer, validBlockID, "invalidReadToken", false, false},
-	// 	{"success", mockContainer, validBlockID, validReadToken, false, true},
-	// }
-
-	// th.Store.EXPECT().GetRootID(gomock.Eq(mockContainer), "badBlock").Return("", errors.New("invalid block"))
-	// th.Store.EXPECT().GetRootID(gomock.Eq(mockContainer), "goodBlockID").Return("rootNotFound", nil)
-	// th.Store.EXPECT().GetRootID(gomock.Eq(mockContainer), "goodBlockID2").Return("rootError", nil)
-	// th.Store.EXPECT().GetRootID(gomock.Eq(mockContainer), validBlockID).Return("testRootID", nil).Times(2)
-	// th.Store.EXPECT().GetSharing(gomock.Eq(mockContainer), "rootNotFound").Return(nil, sql.ErrNoRows)
-	// th.Store.EXPECT().GetSharing(gomock.Eq(mockContainer), "rootError").Return(nil, errors.New("another error"))
-	// th.Store.EXPECT().GetSharing(gomock.Eq(mockContainer), "testRootID").Return(&mockSharing, nil).Times(2)
-
-	// for _, test := range testcases {
-	// 	t.Run(test.title, func(t *testing.T) {
-	// 		success, err := th.Auth.IsValidReadToken(test.container, test.blockID, test.readToken)
-	// 		if test.isError {
-	// 			require.Error(t, err)
-	// 		} else {
-	// 			require.NoError(t, err)
-	// 		}
-	// 		if test.isSuccess {
-	// 			require.True(t, success)
-	// 		} else {
-	// 			require.False(t, success)
-	// 		}
-	// 	})
-	// }
+	boardID := "board-id"
+	notFound := model.NewErrNotFound("not found")
+
+	t.Run("legacy sharing token", func(t *testing.T) {
+		th := setupTestHelper(t)
+		sharing := &model.Sharing{ID: boardID, Enabled: true, Token: "legacy-token"}
+		th.Store.EXPECT().GetSharing(gomock.Any(), boardID).Return(sharing, nil).Times(2)
+		th.Store.EXPECT().GetShareLinkByToken(gomock.Any(), boardID, "legacy-token").Return(nil, notFound).Times(2)
+
+		isValid, err := th.Auth.IsValidReadToken(context.Background(), boardID, "legacy-token", model.ReadTokenAccess{})
+		require.NoError(t, err)
+		require.True(t, isValid)
+
+		isValid, err = th.Auth.IsValidReadToken(context.Background(), boardID, "legacy-token", model.ReadTokenAccess{Permission: model.ShareLinkPermissionComment})
+		require.NoError(t, err)
+		require.False(t, isValid, "legacy tokens are view only")
+	})
+
+	t.Run("unknown token", func(t *testing.T) {
+		th := setupTestHelper(t)
+		th.Store.EXPECT().GetSharing(gomock.Any(), boardID).Return(nil, notFound)
+		th.Store.EXPECT().GetShareLinkByToken(gomock.Any(), boardID, "bad-token").Return(nil, notFound)
+
+		isValid, err := th.Auth.IsValidReadToken(context.Background(), boardID, "bad-token", model.ReadTokenAccess{})
+		require.NoError(t, err)
+		require.False(t, isValid)
+	})
+
+	t.Run("expired and revoked links", func(t *testing.T) {
+		th := setupTestHelper(t)
+		expired := &model.ShareLink{ID: "l1", BoardID: boardID, Token: "expired", Scope: model.ShareLinkScopeBoard,
+			Permission: model.ShareLinkPermissionView, ExpiresAt: utils.GetMillis() - 1000}
+		revoked := &model.ShareLink{ID: "l2", BoardID: boardID, Token: "revoked", Scope: model.ShareLinkScopeBoard,
+			Permission: model.ShareLinkPermissionView, RevokedAt: utils.GetMillis()}
+		th.Store.EXPECT().GetSharing(gomock.Any(), boardID).Return(nil, notFound).Times(2)
+		th.Store.EXPECT().GetShareLinkByToken(gomock.Any(), boardID, "expired").Return(expired, nil)
+		th.Store.EXPECT().GetShareLinkByToken(gomock.Any(), boardID, "revoked").Return(revoked, nil)
+
+		for _, token := range []string{"expired", "revoked"} {
+			isValid, err := th.Auth.IsValidReadToken(context.Background(), boardID, token, model.ReadTokenAccess{})
+			require.NoError(t, err)
+			require.False(t, isValid, token)
+		}
+	})
+
+	t.Run("password protected link", func(t *testing.T) {
+		th := setupTestHelper(t)
+		link := &model.ShareLink{ID: "l1", BoardID: boardID, Token: "token", Scope: model.ShareLinkScopeBoard,
+			Permission: model.ShareLinkPermissionView}
+		require.NoError(t, link.SetPassword("secret"))
+		th.Store.EXPECT().GetSharing(gomock.Any(), boardID).Return(nil, notFound).Times(2)
+		th.Store.EXPECT().GetShareLinkByToken(gomock.Any(), boardID, "token").Return(link, nil).Times(2)
+		th.Store.EXPECT().UpdateShareLinkLastUsed(gomock.Any(), "l1", gomock.Any()).Return(nil)
+
+		isValid, err := th.Auth.IsValidReadToken(context.Background(), boardID, "token", model.ReadTokenAccess{Password: "wrong"})
+		require.NoError(t, err)
+		require.False(t, isValid)
+
+		isValid, err = th.Auth.IsValidReadToken(context.Background(), boardID, "token", model.ReadTokenAccess{Password: "secret"})
+		require.NoError(t, err)
+		require.True(t, isValid)
+	})
+
+	t.Run("card scoped link", func(t *testing.T) {
+		th := setupTestHelper(t)
+		link := &model.ShareLink{ID: "l1", BoardID: boardID, Token: "token", Scope: model.ShareLinkScopeCard,
+			ScopeID: "card-1", Permission: model.ShareLinkPermissionComment, LastUsedAt: utils.GetMillis()}
+		th.Store.EXPECT().GetSharing(gomock.Any(), boardID).Return(nil, notFound).Times(2)
+		th.Store.EXPECT().GetShareLinkByToken(gomock.Any(), boardID, "token").Return(link, nil).Times(2)
+		th.Store.EXPECT().GetBlock(gomock.Any(), "card-1").Return(&model.Block{ID: "card-1", BoardID: boardID, Type: model.TypeCard}, nil)
+		th.Store.EXPECT().GetBlock(gomock.Any(), "card-2").Return(&model.Block{ID: "card-2", BoardID: boardID, Type: model.TypeCard}, nil)
+
+		access := model.ReadTokenAccess{BlockIDs: []string{"card-1"}, Permission: model.ShareLinkPermissionComment}
+		isValid, err := th.Auth.IsValidReadToken(context.Background(), boardID, "token", access)
+		require.NoError(t, err)
+		require.True(t, isValid)
+
+		access.BlockIDs = []string{"card-2"}
+		isValid, err = th.Auth.IsValidReadToken(context.Background(), boardID, "token", access)
+		require.NoError(t, err)
+		require.False(t, isValid)
+	})
+
+	t.Run("public sharing disabled", func(t *testing.T) {
+		th := setupTestHelper(t)
+		th.Auth.config.EnablePublicSharedBoards = false
+		sharing := &model.Sharing{ID: boardID, Enabled: true, Token: "legacy-token"}
+		th.Store.EXPECT().GetSharing(gomock.Any(), boardID).Return(sharing, nil)
+		th.Store.EXPECT().GetShareLinkByToken(gomock.Any(), boardID, "legacy-token").Return(nil, notFound)
+
+		isValid, err := th.Auth.IsValidReadToken(context.Background(), boardID, "legacy-token", model.ReadTokenAccess{})
+		require.Error(t, err)
+		require.False(t, isValid)
+	})
 }
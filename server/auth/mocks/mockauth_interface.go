@@ -5,9 +5,11 @@
 package mocks
 
 import (
+	context "context"
 	reflect "reflect"
 
 	gomock "github.com/golang/mock/gomock"
+	model "github.com/mattermost/mattermost-plugin-boards/server/model"
 )
 
 // MockAuthInterface is a mock of AuthInterface interface.
@@ -47,17 +49,47 @@ func (mr *MockAuthInterfaceMockRecorder) DoesUserHaveTeamAccess(arg0, arg1 inter
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DoesUserHaveTeamAccess", reflect.TypeOf((*MockAuthInterface)(nil).DoesUserHaveTeamAccess), arg0, arg1)
 }
 
+// GetShareLinkForReadToken mocks base method.
+func (m *MockAuthInterface) GetShareLinkForReadToken(arg0 context.Context, arg1, arg2, arg3 string) (*model.ShareLink, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetShareLinkForReadToken", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(*model.ShareLink)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetShareLinkForReadToken indicates an expected call of GetShareLinkForReadToken.
+func (mr *MockAuthInterfaceMockRecorder) GetShareLinkForReadToken(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetShareLinkForReadToken", reflect.TypeOf((*MockAuthInterface)(nil).GetShareLinkForReadToken), arg0, arg1, arg2, arg3)
+}
+
+// GetShareLinkScopeFilter mocks base method.
+func (m *MockAuthInterface) GetShareLinkScopeFilter(arg0 context.Context, arg1 *model.ShareLink) (*model.ShareLinkScopeFilter, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetShareLinkScopeFilter", arg0, arg1)
+	ret0, _ := ret[0].(*model.ShareLinkScopeFilter)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetShareLinkScopeFilter indicates an expected call of GetShareLinkScopeFilter.
+func (mr *MockAuthInterfaceMockRecorder) GetShareLinkScopeFilter(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetShareLinkScopeFilter", reflect.TypeOf((*MockAuthInterface)(nil).GetShareLinkScopeFilter), arg0, arg1)
+}
+
 // IsValidReadToken mocks base method.
-func (m *MockAuthInterface) IsValidReadToken(arg0, arg1 string) (bool, error) {
+func (m *MockAuthInterface) IsValidReadToken(arg0 context.Context, arg1, arg2 string, arg3 model.ReadTokenAccess) (bool, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "IsValidReadToken", arg0, arg1)
+	ret := m.ctrl.Call(m, "IsValidReadToken", arg0, arg1, arg2, arg3)
 	ret0, _ := ret[0].(bool)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // IsValidReadToken indicates an expected call of IsValidReadToken.
-func (mr *MockAuthInterfaceMockRecorder) IsValidReadToken(arg0, arg1 interface{}) *gomock.Call {
+func (mr *MockAuthInterfaceMockRecorder) IsValidReadToken(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsValidReadToken", reflect.TypeOf((*MockAuthInterface)(nil).IsValidReadToken), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsValidReadToken", reflect.TypeOf((*MockAuthInterface)(nil).IsValidReadToken), arg0, arg1, arg2, arg3)
 }
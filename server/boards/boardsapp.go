@@ -7,13 +7,16 @@ import (
 	"fmt"
 	"net/http"
 	"sync"
+	"time"
 
+	"github.com/mattermost/mattermost-plugin-boards/server/app"
 	"github.com/mattermost/mattermost-plugin-boards/server/auth"
 	"github.com/mattermost/mattermost-plugin-boards/server/model"
 	"github.com/mattermost/mattermost-plugin-boards/server/server"
 	"github.com/mattermost/mattermost-plugin-boards/server/services/notify"
 	"github.com/mattermost/mattermost-plugin-boards/server/services/permissions/mmpermissions"
 	"github.com/mattermost/mattermost-plugin-boards/server/services/store"
+	"github.com/mattermost/mattermost-plugin-boards/server/services/store/cachelayer"
 	"github.com/mattermost/mattermost-plugin-boards/server/services/store/sqlstore"
 	"github.com/mattermost/mattermost-plugin-boards/server/ws"
 	"github.com/mattermost/mattermost/server/public/pluginapi/cluster"
@@ -30,6 +33,25 @@ const (
 
 	notifyFreqCardSecondsKey  = "notify_freq_card_seconds"
 	notifyFreqBoardSecondsKey = "notify_freq_board_seconds"
+	notifyUnassignmentKey     = "notify_unassignment"
+	formSubmissionsPerHourKey = "form_submissions_per_hour"
+	fileScannerKey            = "file_scanner"
+	clamdAddressKey           = "clamd_address"
+	clamdTimeoutSecondsKey    = "clamd_timeout_seconds"
+	fileGCFrequencyHoursKey   = "file_gc_frequency_hours"
+	fileGCGracePeriodHoursKey = "file_gc_grace_period_hours"
+	teamStorageQuotaMBKey     = "team_storage_quota_mb"
+	boardStorageQuotaMBKey    = "board_storage_quota_mb"
+	fileDeduplicationKey      = "file_deduplication"
+	auditLogPersistedKey      = "audit_log_persisted"
+	auditLogRetentionDaysKey  = "audit_log_retention_days"
+	tracingEnabledKey         = "tracing_enabled"
+	tracingEndpointKey        = "tracing_endpoint"
+	tracingInsecureKey        = "tracing_insecure"
+	tracingSampleRatioKey     = "tracing_sample_ratio"
+	dbQueryTimeoutSecondsKey  = "db_query_timeout_seconds"
+	cacheSizeKey              = "cache_size"
+	cacheTTLSecondsKey        = "cache_ttl_seconds"
 )
 
 type BoardsEmbed struct {
@@ -53,6 +75,7 @@ type BoardsApp struct {
 
 	server          *server.Server
 	wsPluginAdapter ws.PluginAdapterInterface
+	cacheLayer      *cachelayer.CacheLayer
 
 	servicesAPI model.ServicesAPI
 	logger      mlog.LoggerIFace
@@ -82,8 +105,9 @@ func NewBoardsApp(api model.ServicesAPI, manifest *mm_model.Manifest) (*BoardsAp
 		NewMutexFn: func(name string) (*cluster.Mutex, error) {
 			return cluster.NewMutex(&mutexAPIAdapter{api: api}, name)
 		},
-		ServicesAPI: api,
-		ConfigFn:    api.GetConfig,
+		ServicesAPI:  api,
+		ConfigFn:     api.GetConfig,
+		QueryTimeout: time.Duration(cfg.DBQueryTimeout) * time.Second,
 	}
 
 	var db store.Store
@@ -92,6 +116,19 @@ func NewBoardsApp(api model.ServicesAPI, manifest *mm_model.Manifest) (*BoardsAp
 		return nil, fmt.Errorf("error initializing the DB: %w", err)
 	}
 
+	// the boards, memberships and categories read by most requests are cached, and the
+	// writes invalidate them on the other nodes through cluster events
+	var cacheLayer *cachelayer.CacheLayer
+	if cfg.CacheSize > 0 {
+		cacheLayer = cachelayer.New(db, cachelayer.Params{
+			Size:       cfg.CacheSize,
+			TTL:        time.Duration(cfg.CacheTTLSeconds) * time.Second,
+			ClusterAPI: api,
+			Logger:     logger,
+		})
+		db = cacheLayer
+	}
+
 	permissionsService := mmpermissions.New(db, api, logger)
 
 	wsPluginAdapter := ws.NewPluginAdapter(api, auth.New(cfg, db, permissionsService), db, logger)
@@ -130,6 +167,7 @@ func NewBoardsApp(api model.ServicesAPI, manifest *mm_model.Manifest) (*BoardsAp
 		NotifyBackends:     notifyBackends,
 		PermissionsService: permissionsService,
 		IsPlugin:           true,
+		NewMutexFn:         app.MutexFactory(storeParams.NewMutexFn),
 	}
 
 	server, err := server.New(params)
@@ -158,6 +196,7 @@ func NewBoardsApp(api model.ServicesAPI, manifest *mm_model.Manifest) (*BoardsAp
 		manifest:        manifest,
 		server:          server,
 		wsPluginAdapter: wsPluginAdapter,
+		cacheLayer:      cacheLayer,
 		servicesAPI:     api,
 		logger:          logger,
 	}, nil
@@ -204,6 +243,12 @@ func (b *BoardsApp) WebSocketMessageHasBeenPosted(webConnID, userID string, req
 }
 
 func (b *BoardsApp) OnPluginClusterEvent(_ *plugin.Context, ev mm_model.PluginClusterEvent) {
+	if ev.Id == cachelayer.ClusterEventID {
+		if b.cacheLayer != nil {
+			b.cacheLayer.HandleClusterEvent(ev)
+		}
+		return
+	}
 	b.wsPluginAdapter.HandleClusterEvent(ev)
 }
 
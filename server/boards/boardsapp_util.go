@@ -88,6 +88,7 @@ func createBoardsConfig(mmconfig mm_model.Config, baseURL string, serverID strin
 		DBType:                   *mmconfig.SqlSettings.DriverName,
 		DBConfigString:           *mmconfig.SqlSettings.DataSource,
 		DBTablePrefix:            "focalboard_",
+		DBQueryTimeout:           getPluginSettingInt(mmconfig, dbQueryTimeoutSecondsKey, config.DBQueryTimeout),
 		UseSSL:                   false,
 		SecureCookie:             true,
 		WebPath:                  path.Join(*mmconfig.PluginSettings.Directory, "focalboard", "pack"),
@@ -108,6 +109,24 @@ func createBoardsConfig(mmconfig mm_model.Config, baseURL string, serverID strin
 		FeatureFlags:             featureFlags,
 		NotifyFreqCardSeconds:    getPluginSettingInt(mmconfig, notifyFreqCardSecondsKey, 120),
 		NotifyFreqBoardSeconds:   getPluginSettingInt(mmconfig, notifyFreqBoardSecondsKey, 86400),
+		NotifyUnassignment:       getPluginSettingBool(mmconfig, notifyUnassignmentKey, false),
+		FormSubmissionsPerHour:   getPluginSettingInt(mmconfig, formSubmissionsPerHourKey, 20),
+		FileScanner:              getPluginSettingString(mmconfig, fileScannerKey, "none"),
+		ClamdAddress:             getPluginSettingString(mmconfig, clamdAddressKey, ""),
+		ClamdTimeoutSeconds:      getPluginSettingInt(mmconfig, clamdTimeoutSecondsKey, 60),
+		FileGCFrequencyHours:     getPluginSettingInt(mmconfig, fileGCFrequencyHoursKey, 24),
+		FileGCGracePeriodHours:   getPluginSettingInt(mmconfig, fileGCGracePeriodHoursKey, 168),
+		TeamStorageQuotaMB:       getPluginSettingInt(mmconfig, teamStorageQuotaMBKey, 0),
+		BoardStorageQuotaMB:      getPluginSettingInt(mmconfig, boardStorageQuotaMBKey, 0),
+		FileDeduplication:        getPluginSettingBool(mmconfig, fileDeduplicationKey, false),
+		AuditLogPersisted:        getPluginSettingBool(mmconfig, auditLogPersistedKey, false),
+		AuditLogRetentionDays:    getPluginSettingInt(mmconfig, auditLogRetentionDaysKey, 90),
+		TracingEnabled:           getPluginSettingBool(mmconfig, tracingEnabledKey, false),
+		TracingEndpoint:          getPluginSettingString(mmconfig, tracingEndpointKey, "localhost:4318"),
+		TracingInsecure:          getPluginSettingBool(mmconfig, tracingInsecureKey, true),
+		TracingSampleRatio:       getPluginSettingFloat(mmconfig, tracingSampleRatioKey, 1),
+		CacheSize:                getPluginSettingInt(mmconfig, cacheSizeKey, config.CacheSize),
+		CacheTTLSeconds:          getPluginSettingInt(mmconfig, cacheTTLSecondsKey, config.CacheTTL),
 		EnableDataRetention:      enableBoardsDeletion,
 		DataRetentionDays:        *mmconfig.DataRetentionSettings.BoardsRetentionDays,
 		TeammateNameDisplay:      *mmconfig.TeamSettings.TeammateNameDisplay,
@@ -155,3 +174,39 @@ func getPluginSettingInt(mmConfig mm_model.Config, key string, def int) int {
 	}
 	return int(math.Round(valFloat))
 }
+
+func getPluginSettingFloat(mmConfig mm_model.Config, key string, def float64) float64 {
+	val, ok := getPluginSetting(mmConfig, key)
+	if !ok {
+		return def
+	}
+	valFloat, ok := val.(float64)
+	if !ok {
+		return def
+	}
+	return valFloat
+}
+
+func getPluginSettingBool(mmConfig mm_model.Config, key string, def bool) bool {
+	val, ok := getPluginSetting(mmConfig, key)
+	if !ok {
+		return def
+	}
+	valBool, ok := val.(bool)
+	if !ok {
+		return def
+	}
+	return valBool
+}
+
+func getPluginSettingString(mmConfig mm_model.Config, key string, def string) string {
+	val, ok := getPluginSetting(mmConfig, key)
+	if !ok {
+		return def
+	}
+	valString, ok := val.(string)
+	if !ok {
+		return def
+	}
+	return valString
+}
@@ -3,6 +3,7 @@
 package boards
 
 import (
+	"context"
 	"errors"
 	"time"
 )
@@ -11,7 +12,7 @@ var ErrInsufficientLicense = errors.New("appropriate license required")
 
 func (b *BoardsApp) RunDataRetention(nowTime, batchSize int64) (int64, error) {
 	b.logger.Debug("Boards RunDataRetention")
-	license := b.server.Store().GetLicense()
+	license := b.server.Store().GetLicense(context.Background())
 	if license == nil || !(*license.Features.DataRetention) {
 		return 0, ErrInsufficientLicense
 	}
@@ -19,7 +20,7 @@ func (b *BoardsApp) RunDataRetention(nowTime, batchSize int64) (int64, error) {
 	if b.server.Config().EnableDataRetention {
 		boardsRetentionDays := b.server.Config().DataRetentionDays
 		endTimeBoards := convertDaysToCutoff(boardsRetentionDays, time.Unix(nowTime/1000, 0))
-		return b.server.Store().RunDataRetention(endTimeBoards, batchSize)
+		return b.server.Store().RunDataRetention(context.Background(), endTimeBoards, batchSize)
 	}
 	return 0, nil
 }
@@ -55,10 +55,10 @@ func newTestServerMock(mockStore *mockstore.MockStore) *server.Server {
 
 	logger, _ := mlog.NewLogger()
 
-	mockStore.EXPECT().GetTeam(gomock.Any()).Return(nil, nil).AnyTimes()
-	mockStore.EXPECT().UpsertTeamSignupToken(gomock.Any()).AnyTimes()
-	mockStore.EXPECT().GetSystemSettings().AnyTimes()
-	mockStore.EXPECT().SetSystemSetting(gomock.Any(), gomock.Any()).AnyTimes()
+	mockStore.EXPECT().GetTeam(gomock.Any(), gomock.Any()).Return(nil, nil).AnyTimes()
+	mockStore.EXPECT().UpsertTeamSignupToken(gomock.Any(), gomock.Any()).AnyTimes()
+	mockStore.EXPECT().GetSystemSettings(gomock.Any()).AnyTimes()
+	mockStore.EXPECT().SetSystemSetting(gomock.Any(), gomock.Any(), gomock.Any()).AnyTimes()
 
 	permissionsService := localpermissions.New(mockStore, logger)
 
@@ -88,7 +88,7 @@ func TestRunDataRetention(t *testing.T) {
 	now := time.Now().UnixNano()
 
 	t.Run("test null license", func(t *testing.T) {
-		th.Store.EXPECT().GetLicense().Return(nil)
+		th.Store.EXPECT().GetLicense(gomock.Any()).Return(nil)
 		_, err := b.RunDataRetention(now, 10)
 		assert.NotNil(t, err)
 		assert.Equal(t, ErrInsufficientLicense, err)
@@ -97,7 +97,7 @@ func TestRunDataRetention(t *testing.T) {
 	t.Run("test invalid license", func(t *testing.T) {
 		falseValue := false
 
-		th.Store.EXPECT().GetLicense().Return(
+		th.Store.EXPECT().GetLicense(gomock.Any()).Return(
 			&model.License{
 				Features: &model.Features{
 					DataRetention: &falseValue,
@@ -111,7 +111,7 @@ func TestRunDataRetention(t *testing.T) {
 
 	t.Run("test valid license, invalid config", func(t *testing.T) {
 		trueValue := true
-		th.Store.EXPECT().GetLicense().Return(
+		th.Store.EXPECT().GetLicense(gomock.Any()).Return(
 			&model.License{
 				Features: &model.Features{
 					DataRetention: &trueValue,
@@ -125,14 +125,14 @@ func TestRunDataRetention(t *testing.T) {
 
 	t.Run("test valid license, valid config", func(t *testing.T) {
 		trueValue := true
-		th.Store.EXPECT().GetLicense().Return(
+		th.Store.EXPECT().GetLicense(gomock.Any()).Return(
 			&model.License{
 				Features: &model.Features{
 					DataRetention: &trueValue,
 				},
 			})
 
-		th.Store.EXPECT().RunDataRetention(gomock.Any(), int64(10)).Return(int64(100), nil)
+		th.Store.EXPECT().RunDataRetention(gomock.Any(), gomock.Any(), int64(10)).Return(int64(100), nil)
 		b.server.Config().EnableDataRetention = true
 
 		count, err := b.RunDataRetention(now, 10)
@@ -4,6 +4,7 @@
 package boards
 
 import (
+	"context"
 	"fmt"
 	"time"
 
@@ -28,7 +29,7 @@ type notifyBackendParams struct {
 }
 
 func createMentionsNotifyBackend(params notifyBackendParams) (*notifymentions.Backend, error) {
-	delivery, err := createDelivery(params.servicesAPI, params.serverRoot)
+	delivery, err := createDelivery(params.servicesAPI, params.serverRoot, params.appAPI)
 	if err != nil {
 		return nil, err
 	}
@@ -46,7 +47,7 @@ func createMentionsNotifyBackend(params notifyBackendParams) (*notifymentions.Ba
 }
 
 func createSubscriptionsNotifyBackend(params notifyBackendParams) (*notifysubscriptions.Backend, error) {
-	delivery, err := createDelivery(params.servicesAPI, params.serverRoot)
+	delivery, err := createDelivery(params.servicesAPI, params.serverRoot, params.appAPI)
 	if err != nil {
 		return nil, err
 	}
@@ -59,13 +60,14 @@ func createSubscriptionsNotifyBackend(params notifyBackendParams) (*notifysubscr
 		Logger:                 params.logger,
 		NotifyFreqCardSeconds:  params.cfg.NotifyFreqCardSeconds,
 		NotifyFreqBoardSeconds: params.cfg.NotifyFreqBoardSeconds,
+		NotifyUnassignment:     params.cfg.NotifyUnassignment,
 	}
 	backend := notifysubscriptions.New(backendParams)
 
 	return backend, nil
 }
 
-func createDelivery(servicesAPI model.ServicesAPI, serverRoot string) (*plugindelivery.PluginDelivery, error) {
+func createDelivery(servicesAPI model.ServicesAPI, serverRoot string, appAPI *appAPI) (*plugindelivery.PluginDelivery, error) {
 	bot := model.FocalboardBot
 
 	botID, err := servicesAPI.EnsureBot(bot)
@@ -73,12 +75,15 @@ func createDelivery(servicesAPI model.ServicesAPI, serverRoot string) (*pluginde
 		return nil, fmt.Errorf("failed to ensure %s bot: %w", bot.DisplayName, err)
 	}
 
-	return plugindelivery.New(botID, serverRoot, servicesAPI), nil
+	return plugindelivery.New(botID, serverRoot, servicesAPI, appAPI), nil
 }
 
 type appIface interface {
-	CreateSubscription(sub *model.Subscription) (*model.Subscription, error)
-	AddMemberToBoard(member *model.BoardMember) (*model.BoardMember, error)
+	CreateSubscription(ctx context.Context, sub *model.Subscription) (*model.Subscription, error)
+	AddMemberToBoard(ctx context.Context, member *model.BoardMember) (*model.BoardMember, error)
+	GetNotificationPreferences(ctx context.Context, userID, boardID string) (*model.NotificationPreferences, error)
+	CreateNotification(ctx context.Context, notification *model.Notification) (*model.Notification, error)
+	SignFileURL(ctx context.Context, teamID, boardID, fileName string, expiresIn time.Duration) (*model.SignedFileURL, error)
 }
 
 // appAPI provides app and store APIs for notification services. Where appropriate calls are made to the
@@ -95,45 +100,65 @@ func (a *appAPI) init(store store.Store, app appIface) {
 }
 
 func (a *appAPI) GetBlockHistory(blockID string, opts model.QueryBlockHistoryOptions) ([]*model.Block, error) {
-	return a.store.GetBlockHistory(blockID, opts)
+	return a.store.GetBlockHistory(context.Background(), blockID, opts)
 }
 
 func (a *appAPI) GetBlockHistoryNewestChildren(parentID string, opts model.QueryBlockHistoryChildOptions) ([]*model.Block, bool, error) {
-	return a.store.GetBlockHistoryNewestChildren(parentID, opts)
+	return a.store.GetBlockHistoryNewestChildren(context.Background(), parentID, opts)
 }
 
 func (a *appAPI) GetBoardAndCardByID(blockID string) (board *model.Board, card *model.Block, err error) {
-	return a.store.GetBoardAndCardByID(blockID)
+	return a.store.GetBoardAndCardByID(context.Background(), blockID)
+}
+
+func (a *appAPI) GetBlockByID(blockID string) (*model.Block, error) {
+	return a.store.GetBlock(context.Background(), blockID)
 }
 
 func (a *appAPI) GetUserByID(userID string) (*model.User, error) {
-	return a.store.GetUserByID(userID)
+	return a.store.GetUserByID(context.Background(), userID)
 }
 
 func (a *appAPI) CreateSubscription(sub *model.Subscription) (*model.Subscription, error) {
-	return a.app.CreateSubscription(sub)
+	return a.app.CreateSubscription(context.Background(), sub)
 }
 
 func (a *appAPI) GetSubscribersForBlock(blockID string) ([]*model.Subscriber, error) {
-	return a.store.GetSubscribersForBlock(blockID)
+	return a.store.GetSubscribersForBlock(context.Background(), blockID)
 }
 
 func (a *appAPI) UpdateSubscribersNotifiedAt(blockID string, notifyAt int64) error {
-	return a.store.UpdateSubscribersNotifiedAt(blockID, notifyAt)
+	return a.store.UpdateSubscribersNotifiedAt(context.Background(), blockID, notifyAt)
 }
 
 func (a *appAPI) UpsertNotificationHint(hint *model.NotificationHint, notificationFreq time.Duration) (*model.NotificationHint, error) {
-	return a.store.UpsertNotificationHint(hint, notificationFreq)
+	return a.store.UpsertNotificationHint(context.Background(), hint, notificationFreq)
 }
 
 func (a *appAPI) GetNextNotificationHint(remove bool) (*model.NotificationHint, error) {
-	return a.store.GetNextNotificationHint(remove)
+	return a.store.GetNextNotificationHint(context.Background(), remove)
+}
+
+func (a *appAPI) GetNotificationHintCount() (int, error) {
+	return a.store.GetNotificationHintCount(context.Background())
 }
 
 func (a *appAPI) GetMemberForBoard(boardID, userID string) (*model.BoardMember, error) {
-	return a.store.GetMemberForBoard(boardID, userID)
+	return a.store.GetMemberForBoard(context.Background(), boardID, userID)
 }
 
 func (a *appAPI) AddMemberToBoard(member *model.BoardMember) (*model.BoardMember, error) {
-	return a.app.AddMemberToBoard(member)
+	return a.app.AddMemberToBoard(context.Background(), member)
+}
+
+func (a *appAPI) GetNotificationPreferences(userID, boardID string) (*model.NotificationPreferences, error) {
+	return a.app.GetNotificationPreferences(context.Background(), userID, boardID)
+}
+
+func (a *appAPI) CreateNotification(notification *model.Notification) (*model.Notification, error) {
+	return a.app.CreateNotification(context.Background(), notification)
+}
+
+func (a *appAPI) SignFileURL(teamID, boardID, fileName string, expiresIn time.Duration) (*model.SignedFileURL, error) {
+	return a.app.SignFileURL(context.Background(), teamID, boardID, fileName, expiresIn)
 }
@@ -4,6 +4,7 @@
 package boards
 
 import (
+	"context"
 	"path/filepath"
 
 	multierror "github.com/hashicorp/go-multierror"
@@ -29,20 +30,20 @@ type SupportPacket struct {
 func (b *BoardsApp) GenerateSupportData(_ *plugin.Context) ([]*model.FileData, error) {
 	var result *multierror.Error
 
-	boardCount, err := b.server.Store().GetBoardCount(true)
+	boardCount, err := b.server.Store().GetBoardCount(context.Background(), true)
 	if err != nil {
 		result = multierror.Append(result, errors.Wrap(err, "failed to get total board count"))
 	}
-	activeBoardCount, err := b.server.Store().GetBoardCount(false)
+	activeBoardCount, err := b.server.Store().GetBoardCount(context.Background(), false)
 	if err != nil {
 		result = multierror.Append(result, errors.Wrap(err, "failed to get active board count"))
 	}
 
-	cardsCount, err := b.server.Store().GetUsedCardsCount()
+	cardsCount, err := b.server.Store().GetUsedCardsCount(context.Background())
 	if err != nil {
 		result = multierror.Append(result, errors.Wrap(err, "failed to get total cards count"))
 	}
-	usedCardsCount, err := b.server.Store().GetUsedCardsCount()
+	usedCardsCount, err := b.server.Store().GetUsedCardsCount(context.Background())
 	if err != nil {
 		result = multierror.Append(result, errors.Wrap(err, "failed to get active cards count"))
 	}
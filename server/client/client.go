@@ -10,12 +10,11 @@ import (
 	"io"
 	"mime/multipart"
 	"net/http"
+	"net/url"
 	"strings"
 
 	"github.com/mattermost/mattermost-plugin-boards/server/api"
 	"github.com/mattermost/mattermost-plugin-boards/server/model"
-
-	mmModel "github.com/mattermost/mattermost/server/public/model"
 )
 
 const (
@@ -238,6 +237,23 @@ func (c *Client) GetAllBlocksForBoard(boardID string) ([]*model.Block, *Response
 	return model.BlocksFromJSON(r.Body), BuildResponse(r)
 }
 
+// GetBlocksPage returns a page of the blocks of a board following a cursor, and the cursor of
+// the next page, empty on the last page.
+func (c *Client) GetBlocksPage(boardID string, cursor string, perPage int) ([]*model.Block, string, *Response) {
+	query := url.Values{}
+	query.Set("per_page", fmt.Sprintf("%d", perPage))
+	if cursor != "" {
+		query.Set("cursor", cursor)
+	}
+	r, err := c.DoAPIGet(c.GetBlocksRoute(boardID)+"?"+query.Encode(), "")
+	if err != nil {
+		return nil, "", BuildErrorResponse(r, err)
+	}
+	defer closeBody(r)
+
+	return model.BlocksFromJSON(r.Body), r.Header.Get(model.NextCursorHeader), BuildResponse(r)
+}
+
 const disableNotifyQueryParam = "disable_notify=true"
 
 func (c *Client) PatchBlock(boardID, blockID string, blockPatch *model.BlockPatch, disableNotify bool) (bool, *Response) {
@@ -363,6 +379,26 @@ func (c *Client) GetCards(boardID string, page int, perPage int) ([]*model.Card,
 	return cards, BuildResponse(r)
 }
 
+// GetCardsPage returns a page of the cards of a board following a cursor, empty for the first
+// page, and the cursor of the next page, empty on the last page.
+func (c *Client) GetCardsPage(boardID string, cursor string, perPage int) ([]*model.Card, string, *Response) {
+	query := url.Values{}
+	query.Set("per_page", fmt.Sprintf("%d", perPage))
+	query.Set("cursor", cursor)
+	r, err := c.DoAPIGet(c.GetBoardRoute(boardID)+"/cards?"+query.Encode(), "")
+	if err != nil {
+		return nil, "", BuildErrorResponse(r, err)
+	}
+	defer closeBody(r)
+
+	var cards []*model.Card
+	if err := json.NewDecoder(r.Body).Decode(&cards); err != nil {
+		return nil, "", BuildErrorResponse(r, err)
+	}
+
+	return cards, r.Header.Get(model.NextCursorHeader), BuildResponse(r)
+}
+
 func (c *Client) PatchCard(cardID string, cardPatch *model.CardPatch, disableNotify bool) (*model.Card, *Response) {
 	var queryParams string
 	if disableNotify {
@@ -526,6 +562,107 @@ func (c *Client) PostSharing(sharing *model.Sharing) (bool, *Response) {
 	return true, BuildResponse(r)
 }
 
+func (c *Client) GetShareLinksRoute(boardID string) string {
+	return fmt.Sprintf("%s/links", c.GetSharingRoute(boardID))
+}
+
+func (c *Client) GetShareLinks(boardID string) ([]*model.ShareLink, *Response) {
+	r, err := c.DoAPIGet(c.GetShareLinksRoute(boardID), "")
+	if err != nil {
+		return nil, BuildErrorResponse(r, err)
+	}
+	defer closeBody(r)
+
+	var links []*model.ShareLink
+	if err := json.NewDecoder(r.Body).Decode(&links); err != nil {
+		return nil, BuildErrorResponse(r, err)
+	}
+	return links, BuildResponse(r)
+}
+
+func (c *Client) CreateShareLink(boardID string, create *model.ShareLinkCreate) (*model.ShareLink, *Response) {
+	r, err := c.DoAPIPost(c.GetShareLinksRoute(boardID), toJSON(create))
+	if err != nil {
+		return nil, BuildErrorResponse(r, err)
+	}
+	defer closeBody(r)
+
+	link, err := model.ShareLinkFromJSON(r.Body)
+	if err != nil {
+		return nil, BuildErrorResponse(r, err)
+	}
+	return link, BuildResponse(r)
+}
+
+func (c *Client) RevokeShareLink(boardID, linkID string) (bool, *Response) {
+	r, err := c.DoAPIDelete(fmt.Sprintf("%s/%s", c.GetShareLinksRoute(boardID), linkID), "")
+	if err != nil {
+		return false, BuildErrorResponse(r, err)
+	}
+	defer closeBody(r)
+
+	return true, BuildResponse(r)
+}
+
+func (c *Client) GetBoardFormRoute(boardID string) string {
+	return fmt.Sprintf("%s/form", c.GetBoardRoute(boardID))
+}
+
+func (c *Client) GetBoardForm(boardID string) (*model.BoardForm, *Response) {
+	r, err := c.DoAPIGet(c.GetBoardFormRoute(boardID), "")
+	if err != nil {
+		return nil, BuildErrorResponse(r, err)
+	}
+	defer closeBody(r)
+
+	form, err := model.BoardFormFromJSON(r.Body)
+	if err != nil {
+		return nil, BuildErrorResponse(r, err)
+	}
+	return form, BuildResponse(r)
+}
+
+func (c *Client) SaveBoardForm(boardID string, form *model.BoardForm, regenerateToken bool) (*model.BoardForm, *Response) {
+	url := fmt.Sprintf("%s?regenerate_token=%t", c.GetBoardFormRoute(boardID), regenerateToken)
+	r, err := c.DoAPIPost(url, toJSON(form))
+	if err != nil {
+		return nil, BuildErrorResponse(r, err)
+	}
+	defer closeBody(r)
+
+	saved, err := model.BoardFormFromJSON(r.Body)
+	if err != nil {
+		return nil, BuildErrorResponse(r, err)
+	}
+	return saved, BuildResponse(r)
+}
+
+func (c *Client) GetPublicForm(boardID, formToken string) (*model.PublicForm, *Response) {
+	url := fmt.Sprintf("%s/public?form_token=%s", c.GetBoardFormRoute(boardID), formToken)
+	r, err := c.DoAPIGet(url, "")
+	if err != nil {
+		return nil, BuildErrorResponse(r, err)
+	}
+	defer closeBody(r)
+
+	var form model.PublicForm
+	if err := json.NewDecoder(r.Body).Decode(&form); err != nil {
+		return nil, BuildErrorResponse(r, err)
+	}
+	return &form, BuildResponse(r)
+}
+
+func (c *Client) SubmitForm(boardID, formToken string, submission *model.FormSubmission) (bool, *Response) {
+	url := fmt.Sprintf("%s/submit?form_token=%s", c.GetBoardFormRoute(boardID), formToken)
+	r, err := c.DoAPIPost(url, toJSON(submission))
+	if err != nil {
+		return false, BuildErrorResponse(r, err)
+	}
+	defer closeBody(r)
+
+	return true, BuildResponse(r)
+}
+
 func (c *Client) GetMeRoute() string {
 	return "/users/me"
 }
@@ -785,7 +922,7 @@ func (c *Client) TeamUploadFile(teamID, boardID string, data io.Reader) (*api.Fi
 	return fileUploadResponse, BuildResponse(r)
 }
 
-func (c *Client) TeamUploadFileInfo(teamID, boardID string, fileName string) (*mmModel.FileInfo, *Response) {
+func (c *Client) TeamUploadFileInfo(teamID, boardID string, fileName string) (*api.FileInfoResponse, *Response) {
 	r, err := c.DoAPIGet(fmt.Sprintf("/files/teams/%s/%s/%s/info", teamID, boardID, fileName), "")
 	if err != nil {
 		return nil, BuildErrorResponse(r, err)
@@ -798,6 +935,109 @@ func (c *Client) TeamUploadFileInfo(teamID, boardID string, fileName string) (*m
 	return fileInfoResponse, BuildResponse(r)
 }
 
+func (c *Client) GetSignedFileURL(teamID, boardID, fileName string, expiresInMinutes int) (*model.SignedFileURL, *Response) {
+	route := fmt.Sprintf("/files/teams/%s/%s/%s/signed-url", teamID, boardID, fileName)
+	if expiresInMinutes > 0 {
+		route += fmt.Sprintf("?expires_in=%d", expiresInMinutes)
+	}
+
+	r, err := c.DoAPIGet(route, "")
+	if err != nil {
+		return nil, BuildErrorResponse(r, err)
+	}
+	defer closeBody(r)
+
+	signedURL, err := model.SignedFileURLFromJSON(r.Body)
+	if err != nil {
+		return nil, BuildErrorResponse(r, err)
+	}
+	return signedURL, BuildResponse(r)
+}
+
+func (c *Client) GetOrphanedFiles(gracePeriodHours int) (*model.FileGCReport, *Response) {
+	r, err := c.DoAPIGet(fmt.Sprintf("/files/orphans?grace_period_hours=%d", gracePeriodHours), "")
+	if err != nil {
+		return nil, BuildErrorResponse(r, err)
+	}
+	defer closeBody(r)
+
+	report, err := model.FileGCReportFromJSON(r.Body)
+	if err != nil {
+		return nil, BuildErrorResponse(r, err)
+	}
+	return report, BuildResponse(r)
+}
+
+func (c *Client) GetTeamFileUsage(teamID string) (*model.FileUsage, *Response) {
+	r, err := c.DoAPIGet(fmt.Sprintf("/teams/%s/storage", teamID), "")
+	if err != nil {
+		return nil, BuildErrorResponse(r, err)
+	}
+	defer closeBody(r)
+
+	usage, err := model.FileUsageFromJSON(r.Body)
+	if err != nil {
+		return nil, BuildErrorResponse(r, err)
+	}
+	return usage, BuildResponse(r)
+}
+
+func (c *Client) GetBoardFileUsage(boardID string) (*model.FileUsage, *Response) {
+	r, err := c.DoAPIGet(fmt.Sprintf("/boards/%s/storage", boardID), "")
+	if err != nil {
+		return nil, BuildErrorResponse(r, err)
+	}
+	defer closeBody(r)
+
+	usage, err := model.FileUsageFromJSON(r.Body)
+	if err != nil {
+		return nil, BuildErrorResponse(r, err)
+	}
+	return usage, BuildResponse(r)
+}
+
+func (c *Client) GetStorageQuotaRoute(scope model.StorageQuotaScope, scopeID string) string {
+	return fmt.Sprintf("/storage-quotas/%s/%s", scope, scopeID)
+}
+
+func (c *Client) GetStorageQuota(scope model.StorageQuotaScope, scopeID string) (*model.StorageQuota, *Response) {
+	r, err := c.DoAPIGet(c.GetStorageQuotaRoute(scope, scopeID), "")
+	if err != nil {
+		return nil, BuildErrorResponse(r, err)
+	}
+	defer closeBody(r)
+
+	quota, err := model.StorageQuotaFromJSON(r.Body)
+	if err != nil {
+		return nil, BuildErrorResponse(r, err)
+	}
+	return quota, BuildResponse(r)
+}
+
+func (c *Client) SetStorageQuota(scope model.StorageQuotaScope, scopeID string, maxBytes int64) (*model.StorageQuota, *Response) {
+	r, err := c.DoAPIPut(c.GetStorageQuotaRoute(scope, scopeID), toJSON(model.StorageQuota{MaxBytes: maxBytes}))
+	if err != nil {
+		return nil, BuildErrorResponse(r, err)
+	}
+	defer closeBody(r)
+
+	quota, err := model.StorageQuotaFromJSON(r.Body)
+	if err != nil {
+		return nil, BuildErrorResponse(r, err)
+	}
+	return quota, BuildResponse(r)
+}
+
+func (c *Client) DeleteStorageQuota(scope model.StorageQuotaScope, scopeID string) *Response {
+	r, err := c.DoAPIDelete(c.GetStorageQuotaRoute(scope, scopeID), "")
+	if err != nil {
+		return BuildErrorResponse(r, err)
+	}
+	defer closeBody(r)
+
+	return BuildResponse(r)
+}
+
 func (c *Client) GetSubscriptionsRoute() string {
 	return "/subscriptions"
 }
@@ -846,6 +1086,143 @@ func (c *Client) GetSubscriptions(subscriberID string) ([]*model.Subscription, *
 	return subs, BuildResponse(r)
 }
 
+func (c *Client) GetNotificationPreferencesRoute(boardID string) string {
+	return c.GetBoardRoute(boardID) + "/notifications/preferences"
+}
+
+func (c *Client) GetNotificationPreferences(boardID string) (*model.NotificationPreferences, *Response) {
+	r, err := c.DoAPIGet(c.GetNotificationPreferencesRoute(boardID), "")
+	if err != nil {
+		return nil, BuildErrorResponse(r, err)
+	}
+	defer closeBody(r)
+
+	prefs, err := model.NotificationPreferencesFromJSON(r.Body)
+	if err != nil {
+		return nil, BuildErrorResponse(r, err)
+	}
+	return prefs, BuildResponse(r)
+}
+
+func (c *Client) PatchNotificationPreferences(boardID string, patch *model.NotificationPreferencesPatch) (*model.NotificationPreferences, *Response) {
+	r, err := c.DoAPIPatch(c.GetNotificationPreferencesRoute(boardID), toJSON(patch))
+	if err != nil {
+		return nil, BuildErrorResponse(r, err)
+	}
+	defer closeBody(r)
+
+	prefs, err := model.NotificationPreferencesFromJSON(r.Body)
+	if err != nil {
+		return nil, BuildErrorResponse(r, err)
+	}
+	return prefs, BuildResponse(r)
+}
+
+func (c *Client) GetCommentRoute(boardID, commentID string) string {
+	return fmt.Sprintf("%s/comments/%s", c.GetBoardRoute(boardID), commentID)
+}
+
+func (c *Client) EditComment(boardID, commentID, title string) (*model.Block, *Response) {
+	r, err := c.DoAPIPatch(c.GetCommentRoute(boardID, commentID), toJSON(model.CommentEdit{Title: title}))
+	if err != nil {
+		return nil, BuildErrorResponse(r, err)
+	}
+	defer closeBody(r)
+
+	var block *model.Block
+	if err := json.NewDecoder(r.Body).Decode(&block); err != nil {
+		return nil, BuildErrorResponse(r, err)
+	}
+	return block, BuildResponse(r)
+}
+
+func (c *Client) GetCommentHistory(boardID, commentID string) ([]*model.Block, *Response) {
+	r, err := c.DoAPIGet(c.GetCommentRoute(boardID, commentID)+"/history", "")
+	if err != nil {
+		return nil, BuildErrorResponse(r, err)
+	}
+	defer closeBody(r)
+
+	return model.BlocksFromJSON(r.Body), BuildResponse(r)
+}
+
+func (c *Client) GetCommentReactions(boardID, commentID string) ([]*model.CommentReaction, *Response) {
+	r, err := c.DoAPIGet(c.GetCommentRoute(boardID, commentID)+"/reactions", "")
+	if err != nil {
+		return nil, BuildErrorResponse(r, err)
+	}
+	defer closeBody(r)
+
+	var reactions []*model.CommentReaction
+	if err := json.NewDecoder(r.Body).Decode(&reactions); err != nil {
+		return nil, BuildErrorResponse(r, err)
+	}
+	return reactions, BuildResponse(r)
+}
+
+func (c *Client) AddCommentReaction(boardID, commentID, emojiName string) (*model.CommentReaction, *Response) {
+	r, err := c.DoAPIPost(c.GetCommentRoute(boardID, commentID)+"/reactions", toJSON(model.CommentReaction{EmojiName: emojiName}))
+	if err != nil {
+		return nil, BuildErrorResponse(r, err)
+	}
+	defer closeBody(r)
+
+	reaction, err := model.CommentReactionFromJSON(r.Body)
+	if err != nil {
+		return nil, BuildErrorResponse(r, err)
+	}
+	return reaction, BuildResponse(r)
+}
+
+func (c *Client) DeleteCommentReaction(boardID, commentID, emojiName string) (bool, *Response) {
+	r, err := c.DoAPIDelete(c.GetCommentRoute(boardID, commentID)+"/reactions/"+emojiName, "")
+	if err != nil {
+		return false, BuildErrorResponse(r, err)
+	}
+	defer closeBody(r)
+
+	return true, BuildResponse(r)
+}
+
+func (c *Client) GetMyNotificationsRoute() string {
+	return c.GetMeRoute() + "/notifications"
+}
+
+func (c *Client) GetMyNotifications(unreadOnly bool, page, perPage int) (*model.NotificationList, *Response) {
+	url := fmt.Sprintf("%s?unread=%t&page=%d&per_page=%d", c.GetMyNotificationsRoute(), unreadOnly, page, perPage)
+	r, err := c.DoAPIGet(url, "")
+	if err != nil {
+		return nil, BuildErrorResponse(r, err)
+	}
+	defer closeBody(r)
+
+	var list *model.NotificationList
+	if err := json.NewDecoder(r.Body).Decode(&list); err != nil {
+		return nil, BuildErrorResponse(r, err)
+	}
+	return list, BuildResponse(r)
+}
+
+func (c *Client) MarkNotificationRead(notificationID string) (bool, *Response) {
+	r, err := c.DoAPIPost(c.GetMyNotificationsRoute()+"/"+notificationID+"/read", "")
+	if err != nil {
+		return false, BuildErrorResponse(r, err)
+	}
+	defer closeBody(r)
+
+	return true, BuildResponse(r)
+}
+
+func (c *Client) MarkAllNotificationsRead() (bool, *Response) {
+	r, err := c.DoAPIPost(c.GetMyNotificationsRoute()+"/read", "")
+	if err != nil {
+		return false, BuildErrorResponse(r, err)
+	}
+	defer closeBody(r)
+
+	return true, BuildResponse(r)
+}
+
 func (c *Client) GetTemplatesForTeam(teamID string) ([]*model.Board, *Response) {
 	r, err := c.DoAPIGet(c.GetTeamRoute(teamID)+"/templates", "")
 	if err != nil {
@@ -962,6 +1339,23 @@ func (c *Client) GetBlocksComplianceHistory(
 	return res, BuildResponse(r)
 }
 
+func (c *Client) GetAuditEntries(opts model.QueryAuditEntriesOptions) (*model.AuditEntriesResponse, *Response) {
+	query := fmt.Sprintf("?user_id=%s&board_id=%s&event=%s&since=%d&until=%d&page=%d&per_page=%d",
+		opts.UserID, opts.BoardID, url.QueryEscape(opts.Event), opts.Since, opts.Until, opts.Page, opts.PerPage)
+	r, err := c.DoAPIGet("/admin/audit"+query, "")
+	if err != nil {
+		return nil, BuildErrorResponse(r, err)
+	}
+	defer closeBody(r)
+
+	res, err := model.AuditEntriesResponseFromJSON(r.Body)
+	if err != nil {
+		return nil, BuildErrorResponse(r, err)
+	}
+
+	return res, BuildResponse(r)
+}
+
 func (c *Client) HideBoard(teamID, categoryID, boardID string) *Response {
 	r, err := c.DoAPIPut(c.GetTeamRoute(teamID)+"/categories/"+categoryID+"/boards/"+boardID+"/hide", "")
 	if err != nil {
@@ -981,3 +1375,219 @@ func (c *Client) UnhideBoard(teamID, categoryID, boardID string) *Response {
 	defer closeBody(r)
 	return BuildResponse(r)
 }
+
+func (c *Client) GetAttachmentVersionsRoute(boardID, blockID string) string {
+	return fmt.Sprintf("%s/versions", c.GetBlockRoute(boardID, blockID))
+}
+
+func (c *Client) GetAttachmentVersions(boardID, blockID string) ([]*model.AttachmentVersion, *Response) {
+	r, err := c.DoAPIGet(c.GetAttachmentVersionsRoute(boardID, blockID), "")
+	if err != nil {
+		return nil, BuildErrorResponse(r, err)
+	}
+	defer closeBody(r)
+
+	versions, err := model.AttachmentVersionsFromJSON(r.Body)
+	if err != nil {
+		return nil, BuildErrorResponse(r, err)
+	}
+	return versions, BuildResponse(r)
+}
+
+func (c *Client) AddAttachmentVersion(boardID, blockID, filename string, data io.Reader) (*model.AttachmentVersion, *Response) {
+	body := &bytes.Buffer{}
+	writer := multipart.NewWriter(body)
+	part, err := writer.CreateFormFile(api.UploadFormFileKey, filename)
+	if err != nil {
+		return nil, &Response{Error: err}
+	}
+	if _, err = io.Copy(part, data); err != nil {
+		return nil, &Response{Error: err}
+	}
+	writer.Close()
+
+	opt := func(r *http.Request) {
+		r.Header.Add("Content-Type", writer.FormDataContentType())
+	}
+
+	r, err := c.doAPIRequestReader(http.MethodPost, c.APIURL+c.GetAttachmentVersionsRoute(boardID, blockID), body, "", opt)
+	if err != nil {
+		return nil, BuildErrorResponse(r, err)
+	}
+	defer closeBody(r)
+
+	version, err := model.AttachmentVersionFromJSON(r.Body)
+	if err != nil {
+		return nil, BuildErrorResponse(r, err)
+	}
+	return version, BuildResponse(r)
+}
+
+func (c *Client) RestoreAttachmentVersion(boardID, blockID string, version int) (*model.AttachmentVersion, *Response) {
+	r, err := c.DoAPIPost(fmt.Sprintf("%s/%d/restore", c.GetAttachmentVersionsRoute(boardID, blockID), version), "")
+	if err != nil {
+		return nil, BuildErrorResponse(r, err)
+	}
+	defer closeBody(r)
+
+	restored, err := model.AttachmentVersionFromJSON(r.Body)
+	if err != nil {
+		return nil, BuildErrorResponse(r, err)
+	}
+	return restored, BuildResponse(r)
+}
+
+func activityQuery(opts model.QueryActivityOptions) string {
+	query := url.Values{}
+	if opts.UserID != "" {
+		query.Set("user_id", opts.UserID)
+	}
+	if len(opts.EventTypes) != 0 {
+		types := make([]string, 0, len(opts.EventTypes))
+		for _, eventType := range opts.EventTypes {
+			types = append(types, string(eventType))
+		}
+		query.Set("types", strings.Join(types, ","))
+	}
+	if opts.Cursor != "" {
+		query.Set("cursor", opts.Cursor)
+	}
+	if opts.PerPage != 0 {
+		query.Set("per_page", fmt.Sprintf("%d", opts.PerPage))
+	}
+	if len(query) == 0 {
+		return ""
+	}
+	return "?" + query.Encode()
+}
+
+func (c *Client) GetBlockChanges(boardID string, since string, perPage int) (*model.BlockChanges, *Response) {
+	query := url.Values{}
+	if since != "" {
+		query.Set("since", since)
+	}
+	if perPage != 0 {
+		query.Set("per_page", fmt.Sprintf("%d", perPage))
+	}
+	r, err := c.DoAPIGet(c.GetBoardRoute(boardID)+"/changes?"+query.Encode(), "")
+	if err != nil {
+		return nil, BuildErrorResponse(r, err)
+	}
+	defer closeBody(r)
+
+	changes, err := model.BlockChangesFromJSON(r.Body)
+	if err != nil {
+		return nil, BuildErrorResponse(r, err)
+	}
+	return changes, BuildResponse(r)
+}
+
+func (c *Client) GetBoardActivity(boardID string, opts model.QueryActivityOptions) (*model.ActivityFeed, *Response) {
+	r, err := c.DoAPIGet(c.GetBoardRoute(boardID)+"/activity"+activityQuery(opts), "")
+	if err != nil {
+		return nil, BuildErrorResponse(r, err)
+	}
+	defer closeBody(r)
+
+	feed, err := model.ActivityFeedFromJSON(r.Body)
+	if err != nil {
+		return nil, BuildErrorResponse(r, err)
+	}
+	return feed, BuildResponse(r)
+}
+
+func (c *Client) GetCardActivity(cardID string, opts model.QueryActivityOptions) (*model.ActivityFeed, *Response) {
+	r, err := c.DoAPIGet(c.GetCardRoute(cardID)+"/activity"+activityQuery(opts), "")
+	if err != nil {
+		return nil, BuildErrorResponse(r, err)
+	}
+	defer closeBody(r)
+
+	feed, err := model.ActivityFeedFromJSON(r.Body)
+	if err != nil {
+		return nil, BuildErrorResponse(r, err)
+	}
+	return feed, BuildResponse(r)
+}
+
+func (c *Client) GetCardDiff(cardID string, from, to int64) (*model.CardDiff, *Response) {
+	r, err := c.DoAPIGet(fmt.Sprintf("%s/diff?from=%d&to=%d", c.GetCardRoute(cardID), from, to), "")
+	if err != nil {
+		return nil, BuildErrorResponse(r, err)
+	}
+	defer closeBody(r)
+
+	diff, err := model.CardDiffFromJSON(r.Body)
+	if err != nil {
+		return nil, BuildErrorResponse(r, err)
+	}
+	return diff, BuildResponse(r)
+}
+
+func (c *Client) restoreBoard(boardID string, at int64, preview bool) (*model.BoardRestorePlan, *Response) {
+	r, err := c.DoAPIPost(fmt.Sprintf("%s/restore?at=%d&preview=%t", c.GetBoardRoute(boardID), at, preview), "")
+	if err != nil {
+		return nil, BuildErrorResponse(r, err)
+	}
+	defer closeBody(r)
+
+	plan, err := model.BoardRestorePlanFromJSON(r.Body)
+	if err != nil {
+		return nil, BuildErrorResponse(r, err)
+	}
+	return plan, BuildResponse(r)
+}
+
+func (c *Client) PreviewBoardRestore(boardID string, at int64) (*model.BoardRestorePlan, *Response) {
+	return c.restoreBoard(boardID, at, true)
+}
+
+func (c *Client) RestoreBoard(boardID string, at int64) (*model.BoardRestorePlan, *Response) {
+	return c.restoreBoard(boardID, at, false)
+}
+
+func (c *Client) getFlowMetricsQuery(opts model.FlowMetricsOptions) string {
+	query := url.Values{}
+	query.Set("property_id", opts.PropertyID)
+	if opts.From != 0 {
+		query.Set("from", fmt.Sprintf("%d", opts.From))
+	}
+	if opts.To != 0 {
+		query.Set("to", fmt.Sprintf("%d", opts.To))
+	}
+	if len(opts.StartedStatuses) != 0 {
+		query.Set("started", strings.Join(opts.StartedStatuses, ","))
+	}
+	if len(opts.DoneStatuses) != 0 {
+		query.Set("done", strings.Join(opts.DoneStatuses, ","))
+	}
+	return query.Encode()
+}
+
+func (c *Client) GetFlowMetrics(boardID string, opts model.FlowMetricsOptions) (*model.FlowMetrics, *Response) {
+	r, err := c.DoAPIGet(fmt.Sprintf("%s/metrics/flow?%s", c.GetBoardRoute(boardID), c.getFlowMetricsQuery(opts)), "")
+	if err != nil {
+		return nil, BuildErrorResponse(r, err)
+	}
+	defer closeBody(r)
+
+	metrics, err := model.FlowMetricsFromJSON(r.Body)
+	if err != nil {
+		return nil, BuildErrorResponse(r, err)
+	}
+	return metrics, BuildResponse(r)
+}
+
+func (c *Client) GetCumulativeFlow(boardID string, opts model.FlowMetricsOptions) (*model.CumulativeFlow, *Response) {
+	r, err := c.DoAPIGet(fmt.Sprintf("%s/metrics/cumulative-flow?%s", c.GetBoardRoute(boardID), c.getFlowMetricsQuery(opts)), "")
+	if err != nil {
+		return nil, BuildErrorResponse(r, err)
+	}
+	defer closeBody(r)
+
+	flow, err := model.CumulativeFlowFromJSON(r.Body)
+	if err != nil {
+		return nil, BuildErrorResponse(r, err)
+	}
+	return flow, BuildResponse(r)
+}
@@ -4,6 +4,7 @@
 package integrationtests
 
 import (
+	"context"
 	"encoding/json"
 	"sort"
 	"testing"
@@ -27,7 +28,7 @@ func TestGetBoards(t *testing.T) {
 			Type:   model.BoardTypeOpen,
 		}
 
-		board, err := th.Server.App().CreateBoard(newBoard, "user-id", false)
+		board, err := th.Server.App().CreateBoard(context.Background(), newBoard, "user-id", false)
 		require.NoError(t, err)
 		require.NotNil(t, board)
 
@@ -50,7 +51,7 @@ func TestGetBoards(t *testing.T) {
 			Type:   model.BoardTypeOpen,
 			Title:  "Board 1",
 		}
-		rBoard1, err := th.Server.App().CreateBoard(board1, user1.ID, true)
+		rBoard1, err := th.Server.App().CreateBoard(context.Background(), board1, user1.ID, true)
 		require.NoError(t, err)
 		require.NotNil(t, rBoard1)
 
@@ -59,7 +60,7 @@ func TestGetBoards(t *testing.T) {
 			Type:   model.BoardTypeOpen,
 			Title:  "Board 2",
 		}
-		rBoard2, err := th.Server.App().CreateBoard(board2, user2.ID, false)
+		rBoard2, err := th.Server.App().CreateBoard(context.Background(), board2, user2.ID, false)
 		require.NoError(t, err)
 		require.NotNil(t, rBoard2)
 
@@ -68,7 +69,7 @@ func TestGetBoards(t *testing.T) {
 			Type:   model.BoardTypePrivate,
 			Title:  "Board 3",
 		}
-		rBoard3, err := th.Server.App().CreateBoard(board3, user1.ID, true)
+		rBoard3, err := th.Server.App().CreateBoard(context.Background(), board3, user1.ID, true)
 		require.NoError(t, err)
 		require.NotNil(t, rBoard3)
 
@@ -77,7 +78,7 @@ func TestGetBoards(t *testing.T) {
 			Type:   model.BoardTypePrivate,
 			Title:  "Board 4",
 		}
-		rBoard4, err := th.Server.App().CreateBoard(board4, user1.ID, false)
+		rBoard4, err := th.Server.App().CreateBoard(context.Background(), board4, user1.ID, false)
 		require.NoError(t, err)
 		require.NotNil(t, rBoard4)
 
@@ -86,7 +87,7 @@ func TestGetBoards(t *testing.T) {
 			Type:   model.BoardTypePrivate,
 			Title:  "Board 5",
 		}
-		rBoard5, err := th.Server.App().CreateBoard(board5, user2.ID, true)
+		rBoard5, err := th.Server.App().CreateBoard(context.Background(), board5, user2.ID, true)
 		require.NoError(t, err)
 		require.NotNil(t, rBoard5)
 
@@ -94,7 +95,7 @@ func TestGetBoards(t *testing.T) {
 			TeamID: otherTeamID,
 			Type:   model.BoardTypeOpen,
 		}
-		rBoard6, err := th.Server.App().CreateBoard(board6, user1.ID, true)
+		rBoard6, err := th.Server.App().CreateBoard(context.Background(), board6, user1.ID, true)
 		require.NoError(t, err)
 		require.NotNil(t, rBoard6)
 
@@ -155,7 +156,7 @@ func TestCreateBoard(t *testing.T) {
 		require.Equal(t, me.ID, board.ModifiedBy)
 
 		t.Run("creating a board should make the creator an admin", func(t *testing.T) {
-			members, err := th.Server.App().GetMembersForBoard(board.ID)
+			members, err := th.Server.App().GetMembersForBoard(context.Background(), board.ID)
 			require.NoError(t, err)
 			require.Len(t, members, 1)
 			require.Equal(t, me.ID, members[0].UserID)
@@ -210,7 +211,7 @@ func TestCreateBoard(t *testing.T) {
 		require.Equal(t, me.ID, board.ModifiedBy)
 
 		t.Run("creating a board should make the creator an admin", func(t *testing.T) {
-			members, err := th.Server.App().GetMembersForBoard(board.ID)
+			members, err := th.Server.App().GetMembersForBoard(context.Background(), board.ID)
 			require.NoError(t, err)
 			require.Len(t, members, 1)
 			require.Equal(t, me.ID, members[0].UserID)
@@ -260,7 +261,7 @@ func TestCreateBoard(t *testing.T) {
 			th.CheckBadRequest(resp)
 			require.Nil(t, board)
 
-			boards, err := th.Server.App().GetBoardsForUserAndTeam(user1.ID, teamID, true)
+			boards, err := th.Server.App().GetBoardsForUserAndTeam(context.Background(), user1.ID, teamID, true)
 			require.NoError(t, err)
 			require.Empty(t, boards)
 		})
@@ -274,7 +275,7 @@ func TestCreateBoard(t *testing.T) {
 			th.CheckBadRequest(resp)
 			require.Nil(t, board)
 
-			boards, err := th.Server.App().GetBoardsForUserAndTeam(user1.ID, teamID, true)
+			boards, err := th.Server.App().GetBoardsForUserAndTeam(context.Background(), user1.ID, teamID, true)
 			require.NoError(t, err)
 			require.Empty(t, boards)
 		})
@@ -289,7 +290,7 @@ func TestCreateBoard(t *testing.T) {
 			th.CheckForbidden(resp)
 			require.Nil(t, board)
 
-			boards, err := th.Server.App().GetBoardsForUserAndTeam(user1.ID, teamID, true)
+			boards, err := th.Server.App().GetBoardsForUserAndTeam(context.Background(), user1.ID, teamID, true)
 			require.NoError(t, err)
 			require.Empty(t, boards)
 		})
@@ -323,7 +324,7 @@ func TestCreateBoardTemplate(t *testing.T) {
 		require.Equal(t, me.ID, board.ModifiedBy)
 
 		t.Run("creating a board template should make the creator an admin", func(t *testing.T) {
-			members, err := th.Server.App().GetMembersForBoard(board.ID)
+			members, err := th.Server.App().GetMembersForBoard(context.Background(), board.ID)
 			require.NoError(t, err)
 			require.Len(t, members, 1)
 			require.Equal(t, me.ID, members[0].UserID)
@@ -379,7 +380,7 @@ func TestCreateBoardTemplate(t *testing.T) {
 		require.Equal(t, me.ID, board.ModifiedBy)
 
 		t.Run("creating a board template should make the creator an admin", func(t *testing.T) {
-			members, err := th.Server.App().GetMembersForBoard(board.ID)
+			members, err := th.Server.App().GetMembersForBoard(context.Background(), board.ID)
 			require.NoError(t, err)
 			require.Len(t, members, 1)
 			require.Equal(t, me.ID, members[0].UserID)
@@ -494,7 +495,7 @@ func TestSearchBoards(t *testing.T) {
 			Type:   model.BoardTypeOpen,
 			TeamID: teamID,
 		}
-		rBoard1, err := th.Server.App().CreateBoard(board1, user1.ID, true)
+		rBoard1, err := th.Server.App().CreateBoard(context.Background(), board1, user1.ID, true)
 		require.NoError(t, err)
 
 		board2 := &model.Board{
@@ -502,7 +503,7 @@ func TestSearchBoards(t *testing.T) {
 			Type:   model.BoardTypeOpen,
 			TeamID: teamID,
 		}
-		rBoard2, err := th.Server.App().CreateBoard(board2, user1.ID, false)
+		rBoard2, err := th.Server.App().CreateBoard(context.Background(), board2, user1.ID, false)
 		require.NoError(t, err)
 
 		board3 := &model.Board{
@@ -510,7 +511,7 @@ func TestSearchBoards(t *testing.T) {
 			Type:   model.BoardTypePrivate,
 			TeamID: teamID,
 		}
-		rBoard3, err := th.Server.App().CreateBoard(board3, user1.ID, true)
+		rBoard3, err := th.Server.App().CreateBoard(context.Background(), board3, user1.ID, true)
 		require.NoError(t, err)
 
 		board4 := &model.Board{
@@ -518,7 +519,7 @@ func TestSearchBoards(t *testing.T) {
 			Type:   model.BoardTypePrivate,
 			TeamID: teamID,
 		}
-		_, err = th.Server.App().CreateBoard(board4, user1.ID, false)
+		_, err = th.Server.App().CreateBoard(context.Background(), board4, user1.ID, false)
 		require.NoError(t, err)
 
 		board5 := &model.Board{
@@ -526,7 +527,7 @@ func TestSearchBoards(t *testing.T) {
 			Type:   model.BoardTypePrivate,
 			TeamID: "other-team-id",
 		}
-		rBoard5, err := th.Server.App().CreateBoard(board5, user1.ID, true)
+		rBoard5, err := th.Server.App().CreateBoard(context.Background(), board5, user1.ID, true)
 		require.NoError(t, err)
 
 		testCases := []struct {
@@ -606,7 +607,7 @@ func TestGetBoard(t *testing.T) {
 			Type:   model.BoardTypeOpen,
 			TeamID: teamID,
 		}
-		rBoard, err := th.Server.App().CreateBoard(board, th.GetUser1().ID, true)
+		rBoard, err := th.Server.App().CreateBoard(context.Background(), board, th.GetUser1().ID, true)
 		require.NoError(t, err)
 
 		sharing := &model.Sharing{
@@ -650,7 +651,7 @@ func TestGetBoard(t *testing.T) {
 			Type:   model.BoardTypePrivate,
 			TeamID: teamID,
 		}
-		board, err := th.Server.App().CreateBoard(newBoard, th.GetUser1().ID, false)
+		board, err := th.Server.App().CreateBoard(context.Background(), newBoard, th.GetUser1().ID, false)
 		require.NoError(t, err)
 
 		rBoard, resp := th.Client.GetBoard(board.ID, "")
@@ -667,7 +668,7 @@ func TestGetBoard(t *testing.T) {
 			Type:   model.BoardTypePrivate,
 			TeamID: teamID,
 		}
-		board, err := th.Server.App().CreateBoard(newBoard, th.GetUser1().ID, true)
+		board, err := th.Server.App().CreateBoard(context.Background(), newBoard, th.GetUser1().ID, true)
 		require.NoError(t, err)
 
 		rBoard, resp := th.Client.GetBoard(board.ID, "")
@@ -685,7 +686,7 @@ func TestGetBoard(t *testing.T) {
 			Type:   model.BoardTypeOpen,
 			TeamID: teamID,
 		}
-		board, err := th.Server.App().CreateBoard(newBoard, th.GetUser1().ID, false)
+		board, err := th.Server.App().CreateBoard(context.Background(), newBoard, th.GetUser1().ID, false)
 		require.NoError(t, err)
 
 		rBoard, resp := th.Client.GetBoard(board.ID, "")
@@ -716,7 +717,7 @@ func TestGetBoardMetadata(t *testing.T) {
 			Type:   model.BoardTypeOpen,
 			TeamID: teamID,
 		}
-		rBoard, err := th.Server.App().CreateBoard(board, th.GetUser1().ID, true)
+		rBoard, err := th.Server.App().CreateBoard(context.Background(), board, th.GetUser1().ID, true)
 		require.NoError(t, err)
 
 		// Check metadata
@@ -736,8 +737,8 @@ func TestGetBoardMetadata(t *testing.T) {
 			Title:   "Card 1",
 		}
 		time.Sleep(20 * time.Millisecond)
-		require.NoError(t, th.Server.App().InsertBlock(card1, th.GetUser2().ID))
-		rCard1, err := th.Server.App().GetBlockByID(card1.ID)
+		require.NoError(t, th.Server.App().InsertBlock(context.Background(), card1, th.GetUser2().ID))
+		rCard1, err := th.Server.App().GetBlockByID(context.Background(), card1.ID)
 		require.NoError(t, err)
 
 		// Check updated metadata
@@ -757,8 +758,8 @@ func TestGetBoardMetadata(t *testing.T) {
 			Title:   "Card 2",
 		}
 		time.Sleep(20 * time.Millisecond)
-		require.NoError(t, th.Server.App().InsertBlock(card2, th.GetUser1().ID))
-		rCard2, err := th.Server.App().GetBlockByID(card2.ID)
+		require.NoError(t, th.Server.App().InsertBlock(context.Background(), card2, th.GetUser1().ID))
+		rCard2, err := th.Server.App().GetBlockByID(context.Background(), card2.ID)
 		require.NoError(t, err)
 
 		// Check updated metadata
@@ -773,7 +774,7 @@ func TestGetBoardMetadata(t *testing.T) {
 		t.Run("After delete board", func(t *testing.T) {
 			// Delete board
 			time.Sleep(20 * time.Millisecond)
-			require.NoError(t, th.Server.App().DeleteBoard(rBoard.ID, th.GetUser1().ID))
+			require.NoError(t, th.Server.App().DeleteBoard(context.Background(), rBoard.ID, th.GetUser1().ID))
 
 			// Check updated metadata
 			boardMetadata, resp = th.Client.GetBoardMetadata(rBoard.ID, "")
@@ -798,7 +799,7 @@ func TestGetBoardMetadata(t *testing.T) {
 			Type:   model.BoardTypeOpen,
 			TeamID: teamID,
 		}
-		rBoard, err := th.Server.App().CreateBoard(board, th.GetUser1().ID, true)
+		rBoard, err := th.Server.App().CreateBoard(context.Background(), board, th.GetUser1().ID, true)
 		require.NoError(t, err)
 
 		// Check metadata
@@ -819,7 +820,7 @@ func TestGetBoardMetadata(t *testing.T) {
 			Type:   model.BoardTypeOpen,
 			TeamID: teamID,
 		}
-		rBoard, err := th.Server.App().CreateBoard(board, th.GetUser1().ID, true)
+		rBoard, err := th.Server.App().CreateBoard(context.Background(), board, th.GetUser1().ID, true)
 		require.NoError(t, err)
 
 		// Check metadata
@@ -842,7 +843,7 @@ func TestGetBoardMetadata(t *testing.T) {
 			Type:   model.BoardTypeOpen,
 			TeamID: teamID,
 		}
-		rBoard, err := th.Server.App().CreateBoard(board, userID, true)
+		rBoard, err := th.Server.App().CreateBoard(context.Background(), board, userID, true)
 		require.NoError(t, err)
 
 		sharing := &model.Sharing{
@@ -882,7 +883,7 @@ func TestPatchBoard(t *testing.T) {
 			Type:   model.BoardTypeOpen,
 			TeamID: teamID,
 		}
-		board, err := th.Server.App().CreateBoard(newBoard, "user-id", false)
+		board, err := th.Server.App().CreateBoard(context.Background(), newBoard, "user-id", false)
 		require.NoError(t, err)
 
 		newTitle := "a new title 1"
@@ -892,7 +893,7 @@ func TestPatchBoard(t *testing.T) {
 		th.CheckUnauthorized(resp)
 		require.Nil(t, rBoard)
 
-		dbBoard, err := th.Server.App().GetBoard(board.ID)
+		dbBoard, err := th.Server.App().GetBoard(context.Background(), board.ID)
 		require.NoError(t, err)
 		require.Equal(t, initialTitle, dbBoard.Title)
 	})
@@ -920,7 +921,7 @@ func TestPatchBoard(t *testing.T) {
 			Type:   model.BoardTypeOpen,
 			TeamID: teamID,
 		}
-		board, err := th.Server.App().CreateBoard(newBoard, user1.ID, true)
+		board, err := th.Server.App().CreateBoard(context.Background(), newBoard, user1.ID, true)
 		require.NoError(t, err)
 
 		var invalidPatchType model.BoardType = "invalid"
@@ -943,7 +944,7 @@ func TestPatchBoard(t *testing.T) {
 			Type:   model.BoardTypeOpen,
 			TeamID: teamID,
 		}
-		board, err := th.Server.App().CreateBoard(newBoard, user1.ID, true)
+		board, err := th.Server.App().CreateBoard(context.Background(), newBoard, user1.ID, true)
 		require.NoError(t, err)
 
 		newTitle := "a new title"
@@ -967,7 +968,7 @@ func TestPatchBoard(t *testing.T) {
 			Type:   model.BoardTypeOpen,
 			TeamID: teamID,
 		}
-		board, err := th.Server.App().CreateBoard(newBoard, user1.ID, false)
+		board, err := th.Server.App().CreateBoard(context.Background(), newBoard, user1.ID, false)
 		require.NoError(t, err)
 
 		newTitle := "a new title"
@@ -977,7 +978,7 @@ func TestPatchBoard(t *testing.T) {
 		th.CheckForbidden(resp)
 		require.Nil(t, rBoard)
 
-		dbBoard, err := th.Server.App().GetBoard(board.ID)
+		dbBoard, err := th.Server.App().GetBoard(context.Background(), board.ID)
 		require.NoError(t, err)
 		require.Equal(t, initialTitle, dbBoard.Title)
 	})
@@ -995,14 +996,14 @@ func TestDeleteBoard(t *testing.T) {
 			Type:   model.BoardTypeOpen,
 			TeamID: teamID,
 		}
-		board, err := th.Server.App().CreateBoard(newBoard, "user-id", false)
+		board, err := th.Server.App().CreateBoard(context.Background(), newBoard, "user-id", false)
 		require.NoError(t, err)
 
 		success, resp := th.Client.DeleteBoard(board.ID)
 		th.CheckUnauthorized(resp)
 		require.False(t, success)
 
-		dbBoard, err := th.Server.App().GetBoard(board.ID)
+		dbBoard, err := th.Server.App().GetBoard(context.Background(), board.ID)
 		require.NoError(t, err)
 		require.NotNil(t, dbBoard)
 	})
@@ -1016,14 +1017,14 @@ func TestDeleteBoard(t *testing.T) {
 			Type:   model.BoardTypeOpen,
 			TeamID: teamID,
 		}
-		board, err := th.Server.App().CreateBoard(newBoard, "some-user-id", false)
+		board, err := th.Server.App().CreateBoard(context.Background(), newBoard, "some-user-id", false)
 		require.NoError(t, err)
 
 		success, resp := th.Client.DeleteBoard(board.ID)
 		th.CheckForbidden(resp)
 		require.False(t, success)
 
-		dbBoard, err := th.Server.App().GetBoard(board.ID)
+		dbBoard, err := th.Server.App().GetBoard(context.Background(), board.ID)
 		require.NoError(t, err)
 		require.NotNil(t, dbBoard)
 	})
@@ -1046,14 +1047,14 @@ func TestDeleteBoard(t *testing.T) {
 			Type:   model.BoardTypeOpen,
 			TeamID: teamID,
 		}
-		board, err := th.Server.App().CreateBoard(newBoard, th.GetUser1().ID, true)
+		board, err := th.Server.App().CreateBoard(context.Background(), newBoard, th.GetUser1().ID, true)
 		require.NoError(t, err)
 
 		success, resp := th.Client.DeleteBoard(board.ID)
 		th.CheckOK(resp)
 		require.True(t, success)
 
-		dbBoard, err := th.Server.App().GetBoard(board.ID)
+		dbBoard, err := th.Server.App().GetBoard(context.Background(), board.ID)
 		require.Error(t, err)
 		require.True(t, model.IsErrNotFound(err))
 		require.Nil(t, dbBoard)
@@ -1072,18 +1073,18 @@ func TestUndeleteBoard(t *testing.T) {
 			Type:   model.BoardTypeOpen,
 			TeamID: teamID,
 		}
-		board, err := th.Server.App().CreateBoard(newBoard, "user-id", false)
+		board, err := th.Server.App().CreateBoard(context.Background(), newBoard, "user-id", false)
 		require.NoError(t, err)
 
 		time.Sleep(1 * time.Millisecond)
-		err = th.Server.App().DeleteBoard(newBoard.ID, "user-id")
+		err = th.Server.App().DeleteBoard(context.Background(), newBoard.ID, "user-id")
 		require.NoError(t, err)
 
 		success, resp := th.Client.UndeleteBoard(board.ID)
 		th.CheckUnauthorized(resp)
 		require.False(t, success)
 
-		dbBoard, err := th.Server.App().GetBoard(board.ID)
+		dbBoard, err := th.Server.App().GetBoard(context.Background(), board.ID)
 		require.Error(t, err)
 		require.True(t, model.IsErrNotFound(err))
 		require.Nil(t, dbBoard)
@@ -1098,18 +1099,18 @@ func TestUndeleteBoard(t *testing.T) {
 			Type:   model.BoardTypeOpen,
 			TeamID: teamID,
 		}
-		board, err := th.Server.App().CreateBoard(newBoard, "some-user-id", false)
+		board, err := th.Server.App().CreateBoard(context.Background(), newBoard, "some-user-id", false)
 		require.NoError(t, err)
 
 		time.Sleep(1 * time.Millisecond)
-		err = th.Server.App().DeleteBoard(newBoard.ID, "some-user-id")
+		err = th.Server.App().DeleteBoard(context.Background(), newBoard.ID, "some-user-id")
 		require.NoError(t, err)
 
 		success, resp := th.Client.UndeleteBoard(board.ID)
 		th.CheckForbidden(resp)
 		require.False(t, success)
 
-		dbBoard, err := th.Server.App().GetBoard(board.ID)
+		dbBoard, err := th.Server.App().GetBoard(context.Background(), board.ID)
 		require.Error(t, err)
 		require.True(t, model.IsErrNotFound(err))
 		require.Nil(t, dbBoard)
@@ -1124,7 +1125,7 @@ func TestUndeleteBoard(t *testing.T) {
 			Type:   model.BoardTypeOpen,
 			TeamID: teamID,
 		}
-		board, err := th.Server.App().CreateBoard(newBoard, "some-user-id", false)
+		board, err := th.Server.App().CreateBoard(context.Background(), newBoard, "some-user-id", false)
 		require.NoError(t, err)
 
 		newUser2Member := &model.BoardMember{
@@ -1132,18 +1133,18 @@ func TestUndeleteBoard(t *testing.T) {
 			BoardID:      board.ID,
 			SchemeEditor: true,
 		}
-		_, err = th.Server.App().AddMemberToBoard(newUser2Member)
+		_, err = th.Server.App().AddMemberToBoard(context.Background(), newUser2Member)
 		require.NoError(t, err)
 
 		time.Sleep(1 * time.Millisecond)
-		err = th.Server.App().DeleteBoard(newBoard.ID, "some-user-id")
+		err = th.Server.App().DeleteBoard(context.Background(), newBoard.ID, "some-user-id")
 		require.NoError(t, err)
 
 		success, resp := th.Client.UndeleteBoard(board.ID)
 		th.CheckForbidden(resp)
 		require.False(t, success)
 
-		dbBoard, err := th.Server.App().GetBoard(board.ID)
+		dbBoard, err := th.Server.App().GetBoard(context.Background(), board.ID)
 		require.Error(t, err)
 		require.True(t, model.IsErrNotFound(err))
 		require.Nil(t, dbBoard)
@@ -1167,18 +1168,18 @@ func TestUndeleteBoard(t *testing.T) {
 			Type:   model.BoardTypeOpen,
 			TeamID: teamID,
 		}
-		board, err := th.Server.App().CreateBoard(newBoard, th.GetUser1().ID, true)
+		board, err := th.Server.App().CreateBoard(context.Background(), newBoard, th.GetUser1().ID, true)
 		require.NoError(t, err)
 
 		time.Sleep(1 * time.Millisecond)
-		err = th.Server.App().DeleteBoard(newBoard.ID, "user-id")
+		err = th.Server.App().DeleteBoard(context.Background(), newBoard.ID, "user-id")
 		require.NoError(t, err)
 
 		success, resp := th.Client.UndeleteBoard(board.ID)
 		th.CheckOK(resp)
 		require.True(t, success)
 
-		dbBoard, err := th.Server.App().GetBoard(board.ID)
+		dbBoard, err := th.Server.App().GetBoard(context.Background(), board.ID)
 		require.NoError(t, err)
 		require.NotNil(t, dbBoard)
 	})
@@ -1195,7 +1196,7 @@ func TestGetMembersForBoard(t *testing.T) {
 			Type:   model.BoardTypeOpen,
 			TeamID: teamID,
 		}
-		board, err := th.Server.App().CreateBoard(newBoard, user1.ID, true)
+		board, err := th.Server.App().CreateBoard(context.Background(), newBoard, user1.ID, true)
 		require.NoError(t, err)
 
 		newUser2Member := &model.BoardMember{
@@ -1203,7 +1204,7 @@ func TestGetMembersForBoard(t *testing.T) {
 			BoardID:      board.ID,
 			SchemeEditor: true,
 		}
-		user2Member, err := th.Server.App().AddMemberToBoard(newUser2Member)
+		user2Member, err := th.Server.App().AddMemberToBoard(context.Background(), newUser2Member)
 		require.NoError(t, err)
 		require.NotNil(t, user2Member)
 
@@ -1225,7 +1226,7 @@ func TestGetMembersForBoard(t *testing.T) {
 		defer th.TearDown()
 		board := createBoardWithUsers(th)
 
-		_ = th.Server.App().DeleteBoardMember(board.ID, th.GetUser2().ID)
+		_ = th.Server.App().DeleteBoardMember(context.Background(), board.ID, th.GetUser2().ID)
 
 		members, resp := th.Client2.GetMembersForBoard(board.ID)
 		th.CheckForbidden(resp)
@@ -1264,7 +1265,7 @@ func TestAddMember(t *testing.T) {
 			Type:   model.BoardTypeOpen,
 			TeamID: teamID,
 		}
-		board, err := th.Server.App().CreateBoard(newBoard, "user-id", false)
+		board, err := th.Server.App().CreateBoard(context.Background(), newBoard, "user-id", false)
 		require.NoError(t, err)
 
 		newMember := &model.BoardMember{
@@ -1287,7 +1288,7 @@ func TestAddMember(t *testing.T) {
 			Type:   model.BoardTypePrivate,
 			TeamID: teamID,
 		}
-		board, err := th.Server.App().CreateBoard(newBoard, "user-id", false)
+		board, err := th.Server.App().CreateBoard(context.Background(), newBoard, "user-id", false)
 		require.NoError(t, err)
 
 		newMember := &model.BoardMember{
@@ -1326,7 +1327,7 @@ func TestAddMember(t *testing.T) {
 				Type:   model.BoardTypePrivate,
 				TeamID: teamID,
 			}
-			board, err := th.Server.App().CreateBoard(newBoard, th.GetUser1().ID, true)
+			board, err := th.Server.App().CreateBoard(context.Background(), newBoard, th.GetUser1().ID, true)
 			require.NoError(t, err)
 
 			newMember := &model.BoardMember{
@@ -1354,7 +1355,7 @@ func TestAddMember(t *testing.T) {
 				Type:   model.BoardTypeOpen,
 				TeamID: teamID,
 			}
-			board, err := th.Server.App().CreateBoard(newBoard, th.GetUser1().ID, true)
+			board, err := th.Server.App().CreateBoard(context.Background(), newBoard, th.GetUser1().ID, true)
 			require.NoError(t, err)
 
 			newMember := &model.BoardMember{
@@ -1397,7 +1398,7 @@ func TestAddMember(t *testing.T) {
 				Type:   model.BoardTypePrivate,
 				TeamID: teamID,
 			}
-			board, err := th.Server.App().CreateBoard(newBoard, th.GetUser1().ID, true)
+			board, err := th.Server.App().CreateBoard(context.Background(), newBoard, th.GetUser1().ID, true)
 			require.NoError(t, err)
 
 			newMember := &model.BoardMember{
@@ -1427,7 +1428,7 @@ func TestAddMember(t *testing.T) {
 			Type:   model.BoardTypePrivate,
 			TeamID: teamID,
 		}
-		board, err := th.Server.App().CreateBoard(newBoard, th.GetUser1().ID, true)
+		board, err := th.Server.App().CreateBoard(context.Background(), newBoard, th.GetUser1().ID, true)
 		require.NoError(t, err)
 
 		newMember := &model.BoardMember{
@@ -1437,7 +1438,7 @@ func TestAddMember(t *testing.T) {
 			SchemeEditor: true,
 		}
 
-		members, err := th.Server.App().GetMembersForBoard(board.ID)
+		members, err := th.Server.App().GetMembersForBoard(context.Background(), board.ID)
 		require.NoError(t, err)
 		require.Len(t, members, 1)
 		require.True(t, members[0].SchemeAdmin)
@@ -1448,7 +1449,7 @@ func TestAddMember(t *testing.T) {
 		require.True(t, member.SchemeAdmin)
 		require.True(t, member.SchemeEditor)
 
-		members, err = th.Server.App().GetMembersForBoard(board.ID)
+		members, err = th.Server.App().GetMembersForBoard(context.Background(), board.ID)
 		require.NoError(t, err)
 		require.Len(t, members, 1)
 		require.True(t, members[0].SchemeAdmin)
@@ -1468,7 +1469,7 @@ func TestUpdateMember(t *testing.T) {
 			Type:   model.BoardTypeOpen,
 			TeamID: teamID,
 		}
-		board, err := th.Server.App().CreateBoard(newBoard, th.GetUser1().ID, true)
+		board, err := th.Server.App().CreateBoard(context.Background(), newBoard, th.GetUser1().ID, true)
 		require.NoError(t, err)
 
 		updatedMember := &model.BoardMember{
@@ -1491,7 +1492,7 @@ func TestUpdateMember(t *testing.T) {
 			Type:   model.BoardTypeOpen,
 			TeamID: teamID,
 		}
-		board, err := th.Server.App().CreateBoard(newBoard, th.GetUser1().ID, true)
+		board, err := th.Server.App().CreateBoard(context.Background(), newBoard, th.GetUser1().ID, true)
 		require.NoError(t, err)
 
 		updatedMember := &model.BoardMember{
@@ -1529,7 +1530,7 @@ func TestUpdateMember(t *testing.T) {
 			Type:   model.BoardTypeOpen,
 			TeamID: teamID,
 		}
-		board, err := th.Server.App().CreateBoard(newBoard, th.GetUser1().ID, true)
+		board, err := th.Server.App().CreateBoard(context.Background(), newBoard, th.GetUser1().ID, true)
 		require.NoError(t, err)
 
 		newUser2Member := &model.BoardMember{
@@ -1537,7 +1538,7 @@ func TestUpdateMember(t *testing.T) {
 			BoardID:      board.ID,
 			SchemeEditor: true,
 		}
-		user2Member, err := th.Server.App().AddMemberToBoard(newUser2Member)
+		user2Member, err := th.Server.App().AddMemberToBoard(context.Background(), newUser2Member)
 		require.NoError(t, err)
 		require.NotNil(t, user2Member)
 		require.False(t, user2Member.SchemeAdmin)
@@ -1565,7 +1566,7 @@ func TestUpdateMember(t *testing.T) {
 			Type:   model.BoardTypeOpen,
 			TeamID: teamID,
 		}
-		board, err := th.Server.App().CreateBoard(newBoard, th.GetUser1().ID, true)
+		board, err := th.Server.App().CreateBoard(context.Background(), newBoard, th.GetUser1().ID, true)
 		require.NoError(t, err)
 
 		memberUpdate := &model.BoardMember{
@@ -1578,7 +1579,7 @@ func TestUpdateMember(t *testing.T) {
 		th.CheckBadRequest(resp)
 		require.Nil(t, updatedUser1Member)
 
-		members, err := th.Server.App().GetMembersForBoard(board.ID)
+		members, err := th.Server.App().GetMembersForBoard(context.Background(), board.ID)
 		require.NoError(t, err)
 		require.Len(t, members, 1)
 		require.True(t, members[0].SchemeAdmin)
@@ -1594,7 +1595,7 @@ func TestUpdateMember(t *testing.T) {
 			Type:   model.BoardTypeOpen,
 			TeamID: teamID,
 		}
-		board, err := th.Server.App().CreateBoard(newBoard, userAdmin, true)
+		board, err := th.Server.App().CreateBoard(context.Background(), newBoard, userAdmin, true)
 		require.NoError(t, err)
 
 		newGuestMember := &model.BoardMember{
@@ -1605,7 +1606,7 @@ func TestUpdateMember(t *testing.T) {
 			SchemeEditor:    true,
 			SchemeAdmin:     false,
 		}
-		guestMember, err := th.Server.App().AddMemberToBoard(newGuestMember)
+		guestMember, err := th.Server.App().AddMemberToBoard(context.Background(), newGuestMember)
 		require.NoError(t, err)
 		require.NotNil(t, guestMember)
 		require.True(t, guestMember.SchemeViewer)
@@ -1643,7 +1644,7 @@ func TestDeleteMember(t *testing.T) {
 			Type:   model.BoardTypeOpen,
 			TeamID: teamID,
 		}
-		board, err := th.Server.App().CreateBoard(newBoard, th.GetUser1().ID, true)
+		board, err := th.Server.App().CreateBoard(context.Background(), newBoard, th.GetUser1().ID, true)
 		require.NoError(t, err)
 
 		member := &model.BoardMember{
@@ -1665,7 +1666,7 @@ func TestDeleteMember(t *testing.T) {
 			Type:   model.BoardTypeOpen,
 			TeamID: teamID,
 		}
-		board, err := th.Server.App().CreateBoard(newBoard, th.GetUser1().ID, true)
+		board, err := th.Server.App().CreateBoard(context.Background(), newBoard, th.GetUser1().ID, true)
 		require.NoError(t, err)
 
 		member := &model.BoardMember{
@@ -1703,7 +1704,7 @@ func TestDeleteMember(t *testing.T) {
 				Type:   model.BoardTypePrivate,
 				TeamID: teamID,
 			}
-			board, err := th.Server.App().CreateBoard(newBoard, th.GetUser1().ID, true)
+			board, err := th.Server.App().CreateBoard(context.Background(), newBoard, th.GetUser1().ID, true)
 			require.NoError(t, err)
 
 			newUser2Member := &model.BoardMember{
@@ -1711,7 +1712,7 @@ func TestDeleteMember(t *testing.T) {
 				BoardID:      board.ID,
 				SchemeEditor: true,
 			}
-			user2Member, err := th.Server.App().AddMemberToBoard(newUser2Member)
+			user2Member, err := th.Server.App().AddMemberToBoard(context.Background(), newUser2Member)
 			require.NoError(t, err)
 			require.NotNil(t, user2Member)
 			require.False(t, user2Member.SchemeAdmin)
@@ -1722,7 +1723,7 @@ func TestDeleteMember(t *testing.T) {
 				BoardID: board.ID,
 			}
 
-			members, err := th.Server.App().GetMembersForBoard(board.ID)
+			members, err := th.Server.App().GetMembersForBoard(context.Background(), board.ID)
 			require.NoError(t, err)
 			require.Len(t, members, 2)
 
@@ -1730,7 +1731,7 @@ func TestDeleteMember(t *testing.T) {
 			th.CheckOK(resp)
 			require.True(t, success)
 
-			members, err = th.Server.App().GetMembersForBoard(board.ID)
+			members, err = th.Server.App().GetMembersForBoard(context.Background(), board.ID)
 			require.NoError(t, err)
 			require.Len(t, members, 1)
 		})
@@ -1745,7 +1746,7 @@ func TestDeleteMember(t *testing.T) {
 				Type:   model.BoardTypePrivate,
 				TeamID: teamID,
 			}
-			board, err := th.Server.App().CreateBoard(newBoard, th.GetUser1().ID, true)
+			board, err := th.Server.App().CreateBoard(context.Background(), newBoard, th.GetUser1().ID, true)
 			require.NoError(t, err)
 
 			newUser2Member := &model.BoardMember{
@@ -1753,7 +1754,7 @@ func TestDeleteMember(t *testing.T) {
 				BoardID:      board.ID,
 				SchemeEditor: true,
 			}
-			user2Member, err := th.Server.App().AddMemberToBoard(newUser2Member)
+			user2Member, err := th.Server.App().AddMemberToBoard(context.Background(), newUser2Member)
 			require.NoError(t, err)
 			require.NotNil(t, user2Member)
 			require.False(t, user2Member.SchemeAdmin)
@@ -1764,7 +1765,7 @@ func TestDeleteMember(t *testing.T) {
 				BoardID: board.ID,
 			}
 
-			members, err := th.Server.App().GetMembersForBoard(board.ID)
+			members, err := th.Server.App().GetMembersForBoard(context.Background(), board.ID)
 			require.NoError(t, err)
 			require.Len(t, members, 2)
 
@@ -1773,7 +1774,7 @@ func TestDeleteMember(t *testing.T) {
 			th.CheckForbidden(resp)
 			require.False(t, success)
 
-			members, err = th.Server.App().GetMembersForBoard(board.ID)
+			members, err = th.Server.App().GetMembersForBoard(context.Background(), board.ID)
 			require.NoError(t, err)
 			require.Len(t, members, 2)
 		})
@@ -1788,7 +1789,7 @@ func TestDeleteMember(t *testing.T) {
 				Type:   model.BoardTypePrivate,
 				TeamID: teamID,
 			}
-			board, err := th.Server.App().CreateBoard(newBoard, th.GetUser1().ID, true)
+			board, err := th.Server.App().CreateBoard(context.Background(), newBoard, th.GetUser1().ID, true)
 			require.NoError(t, err)
 
 			newUser2Member := &model.BoardMember{
@@ -1796,7 +1797,7 @@ func TestDeleteMember(t *testing.T) {
 				BoardID:      board.ID,
 				SchemeEditor: true,
 			}
-			user2Member, err := th.Server.App().AddMemberToBoard(newUser2Member)
+			user2Member, err := th.Server.App().AddMemberToBoard(context.Background(), newUser2Member)
 			require.NoError(t, err)
 			require.NotNil(t, user2Member)
 			require.False(t, user2Member.SchemeAdmin)
@@ -1807,7 +1808,7 @@ func TestDeleteMember(t *testing.T) {
 				BoardID: board.ID,
 			}
 
-			members, err := th.Server.App().GetMembersForBoard(board.ID)
+			members, err := th.Server.App().GetMembersForBoard(context.Background(), board.ID)
 			require.NoError(t, err)
 			require.Len(t, members, 2)
 
@@ -1815,7 +1816,7 @@ func TestDeleteMember(t *testing.T) {
 			th.CheckForbidden(resp)
 			require.False(t, success)
 
-			members, err = th.Server.App().GetMembersForBoard(board.ID)
+			members, err = th.Server.App().GetMembersForBoard(context.Background(), board.ID)
 			require.NoError(t, err)
 			require.Len(t, members, 2)
 		})
@@ -1830,7 +1831,7 @@ func TestDeleteMember(t *testing.T) {
 			Type:   model.BoardTypePrivate,
 			TeamID: teamID,
 		}
-		board, err := th.Server.App().CreateBoard(newBoard, th.GetUser1().ID, true)
+		board, err := th.Server.App().CreateBoard(context.Background(), newBoard, th.GetUser1().ID, true)
 		require.NoError(t, err)
 
 		memberToDelete := &model.BoardMember{
@@ -1842,7 +1843,7 @@ func TestDeleteMember(t *testing.T) {
 		th.CheckBadRequest(resp)
 		require.False(t, success)
 
-		members, err := th.Server.App().GetMembersForBoard(board.ID)
+		members, err := th.Server.App().GetMembersForBoard(context.Background(), board.ID)
 		require.NoError(t, err)
 		require.Len(t, members, 1)
 		require.True(t, members[0].SchemeAdmin)
@@ -1854,7 +1855,7 @@ func TestGetTemplates(t *testing.T) {
 		th := SetupTestHelper(t).InitBasic()
 		defer th.TearDown()
 
-		err := th.Server.App().InitTemplates()
+		err := th.Server.App().InitTemplates(context.Background())
 		require.NoError(t, err, "InitTemplates should not fail")
 
 		teamID := "my-team-id"
@@ -1949,7 +1950,7 @@ func TestDuplicateBoard(t *testing.T) {
 		}
 		th.Client.AddMemberToBoard(newUserMember)
 
-		members, err := th.Server.App().GetMembersForBoard(board.ID)
+		members, err := th.Server.App().GetMembersForBoard(context.Background(), board.ID)
 		require.NoError(t, err)
 		require.Len(t, members, 2)
 
@@ -1962,7 +1963,7 @@ func TestDuplicateBoard(t *testing.T) {
 		duplicateBoard := rBoardsAndBlock.Boards[0]
 		require.Equal(t, duplicateBoard.Type, model.BoardTypePrivate, "Duplicated board should be private")
 
-		members, err = th.Server.App().GetMembersForBoard(duplicateBoard.ID)
+		members, err = th.Server.App().GetMembersForBoard(context.Background(), duplicateBoard.ID)
 		require.NoError(t, err)
 		require.Len(t, members, 1, "Duplicated board should only have one member")
 		require.Equal(t, me.ID, members[0].UserID)
@@ -2034,7 +2035,7 @@ func TestDuplicateBoard(t *testing.T) {
 		}
 		th.Client.AddMemberToBoard(newUserMember)
 
-		members, err := th.Server.App().GetMembersForBoard(board.ID)
+		members, err := th.Server.App().GetMembersForBoard(context.Background(), board.ID)
 		require.NoError(t, err)
 		require.Len(t, members, 2)
 
@@ -2049,7 +2050,7 @@ func TestDuplicateBoard(t *testing.T) {
 		require.Equal(t, duplicateBoard.Type, model.BoardTypePrivate, "Duplicated board should be private")
 		require.Equal(t, "Public board copy", duplicateBoard.Title)
 
-		members, err = th.Server.App().GetMembersForBoard(duplicateBoard.ID)
+		members, err = th.Server.App().GetMembersForBoard(context.Background(), duplicateBoard.ID)
 		require.NoError(t, err)
 		require.Len(t, members, 1, "Duplicated board should only have one member")
 		require.Equal(t, me.ID, members[0].UserID)
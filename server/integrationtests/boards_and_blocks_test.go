@@ -4,6 +4,7 @@
 package integrationtests
 
 import (
+	"context"
 	"testing"
 
 	"github.com/mattermost/mattermost-plugin-boards/server/model"
@@ -133,7 +134,7 @@ func TestCreateBoardsAndBlocks(t *testing.T) {
 			require.Equal(t, "public board", board1.Title)
 			require.Equal(t, model.BoardTypeOpen, board1.Type)
 			require.NotEqual(t, "board-id-1", board1.ID)
-			blocks1, err := th.Server.App().GetBlocksForBoard(board1.ID)
+			blocks1, err := th.Server.App().GetBlocksForBoard(context.Background(), board1.ID)
 			require.NoError(t, err)
 			require.Len(t, blocks1, 1)
 			require.Equal(t, "block 1", blocks1[0].Title)
@@ -147,18 +148,18 @@ func TestCreateBoardsAndBlocks(t *testing.T) {
 			require.Equal(t, "private board", board2.Title)
 			require.Equal(t, model.BoardTypePrivate, board2.Type)
 			require.NotEqual(t, "board-id-2", board2.ID)
-			blocks2, err := th.Server.App().GetBlocksForBoard(board2.ID)
+			blocks2, err := th.Server.App().GetBlocksForBoard(context.Background(), board2.ID)
 			require.NoError(t, err)
 			require.Len(t, blocks2, 1)
 			require.Equal(t, "block 2", blocks2[0].Title)
 
 			// user should be an admin of both newly created boards
 			user1 := th.GetUser1()
-			members1, err := th.Server.App().GetMembersForBoard(board1.ID)
+			members1, err := th.Server.App().GetMembersForBoard(context.Background(), board1.ID)
 			require.NoError(t, err)
 			require.Len(t, members1, 1)
 			require.Equal(t, user1.ID, members1[0].UserID)
-			members2, err := th.Server.App().GetMembersForBoard(board2.ID)
+			members2, err := th.Server.App().GetMembersForBoard(context.Background(), board2.ID)
 			require.NoError(t, err)
 			require.Len(t, members2, 1)
 			require.Equal(t, user1.ID, members2[0].UserID)
@@ -193,7 +194,7 @@ func TestPatchBoardsAndBlocks(t *testing.T) {
 			TeamID: teamID,
 			Type:   model.BoardTypeOpen,
 		}
-		board1, err := th.Server.App().CreateBoard(newBoard1, userID, true)
+		board1, err := th.Server.App().CreateBoard(context.Background(), newBoard1, userID, true)
 		require.NoError(t, err)
 		require.NotNil(t, board1)
 
@@ -202,7 +203,7 @@ func TestPatchBoardsAndBlocks(t *testing.T) {
 			TeamID: teamID,
 			Type:   model.BoardTypeOpen,
 		}
-		board2, err := th.Server.App().CreateBoard(newBoard2, userID, true)
+		board2, err := th.Server.App().CreateBoard(context.Background(), newBoard2, userID, true)
 		require.NoError(t, err)
 		require.NotNil(t, board2)
 
@@ -211,8 +212,8 @@ func TestPatchBoardsAndBlocks(t *testing.T) {
 			BoardID: board1.ID,
 			Title:   initialTitle,
 		}
-		require.NoError(t, th.Server.App().InsertBlock(newBlock1, userID))
-		block1, err := th.Server.App().GetBlockByID("block-id-1")
+		require.NoError(t, th.Server.App().InsertBlock(context.Background(), newBlock1, userID))
+		block1, err := th.Server.App().GetBlockByID(context.Background(), "block-id-1")
 		require.NoError(t, err)
 		require.NotNil(t, block1)
 
@@ -221,8 +222,8 @@ func TestPatchBoardsAndBlocks(t *testing.T) {
 			BoardID: board2.ID,
 			Title:   initialTitle,
 		}
-		require.NoError(t, th.Server.App().InsertBlock(newBlock2, userID))
-		block2, err := th.Server.App().GetBlockByID("block-id-2")
+		require.NoError(t, th.Server.App().InsertBlock(context.Background(), newBlock2, userID))
+		block2, err := th.Server.App().GetBlockByID(context.Background(), "block-id-2")
 		require.NoError(t, err)
 		require.NotNil(t, block2)
 
@@ -332,7 +333,7 @@ func TestPatchBoardsAndBlocks(t *testing.T) {
 			TeamID: teamID,
 			Type:   model.BoardTypeOpen,
 		}
-		board1, err := th.Server.App().CreateBoard(newBoard1, userID, true)
+		board1, err := th.Server.App().CreateBoard(context.Background(), newBoard1, userID, true)
 		require.NoError(t, err)
 		require.NotNil(t, board1)
 
@@ -341,7 +342,7 @@ func TestPatchBoardsAndBlocks(t *testing.T) {
 			TeamID: teamID,
 			Type:   model.BoardTypeOpen,
 		}
-		board2, err := th.Server.App().CreateBoard(newBoard2, userID, false)
+		board2, err := th.Server.App().CreateBoard(context.Background(), newBoard2, userID, false)
 		require.NoError(t, err)
 		require.NotNil(t, board2)
 
@@ -350,8 +351,8 @@ func TestPatchBoardsAndBlocks(t *testing.T) {
 			BoardID: board1.ID,
 			Title:   initialTitle,
 		}
-		require.NoError(t, th.Server.App().InsertBlock(newBlock1, userID))
-		block1, err := th.Server.App().GetBlockByID("block-id-1")
+		require.NoError(t, th.Server.App().InsertBlock(context.Background(), newBlock1, userID))
+		block1, err := th.Server.App().GetBlockByID(context.Background(), "block-id-1")
 		require.NoError(t, err)
 		require.NotNil(t, block1)
 
@@ -360,8 +361,8 @@ func TestPatchBoardsAndBlocks(t *testing.T) {
 			BoardID: board2.ID,
 			Title:   initialTitle,
 		}
-		require.NoError(t, th.Server.App().InsertBlock(newBlock2, userID))
-		block2, err := th.Server.App().GetBlockByID("block-id-2")
+		require.NoError(t, th.Server.App().InsertBlock(context.Background(), newBlock2, userID))
+		block2, err := th.Server.App().GetBlockByID(context.Background(), "block-id-2")
 		require.NoError(t, err)
 		require.NotNil(t, block2)
 
@@ -396,7 +397,7 @@ func TestPatchBoardsAndBlocks(t *testing.T) {
 			TeamID: teamID,
 			Type:   model.BoardTypeOpen,
 		}
-		board1, err := th.Server.App().CreateBoard(newBoard1, userID, true)
+		board1, err := th.Server.App().CreateBoard(context.Background(), newBoard1, userID, true)
 		require.NoError(t, err)
 		require.NotNil(t, board1)
 
@@ -405,7 +406,7 @@ func TestPatchBoardsAndBlocks(t *testing.T) {
 			TeamID: "different-team-id",
 			Type:   model.BoardTypeOpen,
 		}
-		board2, err := th.Server.App().CreateBoard(newBoard2, userID, true)
+		board2, err := th.Server.App().CreateBoard(context.Background(), newBoard2, userID, true)
 		require.NoError(t, err)
 		require.NotNil(t, board2)
 
@@ -414,8 +415,8 @@ func TestPatchBoardsAndBlocks(t *testing.T) {
 			BoardID: board1.ID,
 			Title:   initialTitle,
 		}
-		require.NoError(t, th.Server.App().InsertBlock(newBlock1, userID))
-		block1, err := th.Server.App().GetBlockByID("block-id-1")
+		require.NoError(t, th.Server.App().InsertBlock(context.Background(), newBlock1, userID))
+		block1, err := th.Server.App().GetBlockByID(context.Background(), "block-id-1")
 		require.NoError(t, err)
 		require.NotNil(t, block1)
 
@@ -424,8 +425,8 @@ func TestPatchBoardsAndBlocks(t *testing.T) {
 			BoardID: board2.ID,
 			Title:   initialTitle,
 		}
-		require.NoError(t, th.Server.App().InsertBlock(newBlock2, userID))
-		block2, err := th.Server.App().GetBlockByID("block-id-2")
+		require.NoError(t, th.Server.App().InsertBlock(context.Background(), newBlock2, userID))
+		block2, err := th.Server.App().GetBlockByID(context.Background(), "block-id-2")
 		require.NoError(t, err)
 		require.NotNil(t, block2)
 
@@ -460,7 +461,7 @@ func TestPatchBoardsAndBlocks(t *testing.T) {
 			TeamID: teamID,
 			Type:   model.BoardTypeOpen,
 		}
-		board1, err := th.Server.App().CreateBoard(newBoard1, userID, true)
+		board1, err := th.Server.App().CreateBoard(context.Background(), newBoard1, userID, true)
 		require.NoError(t, err)
 		require.NotNil(t, board1)
 
@@ -469,7 +470,7 @@ func TestPatchBoardsAndBlocks(t *testing.T) {
 			TeamID: teamID,
 			Type:   model.BoardTypeOpen,
 		}
-		board2, err := th.Server.App().CreateBoard(newBoard2, userID, false)
+		board2, err := th.Server.App().CreateBoard(context.Background(), newBoard2, userID, false)
 		require.NoError(t, err)
 		require.NotNil(t, board2)
 
@@ -478,8 +479,8 @@ func TestPatchBoardsAndBlocks(t *testing.T) {
 			BoardID: board1.ID,
 			Title:   initialTitle,
 		}
-		require.NoError(t, th.Server.App().InsertBlock(newBlock1, userID))
-		block1, err := th.Server.App().GetBlockByID("block-id-1")
+		require.NoError(t, th.Server.App().InsertBlock(context.Background(), newBlock1, userID))
+		block1, err := th.Server.App().GetBlockByID(context.Background(), "block-id-1")
 		require.NoError(t, err)
 		require.NotNil(t, block1)
 
@@ -488,8 +489,8 @@ func TestPatchBoardsAndBlocks(t *testing.T) {
 			BoardID: board2.ID,
 			Title:   initialTitle,
 		}
-		require.NoError(t, th.Server.App().InsertBlock(newBlock2, userID))
-		block2, err := th.Server.App().GetBlockByID("block-id-2")
+		require.NoError(t, th.Server.App().InsertBlock(context.Background(), newBlock2, userID))
+		block2, err := th.Server.App().GetBlockByID(context.Background(), "block-id-2")
 		require.NoError(t, err)
 		require.NotNil(t, block2)
 
@@ -527,7 +528,7 @@ func TestPatchBoardsAndBlocks(t *testing.T) {
 			TeamID: teamID,
 			Type:   model.BoardTypeOpen,
 		}
-		board1, err := th.Server.App().CreateBoard(newBoard1, userID, true)
+		board1, err := th.Server.App().CreateBoard(context.Background(), newBoard1, userID, true)
 		require.NoError(t, err)
 		require.NotNil(t, board1)
 
@@ -536,7 +537,7 @@ func TestPatchBoardsAndBlocks(t *testing.T) {
 			TeamID: teamID,
 			Type:   model.BoardTypeOpen,
 		}
-		board2, err := th.Server.App().CreateBoard(newBoard2, userID, true)
+		board2, err := th.Server.App().CreateBoard(context.Background(), newBoard2, userID, true)
 		require.NoError(t, err)
 		require.NotNil(t, board2)
 
@@ -545,8 +546,8 @@ func TestPatchBoardsAndBlocks(t *testing.T) {
 			BoardID: board1.ID,
 			Title:   initialTitle,
 		}
-		require.NoError(t, th.Server.App().InsertBlock(newBlock1, userID))
-		block1, err := th.Server.App().GetBlockByID("block-id-1")
+		require.NoError(t, th.Server.App().InsertBlock(context.Background(), newBlock1, userID))
+		block1, err := th.Server.App().GetBlockByID(context.Background(), "block-id-1")
 		require.NoError(t, err)
 		require.NotNil(t, block1)
 
@@ -555,8 +556,8 @@ func TestPatchBoardsAndBlocks(t *testing.T) {
 			BoardID: board2.ID,
 			Title:   initialTitle,
 		}
-		require.NoError(t, th.Server.App().InsertBlock(newBlock2, userID))
-		block2, err := th.Server.App().GetBlockByID("block-id-2")
+		require.NoError(t, th.Server.App().InsertBlock(context.Background(), newBlock2, userID))
+		block2, err := th.Server.App().GetBlockByID(context.Background(), "block-id-2")
 		require.NoError(t, err)
 		require.NotNil(t, block2)
 
@@ -590,7 +591,7 @@ func TestPatchBoardsAndBlocks(t *testing.T) {
 			TeamID: teamID,
 			Type:   model.BoardTypeOpen,
 		}
-		board1, err := th.Server.App().CreateBoard(newBoard1, userID, true)
+		board1, err := th.Server.App().CreateBoard(context.Background(), newBoard1, userID, true)
 		require.NoError(t, err)
 		require.NotNil(t, board1)
 
@@ -599,7 +600,7 @@ func TestPatchBoardsAndBlocks(t *testing.T) {
 			TeamID: teamID,
 			Type:   model.BoardTypeOpen,
 		}
-		board2, err := th.Server.App().CreateBoard(newBoard2, userID, true)
+		board2, err := th.Server.App().CreateBoard(context.Background(), newBoard2, userID, true)
 		require.NoError(t, err)
 		require.NotNil(t, board2)
 
@@ -608,8 +609,8 @@ func TestPatchBoardsAndBlocks(t *testing.T) {
 			BoardID: board1.ID,
 			Title:   initialTitle,
 		}
-		require.NoError(t, th.Server.App().InsertBlock(newBlock1, userID))
-		block1, err := th.Server.App().GetBlockByID("block-id-1")
+		require.NoError(t, th.Server.App().InsertBlock(context.Background(), newBlock1, userID))
+		block1, err := th.Server.App().GetBlockByID(context.Background(), "block-id-1")
 		require.NoError(t, err)
 		require.NotNil(t, block1)
 
@@ -618,8 +619,8 @@ func TestPatchBoardsAndBlocks(t *testing.T) {
 			BoardID: board2.ID,
 			Title:   initialTitle,
 		}
-		require.NoError(t, th.Server.App().InsertBlock(newBlock2, userID))
-		block2, err := th.Server.App().GetBlockByID("block-id-2")
+		require.NoError(t, th.Server.App().InsertBlock(context.Background(), newBlock2, userID))
+		block2, err := th.Server.App().GetBlockByID(context.Background(), "block-id-2")
 		require.NoError(t, err)
 		require.NotNil(t, block2)
 
@@ -643,17 +644,17 @@ func TestPatchBoardsAndBlocks(t *testing.T) {
 		require.Len(t, bab.Blocks, 2)
 
 		// ensure that the entities have been updated
-		rBoard1, err := th.Server.App().GetBoard(board1.ID)
+		rBoard1, err := th.Server.App().GetBoard(context.Background(), board1.ID)
 		require.NoError(t, err)
 		require.Equal(t, newTitle, rBoard1.Title)
-		rBlock1, err := th.Server.App().GetBlockByID(block1.ID)
+		rBlock1, err := th.Server.App().GetBlockByID(context.Background(), block1.ID)
 		require.NoError(t, err)
 		require.Equal(t, newTitle, rBlock1.Title)
 
-		rBoard2, err := th.Server.App().GetBoard(board2.ID)
+		rBoard2, err := th.Server.App().GetBoard(context.Background(), board2.ID)
 		require.NoError(t, err)
 		require.Equal(t, newTitle, rBoard2.Title)
-		rBlock2, err := th.Server.App().GetBlockByID(block2.ID)
+		rBlock2, err := th.Server.App().GetBlockByID(context.Background(), block2.ID)
 		require.NoError(t, err)
 		require.Equal(t, newTitle, rBlock2.Title)
 	})
@@ -682,7 +683,7 @@ func TestDeleteBoardsAndBlocks(t *testing.T) {
 			TeamID: teamID,
 			Type:   model.BoardTypeOpen,
 		}
-		board, err := th.Server.App().CreateBoard(newBoard, th.GetUser1().ID, true)
+		board, err := th.Server.App().CreateBoard(context.Background(), newBoard, th.GetUser1().ID, true)
 		require.NoError(t, err)
 		require.NotNil(t, board)
 
@@ -691,8 +692,8 @@ func TestDeleteBoardsAndBlocks(t *testing.T) {
 			BoardID: board.ID,
 			Title:   "title",
 		}
-		require.NoError(t, th.Server.App().InsertBlock(newBlock, th.GetUser1().ID))
-		block, err := th.Server.App().GetBlockByID(newBlock.ID)
+		require.NoError(t, th.Server.App().InsertBlock(context.Background(), newBlock, th.GetUser1().ID))
+		block, err := th.Server.App().GetBlockByID(context.Background(), newBlock.ID)
 		require.NoError(t, err)
 		require.NotNil(t, block)
 
@@ -711,7 +712,7 @@ func TestDeleteBoardsAndBlocks(t *testing.T) {
 				TeamID: "another-team-id",
 				Type:   model.BoardTypeOpen,
 			}
-			otherTeamsBoard, err := th.Server.App().CreateBoard(newOtherTeamsBoard, th.GetUser1().ID, true)
+			otherTeamsBoard, err := th.Server.App().CreateBoard(context.Background(), newOtherTeamsBoard, th.GetUser1().ID, true)
 			require.NoError(t, err)
 			require.NotNil(t, board)
 
@@ -735,7 +736,7 @@ func TestDeleteBoardsAndBlocks(t *testing.T) {
 			Type:   model.BoardTypeOpen,
 			TeamID: "team_id_1",
 		}
-		board1, err := th.Server.App().CreateBoard(newBoard1, th.GetUser1().ID, true)
+		board1, err := th.Server.App().CreateBoard(context.Background(), newBoard1, th.GetUser1().ID, true)
 		require.NoError(t, err)
 		require.NotNil(t, board1)
 
@@ -744,7 +745,7 @@ func TestDeleteBoardsAndBlocks(t *testing.T) {
 			Type:   model.BoardTypeOpen,
 			TeamID: "team_id_1",
 		}
-		board2, err := th.Server.App().CreateBoard(newBoard2, th.GetUser1().ID, false)
+		board2, err := th.Server.App().CreateBoard(context.Background(), newBoard2, th.GetUser1().ID, false)
 		require.NoError(t, err)
 		require.NotNil(t, board2)
 
@@ -773,23 +774,23 @@ func TestDeleteBoardsAndBlocks(t *testing.T) {
 			},
 		}
 
-		bab, err := th.Server.App().CreateBoardsAndBlocks(newBab, th.GetUser1().ID, true)
+		bab, err := th.Server.App().CreateBoardsAndBlocks(context.Background(), newBab, th.GetUser1().ID, true)
 		require.NoError(t, err)
 		require.Len(t, bab.Boards, 2)
 		require.Len(t, bab.Blocks, 2)
 
 		// ensure that the entities have been successfully created
-		board1, err := th.Server.App().GetBoard("board-id-1")
+		board1, err := th.Server.App().GetBoard(context.Background(), "board-id-1")
 		require.NoError(t, err)
 		require.NotNil(t, board1)
-		block1, err := th.Server.App().GetBlockByID("block-id-1")
+		block1, err := th.Server.App().GetBlockByID(context.Background(), "block-id-1")
 		require.NoError(t, err)
 		require.NotNil(t, block1)
 
-		board2, err := th.Server.App().GetBoard("board-id-2")
+		board2, err := th.Server.App().GetBoard(context.Background(), "board-id-2")
 		require.NoError(t, err)
 		require.NotNil(t, board2)
-		block2, err := th.Server.App().GetBlockByID("block-id-2")
+		block2, err := th.Server.App().GetBlockByID(context.Background(), "block-id-2")
 		require.NoError(t, err)
 		require.NotNil(t, block2)
 
@@ -804,20 +805,20 @@ func TestDeleteBoardsAndBlocks(t *testing.T) {
 		require.True(t, success)
 
 		// ensure that the entities have been successfully deleted
-		board1, err = th.Server.App().GetBoard("board-id-1")
+		board1, err = th.Server.App().GetBoard(context.Background(), "board-id-1")
 		require.Error(t, err)
 		require.True(t, model.IsErrNotFound(err))
 		require.Nil(t, board1)
-		block1, err = th.Server.App().GetBlockByID("block-id-1")
+		block1, err = th.Server.App().GetBlockByID(context.Background(), "block-id-1")
 		require.Error(t, err)
 		require.True(t, model.IsErrNotFound(err))
 		require.Nil(t, block1)
 
-		board2, err = th.Server.App().GetBoard("board-id-2")
+		board2, err = th.Server.App().GetBoard(context.Background(), "board-id-2")
 		require.Error(t, err)
 		require.True(t, model.IsErrNotFound(err))
 		require.Nil(t, board2)
-		block2, err = th.Server.App().GetBlockByID("block-id-2")
+		block2, err = th.Server.App().GetBlockByID(context.Background(), "block-id-2")
 		require.Error(t, err)
 		require.True(t, model.IsErrNotFound(err))
 		require.Nil(t, block2)
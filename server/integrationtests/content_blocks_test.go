@@ -4,6 +4,7 @@
 package integrationtests
 
 import (
+	"context"
 	"fmt"
 	"testing"
 
@@ -177,7 +178,7 @@ func TestMoveContentBlock(t *testing.T) {
 				require.EqualError(t, resp.Error, tc.errorMessage)
 			}
 
-			parent, err := th.Server.App().GetBlockByID(card1.ID)
+			parent, err := th.Server.App().GetBlockByID(context.Background(), card1.ID)
 			require.NoError(t, err)
 			require.Equal(t, parent.Fields["contentOrder"], tc.expectedContentOrder)
 		})
@@ -5,6 +5,7 @@ package integrationtests
 
 import (
 	"bytes"
+	"context"
 	"testing"
 
 	"github.com/mattermost/mattermost-plugin-boards/server/model"
@@ -57,11 +58,11 @@ func TestExportBoard(t *testing.T) {
 		require.NoError(t, resp.Error)
 
 		// check for test card
-		boardsImported, err := th.Server.App().GetBoardsForUserAndTeam(th.GetUser1().ID, model.GlobalTeamID, true)
+		boardsImported, err := th.Server.App().GetBoardsForUserAndTeam(context.Background(), th.GetUser1().ID, model.GlobalTeamID, true)
 		require.NoError(t, err)
 		require.Len(t, boardsImported, 1)
 		boardImported := boardsImported[0]
-		blocksImported, err := th.Server.App().GetBlocksForBoard(boardImported.ID)
+		blocksImported, err := th.Server.App().GetBlocksForBoard(context.Background(), boardImported.ID)
 		require.NoError(t, err)
 		require.Len(t, blocksImported, 1)
 		require.Equal(t, block.Title, blocksImported[0].Title)
@@ -6,6 +6,7 @@ package integrationtests
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"fmt"
 	"io"
@@ -136,94 +137,96 @@ type TestData struct {
 
 func setupData(t *testing.T, th *TestHelper) TestData {
 	customTemplate1, err := th.Server.App().CreateBoard(
+		context.Background(),
 		&model.Board{Title: "Custom template 1", TeamID: "test-team", IsTemplate: true, Type: model.BoardTypeOpen, MinimumRole: "viewer"},
 		userAdminID,
 		true,
 	)
 	require.NoError(t, err)
-	err = th.Server.App().InsertBlock(&model.Block{ID: "block-1", Title: "Test", Type: "card", BoardID: customTemplate1.ID, Fields: map[string]interface{}{}}, userAdminID)
+	err = th.Server.App().InsertBlock(context.Background(), &model.Block{ID: "block-1", Title: "Test", Type: "card", BoardID: customTemplate1.ID, Fields: map[string]interface{}{}}, userAdminID)
 	require.NoError(t, err)
 	customTemplate2, err := th.Server.App().CreateBoard(
+		context.Background(),
 		&model.Board{Title: "Custom template 2", TeamID: "test-team", IsTemplate: true, Type: model.BoardTypePrivate, MinimumRole: "viewer"},
 		userAdminID,
 		true)
 	require.NoError(t, err)
-	err = th.Server.App().InsertBlock(&model.Block{ID: "block-2", Title: "Test", Type: "card", BoardID: customTemplate2.ID, Fields: map[string]interface{}{}}, userAdminID)
+	err = th.Server.App().InsertBlock(context.Background(), &model.Block{ID: "block-2", Title: "Test", Type: "card", BoardID: customTemplate2.ID, Fields: map[string]interface{}{}}, userAdminID)
 	require.NoError(t, err)
 
-	board1, err := th.Server.App().CreateBoard(&model.Board{Title: "Board 1", TeamID: "test-team", Type: model.BoardTypeOpen, MinimumRole: "viewer"}, userAdminID, true)
+	board1, err := th.Server.App().CreateBoard(context.Background(), &model.Board{Title: "Board 1", TeamID: "test-team", Type: model.BoardTypeOpen, MinimumRole: "viewer"}, userAdminID, true)
 	require.NoError(t, err)
-	err = th.Server.App().InsertBlock(&model.Block{ID: "block-3", Title: "Test", Type: "card", BoardID: board1.ID, Fields: map[string]interface{}{}}, userAdminID)
+	err = th.Server.App().InsertBlock(context.Background(), &model.Block{ID: "block-3", Title: "Test", Type: "card", BoardID: board1.ID, Fields: map[string]interface{}{}}, userAdminID)
 	require.NoError(t, err)
-	board2, err := th.Server.App().CreateBoard(&model.Board{Title: "Board 2", TeamID: "test-team", Type: model.BoardTypePrivate, MinimumRole: "viewer"}, userAdminID, true)
+	board2, err := th.Server.App().CreateBoard(context.Background(), &model.Board{Title: "Board 2", TeamID: "test-team", Type: model.BoardTypePrivate, MinimumRole: "viewer"}, userAdminID, true)
 	require.NoError(t, err)
 
-	rBoard2, err := th.Server.App().GetBoard(board2.ID)
+	rBoard2, err := th.Server.App().GetBoard(context.Background(), board2.ID)
 	require.NoError(t, err)
 	require.NotNil(t, rBoard2)
 	require.Equal(t, rBoard2, board2)
 
-	boardMember, err := th.Server.App().GetMemberForBoard(board2.ID, userAdminID)
+	boardMember, err := th.Server.App().GetMemberForBoard(context.Background(), board2.ID, userAdminID)
 	require.NoError(t, err)
 	require.NotNil(t, boardMember)
 	require.Equal(t, boardMember.UserID, userAdminID)
 	require.Equal(t, boardMember.BoardID, board2.ID)
 
-	err = th.Server.App().InsertBlock(&model.Block{ID: "block-4", Title: "Test", Type: "card", BoardID: board2.ID, Fields: map[string]interface{}{}}, userAdminID)
+	err = th.Server.App().InsertBlock(context.Background(), &model.Block{ID: "block-4", Title: "Test", Type: "card", BoardID: board2.ID, Fields: map[string]interface{}{}}, userAdminID)
 	require.NoError(t, err)
 
-	err = th.Server.App().UpsertSharing(model.Sharing{ID: board2.ID, Enabled: true, Token: "valid", ModifiedBy: userAdminID, UpdateAt: model.GetMillis()})
+	err = th.Server.App().UpsertSharing(context.Background(), model.Sharing{ID: board2.ID, Enabled: true, Token: "valid", ModifiedBy: userAdminID, UpdateAt: model.GetMillis()})
 	require.NoError(t, err)
 
-	_, err = th.Server.App().AddMemberToBoard(&model.BoardMember{BoardID: customTemplate1.ID, UserID: userViewerID, SchemeViewer: true})
+	_, err = th.Server.App().AddMemberToBoard(context.Background(), &model.BoardMember{BoardID: customTemplate1.ID, UserID: userViewerID, SchemeViewer: true})
 	require.NoError(t, err)
-	_, err = th.Server.App().AddMemberToBoard(&model.BoardMember{BoardID: customTemplate2.ID, UserID: userViewerID, SchemeViewer: true})
+	_, err = th.Server.App().AddMemberToBoard(context.Background(), &model.BoardMember{BoardID: customTemplate2.ID, UserID: userViewerID, SchemeViewer: true})
 	require.NoError(t, err)
-	_, err = th.Server.App().AddMemberToBoard(&model.BoardMember{BoardID: customTemplate1.ID, UserID: userCommenterID, SchemeCommenter: true})
+	_, err = th.Server.App().AddMemberToBoard(context.Background(), &model.BoardMember{BoardID: customTemplate1.ID, UserID: userCommenterID, SchemeCommenter: true})
 	require.NoError(t, err)
-	_, err = th.Server.App().AddMemberToBoard(&model.BoardMember{BoardID: customTemplate2.ID, UserID: userCommenterID, SchemeCommenter: true})
+	_, err = th.Server.App().AddMemberToBoard(context.Background(), &model.BoardMember{BoardID: customTemplate2.ID, UserID: userCommenterID, SchemeCommenter: true})
 	require.NoError(t, err)
-	_, err = th.Server.App().AddMemberToBoard(&model.BoardMember{BoardID: customTemplate1.ID, UserID: userEditorID, SchemeEditor: true})
+	_, err = th.Server.App().AddMemberToBoard(context.Background(), &model.BoardMember{BoardID: customTemplate1.ID, UserID: userEditorID, SchemeEditor: true})
 	require.NoError(t, err)
-	_, err = th.Server.App().AddMemberToBoard(&model.BoardMember{BoardID: customTemplate2.ID, UserID: userEditorID, SchemeEditor: true})
+	_, err = th.Server.App().AddMemberToBoard(context.Background(), &model.BoardMember{BoardID: customTemplate2.ID, UserID: userEditorID, SchemeEditor: true})
 	require.NoError(t, err)
-	_, err = th.Server.App().AddMemberToBoard(&model.BoardMember{BoardID: customTemplate1.ID, UserID: userAdminID, SchemeAdmin: true})
+	_, err = th.Server.App().AddMemberToBoard(context.Background(), &model.BoardMember{BoardID: customTemplate1.ID, UserID: userAdminID, SchemeAdmin: true})
 	require.NoError(t, err)
-	_, err = th.Server.App().AddMemberToBoard(&model.BoardMember{BoardID: customTemplate2.ID, UserID: userAdminID, SchemeAdmin: true})
+	_, err = th.Server.App().AddMemberToBoard(context.Background(), &model.BoardMember{BoardID: customTemplate2.ID, UserID: userAdminID, SchemeAdmin: true})
 	require.NoError(t, err)
 
-	_, err = th.Server.App().AddMemberToBoard(&model.BoardMember{BoardID: board1.ID, UserID: userViewerID, SchemeViewer: true})
+	_, err = th.Server.App().AddMemberToBoard(context.Background(), &model.BoardMember{BoardID: board1.ID, UserID: userViewerID, SchemeViewer: true})
 	require.NoError(t, err)
 
-	boardMember, err = th.Server.App().GetMemberForBoard(board1.ID, userViewerID)
+	boardMember, err = th.Server.App().GetMemberForBoard(context.Background(), board1.ID, userViewerID)
 	require.NoError(t, err)
 	require.NotNil(t, boardMember)
 	require.Equal(t, boardMember.UserID, userViewerID)
 	require.Equal(t, boardMember.BoardID, board1.ID)
 
-	_, err = th.Server.App().AddMemberToBoard(&model.BoardMember{BoardID: board2.ID, UserID: userViewerID, SchemeViewer: true})
+	_, err = th.Server.App().AddMemberToBoard(context.Background(), &model.BoardMember{BoardID: board2.ID, UserID: userViewerID, SchemeViewer: true})
 	require.NoError(t, err)
 
-	boardMember, err = th.Server.App().GetMemberForBoard(board2.ID, userViewerID)
+	boardMember, err = th.Server.App().GetMemberForBoard(context.Background(), board2.ID, userViewerID)
 	require.NoError(t, err)
 	require.NotNil(t, boardMember)
 	require.Equal(t, boardMember.UserID, userViewerID)
 	require.Equal(t, boardMember.BoardID, board2.ID)
 
-	_, err = th.Server.App().AddMemberToBoard(&model.BoardMember{BoardID: board1.ID, UserID: userCommenterID, SchemeCommenter: true})
+	_, err = th.Server.App().AddMemberToBoard(context.Background(), &model.BoardMember{BoardID: board1.ID, UserID: userCommenterID, SchemeCommenter: true})
 	require.NoError(t, err)
-	_, err = th.Server.App().AddMemberToBoard(&model.BoardMember{BoardID: board2.ID, UserID: userCommenterID, SchemeCommenter: true})
+	_, err = th.Server.App().AddMemberToBoard(context.Background(), &model.BoardMember{BoardID: board2.ID, UserID: userCommenterID, SchemeCommenter: true})
 	require.NoError(t, err)
-	_, err = th.Server.App().AddMemberToBoard(&model.BoardMember{BoardID: board1.ID, UserID: userEditorID, SchemeEditor: true})
+	_, err = th.Server.App().AddMemberToBoard(context.Background(), &model.BoardMember{BoardID: board1.ID, UserID: userEditorID, SchemeEditor: true})
 	require.NoError(t, err)
-	_, err = th.Server.App().AddMemberToBoard(&model.BoardMember{BoardID: board2.ID, UserID: userEditorID, SchemeEditor: true})
+	_, err = th.Server.App().AddMemberToBoard(context.Background(), &model.BoardMember{BoardID: board2.ID, UserID: userEditorID, SchemeEditor: true})
 	require.NoError(t, err)
-	_, err = th.Server.App().AddMemberToBoard(&model.BoardMember{BoardID: board1.ID, UserID: userAdminID, SchemeAdmin: true})
+	_, err = th.Server.App().AddMemberToBoard(context.Background(), &model.BoardMember{BoardID: board1.ID, UserID: userAdminID, SchemeAdmin: true})
 	require.NoError(t, err)
-	_, err = th.Server.App().AddMemberToBoard(&model.BoardMember{BoardID: board2.ID, UserID: userAdminID, SchemeAdmin: true})
+	_, err = th.Server.App().AddMemberToBoard(context.Background(), &model.BoardMember{BoardID: board2.ID, UserID: userAdminID, SchemeAdmin: true})
 	require.NoError(t, err)
 
-	_, err = th.Server.App().AddMemberToBoard(&model.BoardMember{BoardID: board2.ID, UserID: userGuestID, SchemeViewer: true})
+	_, err = th.Server.App().AddMemberToBoard(context.Background(), &model.BoardMember{BoardID: board2.ID, UserID: userGuestID, SchemeViewer: true})
 	require.NoError(t, err)
 
 	return TestData{
@@ -425,7 +428,7 @@ func TestPermissionsSearchTeamLinkableBoards(t *testing.T) {
 
 func TestPermissionsGetTeamTemplates(t *testing.T) {
 	extraSetup := func(t *testing.T, th *TestHelper) {
-		err := th.Server.App().InitTemplates()
+		err := th.Server.App().InitTemplates(context.Background())
 		require.NoError(t, err, "InitTemplates should succeed")
 	}
 
@@ -1352,13 +1355,13 @@ func TestPermissionsPatchBoardBlock(t *testing.T) {
 
 func TestPermissionsDeleteBoardBlock(t *testing.T) {
 	extraSetup := func(t *testing.T, th *TestHelper, testData TestData) {
-		err := th.Server.App().InsertBlock(&model.Block{ID: "block-5", Title: "Test", Type: "card", BoardID: testData.publicTemplate.ID}, userAdmin)
+		err := th.Server.App().InsertBlock(context.Background(), &model.Block{ID: "block-5", Title: "Test", Type: "card", BoardID: testData.publicTemplate.ID}, userAdmin)
 		require.NoError(t, err)
-		err = th.Server.App().InsertBlock(&model.Block{ID: "block-6", Title: "Test", Type: "card", BoardID: testData.privateTemplate.ID}, userAdmin)
+		err = th.Server.App().InsertBlock(context.Background(), &model.Block{ID: "block-6", Title: "Test", Type: "card", BoardID: testData.privateTemplate.ID}, userAdmin)
 		require.NoError(t, err)
-		err = th.Server.App().InsertBlock(&model.Block{ID: "block-7", Title: "Test", Type: "card", BoardID: testData.publicBoard.ID}, userAdmin)
+		err = th.Server.App().InsertBlock(context.Background(), &model.Block{ID: "block-7", Title: "Test", Type: "card", BoardID: testData.publicBoard.ID}, userAdmin)
 		require.NoError(t, err)
-		err = th.Server.App().InsertBlock(&model.Block{ID: "block-8", Title: "Test", Type: "card", BoardID: testData.privateBoard.ID}, userAdmin)
+		err = th.Server.App().InsertBlock(context.Background(), &model.Block{ID: "block-8", Title: "Test", Type: "card", BoardID: testData.privateBoard.ID}, userAdmin)
 		require.NoError(t, err)
 	}
 
@@ -1423,29 +1426,29 @@ func TestPermissionsDeleteBoardBlock(t *testing.T) {
 
 func TestPermissionsUndeleteBoardBlock(t *testing.T) {
 	extraSetup := func(t *testing.T, th *TestHelper, testData TestData) {
-		err := th.Server.App().InsertBlock(&model.Block{ID: "block-5", Title: "Test", Type: "card", BoardID: testData.publicTemplate.ID}, userAdmin)
+		err := th.Server.App().InsertBlock(context.Background(), &model.Block{ID: "block-5", Title: "Test", Type: "card", BoardID: testData.publicTemplate.ID}, userAdmin)
 		require.NoError(t, err)
-		err = th.Server.App().InsertBlock(&model.Block{ID: "block-6", Title: "Test", Type: "card", BoardID: testData.privateTemplate.ID}, userAdmin)
+		err = th.Server.App().InsertBlock(context.Background(), &model.Block{ID: "block-6", Title: "Test", Type: "card", BoardID: testData.privateTemplate.ID}, userAdmin)
 		require.NoError(t, err)
-		err = th.Server.App().InsertBlock(&model.Block{ID: "block-7", Title: "Test", Type: "card", BoardID: testData.publicBoard.ID}, userAdmin)
+		err = th.Server.App().InsertBlock(context.Background(), &model.Block{ID: "block-7", Title: "Test", Type: "card", BoardID: testData.publicBoard.ID}, userAdmin)
 		require.NoError(t, err)
-		err = th.Server.App().InsertBlock(&model.Block{ID: "block-8", Title: "Test", Type: "card", BoardID: testData.privateBoard.ID}, userAdmin)
+		err = th.Server.App().InsertBlock(context.Background(), &model.Block{ID: "block-8", Title: "Test", Type: "card", BoardID: testData.privateBoard.ID}, userAdmin)
 		require.NoError(t, err)
-		err = th.Server.App().DeleteBlock("block-1", userAdmin)
+		err = th.Server.App().DeleteBlock(context.Background(), "block-1", userAdmin)
 		require.NoError(t, err)
-		err = th.Server.App().DeleteBlock("block-2", userAdmin)
+		err = th.Server.App().DeleteBlock(context.Background(), "block-2", userAdmin)
 		require.NoError(t, err)
-		err = th.Server.App().DeleteBlock("block-3", userAdmin)
+		err = th.Server.App().DeleteBlock(context.Background(), "block-3", userAdmin)
 		require.NoError(t, err)
-		err = th.Server.App().DeleteBlock("block-4", userAdmin)
+		err = th.Server.App().DeleteBlock(context.Background(), "block-4", userAdmin)
 		require.NoError(t, err)
-		err = th.Server.App().DeleteBlock("block-5", userAdmin)
+		err = th.Server.App().DeleteBlock(context.Background(), "block-5", userAdmin)
 		require.NoError(t, err)
-		err = th.Server.App().DeleteBlock("block-6", userAdmin)
+		err = th.Server.App().DeleteBlock(context.Background(), "block-6", userAdmin)
 		require.NoError(t, err)
-		err = th.Server.App().DeleteBlock("block-7", userAdmin)
+		err = th.Server.App().DeleteBlock(context.Background(), "block-7", userAdmin)
 		require.NoError(t, err)
-		err = th.Server.App().DeleteBlock("block-8", userAdmin)
+		err = th.Server.App().DeleteBlock(context.Background(), "block-8", userAdmin)
 		require.NoError(t, err)
 	}
 
@@ -1510,29 +1513,29 @@ func TestPermissionsUndeleteBoardBlock(t *testing.T) {
 
 func TestPermissionsMoveContentBlock(t *testing.T) {
 	extraSetup := func(t *testing.T, th *TestHelper, testData TestData) {
-		err := th.Server.App().InsertBlock(&model.Block{ID: "content-1-1", Title: "Test", Type: "text", BoardID: testData.publicTemplate.ID, ParentID: "block-1"}, userAdmin)
+		err := th.Server.App().InsertBlock(context.Background(), &model.Block{ID: "content-1-1", Title: "Test", Type: "text", BoardID: testData.publicTemplate.ID, ParentID: "block-1"}, userAdmin)
 		require.NoError(t, err)
-		err = th.Server.App().InsertBlock(&model.Block{ID: "content-1-2", Title: "Test", Type: "text", BoardID: testData.publicTemplate.ID, ParentID: "block-1"}, userAdmin)
+		err = th.Server.App().InsertBlock(context.Background(), &model.Block{ID: "content-1-2", Title: "Test", Type: "text", BoardID: testData.publicTemplate.ID, ParentID: "block-1"}, userAdmin)
 		require.NoError(t, err)
-		_, err = th.Server.App().PatchBlock("block-1", &model.BlockPatch{UpdatedFields: map[string]interface{}{"contentOrder": []string{"content-1-1", "content-1-2"}}}, userAdmin)
+		_, err = th.Server.App().PatchBlock(context.Background(), "block-1", &model.BlockPatch{UpdatedFields: map[string]interface{}{"contentOrder": []string{"content-1-1", "content-1-2"}}}, userAdmin)
 		require.NoError(t, err)
-		err = th.Server.App().InsertBlock(&model.Block{ID: "content-2-1", Title: "Test", Type: "text", BoardID: testData.privateTemplate.ID, ParentID: "block-2"}, userAdmin)
+		err = th.Server.App().InsertBlock(context.Background(), &model.Block{ID: "content-2-1", Title: "Test", Type: "text", BoardID: testData.privateTemplate.ID, ParentID: "block-2"}, userAdmin)
 		require.NoError(t, err)
-		err = th.Server.App().InsertBlock(&model.Block{ID: "content-2-2", Title: "Test", Type: "text", BoardID: testData.privateTemplate.ID, ParentID: "block-2"}, userAdmin)
+		err = th.Server.App().InsertBlock(context.Background(), &model.Block{ID: "content-2-2", Title: "Test", Type: "text", BoardID: testData.privateTemplate.ID, ParentID: "block-2"}, userAdmin)
 		require.NoError(t, err)
-		_, err = th.Server.App().PatchBlock("block-2", &model.BlockPatch{UpdatedFields: map[string]interface{}{"contentOrder": []string{"content-2-1", "content-2-2"}}}, userAdmin)
+		_, err = th.Server.App().PatchBlock(context.Background(), "block-2", &model.BlockPatch{UpdatedFields: map[string]interface{}{"contentOrder": []string{"content-2-1", "content-2-2"}}}, userAdmin)
 		require.NoError(t, err)
-		err = th.Server.App().InsertBlock(&model.Block{ID: "content-3-1", Title: "Test", Type: "text", BoardID: testData.publicBoard.ID, ParentID: "block-3"}, userAdmin)
+		err = th.Server.App().InsertBlock(context.Background(), &model.Block{ID: "content-3-1", Title: "Test", Type: "text", BoardID: testData.publicBoard.ID, ParentID: "block-3"}, userAdmin)
 		require.NoError(t, err)
-		err = th.Server.App().InsertBlock(&model.Block{ID: "content-3-2", Title: "Test", Type: "text", BoardID: testData.publicBoard.ID, ParentID: "block-3"}, userAdmin)
+		err = th.Server.App().InsertBlock(context.Background(), &model.Block{ID: "content-3-2", Title: "Test", Type: "text", BoardID: testData.publicBoard.ID, ParentID: "block-3"}, userAdmin)
 		require.NoError(t, err)
-		_, err = th.Server.App().PatchBlock("block-3", &model.BlockPatch{UpdatedFields: map[string]interface{}{"contentOrder": []string{"content-3-1", "content-3-2"}}}, userAdmin)
+		_, err = th.Server.App().PatchBlock(context.Background(), "block-3", &model.BlockPatch{UpdatedFields: map[string]interface{}{"contentOrder": []string{"content-3-1", "content-3-2"}}}, userAdmin)
 		require.NoError(t, err)
-		err = th.Server.App().InsertBlock(&model.Block{ID: "content-4-1", Title: "Test", Type: "text", BoardID: testData.privateBoard.ID, ParentID: "block-4"}, userAdmin)
+		err = th.Server.App().InsertBlock(context.Background(), &model.Block{ID: "content-4-1", Title: "Test", Type: "text", BoardID: testData.privateBoard.ID, ParentID: "block-4"}, userAdmin)
 		require.NoError(t, err)
-		err = th.Server.App().InsertBlock(&model.Block{ID: "content-4-2", Title: "Test", Type: "text", BoardID: testData.privateBoard.ID, ParentID: "block-4"}, userAdmin)
+		err = th.Server.App().InsertBlock(context.Background(), &model.Block{ID: "content-4-2", Title: "Test", Type: "text", BoardID: testData.privateBoard.ID, ParentID: "block-4"}, userAdmin)
 		require.NoError(t, err)
-		_, err = th.Server.App().PatchBlock("block-4", &model.BlockPatch{UpdatedFields: map[string]interface{}{"contentOrder": []string{"content-4-1", "content-4-2"}}}, userAdmin)
+		_, err = th.Server.App().PatchBlock(context.Background(), "block-4", &model.BlockPatch{UpdatedFields: map[string]interface{}{"contentOrder": []string{"content-4-1", "content-4-2"}}}, userAdmin)
 		require.NoError(t, err)
 	}
 
@@ -1597,13 +1600,13 @@ func TestPermissionsMoveContentBlock(t *testing.T) {
 
 func TestPermissionsUndeleteBoard(t *testing.T) {
 	extraSetup := func(t *testing.T, th *TestHelper, testData TestData) {
-		err := th.Server.App().DeleteBoard(testData.publicBoard.ID, userAdmin)
+		err := th.Server.App().DeleteBoard(context.Background(), testData.publicBoard.ID, userAdmin)
 		require.NoError(t, err)
-		err = th.Server.App().DeleteBoard(testData.privateBoard.ID, userAdmin)
+		err = th.Server.App().DeleteBoard(context.Background(), testData.privateBoard.ID, userAdmin)
 		require.NoError(t, err)
-		err = th.Server.App().DeleteBoard(testData.publicTemplate.ID, userAdmin)
+		err = th.Server.App().DeleteBoard(context.Background(), testData.publicTemplate.ID, userAdmin)
 		require.NoError(t, err)
-		err = th.Server.App().DeleteBoard(testData.privateTemplate.ID, userAdmin)
+		err = th.Server.App().DeleteBoard(context.Background(), testData.privateTemplate.ID, userAdmin)
 		require.NoError(t, err)
 	}
 
@@ -1665,13 +1668,13 @@ func TestPermissionsUndeleteBoard(t *testing.T) {
 
 func TestPermissionsDuplicateBoardBlock(t *testing.T) {
 	extraSetup := func(t *testing.T, th *TestHelper, testData TestData) {
-		err := th.Server.App().InsertBlock(&model.Block{ID: "block-5", Title: "Test", Type: "card", BoardID: testData.publicTemplate.ID}, userAdmin)
+		err := th.Server.App().InsertBlock(context.Background(), &model.Block{ID: "block-5", Title: "Test", Type: "card", BoardID: testData.publicTemplate.ID}, userAdmin)
 		require.NoError(t, err)
-		err = th.Server.App().InsertBlock(&model.Block{ID: "block-6", Title: "Test", Type: "card", BoardID: testData.privateTemplate.ID}, userAdmin)
+		err = th.Server.App().InsertBlock(context.Background(), &model.Block{ID: "block-6", Title: "Test", Type: "card", BoardID: testData.privateTemplate.ID}, userAdmin)
 		require.NoError(t, err)
-		err = th.Server.App().InsertBlock(&model.Block{ID: "block-7", Title: "Test", Type: "card", BoardID: testData.publicBoard.ID}, userAdmin)
+		err = th.Server.App().InsertBlock(context.Background(), &model.Block{ID: "block-7", Title: "Test", Type: "card", BoardID: testData.publicBoard.ID}, userAdmin)
 		require.NoError(t, err)
-		err = th.Server.App().InsertBlock(&model.Block{ID: "block-8", Title: "Test", Type: "card", BoardID: testData.privateBoard.ID}, userAdmin)
+		err = th.Server.App().InsertBlock(context.Background(), &model.Block{ID: "block-8", Title: "Test", Type: "card", BoardID: testData.privateBoard.ID}, userAdmin)
 		require.NoError(t, err)
 	}
 
@@ -1935,13 +1938,13 @@ func TestPermissionsUpdateBoardMember(t *testing.T) {
 
 func TestPermissionsDeleteBoardMember(t *testing.T) {
 	extraSetup := func(t *testing.T, th *TestHelper, testData TestData) {
-		_, err := th.Server.App().AddMemberToBoard(&model.BoardMember{BoardID: testData.publicBoard.ID, UserID: userTeamMemberID, SchemeViewer: true})
+		_, err := th.Server.App().AddMemberToBoard(context.Background(), &model.BoardMember{BoardID: testData.publicBoard.ID, UserID: userTeamMemberID, SchemeViewer: true})
 		require.NoError(t, err)
-		_, err = th.Server.App().AddMemberToBoard(&model.BoardMember{BoardID: testData.privateBoard.ID, UserID: userTeamMemberID, SchemeViewer: true})
+		_, err = th.Server.App().AddMemberToBoard(context.Background(), &model.BoardMember{BoardID: testData.privateBoard.ID, UserID: userTeamMemberID, SchemeViewer: true})
 		require.NoError(t, err)
-		_, err = th.Server.App().AddMemberToBoard(&model.BoardMember{BoardID: testData.publicTemplate.ID, UserID: userTeamMemberID, SchemeViewer: true})
+		_, err = th.Server.App().AddMemberToBoard(context.Background(), &model.BoardMember{BoardID: testData.publicTemplate.ID, UserID: userTeamMemberID, SchemeViewer: true})
 		require.NoError(t, err)
-		_, err = th.Server.App().AddMemberToBoard(&model.BoardMember{BoardID: testData.privateTemplate.ID, UserID: userTeamMemberID, SchemeViewer: true})
+		_, err = th.Server.App().AddMemberToBoard(context.Background(), &model.BoardMember{BoardID: testData.privateTemplate.ID, UserID: userTeamMemberID, SchemeViewer: true})
 		require.NoError(t, err)
 	}
 
@@ -2073,13 +2076,13 @@ func TestPermissionsJoinBoardAsMember(t *testing.T) {
 
 func TestPermissionsLeaveBoardAsMember(t *testing.T) {
 	extraSetup := func(t *testing.T, th *TestHelper, testData TestData) {
-		_, err := th.Server.App().AddMemberToBoard(&model.BoardMember{BoardID: testData.publicBoard.ID, UserID: "not-real-user", SchemeAdmin: true})
+		_, err := th.Server.App().AddMemberToBoard(context.Background(), &model.BoardMember{BoardID: testData.publicBoard.ID, UserID: "not-real-user", SchemeAdmin: true})
 		require.NoError(t, err)
-		_, err = th.Server.App().AddMemberToBoard(&model.BoardMember{BoardID: testData.privateBoard.ID, UserID: "not-real-user", SchemeAdmin: true})
+		_, err = th.Server.App().AddMemberToBoard(context.Background(), &model.BoardMember{BoardID: testData.privateBoard.ID, UserID: "not-real-user", SchemeAdmin: true})
 		require.NoError(t, err)
-		_, err = th.Server.App().AddMemberToBoard(&model.BoardMember{BoardID: testData.publicTemplate.ID, UserID: "not-real-user", SchemeAdmin: true})
+		_, err = th.Server.App().AddMemberToBoard(context.Background(), &model.BoardMember{BoardID: testData.publicTemplate.ID, UserID: "not-real-user", SchemeAdmin: true})
 		require.NoError(t, err)
-		_, err = th.Server.App().AddMemberToBoard(&model.BoardMember{BoardID: testData.privateTemplate.ID, UserID: "not-real-user", SchemeAdmin: true})
+		_, err = th.Server.App().AddMemberToBoard(context.Background(), &model.BoardMember{BoardID: testData.privateTemplate.ID, UserID: "not-real-user", SchemeAdmin: true})
 		require.NoError(t, err)
 	}
 
@@ -2139,19 +2142,19 @@ func TestPermissionsLeaveBoardAsMember(t *testing.T) {
 
 	// Last admin leave should fail
 	extraSetup = func(t *testing.T, th *TestHelper, testData TestData) {
-		_, err := th.Server.App().AddMemberToBoard(&model.BoardMember{BoardID: testData.publicBoard.ID, UserID: userAdminID, SchemeAdmin: true})
+		_, err := th.Server.App().AddMemberToBoard(context.Background(), &model.BoardMember{BoardID: testData.publicBoard.ID, UserID: userAdminID, SchemeAdmin: true})
 		require.NoError(t, err)
-		_, err = th.Server.App().AddMemberToBoard(&model.BoardMember{BoardID: testData.privateBoard.ID, UserID: userAdminID, SchemeAdmin: true})
+		_, err = th.Server.App().AddMemberToBoard(context.Background(), &model.BoardMember{BoardID: testData.privateBoard.ID, UserID: userAdminID, SchemeAdmin: true})
 		require.NoError(t, err)
-		_, err = th.Server.App().AddMemberToBoard(&model.BoardMember{BoardID: testData.publicTemplate.ID, UserID: userAdminID, SchemeAdmin: true})
+		_, err = th.Server.App().AddMemberToBoard(context.Background(), &model.BoardMember{BoardID: testData.publicTemplate.ID, UserID: userAdminID, SchemeAdmin: true})
 		require.NoError(t, err)
-		_, err = th.Server.App().AddMemberToBoard(&model.BoardMember{BoardID: testData.privateTemplate.ID, UserID: userAdminID, SchemeAdmin: true})
+		_, err = th.Server.App().AddMemberToBoard(context.Background(), &model.BoardMember{BoardID: testData.privateTemplate.ID, UserID: userAdminID, SchemeAdmin: true})
 		require.NoError(t, err)
 
-		require.NoError(t, th.Server.App().DeleteBoardMember(testData.publicBoard.ID, "not-real-user"))
-		require.NoError(t, th.Server.App().DeleteBoardMember(testData.privateBoard.ID, "not-real-user"))
-		require.NoError(t, th.Server.App().DeleteBoardMember(testData.publicTemplate.ID, "not-real-user"))
-		require.NoError(t, th.Server.App().DeleteBoardMember(testData.privateTemplate.ID, "not-real-user"))
+		require.NoError(t, th.Server.App().DeleteBoardMember(context.Background(), testData.publicBoard.ID, "not-real-user"))
+		require.NoError(t, th.Server.App().DeleteBoardMember(context.Background(), testData.privateBoard.ID, "not-real-user"))
+		require.NoError(t, th.Server.App().DeleteBoardMember(context.Background(), testData.publicTemplate.ID, "not-real-user"))
+		require.NoError(t, th.Server.App().DeleteBoardMember(context.Background(), testData.privateTemplate.ID, "not-real-user"))
 	}
 
 	ttCases = []TestCase{
@@ -2818,7 +2821,7 @@ func TestPermissionsDeleteBoardsAndBlocks(t *testing.T) {
 		testData := setupData(t, th)
 		ttCases := ttCasesF(t, testData)
 
-		_, err := th.Server.App().AddMemberToBoard(&model.BoardMember{BoardID: testData.publicBoard.ID, UserID: userGuestID, SchemeViewer: true})
+		_, err := th.Server.App().AddMemberToBoard(context.Background(), &model.BoardMember{BoardID: testData.publicBoard.ID, UserID: userGuestID, SchemeViewer: true})
 		require.NoError(t, err)
 
 		runTestCases(t, ttCases, testData, clients)
@@ -3017,30 +3020,37 @@ func TestPermissionsUpdateCategory(t *testing.T) {
 
 	extraSetup := func(t *testing.T, th *TestHelper) map[string]string {
 		categoryNoTeamMember, err := th.Server.App().CreateCategory(
+			context.Background(),
 			&model.Category{Name: "Test category", TeamID: "test-team", UserID: userNoTeamMemberID, CreateAt: model.GetMillis(), UpdateAt: model.GetMillis()},
 		)
 		require.NoError(t, err)
 		categoryTeamMember, err := th.Server.App().CreateCategory(
+			context.Background(),
 			&model.Category{Name: "Test category", TeamID: "test-team", UserID: userTeamMemberID, CreateAt: model.GetMillis(), UpdateAt: model.GetMillis()},
 		)
 		require.NoError(t, err)
 		categoryViewer, err := th.Server.App().CreateCategory(
+			context.Background(),
 			&model.Category{Name: "Test category", TeamID: "test-team", UserID: userViewerID, CreateAt: model.GetMillis(), UpdateAt: model.GetMillis()},
 		)
 		require.NoError(t, err)
 		categoryCommenter, err := th.Server.App().CreateCategory(
+			context.Background(),
 			&model.Category{Name: "Test category", TeamID: "test-team", UserID: userCommenterID, CreateAt: model.GetMillis(), UpdateAt: model.GetMillis()},
 		)
 		require.NoError(t, err)
 		categoryEditor, err := th.Server.App().CreateCategory(
+			context.Background(),
 			&model.Category{Name: "Test category", TeamID: "test-team", UserID: userEditorID, CreateAt: model.GetMillis(), UpdateAt: model.GetMillis()},
 		)
 		require.NoError(t, err)
 		categoryAdmin, err := th.Server.App().CreateCategory(
+			context.Background(),
 			&model.Category{Name: "Test category", TeamID: "test-team", UserID: userAdminID, CreateAt: model.GetMillis(), UpdateAt: model.GetMillis()},
 		)
 		require.NoError(t, err)
 		categoryGuest, err := th.Server.App().CreateCategory(
+			context.Background(),
 			&model.Category{Name: "Test category", TeamID: "test-team", UserID: userGuestID, CreateAt: model.GetMillis(), UpdateAt: model.GetMillis()},
 		)
 		require.NoError(t, err)
@@ -3102,30 +3112,37 @@ func TestPermissionsDeleteCategory(t *testing.T) {
 
 	extraSetup := func(t *testing.T, th *TestHelper) map[string]string {
 		categoryNoTeamMember, err := th.Server.App().CreateCategory(
+			context.Background(),
 			&model.Category{Name: "Test category", TeamID: "test-team", UserID: userNoTeamMemberID, CreateAt: model.GetMillis(), UpdateAt: model.GetMillis()},
 		)
 		require.NoError(t, err)
 		categoryTeamMember, err := th.Server.App().CreateCategory(
+			context.Background(),
 			&model.Category{Name: "Test category", TeamID: "test-team", UserID: userTeamMemberID, CreateAt: model.GetMillis(), UpdateAt: model.GetMillis()},
 		)
 		require.NoError(t, err)
 		categoryViewer, err := th.Server.App().CreateCategory(
+			context.Background(),
 			&model.Category{Name: "Test category", TeamID: "test-team", UserID: userViewerID, CreateAt: model.GetMillis(), UpdateAt: model.GetMillis()},
 		)
 		require.NoError(t, err)
 		categoryCommenter, err := th.Server.App().CreateCategory(
+			context.Background(),
 			&model.Category{Name: "Test category", TeamID: "test-team", UserID: userCommenterID, CreateAt: model.GetMillis(), UpdateAt: model.GetMillis()},
 		)
 		require.NoError(t, err)
 		categoryEditor, err := th.Server.App().CreateCategory(
+			context.Background(),
 			&model.Category{Name: "Test category", TeamID: "test-team", UserID: userEditorID, CreateAt: model.GetMillis(), UpdateAt: model.GetMillis()},
 		)
 		require.NoError(t, err)
 		categoryAdmin, err := th.Server.App().CreateCategory(
+			context.Background(),
 			&model.Category{Name: "Test category", TeamID: "test-team", UserID: userAdminID, CreateAt: model.GetMillis(), UpdateAt: model.GetMillis()},
 		)
 		require.NoError(t, err)
 		categoryGuest, err := th.Server.App().CreateCategory(
+			context.Background(),
 			&model.Category{Name: "Test category", TeamID: "test-team", UserID: userGuestID, CreateAt: model.GetMillis(), UpdateAt: model.GetMillis()},
 		)
 		require.NoError(t, err)
@@ -3180,30 +3197,37 @@ func TestPermissionsUpdateCategoryBoard(t *testing.T) {
 
 	extraSetup := func(t *testing.T, th *TestHelper) map[string]string {
 		categoryNoTeamMember, err := th.Server.App().CreateCategory(
+			context.Background(),
 			&model.Category{Name: "Test category", TeamID: "test-team", UserID: userNoTeamMemberID, CreateAt: model.GetMillis(), UpdateAt: model.GetMillis()},
 		)
 		require.NoError(t, err)
 		categoryTeamMember, err := th.Server.App().CreateCategory(
+			context.Background(),
 			&model.Category{Name: "Test category", TeamID: "test-team", UserID: userTeamMemberID, CreateAt: model.GetMillis(), UpdateAt: model.GetMillis()},
 		)
 		require.NoError(t, err)
 		categoryViewer, err := th.Server.App().CreateCategory(
+			context.Background(),
 			&model.Category{Name: "Test category", TeamID: "test-team", UserID: userViewerID, CreateAt: model.GetMillis(), UpdateAt: model.GetMillis()},
 		)
 		require.NoError(t, err)
 		categoryCommenter, err := th.Server.App().CreateCategory(
+			context.Background(),
 			&model.Category{Name: "Test category", TeamID: "test-team", UserID: userCommenterID, CreateAt: model.GetMillis(), UpdateAt: model.GetMillis()},
 		)
 		require.NoError(t, err)
 		categoryEditor, err := th.Server.App().CreateCategory(
+			context.Background(),
 			&model.Category{Name: "Test category", TeamID: "test-team", UserID: userEditorID, CreateAt: model.GetMillis(), UpdateAt: model.GetMillis()},
 		)
 		require.NoError(t, err)
 		categoryAdmin, err := th.Server.App().CreateCategory(
+			context.Background(),
 			&model.Category{Name: "Test category", TeamID: "test-team", UserID: userAdminID, CreateAt: model.GetMillis(), UpdateAt: model.GetMillis()},
 		)
 		require.NoError(t, err)
 		categoryGuest, err := th.Server.App().CreateCategory(
+			context.Background(),
 			&model.Category{Name: "Test category", TeamID: "test-team", UserID: userGuestID, CreateAt: model.GetMillis(), UpdateAt: model.GetMillis()},
 		)
 		require.NoError(t, err)
@@ -3265,7 +3289,7 @@ func TestPermissionsGetFile(t *testing.T) {
 		clients := setupClients(th)
 		testData := setupData(t, th)
 
-		newFileID, err := th.Server.App().SaveFile(bytes.NewBuffer([]byte("test")), "test-team", testData.privateBoard.ID, "test.png", false)
+		newFileID, err := th.Server.App().SaveFile(context.Background(), bytes.NewBuffer([]byte("test")), "test-team", testData.privateBoard.ID, "test.png", false)
 		require.NoError(t, err)
 
 		ttCases := ttCasesF()
@@ -3280,7 +3304,7 @@ func TestPermissionsGetFile(t *testing.T) {
 		clients := setupLocalClients(th)
 		testData := setupData(t, th)
 
-		newFileID, err := th.Server.App().SaveFile(bytes.NewBuffer([]byte("test")), "test-team", testData.privateBoard.ID, "test.png", false)
+		newFileID, err := th.Server.App().SaveFile(context.Background(), bytes.NewBuffer([]byte("test")), "test-team", testData.privateBoard.ID, "test.png", false)
 		require.NoError(t, err)
 
 		ttCases := ttCasesF()
@@ -3388,34 +3412,42 @@ func TestPermissionsDeleteSubscription(t *testing.T) {
 
 	extraSetup := func(t *testing.T, th *TestHelper) {
 		_, err := th.Server.App().CreateSubscription(
+			context.Background(),
 			&model.Subscription{BlockType: "card", BlockID: "block-3", SubscriberType: "user", SubscriberID: userNoTeamMemberID, CreateAt: model.GetMillis()},
 		)
 		require.NoError(t, err)
 		_, err = th.Server.App().CreateSubscription(
+			context.Background(),
 			&model.Subscription{BlockType: "card", BlockID: "block-3", SubscriberType: "user", SubscriberID: userTeamMemberID, CreateAt: model.GetMillis()},
 		)
 		require.NoError(t, err)
 		_, err = th.Server.App().CreateSubscription(
+			context.Background(),
 			&model.Subscription{BlockType: "card", BlockID: "block-3", SubscriberType: "user", SubscriberID: userViewerID, CreateAt: model.GetMillis()},
 		)
 		require.NoError(t, err)
 		_, err = th.Server.App().CreateSubscription(
+			context.Background(),
 			&model.Subscription{BlockType: "card", BlockID: "block-3", SubscriberType: "user", SubscriberID: userCommenterID, CreateAt: model.GetMillis()},
 		)
 		require.NoError(t, err)
 		_, err = th.Server.App().CreateSubscription(
+			context.Background(),
 			&model.Subscription{BlockType: "card", BlockID: "block-3", SubscriberType: "user", SubscriberID: userEditorID, CreateAt: model.GetMillis()},
 		)
 		require.NoError(t, err)
 		_, err = th.Server.App().CreateSubscription(
+			context.Background(),
 			&model.Subscription{BlockType: "card", BlockID: "block-3", SubscriberType: "user", SubscriberID: userAdminID, CreateAt: model.GetMillis()},
 		)
 		require.NoError(t, err)
 		_, err = th.Server.App().CreateSubscription(
+			context.Background(),
 			&model.Subscription{BlockType: "card", BlockID: "block-3", SubscriberType: "user", SubscriberID: userGuestID, CreateAt: model.GetMillis()},
 		)
 		require.NoError(t, err)
 		_, err = th.Server.App().CreateSubscription(
+			context.Background(),
 			&model.Subscription{BlockType: "card", BlockID: "block-3", SubscriberType: "user", SubscriberID: "other", CreateAt: model.GetMillis()},
 		)
 		require.NoError(t, err)
@@ -3457,7 +3489,7 @@ func TestPermissionsOnboard(t *testing.T) {
 		clients := setupClients(th)
 		testData := setupData(t, th)
 
-		err := th.Server.App().InitTemplates()
+		err := th.Server.App().InitTemplates(context.Background())
 		require.NoError(t, err, "InitTemplates should not fail")
 
 		runTestCases(t, ttCases, testData, clients)
@@ -3468,7 +3500,7 @@ func TestPermissionsOnboard(t *testing.T) {
 		clients := setupLocalClients(th)
 		testData := setupData(t, th)
 
-		err := th.Server.App().InitTemplates()
+		err := th.Server.App().InitTemplates(context.Background())
 		require.NoError(t, err, "InitTemplates should not fail")
 
 		ttCases[1].expectedStatusCode = http.StatusOK
@@ -3576,13 +3608,13 @@ func TestPermissionsMinimumRolesApplied(t *testing.T) {
 				UpdateAt: model.GetMillis(),
 			}})
 		}
-		_, err := th.Server.App().PatchBoard(&model.BoardPatch{MinimumRole: &minimumRole}, testData.privateBoard.ID, userAdminID)
+		_, err := th.Server.App().PatchBoard(context.Background(), &model.BoardPatch{MinimumRole: &minimumRole}, testData.privateBoard.ID, userAdminID)
 		require.NoError(t, err)
-		_, err = th.Server.App().PatchBoard(&model.BoardPatch{MinimumRole: &minimumRole}, testData.publicBoard.ID, userAdminID)
+		_, err = th.Server.App().PatchBoard(context.Background(), &model.BoardPatch{MinimumRole: &minimumRole}, testData.publicBoard.ID, userAdminID)
 		require.NoError(t, err)
-		_, err = th.Server.App().PatchBoard(&model.BoardPatch{MinimumRole: &minimumRole}, testData.privateTemplate.ID, userAdminID)
+		_, err = th.Server.App().PatchBoard(context.Background(), &model.BoardPatch{MinimumRole: &minimumRole}, testData.privateTemplate.ID, userAdminID)
 		require.NoError(t, err)
-		_, err = th.Server.App().PatchBoard(&model.BoardPatch{MinimumRole: &minimumRole}, testData.publicTemplate.ID, userAdminID)
+		_, err = th.Server.App().PatchBoard(context.Background(), &model.BoardPatch{MinimumRole: &minimumRole}, testData.publicTemplate.ID, userAdminID)
 		require.NoError(t, err)
 
 		if minimumRole == "viewer" || minimumRole == "commenter" {
@@ -4,6 +4,7 @@
 package integrationtests
 
 import (
+	"context"
 	"errors"
 	"os"
 	"strconv"
@@ -88,7 +89,7 @@ func NewPluginTestStore(innerStore store.Store) *PluginTestStore {
 	}
 }
 
-func (s *PluginTestStore) GetTeam(id string) (*model.Team, error) {
+func (s *PluginTestStore) GetTeam(ctx context.Context, id string) (*model.Team, error) {
 	switch id {
 	case "0":
 		return s.baseTeam, nil
@@ -102,7 +103,7 @@ func (s *PluginTestStore) GetTeam(id string) (*model.Team, error) {
 	return nil, errTestStore
 }
 
-func (s *PluginTestStore) GetTeamsForUser(userID string) ([]*model.Team, error) {
+func (s *PluginTestStore) GetTeamsForUser(ctx context.Context, userID string) ([]*model.Team, error) {
 	switch userID {
 	case "no-team-member":
 		return []*model.Team{}, nil
@@ -122,7 +123,7 @@ func (s *PluginTestStore) GetTeamsForUser(userID string) ([]*model.Team, error)
 	return nil, errTestStore
 }
 
-func (s *PluginTestStore) GetUserByID(userID string) (*model.User, error) {
+func (s *PluginTestStore) GetUserByID(ctx context.Context, userID string) (*model.User, error) {
 	user := s.users[userID]
 	if user == nil {
 		return nil, errTestStore
@@ -130,7 +131,7 @@ func (s *PluginTestStore) GetUserByID(userID string) (*model.User, error) {
 	return user, nil
 }
 
-func (s *PluginTestStore) GetUsersList(userIDs []string, showEmail, showName bool) ([]*model.User, error) {
+func (s *PluginTestStore) GetUsersList(ctx context.Context, userIDs []string, showEmail, showName bool) ([]*model.User, error) {
 	var users []*model.User
 	for _, id := range userIDs {
 		user := s.users[id]
@@ -141,7 +142,7 @@ func (s *PluginTestStore) GetUsersList(userIDs []string, showEmail, showName boo
 	return users, nil
 }
 
-func (s *PluginTestStore) GetUserByEmail(email string) (*model.User, error) {
+func (s *PluginTestStore) GetUserByEmail(ctx context.Context, email string) (*model.User, error) {
 	for _, user := range s.users {
 		if user.Email == email {
 			return user, nil
@@ -150,7 +151,7 @@ func (s *PluginTestStore) GetUserByEmail(email string) (*model.User, error) {
 	return nil, errTestStore
 }
 
-func (s *PluginTestStore) GetUserByUsername(username string) (*model.User, error) {
+func (s *PluginTestStore) GetUserByUsername(ctx context.Context, username string) (*model.User, error) {
 	for _, user := range s.users {
 		if user.Username == username {
 			return user, nil
@@ -159,7 +160,7 @@ func (s *PluginTestStore) GetUserByUsername(username string) (*model.User, error
 	return nil, errTestStore
 }
 
-func (s *PluginTestStore) GetUserPreferences(userID string) (mmModel.Preferences, error) {
+func (s *PluginTestStore) GetUserPreferences(ctx context.Context, userID string) (mmModel.Preferences, error) {
 	if userID == userTeamMember {
 		return mmModel.Preferences{
 			mmModel.Preference{
@@ -174,7 +175,7 @@ func (s *PluginTestStore) GetUserPreferences(userID string) (mmModel.Preferences
 	return nil, errTestStore
 }
 
-func (s *PluginTestStore) GetUsersByTeam(teamID string, asGuestID string, showEmail, showName bool) ([]*model.User, error) {
+func (s *PluginTestStore) GetUsersByTeam(ctx context.Context, teamID string, asGuestID string, showEmail, showName bool) ([]*model.User, error) {
 	if asGuestID == "guest" {
 		return []*model.User{
 			s.users["viewer"],
@@ -209,9 +210,9 @@ func (s *PluginTestStore) GetUsersByTeam(teamID string, asGuestID string, showEm
 	return nil, errTestStore
 }
 
-func (s *PluginTestStore) SearchUsersByTeam(teamID string, searchQuery string, asGuestID string, excludeBots bool, showEmail, showName bool) ([]*model.User, error) {
+func (s *PluginTestStore) SearchUsersByTeam(ctx context.Context, teamID string, searchQuery string, asGuestID string, excludeBots bool, showEmail, showName bool) ([]*model.User, error) {
 	users := []*model.User{}
-	teamUsers, err := s.GetUsersByTeam(teamID, asGuestID, showEmail, showName)
+	teamUsers, err := s.GetUsersByTeam(ctx, teamID, asGuestID, showEmail, showName)
 	if err != nil {
 		return nil, err
 	}
@@ -227,23 +228,23 @@ func (s *PluginTestStore) SearchUsersByTeam(teamID string, searchQuery string, a
 	return users, nil
 }
 
-func (s *PluginTestStore) GetMembersForUser(userID string) ([]*model.BoardMember, error) {
-	return s.Store.GetMembersForUser(userID)
+func (s *PluginTestStore) GetMembersForUser(ctx context.Context, userID string) ([]*model.BoardMember, error) {
+	return s.Store.GetMembersForUser(context.Background(), userID)
 }
 
-func (s *PluginTestStore) CanSeeUser(seerID string, seenID string) (bool, error) {
-	user, err := s.GetUserByID(seerID)
+func (s *PluginTestStore) CanSeeUser(ctx context.Context, seerID string, seenID string) (bool, error) {
+	user, err := s.GetUserByID(ctx, seerID)
 	if err != nil {
 		return false, err
 	}
 	if !user.IsGuest {
 		return true, nil
 	}
-	seerMembers, err := s.GetMembersForUser(seerID)
+	seerMembers, err := s.GetMembersForUser(ctx, seerID)
 	if err != nil {
 		return false, err
 	}
-	seenMembers, err := s.GetMembersForUser(seenID)
+	seenMembers, err := s.GetMembersForUser(ctx, seenID)
 	if err != nil {
 		return false, err
 	}
@@ -257,7 +258,7 @@ func (s *PluginTestStore) CanSeeUser(seerID string, seenID string) (bool, error)
 	return false, nil
 }
 
-func (s *PluginTestStore) SearchUserChannels(teamID, userID, query string) ([]*mmModel.Channel, error) {
+func (s *PluginTestStore) SearchUserChannels(ctx context.Context, teamID, userID, query string) ([]*mmModel.Channel, error) {
 	return []*mmModel.Channel{
 		{
 			TeamId:      teamID,
@@ -274,7 +275,7 @@ func (s *PluginTestStore) SearchUserChannels(teamID, userID, query string) ([]*m
 	}, nil
 }
 
-func (s *PluginTestStore) GetChannel(teamID, channel string) (*mmModel.Channel, error) {
+func (s *PluginTestStore) GetChannel(ctx context.Context, teamID, channel string) (*mmModel.Channel, error) {
 	if channel == "valid-channel-id" {
 		return &mmModel.Channel{
 			TeamId:      teamID,
@@ -293,13 +294,13 @@ func (s *PluginTestStore) GetChannel(teamID, channel string) (*mmModel.Channel,
 	return nil, errTestStore
 }
 
-func (s *PluginTestStore) SearchBoardsForUser(term string, field model.BoardSearchField, userID string, includePublicBoards bool) ([]*model.Board, error) {
-	boards, err := s.Store.SearchBoardsForUser(term, field, userID, includePublicBoards)
+func (s *PluginTestStore) SearchBoardsForUser(ctx context.Context, term string, field model.BoardSearchField, userID string, includePublicBoards bool) ([]*model.Board, error) {
+	boards, err := s.Store.SearchBoardsForUser(context.Background(), term, field, userID, includePublicBoards)
 	if err != nil {
 		return nil, err
 	}
 
-	teams, err := s.GetTeamsForUser(userID)
+	teams, err := s.GetTeamsForUser(ctx, userID)
 	if err != nil {
 		return nil, err
 	}
@@ -316,8 +317,8 @@ func (s *PluginTestStore) SearchBoardsForUser(term string, field model.BoardSear
 	return resultBoards, nil
 }
 
-func (s *PluginTestStore) GetLicense() *mmModel.License {
-	license := s.Store.GetLicense()
+func (s *PluginTestStore) GetLicense(ctx context.Context) *mmModel.License {
+	license := s.Store.GetLicense(context.Background())
 
 	if license == nil {
 		license = &mmModel.License{
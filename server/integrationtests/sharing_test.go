@@ -4,6 +4,7 @@
 package integrationtests
 
 import (
+	"context"
 	"testing"
 
 	"github.com/mattermost/mattermost-plugin-boards/server/model"
@@ -31,12 +32,12 @@ func TestSharing(t *testing.T) {
 			Type:   model.BoardTypeOpen,
 		}
 
-		board, err := th.Server.App().CreateBoard(newBoard, th.GetUser1().ID, true)
+		board, err := th.Server.App().CreateBoard(context.Background(), newBoard, th.GetUser1().ID, true)
 		require.NoError(t, err)
 		require.NotNil(t, board)
 		boardID = board.ID
 
-		s, err := th.Server.App().GetSharing(boardID)
+		s, err := th.Server.App().GetSharing(context.Background(), boardID)
 		require.Error(t, err)
 		require.True(t, model.IsErrNotFound(err))
 		require.Nil(t, s)
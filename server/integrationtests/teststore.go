@@ -4,6 +4,8 @@
 package integrationtests
 
 import (
+	"context"
+
 	"github.com/mattermost/mattermost-plugin-boards/server/services/store"
 
 	mmModel "github.com/mattermost/mattermost/server/public/model"
@@ -108,6 +110,6 @@ func NewTestProfessionalStore(store store.Store) *TestStore {
 	return testStore
 }
 
-func (s *TestStore) GetLicense() *mmModel.License {
+func (s *TestStore) GetLicense(ctx context.Context) *mmModel.License {
 	return s.license
 }
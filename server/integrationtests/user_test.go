@@ -5,6 +5,7 @@ package integrationtests
 
 import (
 	"bytes"
+	"context"
 	"crypto/rand"
 	"testing"
 
@@ -78,7 +79,7 @@ func TestGetUserList(t *testing.T) {
 		Type:   model.BoardTypeOpen,
 		TeamID: testTeamID,
 	}
-	board, err := th.Server.App().CreateBoard(newBoard, userID1, true)
+	board, err := th.Server.App().CreateBoard(context.Background(), newBoard, userID1, true)
 	require.NoError(t, err)
 
 	// add Guest as board member
@@ -90,7 +91,7 @@ func TestGetUserList(t *testing.T) {
 		SchemeEditor:    true,
 		SchemeAdmin:     false,
 	}
-	guestMember, err := th.Server.App().AddMemberToBoard(newGuestMember)
+	guestMember, err := th.Server.App().AddMemberToBoard(context.Background(), newGuestMember)
 	require.NoError(t, err)
 	require.NotNil(t, guestMember)
 
@@ -109,7 +110,7 @@ func TestGetUserList(t *testing.T) {
 		SchemeEditor:    true,
 		SchemeAdmin:     false,
 	}
-	newMember, err := th.Server.App().AddMemberToBoard(newBoardMember)
+	newMember, err := th.Server.App().AddMemberToBoard(context.Background(), newBoardMember)
 	require.NoError(t, err)
 	require.NotNil(t, newMember)
 
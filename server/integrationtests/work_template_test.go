@@ -4,6 +4,7 @@
 package integrationtests
 
 import (
+	"context"
 	"testing"
 
 	"github.com/stretchr/testify/require"
@@ -31,7 +32,7 @@ func TestGetTemplatesForWorkTemplate(t *testing.T) {
 	th := SetupTestHelper(t).InitBasic()
 	defer th.TearDown()
 
-	err := th.Server.App().InitTemplates()
+	err := th.Server.App().InitTemplates(context.Background())
 	require.NoError(t, err, "InitTemplates should not fail")
 
 	rBoards, resp := th.Client.GetTemplatesForTeam("0")
@@ -0,0 +1,154 @@
+// Copyright (c) 2020-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package model
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+)
+
+// ActivityEventType is the kind of change an activity event describes.
+type ActivityEventType string
+
+const (
+	ActivityBoardCreated        ActivityEventType = "board_created"
+	ActivityBoardRenamed        ActivityEventType = "board_renamed"
+	ActivityBoardDeleted        ActivityEventType = "board_deleted"
+	ActivityCardCreated         ActivityEventType = "card_created"
+	ActivityCardRenamed         ActivityEventType = "card_renamed"
+	ActivityCardDeleted         ActivityEventType = "card_deleted"
+	ActivityCardPropertyChanged ActivityEventType = "card_property_changed"
+	ActivityCommentAdded        ActivityEventType = "comment_added"
+	ActivityCommentEdited       ActivityEventType = "comment_edited"
+	ActivityCommentDeleted      ActivityEventType = "comment_deleted"
+	ActivityContentAdded        ActivityEventType = "content_added"
+	ActivityContentChanged      ActivityEventType = "content_changed"
+	ActivityContentDeleted      ActivityEventType = "content_deleted"
+)
+
+var activityEventTypes = map[ActivityEventType]bool{
+	ActivityBoardCreated:        true,
+	ActivityBoardRenamed:        true,
+	ActivityBoardDeleted:        true,
+	ActivityCardCreated:         true,
+	ActivityCardRenamed:         true,
+	ActivityCardDeleted:         true,
+	ActivityCardPropertyChanged: true,
+	ActivityCommentAdded:        true,
+	ActivityCommentEdited:       true,
+	ActivityCommentDeleted:      true,
+	ActivityContentAdded:        true,
+	ActivityContentChanged:      true,
+	ActivityContentDeleted:      true,
+}
+
+// ActivityEvent is a change of a board or card, built from their history.
+// swagger:model
+type ActivityEvent struct {
+	// The id of the event
+	// required: true
+	ID string `json:"id"`
+
+	// The kind of change
+	// required: true
+	Type ActivityEventType `json:"type"`
+
+	// The id of the board changed
+	// required: true
+	BoardID string `json:"boardId"`
+
+	// The id of the card changed, if any
+	// required: false
+	CardID string `json:"cardId,omitempty"`
+
+	// The id of the block changed, if not the board or card
+	// required: false
+	BlockID string `json:"blockId,omitempty"`
+
+	// The type of the block changed
+	// required: false
+	BlockType BlockType `json:"blockType,omitempty"`
+
+	// The id of the user who made the change
+	// required: true
+	UserID string `json:"userId"`
+
+	// The title of the board or card changed
+	// required: false
+	Title string `json:"title,omitempty"`
+
+	// The id of the card property changed, for property changes
+	// required: false
+	PropertyID string `json:"propertyId,omitempty"`
+
+	// The name of the card property changed, for property changes
+	// required: false
+	PropertyName string `json:"propertyName,omitempty"`
+
+	// The value before the change, for renames and property changes
+	// required: false
+	OldValue string `json:"oldValue,omitempty"`
+
+	// The value after the change, for renames and property changes
+	// required: false
+	NewValue string `json:"newValue,omitempty"`
+
+	// The users added to a person property
+	// required: false
+	AddedUserIDs []string `json:"addedUserIds,omitempty"`
+
+	// The users removed from a person property
+	// required: false
+	RemovedUserIDs []string `json:"removedUserIds,omitempty"`
+
+	// A human readable description of the change
+	// required: true
+	Message string `json:"message"`
+
+	// The time of the change in miliseconds since the current epoch
+	// required: true
+	CreateAt int64 `json:"createAt"`
+}
+
+// ActivityFeed is a page of activity events, newest first.
+// swagger:model
+type ActivityFeed struct {
+	// The events of the page
+	// required: true
+	Events []*ActivityEvent `json:"events"`
+
+	// The cursor of the next page, empty if this is the last page
+	// required: false
+	NextCursor string `json:"nextCursor,omitempty"`
+}
+
+// QueryActivityOptions are the options of a query for activity events.
+type QueryActivityOptions struct {
+	UserID     string              // if not empty then filter for changes made by a specific user
+	EventTypes []ActivityEventType // if not empty then filter for events of these types
+	Cursor     string              // if not empty then return the events older than the previous page
+	PerPage    int                 // number of events per page
+}
+
+// IsValid checks the event types of the options.
+func (o QueryActivityOptions) IsValid() error {
+	for _, eventType := range o.EventTypes {
+		if !activityEventTypes[eventType] {
+			return NewErrBadRequest(fmt.Sprintf("invalid activity event type: %s", eventType))
+		}
+	}
+	if o.PerPage < 0 {
+		return NewErrBadRequest("invalid number of events per page")
+	}
+	return nil
+}
+
+func ActivityFeedFromJSON(data io.Reader) (*ActivityFeed, error) {
+	var feed ActivityFeed
+	if err := json.NewDecoder(data).Decode(&feed); err != nil {
+		return nil, err
+	}
+	return &feed, nil
+}
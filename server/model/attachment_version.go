@@ -0,0 +1,156 @@
+// Copyright (c) 2020-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package model
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+
+	"github.com/mattermost/mattermost-plugin-boards/server/utils"
+)
+
+// BlockFieldVersions holds the file versions of an attachment block. The fileId field of the
+// block is the file of the latest version.
+const BlockFieldVersions = "versions"
+
+// AttachmentVersion is a version of the file of an attachment block.
+// swagger:model
+type AttachmentVersion struct {
+	// Number of the version, starting at 1
+	// required: true
+	Version int `json:"version"`
+
+	// Stored name of the file of the version
+	// required: true
+	FileID string `json:"fileId"`
+
+	// Name of the uploaded file
+	// required: false
+	Name string `json:"name,omitempty"`
+
+	// ID of the user who uploaded or restored the version
+	// required: true
+	UploadedBy string `json:"uploadedBy"`
+
+	// Upload time in miliseconds since the current epoch
+	// required: true
+	UploadedAt int64 `json:"uploadedAt"`
+
+	// Number of the version this version restored, if any
+	// required: false
+	RestoredFrom int `json:"restoredFrom,omitempty"`
+}
+
+func AttachmentVersionFromJSON(data io.Reader) (*AttachmentVersion, error) {
+	var version AttachmentVersion
+	if err := json.NewDecoder(data).Decode(&version); err != nil {
+		return nil, err
+	}
+	return &version, nil
+}
+
+func AttachmentVersionsFromJSON(data io.Reader) ([]*AttachmentVersion, error) {
+	var versions []*AttachmentVersion
+	if err := json.NewDecoder(data).Decode(&versions); err != nil {
+		return nil, err
+	}
+	return versions, nil
+}
+
+// GetAttachmentVersions returns the file versions of an attachment block, oldest first. Blocks
+// created before versions were recorded have their current file as only version.
+func GetAttachmentVersions(block *Block) ([]*AttachmentVersion, error) {
+	if value, ok := block.Fields[BlockFieldVersions]; ok && value != nil {
+		data, err := json.Marshal(value)
+		if err != nil {
+			return nil, err
+		}
+		var versions []*AttachmentVersion
+		if err := json.Unmarshal(data, &versions); err != nil {
+			return nil, NewErrBadRequest(fmt.Sprintf("invalid attachment versions in block %s", block.ID))
+		}
+		return versions, nil
+	}
+
+	fileID := getBlockFileID(block)
+	if fileID == "" {
+		return []*AttachmentVersion{}, nil
+	}
+	return []*AttachmentVersion{{
+		Version:    1,
+		FileID:     fileID,
+		Name:       block.Title,
+		UploadedBy: block.CreatedBy,
+		UploadedAt: block.CreateAt,
+	}}, nil
+}
+
+// AttachmentVersionsField returns the versions in the form block fields hold them.
+func AttachmentVersionsField(versions []*AttachmentVersion) []interface{} {
+	field := make([]interface{}, 0, len(versions))
+	for _, version := range versions {
+		value := map[string]interface{}{
+			"version":    version.Version,
+			"fileId":     version.FileID,
+			"uploadedBy": version.UploadedBy,
+			"uploadedAt": version.UploadedAt,
+		}
+		if version.Name != "" {
+			value["name"] = version.Name
+		}
+		if version.RestoredFrom != 0 {
+			value["restoredFrom"] = version.RestoredFrom
+		}
+		field = append(field, value)
+	}
+	return field
+}
+
+// GetAttachmentVersionFileIDs returns the stored names of the files of all the recorded versions
+// of an attachment block.
+func GetAttachmentVersionFileIDs(block *Block) []string {
+	if _, ok := block.Fields[BlockFieldVersions]; !ok {
+		return nil
+	}
+	versions, err := GetAttachmentVersions(block)
+	if err != nil {
+		return nil
+	}
+
+	fileIDs := make([]string, 0, len(versions))
+	for _, version := range versions {
+		fileIDs = append(fileIDs, version.FileID)
+	}
+	return fileIDs
+}
+
+// GetBlockFileIDs returns the IDs of the files an image or attachment block references, including
+// the files of the earlier versions of attachments.
+func GetBlockFileIDs(block *Block) []string {
+	names := GetAttachmentVersionFileIDs(block)
+	for _, field := range []string{BlockFieldFileId, BlockFieldAttachmentId} {
+		if name, ok := block.Fields[field].(string); ok {
+			names = append(names, name)
+		}
+	}
+
+	fileIDs := make([]string, 0, len(names))
+	for _, name := range names {
+		if fileID := utils.RetrieveFileIDFromBlockFieldStorage(name); fileID != "" {
+			fileIDs = append(fileIDs, fileID)
+		}
+	}
+	return fileIDs
+}
+
+func getBlockFileID(block *Block) string {
+	if fileID, ok := block.Fields[BlockFieldAttachmentId].(string); ok && fileID != "" {
+		return fileID
+	}
+	if fileID, ok := block.Fields[BlockFieldFileId].(string); ok {
+		return fileID
+	}
+	return ""
+}
@@ -0,0 +1,84 @@
+// Copyright (c) 2020-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package model
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mattermost/mattermost-plugin-boards/server/utils"
+)
+
+func TestGetAttachmentVersions(t *testing.T) {
+	fileID := "7" + utils.NewID(utils.IDTypeNone) + ".pdf"
+
+	t.Run("recorded versions", func(t *testing.T) {
+		block := &Block{Fields: map[string]interface{}{
+			BlockFieldFileId: fileID,
+			BlockFieldVersions: AttachmentVersionsField([]*AttachmentVersion{
+				{Version: 1, FileID: fileID, UploadedBy: "user-1", UploadedAt: 10},
+				{Version: 2, FileID: fileID, UploadedBy: "user-2", UploadedAt: 20, RestoredFrom: 1},
+			}),
+		}}
+
+		versions, err := GetAttachmentVersions(block)
+		require.NoError(t, err)
+		require.Len(t, versions, 2)
+		assert.Equal(t, 1, versions[1].RestoredFrom)
+		assert.Equal(t, []string{fileID, fileID}, GetAttachmentVersionFileIDs(block))
+	})
+
+	t.Run("no file", func(t *testing.T) {
+		versions, err := GetAttachmentVersions(&Block{Fields: map[string]interface{}{}})
+		require.NoError(t, err)
+		assert.Empty(t, versions)
+		assert.Nil(t, GetAttachmentVersionFileIDs(&Block{Fields: map[string]interface{}{}}))
+	})
+
+	t.Run("invalid versions", func(t *testing.T) {
+		_, err := GetAttachmentVersions(&Block{Fields: map[string]interface{}{BlockFieldVersions: "v1"}})
+		assert.True(t, IsErrBadRequest(err))
+	})
+}
+
+func TestGetBlockFileIDs(t *testing.T) {
+	imageID := utils.NewID(utils.IDTypeNone)
+	attachmentID := utils.NewID(utils.IDTypeNone)
+	versionID := utils.NewID(utils.IDTypeNone)
+
+	t.Run("image", func(t *testing.T) {
+		block := &Block{Fields: map[string]interface{}{BlockFieldFileId: "7" + imageID + ".png"}}
+		assert.Equal(t, []string{imageID}, GetBlockFileIDs(block))
+	})
+
+	t.Run("attachment with versions", func(t *testing.T) {
+		block := &Block{Fields: map[string]interface{}{
+			BlockFieldAttachmentId: "7" + attachmentID + ".pdf",
+			BlockFieldVersions: AttachmentVersionsField([]*AttachmentVersion{
+				{Version: 1, FileID: "7" + versionID + ".pdf", UploadedBy: "user-1", UploadedAt: 10},
+			}),
+		}}
+		assert.ElementsMatch(t, []string{attachmentID, versionID}, GetBlockFileIDs(block))
+	})
+
+	t.Run("no file", func(t *testing.T) {
+		assert.Empty(t, GetBlockFileIDs(&Block{Fields: map[string]interface{}{BlockFieldFileId: ""}}))
+	})
+}
+
+func TestValidateAttachmentVersions(t *testing.T) {
+	patch := &BlockPatch{UpdatedFields: map[string]interface{}{
+		BlockFieldVersions: AttachmentVersionsField([]*AttachmentVersion{
+			{Version: 1, FileID: "7" + utils.NewID(utils.IDTypeNone) + ".pdf"},
+		}),
+	}}
+	require.NoError(t, ValidateBlockPatch(patch))
+
+	patch.UpdatedFields[BlockFieldVersions] = AttachmentVersionsField([]*AttachmentVersion{
+		{Version: 1, FileID: "../../etc/passwd"},
+	})
+	assert.Error(t, ValidateBlockPatch(patch))
+}
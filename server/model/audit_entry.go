@@ -0,0 +1,102 @@
+// Copyright (c) 2020-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package model
+
+import (
+	"encoding/json"
+	"io"
+)
+
+// AuditEntry is an audit record persisted in the database.
+// swagger:model
+type AuditEntry struct {
+	// The id of the entry
+	// required: true
+	ID string `json:"id"`
+
+	// The audit level of the record: auth, mod or read
+	// required: true
+	Level string `json:"level"`
+
+	// The path of the API called
+	// required: true
+	APIPath string `json:"apiPath"`
+
+	// The name of the API event
+	// required: true
+	Event string `json:"event"`
+
+	// The result of the call: success, attempt or fail
+	// required: true
+	Status string `json:"status"`
+
+	// The id of the user who made the call
+	// required: false
+	UserID string `json:"userId,omitempty"`
+
+	// The id of the session of the call
+	// required: false
+	SessionID string `json:"sessionId,omitempty"`
+
+	// The user agent of the client
+	// required: false
+	Client string `json:"client,omitempty"`
+
+	// The address the call came from
+	// required: false
+	IPAddress string `json:"ipAddress,omitempty"`
+
+	// The id of the team affected, if any
+	// required: false
+	TeamID string `json:"teamId,omitempty"`
+
+	// The id of the board affected, if any
+	// required: false
+	BoardID string `json:"boardId,omitempty"`
+
+	// The id of the card affected, if any
+	// required: false
+	CardID string `json:"cardId,omitempty"`
+
+	// The metadata of the record
+	// required: false
+	Meta map[string]interface{} `json:"meta,omitempty"`
+
+	// The creation time in miliseconds since the current epoch
+	// required: true
+	CreateAt int64 `json:"createAt"`
+}
+
+// AuditEntriesResponse is the response body to a request for audit entries.
+// swagger:model
+type AuditEntriesResponse struct {
+	// True if there is a next page for pagination
+	// required: true
+	HasNext bool `json:"hasNext"`
+
+	// The array of audit entries, newest first.
+	// required: true
+	Results []*AuditEntry `json:"results"`
+}
+
+// AuditEntriesMaxPerPage is the largest page of audit entries that can be requested.
+const AuditEntriesMaxPerPage = 200
+
+type QueryAuditEntriesOptions struct {
+	UserID  string // if not empty then filter for entries of a specific user
+	BoardID string // if not empty then filter for entries of a specific board
+	Event   string // if not empty then filter for entries of a specific event
+	Since   int64  // if non-zero then filter for entries created at or after Since
+	Until   int64  // if non-zero then filter for entries created before Until
+	Page    int    // page number to select when paginating
+	PerPage int    // number of entries per page (default=60)
+}
+
+func AuditEntriesResponseFromJSON(data io.Reader) (*AuditEntriesResponse, error) {
+	var response AuditEntriesResponse
+	if err := json.NewDecoder(data).Decode(&response); err != nil {
+		return nil, err
+	}
+	return &response, nil
+}
@@ -193,6 +193,26 @@ func (b *Block) baseValidations() error {
 		}
 	}
 
+	if versions, ok := b.Fields[BlockFieldVersions]; ok {
+		if err = validateAttachmentVersions(versions); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// validateAttachmentVersions checks the file names of the versions of an attachment block.
+func validateAttachmentVersions(value interface{}) error {
+	versions, err := GetAttachmentVersions(&Block{Fields: map[string]interface{}{BlockFieldVersions: value}})
+	if err != nil {
+		return err
+	}
+	for _, version := range versions {
+		if err := ValidateFileId(version.FileID); err != nil {
+			return err
+		}
+	}
 	return nil
 }
 
@@ -252,6 +272,12 @@ func validateUpdatedFields(fields map[string]interface{}) error {
 			}
 		}
 
+		if key == BlockFieldVersions {
+			if err := validateAttachmentVersions(value); err != nil {
+				return err
+			}
+		}
+
 		if nestedMap, ok := value.(map[string]interface{}); ok {
 			if err := validateUpdatedFields(nestedMap); err != nil {
 				return err
@@ -309,8 +335,9 @@ type QueryBlocksOptions struct {
 	BoardID   string    // if not empty then filter for blocks belonging to specified board
 	ParentID  string    // if not empty then filter for blocks belonging to specified parent
 	BlockType BlockType // if not empty and not `TypeUnknown` then filter for records of specified block type
+	AfterID   string    // if not empty then filter for blocks with an ID greater than AfterID
 	Page      int       // page number to select when paginating
-	PerPage   int       // number of blocks per page (default=-1, meaning unlimited)
+	PerPage   int       // number of blocks per page (default=-1, meaning unlimited), in ID order
 }
 
 // QuerySubtreeOptions are query options that can be passed to GetSubTree methods.
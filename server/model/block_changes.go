@@ -0,0 +1,107 @@
+// Copyright (c) 2020-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package model
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"io"
+)
+
+// NextCursorHeader is the response header holding the cursor of the next page of a list of
+// blocks or cards, absent on the last page.
+const NextCursorHeader = "X-Next-Cursor"
+
+// BlockCursor is the position of a block in a list of blocks. The clients only see it encoded,
+// and give it back to read the blocks following it.
+type BlockCursor struct {
+	UpdateAt int64  `json:"u,omitempty"`
+	ID       string `json:"i"`
+}
+
+// Encode returns the opaque string of the cursor.
+func (c BlockCursor) Encode() string {
+	data, _ := json.Marshal(c)
+	return base64.RawURLEncoding.EncodeToString(data)
+}
+
+// DecodeBlockCursor returns the cursor of an opaque string returned by Encode.
+func DecodeBlockCursor(cursor string) (BlockCursor, error) {
+	var c BlockCursor
+	data, err := base64.RawURLEncoding.DecodeString(cursor)
+	if err != nil {
+		return c, NewErrBadRequest("invalid cursor")
+	}
+	if err := json.Unmarshal(data, &c); err != nil || c.ID == "" || c.UpdateAt < 0 {
+		return c, NewErrBadRequest("invalid cursor")
+	}
+	return c, nil
+}
+
+// BlockTombstone is a block deleted from a board.
+// swagger:model
+type BlockTombstone struct {
+	// The id of the deleted block
+	// required: true
+	ID string `json:"id"`
+
+	// The id of the parent of the deleted block
+	// required: true
+	ParentID string `json:"parentId"`
+
+	// The id of the board of the deleted block
+	// required: true
+	BoardID string `json:"boardId"`
+
+	// The type of the deleted block
+	// required: true
+	Type BlockType `json:"type"`
+
+	// The id of the user who deleted the block
+	// required: true
+	ModifiedBy string `json:"modifiedBy"`
+
+	// The deletion time in milliseconds since the current epoch
+	// required: true
+	DeleteAt int64 `json:"deleteAt"`
+}
+
+// BlockChanges are the blocks of a board changed or deleted after a cursor, oldest first.
+// swagger:model
+type BlockChanges struct {
+	// The blocks created or updated, in their current version
+	// required: true
+	Blocks []*Block `json:"blocks"`
+
+	// The blocks deleted
+	// required: true
+	Deleted []*BlockTombstone `json:"deleted"`
+
+	// The cursor to read the next changes from. It is returned even if there are no more
+	// changes yet, to read the later ones.
+	// required: true
+	Cursor string `json:"cursor"`
+
+	// Whether more changes are available after the cursor
+	// required: true
+	HasMore bool `json:"hasMore"`
+}
+
+// QueryBlockChangesOptions are the options of a query for the changes of a board. The changes
+// are sorted by update time, then by block ID.
+type QueryBlockChangesOptions struct {
+	BoardID        string // the board whose blocks changed
+	AfterUpdateAt  int64  // if non-zero then filter for changes after this update time, or at this time after AfterID
+	AfterID        string // the block ID following which the changes of time AfterUpdateAt are returned
+	BeforeUpdateAt int64  // if non-zero then filter for changes at or before this update time
+	Limit          uint64 // if non-zero then limit the number of returned records
+}
+
+func BlockChangesFromJSON(data io.Reader) (*BlockChanges, error) {
+	var changes BlockChanges
+	if err := json.NewDecoder(data).Decode(&changes); err != nil {
+		return nil, err
+	}
+	return &changes, nil
+}
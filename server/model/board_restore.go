@@ -0,0 +1,151 @@
+// Copyright (c) 2020-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package model
+
+import (
+	"encoding/json"
+	"io"
+	"reflect"
+	"sort"
+)
+
+// BlockRestoreAction is what restoring a board to an earlier time does to one of its blocks.
+type BlockRestoreAction string
+
+const (
+	// BlockRestoreRecreate recreates a block deleted since.
+	BlockRestoreRecreate BlockRestoreAction = "recreate"
+	// BlockRestoreRevert reverts the changes made to a block since.
+	BlockRestoreRevert BlockRestoreAction = "revert"
+	// BlockRestoreDelete deletes a block created since.
+	BlockRestoreDelete BlockRestoreAction = "delete"
+)
+
+// BlockRestoreChange is the change restoring a board to an earlier time makes to one of its blocks.
+// swagger:model
+type BlockRestoreChange struct {
+	// What the restore does to the block
+	// required: true
+	Action BlockRestoreAction `json:"action"`
+
+	// The block as it is now, empty for recreated blocks
+	// required: false
+	Current *Block `json:"current,omitempty"`
+
+	// The block as it was at the restore time, empty for deleted blocks
+	// required: false
+	Restored *Block `json:"restored,omitempty"`
+}
+
+// BoardRestoreChange is the change restoring a board to an earlier time makes to its title,
+// description, icon and properties.
+// swagger:model
+type BoardRestoreChange struct {
+	// The board as it is now
+	// required: true
+	Current *Board `json:"current"`
+
+	// The board as it was at the restore time
+	// required: true
+	Restored *Board `json:"restored"`
+}
+
+// BoardRestorePlan lists the changes restoring a board to an earlier time makes.
+// swagger:model
+type BoardRestorePlan struct {
+	// The id of the board
+	// required: true
+	BoardID string `json:"boardId"`
+
+	// The time the board is restored to, in miliseconds since the current epoch
+	// required: true
+	At int64 `json:"at"`
+
+	// The change of the board itself, empty if it did not change since
+	// required: false
+	Board *BoardRestoreChange `json:"board,omitempty"`
+
+	// The changes of the blocks of the board
+	// required: true
+	Blocks []*BlockRestoreChange `json:"blocks"`
+}
+
+// IsEmpty returns true if the board did not change since the restore time.
+func (p *BoardRestorePlan) IsEmpty() bool {
+	return p.Board == nil && len(p.Blocks) == 0
+}
+
+func BoardRestorePlanFromJSON(data io.Reader) (*BoardRestorePlan, error) {
+	var plan BoardRestorePlan
+	if err := json.NewDecoder(data).Decode(&plan); err != nil {
+		return nil, err
+	}
+	return &plan, nil
+}
+
+// NewBoardRestorePlan compares the current board and blocks with the board and blocks at the
+// restore time, and returns the changes restoring them makes. The type, minimum role and channel
+// of the board are not part of the restore, as they control who can access it.
+func NewBoardRestorePlan(at int64, current, restored *Board, currentBlocks, restoredBlocks []*Block) *BoardRestorePlan {
+	plan := &BoardRestorePlan{
+		BoardID: current.ID,
+		At:      at,
+		Blocks:  []*BlockRestoreChange{},
+	}
+
+	if !boardContentEqual(current, restored) {
+		plan.Board = &BoardRestoreChange{Current: current, Restored: restored}
+	}
+
+	currentByID := make(map[string]*Block, len(currentBlocks))
+	for _, block := range currentBlocks {
+		currentByID[block.ID] = block
+	}
+	restoredByID := make(map[string]*Block, len(restoredBlocks))
+	for _, block := range restoredBlocks {
+		restoredByID[block.ID] = block
+		currentBlock, ok := currentByID[block.ID]
+		switch {
+		case !ok:
+			plan.Blocks = append(plan.Blocks, &BlockRestoreChange{Action: BlockRestoreRecreate, Restored: block})
+		case !blockContentEqual(currentBlock, block):
+			plan.Blocks = append(plan.Blocks, &BlockRestoreChange{Action: BlockRestoreRevert, Current: currentBlock, Restored: block})
+		}
+	}
+	for _, block := range currentBlocks {
+		if _, ok := restoredByID[block.ID]; !ok {
+			plan.Blocks = append(plan.Blocks, &BlockRestoreChange{Action: BlockRestoreDelete, Current: block})
+		}
+	}
+
+	sort.SliceStable(plan.Blocks, func(i, j int) bool {
+		return plan.Blocks[i].BlockID() < plan.Blocks[j].BlockID()
+	})
+	return plan
+}
+
+// BlockID returns the id of the block changed.
+func (c *BlockRestoreChange) BlockID() string {
+	if c.Current != nil {
+		return c.Current.ID
+	}
+	return c.Restored.ID
+}
+
+func boardContentEqual(a, b *Board) bool {
+	return a.Title == b.Title &&
+		a.Description == b.Description &&
+		a.Icon == b.Icon &&
+		a.ShowDescription == b.ShowDescription &&
+		reflect.DeepEqual(a.Properties, b.Properties) &&
+		reflect.DeepEqual(a.CardProperties, b.CardProperties)
+}
+
+func blockContentEqual(a, b *Block) bool {
+	return a.ParentID == b.ParentID &&
+		a.Schema == b.Schema &&
+		a.Type == b.Type &&
+		a.Title == b.Title &&
+		reflect.DeepEqual(a.Fields, b.Fields)
+}
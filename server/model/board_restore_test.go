@@ -0,0 +1,77 @@
+// Copyright (c) 2020-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package model
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewBoardRestorePlan(t *testing.T) {
+	board := &Board{
+		ID:             "board-id",
+		Title:          "Board",
+		Properties:     map[string]interface{}{},
+		CardProperties: []map[string]interface{}{{"id": "status", "type": "select"}},
+	}
+	card := &Block{ID: "card", BoardID: board.ID, ParentID: board.ID, Type: TypeCard, Title: "Card", Fields: map[string]interface{}{"icon": "🚀"}}
+
+	t.Run("nothing changed", func(t *testing.T) {
+		current := *card
+		current.UpdateAt = 20
+		current.ModifiedBy = "user-2"
+
+		plan := NewBoardRestorePlan(10, board, board, []*Block{&current}, []*Block{card})
+		assert.True(t, plan.IsEmpty())
+		assert.Equal(t, board.ID, plan.BoardID)
+		assert.Equal(t, int64(10), plan.At)
+	})
+
+	t.Run("board changed", func(t *testing.T) {
+		current := *board
+		current.Title = "Renamed"
+		current.CardProperties = []map[string]interface{}{}
+		current.MinimumRole = BoardRoleViewer
+
+		plan := NewBoardRestorePlan(10, &current, board, nil, nil)
+		require.NotNil(t, plan.Board)
+		assert.Equal(t, "Renamed", plan.Board.Current.Title)
+		assert.Equal(t, "Board", plan.Board.Restored.Title)
+		assert.Empty(t, plan.Blocks)
+	})
+
+	t.Run("access changes are not restored", func(t *testing.T) {
+		current := *board
+		current.Type = BoardTypePrivate
+		current.MinimumRole = BoardRoleViewer
+
+		plan := NewBoardRestorePlan(10, &current, board, nil, nil)
+		assert.True(t, plan.IsEmpty())
+	})
+
+	t.Run("blocks changed", func(t *testing.T) {
+		edited := *card
+		edited.Fields = map[string]interface{}{"icon": "🔥"}
+		deleted := &Block{ID: "deleted", BoardID: board.ID, ParentID: card.ID, Type: TypeText, Title: "text"}
+		created := &Block{ID: "created", BoardID: board.ID, ParentID: board.ID, Type: TypeCard, Title: "New card"}
+
+		plan := NewBoardRestorePlan(10, board, board, []*Block{&edited, created}, []*Block{card, deleted})
+		assert.Nil(t, plan.Board)
+		require.Len(t, plan.Blocks, 3)
+
+		assert.Equal(t, BlockRestoreRevert, plan.Blocks[0].Action)
+		assert.Equal(t, &edited, plan.Blocks[0].Current)
+		assert.Equal(t, card, plan.Blocks[0].Restored)
+
+		assert.Equal(t, BlockRestoreDelete, plan.Blocks[1].Action)
+		assert.Equal(t, created, plan.Blocks[1].Current)
+		assert.Nil(t, plan.Blocks[1].Restored)
+
+		assert.Equal(t, BlockRestoreRecreate, plan.Blocks[2].Action)
+		assert.Nil(t, plan.Blocks[2].Current)
+		assert.Equal(t, "deleted", plan.Blocks[2].BlockID())
+	})
+}
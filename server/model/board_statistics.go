@@ -2,6 +2,90 @@
 // See LICENSE.txt for license information.
 package model
 
+import (
+	"sort"
+	"time"
+)
+
+const (
+	// StatisticsDefaultRange is the date range of the statistics when none is given.
+	StatisticsDefaultRange = 30 * 24 * time.Hour
+	// StatisticsMaxRange is the longest date range of the statistics.
+	StatisticsMaxRange = 366 * 24 * time.Hour
+
+	StatisticsDefaultTopBoards = 10
+	StatisticsMaxTopBoards     = 100
+)
+
+// StatisticsPeriod is the length of the periods the activity of the statistics is broken down into.
+type StatisticsPeriod string
+
+const (
+	StatisticsPeriodDay   StatisticsPeriod = "day"
+	StatisticsPeriodWeek  StatisticsPeriod = "week"
+	StatisticsPeriodMonth StatisticsPeriod = "month"
+)
+
+func (p StatisticsPeriod) IsValid() bool {
+	switch p {
+	case StatisticsPeriodDay, StatisticsPeriodWeek, StatisticsPeriodMonth:
+		return true
+	}
+	return false
+}
+
+// start returns the start of the period containing a time, in UTC. Weeks start on Monday.
+func (p StatisticsPeriod) start(at time.Time) time.Time {
+	year, month, day := at.UTC().Date()
+	switch p {
+	case StatisticsPeriodWeek:
+		day -= (int(at.UTC().Weekday()) + 6) % 7
+	case StatisticsPeriodMonth:
+		day = 1
+	}
+	return time.Date(year, month, day, 0, 0, 0, 0, time.UTC)
+}
+
+// next returns the start of the period following the one starting at a time.
+func (p StatisticsPeriod) next(start time.Time) time.Time {
+	switch p {
+	case StatisticsPeriodWeek:
+		return start.AddDate(0, 0, 7)
+	case StatisticsPeriodMonth:
+		return start.AddDate(0, 1, 0)
+	}
+	return start.AddDate(0, 0, 1)
+}
+
+// StatisticsOptions are the options of the statistics of the Boards server.
+type StatisticsOptions struct {
+	// if non-empty, only the boards of this team are counted
+	TeamID string
+	// the date range of the activity, in milliseconds since the current epoch
+	From int64
+	To   int64
+	// the number of the most active boards to return
+	TopBoards int
+	// the length of the periods the activity is broken down into
+	Period StatisticsPeriod
+}
+
+func (o StatisticsOptions) IsValid() error {
+	if o.From < 0 || o.To <= o.From {
+		return NewErrBadRequest("`from` must be a timestamp before `to`")
+	}
+	if o.To-o.From > StatisticsMaxRange.Milliseconds() {
+		return NewErrBadRequest("date range of statistics is limited to 366 days")
+	}
+	if o.TopBoards < 0 || o.TopBoards > StatisticsMaxTopBoards {
+		return NewErrBadRequest("number of top boards must be between 0 and 100")
+	}
+	if !o.Period.IsValid() {
+		return NewErrBadRequest("period must be one of day, week or month")
+	}
+	return nil
+}
+
 // BoardsStatistics is the representation of the statistics for the Boards server
 // swagger:model
 type BoardsStatistics struct {
@@ -12,4 +96,193 @@ type BoardsStatistics struct {
 	// The maximum number of cards on the server
 	// required: true
 	Cards int64 `json:"card_count"`
+
+	// The total size of the files stored for boards, in bytes
+	// required: false
+	FileBytes int64 `json:"file_bytes"`
+
+	// The number of files stored for boards
+	// required: false
+	FileCount int64 `json:"file_count"`
+
+	// The storage used by the boards of each team
+	// required: false
+	TeamFileUsage []*FileUsage `json:"team_file_usage"`
+
+	// The team the statistics are limited to, empty for the whole server
+	// required: false
+	TeamID string `json:"team_id,omitempty"`
+
+	// The start of the date range of the activity, in milliseconds since the current epoch
+	// required: false
+	From int64 `json:"from"`
+
+	// The end of the date range of the activity, in milliseconds since the current epoch
+	// required: false
+	To int64 `json:"to"`
+
+	// The number of users who changed boards before the end of the date range
+	// required: false
+	ActiveUsers ActiveUserCounts `json:"active_users"`
+
+	// The activity in the date range
+	// required: false
+	Activity ActivityCounts `json:"activity"`
+
+	// The length of the periods of the activity: day, week or month
+	// required: false
+	Period StatisticsPeriod `json:"period"`
+
+	// The activity in each period of the date range, in UTC. The first and the last periods are
+	// cut to the date range
+	// required: false
+	ActivityByPeriod []*PeriodActivity `json:"activity_by_period"`
+
+	// The number of blocks of each type, created in the date range
+	// required: false
+	BlockCounts map[string]int64 `json:"block_counts"`
+
+	// The statistics of each team
+	// required: false
+	Teams []*TeamStatistics `json:"teams"`
+
+	// The boards with the most changes in the date range
+	// required: false
+	TopBoards []*BoardActivity `json:"top_boards"`
+}
+
+// ActiveUserCounts are the numbers of users who changed boards in the last 1, 7 and 30 days.
+// swagger:model
+type ActiveUserCounts struct {
+	// required: true
+	Day int `json:"day"`
+	// required: true
+	Week int `json:"week"`
+	// required: true
+	Month int `json:"month"`
+}
+
+// ActivityCounts count the cards and comments of boards over a date range.
+// swagger:model
+type ActivityCounts struct {
+	// The number of cards created
+	// required: true
+	CardsCreated int64 `json:"cards_created"`
+
+	// The number of cards moved to the done option of the status property of their board. Boards
+	// without a done option have no completed cards
+	// required: true
+	CardsCompleted int64 `json:"cards_completed"`
+
+	// The number of comments added
+	// required: true
+	Comments int64 `json:"comments"`
+}
+
+func (c *ActivityCounts) Add(other ActivityCounts) {
+	c.CardsCreated += other.CardsCreated
+	c.CardsCompleted += other.CardsCompleted
+	c.Comments += other.Comments
+}
+
+// PeriodActivity is the activity of a period of the date range of the statistics.
+// swagger:model
+type PeriodActivity struct {
+	// The start of the period, in milliseconds since the current epoch
+	// required: true
+	Start int64 `json:"start"`
+
+	// required: true
+	Activity ActivityCounts `json:"activity"`
+}
+
+// StatisticsPeriods returns the empty periods of the date range of the options, in UTC. The first
+// and the last periods are cut to the date range.
+func StatisticsPeriods(opts StatisticsOptions) []*PeriodActivity {
+	periods := []*PeriodActivity{{Start: opts.From}}
+	start := opts.Period.start(time.UnixMilli(opts.From))
+	for {
+		start = opts.Period.next(start)
+		if start.UnixMilli() > opts.To {
+			return periods
+		}
+		periods = append(periods, &PeriodActivity{Start: start.UnixMilli()})
+	}
+}
+
+// FindPeriod returns the period of a time of the date range. Earlier times, such as the start of
+// the day the date range starts in, are in the first period.
+func FindPeriod(periods []*PeriodActivity, at int64) *PeriodActivity {
+	i := sort.Search(len(periods), func(i int) bool {
+		return periods[i].Start > at
+	})
+	if i == 0 {
+		return periods[0]
+	}
+	return periods[i-1]
+}
+
+// TeamStatistics are the statistics of the boards of a team.
+// swagger:model
+type TeamStatistics struct {
+	// required: true
+	TeamID string `json:"team_id"`
+
+	// The number of boards of the team
+	// required: true
+	Boards int64 `json:"board_count"`
+
+	// The number of cards of the boards of the team
+	// required: true
+	Cards int64 `json:"card_count"`
+
+	// required: true
+	ActiveUsers ActiveUserCounts `json:"active_users"`
+
+	// required: true
+	Activity ActivityCounts `json:"activity"`
+
+	// required: true
+	FileBytes int64 `json:"file_bytes"`
+
+	// required: true
+	FileCount int64 `json:"file_count"`
+}
+
+// BoardActivity is the activity of a board over a date range.
+// swagger:model
+type BoardActivity struct {
+	// required: true
+	BoardID string `json:"board_id"`
+
+	// required: true
+	TeamID string `json:"team_id"`
+
+	// required: true
+	Title string `json:"title"`
+
+	// The number of changes of the blocks of the board
+	// required: true
+	Changes int64 `json:"changes"`
+
+	// The number of changes of the cards of the board
+	// required: true
+	CardChanges int64 `json:"card_changes"`
+
+	// The number of users who changed the board
+	// required: true
+	Editors int `json:"editors"`
+
+	// The number of users who changed the board before the end of the date range
+	// required: true
+	ActiveUsers ActiveUserCounts `json:"active_users"`
+
+	// required: true
+	Activity ActivityCounts `json:"activity"`
+
+	// required: true
+	FileBytes int64 `json:"file_bytes"`
+
+	// required: true
+	FileCount int64 `json:"file_count"`
 }
@@ -0,0 +1,67 @@
+// Copyright (c) 2020-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package model
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStatisticsPeriods(t *testing.T) {
+	millis := func(value string) int64 {
+		at, err := time.Parse(time.RFC3339, value)
+		require.NoError(t, err)
+		return at.UnixMilli()
+	}
+	starts := func(periods []*PeriodActivity) []int64 {
+		result := make([]int64, 0, len(periods))
+		for _, period := range periods {
+			result = append(result, period.Start)
+		}
+		return result
+	}
+
+	from := millis("2026-01-30T15:00:00Z")
+	to := millis("2026-03-02T09:00:00Z")
+
+	t.Run("days", func(t *testing.T) {
+		periods := StatisticsPeriods(StatisticsOptions{From: from, To: to, Period: StatisticsPeriodDay})
+		require.Len(t, periods, 32)
+		assert.Equal(t, from, periods[0].Start)
+		assert.Equal(t, millis("2026-01-31T00:00:00Z"), periods[1].Start)
+		assert.Equal(t, millis("2026-03-02T00:00:00Z"), periods[31].Start)
+	})
+
+	t.Run("weeks start on Monday", func(t *testing.T) {
+		periods := StatisticsPeriods(StatisticsOptions{From: from, To: to, Period: StatisticsPeriodWeek})
+		assert.Equal(t, []int64{
+			from,
+			millis("2026-02-02T00:00:00Z"),
+			millis("2026-02-09T00:00:00Z"),
+			millis("2026-02-16T00:00:00Z"),
+			millis("2026-02-23T00:00:00Z"),
+			millis("2026-03-02T00:00:00Z"),
+		}, starts(periods))
+	})
+
+	t.Run("months", func(t *testing.T) {
+		periods := StatisticsPeriods(StatisticsOptions{From: from, To: to, Period: StatisticsPeriodMonth})
+		assert.Equal(t, []int64{
+			from,
+			millis("2026-02-01T00:00:00Z"),
+			millis("2026-03-01T00:00:00Z"),
+		}, starts(periods))
+	})
+
+	t.Run("find period", func(t *testing.T) {
+		periods := StatisticsPeriods(StatisticsOptions{From: from, To: to, Period: StatisticsPeriodMonth})
+		assert.Equal(t, periods[0], FindPeriod(periods, millis("2026-01-30T00:00:00Z")))
+		assert.Equal(t, periods[0], FindPeriod(periods, from))
+		assert.Equal(t, periods[1], FindPeriod(periods, millis("2026-02-01T00:00:00Z")))
+		assert.Equal(t, periods[2], FindPeriod(periods, to))
+	})
+}
@@ -0,0 +1,131 @@
+// Copyright (c) 2020-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package model
+
+import (
+	"encoding/json"
+	"io"
+)
+
+// ContentChangeType is how a content block of a card changed between two versions of the card.
+type ContentChangeType string
+
+const (
+	ContentAdded   ContentChangeType = "added"
+	ContentRemoved ContentChangeType = "removed"
+	ContentChanged ContentChangeType = "changed"
+)
+
+// CardDiff is the difference between the versions of a card at two times.
+// swagger:model
+type CardDiff struct {
+	// The id of the card
+	// required: true
+	CardID string `json:"cardId"`
+
+	// The id of the board of the card
+	// required: true
+	BoardID string `json:"boardId"`
+
+	// The time of the older version, in miliseconds since the current epoch
+	// required: true
+	From int64 `json:"from"`
+
+	// The time of the newer version, in miliseconds since the current epoch
+	// required: true
+	To int64 `json:"to"`
+
+	// The ids of the users who changed the card or its content between the two times
+	// required: true
+	Authors []string `json:"authors"`
+
+	// The change of the title of the card, empty if the title did not change
+	// required: false
+	Title *TextDiff `json:"title,omitempty"`
+
+	// The changes of the properties of the card, by property index
+	// required: true
+	Properties []*PropertyDiff `json:"properties"`
+
+	// The content blocks of the card added, removed or with a changed text
+	// required: true
+	Content []*ContentDiff `json:"content"`
+}
+
+// IsEmpty returns true if the card did not change between the two times.
+func (d *CardDiff) IsEmpty() bool {
+	return d.Title == nil && len(d.Properties) == 0 && len(d.Content) == 0
+}
+
+// TextDiff is the change of a text.
+// swagger:model
+type TextDiff struct {
+	// The older text
+	// required: true
+	OldValue string `json:"oldValue"`
+
+	// The newer text
+	// required: true
+	NewValue string `json:"newValue"`
+
+	// The changes, as markdown with insertions in code spans and deletions struck through
+	// required: true
+	Markdown string `json:"markdown"`
+}
+
+// PropertyDiff is the change of the value of a card property.
+// swagger:model
+type PropertyDiff struct {
+	// The id of the property
+	// required: true
+	ID string `json:"id"`
+
+	// The name of the property
+	// required: true
+	Name string `json:"name"`
+
+	// The older value, as displayed
+	// required: true
+	OldValue string `json:"oldValue"`
+
+	// The newer value, as displayed
+	// required: true
+	NewValue string `json:"newValue"`
+
+	// The users added to a person or multi person property
+	// required: false
+	AddedUserIDs []string `json:"addedUserIds,omitempty"`
+
+	// The users removed from a person or multi person property
+	// required: false
+	RemovedUserIDs []string `json:"removedUserIds,omitempty"`
+}
+
+// ContentDiff is the change of a content block of a card.
+// swagger:model
+type ContentDiff struct {
+	// The id of the content block
+	// required: true
+	BlockID string `json:"blockId"`
+
+	// The type of the content block
+	// required: true
+	Type BlockType `json:"type"`
+
+	// How the content block changed
+	// required: true
+	Change ContentChangeType `json:"change"`
+
+	// The change of the text of the content block
+	// required: true
+	Text *TextDiff `json:"text"`
+}
+
+func CardDiffFromJSON(data io.Reader) (*CardDiff, error) {
+	var diff CardDiff
+	if err := json.NewDecoder(data).Decode(&diff); err != nil {
+		return nil, err
+	}
+	return &diff, nil
+}
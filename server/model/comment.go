@@ -0,0 +1,115 @@
+// Copyright (c) 2020-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package model
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+	"unicode"
+)
+
+const (
+	// CommentFieldParentID is the comment block field holding the ID of the comment being replied to.
+	CommentFieldParentID = "parentCommentId"
+
+	// CommentFieldEditedAt is the comment block field holding the time the comment text was last edited.
+	CommentFieldEditedAt = "editedAt"
+
+	// EmojiNameMaxLength is the maximum length of an emoji name used for a reaction.
+	EmojiNameMaxLength = 64
+)
+
+// CommentParentID returns the ID of the comment the specified comment block replies to, or an
+// empty string if the block is not a reply.
+func CommentParentID(block *Block) string {
+	if block == nil || block.Type != TypeComment || block.Fields == nil {
+		return ""
+	}
+	parentID, _ := block.Fields[CommentFieldParentID].(string)
+	return parentID
+}
+
+// CommentEdit is the payload used to edit the text of a comment.
+// swagger:model
+type CommentEdit struct {
+	// The new comment text
+	// required: true
+	Title string `json:"title"`
+}
+
+// CommentReaction is an emoji reaction a user added to a comment.
+// swagger:model
+type CommentReaction struct {
+	// BlockID is the id of the comment block reacted to
+	// required: true
+	BlockID string `json:"blockId"`
+
+	// BoardID is the id of the board the comment belongs to
+	// required: true
+	BoardID string `json:"boardId"`
+
+	// UserID is the id of the user who reacted
+	// required: true
+	UserID string `json:"userId"`
+
+	// EmojiName is the name of the emoji used for the reaction
+	// required: true
+	EmojiName string `json:"emojiName"`
+
+	// CreatedAt is the timestamp this reaction was created in miliseconds since the current epoch
+	// required: true
+	CreateAt int64 `json:"createAt"`
+
+	// DeleteAt is the timestamp this reaction was removed in miliseconds since the current epoch, or zero if not removed
+	// required: true
+	DeleteAt int64 `json:"deleteAt"`
+}
+
+func (r *CommentReaction) IsValid() error {
+	if r == nil {
+		return ErrInvalidCommentReaction{"cannot be nil"}
+	}
+	if r.BlockID == "" {
+		return ErrInvalidCommentReaction{"missing block id"}
+	}
+	if r.BoardID == "" {
+		return ErrInvalidCommentReaction{"missing board id"}
+	}
+	if r.UserID == "" {
+		return ErrInvalidCommentReaction{"missing user id"}
+	}
+	return IsValidEmojiName(r.EmojiName)
+}
+
+// IsValidEmojiName checks that an emoji name is non-empty, not too long and contains no whitespace.
+func IsValidEmojiName(name string) error {
+	if name == "" {
+		return ErrInvalidCommentReaction{"missing emoji name"}
+	}
+	if len(name) > EmojiNameMaxLength {
+		return ErrInvalidCommentReaction{fmt.Sprintf("emoji name longer than %d characters", EmojiNameMaxLength)}
+	}
+	if strings.IndexFunc(name, unicode.IsSpace) != -1 {
+		return ErrInvalidCommentReaction{"emoji name cannot contain whitespace"}
+	}
+	return nil
+}
+
+func CommentReactionFromJSON(data io.Reader) (*CommentReaction, error) {
+	var reaction CommentReaction
+	if err := json.NewDecoder(data).Decode(&reaction); err != nil {
+		return nil, err
+	}
+	return &reaction, nil
+}
+
+type ErrInvalidCommentReaction struct {
+	msg string
+}
+
+func (e ErrInvalidCommentReaction) Error() string {
+	return e.msg
+}
@@ -0,0 +1,54 @@
+// Copyright (c) 2020-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package model
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestCommentParentID(t *testing.T) {
+	reply := &Block{
+		Type:   TypeComment,
+		Fields: map[string]interface{}{CommentFieldParentID: "comment-1"},
+	}
+	require.Equal(t, "comment-1", CommentParentID(reply))
+
+	require.Empty(t, CommentParentID(&Block{Type: TypeComment}))
+	require.Empty(t, CommentParentID(&Block{Type: TypeText, Fields: reply.Fields}))
+	require.Empty(t, CommentParentID(&Block{Type: TypeComment, Fields: map[string]interface{}{CommentFieldParentID: 42}}))
+	require.Empty(t, CommentParentID(nil))
+}
+
+func TestCommentReactionIsValid(t *testing.T) {
+	valid := CommentReaction{
+		BlockID:   "block-id",
+		BoardID:   "board-id",
+		UserID:    "user-id",
+		EmojiName: "thumbsup",
+	}
+	require.NoError(t, valid.IsValid())
+
+	testCases := []struct {
+		name   string
+		modify func(r *CommentReaction)
+	}{
+		{"missing block id", func(r *CommentReaction) { r.BlockID = "" }},
+		{"missing board id", func(r *CommentReaction) { r.BoardID = "" }},
+		{"missing user id", func(r *CommentReaction) { r.UserID = "" }},
+		{"missing emoji", func(r *CommentReaction) { r.EmojiName = "" }},
+		{"emoji with space", func(r *CommentReaction) { r.EmojiName = "thumbs up" }},
+		{"emoji too long", func(r *CommentReaction) { r.EmojiName = strings.Repeat("a", EmojiNameMaxLength+1) }},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			reaction := valid
+			tc.modify(&reaction)
+			require.Error(t, reaction.IsValid())
+		})
+	}
+}
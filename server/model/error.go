@@ -28,6 +28,8 @@ var (
 
 	ErrRequestEntityTooLarge = errors.New("request entity too large")
 
+	ErrTooManyRequests = errors.New("too many requests")
+
 	ErrInvalidBoardSearchField = errors.New("invalid board search field")
 )
 
@@ -165,6 +167,7 @@ func (ni *ErrNotImplemented) Error() string {
 
 // IsErrBadRequest returns true if `err` is or wraps one of:
 // - model.ErrBadRequest
+// - model.ErrFileInfected
 // - model.ErrViewsLimitReached
 // - model.ErrAuthParam
 // - model.ErrInvalidCategory
@@ -183,6 +186,12 @@ func IsErrBadRequest(err error) bool {
 		return true
 	}
 
+	// check if this is a model.ErrFileInfected
+	var fi *ErrFileInfected
+	if errors.As(err, &fi) {
+		return true
+	}
+
 	// check if this is a model.ErrViewsLimitReached
 	if errors.Is(err, ErrViewsLimitReached) {
 		return true
@@ -309,10 +318,23 @@ func IsErrNotFound(err error) bool {
 }
 
 // IsErrRequestEntityTooLarge returns true if `err` is or wraps one of:
-// - model.ErrRequestEntityTooLarge.
+// - model.ErrRequestEntityTooLarge
+// - model.ErrStorageQuotaExceeded.
 func IsErrRequestEntityTooLarge(err error) bool {
 	// check if this is a model.ErrRequestEntityTooLarge
-	return errors.Is(err, ErrRequestEntityTooLarge)
+	if errors.Is(err, ErrRequestEntityTooLarge) {
+		return true
+	}
+
+	// check if this is a model.ErrStorageQuotaExceeded
+	var qe *ErrStorageQuotaExceeded
+	return errors.As(err, &qe)
+}
+
+// IsErrTooManyRequests returns true if `err` is or wraps one of:
+// - model.ErrTooManyRequests.
+func IsErrTooManyRequests(err error) bool {
+	return errors.Is(err, ErrTooManyRequests)
 }
 
 // IsErrNotImplemented returns true if `err` is or wraps one of:
@@ -3,9 +3,12 @@
 package model
 
 import (
+	"encoding/json"
+	"io"
 	"mime"
 	"path/filepath"
 	"strings"
+	"time"
 
 	"github.com/mattermost/mattermost-plugin-boards/server/utils"
 	mm_model "github.com/mattermost/mattermost/server/public/model"
@@ -23,3 +26,115 @@ func NewFileInfo(name string) *mm_model.FileInfo {
 		MimeType:  mime.TypeByExtension(extension),
 	}
 }
+
+// FileSize selects the original of an uploaded file or one of its derivatives.
+type FileSize string
+
+const (
+	FileSizeOriginal FileSize = ""
+	FileSizeThumb    FileSize = "thumb"
+	FileSizePreview  FileSize = "preview"
+)
+
+const (
+	// Thumbnails fit within ThumbnailWidth x ThumbnailHeight.
+	ThumbnailWidth  = 120
+	ThumbnailHeight = 100
+
+	// Previews are at most PreviewWidth wide.
+	PreviewWidth = 1920
+
+	// MaxImageResolution is the largest image, in pixels, derivatives are generated for.
+	MaxImageResolution = 7680 * 4320
+
+	fileDerivativeExtension = ".jpg"
+)
+
+// FileDerivativeSizes are the derivatives generated for image uploads.
+var FileDerivativeSizes = []FileSize{FileSizeThumb, FileSizePreview}
+
+func (s FileSize) IsValid() bool {
+	switch s {
+	case FileSizeOriginal, FileSizeThumb, FileSizePreview:
+		return true
+	}
+	return false
+}
+
+// FileDerivativePath returns the path of a derivative of a file, stored next to the original.
+func FileDerivativePath(path string, size FileSize) string {
+	if size == FileSizeOriginal {
+		return path
+	}
+	return strings.TrimSuffix(path, filepath.Ext(path)) + "_" + string(size) + fileDerivativeExtension
+}
+
+// IsFileDerivativeName returns true if the file name is the name of a derivative of a file.
+func IsFileDerivativeName(name string) bool {
+	for _, size := range FileDerivativeSizes {
+		if strings.HasSuffix(name, "_"+string(size)+fileDerivativeExtension) {
+			return true
+		}
+	}
+	return false
+}
+
+// GetFileDerivativePath returns the path of a derivative recorded on a file info, or an empty
+// string if the derivative was not generated.
+func GetFileDerivativePath(fileInfo *mm_model.FileInfo, size FileSize) string {
+	if fileInfo == nil {
+		return ""
+	}
+	switch size {
+	case FileSizeThumb:
+		return fileInfo.ThumbnailPath
+	case FileSizePreview:
+		return fileInfo.PreviewPath
+	}
+	return fileInfo.Path
+}
+
+// SetFileDerivativePath records the path of a derivative on a file info.
+func SetFileDerivativePath(fileInfo *mm_model.FileInfo, size FileSize, path string) {
+	switch size {
+	case FileSizeThumb:
+		fileInfo.ThumbnailPath = path
+	case FileSizePreview:
+		fileInfo.PreviewPath = path
+		fileInfo.HasPreviewImage = path != ""
+	}
+}
+
+const (
+	// SignedFileURLDefaultExpiry is the lifetime of a signed file URL when none is requested.
+	SignedFileURLDefaultExpiry = 15 * time.Minute
+
+	// SignedFileURLMaxExpiry is the longest lifetime a signed file URL can be minted for.
+	SignedFileURLMaxExpiry = 7 * 24 * time.Hour
+
+	// SignedFileURLExpiresParam and SignedFileURLSignatureParam are the query parameters
+	// carrying the expiry and the signature of a signed file URL.
+	SignedFileURLExpiresParam   = "expires"
+	SignedFileURLSignatureParam = "signature"
+)
+
+// SignedFileURL is a URL granting read access to a single file until it expires, without a
+// session or a read token.
+// swagger:model
+type SignedFileURL struct {
+	// The signed URL of the file. Append size=thumb or size=preview to get a derivative
+	// required: true
+	URL string `json:"url"`
+
+	// Expiry time in miliseconds since the current epoch
+	// required: true
+	ExpiresAt int64 `json:"expiresAt"`
+}
+
+func SignedFileURLFromJSON(data io.Reader) (*SignedFileURL, error) {
+	var signedURL SignedFileURL
+	if err := json.NewDecoder(data).Decode(&signedURL); err != nil {
+		return nil, err
+	}
+	return &signedURL, nil
+}
@@ -0,0 +1,57 @@
+// Copyright (c) 2020-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package model
+
+import (
+	"encoding/json"
+	"io"
+)
+
+// OrphanedFile is a stored file no block references.
+// swagger:model
+type OrphanedFile struct {
+	// Path of the file in the files storage
+	// required: true
+	Path string `json:"path"`
+
+	// Size of the file in bytes
+	// required: true
+	Size int64 `json:"size"`
+
+	// Last modification time in miliseconds since the current epoch
+	// required: true
+	ModifiedAt int64 `json:"modifiedAt"`
+}
+
+// FileGCReport is the outcome of a garbage collection of orphaned files.
+// swagger:model
+type FileGCReport struct {
+	// True if the orphaned files were only reported, not removed
+	// required: true
+	DryRun bool `json:"dryRun"`
+
+	// Number of files examined
+	// required: true
+	ScannedFiles int `json:"scannedFiles"`
+
+	// The orphaned files found
+	// required: true
+	OrphanedFiles []OrphanedFile `json:"orphanedFiles"`
+
+	// Number of files removed
+	// required: true
+	RemovedFiles int `json:"removedFiles"`
+
+	// Bytes reclaimed by removing files
+	// required: true
+	ReclaimedBytes int64 `json:"reclaimedBytes"`
+}
+
+func FileGCReportFromJSON(data io.Reader) (*FileGCReport, error) {
+	var report FileGCReport
+	if err := json.NewDecoder(data).Decode(&report); err != nil {
+		return nil, err
+	}
+	return &report, nil
+}
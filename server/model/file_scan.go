@@ -0,0 +1,65 @@
+// Copyright (c) 2020-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package model
+
+// FileScanStatus is the malware scan state of an uploaded file.
+type FileScanStatus string
+
+const (
+	// FileScanPending files are being scanned, or could not be scanned.
+	FileScanPending FileScanStatus = "pending"
+	// FileScanClean files were scanned and no malware was found.
+	FileScanClean FileScanStatus = "clean"
+	// FileScanInfected files were found to contain malware.
+	FileScanInfected FileScanStatus = "infected"
+)
+
+// FileScan is the result of the malware scan of an uploaded file. Files uploaded while scanning
+// was disabled have no scan and are served as clean.
+//
+// Scans are kept in their own table rather than on the file info: file infos are the FileInfo of
+// the Mattermost server, whose table boards cannot add columns to, and uploads rejected by the scan
+// have no file info at all, yet their quarantine must be recorded. The API returns the status of a
+// file along with its file info.
+// swagger:model
+type FileScan struct {
+	// ID of the file info of the scanned file
+	// required: true
+	FileID string `json:"fileId"`
+
+	// Scan status: pending, clean or infected
+	// required: true
+	Status FileScanStatus `json:"status"`
+
+	// Name of the malware found, if any
+	// required: false
+	Signature string `json:"signature,omitempty"`
+
+	// Name of the scanner that scanned the file
+	// required: true
+	Scanner string `json:"scanner"`
+
+	// Scan time in miliseconds since the current epoch
+	// required: true
+	ScannedAt int64 `json:"scannedAt"`
+}
+
+// IsQuarantined returns true if the file must not be served, either because malware was found
+// in it or because it has not been successfully scanned yet.
+func (s *FileScan) IsQuarantined() bool {
+	return s != nil && s.Status != FileScanClean
+}
+
+// ErrFileInfected is returned when malware is found in an uploaded file.
+type ErrFileInfected struct {
+	Signature string
+}
+
+func NewErrFileInfected(signature string) *ErrFileInfected {
+	return &ErrFileInfected{Signature: signature}
+}
+
+func (e *ErrFileInfected) Error() string {
+	return "file rejected by malware scan: " + e.Signature
+}
@@ -0,0 +1,31 @@
+// Copyright (c) 2020-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package model
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFileDerivativePath(t *testing.T) {
+	assert.Equal(t, "boards/20240101/7abc.png", FileDerivativePath("boards/20240101/7abc.png", FileSizeOriginal))
+	assert.Equal(t, "boards/20240101/7abc_thumb.jpg", FileDerivativePath("boards/20240101/7abc.png", FileSizeThumb))
+	assert.Equal(t, "boards/20240101/7abc_preview.jpg", FileDerivativePath("boards/20240101/7abc.png", FileSizePreview))
+	assert.Equal(t, "7abc_thumb.jpg", FileDerivativePath("7abc", FileSizeThumb))
+}
+
+func TestIsFileDerivativeName(t *testing.T) {
+	assert.True(t, IsFileDerivativeName("7abc_thumb.jpg"))
+	assert.True(t, IsFileDerivativeName("7abc_preview.jpg"))
+	assert.False(t, IsFileDerivativeName("7abc.jpg"))
+	assert.False(t, IsFileDerivativeName("7abc_thumb.png"))
+}
+
+func TestFileSizeIsValid(t *testing.T) {
+	assert.True(t, FileSizeOriginal.IsValid())
+	assert.True(t, FileSizeThumb.IsValid())
+	assert.True(t, FileSizePreview.IsValid())
+	assert.False(t, FileSize("huge").IsValid())
+}
@@ -0,0 +1,205 @@
+// Copyright (c) 2020-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package model
+
+import (
+	"encoding/json"
+	"io"
+	"time"
+)
+
+// FlowMetricsMaxRange is the longest date range flow metrics are computed over.
+const FlowMetricsMaxRange = 366 * 24 * time.Hour
+
+// FlowMetricsOptions are the options of the flow metrics of a board.
+type FlowMetricsOptions struct {
+	// the id of the select property holding the status of the cards
+	PropertyID string
+	// the date range, in milliseconds since the current epoch
+	From int64
+	To   int64
+	// the options of the property a card is started in. Defaults to all but the first and the
+	// done ones
+	StartedStatuses []string
+	// the options of the property a card is done in. Defaults to the last one
+	DoneStatuses []string
+}
+
+func (o FlowMetricsOptions) IsValid() error {
+	if o.PropertyID == "" {
+		return NewErrBadRequest("missing status property")
+	}
+	if o.From < 0 || o.To <= o.From {
+		return NewErrBadRequest("`from` must be a timestamp before `to`")
+	}
+	if o.To-o.From > FlowMetricsMaxRange.Milliseconds() {
+		return NewErrBadRequest("date range of flow metrics is limited to 366 days")
+	}
+	return nil
+}
+
+// FlowStatus is an option of the status property of flow metrics.
+// swagger:model
+type FlowStatus struct {
+	// The id of the option
+	// required: true
+	ID string `json:"id"`
+
+	// The value of the option
+	// required: true
+	Value string `json:"value"`
+}
+
+// CardFlow is the flow of a card through the statuses.
+// swagger:model
+type CardFlow struct {
+	// The id of the card
+	// required: true
+	CardID string `json:"cardId"`
+
+	// The title of the card
+	// required: true
+	Title string `json:"title"`
+
+	// The status of the card at the end of the date range, empty if none
+	// required: true
+	Status string `json:"status"`
+
+	// The creation time of the card
+	// required: true
+	CreatedAt int64 `json:"createdAt"`
+
+	// The time the card first entered a started status
+	// required: false
+	StartedAt int64 `json:"startedAt,omitempty"`
+
+	// The time the card entered the done status it is in
+	// required: false
+	DoneAt int64 `json:"doneAt,omitempty"`
+
+	// Time from started to done, in milliseconds
+	// required: false
+	CycleTime int64 `json:"cycleTime,omitempty"`
+
+	// Time from creation to done, in milliseconds
+	// required: false
+	LeadTime int64 `json:"leadTime,omitempty"`
+
+	// Time spent in each status, in milliseconds, keyed by option id. Empty key for no status
+	// required: true
+	TimeInStatus map[string]int64 `json:"timeInStatus"`
+}
+
+// DurationDistribution summarizes a set of durations, in milliseconds.
+// swagger:model
+type DurationDistribution struct {
+	// required: true
+	Count int `json:"count"`
+	// required: true
+	Min int64 `json:"min"`
+	// required: true
+	Max int64 `json:"max"`
+	// required: true
+	Mean int64 `json:"mean"`
+	// required: true
+	Median int64 `json:"median"`
+	// required: true
+	P85 int64 `json:"p85"`
+	// required: true
+	P95 int64 `json:"p95"`
+}
+
+// WeeklyThroughput is the number of cards done during a week.
+// swagger:model
+type WeeklyThroughput struct {
+	// Start of the week, on monday at midnight UTC
+	// required: true
+	WeekStart int64 `json:"weekStart"`
+
+	// required: true
+	Count int `json:"count"`
+}
+
+// FlowMetrics are the delivery metrics of a board over a date range, computed from the status
+// changes of its cards.
+// swagger:model
+type FlowMetrics struct {
+	// required: true
+	BoardID string `json:"boardId"`
+	// required: true
+	PropertyID string `json:"propertyId"`
+	// required: true
+	From int64 `json:"from"`
+	// required: true
+	To int64 `json:"to"`
+
+	// The options of the status property, in order
+	// required: true
+	Statuses []FlowStatus `json:"statuses"`
+	// required: true
+	StartedStatuses []string `json:"startedStatuses"`
+	// required: true
+	DoneStatuses []string `json:"doneStatuses"`
+
+	// The flow of the cards existing at the end of the date range
+	// required: true
+	Cards []*CardFlow `json:"cards"`
+
+	// Cycle times of the cards done in the date range
+	// required: true
+	CycleTime DurationDistribution `json:"cycleTime"`
+
+	// Lead times of the cards done in the date range
+	// required: true
+	LeadTime DurationDistribution `json:"leadTime"`
+
+	// Cards done per week of the date range
+	// required: true
+	Throughput []WeeklyThroughput `json:"throughput"`
+}
+
+// CumulativeFlowPoint is the number of cards in each status at the end of a day.
+// swagger:model
+type CumulativeFlowPoint struct {
+	// Start of the day, at midnight UTC
+	// required: true
+	Date int64 `json:"date"`
+
+	// Number of cards keyed by option id. Empty key for no status
+	// required: true
+	Counts map[string]int `json:"counts"`
+}
+
+// CumulativeFlow is the daily number of cards in each status of a board over a date range.
+// swagger:model
+type CumulativeFlow struct {
+	// required: true
+	BoardID string `json:"boardId"`
+	// required: true
+	PropertyID string `json:"propertyId"`
+	// required: true
+	From int64 `json:"from"`
+	// required: true
+	To int64 `json:"to"`
+	// required: true
+	Statuses []FlowStatus `json:"statuses"`
+	// required: true
+	Points []CumulativeFlowPoint `json:"points"`
+}
+
+func FlowMetricsFromJSON(data io.Reader) (*FlowMetrics, error) {
+	var metrics FlowMetrics
+	if err := json.NewDecoder(data).Decode(&metrics); err != nil {
+		return nil, err
+	}
+	return &metrics, nil
+}
+
+func CumulativeFlowFromJSON(data io.Reader) (*CumulativeFlow, error) {
+	var flow CumulativeFlow
+	if err := json.NewDecoder(data).Decode(&flow); err != nil {
+		return nil, err
+	}
+	return &flow, nil
+}
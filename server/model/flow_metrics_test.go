@@ -0,0 +1,22 @@
+// Copyright (c) 2020-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package model
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestFlowMetricsOptionsIsValid(t *testing.T) {
+	day := int64(24 * 60 * 60 * 1000)
+
+	require.NoError(t, FlowMetricsOptions{PropertyID: "status", From: day, To: 30 * day}.IsValid())
+	require.NoError(t, FlowMetricsOptions{PropertyID: "status", From: day, To: 367 * day}.IsValid())
+
+	require.True(t, IsErrBadRequest(FlowMetricsOptions{From: day, To: 30 * day}.IsValid()))
+	require.True(t, IsErrBadRequest(FlowMetricsOptions{PropertyID: "status", From: 30 * day, To: day}.IsValid()))
+	require.True(t, IsErrBadRequest(FlowMetricsOptions{PropertyID: "status", From: day, To: day}.IsValid()))
+	require.True(t, IsErrBadRequest(FlowMetricsOptions{PropertyID: "status", From: day, To: 368 * day}.IsValid()))
+}
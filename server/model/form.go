@@ -0,0 +1,425 @@
+// Copyright (c) 2020-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package model
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+)
+
+const (
+	FormTitleMaxLength       = 255
+	FormDescriptionMaxLength = 4000
+	FormLabelMaxLength       = 255
+	FormValueMaxLength       = 4000
+	FormMaxFields            = 50
+
+	// FormHoneypotField is the name of the hidden form field that humans leave empty. Submissions
+	// filling it in are silently dropped.
+	FormHoneypotField = "website"
+)
+
+// formPropertyTypes are the card property types that can be exposed in a form. Person properties
+// and computed properties cannot be set by people outside the board.
+var formPropertyTypes = map[string]bool{
+	"text":        true,
+	"number":      true,
+	"email":       true,
+	"phone":       true,
+	"url":         true,
+	"select":      true,
+	"multiSelect": true,
+	"checkbox":    true,
+	"date":        true,
+}
+
+// BoardForm is the definition of the public form of a board, used to create cards from
+// submissions made by people outside the board.
+// swagger:model
+type BoardForm struct {
+	// The board the form creates cards in
+	// required: true
+	BoardID string `json:"boardId"`
+
+	// Token authenticating submissions to the form
+	// required: true
+	Token string `json:"token"`
+
+	// Is the form accepting submissions
+	// required: true
+	Enabled bool `json:"enabled"`
+
+	// Title shown at the top of the form
+	// required: false
+	Title string `json:"title"`
+
+	// Description shown below the title of the form
+	// required: false
+	Description string `json:"description"`
+
+	// Label of the card title input. The card title is always required
+	// required: false
+	TitleLabel string `json:"titleLabel"`
+
+	// The card properties exposed in the form, in display order
+	// required: true
+	Fields []BoardFormField `json:"fields"`
+
+	// ID of the bot user set as the author of the created cards. Defaults to the system user
+	// required: false
+	AuthorID string `json:"authorId"`
+
+	// Enables the honeypot field used to drop submissions made by bots
+	// required: false
+	Honeypot bool `json:"honeypot"`
+
+	// ID of the user who last modified the form
+	// required: false
+	ModifiedBy string `json:"modifiedBy"`
+
+	// Updated time in miliseconds since the current epoch
+	// required: false
+	UpdateAt int64 `json:"updateAt"`
+}
+
+// BoardFormField is a card property exposed in a form.
+// swagger:model
+type BoardFormField struct {
+	// ID of the card property
+	// required: true
+	PropertyID string `json:"propertyId"`
+
+	// Label of the input. Defaults to the property name
+	// required: false
+	Label string `json:"label"`
+
+	// Is a value required
+	// required: false
+	Required bool `json:"required"`
+}
+
+// PublicForm is the form definition shown to people outside the board.
+// swagger:model
+type PublicForm struct {
+	// The board the form creates cards in
+	// required: true
+	BoardID string `json:"boardId"`
+
+	// Title shown at the top of the form
+	// required: false
+	Title string `json:"title"`
+
+	// Description shown below the title of the form
+	// required: false
+	Description string `json:"description"`
+
+	// Label of the card title input
+	// required: true
+	TitleLabel string `json:"titleLabel"`
+
+	// The inputs of the form, in display order
+	// required: true
+	Fields []PublicFormField `json:"fields"`
+
+	// Name of the hidden honeypot field, if enabled
+	// required: false
+	HoneypotField string `json:"honeypotField,omitempty"`
+}
+
+// PublicFormField is an input of a public form.
+// swagger:model
+type PublicFormField struct {
+	// ID of the card property
+	// required: true
+	PropertyID string `json:"propertyId"`
+
+	// Label of the input
+	// required: true
+	Label string `json:"label"`
+
+	// Type of the card property
+	// required: true
+	Type string `json:"type"`
+
+	// Is a value required
+	// required: true
+	Required bool `json:"required"`
+
+	// Options of select and multiSelect properties, in display order
+	// required: false
+	Options []PropDefOption `json:"options,omitempty"`
+}
+
+// FormSubmission is a submission to a public form.
+// swagger:model
+type FormSubmission struct {
+	// Title of the card
+	// required: true
+	Title string `json:"title"`
+
+	// Map of property IDs to values
+	// required: false
+	Properties map[string]any `json:"properties"`
+
+	// Honeypot field, must be left empty
+	// required: false
+	Website string `json:"website"`
+}
+
+func BoardFormFromJSON(data io.Reader) (*BoardForm, error) {
+	var form BoardForm
+	if err := json.NewDecoder(data).Decode(&form); err != nil {
+		return nil, err
+	}
+	return &form, nil
+}
+
+// IsValid checks the form definition against the property schema of its board.
+func (f *BoardForm) IsValid(schema PropSchema) error {
+	if f == nil {
+		return ErrInvalidBoardForm{"cannot be nil"}
+	}
+	if f.BoardID == "" {
+		return ErrInvalidBoardForm{"missing board id"}
+	}
+	if f.Token == "" {
+		return ErrInvalidBoardForm{"missing token"}
+	}
+	if utf8.RuneCountInString(f.Title) > FormTitleMaxLength {
+		return ErrInvalidBoardForm{"title too long"}
+	}
+	if utf8.RuneCountInString(f.Description) > FormDescriptionMaxLength {
+		return ErrInvalidBoardForm{"description too long"}
+	}
+	if utf8.RuneCountInString(f.TitleLabel) > FormLabelMaxLength {
+		return ErrInvalidBoardForm{"title label too long"}
+	}
+	if len(f.Fields) > FormMaxFields {
+		return ErrInvalidBoardForm{"too many fields"}
+	}
+
+	seen := make(map[string]bool, len(f.Fields))
+	for _, field := range f.Fields {
+		if seen[field.PropertyID] {
+			return ErrInvalidBoardForm{fmt.Sprintf("duplicate field %s", field.PropertyID)}
+		}
+		seen[field.PropertyID] = true
+
+		pd, ok := schema[field.PropertyID]
+		if !ok {
+			return ErrInvalidBoardForm{fmt.Sprintf("unknown property %s", field.PropertyID)}
+		}
+		if !formPropertyTypes[pd.Type] {
+			return ErrInvalidBoardForm{fmt.Sprintf("property %s of type %s cannot be used in a form", field.PropertyID, pd.Type)}
+		}
+		if utf8.RuneCountInString(field.Label) > FormLabelMaxLength {
+			return ErrInvalidBoardForm{fmt.Sprintf("label of field %s too long", field.PropertyID)}
+		}
+	}
+	return nil
+}
+
+// Public returns the form as shown to people outside the board. Fields whose property has been
+// removed from the board, or changed to a type forms do not support, are left out.
+func (f *BoardForm) Public(schema PropSchema) *PublicForm {
+	public := &PublicForm{
+		BoardID:     f.BoardID,
+		Title:       f.Title,
+		Description: f.Description,
+		TitleLabel:  f.TitleLabel,
+		Fields:      []PublicFormField{},
+	}
+	if public.TitleLabel == "" {
+		public.TitleLabel = "Title"
+	}
+	if f.Honeypot {
+		public.HoneypotField = FormHoneypotField
+	}
+
+	for _, field := range f.Fields {
+		pd, ok := schema[field.PropertyID]
+		if !ok || !formPropertyTypes[pd.Type] {
+			continue
+		}
+
+		publicField := PublicFormField{
+			PropertyID: field.PropertyID,
+			Label:      field.Label,
+			Type:       pd.Type,
+			Required:   field.Required,
+		}
+		if publicField.Label == "" {
+			publicField.Label = pd.Name
+		}
+		for _, opt := range pd.Options {
+			publicField.Options = append(publicField.Options, opt)
+		}
+		sort.Slice(publicField.Options, func(i, j int) bool {
+			return publicField.Options[i].Index < publicField.Options[j].Index
+		})
+		public.Fields = append(public.Fields, publicField)
+	}
+	return public
+}
+
+// IsSpam returns true if the honeypot field of the form has been filled in.
+func (f *BoardForm) IsSpam(submission *FormSubmission) bool {
+	return f.Honeypot && submission.Website != ""
+}
+
+// CardFromSubmission validates a submission against the form and the property schema of its
+// board, and returns the card to create. Values of properties not exposed by the form are
+// rejected.
+func (f *BoardForm) CardFromSubmission(submission *FormSubmission, schema PropSchema) (*Card, error) {
+	title := strings.TrimSpace(submission.Title)
+	if title == "" {
+		return nil, ErrInvalidFormSubmission{"missing title"}
+	}
+	if utf8.RuneCountInString(title) > FormTitleMaxLength {
+		return nil, ErrInvalidFormSubmission{"title too long"}
+	}
+
+	fields := make(map[string]BoardFormField, len(f.Fields))
+	for _, field := range f.Fields {
+		fields[field.PropertyID] = field
+	}
+	for propertyID := range submission.Properties {
+		if _, ok := fields[propertyID]; !ok {
+			return nil, ErrInvalidFormSubmission{fmt.Sprintf("property %s is not part of the form", propertyID)}
+		}
+	}
+
+	properties := make(map[string]any)
+	for _, field := range f.Fields {
+		pd, ok := schema[field.PropertyID]
+		if !ok || !formPropertyTypes[pd.Type] {
+			continue
+		}
+
+		value, err := formValue(pd, submission.Properties[field.PropertyID])
+		if err != nil {
+			return nil, ErrInvalidFormSubmission{fmt.Sprintf("invalid value for %s: %s", pd.Name, err.Error())}
+		}
+		if value == nil {
+			if field.Required {
+				return nil, ErrInvalidFormSubmission{fmt.Sprintf("missing value for %s", pd.Name)}
+			}
+			continue
+		}
+		properties[field.PropertyID] = value
+	}
+
+	return &Card{
+		Title:        title,
+		ContentOrder: []string{},
+		Properties:   properties,
+	}, nil
+}
+
+// formValue validates a submitted value for a property and returns it in the form cards store it,
+// or nil if the value is empty.
+func formValue(pd PropDef, v any) (any, error) {
+	if v == nil {
+		return nil, nil
+	}
+
+	switch pd.Type {
+	case "multiSelect":
+		ids, ok := v.([]any)
+		if !ok {
+			return nil, ErrInvalidPropertyValueType
+		}
+		if len(ids) == 0 {
+			return nil, nil
+		}
+		for _, idIface := range ids {
+			id, ok := idIface.(string)
+			if !ok {
+				return nil, ErrInvalidPropertyValueType
+			}
+			if _, ok := pd.Options[id]; !ok {
+				return nil, ErrInvalidPropertyValue
+			}
+		}
+		return ids, nil
+
+	case "checkbox":
+		switch b := v.(type) {
+		case bool:
+			if !b {
+				return nil, nil
+			}
+			return "true", nil
+		case string:
+			if b == "" || b == "false" {
+				return nil, nil
+			}
+			if b != "true" {
+				return nil, ErrInvalidPropertyValue
+			}
+			return b, nil
+		}
+		return nil, ErrInvalidPropertyValueType
+	}
+
+	var s string
+	switch value := v.(type) {
+	case string:
+		s = strings.TrimSpace(value)
+	case float64:
+		if pd.Type != "number" {
+			return nil, ErrInvalidPropertyValueType
+		}
+		s = strconv.FormatFloat(value, 'f', -1, 64)
+	default:
+		return nil, ErrInvalidPropertyValueType
+	}
+	if s == "" {
+		return nil, nil
+	}
+	if utf8.RuneCountInString(s) > FormValueMaxLength {
+		return nil, ErrInvalidPropertyValue
+	}
+
+	switch pd.Type {
+	case "select":
+		if _, ok := pd.Options[s]; !ok {
+			return nil, ErrInvalidPropertyValue
+		}
+	case "number":
+		if _, err := strconv.ParseFloat(s, 64); err != nil {
+			return nil, ErrInvalidPropertyValue
+		}
+	case "date":
+		if _, err := pd.ParseDate(s); err != nil {
+			return nil, ErrInvalidDate
+		}
+	case "email":
+		if !strings.Contains(s, "@") {
+			return nil, ErrInvalidPropertyValue
+		}
+	}
+	return s, nil
+}
+
+type ErrInvalidBoardForm struct {
+	msg string
+}
+
+func (e ErrInvalidBoardForm) Error() string {
+	return e.msg
+}
+
+type ErrInvalidFormSubmission struct {
+	msg string
+}
+
+func (e ErrInvalidFormSubmission) Error() string {
+	return e.msg
+}
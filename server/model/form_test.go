@@ -0,0 +1,159 @@
+// Copyright (c) 2020-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package model
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func testFormSchema() PropSchema {
+	return PropSchema{
+		"text": {ID: "text", Name: "Description", Type: "text"},
+		"num":  {ID: "num", Name: "Estimate", Type: "number"},
+		"prio": {ID: "prio", Name: "Priority", Type: "select", Options: map[string]PropDefOption{
+			"high": {ID: "high", Index: 0, Value: "High"},
+			"low":  {ID: "low", Index: 1, Value: "Low"},
+		}},
+		"tags": {ID: "tags", Name: "Tags", Type: "multiSelect", Options: map[string]PropDefOption{
+			"a": {ID: "a", Index: 0, Value: "A"},
+		}},
+		"done":  {ID: "done", Name: "Done", Type: "checkbox"},
+		"due":   {ID: "due", Name: "Due", Type: "date"},
+		"owner": {ID: "owner", Name: "Owner", Type: "person"},
+	}
+}
+
+func TestBoardFormIsValid(t *testing.T) {
+	schema := testFormSchema()
+	valid := func() *BoardForm {
+		return &BoardForm{
+			BoardID: "board-id",
+			Token:   "token",
+			Fields:  []BoardFormField{{PropertyID: "text", Required: true}, {PropertyID: "prio"}},
+		}
+	}
+
+	require.NoError(t, valid().IsValid(schema))
+
+	form := valid()
+	form.Fields = append(form.Fields, BoardFormField{PropertyID: "missing"})
+	require.Error(t, form.IsValid(schema))
+
+	form = valid()
+	form.Fields = append(form.Fields, BoardFormField{PropertyID: "text"})
+	require.Error(t, form.IsValid(schema), "duplicate field")
+
+	form = valid()
+	form.Fields = append(form.Fields, BoardFormField{PropertyID: "owner"})
+	require.Error(t, form.IsValid(schema), "person properties cannot be exposed")
+
+	form = valid()
+	form.Token = ""
+	require.Error(t, form.IsValid(schema))
+}
+
+func TestBoardFormPublic(t *testing.T) {
+	form := &BoardForm{
+		BoardID:  "board-id",
+		Token:    "token",
+		Honeypot: true,
+		Fields: []BoardFormField{
+			{PropertyID: "prio", Label: "How urgent?", Required: true},
+			{PropertyID: "text"},
+			{PropertyID: "deleted"},
+		},
+	}
+
+	public := form.Public(testFormSchema())
+	assert.Equal(t, FormHoneypotField, public.HoneypotField)
+	assert.NotEmpty(t, public.TitleLabel)
+	require.Len(t, public.Fields, 2)
+
+	assert.Equal(t, "How urgent?", public.Fields[0].Label)
+	assert.True(t, public.Fields[0].Required)
+	require.Len(t, public.Fields[0].Options, 2)
+	assert.Equal(t, "high", public.Fields[0].Options[0].ID)
+
+	assert.Equal(t, "Description", public.Fields[1].Label)
+}
+
+func TestBoardFormCardFromSubmission(t *testing.T) {
+	schema := testFormSchema()
+	form := &BoardForm{
+		BoardID: "board-id",
+		Token:   "token",
+		Fields: []BoardFormField{
+			{PropertyID: "text", Required: true},
+			{PropertyID: "num"},
+			{PropertyID: "prio"},
+			{PropertyID: "tags"},
+			{PropertyID: "done"},
+			{PropertyID: "due"},
+		},
+	}
+
+	t.Run("valid submission", func(t *testing.T) {
+		card, err := form.CardFromSubmission(&FormSubmission{
+			Title: " Bug report ",
+			Properties: map[string]any{
+				"text": "It broke",
+				"num":  float64(3),
+				"prio": "high",
+				"tags": []any{"a"},
+				"done": true,
+				"due":  `{"from":1642161600000}`,
+			},
+		}, schema)
+		require.NoError(t, err)
+		assert.Equal(t, "Bug report", card.Title)
+		assert.Equal(t, map[string]any{
+			"text": "It broke",
+			"num":  "3",
+			"prio": "high",
+			"tags": []any{"a"},
+			"done": "true",
+			"due":  `{"from":1642161600000}`,
+		}, card.Properties)
+	})
+
+	t.Run("empty optional values are left out", func(t *testing.T) {
+		card, err := form.CardFromSubmission(&FormSubmission{
+			Title:      "title",
+			Properties: map[string]any{"text": "x", "num": "", "tags": []any{}, "done": false},
+		}, schema)
+		require.NoError(t, err)
+		assert.Equal(t, map[string]any{"text": "x"}, card.Properties)
+	})
+
+	invalid := map[string]*FormSubmission{
+		"missing title":          {Properties: map[string]any{"text": "x"}},
+		"missing required":       {Title: "title", Properties: map[string]any{"text": "  "}},
+		"property not in form":   {Title: "title", Properties: map[string]any{"text": "x", "owner": "user-id"}},
+		"unknown option":         {Title: "title", Properties: map[string]any{"text": "x", "prio": "medium"}},
+		"unknown multi option":   {Title: "title", Properties: map[string]any{"text": "x", "tags": []any{"b"}}},
+		"not a number":           {Title: "title", Properties: map[string]any{"text": "x", "num": "three"}},
+		"invalid date":           {Title: "title", Properties: map[string]any{"text": "x", "due": "tomorrow"}},
+		"wrong type for text":    {Title: "title", Properties: map[string]any{"text": float64(1)}},
+		"invalid checkbox value": {Title: "title", Properties: map[string]any{"text": "x", "done": "maybe"}},
+	}
+	for name, submission := range invalid {
+		t.Run(name, func(t *testing.T) {
+			_, err := form.CardFromSubmission(submission, schema)
+			require.Error(t, err)
+		})
+	}
+}
+
+func TestBoardFormIsSpam(t *testing.T) {
+	form := &BoardForm{}
+	submission := &FormSubmission{Title: "title", Website: "http://spam.example.com"}
+	assert.False(t, form.IsSpam(submission), "honeypot disabled")
+
+	form.Honeypot = true
+	assert.True(t, form.IsSpam(submission))
+	assert.False(t, form.IsSpam(&FormSubmission{Title: "title"}))
+}
@@ -0,0 +1,122 @@
+// Copyright (c) 2020-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package model
+
+import (
+	"errors"
+	"fmt"
+)
+
+const (
+	NotificationsDefaultPerPage = 50
+	NotificationsMaxPerPage     = 200
+)
+
+var ErrInvalidNotification = errors.New("invalid notification")
+
+// NotificationType is the kind of event an in-app notification was created for.
+type NotificationType string
+
+const (
+	NotificationTypeMention    NotificationType = "mention"
+	NotificationTypeAssigned   NotificationType = "assigned"
+	NotificationTypeUnassigned NotificationType = "unassigned"
+	NotificationTypeCardChange NotificationType = "card_change"
+	NotificationTypeReply      NotificationType = "reply"
+)
+
+func (nt NotificationType) IsValid() bool {
+	switch nt {
+	case NotificationTypeMention, NotificationTypeAssigned, NotificationTypeUnassigned, NotificationTypeCardChange, NotificationTypeReply:
+		return true
+	}
+	return false
+}
+
+// Notification is an entry in a user's in-app notification inbox.
+// swagger:model
+type Notification struct {
+	// The id of the notification
+	// required: true
+	ID string `json:"id"`
+
+	// The user the notification is for
+	// required: true
+	UserID string `json:"userId"`
+
+	// The type of event the notification was created for
+	// required: true
+	Type NotificationType `json:"type"`
+
+	// The team of the board
+	// required: true
+	TeamID string `json:"teamId"`
+
+	// The board the event happened on
+	// required: true
+	BoardID string `json:"boardId"`
+
+	// The card the event happened on
+	// required: false
+	CardID string `json:"cardId"`
+
+	// The block that triggered the event, if not the card itself
+	// required: false
+	BlockID string `json:"blockId,omitempty"`
+
+	// The user that triggered the event
+	// required: false
+	ActorID string `json:"actorId"`
+
+	// The title of the card at the time of the event
+	// required: false
+	Title string `json:"title"`
+
+	// Event specific details, such as the text around an @mention or the name of a person property
+	// required: false
+	Message string `json:"message"`
+
+	// The creation time in milliseconds since the current epoch
+	// required: true
+	CreateAt int64 `json:"createAt"`
+
+	// The time the notification was read in milliseconds since the current epoch, or zero if unread
+	// required: true
+	ReadAt int64 `json:"readAt"`
+}
+
+func (n *Notification) IsValid() error {
+	if n == nil {
+		return ErrInvalidNotification
+	}
+	if n.UserID == "" {
+		return fmt.Errorf("missing user id: %w", ErrInvalidNotification)
+	}
+	if n.BoardID == "" {
+		return fmt.Errorf("missing board id: %w", ErrInvalidNotification)
+	}
+	if !n.Type.IsValid() {
+		return fmt.Errorf("invalid type %s: %w", n.Type, ErrInvalidNotification)
+	}
+	return nil
+}
+
+// NotificationList is a page of a user's notifications along with their total unread count.
+// swagger:model
+type NotificationList struct {
+	// The notifications, newest first
+	// required: true
+	Notifications []*Notification `json:"notifications"`
+
+	// The number of unread notifications the user has
+	// required: true
+	UnreadCount int64 `json:"unreadCount"`
+}
+
+// QueryNotificationsOptions are query options that can be passed to GetNotificationsForUser.
+type QueryNotificationsOptions struct {
+	UnreadOnly bool // if true then only unread notifications are returned
+	Page       int  // the page to return, starting at zero
+	PerPage    int  // the number of notifications per page
+}
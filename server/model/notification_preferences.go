@@ -0,0 +1,225 @@
+// Copyright (c) 2020-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package model
+
+import (
+	"encoding/json"
+	"io"
+)
+
+// NotificationEventKind is a category of block change a user can opt in or out of.
+type NotificationEventKind string
+
+const (
+	NotifyEventProperties  NotificationEventKind = "properties"
+	NotifyEventComments    NotificationEventKind = "comments"
+	NotifyEventAttachments NotificationEventKind = "attachments"
+	NotifyEventContent     NotificationEventKind = "content"
+	NotifyEventNone        NotificationEventKind = ""
+)
+
+// NotificationEventKindForBlockType returns the event kind used when filtering notifications
+// for changes to a block of the specified type. Changes to the card itself (title, properties,
+// add/delete) are reported as property changes.
+func NotificationEventKindForBlockType(blockType BlockType) NotificationEventKind {
+	switch blockType {
+	case TypeCard:
+		return NotifyEventProperties
+	case TypeComment:
+		return NotifyEventComments
+	case TypeImage, TypeAttachment:
+		return NotifyEventAttachments
+	case TypeText, TypeCheckbox, TypeDivider:
+		return NotifyEventContent
+	}
+	return NotifyEventNone
+}
+
+// NotificationPreferences holds the notification settings a user has for a single board.
+// When no preferences have been saved the defaults returned by
+// NewDefaultNotificationPreferences apply.
+// swagger:model
+type NotificationPreferences struct {
+	// The user the preferences belong to
+	// required: true
+	UserID string `json:"userId"`
+
+	// The board the preferences apply to
+	// required: true
+	BoardID string `json:"boardId"`
+
+	// Muted suppresses all notifications for the board, including @mentions
+	// required: true
+	Muted bool `json:"muted"`
+
+	// MentionsOnly suppresses all notifications except @mentions
+	// required: true
+	MentionsOnly bool `json:"mentionsOnly"`
+
+	// AssignmentOnly suppresses change notifications unless the user was assigned via a person property
+	// required: true
+	AssignmentOnly bool `json:"assignmentOnly"`
+
+	// Notify on card title and property changes
+	// required: true
+	Properties bool `json:"properties"`
+
+	// Notify on comments
+	// required: true
+	Comments bool `json:"comments"`
+
+	// Notify on image and file attachments
+	// required: true
+	Attachments bool `json:"attachments"`
+
+	// Notify on card content edits
+	// required: true
+	Content bool `json:"content"`
+
+	// The last update time in miliseconds since the current epoch
+	// required: false
+	UpdateAt int64 `json:"updateAt"`
+}
+
+// NewDefaultNotificationPreferences returns the preferences used for users that have not
+// customized their notifications for a board: every event kind is enabled.
+func NewDefaultNotificationPreferences(userID, boardID string) *NotificationPreferences {
+	return &NotificationPreferences{
+		UserID:      userID,
+		BoardID:     boardID,
+		Properties:  true,
+		Comments:    true,
+		Attachments: true,
+		Content:     true,
+	}
+}
+
+// IsValid checks the preferences refer to a valid user and board.
+func (p *NotificationPreferences) IsValid() error {
+	if p == nil {
+		return ErrInvalidNotificationPreferences{"cannot be nil"}
+	}
+	if p.UserID == "" {
+		return ErrInvalidNotificationPreferences{"missing user id"}
+	}
+	if err := IsValidId(p.BoardID); err != nil {
+		return ErrInvalidNotificationPreferences{err.Error()}
+	}
+	return nil
+}
+
+// IsDefault returns true if the preferences do not filter any notification.
+func (p *NotificationPreferences) IsDefault() bool {
+	return !p.Muted && !p.MentionsOnly && !p.AssignmentOnly &&
+		p.Properties && p.Comments && p.Attachments && p.Content
+}
+
+// AllowsMentions returns true if @mention notifications should be delivered.
+func (p *NotificationPreferences) AllowsMentions() bool {
+	return !p.Muted
+}
+
+// AllowsReplies returns true if notifications for replies to the user's comments should be delivered.
+// Like mentions, replies address the user directly and are only suppressed by muting the board.
+func (p *NotificationPreferences) AllowsReplies() bool {
+	return !p.Muted
+}
+
+// AllowsAssignments returns true if notifications for being assigned to a card should be delivered.
+func (p *NotificationPreferences) AllowsAssignments() bool {
+	return !p.Muted && !p.MentionsOnly
+}
+
+// AllowsEvent returns true if change notifications of the specified kind should be delivered.
+func (p *NotificationPreferences) AllowsEvent(kind NotificationEventKind) bool {
+	if p.Muted || p.MentionsOnly || p.AssignmentOnly {
+		return false
+	}
+
+	switch kind {
+	case NotifyEventProperties:
+		return p.Properties
+	case NotifyEventComments:
+		return p.Comments
+	case NotifyEventAttachments:
+		return p.Attachments
+	case NotifyEventContent:
+		return p.Content
+	}
+	return false
+}
+
+// NotificationPreferencesPatch is a patch for modifying a user's notification preferences for a board.
+// swagger:model
+type NotificationPreferencesPatch struct {
+	// Mute all notifications for the board
+	// required: false
+	Muted *bool `json:"muted"`
+
+	// Only notify on @mentions
+	// required: false
+	MentionsOnly *bool `json:"mentionsOnly"`
+
+	// Only notify when assigned via a person property
+	// required: false
+	AssignmentOnly *bool `json:"assignmentOnly"`
+
+	// Notify on card title and property changes
+	// required: false
+	Properties *bool `json:"properties"`
+
+	// Notify on comments
+	// required: false
+	Comments *bool `json:"comments"`
+
+	// Notify on image and file attachments
+	// required: false
+	Attachments *bool `json:"attachments"`
+
+	// Notify on card content edits
+	// required: false
+	Content *bool `json:"content"`
+}
+
+// Patch returns an updated version of the preferences.
+func (p *NotificationPreferencesPatch) Patch(prefs *NotificationPreferences) *NotificationPreferences {
+	if p.Muted != nil {
+		prefs.Muted = *p.Muted
+	}
+	if p.MentionsOnly != nil {
+		prefs.MentionsOnly = *p.MentionsOnly
+	}
+	if p.AssignmentOnly != nil {
+		prefs.AssignmentOnly = *p.AssignmentOnly
+	}
+	if p.Properties != nil {
+		prefs.Properties = *p.Properties
+	}
+	if p.Comments != nil {
+		prefs.Comments = *p.Comments
+	}
+	if p.Attachments != nil {
+		prefs.Attachments = *p.Attachments
+	}
+	if p.Content != nil {
+		prefs.Content = *p.Content
+	}
+	return prefs
+}
+
+func NotificationPreferencesFromJSON(data io.Reader) (*NotificationPreferences, error) {
+	var prefs NotificationPreferences
+	if err := json.NewDecoder(data).Decode(&prefs); err != nil {
+		return nil, err
+	}
+	return &prefs, nil
+}
+
+type ErrInvalidNotificationPreferences struct {
+	msg string
+}
+
+func (e ErrInvalidNotificationPreferences) Error() string {
+	return e.msg
+}
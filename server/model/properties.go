@@ -56,6 +56,8 @@ type PropDef struct {
 	Name    string                   `json:"name"`
 	Type    string                   `json:"type"`
 	Options map[string]PropDefOption `json:"options"`
+	// DoneOptionID is the option of a select property cards are moved to once completed.
+	DoneOptionID string `json:"doneOptionId"`
 }
 
 // GetValue resolves the value of a property if the passed value is an ID for an option,
@@ -230,11 +232,12 @@ func ParsePropertySchema(board *Board) (PropSchema, error) {
 
 	for i, prop := range board.CardProperties {
 		pd := PropDef{
-			ID:      getMapString("id", prop),
-			Index:   i,
-			Name:    getMapString("name", prop),
-			Type:    getMapString("type", prop),
-			Options: make(map[string]PropDefOption),
+			ID:           getMapString("id", prop),
+			Index:        i,
+			Name:         getMapString("name", prop),
+			Type:         getMapString("type", prop),
+			Options:      make(map[string]PropDefOption),
+			DoneOptionID: getMapString("doneOptionId", prop),
 		}
 		optsIface, ok := prop["options"]
 		if ok {
@@ -261,6 +264,26 @@ func ParsePropertySchema(board *Board) (PropSchema, error) {
 	return schema, nil
 }
 
+// DoneStatus returns the select property of the schema whose done option is set, the status of
+// the completed cards. If several properties have one, the first of the board is returned.
+func (s PropSchema) DoneStatus() (PropDef, bool) {
+	var status PropDef
+	found := false
+	for _, pd := range s {
+		if pd.Type != "select" || pd.DoneOptionID == "" {
+			continue
+		}
+		if _, ok := pd.Options[pd.DoneOptionID]; !ok {
+			continue
+		}
+		if !found || pd.Index < status.Index {
+			status = pd
+			found = true
+		}
+	}
+	return status, found
+}
+
 func getMapString(key string, m map[string]interface{}) string {
 	iface, ok := m[key]
 	if !ok {
@@ -62,6 +62,48 @@ func Test_parsePropertySchema(t *testing.T) {
 	})
 }
 
+func TestPropSchemaDoneStatus(t *testing.T) {
+	selectProp := func(id, doneOptionID string) map[string]interface{} {
+		return map[string]interface{}{
+			"id":           id,
+			"name":         id,
+			"type":         "select",
+			"doneOptionId": doneOptionID,
+			"options": []interface{}{
+				map[string]interface{}{"id": "todo", "value": "To Do"},
+				map[string]interface{}{"id": "done", "value": "Done"},
+			},
+		}
+	}
+	doneStatus := func(props ...map[string]interface{}) (PropDef, bool) {
+		schema, err := ParsePropertySchema(&Board{CardProperties: props})
+		require.NoError(t, err)
+		return schema.DoneStatus()
+	}
+
+	t.Run("first property with a done option", func(t *testing.T) {
+		status, ok := doneStatus(selectProp("priority", ""), selectProp("status", "done"), selectProp("stage", "todo"))
+		require.True(t, ok)
+		assert.Equal(t, "status", status.ID)
+		assert.Equal(t, "done", status.DoneOptionID)
+	})
+
+	t.Run("no done option", func(t *testing.T) {
+		_, ok := doneStatus(selectProp("status", ""))
+		assert.False(t, ok)
+	})
+
+	t.Run("done option not among the options", func(t *testing.T) {
+		_, ok := doneStatus(selectProp("status", "deleted"))
+		assert.False(t, ok)
+	})
+
+	t.Run("not a select property", func(t *testing.T) {
+		_, ok := doneStatus(map[string]interface{}{"id": "text", "type": "text", "doneOptionId": "done"})
+		assert.False(t, ok)
+	})
+}
+
 func Test_GetValue(t *testing.T) {
 	resolver := MockResolver{}
 
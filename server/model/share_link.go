@@ -0,0 +1,307 @@
+// Copyright (c) 2020-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package model
+
+import (
+	"encoding/json"
+	"io"
+
+	"golang.org/x/crypto/bcrypt"
+)
+
+const (
+	ShareLinkNameMaxLength     = 100
+	ShareLinkPasswordMaxLength = 72 // bcrypt ignores anything longer
+)
+
+// ShareLinkScope is the part of a board a share link grants access to.
+type ShareLinkScope string
+
+const (
+	ShareLinkScopeBoard ShareLinkScope = "board"
+	ShareLinkScopeView  ShareLinkScope = "view"
+	ShareLinkScopeCard  ShareLinkScope = "card"
+)
+
+func (s ShareLinkScope) IsValid() bool {
+	switch s {
+	case ShareLinkScopeBoard, ShareLinkScopeView, ShareLinkScopeCard:
+		return true
+	}
+	return false
+}
+
+// ShareLinkPermission is the level of access a share link grants.
+type ShareLinkPermission string
+
+const (
+	ShareLinkPermissionView    ShareLinkPermission = "view"
+	ShareLinkPermissionComment ShareLinkPermission = "comment"
+)
+
+func (p ShareLinkPermission) IsValid() bool {
+	switch p {
+	case ShareLinkPermissionView, ShareLinkPermissionComment:
+		return true
+	}
+	return false
+}
+
+// Includes returns true if the permission grants at least the specified permission.
+func (p ShareLinkPermission) Includes(other ShareLinkPermission) bool {
+	if p == ShareLinkPermissionComment {
+		return other == ShareLinkPermissionView || other == ShareLinkPermissionComment
+	}
+	return p == other
+}
+
+// ShareLink is a named link granting access to a board, or a part of it, via a read token.
+// swagger:model
+type ShareLink struct {
+	// ID of the share link
+	// required: true
+	ID string `json:"id"`
+
+	// ID of the board shared
+	// required: true
+	BoardID string `json:"boardId"`
+
+	// Name describing the share link
+	// required: true
+	Name string `json:"name"`
+
+	// Access token used as `read_token`
+	// required: true
+	Token string `json:"token"`
+
+	// Scope of the link: board, view or card
+	// required: true
+	Scope ShareLinkScope `json:"scope"`
+
+	// ID of the view or card shared, for view and card scoped links
+	// required: false
+	ScopeID string `json:"scopeId,omitempty"`
+
+	// Permission granted by the link: view or comment
+	// required: true
+	Permission ShareLinkPermission `json:"permission"`
+
+	// Hash of the password required to use the link, if any
+	PasswordHash string `json:"-"`
+
+	// True if a password is required to use the link
+	// required: true
+	HasPassword bool `json:"hasPassword"`
+
+	// Expiry time in miliseconds since the current epoch, or zero if the link does not expire
+	// required: false
+	ExpiresAt int64 `json:"expiresAt"`
+
+	// ID of the user who created the link
+	// required: true
+	CreatedBy string `json:"createdBy"`
+
+	// Created time in miliseconds since the current epoch
+	// required: true
+	CreateAt int64 `json:"createAt"`
+
+	// Last time the link was used in miliseconds since the current epoch, or zero if never used
+	// required: false
+	LastUsedAt int64 `json:"lastUsedAt"`
+
+	// Revocation time in miliseconds since the current epoch, or zero if not revoked
+	// required: false
+	RevokedAt int64 `json:"revokedAt"`
+}
+
+func (l *ShareLink) IsValid() error {
+	if l == nil {
+		return ErrInvalidShareLink{"cannot be nil"}
+	}
+	if l.BoardID == "" {
+		return ErrInvalidShareLink{"missing board id"}
+	}
+	if l.Token == "" {
+		return ErrInvalidShareLink{"missing token"}
+	}
+	if len(l.Name) > ShareLinkNameMaxLength {
+		return ErrInvalidShareLink{"name too long"}
+	}
+	if !l.Scope.IsValid() {
+		return ErrInvalidShareLink{"invalid scope"}
+	}
+	if l.Scope == ShareLinkScopeBoard && l.ScopeID != "" {
+		return ErrInvalidShareLink{"board scope cannot have a scope id"}
+	}
+	if l.Scope != ShareLinkScopeBoard && l.ScopeID == "" {
+		return ErrInvalidShareLink{"missing scope id"}
+	}
+	if !l.Permission.IsValid() {
+		return ErrInvalidShareLink{"invalid permission"}
+	}
+	return nil
+}
+
+// IsActive returns true if the link has not been revoked and has not expired at the specified time.
+func (l *ShareLink) IsActive(now int64) bool {
+	if l.RevokedAt != 0 {
+		return false
+	}
+	return l.ExpiresAt == 0 || now < l.ExpiresAt
+}
+
+// SetPassword sets the password required to use the link; an empty password removes it.
+func (l *ShareLink) SetPassword(password string) error {
+	if password == "" {
+		l.PasswordHash = ""
+		l.HasPassword = false
+		return nil
+	}
+	if len(password) > ShareLinkPasswordMaxLength {
+		return ErrInvalidShareLink{"password too long"}
+	}
+
+	hash, err := bcrypt.GenerateFromPassword([]byte(password), bcrypt.DefaultCost)
+	if err != nil {
+		return err
+	}
+	l.PasswordHash = string(hash)
+	l.HasPassword = true
+	return nil
+}
+
+// CheckPassword returns true if the link requires no password or the password matches.
+func (l *ShareLink) CheckPassword(password string) bool {
+	if l.PasswordHash == "" {
+		return true
+	}
+	return bcrypt.CompareHashAndPassword([]byte(l.PasswordHash), []byte(password)) == nil
+}
+
+// ShareLinkScopeFilter decides which blocks of a board a share link grants access to. Card scoped
+// links grant access to the card and its contents. View scoped links grant access to the view, and
+// to the cards selected by the view's filter with their contents, but not to the board's other
+// views.
+type ShareLinkScopeFilter struct {
+	Link *ShareLink
+
+	filter ViewFilter
+	schema PropSchema
+}
+
+// NewShareLinkScopeFilter returns the filter of the blocks within the scope of a share link. The
+// view shared by a view scoped link is required, and ignored for other links.
+func NewShareLinkScopeFilter(link *ShareLink, board *Board, view *Block) (*ShareLinkScopeFilter, error) {
+	filter := &ShareLinkScopeFilter{Link: link}
+	if link.Scope != ShareLinkScopeView {
+		return filter, nil
+	}
+
+	if view == nil || view.ID != link.ScopeID || view.BoardID != link.BoardID || view.Type != TypeView {
+		return nil, ErrInvalidShareLink{"shared view not found"}
+	}
+
+	viewFilter, err := ParseViewFilter(view)
+	if err != nil {
+		return nil, err
+	}
+	schema, err := ParsePropertySchema(board)
+	if err != nil {
+		return nil, err
+	}
+
+	filter.filter = viewFilter
+	filter.schema = schema
+	return filter, nil
+}
+
+// NeedsCard returns true if the card a block belongs to is needed to decide whether the block is
+// within the scope of the link.
+func (s *ShareLinkScopeFilter) NeedsCard(block *Block) bool {
+	return s.Link.Scope == ShareLinkScopeView && block != nil && block.Type != TypeCard && block.ID != s.Link.ScopeID
+}
+
+// AllowsBlock returns true if the block is within the scope of the link. The card is the parent of
+// the block, and is only needed when NeedsCard returns true.
+func (s *ShareLinkScopeFilter) AllowsBlock(block *Block, card *Block) bool {
+	if block == nil || block.BoardID != s.Link.BoardID {
+		return false
+	}
+
+	switch s.Link.Scope {
+	case ShareLinkScopeBoard:
+		return true
+	case ShareLinkScopeCard:
+		return block.ID == s.Link.ScopeID || block.ParentID == s.Link.ScopeID
+	case ShareLinkScopeView:
+		if block.ID == s.Link.ScopeID {
+			return true
+		}
+		if block.Type == TypeCard {
+			return s.filter.Matches(block, s.schema)
+		}
+		if card == nil || card.Type != TypeCard || card.ID != block.ParentID || card.BoardID != s.Link.BoardID {
+			return false
+		}
+		return s.filter.Matches(card, s.schema)
+	}
+	return false
+}
+
+// ShareLinkCreate is the payload used to create a share link.
+// swagger:model
+type ShareLinkCreate struct {
+	// Name describing the share link
+	// required: false
+	Name string `json:"name"`
+
+	// Scope of the link: board, view or card. Defaults to board
+	// required: false
+	Scope ShareLinkScope `json:"scope"`
+
+	// ID of the view or card shared, for view and card scoped links
+	// required: false
+	ScopeID string `json:"scopeId"`
+
+	// Permission granted by the link: view or comment. Defaults to view
+	// required: false
+	Permission ShareLinkPermission `json:"permission"`
+
+	// Optional password required to use the link
+	// required: false
+	Password string `json:"password"`
+
+	// Optional expiry time in miliseconds since the current epoch
+	// required: false
+	ExpiresAt int64 `json:"expiresAt"`
+}
+
+// ReadTokenAccess describes the access requested with a read token.
+type ReadTokenAccess struct {
+	// Password supplied for password protected share links.
+	Password string
+
+	// BlockIDs are the blocks being accessed. When empty, access to the board itself is requested,
+	// which is granted by any active share link of the board.
+	BlockIDs []string
+
+	// Permission is the permission required. Defaults to view.
+	Permission ShareLinkPermission
+}
+
+func ShareLinkFromJSON(data io.Reader) (*ShareLink, error) {
+	var link ShareLink
+	if err := json.NewDecoder(data).Decode(&link); err != nil {
+		return nil, err
+	}
+	return &link, nil
+}
+
+type ErrInvalidShareLink struct {
+	msg string
+}
+
+func (e ErrInvalidShareLink) Error() string {
+	return e.msg
+}
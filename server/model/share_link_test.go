@@ -0,0 +1,143 @@
+// Copyright (c) 2020-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package model
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestShareLinkIsValid(t *testing.T) {
+	valid := func() *ShareLink {
+		return &ShareLink{
+			BoardID:    "board-id",
+			Token:      "token",
+			Scope:      ShareLinkScopeBoard,
+			Permission: ShareLinkPermissionView,
+		}
+	}
+
+	require.NoError(t, valid().IsValid())
+
+	link := valid()
+	link.Token = ""
+	require.Error(t, link.IsValid())
+
+	link = valid()
+	link.ScopeID = "card-id"
+	require.Error(t, link.IsValid(), "board scope cannot have a scope id")
+
+	link = valid()
+	link.Scope = ShareLinkScopeCard
+	require.Error(t, link.IsValid(), "card scope needs a scope id")
+	link.ScopeID = "card-id"
+	require.NoError(t, link.IsValid())
+
+	link = valid()
+	link.Permission = "edit"
+	require.Error(t, link.IsValid())
+}
+
+func TestShareLinkIsActive(t *testing.T) {
+	link := &ShareLink{}
+	assert.True(t, link.IsActive(100))
+
+	link.ExpiresAt = 200
+	assert.True(t, link.IsActive(100))
+	assert.False(t, link.IsActive(200))
+
+	link.ExpiresAt = 0
+	link.RevokedAt = 50
+	assert.False(t, link.IsActive(100))
+}
+
+func TestShareLinkPassword(t *testing.T) {
+	link := &ShareLink{}
+	assert.True(t, link.CheckPassword(""))
+
+	require.NoError(t, link.SetPassword("secret"))
+	assert.True(t, link.HasPassword)
+	assert.True(t, link.CheckPassword("secret"))
+	assert.False(t, link.CheckPassword(""))
+	assert.False(t, link.CheckPassword("wrong"))
+
+	require.NoError(t, link.SetPassword(""))
+	assert.False(t, link.HasPassword)
+	assert.True(t, link.CheckPassword("anything"))
+}
+
+func TestShareLinkPermissionIncludes(t *testing.T) {
+	assert.True(t, ShareLinkPermissionView.Includes(ShareLinkPermissionView))
+	assert.False(t, ShareLinkPermissionView.Includes(ShareLinkPermissionComment))
+	assert.True(t, ShareLinkPermissionComment.Includes(ShareLinkPermissionView))
+	assert.True(t, ShareLinkPermissionComment.Includes(ShareLinkPermissionComment))
+}
+
+func TestShareLinkScopeFilterAllowsBlock(t *testing.T) {
+	board := &Board{ID: "board-id", CardProperties: []map[string]interface{}{
+		{"id": "status", "name": "Status", "type": "select"},
+	}}
+	view := &Block{ID: "view-1", BoardID: "board-id", Type: TypeView, Fields: map[string]interface{}{
+		"filter": map[string]interface{}{
+			"operation": "and",
+			"filters": []interface{}{
+				map[string]interface{}{"propertyId": "status", "condition": "includes", "values": []interface{}{"done"}},
+			},
+		},
+	}}
+	otherView := &Block{ID: "view-2", BoardID: "board-id", Type: TypeView}
+	card := &Block{ID: "card-1", BoardID: "board-id", Type: TypeCard, Fields: map[string]interface{}{
+		"properties": map[string]interface{}{"status": "done"},
+	}}
+	otherCard := &Block{ID: "card-2", BoardID: "board-id", Type: TypeCard, Fields: map[string]interface{}{
+		"properties": map[string]interface{}{"status": "todo"},
+	}}
+	content := &Block{ID: "text-1", BoardID: "board-id", ParentID: "card-1", Type: TypeText}
+	otherContent := &Block{ID: "text-2", BoardID: "board-id", ParentID: "card-2", Type: TypeText}
+	foreign := &Block{ID: "card-3", BoardID: "other-board", Type: TypeCard}
+
+	t.Run("board scope", func(t *testing.T) {
+		scope, err := NewShareLinkScopeFilter(&ShareLink{BoardID: "board-id", Scope: ShareLinkScopeBoard}, board, nil)
+		require.NoError(t, err)
+		for _, block := range []*Block{view, otherView, card, otherCard, content} {
+			assert.True(t, scope.AllowsBlock(block, nil), block.ID)
+		}
+		assert.False(t, scope.AllowsBlock(foreign, nil))
+		assert.False(t, scope.AllowsBlock(nil, nil))
+	})
+
+	t.Run("view scope", func(t *testing.T) {
+		scope, err := NewShareLinkScopeFilter(&ShareLink{BoardID: "board-id", Scope: ShareLinkScopeView, ScopeID: "view-1"}, board, view)
+		require.NoError(t, err)
+		assert.True(t, scope.AllowsBlock(view, nil))
+		assert.False(t, scope.AllowsBlock(otherView, nil))
+		assert.True(t, scope.AllowsBlock(card, nil))
+		assert.False(t, scope.AllowsBlock(otherCard, nil))
+		assert.False(t, scope.AllowsBlock(foreign, nil))
+
+		assert.True(t, scope.NeedsCard(content))
+		assert.True(t, scope.AllowsBlock(content, card))
+		assert.False(t, scope.AllowsBlock(content, nil))
+		assert.False(t, scope.AllowsBlock(content, otherCard))
+		assert.False(t, scope.AllowsBlock(otherContent, otherCard))
+	})
+
+	t.Run("view scope without the view", func(t *testing.T) {
+		_, err := NewShareLinkScopeFilter(&ShareLink{BoardID: "board-id", Scope: ShareLinkScopeView, ScopeID: "view-1"}, board, otherView)
+		require.Error(t, err)
+	})
+
+	t.Run("card scope", func(t *testing.T) {
+		scope, err := NewShareLinkScopeFilter(&ShareLink{BoardID: "board-id", Scope: ShareLinkScopeCard, ScopeID: "card-1"}, board, nil)
+		require.NoError(t, err)
+		assert.False(t, scope.NeedsCard(content))
+		assert.True(t, scope.AllowsBlock(card, nil))
+		assert.True(t, scope.AllowsBlock(content, nil))
+		assert.False(t, scope.AllowsBlock(otherCard, nil))
+		assert.False(t, scope.AllowsBlock(otherContent, nil))
+		assert.False(t, scope.AllowsBlock(view, nil))
+	})
+}
@@ -0,0 +1,125 @@
+// Copyright (c) 2020-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package model
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+)
+
+type StorageQuotaScope string
+
+const (
+	StorageQuotaScopeTeam  StorageQuotaScope = "team"
+	StorageQuotaScopeBoard StorageQuotaScope = "board"
+)
+
+// StorageQuota limits the total size of the files uploaded to the boards of a team, or to a
+// single board. It overrides the default quota of the server for its scope.
+// swagger:model
+type StorageQuota struct {
+	// Scope of the quota, team or board
+	// required: true
+	Scope StorageQuotaScope `json:"scope"`
+
+	// ID of the team or board the quota applies to
+	// required: true
+	ScopeID string `json:"scopeId"`
+
+	// Maximum total size of the files in bytes, 0 for unlimited
+	// required: true
+	MaxBytes int64 `json:"maxBytes"`
+
+	// ID of the user who last set the quota
+	// required: false
+	ModifiedBy string `json:"modifiedBy"`
+
+	// Updated time in miliseconds since the current epoch
+	// required: false
+	UpdateAt int64 `json:"updateAt"`
+}
+
+func StorageQuotaFromJSON(data io.Reader) (*StorageQuota, error) {
+	var quota StorageQuota
+	if err := json.NewDecoder(data).Decode(&quota); err != nil {
+		return nil, err
+	}
+	return &quota, nil
+}
+
+// IsValid checks the scope and limit of the quota.
+func (q *StorageQuota) IsValid() error {
+	switch q.Scope {
+	case StorageQuotaScopeTeam:
+		if err := ValidateTeamID(q.ScopeID, false); err != nil {
+			return NewErrBadRequest(err.Error())
+		}
+	case StorageQuotaScopeBoard:
+		if err := IsValidId(q.ScopeID); err != nil {
+			return NewErrBadRequest(err.Error())
+		}
+	default:
+		return NewErrBadRequest(fmt.Sprintf("invalid storage quota scope: %s", q.Scope))
+	}
+
+	if q.MaxBytes < 0 {
+		return NewErrBadRequest("storage quota cannot be negative")
+	}
+	return nil
+}
+
+// FileUsage is the total size and number of the files stored for a team or a board.
+// swagger:model
+type FileUsage struct {
+	// ID of the team the files belong to
+	// required: true
+	TeamID string `json:"teamId"`
+
+	// ID of the board the files belong to, empty for the usage of a whole team
+	// required: false
+	BoardID string `json:"boardId,omitempty"`
+
+	// Total size of the files in bytes
+	// required: true
+	Bytes int64 `json:"bytes"`
+
+	// Number of files
+	// required: true
+	FileCount int64 `json:"fileCount"`
+
+	// Effective quota in bytes, 0 for unlimited
+	// required: false
+	QuotaBytes int64 `json:"quotaBytes"`
+}
+
+func FileUsageFromJSON(data io.Reader) (*FileUsage, error) {
+	var usage FileUsage
+	if err := json.NewDecoder(data).Decode(&usage); err != nil {
+		return nil, err
+	}
+	return &usage, nil
+}
+
+// ErrStorageQuotaExceeded is returned when storing a file would exceed the storage quota of a
+// team or board.
+type ErrStorageQuotaExceeded struct {
+	Scope     StorageQuotaScope
+	ScopeID   string
+	UsedBytes int64
+	MaxBytes  int64
+}
+
+func NewErrStorageQuotaExceeded(scope StorageQuotaScope, scopeID string, usedBytes, maxBytes int64) *ErrStorageQuotaExceeded {
+	return &ErrStorageQuotaExceeded{
+		Scope:     scope,
+		ScopeID:   scopeID,
+		UsedBytes: usedBytes,
+		MaxBytes:  maxBytes,
+	}
+}
+
+func (e *ErrStorageQuotaExceeded) Error() string {
+	return fmt.Sprintf("storage quota exceeded: the %s uses %d of its %d bytes", e.Scope, e.UsedBytes, e.MaxBytes)
+}
@@ -0,0 +1,325 @@
+// Copyright (c) 2020-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package model
+
+import (
+	"encoding/json"
+	"strconv"
+	"strings"
+)
+
+const (
+	ViewFilterOperationAnd = "and"
+	ViewFilterOperationOr  = "or"
+
+	viewFilterHalfDay = 12 * 60 * 60 * 1000
+
+	propTypeDate        = "date"
+	propTypeCreatedBy   = "createdBy"
+	propTypeUpdatedBy   = "updatedBy"
+	propTypeCreatedTime = "createdTime"
+	propTypeUpdatedTime = "updatedTime"
+	propTypeCard        = "card"
+)
+
+// ViewFilter is the filter of a board view, as stored in the `filter` field of the view. A filter
+// with an operation is a group of filters, otherwise it is a clause on a card property. Cards are
+// matched the same way as the webapp's CardFilter does.
+type ViewFilter struct {
+	Operation  string       `json:"operation,omitempty"`
+	Filters    []ViewFilter `json:"filters,omitempty"`
+	PropertyID string       `json:"propertyId,omitempty"`
+	Condition  string       `json:"condition,omitempty"`
+	Values     []string     `json:"values,omitempty"`
+}
+
+// ParseViewFilter returns the filter of a view. A view without a filter matches every card.
+func ParseViewFilter(view *Block) (ViewFilter, error) {
+	filter := ViewFilter{Operation: ViewFilterOperationAnd}
+	if view == nil || view.Fields["filter"] == nil {
+		return filter, nil
+	}
+
+	data, err := json.Marshal(view.Fields["filter"])
+	if err != nil {
+		return filter, err
+	}
+	if err := json.Unmarshal(data, &filter); err != nil {
+		return filter, err
+	}
+	if filter.Operation == "" {
+		filter.Operation = ViewFilterOperationAnd
+	}
+	return filter, nil
+}
+
+func (f ViewFilter) isGroup() bool {
+	return f.Operation != ""
+}
+
+// Matches returns true if the card meets the filter.
+func (f ViewFilter) Matches(card *Block, schema PropSchema) bool {
+	if !f.isGroup() {
+		return f.clauseMatches(card, schema)
+	}
+	if len(f.Filters) == 0 {
+		return true
+	}
+
+	if f.Operation == ViewFilterOperationOr {
+		for _, filter := range f.Filters {
+			if filter.Matches(card, schema) {
+				return true
+			}
+		}
+		return false
+	}
+
+	for _, filter := range f.Filters {
+		if !filter.Matches(card, schema) {
+			return false
+		}
+	}
+	return true
+}
+
+// viewFilterDate is the value of a date property, either a single date or a range.
+type viewFilterDate struct {
+	From int64 `json:"from"`
+	To   int64 `json:"to"`
+}
+
+func parseViewFilterDate(value interface{}) *viewFilterDate {
+	date := &viewFilterDate{}
+	s, _ := value.(string)
+	if s == "" {
+		return date
+	}
+	if millis, err := strconv.ParseFloat(s, 64); err == nil {
+		date.From = int64(millis)
+		return date
+	}
+	_ = json.Unmarshal([]byte(s), date)
+	return date
+}
+
+func (f ViewFilter) clauseMatches(card *Block, schema PropSchema) bool {
+	props, _ := card.Fields["properties"].(map[string]interface{})
+	value := props[f.PropertyID]
+	if f.PropertyID == "title" {
+		value = strings.ToLower(card.Title)
+	}
+
+	def, hasDef := schema[f.PropertyID]
+	var date *viewFilterDate
+	if hasDef && def.Type == propTypeDate {
+		date = parseViewFilterDate(value)
+	}
+	if !isTruthy(value) && hasDef {
+		switch def.Type {
+		case propTypeCreatedBy:
+			value = card.CreatedBy
+		case propTypeUpdatedBy:
+			value = card.ModifiedBy
+		case propTypeCreatedTime:
+			value = strconv.FormatInt(card.CreateAt, 10)
+			date = parseViewFilterDate(value)
+		case propTypeUpdatedTime:
+			value = strconv.FormatInt(card.UpdateAt, 10)
+			date = parseViewFilterDate(value)
+		}
+	}
+
+	var cardIDs []string
+	isCardProp := hasDef && def.Type == propTypeCard
+	if isCardProp {
+		cardIDs = extractLinkedCardIDs(value)
+	}
+	isTime := hasDef && (def.Type == propTypeCreatedTime || def.Type == propTypeUpdatedTime)
+
+	var first string
+	if len(f.Values) > 0 {
+		first = strings.ToLower(f.Values[0])
+	}
+
+	switch f.Condition {
+	case "includes", "notIncludes":
+		if len(f.Values) == 0 {
+			return true
+		}
+		included := false
+		for _, v := range f.Values {
+			if isCardProp && containsString(cardIDs, v) || !isCardProp && valueIncludes(value, v) {
+				included = true
+				break
+			}
+		}
+		return included == (f.Condition == "includes")
+	case "isEmpty":
+		if isCardProp {
+			return len(cardIDs) == 0
+		}
+		return valueLength(value) == 0
+	case "isNotEmpty":
+		if isCardProp {
+			return len(cardIDs) > 0
+		}
+		return valueLength(value) > 0
+	case "isSet":
+		return isTruthy(value)
+	case "isNotSet":
+		return !isTruthy(value)
+	case "is":
+		if len(f.Values) == 0 {
+			return true
+		}
+		if date != nil {
+			filterDate := parseFilterMillis(f.Values[0])
+			if isTime {
+				return date.From != 0 && date.From > filterDate-viewFilterHalfDay && date.From < filterDate+viewFilterHalfDay
+			}
+			if date.From != 0 && date.To != 0 {
+				return date.From <= filterDate && date.To >= filterDate
+			}
+			return date.From == filterDate
+		}
+		s, ok := value.(string)
+		return ok && s == first
+	case "contains", "notContains":
+		if len(f.Values) == 0 {
+			return true
+		}
+		var contains bool
+		if values, ok := value.([]interface{}); ok {
+			contains = valueIncludes(values, first)
+		} else {
+			s, _ := value.(string)
+			contains = strings.Contains(s, first)
+		}
+		return contains == (f.Condition == "contains")
+	case "startsWith", "notStartsWith":
+		if len(f.Values) == 0 {
+			return true
+		}
+		s, _ := value.(string)
+		return strings.HasPrefix(s, first) == (f.Condition == "startsWith")
+	case "endsWith", "notEndsWith":
+		if len(f.Values) == 0 {
+			return true
+		}
+		s, _ := value.(string)
+		return strings.HasSuffix(s, first) == (f.Condition == "endsWith")
+	case "isBefore":
+		if len(f.Values) == 0 {
+			return true
+		}
+		if date == nil || date.From == 0 {
+			return false
+		}
+		filterDate := parseFilterMillis(f.Values[0])
+		if isTime {
+			return date.From < filterDate-viewFilterHalfDay
+		}
+		return date.From < filterDate
+	case "isAfter":
+		if len(f.Values) == 0 {
+			return true
+		}
+		if date == nil {
+			return false
+		}
+		filterDate := parseFilterMillis(f.Values[0])
+		if isTime {
+			return date.From != 0 && date.From > filterDate+viewFilterHalfDay
+		}
+		if date.To != 0 {
+			return date.To > filterDate
+		}
+		return date.From != 0 && date.From > filterDate
+	}
+	return true
+}
+
+// extractLinkedCardIDs returns the IDs of the cards referenced by a `card` property, stored as
+// "boardID|cardID1:title1,cardID2:title2" or, formerly, as "boardID:cardID:title".
+func extractLinkedCardIDs(value interface{}) []string {
+	s, _ := value.(string)
+	if s == "" {
+		return nil
+	}
+
+	if _, cards, ok := strings.Cut(s, "|"); ok {
+		var ids []string
+		for _, card := range strings.Split(cards, ",") {
+			if id, _, _ := strings.Cut(card, ":"); id != "" {
+				ids = append(ids, id)
+			}
+		}
+		return ids
+	}
+
+	if parts := strings.Split(s, ":"); len(parts) >= 2 {
+		return []string{parts[1]}
+	}
+	return nil
+}
+
+// parseFilterMillis parses the leading integer of a filter value, as parseInt does.
+func parseFilterMillis(s string) int64 {
+	end := 0
+	for end < len(s) && (s[end] >= '0' && s[end] <= '9' || end == 0 && s[end] == '-') {
+		end++
+	}
+	millis, _ := strconv.ParseInt(s[:end], 10, 64)
+	return millis
+}
+
+func valueIncludes(value interface{}, s string) bool {
+	switch v := value.(type) {
+	case []interface{}:
+		for _, item := range v {
+			if item == s {
+				return true
+			}
+		}
+		return false
+	case string:
+		return v == s
+	}
+	return false
+}
+
+func valueLength(value interface{}) int {
+	switch v := value.(type) {
+	case []interface{}:
+		return len(v)
+	case string:
+		return len(v)
+	}
+	return 0
+}
+
+// isTruthy returns true if the property value is truthy in JavaScript terms.
+func isTruthy(value interface{}) bool {
+	switch v := value.(type) {
+	case nil:
+		return false
+	case string:
+		return v != ""
+	case bool:
+		return v
+	case float64:
+		return v != 0
+	}
+	return true
+}
+
+func containsString(values []string, s string) bool {
+	for _, v := range values {
+		if v == s {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,101 @@
+// Copyright (c) 2020-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package model
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestViewFilterMatches(t *testing.T) {
+	schema := PropSchema{
+		"status":  {ID: "status", Type: "select"},
+		"labels":  {ID: "labels", Type: "multiSelect"},
+		"due":     {ID: "due", Type: propTypeDate},
+		"related": {ID: "related", Type: propTypeCard},
+	}
+	card := &Block{
+		ID:    "card-id",
+		Type:  TypeCard,
+		Title: "Fix The Login",
+		Fields: map[string]interface{}{
+			"properties": map[string]interface{}{
+				"status":  "done",
+				"labels":  []interface{}{"bug", "ui"},
+				"due":     `{"from":1000,"to":3000}`,
+				"related": "board-id|other-card:Other card",
+			},
+		},
+	}
+
+	testCases := []struct {
+		name    string
+		filter  ViewFilter
+		matches bool
+	}{
+		{"empty group", ViewFilter{Operation: ViewFilterOperationAnd}, true},
+		{"includes", ViewFilter{PropertyID: "status", Condition: "includes", Values: []string{"todo", "done"}}, true},
+		{"not includes", ViewFilter{PropertyID: "status", Condition: "notIncludes", Values: []string{"done"}}, false},
+		{"multi select includes", ViewFilter{PropertyID: "labels", Condition: "includes", Values: []string{"ui"}}, true},
+		{"is empty", ViewFilter{PropertyID: "unset", Condition: "isEmpty"}, true},
+		{"title contains", ViewFilter{PropertyID: "title", Condition: "contains", Values: []string{"LOGIN"}}, true},
+		{"title starts with", ViewFilter{PropertyID: "title", Condition: "startsWith", Values: []string{"login"}}, false},
+		{"date in range", ViewFilter{PropertyID: "due", Condition: "is", Values: []string{"2000"}}, true},
+		{"date after", ViewFilter{PropertyID: "due", Condition: "isAfter", Values: []string{"4000"}}, false},
+		{"linked card", ViewFilter{PropertyID: "related", Condition: "includes", Values: []string{"other-card"}}, true},
+		{
+			"and group",
+			ViewFilter{Operation: ViewFilterOperationAnd, Filters: []ViewFilter{
+				{PropertyID: "status", Condition: "includes", Values: []string{"done"}},
+				{PropertyID: "labels", Condition: "includes", Values: []string{"feature"}},
+			}},
+			false,
+		},
+		{
+			"or group",
+			ViewFilter{Operation: ViewFilterOperationOr, Filters: []ViewFilter{
+				{PropertyID: "status", Condition: "includes", Values: []string{"todo"}},
+				{PropertyID: "labels", Condition: "includes", Values: []string{"bug"}},
+			}},
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.matches, tc.filter.Matches(card, schema))
+		})
+	}
+}
+
+func TestParseViewFilter(t *testing.T) {
+	t.Run("view without a filter", func(t *testing.T) {
+		filter, err := ParseViewFilter(&Block{Type: TypeView})
+		require.NoError(t, err)
+		assert.Equal(t, ViewFilterOperationAnd, filter.Operation)
+		assert.True(t, filter.Matches(&Block{Type: TypeCard}, nil))
+	})
+
+	t.Run("view with a filter", func(t *testing.T) {
+		view := &Block{
+			Type: TypeView,
+			Fields: map[string]interface{}{
+				"filter": map[string]interface{}{
+					"operation": "or",
+					"filters": []interface{}{
+						map[string]interface{}{"propertyId": "status", "condition": "includes", "values": []interface{}{"done"}},
+					},
+				},
+			},
+		}
+		filter, err := ParseViewFilter(view)
+		require.NoError(t, err)
+		assert.Equal(t, ViewFilterOperationOr, filter.Operation)
+		require.Len(t, filter.Filters, 1)
+		assert.Equal(t, "status", filter.Filters[0].PropertyID)
+		assert.Equal(t, []string{"done"}, filter.Filters[0].Values)
+	})
+}
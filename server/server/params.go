@@ -6,6 +6,7 @@ package server
 import (
 	"fmt"
 
+	"github.com/mattermost/mattermost-plugin-boards/server/app"
 	"github.com/mattermost/mattermost-plugin-boards/server/model"
 	"github.com/mattermost/mattermost-plugin-boards/server/services/config"
 	"github.com/mattermost/mattermost-plugin-boards/server/services/notify"
@@ -27,6 +28,7 @@ type Params struct {
 	PermissionsService permissions.PermissionsService
 	ServicesAPI        model.ServicesAPI
 	IsPlugin           bool
+	NewMutexFn         app.MutexFactory
 }
 
 func (p Params) CheckValid() error {
@@ -4,6 +4,7 @@
 package server
 
 import (
+	"context"
 	"database/sql"
 	"fmt"
 	"net"
@@ -23,13 +24,18 @@ import (
 	appModel "github.com/mattermost/mattermost-plugin-boards/server/model"
 	"github.com/mattermost/mattermost-plugin-boards/server/services/audit"
 	"github.com/mattermost/mattermost-plugin-boards/server/services/config"
+	"github.com/mattermost/mattermost-plugin-boards/server/services/filescanner"
 	"github.com/mattermost/mattermost-plugin-boards/server/services/metrics"
 	"github.com/mattermost/mattermost-plugin-boards/server/services/notify"
 	"github.com/mattermost/mattermost-plugin-boards/server/services/notify/notifylogger"
 	"github.com/mattermost/mattermost-plugin-boards/server/services/scheduler"
 	"github.com/mattermost/mattermost-plugin-boards/server/services/store"
+	"github.com/mattermost/mattermost-plugin-boards/server/services/store/cachelayer"
 	"github.com/mattermost/mattermost-plugin-boards/server/services/store/sqlstore"
+	"github.com/mattermost/mattermost-plugin-boards/server/services/store/timerlayer"
+	"github.com/mattermost/mattermost-plugin-boards/server/services/store/tracinglayer"
 	"github.com/mattermost/mattermost-plugin-boards/server/services/telemetry"
+	"github.com/mattermost/mattermost-plugin-boards/server/services/tracing"
 	"github.com/mattermost/mattermost-plugin-boards/server/services/webhook"
 	"github.com/mattermost/mattermost-plugin-boards/server/utils"
 	"github.com/mattermost/mattermost-plugin-boards/server/web"
@@ -43,6 +49,8 @@ import (
 const (
 	cleanupSessionTaskFrequency = 10 * time.Minute
 	updateMetricsTaskFrequency  = 15 * time.Minute
+	auditCleanUpTaskFrequency   = 24 * time.Hour
+	tracingShutdownTimeout      = 5 * time.Second
 )
 
 type Server struct {
@@ -56,7 +64,10 @@ type Server struct {
 	cleanUpSessionsTask    *scheduler.ScheduledTask
 	metricsServer          *metrics.Service
 	metricsService         *metrics.Metrics
+	tracing                *tracing.Tracing
 	metricsUpdaterTask     *scheduler.ScheduledTask
+	fileGCTask             *scheduler.ScheduledTask
+	auditCleanUpTask       *scheduler.ScheduledTask
 	auditService           *audit.Audit
 	notificationService    *notify.Service
 	servicesStartStopMutex sync.Mutex
@@ -72,13 +83,40 @@ func New(params Params) (*Server, error) {
 		return nil, err
 	}
 
-	authenticator := auth.New(params.Cfg, params.DBStore, params.PermissionsService)
+	// Init metrics
+	instanceInfo := metrics.InstanceInfo{
+		Version:        appModel.CurrentVersion,
+		BuildNum:       appModel.BuildNumber,
+		Edition:        appModel.Edition,
+		InstallationID: os.Getenv("MM_CLOUD_INSTALLATION_ID"),
+	}
+	metricsService := metrics.NewMetrics(instanceInfo)
+	if cacheLayer, ok := params.DBStore.(*cachelayer.CacheLayer); ok {
+		cacheLayer.SetMetrics(metricsService)
+	}
+
+	// Init tracing
+	tracingService, err := tracing.New(params.Cfg, appModel.CurrentVersion)
+	if err != nil {
+		return nil, fmt.Errorf("unable to initialize tracing: %w", err)
+	}
+
+	// the store used by the services records the duration of each method, and a span for
+	// each call when tracing is enabled
+	var servicesStore store.Store = params.DBStore
+	if params.Cfg.TracingEnabled {
+		servicesStore = tracinglayer.New(servicesStore)
+	}
+	timedStore := timerlayer.New(servicesStore, metricsService)
+
+	authenticator := auth.New(params.Cfg, timedStore, params.PermissionsService)
 
 	// if no ws adapter is provided, we spin up a websocket server
 	wsAdapter := params.WSAdapter
 	if wsAdapter == nil {
-		wsAdapter = ws.NewServer(authenticator, params.Logger, params.DBStore)
+		wsAdapter = ws.NewServer(authenticator, params.Logger, timedStore)
 	}
+	registerWebsocketMetrics(wsAdapter, metricsService)
 
 	filesBackendSettings := filestore.FileBackendSettings{}
 	filesBackendSettings.DriverName = params.Cfg.FilesDriver
@@ -102,16 +140,12 @@ func New(params Params) (*Server, error) {
 		return nil, errors.New("unable to initialize the files storage")
 	}
 
-	webhookClient := webhook.NewClient(params.Cfg, params.Logger)
-
-	// Init metrics
-	instanceInfo := metrics.InstanceInfo{
-		Version:        appModel.CurrentVersion,
-		BuildNum:       appModel.BuildNumber,
-		Edition:        appModel.Edition,
-		InstallationID: os.Getenv("MM_CLOUD_INSTALLATION_ID"),
+	fileScanner, err := filescanner.New(params.Cfg)
+	if err != nil {
+		return nil, fmt.Errorf("unable to initialize the file scanner: %w", err)
 	}
-	metricsService := metrics.NewMetrics(instanceInfo)
+
+	webhookClient := webhook.NewClient(params.Cfg, params.Logger)
 
 	// Init audit
 	auditService, errAudit := audit.NewAudit()
@@ -123,15 +157,16 @@ func New(params Params) (*Server, error) {
 	}
 
 	// Init notification services
-	notificationService, errNotify := initNotificationService(params.NotifyBackends, params.Logger)
+	notificationService, errNotify := initNotificationService(params.NotifyBackends, params.Logger, metricsService)
 	if errNotify != nil {
 		return nil, fmt.Errorf("cannot initialize notification service(s): %w", errNotify)
 	}
 
 	appServices := app.Services{
 		Auth:             authenticator,
-		Store:            params.DBStore,
+		Store:            timedStore,
 		FilesBackend:     filesBackend,
+		FileScanner:      fileScanner,
 		Webhook:          webhookClient,
 		Metrics:          metricsService,
 		Notifications:    notificationService,
@@ -139,16 +174,25 @@ func New(params Params) (*Server, error) {
 		Permissions:      params.PermissionsService,
 		ServicesAPI:      params.ServicesAPI,
 		SkipTemplateInit: utils.IsRunningUnitTests(),
+		NewMutexFn:       params.NewMutexFn,
+	}
+	var appAdapter ws.Adapter = wsAdapter
+	if params.Cfg.TracingEnabled {
+		appAdapter = ws.NewTracingAdapter(wsAdapter)
+	}
+	app := app.New(params.Cfg, appAdapter, appServices)
+
+	if params.Cfg.AuditLogPersisted {
+		auditService.AddSink(app.NewAuditStoreSink())
 	}
-	app := app.New(params.Cfg, wsAdapter, appServices)
 
-	focalboardAPI := api.NewAPI(app, params.SingleUserToken, params.Cfg.AuthMode, params.PermissionsService, params.Logger, auditService)
+	focalboardAPI := api.NewAPI(app, params.SingleUserToken, params.Cfg.AuthMode, params.PermissionsService, params.Logger, auditService, metricsService)
 
 	// Local router for admin APIs
 	localRouter := mux.NewRouter()
 
 	// Init team
-	if _, err := app.GetRootTeam(); err != nil {
+	if _, err := app.GetRootTeam(context.Background()); err != nil {
 		params.Logger.Error("Unable to get root team", mlog.Err(err))
 		return nil, err
 	}
@@ -161,7 +205,7 @@ func New(params Params) (*Server, error) {
 	}
 	webServer.AddRoutes(focalboardAPI)
 
-	settings, err := params.DBStore.GetSystemSettings()
+	settings, err := params.DBStore.GetSystemSettings(context.Background())
 	if err != nil {
 		return nil, err
 	}
@@ -170,7 +214,7 @@ func New(params Params) (*Server, error) {
 	telemetryID := settings["TelemetryID"]
 	if len(telemetryID) == 0 {
 		telemetryID = utils.NewID(utils.IDTypeNone)
-		if err = params.DBStore.SetSystemSetting("TelemetryID", telemetryID); err != nil {
+		if err = params.DBStore.SetSystemSetting(context.Background(), "TelemetryID", telemetryID); err != nil {
 			return nil, err
 		}
 	}
@@ -193,6 +237,7 @@ func New(params Params) (*Server, error) {
 		telemetry:           telemetryService,
 		metricsServer:       metrics.NewMetricsServer(params.Cfg.PrometheusAddress, metricsService, params.Logger),
 		metricsService:      metricsService,
+		tracing:             tracingService,
 		auditService:        auditService,
 		notificationService: notificationService,
 		logger:              params.Logger,
@@ -220,6 +265,7 @@ func NewStore(config *config.Configuration, logger mlog.LoggerIFace) (store.Stor
 	storeParams := sqlstore.Params{
 		DBType:           config.DBType,
 		DBPingAttempts:   config.DBPingAttempts,
+		QueryTimeout:     time.Duration(config.DBQueryTimeout) * time.Second,
 		ConnectionString: config.DBConfigString,
 		TablePrefix:      config.DBTablePrefix,
 		Logger:           logger,
@@ -249,7 +295,7 @@ func (s *Server) Start() error {
 	}
 
 	metricsUpdater := func() {
-		blockCounts, err := s.store.GetBlockCountsByType()
+		blockCounts, err := s.store.GetBlockCountsByType(context.Background())
 		if err != nil {
 			s.logger.Error("Error updating metrics", mlog.String("group", "blocks"), mlog.Err(err))
 			return
@@ -258,14 +304,14 @@ func (s *Server) Start() error {
 		for blockType, count := range blockCounts {
 			s.metricsService.ObserveBlockCount(blockType, count)
 		}
-		boardCount, err := s.store.GetBoardCount(false)
+		boardCount, err := s.store.GetBoardCount(context.Background(), false)
 		if err != nil {
 			s.logger.Error("Error updating metrics", mlog.String("group", "boards"), mlog.Err(err))
 			return
 		}
 		s.logger.Debug("Board metrics collected", mlog.Int("board_count", boardCount))
 		s.metricsService.ObserveBoardCount(boardCount)
-		teamCount, err := s.store.GetTeamCount()
+		teamCount, err := s.store.GetTeamCount(context.Background())
 		if err != nil {
 			s.logger.Error("Error updating metrics", mlog.String("group", "teams"), mlog.Err(err))
 			return
@@ -276,6 +322,25 @@ func (s *Server) Start() error {
 	// metricsUpdater()   Calling this immediately causes integration unit tests to fail.
 	s.metricsUpdaterTask = scheduler.CreateRecurringTask("updateMetrics", metricsUpdater, updateMetricsTaskFrequency)
 
+	if s.config.FileGCFrequencyHours > 0 {
+		gracePeriod := time.Duration(s.config.FileGCGracePeriodHours) * time.Hour
+		fileGC := func() {
+			if _, err := s.app.CollectOrphanedFiles(context.Background(), gracePeriod, false); err != nil {
+				s.logger.Error("Error collecting orphaned files", mlog.Err(err))
+			}
+		}
+		s.fileGCTask = scheduler.CreateRecurringTask("collectOrphanedFiles", fileGC, time.Duration(s.config.FileGCFrequencyHours)*time.Hour)
+	}
+
+	if s.config.AuditLogPersisted && s.config.AuditLogRetentionDays > 0 {
+		auditCleanUp := func() {
+			if _, err := s.app.CleanUpAuditLog(context.Background()); err != nil {
+				s.logger.Error("Error cleaning up the audit log", mlog.Err(err))
+			}
+		}
+		s.auditCleanUpTask = scheduler.CreateRecurringTask("cleanUpAuditLog", auditCleanUp, auditCleanUpTaskFrequency)
+	}
+
 	if s.config.Telemetry {
 		firstRun := utils.GetMillis()
 		s.telemetry.RunTelemetryJob(firstRun)
@@ -317,6 +382,14 @@ func (s *Server) Shutdown() error {
 		s.metricsUpdaterTask.Cancel()
 	}
 
+	if s.fileGCTask != nil {
+		s.fileGCTask.Cancel()
+	}
+
+	if s.auditCleanUpTask != nil {
+		s.auditCleanUpTask.Cancel()
+	}
+
 	if err := s.telemetry.Shutdown(); err != nil {
 		s.logger.Warn("Error occurred when shutting down telemetry", mlog.Err(err))
 	}
@@ -331,6 +404,12 @@ func (s *Server) Shutdown() error {
 
 	s.app.Shutdown()
 
+	ctx, cancel := context.WithTimeout(context.Background(), tracingShutdownTimeout)
+	defer cancel()
+	if err := s.tracing.Shutdown(ctx); err != nil {
+		s.logger.Warn("Error occurred when shutting down tracing", mlog.Err(err))
+	}
+
 	defer s.logger.Info("Server.Shutdown")
 
 	return s.store.Shutdown()
@@ -439,29 +518,29 @@ func initTelemetry(opts telemetryOptions) *telemetry.Service {
 		m := make(map[string]interface{})
 		var count int
 		var err error
-		if count, err = opts.app.GetRegisteredUserCount(); err != nil {
+		if count, err = opts.app.GetRegisteredUserCount(context.Background()); err != nil {
 			return nil, err
 		}
 		m["registered_users"] = count
 
-		if count, err = opts.app.GetDailyActiveUsers(); err != nil {
+		if count, err = opts.app.GetDailyActiveUsers(context.Background()); err != nil {
 			return nil, err
 		}
 		m["daily_active_users"] = count
 
-		if count, err = opts.app.GetWeeklyActiveUsers(); err != nil {
+		if count, err = opts.app.GetWeeklyActiveUsers(context.Background()); err != nil {
 			return nil, err
 		}
 		m["weekly_active_users"] = count
 
-		if count, err = opts.app.GetMonthlyActiveUsers(); err != nil {
+		if count, err = opts.app.GetMonthlyActiveUsers(context.Background()); err != nil {
 			return nil, err
 		}
 		m["monthly_active_users"] = count
 		return m, nil
 	})
 	telemetryService.RegisterTracker("blocks", func() (telemetry.Tracker, error) {
-		blockCounts, err := opts.app.GetBlockCountsByType()
+		blockCounts, err := opts.app.GetBlockCountsByType(context.Background())
 		if err != nil {
 			return nil, err
 		}
@@ -472,7 +551,7 @@ func initTelemetry(opts telemetryOptions) *telemetry.Service {
 		return m, nil
 	})
 	telemetryService.RegisterTracker("boards", func() (telemetry.Tracker, error) {
-		boardCount, err := opts.app.GetBoardCount(false)
+		boardCount, err := opts.app.GetBoardCount(context.Background(), false)
 		if err != nil {
 			return nil, err
 		}
@@ -482,7 +561,7 @@ func initTelemetry(opts telemetryOptions) *telemetry.Service {
 		return m, nil
 	})
 	telemetryService.RegisterTracker("teams", func() (telemetry.Tracker, error) {
-		count, err := opts.app.GetTeamCount()
+		count, err := opts.app.GetTeamCount(context.Background())
 		if err != nil {
 			return nil, err
 		}
@@ -494,11 +573,24 @@ func initTelemetry(opts telemetryOptions) *telemetry.Service {
 	return telemetryService
 }
 
-func initNotificationService(backends []notify.Backend, logger mlog.LoggerIFace) (*notify.Service, error) {
+func initNotificationService(backends []notify.Backend, logger mlog.LoggerIFace, metricsService *metrics.Metrics) (*notify.Service, error) {
 	loggerBackend := notifylogger.New(logger, mlog.LvlDebug)
 
 	backends = append(backends, loggerBackend)
 
 	service, err := notify.New(logger, backends...)
+	if service != nil {
+		service.SetMetrics(metricsService)
+	}
 	return service, err
 }
+
+// registerWebsocketMetrics adds the gauge of the active listeners of the websocket adapter.
+func registerWebsocketMetrics(wsAdapter ws.Adapter, metricsService *metrics.Metrics) {
+	switch adapter := wsAdapter.(type) {
+	case *ws.Server:
+		metricsService.RegisterWebsocketListeners("server", adapter.ListenerCount)
+	case *ws.PluginAdapter:
+		metricsService.RegisterWebsocketListeners("plugin", adapter.ListenerCount)
+	}
+}
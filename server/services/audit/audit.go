@@ -19,6 +19,7 @@ const (
 	KeyIPAddress = "ip_address"
 	KeyClusterID = "cluster_id"
 	KeyTeamID    = "team_id"
+	KeyTraceID   = "trace_id"
 
 	Success = "success"
 	Attempt = "attempt"
@@ -31,9 +32,15 @@ var (
 	LevelRead   = mlog.Level{ID: 1002, Name: "read"}
 )
 
+// Sink receives every audit record, in addition to the log targets of the audit service.
+type Sink interface {
+	WriteRecord(level mlog.Level, rec *Record)
+}
+
 // Audit provides auditing service.
 type Audit struct {
 	auditLogger *mlog.Logger
+	sinks       []Sink
 }
 
 // NewAudit creates a new Audit instance which can be configured via `(*Audit).Configure`.
@@ -61,6 +68,12 @@ func (a *Audit) Configure(cfgFile string, cfgEscaped string) error {
 	return a.auditLogger.Configure(cfgFile, cfgEscaped, nil)
 }
 
+// AddSink adds a sink receiving every record logged. Sinks must be added before records are
+// logged.
+func (a *Audit) AddSink(sink Sink) {
+	a.sinks = append(a.sinks, sink)
+}
+
 // Shutdown shuts down the audit service after making best efforts to flush any
 // remaining records.
 func (a *Audit) Shutdown() error {
@@ -84,4 +97,8 @@ func (a *Audit) LogRecord(level mlog.Level, rec *Record) {
 	}
 
 	a.auditLogger.Log(level, "audit "+rec.Event, fields...)
+
+	for _, sink := range a.sinks {
+		sink.WriteRecord(level, rec)
+	}
 }
@@ -0,0 +1,38 @@
+// Copyright (c) 2020-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package audit
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/mattermost/mattermost/server/public/shared/mlog"
+)
+
+type recordingSink struct {
+	levels  []mlog.Level
+	records []*Record
+}
+
+func (s *recordingSink) WriteRecord(level mlog.Level, rec *Record) {
+	s.levels = append(s.levels, level)
+	s.records = append(s.records, rec)
+}
+
+func TestAudit_AddSink(t *testing.T) {
+	audit, err := NewAudit()
+	require.NoError(t, err)
+	defer func() { require.NoError(t, audit.Shutdown()) }()
+
+	sink := &recordingSink{}
+	audit.AddSink(sink)
+
+	rec := &Record{Event: "patchBlock", Status: Success}
+	audit.LogRecord(LevelModify, rec)
+
+	require.Len(t, sink.records, 1)
+	require.Equal(t, rec, sink.records[0])
+	require.Equal(t, LevelModify, sink.levels[0])
+}
@@ -0,0 +1,229 @@
+// Copyright (c) 2020-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package blocksuite
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"math"
+)
+
+const (
+	// MaxSnapshotSize is the size of the largest snapshot that is decoded.
+	MaxSnapshotSize = 16 * 1024 * 1024
+
+	// maxNodes is the largest number of clock units a decoded snapshot may contain. Deleted and
+	// garbage collected content is encoded by its length only, so it is not bounded by the size of
+	// the snapshot.
+	maxNodes = 1 << 20
+
+	// maxAnyDepth is the deepest nesting of objects and arrays read by readAny.
+	maxAnyDepth = 64
+
+	// maxConflictSteps is the largest number of concurrent insertions visited while integrating
+	// the nodes of a snapshot.
+	maxConflictSteps = 16 * maxNodes
+)
+
+var (
+	ErrUnexpectedEOF    = errors.New("unexpected end of snapshot")
+	ErrVarIntTooLong    = errors.New("variable length integer too long")
+	ErrInvalidCount     = errors.New("count exceeds the remaining snapshot")
+	ErrSnapshotTooLarge = errors.New("snapshot too large")
+	ErrNestingTooDeep   = errors.New("value nested too deeply")
+	ErrDuplicateStruct  = errors.New("duplicate struct")
+)
+
+// decoder reads the lib0 binary encoding used by Yjs.
+type decoder struct {
+	buf []byte
+	pos int
+}
+
+func newDecoder(buf []byte) *decoder {
+	return &decoder{buf: buf}
+}
+
+func (d *decoder) hasContent() bool {
+	return d.pos < len(d.buf)
+}
+
+// remaining returns the number of bytes left to read.
+func (d *decoder) remaining() uint64 {
+	return uint64(len(d.buf) - d.pos)
+}
+
+// readCount reads the number of elements that follow. Every element takes at least one byte, so
+// a count larger than the remaining bytes is invalid.
+func (d *decoder) readCount() (uint64, error) {
+	n, err := d.readVarUint()
+	if err != nil {
+		return 0, err
+	}
+	if n > d.remaining() {
+		return 0, ErrInvalidCount
+	}
+	return n, nil
+}
+
+func (d *decoder) readUint8() (byte, error) {
+	if d.pos >= len(d.buf) {
+		return 0, ErrUnexpectedEOF
+	}
+	b := d.buf[d.pos]
+	d.pos++
+	return b, nil
+}
+
+func (d *decoder) readBytes(n uint64) ([]byte, error) {
+	if n > uint64(len(d.buf)-d.pos) {
+		return nil, ErrUnexpectedEOF
+	}
+	b := d.buf[d.pos : d.pos+int(n)]
+	d.pos += int(n)
+	return b, nil
+}
+
+func (d *decoder) readVarUint() (uint64, error) {
+	var num uint64
+	var shift uint
+	for {
+		b, err := d.readUint8()
+		if err != nil {
+			return 0, err
+		}
+		if shift > 63 {
+			return 0, ErrVarIntTooLong
+		}
+		num |= uint64(b&0x7f) << shift
+		if b < 0x80 {
+			return num, nil
+		}
+		shift += 7
+	}
+}
+
+// readVarInt reads a signed integer. The first byte holds a continuation bit, a sign bit and
+// six bits of the value.
+func (d *decoder) readVarInt() (int64, error) {
+	b, err := d.readUint8()
+	if err != nil {
+		return 0, err
+	}
+	num := uint64(b & 0x3f)
+	negative := b&0x40 != 0
+	shift := uint(6)
+	for b&0x80 != 0 {
+		if b, err = d.readUint8(); err != nil {
+			return 0, err
+		}
+		if shift > 63 {
+			return 0, ErrVarIntTooLong
+		}
+		num |= uint64(b&0x7f) << shift
+		shift += 7
+	}
+	if negative {
+		return -int64(num), nil
+	}
+	return int64(num), nil
+}
+
+func (d *decoder) readVarBytes() ([]byte, error) {
+	n, err := d.readVarUint()
+	if err != nil {
+		return nil, err
+	}
+	return d.readBytes(n)
+}
+
+func (d *decoder) readVarString() (string, error) {
+	b, err := d.readVarBytes()
+	if err != nil {
+		return "", err
+	}
+	return string(b), nil
+}
+
+// readAny reads a value encoded with lib0's `writeAny`.
+func (d *decoder) readAny() (interface{}, error) {
+	return d.readAnyDepth(0)
+}
+
+func (d *decoder) readAnyDepth(depth int) (interface{}, error) {
+	if depth > maxAnyDepth {
+		return nil, ErrNestingTooDeep
+	}
+
+	t, err := d.readUint8()
+	if err != nil {
+		return nil, err
+	}
+
+	switch t {
+	case 127, 126: // undefined, null
+		return nil, nil
+	case 125: // integer
+		return d.readVarInt()
+	case 124: // float32
+		b, err := d.readBytes(4)
+		if err != nil {
+			return nil, err
+		}
+		return float64(math.Float32frombits(binary.BigEndian.Uint32(b))), nil
+	case 123: // float64
+		b, err := d.readBytes(8)
+		if err != nil {
+			return nil, err
+		}
+		return math.Float64frombits(binary.BigEndian.Uint64(b)), nil
+	case 122: // bigint
+		b, err := d.readBytes(8)
+		if err != nil {
+			return nil, err
+		}
+		return int64(binary.BigEndian.Uint64(b)), nil
+	case 121:
+		return false, nil
+	case 120:
+		return true, nil
+	case 119:
+		return d.readVarString()
+	case 118: // object
+		n, err := d.readCount()
+		if err != nil {
+			return nil, err
+		}
+		obj := make(map[string]interface{})
+		for i := uint64(0); i < n; i++ {
+			key, err := d.readVarString()
+			if err != nil {
+				return nil, err
+			}
+			if obj[key], err = d.readAnyDepth(depth + 1); err != nil {
+				return nil, err
+			}
+		}
+		return obj, nil
+	case 117: // array
+		n, err := d.readCount()
+		if err != nil {
+			return nil, err
+		}
+		arr := make([]interface{}, 0, n)
+		for i := uint64(0); i < n; i++ {
+			v, err := d.readAnyDepth(depth + 1)
+			if err != nil {
+				return nil, err
+			}
+			arr = append(arr, v)
+		}
+		return arr, nil
+	case 116: // Uint8Array
+		return d.readVarBytes()
+	default:
+		return nil, fmt.Errorf("unknown value type %d", t)
+	}
+}
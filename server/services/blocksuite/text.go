@@ -0,0 +1,48 @@
+// Copyright (c) 2020-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package blocksuite
+
+import (
+	"strings"
+	"unicode/utf16"
+)
+
+// ExtractText decodes a BlockSuite document snapshot (a Yjs update) and returns the plain text of
+// every live Y.Text it contains. In BlockSuite each paragraph-like block owns one Y.Text, so each
+// returned string corresponds to one block. Empty texts are omitted.
+func ExtractText(snapshot []byte) ([]string, error) {
+	doc, err := decodeUpdate(snapshot)
+	if err != nil {
+		return nil, err
+	}
+
+	var paragraphs []string
+	for _, n := range doc.nodes {
+		if n.contentRef != refType || n.deleted || n.dropped {
+			continue
+		}
+		if n.typeRef != typeRefText && n.typeRef != typeRefXMLText {
+			continue
+		}
+
+		seq, ok := doc.sequences["type:"+n.id.key()]
+		if !ok {
+			continue
+		}
+		if text := seq.text(); strings.TrimSpace(text) != "" {
+			paragraphs = append(paragraphs, text)
+		}
+	}
+	return paragraphs, nil
+}
+
+func (s *sequence) text() string {
+	var units []uint16
+	for n := s.start; n != nil; n = n.right {
+		if n.hasText() && !n.deleted {
+			units = append(units, n.unit)
+		}
+	}
+	return string(utf16.Decode(units))
+}
@@ -0,0 +1,231 @@
+// Copyright (c) 2020-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package blocksuite
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// updateBuilder writes Yjs updates (v1) for tests.
+type updateBuilder struct {
+	buf []byte
+}
+
+func (b *updateBuilder) uint8(v byte) *updateBuilder {
+	b.buf = append(b.buf, v)
+	return b
+}
+
+func (b *updateBuilder) varUint(v uint64) *updateBuilder {
+	for v >= 0x80 {
+		b.buf = append(b.buf, byte(v)|0x80)
+		v >>= 7
+	}
+	b.buf = append(b.buf, byte(v))
+	return b
+}
+
+func (b *updateBuilder) str(s string) *updateBuilder {
+	b.varUint(uint64(len(s)))
+	b.buf = append(b.buf, s...)
+	return b
+}
+
+func (b *updateBuilder) id(client, clock uint64) *updateBuilder {
+	return b.varUint(client).varUint(clock)
+}
+
+// paragraphDoc writes the structs of client 1 for a BlockSuite-like document holding a single
+// paragraph block whose text is inserted in two steps.
+func paragraphDoc(b *updateBuilder, first, second string) {
+	b.varUint(4).varUint(1).varUint(0)
+	// blocks["b1"] = Y.Map
+	b.uint8(refType | bit6).varUint(1).str("blocks").str("b1").varUint(1)
+	// blocks["b1"]["prop:text"] = Y.Text
+	b.uint8(refType|bit6).varUint(0).id(1, 0).str("prop:text").varUint(typeRefText)
+	// text.insert(0, first)
+	b.uint8(refString).varUint(0).id(1, 1).str(first)
+	// text.insert(len(first), second)
+	b.uint8(refString|bit8).id(1, uint64(1+len(first))).str(second)
+}
+
+func TestExtractText(t *testing.T) {
+	t.Run("empty snapshot", func(t *testing.T) {
+		paragraphs, err := ExtractText(nil)
+		require.NoError(t, err)
+		assert.Empty(t, paragraphs)
+
+		paragraphs, err = ExtractText([]byte{0, 0})
+		require.NoError(t, err)
+		assert.Empty(t, paragraphs)
+	})
+
+	t.Run("single paragraph", func(t *testing.T) {
+		b := &updateBuilder{}
+		b.varUint(1)
+		paragraphDoc(b, "hello @alice", " and @bob")
+		b.varUint(0)
+
+		paragraphs, err := ExtractText(b.buf)
+		require.NoError(t, err)
+		assert.Equal(t, []string{"hello @alice and @bob"}, paragraphs)
+	})
+
+	t.Run("deleted text", func(t *testing.T) {
+		b := &updateBuilder{}
+		b.varUint(1)
+		paragraphDoc(b, "hello @alice", " and @bob")
+		// delete "@alice"
+		b.varUint(1).varUint(1).varUint(1).varUint(8).varUint(6)
+
+		paragraphs, err := ExtractText(b.buf)
+		require.NoError(t, err)
+		assert.Equal(t, []string{"hello  and @bob"}, paragraphs)
+	})
+
+	t.Run("insert by another client inside an item", func(t *testing.T) {
+		b := &updateBuilder{}
+		b.varUint(2)
+		paragraphDoc(b, "hello @alice", " and @bob")
+		// client 2 inserts "@carol " between "hello " and "@alice"
+		b.varUint(1).varUint(2).varUint(0)
+		b.uint8(refString|bit8|bit7).id(1, 7).id(1, 8).str("@carol ")
+		b.varUint(0)
+
+		paragraphs, err := ExtractText(b.buf)
+		require.NoError(t, err)
+		assert.Equal(t, []string{"hello @carol @alice and @bob"}, paragraphs)
+	})
+
+	t.Run("deleted block", func(t *testing.T) {
+		b := &updateBuilder{}
+		b.varUint(1)
+		paragraphDoc(b, "hello @alice", " and @bob")
+		// delete the whole block
+		b.varUint(1).varUint(1).varUint(1).varUint(0).varUint(23)
+
+		paragraphs, err := ExtractText(b.buf)
+		require.NoError(t, err)
+		assert.Empty(t, paragraphs)
+	})
+
+	t.Run("truncated snapshot", func(t *testing.T) {
+		b := &updateBuilder{}
+		b.varUint(1)
+		paragraphDoc(b, "hello @alice", " and @bob")
+
+		_, err := ExtractText(b.buf[:len(b.buf)-4])
+		require.ErrorIs(t, err, ErrUnexpectedEOF)
+	})
+}
+
+func TestExtractTextMalformed(t *testing.T) {
+	t.Run("every truncation", func(t *testing.T) {
+		b := &updateBuilder{}
+		b.varUint(1)
+		paragraphDoc(b, "hello @alice", " and @bob")
+		b.varUint(1).varUint(1).varUint(1).varUint(8).varUint(6)
+
+		for i := range b.buf {
+			_, _ = ExtractText(b.buf[:i])
+		}
+	})
+
+	t.Run("client count larger than the snapshot", func(t *testing.T) {
+		b := &updateBuilder{}
+		b.varUint(1 << 40)
+
+		_, err := ExtractText(b.buf)
+		require.ErrorIs(t, err, ErrInvalidCount)
+	})
+
+	t.Run("struct count larger than the snapshot", func(t *testing.T) {
+		b := &updateBuilder{}
+		b.varUint(1).varUint(1 << 40).varUint(1).varUint(0)
+
+		_, err := ExtractText(b.buf)
+		require.ErrorIs(t, err, ErrInvalidCount)
+	})
+
+	t.Run("any content count larger than the snapshot", func(t *testing.T) {
+		b := &updateBuilder{}
+		b.varUint(1).varUint(1).varUint(1).varUint(0)
+		b.uint8(refAny).varUint(1).str("root").varUint(1 << 40)
+
+		_, err := ExtractText(b.buf)
+		require.ErrorIs(t, err, ErrInvalidCount)
+	})
+
+	t.Run("deleted content longer than the node limit", func(t *testing.T) {
+		b := &updateBuilder{}
+		b.varUint(1).varUint(1).varUint(1).varUint(0)
+		b.uint8(refDeleted).varUint(1).str("root").varUint(1 << 62)
+
+		_, err := ExtractText(b.buf)
+		require.ErrorIs(t, err, ErrSnapshotTooLarge)
+	})
+
+	t.Run("deleted ranges beyond the structs", func(t *testing.T) {
+		b := &updateBuilder{}
+		b.varUint(1)
+		paragraphDoc(b, "hello @alice", " and @bob")
+		// a garbage collected range moves the clock far ahead of the nodes.
+		b.buf[1] = 5
+		b.uint8(refGC).varUint(1 << 60)
+		b.varUint(1).varUint(1).varUint(1).varUint(0).varUint(1<<63 - 1)
+
+		paragraphs, err := ExtractText(b.buf)
+		require.NoError(t, err)
+		assert.Empty(t, paragraphs)
+	})
+
+	t.Run("overlapping deleted ranges", func(t *testing.T) {
+		b := &updateBuilder{}
+		b.varUint(1).varUint(1).varUint(1).varUint(0)
+		b.uint8(refDeleted).varUint(1).str("root").varUint(maxNodes)
+		b.varUint(1).varUint(1).varUint(100)
+		for i := 0; i < 100; i++ {
+			b.varUint(0).varUint(maxNodes)
+		}
+
+		_, err := ExtractText(b.buf)
+		require.ErrorIs(t, err, ErrSnapshotTooLarge)
+	})
+
+	t.Run("deeply nested value", func(t *testing.T) {
+		b := &updateBuilder{}
+		b.varUint(1).varUint(1).varUint(1).varUint(0)
+		b.uint8(refAny).varUint(1).str("root").varUint(1)
+		for i := 0; i <= maxAnyDepth; i++ {
+			b.uint8(117).varUint(1)
+		}
+		b.uint8(127)
+
+		_, err := ExtractText(b.buf)
+		require.ErrorIs(t, err, ErrNestingTooDeep)
+	})
+
+	t.Run("snapshot too large", func(t *testing.T) {
+		_, err := ExtractText(make([]byte, MaxSnapshotSize+1))
+		require.ErrorIs(t, err, ErrSnapshotTooLarge)
+	})
+}
+
+func FuzzExtractText(f *testing.F) {
+	b := &updateBuilder{}
+	b.varUint(2)
+	paragraphDoc(b, "hello @alice", " and @bob")
+	b.varUint(1).varUint(2).varUint(0)
+	b.uint8(refString|bit8|bit7).id(1, 7).id(1, 8).str("@carol ")
+	b.varUint(1).varUint(1).varUint(1).varUint(8).varUint(6)
+
+	f.Add(b.buf)
+	f.Add([]byte{1, 1, 1, 0, refDeleted, 1, 4, 'r', 'o', 'o', 't', 0xff, 0xff, 0x7f})
+	f.Fuzz(func(t *testing.T, snapshot []byte) {
+		_, _ = ExtractText(snapshot)
+	})
+}
@@ -0,0 +1,520 @@
+// Copyright (c) 2020-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package blocksuite
+
+import (
+	"fmt"
+	"sort"
+	"unicode/utf16"
+)
+
+const (
+	bit6  = 0x20
+	bit7  = 0x40
+	bit8  = 0x80
+	bits5 = 0x1f
+)
+
+// struct and content refs from the Yjs update format (v1).
+const (
+	refGC          = 0
+	refDeleted     = 1
+	refJSON        = 2
+	refBinary      = 3
+	refString      = 4
+	refEmbed       = 5
+	refFormat      = 6
+	refType        = 7
+	refAny         = 8
+	refDoc         = 9
+	refSkip        = 10
+	typeRefText    = 2
+	typeRefXMLElem = 3
+	typeRefXMLHook = 5
+	typeRefXMLText = 6
+)
+
+type id struct {
+	client uint64
+	clock  uint64
+}
+
+func (i id) key() string {
+	return fmt.Sprintf("%d:%d", i.client, i.clock)
+}
+
+// node is a single clock unit of an item. String items are exploded into one node per UTF-16
+// code unit so that origins pointing into the middle of an item resolve without splitting.
+type node struct {
+	id          id
+	origin      *id
+	rightOrigin *id
+
+	// explicit parent info; empty when it is copied from the origin.
+	parentName string
+	parentID   *id
+	parentSub  string
+
+	contentRef byte
+	typeRef    uint64
+	unit       uint16
+	deleted    bool
+
+	// resolved during integration.
+	parent      string
+	dropped     bool
+	integrated  bool
+	visiting    bool
+	left, right *node
+}
+
+func (n *node) hasText() bool {
+	return n.contentRef == refString
+}
+
+// sequence is the ordered list of nodes sharing a parent.
+type sequence struct {
+	start *node
+}
+
+// document is a decoded Yjs update with its sequences integrated.
+type document struct {
+	nodes     []*node
+	byID      map[id]*node
+	sequences map[string]*sequence
+
+	// the number of concurrent insertions visited while integrating.
+	conflictSteps int
+}
+
+// decodeUpdate decodes a Yjs update as produced by `Y.encodeStateAsUpdate`.
+func decodeUpdate(buf []byte) (*document, error) {
+	doc := &document{
+		byID:      make(map[id]*node),
+		sequences: make(map[string]*sequence),
+	}
+	if len(buf) == 0 {
+		return doc, nil
+	}
+	if len(buf) > MaxSnapshotSize {
+		return nil, ErrSnapshotTooLarge
+	}
+
+	d := newDecoder(buf)
+	if err := doc.readStructs(d); err != nil {
+		return nil, fmt.Errorf("cannot decode structs: %w", err)
+	}
+
+	sort.Slice(doc.nodes, func(i, j int) bool {
+		if doc.nodes[i].id.client != doc.nodes[j].id.client {
+			return doc.nodes[i].id.client < doc.nodes[j].id.client
+		}
+		return doc.nodes[i].id.clock < doc.nodes[j].id.clock
+	})
+
+	if err := doc.readDeleteSet(d); err != nil {
+		return nil, fmt.Errorf("cannot decode delete set: %w", err)
+	}
+	if err := doc.integrateAll(); err != nil {
+		return nil, fmt.Errorf("cannot integrate structs: %w", err)
+	}
+	return doc, nil
+}
+
+func (doc *document) readStructs(d *decoder) error {
+	numClients, err := d.readCount()
+	if err != nil {
+		return err
+	}
+
+	for i := uint64(0); i < numClients; i++ {
+		numStructs, err := d.readCount()
+		if err != nil {
+			return err
+		}
+		client, err := d.readVarUint()
+		if err != nil {
+			return err
+		}
+		clock, err := d.readVarUint()
+		if err != nil {
+			return err
+		}
+
+		for j := uint64(0); j < numStructs; j++ {
+			length, err := doc.readStruct(d, id{client: client, clock: clock})
+			if err != nil {
+				return err
+			}
+			if clock+length < clock {
+				return ErrInvalidCount
+			}
+			clock += length
+		}
+	}
+	return nil
+}
+
+func readID(d *decoder) (*id, error) {
+	client, err := d.readVarUint()
+	if err != nil {
+		return nil, err
+	}
+	clock, err := d.readVarUint()
+	if err != nil {
+		return nil, err
+	}
+	return &id{client: client, clock: clock}, nil
+}
+
+// readStruct reads a single struct and returns the number of clock units it occupies.
+func (doc *document) readStruct(d *decoder, start id) (uint64, error) {
+	info, err := d.readUint8()
+	if err != nil {
+		return 0, err
+	}
+
+	switch info & bits5 {
+	case refGC, refSkip:
+		// garbage collected or skipped ranges carry no content.
+		return d.readVarUint()
+	}
+
+	tmpl := node{contentRef: info & bits5}
+	if info&bit8 != 0 {
+		if tmpl.origin, err = readID(d); err != nil {
+			return 0, err
+		}
+	}
+	if info&bit7 != 0 {
+		if tmpl.rightOrigin, err = readID(d); err != nil {
+			return 0, err
+		}
+	}
+	if info&(bit7|bit8) == 0 {
+		hasParentKey, err := d.readVarUint()
+		if err != nil {
+			return 0, err
+		}
+		if hasParentKey == 1 {
+			if tmpl.parentName, err = d.readVarString(); err != nil {
+				return 0, err
+			}
+		} else if tmpl.parentID, err = readID(d); err != nil {
+			return 0, err
+		}
+		if info&bit6 != 0 {
+			if tmpl.parentSub, err = d.readVarString(); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	units, length, err := readContent(d, &tmpl)
+	if err != nil {
+		return 0, err
+	}
+	if length > maxNodes-uint64(len(doc.nodes)) {
+		return 0, ErrSnapshotTooLarge
+	}
+
+	for i := uint64(0); i < length; i++ {
+		n := tmpl
+		n.id = id{client: start.client, clock: start.clock + i}
+		if i > 0 {
+			// every unit after the first is inserted right after its predecessor.
+			n.origin = &id{client: start.client, clock: start.clock + i - 1}
+		}
+		if units != nil {
+			n.unit = units[i]
+		}
+		if _, ok := doc.byID[n.id]; ok {
+			return 0, ErrDuplicateStruct
+		}
+		doc.nodes = append(doc.nodes, &n)
+		doc.byID[n.id] = &n
+	}
+	return length, nil
+}
+
+// readContent reads the content of an item and returns the UTF-16 code units for string content
+// and the number of clock units the content occupies.
+func readContent(d *decoder, n *node) ([]uint16, uint64, error) {
+	switch n.contentRef {
+	case refDeleted:
+		n.deleted = true
+		length, err := d.readVarUint()
+		return nil, length, err
+	case refJSON:
+		length, err := d.readCount()
+		if err != nil {
+			return nil, 0, err
+		}
+		for i := uint64(0); i < length; i++ {
+			if _, err := d.readVarString(); err != nil {
+				return nil, 0, err
+			}
+		}
+		return nil, length, nil
+	case refBinary:
+		_, err := d.readVarBytes()
+		return nil, 1, err
+	case refString:
+		s, err := d.readVarString()
+		if err != nil {
+			return nil, 0, err
+		}
+		units := utf16.Encode([]rune(s))
+		return units, uint64(len(units)), nil
+	case refEmbed:
+		_, err := d.readVarString()
+		return nil, 1, err
+	case refFormat:
+		if _, err := d.readVarString(); err != nil {
+			return nil, 0, err
+		}
+		_, err := d.readVarString()
+		return nil, 1, err
+	case refType:
+		typeRef, err := d.readVarUint()
+		if err != nil {
+			return nil, 0, err
+		}
+		n.typeRef = typeRef
+		if typeRef == typeRefXMLElem || typeRef == typeRefXMLHook {
+			if _, err := d.readVarString(); err != nil {
+				return nil, 0, err
+			}
+		}
+		return nil, 1, nil
+	case refAny:
+		length, err := d.readCount()
+		if err != nil {
+			return nil, 0, err
+		}
+		for i := uint64(0); i < length; i++ {
+			if _, err := d.readAny(); err != nil {
+				return nil, 0, err
+			}
+		}
+		return nil, length, nil
+	case refDoc:
+		if _, err := d.readVarString(); err != nil {
+			return nil, 0, err
+		}
+		_, err := d.readAny()
+		return nil, 1, err
+	default:
+		return nil, 0, fmt.Errorf("unknown content ref %d", n.contentRef)
+	}
+}
+
+func (doc *document) readDeleteSet(d *decoder) error {
+	if !d.hasContent() {
+		return nil
+	}
+
+	numClients, err := d.readCount()
+	if err != nil {
+		return err
+	}
+
+	// ranges deleted twice would otherwise allow visiting the same nodes over and over.
+	var visited uint64
+	for i := uint64(0); i < numClients; i++ {
+		client, err := d.readVarUint()
+		if err != nil {
+			return err
+		}
+		numDeletes, err := d.readCount()
+		if err != nil {
+			return err
+		}
+		for j := uint64(0); j < numDeletes; j++ {
+			clock, err := d.readVarUint()
+			if err != nil {
+				return err
+			}
+			length, err := d.readVarUint()
+			if err != nil {
+				return err
+			}
+			// the ranges are not bounded by the structs read, so only the nodes within them are
+			// visited.
+			start := sort.Search(len(doc.nodes), func(k int) bool {
+				n := doc.nodes[k]
+				return n.id.client > client || (n.id.client == client && n.id.clock >= clock)
+			})
+			for _, n := range doc.nodes[start:] {
+				if n.id.client != client || n.id.clock-clock >= length {
+					break
+				}
+				if visited++; visited > maxNodes {
+					return ErrSnapshotTooLarge
+				}
+				n.deleted = true
+			}
+		}
+	}
+	return nil
+}
+
+// integrateAll integrates every node into its parent sequence, integrating the nodes it depends
+// on first.
+func (doc *document) integrateAll() error {
+	for _, n := range doc.nodes {
+		stack := []*node{n}
+		for len(stack) > 0 {
+			top := stack[len(stack)-1]
+			if top.integrated {
+				stack = stack[:len(stack)-1]
+				continue
+			}
+			top.visiting = true
+			if dep := doc.pendingDependency(top); dep != nil {
+				stack = append(stack, dep)
+				continue
+			}
+			if err := doc.integrate(top); err != nil {
+				return err
+			}
+			top.visiting = false
+			stack = stack[:len(stack)-1]
+		}
+	}
+	return nil
+}
+
+// pendingDependency returns a node that must be integrated before n, if any.
+func (doc *document) pendingDependency(n *node) *node {
+	deps := []*id{n.origin, n.rightOrigin, n.parentID}
+	if n.id.clock > 0 {
+		deps = append(deps, &id{client: n.id.client, clock: n.id.clock - 1})
+	}
+	for _, depID := range deps {
+		if depID == nil {
+			continue
+		}
+		if dep, ok := doc.byID[*depID]; ok && !dep.integrated && !dep.visiting {
+			return dep
+		}
+	}
+	return nil
+}
+
+func (doc *document) integrate(n *node) error {
+	n.integrated = true
+
+	// origins that are not integrated yet only occur with cyclic dependencies, which a valid
+	// update does not have. Linking to them would corrupt the sequences.
+	var left, right *node
+	if n.origin != nil {
+		if left = doc.byID[*n.origin]; left == nil || left.dropped || left == n || !left.integrated {
+			// origin was garbage collected; the content is gone too.
+			n.dropped = true
+			return nil
+		}
+	}
+	if n.rightOrigin != nil {
+		if right = doc.byID[*n.rightOrigin]; right == nil || right.dropped || right == n || !right.integrated {
+			n.dropped = true
+			return nil
+		}
+	}
+
+	switch {
+	case n.parentName != "":
+		n.parent = "root:" + n.parentName
+	case n.parentID != nil:
+		p, ok := doc.byID[*n.parentID]
+		if !ok || p.dropped {
+			n.dropped = true
+			return nil
+		}
+		n.parent = "type:" + p.id.key()
+	case left != nil:
+		n.parent, n.parentSub = left.parent, left.parentSub
+	case right != nil:
+		n.parent, n.parentSub = right.parent, right.parentSub
+	default:
+		n.dropped = true
+		return nil
+	}
+
+	if n.parentSub != "" {
+		// map entries are not part of a sequence.
+		return nil
+	}
+
+	seq, ok := doc.sequences[n.parent]
+	if !ok {
+		seq = &sequence{}
+		doc.sequences[n.parent] = seq
+	}
+
+	// resolve conflicts with concurrently inserted nodes, as done by Yjs' Item.integrate.
+	if (left != nil && left.right != right) || (left == nil && (right == nil || right != seq.start)) {
+		o := seq.start
+		if left != nil {
+			o = left.right
+		}
+		conflicting := make(map[*node]struct{})
+		beforeOrigin := make(map[*node]struct{})
+		for o != nil && o != right {
+			if doc.conflictSteps++; doc.conflictSteps > maxConflictSteps {
+				return ErrSnapshotTooLarge
+			}
+			beforeOrigin[o] = struct{}{}
+			conflicting[o] = struct{}{}
+			if sameID(n.origin, o.origin) {
+				if o.id.client < n.id.client {
+					left = o
+					conflicting = make(map[*node]struct{})
+				} else if sameID(n.rightOrigin, o.rightOrigin) {
+					break
+				}
+			} else if oOrigin := doc.nodeFor(o.origin); oOrigin != nil && contains(beforeOrigin, oOrigin) {
+				if !contains(conflicting, oOrigin) {
+					left = o
+					conflicting = make(map[*node]struct{})
+				}
+			} else {
+				break
+			}
+			o = o.right
+		}
+	}
+
+	n.left = left
+	if left != nil {
+		n.right = left.right
+		left.right = n
+	} else {
+		n.right = seq.start
+		seq.start = n
+	}
+	if n.right != nil {
+		n.right.left = n
+	}
+	return nil
+}
+
+func (doc *document) nodeFor(i *id) *node {
+	if i == nil {
+		return nil
+	}
+	return doc.byID[*i]
+}
+
+func sameID(a, b *id) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return *a == *b
+}
+
+func contains(set map[*node]struct{}, n *node) bool {
+	_, ok := set[n]
+	return ok
+}
@@ -13,6 +13,9 @@ const (
 	DefaultServerRoot = "http://localhost:8000"
 	DefaultPort       = 8000
 	DBPingAttempts    = 5
+	DBQueryTimeout    = 60 // seconds
+	CacheSize         = 10000
+	CacheTTL          = 60 // seconds
 )
 
 type AmazonS3Config struct {
@@ -36,6 +39,7 @@ type Configuration struct {
 	DBType                   string            `json:"dbtype" mapstructure:"dbtype"`
 	DBConfigString           string            `json:"dbconfig" mapstructure:"dbconfig"`
 	DBPingAttempts           int               `json:"dbpingattempts" mapstructure:"dbpingattempts"`
+	DBQueryTimeout           int               `json:"dbquerytimeout" mapstructure:"dbquerytimeout"`
 	DBTablePrefix            string            `json:"dbtableprefix" mapstructure:"dbtableprefix"`
 	UseSSL                   bool              `json:"useSSL" mapstructure:"useSSL"`
 	SecureCookie             bool              `json:"secureCookie" mapstructure:"secureCookie"`
@@ -70,8 +74,34 @@ type Configuration struct {
 	AuditCfgFile string `json:"audit_cfg_file" mapstructure:"audit_cfg_file"`
 	AuditCfgJSON string `json:"audit_cfg_json" mapstructure:"audit_cfg_json"`
 
-	NotifyFreqCardSeconds  int `json:"notify_freq_card_seconds" mapstructure:"notify_freq_card_seconds"`
-	NotifyFreqBoardSeconds int `json:"notify_freq_board_seconds" mapstructure:"notify_freq_board_seconds"`
+	AuditLogPersisted     bool `json:"audit_log_persisted" mapstructure:"audit_log_persisted"`
+	AuditLogRetentionDays int  `json:"audit_log_retention_days" mapstructure:"audit_log_retention_days"`
+
+	NotifyFreqCardSeconds  int  `json:"notify_freq_card_seconds" mapstructure:"notify_freq_card_seconds"`
+	NotifyFreqBoardSeconds int  `json:"notify_freq_board_seconds" mapstructure:"notify_freq_board_seconds"`
+	NotifyUnassignment     bool `json:"notify_unassignment" mapstructure:"notify_unassignment"`
+
+	FormSubmissionsPerHour int `json:"form_submissions_per_hour" mapstructure:"form_submissions_per_hour"`
+
+	FileScanner         string `json:"file_scanner" mapstructure:"file_scanner"`
+	ClamdAddress        string `json:"clamd_address" mapstructure:"clamd_address"`
+	ClamdTimeoutSeconds int    `json:"clamd_timeout_seconds" mapstructure:"clamd_timeout_seconds"`
+
+	FileGCFrequencyHours   int `json:"file_gc_frequency_hours" mapstructure:"file_gc_frequency_hours"`
+	FileGCGracePeriodHours int `json:"file_gc_grace_period_hours" mapstructure:"file_gc_grace_period_hours"`
+
+	TeamStorageQuotaMB  int `json:"team_storage_quota_mb" mapstructure:"team_storage_quota_mb"`
+	BoardStorageQuotaMB int `json:"board_storage_quota_mb" mapstructure:"board_storage_quota_mb"`
+
+	FileDeduplication bool `json:"file_deduplication" mapstructure:"file_deduplication"`
+
+	TracingEnabled     bool    `json:"tracing_enabled" mapstructure:"tracing_enabled"`
+	TracingEndpoint    string  `json:"tracing_endpoint" mapstructure:"tracing_endpoint"`
+	TracingInsecure    bool    `json:"tracing_insecure" mapstructure:"tracing_insecure"`
+	TracingSampleRatio float64 `json:"tracing_sample_ratio" mapstructure:"tracing_sample_ratio"`
+
+	CacheSize       int `json:"cache_size" mapstructure:"cache_size"`
+	CacheTTLSeconds int `json:"cache_ttl_seconds" mapstructure:"cache_ttl_seconds"`
 }
 
 // ReadConfigFile read the configuration from the filesystem.
@@ -86,6 +116,9 @@ func ReadConfigFile(configFilePath string) (*Configuration, error) {
 	viper.AutomaticEnv() // read config values from env like FOCALBOARD_SERVERROOT=...
 	viper.SetDefault("ServerRoot", DefaultServerRoot)
 	viper.SetDefault("DBPingAttempts", DBPingAttempts)
+	viper.SetDefault("DBQueryTimeout", DBQueryTimeout)
+	viper.SetDefault("cache_size", CacheSize)
+	viper.SetDefault("cache_ttl_seconds", CacheTTL)
 	viper.SetDefault("Port", DefaultPort)
 	viper.SetDefault("DBType", "sqlite3")
 	viper.SetDefault("DBConfigString", "./focalboard.db")
@@ -106,6 +139,22 @@ func ReadConfigFile(configFilePath string) (*Configuration, error) {
 	viper.SetDefault("AuthMode", "native")
 	viper.SetDefault("NotifyFreqCardSeconds", 120)    // 2 minutes after last card edit
 	viper.SetDefault("NotifyFreqBoardSeconds", 86400) // 1 day after last card edit
+	viper.SetDefault("NotifyUnassignment", false)
+	viper.SetDefault("FormSubmissionsPerHour", 20) // per client address and form
+	viper.SetDefault("FileScanner", "none")
+	viper.SetDefault("ClamdAddress", "")
+	viper.SetDefault("ClamdTimeoutSeconds", 60)
+	viper.SetDefault("FileGCFrequencyHours", 24)    // 0 disables the collection of orphaned files
+	viper.SetDefault("FileGCGracePeriodHours", 168) // 1 week
+	viper.SetDefault("TeamStorageQuotaMB", 0)       // 0 means unlimited
+	viper.SetDefault("BoardStorageQuotaMB", 0)      // 0 means unlimited
+	viper.SetDefault("FileDeduplication", false)
+	viper.SetDefault("TracingEnabled", false)
+	viper.SetDefault("TracingEndpoint", "localhost:4318") // OTLP over HTTP of a local collector
+	viper.SetDefault("TracingInsecure", true)
+	viper.SetDefault("TracingSampleRatio", 1.0)
+	viper.SetDefault("AuditLogPersisted", false)
+	viper.SetDefault("AuditLogRetentionDays", 90) // 0 keeps the audit log forever
 	viper.SetDefault("EnableDataRetention", false)
 	viper.SetDefault("FeatureFlags", map[string]string{})
 	viper.SetDefault("DataRetentionDays", 365) // 1 year is default
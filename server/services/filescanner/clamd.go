@@ -0,0 +1,139 @@
+// Copyright (c) 2020-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package filescanner
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"strings"
+	"time"
+)
+
+const (
+	clamdChunkSize = 64 * 1024
+
+	clamdCleanReply    = "stream: OK"
+	clamdInfectedReply = " FOUND"
+	clamdErrorReply    = " ERROR"
+)
+
+var (
+	errEmptyClamdAddress = errors.New("clamd address is not set")
+	errClamd             = errors.New("clamd error")
+)
+
+// Clamd scans files with a ClamAV daemon, streaming them over its unix or TCP socket with the
+// INSTREAM command.
+type Clamd struct {
+	network string
+	address string
+	timeout time.Duration
+}
+
+// NewClamd creates a scanner for the clamd socket at address, either a unix socket path prefixed
+// with "unix://" or a host:port optionally prefixed with "tcp://". Each scan, including the
+// transfer of the file, must complete within timeout.
+func NewClamd(address string, timeout time.Duration) (*Clamd, error) {
+	network := "tcp"
+	switch {
+	case strings.HasPrefix(address, "unix://"):
+		network = "unix"
+		address = strings.TrimPrefix(address, "unix://")
+	case strings.HasPrefix(address, "tcp://"):
+		address = strings.TrimPrefix(address, "tcp://")
+	}
+	if address == "" {
+		return nil, errEmptyClamdAddress
+	}
+
+	return &Clamd{
+		network: network,
+		address: address,
+		timeout: timeout,
+	}, nil
+}
+
+func (c *Clamd) Name() string {
+	return ClamdName
+}
+
+func (c *Clamd) Scan(reader io.Reader) (*Result, error) {
+	conn, err := net.DialTimeout(c.network, c.address, c.timeout)
+	if err != nil {
+		return nil, fmt.Errorf("cannot connect to clamd: %w", err)
+	}
+	defer conn.Close()
+
+	if err = conn.SetDeadline(time.Now().Add(c.timeout)); err != nil {
+		return nil, err
+	}
+
+	if err = c.stream(conn, reader); err != nil {
+		// clamd closes the connection when the file is over its size limit; its reply, if
+		// any, explains why.
+		if _, replyErr := c.readReply(conn); errors.Is(replyErr, errClamd) {
+			return nil, replyErr
+		}
+		return nil, fmt.Errorf("cannot stream file to clamd: %w", err)
+	}
+
+	return c.readReply(conn)
+}
+
+// stream sends the file as INSTREAM chunks, each prefixed with its length, followed by a
+// zero-length chunk.
+func (c *Clamd) stream(conn net.Conn, reader io.Reader) error {
+	if _, err := conn.Write([]byte("zINSTREAM\x00")); err != nil {
+		return err
+	}
+
+	buf := make([]byte, 4+clamdChunkSize)
+	for {
+		n, err := io.ReadFull(reader, buf[4:])
+		if n > 0 {
+			binary.BigEndian.PutUint32(buf[:4], uint32(n))
+			if _, writeErr := conn.Write(buf[:4+n]); writeErr != nil {
+				return writeErr
+			}
+		}
+		if errors.Is(err, io.EOF) || errors.Is(err, io.ErrUnexpectedEOF) {
+			break
+		}
+		if err != nil {
+			return err
+		}
+	}
+
+	_, err := conn.Write([]byte{0, 0, 0, 0})
+	return err
+}
+
+// readReply parses the reply of clamd to an INSTREAM command, one of "stream: OK",
+// "stream: <signature> FOUND" or "<reason> ERROR".
+func (c *Clamd) readReply(conn net.Conn) (*Result, error) {
+	data, err := io.ReadAll(conn)
+	if err != nil && len(data) == 0 {
+		return nil, fmt.Errorf("cannot read clamd reply: %w", err)
+	}
+
+	if i := bytes.IndexByte(data, 0); i >= 0 {
+		data = data[:i]
+	}
+	reply := strings.TrimSpace(string(data))
+
+	switch {
+	case reply == clamdCleanReply:
+		return &Result{}, nil
+	case strings.HasSuffix(reply, clamdInfectedReply):
+		signature := strings.TrimSuffix(strings.TrimPrefix(reply, "stream: "), clamdInfectedReply)
+		return &Result{Infected: true, Signature: signature}, nil
+	case strings.HasSuffix(reply, clamdErrorReply):
+		return nil, fmt.Errorf("%w: %s", errClamd, strings.TrimSuffix(reply, clamdErrorReply))
+	}
+	return nil, fmt.Errorf("unexpected clamd reply: %q", reply)
+}
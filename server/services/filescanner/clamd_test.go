@@ -0,0 +1,162 @@
+// Copyright (c) 2020-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package filescanner
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/binary"
+	"io"
+	"net"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const eicar = `X5O!P%@AP[4\PZX54(P^)7CC)7}$EICAR-STANDARD-ANTIVIRUS-TEST-FILE!$H+H*`
+
+// fakeClamd is a local stand-in for clamd answering INSTREAM commands. Streams containing the
+// EICAR test string are reported as infected, and streams over maxSize are refused.
+type fakeClamd struct {
+	listener net.Listener
+	maxSize  int
+}
+
+func newFakeClamd(t *testing.T, network, address string) *fakeClamd {
+	listener, err := net.Listen(network, address)
+	require.NoError(t, err)
+	t.Cleanup(func() { listener.Close() })
+
+	f := &fakeClamd{listener: listener, maxSize: 1024 * 1024}
+	go f.serve()
+	return f
+}
+
+func (f *fakeClamd) serve() {
+	for {
+		conn, err := f.listener.Accept()
+		if err != nil {
+			return
+		}
+		go f.handle(conn)
+	}
+}
+
+func (f *fakeClamd) handle(conn net.Conn) {
+	defer conn.Close()
+	reader := bufio.NewReader(conn)
+
+	command, err := reader.ReadString(0)
+	if err != nil {
+		return
+	}
+	if command != "zINSTREAM\x00" {
+		_, _ = conn.Write([]byte("UNKNOWN COMMAND\x00"))
+		return
+	}
+
+	var data bytes.Buffer
+	for {
+		var size uint32
+		if err := binary.Read(reader, binary.BigEndian, &size); err != nil {
+			return
+		}
+		if size == 0 {
+			break
+		}
+		if data.Len()+int(size) > f.maxSize {
+			_, _ = conn.Write([]byte("INSTREAM size limit exceeded. ERROR\x00"))
+			return
+		}
+		if _, err := io.CopyN(&data, reader, int64(size)); err != nil {
+			return
+		}
+	}
+
+	if strings.Contains(data.String(), "EICAR-STANDARD-ANTIVIRUS-TEST-FILE") {
+		_, _ = conn.Write([]byte("stream: Eicar-Test-Signature FOUND\x00"))
+		return
+	}
+	_, _ = conn.Write([]byte("stream: OK\x00"))
+}
+
+func TestClamdScan(t *testing.T) {
+	tcp := newFakeClamd(t, "tcp", "127.0.0.1:0")
+	unix := newFakeClamd(t, "unix", filepath.Join(t.TempDir(), "clamd.sock"))
+
+	addresses := map[string]string{
+		"tcp":  "tcp://" + tcp.listener.Addr().String(),
+		"unix": "unix://" + unix.listener.Addr().String(),
+	}
+
+	for name, address := range addresses {
+		t.Run(name, func(t *testing.T) {
+			scanner, err := NewClamd(address, 5*time.Second)
+			require.NoError(t, err)
+
+			t.Run("clean file", func(t *testing.T) {
+				result, err := scanner.Scan(strings.NewReader("hello world"))
+				require.NoError(t, err)
+				assert.False(t, result.Infected)
+			})
+
+			t.Run("infected file", func(t *testing.T) {
+				result, err := scanner.Scan(strings.NewReader(eicar))
+				require.NoError(t, err)
+				assert.True(t, result.Infected)
+				assert.Equal(t, "Eicar-Test-Signature", result.Signature)
+			})
+
+			t.Run("file spanning several chunks", func(t *testing.T) {
+				data := strings.Repeat("a", clamdChunkSize*2) + eicar
+				result, err := scanner.Scan(strings.NewReader(data))
+				require.NoError(t, err)
+				assert.True(t, result.Infected)
+			})
+
+			t.Run("empty file", func(t *testing.T) {
+				result, err := scanner.Scan(strings.NewReader(""))
+				require.NoError(t, err)
+				assert.False(t, result.Infected)
+			})
+		})
+	}
+
+	t.Run("file over the size limit", func(t *testing.T) {
+		scanner, err := NewClamd(addresses["tcp"], 5*time.Second)
+		require.NoError(t, err)
+
+		result, err := scanner.Scan(strings.NewReader(strings.Repeat("a", tcp.maxSize+1)))
+		require.Error(t, err)
+		assert.Nil(t, result)
+	})
+
+	t.Run("clamd unavailable", func(t *testing.T) {
+		scanner, err := NewClamd("unix://"+filepath.Join(t.TempDir(), "missing.sock"), time.Second)
+		require.NoError(t, err)
+
+		result, err := scanner.Scan(strings.NewReader("hello world"))
+		require.Error(t, err)
+		assert.Nil(t, result)
+	})
+}
+
+func TestNewClamd(t *testing.T) {
+	scanner, err := NewClamd("localhost:3310", time.Second)
+	require.NoError(t, err)
+	assert.Equal(t, "tcp", scanner.network)
+	assert.Equal(t, "localhost:3310", scanner.address)
+
+	scanner, err = NewClamd("unix:///var/run/clamav/clamd.ctl", time.Second)
+	require.NoError(t, err)
+	assert.Equal(t, "unix", scanner.network)
+	assert.Equal(t, "/var/run/clamav/clamd.ctl", scanner.address)
+
+	_, err = NewClamd("unix://", time.Second)
+	require.ErrorIs(t, err, errEmptyClamdAddress)
+}
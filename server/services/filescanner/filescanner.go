@@ -0,0 +1,66 @@
+// Copyright (c) 2020-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package filescanner
+
+import (
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/mattermost/mattermost-plugin-boards/server/services/config"
+)
+
+const (
+	// NoopName and ClamdName are the values of the file_scanner setting selecting a scanner.
+	NoopName  = "none"
+	ClamdName = "clamd"
+
+	defaultClamdTimeout = 60 * time.Second
+)
+
+// Result is the outcome of the scan of a file.
+type Result struct {
+	// Infected is true if malware was found in the file.
+	Infected bool
+
+	// Signature is the name of the malware found, if any.
+	Signature string
+}
+
+// Scanner scans uploaded files for malware.
+type Scanner interface {
+	// Name identifies the scanner in scan results.
+	Name() string
+
+	// Scan reads a file to its end and reports whether malware was found in it. An error means
+	// the file could not be scanned, not that it is infected.
+	Scan(reader io.Reader) (*Result, error)
+}
+
+// New creates the scanner selected by the configuration. Files are not scanned unless a scanner
+// is configured.
+func New(cfg *config.Configuration) (Scanner, error) {
+	switch cfg.FileScanner {
+	case "", NoopName:
+		return Noop{}, nil
+	case ClamdName:
+		timeout := defaultClamdTimeout
+		if cfg.ClamdTimeoutSeconds > 0 {
+			timeout = time.Duration(cfg.ClamdTimeoutSeconds) * time.Second
+		}
+		return NewClamd(cfg.ClamdAddress, timeout)
+	}
+	return nil, fmt.Errorf("unknown file scanner %q", cfg.FileScanner)
+}
+
+// Noop is the default scanner, accepting every file without reading it.
+type Noop struct{}
+
+func (Noop) Name() string {
+	return NoopName
+}
+
+func (Noop) Scan(io.Reader) (*Result, error) {
+	return &Result{}, nil
+}
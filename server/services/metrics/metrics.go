@@ -5,6 +5,7 @@ package metrics
 
 import (
 	"os"
+	"strconv"
 
 	"github.com/prometheus/client_golang/prometheus"
 	"github.com/prometheus/client_golang/prometheus/collectors"
@@ -15,7 +16,14 @@ const (
 	MetricsSubsystemBlocks = "blocks"
 	MetricsSubsystemBoards = "boards"
 	MetricsSubsystemTeams  = "teams"
+	MetricsSubsystemFiles  = "files"
 	MetricsSubsystemSystem = "system"
+	MetricsSubsystemAPI    = "api"
+	MetricsSubsystemStore  = "store"
+	MetricsSubsystemCache  = "cache"
+
+	MetricsSubsystemWebsocket     = "websocket"
+	MetricsSubsystemNotifications = "notifications"
 
 	MetricsCloudInstallationLabel = "installationId"
 )
@@ -47,6 +55,22 @@ type Metrics struct {
 	teamCount  prometheus.Gauge
 
 	blockLastActivity prometheus.Gauge
+
+	orphanedFilesRemovedCount prometheus.Counter
+	orphanedFilesRemovedBytes prometheus.Counter
+
+	apiRequestDuration *prometheus.HistogramVec
+	apiRequestCount    *prometheus.CounterVec
+
+	storeMethodDuration *prometheus.HistogramVec
+
+	notificationDeliveryFailures *prometheus.CounterVec
+	notificationHintsPending     prometheus.Gauge
+
+	cacheHitCount  *prometheus.CounterVec
+	cacheMissCount *prometheus.CounterVec
+
+	additionalLabels map[string]string
 }
 
 // NewMetrics Factory method to create a new metrics collector.
@@ -175,6 +199,89 @@ func NewMetrics(info InstanceInfo) *Metrics {
 	})
 	m.registry.MustRegister(m.blockLastActivity)
 
+	m.orphanedFilesRemovedCount = prometheus.NewCounter(prometheus.CounterOpts{
+		Namespace:   MetricsNamespace,
+		Subsystem:   MetricsSubsystemFiles,
+		Name:        "orphaned_files_removed_total",
+		Help:        "Total number of orphaned files removed.",
+		ConstLabels: additionalLabels,
+	})
+	m.registry.MustRegister(m.orphanedFilesRemovedCount)
+
+	m.orphanedFilesRemovedBytes = prometheus.NewCounter(prometheus.CounterOpts{
+		Namespace:   MetricsNamespace,
+		Subsystem:   MetricsSubsystemFiles,
+		Name:        "orphaned_files_reclaimed_bytes_total",
+		Help:        "Total number of bytes reclaimed by removing orphaned files.",
+		ConstLabels: additionalLabels,
+	})
+	m.registry.MustRegister(m.orphanedFilesRemovedBytes)
+
+	m.apiRequestDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace:   MetricsNamespace,
+		Subsystem:   MetricsSubsystemAPI,
+		Name:        "request_duration_seconds",
+		Help:        "Duration of the API requests, by route.",
+		ConstLabels: additionalLabels,
+	}, []string{"method", "route"})
+	m.registry.MustRegister(m.apiRequestDuration)
+
+	m.apiRequestCount = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace:   MetricsNamespace,
+		Subsystem:   MetricsSubsystemAPI,
+		Name:        "requests_total",
+		Help:        "Total number of API requests, by route and status code.",
+		ConstLabels: additionalLabels,
+	}, []string{"method", "route", "status"})
+	m.registry.MustRegister(m.apiRequestCount)
+
+	m.storeMethodDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace:   MetricsNamespace,
+		Subsystem:   MetricsSubsystemStore,
+		Name:        "method_duration_seconds",
+		Help:        "Duration of the store methods.",
+		ConstLabels: additionalLabels,
+	}, []string{"method", "success"})
+	m.registry.MustRegister(m.storeMethodDuration)
+
+	m.notificationDeliveryFailures = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace:   MetricsNamespace,
+		Subsystem:   MetricsSubsystemNotifications,
+		Name:        "delivery_failures_total",
+		Help:        "Total number of notifications that could not be delivered, by backend.",
+		ConstLabels: additionalLabels,
+	}, []string{"backend"})
+	m.registry.MustRegister(m.notificationDeliveryFailures)
+
+	m.notificationHintsPending = prometheus.NewGauge(prometheus.GaugeOpts{
+		Namespace:   MetricsNamespace,
+		Subsystem:   MetricsSubsystemNotifications,
+		Name:        "hints_pending",
+		Help:        "Number of notification hints waiting to be processed.",
+		ConstLabels: additionalLabels,
+	})
+	m.registry.MustRegister(m.notificationHintsPending)
+
+	m.cacheHitCount = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace:   MetricsNamespace,
+		Subsystem:   MetricsSubsystemCache,
+		Name:        "hits_total",
+		Help:        "Total number of store reads served by the cache, by cache.",
+		ConstLabels: additionalLabels,
+	}, []string{"cache"})
+	m.registry.MustRegister(m.cacheHitCount)
+
+	m.cacheMissCount = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace:   MetricsNamespace,
+		Subsystem:   MetricsSubsystemCache,
+		Name:        "misses_total",
+		Help:        "Total number of store reads not found in the cache, by cache.",
+		ConstLabels: additionalLabels,
+	}, []string{"cache"})
+	m.registry.MustRegister(m.cacheMissCount)
+
+	m.additionalLabels = additionalLabels
+
 	return m
 }
 
@@ -234,3 +341,69 @@ func (m *Metrics) ObserveTeamCount(count int64) {
 		m.teamCount.Set(float64(count))
 	}
 }
+
+func (m *Metrics) IncrementOrphanedFilesRemoved(num int, bytes int64) {
+	if m != nil {
+		m.orphanedFilesRemovedCount.Add(float64(num))
+		m.orphanedFilesRemovedBytes.Add(float64(bytes))
+	}
+}
+
+func (m *Metrics) ObserveAPIRequest(method, route string, status int, elapsed float64) {
+	if m != nil {
+		m.apiRequestDuration.WithLabelValues(method, route).Observe(elapsed)
+		m.apiRequestCount.WithLabelValues(method, route, strconv.Itoa(status)).Inc()
+	}
+}
+
+func (m *Metrics) ObserveStoreMethodDuration(method, success string, elapsed float64) {
+	if m != nil {
+		m.storeMethodDuration.WithLabelValues(method, success).Observe(elapsed)
+	}
+}
+
+// RegisterWebsocketListeners adds a gauge of the active listeners of a websocket adapter, read
+// from count when the metrics are collected.
+func (m *Metrics) RegisterWebsocketListeners(adapter string, count func() int) {
+	if m == nil {
+		return
+	}
+
+	labels := prometheus.Labels{"adapter": adapter}
+	for name, value := range m.additionalLabels {
+		labels[name] = value
+	}
+	m.registry.MustRegister(prometheus.NewGaugeFunc(prometheus.GaugeOpts{
+		Namespace:   MetricsNamespace,
+		Subsystem:   MetricsSubsystemWebsocket,
+		Name:        "listeners",
+		Help:        "Number of active websocket listeners, by adapter.",
+		ConstLabels: labels,
+	}, func() float64 {
+		return float64(count())
+	}))
+}
+
+func (m *Metrics) IncrementNotificationDeliveryFailures(backend string) {
+	if m != nil {
+		m.notificationDeliveryFailures.WithLabelValues(backend).Inc()
+	}
+}
+
+func (m *Metrics) ObserveNotificationHintsPending(count int) {
+	if m != nil {
+		m.notificationHintsPending.Set(float64(count))
+	}
+}
+
+func (m *Metrics) IncrementCacheHit(cache string) {
+	if m != nil {
+		m.cacheHitCount.WithLabelValues(cache).Inc()
+	}
+}
+
+func (m *Metrics) IncrementCacheMiss(cache string) {
+	if m != nil {
+		m.cacheMissCount.WithLabelValues(cache).Inc()
+	}
+}
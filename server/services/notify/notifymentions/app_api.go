@@ -7,4 +7,6 @@ import "github.com/mattermost/mattermost-plugin-boards/server/model"
 type AppAPI interface {
 	GetMemberForBoard(boardID, userID string) (*model.BoardMember, error)
 	AddMemberToBoard(member *model.BoardMember) (*model.BoardMember, error)
+	GetNotificationPreferences(userID, boardID string) (*model.NotificationPreferences, error)
+	CreateNotification(notification *model.Notification) (*model.Notification, error)
 }
@@ -18,11 +18,33 @@ var atMentionRegexp = regexp.MustCompile(`\B@[[:alnum:]][[:alnum:]\.\-_:]*`)
 // a slice of usernames.
 func extractMentions(block *model.Block) map[string]struct{} {
 	mentions := make(map[string]struct{})
-	if block == nil || !strings.Contains(block.Title, "@") {
+	if block == nil {
 		return mentions
 	}
+	addMentions(mentions, block.Title)
+	return mentions
+}
 
-	str := block.Title
+// extractMentionsFromParagraphs extracts any mentions in the specified paragraphs and returns a
+// set of usernames, along with the first paragraph each username is mentioned in.
+func extractMentionsFromParagraphs(paragraphs []string) (map[string]struct{}, map[string]string) {
+	mentions := make(map[string]struct{})
+	found := make(map[string]string)
+	for _, p := range paragraphs {
+		addMentions(mentions, p)
+		for name := range mentions {
+			if _, ok := found[name]; !ok {
+				found[name] = p
+			}
+		}
+	}
+	return mentions, found
+}
+
+func addMentions(mentions map[string]struct{}, str string) {
+	if !strings.Contains(str, "@") {
+		return
+	}
 
 	for _, match := range atMentionRegexp.FindAllString(str, -1) {
 		name := mm_model.NormalizeUsername(match[1:])
@@ -30,5 +52,4 @@ func extractMentions(block *model.Block) map[string]struct{} {
 			mentions[name] = struct{}{}
 		}
 	}
-	return mentions
 }
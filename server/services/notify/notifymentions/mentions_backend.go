@@ -22,6 +22,7 @@ const (
 
 var (
 	ErrMentionPermission = errors.New("mention not permitted")
+	ErrMentionMuted      = errors.New("mentions muted")
 )
 
 type MentionListener interface {
@@ -109,6 +110,47 @@ func (b *Backend) BlockChanged(evt notify.BlockChangeEvent) error {
 	}
 
 	oldMentions := extractMentions(evt.BlockOld)
+
+	extract := func(username string) string {
+		return extractText(evt.BlockChanged.Title, username, newLimits())
+	}
+	return b.notifyMentions(mentions, oldMentions, extract, evt)
+}
+
+// ContentChanged satisfies the `notify.ContentBackend` interface and delivers notifications for
+// @mentions newly added to the document content of a card.
+func (b *Backend) ContentChanged(evt notify.ContentChangeEvent) error {
+	if evt.Board == nil || evt.Card == nil {
+		return nil
+	}
+
+	mentions, paragraphs := extractMentionsFromParagraphs(evt.Paragraphs)
+	if len(mentions) == 0 {
+		return nil
+	}
+
+	oldMentions, _ := extractMentionsFromParagraphs(evt.OldParagraphs)
+
+	extract := func(username string) string {
+		return extractText(paragraphs[username], username, newLimits())
+	}
+
+	// the document belongs to the card, so the card itself is reported as the changed block.
+	blockEvt := notify.BlockChangeEvent{
+		Action:       notify.Update,
+		TeamID:       evt.TeamID,
+		Board:        evt.Board,
+		Card:         evt.Card,
+		BlockChanged: evt.Card,
+		BlockOld:     evt.Card,
+		ModifiedBy:   evt.ModifiedBy,
+	}
+	return b.notifyMentions(mentions, oldMentions, extract, blockEvt)
+}
+
+// notifyMentions delivers a notification for each mention not present in oldMentions and informs
+// the listeners.
+func (b *Backend) notifyMentions(mentions, oldMentions map[string]struct{}, extract func(username string) string, evt notify.BlockChangeEvent) error {
 	merr := merror.New()
 
 	b.mux.RLock()
@@ -122,11 +164,10 @@ func (b *Backend) BlockChanged(evt notify.BlockChangeEvent) error {
 			continue
 		}
 
-		extract := extractText(evt.BlockChanged.Title, username, newLimits())
-
-		userID, err := b.deliverMentionNotification(username, extract, evt)
+		text := extract(username)
+		userID, err := b.deliverMentionNotification(username, text, evt)
 		if err != nil {
-			if errors.Is(err, ErrMentionPermission) {
+			if errors.Is(err, ErrMentionPermission) || errors.Is(err, ErrMentionMuted) {
 				b.logger.Debug("Cannot deliver notification", mlog.String("user", username), mlog.Err(err))
 			} else {
 				merr.Append(fmt.Errorf("cannot deliver notification for @%s: %w", username, err))
@@ -143,6 +184,10 @@ func (b *Backend) BlockChanged(evt notify.BlockChangeEvent) error {
 			mlog.Int("listener_count", len(listeners)),
 		)
 
+		if err == nil {
+			b.addToInbox(userID, text, evt)
+		}
+
 		for _, listener := range listeners {
 			safeCallListener(listener, userID, evt, b.logger)
 		}
@@ -150,6 +195,31 @@ func (b *Backend) BlockChanged(evt notify.BlockChangeEvent) error {
 	return merr.ErrorOrNil()
 }
 
+// addToInbox records a delivered mention in the mentioned user's in-app notification inbox.
+func (b *Backend) addToInbox(userID string, extract string, evt notify.BlockChangeEvent) {
+	notification := &model.Notification{
+		UserID:  userID,
+		Type:    model.NotificationTypeMention,
+		TeamID:  evt.TeamID,
+		BoardID: evt.Board.ID,
+		CardID:  evt.Card.ID,
+		BlockID: evt.BlockChanged.ID,
+		Title:   evt.Card.Title,
+		Message: extract,
+	}
+	if evt.ModifiedBy != nil {
+		notification.ActorID = evt.ModifiedBy.UserID
+	}
+
+	if _, err := b.appAPI.CreateNotification(notification); err != nil {
+		b.logger.Error("Cannot add mention to notification inbox",
+			mlog.String("user_id", userID),
+			mlog.String("card_id", evt.Card.ID),
+			mlog.Err(err),
+		)
+	}
+}
+
 func safeCallListener(listener MentionListener, userID string, evt notify.BlockChangeEvent, logger mlog.LoggerIFace) {
 	// don't let panicky listeners stop notifications
 	defer func() {
@@ -175,6 +245,19 @@ func (b *Backend) deliverMentionNotification(username string, extract string, ev
 		return "", fmt.Errorf("invalid user cannot mention: %w", ErrMentionPermission)
 	}
 
+	prefs, err := b.appAPI.GetNotificationPreferences(mentionedUser.Id, evt.Board.ID)
+	if err != nil {
+		b.logger.Warn("Cannot fetch notification preferences for mentioned user; using defaults",
+			mlog.String("user_id", mentionedUser.Id),
+			mlog.String("board_id", evt.Board.ID),
+			mlog.Err(err),
+		)
+		prefs = model.NewDefaultNotificationPreferences(mentionedUser.Id, evt.Board.ID)
+	}
+	if !prefs.AllowsMentions() {
+		return "", fmt.Errorf("%s muted board %s: %w", mentionedUser.Id, evt.Board.ID, ErrMentionMuted)
+	}
+
 	if evt.Board.Type == model.BoardTypeOpen {
 		// public board rules:
 		//    - admin, editor, commenter: can mention anyone on team (mentioned users are automatically added to board)
@@ -35,6 +35,28 @@ func Test_extractMentions(t *testing.T) {
 	}
 }
 
+func Test_extractMentionsFromParagraphs(t *testing.T) {
+	paragraphs := []string{
+		"Intro without mentions.",
+		"Ping @user1 and @user2",
+		"Also @user2 and @user3",
+	}
+
+	mentions, found := extractMentionsFromParagraphs(paragraphs)
+	if want := makeMap("user1", "user2", "user3"); !reflect.DeepEqual(mentions, want) {
+		t.Errorf("extractMentionsFromParagraphs() mentions = %v, want %v", mentions, want)
+	}
+
+	want := map[string]string{
+		"user1": paragraphs[1],
+		"user2": paragraphs[1],
+		"user3": paragraphs[2],
+	}
+	if !reflect.DeepEqual(found, want) {
+		t.Errorf("extractMentionsFromParagraphs() paragraphs = %v, want %v", found, want)
+	}
+}
+
 func makeBlock(text string) *model.Block {
 	return &model.Block{
 		ID:    mm_model.NewId(),
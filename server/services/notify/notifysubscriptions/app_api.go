@@ -1,6 +1,8 @@
 // Copyright (c) 2020-present Mattermost, Inc. All Rights Reserved.
 // See LICENSE.txt for license information.
 
+//go:generate mockgen -destination=mocks/mockappapi.go -package mocks . AppAPI
+
 package notifysubscriptions
 
 import (
@@ -9,12 +11,18 @@ import (
 	"github.com/mattermost/mattermost-plugin-boards/server/model"
 )
 
-type AppAPI interface {
+// DiffAPI is the part of AppAPI needed to generate the diffs between versions of blocks.
+type DiffAPI interface {
 	GetBlockHistory(blockID string, opts model.QueryBlockHistoryOptions) ([]*model.Block, error)
 	GetBlockHistoryNewestChildren(parentID string, opts model.QueryBlockHistoryChildOptions) ([]*model.Block, bool, error)
-	GetBoardAndCardByID(blockID string) (board *model.Board, card *model.Block, err error)
-
 	GetUserByID(userID string) (*model.User, error)
+}
+
+type AppAPI interface {
+	DiffAPI
+
+	GetBoardAndCardByID(blockID string) (board *model.Board, card *model.Block, err error)
+	GetBlockByID(blockID string) (*model.Block, error)
 
 	CreateSubscription(sub *model.Subscription) (*model.Subscription, error)
 	GetSubscribersForBlock(blockID string) ([]*model.Subscriber, error)
@@ -22,4 +30,9 @@ type AppAPI interface {
 
 	UpsertNotificationHint(hint *model.NotificationHint, notificationFreq time.Duration) (*model.NotificationHint, error)
 	GetNextNotificationHint(remove bool) (*model.NotificationHint, error)
+	GetNotificationHintCount() (int, error)
+
+	GetNotificationPreferences(userID, boardID string) (*model.NotificationPreferences, error)
+
+	CreateNotification(notification *model.Notification) (*model.Notification, error)
 }
@@ -0,0 +1,142 @@
+// Copyright (c) 2020-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package notifysubscriptions
+
+import (
+	"fmt"
+
+	"github.com/mattermost/mattermost-plugin-boards/server/model"
+	"github.com/mattermost/mattermost-plugin-boards/server/services/notify"
+	"github.com/wiggin77/merror"
+
+	"github.com/mattermost/mattermost/server/public/shared/mlog"
+)
+
+// assignmentChange describes a user being added to or removed from a person property of a card.
+type assignmentChange struct {
+	UserID   string
+	PropName string
+	Assigned bool
+}
+
+// assignmentChanges returns the assignment changes contained in a card's property diffs.
+func assignmentChanges(propDiffs []PropDiff) []assignmentChange {
+	var changes []assignmentChange
+	for _, pd := range propDiffs {
+		for _, userID := range pd.AddedUserIDs {
+			changes = append(changes, assignmentChange{UserID: userID, PropName: pd.Name, Assigned: true})
+		}
+		for _, userID := range pd.RemovedUserIDs {
+			changes = append(changes, assignmentChange{UserID: userID, PropName: pd.Name, Assigned: false})
+		}
+	}
+	return changes
+}
+
+// notifyAssignments sends a direct message to users newly assigned to a card via a `person` or
+// `multiPerson` property, and subscribes them to the card. Users removed from such a property are
+// only notified when enabled via config.
+func (b *Backend) notifyAssignments(evt notify.BlockChangeEvent) error {
+	if evt.Card == nil || evt.BlockChanged.Type != model.TypeCard || evt.Action == notify.Delete {
+		return nil
+	}
+
+	schema, err := model.ParsePropertySchema(evt.Board)
+	if err != nil {
+		return fmt.Errorf("cannot parse property schema for board %s: %w", evt.Board.ID, err)
+	}
+
+	dg := &diffGenerator{
+		board:  evt.Board,
+		card:   evt.Card,
+		store:  b.appAPI,
+		logger: b.logger,
+	}
+	changes := assignmentChanges(dg.generatePropDiffs(evt.BlockOld, evt.BlockChanged, schema))
+	if len(changes) == 0 {
+		return nil
+	}
+
+	subs, err := b.appAPI.GetSubscribersForBlock(evt.Card.ID)
+	if err != nil {
+		return fmt.Errorf("cannot fetch subscribers for card %s: %w", evt.Card.ID, err)
+	}
+	subscribed := make(map[string]struct{}, len(subs))
+	for _, sub := range subs {
+		subscribed[sub.SubscriberID] = struct{}{}
+	}
+
+	merr := merror.New()
+	for _, chg := range changes {
+		if chg.Assigned {
+			// user assigned must be a board member to subscribe to the card.
+			if !b.permissions.HasPermissionToBoard(chg.UserID, evt.Board.ID, model.PermissionViewBoard) {
+				b.logger.Debug("Not notifying assigned non-board member",
+					mlog.String("user_id", chg.UserID),
+					mlog.String("card_id", evt.Card.ID),
+				)
+				continue
+			}
+
+			// re-subscribing an existing subscriber would reset when they were last notified.
+			if _, ok := subscribed[chg.UserID]; !ok {
+				sub := &model.Subscription{
+					BlockType:      model.TypeCard,
+					BlockID:        evt.Card.ID,
+					SubscriberType: model.SubTypeUser,
+					SubscriberID:   chg.UserID,
+				}
+				if _, err = b.appAPI.CreateSubscription(sub); err != nil {
+					merr.Append(fmt.Errorf("cannot subscribe assigned user %s to card %s: %w", chg.UserID, evt.Card.ID, err))
+				}
+				subscribed[chg.UserID] = struct{}{}
+			}
+		} else if !b.notifyUnassignment {
+			continue
+		}
+
+		// don't notify users of their own changes.
+		if chg.UserID == evt.ModifiedBy.UserID {
+			continue
+		}
+
+		prefs := getNotificationPreferences(b.appAPI, chg.UserID, evt.Board.ID, b.logger)
+		if !prefs.AllowsAssignments() {
+			b.logger.Debug("Not notifying assignment change per notification preferences",
+				mlog.String("user_id", chg.UserID),
+				mlog.String("board_id", evt.Board.ID),
+			)
+			continue
+		}
+
+		if err = b.delivery.AssignmentDeliver(chg.UserID, chg.Assigned, chg.PropName, evt); err != nil {
+			merr.Append(fmt.Errorf("cannot deliver assignment notification to %s: %w", chg.UserID, err))
+			continue
+		}
+
+		b.logger.Debug("Assignment notification delivered",
+			mlog.String("user_id", chg.UserID),
+			mlog.String("card_id", evt.Card.ID),
+			mlog.Bool("assigned", chg.Assigned),
+		)
+
+		notification := &model.Notification{
+			UserID:  chg.UserID,
+			Type:    model.NotificationTypeUnassigned,
+			TeamID:  evt.TeamID,
+			BoardID: evt.Board.ID,
+			CardID:  evt.Card.ID,
+			ActorID: evt.ModifiedBy.UserID,
+			Title:   evt.Card.Title,
+			Message: chg.PropName,
+		}
+		if chg.Assigned {
+			notification.Type = model.NotificationTypeAssigned
+		}
+		if _, err = b.appAPI.CreateNotification(notification); err != nil {
+			merr.Append(fmt.Errorf("cannot add assignment notification to inbox of %s: %w", chg.UserID, err))
+		}
+	}
+	return merr.ErrorOrNil()
+}
@@ -0,0 +1,198 @@
+// Copyright (c) 2020-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package notifysubscriptions
+
+import (
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mattermost/mattermost-plugin-boards/server/model"
+	"github.com/mattermost/mattermost-plugin-boards/server/services/notify"
+	"github.com/mattermost/mattermost-plugin-boards/server/services/notify/notifysubscriptions/mocks"
+	"github.com/mattermost/mattermost-plugin-boards/server/services/permissions/mmpermissions"
+	mmpermissionsMocks "github.com/mattermost/mattermost-plugin-boards/server/services/permissions/mmpermissions/mocks"
+	permissionsMocks "github.com/mattermost/mattermost-plugin-boards/server/services/permissions/mocks"
+
+	"github.com/mattermost/mattermost/server/public/shared/mlog"
+)
+
+func Test_diffUserIDs(t *testing.T) {
+	added, removed := diffUserIDs(nil, []string{"user1"})
+	assert.Equal(t, []string{"user1"}, added)
+	assert.Empty(t, removed)
+
+	added, removed = diffUserIDs([]string{"user1", "user2"}, []string{"user2", "user3"})
+	assert.Equal(t, []string{"user3"}, added)
+	assert.Equal(t, []string{"user1"}, removed)
+
+	added, removed = diffUserIDs([]string{"user1", "user2"}, []string{"user2", "user1"})
+	assert.Empty(t, added)
+	assert.Empty(t, removed)
+}
+
+func Test_personPropValues(t *testing.T) {
+	card := makeCardWithProps(map[string]interface{}{
+		"owner":     "user1",
+		"reviewers": []interface{}{"user2", "", "user3"},
+		"status":    "user4",
+	})
+
+	assert.Equal(t, []string{"user1"}, personPropValues(card, model.PropDef{ID: "owner", Type: propTypePerson}))
+	assert.Equal(t, []string{"user2", "user3"}, personPropValues(card, model.PropDef{ID: "reviewers", Type: propTypeMultiPerson}))
+	assert.Empty(t, personPropValues(card, model.PropDef{ID: "status", Type: "select"}))
+	assert.Empty(t, personPropValues(card, model.PropDef{ID: "missing", Type: propTypePerson}))
+	assert.Empty(t, personPropValues(nil, model.PropDef{ID: "owner", Type: propTypePerson}))
+}
+
+func Test_assignmentChanges(t *testing.T) {
+	propDiffs := []PropDiff{
+		{ID: "status", Name: "Status", OldValue: "Todo", NewValue: "Done"},
+		{ID: "owner", Name: "Owner", AddedUserIDs: []string{"user1"}, RemovedUserIDs: []string{"user2"}},
+		{ID: "reviewers", Name: "Reviewers", AddedUserIDs: []string{"user3"}},
+	}
+
+	want := []assignmentChange{
+		{UserID: "user1", PropName: "Owner", Assigned: true},
+		{UserID: "user2", PropName: "Owner", Assigned: false},
+		{UserID: "user3", PropName: "Reviewers", Assigned: true},
+	}
+	assert.Equal(t, want, assignmentChanges(propDiffs))
+	assert.Empty(t, assignmentChanges(nil))
+}
+
+const (
+	testAssignBoardID  = "board1"
+	testAssignTeamID   = "team1"
+	testAssignAuthorID = "author1"
+	testAssigneeID     = "assignee1"
+	testOutsiderID     = "outsider1"
+)
+
+// setupAssignmentsBackend returns a backend whose board has an `owner` person property, viewed
+// by the author and the assignee but not by the outsider.
+func setupAssignmentsBackend(t *testing.T, notifyUnassignment bool) (*Backend, *mocks.MockAppAPI, *mocks.MockSubscriptionDelivery) {
+	ctrl := gomock.NewController(t)
+	appAPI := mocks.NewMockAppAPI(ctrl)
+	delivery := mocks.NewMockSubscriptionDelivery(ctrl)
+	permissionsStore := permissionsMocks.NewMockStore(ctrl)
+	pluginAPI := mmpermissionsMocks.NewMockAPI(ctrl)
+	logger := mlog.CreateConsoleTestLogger(t)
+
+	board := &model.Board{ID: testAssignBoardID, TeamID: testAssignTeamID}
+	permissionsStore.EXPECT().GetBoard(gomock.Any(), testAssignBoardID).Return(board, nil).AnyTimes()
+	for _, userID := range []string{testAssignAuthorID, testAssigneeID} {
+		permissionsStore.EXPECT().GetMemberForBoard(gomock.Any(), testAssignBoardID, userID).
+			Return(&model.BoardMember{BoardID: testAssignBoardID, UserID: userID, SchemeEditor: true}, nil).AnyTimes()
+	}
+	permissionsStore.EXPECT().GetMemberForBoard(gomock.Any(), testAssignBoardID, testOutsiderID).
+		Return(nil, model.NewErrNotFound(testOutsiderID)).AnyTimes()
+	pluginAPI.EXPECT().HasPermissionToTeam(gomock.Any(), testAssignTeamID, model.PermissionViewTeam).Return(true).AnyTimes()
+	pluginAPI.EXPECT().HasPermissionToTeam(gomock.Any(), testAssignTeamID, model.PermissionManageTeam).Return(false).AnyTimes()
+
+	appAPI.EXPECT().GetUserByID(gomock.Any()).DoAndReturn(func(userID string) (*model.User, error) {
+		return &model.User{ID: userID, Username: userID}, nil
+	}).AnyTimes()
+	appAPI.EXPECT().GetSubscribersForBlock("card1").Return([]*model.Subscriber{}, nil).AnyTimes()
+	appAPI.EXPECT().GetNotificationPreferences(gomock.Any(), testAssignBoardID).DoAndReturn(func(userID, boardID string) (*model.NotificationPreferences, error) {
+		return model.NewDefaultNotificationPreferences(userID, boardID), nil
+	}).AnyTimes()
+
+	backend := New(BackendParams{
+		AppAPI:             appAPI,
+		Permissions:        mmpermissions.New(permissionsStore, pluginAPI, logger),
+		Delivery:           delivery,
+		Logger:             logger,
+		NotifyUnassignment: notifyUnassignment,
+	})
+	return backend, appAPI, delivery
+}
+
+// assignmentEvent returns the change of the owner of a card by a user.
+func assignmentEvent(modifiedByID, oldOwner, newOwner string) notify.BlockChangeEvent {
+	oldCard := makeCardWithProps(map[string]interface{}{"owner": oldOwner})
+	newCard := makeCardWithProps(map[string]interface{}{"owner": newOwner})
+	oldCard.BoardID = testAssignBoardID
+	newCard.BoardID = testAssignBoardID
+
+	return notify.BlockChangeEvent{
+		Action: notify.Update,
+		TeamID: testAssignTeamID,
+		Board: &model.Board{
+			ID:     testAssignBoardID,
+			TeamID: testAssignTeamID,
+			CardProperties: []map[string]interface{}{
+				{"id": "owner", "name": "Owner", "type": propTypePerson},
+			},
+		},
+		Card:         newCard,
+		BlockChanged: newCard,
+		BlockOld:     oldCard,
+		ModifiedBy:   &model.BoardMember{BoardID: testAssignBoardID, UserID: modifiedByID},
+	}
+}
+
+func TestNotifyAssignments(t *testing.T) {
+	t.Run("assignee is notified and subscribed", func(t *testing.T) {
+		backend, appAPI, delivery := setupAssignmentsBackend(t, false)
+		evt := assignmentEvent(testAssignAuthorID, "", testAssigneeID)
+
+		appAPI.EXPECT().CreateSubscription(&model.Subscription{
+			BlockType:      model.TypeCard,
+			BlockID:        "card1",
+			SubscriberType: model.SubTypeUser,
+			SubscriberID:   testAssigneeID,
+		}).Return(&model.Subscription{}, nil)
+		delivery.EXPECT().AssignmentDeliver(testAssigneeID, true, "Owner", evt).Return(nil)
+		appAPI.EXPECT().CreateNotification(gomock.Any()).DoAndReturn(func(notification *model.Notification) (*model.Notification, error) {
+			assert.Equal(t, testAssigneeID, notification.UserID)
+			assert.Equal(t, model.NotificationTypeAssigned, notification.Type)
+			assert.Equal(t, testAssignBoardID, notification.BoardID)
+			assert.Equal(t, "card1", notification.CardID)
+			assert.Equal(t, testAssignAuthorID, notification.ActorID)
+			assert.Equal(t, "Owner", notification.Message)
+			return notification, nil
+		})
+
+		require.NoError(t, backend.notifyAssignments(evt))
+	})
+
+	t.Run("self assignment is not notified", func(t *testing.T) {
+		backend, appAPI, _ := setupAssignmentsBackend(t, false)
+		evt := assignmentEvent(testAssigneeID, "", testAssigneeID)
+
+		appAPI.EXPECT().CreateSubscription(gomock.Any()).Return(&model.Subscription{}, nil)
+
+		require.NoError(t, backend.notifyAssignments(evt))
+	})
+
+	t.Run("unassignment is not notified by default", func(t *testing.T) {
+		backend, _, _ := setupAssignmentsBackend(t, false)
+		evt := assignmentEvent(testAssignAuthorID, testAssigneeID, "")
+
+		require.NoError(t, backend.notifyAssignments(evt))
+	})
+
+	t.Run("unassignment is notified when enabled", func(t *testing.T) {
+		backend, appAPI, delivery := setupAssignmentsBackend(t, true)
+		evt := assignmentEvent(testAssignAuthorID, testAssigneeID, "")
+
+		delivery.EXPECT().AssignmentDeliver(testAssigneeID, false, "Owner", evt).Return(nil)
+		appAPI.EXPECT().CreateNotification(gomock.Any()).DoAndReturn(func(notification *model.Notification) (*model.Notification, error) {
+			assert.Equal(t, model.NotificationTypeUnassigned, notification.Type)
+			return notification, nil
+		})
+
+		require.NoError(t, backend.notifyAssignments(evt))
+	})
+
+	t.Run("non board member is skipped", func(t *testing.T) {
+		backend, _, _ := setupAssignmentsBackend(t, false)
+		evt := assignmentEvent(testAssignAuthorID, "", testOutsiderID)
+
+		require.NoError(t, backend.notifyAssignments(evt))
+	})
+}
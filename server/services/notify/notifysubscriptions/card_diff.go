@@ -0,0 +1,150 @@
+// Copyright (c) 2020-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package notifysubscriptions
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+
+	"github.com/mattermost/mattermost-plugin-boards/server/model"
+
+	"github.com/mattermost/mattermost/server/public/shared/mlog"
+)
+
+// GenerateCardDiff returns the differences between the versions of a card, and of its content
+// blocks, at two times. The versions are read from history, so the content blocks deleted in
+// between are compared too.
+func GenerateCardDiff(api DiffAPI, board *model.Board, cardID string, from, to int64, logger mlog.LoggerIFace) (*model.CardDiff, error) {
+	opts := model.QueryBlockHistoryOptions{
+		BeforeUpdateAt: to + 1,
+		Limit:          1,
+		Descending:     true,
+	}
+	cards, err := api.GetBlockHistory(cardID, opts)
+	if err != nil {
+		return nil, fmt.Errorf("could not get block history for card %s: %w", cardID, err)
+	}
+	if len(cards) == 0 || cards[0].Type != model.TypeCard {
+		return nil, model.NewErrNotFound("card ID=" + cardID + " at " + strconv.FormatInt(to, 10))
+	}
+	card := cards[0]
+
+	schema, err := model.ParsePropertySchema(board)
+	if err != nil {
+		return nil, fmt.Errorf("could not parse property schema for board %s: %w", board.ID, err)
+	}
+
+	dg := &diffGenerator{
+		board:        board,
+		card:         card,
+		store:        api,
+		lastNotifyAt: from,
+		untilAt:      to,
+		logger:       logger,
+	}
+	diff, err := dg.generateDiffsForCard(card, schema)
+	if err != nil {
+		return nil, err
+	}
+
+	authors := diff.Authors.Keys()
+	sort.Strings(authors)
+	cardDiff := &model.CardDiff{
+		CardID:     cardID,
+		BoardID:    board.ID,
+		From:       from,
+		To:         to,
+		Authors:    authors,
+		Title:      generateTextDiff(liveVersion(diff.OldBlock), liveVersion(diff.NewBlock), logger),
+		Properties: []*model.PropertyDiff{},
+		Content:    []*model.ContentDiff{},
+	}
+
+	for _, propDiff := range diff.PropDiffs {
+		cardDiff.Properties = append(cardDiff.Properties, &model.PropertyDiff{
+			ID:             propDiff.ID,
+			Name:           propDiff.Name,
+			OldValue:       propDiff.OldValue,
+			NewValue:       propDiff.NewValue,
+			AddedUserIDs:   propDiff.AddedUserIDs,
+			RemovedUserIDs: propDiff.RemovedUserIDs,
+		})
+	}
+
+	sort.SliceStable(diff.Diffs, func(i, j int) bool {
+		return diff.Diffs[i].UpdateAt < diff.Diffs[j].UpdateAt
+	})
+	for _, childDiff := range diff.Diffs {
+		if contentDiff := generateContentDiff(childDiff, logger); contentDiff != nil {
+			cardDiff.Content = append(cardDiff.Content, contentDiff)
+		}
+	}
+	return cardDiff, nil
+}
+
+// generateContentDiff returns the change of a content block, or nil if it was both added and
+// removed between the two times, or its text did not change.
+func generateContentDiff(diff *Diff, logger mlog.LoggerIFace) *model.ContentDiff {
+	oldBlock := liveVersion(diff.OldBlock)
+	newBlock := liveVersion(diff.NewBlock)
+
+	contentDiff := &model.ContentDiff{
+		BlockID: diff.NewBlock.ID,
+		Type:    diff.BlockType,
+		Text:    generateTextDiff(oldBlock, newBlock, logger),
+	}
+	switch {
+	case oldBlock == nil && newBlock == nil:
+		return nil
+	case oldBlock == nil:
+		contentDiff.Change = model.ContentAdded
+	case newBlock == nil:
+		contentDiff.Change = model.ContentRemoved
+	case contentDiff.Text == nil:
+		return nil
+	default:
+		contentDiff.Change = model.ContentChanged
+	}
+
+	if contentDiff.Text == nil {
+		contentDiff.Text = &model.TextDiff{OldValue: blockTitle(oldBlock), NewValue: blockTitle(newBlock)}
+	}
+	return contentDiff
+}
+
+// generateTextDiff returns the change of the title of a block, or nil if it did not change
+// beyond whitespace.
+func generateTextDiff(oldBlock, newBlock *model.Block, logger mlog.LoggerIFace) *model.TextDiff {
+	oldText := blockTitle(oldBlock)
+	newText := blockTitle(newBlock)
+	if oldText == newText {
+		return nil
+	}
+
+	markdown := generateMarkdownDiff(oldText, newText, logger)
+	if markdown == "" {
+		return nil
+	}
+	return &model.TextDiff{
+		OldValue: oldText,
+		NewValue: newText,
+		Markdown: markdown,
+	}
+}
+
+// liveVersion returns nil for missing blocks and for the versions recording a deletion.
+func liveVersion(block *model.Block) *model.Block {
+	if block == nil || block.DeleteAt != 0 {
+		return nil
+	}
+	return block
+}
+
+func blockTitle(block *model.Block) string {
+	if block == nil {
+		return ""
+	}
+	return block.Title
+}
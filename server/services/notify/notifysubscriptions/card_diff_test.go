@@ -0,0 +1,161 @@
+// Copyright (c) 2020-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package notifysubscriptions
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mattermost/mattermost-plugin-boards/server/model"
+
+	"github.com/mattermost/mattermost/server/public/shared/mlog"
+)
+
+// historyDiffAPI serves the block versions of history, oldest first.
+type historyDiffAPI struct {
+	history []*model.Block
+}
+
+func (api *historyDiffAPI) inRange(block *model.Block, after, before int64) bool {
+	return (after == 0 || block.UpdateAt > after) && (before == 0 || block.UpdateAt < before)
+}
+
+func (api *historyDiffAPI) GetBlockHistory(blockID string, opts model.QueryBlockHistoryOptions) ([]*model.Block, error) {
+	var blocks []*model.Block
+	for _, block := range api.history {
+		if block.ID == blockID && api.inRange(block, opts.AfterUpdateAt, opts.BeforeUpdateAt) {
+			blocks = append(blocks, block)
+		}
+	}
+	if opts.Descending {
+		for i, j := 0, len(blocks)-1; i < j; i, j = i+1, j-1 {
+			blocks[i], blocks[j] = blocks[j], blocks[i]
+		}
+	}
+	if opts.Limit != 0 && len(blocks) > int(opts.Limit) {
+		blocks = blocks[:opts.Limit]
+	}
+	return blocks, nil
+}
+
+func (api *historyDiffAPI) GetBlockHistoryNewestChildren(parentID string, opts model.QueryBlockHistoryChildOptions) ([]*model.Block, bool, error) {
+	newest := map[string]*model.Block{}
+	var ids []string
+	for _, block := range api.history {
+		if block.ParentID != parentID || !api.inRange(block, opts.AfterUpdateAt, opts.BeforeUpdateAt) {
+			continue
+		}
+		if _, ok := newest[block.ID]; !ok {
+			ids = append(ids, block.ID)
+		}
+		newest[block.ID] = block
+	}
+	blocks := make([]*model.Block, 0, len(ids))
+	for _, id := range ids {
+		blocks = append(blocks, newest[id])
+	}
+	return blocks, false, nil
+}
+
+func (api *historyDiffAPI) GetUserByID(userID string) (*model.User, error) {
+	return &model.User{ID: userID, Username: userID}, nil
+}
+
+func TestGenerateCardDiff(t *testing.T) {
+	logger, err := mlog.NewLogger()
+	require.NoError(t, err)
+
+	board := &model.Board{
+		ID: "board-id",
+		CardProperties: []map[string]interface{}{
+			{
+				"id":   "status",
+				"name": "Status",
+				"type": "select",
+				"options": []interface{}{
+					map[string]interface{}{"id": "todo", "value": "Todo"},
+					map[string]interface{}{"id": "done", "value": "Done"},
+				},
+			},
+		},
+	}
+	cardVersion := func(updateAt int64, modifiedBy, title, status string) *model.Block {
+		return &model.Block{
+			ID: "card", BoardID: board.ID, ParentID: board.ID, Type: model.TypeCard, Title: title,
+			ModifiedBy: modifiedBy, UpdateAt: updateAt,
+			Fields: map[string]interface{}{"properties": map[string]interface{}{"status": status}},
+		}
+	}
+	textVersion := func(id string, updateAt, deleteAt int64, modifiedBy, text string) *model.Block {
+		return &model.Block{
+			ID: id, BoardID: board.ID, ParentID: "card", Type: model.TypeText, Title: text,
+			ModifiedBy: modifiedBy, UpdateAt: updateAt, DeleteAt: deleteAt,
+		}
+	}
+
+	api := &historyDiffAPI{history: []*model.Block{
+		cardVersion(100, "user-1", "Launch", "todo"),
+		textVersion("text-1", 110, 0, "user-1", "first draft of the plan"),
+		textVersion("text-2", 120, 0, "user-1", "to be removed"),
+		cardVersion(200, "user-2", "Launch rocket", "todo"),
+		textVersion("text-1", 210, 0, "user-2", "final draft of the plan"),
+		textVersion("text-2", 220, 220, "user-2", "to be removed"),
+		textVersion("text-3", 230, 0, "user-3", "new notes"),
+		cardVersion(300, "user-3", "Launch rocket", "done"),
+	}}
+
+	t.Run("between two versions", func(t *testing.T) {
+		diff, err := GenerateCardDiff(api, board, "card", 150, 250, logger)
+		require.NoError(t, err)
+
+		assert.Equal(t, []string{"user-2", "user-3"}, diff.Authors)
+		require.NotNil(t, diff.Title)
+		assert.Equal(t, "Launch", diff.Title.OldValue)
+		assert.Equal(t, "Launch rocket", diff.Title.NewValue)
+		assert.Equal(t, "Launch` rocket`", diff.Title.Markdown)
+		assert.Empty(t, diff.Properties)
+
+		require.Len(t, diff.Content, 3)
+		assert.Equal(t, "text-1", diff.Content[0].BlockID)
+		assert.Equal(t, model.ContentChanged, diff.Content[0].Change)
+		assert.Equal(t, "fi~~`rst`~~`nal` draft of the plan", diff.Content[0].Text.Markdown)
+		assert.Equal(t, "text-2", diff.Content[1].BlockID)
+		assert.Equal(t, model.ContentRemoved, diff.Content[1].Change)
+		assert.Equal(t, "to be removed", diff.Content[1].Text.OldValue)
+		assert.Equal(t, "text-3", diff.Content[2].BlockID)
+		assert.Equal(t, model.ContentAdded, diff.Content[2].Change)
+		assert.Equal(t, "new notes", diff.Content[2].Text.NewValue)
+	})
+
+	t.Run("property change only", func(t *testing.T) {
+		diff, err := GenerateCardDiff(api, board, "card", 250, 350, logger)
+		require.NoError(t, err)
+
+		assert.Equal(t, []string{"user-3"}, diff.Authors)
+		assert.Nil(t, diff.Title)
+		assert.Empty(t, diff.Content)
+		require.Len(t, diff.Properties, 1)
+		assert.Equal(t, "Status", diff.Properties[0].Name)
+		assert.Equal(t, "TODO", diff.Properties[0].OldValue)
+		assert.Equal(t, "DONE", diff.Properties[0].NewValue)
+	})
+
+	t.Run("card created in between", func(t *testing.T) {
+		diff, err := GenerateCardDiff(api, board, "card", 50, 150, logger)
+		require.NoError(t, err)
+
+		require.NotNil(t, diff.Title)
+		assert.Equal(t, "", diff.Title.OldValue)
+		require.Len(t, diff.Content, 2)
+		assert.Equal(t, model.ContentAdded, diff.Content[0].Change)
+		assert.Equal(t, model.ContentAdded, diff.Content[1].Change)
+	})
+
+	t.Run("card not created yet", func(t *testing.T) {
+		_, err := GenerateCardDiff(api, board, "card", 10, 50, logger)
+		assert.True(t, model.IsErrNotFound(err))
+	})
+}
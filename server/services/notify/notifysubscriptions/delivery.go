@@ -1,10 +1,13 @@
 // Copyright (c) 2020-present Mattermost, Inc. All Rights Reserved.
 // See LICENSE.txt for license information.
 
+//go:generate mockgen -destination=mocks/mockdelivery.go -package mocks . SubscriptionDelivery
+
 package notifysubscriptions
 
 import (
 	"github.com/mattermost/mattermost-plugin-boards/server/model"
+	"github.com/mattermost/mattermost-plugin-boards/server/services/notify"
 
 	mm_model "github.com/mattermost/mattermost/server/public/model"
 )
@@ -14,4 +17,14 @@ import (
 type SubscriptionDelivery interface {
 	SubscriptionDeliverSlackAttachments(teamID string, subscriberID string, subscriberType model.SubscriberType,
 		attachments []*mm_model.SlackAttachment) error
+
+	// AssignmentDeliver notifies a user they were assigned to, or removed from, a person property of a card.
+	AssignmentDeliver(assigneeID string, assigned bool, propName string, evt notify.BlockChangeEvent) error
+
+	// ReplyDeliver notifies the author of a comment that someone replied to it.
+	ReplyDeliver(authorID string, extract string, evt notify.BlockChangeEvent) error
+
+	// ImagePreviewURL returns a URL of the preview of an image that can be embedded in a
+	// notification, or an empty string if there is none.
+	ImagePreviewURL(teamID, boardID, fileID string) string
 }
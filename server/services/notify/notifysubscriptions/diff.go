@@ -24,6 +24,10 @@ type Diff struct {
 
 	UpdateAt int64 // the UpdateAt of the latest version of the block
 
+	// ChildrenOnly is true if the block itself is unchanged or its own changes are not to be
+	// reported, so that the diff only carries the diffs of its children.
+	ChildrenOnly bool
+
 	schemaDiffs []SchemaDiff
 	PropDiffs   []PropDiff
 
@@ -36,6 +40,10 @@ type PropDiff struct {
 	Name     string
 	OldValue string
 	NewValue string
+
+	// users added to or removed from `person` and `multiPerson` properties.
+	AddedUserIDs   []string
+	RemovedUserIDs []string
 }
 
 type SchemaDiff struct {
@@ -49,9 +57,10 @@ type diffGenerator struct {
 	board *model.Board
 	card  *model.Block
 
-	store        AppAPI
+	store        DiffAPI
 	hint         *model.NotificationHint
 	lastNotifyAt int64
+	untilAt      int64 // if non-zero, the versions of the blocks at this time are diffed instead of the latest
 	logger       mlog.LoggerIFace
 }
 
@@ -152,6 +161,9 @@ func (dg *diffGenerator) generateDiffsForCard(card *model.Block, schema model.Pr
 	opts := model.QueryBlockHistoryChildOptions{
 		AfterUpdateAt: dg.lastNotifyAt,
 	}
+	if dg.untilAt != 0 {
+		opts.BeforeUpdateAt = dg.untilAt + 1
+	}
 	blocks, _, err := dg.store.GetBlockHistoryNewestChildren(card.ID, opts)
 	if err != nil {
 		return nil, fmt.Errorf("could not get subtree for card %s: %w", card.ID, err)
@@ -186,15 +198,16 @@ func (dg *diffGenerator) generateDiffsForCard(card *model.Block, schema model.Pr
 	if len(childDiffs) != 0 {
 		if cardDiff == nil { // will be nil if the card has no other changes besides child diffs
 			cardDiff = &Diff{
-				Board:       dg.board,
-				Card:        card,
-				Authors:     make(StringMap),
-				BlockType:   card.Type,
-				OldBlock:    card,
-				NewBlock:    card,
-				UpdateAt:    card.UpdateAt,
-				PropDiffs:   nil,
-				schemaDiffs: nil,
+				Board:        dg.board,
+				Card:         card,
+				Authors:      make(StringMap),
+				BlockType:    card.Type,
+				OldBlock:     card,
+				NewBlock:     card,
+				UpdateAt:     card.UpdateAt,
+				ChildrenOnly: true,
+				PropDiffs:    nil,
+				schemaDiffs:  nil,
 			}
 		}
 		cardDiff.Diffs = childDiffs
@@ -241,6 +254,9 @@ func (dg *diffGenerator) generateDiffForBlock(newBlock *model.Block, schema mode
 		AfterUpdateAt: dg.lastNotifyAt,
 		Descending:    true,
 	}
+	if dg.untilAt != 0 {
+		opts.BeforeUpdateAt = dg.untilAt + 1
+	}
 	chgBlocks, err := dg.store.GetBlockHistory(newBlock.ID, opts)
 	if err != nil {
 		return nil, fmt.Errorf("error getting block history for block %s: %w", newBlock.ID, err)
@@ -291,20 +307,26 @@ func (dg *diffGenerator) generateDiffForBlock(newBlock *model.Block, schema mode
 }
 
 func (dg *diffGenerator) generatePropDiffs(oldBlock, newBlock *model.Block, schema model.PropSchema) []PropDiff {
+	return GeneratePropDiffs(oldBlock, newBlock, schema, dg.store, dg.logger)
+}
+
+// GeneratePropDiffs returns the differences between the properties of two versions of a card,
+// sorted by property index. oldBlock is nil for a new card.
+func GeneratePropDiffs(oldBlock, newBlock *model.Block, schema model.PropSchema, resolver model.PropValueResolver, logger mlog.LoggerIFace) []PropDiff {
 	var propDiffs []PropDiff
 
-	oldProps, err := model.ParseProperties(oldBlock, schema, dg.store)
+	oldProps, err := model.ParseProperties(oldBlock, schema, resolver)
 	if err != nil {
-		dg.logger.Error("Cannot parse properties for old block",
+		logger.Error("Cannot parse properties for old block",
 			mlog.String("block_id", oldBlock.ID),
 			mlog.Err(err),
 		)
 	}
 
-	newProps, err := model.ParseProperties(newBlock, schema, dg.store)
+	newProps, err := model.ParseProperties(newBlock, schema, resolver)
 	if err != nil {
-		dg.logger.Error("Cannot parse properties for new block",
-			mlog.String("block_id", oldBlock.ID),
+		logger.Error("Cannot parse properties for new block",
+			mlog.String("block_id", newBlock.ID),
 			mlog.Err(err),
 		)
 	}
@@ -349,9 +371,41 @@ func (dg *diffGenerator) generatePropDiffs(oldBlock, newBlock *model.Block, sche
 			})
 		}
 	}
+
+	for i := range propDiffs {
+		def, ok := schema[propDiffs[i].ID]
+		if !ok || (def.Type != propTypePerson && def.Type != propTypeMultiPerson) {
+			continue
+		}
+		propDiffs[i].AddedUserIDs, propDiffs[i].RemovedUserIDs = diffUserIDs(
+			personPropValues(oldBlock, def),
+			personPropValues(newBlock, def),
+		)
+	}
 	return sortPropDiffs(propDiffs)
 }
 
+// diffUserIDs returns the user ids present in newIDs but not oldIDs, and vice versa.
+func diffUserIDs(oldIDs, newIDs []string) (added []string, removed []string) {
+	oldSet := make(map[string]struct{}, len(oldIDs))
+	for _, id := range oldIDs {
+		oldSet[id] = struct{}{}
+	}
+	newSet := make(map[string]struct{}, len(newIDs))
+	for _, id := range newIDs {
+		newSet[id] = struct{}{}
+		if _, ok := oldSet[id]; !ok {
+			added = append(added, id)
+		}
+	}
+	for _, id := range oldIDs {
+		if _, ok := newSet[id]; !ok {
+			removed = append(removed, id)
+		}
+	}
+	return added, removed
+}
+
 func sortPropDiffs(propDiffs []PropDiff) []PropDiff {
 	if len(propDiffs) == 0 {
 		return propDiffs
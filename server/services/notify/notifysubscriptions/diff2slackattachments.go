@@ -44,6 +44,7 @@ type DiffConvOpts struct {
 	Language      string
 	MakeCardLink  func(block *model.Block, board *model.Board, card *model.Block) string
 	MakeBoardLink func(board *model.Board) string
+	MakeImageURL  func(board *model.Board, fileID string) string
 	Logger        mlog.LoggerIFace
 }
 
@@ -151,7 +152,7 @@ func cardDiff2SlackAttachment(cardDiff *Diff, opts DiffConvOpts) (*mm_model.Slac
 	buf := &bytes.Buffer{}
 
 	// card added
-	if cardDiff.NewBlock != nil && cardDiff.OldBlock == nil {
+	if cardDiff.NewBlock != nil && cardDiff.OldBlock == nil && !cardDiff.ChildrenOnly {
 		if err := execTemplate(buf, "AddCardNotify", opts, defAddCardNotify, cardDiff); err != nil {
 			return nil, err
 		}
@@ -163,7 +164,7 @@ func cardDiff2SlackAttachment(cardDiff *Diff, opts DiffConvOpts) (*mm_model.Slac
 	}
 
 	// card deleted
-	if (cardDiff.NewBlock == nil || cardDiff.NewBlock.DeleteAt != 0) && cardDiff.OldBlock != nil {
+	if (cardDiff.NewBlock == nil || cardDiff.NewBlock.DeleteAt != 0) && cardDiff.OldBlock != nil && !cardDiff.ChildrenOnly {
 		buf.Reset()
 		if err := execTemplate(buf, "DeleteCardNotify", opts, defDeleteCardNotify, cardDiff); err != nil {
 			return nil, err
@@ -175,13 +176,14 @@ func cardDiff2SlackAttachment(cardDiff *Diff, opts DiffConvOpts) (*mm_model.Slac
 		return attachment, nil
 	}
 
-	// at this point new and old block are non-nil
+	// at this point the new block is non-nil, and so is the old block unless only the changes of
+	// the children are reported.
 
 	opts.Logger.Debug("cardDiff2SlackAttachment",
 		mlog.String("board_id", cardDiff.Board.ID),
 		mlog.String("card_id", cardDiff.Card.ID),
 		mlog.String("new_block_id", cardDiff.NewBlock.ID),
-		mlog.String("old_block_id", cardDiff.OldBlock.ID),
+		mlog.Bool("children_only", cardDiff.ChildrenOnly),
 		mlog.Int("childDiffs", len(cardDiff.Diffs)),
 	)
 
@@ -213,10 +215,40 @@ func cardDiff2SlackAttachment(cardDiff *Diff, opts DiffConvOpts) (*mm_model.Slac
 	if len(attachment.Fields) == 0 {
 		return nil, nil
 	}
+
+	// preview of the first image added
+	attachment.ImageURL = addedImageURL(cardDiff, opts)
+
 	return attachment, nil
 }
 
+// addedImageURL returns the URL of the preview of the first image added to the card, or an empty
+// string if no image was added.
+func addedImageURL(cardDiff *Diff, opts DiffConvOpts) string {
+	if opts.MakeImageURL == nil {
+		return ""
+	}
+
+	for _, child := range cardDiff.Diffs {
+		if child.BlockType != model.TypeImage || child.OldBlock != nil || child.NewBlock == nil || child.NewBlock.DeleteAt != 0 {
+			continue
+		}
+		fileID, _ := child.NewBlock.Fields[model.BlockFieldFileId].(string)
+		if fileID == "" {
+			continue
+		}
+		if url := opts.MakeImageURL(cardDiff.Board, fileID); url != "" {
+			return url
+		}
+	}
+	return ""
+}
+
 func appendTitleChanges(fields []*mm_model.SlackAttachmentField, cardDiff *Diff) []*mm_model.SlackAttachmentField {
+	if cardDiff.ChildrenOnly {
+		return fields
+	}
+
 	if cardDiff.NewBlock.Title != cardDiff.OldBlock.Title {
 		fields = append(fields, &mm_model.SlackAttachmentField{
 			Short: false,
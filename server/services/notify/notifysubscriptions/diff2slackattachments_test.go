@@ -0,0 +1,83 @@
+// Copyright (c) 2020-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package notifysubscriptions
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mattermost/mattermost-plugin-boards/server/model"
+
+	"github.com/mattermost/mattermost/server/public/shared/mlog"
+)
+
+func Test_addedImageURL(t *testing.T) {
+	board := &model.Board{ID: "board-id", TeamID: "team-id"}
+	image := func(fileID string) *model.Block {
+		return &model.Block{Type: model.TypeImage, Fields: map[string]any{model.BlockFieldFileId: fileID}}
+	}
+	opts := DiffConvOpts{
+		MakeImageURL: func(board *model.Board, fileID string) string {
+			return "https://signed/" + board.TeamID + "/" + board.ID + "/" + fileID
+		},
+	}
+
+	cardDiff := &Diff{
+		Board: board,
+		Diffs: []*Diff{
+			{BlockType: model.TypeText, NewBlock: &model.Block{Type: model.TypeText}},
+			{BlockType: model.TypeImage, OldBlock: image("modified.png"), NewBlock: image("modified.png")},
+			{BlockType: model.TypeImage, NewBlock: image("added.png")},
+			{BlockType: model.TypeImage, NewBlock: image("second.png")},
+		},
+	}
+
+	assert.Equal(t, "https://signed/team-id/board-id/added.png", addedImageURL(cardDiff, opts))
+
+	t.Run("no image added", func(t *testing.T) {
+		assert.Empty(t, addedImageURL(&Diff{Board: board, Diffs: cardDiff.Diffs[:2]}, opts))
+	})
+
+	t.Run("no image URLs", func(t *testing.T) {
+		assert.Empty(t, addedImageURL(cardDiff, DiffConvOpts{}))
+	})
+}
+
+func Test_cardDiff2SlackAttachmentChildrenOnly(t *testing.T) {
+	board := &model.Board{ID: "board-id", TeamID: "team-id"}
+	card := &model.Block{ID: "card-id", Type: model.TypeCard, Title: "new title"}
+	comment := &model.Block{ID: "comment-id", Type: model.TypeComment, Title: "a comment"}
+	opts := DiffConvOpts{
+		MakeCardLink:  func(block *model.Block, board *model.Board, card *model.Block) string { return "card-link" },
+		MakeBoardLink: func(board *model.Board) string { return "board-link" },
+		Logger:        mlog.CreateConsoleTestLogger(t),
+	}
+
+	// an added card carrying a comment is reported as modified, with the comment only.
+	cardDiff := &Diff{
+		Board:        board,
+		Card:         card,
+		Authors:      StringMap{},
+		BlockType:    model.TypeCard,
+		NewBlock:     card,
+		ChildrenOnly: true,
+		Diffs:        []*Diff{{BlockType: model.TypeComment, NewBlock: comment}},
+	}
+
+	attachment, err := cardDiff2SlackAttachment(cardDiff, opts)
+	require.NoError(t, err)
+	require.NotNil(t, attachment)
+	require.Len(t, attachment.Fields, 1)
+	assert.Contains(t, attachment.Fields[0].Value, "a comment")
+
+	// the title change of a card is not reported either.
+	cardDiff.OldBlock = &model.Block{ID: "card-id", Type: model.TypeCard, Title: "old title"}
+	attachment, err = cardDiff2SlackAttachment(cardDiff, opts)
+	require.NoError(t, err)
+	require.NotNil(t, attachment)
+	require.Len(t, attachment.Fields, 1)
+	assert.NotContains(t, attachment.Fields[0].Value, "old title")
+}
@@ -0,0 +1,232 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/mattermost/mattermost-plugin-boards/server/services/notify/notifysubscriptions (interfaces: AppAPI)
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	reflect "reflect"
+	time "time"
+
+	gomock "github.com/golang/mock/gomock"
+	model "github.com/mattermost/mattermost-plugin-boards/server/model"
+)
+
+// MockAppAPI is a mock of AppAPI interface.
+type MockAppAPI struct {
+	ctrl     *gomock.Controller
+	recorder *MockAppAPIMockRecorder
+}
+
+// MockAppAPIMockRecorder is the mock recorder for MockAppAPI.
+type MockAppAPIMockRecorder struct {
+	mock *MockAppAPI
+}
+
+// NewMockAppAPI creates a new mock instance.
+func NewMockAppAPI(ctrl *gomock.Controller) *MockAppAPI {
+	mock := &MockAppAPI{ctrl: ctrl}
+	mock.recorder = &MockAppAPIMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockAppAPI) EXPECT() *MockAppAPIMockRecorder {
+	return m.recorder
+}
+
+// CreateNotification mocks base method.
+func (m *MockAppAPI) CreateNotification(arg0 *model.Notification) (*model.Notification, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateNotification", arg0)
+	ret0, _ := ret[0].(*model.Notification)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateNotification indicates an expected call of CreateNotification.
+func (mr *MockAppAPIMockRecorder) CreateNotification(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateNotification", reflect.TypeOf((*MockAppAPI)(nil).CreateNotification), arg0)
+}
+
+// CreateSubscription mocks base method.
+func (m *MockAppAPI) CreateSubscription(arg0 *model.Subscription) (*model.Subscription, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateSubscription", arg0)
+	ret0, _ := ret[0].(*model.Subscription)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateSubscription indicates an expected call of CreateSubscription.
+func (mr *MockAppAPIMockRecorder) CreateSubscription(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateSubscription", reflect.TypeOf((*MockAppAPI)(nil).CreateSubscription), arg0)
+}
+
+// GetBlockByID mocks base method.
+func (m *MockAppAPI) GetBlockByID(arg0 string) (*model.Block, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetBlockByID", arg0)
+	ret0, _ := ret[0].(*model.Block)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetBlockByID indicates an expected call of GetBlockByID.
+func (mr *MockAppAPIMockRecorder) GetBlockByID(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBlockByID", reflect.TypeOf((*MockAppAPI)(nil).GetBlockByID), arg0)
+}
+
+// GetBlockHistory mocks base method.
+func (m *MockAppAPI) GetBlockHistory(arg0 string, arg1 model.QueryBlockHistoryOptions) ([]*model.Block, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetBlockHistory", arg0, arg1)
+	ret0, _ := ret[0].([]*model.Block)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetBlockHistory indicates an expected call of GetBlockHistory.
+func (mr *MockAppAPIMockRecorder) GetBlockHistory(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBlockHistory", reflect.TypeOf((*MockAppAPI)(nil).GetBlockHistory), arg0, arg1)
+}
+
+// GetBlockHistoryNewestChildren mocks base method.
+func (m *MockAppAPI) GetBlockHistoryNewestChildren(arg0 string, arg1 model.QueryBlockHistoryChildOptions) ([]*model.Block, bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetBlockHistoryNewestChildren", arg0, arg1)
+	ret0, _ := ret[0].([]*model.Block)
+	ret1, _ := ret[1].(bool)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetBlockHistoryNewestChildren indicates an expected call of GetBlockHistoryNewestChildren.
+func (mr *MockAppAPIMockRecorder) GetBlockHistoryNewestChildren(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBlockHistoryNewestChildren", reflect.TypeOf((*MockAppAPI)(nil).GetBlockHistoryNewestChildren), arg0, arg1)
+}
+
+// GetBoardAndCardByID mocks base method.
+func (m *MockAppAPI) GetBoardAndCardByID(arg0 string) (*model.Board, *model.Block, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetBoardAndCardByID", arg0)
+	ret0, _ := ret[0].(*model.Board)
+	ret1, _ := ret[1].(*model.Block)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetBoardAndCardByID indicates an expected call of GetBoardAndCardByID.
+func (mr *MockAppAPIMockRecorder) GetBoardAndCardByID(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBoardAndCardByID", reflect.TypeOf((*MockAppAPI)(nil).GetBoardAndCardByID), arg0)
+}
+
+// GetNextNotificationHint mocks base method.
+func (m *MockAppAPI) GetNextNotificationHint(arg0 bool) (*model.NotificationHint, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetNextNotificationHint", arg0)
+	ret0, _ := ret[0].(*model.NotificationHint)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetNextNotificationHint indicates an expected call of GetNextNotificationHint.
+func (mr *MockAppAPIMockRecorder) GetNextNotificationHint(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetNextNotificationHint", reflect.TypeOf((*MockAppAPI)(nil).GetNextNotificationHint), arg0)
+}
+
+// GetNotificationHintCount mocks base method.
+func (m *MockAppAPI) GetNotificationHintCount() (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetNotificationHintCount")
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetNotificationHintCount indicates an expected call of GetNotificationHintCount.
+func (mr *MockAppAPIMockRecorder) GetNotificationHintCount() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetNotificationHintCount", reflect.TypeOf((*MockAppAPI)(nil).GetNotificationHintCount))
+}
+
+// GetNotificationPreferences mocks base method.
+func (m *MockAppAPI) GetNotificationPreferences(arg0, arg1 string) (*model.NotificationPreferences, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetNotificationPreferences", arg0, arg1)
+	ret0, _ := ret[0].(*model.NotificationPreferences)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetNotificationPreferences indicates an expected call of GetNotificationPreferences.
+func (mr *MockAppAPIMockRecorder) GetNotificationPreferences(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetNotificationPreferences", reflect.TypeOf((*MockAppAPI)(nil).GetNotificationPreferences), arg0, arg1)
+}
+
+// GetSubscribersForBlock mocks base method.
+func (m *MockAppAPI) GetSubscribersForBlock(arg0 string) ([]*model.Subscriber, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSubscribersForBlock", arg0)
+	ret0, _ := ret[0].([]*model.Subscriber)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSubscribersForBlock indicates an expected call of GetSubscribersForBlock.
+func (mr *MockAppAPIMockRecorder) GetSubscribersForBlock(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSubscribersForBlock", reflect.TypeOf((*MockAppAPI)(nil).GetSubscribersForBlock), arg0)
+}
+
+// GetUserByID mocks base method.
+func (m *MockAppAPI) GetUserByID(arg0 string) (*model.User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUserByID", arg0)
+	ret0, _ := ret[0].(*model.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUserByID indicates an expected call of GetUserByID.
+func (mr *MockAppAPIMockRecorder) GetUserByID(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserByID", reflect.TypeOf((*MockAppAPI)(nil).GetUserByID), arg0)
+}
+
+// UpdateSubscribersNotifiedAt mocks base method.
+func (m *MockAppAPI) UpdateSubscribersNotifiedAt(arg0 string, arg1 int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateSubscribersNotifiedAt", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateSubscribersNotifiedAt indicates an expected call of UpdateSubscribersNotifiedAt.
+func (mr *MockAppAPIMockRecorder) UpdateSubscribersNotifiedAt(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateSubscribersNotifiedAt", reflect.TypeOf((*MockAppAPI)(nil).UpdateSubscribersNotifiedAt), arg0, arg1)
+}
+
+// UpsertNotificationHint mocks base method.
+func (m *MockAppAPI) UpsertNotificationHint(arg0 *model.NotificationHint, arg1 time.Duration) (*model.NotificationHint, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpsertNotificationHint", arg0, arg1)
+	ret0, _ := ret[0].(*model.NotificationHint)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpsertNotificationHint indicates an expected call of UpsertNotificationHint.
+func (mr *MockAppAPIMockRecorder) UpsertNotificationHint(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpsertNotificationHint", reflect.TypeOf((*MockAppAPI)(nil).UpsertNotificationHint), arg0, arg1)
+}
@@ -0,0 +1,93 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/mattermost/mattermost-plugin-boards/server/services/notify/notifysubscriptions (interfaces: SubscriptionDelivery)
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+	model "github.com/mattermost/mattermost-plugin-boards/server/model"
+	notify "github.com/mattermost/mattermost-plugin-boards/server/services/notify"
+	model0 "github.com/mattermost/mattermost/server/public/model"
+)
+
+// MockSubscriptionDelivery is a mock of SubscriptionDelivery interface.
+type MockSubscriptionDelivery struct {
+	ctrl     *gomock.Controller
+	recorder *MockSubscriptionDeliveryMockRecorder
+}
+
+// MockSubscriptionDeliveryMockRecorder is the mock recorder for MockSubscriptionDelivery.
+type MockSubscriptionDeliveryMockRecorder struct {
+	mock *MockSubscriptionDelivery
+}
+
+// NewMockSubscriptionDelivery creates a new mock instance.
+func NewMockSubscriptionDelivery(ctrl *gomock.Controller) *MockSubscriptionDelivery {
+	mock := &MockSubscriptionDelivery{ctrl: ctrl}
+	mock.recorder = &MockSubscriptionDeliveryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockSubscriptionDelivery) EXPECT() *MockSubscriptionDeliveryMockRecorder {
+	return m.recorder
+}
+
+// AssignmentDeliver mocks base method.
+func (m *MockSubscriptionDelivery) AssignmentDeliver(arg0 string, arg1 bool, arg2 string, arg3 notify.BlockChangeEvent) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AssignmentDeliver", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AssignmentDeliver indicates an expected call of AssignmentDeliver.
+func (mr *MockSubscriptionDeliveryMockRecorder) AssignmentDeliver(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AssignmentDeliver", reflect.TypeOf((*MockSubscriptionDelivery)(nil).AssignmentDeliver), arg0, arg1, arg2, arg3)
+}
+
+// ImagePreviewURL mocks base method.
+func (m *MockSubscriptionDelivery) ImagePreviewURL(arg0, arg1, arg2 string) string {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ImagePreviewURL", arg0, arg1, arg2)
+	ret0, _ := ret[0].(string)
+	return ret0
+}
+
+// ImagePreviewURL indicates an expected call of ImagePreviewURL.
+func (mr *MockSubscriptionDeliveryMockRecorder) ImagePreviewURL(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ImagePreviewURL", reflect.TypeOf((*MockSubscriptionDelivery)(nil).ImagePreviewURL), arg0, arg1, arg2)
+}
+
+// ReplyDeliver mocks base method.
+func (m *MockSubscriptionDelivery) ReplyDeliver(arg0, arg1 string, arg2 notify.BlockChangeEvent) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReplyDeliver", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ReplyDeliver indicates an expected call of ReplyDeliver.
+func (mr *MockSubscriptionDeliveryMockRecorder) ReplyDeliver(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReplyDeliver", reflect.TypeOf((*MockSubscriptionDelivery)(nil).ReplyDeliver), arg0, arg1, arg2)
+}
+
+// SubscriptionDeliverSlackAttachments mocks base method.
+func (m *MockSubscriptionDelivery) SubscriptionDeliverSlackAttachments(arg0, arg1 string, arg2 model.SubscriberType, arg3 []*model0.SlackAttachment) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SubscriptionDeliverSlackAttachments", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SubscriptionDeliverSlackAttachments indicates an expected call of SubscriptionDeliverSlackAttachments.
+func (mr *MockSubscriptionDeliveryMockRecorder) SubscriptionDeliverSlackAttachments(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SubscriptionDeliverSlackAttachments", reflect.TypeOf((*MockSubscriptionDelivery)(nil).SubscriptionDeliverSlackAttachments), arg0, arg1, arg2, arg3)
+}
@@ -6,10 +6,13 @@ package notifysubscriptions
 import (
 	"errors"
 	"fmt"
+	"sort"
+	"strings"
 	"sync"
 	"time"
 
 	"github.com/mattermost/mattermost-plugin-boards/server/model"
+	"github.com/mattermost/mattermost-plugin-boards/server/services/notify"
 	"github.com/mattermost/mattermost-plugin-boards/server/services/permissions"
 	"github.com/mattermost/mattermost-plugin-boards/server/utils"
 	"github.com/wiggin77/merror"
@@ -21,6 +24,7 @@ const (
 	defBlockNotificationFreq = time.Minute * 2
 	enqueueNotifyHintTimeout = time.Second * 10
 	hintQueueSize            = 20
+	hintsPendingObserveFreq  = time.Minute * 1
 )
 
 var (
@@ -39,8 +43,9 @@ type notifier struct {
 
 	hints chan *model.NotificationHint
 
-	mux  sync.Mutex
-	done chan struct{}
+	mux     sync.Mutex
+	done    chan struct{}
+	metrics notify.Metrics
 }
 
 func newNotifier(params BackendParams) *notifier {
@@ -75,10 +80,42 @@ func (n *notifier) stop() {
 	}
 }
 
+func (n *notifier) setMetrics(metrics notify.Metrics) {
+	n.mux.Lock()
+	defer n.mux.Unlock()
+	n.metrics = metrics
+}
+
+func (n *notifier) getMetrics() notify.Metrics {
+	n.mux.Lock()
+	defer n.mux.Unlock()
+	return n.metrics
+}
+
+// observeHintsPending records the number of hints waiting to be processed. It counts the
+// whole table, so the loop only calls it every hintsPendingObserveFreq.
+func (n *notifier) observeHintsPending() {
+	metrics := n.getMetrics()
+	if metrics == nil {
+		return
+	}
+
+	count, err := n.store.GetNotificationHintCount()
+	if err != nil {
+		n.logger.Warn("notify loop - error counting notification hints", mlog.Err(err))
+		return
+	}
+	metrics.ObserveNotificationHintsPending(count)
+}
+
 func (n *notifier) loop() {
 	done := n.done
 	var nextNotify time.Time
 
+	observeTicker := time.NewTicker(hintsPendingObserveFreq)
+	defer observeTicker.Stop()
+	n.observeHintsPending()
+
 	for {
 		hint, err := n.store.GetNextNotificationHint(false)
 		switch {
@@ -108,6 +145,8 @@ func (n *notifier) loop() {
 			// A new hint was added. Wake up and check if next hint is ready to go.
 		case <-time.After(time.Until(nextNotify)):
 			// Next scheduled hint should be ready now.
+		case <-observeTicker.C:
+			n.observeHintsPending()
 		case <-done:
 			return
 		}
@@ -142,6 +181,9 @@ func (n *notifier) notify() {
 
 	if err = n.notifySubscribers(hint); err != nil {
 		n.logger.Error("Error notifying subscribers", mlog.Err(err))
+		if metrics := n.getMetrics(); metrics != nil {
+			metrics.IncrementNotificationDeliveryFailures(backendName)
+		}
 	}
 }
 
@@ -208,6 +250,9 @@ func (n *notifier) notifySubscribers(hint *model.NotificationHint) error {
 		MakeBoardLink: func(board *model.Board) string {
 			return fmt.Sprintf("[%s](%s)", board.Title, utils.MakeBoardLink(n.serverRoot, board.TeamID, board.ID))
 		},
+		MakeImageURL: func(board *model.Board, fileID string) string {
+			return n.delivery.ImagePreviewURL(board.TeamID, board.ID, fileID)
+		},
 		Logger: n.logger,
 	}
 
@@ -216,10 +261,17 @@ func (n *notifier) notifySubscribers(hint *model.NotificationHint) error {
 		return err
 	}
 
+	schema, err := model.ParsePropertySchema(board)
+	if err != nil {
+		n.logger.Error("notifySubscribers - cannot parse property schema", mlog.String("board_id", board.ID), mlog.Err(err))
+		schema = model.PropSchema{}
+	}
+
 	merr := merror.New()
 	if len(attachments) > 0 {
 		// 보드가 채널에 연결되어 있으면 채널로만 알림 전송
-		if board.ChannelID != "" {
+		deliverToChannel := board.ChannelID != ""
+		if deliverToChannel {
 			n.logger.Debug("notifySubscribers - deliver to channel",
 				mlog.Any("hint", hint),
 				mlog.String("board_id", board.ID),
@@ -230,42 +282,75 @@ func (n *notifier) notifySubscribers(hint *model.NotificationHint) error {
 			if err = n.delivery.SubscriptionDeliverSlackAttachments(board.TeamID, board.ChannelID, model.SubTypeChannel, attachments); err != nil {
 				merr.Append(fmt.Errorf("cannot deliver notification to channel %s: %w", board.ChannelID, err))
 			}
-		} else {
-			// 채널 연결이 없으면 개인 구독자들에게 DM 전송
-			for _, sub := range subs {
-				// don't notify the author of their own changes.
-				authorName, isAuthor := diffAuthors[sub.SubscriberID]
-				if isAuthor && len(diffAuthors) == 1 {
-					n.logger.Debug("notifySubscribers - skipping author",
-						mlog.Any("hint", hint),
-						mlog.String("author_id", sub.SubscriberID),
-						mlog.String("author_username", authorName),
-					)
-					continue
-				}
+		}
 
-				// make sure the subscriber still has permissions for the board.
-				if !n.permissions.HasPermissionToBoard(sub.SubscriberID, board.ID, model.PermissionViewBoard) {
-					n.logger.Debug("notifySubscribers - skipping non-board member",
-						mlog.Any("hint", hint),
-						mlog.String("subscriber_id", sub.SubscriberID),
-						mlog.String("board_id", board.ID),
-					)
+		// 채널 연결이 없으면 개인 구독자들에게 DM 전송. 인앱 알림함에는 항상 기록한다.
+		for _, sub := range subs {
+			if deliverToChannel && sub.SubscriberType != model.SubTypeUser {
+				continue
+			}
+
+			// don't notify the author of their own changes.
+			authorName, isAuthor := diffAuthors[sub.SubscriberID]
+			if isAuthor && len(diffAuthors) == 1 {
+				n.logger.Debug("notifySubscribers - skipping author",
+					mlog.Any("hint", hint),
+					mlog.String("author_id", sub.SubscriberID),
+					mlog.String("author_username", authorName),
+				)
+				continue
+			}
+
+			// make sure the subscriber still has permissions for the board.
+			if !n.permissions.HasPermissionToBoard(sub.SubscriberID, board.ID, model.PermissionViewBoard) {
+				n.logger.Debug("notifySubscribers - skipping non-board member",
+					mlog.Any("hint", hint),
+					mlog.String("subscriber_id", sub.SubscriberID),
+					mlog.String("board_id", board.ID),
+				)
+				continue
+			}
+
+			// apply the subscriber's notification preferences for the board.
+			subAttachments := attachments
+			prefs := getNotificationPreferences(n.store, sub.SubscriberID, board.ID, n.logger)
+			if !prefs.IsDefault() {
+				subAttachments, err = Diffs2SlackAttachments(filterDiffsForPreferences(diffs, prefs, schema), opts)
+				if err != nil {
+					merr.Append(fmt.Errorf("cannot filter notification for subscriber %s: %w", sub.SubscriberID, err))
 					continue
 				}
-
-				n.logger.Debug("notifySubscribers - deliver",
+			}
+			if len(subAttachments) == 0 {
+				n.logger.Debug("notifySubscribers - skipping subscriber per notification preferences",
 					mlog.Any("hint", hint),
-					mlog.String("modified_by_id", hint.ModifiedByID),
 					mlog.String("subscriber_id", sub.SubscriberID),
-					mlog.String("subscriber_type", string(sub.SubscriberType)),
+					mlog.String("board_id", board.ID),
 				)
+				continue
+			}
 
-				if err = n.delivery.SubscriptionDeliverSlackAttachments(board.TeamID, sub.SubscriberID, sub.SubscriberType, attachments); err != nil {
-					merr.Append(fmt.Errorf("cannot deliver notification to subscriber %s [%s]: %w",
-						sub.SubscriberID, sub.SubscriberType, err))
+			if sub.SubscriberType == model.SubTypeUser {
+				if err = n.addToInbox(sub.SubscriberID, board, card, hint, diffAuthors); err != nil {
+					merr.Append(fmt.Errorf("cannot add notification to inbox of subscriber %s: %w", sub.SubscriberID, err))
 				}
 			}
+
+			if deliverToChannel {
+				continue
+			}
+
+			n.logger.Debug("notifySubscribers - deliver",
+				mlog.Any("hint", hint),
+				mlog.String("modified_by_id", hint.ModifiedByID),
+				mlog.String("subscriber_id", sub.SubscriberID),
+				mlog.String("subscriber_type", string(sub.SubscriberType)),
+			)
+
+			if err = n.delivery.SubscriptionDeliverSlackAttachments(board.TeamID, sub.SubscriberID, sub.SubscriberType, subAttachments); err != nil {
+				merr.Append(fmt.Errorf("cannot deliver notification to subscriber %s [%s]: %w",
+					sub.SubscriberID, sub.SubscriberType, err))
+			}
 		}
 	} else {
 		n.logger.Debug("notifySubscribers - skip delivery; no chg",
@@ -288,10 +373,32 @@ func (n *notifier) notifySubscribers(hint *model.NotificationHint) error {
 	}
 
 	// update the last notified_at for all subscribers since we at least attempted to notify all of them.
-	err = dg.store.UpdateSubscribersNotifiedAt(dg.hint.BlockID, notifiedAt)
+	err = n.store.UpdateSubscribersNotifiedAt(dg.hint.BlockID, notifiedAt)
 	if err != nil {
 		merr.Append(fmt.Errorf("could not update subscribers notified_at for block %s: %w", dg.hint.BlockID, err))
 	}
 
 	return merr.ErrorOrNil()
 }
+
+// addToInbox records a card change in a subscriber's in-app notification inbox.
+func (n *notifier) addToInbox(userID string, board *model.Board, card *model.Block, hint *model.NotificationHint, diffAuthors StringMap) error {
+	authors := make([]string, 0, len(diffAuthors))
+	for _, name := range diffAuthors {
+		authors = append(authors, name)
+	}
+	sort.Strings(authors)
+
+	notification := &model.Notification{
+		UserID:  userID,
+		Type:    model.NotificationTypeCardChange,
+		TeamID:  board.TeamID,
+		BoardID: board.ID,
+		CardID:  card.ID,
+		ActorID: hint.ModifiedByID,
+		Title:   card.Title,
+		Message: strings.Join(authors, ", "),
+	}
+	_, err := n.store.CreateNotification(notification)
+	return err
+}
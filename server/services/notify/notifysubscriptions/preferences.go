@@ -0,0 +1,181 @@
+// Copyright (c) 2020-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package notifysubscriptions
+
+import (
+	"github.com/mattermost/mattermost-plugin-boards/server/model"
+	"github.com/mattermost/mattermost-plugin-boards/server/services/notify"
+
+	"github.com/mattermost/mattermost/server/public/shared/mlog"
+)
+
+const (
+	propTypePerson      = "person"
+	propTypeMultiPerson = "multiPerson"
+)
+
+// getNotificationPreferences fetches a user's notification preferences for a board, falling back
+// to the defaults if they cannot be fetched so that a store error never silences notifications.
+func getNotificationPreferences(api AppAPI, userID, boardID string, logger mlog.LoggerIFace) *model.NotificationPreferences {
+	prefs, err := api.GetNotificationPreferences(userID, boardID)
+	if err != nil || prefs == nil {
+		logger.Warn("Cannot fetch notification preferences; using defaults",
+			mlog.String("user_id", userID),
+			mlog.String("board_id", boardID),
+			mlog.Err(err),
+		)
+		return model.NewDefaultNotificationPreferences(userID, boardID)
+	}
+	return prefs
+}
+
+// assigneesForBlock returns the ids of all users referenced by `person` and `multiPerson`
+// properties of the block.
+func assigneesForBlock(block *model.Block, schema model.PropSchema) map[string]struct{} {
+	assignees := make(map[string]struct{})
+	for _, def := range schema {
+		for _, userID := range personPropValues(block, def) {
+			assignees[userID] = struct{}{}
+		}
+	}
+	return assignees
+}
+
+// personPropValues returns the ids of the users referenced by a `person` or `multiPerson`
+// property of the block.
+func personPropValues(block *model.Block, def model.PropDef) []string {
+	if block == nil {
+		return nil
+	}
+
+	props, ok := block.Fields["properties"].(map[string]interface{})
+	if !ok {
+		return nil
+	}
+
+	var userIDs []string
+	switch def.Type {
+	case propTypePerson:
+		if userID, ok := props[def.ID].(string); ok && userID != "" {
+			userIDs = append(userIDs, userID)
+		}
+	case propTypeMultiPerson:
+		vals, ok := props[def.ID].([]interface{})
+		if !ok {
+			return nil
+		}
+		for _, v := range vals {
+			if userID, ok := v.(string); ok && userID != "" {
+				userIDs = append(userIDs, userID)
+			}
+		}
+	}
+	return userIDs
+}
+
+// isNewlyAssigned returns true if the user is referenced by a person property of the new
+// version of a block but not the old one.
+func isNewlyAssigned(userID string, oldBlock, newBlock *model.Block, schema model.PropSchema) bool {
+	if _, ok := assigneesForBlock(newBlock, schema)[userID]; !ok {
+		return false
+	}
+	_, wasAssigned := assigneesForBlock(oldBlock, schema)[userID]
+	return !wasAssigned
+}
+
+// filterDiffsForPreferences returns the subset of diffs a user wants to be notified about based on
+// their notification preferences for the board.
+func filterDiffsForPreferences(diffs []*Diff, prefs *model.NotificationPreferences, schema model.PropSchema) []*Diff {
+	if prefs.IsDefault() {
+		return diffs
+	}
+
+	var filtered []*Diff
+	for _, d := range diffs {
+		if fd := filterDiffForPreferences(d, prefs, schema); fd != nil {
+			filtered = append(filtered, fd)
+		}
+	}
+	return filtered
+}
+
+func filterDiffForPreferences(diff *Diff, prefs *model.NotificationPreferences, schema model.PropSchema) *Diff {
+	kind := model.NotificationEventKindForBlockType(diff.BlockType)
+
+	keep := prefs.AllowsEvent(kind)
+	if !keep && diff.BlockType == model.TypeCard && prefs.AssignmentOnly && prefs.AllowsAssignments() {
+		keep = isNewlyAssigned(prefs.UserID, diff.OldBlock, diff.NewBlock, schema)
+	}
+
+	var children []*Diff
+	for _, child := range diff.Diffs {
+		if fd := filterDiffForPreferences(child, prefs, schema); fd != nil {
+			children = append(children, fd)
+		}
+	}
+
+	if keep {
+		filtered := *diff
+		filtered.Diffs = children
+		return &filtered
+	}
+
+	// the block's own changes are not wanted, but a card that still exists is needed to carry wanted
+	// child changes.
+	if diff.BlockType != model.TypeCard || len(children) == 0 || diff.NewBlock == nil || diff.NewBlock.DeleteAt != 0 {
+		return nil
+	}
+
+	filtered := *diff
+	filtered.ChildrenOnly = true
+	filtered.PropDiffs = nil
+	filtered.Diffs = children
+	return &filtered
+}
+
+// filterSubscribers removes user subscribers whose notification preferences for the board exclude
+// the change described by the event. Channel subscribers are never filtered.
+func (b *Backend) filterSubscribers(subs []*model.Subscriber, evt notify.BlockChangeEvent) []*model.Subscriber {
+	if len(subs) == 0 {
+		return subs
+	}
+
+	kind := model.NotificationEventKindForBlockType(evt.BlockChanged.Type)
+	var schema model.PropSchema
+
+	filtered := make([]*model.Subscriber, 0, len(subs))
+	for _, sub := range subs {
+		if sub.SubscriberType != model.SubTypeUser {
+			filtered = append(filtered, sub)
+			continue
+		}
+
+		prefs := getNotificationPreferences(b.appAPI, sub.SubscriberID, evt.Board.ID, b.logger)
+		if prefs.AllowsEvent(kind) {
+			filtered = append(filtered, sub)
+			continue
+		}
+
+		if prefs.AssignmentOnly && prefs.AllowsAssignments() && evt.BlockChanged.Type == model.TypeCard {
+			if schema == nil {
+				var err error
+				if schema, err = model.ParsePropertySchema(evt.Board); err != nil {
+					b.logger.Error("Cannot parse property schema", mlog.String("board_id", evt.Board.ID), mlog.Err(err))
+					schema = model.PropSchema{}
+				}
+			}
+			if isNewlyAssigned(sub.SubscriberID, evt.BlockOld, evt.BlockChanged, schema) {
+				filtered = append(filtered, sub)
+				continue
+			}
+		}
+
+		b.logger.Debug("filterSubscribers - skipping subscriber per notification preferences",
+			mlog.String("subscriber_id", sub.SubscriberID),
+			mlog.String("board_id", evt.Board.ID),
+			mlog.String("event_kind", string(kind)),
+		)
+	}
+	return filtered
+}
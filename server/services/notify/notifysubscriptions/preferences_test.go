@@ -0,0 +1,136 @@
+// Copyright (c) 2020-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package notifysubscriptions
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mattermost/mattermost-plugin-boards/server/model"
+)
+
+func makeCardWithProps(props map[string]interface{}) *model.Block {
+	return &model.Block{
+		ID:     "card1",
+		Type:   model.TypeCard,
+		Title:  "card",
+		Fields: map[string]interface{}{"properties": props},
+	}
+}
+
+func Test_isNewlyAssigned(t *testing.T) {
+	schema := model.PropSchema{
+		"owner":     {ID: "owner", Type: propTypePerson},
+		"reviewers": {ID: "reviewers", Type: propTypeMultiPerson},
+		"status":    {ID: "status", Type: "select"},
+	}
+
+	empty := makeCardWithProps(map[string]interface{}{})
+	owner := makeCardWithProps(map[string]interface{}{"owner": "user1"})
+	reviewers := makeCardWithProps(map[string]interface{}{"reviewers": []interface{}{"user1", "user2"}})
+	status := makeCardWithProps(map[string]interface{}{"status": "user1"})
+
+	assert.True(t, isNewlyAssigned("user1", empty, owner, schema))
+	assert.True(t, isNewlyAssigned("user2", owner, reviewers, schema))
+	assert.True(t, isNewlyAssigned("user1", nil, owner, schema))
+	assert.False(t, isNewlyAssigned("user1", owner, reviewers, schema))
+	assert.False(t, isNewlyAssigned("user1", owner, empty, schema))
+	assert.False(t, isNewlyAssigned("user1", empty, status, schema))
+}
+
+func Test_filterDiffsForPreferences(t *testing.T) {
+	card := makeCardWithProps(map[string]interface{}{})
+	comment := &model.Block{ID: "comment1", Type: model.TypeComment, Title: "hi"}
+	text := &model.Block{ID: "text1", Type: model.TypeText, Title: "text"}
+
+	makeDiffs := func() []*Diff {
+		return []*Diff{{
+			BlockType: model.TypeCard,
+			OldBlock:  card,
+			NewBlock:  card,
+			PropDiffs: []PropDiff{{ID: "status", NewValue: "DONE"}},
+			Diffs: []*Diff{
+				{BlockType: model.TypeComment, NewBlock: comment},
+				{BlockType: model.TypeText, NewBlock: text},
+			},
+		}}
+	}
+
+	t.Run("defaults keep everything", func(t *testing.T) {
+		diffs := makeDiffs()
+		prefs := model.NewDefaultNotificationPreferences("user1", "board1")
+		assert.Equal(t, diffs, filterDiffsForPreferences(diffs, prefs, nil))
+	})
+
+	t.Run("comments only", func(t *testing.T) {
+		prefs := model.NewDefaultNotificationPreferences("user1", "board1")
+		prefs.Properties = false
+		prefs.Content = false
+
+		filtered := filterDiffsForPreferences(makeDiffs(), prefs, nil)
+		require.Len(t, filtered, 1)
+		assert.True(t, filtered[0].ChildrenOnly)
+		assert.Empty(t, filtered[0].PropDiffs)
+		require.Len(t, filtered[0].Diffs, 1)
+		assert.Equal(t, model.BlockType(model.TypeComment), filtered[0].Diffs[0].BlockType)
+	})
+
+	t.Run("comments only keeps the previous card", func(t *testing.T) {
+		prefs := model.NewDefaultNotificationPreferences("user1", "board1")
+		prefs.Properties = false
+		prefs.Content = false
+
+		diffs := makeDiffs()
+		oldCard := makeCardWithProps(map[string]interface{}{"owner": "user1"})
+		diffs[0].OldBlock = oldCard
+
+		filtered := filterDiffsForPreferences(diffs, prefs, nil)
+		require.Len(t, filtered, 1)
+		assert.Same(t, oldCard, filtered[0].OldBlock)
+		assert.Same(t, card, filtered[0].NewBlock)
+		assert.False(t, diffs[0].ChildrenOnly)
+	})
+
+	t.Run("deleted card does not carry child changes", func(t *testing.T) {
+		prefs := model.NewDefaultNotificationPreferences("user1", "board1")
+		prefs.Properties = false
+
+		diffs := makeDiffs()
+		diffs[0].NewBlock = nil
+		assert.Empty(t, filterDiffsForPreferences(diffs, prefs, nil))
+	})
+
+	t.Run("properties only", func(t *testing.T) {
+		prefs := model.NewDefaultNotificationPreferences("user1", "board1")
+		prefs.Comments = false
+		prefs.Content = false
+
+		filtered := filterDiffsForPreferences(makeDiffs(), prefs, nil)
+		require.Len(t, filtered, 1)
+		assert.Len(t, filtered[0].PropDiffs, 1)
+		assert.Empty(t, filtered[0].Diffs)
+	})
+
+	t.Run("muted", func(t *testing.T) {
+		prefs := model.NewDefaultNotificationPreferences("user1", "board1")
+		prefs.Muted = true
+		assert.Empty(t, filterDiffsForPreferences(makeDiffs(), prefs, nil))
+	})
+
+	t.Run("assignment only", func(t *testing.T) {
+		schema := model.PropSchema{"owner": {ID: "owner", Type: propTypePerson}}
+		prefs := model.NewDefaultNotificationPreferences("user1", "board1")
+		prefs.AssignmentOnly = true
+
+		assert.Empty(t, filterDiffsForPreferences(makeDiffs(), prefs, schema))
+
+		diffs := makeDiffs()
+		diffs[0].NewBlock = makeCardWithProps(map[string]interface{}{"owner": "user1"})
+		filtered := filterDiffsForPreferences(diffs, prefs, schema)
+		require.Len(t, filtered, 1)
+		assert.Empty(t, filtered[0].Diffs)
+	})
+}
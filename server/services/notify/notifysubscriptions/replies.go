@@ -0,0 +1,93 @@
+// Copyright (c) 2020-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package notifysubscriptions
+
+import (
+	"fmt"
+
+	"github.com/mattermost/mattermost-plugin-boards/server/model"
+	"github.com/mattermost/mattermost-plugin-boards/server/services/notify"
+
+	"github.com/mattermost/mattermost/server/public/shared/mlog"
+)
+
+const (
+	maxReplyExtractLen = 200
+)
+
+// replyExtract returns the text of a reply shortened for use in a notification.
+func replyExtract(text string) string {
+	text = stripNewlines(text)
+	runes := []rune(text)
+	if len(runes) <= maxReplyExtractLen {
+		return text
+	}
+	return string(runes[:maxReplyExtractLen]) + "..."
+}
+
+// notifyReply sends a direct message to the author of a comment when someone replies to it.
+func (b *Backend) notifyReply(evt notify.BlockChangeEvent) error {
+	if evt.Action != notify.Add || evt.Card == nil {
+		return nil
+	}
+
+	parentID := model.CommentParentID(evt.BlockChanged)
+	if parentID == "" {
+		return nil
+	}
+
+	parent, err := b.appAPI.GetBlockByID(parentID)
+	if err != nil {
+		return fmt.Errorf("cannot fetch parent comment %s: %w", parentID, err)
+	}
+
+	authorID := parent.CreatedBy
+	if authorID == "" || authorID == evt.ModifiedBy.UserID {
+		// don't notify users replying to their own comments.
+		return nil
+	}
+
+	if !b.permissions.HasPermissionToBoard(authorID, evt.Board.ID, model.PermissionViewBoard) {
+		b.logger.Debug("Not notifying reply to non-board member",
+			mlog.String("user_id", authorID),
+			mlog.String("board_id", evt.Board.ID),
+		)
+		return nil
+	}
+
+	prefs := getNotificationPreferences(b.appAPI, authorID, evt.Board.ID, b.logger)
+	if !prefs.AllowsReplies() {
+		b.logger.Debug("Not notifying reply per notification preferences",
+			mlog.String("user_id", authorID),
+			mlog.String("board_id", evt.Board.ID),
+		)
+		return nil
+	}
+
+	extract := replyExtract(evt.BlockChanged.Title)
+	if err = b.delivery.ReplyDeliver(authorID, extract, evt); err != nil {
+		return fmt.Errorf("cannot deliver reply notification to %s: %w", authorID, err)
+	}
+
+	b.logger.Debug("Reply notification delivered",
+		mlog.String("user_id", authorID),
+		mlog.String("comment_id", parentID),
+	)
+
+	notification := &model.Notification{
+		UserID:  authorID,
+		Type:    model.NotificationTypeReply,
+		TeamID:  evt.TeamID,
+		BoardID: evt.Board.ID,
+		CardID:  evt.Card.ID,
+		BlockID: evt.BlockChanged.ID,
+		ActorID: evt.ModifiedBy.UserID,
+		Title:   evt.Card.Title,
+		Message: extract,
+	}
+	if _, err = b.appAPI.CreateNotification(notification); err != nil {
+		return fmt.Errorf("cannot add reply notification to inbox of %s: %w", authorID, err)
+	}
+	return nil
+}
@@ -0,0 +1,20 @@
+// Copyright (c) 2020-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package notifysubscriptions
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_replyExtract(t *testing.T) {
+	assert.Equal(t, "short reply", replyExtract("short reply"))
+	assert.Equal(t, "first¶ second", replyExtract("first\nsecond"))
+
+	long := strings.Repeat("가", maxReplyExtractLen+10)
+	extract := replyExtract(long)
+	assert.Equal(t, strings.Repeat("가", maxReplyExtractLen)+"...", extract)
+}
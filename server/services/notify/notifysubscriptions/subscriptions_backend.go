@@ -29,6 +29,7 @@ type BackendParams struct {
 	Logger                 mlog.LoggerIFace
 	NotifyFreqCardSeconds  int
 	NotifyFreqBoardSeconds int
+	NotifyUnassignment     bool
 }
 
 // Backend provides the notification backend for subscriptions.
@@ -40,6 +41,7 @@ type Backend struct {
 	logger                 mlog.LoggerIFace
 	notifyFreqCardSeconds  int
 	notifyFreqBoardSeconds int
+	notifyUnassignment     bool
 }
 
 func New(params BackendParams) *Backend {
@@ -51,6 +53,7 @@ func New(params BackendParams) *Backend {
 		logger:                 params.Logger,
 		notifyFreqCardSeconds:  params.NotifyFreqCardSeconds,
 		notifyFreqBoardSeconds: params.NotifyFreqBoardSeconds,
+		notifyUnassignment:     params.NotifyUnassignment,
 	}
 }
 
@@ -70,6 +73,11 @@ func (b *Backend) ShutDown() error {
 	return nil
 }
 
+// SetMetrics sets the metrics recording the hint queue depth and the delivery failures.
+func (b *Backend) SetMetrics(metrics notify.Metrics) {
+	b.notifier.setMetrics(metrics)
+}
+
 func (b *Backend) Name() string {
 	return backendName
 }
@@ -126,7 +134,7 @@ func (b *Backend) BlockChanged(evt notify.BlockChangeEvent) error {
 	if err != nil {
 		merr.Append(fmt.Errorf("cannot fetch subscribers for board %s: %w", evt.Board.ID, err))
 	}
-	if err = b.notifySubscribers(subs, evt.Board.ID, model.TypeBoard, evt.ModifiedBy.UserID); err != nil {
+	if err = b.notifySubscribers(subs, evt.Board.ID, model.TypeBoard, evt); err != nil {
 		merr.Append(fmt.Errorf("cannot notify board subscribers for board %s: %w", evt.Board.ID, err))
 	}
 
@@ -134,12 +142,22 @@ func (b *Backend) BlockChanged(evt notify.BlockChangeEvent) error {
 		return merr.ErrorOrNil()
 	}
 
+	// notify assigned users; this happens first so newly assigned users are card subscribers below.
+	if err = b.notifyAssignments(evt); err != nil {
+		merr.Append(fmt.Errorf("cannot notify assignment changes for card %s: %w", evt.Card.ID, err))
+	}
+
+	// notify the author of a comment being replied to
+	if err = b.notifyReply(evt); err != nil {
+		merr.Append(fmt.Errorf("cannot notify reply for block %s: %w", evt.BlockChanged.ID, err))
+	}
+
 	// notify card subscribers
 	subs, err = b.appAPI.GetSubscribersForBlock(evt.Card.ID)
 	if err != nil {
 		merr.Append(fmt.Errorf("cannot fetch subscribers for card %s: %w", evt.Card.ID, err))
 	}
-	if err = b.notifySubscribers(subs, evt.Card.ID, model.TypeCard, evt.ModifiedBy.UserID); err != nil {
+	if err = b.notifySubscribers(subs, evt.Card.ID, model.TypeCard, evt); err != nil {
 		merr.Append(fmt.Errorf("cannot notify card subscribers for card %s: %w", evt.Card.ID, err))
 	}
 
@@ -149,7 +167,7 @@ func (b *Backend) BlockChanged(evt notify.BlockChangeEvent) error {
 		if err != nil {
 			merr.Append(fmt.Errorf("cannot fetch subscribers for block %s: %w", evt.BlockChanged.ID, err))
 		}
-		if err := b.notifySubscribers(subs, evt.BlockChanged.ID, evt.BlockChanged.Type, evt.ModifiedBy.UserID); err != nil {
+		if err := b.notifySubscribers(subs, evt.BlockChanged.ID, evt.BlockChanged.Type, evt); err != nil {
 			merr.Append(fmt.Errorf("cannot notify block subscribers for block %s: %w", evt.BlockChanged.ID, err))
 		}
 	}
@@ -157,7 +175,10 @@ func (b *Backend) BlockChanged(evt notify.BlockChangeEvent) error {
 }
 
 // notifySubscribers triggers a change notification for subscribers by writing a notification hint to the database.
-func (b *Backend) notifySubscribers(subs []*model.Subscriber, blockID string, idType model.BlockType, modifiedByID string) error {
+// Subscribers whose notification preferences exclude the change are not counted, so no hint is written
+// if nobody wants to hear about it.
+func (b *Backend) notifySubscribers(subs []*model.Subscriber, blockID string, idType model.BlockType, evt notify.BlockChangeEvent) error {
+	subs = b.filterSubscribers(subs, evt)
 	if len(subs) == 0 {
 		return nil
 	}
@@ -165,7 +186,7 @@ func (b *Backend) notifySubscribers(subs []*model.Subscriber, blockID string, id
 	hint := &model.NotificationHint{
 		BlockType:    idType,
 		BlockID:      blockID,
-		ModifiedByID: modifiedByID,
+		ModifiedByID: evt.ModifiedBy.UserID,
 	}
 
 	hint, err := b.appAPI.UpsertNotificationHint(hint, b.getBlockUpdateFreq(idType))
@@ -0,0 +1,44 @@
+// Copyright (c) 2020-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package plugindelivery
+
+import (
+	"fmt"
+
+	"github.com/mattermost/mattermost-plugin-boards/server/services/notify"
+	"github.com/mattermost/mattermost-plugin-boards/server/utils"
+
+	mm_model "github.com/mattermost/mattermost/server/public/model"
+)
+
+// AssignmentDeliver notifies a user via direct message that they were assigned to, or removed from,
+// a person property of a card.
+func (pd *PluginDelivery) AssignmentDeliver(assigneeID string, assigned bool, propName string, evt notify.BlockChangeEvent) error {
+	author, err := pd.api.GetUserByID(evt.ModifiedBy.UserID)
+	if err != nil {
+		return fmt.Errorf("cannot find user: %w", err)
+	}
+
+	assignee, err := pd.api.GetUserByID(assigneeID)
+	if err != nil {
+		return fmt.Errorf("cannot find assignee: %w", err)
+	}
+
+	channel, err := pd.getDirectChannel(evt.TeamID, assignee.Id, pd.botID)
+	if err != nil || channel == nil {
+		return fmt.Errorf("cannot get direct channel: %w", err)
+	}
+
+	link := utils.MakeCardLink(pd.serverRoot, evt.Board.TeamID, evt.Board.ID, evt.Card.ID)
+	boardLink := utils.MakeBoardLink(pd.serverRoot, evt.Board.TeamID, evt.Board.ID)
+
+	post := &mm_model.Post{
+		UserId:    pd.botID,
+		ChannelId: channel.Id,
+		Message:   formatAssignmentMessage(author.Username, assignee.Username, assigned, propName, evt.Card.Title, link, boardLink, evt.Board.Title),
+	}
+
+	_, err = pd.api.CreatePost(post)
+	return err
+}
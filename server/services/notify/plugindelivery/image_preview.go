@@ -0,0 +1,27 @@
+// Copyright (c) 2020-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package plugindelivery
+
+import (
+	"github.com/mattermost/mattermost-plugin-boards/server/model"
+)
+
+// imagePreviewExpiry is how long the image previews embedded in posts stay viewable. Posts are
+// often read long after they are sent, so previews use the longest lifetime allowed.
+const imagePreviewExpiry = model.SignedFileURLMaxExpiry
+
+// ImagePreviewURL returns a signed URL of the preview of an image attached to a card, usable by
+// the clients rendering a post without a session. Returns an empty string if the URL cannot be
+// signed, in which case the post is sent without the preview.
+func (pd *PluginDelivery) ImagePreviewURL(teamID, boardID, fileID string) string {
+	if pd.signer == nil || fileID == "" {
+		return ""
+	}
+
+	signedURL, err := pd.signer.SignFileURL(teamID, boardID, fileID, imagePreviewExpiry)
+	if err != nil {
+		return ""
+	}
+	return signedURL.URL + "&size=" + string(model.FileSizePreview)
+}
@@ -13,6 +13,9 @@ const (
 	// TODO: localize these when i18n is available.
 	defCommentTemplate     = "@%s님이 @%s님을 카드 [%s](%s) 댓글에서 언급했습니다 (보드: [%s](%s))\n> %s"
 	defDescriptionTemplate = "@%s님이 @%s님을 카드 [%s](%s)에서 언급했습니다 (보드: [%s](%s))\n> %s"
+	defAssignedTemplate    = "@%s님이 @%s님을 카드 [%s](%s)의 %s(으)로 지정했습니다 (보드: [%s](%s))"
+	defUnassignedTemplate  = "@%s님이 @%s님을 카드 [%s](%s)의 %s에서 제외했습니다 (보드: [%s](%s))"
+	defReplyTemplate       = "@%s님이 카드 [%s](%s)에서 @%s님의 댓글에 답글을 남겼습니다 (보드: [%s](%s))\n> %s"
 )
 
 func formatMessage(author string, mentionedUser string, extract string, card string, link string, block *model.Block, boardLink string, board string) string {
@@ -22,3 +25,15 @@ func formatMessage(author string, mentionedUser string, extract string, card str
 	}
 	return fmt.Sprintf(template, author, mentionedUser, card, link, board, boardLink, extract)
 }
+
+func formatAssignmentMessage(author string, assignee string, assigned bool, propName string, card string, link string, boardLink string, board string) string {
+	template := defAssignedTemplate
+	if !assigned {
+		template = defUnassignedTemplate
+	}
+	return fmt.Sprintf(template, author, assignee, card, link, propName, board, boardLink)
+}
+
+func formatReplyMessage(author string, commentAuthor string, extract string, card string, link string, boardLink string, board string) string {
+	return fmt.Sprintf(defReplyTemplate, author, card, link, commentAuthor, board, boardLink, extract)
+}
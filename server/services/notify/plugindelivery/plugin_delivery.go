@@ -4,6 +4,10 @@
 package plugindelivery
 
 import (
+	"time"
+
+	"github.com/mattermost/mattermost-plugin-boards/server/model"
+
 	mm_model "github.com/mattermost/mattermost/server/public/model"
 )
 
@@ -35,18 +39,25 @@ type servicesAPI interface {
 	CreateMember(teamID string, userID string) (*mm_model.TeamMember, error)
 }
 
+type fileURLSigner interface {
+	// SignFileURL mints a URL granting read access to a single file until it expires.
+	SignFileURL(teamID, boardID, fileName string, expiresIn time.Duration) (*model.SignedFileURL, error)
+}
+
 // PluginDelivery provides ability to send notifications to direct message channels via Mattermost plugin API.
 type PluginDelivery struct {
 	botID      string
 	serverRoot string
 	api        servicesAPI
+	signer     fileURLSigner
 }
 
 // New creates a PluginDelivery instance.
-func New(botID string, serverRoot string, api servicesAPI) *PluginDelivery {
+func New(botID string, serverRoot string, api servicesAPI, signer fileURLSigner) *PluginDelivery {
 	return &PluginDelivery{
 		botID:      botID,
 		serverRoot: serverRoot,
 		api:        api,
+		signer:     signer,
 	}
 }
@@ -0,0 +1,43 @@
+// Copyright (c) 2020-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package plugindelivery
+
+import (
+	"fmt"
+
+	"github.com/mattermost/mattermost-plugin-boards/server/services/notify"
+	"github.com/mattermost/mattermost-plugin-boards/server/utils"
+
+	mm_model "github.com/mattermost/mattermost/server/public/model"
+)
+
+// ReplyDeliver notifies the author of a comment via direct message that someone replied to it.
+func (pd *PluginDelivery) ReplyDeliver(authorID string, extract string, evt notify.BlockChangeEvent) error {
+	replier, err := pd.api.GetUserByID(evt.ModifiedBy.UserID)
+	if err != nil {
+		return fmt.Errorf("cannot find user: %w", err)
+	}
+
+	author, err := pd.api.GetUserByID(authorID)
+	if err != nil {
+		return fmt.Errorf("cannot find comment author: %w", err)
+	}
+
+	channel, err := pd.getDirectChannel(evt.TeamID, author.Id, pd.botID)
+	if err != nil || channel == nil {
+		return fmt.Errorf("cannot get direct channel: %w", err)
+	}
+
+	link := utils.MakeCardLink(pd.serverRoot, evt.Board.TeamID, evt.Board.ID, evt.Card.ID)
+	boardLink := utils.MakeBoardLink(pd.serverRoot, evt.Board.TeamID, evt.Board.ID)
+
+	post := &mm_model.Post{
+		UserId:    pd.botID,
+		ChannelId: channel.Id,
+		Message:   formatReplyMessage(replier.Username, author.Username, extract, evt.Card.Title, link, boardLink, evt.Board.Title),
+	}
+
+	_, err = pd.api.CreatePost(post)
+	return err
+}
@@ -4,9 +4,13 @@
 package notify
 
 import (
+	"context"
 	"sync"
 
+	"go.opentelemetry.io/otel/attribute"
+
 	"github.com/mattermost/mattermost-plugin-boards/server/model"
+	"github.com/mattermost/mattermost-plugin-boards/server/services/tracing"
 	"github.com/wiggin77/merror"
 
 	"github.com/mattermost/mattermost/server/public/shared/mlog"
@@ -30,6 +34,17 @@ type BlockChangeEvent struct {
 	ModifiedBy   *model.BoardMember
 }
 
+// ContentChangeEvent describes a change to the document content of a card that is stored outside
+// of the block tree, such as a BlockSuite document. The content is provided as plain text paragraphs.
+type ContentChangeEvent struct {
+	TeamID        string
+	Board         *model.Board
+	Card          *model.Block
+	Paragraphs    []string
+	OldParagraphs []string
+	ModifiedBy    *model.BoardMember
+}
+
 // Backend provides an interface for sending notifications.
 type Backend interface {
 	Start() error
@@ -38,11 +53,28 @@ type Backend interface {
 	Name() string
 }
 
+// ContentBackend is implemented by backends that want to be informed of card content changes.
+type ContentBackend interface {
+	ContentChanged(evt ContentChangeEvent) error
+}
+
+// Metrics records the health of the notification delivery.
+type Metrics interface {
+	IncrementNotificationDeliveryFailures(backend string)
+	ObserveNotificationHintsPending(count int)
+}
+
+// MetricsBackend is implemented by backends that record metrics of their own.
+type MetricsBackend interface {
+	SetMetrics(metrics Metrics)
+}
+
 // Service is a service that sends notifications based on block activity using one or more backends.
 type Service struct {
 	mux      sync.RWMutex
 	backends []Backend
 	logger   mlog.LoggerIFace
+	metrics  Metrics
 }
 
 // New creates a notification service with one or more Backends capable of sending notifications.
@@ -70,10 +102,32 @@ func (s *Service) AddBackend(backend Backend) error {
 	}
 	s.mux.Lock()
 	defer s.mux.Unlock()
+	if metricsBackend, ok := backend.(MetricsBackend); ok && s.metrics != nil {
+		metricsBackend.SetMetrics(s.metrics)
+	}
 	s.backends = append(s.backends, backend)
 	return nil
 }
 
+// SetMetrics sets the metrics recording the delivery failures of all backends.
+func (s *Service) SetMetrics(metrics Metrics) {
+	s.mux.Lock()
+	defer s.mux.Unlock()
+
+	s.metrics = metrics
+	for _, backend := range s.backends {
+		if metricsBackend, ok := backend.(MetricsBackend); ok {
+			metricsBackend.SetMetrics(metrics)
+		}
+	}
+}
+
+func (s *Service) incrementDeliveryFailures(backend Backend) {
+	if s.metrics != nil {
+		s.metrics.IncrementNotificationDeliveryFailures(backend.Name())
+	}
+}
+
 // Shutdown calls shutdown for all backends.
 func (s *Service) Shutdown() error {
 	s.mux.Lock()
@@ -90,19 +144,54 @@ func (s *Service) Shutdown() error {
 }
 
 // BlockChanged should be called whenever a block is added/updated/deleted.
-// All backends are informed of the event.
-func (s *Service) BlockChanged(evt BlockChangeEvent) {
+// All backends are informed of the event, each in a span child of the span of the context.
+func (s *Service) BlockChanged(ctx context.Context, evt BlockChangeEvent) {
 	s.mux.RLock()
 	defer s.mux.RUnlock()
 
 	for _, backend := range s.backends {
-		if err := backend.BlockChanged(evt); err != nil {
+		_, span := tracing.StartSpan(ctx, "notify."+backend.Name()+".BlockChanged",
+			attribute.String("action", string(evt.Action)),
+			attribute.String("block_id", evt.BlockChanged.ID),
+		)
+		err := backend.BlockChanged(evt)
+		tracing.EndSpan(span, err)
+		if err != nil {
 			s.logger.Error("Error delivering notification",
 				mlog.String("backend", backend.Name()),
 				mlog.String("action", string(evt.Action)),
 				mlog.String("block_id", evt.BlockChanged.ID),
 				mlog.Err(err),
 			)
+			s.incrementDeliveryFailures(backend)
+		}
+	}
+}
+
+// ContentChanged should be called whenever the document content of a card changes.
+// Backends implementing ContentBackend are informed of the event, each in a span child of the
+// span of the context.
+func (s *Service) ContentChanged(ctx context.Context, evt ContentChangeEvent) {
+	s.mux.RLock()
+	defer s.mux.RUnlock()
+
+	for _, backend := range s.backends {
+		contentBackend, ok := backend.(ContentBackend)
+		if !ok {
+			continue
+		}
+		_, span := tracing.StartSpan(ctx, "notify."+backend.Name()+".ContentChanged",
+			attribute.String("card_id", evt.Card.ID),
+		)
+		err := contentBackend.ContentChanged(evt)
+		tracing.EndSpan(span, err)
+		if err != nil {
+			s.logger.Error("Error delivering content notification",
+				mlog.String("backend", backend.Name()),
+				mlog.String("card_id", evt.Card.ID),
+				mlog.Err(err),
+			)
+			s.incrementDeliveryFailures(backend)
 		}
 	}
 }
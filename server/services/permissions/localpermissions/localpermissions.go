@@ -4,6 +4,8 @@
 package localpermissions
 
 import (
+	"context"
+
 	"github.com/mattermost/mattermost-plugin-boards/server/model"
 	"github.com/mattermost/mattermost-plugin-boards/server/services/permissions"
 
@@ -49,7 +51,7 @@ func (s *Service) HasPermissionToBoard(userID, boardID string, permission *mmMod
 		return false
 	}
 
-	member, err := s.store.GetMemberForBoard(boardID, userID)
+	member, err := s.store.GetMemberForBoard(context.Background(), boardID, userID)
 	if model.IsErrNotFound(err) {
 		return false
 	}
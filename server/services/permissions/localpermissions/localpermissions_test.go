@@ -11,6 +11,7 @@ import (
 
 	mmModel "github.com/mattermost/mattermost/server/public/model"
 
+	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -48,7 +49,7 @@ func TestHasPermissionToBoard(t *testing.T) {
 		boardID := "board-id"
 
 		th.store.EXPECT().
-			GetMemberForBoard(boardID, userID).
+			GetMemberForBoard(gomock.Any(), boardID, userID).
 			Return(nil, sql.ErrNoRows).
 			Times(1)
 
@@ -44,7 +44,7 @@ func (th *TestHelper) checkBoardPermissions(roleName string, member *model.Board
 	for _, p := range hasPermissionTo {
 		th.t.Run(roleName+" "+p.Id, func(t *testing.T) {
 			th.store.EXPECT().
-				GetBoard(member.BoardID).
+				GetBoard(gomock.Any(), member.BoardID).
 				Return(&model.Board{ID: member.BoardID, TeamID: teamID}, nil).
 				Times(1)
 
@@ -54,7 +54,7 @@ func (th *TestHelper) checkBoardPermissions(roleName string, member *model.Board
 				Times(1)
 
 			th.store.EXPECT().
-				GetMemberForBoard(member.BoardID, member.UserID).
+				GetMemberForBoard(gomock.Any(), member.BoardID, member.UserID).
 				Return(member, nil).
 				Times(1)
 
@@ -73,7 +73,7 @@ func (th *TestHelper) checkBoardPermissions(roleName string, member *model.Board
 	for _, p := range hasNotPermissionTo {
 		th.t.Run(roleName+" "+p.Id, func(t *testing.T) {
 			th.store.EXPECT().
-				GetBoard(member.BoardID).
+				GetBoard(gomock.Any(), member.BoardID).
 				Return(&model.Board{ID: member.BoardID, TeamID: teamID}, nil).
 				Times(1)
 
@@ -83,7 +83,7 @@ func (th *TestHelper) checkBoardPermissions(roleName string, member *model.Board
 				Times(1)
 
 			th.store.EXPECT().
-				GetMemberForBoard(member.BoardID, member.UserID).
+				GetMemberForBoard(gomock.Any(), member.BoardID, member.UserID).
 				Return(member, nil).
 				Times(1)
 
@@ -4,6 +4,8 @@
 package mmpermissions
 
 import (
+	"context"
+
 	"github.com/mattermost/mattermost-plugin-boards/server/model"
 	"github.com/mattermost/mattermost-plugin-boards/server/services/permissions"
 
@@ -57,10 +59,10 @@ func (s *Service) HasPermissionToBoard(userID, boardID string, permission *mmMod
 		return false
 	}
 
-	board, err := s.store.GetBoard(boardID)
+	board, err := s.store.GetBoard(context.Background(), boardID)
 	if model.IsErrNotFound(err) {
 		var boards []*model.Board
-		boards, err = s.store.GetBoardHistory(boardID, model.QueryBoardHistoryOptions{Limit: 1, Descending: true})
+		boards, err = s.store.GetBoardHistory(context.Background(), boardID, model.QueryBoardHistoryOptions{Limit: 1, Descending: true})
 		if err != nil {
 			return false
 		}
@@ -82,7 +84,7 @@ func (s *Service) HasPermissionToBoard(userID, boardID string, permission *mmMod
 	if !s.HasPermissionToTeam(userID, board.TeamID, model.PermissionViewTeam) {
 		return false
 	}
-	member, err := s.store.GetMemberForBoard(boardID, userID)
+	member, err := s.store.GetMemberForBoard(context.Background(), boardID, userID)
 	if model.IsErrNotFound(err) {
 		return false
 	}
@@ -12,6 +12,7 @@ import (
 
 	mmModel "github.com/mattermost/mattermost/server/public/model"
 
+	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -73,7 +74,7 @@ func TestHasPermissionToBoard(t *testing.T) {
 
 	t.Run("nonexistent member", func(t *testing.T) {
 		th.store.EXPECT().
-			GetBoard(boardID).
+			GetBoard(gomock.Any(), boardID).
 			Return(&model.Board{ID: boardID, TeamID: teamID}, nil).
 			Times(1)
 
@@ -83,7 +84,7 @@ func TestHasPermissionToBoard(t *testing.T) {
 			Times(1)
 
 		th.store.EXPECT().
-			GetMemberForBoard(boardID, userID).
+			GetMemberForBoard(gomock.Any(), boardID, userID).
 			Return(nil, sql.ErrNoRows).
 			Times(1)
 
@@ -93,12 +94,12 @@ func TestHasPermissionToBoard(t *testing.T) {
 
 	t.Run("nonexistent board", func(t *testing.T) {
 		th.store.EXPECT().
-			GetBoard(boardID).
+			GetBoard(gomock.Any(), boardID).
 			Return(nil, sql.ErrNoRows).
 			Times(1)
 
 		th.store.EXPECT().
-			GetBoardHistory(boardID, model.QueryBoardHistoryOptions{Limit: 1, Descending: true}).
+			GetBoardHistory(gomock.Any(), boardID, model.QueryBoardHistoryOptions{Limit: 1, Descending: true}).
 			Return(nil, sql.ErrNoRows).
 			Times(1)
 
@@ -114,7 +115,7 @@ func TestHasPermissionToBoard(t *testing.T) {
 		}
 
 		th.store.EXPECT().
-			GetBoard(boardID).
+			GetBoard(gomock.Any(), boardID).
 			Return(&model.Board{ID: boardID, TeamID: teamID}, nil).
 			Times(1)
 
@@ -124,7 +125,7 @@ func TestHasPermissionToBoard(t *testing.T) {
 			Times(1)
 
 		th.store.EXPECT().
-			GetMemberForBoard(member.BoardID, member.UserID).
+			GetMemberForBoard(gomock.Any(), member.BoardID, member.UserID).
 			Return(member, nil).
 			Times(1)
 
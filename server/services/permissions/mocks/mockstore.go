@@ -5,6 +5,7 @@
 package mocks
 
 import (
+	context "context"
 	reflect "reflect"
 
 	gomock "github.com/golang/mock/gomock"
@@ -35,46 +36,46 @@ func (m *MockStore) EXPECT() *MockStoreMockRecorder {
 }
 
 // GetBoard mocks base method.
-func (m *MockStore) GetBoard(arg0 string) (*model.Board, error) {
+func (m *MockStore) GetBoard(arg0 context.Context, arg1 string) (*model.Board, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetBoard", arg0)
+	ret := m.ctrl.Call(m, "GetBoard", arg0, arg1)
 	ret0, _ := ret[0].(*model.Board)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetBoard indicates an expected call of GetBoard.
-func (mr *MockStoreMockRecorder) GetBoard(arg0 interface{}) *gomock.Call {
+func (mr *MockStoreMockRecorder) GetBoard(arg0, arg1 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBoard", reflect.TypeOf((*MockStore)(nil).GetBoard), arg0)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBoard", reflect.TypeOf((*MockStore)(nil).GetBoard), arg0, arg1)
 }
 
 // GetBoardHistory mocks base method.
-func (m *MockStore) GetBoardHistory(arg0 string, arg1 model.QueryBoardHistoryOptions) ([]*model.Board, error) {
+func (m *MockStore) GetBoardHistory(arg0 context.Context, arg1 string, arg2 model.QueryBoardHistoryOptions) ([]*model.Board, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetBoardHistory", arg0, arg1)
+	ret := m.ctrl.Call(m, "GetBoardHistory", arg0, arg1, arg2)
 	ret0, _ := ret[0].([]*model.Board)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetBoardHistory indicates an expected call of GetBoardHistory.
-func (mr *MockStoreMockRecorder) GetBoardHistory(arg0, arg1 interface{}) *gomock.Call {
+func (mr *MockStoreMockRecorder) GetBoardHistory(arg0, arg1, arg2 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBoardHistory", reflect.TypeOf((*MockStore)(nil).GetBoardHistory), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBoardHistory", reflect.TypeOf((*MockStore)(nil).GetBoardHistory), arg0, arg1, arg2)
 }
 
 // GetMemberForBoard mocks base method.
-func (m *MockStore) GetMemberForBoard(arg0, arg1 string) (*model.BoardMember, error) {
+func (m *MockStore) GetMemberForBoard(arg0 context.Context, arg1, arg2 string) (*model.BoardMember, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetMemberForBoard", arg0, arg1)
+	ret := m.ctrl.Call(m, "GetMemberForBoard", arg0, arg1, arg2)
 	ret0, _ := ret[0].(*model.BoardMember)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetMemberForBoard indicates an expected call of GetMemberForBoard.
-func (mr *MockStoreMockRecorder) GetMemberForBoard(arg0, arg1 interface{}) *gomock.Call {
+func (mr *MockStoreMockRecorder) GetMemberForBoard(arg0, arg1, arg2 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMemberForBoard", reflect.TypeOf((*MockStore)(nil).GetMemberForBoard), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMemberForBoard", reflect.TypeOf((*MockStore)(nil).GetMemberForBoard), arg0, arg1, arg2)
 }
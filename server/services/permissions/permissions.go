@@ -6,6 +6,8 @@
 package permissions
 
 import (
+	"context"
+
 	"github.com/mattermost/mattermost-plugin-boards/server/model"
 
 	mmModel "github.com/mattermost/mattermost/server/public/model"
@@ -19,7 +21,7 @@ type PermissionsService interface {
 }
 
 type Store interface {
-	GetBoard(boardID string) (*model.Board, error)
-	GetMemberForBoard(boardID, userID string) (*model.BoardMember, error)
-	GetBoardHistory(boardID string, opts model.QueryBoardHistoryOptions) ([]*model.Board, error)
+	GetBoard(ctx context.Context, boardID string) (*model.Board, error)
+	GetMemberForBoard(ctx context.Context, boardID, userID string) (*model.BoardMember, error)
+	GetBoardHistory(ctx context.Context, boardID string, opts model.QueryBoardHistoryOptions) ([]*model.Board, error)
 }
@@ -0,0 +1,227 @@
+// Copyright (c) 2020-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package cachelayer
+
+import (
+	"context"
+
+	"github.com/mattermost/mattermost-plugin-boards/server/model"
+)
+
+// The cached boards and members are copied on both ways, as the callers may modify them.
+
+func (s *CacheLayer) GetBoard(ctx context.Context, id string) (*model.Board, error) {
+	if board, ok := s.boards.get(id); ok {
+		s.observe(cacheBoards, true)
+		return copyBoard(board), nil
+	}
+	s.observe(cacheBoards, false)
+
+	generation := s.boards.currentGeneration()
+	board, err := s.Store.GetBoard(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	s.boards.add(id, copyBoard(board), generation)
+	return board, nil
+}
+
+func (s *CacheLayer) GetMemberForBoard(ctx context.Context, boardID, userID string) (*model.BoardMember, error) {
+	key := memberKey(boardID, userID)
+	if member, ok := s.members.get(key); ok {
+		s.observe(cacheMembers, true)
+		return copyMember(member), nil
+	}
+	s.observe(cacheMembers, false)
+
+	generation := s.members.currentGeneration()
+	member, err := s.Store.GetMemberForBoard(ctx, boardID, userID)
+	if err != nil {
+		return nil, err
+	}
+	if !member.Synthetic {
+		s.members.add(key, copyMember(member), generation)
+	}
+	return member, nil
+}
+
+func (s *CacheLayer) GetMembersForBoard(ctx context.Context, boardID string) ([]*model.BoardMember, error) {
+	if members, ok := s.boardMembers.get(boardID); ok {
+		s.observe(cacheBoardMembers, true)
+		return copyMembers(members), nil
+	}
+	s.observe(cacheBoardMembers, false)
+
+	generation := s.boardMembers.currentGeneration()
+	members, err := s.Store.GetMembersForBoard(ctx, boardID)
+	if err != nil {
+		return nil, err
+	}
+	s.boardMembers.add(boardID, copyMembers(members), generation)
+	return members, nil
+}
+
+func (s *CacheLayer) InsertBoard(ctx context.Context, board *model.Board, userID string) (*model.Board, error) {
+	newBoard, err := s.Store.InsertBoard(ctx, board, userID)
+	s.invalidate(invalidation{BoardIDs: []string{board.ID}})
+	return newBoard, err
+}
+
+func (s *CacheLayer) InsertBoardWithAdmin(ctx context.Context, board *model.Board, userID string) (*model.Board, *model.BoardMember, error) {
+	newBoard, member, err := s.Store.InsertBoardWithAdmin(ctx, board, userID)
+	if newBoard != nil {
+		s.invalidate(invalidation{BoardIDs: []string{newBoard.ID}})
+	}
+	return newBoard, member, err
+}
+
+func (s *CacheLayer) PatchBoard(ctx context.Context, boardID string, boardPatch *model.BoardPatch, userID string) (*model.Board, error) {
+	board, err := s.Store.PatchBoard(ctx, boardID, boardPatch, userID)
+	s.invalidate(invalidation{BoardIDs: []string{boardID}})
+	return board, err
+}
+
+func (s *CacheLayer) DeleteBoard(ctx context.Context, boardID, userID string) error {
+	err := s.Store.DeleteBoard(ctx, boardID, userID)
+	s.invalidate(invalidation{BoardIDs: []string{boardID}})
+	return err
+}
+
+func (s *CacheLayer) UndeleteBoard(ctx context.Context, boardID string, modifiedBy string) error {
+	err := s.Store.UndeleteBoard(ctx, boardID, modifiedBy)
+	s.invalidate(invalidation{BoardIDs: []string{boardID}})
+	return err
+}
+
+func (s *CacheLayer) DeleteBoardRecord(ctx context.Context, boardID, modifiedBy string) error {
+	err := s.Store.DeleteBoardRecord(ctx, boardID, modifiedBy)
+	s.invalidate(invalidation{BoardIDs: []string{boardID}})
+	return err
+}
+
+func (s *CacheLayer) RestoreBoardToTime(ctx context.Context, boardID string, at int64, modifiedBy string) (*model.BoardRestorePlan, error) {
+	plan, err := s.Store.RestoreBoardToTime(ctx, boardID, at, modifiedBy)
+	s.invalidate(invalidation{BoardIDs: []string{boardID}})
+	return plan, err
+}
+
+func (s *CacheLayer) DuplicateBoard(ctx context.Context, boardID string, userID string, toTeam string, asTemplate bool) (*model.BoardsAndBlocks, []*model.BoardMember, error) {
+	bab, members, err := s.Store.DuplicateBoard(ctx, boardID, userID, toTeam, asTemplate)
+	if bab != nil {
+		s.invalidate(invalidation{BoardIDs: boardIDs(bab.Boards)})
+	}
+	return bab, members, err
+}
+
+func (s *CacheLayer) RemoveDefaultTemplates(ctx context.Context, boards []*model.Board) error {
+	err := s.Store.RemoveDefaultTemplates(ctx, boards)
+	s.invalidate(invalidation{BoardIDs: boardIDs(boards)})
+	return err
+}
+
+func (s *CacheLayer) CreateBoardsAndBlocksWithAdmin(ctx context.Context, bab *model.BoardsAndBlocks, userID string) (*model.BoardsAndBlocks, []*model.BoardMember, error) {
+	newBab, members, err := s.Store.CreateBoardsAndBlocksWithAdmin(ctx, bab, userID)
+	if newBab != nil {
+		s.invalidate(invalidation{BoardIDs: boardIDs(newBab.Boards)})
+	}
+	return newBab, members, err
+}
+
+func (s *CacheLayer) CreateBoardsAndBlocks(ctx context.Context, bab *model.BoardsAndBlocks, userID string) (*model.BoardsAndBlocks, error) {
+	newBab, err := s.Store.CreateBoardsAndBlocks(ctx, bab, userID)
+	if newBab != nil {
+		s.invalidate(invalidation{BoardIDs: boardIDs(newBab.Boards)})
+	}
+	return newBab, err
+}
+
+func (s *CacheLayer) PatchBoardsAndBlocks(ctx context.Context, pbab *model.PatchBoardsAndBlocks, userID string) (*model.BoardsAndBlocks, error) {
+	bab, err := s.Store.PatchBoardsAndBlocks(ctx, pbab, userID)
+	s.invalidate(invalidation{BoardIDs: pbab.BoardIDs})
+	return bab, err
+}
+
+func (s *CacheLayer) DeleteBoardsAndBlocks(ctx context.Context, dbab *model.DeleteBoardsAndBlocks, userID string) error {
+	err := s.Store.DeleteBoardsAndBlocks(ctx, dbab, userID)
+	s.invalidate(invalidation{BoardIDs: dbab.Boards})
+	return err
+}
+
+func (s *CacheLayer) SaveMember(ctx context.Context, bm *model.BoardMember) (*model.BoardMember, error) {
+	member, err := s.Store.SaveMember(ctx, bm)
+	s.invalidate(invalidation{Members: []memberID{{BoardID: bm.BoardID, UserID: bm.UserID}}})
+	return member, err
+}
+
+func (s *CacheLayer) DeleteMember(ctx context.Context, boardID, userID string) error {
+	err := s.Store.DeleteMember(ctx, boardID, userID)
+	s.invalidate(invalidation{Members: []memberID{{BoardID: boardID, UserID: userID}}})
+	return err
+}
+
+func (s *CacheLayer) RunDataRetention(ctx context.Context, globalRetentionDate int64, batchSize int64) (int64, error) {
+	deleted, err := s.Store.RunDataRetention(ctx, globalRetentionDate, batchSize)
+	s.invalidate(invalidation{All: true})
+	return deleted, err
+}
+
+func boardIDs(boards []*model.Board) []string {
+	ids := make([]string, 0, len(boards))
+	for _, board := range boards {
+		ids = append(ids, board.ID)
+	}
+	return ids
+}
+
+func copyBoard(board *model.Board) *model.Board {
+	boardCopy := *board
+	if board.Properties != nil {
+		boardCopy.Properties = copyValue(board.Properties).(map[string]interface{})
+	}
+	if board.CardProperties != nil {
+		boardCopy.CardProperties = make([]map[string]interface{}, len(board.CardProperties))
+		for i, property := range board.CardProperties {
+			if property != nil {
+				boardCopy.CardProperties[i] = copyValue(property).(map[string]interface{})
+			}
+		}
+	}
+	return &boardCopy
+}
+
+// copyValue copies the maps and the slices of a JSON value.
+func copyValue(value interface{}) interface{} {
+	switch v := value.(type) {
+	case map[string]interface{}:
+		valueCopy := make(map[string]interface{}, len(v))
+		for key, item := range v {
+			valueCopy[key] = copyValue(item)
+		}
+		return valueCopy
+	case []interface{}:
+		valueCopy := make([]interface{}, len(v))
+		for i, item := range v {
+			valueCopy[i] = copyValue(item)
+		}
+		return valueCopy
+	default:
+		return value
+	}
+}
+
+func copyMember(member *model.BoardMember) *model.BoardMember {
+	memberCopy := *member
+	return &memberCopy
+}
+
+func copyMembers(members []*model.BoardMember) []*model.BoardMember {
+	if members == nil {
+		return nil
+	}
+	membersCopy := make([]*model.BoardMember, len(members))
+	for i, member := range members {
+		membersCopy[i] = copyMember(member)
+	}
+	return membersCopy
+}
@@ -0,0 +1,189 @@
+// Copyright (c) 2020-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package cachelayer
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/mattermost/mattermost-plugin-boards/server/model"
+	"github.com/mattermost/mattermost-plugin-boards/server/services/store"
+
+	mmModel "github.com/mattermost/mattermost/server/public/model"
+	"github.com/mattermost/mattermost/server/public/shared/mlog"
+)
+
+// ClusterEventID is the ID of the cluster events invalidating the caches of the other nodes.
+const ClusterEventID = "store_cache_invalidation"
+
+const (
+	cacheBoards         = "boards"
+	cacheMembers        = "members"
+	cacheBoardMembers   = "board_members"
+	cacheCategoryBoards = "category_boards"
+)
+
+// Metrics records the reads served by the caches, and the ones going to the store.
+type Metrics interface {
+	IncrementCacheHit(cache string)
+	IncrementCacheMiss(cache string)
+}
+
+// ClusterAPI publishes the invalidations to the other nodes of the cluster.
+type ClusterAPI interface {
+	PublishPluginClusterEvent(ev mmModel.PluginClusterEvent, opts mmModel.PluginClusterEventSendOptions) error
+}
+
+type Params struct {
+	// Size is the maximum number of entries of each cache.
+	Size int
+	// TTL is the time after which a cached entry is read from the store again.
+	TTL time.Duration
+	// ClusterAPI publishes the invalidations to the other nodes, if running in a cluster.
+	ClusterAPI ClusterAPI
+	Logger     mlog.LoggerIFace
+}
+
+// CacheLayer is a store layer caching the boards, the board memberships and the categories of
+// the store it wraps, which are read by most requests. The writes made through the layer
+// invalidate the entries they change, on this node and on the other nodes of the cluster.
+//
+// The memberships derived from a channel change without a write to the store: the membership
+// of a user is not cached when it is synthetic, and the members of a board are cached for the
+// time to live only.
+type CacheLayer struct {
+	store.Store
+
+	boards         *lru[*model.Board]
+	members        *lru[*model.BoardMember]
+	boardMembers   *lru[[]*model.BoardMember]
+	categoryBoards *lru[[]model.CategoryBoards]
+
+	clusterAPI ClusterAPI
+	metrics    Metrics
+	logger     mlog.LoggerIFace
+}
+
+func New(childStore store.Store, params Params) *CacheLayer {
+	return &CacheLayer{
+		Store:          childStore,
+		boards:         newLRU[*model.Board](params.Size, params.TTL),
+		members:        newLRU[*model.BoardMember](params.Size, params.TTL),
+		boardMembers:   newLRU[[]*model.BoardMember](params.Size, params.TTL),
+		categoryBoards: newLRU[[]model.CategoryBoards](params.Size, params.TTL),
+		clusterAPI:     params.ClusterAPI,
+		logger:         params.Logger,
+	}
+}
+
+// SetMetrics sets the metrics recording the hits and misses of the caches.
+func (s *CacheLayer) SetMetrics(metrics Metrics) {
+	s.metrics = metrics
+}
+
+func (s *CacheLayer) observe(cache string, hit bool) {
+	if s.metrics == nil {
+		return
+	}
+	if hit {
+		s.metrics.IncrementCacheHit(cache)
+	} else {
+		s.metrics.IncrementCacheMiss(cache)
+	}
+}
+
+// invalidation lists the entries of the caches changed by a write.
+type invalidation struct {
+	BoardIDs   []string          `json:"boardIds,omitempty"`
+	Members    []memberID        `json:"members,omitempty"`
+	Categories []userCategoryIDs `json:"categories,omitempty"`
+	// UserCategories are the IDs of the users whose categories changed in any team.
+	UserCategories []string `json:"userCategories,omitempty"`
+	AllCategories  bool     `json:"allCategories,omitempty"`
+	All            bool     `json:"all,omitempty"`
+}
+
+type memberID struct {
+	BoardID string `json:"boardId"`
+	UserID  string `json:"userId"`
+}
+
+type userCategoryIDs struct {
+	UserID string `json:"userId"`
+	TeamID string `json:"teamId"`
+}
+
+func memberKey(boardID, userID string) string {
+	return boardID + "/" + userID
+}
+
+func categoriesKey(userID, teamID string) string {
+	return userID + "/" + teamID
+}
+
+// invalidate removes the changed entries from the caches of this node, then from the caches of
+// the other nodes.
+func (s *CacheLayer) invalidate(inv invalidation) {
+	s.apply(inv)
+
+	if s.clusterAPI == nil {
+		return
+	}
+	data, err := json.Marshal(inv)
+	if err != nil {
+		s.logger.Error("cannot marshal cache invalidation", mlog.Err(err))
+		return
+	}
+	event := mmModel.PluginClusterEvent{Id: ClusterEventID, Data: data}
+	opts := mmModel.PluginClusterEventSendOptions{
+		SendType: mmModel.PluginClusterEventSendTypeReliable,
+	}
+	if err := s.clusterAPI.PublishPluginClusterEvent(event, opts); err != nil {
+		s.logger.Error("error publishing cache invalidation", mlog.Err(err))
+	}
+}
+
+func (s *CacheLayer) apply(inv invalidation) {
+	if inv.All {
+		s.boards.purge()
+		s.members.purge()
+		s.boardMembers.purge()
+		s.categoryBoards.purge()
+		return
+	}
+
+	for _, boardID := range inv.BoardIDs {
+		s.boards.remove(boardID)
+		s.boardMembers.remove(boardID)
+		s.members.removePrefix(boardID + "/")
+	}
+	for _, member := range inv.Members {
+		s.members.remove(memberKey(member.BoardID, member.UserID))
+		s.boardMembers.remove(member.BoardID)
+	}
+
+	if inv.AllCategories {
+		s.categoryBoards.purge()
+		return
+	}
+	for _, ids := range inv.Categories {
+		s.categoryBoards.remove(categoriesKey(ids.UserID, ids.TeamID))
+	}
+	for _, userID := range inv.UserCategories {
+		s.categoryBoards.removePrefix(userID + "/")
+	}
+}
+
+// HandleClusterEvent applies the invalidations published by the other nodes.
+func (s *CacheLayer) HandleClusterEvent(ev mmModel.PluginClusterEvent) {
+	var inv invalidation
+	if err := json.Unmarshal(ev.Data, &inv); err != nil {
+		s.logger.Error("cannot unmarshal cache invalidation",
+			mlog.String("id", ev.Id),
+			mlog.Err(err),
+		)
+		return
+	}
+	s.apply(inv)
+}
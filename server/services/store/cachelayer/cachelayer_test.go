@@ -0,0 +1,235 @@
+// Copyright (c) 2020-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package cachelayer
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mattermost/mattermost-plugin-boards/server/model"
+	"github.com/mattermost/mattermost-plugin-boards/server/services/store/mockstore"
+
+	mmModel "github.com/mattermost/mattermost/server/public/model"
+	"github.com/mattermost/mattermost/server/public/shared/mlog"
+)
+
+type testClusterAPI struct {
+	events []mmModel.PluginClusterEvent
+}
+
+func (c *testClusterAPI) PublishPluginClusterEvent(ev mmModel.PluginClusterEvent, _ mmModel.PluginClusterEventSendOptions) error {
+	c.events = append(c.events, ev)
+	return nil
+}
+
+type testMetrics struct {
+	hits   map[string]int
+	misses map[string]int
+}
+
+func (m *testMetrics) IncrementCacheHit(cache string) {
+	m.hits[cache]++
+}
+
+func (m *testMetrics) IncrementCacheMiss(cache string) {
+	m.misses[cache]++
+}
+
+type testHelper struct {
+	store      *mockstore.MockStore
+	clusterAPI *testClusterAPI
+	metrics    *testMetrics
+	cache      *CacheLayer
+}
+
+func setupTestHelper(t *testing.T) *testHelper {
+	ctrl := gomock.NewController(t)
+	logger, err := mlog.NewLogger()
+	require.NoError(t, err)
+
+	th := &testHelper{
+		store:      mockstore.NewMockStore(ctrl),
+		clusterAPI: &testClusterAPI{},
+		metrics:    &testMetrics{hits: map[string]int{}, misses: map[string]int{}},
+	}
+	th.cache = New(th.store, Params{
+		Size:       100,
+		TTL:        time.Minute,
+		ClusterAPI: th.clusterAPI,
+		Logger:     logger,
+	})
+	th.cache.SetMetrics(th.metrics)
+	return th
+}
+
+func TestGetBoard(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("reads the board from the store once", func(t *testing.T) {
+		th := setupTestHelper(t)
+		board := &model.Board{ID: "board1", Title: "title", Properties: map[string]interface{}{"key": "value"}}
+		th.store.EXPECT().GetBoard(gomock.Any(), "board1").Return(board, nil).Times(1)
+
+		for i := 0; i < 3; i++ {
+			cached, err := th.cache.GetBoard(ctx, "board1")
+			require.NoError(t, err)
+			assert.Equal(t, board, cached)
+		}
+		assert.Equal(t, 2, th.metrics.hits[cacheBoards])
+		assert.Equal(t, 1, th.metrics.misses[cacheBoards])
+	})
+
+	t.Run("returns copies of the cached board", func(t *testing.T) {
+		th := setupTestHelper(t)
+		board := &model.Board{ID: "board1", Title: "title", Properties: map[string]interface{}{"key": "value"}}
+		th.store.EXPECT().GetBoard(gomock.Any(), "board1").Return(board, nil).Times(1)
+
+		cached, err := th.cache.GetBoard(ctx, "board1")
+		require.NoError(t, err)
+		cached.Title = "changed"
+		cached.Properties["key"] = "changed"
+
+		cached, err = th.cache.GetBoard(ctx, "board1")
+		require.NoError(t, err)
+		assert.Equal(t, "title", cached.Title)
+		assert.Equal(t, "value", cached.Properties["key"])
+	})
+
+	t.Run("does not cache the errors", func(t *testing.T) {
+		th := setupTestHelper(t)
+		th.store.EXPECT().GetBoard(gomock.Any(), "board1").Return(nil, model.NewErrNotFound("board1")).Times(2)
+
+		for i := 0; i < 2; i++ {
+			_, err := th.cache.GetBoard(ctx, "board1")
+			require.True(t, model.IsErrNotFound(err))
+		}
+	})
+
+	t.Run("invalidated by a patch", func(t *testing.T) {
+		th := setupTestHelper(t)
+		board := &model.Board{ID: "board1", Title: "title"}
+		patchedBoard := &model.Board{ID: "board1", Title: "patched"}
+		gomock.InOrder(
+			th.store.EXPECT().GetBoard(gomock.Any(), "board1").Return(board, nil),
+			th.store.EXPECT().PatchBoard(gomock.Any(), "board1", gomock.Any(), "user1").Return(patchedBoard, nil),
+			th.store.EXPECT().GetBoard(gomock.Any(), "board1").Return(patchedBoard, nil),
+		)
+
+		_, err := th.cache.GetBoard(ctx, "board1")
+		require.NoError(t, err)
+		_, err = th.cache.PatchBoard(ctx, "board1", &model.BoardPatch{}, "user1")
+		require.NoError(t, err)
+
+		cached, err := th.cache.GetBoard(ctx, "board1")
+		require.NoError(t, err)
+		assert.Equal(t, "patched", cached.Title)
+		require.Len(t, th.clusterAPI.events, 1)
+		assert.Equal(t, ClusterEventID, th.clusterAPI.events[0].Id)
+	})
+}
+
+func TestGetMemberForBoard(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("invalidated by saving the member", func(t *testing.T) {
+		th := setupTestHelper(t)
+		member := &model.BoardMember{BoardID: "board1", UserID: "user1", SchemeViewer: true}
+		savedMember := &model.BoardMember{BoardID: "board1", UserID: "user1", SchemeEditor: true}
+		gomock.InOrder(
+			th.store.EXPECT().GetMemberForBoard(gomock.Any(), "board1", "user1").Return(member, nil),
+			th.store.EXPECT().SaveMember(gomock.Any(), savedMember).Return(savedMember, nil),
+			th.store.EXPECT().GetMemberForBoard(gomock.Any(), "board1", "user1").Return(savedMember, nil),
+		)
+
+		_, err := th.cache.GetMemberForBoard(ctx, "board1", "user1")
+		require.NoError(t, err)
+		_, err = th.cache.GetMemberForBoard(ctx, "board1", "user1")
+		require.NoError(t, err)
+		_, err = th.cache.SaveMember(ctx, savedMember)
+		require.NoError(t, err)
+
+		cached, err := th.cache.GetMemberForBoard(ctx, "board1", "user1")
+		require.NoError(t, err)
+		assert.True(t, cached.SchemeEditor)
+	})
+
+	t.Run("does not cache the synthetic members", func(t *testing.T) {
+		th := setupTestHelper(t)
+		member := &model.BoardMember{BoardID: "board1", UserID: "user1", SchemeEditor: true, Synthetic: true}
+		th.store.EXPECT().GetMemberForBoard(gomock.Any(), "board1", "user1").Return(member, nil).Times(2)
+
+		for i := 0; i < 2; i++ {
+			_, err := th.cache.GetMemberForBoard(ctx, "board1", "user1")
+			require.NoError(t, err)
+		}
+	})
+
+	t.Run("invalidated by deleting the board", func(t *testing.T) {
+		th := setupTestHelper(t)
+		member := &model.BoardMember{BoardID: "board1", UserID: "user1", SchemeAdmin: true}
+		th.store.EXPECT().GetMemberForBoard(gomock.Any(), "board1", "user1").Return(member, nil).Times(2)
+		th.store.EXPECT().DeleteBoard(gomock.Any(), "board1", "user1").Return(nil)
+
+		_, err := th.cache.GetMemberForBoard(ctx, "board1", "user1")
+		require.NoError(t, err)
+		require.NoError(t, th.cache.DeleteBoard(ctx, "board1", "user1"))
+		_, err = th.cache.GetMemberForBoard(ctx, "board1", "user1")
+		require.NoError(t, err)
+	})
+}
+
+func TestGetUserCategoryBoards(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("invalidated by the changes of the user categories", func(t *testing.T) {
+		th := setupTestHelper(t)
+		categoryBoards := []model.CategoryBoards{
+			{
+				Category:      model.Category{ID: "category1", UserID: "user1", TeamID: "team1"},
+				BoardMetadata: []model.CategoryBoardMetadata{{BoardID: "board1"}},
+			},
+		}
+		th.store.EXPECT().GetUserCategoryBoards(gomock.Any(), "user1", "team1").Return(categoryBoards, nil).Times(2)
+		th.store.EXPECT().SetBoardVisibility(gomock.Any(), "user1", "category1", "board1", false).Return(nil)
+
+		cached, err := th.cache.GetUserCategoryBoards(ctx, "user1", "team1")
+		require.NoError(t, err)
+		cached[0].BoardMetadata[0].Hidden = true
+
+		cached, err = th.cache.GetUserCategoryBoards(ctx, "user1", "team1")
+		require.NoError(t, err)
+		assert.False(t, cached[0].BoardMetadata[0].Hidden)
+
+		require.NoError(t, th.cache.SetBoardVisibility(ctx, "user1", "category1", "board1", false))
+		_, err = th.cache.GetUserCategoryBoards(ctx, "user1", "team1")
+		require.NoError(t, err)
+	})
+}
+
+func TestHandleClusterEvent(t *testing.T) {
+	ctx := context.Background()
+	th := setupTestHelper(t)
+	other := setupTestHelper(t)
+
+	board := &model.Board{ID: "board1", Title: "title"}
+	th.store.EXPECT().GetBoard(gomock.Any(), "board1").Return(board, nil).Times(2)
+	other.store.EXPECT().DeleteBoard(gomock.Any(), "board1", "user1").Return(nil)
+
+	_, err := th.cache.GetBoard(ctx, "board1")
+	require.NoError(t, err)
+
+	require.NoError(t, other.cache.DeleteBoard(ctx, "board1", "user1"))
+	require.Len(t, other.clusterAPI.events, 1)
+	th.cache.HandleClusterEvent(other.clusterAPI.events[0])
+
+	_, err = th.cache.GetBoard(ctx, "board1")
+	require.NoError(t, err)
+	assert.Equal(t, 2, th.metrics.misses[cacheBoards])
+	assert.Empty(t, th.clusterAPI.events)
+}
@@ -0,0 +1,89 @@
+// Copyright (c) 2020-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package cachelayer
+
+import (
+	"context"
+
+	"github.com/mattermost/mattermost-plugin-boards/server/model"
+)
+
+func (s *CacheLayer) GetUserCategoryBoards(ctx context.Context, userID, teamID string) ([]model.CategoryBoards, error) {
+	key := categoriesKey(userID, teamID)
+	if categoryBoards, ok := s.categoryBoards.get(key); ok {
+		s.observe(cacheCategoryBoards, true)
+		return copyCategoryBoards(categoryBoards), nil
+	}
+	s.observe(cacheCategoryBoards, false)
+
+	generation := s.categoryBoards.currentGeneration()
+	categoryBoards, err := s.Store.GetUserCategoryBoards(ctx, userID, teamID)
+	if err != nil {
+		return nil, err
+	}
+	s.categoryBoards.add(key, copyCategoryBoards(categoryBoards), generation)
+	return categoryBoards, nil
+}
+
+func (s *CacheLayer) CreateCategory(ctx context.Context, category model.Category) error {
+	err := s.Store.CreateCategory(ctx, category)
+	s.invalidate(invalidation{Categories: []userCategoryIDs{{UserID: category.UserID, TeamID: category.TeamID}}})
+	return err
+}
+
+func (s *CacheLayer) UpdateCategory(ctx context.Context, category model.Category) error {
+	err := s.Store.UpdateCategory(ctx, category)
+	s.invalidate(invalidation{Categories: []userCategoryIDs{{UserID: category.UserID, TeamID: category.TeamID}}})
+	return err
+}
+
+func (s *CacheLayer) DeleteCategory(ctx context.Context, categoryID, userID, teamID string) error {
+	err := s.Store.DeleteCategory(ctx, categoryID, userID, teamID)
+	s.invalidate(invalidation{Categories: []userCategoryIDs{{UserID: userID, TeamID: teamID}}})
+	return err
+}
+
+func (s *CacheLayer) ReorderCategories(ctx context.Context, userID, teamID string, newCategoryOrder []string) ([]string, error) {
+	order, err := s.Store.ReorderCategories(ctx, userID, teamID, newCategoryOrder)
+	s.invalidate(invalidation{Categories: []userCategoryIDs{{UserID: userID, TeamID: teamID}}})
+	return order, err
+}
+
+func (s *CacheLayer) AddUpdateCategoryBoard(ctx context.Context, userID, categoryID string, boardIDs []string) error {
+	err := s.Store.AddUpdateCategoryBoard(ctx, userID, categoryID, boardIDs)
+	s.invalidate(invalidation{UserCategories: []string{userID}})
+	return err
+}
+
+func (s *CacheLayer) SetBoardVisibility(ctx context.Context, userID, categoryID, boardID string, visible bool) error {
+	err := s.Store.SetBoardVisibility(ctx, userID, categoryID, boardID, visible)
+	s.invalidate(invalidation{UserCategories: []string{userID}})
+	return err
+}
+
+func (s *CacheLayer) ReorderCategoryBoards(ctx context.Context, categoryID string, newBoardsOrder []string) ([]string, error) {
+	order, err := s.Store.ReorderCategoryBoards(ctx, categoryID, newBoardsOrder)
+
+	// the owner of the category is only known from the category itself.
+	inv := invalidation{AllCategories: true}
+	if category, categoryErr := s.Store.GetCategory(ctx, categoryID); categoryErr == nil {
+		inv = invalidation{Categories: []userCategoryIDs{{UserID: category.UserID, TeamID: category.TeamID}}}
+	}
+	s.invalidate(inv)
+	return order, err
+}
+
+func copyCategoryBoards(categoryBoards []model.CategoryBoards) []model.CategoryBoards {
+	if categoryBoards == nil {
+		return nil
+	}
+	categoryBoardsCopy := make([]model.CategoryBoards, len(categoryBoards))
+	for i, category := range categoryBoards {
+		categoryBoardsCopy[i] = category
+		if category.BoardMetadata != nil {
+			categoryBoardsCopy[i].BoardMetadata = append([]model.CategoryBoardMetadata{}, category.BoardMetadata...)
+		}
+	}
+	return categoryBoardsCopy
+}
@@ -0,0 +1,143 @@
+// Copyright (c) 2020-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package cachelayer
+
+import (
+	"container/list"
+	"strings"
+	"sync"
+	"time"
+)
+
+// lru is a least recently used cache of a bounded size, whose entries expire after a time to live.
+// Expired entries are removed when they are read or evicted, so the cache does not run a
+// goroutine of its own.
+//
+// Every removal starts a new generation of the cache. A value read from the store is only added
+// if no removal happened since the read started, so that a value read before a write is not
+// cached after the write has invalidated it.
+type lru[V any] struct {
+	mutex      sync.Mutex
+	size       int
+	ttl        time.Duration
+	entries    map[string]*list.Element
+	order      *list.List
+	generation uint64
+	now        func() time.Time
+}
+
+type lruEntry[V any] struct {
+	key       string
+	value     V
+	expiresAt time.Time
+}
+
+func newLRU[V any](size int, ttl time.Duration) *lru[V] {
+	return &lru[V]{
+		size:    size,
+		ttl:     ttl,
+		entries: map[string]*list.Element{},
+		order:   list.New(),
+		now:     time.Now,
+	}
+}
+
+// get returns the value of a key, if it is cached and has not expired.
+func (c *lru[V]) get(key string) (V, bool) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	var zero V
+	element, ok := c.entries[key]
+	if !ok {
+		return zero, false
+	}
+	entry := element.Value.(*lruEntry[V])
+	if !c.now().Before(entry.expiresAt) {
+		c.removeElement(element)
+		return zero, false
+	}
+	c.order.MoveToFront(element)
+	return entry.value, true
+}
+
+// currentGeneration returns the generation to add the values read from now on with.
+func (c *lru[V]) currentGeneration() uint64 {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	return c.generation
+}
+
+// add caches the value of a key read in a generation, evicting the least recently used entry if
+// the cache is full. The value is dropped if the cache changed generation since.
+func (c *lru[V]) add(key string, value V, generation uint64) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	if generation != c.generation {
+		return
+	}
+
+	expiresAt := c.now().Add(c.ttl)
+	if element, ok := c.entries[key]; ok {
+		entry := element.Value.(*lruEntry[V])
+		entry.value = value
+		entry.expiresAt = expiresAt
+		c.order.MoveToFront(element)
+		return
+	}
+
+	c.entries[key] = c.order.PushFront(&lruEntry[V]{key: key, value: value, expiresAt: expiresAt})
+	for c.order.Len() > c.size {
+		c.removeElement(c.order.Back())
+	}
+}
+
+// remove removes a key from the cache.
+func (c *lru[V]) remove(key string) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	c.generation++
+	if element, ok := c.entries[key]; ok {
+		c.removeElement(element)
+	}
+}
+
+// removePrefix removes the keys starting with a prefix from the cache.
+func (c *lru[V]) removePrefix(prefix string) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	c.generation++
+	for key, element := range c.entries {
+		if strings.HasPrefix(key, prefix) {
+			c.removeElement(element)
+		}
+	}
+}
+
+// purge removes every key from the cache.
+func (c *lru[V]) purge() {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	c.generation++
+	c.entries = map[string]*list.Element{}
+	c.order.Init()
+}
+
+// len returns the number of entries of the cache, expired or not.
+func (c *lru[V]) len() int {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	return c.order.Len()
+}
+
+func (c *lru[V]) removeElement(element *list.Element) {
+	c.order.Remove(element)
+	delete(c.entries, element.Value.(*lruEntry[V]).key)
+}
@@ -0,0 +1,87 @@
+// Copyright (c) 2020-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package cachelayer
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLRU(t *testing.T) {
+	t.Run("evicts the least recently used entry", func(t *testing.T) {
+		cache := newLRU[int](2, time.Minute)
+		cache.add("a", 1, cache.currentGeneration())
+		cache.add("b", 2, cache.currentGeneration())
+
+		_, ok := cache.get("a")
+		require.True(t, ok)
+
+		cache.add("c", 3, cache.currentGeneration())
+		require.Equal(t, 2, cache.len())
+
+		_, ok = cache.get("b")
+		assert.False(t, ok)
+		value, ok := cache.get("a")
+		require.True(t, ok)
+		assert.Equal(t, 1, value)
+		value, ok = cache.get("c")
+		require.True(t, ok)
+		assert.Equal(t, 3, value)
+	})
+
+	t.Run("expires the entries after the time to live", func(t *testing.T) {
+		now := time.Now()
+		cache := newLRU[int](10, time.Minute)
+		cache.now = func() time.Time { return now }
+		cache.add("a", 1, cache.currentGeneration())
+
+		now = now.Add(59 * time.Second)
+		_, ok := cache.get("a")
+		require.True(t, ok)
+
+		now = now.Add(time.Second)
+		_, ok = cache.get("a")
+		assert.False(t, ok)
+		assert.Equal(t, 0, cache.len())
+	})
+
+	t.Run("does not add the values read before a removal", func(t *testing.T) {
+		cache := newLRU[int](10, time.Minute)
+		generation := cache.currentGeneration()
+
+		cache.remove("a")
+		cache.add("a", 1, generation)
+
+		_, ok := cache.get("a")
+		assert.False(t, ok)
+	})
+
+	t.Run("removes the keys with a prefix", func(t *testing.T) {
+		cache := newLRU[int](10, time.Minute)
+		cache.add("board1/user1", 1, cache.currentGeneration())
+		cache.add("board1/user2", 2, cache.currentGeneration())
+		cache.add("board2/user1", 3, cache.currentGeneration())
+
+		cache.removePrefix("board1/")
+
+		require.Equal(t, 1, cache.len())
+		_, ok := cache.get("board2/user1")
+		assert.True(t, ok)
+	})
+
+	t.Run("purges every key", func(t *testing.T) {
+		cache := newLRU[int](10, time.Minute)
+		cache.add("a", 1, cache.currentGeneration())
+		cache.add("b", 2, cache.currentGeneration())
+
+		cache.purge()
+
+		assert.Equal(t, 0, cache.len())
+		_, ok := cache.get("a")
+		assert.False(t, ok)
+	})
+}
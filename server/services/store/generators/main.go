@@ -26,6 +26,7 @@ const (
 	Int32Type              = "int32"
 	Int64Type              = "int64"
 	BoolType               = "bool"
+	ContextType            = "context.Context"
 )
 
 func isError(typeName string) bool {
@@ -48,6 +49,12 @@ func main() {
 	if err := buildTransactionalStore(); err != nil {
 		log.Fatal(err)
 	}
+	if err := buildTimerLayer(); err != nil {
+		log.Fatal(err)
+	}
+	if err := buildTracingLayer(); err != nil {
+		log.Fatal(err)
+	}
 }
 
 func buildTransactionalStore() error {
@@ -63,6 +70,32 @@ func buildTransactionalStore() error {
 	return os.WriteFile(path.Join("sqlstore/public_methods.go"), formatedCode, 0644) //nolint:gosec
 }
 
+func buildTimerLayer() error {
+	code, err := generateLayer("TimerLayer", "timer_layer.go.tmpl")
+	if err != nil {
+		return err
+	}
+	formatedCode, err := format.Source(code)
+	if err != nil {
+		return err
+	}
+
+	return os.WriteFile(path.Join("timerlayer/timerlayer.go"), formatedCode, 0644) //nolint:gosec
+}
+
+func buildTracingLayer() error {
+	code, err := generateLayer("TracingLayer", "tracing_layer.go.tmpl")
+	if err != nil {
+		return err
+	}
+	formatedCode, err := format.Source(code)
+	if err != nil {
+		return err
+	}
+
+	return os.WriteFile(path.Join("tracinglayer/tracinglayer.go"), formatedCode, 0644) //nolint:gosec
+}
+
 type methodParam struct {
 	Name string
 	Type string
@@ -223,6 +256,20 @@ func generateLayer(name, templateFile string) ([]byte, error) {
 			}
 			return strings.Join(paramsNames, ", ")
 		},
+		"joinStoreParams": func(params []methodParam) string {
+			paramsNames := make([]string, 0, len(params))
+			for _, param := range params {
+				if param.Type == ContextType {
+					continue
+				}
+				tParams := ""
+				if strings.HasPrefix(param.Type, "...") {
+					tParams = "..."
+				}
+				paramsNames = append(paramsNames, param.Name+tParams)
+			}
+			return strings.Join(paramsNames, ", ")
+		},
 		"joinParamsWithType": func(params []methodParam) string {
 			paramsWithType := []string{}
 			for _, param := range params {
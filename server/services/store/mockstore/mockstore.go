@@ -5,6 +5,7 @@
 package mockstore
 
 import (
+	context "context"
 	reflect "reflect"
 	time "time"
 
@@ -36,54 +37,98 @@ func (m *MockStore) EXPECT() *MockStoreMockRecorder {
 	return m.recorder
 }
 
+// AddCommentReaction mocks base method.
+func (m *MockStore) AddCommentReaction(ctx context.Context, reaction *model.CommentReaction) (*model.CommentReaction, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddCommentReaction", ctx, reaction)
+	ret0, _ := ret[0].(*model.CommentReaction)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddCommentReaction indicates an expected call of AddCommentReaction.
+func (mr *MockStoreMockRecorder) AddCommentReaction(ctx, reaction interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddCommentReaction", reflect.TypeOf((*MockStore)(nil).AddCommentReaction), ctx, reaction)
+}
+
+// AddFileUsage mocks base method.
+func (m *MockStore) AddFileUsage(ctx context.Context, teamID, boardID string, bytes, fileCount int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddFileUsage", ctx, teamID, boardID, bytes, fileCount)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AddFileUsage indicates an expected call of AddFileUsage.
+func (mr *MockStoreMockRecorder) AddFileUsage(ctx, teamID, boardID, bytes, fileCount interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddFileUsage", reflect.TypeOf((*MockStore)(nil).AddFileUsage), ctx, teamID, boardID, bytes, fileCount)
+}
+
 // AddUpdateCategoryBoard mocks base method.
-func (m *MockStore) AddUpdateCategoryBoard(userID, categoryID string, boardIDs []string) error {
+func (m *MockStore) AddUpdateCategoryBoard(ctx context.Context, userID, categoryID string, boardIDs []string) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "AddUpdateCategoryBoard", userID, categoryID, boardIDs)
+	ret := m.ctrl.Call(m, "AddUpdateCategoryBoard", ctx, userID, categoryID, boardIDs)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // AddUpdateCategoryBoard indicates an expected call of AddUpdateCategoryBoard.
-func (mr *MockStoreMockRecorder) AddUpdateCategoryBoard(userID, categoryID, boardIDs interface{}) *gomock.Call {
+func (mr *MockStoreMockRecorder) AddUpdateCategoryBoard(ctx, userID, categoryID, boardIDs interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddUpdateCategoryBoard", reflect.TypeOf((*MockStore)(nil).AddUpdateCategoryBoard), userID, categoryID, boardIDs)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddUpdateCategoryBoard", reflect.TypeOf((*MockStore)(nil).AddUpdateCategoryBoard), ctx, userID, categoryID, boardIDs)
 }
 
 // CanSeeUser mocks base method.
-func (m *MockStore) CanSeeUser(seerID, seenID string) (bool, error) {
+func (m *MockStore) CanSeeUser(ctx context.Context, seerID, seenID string) (bool, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CanSeeUser", seerID, seenID)
+	ret := m.ctrl.Call(m, "CanSeeUser", ctx, seerID, seenID)
 	ret0, _ := ret[0].(bool)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // CanSeeUser indicates an expected call of CanSeeUser.
-func (mr *MockStoreMockRecorder) CanSeeUser(seerID, seenID interface{}) *gomock.Call {
+func (mr *MockStoreMockRecorder) CanSeeUser(ctx, seerID, seenID interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CanSeeUser", reflect.TypeOf((*MockStore)(nil).CanSeeUser), seerID, seenID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CanSeeUser", reflect.TypeOf((*MockStore)(nil).CanSeeUser), ctx, seerID, seenID)
+}
+
+// CountFileInfosWithPath mocks base method.
+func (m *MockStore) CountFileInfosWithPath(ctx context.Context, path string) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountFileInfosWithPath", ctx, path)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountFileInfosWithPath indicates an expected call of CountFileInfosWithPath.
+func (mr *MockStoreMockRecorder) CountFileInfosWithPath(ctx, path interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountFileInfosWithPath", reflect.TypeOf((*MockStore)(nil).CountFileInfosWithPath), ctx, path)
 }
 
 // CreateBoardsAndBlocks mocks base method.
-func (m *MockStore) CreateBoardsAndBlocks(bab *model.BoardsAndBlocks, userID string) (*model.BoardsAndBlocks, error) {
+func (m *MockStore) CreateBoardsAndBlocks(ctx context.Context, bab *model.BoardsAndBlocks, userID string) (*model.BoardsAndBlocks, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CreateBoardsAndBlocks", bab, userID)
+	ret := m.ctrl.Call(m, "CreateBoardsAndBlocks", ctx, bab, userID)
 	ret0, _ := ret[0].(*model.BoardsAndBlocks)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // CreateBoardsAndBlocks indicates an expected call of CreateBoardsAndBlocks.
-func (mr *MockStoreMockRecorder) CreateBoardsAndBlocks(bab, userID interface{}) *gomock.Call {
+func (mr *MockStoreMockRecorder) CreateBoardsAndBlocks(ctx, bab, userID interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateBoardsAndBlocks", reflect.TypeOf((*MockStore)(nil).CreateBoardsAndBlocks), bab, userID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateBoardsAndBlocks", reflect.TypeOf((*MockStore)(nil).CreateBoardsAndBlocks), ctx, bab, userID)
 }
 
 // CreateBoardsAndBlocksWithAdmin mocks base method.
-func (m *MockStore) CreateBoardsAndBlocksWithAdmin(bab *model.BoardsAndBlocks, userID string) (*model.BoardsAndBlocks, []*model.BoardMember, error) {
+func (m *MockStore) CreateBoardsAndBlocksWithAdmin(ctx context.Context, bab *model.BoardsAndBlocks, userID string) (*model.BoardsAndBlocks, []*model.BoardMember, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CreateBoardsAndBlocksWithAdmin", bab, userID)
+	ret := m.ctrl.Call(m, "CreateBoardsAndBlocksWithAdmin", ctx, bab, userID)
 	ret0, _ := ret[0].(*model.BoardsAndBlocks)
 	ret1, _ := ret[1].([]*model.BoardMember)
 	ret2, _ := ret[2].(error)
@@ -91,38 +136,68 @@ func (m *MockStore) CreateBoardsAndBlocksWithAdmin(bab *model.BoardsAndBlocks, u
 }
 
 // CreateBoardsAndBlocksWithAdmin indicates an expected call of CreateBoardsAndBlocksWithAdmin.
-func (mr *MockStoreMockRecorder) CreateBoardsAndBlocksWithAdmin(bab, userID interface{}) *gomock.Call {
+func (mr *MockStoreMockRecorder) CreateBoardsAndBlocksWithAdmin(ctx, bab, userID interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateBoardsAndBlocksWithAdmin", reflect.TypeOf((*MockStore)(nil).CreateBoardsAndBlocksWithAdmin), bab, userID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateBoardsAndBlocksWithAdmin", reflect.TypeOf((*MockStore)(nil).CreateBoardsAndBlocksWithAdmin), ctx, bab, userID)
 }
 
 // CreateCategory mocks base method.
-func (m *MockStore) CreateCategory(category model.Category) error {
+func (m *MockStore) CreateCategory(ctx context.Context, category model.Category) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CreateCategory", category)
+	ret := m.ctrl.Call(m, "CreateCategory", ctx, category)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // CreateCategory indicates an expected call of CreateCategory.
-func (mr *MockStoreMockRecorder) CreateCategory(category interface{}) *gomock.Call {
+func (mr *MockStoreMockRecorder) CreateCategory(ctx, category interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateCategory", reflect.TypeOf((*MockStore)(nil).CreateCategory), category)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateCategory", reflect.TypeOf((*MockStore)(nil).CreateCategory), ctx, category)
+}
+
+// CreateNotification mocks base method.
+func (m *MockStore) CreateNotification(ctx context.Context, notification *model.Notification) (*model.Notification, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateNotification", ctx, notification)
+	ret0, _ := ret[0].(*model.Notification)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateNotification indicates an expected call of CreateNotification.
+func (mr *MockStoreMockRecorder) CreateNotification(ctx, notification interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateNotification", reflect.TypeOf((*MockStore)(nil).CreateNotification), ctx, notification)
+}
+
+// CreateShareLink mocks base method.
+func (m *MockStore) CreateShareLink(ctx context.Context, link *model.ShareLink) (*model.ShareLink, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateShareLink", ctx, link)
+	ret0, _ := ret[0].(*model.ShareLink)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateShareLink indicates an expected call of CreateShareLink.
+func (mr *MockStoreMockRecorder) CreateShareLink(ctx, link interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateShareLink", reflect.TypeOf((*MockStore)(nil).CreateShareLink), ctx, link)
 }
 
 // CreateSubscription mocks base method.
-func (m *MockStore) CreateSubscription(sub *model.Subscription) (*model.Subscription, error) {
+func (m *MockStore) CreateSubscription(ctx context.Context, sub *model.Subscription) (*model.Subscription, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CreateSubscription", sub)
+	ret := m.ctrl.Call(m, "CreateSubscription", ctx, sub)
 	ret0, _ := ret[0].(*model.Subscription)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // CreateSubscription indicates an expected call of CreateSubscription.
-func (mr *MockStoreMockRecorder) CreateSubscription(sub interface{}) *gomock.Call {
+func (mr *MockStoreMockRecorder) CreateSubscription(ctx, sub interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateSubscription", reflect.TypeOf((*MockStore)(nil).CreateSubscription), sub)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateSubscription", reflect.TypeOf((*MockStore)(nil).CreateSubscription), ctx, sub)
 }
 
 // DBType mocks base method.
@@ -153,151 +228,250 @@ func (mr *MockStoreMockRecorder) DBVersion() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DBVersion", reflect.TypeOf((*MockStore)(nil).DBVersion))
 }
 
+// DeleteAuditEntriesBefore mocks base method.
+func (m *MockStore) DeleteAuditEntriesBefore(ctx context.Context, before int64) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteAuditEntriesBefore", ctx, before)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteAuditEntriesBefore indicates an expected call of DeleteAuditEntriesBefore.
+func (mr *MockStoreMockRecorder) DeleteAuditEntriesBefore(ctx, before interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteAuditEntriesBefore", reflect.TypeOf((*MockStore)(nil).DeleteAuditEntriesBefore), ctx, before)
+}
+
 // DeleteBlock mocks base method.
-func (m *MockStore) DeleteBlock(blockID, modifiedBy string) error {
+func (m *MockStore) DeleteBlock(ctx context.Context, blockID, modifiedBy string) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DeleteBlock", blockID, modifiedBy)
+	ret := m.ctrl.Call(m, "DeleteBlock", ctx, blockID, modifiedBy)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // DeleteBlock indicates an expected call of DeleteBlock.
-func (mr *MockStoreMockRecorder) DeleteBlock(blockID, modifiedBy interface{}) *gomock.Call {
+func (mr *MockStoreMockRecorder) DeleteBlock(ctx, blockID, modifiedBy interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteBlock", reflect.TypeOf((*MockStore)(nil).DeleteBlock), blockID, modifiedBy)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteBlock", reflect.TypeOf((*MockStore)(nil).DeleteBlock), ctx, blockID, modifiedBy)
 }
 
 // DeleteBlockRecord mocks base method.
-func (m *MockStore) DeleteBlockRecord(blockID, modifiedBy string) error {
+func (m *MockStore) DeleteBlockRecord(ctx context.Context, blockID, modifiedBy string) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DeleteBlockRecord", blockID, modifiedBy)
+	ret := m.ctrl.Call(m, "DeleteBlockRecord", ctx, blockID, modifiedBy)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // DeleteBlockRecord indicates an expected call of DeleteBlockRecord.
-func (mr *MockStoreMockRecorder) DeleteBlockRecord(blockID, modifiedBy interface{}) *gomock.Call {
+func (mr *MockStoreMockRecorder) DeleteBlockRecord(ctx, blockID, modifiedBy interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteBlockRecord", reflect.TypeOf((*MockStore)(nil).DeleteBlockRecord), ctx, blockID, modifiedBy)
+}
+
+// DeleteBlockSuiteDocByCardID mocks base method.
+func (m *MockStore) DeleteBlockSuiteDocByCardID(ctx context.Context, cardID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteBlockSuiteDocByCardID", ctx, cardID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteBlockSuiteDocByCardID indicates an expected call of DeleteBlockSuiteDocByCardID.
+func (mr *MockStoreMockRecorder) DeleteBlockSuiteDocByCardID(ctx, cardID interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteBlockRecord", reflect.TypeOf((*MockStore)(nil).DeleteBlockRecord), blockID, modifiedBy)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteBlockSuiteDocByCardID", reflect.TypeOf((*MockStore)(nil).DeleteBlockSuiteDocByCardID), ctx, cardID)
 }
 
 // DeleteBoard mocks base method.
-func (m *MockStore) DeleteBoard(boardID, userID string) error {
+func (m *MockStore) DeleteBoard(ctx context.Context, boardID, userID string) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DeleteBoard", boardID, userID)
+	ret := m.ctrl.Call(m, "DeleteBoard", ctx, boardID, userID)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // DeleteBoard indicates an expected call of DeleteBoard.
-func (mr *MockStoreMockRecorder) DeleteBoard(boardID, userID interface{}) *gomock.Call {
+func (mr *MockStoreMockRecorder) DeleteBoard(ctx, boardID, userID interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteBoard", reflect.TypeOf((*MockStore)(nil).DeleteBoard), boardID, userID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteBoard", reflect.TypeOf((*MockStore)(nil).DeleteBoard), ctx, boardID, userID)
 }
 
 // DeleteBoardRecord mocks base method.
-func (m *MockStore) DeleteBoardRecord(boardID, modifiedBy string) error {
+func (m *MockStore) DeleteBoardRecord(ctx context.Context, boardID, modifiedBy string) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DeleteBoardRecord", boardID, modifiedBy)
+	ret := m.ctrl.Call(m, "DeleteBoardRecord", ctx, boardID, modifiedBy)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // DeleteBoardRecord indicates an expected call of DeleteBoardRecord.
-func (mr *MockStoreMockRecorder) DeleteBoardRecord(boardID, modifiedBy interface{}) *gomock.Call {
+func (mr *MockStoreMockRecorder) DeleteBoardRecord(ctx, boardID, modifiedBy interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteBoardRecord", reflect.TypeOf((*MockStore)(nil).DeleteBoardRecord), boardID, modifiedBy)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteBoardRecord", reflect.TypeOf((*MockStore)(nil).DeleteBoardRecord), ctx, boardID, modifiedBy)
 }
 
 // DeleteBoardsAndBlocks mocks base method.
-func (m *MockStore) DeleteBoardsAndBlocks(dbab *model.DeleteBoardsAndBlocks, userID string) error {
+func (m *MockStore) DeleteBoardsAndBlocks(ctx context.Context, dbab *model.DeleteBoardsAndBlocks, userID string) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DeleteBoardsAndBlocks", dbab, userID)
+	ret := m.ctrl.Call(m, "DeleteBoardsAndBlocks", ctx, dbab, userID)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // DeleteBoardsAndBlocks indicates an expected call of DeleteBoardsAndBlocks.
-func (mr *MockStoreMockRecorder) DeleteBoardsAndBlocks(dbab, userID interface{}) *gomock.Call {
+func (mr *MockStoreMockRecorder) DeleteBoardsAndBlocks(ctx, dbab, userID interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteBoardsAndBlocks", reflect.TypeOf((*MockStore)(nil).DeleteBoardsAndBlocks), dbab, userID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteBoardsAndBlocks", reflect.TypeOf((*MockStore)(nil).DeleteBoardsAndBlocks), ctx, dbab, userID)
 }
 
 // DeleteCategory mocks base method.
-func (m *MockStore) DeleteCategory(categoryID, userID, teamID string) error {
+func (m *MockStore) DeleteCategory(ctx context.Context, categoryID, userID, teamID string) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DeleteCategory", categoryID, userID, teamID)
+	ret := m.ctrl.Call(m, "DeleteCategory", ctx, categoryID, userID, teamID)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // DeleteCategory indicates an expected call of DeleteCategory.
-func (mr *MockStoreMockRecorder) DeleteCategory(categoryID, userID, teamID interface{}) *gomock.Call {
+func (mr *MockStoreMockRecorder) DeleteCategory(ctx, categoryID, userID, teamID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteCategory", reflect.TypeOf((*MockStore)(nil).DeleteCategory), ctx, categoryID, userID, teamID)
+}
+
+// DeleteCommentReaction mocks base method.
+func (m *MockStore) DeleteCommentReaction(ctx context.Context, blockID, userID, emojiName string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteCommentReaction", ctx, blockID, userID, emojiName)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteCommentReaction indicates an expected call of DeleteCommentReaction.
+func (mr *MockStoreMockRecorder) DeleteCommentReaction(ctx, blockID, userID, emojiName interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteCommentReaction", reflect.TypeOf((*MockStore)(nil).DeleteCommentReaction), ctx, blockID, userID, emojiName)
+}
+
+// DeleteFileInfo mocks base method.
+func (m *MockStore) DeleteFileInfo(ctx context.Context, id string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteFileInfo", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteFileInfo indicates an expected call of DeleteFileInfo.
+func (mr *MockStoreMockRecorder) DeleteFileInfo(ctx, id interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteCategory", reflect.TypeOf((*MockStore)(nil).DeleteCategory), categoryID, userID, teamID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteFileInfo", reflect.TypeOf((*MockStore)(nil).DeleteFileInfo), ctx, id)
+}
+
+// DeleteFiles mocks base method.
+func (m *MockStore) DeleteFiles(ctx context.Context, boardID string, fileIDs []string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteFiles", ctx, boardID, fileIDs)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteFiles indicates an expected call of DeleteFiles.
+func (mr *MockStoreMockRecorder) DeleteFiles(ctx, boardID, fileIDs interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteFiles", reflect.TypeOf((*MockStore)(nil).DeleteFiles), ctx, boardID, fileIDs)
 }
 
 // DeleteMember mocks base method.
-func (m *MockStore) DeleteMember(boardID, userID string) error {
+func (m *MockStore) DeleteMember(ctx context.Context, boardID, userID string) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DeleteMember", boardID, userID)
+	ret := m.ctrl.Call(m, "DeleteMember", ctx, boardID, userID)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // DeleteMember indicates an expected call of DeleteMember.
-func (mr *MockStoreMockRecorder) DeleteMember(boardID, userID interface{}) *gomock.Call {
+func (mr *MockStoreMockRecorder) DeleteMember(ctx, boardID, userID interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteMember", reflect.TypeOf((*MockStore)(nil).DeleteMember), boardID, userID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteMember", reflect.TypeOf((*MockStore)(nil).DeleteMember), ctx, boardID, userID)
 }
 
 // DeleteNotificationHint mocks base method.
-func (m *MockStore) DeleteNotificationHint(blockID string) error {
+func (m *MockStore) DeleteNotificationHint(ctx context.Context, blockID string) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DeleteNotificationHint", blockID)
+	ret := m.ctrl.Call(m, "DeleteNotificationHint", ctx, blockID)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // DeleteNotificationHint indicates an expected call of DeleteNotificationHint.
-func (mr *MockStoreMockRecorder) DeleteNotificationHint(blockID interface{}) *gomock.Call {
+func (mr *MockStoreMockRecorder) DeleteNotificationHint(ctx, blockID interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteNotificationHint", reflect.TypeOf((*MockStore)(nil).DeleteNotificationHint), blockID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteNotificationHint", reflect.TypeOf((*MockStore)(nil).DeleteNotificationHint), ctx, blockID)
+}
+
+// DeleteNotificationPreferences mocks base method.
+func (m *MockStore) DeleteNotificationPreferences(ctx context.Context, userID, boardID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteNotificationPreferences", ctx, userID, boardID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteNotificationPreferences indicates an expected call of DeleteNotificationPreferences.
+func (mr *MockStoreMockRecorder) DeleteNotificationPreferences(ctx, userID, boardID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteNotificationPreferences", reflect.TypeOf((*MockStore)(nil).DeleteNotificationPreferences), ctx, userID, boardID)
+}
+
+// DeleteStorageQuota mocks base method.
+func (m *MockStore) DeleteStorageQuota(ctx context.Context, scope model.StorageQuotaScope, scopeID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteStorageQuota", ctx, scope, scopeID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteStorageQuota indicates an expected call of DeleteStorageQuota.
+func (mr *MockStoreMockRecorder) DeleteStorageQuota(ctx, scope, scopeID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteStorageQuota", reflect.TypeOf((*MockStore)(nil).DeleteStorageQuota), ctx, scope, scopeID)
 }
 
 // DeleteSubscription mocks base method.
-func (m *MockStore) DeleteSubscription(blockID, subscriberID string) error {
+func (m *MockStore) DeleteSubscription(ctx context.Context, blockID, subscriberID string) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DeleteSubscription", blockID, subscriberID)
+	ret := m.ctrl.Call(m, "DeleteSubscription", ctx, blockID, subscriberID)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // DeleteSubscription indicates an expected call of DeleteSubscription.
-func (mr *MockStoreMockRecorder) DeleteSubscription(blockID, subscriberID interface{}) *gomock.Call {
+func (mr *MockStoreMockRecorder) DeleteSubscription(ctx, blockID, subscriberID interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteSubscription", reflect.TypeOf((*MockStore)(nil).DeleteSubscription), blockID, subscriberID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteSubscription", reflect.TypeOf((*MockStore)(nil).DeleteSubscription), ctx, blockID, subscriberID)
 }
 
 // DuplicateBlock mocks base method.
-func (m *MockStore) DuplicateBlock(boardID, blockID, userID string, asTemplate bool) ([]*model.Block, error) {
+func (m *MockStore) DuplicateBlock(ctx context.Context, boardID, blockID, userID string, asTemplate bool) ([]*model.Block, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DuplicateBlock", boardID, blockID, userID, asTemplate)
+	ret := m.ctrl.Call(m, "DuplicateBlock", ctx, boardID, blockID, userID, asTemplate)
 	ret0, _ := ret[0].([]*model.Block)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // DuplicateBlock indicates an expected call of DuplicateBlock.
-func (mr *MockStoreMockRecorder) DuplicateBlock(boardID, blockID, userID, asTemplate interface{}) *gomock.Call {
+func (mr *MockStoreMockRecorder) DuplicateBlock(ctx, boardID, blockID, userID, asTemplate interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DuplicateBlock", reflect.TypeOf((*MockStore)(nil).DuplicateBlock), boardID, blockID, userID, asTemplate)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DuplicateBlock", reflect.TypeOf((*MockStore)(nil).DuplicateBlock), ctx, boardID, blockID, userID, asTemplate)
 }
 
 // DuplicateBoard mocks base method.
-func (m *MockStore) DuplicateBoard(boardID, userID, toTeam string, asTemplate bool) (*model.BoardsAndBlocks, []*model.BoardMember, error) {
+func (m *MockStore) DuplicateBoard(ctx context.Context, boardID, userID, toTeam string, asTemplate bool) (*model.BoardsAndBlocks, []*model.BoardMember, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DuplicateBoard", boardID, userID, toTeam, asTemplate)
+	ret := m.ctrl.Call(m, "DuplicateBoard", ctx, boardID, userID, toTeam, asTemplate)
 	ret0, _ := ret[0].(*model.BoardsAndBlocks)
 	ret1, _ := ret[1].([]*model.BoardMember)
 	ret2, _ := ret[2].(error)
@@ -305,105 +479,152 @@ func (m *MockStore) DuplicateBoard(boardID, userID, toTeam string, asTemplate bo
 }
 
 // DuplicateBoard indicates an expected call of DuplicateBoard.
-func (mr *MockStoreMockRecorder) DuplicateBoard(boardID, userID, toTeam, asTemplate interface{}) *gomock.Call {
+func (mr *MockStoreMockRecorder) DuplicateBoard(ctx, boardID, userID, toTeam, asTemplate interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DuplicateBoard", reflect.TypeOf((*MockStore)(nil).DuplicateBoard), boardID, userID, toTeam, asTemplate)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DuplicateBoard", reflect.TypeOf((*MockStore)(nil).DuplicateBoard), ctx, boardID, userID, toTeam, asTemplate)
 }
 
 // GetActiveUserCount mocks base method.
-func (m *MockStore) GetActiveUserCount(updatedSecondsAgo int64) (int, error) {
+func (m *MockStore) GetActiveUserCount(ctx context.Context, updatedSecondsAgo int64) (int, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetActiveUserCount", updatedSecondsAgo)
+	ret := m.ctrl.Call(m, "GetActiveUserCount", ctx, updatedSecondsAgo)
 	ret0, _ := ret[0].(int)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetActiveUserCount indicates an expected call of GetActiveUserCount.
-func (mr *MockStoreMockRecorder) GetActiveUserCount(updatedSecondsAgo interface{}) *gomock.Call {
+func (mr *MockStoreMockRecorder) GetActiveUserCount(ctx, updatedSecondsAgo interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetActiveUserCount", reflect.TypeOf((*MockStore)(nil).GetActiveUserCount), updatedSecondsAgo)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetActiveUserCount", reflect.TypeOf((*MockStore)(nil).GetActiveUserCount), ctx, updatedSecondsAgo)
+}
+
+// GetActiveUserCountsByTeam mocks base method.
+func (m *MockStore) GetActiveUserCountsByTeam(ctx context.Context, teamID string, at int64) (model.ActiveUserCounts, map[string]model.ActiveUserCounts, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetActiveUserCountsByTeam", ctx, teamID, at)
+	ret0, _ := ret[0].(model.ActiveUserCounts)
+	ret1, _ := ret[1].(map[string]model.ActiveUserCounts)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetActiveUserCountsByTeam indicates an expected call of GetActiveUserCountsByTeam.
+func (mr *MockStoreMockRecorder) GetActiveUserCountsByTeam(ctx, teamID, at interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetActiveUserCountsByTeam", reflect.TypeOf((*MockStore)(nil).GetActiveUserCountsByTeam), ctx, teamID, at)
 }
 
 // GetAllTeams mocks base method.
-func (m *MockStore) GetAllTeams() ([]*model.Team, error) {
+func (m *MockStore) GetAllTeams(ctx context.Context) ([]*model.Team, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetAllTeams")
+	ret := m.ctrl.Call(m, "GetAllTeams", ctx)
 	ret0, _ := ret[0].([]*model.Team)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetAllTeams indicates an expected call of GetAllTeams.
-func (mr *MockStoreMockRecorder) GetAllTeams() *gomock.Call {
+func (mr *MockStoreMockRecorder) GetAllTeams(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAllTeams", reflect.TypeOf((*MockStore)(nil).GetAllTeams), ctx)
+}
+
+// GetAuditEntries mocks base method.
+func (m *MockStore) GetAuditEntries(ctx context.Context, opts model.QueryAuditEntriesOptions) ([]*model.AuditEntry, bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAuditEntries", ctx, opts)
+	ret0, _ := ret[0].([]*model.AuditEntry)
+	ret1, _ := ret[1].(bool)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetAuditEntries indicates an expected call of GetAuditEntries.
+func (mr *MockStoreMockRecorder) GetAuditEntries(ctx, opts interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAllTeams", reflect.TypeOf((*MockStore)(nil).GetAllTeams))
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAuditEntries", reflect.TypeOf((*MockStore)(nil).GetAuditEntries), ctx, opts)
 }
 
 // GetBlock mocks base method.
-func (m *MockStore) GetBlock(blockID string) (*model.Block, error) {
+func (m *MockStore) GetBlock(ctx context.Context, blockID string) (*model.Block, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetBlock", blockID)
+	ret := m.ctrl.Call(m, "GetBlock", ctx, blockID)
 	ret0, _ := ret[0].(*model.Block)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetBlock indicates an expected call of GetBlock.
-func (mr *MockStoreMockRecorder) GetBlock(blockID interface{}) *gomock.Call {
+func (mr *MockStoreMockRecorder) GetBlock(ctx, blockID interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBlock", reflect.TypeOf((*MockStore)(nil).GetBlock), blockID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBlock", reflect.TypeOf((*MockStore)(nil).GetBlock), ctx, blockID)
 }
 
 // GetBlockCountsByType mocks base method.
-func (m *MockStore) GetBlockCountsByType() (map[string]int64, error) {
+func (m *MockStore) GetBlockCountsByType(ctx context.Context) (map[string]int64, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetBlockCountsByType")
+	ret := m.ctrl.Call(m, "GetBlockCountsByType", ctx)
 	ret0, _ := ret[0].(map[string]int64)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetBlockCountsByType indicates an expected call of GetBlockCountsByType.
-func (mr *MockStoreMockRecorder) GetBlockCountsByType() *gomock.Call {
+func (mr *MockStoreMockRecorder) GetBlockCountsByType(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBlockCountsByType", reflect.TypeOf((*MockStore)(nil).GetBlockCountsByType), ctx)
+}
+
+// GetBlockCountsByTypeWithOptions mocks base method.
+func (m *MockStore) GetBlockCountsByTypeWithOptions(ctx context.Context, opts model.StatisticsOptions) (map[string]int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetBlockCountsByTypeWithOptions", ctx, opts)
+	ret0, _ := ret[0].(map[string]int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetBlockCountsByTypeWithOptions indicates an expected call of GetBlockCountsByTypeWithOptions.
+func (mr *MockStoreMockRecorder) GetBlockCountsByTypeWithOptions(ctx, opts interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBlockCountsByType", reflect.TypeOf((*MockStore)(nil).GetBlockCountsByType))
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBlockCountsByTypeWithOptions", reflect.TypeOf((*MockStore)(nil).GetBlockCountsByTypeWithOptions), ctx, opts)
 }
 
 // GetBlockHistory mocks base method.
-func (m *MockStore) GetBlockHistory(blockID string, opts model.QueryBlockHistoryOptions) ([]*model.Block, error) {
+func (m *MockStore) GetBlockHistory(ctx context.Context, blockID string, opts model.QueryBlockHistoryOptions) ([]*model.Block, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetBlockHistory", blockID, opts)
+	ret := m.ctrl.Call(m, "GetBlockHistory", ctx, blockID, opts)
 	ret0, _ := ret[0].([]*model.Block)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetBlockHistory indicates an expected call of GetBlockHistory.
-func (mr *MockStoreMockRecorder) GetBlockHistory(blockID, opts interface{}) *gomock.Call {
+func (mr *MockStoreMockRecorder) GetBlockHistory(ctx, blockID, opts interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBlockHistory", reflect.TypeOf((*MockStore)(nil).GetBlockHistory), blockID, opts)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBlockHistory", reflect.TypeOf((*MockStore)(nil).GetBlockHistory), ctx, blockID, opts)
 }
 
 // GetBlockHistoryDescendants mocks base method.
-func (m *MockStore) GetBlockHistoryDescendants(boardID string, opts model.QueryBlockHistoryOptions) ([]*model.Block, error) {
+func (m *MockStore) GetBlockHistoryDescendants(ctx context.Context, boardID string, opts model.QueryBlockHistoryOptions) ([]*model.Block, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetBlockHistoryDescendants", boardID, opts)
+	ret := m.ctrl.Call(m, "GetBlockHistoryDescendants", ctx, boardID, opts)
 	ret0, _ := ret[0].([]*model.Block)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetBlockHistoryDescendants indicates an expected call of GetBlockHistoryDescendants.
-func (mr *MockStoreMockRecorder) GetBlockHistoryDescendants(boardID, opts interface{}) *gomock.Call {
+func (mr *MockStoreMockRecorder) GetBlockHistoryDescendants(ctx, boardID, opts interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBlockHistoryDescendants", reflect.TypeOf((*MockStore)(nil).GetBlockHistoryDescendants), boardID, opts)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBlockHistoryDescendants", reflect.TypeOf((*MockStore)(nil).GetBlockHistoryDescendants), ctx, boardID, opts)
 }
 
 // GetBlockHistoryNewestChildren mocks base method.
-func (m *MockStore) GetBlockHistoryNewestChildren(parentID string, opts model.QueryBlockHistoryChildOptions) ([]*model.Block, bool, error) {
+func (m *MockStore) GetBlockHistoryNewestChildren(ctx context.Context, parentID string, opts model.QueryBlockHistoryChildOptions) ([]*model.Block, bool, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetBlockHistoryNewestChildren", parentID, opts)
+	ret := m.ctrl.Call(m, "GetBlockHistoryNewestChildren", ctx, parentID, opts)
 	ret0, _ := ret[0].([]*model.Block)
 	ret1, _ := ret[1].(bool)
 	ret2, _ := ret[2].(error)
@@ -411,45 +632,90 @@ func (m *MockStore) GetBlockHistoryNewestChildren(parentID string, opts model.Qu
 }
 
 // GetBlockHistoryNewestChildren indicates an expected call of GetBlockHistoryNewestChildren.
-func (mr *MockStoreMockRecorder) GetBlockHistoryNewestChildren(parentID, opts interface{}) *gomock.Call {
+func (mr *MockStoreMockRecorder) GetBlockHistoryNewestChildren(ctx, parentID, opts interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBlockHistoryNewestChildren", reflect.TypeOf((*MockStore)(nil).GetBlockHistoryNewestChildren), ctx, parentID, opts)
+}
+
+// GetBlockSuiteDocByCardID mocks base method.
+func (m *MockStore) GetBlockSuiteDocByCardID(ctx context.Context, cardID string) (*model.BlockSuiteDoc, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetBlockSuiteDocByCardID", ctx, cardID)
+	ret0, _ := ret[0].(*model.BlockSuiteDoc)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetBlockSuiteDocByCardID indicates an expected call of GetBlockSuiteDocByCardID.
+func (mr *MockStoreMockRecorder) GetBlockSuiteDocByCardID(ctx, cardID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBlockSuiteDocByCardID", reflect.TypeOf((*MockStore)(nil).GetBlockSuiteDocByCardID), ctx, cardID)
+}
+
+// GetBlockSuiteDocInfoByCardID mocks base method.
+func (m *MockStore) GetBlockSuiteDocInfoByCardID(ctx context.Context, cardID string) (*model.BlockSuiteDocInfo, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetBlockSuiteDocInfoByCardID", ctx, cardID)
+	ret0, _ := ret[0].(*model.BlockSuiteDocInfo)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetBlockSuiteDocInfoByCardID indicates an expected call of GetBlockSuiteDocInfoByCardID.
+func (mr *MockStoreMockRecorder) GetBlockSuiteDocInfoByCardID(ctx, cardID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBlockSuiteDocInfoByCardID", reflect.TypeOf((*MockStore)(nil).GetBlockSuiteDocInfoByCardID), ctx, cardID)
+}
+
+// GetBlockTombstones mocks base method.
+func (m *MockStore) GetBlockTombstones(ctx context.Context, opts model.QueryBlockChangesOptions) ([]*model.BlockTombstone, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetBlockTombstones", ctx, opts)
+	ret0, _ := ret[0].([]*model.BlockTombstone)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetBlockTombstones indicates an expected call of GetBlockTombstones.
+func (mr *MockStoreMockRecorder) GetBlockTombstones(ctx, opts interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBlockHistoryNewestChildren", reflect.TypeOf((*MockStore)(nil).GetBlockHistoryNewestChildren), parentID, opts)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBlockTombstones", reflect.TypeOf((*MockStore)(nil).GetBlockTombstones), ctx, opts)
 }
 
 // GetBlocks mocks base method.
-func (m *MockStore) GetBlocks(opts model.QueryBlocksOptions) ([]*model.Block, error) {
+func (m *MockStore) GetBlocks(ctx context.Context, opts model.QueryBlocksOptions) ([]*model.Block, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetBlocks", opts)
+	ret := m.ctrl.Call(m, "GetBlocks", ctx, opts)
 	ret0, _ := ret[0].([]*model.Block)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetBlocks indicates an expected call of GetBlocks.
-func (mr *MockStoreMockRecorder) GetBlocks(opts interface{}) *gomock.Call {
+func (mr *MockStoreMockRecorder) GetBlocks(ctx, opts interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBlocks", reflect.TypeOf((*MockStore)(nil).GetBlocks), opts)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBlocks", reflect.TypeOf((*MockStore)(nil).GetBlocks), ctx, opts)
 }
 
 // GetBlocksByIDs mocks base method.
-func (m *MockStore) GetBlocksByIDs(ids []string) ([]*model.Block, error) {
+func (m *MockStore) GetBlocksByIDs(ctx context.Context, ids []string) ([]*model.Block, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetBlocksByIDs", ids)
+	ret := m.ctrl.Call(m, "GetBlocksByIDs", ctx, ids)
 	ret0, _ := ret[0].([]*model.Block)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetBlocksByIDs indicates an expected call of GetBlocksByIDs.
-func (mr *MockStoreMockRecorder) GetBlocksByIDs(ids interface{}) *gomock.Call {
+func (mr *MockStoreMockRecorder) GetBlocksByIDs(ctx, ids interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBlocksByIDs", reflect.TypeOf((*MockStore)(nil).GetBlocksByIDs), ids)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBlocksByIDs", reflect.TypeOf((*MockStore)(nil).GetBlocksByIDs), ctx, ids)
 }
 
 // GetBlocksComplianceHistory mocks base method.
-func (m *MockStore) GetBlocksComplianceHistory(opts model.QueryBlocksComplianceHistoryOptions) ([]*model.BlockHistory, bool, error) {
+func (m *MockStore) GetBlocksComplianceHistory(ctx context.Context, opts model.QueryBlocksComplianceHistoryOptions) ([]*model.BlockHistory, bool, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetBlocksComplianceHistory", opts)
+	ret := m.ctrl.Call(m, "GetBlocksComplianceHistory", ctx, opts)
 	ret0, _ := ret[0].([]*model.BlockHistory)
 	ret1, _ := ret[1].(bool)
 	ret2, _ := ret[2].(error)
@@ -457,90 +723,105 @@ func (m *MockStore) GetBlocksComplianceHistory(opts model.QueryBlocksComplianceH
 }
 
 // GetBlocksComplianceHistory indicates an expected call of GetBlocksComplianceHistory.
-func (mr *MockStoreMockRecorder) GetBlocksComplianceHistory(opts interface{}) *gomock.Call {
+func (mr *MockStoreMockRecorder) GetBlocksComplianceHistory(ctx, opts interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBlocksComplianceHistory", reflect.TypeOf((*MockStore)(nil).GetBlocksComplianceHistory), opts)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBlocksComplianceHistory", reflect.TypeOf((*MockStore)(nil).GetBlocksComplianceHistory), ctx, opts)
 }
 
 // GetBlocksForBoard mocks base method.
-func (m *MockStore) GetBlocksForBoard(boardID string) ([]*model.Block, error) {
+func (m *MockStore) GetBlocksForBoard(ctx context.Context, boardID string) ([]*model.Block, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetBlocksForBoard", boardID)
+	ret := m.ctrl.Call(m, "GetBlocksForBoard", ctx, boardID)
 	ret0, _ := ret[0].([]*model.Block)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetBlocksForBoard indicates an expected call of GetBlocksForBoard.
-func (mr *MockStoreMockRecorder) GetBlocksForBoard(boardID interface{}) *gomock.Call {
+func (mr *MockStoreMockRecorder) GetBlocksForBoard(ctx, boardID interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBlocksForBoard", reflect.TypeOf((*MockStore)(nil).GetBlocksForBoard), boardID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBlocksForBoard", reflect.TypeOf((*MockStore)(nil).GetBlocksForBoard), ctx, boardID)
 }
 
 // GetBlocksWithParent mocks base method.
-func (m *MockStore) GetBlocksWithParent(boardID, parentID string) ([]*model.Block, error) {
+func (m *MockStore) GetBlocksWithParent(ctx context.Context, boardID, parentID string) ([]*model.Block, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetBlocksWithParent", boardID, parentID)
+	ret := m.ctrl.Call(m, "GetBlocksWithParent", ctx, boardID, parentID)
 	ret0, _ := ret[0].([]*model.Block)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetBlocksWithParent indicates an expected call of GetBlocksWithParent.
-func (mr *MockStoreMockRecorder) GetBlocksWithParent(boardID, parentID interface{}) *gomock.Call {
+func (mr *MockStoreMockRecorder) GetBlocksWithParent(ctx, boardID, parentID interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBlocksWithParent", reflect.TypeOf((*MockStore)(nil).GetBlocksWithParent), boardID, parentID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBlocksWithParent", reflect.TypeOf((*MockStore)(nil).GetBlocksWithParent), ctx, boardID, parentID)
 }
 
 // GetBlocksWithParentAndType mocks base method.
-func (m *MockStore) GetBlocksWithParentAndType(boardID, parentID, blockType string) ([]*model.Block, error) {
+func (m *MockStore) GetBlocksWithParentAndType(ctx context.Context, boardID, parentID, blockType string) ([]*model.Block, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetBlocksWithParentAndType", boardID, parentID, blockType)
+	ret := m.ctrl.Call(m, "GetBlocksWithParentAndType", ctx, boardID, parentID, blockType)
 	ret0, _ := ret[0].([]*model.Block)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetBlocksWithParentAndType indicates an expected call of GetBlocksWithParentAndType.
-func (mr *MockStoreMockRecorder) GetBlocksWithParentAndType(boardID, parentID, blockType interface{}) *gomock.Call {
+func (mr *MockStoreMockRecorder) GetBlocksWithParentAndType(ctx, boardID, parentID, blockType interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBlocksWithParentAndType", reflect.TypeOf((*MockStore)(nil).GetBlocksWithParentAndType), boardID, parentID, blockType)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBlocksWithParentAndType", reflect.TypeOf((*MockStore)(nil).GetBlocksWithParentAndType), ctx, boardID, parentID, blockType)
 }
 
 // GetBlocksWithType mocks base method.
-func (m *MockStore) GetBlocksWithType(boardID, blockType string) ([]*model.Block, error) {
+func (m *MockStore) GetBlocksWithType(ctx context.Context, boardID, blockType string) ([]*model.Block, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetBlocksWithType", boardID, blockType)
+	ret := m.ctrl.Call(m, "GetBlocksWithType", ctx, boardID, blockType)
 	ret0, _ := ret[0].([]*model.Block)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetBlocksWithType indicates an expected call of GetBlocksWithType.
-func (mr *MockStoreMockRecorder) GetBlocksWithType(boardID, blockType interface{}) *gomock.Call {
+func (mr *MockStoreMockRecorder) GetBlocksWithType(ctx, boardID, blockType interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBlocksWithType", reflect.TypeOf((*MockStore)(nil).GetBlocksWithType), boardID, blockType)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBlocksWithType", reflect.TypeOf((*MockStore)(nil).GetBlocksWithType), ctx, boardID, blockType)
 }
 
 // GetBoard mocks base method.
-func (m *MockStore) GetBoard(id string) (*model.Board, error) {
+func (m *MockStore) GetBoard(ctx context.Context, id string) (*model.Board, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetBoard", id)
+	ret := m.ctrl.Call(m, "GetBoard", ctx, id)
 	ret0, _ := ret[0].(*model.Board)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetBoard indicates an expected call of GetBoard.
-func (mr *MockStoreMockRecorder) GetBoard(id interface{}) *gomock.Call {
+func (mr *MockStoreMockRecorder) GetBoard(ctx, id interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBoard", reflect.TypeOf((*MockStore)(nil).GetBoard), id)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBoard", reflect.TypeOf((*MockStore)(nil).GetBoard), ctx, id)
+}
+
+// GetBoardActivity mocks base method.
+func (m *MockStore) GetBoardActivity(ctx context.Context, opts model.StatisticsOptions) ([]*model.BoardActivity, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetBoardActivity", ctx, opts)
+	ret0, _ := ret[0].([]*model.BoardActivity)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetBoardActivity indicates an expected call of GetBoardActivity.
+func (mr *MockStoreMockRecorder) GetBoardActivity(ctx, opts interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBoardActivity", reflect.TypeOf((*MockStore)(nil).GetBoardActivity), ctx, opts)
 }
 
 // GetBoardAndCard mocks base method.
-func (m *MockStore) GetBoardAndCard(block *model.Block) (*model.Board, *model.Block, error) {
+func (m *MockStore) GetBoardAndCard(ctx context.Context, block *model.Block) (*model.Board, *model.Block, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetBoardAndCard", block)
+	ret := m.ctrl.Call(m, "GetBoardAndCard", ctx, block)
 	ret0, _ := ret[0].(*model.Board)
 	ret1, _ := ret[1].(*model.Block)
 	ret2, _ := ret[2].(error)
@@ -548,15 +829,15 @@ func (m *MockStore) GetBoardAndCard(block *model.Block) (*model.Board, *model.Bl
 }
 
 // GetBoardAndCard indicates an expected call of GetBoardAndCard.
-func (mr *MockStoreMockRecorder) GetBoardAndCard(block interface{}) *gomock.Call {
+func (mr *MockStoreMockRecorder) GetBoardAndCard(ctx, block interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBoardAndCard", reflect.TypeOf((*MockStore)(nil).GetBoardAndCard), block)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBoardAndCard", reflect.TypeOf((*MockStore)(nil).GetBoardAndCard), ctx, block)
 }
 
 // GetBoardAndCardByID mocks base method.
-func (m *MockStore) GetBoardAndCardByID(blockID string) (*model.Board, *model.Block, error) {
+func (m *MockStore) GetBoardAndCardByID(ctx context.Context, blockID string) (*model.Board, *model.Block, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetBoardAndCardByID", blockID)
+	ret := m.ctrl.Call(m, "GetBoardAndCardByID", ctx, blockID)
 	ret0, _ := ret[0].(*model.Board)
 	ret1, _ := ret[1].(*model.Block)
 	ret2, _ := ret[2].(error)
@@ -564,60 +845,105 @@ func (m *MockStore) GetBoardAndCardByID(blockID string) (*model.Board, *model.Bl
 }
 
 // GetBoardAndCardByID indicates an expected call of GetBoardAndCardByID.
-func (mr *MockStoreMockRecorder) GetBoardAndCardByID(blockID interface{}) *gomock.Call {
+func (mr *MockStoreMockRecorder) GetBoardAndCardByID(ctx, blockID interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBoardAndCardByID", reflect.TypeOf((*MockStore)(nil).GetBoardAndCardByID), blockID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBoardAndCardByID", reflect.TypeOf((*MockStore)(nil).GetBoardAndCardByID), ctx, blockID)
 }
 
 // GetBoardCount mocks base method.
-func (m *MockStore) GetBoardCount(includeDeleted bool) (int64, error) {
+func (m *MockStore) GetBoardCount(ctx context.Context, includeDeleted bool) (int64, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetBoardCount", includeDeleted)
+	ret := m.ctrl.Call(m, "GetBoardCount", ctx, includeDeleted)
 	ret0, _ := ret[0].(int64)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetBoardCount indicates an expected call of GetBoardCount.
-func (mr *MockStoreMockRecorder) GetBoardCount(includeDeleted interface{}) *gomock.Call {
+func (mr *MockStoreMockRecorder) GetBoardCount(ctx, includeDeleted interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBoardCount", reflect.TypeOf((*MockStore)(nil).GetBoardCount), includeDeleted)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBoardCount", reflect.TypeOf((*MockStore)(nil).GetBoardCount), ctx, includeDeleted)
+}
+
+// GetBoardFileUsage mocks base method.
+func (m *MockStore) GetBoardFileUsage(ctx context.Context, boardID string) (*model.FileUsage, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetBoardFileUsage", ctx, boardID)
+	ret0, _ := ret[0].(*model.FileUsage)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetBoardFileUsage indicates an expected call of GetBoardFileUsage.
+func (mr *MockStoreMockRecorder) GetBoardFileUsage(ctx, boardID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBoardFileUsage", reflect.TypeOf((*MockStore)(nil).GetBoardFileUsage), ctx, boardID)
+}
+
+// GetBoardForm mocks base method.
+func (m *MockStore) GetBoardForm(ctx context.Context, boardID string) (*model.BoardForm, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetBoardForm", ctx, boardID)
+	ret0, _ := ret[0].(*model.BoardForm)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetBoardForm indicates an expected call of GetBoardForm.
+func (mr *MockStoreMockRecorder) GetBoardForm(ctx, boardID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBoardForm", reflect.TypeOf((*MockStore)(nil).GetBoardForm), ctx, boardID)
 }
 
 // GetBoardHistory mocks base method.
-func (m *MockStore) GetBoardHistory(boardID string, opts model.QueryBoardHistoryOptions) ([]*model.Board, error) {
+func (m *MockStore) GetBoardHistory(ctx context.Context, boardID string, opts model.QueryBoardHistoryOptions) ([]*model.Board, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetBoardHistory", boardID, opts)
+	ret := m.ctrl.Call(m, "GetBoardHistory", ctx, boardID, opts)
 	ret0, _ := ret[0].([]*model.Board)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetBoardHistory indicates an expected call of GetBoardHistory.
-func (mr *MockStoreMockRecorder) GetBoardHistory(boardID, opts interface{}) *gomock.Call {
+func (mr *MockStoreMockRecorder) GetBoardHistory(ctx, boardID, opts interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBoardHistory", reflect.TypeOf((*MockStore)(nil).GetBoardHistory), boardID, opts)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBoardHistory", reflect.TypeOf((*MockStore)(nil).GetBoardHistory), ctx, boardID, opts)
 }
 
 // GetBoardMemberHistory mocks base method.
-func (m *MockStore) GetBoardMemberHistory(boardID, userID string, limit uint64) ([]*model.BoardMemberHistoryEntry, error) {
+func (m *MockStore) GetBoardMemberHistory(ctx context.Context, boardID, userID string, limit uint64) ([]*model.BoardMemberHistoryEntry, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetBoardMemberHistory", boardID, userID, limit)
+	ret := m.ctrl.Call(m, "GetBoardMemberHistory", ctx, boardID, userID, limit)
 	ret0, _ := ret[0].([]*model.BoardMemberHistoryEntry)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetBoardMemberHistory indicates an expected call of GetBoardMemberHistory.
-func (mr *MockStoreMockRecorder) GetBoardMemberHistory(boardID, userID, limit interface{}) *gomock.Call {
+func (mr *MockStoreMockRecorder) GetBoardMemberHistory(ctx, boardID, userID, limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBoardMemberHistory", reflect.TypeOf((*MockStore)(nil).GetBoardMemberHistory), ctx, boardID, userID, limit)
+}
+
+// GetBoardRestorePlan mocks base method.
+func (m *MockStore) GetBoardRestorePlan(ctx context.Context, boardID string, at int64) (*model.BoardRestorePlan, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetBoardRestorePlan", ctx, boardID, at)
+	ret0, _ := ret[0].(*model.BoardRestorePlan)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetBoardRestorePlan indicates an expected call of GetBoardRestorePlan.
+func (mr *MockStoreMockRecorder) GetBoardRestorePlan(ctx, boardID, at interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBoardMemberHistory", reflect.TypeOf((*MockStore)(nil).GetBoardMemberHistory), boardID, userID, limit)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBoardRestorePlan", reflect.TypeOf((*MockStore)(nil).GetBoardRestorePlan), ctx, boardID, at)
 }
 
 // GetBoardsComplianceHistory mocks base method.
-func (m *MockStore) GetBoardsComplianceHistory(opts model.QueryBoardsComplianceHistoryOptions) ([]*model.BoardHistory, bool, error) {
+func (m *MockStore) GetBoardsComplianceHistory(ctx context.Context, opts model.QueryBoardsComplianceHistoryOptions) ([]*model.BoardHistory, bool, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetBoardsComplianceHistory", opts)
+	ret := m.ctrl.Call(m, "GetBoardsComplianceHistory", ctx, opts)
 	ret0, _ := ret[0].([]*model.BoardHistory)
 	ret1, _ := ret[1].(bool)
 	ret2, _ := ret[2].(error)
@@ -625,15 +951,15 @@ func (m *MockStore) GetBoardsComplianceHistory(opts model.QueryBoardsComplianceH
 }
 
 // GetBoardsComplianceHistory indicates an expected call of GetBoardsComplianceHistory.
-func (mr *MockStoreMockRecorder) GetBoardsComplianceHistory(opts interface{}) *gomock.Call {
+func (mr *MockStoreMockRecorder) GetBoardsComplianceHistory(ctx, opts interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBoardsComplianceHistory", reflect.TypeOf((*MockStore)(nil).GetBoardsComplianceHistory), opts)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBoardsComplianceHistory", reflect.TypeOf((*MockStore)(nil).GetBoardsComplianceHistory), ctx, opts)
 }
 
 // GetBoardsForCompliance mocks base method.
-func (m *MockStore) GetBoardsForCompliance(opts model.QueryBoardsForComplianceOptions) ([]*model.Board, bool, error) {
+func (m *MockStore) GetBoardsForCompliance(ctx context.Context, opts model.QueryBoardsForComplianceOptions) ([]*model.Board, bool, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetBoardsForCompliance", opts)
+	ret := m.ctrl.Call(m, "GetBoardsForCompliance", ctx, opts)
 	ret0, _ := ret[0].([]*model.Board)
 	ret1, _ := ret[1].(bool)
 	ret2, _ := ret[2].(error)
@@ -641,597 +967,926 @@ func (m *MockStore) GetBoardsForCompliance(opts model.QueryBoardsForComplianceOp
 }
 
 // GetBoardsForCompliance indicates an expected call of GetBoardsForCompliance.
-func (mr *MockStoreMockRecorder) GetBoardsForCompliance(opts interface{}) *gomock.Call {
+func (mr *MockStoreMockRecorder) GetBoardsForCompliance(ctx, opts interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBoardsForCompliance", reflect.TypeOf((*MockStore)(nil).GetBoardsForCompliance), opts)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBoardsForCompliance", reflect.TypeOf((*MockStore)(nil).GetBoardsForCompliance), ctx, opts)
 }
 
 // GetBoardsForUserAndTeam mocks base method.
-func (m *MockStore) GetBoardsForUserAndTeam(userID, teamID string, includePublicBoards bool) ([]*model.Board, error) {
+func (m *MockStore) GetBoardsForUserAndTeam(ctx context.Context, userID, teamID string, includePublicBoards bool) ([]*model.Board, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetBoardsForUserAndTeam", userID, teamID, includePublicBoards)
+	ret := m.ctrl.Call(m, "GetBoardsForUserAndTeam", ctx, userID, teamID, includePublicBoards)
 	ret0, _ := ret[0].([]*model.Board)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetBoardsForUserAndTeam indicates an expected call of GetBoardsForUserAndTeam.
-func (mr *MockStoreMockRecorder) GetBoardsForUserAndTeam(userID, teamID, includePublicBoards interface{}) *gomock.Call {
+func (mr *MockStoreMockRecorder) GetBoardsForUserAndTeam(ctx, userID, teamID, includePublicBoards interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBoardsForUserAndTeam", reflect.TypeOf((*MockStore)(nil).GetBoardsForUserAndTeam), userID, teamID, includePublicBoards)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBoardsForUserAndTeam", reflect.TypeOf((*MockStore)(nil).GetBoardsForUserAndTeam), ctx, userID, teamID, includePublicBoards)
 }
 
 // GetBoardsInTeamByIds mocks base method.
-func (m *MockStore) GetBoardsInTeamByIds(boardIDs []string, teamID string) ([]*model.Board, error) {
+func (m *MockStore) GetBoardsInTeamByIds(ctx context.Context, boardIDs []string, teamID string) ([]*model.Board, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetBoardsInTeamByIds", boardIDs, teamID)
+	ret := m.ctrl.Call(m, "GetBoardsInTeamByIds", ctx, boardIDs, teamID)
 	ret0, _ := ret[0].([]*model.Board)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetBoardsInTeamByIds indicates an expected call of GetBoardsInTeamByIds.
-func (mr *MockStoreMockRecorder) GetBoardsInTeamByIds(boardIDs, teamID interface{}) *gomock.Call {
+func (mr *MockStoreMockRecorder) GetBoardsInTeamByIds(ctx, boardIDs, teamID interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBoardsInTeamByIds", reflect.TypeOf((*MockStore)(nil).GetBoardsInTeamByIds), boardIDs, teamID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBoardsInTeamByIds", reflect.TypeOf((*MockStore)(nil).GetBoardsInTeamByIds), ctx, boardIDs, teamID)
 }
 
 // GetCardLimitTimestamp mocks base method.
-func (m *MockStore) GetCardLimitTimestamp() (int64, error) {
+func (m *MockStore) GetCardLimitTimestamp(ctx context.Context) (int64, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetCardLimitTimestamp")
+	ret := m.ctrl.Call(m, "GetCardLimitTimestamp", ctx)
 	ret0, _ := ret[0].(int64)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetCardLimitTimestamp indicates an expected call of GetCardLimitTimestamp.
-func (mr *MockStoreMockRecorder) GetCardLimitTimestamp() *gomock.Call {
+func (mr *MockStoreMockRecorder) GetCardLimitTimestamp(ctx interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCardLimitTimestamp", reflect.TypeOf((*MockStore)(nil).GetCardLimitTimestamp))
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCardLimitTimestamp", reflect.TypeOf((*MockStore)(nil).GetCardLimitTimestamp), ctx)
 }
 
 // GetCardsCount mocks base method.
-func (m *MockStore) GetCardsCount() (int64, error) {
+func (m *MockStore) GetCardsCount(ctx context.Context) (int64, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetCardsCount")
+	ret := m.ctrl.Call(m, "GetCardsCount", ctx)
 	ret0, _ := ret[0].(int64)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetCardsCount indicates an expected call of GetCardsCount.
-func (mr *MockStoreMockRecorder) GetCardsCount() *gomock.Call {
+func (mr *MockStoreMockRecorder) GetCardsCount(ctx interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCardsCount", reflect.TypeOf((*MockStore)(nil).GetCardsCount))
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCardsCount", reflect.TypeOf((*MockStore)(nil).GetCardsCount), ctx)
 }
 
 // GetCategory mocks base method.
-func (m *MockStore) GetCategory(id string) (*model.Category, error) {
+func (m *MockStore) GetCategory(ctx context.Context, id string) (*model.Category, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetCategory", id)
+	ret := m.ctrl.Call(m, "GetCategory", ctx, id)
 	ret0, _ := ret[0].(*model.Category)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetCategory indicates an expected call of GetCategory.
-func (mr *MockStoreMockRecorder) GetCategory(id interface{}) *gomock.Call {
+func (mr *MockStoreMockRecorder) GetCategory(ctx, id interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCategory", reflect.TypeOf((*MockStore)(nil).GetCategory), id)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCategory", reflect.TypeOf((*MockStore)(nil).GetCategory), ctx, id)
+}
+
+// GetChangedBlocks mocks base method.
+func (m *MockStore) GetChangedBlocks(ctx context.Context, opts model.QueryBlockChangesOptions) ([]*model.Block, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetChangedBlocks", ctx, opts)
+	ret0, _ := ret[0].([]*model.Block)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetChangedBlocks indicates an expected call of GetChangedBlocks.
+func (mr *MockStoreMockRecorder) GetChangedBlocks(ctx, opts interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetChangedBlocks", reflect.TypeOf((*MockStore)(nil).GetChangedBlocks), ctx, opts)
 }
 
 // GetChannel mocks base method.
-func (m *MockStore) GetChannel(teamID, channelID string) (*model0.Channel, error) {
+func (m *MockStore) GetChannel(ctx context.Context, teamID, channelID string) (*model0.Channel, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetChannel", teamID, channelID)
+	ret := m.ctrl.Call(m, "GetChannel", ctx, teamID, channelID)
 	ret0, _ := ret[0].(*model0.Channel)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetChannel indicates an expected call of GetChannel.
-func (mr *MockStoreMockRecorder) GetChannel(teamID, channelID interface{}) *gomock.Call {
+func (mr *MockStoreMockRecorder) GetChannel(ctx, teamID, channelID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetChannel", reflect.TypeOf((*MockStore)(nil).GetChannel), ctx, teamID, channelID)
+}
+
+// GetCommentReactions mocks base method.
+func (m *MockStore) GetCommentReactions(ctx context.Context, blockID string) ([]*model.CommentReaction, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCommentReactions", ctx, blockID)
+	ret0, _ := ret[0].([]*model.CommentReaction)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCommentReactions indicates an expected call of GetCommentReactions.
+func (mr *MockStoreMockRecorder) GetCommentReactions(ctx, blockID interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetChannel", reflect.TypeOf((*MockStore)(nil).GetChannel), teamID, channelID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCommentReactions", reflect.TypeOf((*MockStore)(nil).GetCommentReactions), ctx, blockID)
+}
+
+// GetCommentReactionsForBoard mocks base method.
+func (m *MockStore) GetCommentReactionsForBoard(ctx context.Context, boardID string) ([]*model.CommentReaction, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCommentReactionsForBoard", ctx, boardID)
+	ret0, _ := ret[0].([]*model.CommentReaction)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCommentReactionsForBoard indicates an expected call of GetCommentReactionsForBoard.
+func (mr *MockStoreMockRecorder) GetCommentReactionsForBoard(ctx, boardID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCommentReactionsForBoard", reflect.TypeOf((*MockStore)(nil).GetCommentReactionsForBoard), ctx, boardID)
+}
+
+// GetDailyActivity mocks base method.
+func (m *MockStore) GetDailyActivity(ctx context.Context, opts model.StatisticsOptions) (map[int64]model.ActivityCounts, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDailyActivity", ctx, opts)
+	ret0, _ := ret[0].(map[int64]model.ActivityCounts)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDailyActivity indicates an expected call of GetDailyActivity.
+func (mr *MockStoreMockRecorder) GetDailyActivity(ctx, opts interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDailyActivity", reflect.TypeOf((*MockStore)(nil).GetDailyActivity), ctx, opts)
+}
+
+// GetFileBoardIDs mocks base method.
+func (m *MockStore) GetFileBoardIDs(ctx context.Context, fileIDs []string) (map[string]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetFileBoardIDs", ctx, fileIDs)
+	ret0, _ := ret[0].(map[string]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetFileBoardIDs indicates an expected call of GetFileBoardIDs.
+func (mr *MockStoreMockRecorder) GetFileBoardIDs(ctx, fileIDs interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetFileBoardIDs", reflect.TypeOf((*MockStore)(nil).GetFileBoardIDs), ctx, fileIDs)
+}
+
+// GetFileIDsReferencedByBlocks mocks base method.
+func (m *MockStore) GetFileIDsReferencedByBlocks(ctx context.Context, fileIDs []string) (map[string]bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetFileIDsReferencedByBlocks", ctx, fileIDs)
+	ret0, _ := ret[0].(map[string]bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetFileIDsReferencedByBlocks indicates an expected call of GetFileIDsReferencedByBlocks.
+func (mr *MockStoreMockRecorder) GetFileIDsReferencedByBlocks(ctx, fileIDs interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetFileIDsReferencedByBlocks", reflect.TypeOf((*MockStore)(nil).GetFileIDsReferencedByBlocks), ctx, fileIDs)
 }
 
 // GetFileInfo mocks base method.
-func (m *MockStore) GetFileInfo(id string) (*model0.FileInfo, error) {
+func (m *MockStore) GetFileInfo(ctx context.Context, id string) (*model0.FileInfo, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetFileInfo", id)
+	ret := m.ctrl.Call(m, "GetFileInfo", ctx, id)
 	ret0, _ := ret[0].(*model0.FileInfo)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetFileInfo indicates an expected call of GetFileInfo.
-func (mr *MockStoreMockRecorder) GetFileInfo(id interface{}) *gomock.Call {
+func (mr *MockStoreMockRecorder) GetFileInfo(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetFileInfo", reflect.TypeOf((*MockStore)(nil).GetFileInfo), ctx, id)
+}
+
+// GetFileInfosWithPathPrefix mocks base method.
+func (m *MockStore) GetFileInfosWithPathPrefix(ctx context.Context, prefix string, createdBefore int64) ([]*model0.FileInfo, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetFileInfosWithPathPrefix", ctx, prefix, createdBefore)
+	ret0, _ := ret[0].([]*model0.FileInfo)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetFileInfosWithPathPrefix indicates an expected call of GetFileInfosWithPathPrefix.
+func (mr *MockStoreMockRecorder) GetFileInfosWithPathPrefix(ctx, prefix, createdBefore interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetFileInfosWithPathPrefix", reflect.TypeOf((*MockStore)(nil).GetFileInfosWithPathPrefix), ctx, prefix, createdBefore)
+}
+
+// GetFileScan mocks base method.
+func (m *MockStore) GetFileScan(ctx context.Context, fileID string) (*model.FileScan, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetFileScan", ctx, fileID)
+	ret0, _ := ret[0].(*model.FileScan)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetFileScan indicates an expected call of GetFileScan.
+func (mr *MockStoreMockRecorder) GetFileScan(ctx, fileID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetFileScan", reflect.TypeOf((*MockStore)(nil).GetFileScan), ctx, fileID)
+}
+
+// GetFileUsageByTeam mocks base method.
+func (m *MockStore) GetFileUsageByTeam(ctx context.Context) ([]*model.FileUsage, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetFileUsageByTeam", ctx)
+	ret0, _ := ret[0].([]*model.FileUsage)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetFileUsageByTeam indicates an expected call of GetFileUsageByTeam.
+func (mr *MockStoreMockRecorder) GetFileUsageByTeam(ctx interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetFileInfo", reflect.TypeOf((*MockStore)(nil).GetFileInfo), id)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetFileUsageByTeam", reflect.TypeOf((*MockStore)(nil).GetFileUsageByTeam), ctx)
 }
 
 // GetLicense mocks base method.
-func (m *MockStore) GetLicense() *model0.License {
+func (m *MockStore) GetLicense(ctx context.Context) *model0.License {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetLicense")
+	ret := m.ctrl.Call(m, "GetLicense", ctx)
 	ret0, _ := ret[0].(*model0.License)
 	return ret0
 }
 
 // GetLicense indicates an expected call of GetLicense.
-func (mr *MockStoreMockRecorder) GetLicense() *gomock.Call {
+func (mr *MockStoreMockRecorder) GetLicense(ctx interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLicense", reflect.TypeOf((*MockStore)(nil).GetLicense))
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLicense", reflect.TypeOf((*MockStore)(nil).GetLicense), ctx)
 }
 
 // GetMemberForBoard mocks base method.
-func (m *MockStore) GetMemberForBoard(boardID, userID string) (*model.BoardMember, error) {
+func (m *MockStore) GetMemberForBoard(ctx context.Context, boardID, userID string) (*model.BoardMember, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetMemberForBoard", boardID, userID)
+	ret := m.ctrl.Call(m, "GetMemberForBoard", ctx, boardID, userID)
 	ret0, _ := ret[0].(*model.BoardMember)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetMemberForBoard indicates an expected call of GetMemberForBoard.
-func (mr *MockStoreMockRecorder) GetMemberForBoard(boardID, userID interface{}) *gomock.Call {
+func (mr *MockStoreMockRecorder) GetMemberForBoard(ctx, boardID, userID interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMemberForBoard", reflect.TypeOf((*MockStore)(nil).GetMemberForBoard), boardID, userID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMemberForBoard", reflect.TypeOf((*MockStore)(nil).GetMemberForBoard), ctx, boardID, userID)
 }
 
 // GetMembersForBoard mocks base method.
-func (m *MockStore) GetMembersForBoard(boardID string) ([]*model.BoardMember, error) {
+func (m *MockStore) GetMembersForBoard(ctx context.Context, boardID string) ([]*model.BoardMember, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetMembersForBoard", boardID)
+	ret := m.ctrl.Call(m, "GetMembersForBoard", ctx, boardID)
 	ret0, _ := ret[0].([]*model.BoardMember)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetMembersForBoard indicates an expected call of GetMembersForBoard.
-func (mr *MockStoreMockRecorder) GetMembersForBoard(boardID interface{}) *gomock.Call {
+func (mr *MockStoreMockRecorder) GetMembersForBoard(ctx, boardID interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMembersForBoard", reflect.TypeOf((*MockStore)(nil).GetMembersForBoard), boardID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMembersForBoard", reflect.TypeOf((*MockStore)(nil).GetMembersForBoard), ctx, boardID)
 }
 
 // GetMembersForUser mocks base method.
-func (m *MockStore) GetMembersForUser(userID string) ([]*model.BoardMember, error) {
+func (m *MockStore) GetMembersForUser(ctx context.Context, userID string) ([]*model.BoardMember, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetMembersForUser", userID)
+	ret := m.ctrl.Call(m, "GetMembersForUser", ctx, userID)
 	ret0, _ := ret[0].([]*model.BoardMember)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetMembersForUser indicates an expected call of GetMembersForUser.
-func (mr *MockStoreMockRecorder) GetMembersForUser(userID interface{}) *gomock.Call {
+func (mr *MockStoreMockRecorder) GetMembersForUser(ctx, userID interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMembersForUser", reflect.TypeOf((*MockStore)(nil).GetMembersForUser), userID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMembersForUser", reflect.TypeOf((*MockStore)(nil).GetMembersForUser), ctx, userID)
 }
 
 // GetNextNotificationHint mocks base method.
-func (m *MockStore) GetNextNotificationHint(remove bool) (*model.NotificationHint, error) {
+func (m *MockStore) GetNextNotificationHint(ctx context.Context, remove bool) (*model.NotificationHint, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetNextNotificationHint", remove)
+	ret := m.ctrl.Call(m, "GetNextNotificationHint", ctx, remove)
 	ret0, _ := ret[0].(*model.NotificationHint)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetNextNotificationHint indicates an expected call of GetNextNotificationHint.
-func (mr *MockStoreMockRecorder) GetNextNotificationHint(remove interface{}) *gomock.Call {
+func (mr *MockStoreMockRecorder) GetNextNotificationHint(ctx, remove interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetNextNotificationHint", reflect.TypeOf((*MockStore)(nil).GetNextNotificationHint), remove)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetNextNotificationHint", reflect.TypeOf((*MockStore)(nil).GetNextNotificationHint), ctx, remove)
+}
+
+// GetNotification mocks base method.
+func (m *MockStore) GetNotification(ctx context.Context, userID, notificationID string) (*model.Notification, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetNotification", ctx, userID, notificationID)
+	ret0, _ := ret[0].(*model.Notification)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetNotification indicates an expected call of GetNotification.
+func (mr *MockStoreMockRecorder) GetNotification(ctx, userID, notificationID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetNotification", reflect.TypeOf((*MockStore)(nil).GetNotification), ctx, userID, notificationID)
 }
 
 // GetNotificationHint mocks base method.
-func (m *MockStore) GetNotificationHint(blockID string) (*model.NotificationHint, error) {
+func (m *MockStore) GetNotificationHint(ctx context.Context, blockID string) (*model.NotificationHint, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetNotificationHint", blockID)
+	ret := m.ctrl.Call(m, "GetNotificationHint", ctx, blockID)
 	ret0, _ := ret[0].(*model.NotificationHint)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetNotificationHint indicates an expected call of GetNotificationHint.
-func (mr *MockStoreMockRecorder) GetNotificationHint(blockID interface{}) *gomock.Call {
+func (mr *MockStoreMockRecorder) GetNotificationHint(ctx, blockID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetNotificationHint", reflect.TypeOf((*MockStore)(nil).GetNotificationHint), ctx, blockID)
+}
+
+// GetNotificationHintCount mocks base method.
+func (m *MockStore) GetNotificationHintCount(ctx context.Context) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetNotificationHintCount", ctx)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetNotificationHintCount indicates an expected call of GetNotificationHintCount.
+func (mr *MockStoreMockRecorder) GetNotificationHintCount(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetNotificationHintCount", reflect.TypeOf((*MockStore)(nil).GetNotificationHintCount), ctx)
+}
+
+// GetNotificationPreferences mocks base method.
+func (m *MockStore) GetNotificationPreferences(ctx context.Context, userID, boardID string) (*model.NotificationPreferences, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetNotificationPreferences", ctx, userID, boardID)
+	ret0, _ := ret[0].(*model.NotificationPreferences)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetNotificationPreferences indicates an expected call of GetNotificationPreferences.
+func (mr *MockStoreMockRecorder) GetNotificationPreferences(ctx, userID, boardID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetNotificationPreferences", reflect.TypeOf((*MockStore)(nil).GetNotificationPreferences), ctx, userID, boardID)
+}
+
+// GetNotificationPreferencesForBoard mocks base method.
+func (m *MockStore) GetNotificationPreferencesForBoard(ctx context.Context, boardID string) ([]*model.NotificationPreferences, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetNotificationPreferencesForBoard", ctx, boardID)
+	ret0, _ := ret[0].([]*model.NotificationPreferences)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetNotificationPreferencesForBoard indicates an expected call of GetNotificationPreferencesForBoard.
+func (mr *MockStoreMockRecorder) GetNotificationPreferencesForBoard(ctx, boardID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetNotificationPreferencesForBoard", reflect.TypeOf((*MockStore)(nil).GetNotificationPreferencesForBoard), ctx, boardID)
+}
+
+// GetNotificationsForUser mocks base method.
+func (m *MockStore) GetNotificationsForUser(ctx context.Context, userID string, opts model.QueryNotificationsOptions) ([]*model.Notification, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetNotificationsForUser", ctx, userID, opts)
+	ret0, _ := ret[0].([]*model.Notification)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetNotificationsForUser indicates an expected call of GetNotificationsForUser.
+func (mr *MockStoreMockRecorder) GetNotificationsForUser(ctx, userID, opts interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetNotificationHint", reflect.TypeOf((*MockStore)(nil).GetNotificationHint), blockID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetNotificationsForUser", reflect.TypeOf((*MockStore)(nil).GetNotificationsForUser), ctx, userID, opts)
 }
 
 // GetRegisteredUserCount mocks base method.
-func (m *MockStore) GetRegisteredUserCount() (int, error) {
+func (m *MockStore) GetRegisteredUserCount(ctx context.Context) (int, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetRegisteredUserCount")
+	ret := m.ctrl.Call(m, "GetRegisteredUserCount", ctx)
 	ret0, _ := ret[0].(int)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetRegisteredUserCount indicates an expected call of GetRegisteredUserCount.
-func (mr *MockStoreMockRecorder) GetRegisteredUserCount() *gomock.Call {
+func (mr *MockStoreMockRecorder) GetRegisteredUserCount(ctx interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRegisteredUserCount", reflect.TypeOf((*MockStore)(nil).GetRegisteredUserCount))
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRegisteredUserCount", reflect.TypeOf((*MockStore)(nil).GetRegisteredUserCount), ctx)
+}
+
+// GetShareLink mocks base method.
+func (m *MockStore) GetShareLink(ctx context.Context, linkID string) (*model.ShareLink, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetShareLink", ctx, linkID)
+	ret0, _ := ret[0].(*model.ShareLink)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetShareLink indicates an expected call of GetShareLink.
+func (mr *MockStoreMockRecorder) GetShareLink(ctx, linkID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetShareLink", reflect.TypeOf((*MockStore)(nil).GetShareLink), ctx, linkID)
+}
+
+// GetShareLinkByToken mocks base method.
+func (m *MockStore) GetShareLinkByToken(ctx context.Context, boardID, token string) (*model.ShareLink, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetShareLinkByToken", ctx, boardID, token)
+	ret0, _ := ret[0].(*model.ShareLink)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetShareLinkByToken indicates an expected call of GetShareLinkByToken.
+func (mr *MockStoreMockRecorder) GetShareLinkByToken(ctx, boardID, token interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetShareLinkByToken", reflect.TypeOf((*MockStore)(nil).GetShareLinkByToken), ctx, boardID, token)
+}
+
+// GetShareLinksForBoard mocks base method.
+func (m *MockStore) GetShareLinksForBoard(ctx context.Context, boardID string) ([]*model.ShareLink, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetShareLinksForBoard", ctx, boardID)
+	ret0, _ := ret[0].([]*model.ShareLink)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetShareLinksForBoard indicates an expected call of GetShareLinksForBoard.
+func (mr *MockStoreMockRecorder) GetShareLinksForBoard(ctx, boardID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetShareLinksForBoard", reflect.TypeOf((*MockStore)(nil).GetShareLinksForBoard), ctx, boardID)
 }
 
 // GetSharing mocks base method.
-func (m *MockStore) GetSharing(rootID string) (*model.Sharing, error) {
+func (m *MockStore) GetSharing(ctx context.Context, rootID string) (*model.Sharing, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetSharing", rootID)
+	ret := m.ctrl.Call(m, "GetSharing", ctx, rootID)
 	ret0, _ := ret[0].(*model.Sharing)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetSharing indicates an expected call of GetSharing.
-func (mr *MockStoreMockRecorder) GetSharing(rootID interface{}) *gomock.Call {
+func (mr *MockStoreMockRecorder) GetSharing(ctx, rootID interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSharing", reflect.TypeOf((*MockStore)(nil).GetSharing), rootID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSharing", reflect.TypeOf((*MockStore)(nil).GetSharing), ctx, rootID)
+}
+
+// GetStorageQuota mocks base method.
+func (m *MockStore) GetStorageQuota(ctx context.Context, scope model.StorageQuotaScope, scopeID string) (*model.StorageQuota, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetStorageQuota", ctx, scope, scopeID)
+	ret0, _ := ret[0].(*model.StorageQuota)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetStorageQuota indicates an expected call of GetStorageQuota.
+func (mr *MockStoreMockRecorder) GetStorageQuota(ctx, scope, scopeID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetStorageQuota", reflect.TypeOf((*MockStore)(nil).GetStorageQuota), ctx, scope, scopeID)
 }
 
 // GetSubTree2 mocks base method.
-func (m *MockStore) GetSubTree2(boardID, blockID string, opts model.QuerySubtreeOptions) ([]*model.Block, error) {
+func (m *MockStore) GetSubTree2(ctx context.Context, boardID, blockID string, opts model.QuerySubtreeOptions) ([]*model.Block, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetSubTree2", boardID, blockID, opts)
+	ret := m.ctrl.Call(m, "GetSubTree2", ctx, boardID, blockID, opts)
 	ret0, _ := ret[0].([]*model.Block)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetSubTree2 indicates an expected call of GetSubTree2.
-func (mr *MockStoreMockRecorder) GetSubTree2(boardID, blockID, opts interface{}) *gomock.Call {
+func (mr *MockStoreMockRecorder) GetSubTree2(ctx, boardID, blockID, opts interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSubTree2", reflect.TypeOf((*MockStore)(nil).GetSubTree2), boardID, blockID, opts)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSubTree2", reflect.TypeOf((*MockStore)(nil).GetSubTree2), ctx, boardID, blockID, opts)
 }
 
 // GetSubscribersCountForBlock mocks base method.
-func (m *MockStore) GetSubscribersCountForBlock(blockID string) (int, error) {
+func (m *MockStore) GetSubscribersCountForBlock(ctx context.Context, blockID string) (int, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetSubscribersCountForBlock", blockID)
+	ret := m.ctrl.Call(m, "GetSubscribersCountForBlock", ctx, blockID)
 	ret0, _ := ret[0].(int)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetSubscribersCountForBlock indicates an expected call of GetSubscribersCountForBlock.
-func (mr *MockStoreMockRecorder) GetSubscribersCountForBlock(blockID interface{}) *gomock.Call {
+func (mr *MockStoreMockRecorder) GetSubscribersCountForBlock(ctx, blockID interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSubscribersCountForBlock", reflect.TypeOf((*MockStore)(nil).GetSubscribersCountForBlock), blockID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSubscribersCountForBlock", reflect.TypeOf((*MockStore)(nil).GetSubscribersCountForBlock), ctx, blockID)
 }
 
 // GetSubscribersForBlock mocks base method.
-func (m *MockStore) GetSubscribersForBlock(blockID string) ([]*model.Subscriber, error) {
+func (m *MockStore) GetSubscribersForBlock(ctx context.Context, blockID string) ([]*model.Subscriber, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetSubscribersForBlock", blockID)
+	ret := m.ctrl.Call(m, "GetSubscribersForBlock", ctx, blockID)
 	ret0, _ := ret[0].([]*model.Subscriber)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetSubscribersForBlock indicates an expected call of GetSubscribersForBlock.
-func (mr *MockStoreMockRecorder) GetSubscribersForBlock(blockID interface{}) *gomock.Call {
+func (mr *MockStoreMockRecorder) GetSubscribersForBlock(ctx, blockID interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSubscribersForBlock", reflect.TypeOf((*MockStore)(nil).GetSubscribersForBlock), blockID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSubscribersForBlock", reflect.TypeOf((*MockStore)(nil).GetSubscribersForBlock), ctx, blockID)
 }
 
 // GetSubscription mocks base method.
-func (m *MockStore) GetSubscription(blockID, subscriberID string) (*model.Subscription, error) {
+func (m *MockStore) GetSubscription(ctx context.Context, blockID, subscriberID string) (*model.Subscription, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetSubscription", blockID, subscriberID)
+	ret := m.ctrl.Call(m, "GetSubscription", ctx, blockID, subscriberID)
 	ret0, _ := ret[0].(*model.Subscription)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetSubscription indicates an expected call of GetSubscription.
-func (mr *MockStoreMockRecorder) GetSubscription(blockID, subscriberID interface{}) *gomock.Call {
+func (mr *MockStoreMockRecorder) GetSubscription(ctx, blockID, subscriberID interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSubscription", reflect.TypeOf((*MockStore)(nil).GetSubscription), blockID, subscriberID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSubscription", reflect.TypeOf((*MockStore)(nil).GetSubscription), ctx, blockID, subscriberID)
 }
 
 // GetSubscriptions mocks base method.
-func (m *MockStore) GetSubscriptions(subscriberID string) ([]*model.Subscription, error) {
+func (m *MockStore) GetSubscriptions(ctx context.Context, subscriberID string) ([]*model.Subscription, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetSubscriptions", subscriberID)
+	ret := m.ctrl.Call(m, "GetSubscriptions", ctx, subscriberID)
 	ret0, _ := ret[0].([]*model.Subscription)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetSubscriptions indicates an expected call of GetSubscriptions.
-func (mr *MockStoreMockRecorder) GetSubscriptions(subscriberID interface{}) *gomock.Call {
+func (mr *MockStoreMockRecorder) GetSubscriptions(ctx, subscriberID interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSubscriptions", reflect.TypeOf((*MockStore)(nil).GetSubscriptions), subscriberID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSubscriptions", reflect.TypeOf((*MockStore)(nil).GetSubscriptions), ctx, subscriberID)
 }
 
 // GetSystemSetting mocks base method.
-func (m *MockStore) GetSystemSetting(key string) (string, error) {
+func (m *MockStore) GetSystemSetting(ctx context.Context, key string) (string, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetSystemSetting", key)
+	ret := m.ctrl.Call(m, "GetSystemSetting", ctx, key)
 	ret0, _ := ret[0].(string)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetSystemSetting indicates an expected call of GetSystemSetting.
-func (mr *MockStoreMockRecorder) GetSystemSetting(key interface{}) *gomock.Call {
+func (mr *MockStoreMockRecorder) GetSystemSetting(ctx, key interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSystemSetting", reflect.TypeOf((*MockStore)(nil).GetSystemSetting), key)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSystemSetting", reflect.TypeOf((*MockStore)(nil).GetSystemSetting), ctx, key)
 }
 
 // GetSystemSettings mocks base method.
-func (m *MockStore) GetSystemSettings() (map[string]string, error) {
+func (m *MockStore) GetSystemSettings(ctx context.Context) (map[string]string, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetSystemSettings")
+	ret := m.ctrl.Call(m, "GetSystemSettings", ctx)
 	ret0, _ := ret[0].(map[string]string)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetSystemSettings indicates an expected call of GetSystemSettings.
-func (mr *MockStoreMockRecorder) GetSystemSettings() *gomock.Call {
+func (mr *MockStoreMockRecorder) GetSystemSettings(ctx interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSystemSettings", reflect.TypeOf((*MockStore)(nil).GetSystemSettings))
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSystemSettings", reflect.TypeOf((*MockStore)(nil).GetSystemSettings), ctx)
 }
 
 // GetTeam mocks base method.
-func (m *MockStore) GetTeam(ID string) (*model.Team, error) {
+func (m *MockStore) GetTeam(ctx context.Context, ID string) (*model.Team, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetTeam", ID)
+	ret := m.ctrl.Call(m, "GetTeam", ctx, ID)
 	ret0, _ := ret[0].(*model.Team)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetTeam indicates an expected call of GetTeam.
-func (mr *MockStoreMockRecorder) GetTeam(ID interface{}) *gomock.Call {
+func (mr *MockStoreMockRecorder) GetTeam(ctx, ID interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTeam", reflect.TypeOf((*MockStore)(nil).GetTeam), ID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTeam", reflect.TypeOf((*MockStore)(nil).GetTeam), ctx, ID)
+}
+
+// GetTeamBoardAndCardCounts mocks base method.
+func (m *MockStore) GetTeamBoardAndCardCounts(ctx context.Context, teamID string) ([]*model.TeamStatistics, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTeamBoardAndCardCounts", ctx, teamID)
+	ret0, _ := ret[0].([]*model.TeamStatistics)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTeamBoardAndCardCounts indicates an expected call of GetTeamBoardAndCardCounts.
+func (mr *MockStoreMockRecorder) GetTeamBoardAndCardCounts(ctx, teamID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTeamBoardAndCardCounts", reflect.TypeOf((*MockStore)(nil).GetTeamBoardAndCardCounts), ctx, teamID)
 }
 
 // GetTeamCount mocks base method.
-func (m *MockStore) GetTeamCount() (int64, error) {
+func (m *MockStore) GetTeamCount(ctx context.Context) (int64, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetTeamCount")
+	ret := m.ctrl.Call(m, "GetTeamCount", ctx)
 	ret0, _ := ret[0].(int64)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetTeamCount indicates an expected call of GetTeamCount.
-func (mr *MockStoreMockRecorder) GetTeamCount() *gomock.Call {
+func (mr *MockStoreMockRecorder) GetTeamCount(ctx interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTeamCount", reflect.TypeOf((*MockStore)(nil).GetTeamCount))
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTeamCount", reflect.TypeOf((*MockStore)(nil).GetTeamCount), ctx)
+}
+
+// GetTeamFileUsage mocks base method.
+func (m *MockStore) GetTeamFileUsage(ctx context.Context, teamID string) (*model.FileUsage, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTeamFileUsage", ctx, teamID)
+	ret0, _ := ret[0].(*model.FileUsage)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTeamFileUsage indicates an expected call of GetTeamFileUsage.
+func (mr *MockStoreMockRecorder) GetTeamFileUsage(ctx, teamID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTeamFileUsage", reflect.TypeOf((*MockStore)(nil).GetTeamFileUsage), ctx, teamID)
 }
 
 // GetTeamsForUser mocks base method.
-func (m *MockStore) GetTeamsForUser(userID string) ([]*model.Team, error) {
+func (m *MockStore) GetTeamsForUser(ctx context.Context, userID string) ([]*model.Team, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetTeamsForUser", userID)
+	ret := m.ctrl.Call(m, "GetTeamsForUser", ctx, userID)
 	ret0, _ := ret[0].([]*model.Team)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetTeamsForUser indicates an expected call of GetTeamsForUser.
-func (mr *MockStoreMockRecorder) GetTeamsForUser(userID interface{}) *gomock.Call {
+func (mr *MockStoreMockRecorder) GetTeamsForUser(ctx, userID interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTeamsForUser", reflect.TypeOf((*MockStore)(nil).GetTeamsForUser), userID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTeamsForUser", reflect.TypeOf((*MockStore)(nil).GetTeamsForUser), ctx, userID)
 }
 
 // GetTemplateBoards mocks base method.
-func (m *MockStore) GetTemplateBoards(teamID, userID string) ([]*model.Board, error) {
+func (m *MockStore) GetTemplateBoards(ctx context.Context, teamID, userID string) ([]*model.Board, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetTemplateBoards", teamID, userID)
+	ret := m.ctrl.Call(m, "GetTemplateBoards", ctx, teamID, userID)
 	ret0, _ := ret[0].([]*model.Board)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetTemplateBoards indicates an expected call of GetTemplateBoards.
-func (mr *MockStoreMockRecorder) GetTemplateBoards(teamID, userID interface{}) *gomock.Call {
+func (mr *MockStoreMockRecorder) GetTemplateBoards(ctx, teamID, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTemplateBoards", reflect.TypeOf((*MockStore)(nil).GetTemplateBoards), ctx, teamID, userID)
+}
+
+// GetUnreadNotificationCount mocks base method.
+func (m *MockStore) GetUnreadNotificationCount(ctx context.Context, userID string) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUnreadNotificationCount", ctx, userID)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUnreadNotificationCount indicates an expected call of GetUnreadNotificationCount.
+func (mr *MockStoreMockRecorder) GetUnreadNotificationCount(ctx, userID interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTemplateBoards", reflect.TypeOf((*MockStore)(nil).GetTemplateBoards), teamID, userID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUnreadNotificationCount", reflect.TypeOf((*MockStore)(nil).GetUnreadNotificationCount), ctx, userID)
 }
 
 // GetUsedCardsCount mocks base method.
-func (m *MockStore) GetUsedCardsCount() (int64, error) {
+func (m *MockStore) GetUsedCardsCount(ctx context.Context) (int64, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetUsedCardsCount")
+	ret := m.ctrl.Call(m, "GetUsedCardsCount", ctx)
 	ret0, _ := ret[0].(int64)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetUsedCardsCount indicates an expected call of GetUsedCardsCount.
-func (mr *MockStoreMockRecorder) GetUsedCardsCount() *gomock.Call {
+func (mr *MockStoreMockRecorder) GetUsedCardsCount(ctx interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUsedCardsCount", reflect.TypeOf((*MockStore)(nil).GetUsedCardsCount))
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUsedCardsCount", reflect.TypeOf((*MockStore)(nil).GetUsedCardsCount), ctx)
 }
 
 // GetUserByEmail mocks base method.
-func (m *MockStore) GetUserByEmail(email string) (*model.User, error) {
+func (m *MockStore) GetUserByEmail(ctx context.Context, email string) (*model.User, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetUserByEmail", email)
+	ret := m.ctrl.Call(m, "GetUserByEmail", ctx, email)
 	ret0, _ := ret[0].(*model.User)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetUserByEmail indicates an expected call of GetUserByEmail.
-func (mr *MockStoreMockRecorder) GetUserByEmail(email interface{}) *gomock.Call {
+func (mr *MockStoreMockRecorder) GetUserByEmail(ctx, email interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserByEmail", reflect.TypeOf((*MockStore)(nil).GetUserByEmail), email)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserByEmail", reflect.TypeOf((*MockStore)(nil).GetUserByEmail), ctx, email)
 }
 
 // GetUserByID mocks base method.
-func (m *MockStore) GetUserByID(userID string) (*model.User, error) {
+func (m *MockStore) GetUserByID(ctx context.Context, userID string) (*model.User, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetUserByID", userID)
+	ret := m.ctrl.Call(m, "GetUserByID", ctx, userID)
 	ret0, _ := ret[0].(*model.User)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetUserByID indicates an expected call of GetUserByID.
-func (mr *MockStoreMockRecorder) GetUserByID(userID interface{}) *gomock.Call {
+func (mr *MockStoreMockRecorder) GetUserByID(ctx, userID interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserByID", reflect.TypeOf((*MockStore)(nil).GetUserByID), userID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserByID", reflect.TypeOf((*MockStore)(nil).GetUserByID), ctx, userID)
 }
 
 // GetUserByUsername mocks base method.
-func (m *MockStore) GetUserByUsername(username string) (*model.User, error) {
+func (m *MockStore) GetUserByUsername(ctx context.Context, username string) (*model.User, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetUserByUsername", username)
+	ret := m.ctrl.Call(m, "GetUserByUsername", ctx, username)
 	ret0, _ := ret[0].(*model.User)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetUserByUsername indicates an expected call of GetUserByUsername.
-func (mr *MockStoreMockRecorder) GetUserByUsername(username interface{}) *gomock.Call {
+func (mr *MockStoreMockRecorder) GetUserByUsername(ctx, username interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserByUsername", reflect.TypeOf((*MockStore)(nil).GetUserByUsername), username)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserByUsername", reflect.TypeOf((*MockStore)(nil).GetUserByUsername), ctx, username)
 }
 
 // GetUserCategories mocks base method.
-func (m *MockStore) GetUserCategories(userID, teamID string) ([]model.Category, error) {
+func (m *MockStore) GetUserCategories(ctx context.Context, userID, teamID string) ([]model.Category, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetUserCategories", userID, teamID)
+	ret := m.ctrl.Call(m, "GetUserCategories", ctx, userID, teamID)
 	ret0, _ := ret[0].([]model.Category)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetUserCategories indicates an expected call of GetUserCategories.
-func (mr *MockStoreMockRecorder) GetUserCategories(userID, teamID interface{}) *gomock.Call {
+func (mr *MockStoreMockRecorder) GetUserCategories(ctx, userID, teamID interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserCategories", reflect.TypeOf((*MockStore)(nil).GetUserCategories), userID, teamID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserCategories", reflect.TypeOf((*MockStore)(nil).GetUserCategories), ctx, userID, teamID)
 }
 
 // GetUserCategoryBoards mocks base method.
-func (m *MockStore) GetUserCategoryBoards(userID, teamID string) ([]model.CategoryBoards, error) {
+func (m *MockStore) GetUserCategoryBoards(ctx context.Context, userID, teamID string) ([]model.CategoryBoards, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetUserCategoryBoards", userID, teamID)
+	ret := m.ctrl.Call(m, "GetUserCategoryBoards", ctx, userID, teamID)
 	ret0, _ := ret[0].([]model.CategoryBoards)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetUserCategoryBoards indicates an expected call of GetUserCategoryBoards.
-func (mr *MockStoreMockRecorder) GetUserCategoryBoards(userID, teamID interface{}) *gomock.Call {
+func (mr *MockStoreMockRecorder) GetUserCategoryBoards(ctx, userID, teamID interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserCategoryBoards", reflect.TypeOf((*MockStore)(nil).GetUserCategoryBoards), userID, teamID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserCategoryBoards", reflect.TypeOf((*MockStore)(nil).GetUserCategoryBoards), ctx, userID, teamID)
 }
 
 // GetUserPreferences mocks base method.
-func (m *MockStore) GetUserPreferences(userID string) (model0.Preferences, error) {
+func (m *MockStore) GetUserPreferences(ctx context.Context, userID string) (model0.Preferences, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetUserPreferences", userID)
+	ret := m.ctrl.Call(m, "GetUserPreferences", ctx, userID)
 	ret0, _ := ret[0].(model0.Preferences)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetUserPreferences indicates an expected call of GetUserPreferences.
-func (mr *MockStoreMockRecorder) GetUserPreferences(userID interface{}) *gomock.Call {
+func (mr *MockStoreMockRecorder) GetUserPreferences(ctx, userID interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserPreferences", reflect.TypeOf((*MockStore)(nil).GetUserPreferences), userID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserPreferences", reflect.TypeOf((*MockStore)(nil).GetUserPreferences), ctx, userID)
 }
 
 // GetUserTimezone mocks base method.
-func (m *MockStore) GetUserTimezone(userID string) (string, error) {
+func (m *MockStore) GetUserTimezone(ctx context.Context, userID string) (string, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetUserTimezone", userID)
+	ret := m.ctrl.Call(m, "GetUserTimezone", ctx, userID)
 	ret0, _ := ret[0].(string)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetUserTimezone indicates an expected call of GetUserTimezone.
-func (mr *MockStoreMockRecorder) GetUserTimezone(userID interface{}) *gomock.Call {
+func (mr *MockStoreMockRecorder) GetUserTimezone(ctx, userID interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserTimezone", reflect.TypeOf((*MockStore)(nil).GetUserTimezone), userID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserTimezone", reflect.TypeOf((*MockStore)(nil).GetUserTimezone), ctx, userID)
 }
 
 // GetUsersByTeam mocks base method.
-func (m *MockStore) GetUsersByTeam(teamID, asGuestID string, showEmail, showName bool) ([]*model.User, error) {
+func (m *MockStore) GetUsersByTeam(ctx context.Context, teamID, asGuestID string, showEmail, showName bool) ([]*model.User, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetUsersByTeam", teamID, asGuestID, showEmail, showName)
+	ret := m.ctrl.Call(m, "GetUsersByTeam", ctx, teamID, asGuestID, showEmail, showName)
 	ret0, _ := ret[0].([]*model.User)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetUsersByTeam indicates an expected call of GetUsersByTeam.
-func (mr *MockStoreMockRecorder) GetUsersByTeam(teamID, asGuestID, showEmail, showName interface{}) *gomock.Call {
+func (mr *MockStoreMockRecorder) GetUsersByTeam(ctx, teamID, asGuestID, showEmail, showName interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUsersByTeam", reflect.TypeOf((*MockStore)(nil).GetUsersByTeam), teamID, asGuestID, showEmail, showName)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUsersByTeam", reflect.TypeOf((*MockStore)(nil).GetUsersByTeam), ctx, teamID, asGuestID, showEmail, showName)
 }
 
 // GetUsersList mocks base method.
-func (m *MockStore) GetUsersList(userIDs []string, showEmail, showName bool) ([]*model.User, error) {
+func (m *MockStore) GetUsersList(ctx context.Context, userIDs []string, showEmail, showName bool) ([]*model.User, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetUsersList", userIDs, showEmail, showName)
+	ret := m.ctrl.Call(m, "GetUsersList", ctx, userIDs, showEmail, showName)
 	ret0, _ := ret[0].([]*model.User)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetUsersList indicates an expected call of GetUsersList.
-func (mr *MockStoreMockRecorder) GetUsersList(userIDs, showEmail, showName interface{}) *gomock.Call {
+func (mr *MockStoreMockRecorder) GetUsersList(ctx, userIDs, showEmail, showName interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUsersList", reflect.TypeOf((*MockStore)(nil).GetUsersList), ctx, userIDs, showEmail, showName)
+}
+
+// InsertAuditEntry mocks base method.
+func (m *MockStore) InsertAuditEntry(ctx context.Context, entry *model.AuditEntry) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InsertAuditEntry", ctx, entry)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// InsertAuditEntry indicates an expected call of InsertAuditEntry.
+func (mr *MockStoreMockRecorder) InsertAuditEntry(ctx, entry interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUsersList", reflect.TypeOf((*MockStore)(nil).GetUsersList), userIDs, showEmail, showName)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InsertAuditEntry", reflect.TypeOf((*MockStore)(nil).InsertAuditEntry), ctx, entry)
 }
 
 // InsertBlock mocks base method.
-func (m *MockStore) InsertBlock(block *model.Block, userID string) error {
+func (m *MockStore) InsertBlock(ctx context.Context, block *model.Block, userID string) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "InsertBlock", block, userID)
+	ret := m.ctrl.Call(m, "InsertBlock", ctx, block, userID)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // InsertBlock indicates an expected call of InsertBlock.
-func (mr *MockStoreMockRecorder) InsertBlock(block, userID interface{}) *gomock.Call {
+func (mr *MockStoreMockRecorder) InsertBlock(ctx, block, userID interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InsertBlock", reflect.TypeOf((*MockStore)(nil).InsertBlock), block, userID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InsertBlock", reflect.TypeOf((*MockStore)(nil).InsertBlock), ctx, block, userID)
 }
 
 // InsertBlocks mocks base method.
-func (m *MockStore) InsertBlocks(blocks []*model.Block, userID string) error {
+func (m *MockStore) InsertBlocks(ctx context.Context, blocks []*model.Block, userID string) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "InsertBlocks", blocks, userID)
+	ret := m.ctrl.Call(m, "InsertBlocks", ctx, blocks, userID)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // InsertBlocks indicates an expected call of InsertBlocks.
-func (mr *MockStoreMockRecorder) InsertBlocks(blocks, userID interface{}) *gomock.Call {
+func (mr *MockStoreMockRecorder) InsertBlocks(ctx, blocks, userID interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InsertBlocks", reflect.TypeOf((*MockStore)(nil).InsertBlocks), blocks, userID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InsertBlocks", reflect.TypeOf((*MockStore)(nil).InsertBlocks), ctx, blocks, userID)
 }
 
 // InsertBoard mocks base method.
-func (m *MockStore) InsertBoard(board *model.Board, userID string) (*model.Board, error) {
+func (m *MockStore) InsertBoard(ctx context.Context, board *model.Board, userID string) (*model.Board, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "InsertBoard", board, userID)
+	ret := m.ctrl.Call(m, "InsertBoard", ctx, board, userID)
 	ret0, _ := ret[0].(*model.Board)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // InsertBoard indicates an expected call of InsertBoard.
-func (mr *MockStoreMockRecorder) InsertBoard(board, userID interface{}) *gomock.Call {
+func (mr *MockStoreMockRecorder) InsertBoard(ctx, board, userID interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InsertBoard", reflect.TypeOf((*MockStore)(nil).InsertBoard), board, userID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InsertBoard", reflect.TypeOf((*MockStore)(nil).InsertBoard), ctx, board, userID)
 }
 
 // InsertBoardWithAdmin mocks base method.
-func (m *MockStore) InsertBoardWithAdmin(board *model.Board, userID string) (*model.Board, *model.BoardMember, error) {
+func (m *MockStore) InsertBoardWithAdmin(ctx context.Context, board *model.Board, userID string) (*model.Board, *model.BoardMember, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "InsertBoardWithAdmin", board, userID)
+	ret := m.ctrl.Call(m, "InsertBoardWithAdmin", ctx, board, userID)
 	ret0, _ := ret[0].(*model.Board)
 	ret1, _ := ret[1].(*model.BoardMember)
 	ret2, _ := ret[2].(error)
@@ -1239,300 +1894,399 @@ func (m *MockStore) InsertBoardWithAdmin(board *model.Board, userID string) (*mo
 }
 
 // InsertBoardWithAdmin indicates an expected call of InsertBoardWithAdmin.
-func (mr *MockStoreMockRecorder) InsertBoardWithAdmin(board, userID interface{}) *gomock.Call {
+func (mr *MockStoreMockRecorder) InsertBoardWithAdmin(ctx, board, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InsertBoardWithAdmin", reflect.TypeOf((*MockStore)(nil).InsertBoardWithAdmin), ctx, board, userID)
+}
+
+// MarkAllNotificationsRead mocks base method.
+func (m *MockStore) MarkAllNotificationsRead(ctx context.Context, userID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MarkAllNotificationsRead", ctx, userID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// MarkAllNotificationsRead indicates an expected call of MarkAllNotificationsRead.
+func (mr *MockStoreMockRecorder) MarkAllNotificationsRead(ctx, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarkAllNotificationsRead", reflect.TypeOf((*MockStore)(nil).MarkAllNotificationsRead), ctx, userID)
+}
+
+// MarkNotificationRead mocks base method.
+func (m *MockStore) MarkNotificationRead(ctx context.Context, userID, notificationID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MarkNotificationRead", ctx, userID, notificationID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// MarkNotificationRead indicates an expected call of MarkNotificationRead.
+func (mr *MockStoreMockRecorder) MarkNotificationRead(ctx, userID, notificationID interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InsertBoardWithAdmin", reflect.TypeOf((*MockStore)(nil).InsertBoardWithAdmin), board, userID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarkNotificationRead", reflect.TypeOf((*MockStore)(nil).MarkNotificationRead), ctx, userID, notificationID)
 }
 
 // PatchBlock mocks base method.
-func (m *MockStore) PatchBlock(blockID string, blockPatch *model.BlockPatch, userID string) error {
+func (m *MockStore) PatchBlock(ctx context.Context, blockID string, blockPatch *model.BlockPatch, userID string) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "PatchBlock", blockID, blockPatch, userID)
+	ret := m.ctrl.Call(m, "PatchBlock", ctx, blockID, blockPatch, userID)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // PatchBlock indicates an expected call of PatchBlock.
-func (mr *MockStoreMockRecorder) PatchBlock(blockID, blockPatch, userID interface{}) *gomock.Call {
+func (mr *MockStoreMockRecorder) PatchBlock(ctx, blockID, blockPatch, userID interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PatchBlock", reflect.TypeOf((*MockStore)(nil).PatchBlock), blockID, blockPatch, userID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PatchBlock", reflect.TypeOf((*MockStore)(nil).PatchBlock), ctx, blockID, blockPatch, userID)
 }
 
 // PatchBlocks mocks base method.
-func (m *MockStore) PatchBlocks(blockPatches *model.BlockPatchBatch, userID string) error {
+func (m *MockStore) PatchBlocks(ctx context.Context, blockPatches *model.BlockPatchBatch, userID string) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "PatchBlocks", blockPatches, userID)
+	ret := m.ctrl.Call(m, "PatchBlocks", ctx, blockPatches, userID)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // PatchBlocks indicates an expected call of PatchBlocks.
-func (mr *MockStoreMockRecorder) PatchBlocks(blockPatches, userID interface{}) *gomock.Call {
+func (mr *MockStoreMockRecorder) PatchBlocks(ctx, blockPatches, userID interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PatchBlocks", reflect.TypeOf((*MockStore)(nil).PatchBlocks), blockPatches, userID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PatchBlocks", reflect.TypeOf((*MockStore)(nil).PatchBlocks), ctx, blockPatches, userID)
 }
 
 // PatchBoard mocks base method.
-func (m *MockStore) PatchBoard(boardID string, boardPatch *model.BoardPatch, userID string) (*model.Board, error) {
+func (m *MockStore) PatchBoard(ctx context.Context, boardID string, boardPatch *model.BoardPatch, userID string) (*model.Board, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "PatchBoard", boardID, boardPatch, userID)
+	ret := m.ctrl.Call(m, "PatchBoard", ctx, boardID, boardPatch, userID)
 	ret0, _ := ret[0].(*model.Board)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // PatchBoard indicates an expected call of PatchBoard.
-func (mr *MockStoreMockRecorder) PatchBoard(boardID, boardPatch, userID interface{}) *gomock.Call {
+func (mr *MockStoreMockRecorder) PatchBoard(ctx, boardID, boardPatch, userID interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PatchBoard", reflect.TypeOf((*MockStore)(nil).PatchBoard), boardID, boardPatch, userID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PatchBoard", reflect.TypeOf((*MockStore)(nil).PatchBoard), ctx, boardID, boardPatch, userID)
 }
 
 // PatchBoardsAndBlocks mocks base method.
-func (m *MockStore) PatchBoardsAndBlocks(pbab *model.PatchBoardsAndBlocks, userID string) (*model.BoardsAndBlocks, error) {
+func (m *MockStore) PatchBoardsAndBlocks(ctx context.Context, pbab *model.PatchBoardsAndBlocks, userID string) (*model.BoardsAndBlocks, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "PatchBoardsAndBlocks", pbab, userID)
+	ret := m.ctrl.Call(m, "PatchBoardsAndBlocks", ctx, pbab, userID)
 	ret0, _ := ret[0].(*model.BoardsAndBlocks)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // PatchBoardsAndBlocks indicates an expected call of PatchBoardsAndBlocks.
-func (mr *MockStoreMockRecorder) PatchBoardsAndBlocks(pbab, userID interface{}) *gomock.Call {
+func (mr *MockStoreMockRecorder) PatchBoardsAndBlocks(ctx, pbab, userID interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PatchBoardsAndBlocks", reflect.TypeOf((*MockStore)(nil).PatchBoardsAndBlocks), pbab, userID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PatchBoardsAndBlocks", reflect.TypeOf((*MockStore)(nil).PatchBoardsAndBlocks), ctx, pbab, userID)
 }
 
 // PatchUserPreferences mocks base method.
-func (m *MockStore) PatchUserPreferences(userID string, patch model.UserPreferencesPatch) (model0.Preferences, error) {
+func (m *MockStore) PatchUserPreferences(ctx context.Context, userID string, patch model.UserPreferencesPatch) (model0.Preferences, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "PatchUserPreferences", userID, patch)
+	ret := m.ctrl.Call(m, "PatchUserPreferences", ctx, userID, patch)
 	ret0, _ := ret[0].(model0.Preferences)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // PatchUserPreferences indicates an expected call of PatchUserPreferences.
-func (mr *MockStoreMockRecorder) PatchUserPreferences(userID, patch interface{}) *gomock.Call {
+func (mr *MockStoreMockRecorder) PatchUserPreferences(ctx, userID, patch interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PatchUserPreferences", reflect.TypeOf((*MockStore)(nil).PatchUserPreferences), userID, patch)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PatchUserPreferences", reflect.TypeOf((*MockStore)(nil).PatchUserPreferences), ctx, userID, patch)
 }
 
 // PostMessage mocks base method.
-func (m *MockStore) PostMessage(message, postType, channelID string) error {
+func (m *MockStore) PostMessage(ctx context.Context, message, postType, channelID string) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "PostMessage", message, postType, channelID)
+	ret := m.ctrl.Call(m, "PostMessage", ctx, message, postType, channelID)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // PostMessage indicates an expected call of PostMessage.
-func (mr *MockStoreMockRecorder) PostMessage(message, postType, channelID interface{}) *gomock.Call {
+func (mr *MockStoreMockRecorder) PostMessage(ctx, message, postType, channelID interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PostMessage", reflect.TypeOf((*MockStore)(nil).PostMessage), message, postType, channelID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PostMessage", reflect.TypeOf((*MockStore)(nil).PostMessage), ctx, message, postType, channelID)
 }
 
 // RemoveDefaultTemplates mocks base method.
-func (m *MockStore) RemoveDefaultTemplates(boards []*model.Board) error {
+func (m *MockStore) RemoveDefaultTemplates(ctx context.Context, boards []*model.Board) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "RemoveDefaultTemplates", boards)
+	ret := m.ctrl.Call(m, "RemoveDefaultTemplates", ctx, boards)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // RemoveDefaultTemplates indicates an expected call of RemoveDefaultTemplates.
-func (mr *MockStoreMockRecorder) RemoveDefaultTemplates(boards interface{}) *gomock.Call {
+func (mr *MockStoreMockRecorder) RemoveDefaultTemplates(ctx, boards interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveDefaultTemplates", reflect.TypeOf((*MockStore)(nil).RemoveDefaultTemplates), boards)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveDefaultTemplates", reflect.TypeOf((*MockStore)(nil).RemoveDefaultTemplates), ctx, boards)
 }
 
 // ReorderCategories mocks base method.
-func (m *MockStore) ReorderCategories(userID, teamID string, newCategoryOrder []string) ([]string, error) {
+func (m *MockStore) ReorderCategories(ctx context.Context, userID, teamID string, newCategoryOrder []string) ([]string, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ReorderCategories", userID, teamID, newCategoryOrder)
+	ret := m.ctrl.Call(m, "ReorderCategories", ctx, userID, teamID, newCategoryOrder)
 	ret0, _ := ret[0].([]string)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // ReorderCategories indicates an expected call of ReorderCategories.
-func (mr *MockStoreMockRecorder) ReorderCategories(userID, teamID, newCategoryOrder interface{}) *gomock.Call {
+func (mr *MockStoreMockRecorder) ReorderCategories(ctx, userID, teamID, newCategoryOrder interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReorderCategories", reflect.TypeOf((*MockStore)(nil).ReorderCategories), userID, teamID, newCategoryOrder)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReorderCategories", reflect.TypeOf((*MockStore)(nil).ReorderCategories), ctx, userID, teamID, newCategoryOrder)
 }
 
 // ReorderCategoryBoards mocks base method.
-func (m *MockStore) ReorderCategoryBoards(categoryID string, newBoardsOrder []string) ([]string, error) {
+func (m *MockStore) ReorderCategoryBoards(ctx context.Context, categoryID string, newBoardsOrder []string) ([]string, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ReorderCategoryBoards", categoryID, newBoardsOrder)
+	ret := m.ctrl.Call(m, "ReorderCategoryBoards", ctx, categoryID, newBoardsOrder)
 	ret0, _ := ret[0].([]string)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // ReorderCategoryBoards indicates an expected call of ReorderCategoryBoards.
-func (mr *MockStoreMockRecorder) ReorderCategoryBoards(categoryID, newBoardsOrder interface{}) *gomock.Call {
+func (mr *MockStoreMockRecorder) ReorderCategoryBoards(ctx, categoryID, newBoardsOrder interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReorderCategoryBoards", reflect.TypeOf((*MockStore)(nil).ReorderCategoryBoards), ctx, categoryID, newBoardsOrder)
+}
+
+// ReserveFileUsage mocks base method.
+func (m *MockStore) ReserveFileUsage(ctx context.Context, teamID, boardID string, bytes, teamQuota, boardQuota int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReserveFileUsage", ctx, teamID, boardID, bytes, teamQuota, boardQuota)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ReserveFileUsage indicates an expected call of ReserveFileUsage.
+func (mr *MockStoreMockRecorder) ReserveFileUsage(ctx, teamID, boardID, bytes, teamQuota, boardQuota interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReorderCategoryBoards", reflect.TypeOf((*MockStore)(nil).ReorderCategoryBoards), categoryID, newBoardsOrder)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReserveFileUsage", reflect.TypeOf((*MockStore)(nil).ReserveFileUsage), ctx, teamID, boardID, bytes, teamQuota, boardQuota)
+}
+
+// RestoreBoardToTime mocks base method.
+func (m *MockStore) RestoreBoardToTime(ctx context.Context, boardID string, at int64, modifiedBy string) (*model.BoardRestorePlan, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RestoreBoardToTime", ctx, boardID, at, modifiedBy)
+	ret0, _ := ret[0].(*model.BoardRestorePlan)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RestoreBoardToTime indicates an expected call of RestoreBoardToTime.
+func (mr *MockStoreMockRecorder) RestoreBoardToTime(ctx, boardID, at, modifiedBy interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RestoreBoardToTime", reflect.TypeOf((*MockStore)(nil).RestoreBoardToTime), ctx, boardID, at, modifiedBy)
 }
 
 // RestoreFiles mocks base method.
-func (m *MockStore) RestoreFiles(fileIDs []string) error {
+func (m *MockStore) RestoreFiles(ctx context.Context, boardID string, fileIDs []string) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "RestoreFiles", fileIDs)
+	ret := m.ctrl.Call(m, "RestoreFiles", ctx, boardID, fileIDs)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // RestoreFiles indicates an expected call of RestoreFiles.
-func (mr *MockStoreMockRecorder) RestoreFiles(fileIDs interface{}) *gomock.Call {
+func (mr *MockStoreMockRecorder) RestoreFiles(ctx, boardID, fileIDs interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RestoreFiles", reflect.TypeOf((*MockStore)(nil).RestoreFiles), fileIDs)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RestoreFiles", reflect.TypeOf((*MockStore)(nil).RestoreFiles), ctx, boardID, fileIDs)
+}
+
+// RevokeShareLink mocks base method.
+func (m *MockStore) RevokeShareLink(ctx context.Context, linkID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RevokeShareLink", ctx, linkID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RevokeShareLink indicates an expected call of RevokeShareLink.
+func (mr *MockStoreMockRecorder) RevokeShareLink(ctx, linkID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RevokeShareLink", reflect.TypeOf((*MockStore)(nil).RevokeShareLink), ctx, linkID)
 }
 
 // RunDataRetention mocks base method.
-func (m *MockStore) RunDataRetention(globalRetentionDate, batchSize int64) (int64, error) {
+func (m *MockStore) RunDataRetention(ctx context.Context, globalRetentionDate, batchSize int64) (int64, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "RunDataRetention", globalRetentionDate, batchSize)
+	ret := m.ctrl.Call(m, "RunDataRetention", ctx, globalRetentionDate, batchSize)
 	ret0, _ := ret[0].(int64)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // RunDataRetention indicates an expected call of RunDataRetention.
-func (mr *MockStoreMockRecorder) RunDataRetention(globalRetentionDate, batchSize interface{}) *gomock.Call {
+func (mr *MockStoreMockRecorder) RunDataRetention(ctx, globalRetentionDate, batchSize interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RunDataRetention", reflect.TypeOf((*MockStore)(nil).RunDataRetention), ctx, globalRetentionDate, batchSize)
+}
+
+// SaveFileBoard mocks base method.
+func (m *MockStore) SaveFileBoard(ctx context.Context, fileID, boardID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SaveFileBoard", ctx, fileID, boardID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SaveFileBoard indicates an expected call of SaveFileBoard.
+func (mr *MockStoreMockRecorder) SaveFileBoard(ctx, fileID, boardID interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RunDataRetention", reflect.TypeOf((*MockStore)(nil).RunDataRetention), globalRetentionDate, batchSize)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveFileBoard", reflect.TypeOf((*MockStore)(nil).SaveFileBoard), ctx, fileID, boardID)
 }
 
 // SaveFileInfo mocks base method.
-func (m *MockStore) SaveFileInfo(fileInfo *model0.FileInfo) error {
+func (m *MockStore) SaveFileInfo(ctx context.Context, fileInfo *model0.FileInfo) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "SaveFileInfo", fileInfo)
+	ret := m.ctrl.Call(m, "SaveFileInfo", ctx, fileInfo)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // SaveFileInfo indicates an expected call of SaveFileInfo.
-func (mr *MockStoreMockRecorder) SaveFileInfo(fileInfo interface{}) *gomock.Call {
+func (mr *MockStoreMockRecorder) SaveFileInfo(ctx, fileInfo interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveFileInfo", reflect.TypeOf((*MockStore)(nil).SaveFileInfo), fileInfo)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveFileInfo", reflect.TypeOf((*MockStore)(nil).SaveFileInfo), ctx, fileInfo)
 }
 
 // SaveMember mocks base method.
-func (m *MockStore) SaveMember(bm *model.BoardMember) (*model.BoardMember, error) {
+func (m *MockStore) SaveMember(ctx context.Context, bm *model.BoardMember) (*model.BoardMember, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "SaveMember", bm)
+	ret := m.ctrl.Call(m, "SaveMember", ctx, bm)
 	ret0, _ := ret[0].(*model.BoardMember)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // SaveMember indicates an expected call of SaveMember.
-func (mr *MockStoreMockRecorder) SaveMember(bm interface{}) *gomock.Call {
+func (mr *MockStoreMockRecorder) SaveMember(ctx, bm interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveMember", reflect.TypeOf((*MockStore)(nil).SaveMember), bm)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveMember", reflect.TypeOf((*MockStore)(nil).SaveMember), ctx, bm)
 }
 
 // SearchBoardsForUser mocks base method.
-func (m *MockStore) SearchBoardsForUser(term string, searchField model.BoardSearchField, userID string, includePublicBoards bool) ([]*model.Board, error) {
+func (m *MockStore) SearchBoardsForUser(ctx context.Context, term string, searchField model.BoardSearchField, userID string, includePublicBoards bool) ([]*model.Board, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "SearchBoardsForUser", term, searchField, userID, includePublicBoards)
+	ret := m.ctrl.Call(m, "SearchBoardsForUser", ctx, term, searchField, userID, includePublicBoards)
 	ret0, _ := ret[0].([]*model.Board)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // SearchBoardsForUser indicates an expected call of SearchBoardsForUser.
-func (mr *MockStoreMockRecorder) SearchBoardsForUser(term, searchField, userID, includePublicBoards interface{}) *gomock.Call {
+func (mr *MockStoreMockRecorder) SearchBoardsForUser(ctx, term, searchField, userID, includePublicBoards interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SearchBoardsForUser", reflect.TypeOf((*MockStore)(nil).SearchBoardsForUser), term, searchField, userID, includePublicBoards)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SearchBoardsForUser", reflect.TypeOf((*MockStore)(nil).SearchBoardsForUser), ctx, term, searchField, userID, includePublicBoards)
 }
 
 // SearchBoardsForUserInTeam mocks base method.
-func (m *MockStore) SearchBoardsForUserInTeam(teamID, term, userID string) ([]*model.Board, error) {
+func (m *MockStore) SearchBoardsForUserInTeam(ctx context.Context, teamID, term, userID string) ([]*model.Board, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "SearchBoardsForUserInTeam", teamID, term, userID)
+	ret := m.ctrl.Call(m, "SearchBoardsForUserInTeam", ctx, teamID, term, userID)
 	ret0, _ := ret[0].([]*model.Board)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // SearchBoardsForUserInTeam indicates an expected call of SearchBoardsForUserInTeam.
-func (mr *MockStoreMockRecorder) SearchBoardsForUserInTeam(teamID, term, userID interface{}) *gomock.Call {
+func (mr *MockStoreMockRecorder) SearchBoardsForUserInTeam(ctx, teamID, term, userID interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SearchBoardsForUserInTeam", reflect.TypeOf((*MockStore)(nil).SearchBoardsForUserInTeam), teamID, term, userID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SearchBoardsForUserInTeam", reflect.TypeOf((*MockStore)(nil).SearchBoardsForUserInTeam), ctx, teamID, term, userID)
 }
 
 // SearchUserChannels mocks base method.
-func (m *MockStore) SearchUserChannels(teamID, userID, query string) ([]*model0.Channel, error) {
+func (m *MockStore) SearchUserChannels(ctx context.Context, teamID, userID, query string) ([]*model0.Channel, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "SearchUserChannels", teamID, userID, query)
+	ret := m.ctrl.Call(m, "SearchUserChannels", ctx, teamID, userID, query)
 	ret0, _ := ret[0].([]*model0.Channel)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // SearchUserChannels indicates an expected call of SearchUserChannels.
-func (mr *MockStoreMockRecorder) SearchUserChannels(teamID, userID, query interface{}) *gomock.Call {
+func (mr *MockStoreMockRecorder) SearchUserChannels(ctx, teamID, userID, query interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SearchUserChannels", reflect.TypeOf((*MockStore)(nil).SearchUserChannels), teamID, userID, query)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SearchUserChannels", reflect.TypeOf((*MockStore)(nil).SearchUserChannels), ctx, teamID, userID, query)
 }
 
 // SearchUsersByTeam mocks base method.
-func (m *MockStore) SearchUsersByTeam(teamID, searchQuery, asGuestID string, excludeBots, showEmail, showName bool) ([]*model.User, error) {
+func (m *MockStore) SearchUsersByTeam(ctx context.Context, teamID, searchQuery, asGuestID string, excludeBots, showEmail, showName bool) ([]*model.User, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "SearchUsersByTeam", teamID, searchQuery, asGuestID, excludeBots, showEmail, showName)
+	ret := m.ctrl.Call(m, "SearchUsersByTeam", ctx, teamID, searchQuery, asGuestID, excludeBots, showEmail, showName)
 	ret0, _ := ret[0].([]*model.User)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // SearchUsersByTeam indicates an expected call of SearchUsersByTeam.
-func (mr *MockStoreMockRecorder) SearchUsersByTeam(teamID, searchQuery, asGuestID, excludeBots, showEmail, showName interface{}) *gomock.Call {
+func (mr *MockStoreMockRecorder) SearchUsersByTeam(ctx, teamID, searchQuery, asGuestID, excludeBots, showEmail, showName interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SearchUsersByTeam", reflect.TypeOf((*MockStore)(nil).SearchUsersByTeam), teamID, searchQuery, asGuestID, excludeBots, showEmail, showName)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SearchUsersByTeam", reflect.TypeOf((*MockStore)(nil).SearchUsersByTeam), ctx, teamID, searchQuery, asGuestID, excludeBots, showEmail, showName)
 }
 
 // SendMessage mocks base method.
-func (m *MockStore) SendMessage(message, postType string, receipts []string) error {
+func (m *MockStore) SendMessage(ctx context.Context, message, postType string, receipts []string) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "SendMessage", message, postType, receipts)
+	ret := m.ctrl.Call(m, "SendMessage", ctx, message, postType, receipts)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // SendMessage indicates an expected call of SendMessage.
-func (mr *MockStoreMockRecorder) SendMessage(message, postType, receipts interface{}) *gomock.Call {
+func (mr *MockStoreMockRecorder) SendMessage(ctx, message, postType, receipts interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendMessage", reflect.TypeOf((*MockStore)(nil).SendMessage), message, postType, receipts)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendMessage", reflect.TypeOf((*MockStore)(nil).SendMessage), ctx, message, postType, receipts)
 }
 
 // SetBoardVisibility mocks base method.
-func (m *MockStore) SetBoardVisibility(userID, categoryID, boardID string, visible bool) error {
+func (m *MockStore) SetBoardVisibility(ctx context.Context, userID, categoryID, boardID string, visible bool) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "SetBoardVisibility", userID, categoryID, boardID, visible)
+	ret := m.ctrl.Call(m, "SetBoardVisibility", ctx, userID, categoryID, boardID, visible)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // SetBoardVisibility indicates an expected call of SetBoardVisibility.
-func (mr *MockStoreMockRecorder) SetBoardVisibility(userID, categoryID, boardID, visible interface{}) *gomock.Call {
+func (mr *MockStoreMockRecorder) SetBoardVisibility(ctx, userID, categoryID, boardID, visible interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetBoardVisibility", reflect.TypeOf((*MockStore)(nil).SetBoardVisibility), userID, categoryID, boardID, visible)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetBoardVisibility", reflect.TypeOf((*MockStore)(nil).SetBoardVisibility), ctx, userID, categoryID, boardID, visible)
 }
 
 // SetSystemSetting mocks base method.
-func (m *MockStore) SetSystemSetting(key, value string) error {
+func (m *MockStore) SetSystemSetting(ctx context.Context, key, value string) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "SetSystemSetting", key, value)
+	ret := m.ctrl.Call(m, "SetSystemSetting", ctx, key, value)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // SetSystemSetting indicates an expected call of SetSystemSetting.
-func (mr *MockStoreMockRecorder) SetSystemSetting(key, value interface{}) *gomock.Call {
+func (mr *MockStoreMockRecorder) SetSystemSetting(ctx, key, value interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetSystemSetting", reflect.TypeOf((*MockStore)(nil).SetSystemSetting), ctx, key, value)
+}
+
+// SetSystemSettingIfAbsent mocks base method.
+func (m *MockStore) SetSystemSettingIfAbsent(ctx context.Context, key, value string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetSystemSettingIfAbsent", ctx, key, value)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetSystemSettingIfAbsent indicates an expected call of SetSystemSettingIfAbsent.
+func (mr *MockStoreMockRecorder) SetSystemSettingIfAbsent(ctx, key, value interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetSystemSetting", reflect.TypeOf((*MockStore)(nil).SetSystemSetting), key, value)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetSystemSettingIfAbsent", reflect.TypeOf((*MockStore)(nil).SetSystemSettingIfAbsent), ctx, key, value)
 }
 
 // Shutdown mocks base method.
@@ -1550,129 +2304,229 @@ func (mr *MockStoreMockRecorder) Shutdown() *gomock.Call {
 }
 
 // UndeleteBlock mocks base method.
-func (m *MockStore) UndeleteBlock(blockID, modifiedBy string) error {
+func (m *MockStore) UndeleteBlock(ctx context.Context, blockID, modifiedBy string) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "UndeleteBlock", blockID, modifiedBy)
+	ret := m.ctrl.Call(m, "UndeleteBlock", ctx, blockID, modifiedBy)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // UndeleteBlock indicates an expected call of UndeleteBlock.
-func (mr *MockStoreMockRecorder) UndeleteBlock(blockID, modifiedBy interface{}) *gomock.Call {
+func (mr *MockStoreMockRecorder) UndeleteBlock(ctx, blockID, modifiedBy interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UndeleteBlock", reflect.TypeOf((*MockStore)(nil).UndeleteBlock), blockID, modifiedBy)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UndeleteBlock", reflect.TypeOf((*MockStore)(nil).UndeleteBlock), ctx, blockID, modifiedBy)
 }
 
 // UndeleteBoard mocks base method.
-func (m *MockStore) UndeleteBoard(boardID, modifiedBy string) error {
+func (m *MockStore) UndeleteBoard(ctx context.Context, boardID, modifiedBy string) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "UndeleteBoard", boardID, modifiedBy)
+	ret := m.ctrl.Call(m, "UndeleteBoard", ctx, boardID, modifiedBy)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // UndeleteBoard indicates an expected call of UndeleteBoard.
-func (mr *MockStoreMockRecorder) UndeleteBoard(boardID, modifiedBy interface{}) *gomock.Call {
+func (mr *MockStoreMockRecorder) UndeleteBoard(ctx, boardID, modifiedBy interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UndeleteBoard", reflect.TypeOf((*MockStore)(nil).UndeleteBoard), boardID, modifiedBy)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UndeleteBoard", reflect.TypeOf((*MockStore)(nil).UndeleteBoard), ctx, boardID, modifiedBy)
 }
 
 // UpdateCardLimitTimestamp mocks base method.
-func (m *MockStore) UpdateCardLimitTimestamp(cardLimit int) (int64, error) {
+func (m *MockStore) UpdateCardLimitTimestamp(ctx context.Context, cardLimit int) (int64, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "UpdateCardLimitTimestamp", cardLimit)
+	ret := m.ctrl.Call(m, "UpdateCardLimitTimestamp", ctx, cardLimit)
 	ret0, _ := ret[0].(int64)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // UpdateCardLimitTimestamp indicates an expected call of UpdateCardLimitTimestamp.
-func (mr *MockStoreMockRecorder) UpdateCardLimitTimestamp(cardLimit interface{}) *gomock.Call {
+func (mr *MockStoreMockRecorder) UpdateCardLimitTimestamp(ctx, cardLimit interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateCardLimitTimestamp", reflect.TypeOf((*MockStore)(nil).UpdateCardLimitTimestamp), cardLimit)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateCardLimitTimestamp", reflect.TypeOf((*MockStore)(nil).UpdateCardLimitTimestamp), ctx, cardLimit)
 }
 
 // UpdateCategory mocks base method.
-func (m *MockStore) UpdateCategory(category model.Category) error {
+func (m *MockStore) UpdateCategory(ctx context.Context, category model.Category) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "UpdateCategory", category)
+	ret := m.ctrl.Call(m, "UpdateCategory", ctx, category)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // UpdateCategory indicates an expected call of UpdateCategory.
-func (mr *MockStoreMockRecorder) UpdateCategory(category interface{}) *gomock.Call {
+func (mr *MockStoreMockRecorder) UpdateCategory(ctx, category interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateCategory", reflect.TypeOf((*MockStore)(nil).UpdateCategory), ctx, category)
+}
+
+// UpdateFileInfoPaths mocks base method.
+func (m *MockStore) UpdateFileInfoPaths(ctx context.Context, fileInfo *model0.FileInfo) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateFileInfoPaths", ctx, fileInfo)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateFileInfoPaths indicates an expected call of UpdateFileInfoPaths.
+func (mr *MockStoreMockRecorder) UpdateFileInfoPaths(ctx, fileInfo interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateFileInfoPaths", reflect.TypeOf((*MockStore)(nil).UpdateFileInfoPaths), ctx, fileInfo)
+}
+
+// UpdateShareLinkLastUsed mocks base method.
+func (m *MockStore) UpdateShareLinkLastUsed(ctx context.Context, linkID string, lastUsedAt int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateShareLinkLastUsed", ctx, linkID, lastUsedAt)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateShareLinkLastUsed indicates an expected call of UpdateShareLinkLastUsed.
+func (mr *MockStoreMockRecorder) UpdateShareLinkLastUsed(ctx, linkID, lastUsedAt interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateCategory", reflect.TypeOf((*MockStore)(nil).UpdateCategory), category)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateShareLinkLastUsed", reflect.TypeOf((*MockStore)(nil).UpdateShareLinkLastUsed), ctx, linkID, lastUsedAt)
 }
 
 // UpdateSubscribersNotifiedAt mocks base method.
-func (m *MockStore) UpdateSubscribersNotifiedAt(blockID string, notifiedAt int64) error {
+func (m *MockStore) UpdateSubscribersNotifiedAt(ctx context.Context, blockID string, notifiedAt int64) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "UpdateSubscribersNotifiedAt", blockID, notifiedAt)
+	ret := m.ctrl.Call(m, "UpdateSubscribersNotifiedAt", ctx, blockID, notifiedAt)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // UpdateSubscribersNotifiedAt indicates an expected call of UpdateSubscribersNotifiedAt.
-func (mr *MockStoreMockRecorder) UpdateSubscribersNotifiedAt(blockID, notifiedAt interface{}) *gomock.Call {
+func (mr *MockStoreMockRecorder) UpdateSubscribersNotifiedAt(ctx, blockID, notifiedAt interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateSubscribersNotifiedAt", reflect.TypeOf((*MockStore)(nil).UpdateSubscribersNotifiedAt), blockID, notifiedAt)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateSubscribersNotifiedAt", reflect.TypeOf((*MockStore)(nil).UpdateSubscribersNotifiedAt), ctx, blockID, notifiedAt)
+}
+
+// UpsertBlockSuiteDoc mocks base method.
+func (m *MockStore) UpsertBlockSuiteDoc(ctx context.Context, doc *model.BlockSuiteDoc) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpsertBlockSuiteDoc", ctx, doc)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpsertBlockSuiteDoc indicates an expected call of UpsertBlockSuiteDoc.
+func (mr *MockStoreMockRecorder) UpsertBlockSuiteDoc(ctx, doc interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpsertBlockSuiteDoc", reflect.TypeOf((*MockStore)(nil).UpsertBlockSuiteDoc), ctx, doc)
+}
+
+// UpsertBoardForm mocks base method.
+func (m *MockStore) UpsertBoardForm(ctx context.Context, form *model.BoardForm) (*model.BoardForm, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpsertBoardForm", ctx, form)
+	ret0, _ := ret[0].(*model.BoardForm)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpsertBoardForm indicates an expected call of UpsertBoardForm.
+func (mr *MockStoreMockRecorder) UpsertBoardForm(ctx, form interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpsertBoardForm", reflect.TypeOf((*MockStore)(nil).UpsertBoardForm), ctx, form)
+}
+
+// UpsertFileScan mocks base method.
+func (m *MockStore) UpsertFileScan(ctx context.Context, scan *model.FileScan) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpsertFileScan", ctx, scan)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpsertFileScan indicates an expected call of UpsertFileScan.
+func (mr *MockStoreMockRecorder) UpsertFileScan(ctx, scan interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpsertFileScan", reflect.TypeOf((*MockStore)(nil).UpsertFileScan), ctx, scan)
 }
 
 // UpsertNotificationHint mocks base method.
-func (m *MockStore) UpsertNotificationHint(hint *model.NotificationHint, notificationFreq time.Duration) (*model.NotificationHint, error) {
+func (m *MockStore) UpsertNotificationHint(ctx context.Context, hint *model.NotificationHint, notificationFreq time.Duration) (*model.NotificationHint, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "UpsertNotificationHint", hint, notificationFreq)
+	ret := m.ctrl.Call(m, "UpsertNotificationHint", ctx, hint, notificationFreq)
 	ret0, _ := ret[0].(*model.NotificationHint)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // UpsertNotificationHint indicates an expected call of UpsertNotificationHint.
-func (mr *MockStoreMockRecorder) UpsertNotificationHint(hint, notificationFreq interface{}) *gomock.Call {
+func (mr *MockStoreMockRecorder) UpsertNotificationHint(ctx, hint, notificationFreq interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpsertNotificationHint", reflect.TypeOf((*MockStore)(nil).UpsertNotificationHint), hint, notificationFreq)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpsertNotificationHint", reflect.TypeOf((*MockStore)(nil).UpsertNotificationHint), ctx, hint, notificationFreq)
+}
+
+// UpsertNotificationPreferences mocks base method.
+func (m *MockStore) UpsertNotificationPreferences(ctx context.Context, prefs *model.NotificationPreferences) (*model.NotificationPreferences, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpsertNotificationPreferences", ctx, prefs)
+	ret0, _ := ret[0].(*model.NotificationPreferences)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpsertNotificationPreferences indicates an expected call of UpsertNotificationPreferences.
+func (mr *MockStoreMockRecorder) UpsertNotificationPreferences(ctx, prefs interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpsertNotificationPreferences", reflect.TypeOf((*MockStore)(nil).UpsertNotificationPreferences), ctx, prefs)
 }
 
 // UpsertSharing mocks base method.
-func (m *MockStore) UpsertSharing(sharing model.Sharing) error {
+func (m *MockStore) UpsertSharing(ctx context.Context, sharing model.Sharing) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "UpsertSharing", sharing)
+	ret := m.ctrl.Call(m, "UpsertSharing", ctx, sharing)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // UpsertSharing indicates an expected call of UpsertSharing.
-func (mr *MockStoreMockRecorder) UpsertSharing(sharing interface{}) *gomock.Call {
+func (mr *MockStoreMockRecorder) UpsertSharing(ctx, sharing interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpsertSharing", reflect.TypeOf((*MockStore)(nil).UpsertSharing), ctx, sharing)
+}
+
+// UpsertStorageQuota mocks base method.
+func (m *MockStore) UpsertStorageQuota(ctx context.Context, quota *model.StorageQuota) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpsertStorageQuota", ctx, quota)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpsertStorageQuota indicates an expected call of UpsertStorageQuota.
+func (mr *MockStoreMockRecorder) UpsertStorageQuota(ctx, quota interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpsertSharing", reflect.TypeOf((*MockStore)(nil).UpsertSharing), sharing)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpsertStorageQuota", reflect.TypeOf((*MockStore)(nil).UpsertStorageQuota), ctx, quota)
 }
 
 // UpsertTeamSettings mocks base method.
-func (m *MockStore) UpsertTeamSettings(team model.Team) error {
+func (m *MockStore) UpsertTeamSettings(ctx context.Context, team model.Team) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "UpsertTeamSettings", team)
+	ret := m.ctrl.Call(m, "UpsertTeamSettings", ctx, team)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // UpsertTeamSettings indicates an expected call of UpsertTeamSettings.
-func (mr *MockStoreMockRecorder) UpsertTeamSettings(team interface{}) *gomock.Call {
+func (mr *MockStoreMockRecorder) UpsertTeamSettings(ctx, team interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpsertTeamSettings", reflect.TypeOf((*MockStore)(nil).UpsertTeamSettings), team)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpsertTeamSettings", reflect.TypeOf((*MockStore)(nil).UpsertTeamSettings), ctx, team)
 }
 
 // UpsertTeamSignupToken mocks base method.
-func (m *MockStore) UpsertTeamSignupToken(team model.Team) error {
+func (m *MockStore) UpsertTeamSignupToken(ctx context.Context, team model.Team) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "UpsertTeamSignupToken", team)
+	ret := m.ctrl.Call(m, "UpsertTeamSignupToken", ctx, team)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // UpsertTeamSignupToken indicates an expected call of UpsertTeamSignupToken.
-func (mr *MockStoreMockRecorder) UpsertTeamSignupToken(team interface{}) *gomock.Call {
+func (mr *MockStoreMockRecorder) UpsertTeamSignupToken(ctx, team interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpsertTeamSignupToken", reflect.TypeOf((*MockStore)(nil).UpsertTeamSignupToken), team)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpsertTeamSignupToken", reflect.TypeOf((*MockStore)(nil).UpsertTeamSignupToken), ctx, team)
 }
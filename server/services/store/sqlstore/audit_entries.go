@@ -0,0 +1,187 @@
+// Copyright (c) 2020-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package sqlstore
+
+import (
+	"database/sql"
+	"encoding/json"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/mattermost/mattermost-plugin-boards/server/model"
+	"github.com/mattermost/mattermost-plugin-boards/server/utils"
+
+	"github.com/mattermost/mattermost/server/public/shared/mlog"
+)
+
+var auditEntryFields = []string{
+	"id",
+	"level",
+	"api_path",
+	"event",
+	"status",
+	"user_id",
+	"session_id",
+	"client",
+	"ip_address",
+	"team_id",
+	"board_id",
+	"card_id",
+	"meta",
+	"create_at",
+}
+
+func (s *SQLStore) auditEntriesFromRows(rows *sql.Rows) ([]*model.AuditEntry, error) {
+	results := []*model.AuditEntry{}
+
+	for rows.Next() {
+		var entry model.AuditEntry
+		var apiPath, userID, sessionID, client, ipAddress, teamID, boardID, cardID sql.NullString
+		var metaJSON []byte
+		err := rows.Scan(
+			&entry.ID,
+			&entry.Level,
+			&apiPath,
+			&entry.Event,
+			&entry.Status,
+			&userID,
+			&sessionID,
+			&client,
+			&ipAddress,
+			&teamID,
+			&boardID,
+			&cardID,
+			&metaJSON,
+			&entry.CreateAt,
+		)
+		if err != nil {
+			return nil, err
+		}
+		entry.APIPath = apiPath.String
+		entry.UserID = userID.String
+		entry.SessionID = sessionID.String
+		entry.Client = client.String
+		entry.IPAddress = ipAddress.String
+		entry.TeamID = teamID.String
+		entry.BoardID = boardID.String
+		entry.CardID = cardID.String
+		if len(metaJSON) > 0 {
+			if err := json.Unmarshal(metaJSON, &entry.Meta); err != nil {
+				return nil, err
+			}
+		}
+		results = append(results, &entry)
+	}
+	return results, nil
+}
+
+// insertAuditEntry persists an audit record. The id and creation time are set if missing.
+func (s *SQLStore) insertAuditEntry(db sq.BaseRunner, entry *model.AuditEntry) error {
+	if entry.ID == "" {
+		entry.ID = utils.NewID(utils.IDTypeNone)
+	}
+	if entry.CreateAt == 0 {
+		entry.CreateAt = utils.GetMillis()
+	}
+
+	metaJSON, err := json.Marshal(entry.Meta)
+	if err != nil {
+		return err
+	}
+
+	query := s.getQueryBuilder(db).
+		Insert(s.tablePrefix+"audit_entries").
+		Columns(auditEntryFields...).
+		Values(
+			entry.ID,
+			entry.Level,
+			entry.APIPath,
+			entry.Event,
+			entry.Status,
+			entry.UserID,
+			entry.SessionID,
+			entry.Client,
+			entry.IPAddress,
+			entry.TeamID,
+			entry.BoardID,
+			entry.CardID,
+			metaJSON,
+			entry.CreateAt,
+		)
+
+	if _, err := query.Exec(); err != nil {
+		s.logger.Error("Cannot insert audit entry",
+			mlog.String("event", entry.Event),
+			mlog.Err(err),
+		)
+		return err
+	}
+	return nil
+}
+
+// getAuditEntries fetches a page of the audit entries matching opts, newest first.
+func (s *SQLStore) getAuditEntries(db sq.BaseRunner, opts model.QueryAuditEntriesOptions) ([]*model.AuditEntry, bool, error) {
+	query := s.getQueryBuilder(db).
+		Select(auditEntryFields...).
+		From(s.tablePrefix+"audit_entries").
+		OrderBy("create_at DESC", "id DESC")
+
+	if opts.UserID != "" {
+		query = query.Where(sq.Eq{"user_id": opts.UserID})
+	}
+	if opts.BoardID != "" {
+		query = query.Where(sq.Eq{"board_id": opts.BoardID})
+	}
+	if opts.Event != "" {
+		query = query.Where(sq.Eq{"event": opts.Event})
+	}
+	if opts.Since != 0 {
+		query = query.Where(sq.GtOrEq{"create_at": opts.Since})
+	}
+	if opts.Until != 0 {
+		query = query.Where(sq.Lt{"create_at": opts.Until})
+	}
+
+	if opts.Page != 0 {
+		query = query.Offset(offset(opts.Page, opts.PerPage))
+	}
+
+	if opts.PerPage > 0 {
+		// N+1 to check if there's a next page for pagination
+		query = query.Limit(limit(opts.PerPage) + 1)
+	}
+
+	rows, err := query.Query()
+	if err != nil {
+		s.logger.Error("Cannot fetch audit entries", mlog.Err(err))
+		return nil, false, err
+	}
+	defer s.CloseRows(rows)
+
+	entries, err := s.auditEntriesFromRows(rows)
+	if err != nil {
+		return nil, false, err
+	}
+
+	var hasMore bool
+	if opts.PerPage > 0 && len(entries) > opts.PerPage {
+		entries = entries[0:opts.PerPage]
+		hasMore = true
+	}
+	return entries, hasMore, nil
+}
+
+// deleteAuditEntriesBefore removes the audit entries created before a time, and returns how many
+// were removed.
+func (s *SQLStore) deleteAuditEntriesBefore(db sq.BaseRunner, before int64) (int64, error) {
+	query := s.getQueryBuilder(db).
+		Delete(s.tablePrefix + "audit_entries").
+		Where(sq.Lt{"create_at": before})
+
+	result, err := query.Exec()
+	if err != nil {
+		s.logger.Error("Cannot delete audit entries", mlog.Int("before", before), mlog.Err(err))
+		return 0, err
+	}
+	return result.RowsAffected()
+}
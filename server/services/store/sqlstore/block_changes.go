@@ -0,0 +1,117 @@
+// Copyright (c) 2020-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package sqlstore
+
+import (
+	sq "github.com/Masterminds/squirrel"
+	"github.com/mattermost/mattermost-plugin-boards/server/model"
+
+	"github.com/mattermost/mattermost/server/public/shared/mlog"
+)
+
+// afterChangesCursor filters for the rows following the cursor of the options in the order of
+// the changes, which is by update time then by block ID.
+func afterChangesCursor(tableAlias string, opts model.QueryBlockChangesOptions) sq.Sqlizer {
+	return sq.Or{
+		sq.Gt{tableAlias + "update_at": opts.AfterUpdateAt},
+		sq.And{
+			sq.Eq{tableAlias + "update_at": opts.AfterUpdateAt},
+			sq.Gt{tableAlias + "id": opts.AfterID},
+		},
+	}
+}
+
+// getChangedBlocks returns the blocks of a board updated after the cursor of the options, in the
+// order of the changes.
+func (s *SQLStore) getChangedBlocks(db sq.BaseRunner, opts model.QueryBlockChangesOptions) ([]*model.Block, error) {
+	query := s.getQueryBuilder(db).
+		Select(s.blockFields("")...).
+		From(s.tablePrefix + "blocks").
+		Where(sq.Eq{"board_id": opts.BoardID}).
+		OrderBy("update_at, id")
+
+	if opts.AfterUpdateAt != 0 || opts.AfterID != "" {
+		query = query.Where(afterChangesCursor("", opts))
+	}
+
+	if opts.BeforeUpdateAt != 0 {
+		query = query.Where(sq.LtOrEq{"update_at": opts.BeforeUpdateAt})
+	}
+
+	if opts.Limit != 0 {
+		query = query.Limit(opts.Limit)
+	}
+
+	rows, err := query.Query()
+	if err != nil {
+		s.logger.Error(`getChangedBlocks ERROR`, mlog.Err(err))
+		return nil, err
+	}
+	defer s.CloseRows(rows)
+
+	return s.blocksFromRows(rows)
+}
+
+// getBlockTombstones returns the blocks of a board deleted after the cursor of the options, in the
+// order of the changes. A block is returned once, for its last deletion, and not at all if it was
+// restored since.
+func (s *SQLStore) getBlockTombstones(db sq.BaseRunner, opts model.QueryBlockChangesOptions) ([]*model.BlockTombstone, error) {
+	query := s.getQueryBuilder(db).
+		Select(
+			"bh.id",
+			"bh.parent_id",
+			"bh.board_id",
+			"bh.type",
+			"COALESCE(bh.modified_by, '')",
+			"bh.update_at",
+		).
+		From(s.tablePrefix + "blocks_history AS bh").
+		Where(sq.Eq{"bh.board_id": opts.BoardID}).
+		Where(sq.Gt{"bh.delete_at": 0}).
+		Where("NOT EXISTS (SELECT 1 FROM " + s.tablePrefix + "blocks_history AS bh2 WHERE bh2.id = bh.id AND bh2.update_at > bh.update_at)").
+		Where("NOT EXISTS (SELECT 1 FROM " + s.tablePrefix + "blocks AS b WHERE b.id = bh.id)").
+		OrderBy("bh.update_at, bh.id")
+
+	if opts.AfterUpdateAt != 0 || opts.AfterID != "" {
+		query = query.Where(afterChangesCursor("bh.", opts))
+	}
+
+	if opts.BeforeUpdateAt != 0 {
+		query = query.Where(sq.LtOrEq{"bh.update_at": opts.BeforeUpdateAt})
+	}
+
+	if opts.Limit != 0 {
+		query = query.Limit(opts.Limit)
+	}
+
+	rows, err := query.Query()
+	if err != nil {
+		s.logger.Error(`getBlockTombstones ERROR`, mlog.Err(err))
+		return nil, err
+	}
+	defer s.CloseRows(rows)
+
+	tombstones := []*model.BlockTombstone{}
+	seen := map[string]bool{}
+	for rows.Next() {
+		var tombstone model.BlockTombstone
+		if err := rows.Scan(
+			&tombstone.ID,
+			&tombstone.ParentID,
+			&tombstone.BoardID,
+			&tombstone.Type,
+			&tombstone.ModifiedBy,
+			&tombstone.DeleteAt,
+		); err != nil {
+			return nil, err
+		}
+		// a deletion may be recorded twice at the same time.
+		if seen[tombstone.ID] {
+			continue
+		}
+		seen[tombstone.ID] = true
+		tombstones = append(tombstones, &tombstone)
+	}
+	return tombstones, rows.Err()
+}
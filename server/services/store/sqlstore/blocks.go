@@ -73,12 +73,16 @@ func (s *SQLStore) getBlocks(db sq.BaseRunner, opts model.QueryBlocksOptions) ([
 		query = query.Where(sq.Eq{"type": opts.BlockType})
 	}
 
+	if opts.AfterID != "" {
+		query = query.Where(sq.Gt{"id": opts.AfterID})
+	}
+
 	if opts.Page != 0 {
 		query = query.Offset(offset(opts.Page, opts.PerPage))
 	}
 
 	if opts.PerPage > 0 {
-		query = query.Limit(limit(opts.PerPage))
+		query = query.OrderBy("id").Limit(limit(opts.PerPage))
 	}
 
 	rows, err := query.Query()
@@ -422,14 +426,8 @@ func (s *SQLStore) deleteBlockAndChildren(db sq.BaseRunner, blockID string, modi
 		}
 	}
 
-	if len(fileIDs) > 0 {
-		deleteFileInfoQuery := s.getQueryBuilder(db).
-			Update("FileInfo").
-			Set("DeleteAt", model.GetMillis()).
-			Where(sq.Eq{"id": fileIDs})
-		if _, err := deleteFileInfoQuery.Exec(); err != nil {
-			return err
-		}
+	if err := s.deleteFiles(db, block.BoardID, fileIDs); err != nil {
+		return err
 	}
 
 	deleteQuery := s.getQueryBuilder(db).
@@ -464,6 +462,16 @@ func (s *SQLStore) undeleteBlock(db sq.BaseRunner, blockID string, modifiedBy st
 		return nil // undeleting not deleted block is not considered an error (for now)
 	}
 
+	if err := s.reinsertBlock(db, block, modifiedBy); err != nil {
+		return err
+	}
+
+	return s.undeleteBlockChildren(db, block.BoardID, block.ID, modifiedBy)
+}
+
+// reinsertBlock inserts a version of a block taken from its history back into the blocks table,
+// recording the new version in history and restoring the file infos of its files.
+func (s *SQLStore) reinsertBlock(db sq.BaseRunner, block *model.Block, modifiedBy string) error {
 	fieldsJSON, err := json.Marshal(block.Fields)
 	if err != nil {
 		return err
@@ -532,13 +540,7 @@ func (s *SQLStore) undeleteBlock(db sq.BaseRunner, blockID string, modifiedBy st
 		}
 	}
 
-	if len(fileIDs) > 0 {
-		if err := s.restoreFiles(db, fileIDs); err != nil {
-			return err
-		}
-	}
-
-	return s.undeleteBlockChildren(db, block.BoardID, block.ID, modifiedBy)
+	return s.restoreFiles(db, block.BoardID, fileIDs)
 }
 
 func (s *SQLStore) getBlockCountsByType(db sq.BaseRunner) (map[string]int64, error) {
@@ -1004,15 +1006,8 @@ func (s *SQLStore) deleteBlockChildren(db sq.BaseRunner, boardID string, parentI
 		}
 	}
 
-	if len(fileIDs) > 0 {
-		deleteFileInfoQuery := s.getQueryBuilder(db).
-			Update("FileInfo").
-			Set("DeleteAt", model.GetMillis()).
-			Where(sq.Eq{"id": fileIDs})
-
-		if _, err := deleteFileInfoQuery.Exec(); err != nil {
-			return err
-		}
+	if err := s.deleteFiles(db, boardID, fileIDs); err != nil {
+		return err
 	}
 
 	deleteQuery := s.getQueryBuilder(db).
@@ -1197,7 +1192,7 @@ func (s *SQLStore) undeleteBlockChildren(db sq.BaseRunner, boardID string, paren
 	}
 
 	if len(fileIDs) > 0 {
-		if err := s.restoreFiles(db, fileIDs); err != nil {
+		if err := s.restoreFiles(db, boardID, fileIDs); err != nil {
 			return fmt.Errorf("undeleteBlockChildren unable to restore files: %w", err)
 		}
 		s.logger.Debug("undeleteBlockChildren - restored files", mlog.Int("file_count", len(fileIDs)))
@@ -4,6 +4,7 @@
 package sqlstore
 
 import (
+	"context"
 	"database/sql"
 	"fmt"
 
@@ -13,8 +14,11 @@ import (
 )
 
 // GetBlockSuiteDocByCardID retrieves a BlockSuite document by card_id.
-func (s *SQLStore) GetBlockSuiteDocByCardID(cardID string) (*model.BlockSuiteDoc, error) {
-	query := s.getQueryBuilder(s.db).
+func (s *SQLStore) GetBlockSuiteDocByCardID(ctx context.Context, cardID string) (*model.BlockSuiteDoc, error) {
+	runner := s.runner(ctx, s.db)
+	defer runner.close()
+
+	query := s.getQueryBuilder(runner).
 		Select(
 			"doc_id",
 			"card_id",
@@ -54,8 +58,11 @@ func (s *SQLStore) GetBlockSuiteDocByCardID(cardID string) (*model.BlockSuiteDoc
 }
 
 // GetBlockSuiteDocInfoByCardID retrieves metadata (without snapshot) by card_id.
-func (s *SQLStore) GetBlockSuiteDocInfoByCardID(cardID string) (*model.BlockSuiteDocInfo, error) {
-	query := s.getQueryBuilder(s.db).
+func (s *SQLStore) GetBlockSuiteDocInfoByCardID(ctx context.Context, cardID string) (*model.BlockSuiteDocInfo, error) {
+	runner := s.runner(ctx, s.db)
+	defer runner.close()
+
+	query := s.getQueryBuilder(runner).
 		Select(
 			"doc_id",
 			"card_id",
@@ -93,13 +100,16 @@ func (s *SQLStore) GetBlockSuiteDocInfoByCardID(cardID string) (*model.BlockSuit
 }
 
 // UpsertBlockSuiteDoc inserts or updates a BlockSuite document.
-func (s *SQLStore) UpsertBlockSuiteDoc(doc *model.BlockSuiteDoc) error {
+func (s *SQLStore) UpsertBlockSuiteDoc(ctx context.Context, doc *model.BlockSuiteDoc) error {
+	runner := s.runner(ctx, s.db)
+	defer runner.close()
+
 	if err := doc.IsValid(); err != nil {
 		return err
 	}
 
 	// Verify that the card exists
-	cardExistsQuery := s.getQueryBuilder(s.db).
+	cardExistsQuery := s.getQueryBuilder(runner).
 		Select("1").
 		From(s.tablePrefix + "blocks").
 		Where(sq.Eq{
@@ -122,7 +132,7 @@ func (s *SQLStore) UpsertBlockSuiteDoc(doc *model.BlockSuiteDoc) error {
 
 	// Build upsert query based on database type
 	var query sq.InsertBuilder
-	query = s.getQueryBuilder(s.db).
+	query = s.getQueryBuilder(runner).
 		Insert(s.tablePrefix + "blocksuite_docs").
 		Columns(
 			"doc_id",
@@ -187,8 +197,11 @@ func (s *SQLStore) UpsertBlockSuiteDoc(doc *model.BlockSuiteDoc) error {
 }
 
 // DeleteBlockSuiteDocByCardID deletes a BlockSuite document by card_id.
-func (s *SQLStore) DeleteBlockSuiteDocByCardID(cardID string) error {
-	query := s.getQueryBuilder(s.db).
+func (s *SQLStore) DeleteBlockSuiteDocByCardID(ctx context.Context, cardID string) error {
+	runner := s.runner(ctx, s.db)
+	defer runner.close()
+
+	query := s.getQueryBuilder(runner).
 		Delete(s.tablePrefix + "blocksuite_docs").
 		Where(sq.Eq{"card_id": cardID})
 
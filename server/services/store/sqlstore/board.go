@@ -579,7 +579,7 @@ func (s *SQLStore) getMemberForBoard(db sq.BaseRunner, boardID, userID string) (
 		}
 		var user *model.User
 		// No synthetic memberships for guests
-		user, err := s.GetUserByID(userID)
+		user, err := s.getUserByID(db, userID)
 		if err != nil {
 			return nil, err
 		}
@@ -587,7 +587,7 @@ func (s *SQLStore) getMemberForBoard(db sq.BaseRunner, boardID, userID string) (
 			return nil, model.NewErrNotFound("user is a guest")
 		}
 
-		b, boardErr := s.GetBoard(boardID)
+		b, boardErr := s.getBoard(db, boardID)
 		if boardErr != nil {
 			return nil, boardErr
 		}
@@ -687,7 +687,7 @@ func (s *SQLStore) getMembersForUser(db sq.BaseRunner, userID string) ([]*model.
 		return nil, err
 	}
 
-	user, err := s.GetUserByID(userID)
+	user, err := s.getUserByID(db, userID)
 	if err != nil {
 		return nil, err
 	}
@@ -0,0 +1,138 @@
+// Copyright (c) 2020-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package sqlstore
+
+import (
+	"database/sql"
+	"encoding/json"
+	"errors"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/mattermost/mattermost-plugin-boards/server/model"
+	"github.com/mattermost/mattermost-plugin-boards/server/utils"
+
+	"github.com/mattermost/mattermost/server/public/shared/mlog"
+)
+
+// upsertBoardForm creates or replaces the form of a board.
+func (s *SQLStore) upsertBoardForm(db sq.BaseRunner, form *model.BoardForm) (*model.BoardForm, error) {
+	formUpsert := *form
+	formUpsert.UpdateAt = utils.GetMillis()
+	if formUpsert.Fields == nil {
+		formUpsert.Fields = []model.BoardFormField{}
+	}
+
+	fieldsJSON, err := json.Marshal(formUpsert.Fields)
+	if err != nil {
+		return nil, err
+	}
+
+	query := s.getQueryBuilder(db).
+		Insert(s.tablePrefix+"board_forms").
+		Columns(
+			"board_id",
+			"token",
+			"enabled",
+			"title",
+			"description",
+			"title_label",
+			"fields",
+			"author_id",
+			"honeypot",
+			"modified_by",
+			"update_at",
+		).
+		Values(
+			formUpsert.BoardID,
+			formUpsert.Token,
+			formUpsert.Enabled,
+			formUpsert.Title,
+			formUpsert.Description,
+			formUpsert.TitleLabel,
+			fieldsJSON,
+			formUpsert.AuthorID,
+			formUpsert.Honeypot,
+			formUpsert.ModifiedBy,
+			formUpsert.UpdateAt,
+		)
+	if s.dbType == model.MysqlDBType {
+		query = query.Suffix("ON DUPLICATE KEY UPDATE token = ?, enabled = ?, title = ?, description = ?, title_label = ?, fields = ?, author_id = ?, honeypot = ?, modified_by = ?, update_at = ?",
+			formUpsert.Token, formUpsert.Enabled, formUpsert.Title, formUpsert.Description, formUpsert.TitleLabel,
+			fieldsJSON, formUpsert.AuthorID, formUpsert.Honeypot, formUpsert.ModifiedBy, formUpsert.UpdateAt)
+	} else {
+		query = query.Suffix(
+			`ON CONFLICT (board_id)
+			 DO UPDATE SET token = EXCLUDED.token, enabled = EXCLUDED.enabled, title = EXCLUDED.title,
+			 description = EXCLUDED.description, title_label = EXCLUDED.title_label, fields = EXCLUDED.fields,
+			 author_id = EXCLUDED.author_id, honeypot = EXCLUDED.honeypot, modified_by = EXCLUDED.modified_by,
+			 update_at = EXCLUDED.update_at`,
+		)
+	}
+
+	if _, err := query.Exec(); err != nil {
+		s.logger.Error("Cannot upsert board form",
+			mlog.String("board_id", form.BoardID),
+			mlog.Err(err),
+		)
+		return nil, err
+	}
+	return &formUpsert, nil
+}
+
+// getBoardForm fetches the form of a board.
+func (s *SQLStore) getBoardForm(db sq.BaseRunner, boardID string) (*model.BoardForm, error) {
+	query := s.getQueryBuilder(db).
+		Select(
+			"board_id",
+			"token",
+			"enabled",
+			"title",
+			"description",
+			"title_label",
+			"fields",
+			"author_id",
+			"honeypot",
+			"modified_by",
+			"update_at",
+		).
+		From(s.tablePrefix + "board_forms").
+		Where(sq.Eq{"board_id": boardID})
+
+	var form model.BoardForm
+	var title, description, titleLabel, authorID, modifiedBy sql.NullString
+	var fieldsJSON []byte
+	err := query.QueryRow().Scan(
+		&form.BoardID,
+		&form.Token,
+		&form.Enabled,
+		&title,
+		&description,
+		&titleLabel,
+		&fieldsJSON,
+		&authorID,
+		&form.Honeypot,
+		&modifiedBy,
+		&form.UpdateAt,
+	)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, model.NewErrNotFound("form for board ID=" + boardID)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	form.Title = title.String
+	form.Description = description.String
+	form.TitleLabel = titleLabel.String
+	form.AuthorID = authorID.String
+	form.ModifiedBy = modifiedBy.String
+
+	form.Fields = []model.BoardFormField{}
+	if len(fieldsJSON) > 0 {
+		if err := json.Unmarshal(fieldsJSON, &form.Fields); err != nil {
+			return nil, err
+		}
+	}
+	return &form, nil
+}
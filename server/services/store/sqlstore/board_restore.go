@@ -0,0 +1,128 @@
+// Copyright (c) 2020-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package sqlstore
+
+import (
+	"fmt"
+	"strconv"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/mattermost/mattermost-plugin-boards/server/model"
+
+	"github.com/mattermost/mattermost/server/public/shared/mlog"
+)
+
+// getBlocksAtTime returns the blocks of a board as they were at the given time, from the
+// blocks_history table. Blocks deleted at that time are not returned.
+func (s *SQLStore) getBlocksAtTime(db sq.BaseRunner, boardID string, at int64) ([]*model.Block, error) {
+	// as we're joining 2 queries, we need to avoid numbered
+	// placeholders until the join is done, so we use the default
+	// question mark placeholder here
+	builder := s.getQueryBuilder(db).PlaceholderFormat(sq.Question)
+
+	subQuery, subArgs, err := builder.
+		Select("bh2.id", "MAX(bh2.insert_at) AS max_insert_at").
+		From(s.tablePrefix + "blocks_history AS bh2").
+		Where(sq.Eq{"bh2.board_id": boardID}).
+		Where(sq.LtOrEq{"bh2.update_at": at}).
+		GroupBy("bh2.id").
+		ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("getBlocksAtTime unable to generate subquery: %w", err)
+	}
+
+	sql, args, err := builder.
+		Select(s.blockFields("bh")...).
+		From(s.tablePrefix+"blocks_history AS bh").
+		InnerJoin("("+subQuery+") AS sub ON bh.id=sub.id AND bh.insert_at=sub.max_insert_at", subArgs...).
+		Where(sq.Eq{"bh.delete_at": 0}).
+		ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("getBlocksAtTime unable to generate sql: %w", err)
+	}
+
+	if s.dbType == model.PostgresDBType || s.dbType == model.SqliteDBType {
+		sql, err = sq.Dollar.ReplacePlaceholders(sql)
+		if err != nil {
+			return nil, fmt.Errorf("getBlocksAtTime unable to replace sql placeholders: %w", err)
+		}
+	}
+
+	rows, err := db.Query(sql, args...)
+	if err != nil {
+		s.logger.Error(`getBlocksAtTime ERROR`, mlog.Err(err))
+		return nil, err
+	}
+	defer s.CloseRows(rows)
+
+	return s.blocksFromRows(rows)
+}
+
+func (s *SQLStore) getBoardRestorePlan(db sq.BaseRunner, boardID string, at int64) (*model.BoardRestorePlan, error) {
+	current, err := s.getBoard(db, boardID)
+	if err != nil {
+		return nil, err
+	}
+
+	boards, err := s.getBoardHistory(db, boardID, model.QueryBoardHistoryOptions{BeforeUpdateAt: at + 1, Limit: 1, Descending: true})
+	if err != nil {
+		return nil, err
+	}
+	if len(boards) == 0 || boards[0].DeleteAt != 0 {
+		return nil, model.NewErrNotFound("board ID=" + boardID + " at " + strconv.FormatInt(at, 10))
+	}
+
+	currentBlocks, err := s.getBlocksForBoard(db, boardID)
+	if err != nil {
+		return nil, err
+	}
+
+	restoredBlocks, err := s.getBlocksAtTime(db, boardID, at)
+	if err != nil {
+		return nil, err
+	}
+
+	return model.NewBoardRestorePlan(at, current, boards[0], currentBlocks, restoredBlocks), nil
+}
+
+// restoreBoardToTime restores the board and its blocks to how they were at the given time, and
+// returns the changes made.
+func (s *SQLStore) restoreBoardToTime(db sq.BaseRunner, boardID string, at int64, modifiedBy string) (*model.BoardRestorePlan, error) {
+	plan, err := s.getBoardRestorePlan(db, boardID, at)
+	if err != nil {
+		return nil, err
+	}
+
+	if plan.Board != nil {
+		board := *plan.Board.Current
+		board.Title = plan.Board.Restored.Title
+		board.Description = plan.Board.Restored.Description
+		board.Icon = plan.Board.Restored.Icon
+		board.ShowDescription = plan.Board.Restored.ShowDescription
+		board.Properties = plan.Board.Restored.Properties
+		board.CardProperties = plan.Board.Restored.CardProperties
+		if _, err := s.insertBoard(db, &board, modifiedBy); err != nil {
+			return nil, err
+		}
+	}
+
+	for _, change := range plan.Blocks {
+		switch change.Action {
+		case model.BlockRestoreRecreate:
+			err = s.reinsertBlock(db, change.Restored, modifiedBy)
+		case model.BlockRestoreRevert:
+			block := *change.Restored
+			block.DeleteAt = 0
+			err = s.insertBlock(db, &block, modifiedBy)
+		case model.BlockRestoreDelete:
+			// the children of the block are part of the plan themselves.
+			err = s.deleteBlockAndChildren(db, change.Current.ID, modifiedBy, true)
+		}
+		if err != nil {
+			return nil, fmt.Errorf("cannot restore block %s: %w", change.BlockID(), err)
+		}
+	}
+
+	return plan, nil
+}
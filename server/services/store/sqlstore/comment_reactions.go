@@ -0,0 +1,161 @@
+// Copyright (c) 2020-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package sqlstore
+
+import (
+	"database/sql"
+	"fmt"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/mattermost/mattermost-plugin-boards/server/model"
+
+	"github.com/mattermost/mattermost/server/public/shared/mlog"
+)
+
+var commentReactionFields = []string{
+	"block_id",
+	"board_id",
+	"user_id",
+	"emoji_name",
+	"create_at",
+	"delete_at",
+}
+
+func valuesForCommentReaction(reaction *model.CommentReaction) []interface{} {
+	return []interface{}{
+		reaction.BlockID,
+		reaction.BoardID,
+		reaction.UserID,
+		reaction.EmojiName,
+		reaction.CreateAt,
+		reaction.DeleteAt,
+	}
+}
+
+func (s *SQLStore) commentReactionsFromRows(rows *sql.Rows) ([]*model.CommentReaction, error) {
+	reactions := []*model.CommentReaction{}
+
+	for rows.Next() {
+		var reaction model.CommentReaction
+		err := rows.Scan(
+			&reaction.BlockID,
+			&reaction.BoardID,
+			&reaction.UserID,
+			&reaction.EmojiName,
+			&reaction.CreateAt,
+			&reaction.DeleteAt,
+		)
+		if err != nil {
+			return nil, err
+		}
+		reactions = append(reactions, &reaction)
+	}
+	return reactions, nil
+}
+
+// addCommentReaction adds a reaction to a comment, or restores a previously removed reaction
+// of the same user and emoji.
+func (s *SQLStore) addCommentReaction(db sq.BaseRunner, reaction *model.CommentReaction) (*model.CommentReaction, error) {
+	if err := reaction.IsValid(); err != nil {
+		return nil, err
+	}
+
+	now := model.GetMillis()
+
+	reactionAdd := *reaction
+	reactionAdd.CreateAt = now
+	reactionAdd.DeleteAt = 0
+
+	query := s.getQueryBuilder(db).
+		Insert(s.tablePrefix + "comment_reactions").
+		Columns(commentReactionFields...).
+		Values(valuesForCommentReaction(&reactionAdd)...)
+
+	if s.dbType == model.MysqlDBType {
+		query = query.Suffix("ON DUPLICATE KEY UPDATE delete_at = 0, create_at = ?", now)
+	} else {
+		query = query.Suffix("ON CONFLICT (block_id,user_id,emoji_name) DO UPDATE SET delete_at = 0, create_at = ?", now)
+	}
+
+	if _, err := query.Exec(); err != nil {
+		s.logger.Error("Cannot add comment reaction",
+			mlog.String("block_id", reaction.BlockID),
+			mlog.String("user_id", reaction.UserID),
+			mlog.Err(err),
+		)
+		return nil, err
+	}
+	return &reactionAdd, nil
+}
+
+// deleteCommentReaction soft deletes a user's reaction to a comment.
+func (s *SQLStore) deleteCommentReaction(db sq.BaseRunner, blockID, userID, emojiName string) error {
+	query := s.getQueryBuilder(db).
+		Update(s.tablePrefix+"comment_reactions").
+		Set("delete_at", model.GetMillis()).
+		Where(sq.Eq{"block_id": blockID}).
+		Where(sq.Eq{"user_id": userID}).
+		Where(sq.Eq{"emoji_name": emojiName}).
+		Where(sq.Eq{"delete_at": 0})
+
+	result, err := query.Exec()
+	if err != nil {
+		return err
+	}
+
+	count, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	if count == 0 {
+		message := fmt.Sprintf("comment reaction BlockID=%s UserID=%s EmojiName=%s", blockID, userID, emojiName)
+		return model.NewErrNotFound(message)
+	}
+	return nil
+}
+
+// getCommentReactions fetches the reactions to a comment, oldest first.
+func (s *SQLStore) getCommentReactions(db sq.BaseRunner, blockID string) ([]*model.CommentReaction, error) {
+	query := s.getQueryBuilder(db).
+		Select(commentReactionFields...).
+		From(s.tablePrefix+"comment_reactions").
+		Where(sq.Eq{"block_id": blockID}).
+		Where(sq.Eq{"delete_at": 0}).
+		OrderBy("create_at", "user_id", "emoji_name")
+
+	rows, err := query.Query()
+	if err != nil {
+		s.logger.Error("Cannot fetch comment reactions",
+			mlog.String("block_id", blockID),
+			mlog.Err(err),
+		)
+		return nil, err
+	}
+	defer s.CloseRows(rows)
+
+	return s.commentReactionsFromRows(rows)
+}
+
+// getCommentReactionsForBoard fetches the reactions to all comments of a board.
+func (s *SQLStore) getCommentReactionsForBoard(db sq.BaseRunner, boardID string) ([]*model.CommentReaction, error) {
+	query := s.getQueryBuilder(db).
+		Select(commentReactionFields...).
+		From(s.tablePrefix+"comment_reactions").
+		Where(sq.Eq{"board_id": boardID}).
+		Where(sq.Eq{"delete_at": 0}).
+		OrderBy("block_id", "create_at", "user_id", "emoji_name")
+
+	rows, err := query.Query()
+	if err != nil {
+		s.logger.Error("Cannot fetch comment reactions for board",
+			mlog.String("board_id", boardID),
+			mlog.Err(err),
+		)
+		return nil, err
+	}
+	defer s.CloseRows(rows)
+
+	return s.commentReactionsFromRows(rows)
+}
@@ -73,7 +73,7 @@ func (s *SQLStore) getBlocksWithSameID(db sq.BaseRunner) ([]*model.Block, error)
 }
 
 func (s *SQLStore) RunUniqueIDsMigration() error {
-	setting, err := s.GetSystemSetting(UniqueIDsMigrationKey)
+	setting, err := s.GetSystemSetting(context.Background(), UniqueIDsMigrationKey)
 	if err != nil {
 		return fmt.Errorf("cannot get migration state: %w", err)
 	}
@@ -140,7 +140,7 @@ func (s *SQLStore) RunUniqueIDsMigration() error {
 // because of the preexisting purpose of this migration, and has been
 // preserved for compatibility with already migrated instances.
 func (s *SQLStore) RunCategoryUUIDIDMigration() error {
-	setting, err := s.GetSystemSetting(CategoryUUIDIDMigrationKey)
+	setting, err := s.GetSystemSetting(context.Background(), CategoryUUIDIDMigrationKey)
 	if err != nil {
 		return fmt.Errorf("cannot get migration state: %w", err)
 	}
@@ -354,7 +354,7 @@ func (s *SQLStore) createCategoryBoards(db sq.BaseRunner) error {
 // group messages. This function migrates all boards
 // belonging to a DM to the best possible team.
 func (s *SQLStore) RunTeamLessBoardsMigration() error {
-	setting, err := s.GetSystemSetting(TeamLessBoardsMigrationKey)
+	setting, err := s.GetSystemSetting(context.Background(), TeamLessBoardsMigrationKey)
 	if err != nil {
 		return fmt.Errorf("cannot get teamless boards migration state: %w", err)
 	}
@@ -552,7 +552,7 @@ func (s *SQLStore) getBoardUserTeams(tx sq.BaseRunner, board *model.Board) (map[
 }
 
 func (s *SQLStore) RunDeletedMembershipBoardsMigration() error {
-	setting, err := s.GetSystemSetting(DeletedMembershipBoardsMigrationKey)
+	setting, err := s.GetSystemSetting(context.Background(), DeletedMembershipBoardsMigrationKey)
 	if err != nil {
 		return fmt.Errorf("cannot get deleted membership boards migration state: %w", err)
 	}
@@ -569,7 +569,7 @@ func (s *SQLStore) RunDeletedMembershipBoardsMigration() error {
 
 	if len(boards) == 0 {
 		s.logger.Debug("No boards with owner not anymore on their team found, marking runDeletedMembershipBoardsMigration as done")
-		if sErr := s.SetSystemSetting(DeletedMembershipBoardsMigrationKey, strconv.FormatBool(true)); sErr != nil {
+		if sErr := s.SetSystemSetting(context.Background(), DeletedMembershipBoardsMigrationKey, strconv.FormatBool(true)); sErr != nil {
 			return fmt.Errorf("cannot mark migration as completed: %w", sErr)
 		}
 		return nil
@@ -795,7 +795,7 @@ func (s *SQLStore) RunDeDuplicateCategoryBoardsMigration(currentMigration int) e
 		return nil
 	}
 
-	setting, err := s.GetSystemSetting(DeDuplicateCategoryBoardTableMigrationKey)
+	setting, err := s.GetSystemSetting(context.Background(), DeDuplicateCategoryBoardTableMigrationKey)
 	if err != nil {
 		return fmt.Errorf("cannot get DeDuplicateCategoryBoardTableMigration state: %w", err)
 	}
@@ -4,6 +4,7 @@
 package sqlstore
 
 import (
+	"context"
 	"testing"
 	"time"
 
@@ -127,9 +128,9 @@ func TestReplaceBlockID(t *testing.T) {
 	require.NoError(t, err)
 	newBlock7, err := sqlStore.getLegacyBlock(sqlStore.db, container2, block7.ID)
 	require.NoError(t, err)
-	newBlock8, err := sqlStore.GetBlock(block8.ID)
+	newBlock8, err := sqlStore.GetBlock(context.Background(), block8.ID)
 	require.NoError(t, err)
-	newBlock9, err := sqlStore.GetBlock(block9.ID)
+	newBlock9, err := sqlStore.GetBlock(context.Background(), block9.ID)
 	require.NoError(t, err)
 
 	require.Equal(t, newID, newBlock1.ID)
@@ -155,7 +156,7 @@ func TestRunUniqueIDsMigration(t *testing.T) {
 
 	// we need to mark the migration as not done so we can run it
 	// again with the test data
-	keyErr := sqlStore.SetSystemSetting(UniqueIDsMigrationKey, "false")
+	keyErr := sqlStore.SetSystemSetting(context.Background(), UniqueIDsMigrationKey, "false")
 	require.NoError(t, keyErr)
 
 	container1 := "1"
@@ -67,6 +67,16 @@ func (s *SQLStore) runDataRetention(db sq.BaseRunner, globalRetentionDate int64,
 			PrimaryKeys:   []string{"id"},
 			BoardIDColumn: "board_id",
 		},
+		{
+			Table:         "file_usage",
+			PrimaryKeys:   []string{"board_id"},
+			BoardIDColumn: "board_id",
+		},
+		{
+			Table:         "file_boards",
+			PrimaryKeys:   []string{"file_id"},
+			BoardIDColumn: "board_id",
+		},
 	}
 
 	subBuilder := s.getQueryBuilder(db).
@@ -0,0 +1,74 @@
+// Copyright (c) 2020-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package sqlstore
+
+import (
+	"encoding/json"
+	"strings"
+
+	sq "github.com/Masterminds/squirrel"
+
+	"github.com/mattermost/mattermost-plugin-boards/server/model"
+
+	"github.com/mattermost/mattermost/server/public/shared/mlog"
+)
+
+// getFileIDsReferencedByBlocks returns which of the files are referenced by an image or
+// attachment block of any board, live or in history. The blocks are read once whatever the
+// number of files checked.
+func (s *SQLStore) getFileIDsReferencedByBlocks(db sq.BaseRunner, fileIDs []string) (map[string]bool, error) {
+	referenced := map[string]bool{}
+	if len(fileIDs) == 0 {
+		return referenced, nil
+	}
+
+	candidates := make(map[string]bool, len(fileIDs))
+	for _, fileID := range fileIDs {
+		candidates[fileID] = true
+	}
+
+	for _, table := range []string{"blocks", "blocks_history"} {
+		query := s.getQueryBuilder(db).
+			Select("fields").
+			From(s.tablePrefix + table).
+			Where(sq.Eq{"type": []model.BlockType{model.TypeImage, model.TypeAttachment}})
+
+		rows, err := query.Query()
+		if err != nil {
+			return nil, err
+		}
+
+		for rows.Next() {
+			var fieldsJSON string
+			if err := rows.Scan(&fieldsJSON); err != nil {
+				s.CloseRows(rows)
+				return nil, err
+			}
+
+			block := &model.Block{}
+			if err := json.Unmarshal([]byte(fieldsJSON), &block.Fields); err != nil {
+				// the files a block may reference are kept if its fields cannot be read.
+				s.logger.Warn("Cannot read the fields of a block referencing files", mlog.String("table", table), mlog.Err(err))
+				for fileID := range candidates {
+					if strings.Contains(fieldsJSON, fileID) {
+						referenced[fileID] = true
+					}
+				}
+				continue
+			}
+
+			for _, fileID := range model.GetBlockFileIDs(block) {
+				if candidates[fileID] {
+					referenced[fileID] = true
+				}
+			}
+		}
+		err = rows.Err()
+		s.CloseRows(rows)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return referenced, nil
+}
@@ -0,0 +1,89 @@
+// Copyright (c) 2020-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package sqlstore
+
+import (
+	"database/sql"
+	"errors"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/mattermost/mattermost-plugin-boards/server/model"
+
+	"github.com/mattermost/mattermost/server/public/shared/mlog"
+)
+
+// upsertFileScan records the malware scan result of a file, replacing any previous result.
+func (s *SQLStore) upsertFileScan(db sq.BaseRunner, scan *model.FileScan) error {
+	query := s.getQueryBuilder(db).
+		Insert(s.tablePrefix+"file_scans").
+		Columns(
+			"file_id",
+			"status",
+			"signature",
+			"scanner",
+			"scanned_at",
+		).
+		Values(
+			scan.FileID,
+			scan.Status,
+			scan.Signature,
+			scan.Scanner,
+			scan.ScannedAt,
+		)
+	if s.dbType == model.MysqlDBType {
+		query = query.Suffix("ON DUPLICATE KEY UPDATE status = ?, signature = ?, scanner = ?, scanned_at = ?",
+			scan.Status, scan.Signature, scan.Scanner, scan.ScannedAt)
+	} else {
+		query = query.Suffix(
+			`ON CONFLICT (file_id)
+			 DO UPDATE SET status = EXCLUDED.status, signature = EXCLUDED.signature,
+			 scanner = EXCLUDED.scanner, scanned_at = EXCLUDED.scanned_at`,
+		)
+	}
+
+	if _, err := query.Exec(); err != nil {
+		s.logger.Error("Cannot upsert file scan",
+			mlog.String("file_id", scan.FileID),
+			mlog.Err(err),
+		)
+		return err
+	}
+	return nil
+}
+
+// getFileScan fetches the malware scan result of a file.
+func (s *SQLStore) getFileScan(db sq.BaseRunner, fileID string) (*model.FileScan, error) {
+	query := s.getQueryBuilder(db).
+		Select(
+			"file_id",
+			"status",
+			"signature",
+			"scanner",
+			"scanned_at",
+		).
+		From(s.tablePrefix + "file_scans").
+		Where(sq.Eq{"file_id": fileID})
+
+	var scan model.FileScan
+	var signature, scanner sql.NullString
+	var scannedAt sql.NullInt64
+	err := query.QueryRow().Scan(
+		&scan.FileID,
+		&scan.Status,
+		&signature,
+		&scanner,
+		&scannedAt,
+	)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, model.NewErrNotFound("scan for file ID=" + fileID)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	scan.Signature = signature.String
+	scan.Scanner = scanner.String
+	scan.ScannedAt = scannedAt.Int64
+	return &scan, nil
+}
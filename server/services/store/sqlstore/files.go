@@ -4,11 +4,13 @@
 package sqlstore
 
 import (
+	"database/sql"
 	"errors"
 	"net/http"
 
 	sq "github.com/Masterminds/squirrel"
 	"github.com/mattermost/mattermost-plugin-boards/server/model"
+	"github.com/mattermost/mattermost-plugin-boards/server/utils"
 	mmModel "github.com/mattermost/mattermost/server/public/model"
 	"github.com/mattermost/mattermost/server/public/shared/mlog"
 )
@@ -97,24 +99,224 @@ func (s *SQLStore) saveFileInfo(db sq.BaseRunner, fileInfo *mmModel.FileInfo) er
 	return nil
 }
 
-func (s *SQLStore) restoreFiles(db sq.BaseRunner, fileIDs []string) error {
+// deleteFiles soft deletes the file infos of a board, releasing the storage usage of those that
+// were not deleted yet.
+func (s *SQLStore) deleteFiles(db sq.BaseRunner, boardID string, fileIDs []string) error {
+	return s.setFilesDeleteAt(db, boardID, fileIDs, model.GetMillis())
+}
+
+// restoreFiles restores the soft deleted file infos of a board, charging their storage usage to
+// it again.
+func (s *SQLStore) restoreFiles(db sq.BaseRunner, boardID string, fileIDs []string) error {
+	if err := s.setFilesDeleteAt(db, boardID, fileIDs, 0); err != nil {
+		s.logger.Error(
+			"failed to restore files",
+			mlog.Int("file_count", len(fileIDs)),
+			mlog.Err(err),
+		)
+		return err
+	}
+	return nil
+}
+
+// setFilesDeleteAt soft deletes the file infos of a board, or restores them if deleteAt is zero,
+// and moves the storage usage of those changing state out of, or back into, the usage of the board.
+func (s *SQLStore) setFilesDeleteAt(db sq.BaseRunner, boardID string, fileIDs []string, deleteAt int64) error {
 	if len(fileIDs) == 0 {
 		return nil
 	}
 
+	changing := sq.And{sq.Eq{"Id": fileIDs}, sq.Eq{"DeleteAt": 0}}
+	if deleteAt == 0 {
+		changing = sq.And{sq.Eq{"Id": fileIDs}, sq.NotEq{"DeleteAt": 0}}
+	}
+
+	var bytes, fileCount int64
+	err := s.getQueryBuilder(db).
+		Select(
+			"COALESCE(SUM(Size), 0)",
+			"COUNT(*)",
+		).
+		From("FileInfo").
+		Where(changing).
+		QueryRow().
+		Scan(&bytes, &fileCount)
+	if err != nil {
+		return err
+	}
+	if fileCount == 0 {
+		return nil
+	}
+
 	query := s.getQueryBuilder(db).
 		Update("FileInfo").
-		Set("DeleteAt", 0).
-		Where(sq.Eq{"Id": fileIDs})
+		Set("DeleteAt", deleteAt).
+		Where(changing)
 
 	if _, err := query.Exec(); err != nil {
-		s.logger.Error(
-			"failed to restore files",
-			mlog.Int("file_count", len(fileIDs)),
-			mlog.Err(err),
-		)
 		return err
 	}
 
+	if deleteAt != 0 {
+		bytes, fileCount = -bytes, -fileCount
+	}
+	return s.addBoardFileUsage(db, boardID, bytes, fileCount)
+}
+
+// countFileInfosWithPath returns the number of file infos, deleted or not, referencing a stored
+// file.
+func (s *SQLStore) countFileInfosWithPath(db sq.BaseRunner, path string) (int64, error) {
+	query := s.getQueryBuilder(db).
+		Select("COUNT(*)").
+		From("FileInfo").
+		Where(sq.Eq{"Path": path})
+
+	var count int64
+	if err := query.QueryRow().Scan(&count); err != nil {
+		return 0, err
+	}
+	return count, nil
+}
+
+// getFileInfosWithPathPrefix returns the file infos created by boards before createdBefore whose
+// stored file is under the prefix.
+func (s *SQLStore) getFileInfosWithPathPrefix(db sq.BaseRunner, prefix string, createdBefore int64) ([]*mmModel.FileInfo, error) {
+	query := s.getQueryBuilder(db).
+		Select(
+			"Id",
+			"CreateAt",
+			"Path",
+			"ThumbnailPath",
+			"PreviewPath",
+			"Size",
+		).
+		From("FileInfo").
+		Where(sq.Eq{"CreatorId": "boards"}).
+		Where(sq.Like{"Path": prefix + "%"}).
+		Where(sq.Lt{"CreateAt": createdBefore}).
+		OrderBy("Path", "Id")
+
+	rows, err := query.Query()
+	if err != nil {
+		s.logger.Error("failed to get fileinfos by path", mlog.String("prefix", prefix), mlog.Err(err))
+		return nil, err
+	}
+	defer s.CloseRows(rows)
+
+	fileInfos := []*mmModel.FileInfo{}
+	for rows.Next() {
+		var fileInfo mmModel.FileInfo
+		var thumbnailPath, previewPath sql.NullString
+		if err := rows.Scan(
+			&fileInfo.Id,
+			&fileInfo.CreateAt,
+			&fileInfo.Path,
+			&thumbnailPath,
+			&previewPath,
+			&fileInfo.Size,
+		); err != nil {
+			return nil, err
+		}
+		fileInfo.ThumbnailPath = thumbnailPath.String
+		fileInfo.PreviewPath = previewPath.String
+		fileInfos = append(fileInfos, &fileInfo)
+	}
+	return fileInfos, rows.Err()
+}
+
+// deleteFileInfo permanently removes a file info. The stored file is left untouched.
+func (s *SQLStore) deleteFileInfo(db sq.BaseRunner, id string) error {
+	query := s.getQueryBuilder(db).
+		Delete("FileInfo").
+		Where(sq.Eq{"Id": id})
+
+	if _, err := query.Exec(); err != nil {
+		s.logger.Error("failed to delete fileinfo", mlog.String("id", id), mlog.Err(err))
+		return err
+	}
+
+	boardQuery := s.getQueryBuilder(db).
+		Delete(s.tablePrefix + "file_boards").
+		Where(sq.Eq{"file_id": id})
+
+	if _, err := boardQuery.Exec(); err != nil {
+		s.logger.Error("failed to delete board of fileinfo", mlog.String("id", id), mlog.Err(err))
+		return err
+	}
+	return nil
+}
+
+// updateFileInfoPaths updates the paths of a stored file and of its derivatives.
+func (s *SQLStore) updateFileInfoPaths(db sq.BaseRunner, fileInfo *mmModel.FileInfo) error {
+	query := s.getQueryBuilder(db).
+		Update("FileInfo").
+		Set("Path", fileInfo.Path).
+		Set("ThumbnailPath", fileInfo.ThumbnailPath).
+		Set("PreviewPath", fileInfo.PreviewPath).
+		Set("UpdateAt", utils.GetMillis()).
+		Where(sq.Eq{"Id": fileInfo.Id})
+
+	if _, err := query.Exec(); err != nil {
+		s.logger.Error("failed to update fileinfo paths", mlog.String("id", fileInfo.Id), mlog.Err(err))
+		return err
+	}
+	return nil
+}
+
+// saveFileBoard records the board the storage of a file is charged to, for the files whose path
+// does not name their board.
+func (s *SQLStore) saveFileBoard(db sq.BaseRunner, fileID, boardID string) error {
+	query := s.getQueryBuilder(db).
+		Insert(s.tablePrefix+"file_boards").
+		Columns(
+			"file_id",
+			"board_id",
+		).
+		Values(
+			fileID,
+			boardID,
+		)
+	if s.dbType == model.MysqlDBType {
+		query = query.Suffix("ON DUPLICATE KEY UPDATE board_id = ?", boardID)
+	} else {
+		query = query.Suffix("ON CONFLICT (file_id) DO UPDATE SET board_id = EXCLUDED.board_id")
+	}
+
+	if _, err := query.Exec(); err != nil {
+		s.logger.Error("failed to save board of fileinfo", mlog.String("id", fileID), mlog.Err(err))
+		return err
+	}
 	return nil
 }
+
+// getFileBoardIDs returns the boards recorded for the files, by file ID. Files without a
+// recorded board are left out.
+func (s *SQLStore) getFileBoardIDs(db sq.BaseRunner, fileIDs []string) (map[string]string, error) {
+	boardIDs := map[string]string{}
+	if len(fileIDs) == 0 {
+		return boardIDs, nil
+	}
+
+	query := s.getQueryBuilder(db).
+		Select(
+			"file_id",
+			"board_id",
+		).
+		From(s.tablePrefix + "file_boards").
+		Where(sq.Eq{"file_id": fileIDs})
+
+	rows, err := query.Query()
+	if err != nil {
+		return nil, err
+	}
+	defer s.CloseRows(rows)
+
+	for rows.Next() {
+		var fileID, boardID string
+		if err := rows.Scan(&fileID, &boardID); err != nil {
+			return nil, err
+		}
+		boardIDs[fileID] = boardID
+	}
+	return boardIDs, rows.Err()
+}
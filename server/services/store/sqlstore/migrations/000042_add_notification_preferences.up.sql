@@ -0,0 +1,16 @@
+CREATE TABLE IF NOT EXISTS {{.prefix}}notification_preferences (
+	user_id VARCHAR(36) NOT NULL,
+	board_id VARCHAR(36) NOT NULL,
+	muted BOOLEAN,
+	mentions_only BOOLEAN,
+	assignment_only BOOLEAN,
+	notify_properties BOOLEAN,
+	notify_comments BOOLEAN,
+	notify_attachments BOOLEAN,
+	notify_content BOOLEAN,
+	update_at BIGINT,
+	PRIMARY KEY (user_id, board_id)
+) {{if .mysql}}DEFAULT CHARACTER SET utf8mb4{{end}};
+
+{{- /* createIndexIfNeeded tableName columns */ -}}
+{{ createIndexIfNeeded "notification_preferences" "board_id" }}
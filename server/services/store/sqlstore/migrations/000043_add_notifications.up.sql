@@ -0,0 +1,19 @@
+CREATE TABLE IF NOT EXISTS {{.prefix}}notifications (
+	id VARCHAR(36) NOT NULL,
+	user_id VARCHAR(36) NOT NULL,
+	type VARCHAR(32) NOT NULL,
+	team_id VARCHAR(36),
+	board_id VARCHAR(36) NOT NULL,
+	card_id VARCHAR(36),
+	block_id VARCHAR(36),
+	actor_id VARCHAR(36),
+	title TEXT,
+	message TEXT,
+	create_at BIGINT,
+	read_at BIGINT,
+	PRIMARY KEY (id)
+) {{if .mysql}}DEFAULT CHARACTER SET utf8mb4{{end}};
+
+{{- /* createIndexIfNeeded tableName columns */ -}}
+{{ createIndexIfNeeded "notifications" "user_id, create_at" }}
+{{ createIndexIfNeeded "notifications" "user_id, read_at" }}
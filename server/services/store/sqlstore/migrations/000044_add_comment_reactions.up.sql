@@ -0,0 +1,12 @@
+CREATE TABLE IF NOT EXISTS {{.prefix}}comment_reactions (
+	block_id VARCHAR(36) NOT NULL,
+	board_id VARCHAR(36) NOT NULL,
+	user_id VARCHAR(36) NOT NULL,
+	emoji_name VARCHAR(64) NOT NULL,
+	create_at BIGINT,
+	delete_at BIGINT,
+	PRIMARY KEY (block_id, user_id, emoji_name)
+) {{if .mysql}}DEFAULT CHARACTER SET utf8mb4{{end}};
+
+{{- /* createIndexIfNeeded tableName columns */ -}}
+{{ createIndexIfNeeded "comment_reactions" "board_id" }}
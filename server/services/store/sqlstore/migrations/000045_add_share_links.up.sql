@@ -0,0 +1,20 @@
+CREATE TABLE IF NOT EXISTS {{.prefix}}share_links (
+	id VARCHAR(36) NOT NULL,
+	board_id VARCHAR(36) NOT NULL,
+	name VARCHAR(100),
+	token VARCHAR(100) NOT NULL,
+	scope VARCHAR(16) NOT NULL,
+	scope_id VARCHAR(36),
+	permission VARCHAR(16) NOT NULL,
+	password_hash VARCHAR(100),
+	expires_at BIGINT,
+	created_by VARCHAR(36),
+	create_at BIGINT,
+	last_used_at BIGINT,
+	revoked_at BIGINT,
+	PRIMARY KEY (id)
+) {{if .mysql}}DEFAULT CHARACTER SET utf8mb4{{end}};
+
+{{- /* createIndexIfNeeded tableName columns */ -}}
+{{ createIndexIfNeeded "share_links" "board_id" }}
+{{ createIndexIfNeeded "share_links" "token" }}
@@ -0,0 +1,14 @@
+CREATE TABLE IF NOT EXISTS {{.prefix}}board_forms (
+	board_id VARCHAR(36) NOT NULL,
+	token VARCHAR(100) NOT NULL,
+	enabled BOOLEAN,
+	title VARCHAR(255),
+	description TEXT,
+	title_label VARCHAR(255),
+	fields {{if .postgres}}JSON{{else}}TEXT{{end}},
+	author_id VARCHAR(36),
+	honeypot BOOLEAN,
+	modified_by VARCHAR(36),
+	update_at BIGINT,
+	PRIMARY KEY (board_id)
+) {{if .mysql}}DEFAULT CHARACTER SET utf8mb4{{end}};
@@ -0,0 +1,8 @@
+CREATE TABLE IF NOT EXISTS {{.prefix}}file_scans (
+	file_id VARCHAR(36) NOT NULL,
+	status VARCHAR(16) NOT NULL,
+	signature VARCHAR(255),
+	scanner VARCHAR(50),
+	scanned_at BIGINT,
+	PRIMARY KEY (file_id)
+) {{if .mysql}}DEFAULT CHARACTER SET utf8mb4{{end}};
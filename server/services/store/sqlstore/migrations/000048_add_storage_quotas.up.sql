@@ -0,0 +1,31 @@
+CREATE TABLE IF NOT EXISTS {{.prefix}}file_usage (
+	board_id VARCHAR(36) NOT NULL,
+	team_id VARCHAR(36) NOT NULL,
+	bytes BIGINT NOT NULL DEFAULT 0,
+	file_count BIGINT NOT NULL DEFAULT 0,
+	update_at BIGINT NOT NULL,
+	PRIMARY KEY (board_id)
+) {{if .mysql}}DEFAULT CHARACTER SET utf8mb4{{end}};
+
+{{- /* createIndexIfNeeded tableName columns */ -}}
+{{ createIndexIfNeeded "file_usage" "team_id" }}
+
+CREATE TABLE IF NOT EXISTS {{.prefix}}storage_quotas (
+	scope VARCHAR(16) NOT NULL,
+	scope_id VARCHAR(36) NOT NULL,
+	max_bytes BIGINT NOT NULL,
+	modified_by VARCHAR(36),
+	update_at BIGINT NOT NULL,
+	PRIMARY KEY (scope, scope_id)
+) {{if .mysql}}DEFAULT CHARACTER SET utf8mb4{{end}};
+
+{{- /* the usage of the files stored so far is charged to their boards, which their paths name */ -}}
+{{if .plugin}}
+INSERT INTO {{.prefix}}file_usage (board_id, team_id, bytes, file_count, update_at)
+SELECT SUBSTR(Path, 28, 27), MIN(SUBSTR(Path, 1, 26)), SUM(Size), COUNT(*), MAX(UpdateAt)
+  FROM FileInfo
+ WHERE CreatorId = 'boards'
+   AND DeleteAt = 0
+   AND Path LIKE '__________________________/___________________________/%'
+ GROUP BY SUBSTR(Path, 28, 27);
+{{end}}
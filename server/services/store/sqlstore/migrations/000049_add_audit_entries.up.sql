@@ -0,0 +1,22 @@
+CREATE TABLE IF NOT EXISTS {{.prefix}}audit_entries (
+	id VARCHAR(36) NOT NULL,
+	level VARCHAR(16) NOT NULL,
+	api_path TEXT,
+	event VARCHAR(100) NOT NULL,
+	status VARCHAR(16) NOT NULL,
+	user_id VARCHAR(36),
+	session_id VARCHAR(36),
+	client TEXT,
+	ip_address VARCHAR(64),
+	team_id VARCHAR(36),
+	board_id VARCHAR(36),
+	card_id VARCHAR(36),
+	meta {{if .postgres}}JSON{{else}}TEXT{{end}},
+	create_at BIGINT NOT NULL,
+	PRIMARY KEY (id)
+) {{if .mysql}}DEFAULT CHARACTER SET utf8mb4{{end}};
+
+{{- /* createIndexIfNeeded tableName columns */ -}}
+{{ createIndexIfNeeded "audit_entries" "create_at" }}
+{{ createIndexIfNeeded "audit_entries" "user_id, create_at" }}
+{{ createIndexIfNeeded "audit_entries" "board_id, create_at" }}
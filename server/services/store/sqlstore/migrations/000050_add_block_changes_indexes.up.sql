@@ -0,0 +1,3 @@
+{{- /* the changes of a board are read in update order, from the blocks and their deletions in history */ -}}
+{{ createIndexIfNeeded "blocks" "board_id, update_at" }}
+{{ createIndexIfNeeded "blocks_history" "board_id, delete_at, update_at" }}
@@ -0,0 +1,8 @@
+CREATE TABLE IF NOT EXISTS {{.prefix}}file_boards (
+	file_id VARCHAR(36) NOT NULL,
+	board_id VARCHAR(36) NOT NULL,
+	PRIMARY KEY (file_id)
+) {{if .mysql}}DEFAULT CHARACTER SET utf8mb4{{end}};
+
+{{- /* createIndexIfNeeded tableName columns */ -}}
+{{ createIndexIfNeeded "file_boards" "board_id" }}
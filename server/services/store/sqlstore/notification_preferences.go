@@ -0,0 +1,178 @@
+// Copyright (c) 2020-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package sqlstore
+
+import (
+	"database/sql"
+	"fmt"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/mattermost/mattermost-plugin-boards/server/model"
+	"github.com/mattermost/mattermost-plugin-boards/server/utils"
+
+	"github.com/mattermost/mattermost/server/public/shared/mlog"
+)
+
+var notificationPreferencesFields = []string{
+	"user_id",
+	"board_id",
+	"muted",
+	"mentions_only",
+	"assignment_only",
+	"notify_properties",
+	"notify_comments",
+	"notify_attachments",
+	"notify_content",
+	"update_at",
+}
+
+func valuesForNotificationPreferences(prefs *model.NotificationPreferences) []interface{} {
+	return []interface{}{
+		prefs.UserID,
+		prefs.BoardID,
+		prefs.Muted,
+		prefs.MentionsOnly,
+		prefs.AssignmentOnly,
+		prefs.Properties,
+		prefs.Comments,
+		prefs.Attachments,
+		prefs.Content,
+		prefs.UpdateAt,
+	}
+}
+
+func (s *SQLStore) notificationPreferencesFromRows(rows *sql.Rows) ([]*model.NotificationPreferences, error) {
+	results := []*model.NotificationPreferences{}
+
+	for rows.Next() {
+		var prefs model.NotificationPreferences
+		err := rows.Scan(
+			&prefs.UserID,
+			&prefs.BoardID,
+			&prefs.Muted,
+			&prefs.MentionsOnly,
+			&prefs.AssignmentOnly,
+			&prefs.Properties,
+			&prefs.Comments,
+			&prefs.Attachments,
+			&prefs.Content,
+			&prefs.UpdateAt,
+		)
+		if err != nil {
+			return nil, err
+		}
+		results = append(results, &prefs)
+	}
+	return results, nil
+}
+
+// upsertNotificationPreferences creates or replaces the notification preferences a user has for a board.
+func (s *SQLStore) upsertNotificationPreferences(db sq.BaseRunner, prefs *model.NotificationPreferences) (*model.NotificationPreferences, error) {
+	if err := prefs.IsValid(); err != nil {
+		return nil, err
+	}
+
+	prefsNew := *prefs
+	prefsNew.UpdateAt = utils.GetMillis()
+
+	query := s.getQueryBuilder(db).
+		Insert(s.tablePrefix + "notification_preferences").
+		Columns(notificationPreferencesFields...).
+		Values(valuesForNotificationPreferences(&prefsNew)...)
+
+	if s.dbType == model.MysqlDBType {
+		query = query.Suffix(
+			`ON DUPLICATE KEY UPDATE muted = ?, mentions_only = ?, assignment_only = ?, notify_properties = ?,
+			   notify_comments = ?, notify_attachments = ?, notify_content = ?, update_at = ?`,
+			prefsNew.Muted, prefsNew.MentionsOnly, prefsNew.AssignmentOnly, prefsNew.Properties,
+			prefsNew.Comments, prefsNew.Attachments, prefsNew.Content, prefsNew.UpdateAt,
+		)
+	} else {
+		query = query.Suffix(
+			`ON CONFLICT (user_id, board_id)
+			 DO UPDATE SET muted = EXCLUDED.muted, mentions_only = EXCLUDED.mentions_only,
+			   assignment_only = EXCLUDED.assignment_only, notify_properties = EXCLUDED.notify_properties,
+			   notify_comments = EXCLUDED.notify_comments, notify_attachments = EXCLUDED.notify_attachments,
+			   notify_content = EXCLUDED.notify_content, update_at = EXCLUDED.update_at`,
+		)
+	}
+
+	if _, err := query.Exec(); err != nil {
+		s.logger.Error("Cannot upsert notification preferences",
+			mlog.String("user_id", prefs.UserID),
+			mlog.String("board_id", prefs.BoardID),
+			mlog.Err(err),
+		)
+		return nil, err
+	}
+	return &prefsNew, nil
+}
+
+// getNotificationPreferences fetches the notification preferences a user has for a board.
+func (s *SQLStore) getNotificationPreferences(db sq.BaseRunner, userID, boardID string) (*model.NotificationPreferences, error) {
+	query := s.getQueryBuilder(db).
+		Select(notificationPreferencesFields...).
+		From(s.tablePrefix + "notification_preferences").
+		Where(sq.Eq{"user_id": userID}).
+		Where(sq.Eq{"board_id": boardID})
+
+	rows, err := query.Query()
+	if err != nil {
+		s.logger.Error("Cannot fetch notification preferences",
+			mlog.String("user_id", userID),
+			mlog.String("board_id", boardID),
+			mlog.Err(err),
+		)
+		return nil, err
+	}
+	defer s.CloseRows(rows)
+
+	results, err := s.notificationPreferencesFromRows(rows)
+	if err != nil {
+		return nil, err
+	}
+	if len(results) == 0 {
+		message := fmt.Sprintf("notification preferences UserID=%s BoardID=%s", userID, boardID)
+		return nil, model.NewErrNotFound(message)
+	}
+	return results[0], nil
+}
+
+// getNotificationPreferencesForBoard fetches the notification preferences saved by all users of a board.
+func (s *SQLStore) getNotificationPreferencesForBoard(db sq.BaseRunner, boardID string) ([]*model.NotificationPreferences, error) {
+	query := s.getQueryBuilder(db).
+		Select(notificationPreferencesFields...).
+		From(s.tablePrefix + "notification_preferences").
+		Where(sq.Eq{"board_id": boardID})
+
+	rows, err := query.Query()
+	if err != nil {
+		s.logger.Error("Cannot fetch notification preferences for board",
+			mlog.String("board_id", boardID),
+			mlog.Err(err),
+		)
+		return nil, err
+	}
+	defer s.CloseRows(rows)
+
+	return s.notificationPreferencesFromRows(rows)
+}
+
+// deleteNotificationPreferences removes the preferences a user has for a board, reverting to defaults.
+func (s *SQLStore) deleteNotificationPreferences(db sq.BaseRunner, userID, boardID string) error {
+	query := s.getQueryBuilder(db).
+		Delete(s.tablePrefix + "notification_preferences").
+		Where(sq.Eq{"user_id": userID}).
+		Where(sq.Eq{"board_id": boardID})
+
+	if _, err := query.Exec(); err != nil {
+		s.logger.Error("Cannot delete notification preferences",
+			mlog.String("user_id", userID),
+			mlog.String("board_id", boardID),
+			mlog.Err(err),
+		)
+		return err
+	}
+	return nil
+}
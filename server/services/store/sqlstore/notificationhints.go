@@ -192,3 +192,17 @@ func (s *SQLStore) getNextNotificationHint(db sq.BaseRunner, remove bool) (*mode
 
 	return hint, nil
 }
+
+// getNotificationHintCount returns the number of notification hints waiting to be processed.
+func (s *SQLStore) getNotificationHintCount(db sq.BaseRunner) (int, error) {
+	query := s.getQueryBuilder(db).
+		Select("COUNT(*)").
+		From(s.tablePrefix + "notification_hints")
+
+	var count int
+	if err := query.QueryRow().Scan(&count); err != nil {
+		s.logger.Error("Cannot count notification hints", mlog.Err(err))
+		return 0, err
+	}
+	return count, nil
+}
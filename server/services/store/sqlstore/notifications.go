@@ -0,0 +1,232 @@
+// Copyright (c) 2020-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package sqlstore
+
+import (
+	"database/sql"
+	"fmt"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/mattermost/mattermost-plugin-boards/server/model"
+	"github.com/mattermost/mattermost-plugin-boards/server/utils"
+
+	"github.com/mattermost/mattermost/server/public/shared/mlog"
+)
+
+var notificationFields = []string{
+	"id",
+	"user_id",
+	"type",
+	"team_id",
+	"board_id",
+	"card_id",
+	"block_id",
+	"actor_id",
+	"title",
+	"message",
+	"create_at",
+	"read_at",
+}
+
+func valuesForNotification(n *model.Notification) []interface{} {
+	return []interface{}{
+		n.ID,
+		n.UserID,
+		n.Type,
+		n.TeamID,
+		n.BoardID,
+		n.CardID,
+		n.BlockID,
+		n.ActorID,
+		n.Title,
+		n.Message,
+		n.CreateAt,
+		n.ReadAt,
+	}
+}
+
+func (s *SQLStore) notificationsFromRows(rows *sql.Rows) ([]*model.Notification, error) {
+	results := []*model.Notification{}
+
+	for rows.Next() {
+		var n model.Notification
+		var teamID, cardID, blockID, actorID, title, message sql.NullString
+		err := rows.Scan(
+			&n.ID,
+			&n.UserID,
+			&n.Type,
+			&teamID,
+			&n.BoardID,
+			&cardID,
+			&blockID,
+			&actorID,
+			&title,
+			&message,
+			&n.CreateAt,
+			&n.ReadAt,
+		)
+		if err != nil {
+			return nil, err
+		}
+		n.TeamID = teamID.String
+		n.CardID = cardID.String
+		n.BlockID = blockID.String
+		n.ActorID = actorID.String
+		n.Title = title.String
+		n.Message = message.String
+		results = append(results, &n)
+	}
+	return results, nil
+}
+
+// createNotification adds a notification to a user's inbox.
+func (s *SQLStore) createNotification(db sq.BaseRunner, notification *model.Notification) (*model.Notification, error) {
+	if err := notification.IsValid(); err != nil {
+		return nil, err
+	}
+
+	notificationAdd := *notification
+	notificationAdd.ID = utils.NewID(utils.IDTypeNone)
+	notificationAdd.CreateAt = utils.GetMillis()
+	notificationAdd.ReadAt = 0
+
+	query := s.getQueryBuilder(db).
+		Insert(s.tablePrefix + "notifications").
+		Columns(notificationFields...).
+		Values(valuesForNotification(&notificationAdd)...)
+
+	if _, err := query.Exec(); err != nil {
+		s.logger.Error("Cannot create notification",
+			mlog.String("user_id", notification.UserID),
+			mlog.String("board_id", notification.BoardID),
+			mlog.Err(err),
+		)
+		return nil, err
+	}
+	return &notificationAdd, nil
+}
+
+// getNotificationsForUser fetches a page of a user's notifications, newest first.
+func (s *SQLStore) getNotificationsForUser(db sq.BaseRunner, userID string, opts model.QueryNotificationsOptions) ([]*model.Notification, error) {
+	query := s.getQueryBuilder(db).
+		Select(notificationFields...).
+		From(s.tablePrefix+"notifications").
+		Where(sq.Eq{"user_id": userID}).
+		OrderBy("create_at DESC", "id DESC")
+
+	if opts.UnreadOnly {
+		query = query.Where(sq.Eq{"read_at": 0})
+	}
+
+	if opts.PerPage > 0 {
+		query = query.Limit(uint64(opts.PerPage))
+		if opts.Page > 0 {
+			query = query.Offset(uint64(opts.Page * opts.PerPage))
+		}
+	}
+
+	rows, err := query.Query()
+	if err != nil {
+		s.logger.Error("Cannot fetch notifications for user",
+			mlog.String("user_id", userID),
+			mlog.Err(err),
+		)
+		return nil, err
+	}
+	defer s.CloseRows(rows)
+
+	return s.notificationsFromRows(rows)
+}
+
+// getNotification fetches a single notification of a user.
+func (s *SQLStore) getNotification(db sq.BaseRunner, userID, notificationID string) (*model.Notification, error) {
+	query := s.getQueryBuilder(db).
+		Select(notificationFields...).
+		From(s.tablePrefix + "notifications").
+		Where(sq.Eq{"id": notificationID}).
+		Where(sq.Eq{"user_id": userID})
+
+	rows, err := query.Query()
+	if err != nil {
+		s.logger.Error("Cannot fetch notification",
+			mlog.String("notification_id", notificationID),
+			mlog.Err(err),
+		)
+		return nil, err
+	}
+	defer s.CloseRows(rows)
+
+	results, err := s.notificationsFromRows(rows)
+	if err != nil {
+		return nil, err
+	}
+	if len(results) == 0 {
+		return nil, model.NewErrNotFound("notification ID=" + notificationID)
+	}
+	return results[0], nil
+}
+
+// getUnreadNotificationCount returns the number of unread notifications a user has.
+func (s *SQLStore) getUnreadNotificationCount(db sq.BaseRunner, userID string) (int64, error) {
+	query := s.getQueryBuilder(db).
+		Select("COUNT(*)").
+		From(s.tablePrefix + "notifications").
+		Where(sq.Eq{"user_id": userID}).
+		Where(sq.Eq{"read_at": 0})
+
+	var count int64
+	if err := query.QueryRow().Scan(&count); err != nil {
+		s.logger.Error("Cannot count unread notifications",
+			mlog.String("user_id", userID),
+			mlog.Err(err),
+		)
+		return 0, err
+	}
+	return count, nil
+}
+
+// markNotificationRead marks a notification of a user as read.
+func (s *SQLStore) markNotificationRead(db sq.BaseRunner, userID, notificationID string) error {
+	query := s.getQueryBuilder(db).
+		Update(s.tablePrefix+"notifications").
+		Set("read_at", utils.GetMillis()).
+		Where(sq.Eq{"id": notificationID}).
+		Where(sq.Eq{"user_id": userID}).
+		Where(sq.Eq{"read_at": 0})
+
+	result, err := query.Exec()
+	if err != nil {
+		return err
+	}
+
+	count, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if count == 0 {
+		// either the notification does not exist for the user, or it was already read.
+		if _, err := s.getNotification(db, userID, notificationID); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// markAllNotificationsRead marks every unread notification of a user as read.
+func (s *SQLStore) markAllNotificationsRead(db sq.BaseRunner, userID string) error {
+	query := s.getQueryBuilder(db).
+		Update(s.tablePrefix+"notifications").
+		Set("read_at", utils.GetMillis()).
+		Where(sq.Eq{"user_id": userID}).
+		Where(sq.Eq{"read_at": 0})
+
+	if _, err := query.Exec(); err != nil {
+		s.logger.Error("Cannot mark notifications read",
+			mlog.String("user_id", userID),
+			mlog.Err(err),
+		)
+		return fmt.Errorf("cannot mark notifications read for user %s: %w", userID, err)
+	}
+	return nil
+}
@@ -6,6 +6,7 @@ package sqlstore
 import (
 	"database/sql"
 	"fmt"
+	"time"
 
 	mmModel "github.com/mattermost/mattermost/server/public/model"
 
@@ -44,6 +45,7 @@ type Params struct {
 	ServicesAPI      servicesAPI
 	SkipMigrations   bool
 	ConfigFn         func() *mmModel.Config
+	QueryTimeout     time.Duration
 }
 
 func (p Params) CheckValid() error {
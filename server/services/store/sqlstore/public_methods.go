@@ -7,8 +7,8 @@
 // To add a public method, create an entry in the Store interface,
 // prefix it with a @withTransaction comment if you need it to be
 // transactional and then add a private method in the store itself
-// with db sq.BaseRunner as the first parameter before running `make
-// generate`
+// with db sq.BaseRunner as the first parameter, in place of the context,
+// before running `make generate`
 
 package sqlstore
 
@@ -22,15 +22,33 @@ import (
 	"github.com/mattermost/mattermost/server/public/shared/mlog"
 )
 
-func (s *SQLStore) AddUpdateCategoryBoard(userID string, categoryID string, boardIDs []string) error {
+func (s *SQLStore) AddCommentReaction(ctx context.Context, reaction *model.CommentReaction) (*model.CommentReaction, error) {
+	runner := s.runner(ctx, s.db)
+	defer runner.close()
+	return s.addCommentReaction(runner, reaction)
+
+}
+
+func (s *SQLStore) AddFileUsage(ctx context.Context, teamID string, boardID string, bytes int64, fileCount int64) error {
+	runner := s.runner(ctx, s.db)
+	defer runner.close()
+	return s.addFileUsage(runner, teamID, boardID, bytes, fileCount)
+
+}
+
+func (s *SQLStore) AddUpdateCategoryBoard(ctx context.Context, userID string, categoryID string, boardIDs []string) error {
 	if s.dbType == model.SqliteDBType {
-		return s.addUpdateCategoryBoard(s.db, userID, categoryID, boardIDs)
+		runner := s.runner(ctx, s.db)
+		defer runner.close()
+		return s.addUpdateCategoryBoard(runner, userID, categoryID, boardIDs)
 	}
-	tx, txErr := s.db.BeginTx(context.Background(), nil)
+	tx, txErr := s.db.BeginTx(ctx, nil)
 	if txErr != nil {
 		return txErr
 	}
-	err := s.addUpdateCategoryBoard(tx, userID, categoryID, boardIDs)
+	runner := s.runner(ctx, tx)
+	defer runner.close()
+	err := s.addUpdateCategoryBoard(runner, userID, categoryID, boardIDs)
 	if err != nil {
 		if rollbackErr := tx.Rollback(); rollbackErr != nil {
 			s.logger.Error("transaction rollback error", mlog.Err(rollbackErr), mlog.String("methodName", "AddUpdateCategoryBoard"))
@@ -46,20 +64,33 @@ func (s *SQLStore) AddUpdateCategoryBoard(userID string, categoryID string, boar
 
 }
 
-func (s *SQLStore) CanSeeUser(seerID string, seenID string) (bool, error) {
-	return s.canSeeUser(s.db, seerID, seenID)
+func (s *SQLStore) CanSeeUser(ctx context.Context, seerID string, seenID string) (bool, error) {
+	runner := s.runner(ctx, s.db)
+	defer runner.close()
+	return s.canSeeUser(runner, seerID, seenID)
+
+}
+
+func (s *SQLStore) CountFileInfosWithPath(ctx context.Context, path string) (int64, error) {
+	runner := s.runner(ctx, s.db)
+	defer runner.close()
+	return s.countFileInfosWithPath(runner, path)
 
 }
 
-func (s *SQLStore) CreateBoardsAndBlocks(bab *model.BoardsAndBlocks, userID string) (*model.BoardsAndBlocks, error) {
+func (s *SQLStore) CreateBoardsAndBlocks(ctx context.Context, bab *model.BoardsAndBlocks, userID string) (*model.BoardsAndBlocks, error) {
 	if s.dbType == model.SqliteDBType {
-		return s.createBoardsAndBlocks(s.db, bab, userID)
+		runner := s.runner(ctx, s.db)
+		defer runner.close()
+		return s.createBoardsAndBlocks(runner, bab, userID)
 	}
-	tx, txErr := s.db.BeginTx(context.Background(), nil)
+	tx, txErr := s.db.BeginTx(ctx, nil)
 	if txErr != nil {
 		return nil, txErr
 	}
-	result, err := s.createBoardsAndBlocks(tx, bab, userID)
+	runner := s.runner(ctx, tx)
+	defer runner.close()
+	result, err := s.createBoardsAndBlocks(runner, bab, userID)
 	if err != nil {
 		if rollbackErr := tx.Rollback(); rollbackErr != nil {
 			s.logger.Error("transaction rollback error", mlog.Err(rollbackErr), mlog.String("methodName", "CreateBoardsAndBlocks"))
@@ -75,15 +106,19 @@ func (s *SQLStore) CreateBoardsAndBlocks(bab *model.BoardsAndBlocks, userID stri
 
 }
 
-func (s *SQLStore) CreateBoardsAndBlocksWithAdmin(bab *model.BoardsAndBlocks, userID string) (*model.BoardsAndBlocks, []*model.BoardMember, error) {
+func (s *SQLStore) CreateBoardsAndBlocksWithAdmin(ctx context.Context, bab *model.BoardsAndBlocks, userID string) (*model.BoardsAndBlocks, []*model.BoardMember, error) {
 	if s.dbType == model.SqliteDBType {
-		return s.createBoardsAndBlocksWithAdmin(s.db, bab, userID)
+		runner := s.runner(ctx, s.db)
+		defer runner.close()
+		return s.createBoardsAndBlocksWithAdmin(runner, bab, userID)
 	}
-	tx, txErr := s.db.BeginTx(context.Background(), nil)
+	tx, txErr := s.db.BeginTx(ctx, nil)
 	if txErr != nil {
 		return nil, nil, txErr
 	}
-	result, resultVar1, err := s.createBoardsAndBlocksWithAdmin(tx, bab, userID)
+	runner := s.runner(ctx, tx)
+	defer runner.close()
+	result, resultVar1, err := s.createBoardsAndBlocksWithAdmin(runner, bab, userID)
 	if err != nil {
 		if rollbackErr := tx.Rollback(); rollbackErr != nil {
 			s.logger.Error("transaction rollback error", mlog.Err(rollbackErr), mlog.String("methodName", "CreateBoardsAndBlocksWithAdmin"))
@@ -99,15 +134,19 @@ func (s *SQLStore) CreateBoardsAndBlocksWithAdmin(bab *model.BoardsAndBlocks, us
 
 }
 
-func (s *SQLStore) CreateCategory(category model.Category) error {
+func (s *SQLStore) CreateCategory(ctx context.Context, category model.Category) error {
 	if s.dbType == model.SqliteDBType {
-		return s.createCategory(s.db, category)
+		runner := s.runner(ctx, s.db)
+		defer runner.close()
+		return s.createCategory(runner, category)
 	}
-	tx, txErr := s.db.BeginTx(context.Background(), nil)
+	tx, txErr := s.db.BeginTx(ctx, nil)
 	if txErr != nil {
 		return txErr
 	}
-	err := s.createCategory(tx, category)
+	runner := s.runner(ctx, tx)
+	defer runner.close()
+	err := s.createCategory(runner, category)
 	if err != nil {
 		if rollbackErr := tx.Rollback(); rollbackErr != nil {
 			s.logger.Error("transaction rollback error", mlog.Err(rollbackErr), mlog.String("methodName", "CreateCategory"))
@@ -123,20 +162,47 @@ func (s *SQLStore) CreateCategory(category model.Category) error {
 
 }
 
-func (s *SQLStore) CreateSubscription(sub *model.Subscription) (*model.Subscription, error) {
-	return s.createSubscription(s.db, sub)
+func (s *SQLStore) CreateNotification(ctx context.Context, notification *model.Notification) (*model.Notification, error) {
+	runner := s.runner(ctx, s.db)
+	defer runner.close()
+	return s.createNotification(runner, notification)
+
+}
+
+func (s *SQLStore) CreateShareLink(ctx context.Context, link *model.ShareLink) (*model.ShareLink, error) {
+	runner := s.runner(ctx, s.db)
+	defer runner.close()
+	return s.createShareLink(runner, link)
 
 }
 
-func (s *SQLStore) DeleteBlock(blockID string, modifiedBy string) error {
+func (s *SQLStore) CreateSubscription(ctx context.Context, sub *model.Subscription) (*model.Subscription, error) {
+	runner := s.runner(ctx, s.db)
+	defer runner.close()
+	return s.createSubscription(runner, sub)
+
+}
+
+func (s *SQLStore) DeleteAuditEntriesBefore(ctx context.Context, before int64) (int64, error) {
+	runner := s.runner(ctx, s.db)
+	defer runner.close()
+	return s.deleteAuditEntriesBefore(runner, before)
+
+}
+
+func (s *SQLStore) DeleteBlock(ctx context.Context, blockID string, modifiedBy string) error {
 	if s.dbType == model.SqliteDBType {
-		return s.deleteBlock(s.db, blockID, modifiedBy)
+		runner := s.runner(ctx, s.db)
+		defer runner.close()
+		return s.deleteBlock(runner, blockID, modifiedBy)
 	}
-	tx, txErr := s.db.BeginTx(context.Background(), nil)
+	tx, txErr := s.db.BeginTx(ctx, nil)
 	if txErr != nil {
 		return txErr
 	}
-	err := s.deleteBlock(tx, blockID, modifiedBy)
+	runner := s.runner(ctx, tx)
+	defer runner.close()
+	err := s.deleteBlock(runner, blockID, modifiedBy)
 	if err != nil {
 		if rollbackErr := tx.Rollback(); rollbackErr != nil {
 			s.logger.Error("transaction rollback error", mlog.Err(rollbackErr), mlog.String("methodName", "DeleteBlock"))
@@ -152,20 +218,26 @@ func (s *SQLStore) DeleteBlock(blockID string, modifiedBy string) error {
 
 }
 
-func (s *SQLStore) DeleteBlockRecord(blockID string, modifiedBy string) error {
-	return s.deleteBlockRecord(s.db, blockID, modifiedBy)
+func (s *SQLStore) DeleteBlockRecord(ctx context.Context, blockID string, modifiedBy string) error {
+	runner := s.runner(ctx, s.db)
+	defer runner.close()
+	return s.deleteBlockRecord(runner, blockID, modifiedBy)
 
 }
 
-func (s *SQLStore) DeleteBoard(boardID string, userID string) error {
+func (s *SQLStore) DeleteBoard(ctx context.Context, boardID string, userID string) error {
 	if s.dbType == model.SqliteDBType {
-		return s.deleteBoard(s.db, boardID, userID)
+		runner := s.runner(ctx, s.db)
+		defer runner.close()
+		return s.deleteBoard(runner, boardID, userID)
 	}
-	tx, txErr := s.db.BeginTx(context.Background(), nil)
+	tx, txErr := s.db.BeginTx(ctx, nil)
 	if txErr != nil {
 		return txErr
 	}
-	err := s.deleteBoard(tx, boardID, userID)
+	runner := s.runner(ctx, tx)
+	defer runner.close()
+	err := s.deleteBoard(runner, boardID, userID)
 	if err != nil {
 		if rollbackErr := tx.Rollback(); rollbackErr != nil {
 			s.logger.Error("transaction rollback error", mlog.Err(rollbackErr), mlog.String("methodName", "DeleteBoard"))
@@ -181,20 +253,26 @@ func (s *SQLStore) DeleteBoard(boardID string, userID string) error {
 
 }
 
-func (s *SQLStore) DeleteBoardRecord(boardID string, modifiedBy string) error {
-	return s.deleteBoardRecord(s.db, boardID, modifiedBy)
+func (s *SQLStore) DeleteBoardRecord(ctx context.Context, boardID string, modifiedBy string) error {
+	runner := s.runner(ctx, s.db)
+	defer runner.close()
+	return s.deleteBoardRecord(runner, boardID, modifiedBy)
 
 }
 
-func (s *SQLStore) DeleteBoardsAndBlocks(dbab *model.DeleteBoardsAndBlocks, userID string) error {
+func (s *SQLStore) DeleteBoardsAndBlocks(ctx context.Context, dbab *model.DeleteBoardsAndBlocks, userID string) error {
 	if s.dbType == model.SqliteDBType {
-		return s.deleteBoardsAndBlocks(s.db, dbab, userID)
+		runner := s.runner(ctx, s.db)
+		defer runner.close()
+		return s.deleteBoardsAndBlocks(runner, dbab, userID)
 	}
-	tx, txErr := s.db.BeginTx(context.Background(), nil)
+	tx, txErr := s.db.BeginTx(ctx, nil)
 	if txErr != nil {
 		return txErr
 	}
-	err := s.deleteBoardsAndBlocks(tx, dbab, userID)
+	runner := s.runner(ctx, tx)
+	defer runner.close()
+	err := s.deleteBoardsAndBlocks(runner, dbab, userID)
 	if err != nil {
 		if rollbackErr := tx.Rollback(); rollbackErr != nil {
 			s.logger.Error("transaction rollback error", mlog.Err(rollbackErr), mlog.String("methodName", "DeleteBoardsAndBlocks"))
@@ -210,35 +288,124 @@ func (s *SQLStore) DeleteBoardsAndBlocks(dbab *model.DeleteBoardsAndBlocks, user
 
 }
 
-func (s *SQLStore) DeleteCategory(categoryID string, userID string, teamID string) error {
-	return s.deleteCategory(s.db, categoryID, userID, teamID)
+func (s *SQLStore) DeleteCategory(ctx context.Context, categoryID string, userID string, teamID string) error {
+	runner := s.runner(ctx, s.db)
+	defer runner.close()
+	return s.deleteCategory(runner, categoryID, userID, teamID)
 
 }
 
-func (s *SQLStore) DeleteMember(boardID string, userID string) error {
-	return s.deleteMember(s.db, boardID, userID)
+func (s *SQLStore) DeleteCommentReaction(ctx context.Context, blockID string, userID string, emojiName string) error {
+	runner := s.runner(ctx, s.db)
+	defer runner.close()
+	return s.deleteCommentReaction(runner, blockID, userID, emojiName)
 
 }
 
-func (s *SQLStore) DeleteNotificationHint(blockID string) error {
-	return s.deleteNotificationHint(s.db, blockID)
+func (s *SQLStore) DeleteFileInfo(ctx context.Context, id string) error {
+	if s.dbType == model.SqliteDBType {
+		runner := s.runner(ctx, s.db)
+		defer runner.close()
+		return s.deleteFileInfo(runner, id)
+	}
+	tx, txErr := s.db.BeginTx(ctx, nil)
+	if txErr != nil {
+		return txErr
+	}
+	runner := s.runner(ctx, tx)
+	defer runner.close()
+	err := s.deleteFileInfo(runner, id)
+	if err != nil {
+		if rollbackErr := tx.Rollback(); rollbackErr != nil {
+			s.logger.Error("transaction rollback error", mlog.Err(rollbackErr), mlog.String("methodName", "DeleteFileInfo"))
+		}
+		return err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return err
+	}
+
+	return nil
+
+}
+
+func (s *SQLStore) DeleteFiles(ctx context.Context, boardID string, fileIDs []string) error {
+	if s.dbType == model.SqliteDBType {
+		runner := s.runner(ctx, s.db)
+		defer runner.close()
+		return s.deleteFiles(runner, boardID, fileIDs)
+	}
+	tx, txErr := s.db.BeginTx(ctx, nil)
+	if txErr != nil {
+		return txErr
+	}
+	runner := s.runner(ctx, tx)
+	defer runner.close()
+	err := s.deleteFiles(runner, boardID, fileIDs)
+	if err != nil {
+		if rollbackErr := tx.Rollback(); rollbackErr != nil {
+			s.logger.Error("transaction rollback error", mlog.Err(rollbackErr), mlog.String("methodName", "DeleteFiles"))
+		}
+		return err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return err
+	}
+
+	return nil
 
 }
 
-func (s *SQLStore) DeleteSubscription(blockID string, subscriberID string) error {
-	return s.deleteSubscription(s.db, blockID, subscriberID)
+func (s *SQLStore) DeleteMember(ctx context.Context, boardID string, userID string) error {
+	runner := s.runner(ctx, s.db)
+	defer runner.close()
+	return s.deleteMember(runner, boardID, userID)
 
 }
 
-func (s *SQLStore) DuplicateBlock(boardID string, blockID string, userID string, asTemplate bool) ([]*model.Block, error) {
+func (s *SQLStore) DeleteNotificationHint(ctx context.Context, blockID string) error {
+	runner := s.runner(ctx, s.db)
+	defer runner.close()
+	return s.deleteNotificationHint(runner, blockID)
+
+}
+
+func (s *SQLStore) DeleteNotificationPreferences(ctx context.Context, userID string, boardID string) error {
+	runner := s.runner(ctx, s.db)
+	defer runner.close()
+	return s.deleteNotificationPreferences(runner, userID, boardID)
+
+}
+
+func (s *SQLStore) DeleteStorageQuota(ctx context.Context, scope model.StorageQuotaScope, scopeID string) error {
+	runner := s.runner(ctx, s.db)
+	defer runner.close()
+	return s.deleteStorageQuota(runner, scope, scopeID)
+
+}
+
+func (s *SQLStore) DeleteSubscription(ctx context.Context, blockID string, subscriberID string) error {
+	runner := s.runner(ctx, s.db)
+	defer runner.close()
+	return s.deleteSubscription(runner, blockID, subscriberID)
+
+}
+
+func (s *SQLStore) DuplicateBlock(ctx context.Context, boardID string, blockID string, userID string, asTemplate bool) ([]*model.Block, error) {
 	if s.dbType == model.SqliteDBType {
-		return s.duplicateBlock(s.db, boardID, blockID, userID, asTemplate)
+		runner := s.runner(ctx, s.db)
+		defer runner.close()
+		return s.duplicateBlock(runner, boardID, blockID, userID, asTemplate)
 	}
-	tx, txErr := s.db.BeginTx(context.Background(), nil)
+	tx, txErr := s.db.BeginTx(ctx, nil)
 	if txErr != nil {
 		return nil, txErr
 	}
-	result, err := s.duplicateBlock(tx, boardID, blockID, userID, asTemplate)
+	runner := s.runner(ctx, tx)
+	defer runner.close()
+	result, err := s.duplicateBlock(runner, boardID, blockID, userID, asTemplate)
 	if err != nil {
 		if rollbackErr := tx.Rollback(); rollbackErr != nil {
 			s.logger.Error("transaction rollback error", mlog.Err(rollbackErr), mlog.String("methodName", "DuplicateBlock"))
@@ -254,15 +421,19 @@ func (s *SQLStore) DuplicateBlock(boardID string, blockID string, userID string,
 
 }
 
-func (s *SQLStore) DuplicateBoard(boardID string, userID string, toTeam string, asTemplate bool) (*model.BoardsAndBlocks, []*model.BoardMember, error) {
+func (s *SQLStore) DuplicateBoard(ctx context.Context, boardID string, userID string, toTeam string, asTemplate bool) (*model.BoardsAndBlocks, []*model.BoardMember, error) {
 	if s.dbType == model.SqliteDBType {
-		return s.duplicateBoard(s.db, boardID, userID, toTeam, asTemplate)
+		runner := s.runner(ctx, s.db)
+		defer runner.close()
+		return s.duplicateBoard(runner, boardID, userID, toTeam, asTemplate)
 	}
-	tx, txErr := s.db.BeginTx(context.Background(), nil)
+	tx, txErr := s.db.BeginTx(ctx, nil)
 	if txErr != nil {
 		return nil, nil, txErr
 	}
-	result, resultVar1, err := s.duplicateBoard(tx, boardID, userID, toTeam, asTemplate)
+	runner := s.runner(ctx, tx)
+	defer runner.close()
+	result, resultVar1, err := s.duplicateBoard(runner, boardID, userID, toTeam, asTemplate)
 	if err != nil {
 		if rollbackErr := tx.Rollback(); rollbackErr != nil {
 			s.logger.Error("transaction rollback error", mlog.Err(rollbackErr), mlog.String("methodName", "DuplicateBoard"))
@@ -278,305 +449,635 @@ func (s *SQLStore) DuplicateBoard(boardID string, userID string, toTeam string,
 
 }
 
-func (s *SQLStore) GetActiveUserCount(updatedSecondsAgo int64) (int, error) {
-	return s.getActiveUserCount(s.db, updatedSecondsAgo)
+func (s *SQLStore) GetActiveUserCount(ctx context.Context, updatedSecondsAgo int64) (int, error) {
+	runner := s.runner(ctx, s.db)
+	defer runner.close()
+	return s.getActiveUserCount(runner, updatedSecondsAgo)
+
+}
+
+func (s *SQLStore) GetActiveUserCountsByTeam(ctx context.Context, teamID string, at int64) (model.ActiveUserCounts, map[string]model.ActiveUserCounts, error) {
+	runner := s.runner(ctx, s.db)
+	defer runner.close()
+	return s.getActiveUserCountsByTeam(runner, teamID, at)
+
+}
+
+func (s *SQLStore) GetAllTeams(ctx context.Context) ([]*model.Team, error) {
+	runner := s.runner(ctx, s.db)
+	defer runner.close()
+	return s.getAllTeams(runner)
+
+}
+
+func (s *SQLStore) GetAuditEntries(ctx context.Context, opts model.QueryAuditEntriesOptions) ([]*model.AuditEntry, bool, error) {
+	runner := s.runner(ctx, s.db)
+	defer runner.close()
+	return s.getAuditEntries(runner, opts)
+
+}
+
+func (s *SQLStore) GetBlock(ctx context.Context, blockID string) (*model.Block, error) {
+	runner := s.runner(ctx, s.db)
+	defer runner.close()
+	return s.getBlock(runner, blockID)
+
+}
+
+func (s *SQLStore) GetBlockCountsByType(ctx context.Context) (map[string]int64, error) {
+	runner := s.runner(ctx, s.db)
+	defer runner.close()
+	return s.getBlockCountsByType(runner)
+
+}
+
+func (s *SQLStore) GetBlockCountsByTypeWithOptions(ctx context.Context, opts model.StatisticsOptions) (map[string]int64, error) {
+	runner := s.runner(ctx, s.db)
+	defer runner.close()
+	return s.getBlockCountsByTypeWithOptions(runner, opts)
+
+}
+
+func (s *SQLStore) GetBlockHistory(ctx context.Context, blockID string, opts model.QueryBlockHistoryOptions) ([]*model.Block, error) {
+	runner := s.runner(ctx, s.db)
+	defer runner.close()
+	return s.getBlockHistory(runner, blockID, opts)
+
+}
+
+func (s *SQLStore) GetBlockHistoryDescendants(ctx context.Context, boardID string, opts model.QueryBlockHistoryOptions) ([]*model.Block, error) {
+	runner := s.runner(ctx, s.db)
+	defer runner.close()
+	return s.getBlockHistoryDescendants(runner, boardID, opts)
+
+}
+
+func (s *SQLStore) GetBlockHistoryNewestChildren(ctx context.Context, parentID string, opts model.QueryBlockHistoryChildOptions) ([]*model.Block, bool, error) {
+	runner := s.runner(ctx, s.db)
+	defer runner.close()
+	return s.getBlockHistoryNewestChildren(runner, parentID, opts)
+
+}
+
+func (s *SQLStore) GetBlockTombstones(ctx context.Context, opts model.QueryBlockChangesOptions) ([]*model.BlockTombstone, error) {
+	runner := s.runner(ctx, s.db)
+	defer runner.close()
+	return s.getBlockTombstones(runner, opts)
+
+}
+
+func (s *SQLStore) GetBlocks(ctx context.Context, opts model.QueryBlocksOptions) ([]*model.Block, error) {
+	runner := s.runner(ctx, s.db)
+	defer runner.close()
+	return s.getBlocks(runner, opts)
+
+}
+
+func (s *SQLStore) GetBlocksByIDs(ctx context.Context, ids []string) ([]*model.Block, error) {
+	runner := s.runner(ctx, s.db)
+	defer runner.close()
+	return s.getBlocksByIDs(runner, ids)
+
+}
+
+func (s *SQLStore) GetBlocksComplianceHistory(ctx context.Context, opts model.QueryBlocksComplianceHistoryOptions) ([]*model.BlockHistory, bool, error) {
+	runner := s.runner(ctx, s.db)
+	defer runner.close()
+	return s.getBlocksComplianceHistory(runner, opts)
+
+}
+
+func (s *SQLStore) GetBlocksForBoard(ctx context.Context, boardID string) ([]*model.Block, error) {
+	runner := s.runner(ctx, s.db)
+	defer runner.close()
+	return s.getBlocksForBoard(runner, boardID)
+
+}
+
+func (s *SQLStore) GetBlocksWithParent(ctx context.Context, boardID string, parentID string) ([]*model.Block, error) {
+	runner := s.runner(ctx, s.db)
+	defer runner.close()
+	return s.getBlocksWithParent(runner, boardID, parentID)
+
+}
+
+func (s *SQLStore) GetBlocksWithParentAndType(ctx context.Context, boardID string, parentID string, blockType string) ([]*model.Block, error) {
+	runner := s.runner(ctx, s.db)
+	defer runner.close()
+	return s.getBlocksWithParentAndType(runner, boardID, parentID, blockType)
+
+}
+
+func (s *SQLStore) GetBlocksWithType(ctx context.Context, boardID string, blockType string) ([]*model.Block, error) {
+	runner := s.runner(ctx, s.db)
+	defer runner.close()
+	return s.getBlocksWithType(runner, boardID, blockType)
+
+}
+
+func (s *SQLStore) GetBoard(ctx context.Context, id string) (*model.Board, error) {
+	runner := s.runner(ctx, s.db)
+	defer runner.close()
+	return s.getBoard(runner, id)
+
+}
+
+func (s *SQLStore) GetBoardActivity(ctx context.Context, opts model.StatisticsOptions) ([]*model.BoardActivity, error) {
+	runner := s.runner(ctx, s.db)
+	defer runner.close()
+	return s.getBoardActivity(runner, opts)
+
+}
+
+func (s *SQLStore) GetBoardAndCard(ctx context.Context, block *model.Block) (*model.Board, *model.Block, error) {
+	runner := s.runner(ctx, s.db)
+	defer runner.close()
+	return s.getBoardAndCard(runner, block)
+
+}
+
+func (s *SQLStore) GetBoardAndCardByID(ctx context.Context, blockID string) (*model.Board, *model.Block, error) {
+	runner := s.runner(ctx, s.db)
+	defer runner.close()
+	return s.getBoardAndCardByID(runner, blockID)
+
+}
+
+func (s *SQLStore) GetBoardCount(ctx context.Context, includeDeleted bool) (int64, error) {
+	runner := s.runner(ctx, s.db)
+	defer runner.close()
+	return s.getBoardCount(runner, includeDeleted)
+
+}
+
+func (s *SQLStore) GetBoardFileUsage(ctx context.Context, boardID string) (*model.FileUsage, error) {
+	runner := s.runner(ctx, s.db)
+	defer runner.close()
+	return s.getBoardFileUsage(runner, boardID)
+
+}
+
+func (s *SQLStore) GetBoardForm(ctx context.Context, boardID string) (*model.BoardForm, error) {
+	runner := s.runner(ctx, s.db)
+	defer runner.close()
+	return s.getBoardForm(runner, boardID)
+
+}
+
+func (s *SQLStore) GetBoardHistory(ctx context.Context, boardID string, opts model.QueryBoardHistoryOptions) ([]*model.Board, error) {
+	runner := s.runner(ctx, s.db)
+	defer runner.close()
+	return s.getBoardHistory(runner, boardID, opts)
+
+}
+
+func (s *SQLStore) GetBoardMemberHistory(ctx context.Context, boardID string, userID string, limit uint64) ([]*model.BoardMemberHistoryEntry, error) {
+	runner := s.runner(ctx, s.db)
+	defer runner.close()
+	return s.getBoardMemberHistory(runner, boardID, userID, limit)
+
+}
+
+func (s *SQLStore) GetBoardRestorePlan(ctx context.Context, boardID string, at int64) (*model.BoardRestorePlan, error) {
+	runner := s.runner(ctx, s.db)
+	defer runner.close()
+	return s.getBoardRestorePlan(runner, boardID, at)
+
+}
+
+func (s *SQLStore) GetBoardsComplianceHistory(ctx context.Context, opts model.QueryBoardsComplianceHistoryOptions) ([]*model.BoardHistory, bool, error) {
+	runner := s.runner(ctx, s.db)
+	defer runner.close()
+	return s.getBoardsComplianceHistory(runner, opts)
+
+}
+
+func (s *SQLStore) GetBoardsForCompliance(ctx context.Context, opts model.QueryBoardsForComplianceOptions) ([]*model.Board, bool, error) {
+	runner := s.runner(ctx, s.db)
+	defer runner.close()
+	return s.getBoardsForCompliance(runner, opts)
 
 }
 
-func (s *SQLStore) GetAllTeams() ([]*model.Team, error) {
-	return s.getAllTeams(s.db)
+func (s *SQLStore) GetBoardsForUserAndTeam(ctx context.Context, userID string, teamID string, includePublicBoards bool) ([]*model.Board, error) {
+	runner := s.runner(ctx, s.db)
+	defer runner.close()
+	return s.getBoardsForUserAndTeam(runner, userID, teamID, includePublicBoards)
 
 }
 
-func (s *SQLStore) GetBlock(blockID string) (*model.Block, error) {
-	return s.getBlock(s.db, blockID)
+func (s *SQLStore) GetBoardsInTeamByIds(ctx context.Context, boardIDs []string, teamID string) ([]*model.Board, error) {
+	runner := s.runner(ctx, s.db)
+	defer runner.close()
+	return s.getBoardsInTeamByIds(runner, boardIDs, teamID)
 
 }
 
-func (s *SQLStore) GetBlockCountsByType() (map[string]int64, error) {
-	return s.getBlockCountsByType(s.db)
+func (s *SQLStore) GetCardLimitTimestamp(ctx context.Context) (int64, error) {
+	runner := s.runner(ctx, s.db)
+	defer runner.close()
+	return s.getCardLimitTimestamp(runner)
 
 }
 
-func (s *SQLStore) GetBlockHistory(blockID string, opts model.QueryBlockHistoryOptions) ([]*model.Block, error) {
-	return s.getBlockHistory(s.db, blockID, opts)
+func (s *SQLStore) GetCardsCount(ctx context.Context) (int64, error) {
+	runner := s.runner(ctx, s.db)
+	defer runner.close()
+	return s.getCardsCount(runner)
 
 }
 
-func (s *SQLStore) GetBlockHistoryDescendants(boardID string, opts model.QueryBlockHistoryOptions) ([]*model.Block, error) {
-	return s.getBlockHistoryDescendants(s.db, boardID, opts)
+func (s *SQLStore) GetCategory(ctx context.Context, id string) (*model.Category, error) {
+	runner := s.runner(ctx, s.db)
+	defer runner.close()
+	return s.getCategory(runner, id)
 
 }
 
-func (s *SQLStore) GetBlockHistoryNewestChildren(parentID string, opts model.QueryBlockHistoryChildOptions) ([]*model.Block, bool, error) {
-	return s.getBlockHistoryNewestChildren(s.db, parentID, opts)
+func (s *SQLStore) GetChangedBlocks(ctx context.Context, opts model.QueryBlockChangesOptions) ([]*model.Block, error) {
+	runner := s.runner(ctx, s.db)
+	defer runner.close()
+	return s.getChangedBlocks(runner, opts)
 
 }
 
-func (s *SQLStore) GetBlocks(opts model.QueryBlocksOptions) ([]*model.Block, error) {
-	return s.getBlocks(s.db, opts)
+func (s *SQLStore) GetChannel(ctx context.Context, teamID string, channelID string) (*mmModel.Channel, error) {
+	runner := s.runner(ctx, s.db)
+	defer runner.close()
+	return s.getChannel(runner, teamID, channelID)
 
 }
 
-func (s *SQLStore) GetBlocksByIDs(ids []string) ([]*model.Block, error) {
-	return s.getBlocksByIDs(s.db, ids)
+func (s *SQLStore) GetCommentReactions(ctx context.Context, blockID string) ([]*model.CommentReaction, error) {
+	runner := s.runner(ctx, s.db)
+	defer runner.close()
+	return s.getCommentReactions(runner, blockID)
 
 }
 
-func (s *SQLStore) GetBlocksComplianceHistory(opts model.QueryBlocksComplianceHistoryOptions) ([]*model.BlockHistory, bool, error) {
-	return s.getBlocksComplianceHistory(s.db, opts)
+func (s *SQLStore) GetCommentReactionsForBoard(ctx context.Context, boardID string) ([]*model.CommentReaction, error) {
+	runner := s.runner(ctx, s.db)
+	defer runner.close()
+	return s.getCommentReactionsForBoard(runner, boardID)
 
 }
 
-func (s *SQLStore) GetBlocksForBoard(boardID string) ([]*model.Block, error) {
-	return s.getBlocksForBoard(s.db, boardID)
+func (s *SQLStore) GetDailyActivity(ctx context.Context, opts model.StatisticsOptions) (map[int64]model.ActivityCounts, error) {
+	runner := s.runner(ctx, s.db)
+	defer runner.close()
+	return s.getDailyActivity(runner, opts)
 
 }
 
-func (s *SQLStore) GetBlocksWithParent(boardID string, parentID string) ([]*model.Block, error) {
-	return s.getBlocksWithParent(s.db, boardID, parentID)
+func (s *SQLStore) GetFileBoardIDs(ctx context.Context, fileIDs []string) (map[string]string, error) {
+	runner := s.runner(ctx, s.db)
+	defer runner.close()
+	return s.getFileBoardIDs(runner, fileIDs)
 
 }
 
-func (s *SQLStore) GetBlocksWithParentAndType(boardID string, parentID string, blockType string) ([]*model.Block, error) {
-	return s.getBlocksWithParentAndType(s.db, boardID, parentID, blockType)
+func (s *SQLStore) GetFileIDsReferencedByBlocks(ctx context.Context, fileIDs []string) (map[string]bool, error) {
+	runner := s.runner(ctx, s.db)
+	defer runner.close()
+	return s.getFileIDsReferencedByBlocks(runner, fileIDs)
 
 }
 
-func (s *SQLStore) GetBlocksWithType(boardID string, blockType string) ([]*model.Block, error) {
-	return s.getBlocksWithType(s.db, boardID, blockType)
+func (s *SQLStore) GetFileInfo(ctx context.Context, id string) (*mmModel.FileInfo, error) {
+	runner := s.runner(ctx, s.db)
+	defer runner.close()
+	return s.getFileInfo(runner, id)
 
 }
 
-func (s *SQLStore) GetBoard(id string) (*model.Board, error) {
-	return s.getBoard(s.db, id)
+func (s *SQLStore) GetFileInfosWithPathPrefix(ctx context.Context, prefix string, createdBefore int64) ([]*mmModel.FileInfo, error) {
+	runner := s.runner(ctx, s.db)
+	defer runner.close()
+	return s.getFileInfosWithPathPrefix(runner, prefix, createdBefore)
 
 }
 
-func (s *SQLStore) GetBoardAndCard(block *model.Block) (*model.Board, *model.Block, error) {
-	return s.getBoardAndCard(s.db, block)
+func (s *SQLStore) GetFileScan(ctx context.Context, fileID string) (*model.FileScan, error) {
+	runner := s.runner(ctx, s.db)
+	defer runner.close()
+	return s.getFileScan(runner, fileID)
 
 }
 
-func (s *SQLStore) GetBoardAndCardByID(blockID string) (*model.Board, *model.Block, error) {
-	return s.getBoardAndCardByID(s.db, blockID)
+func (s *SQLStore) GetFileUsageByTeam(ctx context.Context) ([]*model.FileUsage, error) {
+	runner := s.runner(ctx, s.db)
+	defer runner.close()
+	return s.getFileUsageByTeam(runner)
 
 }
 
-func (s *SQLStore) GetBoardCount(includeDeleted bool) (int64, error) {
-	return s.getBoardCount(s.db, includeDeleted)
+func (s *SQLStore) GetLicense(ctx context.Context) *mmModel.License {
+	runner := s.runner(ctx, s.db)
+	defer runner.close()
+	return s.getLicense(runner)
 
 }
 
-func (s *SQLStore) GetBoardHistory(boardID string, opts model.QueryBoardHistoryOptions) ([]*model.Board, error) {
-	return s.getBoardHistory(s.db, boardID, opts)
+func (s *SQLStore) GetMemberForBoard(ctx context.Context, boardID string, userID string) (*model.BoardMember, error) {
+	runner := s.runner(ctx, s.db)
+	defer runner.close()
+	return s.getMemberForBoard(runner, boardID, userID)
 
 }
 
-func (s *SQLStore) GetBoardMemberHistory(boardID string, userID string, limit uint64) ([]*model.BoardMemberHistoryEntry, error) {
-	return s.getBoardMemberHistory(s.db, boardID, userID, limit)
+func (s *SQLStore) GetMembersForBoard(ctx context.Context, boardID string) ([]*model.BoardMember, error) {
+	runner := s.runner(ctx, s.db)
+	defer runner.close()
+	return s.getMembersForBoard(runner, boardID)
 
 }
 
-func (s *SQLStore) GetBoardsComplianceHistory(opts model.QueryBoardsComplianceHistoryOptions) ([]*model.BoardHistory, bool, error) {
-	return s.getBoardsComplianceHistory(s.db, opts)
+func (s *SQLStore) GetMembersForUser(ctx context.Context, userID string) ([]*model.BoardMember, error) {
+	runner := s.runner(ctx, s.db)
+	defer runner.close()
+	return s.getMembersForUser(runner, userID)
 
 }
 
-func (s *SQLStore) GetBoardsForCompliance(opts model.QueryBoardsForComplianceOptions) ([]*model.Board, bool, error) {
-	return s.getBoardsForCompliance(s.db, opts)
+func (s *SQLStore) GetNextNotificationHint(ctx context.Context, remove bool) (*model.NotificationHint, error) {
+	runner := s.runner(ctx, s.db)
+	defer runner.close()
+	return s.getNextNotificationHint(runner, remove)
 
 }
 
-func (s *SQLStore) GetBoardsForUserAndTeam(userID string, teamID string, includePublicBoards bool) ([]*model.Board, error) {
-	return s.getBoardsForUserAndTeam(s.db, userID, teamID, includePublicBoards)
+func (s *SQLStore) GetNotification(ctx context.Context, userID string, notificationID string) (*model.Notification, error) {
+	runner := s.runner(ctx, s.db)
+	defer runner.close()
+	return s.getNotification(runner, userID, notificationID)
 
 }
 
-func (s *SQLStore) GetBoardsInTeamByIds(boardIDs []string, teamID string) ([]*model.Board, error) {
-	return s.getBoardsInTeamByIds(s.db, boardIDs, teamID)
+func (s *SQLStore) GetNotificationHint(ctx context.Context, blockID string) (*model.NotificationHint, error) {
+	runner := s.runner(ctx, s.db)
+	defer runner.close()
+	return s.getNotificationHint(runner, blockID)
 
 }
 
-func (s *SQLStore) GetCardLimitTimestamp() (int64, error) {
-	return s.getCardLimitTimestamp(s.db)
+func (s *SQLStore) GetNotificationHintCount(ctx context.Context) (int, error) {
+	runner := s.runner(ctx, s.db)
+	defer runner.close()
+	return s.getNotificationHintCount(runner)
 
 }
 
-func (s *SQLStore) GetCardsCount() (int64, error) {
-	return s.getCardsCount(s.db)
+func (s *SQLStore) GetNotificationPreferences(ctx context.Context, userID string, boardID string) (*model.NotificationPreferences, error) {
+	runner := s.runner(ctx, s.db)
+	defer runner.close()
+	return s.getNotificationPreferences(runner, userID, boardID)
 
 }
 
-func (s *SQLStore) GetCategory(id string) (*model.Category, error) {
-	return s.getCategory(s.db, id)
+func (s *SQLStore) GetNotificationPreferencesForBoard(ctx context.Context, boardID string) ([]*model.NotificationPreferences, error) {
+	runner := s.runner(ctx, s.db)
+	defer runner.close()
+	return s.getNotificationPreferencesForBoard(runner, boardID)
 
 }
 
-func (s *SQLStore) GetChannel(teamID string, channelID string) (*mmModel.Channel, error) {
-	return s.getChannel(s.db, teamID, channelID)
+func (s *SQLStore) GetNotificationsForUser(ctx context.Context, userID string, opts model.QueryNotificationsOptions) ([]*model.Notification, error) {
+	runner := s.runner(ctx, s.db)
+	defer runner.close()
+	return s.getNotificationsForUser(runner, userID, opts)
 
 }
 
-func (s *SQLStore) GetFileInfo(id string) (*mmModel.FileInfo, error) {
-	return s.getFileInfo(s.db, id)
+func (s *SQLStore) GetRegisteredUserCount(ctx context.Context) (int, error) {
+	runner := s.runner(ctx, s.db)
+	defer runner.close()
+	return s.getRegisteredUserCount(runner)
 
 }
 
-func (s *SQLStore) GetLicense() *mmModel.License {
-	return s.getLicense(s.db)
+func (s *SQLStore) GetShareLink(ctx context.Context, linkID string) (*model.ShareLink, error) {
+	runner := s.runner(ctx, s.db)
+	defer runner.close()
+	return s.getShareLink(runner, linkID)
 
 }
 
-func (s *SQLStore) GetMemberForBoard(boardID string, userID string) (*model.BoardMember, error) {
-	return s.getMemberForBoard(s.db, boardID, userID)
+func (s *SQLStore) GetShareLinkByToken(ctx context.Context, boardID string, token string) (*model.ShareLink, error) {
+	runner := s.runner(ctx, s.db)
+	defer runner.close()
+	return s.getShareLinkByToken(runner, boardID, token)
 
 }
 
-func (s *SQLStore) GetMembersForBoard(boardID string) ([]*model.BoardMember, error) {
-	return s.getMembersForBoard(s.db, boardID)
+func (s *SQLStore) GetShareLinksForBoard(ctx context.Context, boardID string) ([]*model.ShareLink, error) {
+	runner := s.runner(ctx, s.db)
+	defer runner.close()
+	return s.getShareLinksForBoard(runner, boardID)
 
 }
 
-func (s *SQLStore) GetMembersForUser(userID string) ([]*model.BoardMember, error) {
-	return s.getMembersForUser(s.db, userID)
+func (s *SQLStore) GetSharing(ctx context.Context, rootID string) (*model.Sharing, error) {
+	runner := s.runner(ctx, s.db)
+	defer runner.close()
+	return s.getSharing(runner, rootID)
 
 }
 
-func (s *SQLStore) GetNextNotificationHint(remove bool) (*model.NotificationHint, error) {
-	return s.getNextNotificationHint(s.db, remove)
+func (s *SQLStore) GetStorageQuota(ctx context.Context, scope model.StorageQuotaScope, scopeID string) (*model.StorageQuota, error) {
+	runner := s.runner(ctx, s.db)
+	defer runner.close()
+	return s.getStorageQuota(runner, scope, scopeID)
 
 }
 
-func (s *SQLStore) GetNotificationHint(blockID string) (*model.NotificationHint, error) {
-	return s.getNotificationHint(s.db, blockID)
+func (s *SQLStore) GetSubTree2(ctx context.Context, boardID string, blockID string, opts model.QuerySubtreeOptions) ([]*model.Block, error) {
+	runner := s.runner(ctx, s.db)
+	defer runner.close()
+	return s.getSubTree2(runner, boardID, blockID, opts)
 
 }
 
-func (s *SQLStore) GetRegisteredUserCount() (int, error) {
-	return s.getRegisteredUserCount(s.db)
+func (s *SQLStore) GetSubscribersCountForBlock(ctx context.Context, blockID string) (int, error) {
+	runner := s.runner(ctx, s.db)
+	defer runner.close()
+	return s.getSubscribersCountForBlock(runner, blockID)
 
 }
 
-func (s *SQLStore) GetSharing(rootID string) (*model.Sharing, error) {
-	return s.getSharing(s.db, rootID)
+func (s *SQLStore) GetSubscribersForBlock(ctx context.Context, blockID string) ([]*model.Subscriber, error) {
+	runner := s.runner(ctx, s.db)
+	defer runner.close()
+	return s.getSubscribersForBlock(runner, blockID)
 
 }
 
-func (s *SQLStore) GetSubTree2(boardID string, blockID string, opts model.QuerySubtreeOptions) ([]*model.Block, error) {
-	return s.getSubTree2(s.db, boardID, blockID, opts)
+func (s *SQLStore) GetSubscription(ctx context.Context, blockID string, subscriberID string) (*model.Subscription, error) {
+	runner := s.runner(ctx, s.db)
+	defer runner.close()
+	return s.getSubscription(runner, blockID, subscriberID)
 
 }
 
-func (s *SQLStore) GetSubscribersCountForBlock(blockID string) (int, error) {
-	return s.getSubscribersCountForBlock(s.db, blockID)
+func (s *SQLStore) GetSubscriptions(ctx context.Context, subscriberID string) ([]*model.Subscription, error) {
+	runner := s.runner(ctx, s.db)
+	defer runner.close()
+	return s.getSubscriptions(runner, subscriberID)
 
 }
 
-func (s *SQLStore) GetSubscribersForBlock(blockID string) ([]*model.Subscriber, error) {
-	return s.getSubscribersForBlock(s.db, blockID)
+func (s *SQLStore) GetSystemSetting(ctx context.Context, key string) (string, error) {
+	runner := s.runner(ctx, s.db)
+	defer runner.close()
+	return s.getSystemSetting(runner, key)
 
 }
 
-func (s *SQLStore) GetSubscription(blockID string, subscriberID string) (*model.Subscription, error) {
-	return s.getSubscription(s.db, blockID, subscriberID)
+func (s *SQLStore) GetSystemSettings(ctx context.Context) (map[string]string, error) {
+	runner := s.runner(ctx, s.db)
+	defer runner.close()
+	return s.getSystemSettings(runner)
 
 }
 
-func (s *SQLStore) GetSubscriptions(subscriberID string) ([]*model.Subscription, error) {
-	return s.getSubscriptions(s.db, subscriberID)
+func (s *SQLStore) GetTeam(ctx context.Context, ID string) (*model.Team, error) {
+	runner := s.runner(ctx, s.db)
+	defer runner.close()
+	return s.getTeam(runner, ID)
 
 }
 
-func (s *SQLStore) GetSystemSetting(key string) (string, error) {
-	return s.getSystemSetting(s.db, key)
+func (s *SQLStore) GetTeamBoardAndCardCounts(ctx context.Context, teamID string) ([]*model.TeamStatistics, error) {
+	runner := s.runner(ctx, s.db)
+	defer runner.close()
+	return s.getTeamBoardAndCardCounts(runner, teamID)
 
 }
 
-func (s *SQLStore) GetSystemSettings() (map[string]string, error) {
-	return s.getSystemSettings(s.db)
+func (s *SQLStore) GetTeamCount(ctx context.Context) (int64, error) {
+	runner := s.runner(ctx, s.db)
+	defer runner.close()
+	return s.getTeamCount(runner)
 
 }
 
-func (s *SQLStore) GetTeam(ID string) (*model.Team, error) {
-	return s.getTeam(s.db, ID)
+func (s *SQLStore) GetTeamFileUsage(ctx context.Context, teamID string) (*model.FileUsage, error) {
+	runner := s.runner(ctx, s.db)
+	defer runner.close()
+	return s.getTeamFileUsage(runner, teamID)
 
 }
 
-func (s *SQLStore) GetTeamCount() (int64, error) {
-	return s.getTeamCount(s.db)
+func (s *SQLStore) GetTeamsForUser(ctx context.Context, userID string) ([]*model.Team, error) {
+	runner := s.runner(ctx, s.db)
+	defer runner.close()
+	return s.getTeamsForUser(runner, userID)
 
 }
 
-func (s *SQLStore) GetTeamsForUser(userID string) ([]*model.Team, error) {
-	return s.getTeamsForUser(s.db, userID)
+func (s *SQLStore) GetTemplateBoards(ctx context.Context, teamID string, userID string) ([]*model.Board, error) {
+	runner := s.runner(ctx, s.db)
+	defer runner.close()
+	return s.getTemplateBoards(runner, teamID, userID)
 
 }
 
-func (s *SQLStore) GetTemplateBoards(teamID string, userID string) ([]*model.Board, error) {
-	return s.getTemplateBoards(s.db, teamID, userID)
+func (s *SQLStore) GetUnreadNotificationCount(ctx context.Context, userID string) (int64, error) {
+	runner := s.runner(ctx, s.db)
+	defer runner.close()
+	return s.getUnreadNotificationCount(runner, userID)
 
 }
 
-func (s *SQLStore) GetUsedCardsCount() (int64, error) {
-	return s.getUsedCardsCount(s.db)
+func (s *SQLStore) GetUsedCardsCount(ctx context.Context) (int64, error) {
+	runner := s.runner(ctx, s.db)
+	defer runner.close()
+	return s.getUsedCardsCount(runner)
 
 }
 
-func (s *SQLStore) GetUserByEmail(email string) (*model.User, error) {
-	return s.getUserByEmail(s.db, email)
+func (s *SQLStore) GetUserByEmail(ctx context.Context, email string) (*model.User, error) {
+	runner := s.runner(ctx, s.db)
+	defer runner.close()
+	return s.getUserByEmail(runner, email)
 
 }
 
-func (s *SQLStore) GetUserByID(userID string) (*model.User, error) {
-	return s.getUserByID(s.db, userID)
+func (s *SQLStore) GetUserByID(ctx context.Context, userID string) (*model.User, error) {
+	runner := s.runner(ctx, s.db)
+	defer runner.close()
+	return s.getUserByID(runner, userID)
 
 }
 
-func (s *SQLStore) GetUserByUsername(username string) (*model.User, error) {
-	return s.getUserByUsername(s.db, username)
+func (s *SQLStore) GetUserByUsername(ctx context.Context, username string) (*model.User, error) {
+	runner := s.runner(ctx, s.db)
+	defer runner.close()
+	return s.getUserByUsername(runner, username)
 
 }
 
-func (s *SQLStore) GetUserCategories(userID string, teamID string) ([]model.Category, error) {
-	return s.getUserCategories(s.db, userID, teamID)
+func (s *SQLStore) GetUserCategories(ctx context.Context, userID string, teamID string) ([]model.Category, error) {
+	runner := s.runner(ctx, s.db)
+	defer runner.close()
+	return s.getUserCategories(runner, userID, teamID)
 
 }
 
-func (s *SQLStore) GetUserCategoryBoards(userID string, teamID string) ([]model.CategoryBoards, error) {
-	return s.getUserCategoryBoards(s.db, userID, teamID)
+func (s *SQLStore) GetUserCategoryBoards(ctx context.Context, userID string, teamID string) ([]model.CategoryBoards, error) {
+	runner := s.runner(ctx, s.db)
+	defer runner.close()
+	return s.getUserCategoryBoards(runner, userID, teamID)
 
 }
 
-func (s *SQLStore) GetUserPreferences(userID string) (mmModel.Preferences, error) {
-	return s.getUserPreferences(s.db, userID)
+func (s *SQLStore) GetUserPreferences(ctx context.Context, userID string) (mmModel.Preferences, error) {
+	runner := s.runner(ctx, s.db)
+	defer runner.close()
+	return s.getUserPreferences(runner, userID)
 
 }
 
-func (s *SQLStore) GetUserTimezone(userID string) (string, error) {
-	return s.getUserTimezone(s.db, userID)
+func (s *SQLStore) GetUserTimezone(ctx context.Context, userID string) (string, error) {
+	runner := s.runner(ctx, s.db)
+	defer runner.close()
+	return s.getUserTimezone(runner, userID)
 
 }
 
-func (s *SQLStore) GetUsersByTeam(teamID string, asGuestID string, showEmail bool, showName bool) ([]*model.User, error) {
-	return s.getUsersByTeam(s.db, teamID, asGuestID, showEmail, showName)
+func (s *SQLStore) GetUsersByTeam(ctx context.Context, teamID string, asGuestID string, showEmail bool, showName bool) ([]*model.User, error) {
+	runner := s.runner(ctx, s.db)
+	defer runner.close()
+	return s.getUsersByTeam(runner, teamID, asGuestID, showEmail, showName)
 
 }
 
-func (s *SQLStore) GetUsersList(userIDs []string, showEmail bool, showName bool) ([]*model.User, error) {
-	return s.getUsersList(s.db, userIDs, showEmail, showName)
+func (s *SQLStore) GetUsersList(ctx context.Context, userIDs []string, showEmail bool, showName bool) ([]*model.User, error) {
+	runner := s.runner(ctx, s.db)
+	defer runner.close()
+	return s.getUsersList(runner, userIDs, showEmail, showName)
 
 }
 
-func (s *SQLStore) InsertBlock(block *model.Block, userID string) error {
+func (s *SQLStore) InsertAuditEntry(ctx context.Context, entry *model.AuditEntry) error {
+	runner := s.runner(ctx, s.db)
+	defer runner.close()
+	return s.insertAuditEntry(runner, entry)
+
+}
+
+func (s *SQLStore) InsertBlock(ctx context.Context, block *model.Block, userID string) error {
 	if s.dbType == model.SqliteDBType {
-		return s.insertBlock(s.db, block, userID)
+		runner := s.runner(ctx, s.db)
+		defer runner.close()
+		return s.insertBlock(runner, block, userID)
 	}
-	tx, txErr := s.db.BeginTx(context.Background(), nil)
+	tx, txErr := s.db.BeginTx(ctx, nil)
 	if txErr != nil {
 		return txErr
 	}
-	err := s.insertBlock(tx, block, userID)
+	runner := s.runner(ctx, tx)
+	defer runner.close()
+	err := s.insertBlock(runner, block, userID)
 	if err != nil {
 		if rollbackErr := tx.Rollback(); rollbackErr != nil {
 			s.logger.Error("transaction rollback error", mlog.Err(rollbackErr), mlog.String("methodName", "InsertBlock"))
@@ -592,15 +1093,19 @@ func (s *SQLStore) InsertBlock(block *model.Block, userID string) error {
 
 }
 
-func (s *SQLStore) InsertBlocks(blocks []*model.Block, userID string) error {
+func (s *SQLStore) InsertBlocks(ctx context.Context, blocks []*model.Block, userID string) error {
 	if s.dbType == model.SqliteDBType {
-		return s.insertBlocks(s.db, blocks, userID)
+		runner := s.runner(ctx, s.db)
+		defer runner.close()
+		return s.insertBlocks(runner, blocks, userID)
 	}
-	tx, txErr := s.db.BeginTx(context.Background(), nil)
+	tx, txErr := s.db.BeginTx(ctx, nil)
 	if txErr != nil {
 		return txErr
 	}
-	err := s.insertBlocks(tx, blocks, userID)
+	runner := s.runner(ctx, tx)
+	defer runner.close()
+	err := s.insertBlocks(runner, blocks, userID)
 	if err != nil {
 		if rollbackErr := tx.Rollback(); rollbackErr != nil {
 			s.logger.Error("transaction rollback error", mlog.Err(rollbackErr), mlog.String("methodName", "InsertBlocks"))
@@ -616,20 +1121,26 @@ func (s *SQLStore) InsertBlocks(blocks []*model.Block, userID string) error {
 
 }
 
-func (s *SQLStore) InsertBoard(board *model.Board, userID string) (*model.Board, error) {
-	return s.insertBoard(s.db, board, userID)
+func (s *SQLStore) InsertBoard(ctx context.Context, board *model.Board, userID string) (*model.Board, error) {
+	runner := s.runner(ctx, s.db)
+	defer runner.close()
+	return s.insertBoard(runner, board, userID)
 
 }
 
-func (s *SQLStore) InsertBoardWithAdmin(board *model.Board, userID string) (*model.Board, *model.BoardMember, error) {
+func (s *SQLStore) InsertBoardWithAdmin(ctx context.Context, board *model.Board, userID string) (*model.Board, *model.BoardMember, error) {
 	if s.dbType == model.SqliteDBType {
-		return s.insertBoardWithAdmin(s.db, board, userID)
+		runner := s.runner(ctx, s.db)
+		defer runner.close()
+		return s.insertBoardWithAdmin(runner, board, userID)
 	}
-	tx, txErr := s.db.BeginTx(context.Background(), nil)
+	tx, txErr := s.db.BeginTx(ctx, nil)
 	if txErr != nil {
 		return nil, nil, txErr
 	}
-	result, resultVar1, err := s.insertBoardWithAdmin(tx, board, userID)
+	runner := s.runner(ctx, tx)
+	defer runner.close()
+	result, resultVar1, err := s.insertBoardWithAdmin(runner, board, userID)
 	if err != nil {
 		if rollbackErr := tx.Rollback(); rollbackErr != nil {
 			s.logger.Error("transaction rollback error", mlog.Err(rollbackErr), mlog.String("methodName", "InsertBoardWithAdmin"))
@@ -645,15 +1156,33 @@ func (s *SQLStore) InsertBoardWithAdmin(board *model.Board, userID string) (*mod
 
 }
 
-func (s *SQLStore) PatchBlock(blockID string, blockPatch *model.BlockPatch, userID string) error {
+func (s *SQLStore) MarkAllNotificationsRead(ctx context.Context, userID string) error {
+	runner := s.runner(ctx, s.db)
+	defer runner.close()
+	return s.markAllNotificationsRead(runner, userID)
+
+}
+
+func (s *SQLStore) MarkNotificationRead(ctx context.Context, userID string, notificationID string) error {
+	runner := s.runner(ctx, s.db)
+	defer runner.close()
+	return s.markNotificationRead(runner, userID, notificationID)
+
+}
+
+func (s *SQLStore) PatchBlock(ctx context.Context, blockID string, blockPatch *model.BlockPatch, userID string) error {
 	if s.dbType == model.SqliteDBType {
-		return s.patchBlock(s.db, blockID, blockPatch, userID)
+		runner := s.runner(ctx, s.db)
+		defer runner.close()
+		return s.patchBlock(runner, blockID, blockPatch, userID)
 	}
-	tx, txErr := s.db.BeginTx(context.Background(), nil)
+	tx, txErr := s.db.BeginTx(ctx, nil)
 	if txErr != nil {
 		return txErr
 	}
-	err := s.patchBlock(tx, blockID, blockPatch, userID)
+	runner := s.runner(ctx, tx)
+	defer runner.close()
+	err := s.patchBlock(runner, blockID, blockPatch, userID)
 	if err != nil {
 		if rollbackErr := tx.Rollback(); rollbackErr != nil {
 			s.logger.Error("transaction rollback error", mlog.Err(rollbackErr), mlog.String("methodName", "PatchBlock"))
@@ -669,15 +1198,19 @@ func (s *SQLStore) PatchBlock(blockID string, blockPatch *model.BlockPatch, user
 
 }
 
-func (s *SQLStore) PatchBlocks(blockPatches *model.BlockPatchBatch, userID string) error {
+func (s *SQLStore) PatchBlocks(ctx context.Context, blockPatches *model.BlockPatchBatch, userID string) error {
 	if s.dbType == model.SqliteDBType {
-		return s.patchBlocks(s.db, blockPatches, userID)
+		runner := s.runner(ctx, s.db)
+		defer runner.close()
+		return s.patchBlocks(runner, blockPatches, userID)
 	}
-	tx, txErr := s.db.BeginTx(context.Background(), nil)
+	tx, txErr := s.db.BeginTx(ctx, nil)
 	if txErr != nil {
 		return txErr
 	}
-	err := s.patchBlocks(tx, blockPatches, userID)
+	runner := s.runner(ctx, tx)
+	defer runner.close()
+	err := s.patchBlocks(runner, blockPatches, userID)
 	if err != nil {
 		if rollbackErr := tx.Rollback(); rollbackErr != nil {
 			s.logger.Error("transaction rollback error", mlog.Err(rollbackErr), mlog.String("methodName", "PatchBlocks"))
@@ -693,15 +1226,19 @@ func (s *SQLStore) PatchBlocks(blockPatches *model.BlockPatchBatch, userID strin
 
 }
 
-func (s *SQLStore) PatchBoard(boardID string, boardPatch *model.BoardPatch, userID string) (*model.Board, error) {
+func (s *SQLStore) PatchBoard(ctx context.Context, boardID string, boardPatch *model.BoardPatch, userID string) (*model.Board, error) {
 	if s.dbType == model.SqliteDBType {
-		return s.patchBoard(s.db, boardID, boardPatch, userID)
+		runner := s.runner(ctx, s.db)
+		defer runner.close()
+		return s.patchBoard(runner, boardID, boardPatch, userID)
 	}
-	tx, txErr := s.db.BeginTx(context.Background(), nil)
+	tx, txErr := s.db.BeginTx(ctx, nil)
 	if txErr != nil {
 		return nil, txErr
 	}
-	result, err := s.patchBoard(tx, boardID, boardPatch, userID)
+	runner := s.runner(ctx, tx)
+	defer runner.close()
+	result, err := s.patchBoard(runner, boardID, boardPatch, userID)
 	if err != nil {
 		if rollbackErr := tx.Rollback(); rollbackErr != nil {
 			s.logger.Error("transaction rollback error", mlog.Err(rollbackErr), mlog.String("methodName", "PatchBoard"))
@@ -717,15 +1254,19 @@ func (s *SQLStore) PatchBoard(boardID string, boardPatch *model.BoardPatch, user
 
 }
 
-func (s *SQLStore) PatchBoardsAndBlocks(pbab *model.PatchBoardsAndBlocks, userID string) (*model.BoardsAndBlocks, error) {
+func (s *SQLStore) PatchBoardsAndBlocks(ctx context.Context, pbab *model.PatchBoardsAndBlocks, userID string) (*model.BoardsAndBlocks, error) {
 	if s.dbType == model.SqliteDBType {
-		return s.patchBoardsAndBlocks(s.db, pbab, userID)
+		runner := s.runner(ctx, s.db)
+		defer runner.close()
+		return s.patchBoardsAndBlocks(runner, pbab, userID)
 	}
-	tx, txErr := s.db.BeginTx(context.Background(), nil)
+	tx, txErr := s.db.BeginTx(ctx, nil)
 	if txErr != nil {
 		return nil, txErr
 	}
-	result, err := s.patchBoardsAndBlocks(tx, pbab, userID)
+	runner := s.runner(ctx, tx)
+	defer runner.close()
+	result, err := s.patchBoardsAndBlocks(runner, pbab, userID)
 	if err != nil {
 		if rollbackErr := tx.Rollback(); rollbackErr != nil {
 			s.logger.Error("transaction rollback error", mlog.Err(rollbackErr), mlog.String("methodName", "PatchBoardsAndBlocks"))
@@ -741,40 +1282,145 @@ func (s *SQLStore) PatchBoardsAndBlocks(pbab *model.PatchBoardsAndBlocks, userID
 
 }
 
-func (s *SQLStore) PatchUserPreferences(userID string, patch model.UserPreferencesPatch) (mmModel.Preferences, error) {
-	return s.patchUserPreferences(s.db, userID, patch)
+func (s *SQLStore) PatchUserPreferences(ctx context.Context, userID string, patch model.UserPreferencesPatch) (mmModel.Preferences, error) {
+	runner := s.runner(ctx, s.db)
+	defer runner.close()
+	return s.patchUserPreferences(runner, userID, patch)
+
+}
+
+func (s *SQLStore) PostMessage(ctx context.Context, message string, postType string, channelID string) error {
+	runner := s.runner(ctx, s.db)
+	defer runner.close()
+	return s.postMessage(runner, message, postType, channelID)
 
 }
 
-func (s *SQLStore) PostMessage(message string, postType string, channelID string) error {
-	return s.postMessage(s.db, message, postType, channelID)
+func (s *SQLStore) RemoveDefaultTemplates(ctx context.Context, boards []*model.Board) error {
+	runner := s.runner(ctx, s.db)
+	defer runner.close()
+	return s.removeDefaultTemplates(runner, boards)
 
 }
 
-func (s *SQLStore) RemoveDefaultTemplates(boards []*model.Board) error {
-	return s.removeDefaultTemplates(s.db, boards)
+func (s *SQLStore) ReorderCategories(ctx context.Context, userID string, teamID string, newCategoryOrder []string) ([]string, error) {
+	runner := s.runner(ctx, s.db)
+	defer runner.close()
+	return s.reorderCategories(runner, userID, teamID, newCategoryOrder)
 
 }
 
-func (s *SQLStore) ReorderCategories(userID string, teamID string, newCategoryOrder []string) ([]string, error) {
-	return s.reorderCategories(s.db, userID, teamID, newCategoryOrder)
+func (s *SQLStore) ReorderCategoryBoards(ctx context.Context, categoryID string, newBoardsOrder []string) ([]string, error) {
+	runner := s.runner(ctx, s.db)
+	defer runner.close()
+	return s.reorderCategoryBoards(runner, categoryID, newBoardsOrder)
 
 }
 
-func (s *SQLStore) ReorderCategoryBoards(categoryID string, newBoardsOrder []string) ([]string, error) {
-	return s.reorderCategoryBoards(s.db, categoryID, newBoardsOrder)
+func (s *SQLStore) ReserveFileUsage(ctx context.Context, teamID string, boardID string, bytes int64, teamQuota int64, boardQuota int64) error {
+	if s.dbType == model.SqliteDBType {
+		runner := s.runner(ctx, s.db)
+		defer runner.close()
+		return s.reserveFileUsage(runner, teamID, boardID, bytes, teamQuota, boardQuota)
+	}
+	tx, txErr := s.db.BeginTx(ctx, nil)
+	if txErr != nil {
+		return txErr
+	}
+	runner := s.runner(ctx, tx)
+	defer runner.close()
+	err := s.reserveFileUsage(runner, teamID, boardID, bytes, teamQuota, boardQuota)
+	if err != nil {
+		if rollbackErr := tx.Rollback(); rollbackErr != nil {
+			s.logger.Error("transaction rollback error", mlog.Err(rollbackErr), mlog.String("methodName", "ReserveFileUsage"))
+		}
+		return err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return err
+	}
+
+	return nil
 
 }
 
-func (s *SQLStore) RunDataRetention(globalRetentionDate int64, batchSize int64) (int64, error) {
+func (s *SQLStore) RestoreBoardToTime(ctx context.Context, boardID string, at int64, modifiedBy string) (*model.BoardRestorePlan, error) {
 	if s.dbType == model.SqliteDBType {
-		return s.runDataRetention(s.db, globalRetentionDate, batchSize)
+		runner := s.runner(ctx, s.db)
+		defer runner.close()
+		return s.restoreBoardToTime(runner, boardID, at, modifiedBy)
 	}
-	tx, txErr := s.db.BeginTx(context.Background(), nil)
+	tx, txErr := s.db.BeginTx(ctx, nil)
+	if txErr != nil {
+		return nil, txErr
+	}
+	runner := s.runner(ctx, tx)
+	defer runner.close()
+	result, err := s.restoreBoardToTime(runner, boardID, at, modifiedBy)
+	if err != nil {
+		if rollbackErr := tx.Rollback(); rollbackErr != nil {
+			s.logger.Error("transaction rollback error", mlog.Err(rollbackErr), mlog.String("methodName", "RestoreBoardToTime"))
+		}
+		return nil, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+
+	return result, nil
+
+}
+
+func (s *SQLStore) RestoreFiles(ctx context.Context, boardID string, fileIDs []string) error {
+	if s.dbType == model.SqliteDBType {
+		runner := s.runner(ctx, s.db)
+		defer runner.close()
+		return s.restoreFiles(runner, boardID, fileIDs)
+	}
+	tx, txErr := s.db.BeginTx(ctx, nil)
+	if txErr != nil {
+		return txErr
+	}
+	runner := s.runner(ctx, tx)
+	defer runner.close()
+	err := s.restoreFiles(runner, boardID, fileIDs)
+	if err != nil {
+		if rollbackErr := tx.Rollback(); rollbackErr != nil {
+			s.logger.Error("transaction rollback error", mlog.Err(rollbackErr), mlog.String("methodName", "RestoreFiles"))
+		}
+		return err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return err
+	}
+
+	return nil
+
+}
+
+func (s *SQLStore) RevokeShareLink(ctx context.Context, linkID string) error {
+	runner := s.runner(ctx, s.db)
+	defer runner.close()
+	return s.revokeShareLink(runner, linkID)
+
+}
+
+func (s *SQLStore) RunDataRetention(ctx context.Context, globalRetentionDate int64, batchSize int64) (int64, error) {
+	if s.dbType == model.SqliteDBType {
+		runner := s.runner(ctx, s.db)
+		defer runner.close()
+		return s.runDataRetention(runner, globalRetentionDate, batchSize)
+	}
+	tx, txErr := s.db.BeginTx(ctx, nil)
 	if txErr != nil {
 		return 0, txErr
 	}
-	result, err := s.runDataRetention(tx, globalRetentionDate, batchSize)
+	runner := s.runner(ctx, tx)
+	defer runner.close()
+	result, err := s.runDataRetention(runner, globalRetentionDate, batchSize)
 	if err != nil {
 		if rollbackErr := tx.Rollback(); rollbackErr != nil {
 			s.logger.Error("transaction rollback error", mlog.Err(rollbackErr), mlog.String("methodName", "RunDataRetention"))
@@ -790,65 +1436,96 @@ func (s *SQLStore) RunDataRetention(globalRetentionDate int64, batchSize int64)
 
 }
 
-func (s *SQLStore) SaveFileInfo(fileInfo *mmModel.FileInfo) error {
-	return s.saveFileInfo(s.db, fileInfo)
+func (s *SQLStore) SaveFileBoard(ctx context.Context, fileID string, boardID string) error {
+	runner := s.runner(ctx, s.db)
+	defer runner.close()
+	return s.saveFileBoard(runner, fileID, boardID)
+
+}
+
+func (s *SQLStore) SaveFileInfo(ctx context.Context, fileInfo *mmModel.FileInfo) error {
+	runner := s.runner(ctx, s.db)
+	defer runner.close()
+	return s.saveFileInfo(runner, fileInfo)
 
 }
 
-func (s *SQLStore) RestoreFiles(fileIDs []string) error {
-	return s.restoreFiles(s.db, fileIDs)
+func (s *SQLStore) SaveMember(ctx context.Context, bm *model.BoardMember) (*model.BoardMember, error) {
+	runner := s.runner(ctx, s.db)
+	defer runner.close()
+	return s.saveMember(runner, bm)
 
 }
 
-func (s *SQLStore) SaveMember(bm *model.BoardMember) (*model.BoardMember, error) {
-	return s.saveMember(s.db, bm)
+func (s *SQLStore) SearchBoardsForUser(ctx context.Context, term string, searchField model.BoardSearchField, userID string, includePublicBoards bool) ([]*model.Board, error) {
+	runner := s.runner(ctx, s.db)
+	defer runner.close()
+	return s.searchBoardsForUser(runner, term, searchField, userID, includePublicBoards)
 
 }
 
-func (s *SQLStore) SearchBoardsForUser(term string, searchField model.BoardSearchField, userID string, includePublicBoards bool) ([]*model.Board, error) {
-	return s.searchBoardsForUser(s.db, term, searchField, userID, includePublicBoards)
+func (s *SQLStore) SearchBoardsForUserInTeam(ctx context.Context, teamID string, term string, userID string) ([]*model.Board, error) {
+	runner := s.runner(ctx, s.db)
+	defer runner.close()
+	return s.searchBoardsForUserInTeam(runner, teamID, term, userID)
 
 }
 
-func (s *SQLStore) SearchBoardsForUserInTeam(teamID string, term string, userID string) ([]*model.Board, error) {
-	return s.searchBoardsForUserInTeam(s.db, teamID, term, userID)
+func (s *SQLStore) SearchUserChannels(ctx context.Context, teamID string, userID string, query string) ([]*mmModel.Channel, error) {
+	runner := s.runner(ctx, s.db)
+	defer runner.close()
+	return s.searchUserChannels(runner, teamID, userID, query)
 
 }
 
-func (s *SQLStore) SearchUserChannels(teamID string, userID string, query string) ([]*mmModel.Channel, error) {
-	return s.searchUserChannels(s.db, teamID, userID, query)
+func (s *SQLStore) SearchUsersByTeam(ctx context.Context, teamID string, searchQuery string, asGuestID string, excludeBots bool, showEmail bool, showName bool) ([]*model.User, error) {
+	runner := s.runner(ctx, s.db)
+	defer runner.close()
+	return s.searchUsersByTeam(runner, teamID, searchQuery, asGuestID, excludeBots, showEmail, showName)
 
 }
 
-func (s *SQLStore) SearchUsersByTeam(teamID string, searchQuery string, asGuestID string, excludeBots bool, showEmail bool, showName bool) ([]*model.User, error) {
-	return s.searchUsersByTeam(s.db, teamID, searchQuery, asGuestID, excludeBots, showEmail, showName)
+func (s *SQLStore) SendMessage(ctx context.Context, message string, postType string, receipts []string) error {
+	runner := s.runner(ctx, s.db)
+	defer runner.close()
+	return s.sendMessage(runner, message, postType, receipts)
 
 }
 
-func (s *SQLStore) SendMessage(message string, postType string, receipts []string) error {
-	return s.sendMessage(s.db, message, postType, receipts)
+func (s *SQLStore) SetBoardVisibility(ctx context.Context, userID string, categoryID string, boardID string, visible bool) error {
+	runner := s.runner(ctx, s.db)
+	defer runner.close()
+	return s.setBoardVisibility(runner, userID, categoryID, boardID, visible)
 
 }
 
-func (s *SQLStore) SetBoardVisibility(userID string, categoryID string, boardID string, visible bool) error {
-	return s.setBoardVisibility(s.db, userID, categoryID, boardID, visible)
+func (s *SQLStore) SetSystemSetting(ctx context.Context, key string, value string) error {
+	runner := s.runner(ctx, s.db)
+	defer runner.close()
+	return s.setSystemSetting(runner, key, value)
 
 }
 
-func (s *SQLStore) SetSystemSetting(key string, value string) error {
-	return s.setSystemSetting(s.db, key, value)
+func (s *SQLStore) SetSystemSettingIfAbsent(ctx context.Context, key string, value string) error {
+	runner := s.runner(ctx, s.db)
+	defer runner.close()
+	return s.setSystemSettingIfAbsent(runner, key, value)
 
 }
 
-func (s *SQLStore) UndeleteBlock(blockID string, modifiedBy string) error {
+func (s *SQLStore) UndeleteBlock(ctx context.Context, blockID string, modifiedBy string) error {
 	if s.dbType == model.SqliteDBType {
-		return s.undeleteBlock(s.db, blockID, modifiedBy)
+		runner := s.runner(ctx, s.db)
+		defer runner.close()
+		return s.undeleteBlock(runner, blockID, modifiedBy)
 	}
-	tx, txErr := s.db.BeginTx(context.Background(), nil)
+	tx, txErr := s.db.BeginTx(ctx, nil)
 	if txErr != nil {
 		return txErr
 	}
-	err := s.undeleteBlock(tx, blockID, modifiedBy)
+	runner := s.runner(ctx, tx)
+	defer runner.close()
+	err := s.undeleteBlock(runner, blockID, modifiedBy)
 	if err != nil {
 		if rollbackErr := tx.Rollback(); rollbackErr != nil {
 			s.logger.Error("transaction rollback error", mlog.Err(rollbackErr), mlog.String("methodName", "UndeleteBlock"))
@@ -864,15 +1541,19 @@ func (s *SQLStore) UndeleteBlock(blockID string, modifiedBy string) error {
 
 }
 
-func (s *SQLStore) UndeleteBoard(boardID string, modifiedBy string) error {
+func (s *SQLStore) UndeleteBoard(ctx context.Context, boardID string, modifiedBy string) error {
 	if s.dbType == model.SqliteDBType {
-		return s.undeleteBoard(s.db, boardID, modifiedBy)
+		runner := s.runner(ctx, s.db)
+		defer runner.close()
+		return s.undeleteBoard(runner, boardID, modifiedBy)
 	}
-	tx, txErr := s.db.BeginTx(context.Background(), nil)
+	tx, txErr := s.db.BeginTx(ctx, nil)
 	if txErr != nil {
 		return txErr
 	}
-	err := s.undeleteBoard(tx, boardID, modifiedBy)
+	runner := s.runner(ctx, tx)
+	defer runner.close()
+	err := s.undeleteBoard(runner, boardID, modifiedBy)
 	if err != nil {
 		if rollbackErr := tx.Rollback(); rollbackErr != nil {
 			s.logger.Error("transaction rollback error", mlog.Err(rollbackErr), mlog.String("methodName", "UndeleteBoard"))
@@ -888,37 +1569,93 @@ func (s *SQLStore) UndeleteBoard(boardID string, modifiedBy string) error {
 
 }
 
-func (s *SQLStore) UpdateCardLimitTimestamp(cardLimit int) (int64, error) {
-	return s.updateCardLimitTimestamp(s.db, cardLimit)
+func (s *SQLStore) UpdateCardLimitTimestamp(ctx context.Context, cardLimit int) (int64, error) {
+	runner := s.runner(ctx, s.db)
+	defer runner.close()
+	return s.updateCardLimitTimestamp(runner, cardLimit)
+
+}
+
+func (s *SQLStore) UpdateCategory(ctx context.Context, category model.Category) error {
+	runner := s.runner(ctx, s.db)
+	defer runner.close()
+	return s.updateCategory(runner, category)
+
+}
+
+func (s *SQLStore) UpdateFileInfoPaths(ctx context.Context, fileInfo *mmModel.FileInfo) error {
+	runner := s.runner(ctx, s.db)
+	defer runner.close()
+	return s.updateFileInfoPaths(runner, fileInfo)
+
+}
+
+func (s *SQLStore) UpdateShareLinkLastUsed(ctx context.Context, linkID string, lastUsedAt int64) error {
+	runner := s.runner(ctx, s.db)
+	defer runner.close()
+	return s.updateShareLinkLastUsed(runner, linkID, lastUsedAt)
+
+}
+
+func (s *SQLStore) UpdateSubscribersNotifiedAt(ctx context.Context, blockID string, notifiedAt int64) error {
+	runner := s.runner(ctx, s.db)
+	defer runner.close()
+	return s.updateSubscribersNotifiedAt(runner, blockID, notifiedAt)
+
+}
+
+func (s *SQLStore) UpsertBoardForm(ctx context.Context, form *model.BoardForm) (*model.BoardForm, error) {
+	runner := s.runner(ctx, s.db)
+	defer runner.close()
+	return s.upsertBoardForm(runner, form)
+
+}
+
+func (s *SQLStore) UpsertFileScan(ctx context.Context, scan *model.FileScan) error {
+	runner := s.runner(ctx, s.db)
+	defer runner.close()
+	return s.upsertFileScan(runner, scan)
 
 }
 
-func (s *SQLStore) UpdateCategory(category model.Category) error {
-	return s.updateCategory(s.db, category)
+func (s *SQLStore) UpsertNotificationHint(ctx context.Context, hint *model.NotificationHint, notificationFreq time.Duration) (*model.NotificationHint, error) {
+	runner := s.runner(ctx, s.db)
+	defer runner.close()
+	return s.upsertNotificationHint(runner, hint, notificationFreq)
 
 }
 
-func (s *SQLStore) UpdateSubscribersNotifiedAt(blockID string, notifiedAt int64) error {
-	return s.updateSubscribersNotifiedAt(s.db, blockID, notifiedAt)
+func (s *SQLStore) UpsertNotificationPreferences(ctx context.Context, prefs *model.NotificationPreferences) (*model.NotificationPreferences, error) {
+	runner := s.runner(ctx, s.db)
+	defer runner.close()
+	return s.upsertNotificationPreferences(runner, prefs)
 
 }
 
-func (s *SQLStore) UpsertNotificationHint(hint *model.NotificationHint, notificationFreq time.Duration) (*model.NotificationHint, error) {
-	return s.upsertNotificationHint(s.db, hint, notificationFreq)
+func (s *SQLStore) UpsertSharing(ctx context.Context, sharing model.Sharing) error {
+	runner := s.runner(ctx, s.db)
+	defer runner.close()
+	return s.upsertSharing(runner, sharing)
 
 }
 
-func (s *SQLStore) UpsertSharing(sharing model.Sharing) error {
-	return s.upsertSharing(s.db, sharing)
+func (s *SQLStore) UpsertStorageQuota(ctx context.Context, quota *model.StorageQuota) error {
+	runner := s.runner(ctx, s.db)
+	defer runner.close()
+	return s.upsertStorageQuota(runner, quota)
 
 }
 
-func (s *SQLStore) UpsertTeamSettings(team model.Team) error {
-	return s.upsertTeamSettings(s.db, team)
+func (s *SQLStore) UpsertTeamSettings(ctx context.Context, team model.Team) error {
+	runner := s.runner(ctx, s.db)
+	defer runner.close()
+	return s.upsertTeamSettings(runner, team)
 
 }
 
-func (s *SQLStore) UpsertTeamSignupToken(team model.Team) error {
-	return s.upsertTeamSignupToken(s.db, team)
+func (s *SQLStore) UpsertTeamSignupToken(ctx context.Context, team model.Team) error {
+	runner := s.runner(ctx, s.db)
+	defer runner.close()
+	return s.upsertTeamSignupToken(runner, team)
 
 }
@@ -0,0 +1,116 @@
+// Copyright (c) 2020-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package sqlstore
+
+import (
+	"context"
+	"database/sql"
+	"strings"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/mattermost/mattermost-plugin-boards/server/services/tracing"
+)
+
+// contextRunner runs the statements of a store method with the context of the method, so that
+// they are cancelled with it, and records a span with the SQL text of each statement when
+// tracing is enabled.
+type contextRunner struct {
+	ctx     context.Context
+	db      sq.StdSqlCtx
+	dbType  string
+	timeout time.Duration
+	cancels []context.CancelFunc
+}
+
+// runner returns the runner of the statements of a store method on the database or on a
+// transaction. The runner is closed once the method is done.
+func (s *SQLStore) runner(ctx context.Context, db sq.StdSqlCtx) *contextRunner {
+	return &contextRunner{ctx: ctx, db: db, dbType: s.dbType, timeout: s.queryTimeout}
+}
+
+// withTimeout returns the context of a statement, which times out after the query timeout of
+// the store if any. Each statement has its own timeout, so that the transactions and batches of
+// a store method are not bounded as a whole.
+func (r *contextRunner) withTimeout(ctx context.Context) (context.Context, context.CancelFunc) {
+	if r.timeout <= 0 {
+		return ctx, func() {}
+	}
+	return context.WithTimeout(ctx, r.timeout)
+}
+
+// keepTimeout returns the context of a query. Its rows are read after the query returns, so
+// its timeout is only released when the runner is closed.
+func (r *contextRunner) keepTimeout(ctx context.Context) context.Context {
+	ctx, cancel := r.withTimeout(ctx)
+	r.cancels = append(r.cancels, cancel)
+	return ctx
+}
+
+// close releases the timeouts of the queries run.
+func (r *contextRunner) close() {
+	for _, cancel := range r.cancels {
+		cancel()
+	}
+	r.cancels = nil
+}
+
+func (r *contextRunner) startSpan(ctx context.Context, query string) (context.Context, trace.Span) {
+	operation := strings.ToUpper(strings.SplitN(strings.TrimSpace(query), " ", 2)[0])
+	return tracing.StartSpan(ctx, "sql."+strings.ToLower(operation),
+		attribute.String("db.system", r.dbType),
+		attribute.String("db.operation", operation),
+		attribute.String("db.statement", query),
+	)
+}
+
+func (r *contextRunner) Exec(query string, args ...interface{}) (sql.Result, error) {
+	return r.ExecContext(r.ctx, query, args...)
+}
+
+func (r *contextRunner) Query(query string, args ...interface{}) (*sql.Rows, error) {
+	return r.QueryContext(r.ctx, query, args...)
+}
+
+func (r *contextRunner) QueryRow(query string, args ...interface{}) *sql.Row {
+	return r.QueryRowContext(r.ctx, query, args...)
+}
+
+func (r *contextRunner) ExecContext(ctx context.Context, query string, args ...interface{}) (sql.Result, error) {
+	ctx, cancel := r.withTimeout(ctx)
+	defer cancel()
+
+	if !tracing.Enabled() {
+		return r.db.ExecContext(ctx, query, args...)
+	}
+	ctx, span := r.startSpan(ctx, query)
+	result, err := r.db.ExecContext(ctx, query, args...)
+	tracing.EndSpan(span, err)
+	return result, err
+}
+
+func (r *contextRunner) QueryContext(ctx context.Context, query string, args ...interface{}) (*sql.Rows, error) {
+	ctx = r.keepTimeout(ctx)
+	if !tracing.Enabled() {
+		return r.db.QueryContext(ctx, query, args...)
+	}
+	ctx, span := r.startSpan(ctx, query)
+	rows, err := r.db.QueryContext(ctx, query, args...)
+	tracing.EndSpan(span, err)
+	return rows, err
+}
+
+func (r *contextRunner) QueryRowContext(ctx context.Context, query string, args ...interface{}) *sql.Row {
+	ctx = r.keepTimeout(ctx)
+	if !tracing.Enabled() {
+		return r.db.QueryRowContext(ctx, query, args...)
+	}
+	ctx, span := r.startSpan(ctx, query)
+	row := r.db.QueryRowContext(ctx, query, args...)
+	tracing.EndSpan(span, row.Err())
+	return row
+}
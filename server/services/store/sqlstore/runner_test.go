@@ -0,0 +1,121 @@
+// Copyright (c) 2020-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package sqlstore
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func setupRunnerTest(t *testing.T, queryTimeout time.Duration) *SQLStore {
+	dbType, connectionString, err := PrepareNewTestDatabase()
+	require.NoError(t, err)
+
+	sqlDB, err := sql.Open(dbType, connectionString)
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = sqlDB.Close() })
+
+	return &SQLStore{db: sqlDB, dbType: dbType, queryTimeout: queryTimeout}
+}
+
+func TestStatementTimeout(t *testing.T) {
+	t.Run("without a query timeout", func(t *testing.T) {
+		s := setupRunnerTest(t, 0)
+		runner := s.runner(context.Background(), s.db)
+		defer runner.close()
+
+		ctx := runner.keepTimeout(context.Background())
+		_, ok := ctx.Deadline()
+		assert.False(t, ok)
+	})
+
+	t.Run("with a query timeout", func(t *testing.T) {
+		s := setupRunnerTest(t, time.Minute)
+		runner := s.runner(context.Background(), s.db)
+		defer runner.close()
+
+		ctx := runner.keepTimeout(context.Background())
+		deadline, ok := ctx.Deadline()
+		require.True(t, ok)
+		assert.WithinDuration(t, time.Now().Add(time.Minute), deadline, 5*time.Second)
+	})
+
+	t.Run("cancelled with the context of the method", func(t *testing.T) {
+		s := setupRunnerTest(t, time.Minute)
+		runner := s.runner(context.Background(), s.db)
+		defer runner.close()
+
+		parent, cancelParent := context.WithCancel(context.Background())
+		ctx := runner.keepTimeout(parent)
+
+		cancelParent()
+		assert.ErrorIs(t, ctx.Err(), context.Canceled)
+	})
+
+	t.Run("released when the runner is closed", func(t *testing.T) {
+		s := setupRunnerTest(t, time.Minute)
+		runner := s.runner(context.Background(), s.db)
+
+		ctx := runner.keepTimeout(context.Background())
+		require.NoError(t, ctx.Err())
+
+		runner.close()
+		assert.ErrorIs(t, ctx.Err(), context.Canceled)
+	})
+
+	t.Run("each statement has its own timeout", func(t *testing.T) {
+		s := setupRunnerTest(t, 200*time.Millisecond)
+		runner := s.runner(context.Background(), s.db)
+		defer runner.close()
+
+		for i := 0; i < 3; i++ {
+			time.Sleep(100 * time.Millisecond)
+
+			var value int
+			err := s.getQueryBuilder(runner).
+				Select("1").
+				QueryRow().
+				Scan(&value)
+			require.NoError(t, err)
+		}
+	})
+}
+
+func TestRunner(t *testing.T) {
+	t.Run("runs the statements", func(t *testing.T) {
+		s := setupRunnerTest(t, 0)
+
+		var value int
+		err := s.getQueryBuilder(s.runner(context.Background(), s.db)).
+			Select("1").
+			QueryRow().
+			Scan(&value)
+		require.NoError(t, err)
+		assert.Equal(t, 1, value)
+	})
+
+	t.Run("cancelled statements", func(t *testing.T) {
+		s := setupRunnerTest(t, 0)
+
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+
+		var value int
+		err := s.getQueryBuilder(s.runner(ctx, s.db)).
+			Select("1").
+			QueryRow().
+			Scan(&value)
+		assert.ErrorIs(t, err, context.Canceled)
+
+		_, err = s.getQueryBuilder(s.runner(ctx, s.db)).
+			Select("1").
+			Query()
+		assert.ErrorIs(t, err, context.Canceled)
+	})
+}
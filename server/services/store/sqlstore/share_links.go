@@ -0,0 +1,207 @@
+// Copyright (c) 2020-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package sqlstore
+
+import (
+	"database/sql"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/mattermost/mattermost-plugin-boards/server/model"
+	"github.com/mattermost/mattermost-plugin-boards/server/utils"
+
+	"github.com/mattermost/mattermost/server/public/shared/mlog"
+)
+
+var shareLinkFields = []string{
+	"id",
+	"board_id",
+	"name",
+	"token",
+	"scope",
+	"scope_id",
+	"permission",
+	"password_hash",
+	"expires_at",
+	"created_by",
+	"create_at",
+	"last_used_at",
+	"revoked_at",
+}
+
+func valuesForShareLink(link *model.ShareLink) []interface{} {
+	return []interface{}{
+		link.ID,
+		link.BoardID,
+		link.Name,
+		link.Token,
+		link.Scope,
+		link.ScopeID,
+		link.Permission,
+		link.PasswordHash,
+		link.ExpiresAt,
+		link.CreatedBy,
+		link.CreateAt,
+		link.LastUsedAt,
+		link.RevokedAt,
+	}
+}
+
+func (s *SQLStore) shareLinksFromRows(rows *sql.Rows) ([]*model.ShareLink, error) {
+	links := []*model.ShareLink{}
+
+	for rows.Next() {
+		var link model.ShareLink
+		var name, scopeID, passwordHash, createdBy sql.NullString
+		var expiresAt, lastUsedAt, revokedAt sql.NullInt64
+		err := rows.Scan(
+			&link.ID,
+			&link.BoardID,
+			&name,
+			&link.Token,
+			&link.Scope,
+			&scopeID,
+			&link.Permission,
+			&passwordHash,
+			&expiresAt,
+			&createdBy,
+			&link.CreateAt,
+			&lastUsedAt,
+			&revokedAt,
+		)
+		if err != nil {
+			return nil, err
+		}
+		link.Name = name.String
+		link.ScopeID = scopeID.String
+		link.PasswordHash = passwordHash.String
+		link.HasPassword = link.PasswordHash != ""
+		link.ExpiresAt = expiresAt.Int64
+		link.CreatedBy = createdBy.String
+		link.LastUsedAt = lastUsedAt.Int64
+		link.RevokedAt = revokedAt.Int64
+		links = append(links, &link)
+	}
+	return links, nil
+}
+
+// createShareLink creates a new share link for a board.
+func (s *SQLStore) createShareLink(db sq.BaseRunner, link *model.ShareLink) (*model.ShareLink, error) {
+	linkAdd := *link
+	linkAdd.ID = utils.NewID(utils.IDTypeNone)
+	linkAdd.CreateAt = utils.GetMillis()
+	linkAdd.LastUsedAt = 0
+	linkAdd.RevokedAt = 0
+
+	if err := linkAdd.IsValid(); err != nil {
+		return nil, err
+	}
+
+	query := s.getQueryBuilder(db).
+		Insert(s.tablePrefix + "share_links").
+		Columns(shareLinkFields...).
+		Values(valuesForShareLink(&linkAdd)...)
+
+	if _, err := query.Exec(); err != nil {
+		s.logger.Error("Cannot create share link",
+			mlog.String("board_id", link.BoardID),
+			mlog.Err(err),
+		)
+		return nil, err
+	}
+	return &linkAdd, nil
+}
+
+func (s *SQLStore) getShareLinkWhere(db sq.BaseRunner, where sq.Eq, notFound string) (*model.ShareLink, error) {
+	query := s.getQueryBuilder(db).
+		Select(shareLinkFields...).
+		From(s.tablePrefix + "share_links").
+		Where(where)
+
+	rows, err := query.Query()
+	if err != nil {
+		s.logger.Error("Cannot fetch share link", mlog.Err(err))
+		return nil, err
+	}
+	defer s.CloseRows(rows)
+
+	links, err := s.shareLinksFromRows(rows)
+	if err != nil {
+		return nil, err
+	}
+	if len(links) == 0 {
+		return nil, model.NewErrNotFound(notFound)
+	}
+	return links[0], nil
+}
+
+// getShareLink fetches a share link by ID.
+func (s *SQLStore) getShareLink(db sq.BaseRunner, linkID string) (*model.ShareLink, error) {
+	return s.getShareLinkWhere(db, sq.Eq{"id": linkID}, "share link ID="+linkID)
+}
+
+// getShareLinkByToken fetches the share link of a board with the specified token.
+func (s *SQLStore) getShareLinkByToken(db sq.BaseRunner, boardID, token string) (*model.ShareLink, error) {
+	return s.getShareLinkWhere(db, sq.Eq{"board_id": boardID, "token": token}, "share link for board ID="+boardID)
+}
+
+// getShareLinksForBoard fetches all share links of a board, including revoked ones, newest first.
+func (s *SQLStore) getShareLinksForBoard(db sq.BaseRunner, boardID string) ([]*model.ShareLink, error) {
+	query := s.getQueryBuilder(db).
+		Select(shareLinkFields...).
+		From(s.tablePrefix+"share_links").
+		Where(sq.Eq{"board_id": boardID}).
+		OrderBy("create_at DESC", "id")
+
+	rows, err := query.Query()
+	if err != nil {
+		s.logger.Error("Cannot fetch share links for board",
+			mlog.String("board_id", boardID),
+			mlog.Err(err),
+		)
+		return nil, err
+	}
+	defer s.CloseRows(rows)
+
+	return s.shareLinksFromRows(rows)
+}
+
+// revokeShareLink revokes a share link so its token can no longer be used.
+func (s *SQLStore) revokeShareLink(db sq.BaseRunner, linkID string) error {
+	query := s.getQueryBuilder(db).
+		Update(s.tablePrefix+"share_links").
+		Set("revoked_at", utils.GetMillis()).
+		Where(sq.Eq{"id": linkID}).
+		Where(sq.Eq{"revoked_at": 0})
+
+	result, err := query.Exec()
+	if err != nil {
+		return err
+	}
+
+	count, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if count == 0 {
+		return model.NewErrNotFound("active share link ID=" + linkID)
+	}
+	return nil
+}
+
+// updateShareLinkLastUsed records the last time a share link was used.
+func (s *SQLStore) updateShareLinkLastUsed(db sq.BaseRunner, linkID string, lastUsedAt int64) error {
+	query := s.getQueryBuilder(db).
+		Update(s.tablePrefix+"share_links").
+		Set("last_used_at", lastUsedAt).
+		Where(sq.Eq{"id": linkID})
+
+	if _, err := query.Exec(); err != nil {
+		s.logger.Error("Cannot update share link last used",
+			mlog.String("link_id", linkID),
+			mlog.Err(err),
+		)
+		return err
+	}
+	return nil
+}
@@ -8,6 +8,7 @@ import (
 	"fmt"
 	"net/url"
 	"strings"
+	"time"
 
 	sq "github.com/Masterminds/squirrel"
 
@@ -31,6 +32,7 @@ type SQLStore struct {
 	isBinaryParam    bool
 	schemaName       string
 	configFn         func() *mmModel.Config
+	queryTimeout     time.Duration
 }
 
 // MutexFactory is used by the store in plugin mode to generate
@@ -55,6 +57,7 @@ func New(params Params) (*SQLStore, error) {
 		NewMutexFn:       params.NewMutexFn,
 		servicesAPI:      params.ServicesAPI,
 		configFn:         params.ConfigFn,
+		queryTimeout:     params.QueryTimeout,
 	}
 
 	var err error
@@ -14,6 +14,7 @@ import (
 
 func TestSQLStore(t *testing.T) {
 	t.Run("BlocksStore", func(t *testing.T) { storetests.StoreTestBlocksStore(t, SetupTests) })
+	t.Run("BlockChangesStore", func(t *testing.T) { storetests.StoreTestBlockChangesStore(t, SetupTests) })
 	t.Run("SharingStore", func(t *testing.T) { storetests.StoreTestSharingStore(t, SetupTests) })
 	t.Run("SystemStore", func(t *testing.T) { storetests.StoreTestSystemStore(t, SetupTests) })
 	t.Run("UserStore", func(t *testing.T) { storetests.StoreTestUserStore(t, SetupTests) })
@@ -22,6 +23,17 @@ func TestSQLStore(t *testing.T) {
 	t.Run("BoardStore", func(t *testing.T) { storetests.StoreTestBoardStore(t, SetupTests) })
 	t.Run("BoardsAndBlocksStore", func(t *testing.T) { storetests.StoreTestBoardsAndBlocksStore(t, SetupTests) })
 	t.Run("NotificationHintStore", func(t *testing.T) { storetests.StoreTestNotificationHintsStore(t, SetupTests) })
+	t.Run("NotificationPreferencesStore", func(t *testing.T) { storetests.StoreTestNotificationPreferencesStore(t, SetupTests) })
+	t.Run("NotificationsStore", func(t *testing.T) { storetests.StoreTestNotificationsStore(t, SetupTests) })
+	t.Run("CommentReactionsStore", func(t *testing.T) { storetests.StoreTestCommentReactionsStore(t, SetupTests) })
+	t.Run("ShareLinksStore", func(t *testing.T) { storetests.StoreTestShareLinksStore(t, SetupTests) })
+	t.Run("BoardFormsStore", func(t *testing.T) { storetests.StoreTestBoardFormsStore(t, SetupTests) })
+	t.Run("FileScansStore", func(t *testing.T) { storetests.StoreTestFileScansStore(t, SetupTests) })
+	t.Run("FileReferencesStore", func(t *testing.T) { storetests.StoreTestFileReferencesStore(t, SetupTests) })
+	t.Run("StorageQuotasStore", func(t *testing.T) { storetests.StoreTestStorageQuotasStore(t, SetupTests) })
+	t.Run("AuditEntriesStore", func(t *testing.T) { storetests.StoreTestAuditEntriesStore(t, SetupTests) })
+	t.Run("BoardRestoreStore", func(t *testing.T) { storetests.StoreTestBoardRestoreStore(t, SetupTests) })
+	t.Run("StatisticsStore", func(t *testing.T) { storetests.StoreTestStatisticsStore(t, SetupTests) })
 	t.Run("DataRetention", func(t *testing.T) { storetests.StoreTestDataRetention(t, SetupTests) })
 	t.Run("CloudStore", func(t *testing.T) { storetests.StoreTestCloudStore(t, SetupTests) })
 	t.Run("StoreTestFileStore", func(t *testing.T) { storetests.StoreTestFileStore(t, SetupTests) })
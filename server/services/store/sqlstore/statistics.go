@@ -0,0 +1,307 @@
+// Copyright (c) 2020-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package sqlstore
+
+import (
+	"fmt"
+	"sort"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+
+	"github.com/mattermost/mattermost-plugin-boards/server/model"
+
+	"github.com/mattermost/mattermost/server/public/shared/mlog"
+)
+
+const (
+	activeUsersDay   = 24 * time.Hour
+	activeUsersWeek  = 7 * activeUsersDay
+	activeUsersMonth = 30 * activeUsersDay
+)
+
+// activeUserColumns returns the columns counting the users who changed blocks in the last 1, 7
+// and 30 days before the given time.
+func activeUserColumns(query sq.SelectBuilder, at int64) sq.SelectBuilder {
+	for _, period := range []time.Duration{activeUsersDay, activeUsersWeek, activeUsersMonth} {
+		query = query.Column(sq.Expr(
+			"COUNT(DISTINCT CASE WHEN bh.update_at > ? THEN bh.modified_by END)",
+			at-period.Milliseconds(),
+		))
+	}
+	return query
+}
+
+// getBlockCountsByTypeWithOptions returns the number of blocks of each type of the boards of a
+// team, or of all teams, created in a date range.
+func (s *SQLStore) getBlockCountsByTypeWithOptions(db sq.BaseRunner, opts model.StatisticsOptions) (map[string]int64, error) {
+	query := s.getQueryBuilder(db).
+		Select(
+			"b.type",
+			"COUNT(*) AS count",
+		).
+		From(s.tablePrefix + "blocks b").
+		Join(s.tablePrefix + "boards bd on b.board_id=bd.id").
+		Where(sq.Eq{
+			"bd.is_template": false,
+			"bd.delete_at":   0,
+		}).
+		GroupBy("b.type")
+
+	if opts.TeamID != "" {
+		query = query.Where(sq.Eq{"bd.team_id": opts.TeamID})
+	}
+	if opts.From != 0 {
+		query = query.Where(sq.GtOrEq{"b.create_at": opts.From})
+	}
+	if opts.To != 0 {
+		query = query.Where(sq.LtOrEq{"b.create_at": opts.To})
+	}
+
+	rows, err := query.Query()
+	if err != nil {
+		s.logger.Error(`GetBlockCountsByTypeWithOptions ERROR`, mlog.Err(err))
+		return nil, err
+	}
+	defer s.CloseRows(rows)
+
+	m := make(map[string]int64)
+	for rows.Next() {
+		var blockType string
+		var count int64
+		if err := rows.Scan(&blockType, &count); err != nil {
+			return nil, err
+		}
+		m[blockType] = count
+	}
+	return m, rows.Err()
+}
+
+// getTeamBoardAndCardCounts returns the number of boards and cards of a team, or of each team
+// if teamID is empty. Only the TeamID, Boards and Cards of the statistics are set.
+func (s *SQLStore) getTeamBoardAndCardCounts(db sq.BaseRunner, teamID string) ([]*model.TeamStatistics, error) {
+	boardsQuery := s.getQueryBuilder(db).
+		Select("team_id", "COUNT(*)").
+		From(s.tablePrefix + "boards").
+		Where(sq.Eq{
+			"is_template": false,
+			"delete_at":   0,
+		}).
+		GroupBy("team_id").
+		OrderBy("team_id")
+	if teamID != "" {
+		boardsQuery = boardsQuery.Where(sq.Eq{"team_id": teamID})
+	}
+
+	rows, err := boardsQuery.Query()
+	if err != nil {
+		s.logger.Error(`GetTeamBoardAndCardCounts ERROR`, mlog.Err(err))
+		return nil, err
+	}
+	defer s.CloseRows(rows)
+
+	results := []*model.TeamStatistics{}
+	byTeam := map[string]*model.TeamStatistics{}
+	for rows.Next() {
+		var stats model.TeamStatistics
+		if err := rows.Scan(&stats.TeamID, &stats.Boards); err != nil {
+			return nil, err
+		}
+		results = append(results, &stats)
+		byTeam[stats.TeamID] = &stats
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	cardsQuery := s.activeCardsQuery(s.getQueryBuilder(db), "bd.team_id", 0, false).
+		Column("COUNT(b.id)").
+		GroupBy("bd.team_id")
+	if teamID != "" {
+		cardsQuery = cardsQuery.Where(sq.Eq{"bd.team_id": teamID})
+	}
+
+	cardRows, err := cardsQuery.Query()
+	if err != nil {
+		s.logger.Error(`GetTeamBoardAndCardCounts ERROR`, mlog.Err(err))
+		return nil, err
+	}
+	defer s.CloseRows(cardRows)
+
+	for cardRows.Next() {
+		var team string
+		var count int64
+		if err := cardRows.Scan(&team, &count); err != nil {
+			return nil, err
+		}
+		if stats, ok := byTeam[team]; ok {
+			stats.Cards = count
+		}
+	}
+	return results, cardRows.Err()
+}
+
+// getActiveUserCountsByTeam returns the number of users who changed the blocks of the boards of
+// a team, or of all teams if teamID is empty, in the last 1, 7 and 30 days before the given time,
+// in total and for each team.
+func (s *SQLStore) getActiveUserCountsByTeam(db sq.BaseRunner, teamID string, at int64) (total model.ActiveUserCounts, byTeam map[string]model.ActiveUserCounts, err error) {
+	activeUsersQuery := func(columns ...string) sq.SelectBuilder {
+		query := activeUserColumns(s.getQueryBuilder(db).Select(columns...), at).
+			From(s.tablePrefix + "blocks_history bh").
+			Join(s.tablePrefix + "boards b on bh.board_id=b.id").
+			Where(sq.Eq{"b.is_template": false}).
+			Where(sq.Gt{"bh.update_at": at - activeUsersMonth.Milliseconds()}).
+			Where(sq.LtOrEq{"bh.update_at": at})
+		if teamID != "" {
+			query = query.Where(sq.Eq{"b.team_id": teamID})
+		}
+		return query
+	}
+
+	err = activeUsersQuery().QueryRow().Scan(&total.Day, &total.Week, &total.Month)
+	if err != nil {
+		s.logger.Error(`GetActiveUserCountsByTeam ERROR`, mlog.Err(err))
+		return total, nil, err
+	}
+
+	rows, err := activeUsersQuery("b.team_id").GroupBy("b.team_id").Query()
+	if err != nil {
+		s.logger.Error(`GetActiveUserCountsByTeam ERROR`, mlog.Err(err))
+		return total, nil, err
+	}
+	defer s.CloseRows(rows)
+
+	byTeam = map[string]model.ActiveUserCounts{}
+	for rows.Next() {
+		var team string
+		var count model.ActiveUserCounts
+		if err := rows.Scan(&team, &count.Day, &count.Week, &count.Month); err != nil {
+			return total, nil, err
+		}
+		byTeam[team] = count
+	}
+	return total, byTeam, rows.Err()
+}
+
+// getDailyActivity returns the number of cards created and comments added in a date range on each
+// day, in UTC, keyed by the start of the day in milliseconds since the current epoch. The cards
+// completed are not set.
+func (s *SQLStore) getDailyActivity(db sq.BaseRunner, opts model.StatisticsOptions) (map[int64]model.ActivityCounts, error) {
+	// the day is computed with an integer division, which MySQL has its own operator for.
+	dayLength := activeUsersDay.Milliseconds()
+	day := fmt.Sprintf("bh.create_at / %d", dayLength)
+	if s.dbType == model.MysqlDBType {
+		day = fmt.Sprintf("bh.create_at DIV %d", dayLength)
+	}
+
+	query := s.getQueryBuilder(db).
+		Select(day).
+		Column(sq.Expr("COUNT(DISTINCT CASE WHEN bh.type = ? THEN bh.id END)", model.TypeCard)).
+		Column(sq.Expr("COUNT(DISTINCT CASE WHEN bh.type = ? THEN bh.id END)", model.TypeComment)).
+		From(s.tablePrefix + "blocks_history bh").
+		Join(s.tablePrefix + "boards b on bh.board_id=b.id").
+		Where(sq.Eq{
+			"bh.type":       []model.BlockType{model.TypeCard, model.TypeComment},
+			"b.is_template": false,
+			"b.delete_at":   0,
+		}).
+		Where(sq.GtOrEq{"bh.create_at": opts.From}).
+		Where(sq.LtOrEq{"bh.create_at": opts.To}).
+		GroupBy(day)
+	if opts.TeamID != "" {
+		query = query.Where(sq.Eq{"b.team_id": opts.TeamID})
+	}
+
+	rows, err := query.Query()
+	if err != nil {
+		s.logger.Error(`GetDailyActivity ERROR`, mlog.Err(err))
+		return nil, err
+	}
+	defer s.CloseRows(rows)
+
+	results := map[int64]model.ActivityCounts{}
+	for rows.Next() {
+		var day int64
+		var counts model.ActivityCounts
+		if err := rows.Scan(&day, &counts.CardsCreated, &counts.Comments); err != nil {
+			return nil, err
+		}
+		results[day*dayLength] = counts
+	}
+	return results, rows.Err()
+}
+
+// getBoardActivity returns the activity of the boards changed in a date range, most changed
+// first. The file usage is not set.
+func (s *SQLStore) getBoardActivity(db sq.BaseRunner, opts model.StatisticsOptions) ([]*model.BoardActivity, error) {
+	since := opts.From
+	if monthAgo := opts.To - activeUsersMonth.Milliseconds(); monthAgo < since {
+		since = monthAgo
+	}
+
+	query := s.getQueryBuilder(db).
+		Select("bh.board_id", "b.team_id", "b.title").
+		Column(sq.Expr("COUNT(CASE WHEN bh.update_at >= ? THEN 1 END)", opts.From)).
+		Column(sq.Expr("COUNT(CASE WHEN bh.update_at >= ? AND bh.type = ? THEN 1 END)", opts.From, model.TypeCard)).
+		Column(sq.Expr("COUNT(DISTINCT CASE WHEN bh.update_at >= ? THEN bh.modified_by END)", opts.From))
+	query = activeUserColumns(query, opts.To).
+		Column(sq.Expr("COUNT(DISTINCT CASE WHEN bh.type = ? AND bh.create_at >= ? AND bh.create_at <= ? THEN bh.id END)", model.TypeCard, opts.From, opts.To)).
+		Column(sq.Expr("COUNT(DISTINCT CASE WHEN bh.type = ? AND bh.create_at >= ? AND bh.create_at <= ? THEN bh.id END)", model.TypeComment, opts.From, opts.To)).
+		From(s.tablePrefix+"blocks_history bh").
+		Join(s.tablePrefix+"boards b on bh.board_id=b.id").
+		Where(sq.Eq{
+			"b.is_template": false,
+			"b.delete_at":   0,
+		}).
+		Where(sq.GtOrEq{"bh.update_at": since}).
+		Where(sq.LtOrEq{"bh.update_at": opts.To}).
+		GroupBy("bh.board_id", "b.team_id", "b.title")
+	if opts.TeamID != "" {
+		query = query.Where(sq.Eq{"b.team_id": opts.TeamID})
+	}
+
+	rows, err := query.Query()
+	if err != nil {
+		s.logger.Error(`GetBoardActivity ERROR`, mlog.Err(err))
+		return nil, err
+	}
+	defer s.CloseRows(rows)
+
+	results := []*model.BoardActivity{}
+	for rows.Next() {
+		var activity model.BoardActivity
+		err := rows.Scan(
+			&activity.BoardID,
+			&activity.TeamID,
+			&activity.Title,
+			&activity.Changes,
+			&activity.CardChanges,
+			&activity.Editors,
+			&activity.ActiveUsers.Day,
+			&activity.ActiveUsers.Week,
+			&activity.ActiveUsers.Month,
+			&activity.Activity.CardsCreated,
+			&activity.Activity.Comments,
+		)
+		if err != nil {
+			return nil, err
+		}
+		// boards only changed in the month before the date range
+		if activity.Changes == 0 {
+			continue
+		}
+		results = append(results, &activity)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	sort.SliceStable(results, func(i, j int) bool {
+		if results[i].Changes != results[j].Changes {
+			return results[i].Changes > results[j].Changes
+		}
+		return results[i].BoardID < results[j].BoardID
+	})
+	return results, nil
+}
@@ -0,0 +1,311 @@
+// Copyright (c) 2020-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package sqlstore
+
+import (
+	"database/sql"
+	"errors"
+	"fmt"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/mattermost/mattermost-plugin-boards/server/model"
+	"github.com/mattermost/mattermost-plugin-boards/server/utils"
+
+	"github.com/mattermost/mattermost/server/public/shared/mlog"
+)
+
+// addFileUsage adds bytes and fileCount, which may be negative, to the storage usage of a board.
+// The usage never goes below zero.
+func (s *SQLStore) addFileUsage(db sq.BaseRunner, teamID, boardID string, bytes, fileCount int64) error {
+	now := utils.GetMillis()
+	query := s.getQueryBuilder(db).
+		Insert(s.tablePrefix+"file_usage").
+		Columns(
+			"board_id",
+			"team_id",
+			"bytes",
+			"file_count",
+			"update_at",
+		).
+		Values(
+			boardID,
+			teamID,
+			max(bytes, 0),
+			max(fileCount, 0),
+			now,
+		)
+
+	greatest := "GREATEST"
+	if s.dbType == model.SqliteDBType {
+		greatest = "MAX"
+	}
+	if s.dbType == model.MysqlDBType {
+		query = query.Suffix(
+			fmt.Sprintf("ON DUPLICATE KEY UPDATE team_id = ?, bytes = %[1]s(bytes + ?, 0), file_count = %[1]s(file_count + ?, 0), update_at = ?", greatest),
+			teamID, bytes, fileCount, now)
+	} else {
+		table := s.tablePrefix + "file_usage"
+		query = query.Suffix(
+			fmt.Sprintf(`ON CONFLICT (board_id)
+			 DO UPDATE SET team_id = EXCLUDED.team_id, bytes = %[1]s(%[2]s.bytes + ?, 0),
+			 file_count = %[1]s(%[2]s.file_count + ?, 0), update_at = EXCLUDED.update_at`, greatest, table),
+			bytes, fileCount)
+	}
+
+	if _, err := query.Exec(); err != nil {
+		s.logger.Error("Cannot update file usage",
+			mlog.String("board_id", boardID),
+			mlog.Err(err),
+		)
+		return err
+	}
+	return nil
+}
+
+// reserveFileUsage adds bytes and a file to the storage usage of a board, unless it would exceed
+// the quota of the board or of its team, zero meaning no quota, in which case an
+// ErrStorageQuotaExceeded is returned. The usage rows of the team are locked while checking the
+// quotas, so that concurrent reservations are checked one after the other.
+func (s *SQLStore) reserveFileUsage(db sq.BaseRunner, teamID, boardID string, bytes, teamQuota, boardQuota int64) error {
+	// the row of the board is created first, so that it is locked with the others of its team.
+	if err := s.addFileUsage(db, teamID, boardID, 0, 0); err != nil {
+		return err
+	}
+
+	query := s.getQueryBuilder(db).
+		Select(
+			"board_id",
+			"bytes",
+		).
+		From(s.tablePrefix + "file_usage").
+		Where(sq.Eq{"team_id": teamID})
+	if s.dbType != model.SqliteDBType {
+		query = query.Suffix("FOR UPDATE")
+	}
+
+	rows, err := query.Query()
+	if err != nil {
+		return err
+	}
+	var teamBytes, boardBytes int64
+	for rows.Next() {
+		var rowBoardID string
+		var rowBytes int64
+		if err := rows.Scan(&rowBoardID, &rowBytes); err != nil {
+			s.CloseRows(rows)
+			return err
+		}
+		teamBytes += rowBytes
+		if rowBoardID == boardID {
+			boardBytes = rowBytes
+		}
+	}
+	err = rows.Err()
+	s.CloseRows(rows)
+	if err != nil {
+		return err
+	}
+
+	if boardQuota > 0 && boardBytes+bytes > boardQuota {
+		return model.NewErrStorageQuotaExceeded(model.StorageQuotaScopeBoard, boardID, boardBytes, boardQuota)
+	}
+	if teamQuota > 0 && teamBytes+bytes > teamQuota {
+		return model.NewErrStorageQuotaExceeded(model.StorageQuotaScopeTeam, teamID, teamBytes, teamQuota)
+	}
+
+	return s.addFileUsage(db, teamID, boardID, bytes, 1)
+}
+
+// addBoardFileUsage adds bytes and fileCount, which may be negative, to the storage usage of a
+// board, charged to the team the board belongs to. Deleted boards only have their existing usage
+// updated.
+func (s *SQLStore) addBoardFileUsage(db sq.BaseRunner, boardID string, bytes, fileCount int64) error {
+	if bytes == 0 && fileCount == 0 {
+		return nil
+	}
+
+	var teamID string
+	err := s.getQueryBuilder(db).
+		Select("team_id").
+		From(s.tablePrefix + "boards").
+		Where(sq.Eq{"id": boardID}).
+		QueryRow().
+		Scan(&teamID)
+	if err == nil {
+		return s.addFileUsage(db, teamID, boardID, bytes, fileCount)
+	}
+	if !errors.Is(err, sql.ErrNoRows) {
+		return err
+	}
+
+	greatest := "GREATEST"
+	if s.dbType == model.SqliteDBType {
+		greatest = "MAX"
+	}
+	query := s.getQueryBuilder(db).
+		Update(s.tablePrefix+"file_usage").
+		Set("bytes", sq.Expr(greatest+"(bytes + ?, 0)", bytes)).
+		Set("file_count", sq.Expr(greatest+"(file_count + ?, 0)", fileCount)).
+		Set("update_at", utils.GetMillis()).
+		Where(sq.Eq{"board_id": boardID})
+
+	if _, err := query.Exec(); err != nil {
+		return err
+	}
+	return nil
+}
+
+// getBoardFileUsage returns the storage usage of a board, which is zero if it has no files.
+func (s *SQLStore) getBoardFileUsage(db sq.BaseRunner, boardID string) (*model.FileUsage, error) {
+	query := s.getQueryBuilder(db).
+		Select(
+			"team_id",
+			"bytes",
+			"file_count",
+		).
+		From(s.tablePrefix + "file_usage").
+		Where(sq.Eq{"board_id": boardID})
+
+	usage := model.FileUsage{BoardID: boardID}
+	err := query.QueryRow().Scan(&usage.TeamID, &usage.Bytes, &usage.FileCount)
+	if err != nil && !errors.Is(err, sql.ErrNoRows) {
+		return nil, err
+	}
+	return &usage, nil
+}
+
+// getTeamFileUsage returns the storage usage of all the boards of a team.
+func (s *SQLStore) getTeamFileUsage(db sq.BaseRunner, teamID string) (*model.FileUsage, error) {
+	query := s.getQueryBuilder(db).
+		Select(
+			"COALESCE(SUM(bytes), 0)",
+			"COALESCE(SUM(file_count), 0)",
+		).
+		From(s.tablePrefix + "file_usage").
+		Where(sq.Eq{"team_id": teamID})
+
+	usage := model.FileUsage{TeamID: teamID}
+	if err := query.QueryRow().Scan(&usage.Bytes, &usage.FileCount); err != nil {
+		return nil, err
+	}
+	return &usage, nil
+}
+
+// getFileUsageByTeam returns the storage usage of every team with stored files.
+func (s *SQLStore) getFileUsageByTeam(db sq.BaseRunner) ([]*model.FileUsage, error) {
+	query := s.getQueryBuilder(db).
+		Select(
+			"team_id",
+			"SUM(bytes)",
+			"SUM(file_count)",
+		).
+		From(s.tablePrefix + "file_usage").
+		GroupBy("team_id").
+		OrderBy("team_id")
+
+	rows, err := query.Query()
+	if err != nil {
+		s.logger.Error("Cannot get file usage by team", mlog.Err(err))
+		return nil, err
+	}
+	defer s.CloseRows(rows)
+
+	results := []*model.FileUsage{}
+	for rows.Next() {
+		var usage model.FileUsage
+		if err := rows.Scan(&usage.TeamID, &usage.Bytes, &usage.FileCount); err != nil {
+			return nil, err
+		}
+		results = append(results, &usage)
+	}
+	return results, rows.Err()
+}
+
+// upsertStorageQuota sets the storage quota of a team or board, replacing any previous one.
+func (s *SQLStore) upsertStorageQuota(db sq.BaseRunner, quota *model.StorageQuota) error {
+	query := s.getQueryBuilder(db).
+		Insert(s.tablePrefix+"storage_quotas").
+		Columns(
+			"scope",
+			"scope_id",
+			"max_bytes",
+			"modified_by",
+			"update_at",
+		).
+		Values(
+			quota.Scope,
+			quota.ScopeID,
+			quota.MaxBytes,
+			quota.ModifiedBy,
+			quota.UpdateAt,
+		)
+	if s.dbType == model.MysqlDBType {
+		query = query.Suffix("ON DUPLICATE KEY UPDATE max_bytes = ?, modified_by = ?, update_at = ?",
+			quota.MaxBytes, quota.ModifiedBy, quota.UpdateAt)
+	} else {
+		query = query.Suffix(
+			`ON CONFLICT (scope, scope_id)
+			 DO UPDATE SET max_bytes = EXCLUDED.max_bytes, modified_by = EXCLUDED.modified_by,
+			 update_at = EXCLUDED.update_at`,
+		)
+	}
+
+	if _, err := query.Exec(); err != nil {
+		s.logger.Error("Cannot upsert storage quota",
+			mlog.String("scope", string(quota.Scope)),
+			mlog.String("scope_id", quota.ScopeID),
+			mlog.Err(err),
+		)
+		return err
+	}
+	return nil
+}
+
+// getStorageQuota fetches the storage quota set for a team or board.
+func (s *SQLStore) getStorageQuota(db sq.BaseRunner, scope model.StorageQuotaScope, scopeID string) (*model.StorageQuota, error) {
+	query := s.getQueryBuilder(db).
+		Select(
+			"scope",
+			"scope_id",
+			"max_bytes",
+			"modified_by",
+			"update_at",
+		).
+		From(s.tablePrefix + "storage_quotas").
+		Where(sq.Eq{"scope": scope}).
+		Where(sq.Eq{"scope_id": scopeID})
+
+	var quota model.StorageQuota
+	var modifiedBy sql.NullString
+	err := query.QueryRow().Scan(
+		&quota.Scope,
+		&quota.ScopeID,
+		&quota.MaxBytes,
+		&modifiedBy,
+		&quota.UpdateAt,
+	)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, model.NewErrNotFound(fmt.Sprintf("storage quota for %s ID=%s", scope, scopeID))
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	quota.ModifiedBy = modifiedBy.String
+	return &quota, nil
+}
+
+// deleteStorageQuota removes the storage quota set for a team or board, which falls back to the
+// default quota of the server.
+func (s *SQLStore) deleteStorageQuota(db sq.BaseRunner, scope model.StorageQuotaScope, scopeID string) error {
+	query := s.getQueryBuilder(db).
+		Delete(s.tablePrefix + "storage_quotas").
+		Where(sq.Eq{"scope": scope}).
+		Where(sq.Eq{"scope_id": scopeID})
+
+	if _, err := query.Exec(); err != nil {
+		return err
+	}
+	return nil
+}
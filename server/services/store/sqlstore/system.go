@@ -66,3 +66,17 @@ func (s *SQLStore) setSystemSetting(db sq.BaseRunner, id, value string) error {
 
 	return nil
 }
+
+// setSystemSettingIfAbsent stores a setting unless it is already set, keeping the existing value.
+func (s *SQLStore) setSystemSettingIfAbsent(db sq.BaseRunner, id, value string) error {
+	query := s.getQueryBuilder(db).Insert(s.tablePrefix+"system_settings").Columns("id", "value").Values(id, value)
+
+	if s.dbType == model.MysqlDBType {
+		query = query.Options("IGNORE")
+	} else {
+		query = query.Suffix("ON CONFLICT (id) DO NOTHING")
+	}
+
+	_, err := query.Exec()
+	return err
+}
@@ -25,7 +25,7 @@ func (unf UserNotFoundError) Error() string {
 	return fmt.Sprintf("user not found (%s)", unf.id)
 }
 
-func (s *SQLStore) baseUserQuery(showEmail, showName bool) sq.SelectBuilder {
+func (s *SQLStore) baseUserQuery(db sq.BaseRunner, showEmail, showName bool) sq.SelectBuilder {
 	emailField := "''"
 	if showEmail {
 		emailField = "u.email"
@@ -37,7 +37,7 @@ func (s *SQLStore) baseUserQuery(showEmail, showName bool) sq.SelectBuilder {
 		lastNameField = "u.lastname"
 	}
 
-	return s.getQueryBuilder(s.db).
+	return s.getQueryBuilder(db).
 		Select(
 			"u.id",
 			"u.username",
@@ -259,7 +259,7 @@ func (s *SQLStore) getActiveUserCount(db sq.BaseRunner, updatedSecondsAgo int64)
 }
 
 func (s *SQLStore) getUsersByTeam(db sq.BaseRunner, teamID string, asGuestID string, showEmail, showName bool) ([]*model.User, error) {
-	query := s.baseUserQuery(showEmail, showName).
+	query := s.baseUserQuery(db, showEmail, showName).
 		Where(sq.Eq{"u.deleteAt": 0})
 
 	if asGuestID == "" {
@@ -295,8 +295,8 @@ func (s *SQLStore) getUsersByTeam(db sq.BaseRunner, teamID string, asGuestID str
 	return users, nil
 }
 
-func (s *SQLStore) getUsersList(_ sq.BaseRunner, userIDs []string, showEmail, showName bool) ([]*model.User, error) {
-	query := s.baseUserQuery(showEmail, showName).
+func (s *SQLStore) getUsersList(db sq.BaseRunner, userIDs []string, showEmail, showName bool) ([]*model.User, error) {
+	query := s.baseUserQuery(db, showEmail, showName).
 		Where(sq.Eq{"u.id": userIDs})
 
 	rows, err := query.Query()
@@ -331,7 +331,7 @@ func (s *SQLStore) searchUsersByTeam(db sq.BaseRunner, teamID string, searchQuer
 		fullNameField = "LOWER(u.firstname || ' ' || u.lastname)"
 	}
 
-	query := s.baseUserQuery(showEmail, showName).
+	query := s.baseUserQuery(db, showEmail, showName).
 		Where(sq.Eq{"u.deleteAt": 0}).
 		Where(sq.Or{
 			sq.Like{"LOWER(u.username)": "%" + strings.ToLower(searchQuery) + "%"},
@@ -6,6 +6,7 @@
 package store
 
 import (
+	"context"
 	"time"
 
 	"github.com/mattermost/mattermost-plugin-boards/server/model"
@@ -15,171 +16,239 @@ import (
 
 const CardLimitTimestampSystemKey = "card_limit_timestamp"
 
-// Store represents the abstraction of the data storage.
+// Store represents the abstraction of the data storage. Its methods take the context of the
+// request they serve, which cancels the statements running for it when the request ends.
 type Store interface {
-	GetBlocks(opts model.QueryBlocksOptions) ([]*model.Block, error)
-	GetBlocksWithParentAndType(boardID, parentID string, blockType string) ([]*model.Block, error)
-	GetBlocksWithParent(boardID, parentID string) ([]*model.Block, error)
-	GetBlocksByIDs(ids []string) ([]*model.Block, error)
-	GetBlocksWithType(boardID, blockType string) ([]*model.Block, error)
-	GetSubTree2(boardID, blockID string, opts model.QuerySubtreeOptions) ([]*model.Block, error)
-	GetBlocksForBoard(boardID string) ([]*model.Block, error)
+	GetBlocks(ctx context.Context, opts model.QueryBlocksOptions) ([]*model.Block, error)
+	GetBlocksWithParentAndType(ctx context.Context, boardID, parentID string, blockType string) ([]*model.Block, error)
+	GetBlocksWithParent(ctx context.Context, boardID, parentID string) ([]*model.Block, error)
+	GetBlocksByIDs(ctx context.Context, ids []string) ([]*model.Block, error)
+	GetBlocksWithType(ctx context.Context, boardID, blockType string) ([]*model.Block, error)
+	GetSubTree2(ctx context.Context, boardID, blockID string, opts model.QuerySubtreeOptions) ([]*model.Block, error)
+	GetBlocksForBoard(ctx context.Context, boardID string) ([]*model.Block, error)
 	// @withTransaction
-	InsertBlock(block *model.Block, userID string) error
+	InsertBlock(ctx context.Context, block *model.Block, userID string) error
 	// @withTransaction
-	DeleteBlock(blockID string, modifiedBy string) error
+	DeleteBlock(ctx context.Context, blockID string, modifiedBy string) error
 	// @withTransaction
-	InsertBlocks(blocks []*model.Block, userID string) error
+	InsertBlocks(ctx context.Context, blocks []*model.Block, userID string) error
 	// @withTransaction
-	UndeleteBlock(blockID string, modifiedBy string) error
+	UndeleteBlock(ctx context.Context, blockID string, modifiedBy string) error
 	// @withTransaction
-	UndeleteBoard(boardID string, modifiedBy string) error
-	GetBlockCountsByType() (map[string]int64, error)
-	GetBoardCount(includeDeleted bool) (int64, error)
-	GetBlock(blockID string) (*model.Block, error)
+	UndeleteBoard(ctx context.Context, boardID string, modifiedBy string) error
+	GetBoardRestorePlan(ctx context.Context, boardID string, at int64) (*model.BoardRestorePlan, error)
 	// @withTransaction
-	PatchBlock(blockID string, blockPatch *model.BlockPatch, userID string) error
-	GetBlockHistory(blockID string, opts model.QueryBlockHistoryOptions) ([]*model.Block, error)
-	GetBlockHistoryDescendants(boardID string, opts model.QueryBlockHistoryOptions) ([]*model.Block, error)
-	GetBlockHistoryNewestChildren(parentID string, opts model.QueryBlockHistoryChildOptions) ([]*model.Block, bool, error)
-	GetBoardHistory(boardID string, opts model.QueryBoardHistoryOptions) ([]*model.Board, error)
-	GetBoardAndCardByID(blockID string) (board *model.Board, card *model.Block, err error)
-	GetBoardAndCard(block *model.Block) (board *model.Board, card *model.Block, err error)
+	RestoreBoardToTime(ctx context.Context, boardID string, at int64, modifiedBy string) (*model.BoardRestorePlan, error)
+	GetBlockCountsByType(ctx context.Context) (map[string]int64, error)
+	GetBlockCountsByTypeWithOptions(ctx context.Context, opts model.StatisticsOptions) (map[string]int64, error)
+	GetTeamBoardAndCardCounts(ctx context.Context, teamID string) ([]*model.TeamStatistics, error)
+	GetActiveUserCountsByTeam(ctx context.Context, teamID string, at int64) (total model.ActiveUserCounts, byTeam map[string]model.ActiveUserCounts, err error)
+	GetBoardActivity(ctx context.Context, opts model.StatisticsOptions) ([]*model.BoardActivity, error)
+	GetDailyActivity(ctx context.Context, opts model.StatisticsOptions) (map[int64]model.ActivityCounts, error)
+	GetBoardCount(ctx context.Context, includeDeleted bool) (int64, error)
+	GetBlock(ctx context.Context, blockID string) (*model.Block, error)
 	// @withTransaction
-	DuplicateBoard(boardID string, userID string, toTeam string, asTemplate bool) (*model.BoardsAndBlocks, []*model.BoardMember, error)
+	PatchBlock(ctx context.Context, blockID string, blockPatch *model.BlockPatch, userID string) error
+	GetBlockHistory(ctx context.Context, blockID string, opts model.QueryBlockHistoryOptions) ([]*model.Block, error)
+	GetBlockHistoryDescendants(ctx context.Context, boardID string, opts model.QueryBlockHistoryOptions) ([]*model.Block, error)
+	GetChangedBlocks(ctx context.Context, opts model.QueryBlockChangesOptions) ([]*model.Block, error)
+	GetBlockTombstones(ctx context.Context, opts model.QueryBlockChangesOptions) ([]*model.BlockTombstone, error)
+	GetBlockHistoryNewestChildren(ctx context.Context, parentID string, opts model.QueryBlockHistoryChildOptions) ([]*model.Block, bool, error)
+	GetBoardHistory(ctx context.Context, boardID string, opts model.QueryBoardHistoryOptions) ([]*model.Board, error)
+	GetBoardAndCardByID(ctx context.Context, blockID string) (board *model.Board, card *model.Block, err error)
+	GetBoardAndCard(ctx context.Context, block *model.Block) (board *model.Board, card *model.Block, err error)
 	// @withTransaction
-	DuplicateBlock(boardID string, blockID string, userID string, asTemplate bool) ([]*model.Block, error)
+	DuplicateBoard(ctx context.Context, boardID string, userID string, toTeam string, asTemplate bool) (*model.BoardsAndBlocks, []*model.BoardMember, error)
 	// @withTransaction
-	PatchBlocks(blockPatches *model.BlockPatchBatch, userID string) error
+	DuplicateBlock(ctx context.Context, boardID string, blockID string, userID string, asTemplate bool) ([]*model.Block, error)
+	// @withTransaction
+	PatchBlocks(ctx context.Context, blockPatches *model.BlockPatchBatch, userID string) error
 
 	Shutdown() error
 
-	GetSystemSetting(key string) (string, error)
-	GetSystemSettings() (map[string]string, error)
-	SetSystemSetting(key, value string) error
-
-	GetRegisteredUserCount() (int, error)
-	GetUserByID(userID string) (*model.User, error)
-	GetUsersList(userIDs []string, showEmail, showName bool) ([]*model.User, error)
-	GetUserByEmail(email string) (*model.User, error)
-	GetUserByUsername(username string) (*model.User, error)
-	GetUsersByTeam(teamID string, asGuestID string, showEmail, showName bool) ([]*model.User, error)
-	SearchUsersByTeam(teamID string, searchQuery string, asGuestID string, excludeBots bool, showEmail, showName bool) ([]*model.User, error)
-	PatchUserPreferences(userID string, patch model.UserPreferencesPatch) (mmModel.Preferences, error)
-	GetUserPreferences(userID string) (mmModel.Preferences, error)
-
-	GetActiveUserCount(updatedSecondsAgo int64) (int, error)
-
-	UpsertSharing(sharing model.Sharing) error
-	GetSharing(rootID string) (*model.Sharing, error)
-
-	UpsertTeamSignupToken(team model.Team) error
-	UpsertTeamSettings(team model.Team) error
-	GetTeam(ID string) (*model.Team, error)
-	GetTeamsForUser(userID string) ([]*model.Team, error)
-	GetAllTeams() ([]*model.Team, error)
-	GetTeamCount() (int64, error)
-
-	InsertBoard(board *model.Board, userID string) (*model.Board, error)
+	GetSystemSetting(ctx context.Context, key string) (string, error)
+	GetSystemSettings(ctx context.Context) (map[string]string, error)
+	SetSystemSetting(ctx context.Context, key, value string) error
+	SetSystemSettingIfAbsent(ctx context.Context, key, value string) error
+
+	GetRegisteredUserCount(ctx context.Context) (int, error)
+	GetUserByID(ctx context.Context, userID string) (*model.User, error)
+	GetUsersList(ctx context.Context, userIDs []string, showEmail, showName bool) ([]*model.User, error)
+	GetUserByEmail(ctx context.Context, email string) (*model.User, error)
+	GetUserByUsername(ctx context.Context, username string) (*model.User, error)
+	GetUsersByTeam(ctx context.Context, teamID string, asGuestID string, showEmail, showName bool) ([]*model.User, error)
+	SearchUsersByTeam(ctx context.Context, teamID string, searchQuery string, asGuestID string, excludeBots bool, showEmail, showName bool) ([]*model.User, error)
+	PatchUserPreferences(ctx context.Context, userID string, patch model.UserPreferencesPatch) (mmModel.Preferences, error)
+	GetUserPreferences(ctx context.Context, userID string) (mmModel.Preferences, error)
+
+	GetActiveUserCount(ctx context.Context, updatedSecondsAgo int64) (int, error)
+
+	UpsertSharing(ctx context.Context, sharing model.Sharing) error
+	GetSharing(ctx context.Context, rootID string) (*model.Sharing, error)
+
+	CreateShareLink(ctx context.Context, link *model.ShareLink) (*model.ShareLink, error)
+	GetShareLink(ctx context.Context, linkID string) (*model.ShareLink, error)
+	GetShareLinkByToken(ctx context.Context, boardID, token string) (*model.ShareLink, error)
+	GetShareLinksForBoard(ctx context.Context, boardID string) ([]*model.ShareLink, error)
+	RevokeShareLink(ctx context.Context, linkID string) error
+	UpdateShareLinkLastUsed(ctx context.Context, linkID string, lastUsedAt int64) error
+
+	UpsertBoardForm(ctx context.Context, form *model.BoardForm) (*model.BoardForm, error)
+	GetBoardForm(ctx context.Context, boardID string) (*model.BoardForm, error)
+
+	UpsertFileScan(ctx context.Context, scan *model.FileScan) error
+	GetFileScan(ctx context.Context, fileID string) (*model.FileScan, error)
+	GetFileIDsReferencedByBlocks(ctx context.Context, fileIDs []string) (map[string]bool, error)
+
+	AddFileUsage(ctx context.Context, teamID, boardID string, bytes, fileCount int64) error
 	// @withTransaction
-	InsertBoardWithAdmin(board *model.Board, userID string) (*model.Board, *model.BoardMember, error)
+	ReserveFileUsage(ctx context.Context, teamID, boardID string, bytes, teamQuota, boardQuota int64) error
+	GetBoardFileUsage(ctx context.Context, boardID string) (*model.FileUsage, error)
+	GetTeamFileUsage(ctx context.Context, teamID string) (*model.FileUsage, error)
+	GetFileUsageByTeam(ctx context.Context) ([]*model.FileUsage, error)
+	UpsertStorageQuota(ctx context.Context, quota *model.StorageQuota) error
+	GetStorageQuota(ctx context.Context, scope model.StorageQuotaScope, scopeID string) (*model.StorageQuota, error)
+	DeleteStorageQuota(ctx context.Context, scope model.StorageQuotaScope, scopeID string) error
+
+	InsertAuditEntry(ctx context.Context, entry *model.AuditEntry) error
+	GetAuditEntries(ctx context.Context, opts model.QueryAuditEntriesOptions) ([]*model.AuditEntry, bool, error)
+	DeleteAuditEntriesBefore(ctx context.Context, before int64) (int64, error)
+
+	UpsertTeamSignupToken(ctx context.Context, team model.Team) error
+	UpsertTeamSettings(ctx context.Context, team model.Team) error
+	GetTeam(ctx context.Context, ID string) (*model.Team, error)
+	GetTeamsForUser(ctx context.Context, userID string) ([]*model.Team, error)
+	GetAllTeams(ctx context.Context) ([]*model.Team, error)
+	GetTeamCount(ctx context.Context) (int64, error)
+
+	InsertBoard(ctx context.Context, board *model.Board, userID string) (*model.Board, error)
 	// @withTransaction
-	PatchBoard(boardID string, boardPatch *model.BoardPatch, userID string) (*model.Board, error)
-	GetBoard(id string) (*model.Board, error)
-	GetBoardsForUserAndTeam(userID, teamID string, includePublicBoards bool) ([]*model.Board, error)
-	GetBoardsInTeamByIds(boardIDs []string, teamID string) ([]*model.Board, error)
+	InsertBoardWithAdmin(ctx context.Context, board *model.Board, userID string) (*model.Board, *model.BoardMember, error)
 	// @withTransaction
-	DeleteBoard(boardID, userID string) error
-
-	SaveMember(bm *model.BoardMember) (*model.BoardMember, error)
-	DeleteMember(boardID, userID string) error
-	GetMemberForBoard(boardID, userID string) (*model.BoardMember, error)
-	GetBoardMemberHistory(boardID, userID string, limit uint64) ([]*model.BoardMemberHistoryEntry, error)
-	GetMembersForBoard(boardID string) ([]*model.BoardMember, error)
-	GetMembersForUser(userID string) ([]*model.BoardMember, error)
-	CanSeeUser(seerID string, seenID string) (bool, error)
-	SearchBoardsForUser(term string, searchField model.BoardSearchField, userID string, includePublicBoards bool) ([]*model.Board, error)
-	SearchBoardsForUserInTeam(teamID, term, userID string) ([]*model.Board, error)
+	PatchBoard(ctx context.Context, boardID string, boardPatch *model.BoardPatch, userID string) (*model.Board, error)
+	GetBoard(ctx context.Context, id string) (*model.Board, error)
+	GetBoardsForUserAndTeam(ctx context.Context, userID, teamID string, includePublicBoards bool) ([]*model.Board, error)
+	GetBoardsInTeamByIds(ctx context.Context, boardIDs []string, teamID string) ([]*model.Board, error)
+	// @withTransaction
+	DeleteBoard(ctx context.Context, boardID, userID string) error
+
+	SaveMember(ctx context.Context, bm *model.BoardMember) (*model.BoardMember, error)
+	DeleteMember(ctx context.Context, boardID, userID string) error
+	GetMemberForBoard(ctx context.Context, boardID, userID string) (*model.BoardMember, error)
+	GetBoardMemberHistory(ctx context.Context, boardID, userID string, limit uint64) ([]*model.BoardMemberHistoryEntry, error)
+	GetMembersForBoard(ctx context.Context, boardID string) ([]*model.BoardMember, error)
+	GetMembersForUser(ctx context.Context, userID string) ([]*model.BoardMember, error)
+	CanSeeUser(ctx context.Context, seerID string, seenID string) (bool, error)
+	SearchBoardsForUser(ctx context.Context, term string, searchField model.BoardSearchField, userID string, includePublicBoards bool) ([]*model.Board, error)
+	SearchBoardsForUserInTeam(ctx context.Context, teamID, term, userID string) ([]*model.Board, error)
 
 	// @withTransaction
-	CreateBoardsAndBlocksWithAdmin(bab *model.BoardsAndBlocks, userID string) (*model.BoardsAndBlocks, []*model.BoardMember, error)
+	CreateBoardsAndBlocksWithAdmin(ctx context.Context, bab *model.BoardsAndBlocks, userID string) (*model.BoardsAndBlocks, []*model.BoardMember, error)
 	// @withTransaction
-	CreateBoardsAndBlocks(bab *model.BoardsAndBlocks, userID string) (*model.BoardsAndBlocks, error)
+	CreateBoardsAndBlocks(ctx context.Context, bab *model.BoardsAndBlocks, userID string) (*model.BoardsAndBlocks, error)
 	// @withTransaction
-	PatchBoardsAndBlocks(pbab *model.PatchBoardsAndBlocks, userID string) (*model.BoardsAndBlocks, error)
+	PatchBoardsAndBlocks(ctx context.Context, pbab *model.PatchBoardsAndBlocks, userID string) (*model.BoardsAndBlocks, error)
 	// @withTransaction
-	DeleteBoardsAndBlocks(dbab *model.DeleteBoardsAndBlocks, userID string) error
+	DeleteBoardsAndBlocks(ctx context.Context, dbab *model.DeleteBoardsAndBlocks, userID string) error
 
-	GetCategory(id string) (*model.Category, error)
+	GetCategory(ctx context.Context, id string) (*model.Category, error)
 
-	GetUserCategories(userID, teamID string) ([]model.Category, error)
+	GetUserCategories(ctx context.Context, userID, teamID string) ([]model.Category, error)
 	// @withTransaction
-	CreateCategory(category model.Category) error
-	UpdateCategory(category model.Category) error
-	DeleteCategory(categoryID, userID, teamID string) error
-	ReorderCategories(userID, teamID string, newCategoryOrder []string) ([]string, error)
+	CreateCategory(ctx context.Context, category model.Category) error
+	UpdateCategory(ctx context.Context, category model.Category) error
+	DeleteCategory(ctx context.Context, categoryID, userID, teamID string) error
+	ReorderCategories(ctx context.Context, userID, teamID string, newCategoryOrder []string) ([]string, error)
 
-	GetUserCategoryBoards(userID, teamID string) ([]model.CategoryBoards, error)
+	GetUserCategoryBoards(ctx context.Context, userID, teamID string) ([]model.CategoryBoards, error)
 
-	GetFileInfo(id string) (*mmModel.FileInfo, error)
-	SaveFileInfo(fileInfo *mmModel.FileInfo) error
-	RestoreFiles(fileIDs []string) error
+	GetFileInfo(ctx context.Context, id string) (*mmModel.FileInfo, error)
+	SaveFileInfo(ctx context.Context, fileInfo *mmModel.FileInfo) error
+	// @withTransaction
+	DeleteFiles(ctx context.Context, boardID string, fileIDs []string) error
+	// @withTransaction
+	RestoreFiles(ctx context.Context, boardID string, fileIDs []string) error
+	CountFileInfosWithPath(ctx context.Context, path string) (int64, error)
+	GetFileInfosWithPathPrefix(ctx context.Context, prefix string, createdBefore int64) ([]*mmModel.FileInfo, error)
+	// @withTransaction
+	DeleteFileInfo(ctx context.Context, id string) error
+	UpdateFileInfoPaths(ctx context.Context, fileInfo *mmModel.FileInfo) error
+	SaveFileBoard(ctx context.Context, fileID, boardID string) error
+	GetFileBoardIDs(ctx context.Context, fileIDs []string) (map[string]string, error)
 
 	// BlockSuite document operations
-	GetBlockSuiteDocByCardID(cardID string) (*model.BlockSuiteDoc, error)
-	GetBlockSuiteDocInfoByCardID(cardID string) (*model.BlockSuiteDocInfo, error)
+	GetBlockSuiteDocByCardID(ctx context.Context, cardID string) (*model.BlockSuiteDoc, error)
+	GetBlockSuiteDocInfoByCardID(ctx context.Context, cardID string) (*model.BlockSuiteDocInfo, error)
 	// @withTransaction
-	UpsertBlockSuiteDoc(doc *model.BlockSuiteDoc) error
-	DeleteBlockSuiteDocByCardID(cardID string) error
+	UpsertBlockSuiteDoc(ctx context.Context, doc *model.BlockSuiteDoc) error
+	DeleteBlockSuiteDocByCardID(ctx context.Context, cardID string) error
 
 	// @withTransaction
-	AddUpdateCategoryBoard(userID, categoryID string, boardIDs []string) error
-	ReorderCategoryBoards(categoryID string, newBoardsOrder []string) ([]string, error)
-	SetBoardVisibility(userID, categoryID, boardID string, visible bool) error
-
-	CreateSubscription(sub *model.Subscription) (*model.Subscription, error)
-	DeleteSubscription(blockID string, subscriberID string) error
-	GetSubscription(blockID string, subscriberID string) (*model.Subscription, error)
-	GetSubscriptions(subscriberID string) ([]*model.Subscription, error)
-	GetSubscribersForBlock(blockID string) ([]*model.Subscriber, error)
-	GetSubscribersCountForBlock(blockID string) (int, error)
-	UpdateSubscribersNotifiedAt(blockID string, notifiedAt int64) error
-
-	UpsertNotificationHint(hint *model.NotificationHint, notificationFreq time.Duration) (*model.NotificationHint, error)
-	DeleteNotificationHint(blockID string) error
-	GetNotificationHint(blockID string) (*model.NotificationHint, error)
-	GetNextNotificationHint(remove bool) (*model.NotificationHint, error)
-
-	RemoveDefaultTemplates(boards []*model.Board) error
-	GetTemplateBoards(teamID, userID string) ([]*model.Board, error)
+	AddUpdateCategoryBoard(ctx context.Context, userID, categoryID string, boardIDs []string) error
+	ReorderCategoryBoards(ctx context.Context, categoryID string, newBoardsOrder []string) ([]string, error)
+	SetBoardVisibility(ctx context.Context, userID, categoryID, boardID string, visible bool) error
+
+	CreateSubscription(ctx context.Context, sub *model.Subscription) (*model.Subscription, error)
+	DeleteSubscription(ctx context.Context, blockID string, subscriberID string) error
+	GetSubscription(ctx context.Context, blockID string, subscriberID string) (*model.Subscription, error)
+	GetSubscriptions(ctx context.Context, subscriberID string) ([]*model.Subscription, error)
+	GetSubscribersForBlock(ctx context.Context, blockID string) ([]*model.Subscriber, error)
+	GetSubscribersCountForBlock(ctx context.Context, blockID string) (int, error)
+	UpdateSubscribersNotifiedAt(ctx context.Context, blockID string, notifiedAt int64) error
+
+	UpsertNotificationHint(ctx context.Context, hint *model.NotificationHint, notificationFreq time.Duration) (*model.NotificationHint, error)
+	DeleteNotificationHint(ctx context.Context, blockID string) error
+	GetNotificationHint(ctx context.Context, blockID string) (*model.NotificationHint, error)
+	GetNextNotificationHint(ctx context.Context, remove bool) (*model.NotificationHint, error)
+	GetNotificationHintCount(ctx context.Context) (int, error)
+
+	UpsertNotificationPreferences(ctx context.Context, prefs *model.NotificationPreferences) (*model.NotificationPreferences, error)
+	GetNotificationPreferences(ctx context.Context, userID, boardID string) (*model.NotificationPreferences, error)
+	GetNotificationPreferencesForBoard(ctx context.Context, boardID string) ([]*model.NotificationPreferences, error)
+	DeleteNotificationPreferences(ctx context.Context, userID, boardID string) error
+
+	CreateNotification(ctx context.Context, notification *model.Notification) (*model.Notification, error)
+	GetNotificationsForUser(ctx context.Context, userID string, opts model.QueryNotificationsOptions) ([]*model.Notification, error)
+	GetNotification(ctx context.Context, userID, notificationID string) (*model.Notification, error)
+	GetUnreadNotificationCount(ctx context.Context, userID string) (int64, error)
+	MarkNotificationRead(ctx context.Context, userID, notificationID string) error
+	MarkAllNotificationsRead(ctx context.Context, userID string) error
+
+	AddCommentReaction(ctx context.Context, reaction *model.CommentReaction) (*model.CommentReaction, error)
+	DeleteCommentReaction(ctx context.Context, blockID, userID, emojiName string) error
+	GetCommentReactions(ctx context.Context, blockID string) ([]*model.CommentReaction, error)
+	GetCommentReactionsForBoard(ctx context.Context, boardID string) ([]*model.CommentReaction, error)
+
+	RemoveDefaultTemplates(ctx context.Context, boards []*model.Board) error
+	GetTemplateBoards(ctx context.Context, teamID, userID string) ([]*model.Board, error)
 
 	// @withTransaction
-	RunDataRetention(globalRetentionDate int64, batchSize int64) (int64, error)
+	RunDataRetention(ctx context.Context, globalRetentionDate int64, batchSize int64) (int64, error)
 
-	GetCardsCount() (int64, error)
-	GetUsedCardsCount() (int64, error)
-	GetCardLimitTimestamp() (int64, error)
-	UpdateCardLimitTimestamp(cardLimit int) (int64, error)
+	GetCardsCount(ctx context.Context) (int64, error)
+	GetUsedCardsCount(ctx context.Context) (int64, error)
+	GetCardLimitTimestamp(ctx context.Context) (int64, error)
+	UpdateCardLimitTimestamp(ctx context.Context, cardLimit int) (int64, error)
 
 	DBType() string
 	DBVersion() string
 
-	GetLicense() *mmModel.License
-	SearchUserChannels(teamID, userID, query string) ([]*mmModel.Channel, error)
-	GetChannel(teamID, channelID string) (*mmModel.Channel, error)
-	PostMessage(message, postType, channelID string) error
-	SendMessage(message, postType string, receipts []string) error
+	GetLicense(ctx context.Context) *mmModel.License
+	SearchUserChannels(ctx context.Context, teamID, userID, query string) ([]*mmModel.Channel, error)
+	GetChannel(ctx context.Context, teamID, channelID string) (*mmModel.Channel, error)
+	PostMessage(ctx context.Context, message, postType, channelID string) error
+	SendMessage(ctx context.Context, message, postType string, receipts []string) error
 
-	GetUserTimezone(userID string) (string, error)
+	GetUserTimezone(ctx context.Context, userID string) (string, error)
 
 	// Compliance
-	GetBoardsForCompliance(opts model.QueryBoardsForComplianceOptions) ([]*model.Board, bool, error)
-	GetBoardsComplianceHistory(opts model.QueryBoardsComplianceHistoryOptions) ([]*model.BoardHistory, bool, error)
-	GetBlocksComplianceHistory(opts model.QueryBlocksComplianceHistoryOptions) ([]*model.BlockHistory, bool, error)
+	GetBoardsForCompliance(ctx context.Context, opts model.QueryBoardsForComplianceOptions) ([]*model.Board, bool, error)
+	GetBoardsComplianceHistory(ctx context.Context, opts model.QueryBoardsComplianceHistoryOptions) ([]*model.BoardHistory, bool, error)
+	GetBlocksComplianceHistory(ctx context.Context, opts model.QueryBlocksComplianceHistoryOptions) ([]*model.BlockHistory, bool, error)
 
 	// For unit testing only
-	DeleteBoardRecord(boardID, modifiedBy string) error
-	DeleteBlockRecord(blockID, modifiedBy string) error
+	DeleteBoardRecord(ctx context.Context, boardID, modifiedBy string) error
+	DeleteBlockRecord(ctx context.Context, blockID, modifiedBy string) error
 }
 
 type NotSupportedError struct {
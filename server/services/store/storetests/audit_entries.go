@@ -0,0 +1,108 @@
+// Copyright (c) 2020-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package storetests
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mattermost/mattermost-plugin-boards/server/model"
+	"github.com/mattermost/mattermost-plugin-boards/server/services/store"
+	"github.com/mattermost/mattermost-plugin-boards/server/utils"
+)
+
+func StoreTestAuditEntriesStore(t *testing.T, setup func(t *testing.T) (store.Store, func())) {
+	t.Run("GetAuditEntries", func(t *testing.T) {
+		store, tearDown := setup(t)
+		defer tearDown()
+		testGetAuditEntries(t, store)
+	})
+	t.Run("DeleteAuditEntriesBefore", func(t *testing.T) {
+		store, tearDown := setup(t)
+		defer tearDown()
+		testDeleteAuditEntriesBefore(t, store)
+	})
+}
+
+func insertTestAuditEntries(t *testing.T, store store.Store, userID, boardID string) {
+	entries := []*model.AuditEntry{
+		{Level: "mod", Event: "patchBlock", Status: "success", UserID: userID, BoardID: boardID, CreateAt: 1000},
+		{Level: "mod", Event: "deleteBlock", Status: "fail", UserID: userID, BoardID: boardID, CreateAt: 2000},
+		{Level: "read", Event: "getBoard", Status: "success", UserID: userID, CreateAt: 3000},
+		{
+			Level:    "mod",
+			Event:    "patchBlock",
+			Status:   "success",
+			UserID:   utils.NewID(utils.IDTypeUser),
+			BoardID:  boardID,
+			CardID:   utils.NewID(utils.IDTypeCard),
+			Meta:     map[string]interface{}{"blockID": "block1"},
+			CreateAt: 4000,
+		},
+	}
+	for _, entry := range entries {
+		require.NoError(t, store.InsertAuditEntry(context.Background(), entry))
+		require.NotEmpty(t, entry.ID)
+	}
+}
+
+func testGetAuditEntries(t *testing.T, store store.Store) {
+	userID := utils.NewID(utils.IDTypeUser)
+	boardID := utils.NewID(utils.IDTypeBoard)
+	insertTestAuditEntries(t, store, userID, boardID)
+
+	t.Run("all entries, newest first", func(t *testing.T) {
+		entries, hasNext, err := store.GetAuditEntries(context.Background(), model.QueryAuditEntriesOptions{})
+		require.NoError(t, err)
+		assert.False(t, hasNext)
+		require.Len(t, entries, 4)
+		assert.EqualValues(t, 4000, entries[0].CreateAt)
+		assert.Equal(t, "block1", entries[0].Meta["blockID"])
+		assert.NotEmpty(t, entries[0].CardID)
+	})
+
+	t.Run("filters", func(t *testing.T) {
+		entries, _, err := store.GetAuditEntries(context.Background(), model.QueryAuditEntriesOptions{UserID: userID})
+		require.NoError(t, err)
+		assert.Len(t, entries, 3)
+
+		entries, _, err = store.GetAuditEntries(context.Background(), model.QueryAuditEntriesOptions{BoardID: boardID, Event: "patchBlock"})
+		require.NoError(t, err)
+		assert.Len(t, entries, 2)
+
+		entries, _, err = store.GetAuditEntries(context.Background(), model.QueryAuditEntriesOptions{Since: 2000, Until: 4000})
+		require.NoError(t, err)
+		require.Len(t, entries, 2)
+		assert.Equal(t, "getBoard", entries[0].Event)
+		assert.Equal(t, "deleteBlock", entries[1].Event)
+	})
+
+	t.Run("pagination", func(t *testing.T) {
+		entries, hasNext, err := store.GetAuditEntries(context.Background(), model.QueryAuditEntriesOptions{Page: 0, PerPage: 3})
+		require.NoError(t, err)
+		assert.True(t, hasNext)
+		assert.Len(t, entries, 3)
+
+		entries, hasNext, err = store.GetAuditEntries(context.Background(), model.QueryAuditEntriesOptions{Page: 1, PerPage: 3})
+		require.NoError(t, err)
+		assert.False(t, hasNext)
+		require.Len(t, entries, 1)
+		assert.EqualValues(t, 1000, entries[0].CreateAt)
+	})
+}
+
+func testDeleteAuditEntriesBefore(t *testing.T, store store.Store) {
+	insertTestAuditEntries(t, store, utils.NewID(utils.IDTypeUser), utils.NewID(utils.IDTypeBoard))
+
+	deleted, err := store.DeleteAuditEntriesBefore(context.Background(), 3000)
+	require.NoError(t, err)
+	assert.EqualValues(t, 2, deleted)
+
+	entries, _, err := store.GetAuditEntries(context.Background(), model.QueryAuditEntriesOptions{})
+	require.NoError(t, err)
+	assert.Len(t, entries, 2)
+}
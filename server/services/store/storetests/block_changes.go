@@ -0,0 +1,226 @@
+// Copyright (c) 2020-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package storetests
+
+import (
+	"context"
+	"sort"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mattermost/mattermost-plugin-boards/server/model"
+	"github.com/mattermost/mattermost-plugin-boards/server/services/store"
+	"github.com/mattermost/mattermost-plugin-boards/server/utils"
+)
+
+func StoreTestBlockChangesStore(t *testing.T, setup func(t *testing.T) (store.Store, func())) {
+	t.Run("GetBlocksAfterID", func(t *testing.T) {
+		store, tearDown := setup(t)
+		defer tearDown()
+		testGetBlocksAfterID(t, store)
+	})
+	t.Run("GetChangedBlocks", func(t *testing.T) {
+		store, tearDown := setup(t)
+		defer tearDown()
+		testGetChangedBlocks(t, store)
+	})
+	t.Run("GetBlockTombstones", func(t *testing.T) {
+		store, tearDown := setup(t)
+		defer tearDown()
+		testGetBlockTombstones(t, store)
+	})
+}
+
+// insertBlockChangesTestBlocks inserts three blocks in a board, returned in ID order, and one in
+// another board.
+func insertBlockChangesTestBlocks(t *testing.T, store store.Store) []*model.Block {
+	boardID := utils.NewID(utils.IDTypeBoard)
+	ids := []string{
+		utils.NewID(utils.IDTypeBlock),
+		utils.NewID(utils.IDTypeBlock),
+		utils.NewID(utils.IDTypeBlock),
+	}
+	sort.Strings(ids)
+
+	blocks := []*model.Block{
+		{ID: ids[0], BoardID: boardID, ModifiedBy: testUserID, Type: model.TypeCard},
+		{ID: ids[1], BoardID: boardID, ModifiedBy: testUserID, Type: model.TypeText, ParentID: ids[0]},
+		{ID: ids[2], BoardID: boardID, ModifiedBy: testUserID, Type: model.TypeCard},
+	}
+	// inserted out of ID order.
+	InsertBlocks(t, store, []*model.Block{blocks[2], blocks[0], blocks[1]}, testUserID)
+	InsertBlocks(t, store, []*model.Block{
+		{ID: utils.NewID(utils.IDTypeBlock), BoardID: utils.NewID(utils.IDTypeBoard), ModifiedBy: testUserID, Type: model.TypeCard},
+	}, testUserID)
+	return blocks
+}
+
+func blockIDs(blocks []*model.Block) []string {
+	ids := make([]string, 0, len(blocks))
+	for _, block := range blocks {
+		ids = append(ids, block.ID)
+	}
+	return ids
+}
+
+func testGetBlocksAfterID(t *testing.T, store store.Store) {
+	inserted := insertBlockChangesTestBlocks(t, store)
+	boardID := inserted[0].BoardID
+
+	t.Run("first page", func(t *testing.T) {
+		blocks, err := store.GetBlocks(context.Background(), model.QueryBlocksOptions{BoardID: boardID, PerPage: 2})
+		require.NoError(t, err)
+		assert.Equal(t, blockIDs(inserted[:2]), blockIDs(blocks))
+	})
+
+	t.Run("next page", func(t *testing.T) {
+		blocks, err := store.GetBlocks(context.Background(), model.QueryBlocksOptions{BoardID: boardID, AfterID: inserted[1].ID, PerPage: 2})
+		require.NoError(t, err)
+		assert.Equal(t, blockIDs(inserted[2:]), blockIDs(blocks))
+	})
+
+	t.Run("with a block type", func(t *testing.T) {
+		blocks, err := store.GetBlocks(context.Background(), model.QueryBlocksOptions{
+			BoardID:   boardID,
+			BlockType: model.TypeCard,
+			AfterID:   inserted[0].ID,
+			PerPage:   2,
+		})
+		require.NoError(t, err)
+		assert.Equal(t, blockIDs(inserted[2:]), blockIDs(blocks))
+	})
+}
+
+func testGetChangedBlocks(t *testing.T, store store.Store) {
+	inserted := insertBlockChangesTestBlocks(t, store)
+	boardID := inserted[0].BoardID
+	block1 := inserted[0]
+
+	t.Run("from the start", func(t *testing.T) {
+		blocks, err := store.GetChangedBlocks(context.Background(), model.QueryBlockChangesOptions{BoardID: boardID})
+		require.NoError(t, err)
+		require.Len(t, blocks, 3)
+		for i := 1; i < len(blocks); i++ {
+			assert.LessOrEqual(t, blocks[i-1].UpdateAt, blocks[i].UpdateAt)
+		}
+	})
+
+	t.Run("after a patch", func(t *testing.T) {
+		blocks, err := store.GetChangedBlocks(context.Background(), model.QueryBlockChangesOptions{BoardID: boardID})
+		require.NoError(t, err)
+		last := blocks[len(blocks)-1]
+
+		time.Sleep(10 * time.Millisecond)
+		title := "changed"
+		require.NoError(t, store.PatchBlock(context.Background(), block1.ID, &model.BlockPatch{Title: &title}, testUserID))
+
+		blocks, err = store.GetChangedBlocks(context.Background(), model.QueryBlockChangesOptions{
+			BoardID:       boardID,
+			AfterUpdateAt: last.UpdateAt,
+			AfterID:       last.ID,
+		})
+		require.NoError(t, err)
+		require.Len(t, blocks, 1)
+		assert.Equal(t, block1.ID, blocks[0].ID)
+		assert.Equal(t, title, blocks[0].Title)
+	})
+
+	t.Run("with a limit", func(t *testing.T) {
+		blocks, err := store.GetChangedBlocks(context.Background(), model.QueryBlockChangesOptions{BoardID: boardID, Limit: 2})
+		require.NoError(t, err)
+		require.Len(t, blocks, 2)
+
+		next, err := store.GetChangedBlocks(context.Background(), model.QueryBlockChangesOptions{
+			BoardID:       boardID,
+			AfterUpdateAt: blocks[1].UpdateAt,
+			AfterID:       blocks[1].ID,
+		})
+		require.NoError(t, err)
+		require.Len(t, next, 1)
+		assert.NotContains(t, blockIDs(blocks), next[0].ID)
+	})
+
+	t.Run("held back", func(t *testing.T) {
+		blocks, err := store.GetChangedBlocks(context.Background(), model.QueryBlockChangesOptions{BoardID: boardID})
+		require.NoError(t, err)
+		last := blocks[len(blocks)-1]
+
+		settled, err := store.GetChangedBlocks(context.Background(), model.QueryBlockChangesOptions{
+			BoardID:        boardID,
+			BeforeUpdateAt: last.UpdateAt - 1,
+		})
+		require.NoError(t, err)
+		assert.NotContains(t, blockIDs(settled), last.ID)
+		for _, block := range settled {
+			assert.Less(t, block.UpdateAt, last.UpdateAt)
+		}
+	})
+}
+
+func testGetBlockTombstones(t *testing.T, store store.Store) {
+	inserted := insertBlockChangesTestBlocks(t, store)
+	boardID := inserted[0].BoardID
+	deleted := inserted[2]
+
+	tombstones, err := store.GetBlockTombstones(context.Background(), model.QueryBlockChangesOptions{BoardID: boardID})
+	require.NoError(t, err)
+	require.Empty(t, tombstones)
+
+	time.Sleep(10 * time.Millisecond)
+	require.NoError(t, store.DeleteBlock(context.Background(), deleted.ID, "deleter-id"))
+
+	t.Run("deleted block", func(t *testing.T) {
+		tombstones, err := store.GetBlockTombstones(context.Background(), model.QueryBlockChangesOptions{BoardID: boardID})
+		require.NoError(t, err)
+		require.Len(t, tombstones, 1)
+		assert.Equal(t, deleted.ID, tombstones[0].ID)
+		assert.Equal(t, boardID, tombstones[0].BoardID)
+		assert.EqualValues(t, model.TypeCard, tombstones[0].Type)
+		assert.Equal(t, "deleter-id", tombstones[0].ModifiedBy)
+		assert.NotZero(t, tombstones[0].DeleteAt)
+
+		changed, err := store.GetChangedBlocks(context.Background(), model.QueryBlockChangesOptions{BoardID: boardID})
+		require.NoError(t, err)
+		assert.NotContains(t, blockIDs(changed), deleted.ID)
+
+		after, err := store.GetBlockTombstones(context.Background(), model.QueryBlockChangesOptions{
+			BoardID:       boardID,
+			AfterUpdateAt: tombstones[0].DeleteAt,
+			AfterID:       tombstones[0].ID,
+		})
+		require.NoError(t, err)
+		assert.Empty(t, after)
+
+		settled, err := store.GetBlockTombstones(context.Background(), model.QueryBlockChangesOptions{
+			BoardID:        boardID,
+			BeforeUpdateAt: tombstones[0].DeleteAt - 1,
+		})
+		require.NoError(t, err)
+		assert.Empty(t, settled)
+	})
+
+	t.Run("deleted twice", func(t *testing.T) {
+		time.Sleep(10 * time.Millisecond)
+		require.NoError(t, store.UndeleteBlock(context.Background(), deleted.ID, testUserID))
+		time.Sleep(10 * time.Millisecond)
+		require.NoError(t, store.DeleteBlock(context.Background(), deleted.ID, "deleter-id"))
+
+		tombstones, err := store.GetBlockTombstones(context.Background(), model.QueryBlockChangesOptions{BoardID: boardID})
+		require.NoError(t, err)
+		require.Len(t, tombstones, 1)
+		assert.Equal(t, deleted.ID, tombstones[0].ID)
+	})
+
+	t.Run("restored block", func(t *testing.T) {
+		time.Sleep(10 * time.Millisecond)
+		require.NoError(t, store.UndeleteBlock(context.Background(), deleted.ID, testUserID))
+
+		tombstones, err := store.GetBlockTombstones(context.Background(), model.QueryBlockChangesOptions{BoardID: boardID})
+		require.NoError(t, err)
+		assert.Empty(t, tombstones)
+	})
+}
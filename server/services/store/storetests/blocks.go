@@ -4,6 +4,7 @@
 package storetests
 
 import (
+	"context"
 	"math"
 	"strconv"
 	"strings"
@@ -96,7 +97,7 @@ func testInsertBlock(t *testing.T, store store.Store) {
 	userID := testUserID
 	boardID := testBoardID
 
-	blocks, errBlocks := store.GetBlocksForBoard(boardID)
+	blocks, errBlocks := store.GetBlocksForBoard(context.Background(), boardID)
 	require.NoError(t, errBlocks)
 	initialCount := len(blocks)
 
@@ -107,10 +108,10 @@ func testInsertBlock(t *testing.T, store store.Store) {
 			ModifiedBy: userID,
 		}
 
-		err := store.InsertBlock(block, "user-id-1")
+		err := store.InsertBlock(context.Background(), block, "user-id-1")
 		require.NoError(t, err)
 
-		blocks, err := store.GetBlocksForBoard(boardID)
+		blocks, err := store.GetBlocksForBoard(context.Background(), boardID)
 		require.NoError(t, err)
 		require.Len(t, blocks, initialCount+1)
 	})
@@ -122,10 +123,10 @@ func testInsertBlock(t *testing.T, store store.Store) {
 			ModifiedBy: userID,
 		}
 
-		err := store.InsertBlock(block, "user-id-1")
+		err := store.InsertBlock(context.Background(), block, "user-id-1")
 		require.Error(t, err)
 
-		blocks, err := store.GetBlocksForBoard(boardID)
+		blocks, err := store.GetBlocksForBoard(context.Background(), boardID)
 		require.NoError(t, err)
 		require.Len(t, blocks, initialCount+1)
 	})
@@ -138,10 +139,10 @@ func testInsertBlock(t *testing.T, store store.Store) {
 			Fields:     map[string]interface{}{"no-serialiable-value": t.Run},
 		}
 
-		err := store.InsertBlock(block, "user-id-1")
+		err := store.InsertBlock(context.Background(), block, "user-id-1")
 		require.Error(t, err)
 
-		blocks, err := store.GetBlocksForBoard(boardID)
+		blocks, err := store.GetBlocksForBoard(context.Background(), boardID)
 		require.NoError(t, err)
 		require.Len(t, blocks, initialCount+1)
 	})
@@ -154,7 +155,7 @@ func testInsertBlock(t *testing.T, store store.Store) {
 			Title:      strings.Repeat("A", model.BlockTitleMaxRunes+1),
 		}
 
-		err := store.InsertBlock(block, "user-id-1")
+		err := store.InsertBlock(context.Background(), block, "user-id-1")
 		require.ErrorIs(t, err, model.ErrBlockTitleSizeLimitExceeded)
 	})
 
@@ -171,7 +172,7 @@ func testInsertBlock(t *testing.T, store store.Store) {
 			},
 		}
 
-		err := store.InsertBlock(block, "user-id-2")
+		err := store.InsertBlock(context.Background(), block, "user-id-2")
 		require.ErrorIs(t, err, model.ErrBlockFieldsSizeLimitExceeded)
 	})
 
@@ -180,7 +181,7 @@ func testInsertBlock(t *testing.T, store store.Store) {
 			BoardID: testBoardID,
 		}
 
-		err := store.InsertBlock(block, "user-id-2")
+		err := store.InsertBlock(context.Background(), block, "user-id-2")
 		require.NoError(t, err)
 		require.Equal(t, "user-id-2", block.CreatedBy)
 	})
@@ -193,7 +194,7 @@ func testInsertBlock(t *testing.T, store store.Store) {
 		}
 
 		// inserting
-		err := store.InsertBlock(block, "user-id-2")
+		err := store.InsertBlock(context.Background(), block, "user-id-2")
 		require.NoError(t, err)
 
 		// created by populated from user id for new blocks
@@ -210,7 +211,7 @@ func testInsertBlock(t *testing.T, store store.Store) {
 			CreatedBy: "user-id-3",
 			Title:     "New Title",
 		}
-		err = store.InsertBlock(newBlock, "user-id-4")
+		err = store.InsertBlock(context.Background(), newBlock, "user-id-4")
 		require.NoError(t, err)
 		// created by is not altered for existing blocks
 		require.Equal(t, "user-id-3", newBlock.CreatedBy)
@@ -226,7 +227,7 @@ func testInsertBlock(t *testing.T, store store.Store) {
 		}
 
 		// inserting
-		err := store.InsertBlock(block, "user-id-3")
+		err := store.InsertBlock(context.Background(), block, "user-id-3")
 		require.NoError(t, err)
 
 		// created by populated from user id for new blocks
@@ -243,7 +244,7 @@ func testInsertBlock(t *testing.T, store store.Store) {
 			CreatedBy: "user-id-3",
 			Title:     strings.Repeat("A", model.BlockTitleMaxRunes+1),
 		}
-		err = store.InsertBlock(newBlock, "user-id-3")
+		err = store.InsertBlock(context.Background(), newBlock, "user-id-3")
 		require.ErrorIs(t, err, model.ErrBlockTitleSizeLimitExceeded)
 	})
 
@@ -256,7 +257,7 @@ func testInsertBlock(t *testing.T, store store.Store) {
 		}
 
 		// inserting
-		err := store.InsertBlock(block, "user-id-3")
+		err := store.InsertBlock(context.Background(), block, "user-id-3")
 		require.NoError(t, err)
 
 		// created by populated from user id for new blocks
@@ -278,7 +279,7 @@ func testInsertBlock(t *testing.T, store store.Store) {
 				"four":  strings.Repeat("4", model.BlockFieldsMaxRunes/4),
 			},
 		}
-		err = store.InsertBlock(newBlock, "user-id-3")
+		err = store.InsertBlock(context.Background(), newBlock, "user-id-3")
 		require.ErrorIs(t, err, model.ErrBlockFieldsSizeLimitExceeded)
 	})
 
@@ -300,11 +301,11 @@ func testInsertBlock(t *testing.T, store store.Store) {
 		}
 
 		// inserting
-		err := store.InsertBlock(block, "user-id-1")
+		err := store.InsertBlock(context.Background(), block, "user-id-1")
 		require.NoError(t, err)
 		expectedTime := time.Now()
 
-		retrievedBlock, err := store.GetBlock("id-10")
+		retrievedBlock, err := store.GetBlock(context.Background(), "id-10")
 		assert.NoError(t, err)
 		assert.NotNil(t, retrievedBlock)
 		assert.Equal(t, "board-id-1", retrievedBlock.BoardID)
@@ -318,7 +319,7 @@ func testInsertBlock(t *testing.T, store store.Store) {
 func testInsertBlocks(t *testing.T, store store.Store) {
 	userID := testUserID
 
-	blocks, errBlocks := store.GetBlocksForBoard("id-test")
+	blocks, errBlocks := store.GetBlocksForBoard(context.Background(), "id-test")
 	require.NoError(t, errBlocks)
 	initialCount := len(blocks)
 
@@ -338,10 +339,10 @@ func testInsertBlocks(t *testing.T, store store.Store) {
 		newBlocks := []*model.Block{validBlock, invalidBlock}
 
 		time.Sleep(1 * time.Millisecond)
-		err := store.InsertBlocks(newBlocks, "user-id-1")
+		err := store.InsertBlocks(context.Background(), newBlocks, "user-id-1")
 		require.Error(t, err)
 
-		blocks, err := store.GetBlocksForBoard("id-test")
+		blocks, err := store.GetBlocksForBoard(context.Background(), "id-test")
 		require.NoError(t, err)
 		// no blocks should have been inserted
 		require.Len(t, blocks, initialCount)
@@ -360,20 +361,20 @@ func testPatchBlock(t *testing.T, store store.Store) {
 		Fields:     map[string]interface{}{"test": "test value", "test2": "test value 2"},
 	}
 
-	err := store.InsertBlock(block, "user-id-1")
+	err := store.InsertBlock(context.Background(), block, "user-id-1")
 	require.NoError(t, err)
 
-	blocks, errBlocks := store.GetBlocksForBoard(boardID)
+	blocks, errBlocks := store.GetBlocksForBoard(context.Background(), boardID)
 	require.NoError(t, errBlocks)
 	initialCount := len(blocks)
 
 	t.Run("not existing block id", func(t *testing.T) {
-		err := store.PatchBlock("invalid-block-id", &model.BlockPatch{}, "user-id-1")
+		err := store.PatchBlock(context.Background(), "invalid-block-id", &model.BlockPatch{}, "user-id-1")
 		var nf *model.ErrNotFound
 		require.ErrorAs(t, err, &nf)
 		require.True(t, model.IsErrNotFound(err))
 
-		blocks, err := store.GetBlocksForBoard(boardID)
+		blocks, err := store.GetBlocksForBoard(context.Background(), boardID)
 		require.NoError(t, err)
 		require.Len(t, blocks, initialCount)
 	})
@@ -383,10 +384,10 @@ func testPatchBlock(t *testing.T, store store.Store) {
 			UpdatedFields: map[string]interface{}{"no-serialiable-value": t.Run},
 		}
 
-		err := store.PatchBlock("id-test", blockPatch, "user-id-1")
+		err := store.PatchBlock(context.Background(), "id-test", blockPatch, "user-id-1")
 		require.Error(t, err)
 
-		blocks, err := store.GetBlocksForBoard(boardID)
+		blocks, err := store.GetBlocksForBoard(context.Background(), boardID)
 		require.NoError(t, err)
 		require.Len(t, blocks, initialCount)
 	})
@@ -401,10 +402,10 @@ func testPatchBlock(t *testing.T, store store.Store) {
 		time.Sleep(1 * time.Millisecond)
 
 		// inserting
-		err := store.PatchBlock("id-test", &blockPatch, "user-id-2")
+		err := store.PatchBlock(context.Background(), "id-test", &blockPatch, "user-id-2")
 		require.NoError(t, err)
 
-		retrievedBlock, err := store.GetBlock("id-test")
+		retrievedBlock, err := store.GetBlock(context.Background(), "id-test")
 		require.NoError(t, err)
 
 		// created by populated from user id for new blocks
@@ -421,10 +422,10 @@ func testPatchBlock(t *testing.T, store store.Store) {
 		time.Sleep(1 * time.Millisecond)
 
 		// inserting
-		err := store.PatchBlock("id-test", blockPatch, "user-id-2")
+		err := store.PatchBlock(context.Background(), "id-test", blockPatch, "user-id-2")
 		require.NoError(t, err)
 
-		retrievedBlock, err := store.GetBlock("id-test")
+		retrievedBlock, err := store.GetBlock(context.Background(), "id-test")
 		require.NoError(t, err)
 
 		// created by populated from user id for new blocks
@@ -443,10 +444,10 @@ func testPatchBlock(t *testing.T, store store.Store) {
 		time.Sleep(1 * time.Millisecond)
 
 		// inserting
-		err := store.PatchBlock("id-test", blockPatch, "user-id-2")
+		err := store.PatchBlock(context.Background(), "id-test", blockPatch, "user-id-2")
 		require.NoError(t, err)
 
-		retrievedBlock, err := store.GetBlock("id-test")
+		retrievedBlock, err := store.GetBlock(context.Background(), "id-test")
 		require.NoError(t, err)
 
 		// created by populated from user id for new blocks
@@ -471,7 +472,7 @@ func testPatchBlocks(t *testing.T, store store.Store) {
 	}
 
 	insertBlocks := []*model.Block{block, block2}
-	err := store.InsertBlocks(insertBlocks, "user-id-1")
+	err := store.InsertBlocks(context.Background(), insertBlocks, "user-id-1")
 	require.NoError(t, err)
 
 	t.Run("successful updated existing blocks", func(t *testing.T) {
@@ -488,14 +489,14 @@ func testPatchBlocks(t *testing.T, store store.Store) {
 		blockPatches := []model.BlockPatch{blockPatch, blockPatch2}
 
 		time.Sleep(1 * time.Millisecond)
-		err := store.PatchBlocks(&model.BlockPatchBatch{BlockIDs: blockIds, BlockPatches: blockPatches}, "user-id-1")
+		err := store.PatchBlocks(context.Background(), &model.BlockPatchBatch{BlockIDs: blockIds, BlockPatches: blockPatches}, "user-id-1")
 		require.NoError(t, err)
 
-		retrievedBlock, err := store.GetBlock("id-test")
+		retrievedBlock, err := store.GetBlock(context.Background(), "id-test")
 		require.NoError(t, err)
 		require.Equal(t, title, retrievedBlock.Title)
 
-		retrievedBlock2, err := store.GetBlock("id-test2")
+		retrievedBlock2, err := store.GetBlock(context.Background(), "id-test2")
 		require.NoError(t, err)
 		require.Equal(t, title, retrievedBlock2.Title)
 	})
@@ -518,11 +519,11 @@ func testPatchBlocks(t *testing.T, store store.Store) {
 		blockPatches := []model.BlockPatch{blockPatch, blockPatch2}
 
 		time.Sleep(1 * time.Millisecond)
-		err := store.PatchBlocks(&model.BlockPatchBatch{BlockIDs: blockIds, BlockPatches: blockPatches}, "user-id-1")
+		err := store.PatchBlocks(context.Background(), &model.BlockPatchBatch{BlockIDs: blockIds, BlockPatches: blockPatches}, "user-id-1")
 		var nf *model.ErrNotFound
 		require.ErrorAs(t, err, &nf)
 
-		retrievedBlock, err := store.GetBlock("id-test")
+		retrievedBlock, err := store.GetBlock(context.Background(), "id-test")
 		require.NoError(t, err)
 		require.NotEqual(t, title, retrievedBlock.Title)
 	})
@@ -570,7 +571,7 @@ var (
 
 func testGetSubTree2(t *testing.T, store store.Store) {
 	boardID := testBoardID
-	blocks, err := store.GetBlocksForBoard(boardID)
+	blocks, err := store.GetBlocksForBoard(context.Background(), boardID)
 	require.NoError(t, err)
 	initialCount := len(blocks)
 
@@ -578,12 +579,12 @@ func testGetSubTree2(t *testing.T, store store.Store) {
 	time.Sleep(1 * time.Millisecond)
 	defer DeleteBlocks(t, store, subtreeSampleBlocks, "test")
 
-	blocks, err = store.GetBlocksForBoard(boardID)
+	blocks, err = store.GetBlocksForBoard(context.Background(), boardID)
 	require.NoError(t, err)
 	require.Len(t, blocks, initialCount+6)
 
 	t.Run("from root id", func(t *testing.T) {
-		blocks, err = store.GetSubTree2(boardID, "parent", model.QuerySubtreeOptions{})
+		blocks, err = store.GetSubTree2(context.Background(), boardID, "parent", model.QuerySubtreeOptions{})
 		require.NoError(t, err)
 		require.Len(t, blocks, 3)
 		require.True(t, ContainsBlockWithID(blocks, "parent"))
@@ -592,7 +593,7 @@ func testGetSubTree2(t *testing.T, store store.Store) {
 	})
 
 	t.Run("from child id", func(t *testing.T) {
-		blocks, err = store.GetSubTree2(boardID, "child1", model.QuerySubtreeOptions{})
+		blocks, err = store.GetSubTree2(context.Background(), boardID, "child1", model.QuerySubtreeOptions{})
 		require.NoError(t, err)
 		require.Len(t, blocks, 2)
 		require.True(t, ContainsBlockWithID(blocks, "child1"))
@@ -600,7 +601,7 @@ func testGetSubTree2(t *testing.T, store store.Store) {
 	})
 
 	t.Run("from not existing id", func(t *testing.T) {
-		blocks, err = store.GetSubTree2(boardID, "not-exists", model.QuerySubtreeOptions{})
+		blocks, err = store.GetSubTree2(context.Background(), boardID, "not-exists", model.QuerySubtreeOptions{})
 		require.NoError(t, err)
 		require.Empty(t, blocks)
 	})
@@ -610,7 +611,7 @@ func testDeleteBlock(t *testing.T, store store.Store) {
 	userID := testUserID
 	boardID := testBoardID
 
-	blocks, err := store.GetBlocksForBoard(boardID)
+	blocks, err := store.GetBlocksForBoard(context.Background(), boardID)
 	require.NoError(t, err)
 	initialCount := len(blocks)
 
@@ -634,32 +635,32 @@ func testDeleteBlock(t *testing.T, store store.Store) {
 	InsertBlocks(t, store, blocksToInsert, "user-id-1")
 	defer DeleteBlocks(t, store, blocksToInsert, "test")
 
-	blocks, err = store.GetBlocksForBoard(boardID)
+	blocks, err = store.GetBlocksForBoard(context.Background(), boardID)
 	require.NoError(t, err)
 	require.Len(t, blocks, initialCount+3)
 
 	t.Run("existing id", func(t *testing.T) {
 		// Wait for not colliding the ID+insert_at key
 		time.Sleep(1 * time.Millisecond)
-		err := store.DeleteBlock("block1", userID)
+		err := store.DeleteBlock(context.Background(), "block1", userID)
 		require.NoError(t, err)
 	})
 
 	t.Run("existing id multiple times", func(t *testing.T) {
 		// Wait for not colliding the ID+insert_at key
 		time.Sleep(1 * time.Millisecond)
-		err := store.DeleteBlock("block1", userID)
+		err := store.DeleteBlock(context.Background(), "block1", userID)
 		require.NoError(t, err)
 		// Wait for not colliding the ID+insert_at key
 		time.Sleep(1 * time.Millisecond)
-		err = store.DeleteBlock("block1", userID)
+		err = store.DeleteBlock(context.Background(), "block1", userID)
 		require.NoError(t, err)
 	})
 
 	t.Run("from not existing id", func(t *testing.T) {
 		// Wait for not colliding the ID+insert_at key
 		time.Sleep(1 * time.Millisecond)
-		err := store.DeleteBlock("not-exists", userID)
+		err := store.DeleteBlock(context.Background(), "not-exists", userID)
 		require.NoError(t, err)
 	})
 }
@@ -668,7 +669,7 @@ func testUndeleteBlock(t *testing.T, store store.Store) {
 	boardID := testBoardID
 	userID := testUserID
 
-	blocks, err := store.GetBlocksForBoard(boardID)
+	blocks, err := store.GetBlocksForBoard(context.Background(), boardID)
 	require.NoError(t, err)
 	initialCount := len(blocks)
 
@@ -692,26 +693,26 @@ func testUndeleteBlock(t *testing.T, store store.Store) {
 	InsertBlocks(t, store, blocksToInsert, "user-id-1")
 	defer DeleteBlocks(t, store, blocksToInsert, "test")
 
-	blocks, err = store.GetBlocksForBoard(boardID)
+	blocks, err = store.GetBlocksForBoard(context.Background(), boardID)
 	require.NoError(t, err)
 	require.Len(t, blocks, initialCount+3)
 
 	t.Run("existing id", func(t *testing.T) {
 		// Wait for not colliding the ID+insert_at key
 		time.Sleep(1 * time.Millisecond)
-		err := store.DeleteBlock("block1", userID)
+		err := store.DeleteBlock(context.Background(), "block1", userID)
 		require.NoError(t, err)
 
-		block, err := store.GetBlock("block1")
+		block, err := store.GetBlock(context.Background(), "block1")
 		var nf *model.ErrNotFound
 		require.ErrorAs(t, err, &nf)
 		require.Nil(t, block)
 
 		time.Sleep(1 * time.Millisecond)
-		err = store.UndeleteBlock("block1", userID)
+		err = store.UndeleteBlock(context.Background(), "block1", userID)
 		require.NoError(t, err)
 
-		block, err = store.GetBlock("block1")
+		block, err = store.GetBlock(context.Background(), "block1")
 		require.NoError(t, err)
 		require.NotNil(t, block)
 	})
@@ -719,29 +720,29 @@ func testUndeleteBlock(t *testing.T, store store.Store) {
 	t.Run("existing id multiple times", func(t *testing.T) {
 		// Wait for not colliding the ID+insert_at key
 		time.Sleep(1 * time.Millisecond)
-		err := store.DeleteBlock("block1", userID)
+		err := store.DeleteBlock(context.Background(), "block1", userID)
 		require.NoError(t, err)
 
-		block, err := store.GetBlock("block1")
+		block, err := store.GetBlock(context.Background(), "block1")
 		var nf *model.ErrNotFound
 		require.ErrorAs(t, err, &nf)
 		require.Nil(t, block)
 
 		// Wait for not colliding the ID+insert_at key
 		time.Sleep(1 * time.Millisecond)
-		err = store.UndeleteBlock("block1", userID)
+		err = store.UndeleteBlock(context.Background(), "block1", userID)
 		require.NoError(t, err)
 
-		block, err = store.GetBlock("block1")
+		block, err = store.GetBlock(context.Background(), "block1")
 		require.NoError(t, err)
 		require.NotNil(t, block)
 
 		// Wait for not colliding the ID+insert_at key
 		time.Sleep(1 * time.Millisecond)
-		err = store.UndeleteBlock("block1", userID)
+		err = store.UndeleteBlock(context.Background(), "block1", userID)
 		require.NoError(t, err)
 
-		block, err = store.GetBlock("block1")
+		block, err = store.GetBlock(context.Background(), "block1")
 		require.NoError(t, err)
 		require.NotNil(t, block)
 	})
@@ -749,10 +750,10 @@ func testUndeleteBlock(t *testing.T, store store.Store) {
 	t.Run("from not existing id", func(t *testing.T) {
 		// Wait for not colliding the ID+insert_at key
 		time.Sleep(1 * time.Millisecond)
-		err := store.UndeleteBlock("not-exists", userID)
+		err := store.UndeleteBlock(context.Background(), "not-exists", userID)
 		require.NoError(t, err)
 
-		block, err := store.GetBlock("not-exists")
+		block, err := store.GetBlock(context.Background(), "not-exists")
 		var nf *model.ErrNotFound
 		require.ErrorAs(t, err, &nf)
 		require.Nil(t, block)
@@ -761,7 +762,7 @@ func testUndeleteBlock(t *testing.T, store store.Store) {
 
 func testGetBlocks(t *testing.T, store store.Store) {
 	boardID := testBoardID
-	blocks, err := store.GetBlocksForBoard(boardID)
+	blocks, err := store.GetBlocksForBoard(context.Background(), boardID)
 	require.NoError(t, err)
 
 	blocksToInsert := []*model.Block{
@@ -806,77 +807,77 @@ func testGetBlocks(t *testing.T, store store.Store) {
 
 	t.Run("not existing parent", func(t *testing.T) {
 		time.Sleep(1 * time.Millisecond)
-		blocks, err = store.GetBlocksWithParentAndType(boardID, "not-exists", "test")
+		blocks, err = store.GetBlocksWithParentAndType(context.Background(), boardID, "not-exists", "test")
 		require.NoError(t, err)
 		require.Empty(t, blocks)
 	})
 
 	t.Run("not existing type", func(t *testing.T) {
 		time.Sleep(1 * time.Millisecond)
-		blocks, err = store.GetBlocksWithParentAndType(boardID, "block1", "not-existing")
+		blocks, err = store.GetBlocksWithParentAndType(context.Background(), boardID, "block1", "not-existing")
 		require.NoError(t, err)
 		require.Empty(t, blocks)
 	})
 
 	t.Run("valid parent and type", func(t *testing.T) {
 		time.Sleep(1 * time.Millisecond)
-		blocks, err = store.GetBlocksWithParentAndType(boardID, "block1", "test")
+		blocks, err = store.GetBlocksWithParentAndType(context.Background(), boardID, "block1", "test")
 		require.NoError(t, err)
 		require.Len(t, blocks, 2)
 	})
 
 	t.Run("not existing parent", func(t *testing.T) {
 		time.Sleep(1 * time.Millisecond)
-		blocks, err = store.GetBlocksWithParent(boardID, "not-exists")
+		blocks, err = store.GetBlocksWithParent(context.Background(), boardID, "not-exists")
 		require.NoError(t, err)
 		require.Empty(t, blocks)
 	})
 
 	t.Run("valid parent", func(t *testing.T) {
 		time.Sleep(1 * time.Millisecond)
-		blocks, err = store.GetBlocksWithParent(boardID, "block1")
+		blocks, err = store.GetBlocksWithParent(context.Background(), boardID, "block1")
 		require.NoError(t, err)
 		require.Len(t, blocks, 3)
 	})
 
 	t.Run("not existing type", func(t *testing.T) {
 		time.Sleep(1 * time.Millisecond)
-		blocks, err = store.GetBlocksWithType(boardID, "not-exists")
+		blocks, err = store.GetBlocksWithType(context.Background(), boardID, "not-exists")
 		require.NoError(t, err)
 		require.Empty(t, blocks)
 	})
 
 	t.Run("valid type", func(t *testing.T) {
 		time.Sleep(1 * time.Millisecond)
-		blocks, err = store.GetBlocksWithType(boardID, "test")
+		blocks, err = store.GetBlocksWithType(context.Background(), boardID, "test")
 		require.NoError(t, err)
 		require.Len(t, blocks, 4)
 	})
 
 	t.Run("not existing board", func(t *testing.T) {
 		time.Sleep(1 * time.Millisecond)
-		blocks, err = store.GetBlocksForBoard("not-exists")
+		blocks, err = store.GetBlocksForBoard(context.Background(), "not-exists")
 		require.NoError(t, err)
 		require.Empty(t, blocks)
 	})
 
 	t.Run("all blocks of the a board", func(t *testing.T) {
 		time.Sleep(1 * time.Millisecond)
-		blocks, err = store.GetBlocksForBoard(boardID)
+		blocks, err = store.GetBlocksForBoard(context.Background(), boardID)
 		require.NoError(t, err)
 		require.Len(t, blocks, 5)
 	})
 
 	t.Run("several blocks by ids", func(t *testing.T) {
 		time.Sleep(1 * time.Millisecond)
-		blocks, err = store.GetBlocksByIDs([]string{"block2", "block4"})
+		blocks, err = store.GetBlocksByIDs(context.Background(), []string{"block2", "block4"})
 		require.NoError(t, err)
 		require.Len(t, blocks, 2)
 	})
 
 	t.Run("blocks by ids where some are not found", func(t *testing.T) {
 		time.Sleep(1 * time.Millisecond)
-		blocks, err = store.GetBlocksByIDs([]string{"block2", "blockNonexistent"})
+		blocks, err = store.GetBlocksByIDs(context.Background(), []string{"block2", "blockNonexistent"})
 		var naf *model.ErrNotAllFound
 		require.ErrorAs(t, err, &naf)
 		require.True(t, model.IsErrNotFound(err))
@@ -885,7 +886,7 @@ func testGetBlocks(t *testing.T, store store.Store) {
 
 	t.Run("blocks by ids where none are found", func(t *testing.T) {
 		time.Sleep(1 * time.Millisecond)
-		blocks, err = store.GetBlocksByIDs([]string{"blockNonexistent1", "blockNonexistent2"})
+		blocks, err = store.GetBlocksByIDs(context.Background(), []string{"blockNonexistent1", "blockNonexistent2"})
 		var naf *model.ErrNotAllFound
 		require.ErrorAs(t, err, &naf)
 		require.True(t, model.IsErrNotFound(err))
@@ -901,10 +902,10 @@ func testGetBlock(t *testing.T, store store.Store) {
 			ModifiedBy: "user-id-1",
 		}
 
-		err := store.InsertBlock(block, "user-id-1")
+		err := store.InsertBlock(context.Background(), block, "user-id-1")
 		require.NoError(t, err)
 
-		fetchedBlock, err := store.GetBlock("block-id-10")
+		fetchedBlock, err := store.GetBlock(context.Background(), "block-id-10")
 		require.NoError(t, err)
 		require.NotNil(t, fetchedBlock)
 		require.Equal(t, "block-id-10", fetchedBlock.ID)
@@ -916,7 +917,7 @@ func testGetBlock(t *testing.T, store store.Store) {
 	})
 
 	t.Run("get a non-existing block", func(t *testing.T) {
-		fetchedBlock, err := store.GetBlock("non-existing-id")
+		fetchedBlock, err := store.GetBlock(context.Background(), "non-existing-id")
 		var nf *model.ErrNotFound
 		require.ErrorAs(t, err, &nf)
 		require.Nil(t, fetchedBlock)
@@ -947,33 +948,33 @@ func testDuplicateBlock(t *testing.T, store store.Store) {
 	defer DeleteBlocks(t, store, subtreeSampleBlocks, "test")
 
 	t.Run("duplicate existing block as no template", func(t *testing.T) {
-		blocks, err := store.DuplicateBlock(testBoardID, "child1", testUserID, false)
+		blocks, err := store.DuplicateBlock(context.Background(), testBoardID, "child1", testUserID, false)
 		require.NoError(t, err)
 		require.Len(t, blocks, 2)
 		require.Equal(t, false, blocks[0].Fields["isTemplate"])
 	})
 
 	t.Run("duplicate existing block as template", func(t *testing.T) {
-		blocks, err := store.DuplicateBlock(testBoardID, "child1", testUserID, true)
+		blocks, err := store.DuplicateBlock(context.Background(), testBoardID, "child1", testUserID, true)
 		require.NoError(t, err)
 		require.Len(t, blocks, 2)
 		require.Equal(t, true, blocks[0].Fields["isTemplate"])
 	})
 
 	t.Run("duplicate not existing block", func(t *testing.T) {
-		blocks, err := store.DuplicateBlock(testBoardID, "not-existing-id", testUserID, false)
+		blocks, err := store.DuplicateBlock(context.Background(), testBoardID, "not-existing-id", testUserID, false)
 		require.Error(t, err)
 		require.Nil(t, blocks)
 	})
 
 	t.Run("duplicate not existing board", func(t *testing.T) {
-		blocks, err := store.DuplicateBlock("not-existing-board", "not-existing-id", testUserID, false)
+		blocks, err := store.DuplicateBlock(context.Background(), "not-existing-board", "not-existing-id", testUserID, false)
 		require.Error(t, err)
 		require.Nil(t, blocks)
 	})
 
 	t.Run("not matching board/block", func(t *testing.T) {
-		blocks, err := store.DuplicateBlock("other-id", "child1", testUserID, false)
+		blocks, err := store.DuplicateBlock(context.Background(), "other-id", "child1", testUserID, false)
 		require.Error(t, err)
 		require.Nil(t, blocks)
 	})
@@ -981,7 +982,7 @@ func testDuplicateBlock(t *testing.T, store store.Store) {
 
 func testGetBlockMetadata(t *testing.T, store store.Store) {
 	boardID := testBoardID
-	blocks, err := store.GetBlocksForBoard(boardID)
+	blocks, err := store.GetBlocksForBoard(context.Background(), boardID)
 	require.NoError(t, err)
 
 	blocksToInsert := []*model.Block{
@@ -1033,7 +1034,7 @@ func testGetBlockMetadata(t *testing.T, store store.Store) {
 		opts := model.QueryBlockHistoryOptions{
 			Descending: false,
 		}
-		blocks, err = store.GetBlockHistoryDescendants(boardID, opts)
+		blocks, err = store.GetBlockHistoryDescendants(context.Background(), boardID, opts)
 		require.NoError(t, err)
 		require.Len(t, blocks, 5)
 		expectedBlock := blocksToInsert[0]
@@ -1046,7 +1047,7 @@ func testGetBlockMetadata(t *testing.T, store store.Store) {
 		opts := model.QueryBlockHistoryOptions{
 			Descending: true,
 		}
-		blocks, err = store.GetBlockHistoryDescendants(boardID, opts)
+		blocks, err = store.GetBlockHistoryDescendants(context.Background(), boardID, opts)
 		require.NoError(t, err)
 		require.Len(t, blocks, 5)
 		expectedBlock := blocksToInsert[len(blocksToInsert)-1]
@@ -1060,7 +1061,7 @@ func testGetBlockMetadata(t *testing.T, store store.Store) {
 			Limit:      3,
 			Descending: false,
 		}
-		blocks, err = store.GetBlockHistoryDescendants(boardID, opts)
+		blocks, err = store.GetBlockHistoryDescendants(context.Background(), boardID, opts)
 		require.NoError(t, err)
 		require.Len(t, blocks, 3)
 	})
@@ -1070,7 +1071,7 @@ func testGetBlockMetadata(t *testing.T, store store.Store) {
 			Limit:      1,
 			Descending: false,
 		}
-		blocks, err = store.GetBlockHistoryDescendants(boardID, opts)
+		blocks, err = store.GetBlockHistoryDescendants(context.Background(), boardID, opts)
 		require.NoError(t, err)
 		require.Len(t, blocks, 1)
 		expectedBlock := blocksToInsert[0]
@@ -1084,7 +1085,7 @@ func testGetBlockMetadata(t *testing.T, store store.Store) {
 			Limit:      1,
 			Descending: true,
 		}
-		blocks, err = store.GetBlockHistoryDescendants(boardID, opts)
+		blocks, err = store.GetBlockHistoryDescendants(context.Background(), boardID, opts)
 		require.NoError(t, err)
 		require.Len(t, blocks, 1)
 		expectedBlock := blocksToInsert[len(blocksToInsert)-1]
@@ -1094,7 +1095,7 @@ func testGetBlockMetadata(t *testing.T, store store.Store) {
 	})
 
 	t.Run("get block history after updateAt", func(t *testing.T) {
-		rBlock, err2 := store.GetBlock("block3")
+		rBlock, err2 := store.GetBlock(context.Background(), "block3")
 		require.NoError(t, err2)
 		require.NotZero(t, rBlock.UpdateAt)
 
@@ -1102,7 +1103,7 @@ func testGetBlockMetadata(t *testing.T, store store.Store) {
 			AfterUpdateAt: rBlock.UpdateAt,
 			Descending:    false,
 		}
-		blocks, err = store.GetBlockHistoryDescendants(boardID, opts)
+		blocks, err = store.GetBlockHistoryDescendants(context.Background(), boardID, opts)
 		require.NoError(t, err)
 		require.Len(t, blocks, 2)
 		expectedBlock := blocksToInsert[3]
@@ -1112,7 +1113,7 @@ func testGetBlockMetadata(t *testing.T, store store.Store) {
 	})
 
 	t.Run("get block history before updateAt", func(t *testing.T) {
-		rBlock, err2 := store.GetBlock("block3")
+		rBlock, err2 := store.GetBlock(context.Background(), "block3")
 		require.NoError(t, err2)
 		require.NotZero(t, rBlock.UpdateAt)
 
@@ -1120,7 +1121,7 @@ func testGetBlockMetadata(t *testing.T, store store.Store) {
 			BeforeUpdateAt: rBlock.UpdateAt,
 			Descending:     true,
 		}
-		blocks, err = store.GetBlockHistoryDescendants(boardID, opts)
+		blocks, err = store.GetBlockHistoryDescendants(context.Background(), boardID, opts)
 		require.NoError(t, err)
 		require.Len(t, blocks, 2)
 		expectedBlock := blocksToInsert[1]
@@ -1132,13 +1133,13 @@ func testGetBlockMetadata(t *testing.T, store store.Store) {
 	t.Run("get full block history after delete", func(t *testing.T) {
 		time.Sleep(20 * time.Millisecond)
 		// this will delete `block1` and any other blocks with `block1` as parent.
-		err = store.DeleteBlock(blocksToInsert[0].ID, testUserID)
+		err = store.DeleteBlock(context.Background(), blocksToInsert[0].ID, testUserID)
 		require.NoError(t, err)
 
 		opts := model.QueryBlockHistoryOptions{
 			Descending: true,
 		}
-		blocks, err = store.GetBlockHistoryDescendants(boardID, opts)
+		blocks, err = store.GetBlockHistoryDescendants(context.Background(), boardID, opts)
 		require.NoError(t, err)
 		// all 5 blocks get a history record for insert, then `block1` gets a record for delete,
 		// and all 3 `block1` children get a record for delete. Thus total is 9.
@@ -1148,13 +1149,13 @@ func testGetBlockMetadata(t *testing.T, store store.Store) {
 	t.Run("get full block history after undelete", func(t *testing.T) {
 		time.Sleep(20 * time.Millisecond)
 		// this will undelete `block1` and its children
-		err = store.UndeleteBlock(blocksToInsert[0].ID, testUserID)
+		err = store.UndeleteBlock(context.Background(), blocksToInsert[0].ID, testUserID)
 		require.NoError(t, err)
 
 		opts := model.QueryBlockHistoryOptions{
 			Descending: true,
 		}
-		blocks, err = store.GetBlockHistoryDescendants(boardID, opts)
+		blocks, err = store.GetBlockHistoryDescendants(context.Background(), boardID, opts)
 		require.NoError(t, err)
 		// previous test put 9 records in history table. In this test 1 record was added for undeleting
 		// `block1` and another 3 for undeleting the children for a total of 13.
@@ -1164,7 +1165,7 @@ func testGetBlockMetadata(t *testing.T, store store.Store) {
 	t.Run("get block history of a board with no history", func(t *testing.T) {
 		opts := model.QueryBlockHistoryOptions{}
 
-		blocks, err = store.GetBlockHistoryDescendants("nonexistent-board-id", opts)
+		blocks, err = store.GetBlockHistoryDescendants(context.Background(), "nonexistent-board-id", opts)
 		require.NoError(t, err)
 		require.Empty(t, blocks)
 	})
@@ -1191,70 +1192,70 @@ func testUndeleteBlockChildren(t *testing.T, store store.Store) {
 		cardKeep := cardsKeep[0]
 
 		// delete a card
-		err := store.DeleteBlock(cardDelete.ID, testUserID)
+		err := store.DeleteBlock(context.Background(), cardDelete.ID, testUserID)
 		require.NoError(t, err)
 
 		// ensure the card was deleted
-		block, err := store.GetBlock(cardDelete.ID)
+		block, err := store.GetBlock(context.Background(), cardDelete.ID)
 		require.Error(t, err)
 		require.Nil(t, block)
 
 		// ensure the card children were deleted
-		blocks, err := store.GetBlocksWithParentAndType(cardDelete.BoardID, cardDelete.ID, model.TypeText)
+		blocks, err := store.GetBlocksWithParentAndType(context.Background(), cardDelete.BoardID, cardDelete.ID, model.TypeText)
 		require.NoError(t, err)
 		assert.Empty(t, blocks)
 
 		// ensure the other card children remain.
-		blocks, err = store.GetBlocksWithParentAndType(cardKeep.BoardID, cardKeep.ID, model.TypeText)
+		blocks, err = store.GetBlocksWithParentAndType(context.Background(), cardKeep.BoardID, cardKeep.ID, model.TypeText)
 		require.NoError(t, err)
 		assert.Len(t, blocks, len(blocksKeep))
 
 		// undelete the card
-		err = store.UndeleteBlock(cardDelete.ID, testUserID)
+		err = store.UndeleteBlock(context.Background(), cardDelete.ID, testUserID)
 		require.NoError(t, err)
 
 		// ensure the card was restored
-		block, err = store.GetBlock(cardDelete.ID)
+		block, err = store.GetBlock(context.Background(), cardDelete.ID)
 		require.NoError(t, err)
 		require.NotNil(t, block)
 
 		// ensure the card children were restored
-		blocks, err = store.GetBlocksWithParentAndType(cardDelete.BoardID, cardDelete.ID, model.TypeText)
+		blocks, err = store.GetBlocksWithParentAndType(context.Background(), cardDelete.BoardID, cardDelete.ID, model.TypeText)
 		require.NoError(t, err)
 		assert.Len(t, blocks, len(blocksDelete))
 	})
 
 	t.Run("undelete block children for board", func(t *testing.T) {
 		// delete the board
-		err := store.DeleteBoard(boardDelete.ID, testUserID)
+		err := store.DeleteBoard(context.Background(), boardDelete.ID, testUserID)
 		require.NoError(t, err)
 
 		// ensure the board was deleted
-		board, err := store.GetBoard(boardDelete.ID)
+		board, err := store.GetBoard(context.Background(), boardDelete.ID)
 		require.Error(t, err)
 		require.Nil(t, board)
 
 		// ensure all cards and blocks for the board were deleted
-		blocks, err := store.GetBlocksForBoard(boardDelete.ID)
+		blocks, err := store.GetBlocksForBoard(context.Background(), boardDelete.ID)
 		require.NoError(t, err)
 		assert.Empty(t, blocks)
 
 		// ensure the other board's cards and blocks remain.
-		blocks, err = store.GetBlocksForBoard(boardKeep.ID)
+		blocks, err = store.GetBlocksForBoard(context.Background(), boardKeep.ID)
 		require.NoError(t, err)
 		assert.Len(t, blocks, len(blocksKeep)+len(cardsKeep))
 
 		// undelete the board
-		err = store.UndeleteBoard(boardDelete.ID, testUserID)
+		err = store.UndeleteBoard(context.Background(), boardDelete.ID, testUserID)
 		require.NoError(t, err)
 
 		// ensure the board was restored
-		board, err = store.GetBoard(boardDelete.ID)
+		board, err = store.GetBoard(context.Background(), boardDelete.ID)
 		require.NoError(t, err)
 		require.NotNil(t, board)
 
 		// ensure the board's cards and blocks were restored.
-		blocks, err = store.GetBlocksForBoard(boardDelete.ID)
+		blocks, err = store.GetBlocksForBoard(context.Background(), boardDelete.ID)
 		require.NoError(t, err)
 		assert.Len(t, blocks, len(blocksDelete)+len(cardsDelete))
 	})
@@ -1279,22 +1280,22 @@ func testGetBlockHistoryNewestChildren(t *testing.T, store store.Store) {
 			patch := &model.BlockPatch{
 				Title: &title,
 			}
-			err := store.PatchBlock(block.ID, patch, testUserID)
+			err := store.PatchBlock(context.Background(), block.ID, patch, testUserID)
 			require.NoError(t, err, "error patching content blocks")
 		}
 	}
 
 	// delete some of the content blocks
-	err := store.DeleteBlock(content[0].ID, testUserID)
+	err := store.DeleteBlock(context.Background(), content[0].ID, testUserID)
 	require.NoError(t, err, "error deleting content block")
-	err = store.DeleteBlock(content[3].ID, testUserID)
+	err = store.DeleteBlock(context.Background(), content[3].ID, testUserID)
 	require.NoError(t, err, "error deleting content block")
-	err = store.DeleteBlock(content[7].ID, testUserID)
+	err = store.DeleteBlock(context.Background(), content[7].ID, testUserID)
 	require.NoError(t, err, "error deleting content block")
 
 	t.Run("invalid card", func(t *testing.T) {
 		opts := model.QueryBlockHistoryChildOptions{}
-		blocks, hasMore, err := store.GetBlockHistoryNewestChildren(utils.NewID(utils.IDTypeCard), opts)
+		blocks, hasMore, err := store.GetBlockHistoryNewestChildren(context.Background(), utils.NewID(utils.IDTypeCard), opts)
 		require.NoError(t, err)
 		require.False(t, hasMore)
 		require.Empty(t, blocks)
@@ -1303,7 +1304,7 @@ func testGetBlockHistoryNewestChildren(t *testing.T, store store.Store) {
 	t.Run("valid card with no children", func(t *testing.T) {
 		opts := model.QueryBlockHistoryChildOptions{}
 		emptyCard := createTestCards(t, store, testUserID, board.ID, 1)[0]
-		blocks, hasMore, err := store.GetBlockHistoryNewestChildren(emptyCard.ID, opts)
+		blocks, hasMore, err := store.GetBlockHistoryNewestChildren(context.Background(), emptyCard.ID, opts)
 		require.NoError(t, err)
 		require.False(t, hasMore)
 		require.Empty(t, blocks)
@@ -1311,7 +1312,7 @@ func testGetBlockHistoryNewestChildren(t *testing.T, store store.Store) {
 
 	t.Run("valid card with children", func(t *testing.T) {
 		opts := model.QueryBlockHistoryChildOptions{}
-		blocks, hasMore, err := store.GetBlockHistoryNewestChildren(card.ID, opts)
+		blocks, hasMore, err := store.GetBlockHistoryNewestChildren(context.Background(), card.ID, opts)
 		require.NoError(t, err)
 		require.False(t, hasMore)
 		require.Len(t, blocks, cardCount)
@@ -1332,7 +1333,7 @@ func testGetBlockHistoryNewestChildren(t *testing.T, store store.Store) {
 		reps := 0
 		for {
 			reps++
-			blocks, hasMore, err := store.GetBlockHistoryNewestChildren(card.ID, opts)
+			blocks, hasMore, err := store.GetBlockHistoryNewestChildren(context.Background(), card.ID, opts)
 			require.NoError(t, err)
 			collected = append(collected, blocks...)
 			if !hasMore {
@@ -0,0 +1,70 @@
+// Copyright (c) 2020-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package storetests
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mattermost/mattermost-plugin-boards/server/model"
+	"github.com/mattermost/mattermost-plugin-boards/server/services/store"
+	"github.com/mattermost/mattermost-plugin-boards/server/utils"
+)
+
+func StoreTestBoardFormsStore(t *testing.T, setup func(t *testing.T) (store.Store, func())) {
+	t.Run("UpsertBoardForm", func(t *testing.T) {
+		store, tearDown := setup(t)
+		defer tearDown()
+		testUpsertBoardForm(t, store)
+	})
+}
+
+func testUpsertBoardForm(t *testing.T, store store.Store) {
+	boardID := utils.NewID(utils.IDTypeBoard)
+
+	t.Run("missing form", func(t *testing.T) {
+		form, err := store.GetBoardForm(context.Background(), boardID)
+		require.True(t, model.IsErrNotFound(err))
+		assert.Nil(t, form)
+	})
+
+	t.Run("create and update form", func(t *testing.T) {
+		form := &model.BoardForm{
+			BoardID:    boardID,
+			Token:      utils.NewID(utils.IDTypeToken),
+			Enabled:    true,
+			Title:      "Requests",
+			TitleLabel: "Summary",
+			Fields: []model.BoardFormField{
+				{PropertyID: "prop1", Label: "Details", Required: true},
+				{PropertyID: "prop2"},
+			},
+			AuthorID:   utils.NewID(utils.IDTypeUser),
+			Honeypot:   true,
+			ModifiedBy: utils.NewID(utils.IDTypeUser),
+		}
+
+		saved, err := store.UpsertBoardForm(context.Background(), form)
+		require.NoError(t, err)
+		assert.NotZero(t, saved.UpdateAt)
+
+		got, err := store.GetBoardForm(context.Background(), boardID)
+		require.NoError(t, err)
+		assert.Equal(t, saved, got)
+
+		form.Enabled = false
+		form.Fields = nil
+		_, err = store.UpsertBoardForm(context.Background(), form)
+		require.NoError(t, err)
+
+		got, err = store.GetBoardForm(context.Background(), boardID)
+		require.NoError(t, err)
+		assert.False(t, got.Enabled)
+		assert.Empty(t, got.Fields)
+		assert.Equal(t, form.Token, got.Token)
+	})
+}
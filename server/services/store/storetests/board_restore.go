@@ -0,0 +1,113 @@
+// Copyright (c) 2020-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package storetests
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mattermost/mattermost-plugin-boards/server/model"
+	"github.com/mattermost/mattermost-plugin-boards/server/services/store"
+	"github.com/mattermost/mattermost-plugin-boards/server/utils"
+)
+
+func StoreTestBoardRestoreStore(t *testing.T, setup func(t *testing.T) (store.Store, func())) {
+	t.Run("RestoreBoardToTime", func(t *testing.T) {
+		store, tearDown := setup(t)
+		defer tearDown()
+		testRestoreBoardToTime(t, store)
+	})
+	t.Run("RestoreBoardToTime before creation", func(t *testing.T) {
+		store, tearDown := setup(t)
+		defer tearDown()
+		testRestoreBoardToTimeBeforeCreation(t, store)
+	})
+}
+
+func testRestoreBoardToTime(t *testing.T, store store.Store) {
+	board := createTestBoards(t, store, testTeamID, testUserID, 1)[0]
+	cards := createTestCards(t, store, testUserID, board.ID, 3)
+	contents := createTestBlocksForCard(t, store, cards[0].ID, 2)
+
+	time.Sleep(10 * time.Millisecond)
+	at := utils.GetMillis()
+	time.Sleep(10 * time.Millisecond)
+
+	newTitle := "renamed board"
+	_, err := store.PatchBoard(context.Background(), board.ID, &model.BoardPatch{Title: &newTitle}, testUserID)
+	require.NoError(t, err)
+
+	newCardTitle := "renamed card"
+	require.NoError(t, store.PatchBlock(context.Background(), cards[1].ID, &model.BlockPatch{Title: &newCardTitle}, testUserID))
+	require.NoError(t, store.DeleteBlock(context.Background(), cards[0].ID, testUserID))
+	createdCard := createTestCards(t, store, testUserID, board.ID, 1)[0]
+
+	plan, err := store.GetBoardRestorePlan(context.Background(), board.ID, at)
+	require.NoError(t, err)
+	require.NotNil(t, plan.Board)
+	assert.Equal(t, newTitle, plan.Board.Current.Title)
+	assert.Equal(t, board.Title, plan.Board.Restored.Title)
+
+	actions := map[string]model.BlockRestoreAction{}
+	for _, change := range plan.Blocks {
+		actions[change.BlockID()] = change.Action
+	}
+	assert.Equal(t, map[string]model.BlockRestoreAction{
+		cards[0].ID:    model.BlockRestoreRecreate,
+		contents[0].ID: model.BlockRestoreRecreate,
+		contents[1].ID: model.BlockRestoreRecreate,
+		cards[1].ID:    model.BlockRestoreRevert,
+		createdCard.ID: model.BlockRestoreDelete,
+	}, actions)
+
+	// the preview does not change the board.
+	current, err := store.GetBoard(context.Background(), board.ID)
+	require.NoError(t, err)
+	assert.Equal(t, newTitle, current.Title)
+
+	restorePlan, err := store.RestoreBoardToTime(context.Background(), board.ID, at, testUserID)
+	require.NoError(t, err)
+	assert.Len(t, restorePlan.Blocks, len(plan.Blocks))
+
+	current, err = store.GetBoard(context.Background(), board.ID)
+	require.NoError(t, err)
+	assert.Equal(t, board.Title, current.Title)
+
+	blocks, err := store.GetBlocksForBoard(context.Background(), board.ID)
+	require.NoError(t, err)
+	titles := map[string]string{}
+	for _, block := range blocks {
+		titles[block.ID] = block.Title
+	}
+	assert.Equal(t, map[string]string{
+		cards[0].ID:    cards[0].Title,
+		cards[1].ID:    cards[1].Title,
+		cards[2].ID:    cards[2].Title,
+		contents[0].ID: contents[0].Title,
+		contents[1].ID: contents[1].Title,
+	}, titles)
+
+	// once restored, there is nothing left to restore.
+	plan, err = store.GetBoardRestorePlan(context.Background(), board.ID, at)
+	require.NoError(t, err)
+	assert.Nil(t, plan.Board)
+	assert.Empty(t, plan.Blocks)
+}
+
+func testRestoreBoardToTimeBeforeCreation(t *testing.T, store store.Store) {
+	at := utils.GetMillis()
+	time.Sleep(10 * time.Millisecond)
+	board := createTestBoards(t, store, testTeamID, testUserID, 1)[0]
+
+	_, err := store.GetBoardRestorePlan(context.Background(), board.ID, at)
+	var nf *model.ErrNotFound
+	require.ErrorAs(t, err, &nf)
+
+	_, err = store.RestoreBoardToTime(context.Background(), board.ID, at, testUserID)
+	require.ErrorAs(t, err, &nf)
+}
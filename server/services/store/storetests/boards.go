@@ -4,6 +4,7 @@
 package storetests
 
 import (
+	"context"
 	"testing"
 	"time"
 
@@ -112,10 +113,10 @@ func testGetBoard(t *testing.T, store store.Store) {
 			Type:   model.BoardTypeOpen,
 		}
 
-		_, err := store.InsertBoard(board, userID)
+		_, err := store.InsertBoard(context.Background(), board, userID)
 		require.NoError(t, err)
 
-		rBoard, err := store.GetBoard(board.ID)
+		rBoard, err := store.GetBoard(context.Background(), board.ID)
 		require.NoError(t, err)
 		require.Equal(t, board.ID, rBoard.ID)
 		require.Equal(t, board.TeamID, rBoard.TeamID)
@@ -127,7 +128,7 @@ func testGetBoard(t *testing.T, store store.Store) {
 	})
 
 	t.Run("nonexisting board", func(t *testing.T) {
-		rBoard, err := store.GetBoard("nonexistent-id")
+		rBoard, err := store.GetBoard(context.Background(), "nonexistent-id")
 		var nf *model.ErrNotFound
 		require.ErrorAs(t, err, &nf)
 		require.True(t, model.IsErrNotFound(err), "Should be ErrNotFound compatible error")
@@ -139,7 +140,7 @@ func testGetBoardsForUserAndTeam(t *testing.T, store store.Store) {
 	userID := "user-id-1"
 
 	t.Run("should return empty list if no results are found", func(t *testing.T) {
-		boards, err := store.GetBoardsForUserAndTeam(testUserID, testTeamID, true)
+		boards, err := store.GetBoardsForUserAndTeam(context.Background(), testUserID, testTeamID, true)
 		require.NoError(t, err)
 		require.Empty(t, boards)
 	})
@@ -154,7 +155,7 @@ func testGetBoardsForUserAndTeam(t *testing.T, store store.Store) {
 			TeamID: teamID1,
 			Type:   model.BoardTypeOpen,
 		}
-		rBoard1, _, err := store.InsertBoardWithAdmin(board1, userID)
+		rBoard1, _, err := store.InsertBoardWithAdmin(context.Background(), board1, userID)
 		require.NoError(t, err)
 
 		board2 := &model.Board{
@@ -162,7 +163,7 @@ func testGetBoardsForUserAndTeam(t *testing.T, store store.Store) {
 			TeamID: teamID1,
 			Type:   model.BoardTypePrivate,
 		}
-		rBoard2, _, err := store.InsertBoardWithAdmin(board2, userID)
+		rBoard2, _, err := store.InsertBoardWithAdmin(context.Background(), board2, userID)
 		require.NoError(t, err)
 
 		board3 := &model.Board{
@@ -170,7 +171,7 @@ func testGetBoardsForUserAndTeam(t *testing.T, store store.Store) {
 			TeamID: teamID1,
 			Type:   model.BoardTypeOpen,
 		}
-		rBoard3, err := store.InsertBoard(board3, "other-user")
+		rBoard3, err := store.InsertBoard(context.Background(), board3, "other-user")
 		require.NoError(t, err)
 
 		board4 := &model.Board{
@@ -178,7 +179,7 @@ func testGetBoardsForUserAndTeam(t *testing.T, store store.Store) {
 			TeamID: teamID1,
 			Type:   model.BoardTypePrivate,
 		}
-		_, err = store.InsertBoard(board4, "other-user")
+		_, err = store.InsertBoard(context.Background(), board4, "other-user")
 		require.NoError(t, err)
 
 		// team 2 boards
@@ -187,7 +188,7 @@ func testGetBoardsForUserAndTeam(t *testing.T, store store.Store) {
 			TeamID: teamID2,
 			Type:   model.BoardTypeOpen,
 		}
-		_, _, err = store.InsertBoardWithAdmin(board5, userID)
+		_, _, err = store.InsertBoardWithAdmin(context.Background(), board5, userID)
 		require.NoError(t, err)
 
 		board6 := &model.Board{
@@ -195,11 +196,11 @@ func testGetBoardsForUserAndTeam(t *testing.T, store store.Store) {
 			TeamID: teamID1,
 			Type:   model.BoardTypePrivate,
 		}
-		_, err = store.InsertBoard(board6, "other-user")
+		_, err = store.InsertBoard(context.Background(), board6, "other-user")
 		require.NoError(t, err)
 
 		t.Run("should only find the two boards that the user is a member of for team 1 plus the one open board", func(t *testing.T) {
-			boards, err := store.GetBoardsForUserAndTeam(userID, teamID1, true)
+			boards, err := store.GetBoardsForUserAndTeam(context.Background(), userID, teamID1, true)
 			require.NoError(t, err)
 			require.ElementsMatch(t, []*model.Board{
 				rBoard1,
@@ -209,7 +210,7 @@ func testGetBoardsForUserAndTeam(t *testing.T, store store.Store) {
 		})
 
 		t.Run("should only find the two boards that the user is a member of for team 1", func(t *testing.T) {
-			boards, err := store.GetBoardsForUserAndTeam(userID, teamID1, false)
+			boards, err := store.GetBoardsForUserAndTeam(context.Background(), userID, teamID1, false)
 			require.NoError(t, err)
 			require.ElementsMatch(t, []*model.Board{
 				rBoard1,
@@ -218,7 +219,7 @@ func testGetBoardsForUserAndTeam(t *testing.T, store store.Store) {
 		})
 
 		t.Run("should only find the board that the user is a member of for team 2", func(t *testing.T) {
-			boards, err := store.GetBoardsForUserAndTeam(userID, teamID2, true)
+			boards, err := store.GetBoardsForUserAndTeam(context.Background(), userID, teamID2, true)
 			require.NoError(t, err)
 			require.Len(t, boards, 1)
 			require.Equal(t, board5.ID, boards[0].ID)
@@ -234,7 +235,7 @@ func testGetBoardsInTeamByIds(t *testing.T, store store.Store) {
 				TeamID: testTeamID,
 				Type:   model.BoardTypeOpen,
 			}
-			rBoard, _, err := store.InsertBoardWithAdmin(board, testUserID)
+			rBoard, _, err := store.InsertBoardWithAdmin(context.Background(), board, testUserID)
 			require.NoError(t, err)
 			require.NotNil(t, rBoard)
 		}
@@ -267,7 +268,7 @@ func testGetBoardsInTeamByIds(t *testing.T, store store.Store) {
 
 		for _, tc := range testCases {
 			t.Run(tc.Name, func(t *testing.T) {
-				boards, err := store.GetBoardsInTeamByIds(tc.BoardIDs, testTeamID)
+				boards, err := store.GetBoardsInTeamByIds(context.Background(), tc.BoardIDs, testTeamID)
 				if tc.ExpectedError {
 					var naf *model.ErrNotAllFound
 					require.ErrorAs(t, err, &naf)
@@ -291,7 +292,7 @@ func testInsertBoard(t *testing.T, store store.Store) {
 			Type:   model.BoardTypeOpen,
 		}
 
-		newBoard, err := store.InsertBoard(board, userID)
+		newBoard, err := store.InsertBoard(context.Background(), board, userID)
 		require.NoError(t, err)
 		require.Equal(t, board.ID, newBoard.ID)
 		require.Equal(t, newBoard.Type, model.BoardTypeOpen)
@@ -309,7 +310,7 @@ func testInsertBoard(t *testing.T, store store.Store) {
 			Type:   model.BoardTypePrivate,
 		}
 
-		newBoard, err := store.InsertBoard(board, userID)
+		newBoard, err := store.InsertBoard(context.Background(), board, userID)
 		require.NoError(t, err)
 		require.Equal(t, board.ID, newBoard.ID)
 		require.Equal(t, newBoard.Type, model.BoardTypePrivate)
@@ -327,10 +328,10 @@ func testInsertBoard(t *testing.T, store store.Store) {
 			Properties: map[string]interface{}{"no-serializable-value": t.Run},
 		}
 
-		_, err := store.InsertBoard(board, userID)
+		_, err := store.InsertBoard(context.Background(), board, userID)
 		require.Error(t, err)
 
-		rBoard, err := store.GetBoard(board.ID)
+		rBoard, err := store.GetBoard(context.Background(), board.ID)
 		require.True(t, model.IsErrNotFound(err), "Should be ErrNotFound compatible error")
 		require.Nil(t, rBoard)
 	})
@@ -345,7 +346,7 @@ func testInsertBoard(t *testing.T, store store.Store) {
 		// wait to avoid hitting pk uniqueness constraint in history
 		time.Sleep(10 * time.Millisecond)
 
-		newBoard, err := store.InsertBoard(board, "user2")
+		newBoard, err := store.InsertBoard(context.Background(), board, "user2")
 		require.NoError(t, err)
 		require.Equal(t, "New title", newBoard.Title)
 		require.Equal(t, "user2", newBoard.ModifiedBy)
@@ -358,7 +359,7 @@ func testInsertBoard(t *testing.T, store store.Store) {
 			Type:  model.BoardTypeOpen,
 		}
 
-		newBoard, err := store.InsertBoard(board, userID)
+		newBoard, err := store.InsertBoard(context.Background(), board, userID)
 		require.NoError(t, err)
 		require.Equal(t, model.BoardTypeOpen, newBoard.Type)
 
@@ -370,7 +371,7 @@ func testInsertBoard(t *testing.T, store store.Store) {
 		// wait to avoid hitting pk uniqueness constraint in history
 		time.Sleep(10 * time.Millisecond)
 
-		modifiedBoard, err := store.InsertBoard(boardUpdate, userID)
+		modifiedBoard, err := store.InsertBoard(context.Background(), boardUpdate, userID)
 		require.NoError(t, err)
 		require.Equal(t, model.BoardTypePrivate, modifiedBoard.Type)
 	})
@@ -383,7 +384,7 @@ func testPatchBoard(t *testing.T, store store.Store) {
 		newTitle := "A new title"
 		patch := &model.BoardPatch{Title: &newTitle}
 
-		board, err := store.PatchBoard("nonexistent-board-id", patch, userID)
+		board, err := store.PatchBoard(context.Background(), "nonexistent-board-id", patch, userID)
 		require.Error(t, err)
 		require.Nil(t, board)
 	})
@@ -400,7 +401,7 @@ func testPatchBoard(t *testing.T, store store.Store) {
 			Description: "A simple description",
 		}
 
-		newBoard, err := store.InsertBoard(board, userID)
+		newBoard, err := store.InsertBoard(context.Background(), board, userID)
 		require.NoError(t, err)
 		require.NotNil(t, newBoard)
 		require.Equal(t, userID, newBoard.CreatedBy)
@@ -411,7 +412,7 @@ func testPatchBoard(t *testing.T, store store.Store) {
 		newTitle := "A new title"
 		newDescription := "A new description"
 		patch := &model.BoardPatch{Title: &newTitle, Description: &newDescription}
-		patchedBoard, err := store.PatchBoard(boardID, patch, userID2)
+		patchedBoard, err := store.PatchBoard(context.Background(), boardID, patch, userID2)
 		require.NoError(t, err)
 		require.Equal(t, newTitle, patchedBoard.Title)
 		require.Equal(t, newDescription, patchedBoard.Description)
@@ -432,7 +433,7 @@ func testPatchBoard(t *testing.T, store store.Store) {
 			},
 		}
 
-		newBoard, err := store.InsertBoard(board, userID)
+		newBoard, err := store.InsertBoard(context.Background(), board, userID)
 		require.NoError(t, err)
 		require.NotNil(t, newBoard)
 		require.Equal(t, "1", newBoard.Properties["one"].(string))
@@ -445,7 +446,7 @@ func testPatchBoard(t *testing.T, store store.Store) {
 			UpdatedProperties: map[string]interface{}{"three": "3"},
 			DeletedProperties: []string{"one"},
 		}
-		patchedBoard, err := store.PatchBoard(boardID, patch, userID)
+		patchedBoard, err := store.PatchBoard(context.Background(), boardID, patch, userID)
 		require.NoError(t, err)
 		require.NotContains(t, patchedBoard.Properties, "one")
 		require.Equal(t, "2", patchedBoard.Properties["two"].(string))
@@ -461,7 +462,7 @@ func testPatchBoard(t *testing.T, store store.Store) {
 			Type:   model.BoardTypeOpen,
 		}
 
-		newBoard, err := store.InsertBoard(board, userID)
+		newBoard, err := store.InsertBoard(context.Background(), board, userID)
 		require.NoError(t, err)
 		require.NotNil(t, newBoard)
 		require.Equal(t, newBoard.Type, model.BoardTypeOpen)
@@ -471,7 +472,7 @@ func testPatchBoard(t *testing.T, store store.Store) {
 
 		newType := model.BoardTypePrivate
 		patch := &model.BoardPatch{Type: &newType}
-		patchedBoard, err := store.PatchBoard(boardID, patch, userID)
+		patchedBoard, err := store.PatchBoard(context.Background(), boardID, patch, userID)
 		require.NoError(t, err)
 		require.Equal(t, model.BoardTypePrivate, patchedBoard.Type)
 	})
@@ -489,7 +490,7 @@ func testPatchBoard(t *testing.T, store store.Store) {
 			CardProperties: cardProperties,
 		}
 
-		newBoard, err := store.InsertBoard(board, userID)
+		newBoard, err := store.InsertBoard(context.Background(), board, userID)
 		require.NoError(t, err)
 		require.NotNil(t, newBoard)
 		require.Equal(t, newBoard.Type, model.BoardTypeOpen)
@@ -501,7 +502,7 @@ func testPatchBoard(t *testing.T, store store.Store) {
 
 		newType := model.BoardTypePrivate
 		patch := &model.BoardPatch{Type: &newType}
-		patchedBoard, err := store.PatchBoard(boardID, patch, userID)
+		patchedBoard, err := store.PatchBoard(context.Background(), boardID, patch, userID)
 		require.NoError(t, err)
 		require.Equal(t, model.BoardTypePrivate, patchedBoard.Type)
 		require.Equal(t, properties, patchedBoard.Properties)
@@ -522,7 +523,7 @@ func testPatchBoard(t *testing.T, store store.Store) {
 			CardProperties: cardProperties,
 		}
 
-		newBoard, err := store.InsertBoard(board, userID)
+		newBoard, err := store.InsertBoard(context.Background(), board, userID)
 		require.NoError(t, err)
 		require.NotNil(t, newBoard)
 		require.Equal(t, newBoard.Type, model.BoardTypeOpen)
@@ -537,7 +538,7 @@ func testPatchBoard(t *testing.T, store store.Store) {
 			UpdatedCardProperties: []map[string]interface{}{newProp1},
 			DeletedCardProperties: []string{"prop2"},
 		}
-		patchedBoard, err := store.PatchBoard(boardID, patch, userID)
+		patchedBoard, err := store.PatchBoard(context.Background(), boardID, patch, userID)
 		require.NoError(t, err)
 		require.ElementsMatch(t, expectedCardProperties, patchedBoard.CardProperties)
 	})
@@ -547,7 +548,7 @@ func testDeleteBoard(t *testing.T, store store.Store) {
 	userID := testUserID
 
 	t.Run("should return an error if the board doesn't exist", func(t *testing.T) {
-		require.Error(t, store.DeleteBoard("nonexistent-board-id", userID))
+		require.Error(t, store.DeleteBoard(context.Background(), "nonexistent-board-id", userID))
 	})
 
 	t.Run("should correctly delete the board", func(t *testing.T) {
@@ -559,20 +560,20 @@ func testDeleteBoard(t *testing.T, store store.Store) {
 			Type:   model.BoardTypeOpen,
 		}
 
-		newBoard, err := store.InsertBoard(board, userID)
+		newBoard, err := store.InsertBoard(context.Background(), board, userID)
 		require.NoError(t, err)
 		require.NotNil(t, newBoard)
 
-		rBoard, err := store.GetBoard(boardID)
+		rBoard, err := store.GetBoard(context.Background(), boardID)
 		require.NoError(t, err)
 		require.NotNil(t, rBoard)
 
 		// wait to avoid hitting pk uniqueness constraint in history
 		time.Sleep(10 * time.Millisecond)
 
-		require.NoError(t, store.DeleteBoard(boardID, userID))
+		require.NoError(t, store.DeleteBoard(context.Background(), boardID, userID))
 
-		r2Board, err := store.GetBoard(boardID)
+		r2Board, err := store.GetBoard(context.Background(), boardID)
 		require.True(t, model.IsErrNotFound(err), "Should be ErrNotFound compatible error")
 		require.Nil(t, r2Board)
 	})
@@ -590,7 +591,7 @@ func testInsertBoardWithAdmin(t *testing.T, store store.Store) {
 			Type:   model.BoardTypeOpen,
 		}
 
-		newBoard, newMember, err := store.InsertBoardWithAdmin(board, userID)
+		newBoard, newMember, err := store.InsertBoardWithAdmin(context.Background(), board, userID)
 		require.NoError(t, err)
 		require.NotNil(t, newBoard)
 		require.Equal(t, userID, newBoard.CreatedBy)
@@ -614,18 +615,18 @@ func testSaveMember(t *testing.T, store store.Store) {
 			SchemeAdmin: true,
 		}
 
-		memberHistory, err := store.GetBoardMemberHistory(boardID, userID, 0)
+		memberHistory, err := store.GetBoardMemberHistory(context.Background(), boardID, userID, 0)
 		require.NoError(t, err)
 		initialMemberHistory := len(memberHistory)
 
-		nbm, err := store.SaveMember(bm)
+		nbm, err := store.SaveMember(context.Background(), bm)
 		require.NoError(t, err)
 		require.Equal(t, userID, nbm.UserID)
 		require.Equal(t, boardID, nbm.BoardID)
 
 		require.True(t, nbm.SchemeAdmin)
 
-		memberHistory, err = store.GetBoardMemberHistory(boardID, userID, 0)
+		memberHistory, err = store.GetBoardMemberHistory(context.Background(), boardID, userID, 0)
 		require.NoError(t, err)
 		require.Len(t, memberHistory, initialMemberHistory+1)
 	})
@@ -638,11 +639,11 @@ func testSaveMember(t *testing.T, store store.Store) {
 			SchemeViewer: true,
 		}
 
-		memberHistory, err := store.GetBoardMemberHistory(boardID, userID, 0)
+		memberHistory, err := store.GetBoardMemberHistory(context.Background(), boardID, userID, 0)
 		require.NoError(t, err)
 		initialMemberHistory := len(memberHistory)
 
-		nbm, err := store.SaveMember(bm)
+		nbm, err := store.SaveMember(context.Background(), bm)
 		require.NoError(t, err)
 		require.Equal(t, userID, nbm.UserID)
 		require.Equal(t, boardID, nbm.BoardID)
@@ -651,13 +652,13 @@ func testSaveMember(t *testing.T, store store.Store) {
 		require.True(t, nbm.SchemeEditor)
 		require.True(t, nbm.SchemeViewer)
 
-		memberHistory, err = store.GetBoardMemberHistory(boardID, userID, 0)
+		memberHistory, err = store.GetBoardMemberHistory(context.Background(), boardID, userID, 0)
 		require.NoError(t, err)
 		require.Len(t, memberHistory, initialMemberHistory)
 	})
 
 	t.Run("should return empty list if no results are found", func(t *testing.T) {
-		memberHistory, err := store.GetBoardMemberHistory(boardID, "nonexistent-user", 0)
+		memberHistory, err := store.GetBoardMemberHistory(context.Background(), boardID, "nonexistent-user", 0)
 		require.NoError(t, err)
 		require.Empty(t, memberHistory)
 	})
@@ -668,7 +669,7 @@ func testGetMemberForBoard(t *testing.T, store store.Store) {
 	boardID := testBoardID
 
 	t.Run("should return an error not found for nonexisting membership", func(t *testing.T) {
-		bm, err := store.GetMemberForBoard(boardID, userID)
+		bm, err := store.GetMemberForBoard(context.Background(), boardID, userID)
 		var nf *model.ErrNotFound
 		require.ErrorAs(t, err, &nf)
 		require.True(t, model.IsErrNotFound(err), "Should be ErrNotFound compatible error")
@@ -682,11 +683,11 @@ func testGetMemberForBoard(t *testing.T, store store.Store) {
 			SchemeAdmin: true,
 		}
 
-		nbm, err := store.SaveMember(bm)
+		nbm, err := store.SaveMember(context.Background(), bm)
 		require.NoError(t, err)
 		require.NotNil(t, nbm)
 
-		rbm, err := store.GetMemberForBoard(boardID, userID)
+		rbm, err := store.GetMemberForBoard(context.Background(), boardID, userID)
 		require.NoError(t, err)
 		require.NotNil(t, rbm)
 		require.Equal(t, userID, rbm.UserID)
@@ -697,7 +698,7 @@ func testGetMemberForBoard(t *testing.T, store store.Store) {
 
 func testGetMembersForBoard(t *testing.T, store store.Store) {
 	t.Run("should return empty list if there are no members on a board", func(t *testing.T) {
-		members, err := store.GetMembersForBoard(testBoardID)
+		members, err := store.GetMembersForBoard(context.Background(), testBoardID)
 		require.NoError(t, err)
 		require.Empty(t, members)
 	})
@@ -711,15 +712,15 @@ func testGetMembersForBoard(t *testing.T, store store.Store) {
 		userID3 := "user-id-13"
 
 		bm1 := &model.BoardMember{BoardID: boardID1, UserID: userID1, SchemeAdmin: true}
-		_, err1 := store.SaveMember(bm1)
+		_, err1 := store.SaveMember(context.Background(), bm1)
 		require.NoError(t, err1)
 
 		bm2 := &model.BoardMember{BoardID: boardID1, UserID: userID2, SchemeEditor: true}
-		_, err2 := store.SaveMember(bm2)
+		_, err2 := store.SaveMember(context.Background(), bm2)
 		require.NoError(t, err2)
 
 		bm3 := &model.BoardMember{BoardID: boardID2, UserID: userID3, SchemeAdmin: true}
-		_, err3 := store.SaveMember(bm3)
+		_, err3 := store.SaveMember(context.Background(), bm3)
 		require.NoError(t, err3)
 
 		getMemberIDs := func(members []*model.BoardMember) []string {
@@ -730,12 +731,12 @@ func testGetMembersForBoard(t *testing.T, store store.Store) {
 			return ids
 		}
 
-		board1Members, err := store.GetMembersForBoard(boardID1)
+		board1Members, err := store.GetMembersForBoard(context.Background(), boardID1)
 		require.NoError(t, err)
 		require.Len(t, board1Members, 2)
 		require.ElementsMatch(t, []string{userID1, userID2}, getMemberIDs(board1Members))
 
-		board2Members, err := store.GetMembersForBoard(boardID2)
+		board2Members, err := store.GetMembersForBoard(context.Background(), boardID2)
 		require.NoError(t, err)
 		require.Len(t, board2Members, 1)
 		require.ElementsMatch(t, []string{userID3}, getMemberIDs(board2Members))
@@ -744,7 +745,7 @@ func testGetMembersForBoard(t *testing.T, store store.Store) {
 
 func testGetMembersForUser(t *testing.T, store store.Store) {
 	t.Run("should return empty list if there are no memberships for a user", func(t *testing.T) {
-		members, err := store.GetMembersForUser(testUserID)
+		members, err := store.GetMembersForUser(context.Background(), testUserID)
 		require.NoError(t, err)
 		require.Empty(t, members)
 	})
@@ -755,13 +756,13 @@ func testDeleteMember(t *testing.T, store store.Store) {
 	boardID := testBoardID
 
 	t.Run("should return nil if deleting a nonexistent member", func(t *testing.T) {
-		memberHistory, err := store.GetBoardMemberHistory(boardID, userID, 0)
+		memberHistory, err := store.GetBoardMemberHistory(context.Background(), boardID, userID, 0)
 		require.NoError(t, err)
 		initialMemberHistory := len(memberHistory)
 
-		require.NoError(t, store.DeleteMember(boardID, userID))
+		require.NoError(t, store.DeleteMember(context.Background(), boardID, userID))
 
-		memberHistory, err = store.GetBoardMemberHistory(boardID, userID, 0)
+		memberHistory, err = store.GetBoardMemberHistory(context.Background(), boardID, userID, 0)
 		require.NoError(t, err)
 		require.Len(t, memberHistory, initialMemberHistory)
 	})
@@ -773,24 +774,24 @@ func testDeleteMember(t *testing.T, store store.Store) {
 			SchemeAdmin: true,
 		}
 
-		nbm, err := store.SaveMember(bm)
+		nbm, err := store.SaveMember(context.Background(), bm)
 		require.NoError(t, err)
 		require.NotNil(t, nbm)
 
-		memberHistory, err := store.GetBoardMemberHistory(boardID, userID, 0)
+		memberHistory, err := store.GetBoardMemberHistory(context.Background(), boardID, userID, 0)
 		require.NoError(t, err)
 		initialMemberHistory := len(memberHistory)
 
 		// wait to avoid hitting pk uniqueness constraint in history
 		time.Sleep(1 * time.Millisecond)
 
-		require.NoError(t, store.DeleteMember(boardID, userID))
+		require.NoError(t, store.DeleteMember(context.Background(), boardID, userID))
 
-		rbm, err := store.GetMemberForBoard(boardID, userID)
+		rbm, err := store.GetMemberForBoard(context.Background(), boardID, userID)
 		require.True(t, model.IsErrNotFound(err), "Should be ErrNotFound compatible error")
 		require.Nil(t, rbm)
 
-		memberHistory, err = store.GetBoardMemberHistory(boardID, userID, 0)
+		memberHistory, err = store.GetBoardMemberHistory(context.Background(), boardID, userID, 0)
 		require.NoError(t, err)
 		require.Len(t, memberHistory, initialMemberHistory+1)
 	})
@@ -802,7 +803,7 @@ func testSearchBoardsForUser(t *testing.T, store store.Store) {
 	userID := "user-id-1"
 
 	t.Run("should return empty if user is not a member of any board and there are no public boards on the team", func(t *testing.T) {
-		boards, err := store.SearchBoardsForUser("", model.BoardSearchFieldTitle, userID, true)
+		boards, err := store.SearchBoardsForUser(context.Background(), "", model.BoardSearchFieldTitle, userID, true)
 		require.NoError(t, err)
 		require.Empty(t, boards)
 	})
@@ -814,7 +815,7 @@ func testSearchBoardsForUser(t *testing.T, store store.Store) {
 		Title:      "Public Board with admin",
 		Properties: map[string]any{"foo": "bar1"},
 	}
-	_, _, err := store.InsertBoardWithAdmin(board1, userID)
+	_, _, err := store.InsertBoardWithAdmin(context.Background(), board1, userID)
 	require.NoError(t, err)
 
 	board2 := &model.Board{
@@ -824,7 +825,7 @@ func testSearchBoardsForUser(t *testing.T, store store.Store) {
 		Title:      "Public Board",
 		Properties: map[string]any{"foo": "bar2"},
 	}
-	_, err = store.InsertBoard(board2, userID)
+	_, err = store.InsertBoard(context.Background(), board2, userID)
 	require.NoError(t, err)
 
 	board3 := &model.Board{
@@ -833,7 +834,7 @@ func testSearchBoardsForUser(t *testing.T, store store.Store) {
 		Type:   model.BoardTypePrivate,
 		Title:  "Private Board with admin",
 	}
-	_, _, err = store.InsertBoardWithAdmin(board3, userID)
+	_, _, err = store.InsertBoardWithAdmin(context.Background(), board3, userID)
 	require.NoError(t, err)
 
 	board4 := &model.Board{
@@ -842,7 +843,7 @@ func testSearchBoardsForUser(t *testing.T, store store.Store) {
 		Type:   model.BoardTypePrivate,
 		Title:  "Private Board",
 	}
-	_, err = store.InsertBoard(board4, userID)
+	_, err = store.InsertBoard(context.Background(), board4, userID)
 	require.NoError(t, err)
 
 	board5 := &model.Board{
@@ -851,7 +852,7 @@ func testSearchBoardsForUser(t *testing.T, store store.Store) {
 		Type:   model.BoardTypeOpen,
 		Title:  "Public Board with admin in team 2",
 	}
-	_, _, err = store.InsertBoardWithAdmin(board5, userID)
+	_, _, err = store.InsertBoardWithAdmin(context.Background(), board5, userID)
 	require.NoError(t, err)
 
 	testCases := []struct {
@@ -939,7 +940,7 @@ func testSearchBoardsForUser(t *testing.T, store store.Store) {
 
 	for _, tc := range testCases {
 		t.Run(tc.Name, func(t *testing.T) {
-			boards, err := store.SearchBoardsForUser(tc.Term, tc.SearchField, tc.UserID, tc.IncludePublic)
+			boards, err := store.SearchBoardsForUser(context.Background(), tc.Term, tc.SearchField, tc.UserID, tc.IncludePublic)
 			require.NoError(t, err)
 
 			boardIDs := []string{}
@@ -953,7 +954,7 @@ func testSearchBoardsForUser(t *testing.T, store store.Store) {
 
 func testSearchBoardsForUserInTeam(t *testing.T, store store.Store) {
 	t.Run("should return empty list if there are no resutls", func(t *testing.T) {
-		boards, err := store.SearchBoardsForUserInTeam("nonexistent-team-id", "", testUserID)
+		boards, err := store.SearchBoardsForUserInTeam(context.Background(), "nonexistent-team-id", "", testUserID)
 		require.NoError(t, err)
 		require.Empty(t, boards)
 	})
@@ -985,24 +986,24 @@ func testUndeleteBoard(t *testing.T, store store.Store) {
 			},
 		}
 
-		newBoard, err := store.InsertBoard(board, userID)
+		newBoard, err := store.InsertBoard(context.Background(), board, userID)
 		require.NoError(t, err)
 		require.NotNil(t, newBoard)
 
 		// Wait for not colliding the ID+insert_at key
 		time.Sleep(1 * time.Millisecond)
-		err = store.DeleteBoard(boardID, userID)
+		err = store.DeleteBoard(context.Background(), boardID, userID)
 		require.NoError(t, err)
 
-		board, err = store.GetBoard(boardID)
+		board, err = store.GetBoard(context.Background(), boardID)
 		require.Error(t, err)
 		require.Nil(t, board)
 
 		time.Sleep(1 * time.Millisecond)
-		err = store.UndeleteBoard(boardID, userID)
+		err = store.UndeleteBoard(context.Background(), boardID, userID)
 		require.NoError(t, err)
 
-		board, err = store.GetBoard(boardID)
+		board, err = store.GetBoard(context.Background(), boardID)
 		require.NoError(t, err)
 		require.NotNil(t, board)
 
@@ -1030,34 +1031,34 @@ func testUndeleteBoard(t *testing.T, store store.Store) {
 			Type:   model.BoardTypeOpen,
 		}
 
-		newBoard, err := store.InsertBoard(board, userID)
+		newBoard, err := store.InsertBoard(context.Background(), board, userID)
 		require.NoError(t, err)
 		require.NotNil(t, newBoard)
 
 		// Wait for not colliding the ID+insert_at key
 		time.Sleep(1 * time.Millisecond)
-		err = store.DeleteBoard(boardID, userID)
+		err = store.DeleteBoard(context.Background(), boardID, userID)
 		require.NoError(t, err)
 
-		board, err = store.GetBoard(boardID)
+		board, err = store.GetBoard(context.Background(), boardID)
 		require.Error(t, err)
 		require.Nil(t, board)
 
 		// Wait for not colliding the ID+insert_at key
 		time.Sleep(1 * time.Millisecond)
-		err = store.UndeleteBoard(boardID, userID)
+		err = store.UndeleteBoard(context.Background(), boardID, userID)
 		require.NoError(t, err)
 
-		board, err = store.GetBoard(boardID)
+		board, err = store.GetBoard(context.Background(), boardID)
 		require.NoError(t, err)
 		require.NotNil(t, board)
 
 		// Wait for not colliding the ID+insert_at key
 		time.Sleep(1 * time.Millisecond)
-		err = store.UndeleteBoard(boardID, userID)
+		err = store.UndeleteBoard(context.Background(), boardID, userID)
 		require.NoError(t, err)
 
-		board, err = store.GetBoard(boardID)
+		board, err = store.GetBoard(context.Background(), boardID)
 		require.NoError(t, err)
 		require.NotNil(t, board)
 	})
@@ -1065,10 +1066,10 @@ func testUndeleteBoard(t *testing.T, store store.Store) {
 	t.Run("from not existing id", func(t *testing.T) {
 		// Wait for not colliding the ID+insert_at key
 		time.Sleep(1 * time.Millisecond)
-		err := store.UndeleteBoard("not-exists", userID)
+		err := store.UndeleteBoard(context.Background(), "not-exists", userID)
 		require.NoError(t, err)
 
-		block, err := store.GetBoard("not-exists")
+		block, err := store.GetBoard(context.Background(), "not-exists")
 		require.Error(t, err)
 		require.Nil(t, block)
 	})
@@ -1087,7 +1088,7 @@ func testGetBoardHistory(t *testing.T, store store.Store) {
 			Type:   model.BoardTypeOpen,
 		}
 
-		rBoard1, err := store.InsertBoard(board, userID)
+		rBoard1, err := store.InsertBoard(context.Background(), board, userID)
 		require.NoError(t, err)
 
 		opts := model.QueryBoardHistoryOptions{
@@ -1095,7 +1096,7 @@ func testGetBoardHistory(t *testing.T, store store.Store) {
 			Descending: false,
 		}
 
-		boards, err := store.GetBoardHistory(board.ID, opts)
+		boards, err := store.GetBoardHistory(context.Background(), board.ID, opts)
 		require.NoError(t, err)
 		require.Len(t, boards, 1)
 
@@ -1106,11 +1107,11 @@ func testGetBoardHistory(t *testing.T, store store.Store) {
 		newTitle := "Board: A new title"
 		newDescription := "A new description"
 		patch := &model.BoardPatch{Title: &newTitle, Description: &newDescription}
-		patchedBoard, err := store.PatchBoard(boardID, patch, userID2)
+		patchedBoard, err := store.PatchBoard(context.Background(), boardID, patch, userID2)
 		require.NoError(t, err)
 
 		// Updated history
-		boards, err = store.GetBoardHistory(board.ID, opts)
+		boards, err = store.GetBoardHistory(context.Background(), board.ID, opts)
 		require.NoError(t, err)
 		require.Len(t, boards, 2)
 		require.Equal(t, boards[0].Title, originalTitle)
@@ -1118,7 +1119,7 @@ func testGetBoardHistory(t *testing.T, store store.Store) {
 		require.Equal(t, boards[1].Description, newDescription)
 
 		// Check history against latest board
-		rBoard2, err := store.GetBoard(board.ID)
+		rBoard2, err := store.GetBoard(context.Background(), board.ID)
 		require.NoError(t, err)
 		require.Equal(t, rBoard2.Title, newTitle)
 		require.Equal(t, rBoard2.Title, boards[1].Title)
@@ -1134,7 +1135,7 @@ func testGetBoardHistory(t *testing.T, store store.Store) {
 
 		newTitle2 := "Board: A new title 2"
 		patch2 := &model.BoardPatch{Title: &newTitle2}
-		patchBoard2, err := store.PatchBoard(boardID, patch2, userID2)
+		patchBoard2, err := store.PatchBoard(context.Background(), boardID, patch2, userID2)
 		require.NoError(t, err)
 
 		// Updated history
@@ -1142,7 +1143,7 @@ func testGetBoardHistory(t *testing.T, store store.Store) {
 			Limit:      1,
 			Descending: true,
 		}
-		boards, err = store.GetBoardHistory(board.ID, opts)
+		boards, err = store.GetBoardHistory(context.Background(), board.ID, opts)
 		require.NoError(t, err)
 		require.Len(t, boards, 1)
 		require.Equal(t, boards[0].Title, newTitle2)
@@ -1150,7 +1151,7 @@ func testGetBoardHistory(t *testing.T, store store.Store) {
 
 		// Delete board
 		time.Sleep(10 * time.Millisecond)
-		err = store.DeleteBoard(boardID, userID)
+		err = store.DeleteBoard(context.Background(), boardID, userID)
 		require.NoError(t, err)
 
 		// Updated history after delete
@@ -1158,7 +1159,7 @@ func testGetBoardHistory(t *testing.T, store store.Store) {
 			Limit:      0,
 			Descending: true,
 		}
-		boards, err = store.GetBoardHistory(board.ID, opts)
+		boards, err = store.GetBoardHistory(context.Background(), board.ID, opts)
 		require.NoError(t, err)
 		require.Len(t, boards, 4)
 		require.NotZero(t, boards[0].UpdateAt)
@@ -1172,7 +1173,7 @@ func testGetBoardHistory(t *testing.T, store store.Store) {
 			Limit:      0,
 			Descending: false,
 		}
-		boards, err := store.GetBoardHistory("nonexistent-id", opts)
+		boards, err := store.GetBoardHistory(context.Background(), "nonexistent-id", opts)
 		require.NoError(t, err)
 		require.Len(t, boards, 0)
 	})
@@ -1182,7 +1183,7 @@ func testGetBoardCount(t *testing.T, store store.Store) {
 	userID := testUserID
 
 	t.Run("test GetBoardCount", func(t *testing.T) {
-		originalCount, err := store.GetBoardCount(false)
+		originalCount, err := store.GetBoardCount(context.Background(), false)
 		require.NoError(t, err)
 
 		title := "Board: original title"
@@ -1194,16 +1195,16 @@ func testGetBoardCount(t *testing.T, store store.Store) {
 			Type:   model.BoardTypeOpen,
 		}
 
-		_, err = store.InsertBoard(board, userID)
+		_, err = store.InsertBoard(context.Background(), board, userID)
 		require.NoError(t, err)
 
-		newCount, err := store.GetBoardCount(false)
+		newCount, err := store.GetBoardCount(context.Background(), false)
 		require.NoError(t, err)
 		require.Equal(t, originalCount+1, newCount)
 	})
 
 	t.Run("test GetBoardCount, include deleted", func(t *testing.T) {
-		originalCount, err := store.GetBoardCount(true)
+		originalCount, err := store.GetBoardCount(context.Background(), true)
 		require.NoError(t, err)
 
 		title := "Board: original title"
@@ -1215,17 +1216,17 @@ func testGetBoardCount(t *testing.T, store store.Store) {
 			Type:   model.BoardTypeOpen,
 		}
 
-		_, err = store.InsertBoard(board, userID)
+		_, err = store.InsertBoard(context.Background(), board, userID)
 		require.NoError(t, err)
 
-		newCount, err := store.GetBoardCount(true)
+		newCount, err := store.GetBoardCount(context.Background(), true)
 		require.NoError(t, err)
 		require.Equal(t, originalCount+1, newCount)
 
-		err = store.DeleteBoard(boardID, userID)
+		err = store.DeleteBoard(context.Background(), boardID, userID)
 		require.NoError(t, err)
 		// Count should stay the same
-		newCount, err = store.GetBoardCount(true)
+		newCount, err = store.GetBoardCount(context.Background(), true)
 		require.NoError(t, err)
 		require.Equal(t, originalCount+1, newCount)
 	})
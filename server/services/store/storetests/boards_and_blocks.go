@@ -4,6 +4,7 @@
 package storetests
 
 import (
+	"context"
 	"fmt"
 	"strings"
 	"testing"
@@ -44,7 +45,7 @@ func testCreateBoardsAndBlocks(t *testing.T, store store.Store) {
 	teamID := testTeamID
 	userID := testUserID
 
-	boards, err := store.GetBoardsForUserAndTeam(userID, teamID, true)
+	boards, err := store.GetBoardsForUserAndTeam(context.Background(), userID, teamID, true)
 	require.Nil(t, err)
 	require.Empty(t, boards)
 
@@ -61,7 +62,7 @@ func testCreateBoardsAndBlocks(t *testing.T, store store.Store) {
 			},
 		}
 
-		bab, err := store.CreateBoardsAndBlocks(newBab, userID)
+		bab, err := store.CreateBoardsAndBlocks(context.Background(), newBab, userID)
 		require.Nil(t, err)
 		require.NotNil(t, bab)
 		require.Len(t, bab.Boards, 3)
@@ -94,7 +95,7 @@ func testCreateBoardsAndBlocks(t *testing.T, store store.Store) {
 			},
 		}
 
-		bab, members, err := store.CreateBoardsAndBlocksWithAdmin(newBab, userID)
+		bab, members, err := store.CreateBoardsAndBlocksWithAdmin(context.Background(), newBab, userID)
 		require.Nil(t, err)
 		require.NotNil(t, bab)
 		require.Len(t, bab.Boards, 3)
@@ -136,11 +137,11 @@ func testCreateBoardsAndBlocks(t *testing.T, store store.Store) {
 			},
 		}
 
-		bab, err := store.CreateBoardsAndBlocks(newBab, userID)
+		bab, err := store.CreateBoardsAndBlocks(context.Background(), newBab, userID)
 		require.Error(t, err)
 		require.Nil(t, bab)
 
-		bab, members, err := store.CreateBoardsAndBlocksWithAdmin(newBab, userID)
+		bab, members, err := store.CreateBoardsAndBlocksWithAdmin(context.Background(), newBab, userID)
 		require.Error(t, err)
 		require.Empty(t, bab)
 		require.Empty(t, members)
@@ -160,11 +161,11 @@ func testCreateBoardsAndBlocks(t *testing.T, store store.Store) {
 			},
 		}
 
-		bab, err := store.CreateBoardsAndBlocks(newBab, userID)
+		bab, err := store.CreateBoardsAndBlocks(context.Background(), newBab, userID)
 		require.ErrorIs(t, err, model.ErrBlockTitleSizeLimitExceeded)
 		require.Nil(t, bab)
 
-		bab, members, err := store.CreateBoardsAndBlocksWithAdmin(newBab, userID)
+		bab, members, err := store.CreateBoardsAndBlocksWithAdmin(context.Background(), newBab, userID)
 		require.ErrorIs(t, err, model.ErrBlockTitleSizeLimitExceeded)
 		require.Empty(t, bab)
 		require.Empty(t, members)
@@ -189,7 +190,7 @@ func testPatchBoardsAndBlocks(t *testing.T, store store.Store) {
 			TeamID: teamID,
 			Type:   model.BoardTypeOpen,
 		}
-		_, err := store.InsertBoard(board, userID)
+		_, err := store.InsertBoard(context.Background(), board, userID)
 		require.NoError(t, err)
 
 		block := &model.Block{
@@ -197,7 +198,7 @@ func testPatchBoardsAndBlocks(t *testing.T, store store.Store) {
 			BoardID: "board-id-1",
 			Title:   initialTitle,
 		}
-		require.NoError(t, store.InsertBlock(block, userID))
+		require.NoError(t, store.InsertBlock(context.Background(), block, userID))
 
 		// apply the patches
 		pbab := &model.PatchBoardsAndBlocks{
@@ -214,16 +215,16 @@ func testPatchBoardsAndBlocks(t *testing.T, store store.Store) {
 
 		time.Sleep(10 * time.Millisecond)
 
-		bab, err := store.PatchBoardsAndBlocks(pbab, userID)
+		bab, err := store.PatchBoardsAndBlocks(context.Background(), pbab, userID)
 		require.Error(t, err)
 		require.Nil(t, bab)
 
 		// check that things have not changed
-		rBoard, err := store.GetBoard("board-id-1")
+		rBoard, err := store.GetBoard(context.Background(), "board-id-1")
 		require.NoError(t, err)
 		require.Equal(t, initialTitle, rBoard.Title)
 
-		rBlock, err := store.GetBlock("block-id-1")
+		rBlock, err := store.GetBlock(context.Background(), "block-id-1")
 		require.NoError(t, err)
 		require.Equal(t, initialTitle, rBlock.Title)
 	})
@@ -242,7 +243,7 @@ func testPatchBoardsAndBlocks(t *testing.T, store store.Store) {
 			TeamID: teamID,
 			Type:   model.BoardTypeOpen,
 		}
-		_, err := store.InsertBoard(board, userID)
+		_, err := store.InsertBoard(context.Background(), board, userID)
 		require.NoError(t, err)
 
 		block := &model.Block{
@@ -250,7 +251,7 @@ func testPatchBoardsAndBlocks(t *testing.T, store store.Store) {
 			BoardID: "board-id-1",
 			Title:   initialTitle,
 		}
-		require.NoError(t, store.InsertBlock(block, userID))
+		require.NoError(t, store.InsertBlock(context.Background(), block, userID))
 
 		// apply the patches
 		pbab := &model.PatchBoardsAndBlocks{
@@ -262,12 +263,12 @@ func testPatchBoardsAndBlocks(t *testing.T, store store.Store) {
 
 		time.Sleep(10 * time.Millisecond)
 
-		bab, err := store.PatchBoardsAndBlocks(pbab, userID)
+		bab, err := store.PatchBoardsAndBlocks(context.Background(), pbab, userID)
 		require.ErrorIs(t, err, model.ErrBlockTitleSizeLimitExceeded)
 		require.Nil(t, bab)
 
 		// check that things have not changed
-		rBlock, err := store.GetBlock("block-id-1")
+		rBlock, err := store.GetBlock(context.Background(), "block-id-1")
 		require.NoError(t, err)
 		require.Equal(t, initialTitle, rBlock.Title)
 	})
@@ -285,7 +286,7 @@ func testPatchBoardsAndBlocks(t *testing.T, store store.Store) {
 			},
 		}
 
-		rBab, err := store.CreateBoardsAndBlocks(newBab, userID)
+		rBab, err := store.CreateBoardsAndBlocks(context.Background(), newBab, userID)
 		require.Nil(t, err)
 		require.NotNil(t, rBab)
 		require.Len(t, rBab.Boards, 3)
@@ -311,27 +312,27 @@ func testPatchBoardsAndBlocks(t *testing.T, store store.Store) {
 
 		time.Sleep(10 * time.Millisecond)
 
-		bab, err := store.PatchBoardsAndBlocks(pbab, userID)
+		bab, err := store.PatchBoardsAndBlocks(context.Background(), pbab, userID)
 		require.NoError(t, err)
 		require.NotNil(t, bab)
 		require.Len(t, bab.Boards, 2)
 		require.Len(t, bab.Blocks, 2)
 
 		// check that things have changed
-		board1, err := store.GetBoard("board-id-1")
+		board1, err := store.GetBoard(context.Background(), "board-id-1")
 		require.NoError(t, err)
 		require.Equal(t, newDescription, board1.Description)
 
-		board3, err := store.GetBoard("board-id-3")
+		board3, err := store.GetBoard(context.Background(), "board-id-3")
 		require.NoError(t, err)
 		require.Equal(t, newTitle, board3.Title)
 		require.Equal(t, newDescription, board3.Description)
 
-		block1, err := store.GetBlock("block-id-1")
+		block1, err := store.GetBlock(context.Background(), "block-id-1")
 		require.NoError(t, err)
 		require.Equal(t, newTitle, block1.Title)
 
-		block2, err := store.GetBlock("block-id-2")
+		block2, err := store.GetBlock(context.Background(), "block-id-2")
 		require.NoError(t, err)
 		require.Equal(t, newSchema, block2.Schema)
 	})
@@ -351,40 +352,40 @@ func testDeleteBoardsAndBlocks(t *testing.T, store store.Store) {
 			TeamID: teamID,
 			Type:   model.BoardTypeOpen,
 		}
-		board1, err := store.InsertBoard(newBoard1, userID)
+		board1, err := store.InsertBoard(context.Background(), newBoard1, userID)
 		require.NoError(t, err)
 
 		block1 := &model.Block{
 			ID:      utils.NewID(utils.IDTypeBlock),
 			BoardID: board1.ID,
 		}
-		require.NoError(t, store.InsertBlock(block1, userID))
+		require.NoError(t, store.InsertBlock(context.Background(), block1, userID))
 
 		block2 := &model.Block{
 			ID:      utils.NewID(utils.IDTypeBlock),
 			BoardID: board1.ID,
 		}
-		require.NoError(t, store.InsertBlock(block2, userID))
+		require.NoError(t, store.InsertBlock(context.Background(), block2, userID))
 
 		newBoard2 := &model.Board{
 			ID:     utils.NewID(utils.IDTypeBoard),
 			TeamID: teamID,
 			Type:   model.BoardTypeOpen,
 		}
-		board2, err := store.InsertBoard(newBoard2, userID)
+		board2, err := store.InsertBoard(context.Background(), newBoard2, userID)
 		require.NoError(t, err)
 
 		block3 := &model.Block{
 			ID:      utils.NewID(utils.IDTypeBlock),
 			BoardID: board2.ID,
 		}
-		require.NoError(t, store.InsertBlock(block3, userID))
+		require.NoError(t, store.InsertBlock(context.Background(), block3, userID))
 
 		block4 := &model.Block{
 			ID:      utils.NewID(utils.IDTypeBlock),
 			BoardID: "different-board-id",
 		}
-		require.NoError(t, store.InsertBlock(block4, userID))
+		require.NoError(t, store.InsertBlock(context.Background(), block4, userID))
 
 		dbab := &model.DeleteBoardsAndBlocks{
 			Boards: []string{board1.ID, board2.ID},
@@ -394,26 +395,26 @@ func testDeleteBoardsAndBlocks(t *testing.T, store store.Store) {
 		time.Sleep(10 * time.Millisecond)
 
 		expectedErrorMsg := fmt.Sprintf("block %s doesn't belong to any of the boards in the delete request", block4.ID)
-		require.EqualError(t, store.DeleteBoardsAndBlocks(dbab, userID), expectedErrorMsg)
+		require.EqualError(t, store.DeleteBoardsAndBlocks(context.Background(), dbab, userID), expectedErrorMsg)
 
 		// all the entities should still exist
-		rBoard1, err := store.GetBoard(board1.ID)
+		rBoard1, err := store.GetBoard(context.Background(), board1.ID)
 		require.NoError(t, err)
 		require.NotNil(t, rBoard1)
-		rBlock1, err := store.GetBlock(block1.ID)
+		rBlock1, err := store.GetBlock(context.Background(), block1.ID)
 		require.NoError(t, err)
 		require.NotNil(t, rBlock1)
-		rBlock2, err := store.GetBlock(block2.ID)
+		rBlock2, err := store.GetBlock(context.Background(), block2.ID)
 		require.NoError(t, err)
 		require.NotNil(t, rBlock2)
 
-		rBoard2, err := store.GetBoard(board2.ID)
+		rBoard2, err := store.GetBoard(context.Background(), board2.ID)
 		require.NoError(t, err)
 		require.NotNil(t, rBoard2)
-		rBlock3, err := store.GetBlock(block3.ID)
+		rBlock3, err := store.GetBlock(context.Background(), block3.ID)
 		require.NoError(t, err)
 		require.NotNil(t, rBlock3)
-		rBlock4, err := store.GetBlock(block4.ID)
+		rBlock4, err := store.GetBlock(context.Background(), block4.ID)
 		require.NoError(t, err)
 		require.NotNil(t, rBlock4)
 	})
@@ -428,40 +429,40 @@ func testDeleteBoardsAndBlocks(t *testing.T, store store.Store) {
 			TeamID: teamID,
 			Type:   model.BoardTypeOpen,
 		}
-		board1, err := store.InsertBoard(newBoard1, userID)
+		board1, err := store.InsertBoard(context.Background(), newBoard1, userID)
 		require.NoError(t, err)
 
 		block1 := &model.Block{
 			ID:      utils.NewID(utils.IDTypeBlock),
 			BoardID: board1.ID,
 		}
-		require.NoError(t, store.InsertBlock(block1, userID))
+		require.NoError(t, store.InsertBlock(context.Background(), block1, userID))
 
 		block2 := &model.Block{
 			ID:      utils.NewID(utils.IDTypeBlock),
 			BoardID: board1.ID,
 		}
-		require.NoError(t, store.InsertBlock(block2, userID))
+		require.NoError(t, store.InsertBlock(context.Background(), block2, userID))
 
 		newBoard2 := &model.Board{
 			ID:     utils.NewID(utils.IDTypeBoard),
 			TeamID: teamID,
 			Type:   model.BoardTypeOpen,
 		}
-		board2, err := store.InsertBoard(newBoard2, userID)
+		board2, err := store.InsertBoard(context.Background(), newBoard2, userID)
 		require.NoError(t, err)
 
 		block3 := &model.Block{
 			ID:      utils.NewID(utils.IDTypeBlock),
 			BoardID: board2.ID,
 		}
-		require.NoError(t, store.InsertBlock(block3, userID))
+		require.NoError(t, store.InsertBlock(context.Background(), block3, userID))
 
 		block4 := &model.Block{
 			ID:      utils.NewID(utils.IDTypeBlock),
 			BoardID: board2.ID,
 		}
-		require.NoError(t, store.InsertBlock(block4, userID))
+		require.NoError(t, store.InsertBlock(context.Background(), block4, userID))
 
 		dbab := &model.DeleteBoardsAndBlocks{
 			Boards: []string{board1.ID, board2.ID, "a nonexistent board ID"},
@@ -470,26 +471,26 @@ func testDeleteBoardsAndBlocks(t *testing.T, store store.Store) {
 
 		time.Sleep(10 * time.Millisecond)
 
-		require.True(t, model.IsErrNotFound(store.DeleteBoardsAndBlocks(dbab, userID)))
+		require.True(t, model.IsErrNotFound(store.DeleteBoardsAndBlocks(context.Background(), dbab, userID)))
 
 		// all the entities should still exist
-		rBoard1, err := store.GetBoard(board1.ID)
+		rBoard1, err := store.GetBoard(context.Background(), board1.ID)
 		require.NoError(t, err)
 		require.NotNil(t, rBoard1)
-		rBlock1, err := store.GetBlock(block1.ID)
+		rBlock1, err := store.GetBlock(context.Background(), block1.ID)
 		require.NoError(t, err)
 		require.NotNil(t, rBlock1)
-		rBlock2, err := store.GetBlock(block2.ID)
+		rBlock2, err := store.GetBlock(context.Background(), block2.ID)
 		require.NoError(t, err)
 		require.NotNil(t, rBlock2)
 
-		rBoard2, err := store.GetBoard(board2.ID)
+		rBoard2, err := store.GetBoard(context.Background(), board2.ID)
 		require.NoError(t, err)
 		require.NotNil(t, rBoard2)
-		rBlock3, err := store.GetBlock(block3.ID)
+		rBlock3, err := store.GetBlock(context.Background(), block3.ID)
 		require.NoError(t, err)
 		require.NotNil(t, rBlock3)
-		rBlock4, err := store.GetBlock(block4.ID)
+		rBlock4, err := store.GetBlock(context.Background(), block4.ID)
 		require.NoError(t, err)
 		require.NotNil(t, rBlock4)
 	})
@@ -504,40 +505,40 @@ func testDeleteBoardsAndBlocks(t *testing.T, store store.Store) {
 			TeamID: teamID,
 			Type:   model.BoardTypeOpen,
 		}
-		board1, err := store.InsertBoard(newBoard1, userID)
+		board1, err := store.InsertBoard(context.Background(), newBoard1, userID)
 		require.NoError(t, err)
 
 		block1 := &model.Block{
 			ID:      utils.NewID(utils.IDTypeBlock),
 			BoardID: board1.ID,
 		}
-		require.NoError(t, store.InsertBlock(block1, userID))
+		require.NoError(t, store.InsertBlock(context.Background(), block1, userID))
 
 		block2 := &model.Block{
 			ID:      utils.NewID(utils.IDTypeBlock),
 			BoardID: board1.ID,
 		}
-		require.NoError(t, store.InsertBlock(block2, userID))
+		require.NoError(t, store.InsertBlock(context.Background(), block2, userID))
 
 		newBoard2 := &model.Board{
 			ID:     utils.NewID(utils.IDTypeBoard),
 			TeamID: teamID,
 			Type:   model.BoardTypeOpen,
 		}
-		board2, err := store.InsertBoard(newBoard2, userID)
+		board2, err := store.InsertBoard(context.Background(), newBoard2, userID)
 		require.NoError(t, err)
 
 		block3 := &model.Block{
 			ID:      utils.NewID(utils.IDTypeBlock),
 			BoardID: board2.ID,
 		}
-		require.NoError(t, store.InsertBlock(block3, userID))
+		require.NoError(t, store.InsertBlock(context.Background(), block3, userID))
 
 		block4 := &model.Block{
 			ID:      utils.NewID(utils.IDTypeBlock),
 			BoardID: board2.ID,
 		}
-		require.NoError(t, store.InsertBlock(block4, userID))
+		require.NoError(t, store.InsertBlock(context.Background(), block4, userID))
 
 		dbab := &model.DeleteBoardsAndBlocks{
 			Boards: []string{board1.ID, board2.ID},
@@ -546,26 +547,26 @@ func testDeleteBoardsAndBlocks(t *testing.T, store store.Store) {
 
 		time.Sleep(10 * time.Millisecond)
 
-		require.True(t, model.IsErrNotFound(store.DeleteBoardsAndBlocks(dbab, userID)))
+		require.True(t, model.IsErrNotFound(store.DeleteBoardsAndBlocks(context.Background(), dbab, userID)))
 
 		// all the entities should still exist
-		rBoard1, err := store.GetBoard(board1.ID)
+		rBoard1, err := store.GetBoard(context.Background(), board1.ID)
 		require.NoError(t, err)
 		require.NotNil(t, rBoard1)
-		rBlock1, err := store.GetBlock(block1.ID)
+		rBlock1, err := store.GetBlock(context.Background(), block1.ID)
 		require.NoError(t, err)
 		require.NotNil(t, rBlock1)
-		rBlock2, err := store.GetBlock(block2.ID)
+		rBlock2, err := store.GetBlock(context.Background(), block2.ID)
 		require.NoError(t, err)
 		require.NotNil(t, rBlock2)
 
-		rBoard2, err := store.GetBoard(board2.ID)
+		rBoard2, err := store.GetBoard(context.Background(), board2.ID)
 		require.NoError(t, err)
 		require.NotNil(t, rBoard2)
-		rBlock3, err := store.GetBlock(block3.ID)
+		rBlock3, err := store.GetBlock(context.Background(), block3.ID)
 		require.NoError(t, err)
 		require.NotNil(t, rBlock3)
-		rBlock4, err := store.GetBlock(block4.ID)
+		rBlock4, err := store.GetBlock(context.Background(), block4.ID)
 		require.NoError(t, err)
 		require.NotNil(t, rBlock4)
 	})
@@ -576,40 +577,40 @@ func testDeleteBoardsAndBlocks(t *testing.T, store store.Store) {
 			TeamID: teamID,
 			Type:   model.BoardTypeOpen,
 		}
-		board1, err := store.InsertBoard(newBoard1, userID)
+		board1, err := store.InsertBoard(context.Background(), newBoard1, userID)
 		require.NoError(t, err)
 
 		block1 := &model.Block{
 			ID:      utils.NewID(utils.IDTypeBlock),
 			BoardID: board1.ID,
 		}
-		require.NoError(t, store.InsertBlock(block1, userID))
+		require.NoError(t, store.InsertBlock(context.Background(), block1, userID))
 
 		block2 := &model.Block{
 			ID:      utils.NewID(utils.IDTypeBlock),
 			BoardID: board1.ID,
 		}
-		require.NoError(t, store.InsertBlock(block2, userID))
+		require.NoError(t, store.InsertBlock(context.Background(), block2, userID))
 
 		newBoard2 := &model.Board{
 			ID:     utils.NewID(utils.IDTypeBoard),
 			TeamID: teamID,
 			Type:   model.BoardTypeOpen,
 		}
-		board2, err := store.InsertBoard(newBoard2, userID)
+		board2, err := store.InsertBoard(context.Background(), newBoard2, userID)
 		require.NoError(t, err)
 
 		block3 := &model.Block{
 			ID:      utils.NewID(utils.IDTypeBlock),
 			BoardID: board2.ID,
 		}
-		require.NoError(t, store.InsertBlock(block3, userID))
+		require.NoError(t, store.InsertBlock(context.Background(), block3, userID))
 
 		block4 := &model.Block{
 			ID:      utils.NewID(utils.IDTypeBlock),
 			BoardID: board2.ID,
 		}
-		require.NoError(t, store.InsertBlock(block4, userID))
+		require.NoError(t, store.InsertBlock(context.Background(), block4, userID))
 
 		dbab := &model.DeleteBoardsAndBlocks{
 			Boards: []string{board1.ID, board2.ID},
@@ -618,30 +619,30 @@ func testDeleteBoardsAndBlocks(t *testing.T, store store.Store) {
 
 		time.Sleep(10 * time.Millisecond)
 
-		require.NoError(t, store.DeleteBoardsAndBlocks(dbab, userID))
+		require.NoError(t, store.DeleteBoardsAndBlocks(context.Background(), dbab, userID))
 
-		rBoard1, err := store.GetBoard(board1.ID)
+		rBoard1, err := store.GetBoard(context.Background(), board1.ID)
 		require.Error(t, err)
 		require.True(t, model.IsErrNotFound(err))
 		require.Nil(t, rBoard1)
-		rBlock1, err := store.GetBlock(block1.ID)
+		rBlock1, err := store.GetBlock(context.Background(), block1.ID)
 		require.Error(t, err)
 		require.True(t, model.IsErrNotFound(err))
 		require.Nil(t, rBlock1)
-		rBlock2, err := store.GetBlock(block2.ID)
+		rBlock2, err := store.GetBlock(context.Background(), block2.ID)
 		require.Error(t, err)
 		require.True(t, model.IsErrNotFound(err))
 		require.Nil(t, rBlock2)
 
-		rBoard2, err := store.GetBoard(board2.ID)
+		rBoard2, err := store.GetBoard(context.Background(), board2.ID)
 		require.Error(t, err)
 		require.True(t, model.IsErrNotFound(err))
 		require.Nil(t, rBoard2)
-		rBlock3, err := store.GetBlock(block3.ID)
+		rBlock3, err := store.GetBlock(context.Background(), block3.ID)
 		require.Error(t, err)
 		require.True(t, model.IsErrNotFound(err))
 		require.Nil(t, rBlock3)
-		rBlock4, err := store.GetBlock(block4.ID)
+		rBlock4, err := store.GetBlock(context.Background(), block4.ID)
 		require.Error(t, err)
 		require.True(t, model.IsErrNotFound(err))
 		require.Nil(t, rBlock4)
@@ -665,14 +666,14 @@ func testDuplicateBoard(t *testing.T, store store.Store) {
 		},
 	}
 
-	bab, err := store.CreateBoardsAndBlocks(newBab, userID)
+	bab, err := store.CreateBoardsAndBlocks(context.Background(), newBab, userID)
 	require.Nil(t, err)
 	require.NotNil(t, bab)
 	require.Len(t, bab.Boards, 3)
 	require.Len(t, bab.Blocks, 3)
 
 	t.Run("duplicate existing board as no template", func(t *testing.T) {
-		bab, members, err := store.DuplicateBoard("board-id-1", userID, teamID, false)
+		bab, members, err := store.DuplicateBoard(context.Background(), "board-id-1", userID, teamID, false)
 		require.NoError(t, err)
 		require.Len(t, members, 1)
 		require.Len(t, bab.Boards, 1)
@@ -682,7 +683,7 @@ func testDuplicateBoard(t *testing.T, store store.Store) {
 	})
 
 	t.Run("duplicate existing board as template", func(t *testing.T) {
-		bab, members, err := store.DuplicateBoard("board-id-1", userID, teamID, true)
+		bab, members, err := store.DuplicateBoard(context.Background(), "board-id-1", userID, teamID, true)
 		require.NoError(t, err)
 		require.Len(t, members, 1)
 		require.Len(t, bab.Boards, 1)
@@ -692,7 +693,7 @@ func testDuplicateBoard(t *testing.T, store store.Store) {
 	})
 
 	t.Run("duplicate not existing board", func(t *testing.T) {
-		bab, members, err := store.DuplicateBoard("not-existing-id", userID, teamID, false)
+		bab, members, err := store.DuplicateBoard(context.Background(), "not-existing-id", userID, teamID, false)
 		require.Error(t, err)
 		require.Nil(t, members)
 		require.Nil(t, bab)
@@ -4,6 +4,7 @@
 package storetests
 
 import (
+	"context"
 	"testing"
 
 	"github.com/mattermost/mattermost-plugin-boards/server/model"
@@ -47,10 +48,10 @@ func testGetCreateCategory(t *testing.T, store store.Store) {
 			Collapsed: false,
 		}
 
-		err := store.CreateCategory(category)
+		err := store.CreateCategory(context.Background(), category)
 		assert.NoError(t, err)
 
-		createdCategory, err := store.GetCategory("category_id_1")
+		createdCategory, err := store.GetCategory(context.Background(), "category_id_1")
 		assert.NoError(t, err)
 		assert.Equal(t, "Category", createdCategory.Name)
 		assert.Equal(t, "user_id_1", createdCategory.UserID)
@@ -71,10 +72,10 @@ func testGetCreateCategory(t *testing.T, store store.Store) {
 			Collapsed: true,
 		}
 
-		err := store.CreateCategory(category)
+		err := store.CreateCategory(context.Background(), category)
 		assert.NoError(t, err)
 
-		createdCategory, err := store.GetCategory("category_id_2")
+		createdCategory, err := store.GetCategory(context.Background(), "category_id_2")
 		assert.NoError(t, err)
 		assert.Equal(t, "Category", createdCategory.Name)
 		assert.Equal(t, "user_id_1", createdCategory.UserID)
@@ -83,7 +84,7 @@ func testGetCreateCategory(t *testing.T, store store.Store) {
 	})
 
 	t.Run("get nonexistent category", func(t *testing.T) {
-		category, err := store.GetCategory("nonexistent")
+		category, err := store.GetCategory(context.Background(), "nonexistent")
 		assert.Error(t, err)
 		var nf *model.ErrNotFound
 		assert.ErrorAs(t, err, &nf)
@@ -104,7 +105,7 @@ func testUpdateCategory(t *testing.T, store store.Store) {
 		Collapsed: false,
 	}
 
-	err := store.CreateCategory(category)
+	err := store.CreateCategory(context.Background(), category)
 	assert.NoError(t, err)
 
 	updateNow := utils.GetMillis()
@@ -119,10 +120,10 @@ func testUpdateCategory(t *testing.T, store store.Store) {
 		Collapsed: true,
 	}
 
-	err = store.UpdateCategory(updatedCategory)
+	err = store.UpdateCategory(context.Background(), updatedCategory)
 	assert.NoError(t, err)
 
-	fetchedCategory, err := store.GetCategory("category_id_1")
+	fetchedCategory, err := store.GetCategory(context.Background(), "category_id_1")
 	assert.NoError(t, err)
 	assert.Equal(t, "category_id_1", fetchedCategory.ID)
 	assert.Equal(t, "Category 1 New", fetchedCategory.Name)
@@ -130,10 +131,10 @@ func testUpdateCategory(t *testing.T, store store.Store) {
 
 	// now lets try to un-collapse the same category
 	updatedCategory.Collapsed = false
-	err = store.UpdateCategory(updatedCategory)
+	err = store.UpdateCategory(context.Background(), updatedCategory)
 	assert.NoError(t, err)
 
-	fetchedCategory, err = store.GetCategory("category_id_1")
+	fetchedCategory, err = store.GetCategory(context.Background(), "category_id_1")
 	assert.NoError(t, err)
 	assert.Equal(t, "category_id_1", fetchedCategory.ID)
 	assert.Equal(t, "Category 1 New", fetchedCategory.Name)
@@ -153,13 +154,13 @@ func testDeleteCategory(t *testing.T, store store.Store) {
 		Collapsed: false,
 	}
 
-	err := store.CreateCategory(category)
+	err := store.CreateCategory(context.Background(), category)
 	assert.NoError(t, err)
 
-	err = store.DeleteCategory("category_id_1", "user_id_1", "team_id_1")
+	err = store.DeleteCategory(context.Background(), "category_id_1", "user_id_1", "team_id_1")
 	assert.NoError(t, err)
 
-	deletedCategory, err := store.GetCategory("category_id_1")
+	deletedCategory, err := store.GetCategory(context.Background(), "category_id_1")
 	assert.NoError(t, err)
 	assert.Equal(t, "category_id_1", deletedCategory.ID)
 	assert.Equal(t, "Category 1", deletedCategory.Name)
@@ -179,7 +180,7 @@ func testGetUserCategories(t *testing.T, store store.Store) {
 		DeleteAt:  0,
 		Collapsed: false,
 	}
-	err := store.CreateCategory(category1)
+	err := store.CreateCategory(context.Background(), category1)
 	assert.NoError(t, err)
 
 	category2 := model.Category{
@@ -192,7 +193,7 @@ func testGetUserCategories(t *testing.T, store store.Store) {
 		DeleteAt:  0,
 		Collapsed: false,
 	}
-	err = store.CreateCategory(category2)
+	err = store.CreateCategory(context.Background(), category2)
 	assert.NoError(t, err)
 
 	category3 := model.Category{
@@ -205,17 +206,17 @@ func testGetUserCategories(t *testing.T, store store.Store) {
 		DeleteAt:  0,
 		Collapsed: false,
 	}
-	err = store.CreateCategory(category3)
+	err = store.CreateCategory(context.Background(), category3)
 	assert.NoError(t, err)
 
-	userCategories, err := store.GetUserCategoryBoards("user_id_1", "team_id_1")
+	userCategories, err := store.GetUserCategoryBoards(context.Background(), "user_id_1", "team_id_1")
 	assert.NoError(t, err)
 	assert.Equal(t, 3, len(userCategories))
 }
 
 func testReorderCategories(t *testing.T, store store.Store) {
 	// setup
-	err := store.CreateCategory(model.Category{
+	err := store.CreateCategory(context.Background(), model.Category{
 		ID:     "category_id_1",
 		Name:   "Category 1",
 		Type:   "custom",
@@ -224,7 +225,7 @@ func testReorderCategories(t *testing.T, store store.Store) {
 	})
 	assert.NoError(t, err)
 
-	err = store.CreateCategory(model.Category{
+	err = store.CreateCategory(context.Background(), model.Category{
 		ID:     "category_id_2",
 		Name:   "Category 2",
 		Type:   "custom",
@@ -233,7 +234,7 @@ func testReorderCategories(t *testing.T, store store.Store) {
 	})
 	assert.NoError(t, err)
 
-	err = store.CreateCategory(model.Category{
+	err = store.CreateCategory(context.Background(), model.Category{
 		ID:     "category_id_3",
 		Name:   "Category 3",
 		Type:   "custom",
@@ -243,7 +244,7 @@ func testReorderCategories(t *testing.T, store store.Store) {
 	assert.NoError(t, err)
 
 	// verify the current order
-	categories, err := store.GetUserCategories("user_id", "team_id")
+	categories, err := store.GetUserCategories(context.Background(), "user_id", "team_id")
 	assert.NoError(t, err)
 	assert.Equal(t, 3, len(categories))
 
@@ -253,7 +254,7 @@ func testReorderCategories(t *testing.T, store store.Store) {
 	assert.Equal(t, "category_id_1", categories[2].ID)
 
 	// re-ordering categories normally
-	_, err = store.ReorderCategories("user_id", "team_id", []string{
+	_, err = store.ReorderCategories(context.Background(), "user_id", "team_id", []string{
 		"category_id_2",
 		"category_id_3",
 		"category_id_1",
@@ -261,7 +262,7 @@ func testReorderCategories(t *testing.T, store store.Store) {
 	assert.NoError(t, err)
 
 	// verify the board order
-	categories, err = store.GetUserCategories("user_id", "team_id")
+	categories, err = store.GetUserCategories(context.Background(), "user_id", "team_id")
 	assert.NoError(t, err)
 	assert.Equal(t, 3, len(categories))
 	assert.Equal(t, "category_id_2", categories[0].ID)
@@ -270,7 +271,7 @@ func testReorderCategories(t *testing.T, store store.Store) {
 
 	// lets try specifying a non existing category ID.
 	// It shouldn't cause any problem
-	_, err = store.ReorderCategories("user_id", "team_id", []string{
+	_, err = store.ReorderCategories(context.Background(), "user_id", "team_id", []string{
 		"category_id_1",
 		"category_id_2",
 		"category_id_3",
@@ -278,7 +279,7 @@ func testReorderCategories(t *testing.T, store store.Store) {
 	})
 	assert.NoError(t, err)
 
-	categories, err = store.GetUserCategories("user_id", "team_id")
+	categories, err = store.GetUserCategories(context.Background(), "user_id", "team_id")
 	assert.NoError(t, err)
 	assert.Equal(t, 3, len(categories))
 	assert.Equal(t, "category_id_1", categories[0].ID)
@@ -288,7 +289,7 @@ func testReorderCategories(t *testing.T, store store.Store) {
 
 func testReorderCategoryBoards(t *testing.T, store store.Store) {
 	// setup
-	err := store.CreateCategory(model.Category{
+	err := store.CreateCategory(context.Background(), model.Category{
 		ID:     "category_id_1",
 		Name:   "Category 1",
 		Type:   "custom",
@@ -297,7 +298,7 @@ func testReorderCategoryBoards(t *testing.T, store store.Store) {
 	})
 	assert.NoError(t, err)
 
-	err = store.AddUpdateCategoryBoard("user_id", "category_id_1", []string{
+	err = store.AddUpdateCategoryBoard(context.Background(), "user_id", "category_id_1", []string{
 		"board_id_1",
 		"board_id_2",
 		"board_id_3",
@@ -306,7 +307,7 @@ func testReorderCategoryBoards(t *testing.T, store store.Store) {
 	assert.NoError(t, err)
 
 	// verify current order
-	categoryBoards, err := store.GetUserCategoryBoards("user_id", "team_id")
+	categoryBoards, err := store.GetUserCategoryBoards(context.Background(), "user_id", "team_id")
 	assert.NoError(t, err)
 	assert.Equal(t, 1, len(categoryBoards))
 	assert.Equal(t, 4, len(categoryBoards[0].BoardMetadata))
@@ -316,7 +317,7 @@ func testReorderCategoryBoards(t *testing.T, store store.Store) {
 	assert.Contains(t, categoryBoards[0].BoardMetadata, model.CategoryBoardMetadata{BoardID: "board_id_4", Hidden: false})
 
 	// reordering
-	newOrder, err := store.ReorderCategoryBoards("category_id_1", []string{
+	newOrder, err := store.ReorderCategoryBoards(context.Background(), "category_id_1", []string{
 		"board_id_3",
 		"board_id_1",
 		"board_id_2",
@@ -329,7 +330,7 @@ func testReorderCategoryBoards(t *testing.T, store store.Store) {
 	assert.Equal(t, "board_id_4", newOrder[3])
 
 	// verify new order
-	categoryBoards, err = store.GetUserCategoryBoards("user_id", "team_id")
+	categoryBoards, err = store.GetUserCategoryBoards(context.Background(), "user_id", "team_id")
 	assert.NoError(t, err)
 	assert.Equal(t, 1, len(categoryBoards))
 	assert.Equal(t, 4, len(categoryBoards[0].BoardMetadata))
@@ -4,6 +4,7 @@
 package storetests
 
 import (
+	"context"
 	"testing"
 
 	"github.com/mattermost/mattermost-plugin-boards/server/model"
@@ -44,7 +45,7 @@ func testGetUserCategoryBoards(t *testing.T, store store.Store) {
 		DeleteAt:  0,
 		Collapsed: false,
 	}
-	err := store.CreateCategory(category1)
+	err := store.CreateCategory(context.Background(), category1)
 	assert.NoError(t, err)
 
 	category2 := model.Category{
@@ -57,7 +58,7 @@ func testGetUserCategoryBoards(t *testing.T, store store.Store) {
 		DeleteAt:  0,
 		Collapsed: false,
 	}
-	err = store.CreateCategory(category2)
+	err = store.CreateCategory(context.Background(), category2)
 	assert.NoError(t, err)
 
 	category3 := model.Category{
@@ -70,24 +71,24 @@ func testGetUserCategoryBoards(t *testing.T, store store.Store) {
 		DeleteAt:  0,
 		Collapsed: false,
 	}
-	err = store.CreateCategory(category3)
+	err = store.CreateCategory(context.Background(), category3)
 	assert.NoError(t, err)
 
 	// Adding Board 1 and Board 2 to Category 1
 	// The boards don't need to exists in DB for this test
-	err = store.AddUpdateCategoryBoard("user_id_1", "category_id_1", []string{"board_1"})
+	err = store.AddUpdateCategoryBoard(context.Background(), "user_id_1", "category_id_1", []string{"board_1"})
 	assert.NoError(t, err)
 
-	err = store.AddUpdateCategoryBoard("user_id_1", "category_id_1", []string{"board_2"})
+	err = store.AddUpdateCategoryBoard(context.Background(), "user_id_1", "category_id_1", []string{"board_2"})
 	assert.NoError(t, err)
 
 	// Adding Board 3 to Category 2
-	err = store.AddUpdateCategoryBoard("user_id_1", "category_id_2", []string{"board_3"})
+	err = store.AddUpdateCategoryBoard(context.Background(), "user_id_1", "category_id_2", []string{"board_3"})
 	assert.NoError(t, err)
 
 	// we'll leave category 3 empty
 
-	userCategoryBoards, err := store.GetUserCategoryBoards("user_id_1", "team_id_1")
+	userCategoryBoards, err := store.GetUserCategoryBoards(context.Background(), "user_id_1", "team_id_1")
 	assert.NoError(t, err)
 
 	// we created 3 categories for the user
@@ -118,7 +119,7 @@ func testGetUserCategoryBoards(t *testing.T, store store.Store) {
 	assert.Equal(t, 0, len(category3BoardCategory.BoardMetadata))
 
 	t.Run("get empty category boards", func(t *testing.T) {
-		userCategoryBoards, err := store.GetUserCategoryBoards("nonexistent-user-id", "nonexistent-team-id")
+		userCategoryBoards, err := store.GetUserCategoryBoards(context.Background(), "nonexistent-user-id", "nonexistent-team-id")
 		assert.NoError(t, err)
 		assert.Empty(t, userCategoryBoards)
 	})
@@ -126,7 +127,7 @@ func testGetUserCategoryBoards(t *testing.T, store store.Store) {
 
 func testAddUpdateCategoryBoard(t *testing.T, store store.Store) {
 	// creating few boards and categories to later associoate with the category
-	_, _, err := store.CreateBoardsAndBlocksWithAdmin(&model.BoardsAndBlocks{
+	_, _, err := store.CreateBoardsAndBlocksWithAdmin(context.Background(), &model.BoardsAndBlocks{
 		Boards: []*model.Board{
 			{
 				ID:     "board_id_1",
@@ -140,7 +141,7 @@ func testAddUpdateCategoryBoard(t *testing.T, store store.Store) {
 	}, "user_id")
 	assert.NoError(t, err)
 
-	err = store.CreateCategory(model.Category{
+	err = store.CreateCategory(context.Background(), model.Category{
 		ID:     "category_id",
 		Name:   "Category",
 		UserID: "user_id",
@@ -149,11 +150,11 @@ func testAddUpdateCategoryBoard(t *testing.T, store store.Store) {
 	assert.NoError(t, err)
 
 	// adding a few boards to the category
-	err = store.AddUpdateCategoryBoard("user_id", "category_id", []string{"board_id_1", "board_id_2"})
+	err = store.AddUpdateCategoryBoard(context.Background(), "user_id", "category_id", []string{"board_id_1", "board_id_2"})
 	assert.NoError(t, err)
 
 	// verify inserted data
-	categoryBoards, err := store.GetUserCategoryBoards("user_id", "team_id")
+	categoryBoards, err := store.GetUserCategoryBoards(context.Background(), "user_id", "team_id")
 	assert.NoError(t, err)
 	assert.Equal(t, 1, len(categoryBoards))
 	assert.Equal(t, "category_id", categoryBoards[0].ID)
@@ -162,11 +163,11 @@ func testAddUpdateCategoryBoard(t *testing.T, store store.Store) {
 	assert.Contains(t, categoryBoards[0].BoardMetadata, model.CategoryBoardMetadata{BoardID: "board_id_2", Hidden: false})
 
 	// adding new boards to the same category
-	err = store.AddUpdateCategoryBoard("user_id", "category_id", []string{"board_id_3"})
+	err = store.AddUpdateCategoryBoard(context.Background(), "user_id", "category_id", []string{"board_id_3"})
 	assert.NoError(t, err)
 
 	// verify inserted data
-	categoryBoards, err = store.GetUserCategoryBoards("user_id", "team_id")
+	categoryBoards, err = store.GetUserCategoryBoards(context.Background(), "user_id", "team_id")
 	assert.NoError(t, err)
 	assert.Equal(t, 1, len(categoryBoards))
 	assert.Equal(t, "category_id", categoryBoards[0].ID)
@@ -174,22 +175,22 @@ func testAddUpdateCategoryBoard(t *testing.T, store store.Store) {
 	assert.Contains(t, categoryBoards[0].BoardMetadata, model.CategoryBoardMetadata{BoardID: "board_id_3", Hidden: false})
 
 	// passing empty array
-	err = store.AddUpdateCategoryBoard("user_id", "category_id", []string{})
+	err = store.AddUpdateCategoryBoard(context.Background(), "user_id", "category_id", []string{})
 	assert.NoError(t, err)
 
 	// verify inserted data
-	categoryBoards, err = store.GetUserCategoryBoards("user_id", "team_id")
+	categoryBoards, err = store.GetUserCategoryBoards(context.Background(), "user_id", "team_id")
 	assert.NoError(t, err)
 	assert.Equal(t, 1, len(categoryBoards))
 	assert.Equal(t, "category_id", categoryBoards[0].ID)
 	assert.Equal(t, 3, len(categoryBoards[0].BoardMetadata))
 
 	// passing duplicate data in input
-	err = store.AddUpdateCategoryBoard("user_id", "category_id", []string{"board_id_4", "board_id_4"})
+	err = store.AddUpdateCategoryBoard(context.Background(), "user_id", "category_id", []string{"board_id_4", "board_id_4"})
 	assert.NoError(t, err)
 
 	// verify inserted data
-	categoryBoards, err = store.GetUserCategoryBoards("user_id", "team_id")
+	categoryBoards, err = store.GetUserCategoryBoards(context.Background(), "user_id", "team_id")
 	assert.NoError(t, err)
 	assert.Equal(t, 1, len(categoryBoards))
 	assert.Equal(t, "category_id", categoryBoards[0].ID)
@@ -197,22 +198,22 @@ func testAddUpdateCategoryBoard(t *testing.T, store store.Store) {
 	assert.Contains(t, categoryBoards[0].BoardMetadata, model.CategoryBoardMetadata{BoardID: "board_id_4", Hidden: false})
 
 	// adding already added board
-	err = store.AddUpdateCategoryBoard("user_id", "category_id", []string{"board_id_1", "board_id_2"})
+	err = store.AddUpdateCategoryBoard(context.Background(), "user_id", "category_id", []string{"board_id_1", "board_id_2"})
 	assert.NoError(t, err)
 
 	// verify inserted data
-	categoryBoards, err = store.GetUserCategoryBoards("user_id", "team_id")
+	categoryBoards, err = store.GetUserCategoryBoards(context.Background(), "user_id", "team_id")
 	assert.NoError(t, err)
 	assert.Equal(t, 1, len(categoryBoards))
 	assert.Equal(t, "category_id", categoryBoards[0].ID)
 	assert.Equal(t, 4, len(categoryBoards[0].BoardMetadata))
 
 	// passing already added board along with a new board
-	err = store.AddUpdateCategoryBoard("user_id", "category_id", []string{"board_id_1", "board_id_5"})
+	err = store.AddUpdateCategoryBoard(context.Background(), "user_id", "category_id", []string{"board_id_1", "board_id_5"})
 	assert.NoError(t, err)
 
 	// verify inserted data
-	categoryBoards, err = store.GetUserCategoryBoards("user_id", "team_id")
+	categoryBoards, err = store.GetUserCategoryBoards(context.Background(), "user_id", "team_id")
 	assert.NoError(t, err)
 	assert.Equal(t, 1, len(categoryBoards))
 	assert.Equal(t, "category_id", categoryBoards[0].ID)
@@ -221,7 +222,7 @@ func testAddUpdateCategoryBoard(t *testing.T, store store.Store) {
 }
 
 func testSetBoardVisibility(t *testing.T, store store.Store) {
-	_, _, err := store.CreateBoardsAndBlocksWithAdmin(&model.BoardsAndBlocks{
+	_, _, err := store.CreateBoardsAndBlocksWithAdmin(context.Background(), &model.BoardsAndBlocks{
 		Boards: []*model.Board{
 			{
 				ID:     "board_id_1",
@@ -231,7 +232,7 @@ func testSetBoardVisibility(t *testing.T, store store.Store) {
 	}, "user_id")
 	assert.NoError(t, err)
 
-	err = store.CreateCategory(model.Category{
+	err = store.CreateCategory(context.Background(), model.Category{
 		ID:     "category_id",
 		Name:   "Category",
 		UserID: "user_id",
@@ -240,25 +241,25 @@ func testSetBoardVisibility(t *testing.T, store store.Store) {
 	assert.NoError(t, err)
 
 	// adding a few boards to the category
-	err = store.AddUpdateCategoryBoard("user_id", "category_id", []string{"board_id_1"})
+	err = store.AddUpdateCategoryBoard(context.Background(), "user_id", "category_id", []string{"board_id_1"})
 	assert.NoError(t, err)
 
-	err = store.SetBoardVisibility("user_id", "category_id", "board_id_1", true)
+	err = store.SetBoardVisibility(context.Background(), "user_id", "category_id", "board_id_1", true)
 	assert.NoError(t, err)
 
 	// verify set visibility
-	categoryBoards, err := store.GetUserCategoryBoards("user_id", "team_id")
+	categoryBoards, err := store.GetUserCategoryBoards(context.Background(), "user_id", "team_id")
 	assert.NoError(t, err)
 	assert.Equal(t, 1, len(categoryBoards))
 	assert.Equal(t, "category_id", categoryBoards[0].ID)
 	assert.Equal(t, 1, len(categoryBoards[0].BoardMetadata))
 	assert.False(t, categoryBoards[0].BoardMetadata[0].Hidden)
 
-	err = store.SetBoardVisibility("user_id", "category_id", "board_id_1", false)
+	err = store.SetBoardVisibility(context.Background(), "user_id", "category_id", "board_id_1", false)
 	assert.NoError(t, err)
 
 	// verify set visibility
-	categoryBoards, err = store.GetUserCategoryBoards("user_id", "team_id")
+	categoryBoards, err = store.GetUserCategoryBoards(context.Background(), "user_id", "team_id")
 	assert.NoError(t, err)
 	assert.True(t, categoryBoards[0].BoardMetadata[0].Hidden)
 }
@@ -0,0 +1,110 @@
+// Copyright (c) 2020-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package storetests
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mattermost/mattermost-plugin-boards/server/model"
+	"github.com/mattermost/mattermost-plugin-boards/server/services/store"
+	"github.com/mattermost/mattermost-plugin-boards/server/utils"
+)
+
+func StoreTestCommentReactionsStore(t *testing.T, setup func(t *testing.T) (store.Store, func())) {
+	t.Run("AddCommentReaction", func(t *testing.T) {
+		store, tearDown := setup(t)
+		defer tearDown()
+		testAddCommentReaction(t, store)
+	})
+
+	t.Run("DeleteCommentReaction", func(t *testing.T) {
+		store, tearDown := setup(t)
+		defer tearDown()
+		testDeleteCommentReaction(t, store)
+	})
+}
+
+func testAddCommentReaction(t *testing.T, store store.Store) {
+	boardID := utils.NewID(utils.IDTypeBoard)
+	blockID := utils.NewID(utils.IDTypeBlock)
+	userID := utils.NewID(utils.IDTypeUser)
+
+	t.Run("invalid reaction", func(t *testing.T) {
+		reaction, err := store.AddCommentReaction(context.Background(), &model.CommentReaction{BlockID: blockID, BoardID: boardID, UserID: userID})
+		require.Error(t, err)
+		assert.Nil(t, reaction)
+	})
+
+	t.Run("add reactions", func(t *testing.T) {
+		for _, emoji := range []string{"smile", "tada"} {
+			reaction, err := store.AddCommentReaction(context.Background(), &model.CommentReaction{
+				BlockID:   blockID,
+				BoardID:   boardID,
+				UserID:    userID,
+				EmojiName: emoji,
+			})
+			require.NoError(t, err)
+			assert.NotZero(t, reaction.CreateAt)
+		}
+
+		// adding the same reaction twice is not an error.
+		_, err := store.AddCommentReaction(context.Background(), &model.CommentReaction{
+			BlockID:   blockID,
+			BoardID:   boardID,
+			UserID:    userID,
+			EmojiName: "smile",
+		})
+		require.NoError(t, err)
+
+		reactions, err := store.GetCommentReactions(context.Background(), blockID)
+		require.NoError(t, err)
+		assert.Len(t, reactions, 2)
+
+		reactions, err = store.GetCommentReactionsForBoard(context.Background(), boardID)
+		require.NoError(t, err)
+		assert.Len(t, reactions, 2)
+	})
+}
+
+func testDeleteCommentReaction(t *testing.T, store store.Store) {
+	boardID := utils.NewID(utils.IDTypeBoard)
+	blockID := utils.NewID(utils.IDTypeBlock)
+	userID := utils.NewID(utils.IDTypeUser)
+
+	reaction := &model.CommentReaction{
+		BlockID:   blockID,
+		BoardID:   boardID,
+		UserID:    userID,
+		EmojiName: "smile",
+	}
+	_, err := store.AddCommentReaction(context.Background(), reaction)
+	require.NoError(t, err)
+
+	t.Run("delete reaction", func(t *testing.T) {
+		require.NoError(t, store.DeleteCommentReaction(context.Background(), blockID, userID, "smile"))
+
+		reactions, err := store.GetCommentReactions(context.Background(), blockID)
+		require.NoError(t, err)
+		assert.Empty(t, reactions)
+	})
+
+	t.Run("delete missing reaction", func(t *testing.T) {
+		err := store.DeleteCommentReaction(context.Background(), blockID, userID, "smile")
+		require.True(t, model.IsErrNotFound(err))
+	})
+
+	t.Run("re-add deleted reaction", func(t *testing.T) {
+		_, err := store.AddCommentReaction(context.Background(), reaction)
+		require.NoError(t, err)
+
+		reactions, err := store.GetCommentReactions(context.Background(), blockID)
+		require.NoError(t, err)
+		require.Len(t, reactions, 1)
+		assert.Zero(t, reactions[0].DeleteAt)
+	})
+}
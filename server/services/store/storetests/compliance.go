@@ -4,6 +4,7 @@
 package storetests
 
 import (
+	"context"
 	"math"
 	"testing"
 
@@ -48,7 +49,7 @@ func testGetBoardsForCompliance(t *testing.T, store store.Store) {
 			TeamID: utils.NewID(utils.IDTypeTeam),
 		}
 
-		boards, hasMore, err := store.GetBoardsForCompliance(opts)
+		boards, hasMore, err := store.GetBoardsForCompliance(context.Background(), opts)
 
 		assert.Empty(t, boards)
 		assert.False(t, hasMore)
@@ -58,7 +59,7 @@ func testGetBoardsForCompliance(t *testing.T, store store.Store) {
 	t.Run("All teams", func(t *testing.T) {
 		opts := model.QueryBoardsForComplianceOptions{}
 
-		boards, hasMore, err := store.GetBoardsForCompliance(opts)
+		boards, hasMore, err := store.GetBoardsForCompliance(context.Background(), opts)
 
 		assert.ElementsMatch(t, extractIDs(t, boards), extractIDs(t, boardsAdded1, boardsAdded2))
 		assert.False(t, hasMore)
@@ -70,7 +71,7 @@ func testGetBoardsForCompliance(t *testing.T, store store.Store) {
 			TeamID: team1,
 		}
 
-		boards, hasMore, err := store.GetBoardsForCompliance(opts)
+		boards, hasMore, err := store.GetBoardsForCompliance(context.Background(), opts)
 
 		assert.ElementsMatch(t, extractIDs(t, boards), extractIDs(t, boardsAdded1))
 		assert.False(t, hasMore)
@@ -87,7 +88,7 @@ func testGetBoardsForCompliance(t *testing.T, store store.Store) {
 		allBoards := make([]*model.Board, 0, 20)
 
 		for {
-			boards, hasMore, err := store.GetBoardsForCompliance(opts)
+			boards, hasMore, err := store.GetBoardsForCompliance(context.Background(), opts)
 			require.NoError(t, err)
 			require.NotEmpty(t, boards)
 			allBoards = append(allBoards, boards...)
@@ -128,7 +129,7 @@ func testGetBoardsComplianceHistory(t *testing.T, store store.Store) {
 			TeamID: utils.NewID(utils.IDTypeTeam),
 		}
 
-		boardHistories, hasMore, err := store.GetBoardsComplianceHistory(opts)
+		boardHistories, hasMore, err := store.GetBoardsComplianceHistory(context.Background(), opts)
 
 		assert.Empty(t, boardHistories)
 		assert.False(t, hasMore)
@@ -140,7 +141,7 @@ func testGetBoardsComplianceHistory(t *testing.T, store store.Store) {
 			IncludeDeleted: true,
 		}
 
-		boardHistories, hasMore, err := store.GetBoardsComplianceHistory(opts)
+		boardHistories, hasMore, err := store.GetBoardsComplianceHistory(context.Background(), opts)
 
 		// boardHistories should contain a record for each board added, plus a record for the 2 deleted.
 		assert.ElementsMatch(t, extractIDs(t, boardHistories), extractIDs(t, boardsAdded, boardsDeleted))
@@ -153,7 +154,7 @@ func testGetBoardsComplianceHistory(t *testing.T, store store.Store) {
 			IncludeDeleted: false,
 		}
 
-		boardHistories, hasMore, err := store.GetBoardsComplianceHistory(opts)
+		boardHistories, hasMore, err := store.GetBoardsComplianceHistory(context.Background(), opts)
 
 		// boardHistories should contain a record for each board added, minus the two deleted.
 		assert.ElementsMatch(t, extractIDs(t, boardHistories), extractIDs(t, boardsTeam1, boardsTeam2))
@@ -166,7 +167,7 @@ func testGetBoardsComplianceHistory(t *testing.T, store store.Store) {
 			TeamID: team1,
 		}
 
-		boardHistories, hasMore, err := store.GetBoardsComplianceHistory(opts)
+		boardHistories, hasMore, err := store.GetBoardsComplianceHistory(context.Background(), opts)
 
 		assert.ElementsMatch(t, extractIDs(t, boardHistories), extractIDs(t, boardsTeam1))
 		assert.False(t, hasMore)
@@ -184,7 +185,7 @@ func testGetBoardsComplianceHistory(t *testing.T, store store.Store) {
 
 		for {
 			reps++
-			boardHistories, hasMore, err := store.GetBoardsComplianceHistory(opts)
+			boardHistories, hasMore, err := store.GetBoardsComplianceHistory(context.Background(), opts)
 			require.NoError(t, err)
 			require.NotEmpty(t, boardHistories)
 			allHistories = append(allHistories, boardHistories...)
@@ -222,7 +223,7 @@ func testGetBlocksComplianceHistory(t *testing.T, store store.Store) {
 			TeamID: utils.NewID(utils.IDTypeTeam),
 		}
 
-		boards, hasMore, err := store.GetBlocksComplianceHistory(opts)
+		boards, hasMore, err := store.GetBlocksComplianceHistory(context.Background(), opts)
 
 		assert.Empty(t, boards)
 		assert.False(t, hasMore)
@@ -234,7 +235,7 @@ func testGetBlocksComplianceHistory(t *testing.T, store store.Store) {
 			IncludeDeleted: true,
 		}
 
-		blockHistories, hasMore, err := store.GetBlocksComplianceHistory(opts)
+		blockHistories, hasMore, err := store.GetBlocksComplianceHistory(context.Background(), opts)
 
 		// blockHistories should have records for all cards added, plus all cards deleted
 		assert.ElementsMatch(t, extractIDs(t, blockHistories, nil),
@@ -246,7 +247,7 @@ func testGetBlocksComplianceHistory(t *testing.T, store store.Store) {
 	t.Run("All teams, exclude deleted", func(t *testing.T) {
 		opts := model.QueryBlocksComplianceHistoryOptions{}
 
-		blockHistories, hasMore, err := store.GetBlocksComplianceHistory(opts)
+		blockHistories, hasMore, err := store.GetBlocksComplianceHistory(context.Background(), opts)
 
 		// blockHistories should have records for all cards added that have not been deleted
 		assert.ElementsMatch(t, extractIDs(t, blockHistories, nil),
@@ -260,7 +261,7 @@ func testGetBlocksComplianceHistory(t *testing.T, store store.Store) {
 			TeamID: team1,
 		}
 
-		blockHistories, hasMore, err := store.GetBlocksComplianceHistory(opts)
+		blockHistories, hasMore, err := store.GetBlocksComplianceHistory(context.Background(), opts)
 
 		assert.ElementsMatch(t, extractIDs(t, blockHistories), extractIDs(t, cards2Team1, cards3Team1))
 		assert.False(t, hasMore)
@@ -278,7 +279,7 @@ func testGetBlocksComplianceHistory(t *testing.T, store store.Store) {
 
 		for {
 			reps++
-			blockHistories, hasMore, err := store.GetBlocksComplianceHistory(opts)
+			blockHistories, hasMore, err := store.GetBlocksComplianceHistory(context.Background(), opts)
 			require.NoError(t, err)
 			require.NotEmpty(t, blockHistories)
 			allHistories = append(allHistories, blockHistories...)
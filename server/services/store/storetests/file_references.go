@@ -0,0 +1,82 @@
+// Copyright (c) 2020-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package storetests
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mattermost/mattermost-plugin-boards/server/model"
+	"github.com/mattermost/mattermost-plugin-boards/server/services/store"
+	"github.com/mattermost/mattermost-plugin-boards/server/utils"
+)
+
+func StoreTestFileReferencesStore(t *testing.T, setup func(t *testing.T) (store.Store, func())) {
+	t.Run("GetFileIDsReferencedByBlocks", func(t *testing.T) {
+		store, tearDown := setup(t)
+		defer tearDown()
+		testGetFileIDsReferencedByBlocks(t, store)
+	})
+}
+
+func testGetFileIDsReferencedByBlocks(t *testing.T, store store.Store) {
+	boardID := utils.NewID(utils.IDTypeBoard)
+	imageFileID := utils.NewID(utils.IDTypeNone)
+	attachmentFileID := utils.NewID(utils.IDTypeNone)
+	versionFileID := utils.NewID(utils.IDTypeNone)
+	textFileID := utils.NewID(utils.IDTypeNone)
+	orphanFileID := utils.NewID(utils.IDTypeNone)
+	fileIDs := []string{imageFileID[1:], attachmentFileID[1:], versionFileID[1:], textFileID[1:], orphanFileID[1:]}
+
+	referenced, err := store.GetFileIDsReferencedByBlocks(context.Background(), fileIDs)
+	require.NoError(t, err)
+	assert.Empty(t, referenced)
+
+	image := &model.Block{
+		ID:         utils.NewID(utils.IDTypeBlock),
+		BoardID:    boardID,
+		ParentID:   boardID,
+		Type:       model.TypeImage,
+		ModifiedBy: testUserID,
+		Fields:     map[string]interface{}{model.BlockFieldFileId: imageFileID + ".png"},
+	}
+	attachment := &model.Block{
+		ID:         utils.NewID(utils.IDTypeBlock),
+		BoardID:    boardID,
+		ParentID:   boardID,
+		Type:       model.TypeAttachment,
+		ModifiedBy: testUserID,
+		Fields: map[string]interface{}{
+			model.BlockFieldAttachmentId: attachmentFileID + ".pdf",
+			model.BlockFieldVersions: []interface{}{
+				map[string]interface{}{"version": 1, "fileId": versionFileID + ".pdf", "uploadedBy": testUserID, "uploadedAt": 1},
+			},
+		},
+	}
+	// only image and attachment blocks reference files.
+	text := &model.Block{
+		ID:         utils.NewID(utils.IDTypeBlock),
+		BoardID:    boardID,
+		ParentID:   boardID,
+		Type:       model.TypeText,
+		ModifiedBy: testUserID,
+		Title:      textFileID,
+		Fields:     map[string]interface{}{model.BlockFieldFileId: textFileID + ".png"},
+	}
+	require.NoError(t, store.InsertBlocks(context.Background(), []*model.Block{image, attachment, text}, testUserID))
+
+	referenced, err = store.GetFileIDsReferencedByBlocks(context.Background(), fileIDs)
+	require.NoError(t, err)
+	assert.Equal(t, map[string]bool{imageFileID[1:]: true, attachmentFileID[1:]: true, versionFileID[1:]: true}, referenced)
+
+	// deleted blocks keep referencing their files through history.
+	require.NoError(t, store.DeleteBlock(context.Background(), image.ID, testUserID))
+
+	referenced, err = store.GetFileIDsReferencedByBlocks(context.Background(), fileIDs[:1])
+	require.NoError(t, err)
+	assert.Equal(t, map[string]bool{imageFileID[1:]: true}, referenced)
+}
@@ -0,0 +1,62 @@
+// Copyright (c) 2020-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package storetests
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mattermost/mattermost-plugin-boards/server/model"
+	"github.com/mattermost/mattermost-plugin-boards/server/services/store"
+	"github.com/mattermost/mattermost-plugin-boards/server/utils"
+)
+
+func StoreTestFileScansStore(t *testing.T, setup func(t *testing.T) (store.Store, func())) {
+	t.Run("UpsertFileScan", func(t *testing.T) {
+		store, tearDown := setup(t)
+		defer tearDown()
+		testUpsertFileScan(t, store)
+	})
+}
+
+func testUpsertFileScan(t *testing.T, store store.Store) {
+	fileID := utils.NewID(utils.IDTypeNone)
+
+	t.Run("missing scan", func(t *testing.T) {
+		scan, err := store.GetFileScan(context.Background(), fileID)
+		require.True(t, model.IsErrNotFound(err))
+		assert.Nil(t, scan)
+	})
+
+	t.Run("pending scan is replaced by its result", func(t *testing.T) {
+		require.NoError(t, store.UpsertFileScan(context.Background(), &model.FileScan{
+			FileID:    fileID,
+			Status:    model.FileScanPending,
+			Scanner:   "clamd",
+			ScannedAt: utils.GetMillis(),
+		}))
+
+		scan, err := store.GetFileScan(context.Background(), fileID)
+		require.NoError(t, err)
+		assert.Equal(t, model.FileScanPending, scan.Status)
+		assert.True(t, scan.IsQuarantined())
+
+		require.NoError(t, store.UpsertFileScan(context.Background(), &model.FileScan{
+			FileID:    fileID,
+			Status:    model.FileScanInfected,
+			Signature: "Eicar-Test-Signature",
+			Scanner:   "clamd",
+			ScannedAt: utils.GetMillis(),
+		}))
+
+		scan, err = store.GetFileScan(context.Background(), fileID)
+		require.NoError(t, err)
+		assert.Equal(t, model.FileScanInfected, scan.Status)
+		assert.Equal(t, "Eicar-Test-Signature", scan.Signature)
+		assert.Equal(t, "clamd", scan.Scanner)
+	})
+}
@@ -4,6 +4,7 @@
 package storetests
 
 import (
+	"context"
 	"testing"
 
 	"github.com/mattermost/mattermost-plugin-boards/server/model"
@@ -28,10 +29,10 @@ func StoreTestFileStore(t *testing.T, setup func(t *testing.T) (store.Store, fun
 			DeleteAt:  0,
 		}
 
-		err := sqlStore.SaveFileInfo(fileInfo)
+		err := sqlStore.SaveFileInfo(context.Background(), fileInfo)
 		require.NoError(t, err)
 
-		retrievedFileInfo, err := sqlStore.GetFileInfo("file_info_1")
+		retrievedFileInfo, err := sqlStore.GetFileInfo(context.Background(), "file_info_1")
 		require.NoError(t, err)
 		require.Equal(t, "file_info_1", retrievedFileInfo.Id)
 		require.Equal(t, "Dunder Mifflin Sales Report 2022", retrievedFileInfo.Name)
@@ -42,10 +43,70 @@ func StoreTestFileStore(t *testing.T, setup func(t *testing.T) (store.Store, fun
 	})
 
 	t.Run("should return an error on not found", func(t *testing.T) {
-		fileInfo, err := sqlStore.GetFileInfo("nonexistent")
+		fileInfo, err := sqlStore.GetFileInfo(context.Background(), "nonexistent")
 		require.Error(t, err)
 		var nf *model.ErrNotFound
 		require.ErrorAs(t, err, &nf)
 		require.Nil(t, fileInfo)
 	})
+
+	t.Run("should count and delete fileinfos sharing a stored file", func(t *testing.T) {
+		path := "boards/objects/ab/abcdef.png"
+		for _, id := range []string{"shared_1", "shared_2"} {
+			require.NoError(t, sqlStore.SaveFileInfo(context.Background(), &mmModel.FileInfo{
+				Id:        id,
+				CreatorId: "boards",
+				CreateAt:  1000,
+				Path:      path,
+				Size:      10,
+			}))
+		}
+
+		count, err := sqlStore.CountFileInfosWithPath(context.Background(), path)
+		require.NoError(t, err)
+		require.Equal(t, int64(2), count)
+
+		fileInfos, err := sqlStore.GetFileInfosWithPathPrefix(context.Background(), "boards/objects/", 2000)
+		require.NoError(t, err)
+		require.Len(t, fileInfos, 2)
+		require.Equal(t, "shared_1", fileInfos[0].Id)
+		require.Equal(t, path, fileInfos[0].Path)
+
+		fileInfos, err = sqlStore.GetFileInfosWithPathPrefix(context.Background(), "boards/objects/", 1000)
+		require.NoError(t, err)
+		require.Empty(t, fileInfos)
+
+		require.NoError(t, sqlStore.DeleteFileInfo(context.Background(), "shared_1"))
+		count, err = sqlStore.CountFileInfosWithPath(context.Background(), path)
+		require.NoError(t, err)
+		require.Equal(t, int64(1), count)
+	})
+
+	t.Run("should record the boards of files and move them", func(t *testing.T) {
+		require.NoError(t, sqlStore.SaveFileInfo(context.Background(), &mmModel.FileInfo{
+			Id:        "moved_1",
+			CreatorId: "boards",
+			CreateAt:  1000,
+			Path:      "team/board/7moved_1.png",
+			Size:      10,
+		}))
+
+		require.NoError(t, sqlStore.UpdateFileInfoPaths(context.Background(), &mmModel.FileInfo{
+			Id:   "moved_1",
+			Path: "boards/objects/cd/cdef.png",
+		}))
+		count, err := sqlStore.CountFileInfosWithPath(context.Background(), "boards/objects/cd/cdef.png")
+		require.NoError(t, err)
+		require.Equal(t, int64(1), count)
+
+		require.NoError(t, sqlStore.SaveFileBoard(context.Background(), "moved_1", "board_1"))
+		boardIDs, err := sqlStore.GetFileBoardIDs(context.Background(), []string{"moved_1", "nonexistent"})
+		require.NoError(t, err)
+		require.Equal(t, map[string]string{"moved_1": "board_1"}, boardIDs)
+
+		require.NoError(t, sqlStore.DeleteFileInfo(context.Background(), "moved_1"))
+		boardIDs, err = sqlStore.GetFileBoardIDs(context.Background(), []string{"moved_1"})
+		require.NoError(t, err)
+		require.Empty(t, boardIDs)
+	})
 }
@@ -4,6 +4,7 @@
 package storetests
 
 import (
+	"context"
 	"testing"
 
 	"github.com/mattermost/mattermost-plugin-boards/server/model"
@@ -13,14 +14,14 @@ import (
 
 func InsertBlocks(t *testing.T, s store.Store, blocks []*model.Block, userID string) {
 	for i := range blocks {
-		err := s.InsertBlock(blocks[i], userID)
+		err := s.InsertBlock(context.Background(), blocks[i], userID)
 		require.NoError(t, err)
 	}
 }
 
 func DeleteBlocks(t *testing.T, s store.Store, blocks []*model.Block, modifiedBy string) {
 	for _, block := range blocks {
-		err := s.DeleteBlock(block.ID, modifiedBy)
+		err := s.DeleteBlock(context.Background(), block.ID, modifiedBy)
 		require.NoError(t, err)
 	}
 }
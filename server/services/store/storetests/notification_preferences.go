@@ -0,0 +1,99 @@
+// Copyright (c) 2020-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package storetests
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mattermost/mattermost-plugin-boards/server/model"
+	"github.com/mattermost/mattermost-plugin-boards/server/services/store"
+	"github.com/mattermost/mattermost-plugin-boards/server/utils"
+)
+
+func StoreTestNotificationPreferencesStore(t *testing.T, setup func(t *testing.T) (store.Store, func())) {
+	t.Run("UpsertNotificationPreferences", func(t *testing.T) {
+		store, tearDown := setup(t)
+		defer tearDown()
+		testUpsertNotificationPreferences(t, store)
+	})
+
+	t.Run("GetNotificationPreferencesForBoard", func(t *testing.T) {
+		store, tearDown := setup(t)
+		defer tearDown()
+		testGetNotificationPreferencesForBoard(t, store)
+	})
+
+	t.Run("DeleteNotificationPreferences", func(t *testing.T) {
+		store, tearDown := setup(t)
+		defer tearDown()
+		testDeleteNotificationPreferences(t, store)
+	})
+}
+
+func testUpsertNotificationPreferences(t *testing.T, store store.Store) {
+	userID := utils.NewID(utils.IDTypeUser)
+	boardID := utils.NewID(utils.IDTypeBoard)
+
+	t.Run("not found before upsert", func(t *testing.T) {
+		prefs, err := store.GetNotificationPreferences(context.Background(), userID, boardID)
+		require.True(t, model.IsErrNotFound(err))
+		assert.Nil(t, prefs)
+	})
+
+	t.Run("create and update", func(t *testing.T) {
+		prefs := model.NewDefaultNotificationPreferences(userID, boardID)
+		prefs.Comments = false
+
+		prefsNew, err := store.UpsertNotificationPreferences(context.Background(), prefs)
+		require.NoError(t, err)
+		assert.NotZero(t, prefsNew.UpdateAt)
+
+		prefs.Muted = true
+		_, err = store.UpsertNotificationPreferences(context.Background(), prefs)
+		require.NoError(t, err)
+
+		saved, err := store.GetNotificationPreferences(context.Background(), userID, boardID)
+		require.NoError(t, err)
+		assert.True(t, saved.Muted)
+		assert.False(t, saved.Comments)
+		assert.True(t, saved.Properties)
+	})
+
+	t.Run("invalid preferences", func(t *testing.T) {
+		_, err := store.UpsertNotificationPreferences(context.Background(), &model.NotificationPreferences{UserID: userID})
+		require.Error(t, err)
+	})
+}
+
+func testGetNotificationPreferencesForBoard(t *testing.T, store store.Store) {
+	boardID := utils.NewID(utils.IDTypeBoard)
+
+	for i := 0; i < 3; i++ {
+		_, err := store.UpsertNotificationPreferences(context.Background(), model.NewDefaultNotificationPreferences(utils.NewID(utils.IDTypeUser), boardID))
+		require.NoError(t, err)
+	}
+	_, err := store.UpsertNotificationPreferences(context.Background(), model.NewDefaultNotificationPreferences(utils.NewID(utils.IDTypeUser), utils.NewID(utils.IDTypeBoard)))
+	require.NoError(t, err)
+
+	prefs, err := store.GetNotificationPreferencesForBoard(context.Background(), boardID)
+	require.NoError(t, err)
+	assert.Len(t, prefs, 3)
+}
+
+func testDeleteNotificationPreferences(t *testing.T, store store.Store) {
+	userID := utils.NewID(utils.IDTypeUser)
+	boardID := utils.NewID(utils.IDTypeBoard)
+
+	_, err := store.UpsertNotificationPreferences(context.Background(), model.NewDefaultNotificationPreferences(userID, boardID))
+	require.NoError(t, err)
+
+	require.NoError(t, store.DeleteNotificationPreferences(context.Background(), userID, boardID))
+
+	_, err = store.GetNotificationPreferences(context.Background(), userID, boardID)
+	require.True(t, model.IsErrNotFound(err))
+}
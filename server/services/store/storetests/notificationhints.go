@@ -4,6 +4,7 @@
 package storetests
 
 import (
+	"context"
 	"testing"
 	"time"
 
@@ -13,6 +14,8 @@ import (
 	"github.com/mattermost/mattermost-plugin-boards/server/model"
 	"github.com/mattermost/mattermost-plugin-boards/server/services/store"
 	"github.com/mattermost/mattermost-plugin-boards/server/utils"
+
+	mmModel "github.com/mattermost/mattermost/server/public/model"
 )
 
 func StoreTestNotificationHintsStore(t *testing.T, setup func(t *testing.T) (store.Store, func())) {
@@ -39,6 +42,12 @@ func StoreTestNotificationHintsStore(t *testing.T, setup func(t *testing.T) (sto
 		defer tearDown()
 		testGetNextNotificationHint(t, store)
 	})
+
+	t.Run("GetNotificationHintCount", func(t *testing.T) {
+		store, tearDown := setup(t)
+		defer tearDown()
+		testGetNotificationHintCount(t, store)
+	})
 }
 
 func testUpsertNotificationHint(t *testing.T, store store.Store) {
@@ -49,7 +58,7 @@ func testUpsertNotificationHint(t *testing.T, store store.Store) {
 			ModifiedByID: utils.NewID(utils.IDTypeUser),
 		}
 
-		hintNew, err := store.UpsertNotificationHint(hint, time.Second*15)
+		hintNew, err := store.UpsertNotificationHint(context.Background(), hint, time.Second*15)
 		require.NoError(t, err, "upsert notification hint should not error")
 		assert.Equal(t, hint.BlockID, hintNew.BlockID)
 		assert.NoError(t, hintNew.IsValid())
@@ -61,7 +70,7 @@ func testUpsertNotificationHint(t *testing.T, store store.Store) {
 			BlockID:      utils.NewID(utils.IDTypeBlock),
 			ModifiedByID: utils.NewID(utils.IDTypeUser),
 		}
-		hintNew, err := store.UpsertNotificationHint(hint, time.Second*15)
+		hintNew, err := store.UpsertNotificationHint(context.Background(), hint, time.Second*15)
 		require.NoError(t, err, "upsert notification hint should not error")
 
 		// sleep a short time so the notify_at timestamps won't collide
@@ -72,7 +81,7 @@ func testUpsertNotificationHint(t *testing.T, store store.Store) {
 			BlockID:      hintNew.BlockID,
 			ModifiedByID: hintNew.ModifiedByID,
 		}
-		hintDup, err := store.UpsertNotificationHint(hint, time.Second*15)
+		hintDup, err := store.UpsertNotificationHint(context.Background(), hint, time.Second*15)
 
 		require.NoError(t, err, "upsert notification hint should not error")
 		// notify_at should be updated
@@ -82,22 +91,22 @@ func testUpsertNotificationHint(t *testing.T, store store.Store) {
 	t.Run("invalid notification hint", func(t *testing.T) {
 		hint := &model.NotificationHint{}
 
-		_, err := store.UpsertNotificationHint(hint, time.Second*15)
+		_, err := store.UpsertNotificationHint(context.Background(), hint, time.Second*15)
 		assert.ErrorAs(t, err, &model.ErrInvalidNotificationHint{}, "invalid notification hint should error")
 
 		hint.BlockType = "board"
-		_, err = store.UpsertNotificationHint(hint, time.Second*15)
+		_, err = store.UpsertNotificationHint(context.Background(), hint, time.Second*15)
 		assert.ErrorAs(t, err, &model.ErrInvalidNotificationHint{}, "invalid notification hint should error")
 
-		_, err = store.UpsertNotificationHint(hint, time.Second*15)
+		_, err = store.UpsertNotificationHint(context.Background(), hint, time.Second*15)
 		assert.ErrorAs(t, err, &model.ErrInvalidNotificationHint{}, "invalid notification hint should error")
 
 		hint.ModifiedByID = utils.NewID(utils.IDTypeUser)
-		_, err = store.UpsertNotificationHint(hint, time.Second*15)
+		_, err = store.UpsertNotificationHint(context.Background(), hint, time.Second*15)
 		assert.ErrorAs(t, err, &model.ErrInvalidNotificationHint{}, "invalid notification hint should error")
 
 		hint.BlockID = utils.NewID(utils.IDTypeBlock)
-		hintNew, err := store.UpsertNotificationHint(hint, time.Second*15)
+		hintNew, err := store.UpsertNotificationHint(context.Background(), hint, time.Second*15)
 		assert.NoError(t, err, "valid notification hint should not error")
 		assert.NoError(t, hintNew.IsValid(), "created notification hint should be valid")
 	})
@@ -110,26 +119,26 @@ func testDeleteNotificationHint(t *testing.T, store store.Store) {
 			BlockID:      utils.NewID(utils.IDTypeBlock),
 			ModifiedByID: utils.NewID(utils.IDTypeUser),
 		}
-		hintNew, err := store.UpsertNotificationHint(hint, time.Second*15)
+		hintNew, err := store.UpsertNotificationHint(context.Background(), hint, time.Second*15)
 		require.NoError(t, err, "create notification hint should not error")
 
 		// check the notification hint exists
-		hint, err = store.GetNotificationHint(hintNew.BlockID)
+		hint, err = store.GetNotificationHint(context.Background(), hintNew.BlockID)
 		require.NoError(t, err, "get notification hint should not error")
 		assert.Equal(t, hintNew.BlockID, hint.BlockID)
 		assert.Equal(t, hintNew.CreateAt, hint.CreateAt)
 
-		err = store.DeleteNotificationHint(hintNew.BlockID)
+		err = store.DeleteNotificationHint(context.Background(), hintNew.BlockID)
 		require.NoError(t, err, "delete notification hint should not error")
 
 		// check the notification hint was deleted
-		hint, err = store.GetNotificationHint(hintNew.BlockID)
+		hint, err = store.GetNotificationHint(context.Background(), hintNew.BlockID)
 		require.True(t, model.IsErrNotFound(err), "error should be of type store.ErrNotFound")
 		assert.Nil(t, hint)
 	})
 
 	t.Run("delete non-existent notification hint", func(t *testing.T) {
-		err := store.DeleteNotificationHint("bogus")
+		err := store.DeleteNotificationHint(context.Background(), "bogus")
 		require.True(t, model.IsErrNotFound(err), "error should be of type store.ErrNotFound")
 	})
 }
@@ -141,17 +150,17 @@ func testGetNotificationHint(t *testing.T, store store.Store) {
 			BlockID:      utils.NewID(utils.IDTypeBlock),
 			ModifiedByID: utils.NewID(utils.IDTypeUser),
 		}
-		hintNew, err := store.UpsertNotificationHint(hint, time.Second*15)
+		hintNew, err := store.UpsertNotificationHint(context.Background(), hint, time.Second*15)
 		require.NoError(t, err, "create notification hint should not error")
 
 		// make sure notification hint can be fetched
-		hint, err = store.GetNotificationHint(hintNew.BlockID)
+		hint, err = store.GetNotificationHint(context.Background(), hintNew.BlockID)
 		require.NoError(t, err, "get notification hint should not error")
 		assert.Equal(t, hintNew, hint)
 	})
 
 	t.Run("get non-existent notification hint", func(t *testing.T) {
-		hint, err := store.GetNotificationHint("bogus")
+		hint, err := store.GetNotificationHint(context.Background(), "bogus")
 		require.True(t, model.IsErrNotFound(err), "error should be of type store.ErrNotFound")
 		assert.Nil(t, hint, "hint should be nil")
 	})
@@ -170,7 +179,7 @@ func testGetNextNotificationHint(t *testing.T, store store.Store) {
 				BlockID:      utils.NewID(utils.IDTypeBlock),
 				ModifiedByID: modifiedBy,
 			}
-			hintNew, err := store.UpsertNotificationHint(hint, time.Second*15)
+			hintNew, err := store.UpsertNotificationHint(context.Background(), hint, time.Second*15)
 			require.NoError(t, err, "create notification hint should not error")
 
 			ids[i] = hintNew.BlockID
@@ -180,14 +189,14 @@ func testGetNextNotificationHint(t *testing.T, store store.Store) {
 		// check the hints come back in the right order
 		notifyAt := utils.GetMillisForTime(time.Now().Add(time.Millisecond * 50))
 		for i := 0; i < loops; i++ {
-			hint, err := store.GetNextNotificationHint(false)
+			hint, err := store.GetNextNotificationHint(context.Background(), false)
 			require.NoError(t, err, "get next notification hint should not error")
 			require.NotNil(t, hint, "get next notification hint should not return nil")
 			assert.Equal(t, ids[i], hint.BlockID)
 			assert.Less(t, notifyAt, hint.NotifyAt)
 			notifyAt = hint.NotifyAt
 
-			err = store.DeleteNotificationHint(hint.BlockID)
+			err = store.DeleteNotificationHint(context.Background(), hint.BlockID)
 			require.NoError(t, err, "delete notification hint should not error")
 		}
 	})
@@ -198,17 +207,17 @@ func testGetNextNotificationHint(t *testing.T, store store.Store) {
 		require.NoError(t, err, "emptying notification hint table should not error")
 
 		for {
-			hint, err2 := store.GetNextNotificationHint(false)
+			hint, err2 := store.GetNextNotificationHint(context.Background(), false)
 			if model.IsErrNotFound(err2) {
 				break
 			}
 			require.NoError(t, err2, "get next notification hint should not error")
 
-			err2 = store.DeleteNotificationHint(hint.BlockID)
+			err2 = store.DeleteNotificationHint(context.Background(), hint.BlockID)
 			require.NoError(t, err2, "delete notification hint should not error")
 		}
 
-		_, err = store.GetNextNotificationHint(false)
+		_, err = store.GetNextNotificationHint(context.Background(), false)
 		require.True(t, model.IsErrNotFound(err), "error should be of type store.ErrNotFound")
 	})
 
@@ -222,23 +231,55 @@ func testGetNextNotificationHint(t *testing.T, store store.Store) {
 			BlockID:      utils.NewID(utils.IDTypeBlock),
 			ModifiedByID: utils.NewID(utils.IDTypeUser),
 		}
-		hintNew, err := store.UpsertNotificationHint(hint, time.Second*1)
+		hintNew, err := store.UpsertNotificationHint(context.Background(), hint, time.Second*1)
 		require.NoError(t, err, "create notification hint should not error")
 
-		hintDeleted, err := store.GetNextNotificationHint(true)
+		hintDeleted, err := store.GetNextNotificationHint(context.Background(), true)
 		require.NoError(t, err, "get next notification hint should not error")
 		require.NotNil(t, hintDeleted, "get next notification hint should not return nil")
 		assert.Equal(t, hintNew.BlockID, hintDeleted.BlockID)
 
 		// should be no hint left
-		_, err = store.GetNextNotificationHint(false)
+		_, err = store.GetNextNotificationHint(context.Background(), false)
 		require.True(t, model.IsErrNotFound(err), "error should be of type store.ErrNotFound")
 	})
 }
 
+func testGetNotificationHintCount(t *testing.T, store store.Store) {
+	err := emptyNotificationHintTable(store)
+	require.NoError(t, err, "emptying notification hint table should not error")
+
+	count, err := store.GetNotificationHintCount(context.Background())
+	require.NoError(t, err)
+	assert.Zero(t, count)
+
+	// hints are validated against Mattermost user IDs
+	modifiedBy := mmModel.NewId()
+	for i := 0; i < 3; i++ {
+		hint := &model.NotificationHint{
+			BlockType:    model.TypeCard,
+			BlockID:      utils.NewID(utils.IDTypeBlock),
+			ModifiedByID: modifiedBy,
+		}
+		_, err = store.UpsertNotificationHint(context.Background(), hint, time.Second*15)
+		require.NoError(t, err, "upsert notification hint should not error")
+	}
+
+	count, err = store.GetNotificationHintCount(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, 3, count)
+
+	_, err = store.GetNextNotificationHint(context.Background(), true)
+	require.NoError(t, err)
+
+	count, err = store.GetNotificationHintCount(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, 2, count)
+}
+
 func emptyNotificationHintTable(store store.Store) error {
 	for {
-		hint, err := store.GetNextNotificationHint(false)
+		hint, err := store.GetNextNotificationHint(context.Background(), false)
 		if model.IsErrNotFound(err) {
 			break
 		}
@@ -247,7 +288,7 @@ func emptyNotificationHintTable(store store.Store) error {
 			return err
 		}
 
-		err = store.DeleteNotificationHint(hint.BlockID)
+		err = store.DeleteNotificationHint(context.Background(), hint.BlockID)
 		if err != nil {
 			return err
 		}
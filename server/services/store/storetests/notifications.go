@@ -0,0 +1,162 @@
+// Copyright (c) 2020-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package storetests
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mattermost/mattermost-plugin-boards/server/model"
+	"github.com/mattermost/mattermost-plugin-boards/server/services/store"
+	"github.com/mattermost/mattermost-plugin-boards/server/utils"
+)
+
+func StoreTestNotificationsStore(t *testing.T, setup func(t *testing.T) (store.Store, func())) {
+	t.Run("CreateNotification", func(t *testing.T) {
+		store, tearDown := setup(t)
+		defer tearDown()
+		testCreateNotification(t, store)
+	})
+
+	t.Run("GetNotificationsForUser", func(t *testing.T) {
+		store, tearDown := setup(t)
+		defer tearDown()
+		testGetNotificationsForUser(t, store)
+	})
+
+	t.Run("MarkNotificationsRead", func(t *testing.T) {
+		store, tearDown := setup(t)
+		defer tearDown()
+		testMarkNotificationsRead(t, store)
+	})
+}
+
+func createTestNotifications(t *testing.T, store store.Store, userID string, count int) []*model.Notification {
+	boardID := utils.NewID(utils.IDTypeBoard)
+	notifications := make([]*model.Notification, 0, count)
+	for i := 0; i < count; i++ {
+		n, err := store.CreateNotification(context.Background(), &model.Notification{
+			UserID:  userID,
+			Type:    model.NotificationTypeMention,
+			BoardID: boardID,
+			CardID:  utils.NewID(utils.IDTypeCard),
+			Title:   "card",
+		})
+		require.NoError(t, err)
+		notifications = append(notifications, n)
+		time.Sleep(time.Millisecond * 2)
+	}
+	return notifications
+}
+
+func testCreateNotification(t *testing.T, store store.Store) {
+	userID := utils.NewID(utils.IDTypeUser)
+
+	t.Run("invalid notification", func(t *testing.T) {
+		n, err := store.CreateNotification(context.Background(), &model.Notification{UserID: userID, Type: "bogus"})
+		require.Error(t, err)
+		assert.Nil(t, n)
+	})
+
+	t.Run("create", func(t *testing.T) {
+		n := &model.Notification{
+			UserID:  userID,
+			Type:    model.NotificationTypeAssigned,
+			TeamID:  utils.NewID(utils.IDTypeTeam),
+			BoardID: utils.NewID(utils.IDTypeBoard),
+			CardID:  utils.NewID(utils.IDTypeCard),
+			ActorID: utils.NewID(utils.IDTypeUser),
+			Title:   "card title",
+			Message: "Owner",
+		}
+		created, err := store.CreateNotification(context.Background(), n)
+		require.NoError(t, err)
+		assert.NotEmpty(t, created.ID)
+		assert.NotZero(t, created.CreateAt)
+		assert.Zero(t, created.ReadAt)
+
+		saved, err := store.GetNotification(context.Background(), userID, created.ID)
+		require.NoError(t, err)
+		assert.Equal(t, created, saved)
+	})
+
+	t.Run("not found for other user", func(t *testing.T) {
+		created := createTestNotifications(t, store, userID, 1)[0]
+		n, err := store.GetNotification(context.Background(), utils.NewID(utils.IDTypeUser), created.ID)
+		require.True(t, model.IsErrNotFound(err))
+		assert.Nil(t, n)
+	})
+}
+
+func testGetNotificationsForUser(t *testing.T, store store.Store) {
+	userID := utils.NewID(utils.IDTypeUser)
+	created := createTestNotifications(t, store, userID, 5)
+	createTestNotifications(t, store, utils.NewID(utils.IDTypeUser), 2)
+
+	t.Run("newest first", func(t *testing.T) {
+		notifications, err := store.GetNotificationsForUser(context.Background(), userID, model.QueryNotificationsOptions{})
+		require.NoError(t, err)
+		require.Len(t, notifications, 5)
+		assert.Equal(t, created[4].ID, notifications[0].ID)
+		assert.Equal(t, created[0].ID, notifications[4].ID)
+	})
+
+	t.Run("paged", func(t *testing.T) {
+		notifications, err := store.GetNotificationsForUser(context.Background(), userID, model.QueryNotificationsOptions{Page: 1, PerPage: 2})
+		require.NoError(t, err)
+		require.Len(t, notifications, 2)
+		assert.Equal(t, created[2].ID, notifications[0].ID)
+		assert.Equal(t, created[1].ID, notifications[1].ID)
+	})
+
+	t.Run("unread only", func(t *testing.T) {
+		require.NoError(t, store.MarkNotificationRead(context.Background(), userID, created[4].ID))
+
+		notifications, err := store.GetNotificationsForUser(context.Background(), userID, model.QueryNotificationsOptions{UnreadOnly: true})
+		require.NoError(t, err)
+		require.Len(t, notifications, 4)
+		assert.Equal(t, created[3].ID, notifications[0].ID)
+	})
+}
+
+func testMarkNotificationsRead(t *testing.T, store store.Store) {
+	userID := utils.NewID(utils.IDTypeUser)
+	created := createTestNotifications(t, store, userID, 3)
+
+	count, err := store.GetUnreadNotificationCount(context.Background(), userID)
+	require.NoError(t, err)
+	assert.EqualValues(t, 3, count)
+
+	t.Run("mark one read", func(t *testing.T) {
+		require.NoError(t, store.MarkNotificationRead(context.Background(), userID, created[0].ID))
+
+		saved, err := store.GetNotification(context.Background(), userID, created[0].ID)
+		require.NoError(t, err)
+		assert.NotZero(t, saved.ReadAt)
+
+		count, err := store.GetUnreadNotificationCount(context.Background(), userID)
+		require.NoError(t, err)
+		assert.EqualValues(t, 2, count)
+
+		// marking an already read notification is not an error.
+		require.NoError(t, store.MarkNotificationRead(context.Background(), userID, created[0].ID))
+	})
+
+	t.Run("mark other user's notification", func(t *testing.T) {
+		err := store.MarkNotificationRead(context.Background(), utils.NewID(utils.IDTypeUser), created[1].ID)
+		require.True(t, model.IsErrNotFound(err))
+	})
+
+	t.Run("mark all read", func(t *testing.T) {
+		require.NoError(t, store.MarkAllNotificationsRead(context.Background(), userID))
+
+		count, err := store.GetUnreadNotificationCount(context.Background(), userID)
+		require.NoError(t, err)
+		assert.Zero(t, count)
+	})
+}
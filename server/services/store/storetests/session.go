@@ -4,6 +4,7 @@
 package storetests
 
 import (
+	"context"
 	"testing"
 	"time"
 
@@ -21,7 +22,7 @@ func StoreTestSessionStore(t *testing.T, setup func(t *testing.T) (store.Store,
 
 func testGetActiveUserCount(t *testing.T, store store.Store) {
 	t.Run("no active user", func(t *testing.T) {
-		count, err := store.GetActiveUserCount(60)
+		count, err := store.GetActiveUserCount(context.Background(), 60)
 		require.NoError(t, err)
 		require.Equal(t, 0, count)
 	})
@@ -30,7 +31,7 @@ func testGetActiveUserCount(t *testing.T, store store.Store) {
 		// gen random count active user session
 		count := int(time.Now().Unix() % 10)
 
-		got, err := store.GetActiveUserCount(60)
+		got, err := store.GetActiveUserCount(context.Background(), 60)
 		require.NoError(t, err)
 		require.Equal(t, count, got)
 	})
@@ -0,0 +1,129 @@
+// Copyright (c) 2020-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package storetests
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mattermost/mattermost-plugin-boards/server/model"
+	"github.com/mattermost/mattermost-plugin-boards/server/services/store"
+	"github.com/mattermost/mattermost-plugin-boards/server/utils"
+)
+
+func StoreTestShareLinksStore(t *testing.T, setup func(t *testing.T) (store.Store, func())) {
+	t.Run("CreateShareLink", func(t *testing.T) {
+		store, tearDown := setup(t)
+		defer tearDown()
+		testCreateShareLink(t, store)
+	})
+
+	t.Run("RevokeShareLink", func(t *testing.T) {
+		store, tearDown := setup(t)
+		defer tearDown()
+		testRevokeShareLink(t, store)
+	})
+}
+
+func testCreateShareLink(t *testing.T, store store.Store) {
+	boardID := utils.NewID(utils.IDTypeBoard)
+	userID := utils.NewID(utils.IDTypeUser)
+
+	t.Run("invalid link", func(t *testing.T) {
+		link, err := store.CreateShareLink(context.Background(), &model.ShareLink{BoardID: boardID})
+		require.Error(t, err)
+		assert.Nil(t, link)
+	})
+
+	t.Run("create and get links", func(t *testing.T) {
+		link := &model.ShareLink{
+			BoardID:    boardID,
+			Name:       "card link",
+			Token:      utils.NewID(utils.IDTypeToken),
+			Scope:      model.ShareLinkScopeCard,
+			ScopeID:    utils.NewID(utils.IDTypeCard),
+			Permission: model.ShareLinkPermissionComment,
+			ExpiresAt:  utils.GetMillis() + 60*1000,
+			CreatedBy:  userID,
+		}
+		require.NoError(t, link.SetPassword("secret"))
+
+		created, err := store.CreateShareLink(context.Background(), link)
+		require.NoError(t, err)
+		require.NotEmpty(t, created.ID)
+		assert.NotZero(t, created.CreateAt)
+
+		got, err := store.GetShareLink(context.Background(), created.ID)
+		require.NoError(t, err)
+		assert.Equal(t, link.Token, got.Token)
+		assert.Equal(t, link.Scope, got.Scope)
+		assert.Equal(t, link.ScopeID, got.ScopeID)
+		assert.Equal(t, link.Permission, got.Permission)
+		assert.Equal(t, link.ExpiresAt, got.ExpiresAt)
+		assert.True(t, got.HasPassword)
+		assert.True(t, got.CheckPassword("secret"))
+
+		got, err = store.GetShareLinkByToken(context.Background(), boardID, link.Token)
+		require.NoError(t, err)
+		assert.Equal(t, created.ID, got.ID)
+
+		_, err = store.GetShareLinkByToken(context.Background(), utils.NewID(utils.IDTypeBoard), link.Token)
+		require.True(t, model.IsErrNotFound(err))
+
+		_, err = store.CreateShareLink(context.Background(), &model.ShareLink{
+			BoardID:    boardID,
+			Token:      utils.NewID(utils.IDTypeToken),
+			Scope:      model.ShareLinkScopeBoard,
+			Permission: model.ShareLinkPermissionView,
+			CreatedBy:  userID,
+		})
+		require.NoError(t, err)
+
+		links, err := store.GetShareLinksForBoard(context.Background(), boardID)
+		require.NoError(t, err)
+		require.Len(t, links, 2)
+	})
+
+	t.Run("update last used", func(t *testing.T) {
+		links, err := store.GetShareLinksForBoard(context.Background(), boardID)
+		require.NoError(t, err)
+		require.NotEmpty(t, links)
+
+		lastUsedAt := utils.GetMillis()
+		require.NoError(t, store.UpdateShareLinkLastUsed(context.Background(), links[0].ID, lastUsedAt))
+
+		got, err := store.GetShareLink(context.Background(), links[0].ID)
+		require.NoError(t, err)
+		assert.Equal(t, lastUsedAt, got.LastUsedAt)
+	})
+}
+
+func testRevokeShareLink(t *testing.T, store store.Store) {
+	boardID := utils.NewID(utils.IDTypeBoard)
+
+	link, err := store.CreateShareLink(context.Background(), &model.ShareLink{
+		BoardID:    boardID,
+		Token:      utils.NewID(utils.IDTypeToken),
+		Scope:      model.ShareLinkScopeBoard,
+		Permission: model.ShareLinkPermissionView,
+	})
+	require.NoError(t, err)
+
+	require.NoError(t, store.RevokeShareLink(context.Background(), link.ID))
+
+	got, err := store.GetShareLink(context.Background(), link.ID)
+	require.NoError(t, err)
+	assert.NotZero(t, got.RevokedAt)
+	assert.False(t, got.IsActive(utils.GetMillis()))
+
+	// revoking an already revoked link is a not found error.
+	err = store.RevokeShareLink(context.Background(), link.ID)
+	require.True(t, model.IsErrNotFound(err))
+
+	err = store.RevokeShareLink(context.Background(), utils.NewID(utils.IDTypeNone))
+	require.True(t, model.IsErrNotFound(err))
+}
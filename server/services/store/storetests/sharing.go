@@ -4,6 +4,7 @@
 package storetests
 
 import (
+	"context"
 	"testing"
 
 	"github.com/mattermost/mattermost-plugin-boards/server/model"
@@ -28,9 +29,9 @@ func testUpsertSharingAndGetSharing(t *testing.T, store store.Store) {
 			ModifiedBy: testUserID,
 		}
 
-		err := store.UpsertSharing(sharing)
+		err := store.UpsertSharing(context.Background(), sharing)
 		require.NoError(t, err)
-		newSharing, err := store.GetSharing("sharing-id")
+		newSharing, err := store.GetSharing(context.Background(), "sharing-id")
 		require.NoError(t, err)
 		newSharing.UpdateAt = 0
 		require.Equal(t, sharing, *newSharing)
@@ -43,20 +44,20 @@ func testUpsertSharingAndGetSharing(t *testing.T, store store.Store) {
 			ModifiedBy: "user-id2",
 		}
 
-		newSharing, err := store.GetSharing("sharing-id")
+		newSharing, err := store.GetSharing(context.Background(), "sharing-id")
 		require.NoError(t, err)
 		newSharing.UpdateAt = 0
 		require.NotEqual(t, sharing, *newSharing)
 
-		err = store.UpsertSharing(sharing)
+		err = store.UpsertSharing(context.Background(), sharing)
 		require.NoError(t, err)
-		newSharing, err = store.GetSharing("sharing-id")
+		newSharing, err = store.GetSharing(context.Background(), "sharing-id")
 		require.NoError(t, err)
 		newSharing.UpdateAt = 0
 		require.Equal(t, sharing, *newSharing)
 	})
 	t.Run("Get not existing sharing", func(t *testing.T) {
-		_, err := store.GetSharing("not-existing")
+		_, err := store.GetSharing(context.Background(), "not-existing")
 		require.Error(t, err)
 		require.True(t, model.IsErrNotFound(err))
 	})
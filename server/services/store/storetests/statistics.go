@@ -0,0 +1,213 @@
+// Copyright (c) 2020-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package storetests
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mattermost/mattermost-plugin-boards/server/model"
+	"github.com/mattermost/mattermost-plugin-boards/server/services/store"
+	"github.com/mattermost/mattermost-plugin-boards/server/utils"
+)
+
+func StoreTestStatisticsStore(t *testing.T, setup func(t *testing.T) (store.Store, func())) {
+	t.Run("GetBoardActivity", func(t *testing.T) {
+		store, tearDown := setup(t)
+		defer tearDown()
+		testGetBoardActivity(t, store)
+	})
+	t.Run("GetDailyActivity", func(t *testing.T) {
+		store, tearDown := setup(t)
+		defer tearDown()
+		testGetDailyActivity(t, store)
+	})
+	t.Run("GetTeamBoardAndCardCounts", func(t *testing.T) {
+		store, tearDown := setup(t)
+		defer tearDown()
+		testGetTeamBoardAndCardCounts(t, store)
+	})
+	t.Run("GetActiveUserCountsByTeam", func(t *testing.T) {
+		store, tearDown := setup(t)
+		defer tearDown()
+		testGetActiveUserCountsByTeam(t, store)
+	})
+	t.Run("GetBlockCountsByTypeWithOptions", func(t *testing.T) {
+		store, tearDown := setup(t)
+		defer tearDown()
+		testGetBlockCountsByTypeWithOptions(t, store)
+	})
+}
+
+func createTestComment(t *testing.T, store store.Store, userID string, card *model.Block) *model.Block {
+	comment := &model.Block{
+		ID:        utils.NewID(utils.IDTypeBlock),
+		BoardID:   card.BoardID,
+		ParentID:  card.ID,
+		Type:      model.TypeComment,
+		CreatedBy: userID,
+		Title:     "comment",
+	}
+	require.NoError(t, store.InsertBlock(context.Background(), comment, userID))
+	return comment
+}
+
+func testGetBoardActivity(t *testing.T, store store.Store) {
+	boards := createTestBoards(t, store, testTeamID, testUserID, 2)
+	otherBoard := createTestBoards(t, store, "other-team-id", testUserID, 1)[0]
+
+	time.Sleep(10 * time.Millisecond)
+	from := utils.GetMillis()
+	time.Sleep(10 * time.Millisecond)
+
+	cards := createTestCards(t, store, testUserID, boards[0].ID, 2)
+	createTestComment(t, store, "other-user-id", cards[0])
+	createTestCards(t, store, testUserID, boards[1].ID, 1)
+	createTestCards(t, store, testUserID, otherBoard.ID, 1)
+
+	time.Sleep(10 * time.Millisecond)
+	to := utils.GetMillis()
+
+	activity, err := store.GetBoardActivity(context.Background(), model.StatisticsOptions{From: from, To: to})
+	require.NoError(t, err)
+	require.Len(t, activity, 3)
+
+	assert.Equal(t, boards[0].ID, activity[0].BoardID)
+	assert.Equal(t, testTeamID, activity[0].TeamID)
+	assert.Equal(t, boards[0].Title, activity[0].Title)
+	assert.EqualValues(t, 3, activity[0].Changes)
+	assert.EqualValues(t, 2, activity[0].CardChanges)
+	assert.Equal(t, 2, activity[0].Editors)
+	assert.Equal(t, model.ActiveUserCounts{Day: 2, Week: 2, Month: 2}, activity[0].ActiveUsers)
+	assert.Equal(t, model.ActivityCounts{CardsCreated: 2, Comments: 1}, activity[0].Activity)
+
+	t.Run("filtered by team", func(t *testing.T) {
+		activity, err := store.GetBoardActivity(context.Background(), model.StatisticsOptions{TeamID: "other-team-id", From: from, To: to})
+		require.NoError(t, err)
+		require.Len(t, activity, 1)
+		assert.Equal(t, otherBoard.ID, activity[0].BoardID)
+	})
+
+	t.Run("no activity in the date range", func(t *testing.T) {
+		activity, err := store.GetBoardActivity(context.Background(), model.StatisticsOptions{From: to + 1, To: to + 1000})
+		require.NoError(t, err)
+		assert.Empty(t, activity)
+	})
+}
+
+func testGetDailyActivity(t *testing.T, store store.Store) {
+	board := createTestBoards(t, store, testTeamID, testUserID, 1)[0]
+	otherBoard := createTestBoards(t, store, "other-team-id", testUserID, 1)[0]
+
+	time.Sleep(10 * time.Millisecond)
+	from := utils.GetMillis()
+	time.Sleep(10 * time.Millisecond)
+
+	cards := createTestCards(t, store, testUserID, board.ID, 2)
+	createTestComment(t, store, testUserID, cards[0])
+	createTestCards(t, store, testUserID, otherBoard.ID, 1)
+	// changes of the cards are not counted
+	title := "changed"
+	require.NoError(t, store.PatchBlock(context.Background(), cards[0].ID, &model.BlockPatch{Title: &title}, testUserID))
+
+	time.Sleep(10 * time.Millisecond)
+	to := utils.GetMillis()
+
+	dayLength := (24 * time.Hour).Milliseconds()
+	activity, err := store.GetDailyActivity(context.Background(), model.StatisticsOptions{TeamID: testTeamID, From: from, To: to})
+	require.NoError(t, err)
+	total := model.ActivityCounts{}
+	for start, counts := range activity {
+		// the activity is keyed by the start of the days of the date range
+		assert.Zero(t, start%dayLength)
+		assert.GreaterOrEqual(t, start, from-from%dayLength)
+		assert.LessOrEqual(t, start, to)
+		total.Add(counts)
+	}
+	assert.Equal(t, model.ActivityCounts{CardsCreated: 2, Comments: 1}, total)
+
+	t.Run("all teams", func(t *testing.T) {
+		activity, err := store.GetDailyActivity(context.Background(), model.StatisticsOptions{From: from, To: to})
+		require.NoError(t, err)
+		total := model.ActivityCounts{}
+		for _, counts := range activity {
+			total.Add(counts)
+		}
+		assert.Equal(t, model.ActivityCounts{CardsCreated: 3, Comments: 1}, total)
+	})
+
+	t.Run("no activity in the date range", func(t *testing.T) {
+		activity, err := store.GetDailyActivity(context.Background(), model.StatisticsOptions{From: to + 1, To: to + 1000})
+		require.NoError(t, err)
+		assert.Empty(t, activity)
+	})
+}
+
+func testGetTeamBoardAndCardCounts(t *testing.T, store store.Store) {
+	boards := createTestBoards(t, store, testTeamID, testUserID, 2)
+	otherBoard := createTestBoards(t, store, "other-team-id", testUserID, 1)[0]
+	createTestCards(t, store, testUserID, boards[0].ID, 3)
+	createTestCards(t, store, testUserID, otherBoard.ID, 1)
+
+	counts, err := store.GetTeamBoardAndCardCounts(context.Background(), "")
+	require.NoError(t, err)
+	require.Len(t, counts, 2)
+	assert.Equal(t, &model.TeamStatistics{TeamID: "other-team-id", Boards: 1, Cards: 1}, counts[0])
+	assert.Equal(t, &model.TeamStatistics{TeamID: testTeamID, Boards: 2, Cards: 3}, counts[1])
+
+	counts, err = store.GetTeamBoardAndCardCounts(context.Background(), testTeamID)
+	require.NoError(t, err)
+	require.Len(t, counts, 1)
+	assert.Equal(t, testTeamID, counts[0].TeamID)
+}
+
+func testGetActiveUserCountsByTeam(t *testing.T, store store.Store) {
+	board := createTestBoards(t, store, testTeamID, testUserID, 1)[0]
+	otherBoard := createTestBoards(t, store, "other-team-id", testUserID, 1)[0]
+	card := createTestCards(t, store, testUserID, board.ID, 1)[0]
+	createTestComment(t, store, "other-user-id", card)
+	createTestCards(t, store, "third-user-id", otherBoard.ID, 1)
+
+	now := utils.GetMillis()
+	total, byTeam, err := store.GetActiveUserCountsByTeam(context.Background(), "", now)
+	require.NoError(t, err)
+	assert.Equal(t, model.ActiveUserCounts{Day: 3, Week: 3, Month: 3}, total)
+	assert.Equal(t, map[string]model.ActiveUserCounts{
+		testTeamID:      {Day: 2, Week: 2, Month: 2},
+		"other-team-id": {Day: 1, Week: 1, Month: 1},
+	}, byTeam)
+
+	total, byTeam, err = store.GetActiveUserCountsByTeam(context.Background(), testTeamID, now)
+	require.NoError(t, err)
+	assert.Equal(t, model.ActiveUserCounts{Day: 2, Week: 2, Month: 2}, total)
+	assert.Len(t, byTeam, 1)
+
+	total, _, err = store.GetActiveUserCountsByTeam(context.Background(), "", now+(2*24*time.Hour).Milliseconds())
+	require.NoError(t, err)
+	assert.Equal(t, model.ActiveUserCounts{Day: 0, Week: 3, Month: 3}, total)
+}
+
+func testGetBlockCountsByTypeWithOptions(t *testing.T, store store.Store) {
+	board := createTestBoards(t, store, testTeamID, testUserID, 1)[0]
+	otherBoard := createTestBoards(t, store, "other-team-id", testUserID, 1)[0]
+	cards := createTestCards(t, store, testUserID, board.ID, 2)
+	createTestCards(t, store, testUserID, otherBoard.ID, 1)
+
+	time.Sleep(10 * time.Millisecond)
+	from := utils.GetMillis()
+	time.Sleep(10 * time.Millisecond)
+	createTestBlocksForCard(t, store, cards[0].ID, 2)
+
+	counts, err := store.GetBlockCountsByTypeWithOptions(context.Background(), model.StatisticsOptions{TeamID: testTeamID})
+	require.NoError(t, err)
+	assert.Equal(t, map[string]int64{"card": 2, "text": 2}, counts)
+
+	counts, err = store.GetBlockCountsByTypeWithOptions(context.Background(), model.StatisticsOptions{From: from, To: utils.GetMillis()})
+	require.NoError(t, err)
+	assert.Equal(t, map[string]int64{"text": 2}, counts)
+}
@@ -0,0 +1,215 @@
+// Copyright (c) 2020-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package storetests
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mattermost/mattermost-plugin-boards/server/model"
+	"github.com/mattermost/mattermost-plugin-boards/server/services/store"
+	"github.com/mattermost/mattermost-plugin-boards/server/utils"
+
+	mmModel "github.com/mattermost/mattermost/server/public/model"
+)
+
+func StoreTestStorageQuotasStore(t *testing.T, setup func(t *testing.T) (store.Store, func())) {
+	t.Run("AddFileUsage", func(t *testing.T) {
+		store, tearDown := setup(t)
+		defer tearDown()
+		testAddFileUsage(t, store)
+	})
+	t.Run("ReserveFileUsage", func(t *testing.T) {
+		store, tearDown := setup(t)
+		defer tearDown()
+		testReserveFileUsage(t, store)
+	})
+	t.Run("DeleteAndRestoreFiles", func(t *testing.T) {
+		store, tearDown := setup(t)
+		defer tearDown()
+		testDeleteAndRestoreFiles(t, store)
+	})
+	t.Run("UpsertStorageQuota", func(t *testing.T) {
+		store, tearDown := setup(t)
+		defer tearDown()
+		testUpsertStorageQuota(t, store)
+	})
+}
+
+func testAddFileUsage(t *testing.T, store store.Store) {
+	teamID := utils.NewID(utils.IDTypeTeam)
+	boardID1 := utils.NewID(utils.IDTypeBoard)
+	boardID2 := utils.NewID(utils.IDTypeBoard)
+
+	t.Run("board without files", func(t *testing.T) {
+		usage, err := store.GetBoardFileUsage(context.Background(), boardID1)
+		require.NoError(t, err)
+		assert.Zero(t, usage.Bytes)
+		assert.Zero(t, usage.FileCount)
+
+		usage, err = store.GetTeamFileUsage(context.Background(), teamID)
+		require.NoError(t, err)
+		assert.Zero(t, usage.Bytes)
+	})
+
+	t.Run("usage is summed per board and team", func(t *testing.T) {
+		require.NoError(t, store.AddFileUsage(context.Background(), teamID, boardID1, 100, 1))
+		require.NoError(t, store.AddFileUsage(context.Background(), teamID, boardID1, 50, 1))
+		require.NoError(t, store.AddFileUsage(context.Background(), teamID, boardID2, 25, 1))
+
+		usage, err := store.GetBoardFileUsage(context.Background(), boardID1)
+		require.NoError(t, err)
+		assert.Equal(t, teamID, usage.TeamID)
+		assert.EqualValues(t, 150, usage.Bytes)
+		assert.EqualValues(t, 2, usage.FileCount)
+
+		usage, err = store.GetTeamFileUsage(context.Background(), teamID)
+		require.NoError(t, err)
+		assert.EqualValues(t, 175, usage.Bytes)
+		assert.EqualValues(t, 3, usage.FileCount)
+
+		usages, err := store.GetFileUsageByTeam(context.Background())
+		require.NoError(t, err)
+		require.Len(t, usages, 1)
+		assert.Equal(t, teamID, usages[0].TeamID)
+		assert.EqualValues(t, 175, usages[0].Bytes)
+	})
+
+	t.Run("usage does not go below zero", func(t *testing.T) {
+		require.NoError(t, store.AddFileUsage(context.Background(), teamID, boardID2, -100, -2))
+
+		usage, err := store.GetBoardFileUsage(context.Background(), boardID2)
+		require.NoError(t, err)
+		assert.Zero(t, usage.Bytes)
+		assert.Zero(t, usage.FileCount)
+	})
+}
+
+func testReserveFileUsage(t *testing.T, store store.Store) {
+	teamID := utils.NewID(utils.IDTypeTeam)
+	boardID1 := utils.NewID(utils.IDTypeBoard)
+	boardID2 := utils.NewID(utils.IDTypeBoard)
+
+	t.Run("usage within the quotas is reserved", func(t *testing.T) {
+		require.NoError(t, store.ReserveFileUsage(context.Background(), teamID, boardID1, 60, 100, 60))
+		require.NoError(t, store.ReserveFileUsage(context.Background(), teamID, boardID2, 30, 100, 0))
+
+		usage, err := store.GetBoardFileUsage(context.Background(), boardID1)
+		require.NoError(t, err)
+		assert.EqualValues(t, 60, usage.Bytes)
+		assert.EqualValues(t, 1, usage.FileCount)
+
+		usage, err = store.GetTeamFileUsage(context.Background(), teamID)
+		require.NoError(t, err)
+		assert.EqualValues(t, 90, usage.Bytes)
+	})
+
+	t.Run("usage exceeding the board quota is not reserved", func(t *testing.T) {
+		err := store.ReserveFileUsage(context.Background(), teamID, boardID1, 1, 100, 60)
+		var quotaErr *model.ErrStorageQuotaExceeded
+		require.ErrorAs(t, err, &quotaErr)
+		assert.Equal(t, model.StorageQuotaScopeBoard, quotaErr.Scope)
+		assert.EqualValues(t, 60, quotaErr.UsedBytes)
+	})
+
+	t.Run("usage exceeding the team quota is not reserved", func(t *testing.T) {
+		boardID3 := utils.NewID(utils.IDTypeBoard)
+		err := store.ReserveFileUsage(context.Background(), teamID, boardID3, 11, 100, 0)
+		var quotaErr *model.ErrStorageQuotaExceeded
+		require.ErrorAs(t, err, &quotaErr)
+		assert.Equal(t, model.StorageQuotaScopeTeam, quotaErr.Scope)
+		assert.Equal(t, teamID, quotaErr.ScopeID)
+		assert.EqualValues(t, 90, quotaErr.UsedBytes)
+
+		usage, err := store.GetTeamFileUsage(context.Background(), teamID)
+		require.NoError(t, err)
+		assert.EqualValues(t, 90, usage.Bytes)
+		assert.EqualValues(t, 2, usage.FileCount)
+	})
+}
+
+func testDeleteAndRestoreFiles(t *testing.T, store store.Store) {
+	teamID := utils.NewID(utils.IDTypeTeam)
+	board, err := store.InsertBoard(context.Background(), &model.Board{
+		ID:     utils.NewID(utils.IDTypeBoard),
+		TeamID: teamID,
+		Type:   model.BoardTypeOpen,
+	}, "user-id")
+	require.NoError(t, err)
+
+	fileIDs := []string{utils.NewID(utils.IDTypeNone), utils.NewID(utils.IDTypeNone)}
+	for _, fileID := range fileIDs {
+		require.NoError(t, store.SaveFileInfo(context.Background(), &mmModel.FileInfo{
+			Id:       fileID,
+			CreateAt: utils.GetMillis(),
+			Size:     10,
+		}))
+	}
+	require.NoError(t, store.AddFileUsage(context.Background(), teamID, board.ID, 20, 2))
+
+	t.Run("deleted files release their usage once", func(t *testing.T) {
+		require.NoError(t, store.DeleteFiles(context.Background(), board.ID, fileIDs[:1]))
+		require.NoError(t, store.DeleteFiles(context.Background(), board.ID, fileIDs))
+
+		usage, err := store.GetBoardFileUsage(context.Background(), board.ID)
+		require.NoError(t, err)
+		assert.Zero(t, usage.Bytes)
+		assert.Zero(t, usage.FileCount)
+	})
+
+	t.Run("restored files are charged again", func(t *testing.T) {
+		require.NoError(t, store.RestoreFiles(context.Background(), board.ID, fileIDs))
+		require.NoError(t, store.RestoreFiles(context.Background(), board.ID, fileIDs))
+
+		usage, err := store.GetTeamFileUsage(context.Background(), teamID)
+		require.NoError(t, err)
+		assert.EqualValues(t, 20, usage.Bytes)
+		assert.EqualValues(t, 2, usage.FileCount)
+	})
+}
+
+func testUpsertStorageQuota(t *testing.T, store store.Store) {
+	teamID := utils.NewID(utils.IDTypeTeam)
+
+	t.Run("missing quota", func(t *testing.T) {
+		quota, err := store.GetStorageQuota(context.Background(), model.StorageQuotaScopeTeam, teamID)
+		require.True(t, model.IsErrNotFound(err))
+		assert.Nil(t, quota)
+	})
+
+	t.Run("quota is replaced", func(t *testing.T) {
+		require.NoError(t, store.UpsertStorageQuota(context.Background(), &model.StorageQuota{
+			Scope:      model.StorageQuotaScopeTeam,
+			ScopeID:    teamID,
+			MaxBytes:   1000,
+			ModifiedBy: "user-1",
+			UpdateAt:   utils.GetMillis(),
+		}))
+		require.NoError(t, store.UpsertStorageQuota(context.Background(), &model.StorageQuota{
+			Scope:      model.StorageQuotaScopeTeam,
+			ScopeID:    teamID,
+			MaxBytes:   2000,
+			ModifiedBy: "user-2",
+			UpdateAt:   utils.GetMillis(),
+		}))
+
+		quota, err := store.GetStorageQuota(context.Background(), model.StorageQuotaScopeTeam, teamID)
+		require.NoError(t, err)
+		assert.EqualValues(t, 2000, quota.MaxBytes)
+		assert.Equal(t, "user-2", quota.ModifiedBy)
+
+		_, err = store.GetStorageQuota(context.Background(), model.StorageQuotaScopeBoard, teamID)
+		require.True(t, model.IsErrNotFound(err))
+	})
+
+	t.Run("deleted quota", func(t *testing.T) {
+		require.NoError(t, store.DeleteStorageQuota(context.Background(), model.StorageQuotaScopeTeam, teamID))
+
+		_, err := store.GetStorageQuota(context.Background(), model.StorageQuotaScopeTeam, teamID)
+		require.True(t, model.IsErrNotFound(err))
+	})
+}
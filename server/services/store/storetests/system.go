@@ -4,6 +4,7 @@
 package storetests
 
 import (
+	"context"
 	"testing"
 
 	"github.com/mattermost/mattermost-plugin-boards/server/services/store"
@@ -39,30 +40,41 @@ func StoreTestSystemStore(t *testing.T, setup func(t *testing.T) (store.Store, f
 
 func testSetGetSystemSettings(t *testing.T, store store.Store) {
 	t.Run("Get empty settings", func(t *testing.T) {
-		settings, err := store.GetSystemSettings()
+		settings, err := store.GetSystemSettings(context.Background())
 		require.NoError(t, err)
 		require.Equal(t, dataMigrationSystemSettings, settings)
 	})
 
 	t.Run("Set, update and get multiple settings", func(t *testing.T) {
-		err := store.SetSystemSetting("test-1", "test-value-1")
+		err := store.SetSystemSetting(context.Background(), "test-1", "test-value-1")
 		require.NoError(t, err)
-		err = store.SetSystemSetting("test-2", "test-value-2")
+		err = store.SetSystemSetting(context.Background(), "test-2", "test-value-2")
 		require.NoError(t, err)
-		settings, err := store.GetSystemSettings()
+		settings, err := store.GetSystemSettings(context.Background())
 		require.NoError(t, err)
 		require.Equal(t, addBaseSettings(map[string]string{"test-1": "test-value-1", "test-2": "test-value-2"}), settings)
 
-		err = store.SetSystemSetting("test-2", "test-value-updated-2")
+		err = store.SetSystemSetting(context.Background(), "test-2", "test-value-updated-2")
 		require.NoError(t, err)
-		settings, err = store.GetSystemSettings()
+		settings, err = store.GetSystemSettings(context.Background())
 		require.NoError(t, err)
 		require.Equal(t, addBaseSettings(map[string]string{"test-1": "test-value-1", "test-2": "test-value-updated-2"}), settings)
 	})
 
 	t.Run("Get a single setting", func(t *testing.T) {
-		value, err := store.GetSystemSetting("test-1")
+		value, err := store.GetSystemSetting(context.Background(), "test-1")
 		require.NoError(t, err)
 		require.Equal(t, "test-value-1", value)
 	})
+
+	t.Run("Set a setting only if absent", func(t *testing.T) {
+		err := store.SetSystemSettingIfAbsent(context.Background(), "test-3", "test-value-3")
+		require.NoError(t, err)
+		err = store.SetSystemSettingIfAbsent(context.Background(), "test-3", "test-value-updated-3")
+		require.NoError(t, err)
+
+		value, err := store.GetSystemSetting(context.Background(), "test-3")
+		require.NoError(t, err)
+		require.Equal(t, "test-value-3", value)
+	})
 }
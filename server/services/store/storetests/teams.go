@@ -4,6 +4,7 @@
 package storetests
 
 import (
+	"context"
 	"fmt"
 
 	"github.com/mattermost/mattermost-plugin-boards/server/model"
@@ -44,7 +45,7 @@ func StoreTestTeamStore(t *testing.T, setup func(t *testing.T) (store.Store, fun
 
 func testGetTeam(t *testing.T, store store.Store) {
 	t.Run("Nonexistent team", func(t *testing.T) {
-		got, err := store.GetTeam("nonexistent-id")
+		got, err := store.GetTeam(context.Background(), "nonexistent-id")
 		require.Error(t, err)
 		require.True(t, model.IsErrNotFound(err))
 		require.Nil(t, got)
@@ -57,10 +58,10 @@ func testGetTeam(t *testing.T, store store.Store) {
 			SignupToken: utils.NewID(utils.IDTypeToken),
 		}
 
-		err := store.UpsertTeamSignupToken(*team)
+		err := store.UpsertTeamSignupToken(context.Background(), *team)
 		require.NoError(t, err)
 
-		got, err := store.GetTeam(teamID)
+		got, err := store.GetTeam(context.Background(), teamID)
 		require.NoError(t, err)
 		require.Equal(t, teamID, got.ID)
 	})
@@ -75,20 +76,20 @@ func testUpsertTeamSignupToken(t *testing.T, store store.Store) {
 		}
 
 		// insert
-		err := store.UpsertTeamSignupToken(*team)
+		err := store.UpsertTeamSignupToken(context.Background(), *team)
 		require.NoError(t, err)
 
-		got, err := store.GetTeam(teamID)
+		got, err := store.GetTeam(context.Background(), teamID)
 		require.NoError(t, err)
 		require.Equal(t, team.ID, got.ID)
 		require.Equal(t, team.SignupToken, got.SignupToken)
 
 		// update signup token
 		team.SignupToken = utils.NewID(utils.IDTypeToken)
-		err = store.UpsertTeamSignupToken(*team)
+		err = store.UpsertTeamSignupToken(context.Background(), *team)
 		require.NoError(t, err)
 
-		got, err = store.GetTeam(teamID)
+		got, err = store.GetTeam(context.Background(), teamID)
 		require.NoError(t, err)
 		require.Equal(t, team.ID, got.ID)
 		require.Equal(t, team.SignupToken, got.SignupToken)
@@ -106,10 +107,10 @@ func testUpsertTeamSettings(t *testing.T, store store.Store) {
 		}
 
 		// insert
-		err := store.UpsertTeamSettings(*team)
+		err := store.UpsertTeamSettings(context.Background(), *team)
 		require.NoError(t, err)
 
-		got, err := store.GetTeam(teamID)
+		got, err := store.GetTeam(context.Background(), teamID)
 		require.NoError(t, err)
 		require.Equal(t, team.ID, got.ID)
 		require.Equal(t, team.Settings, got.Settings)
@@ -118,10 +119,10 @@ func testUpsertTeamSettings(t *testing.T, store store.Store) {
 		team.Settings = map[string]interface{}{
 			"field1": "B",
 		}
-		err = store.UpsertTeamSettings(*team)
+		err = store.UpsertTeamSettings(context.Background(), *team)
 		require.NoError(t, err)
 
-		got2, err := store.GetTeam(teamID)
+		got2, err := store.GetTeam(context.Background(), teamID)
 		require.NoError(t, err)
 		require.Equal(t, team.ID, got2.ID)
 		require.Equal(t, team.Settings, got2.Settings)
@@ -131,7 +132,7 @@ func testUpsertTeamSettings(t *testing.T, store store.Store) {
 
 func testGetAllTeams(t *testing.T, store store.Store) {
 	t.Run("No teams response", func(t *testing.T) {
-		got, err := store.GetAllTeams()
+		got, err := store.GetAllTeams(context.Background())
 		require.NoError(t, err)
 		require.Empty(t, got)
 	})
@@ -146,11 +147,11 @@ func testGetAllTeams(t *testing.T, store store.Store) {
 				SignupToken: utils.NewID(utils.IDTypeToken),
 			}
 
-			err := store.UpsertTeamSignupToken(*team)
+			err := store.UpsertTeamSignupToken(context.Background(), *team)
 			require.NoError(t, err)
 		}
 
-		got, err := store.GetAllTeams()
+		got, err := store.GetAllTeams(context.Background())
 		require.NoError(t, err)
 		require.Len(t, got, teamCount)
 	})
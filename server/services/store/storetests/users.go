@@ -4,6 +4,7 @@
 package storetests
 
 import (
+	"context"
 	"testing"
 
 	"github.com/stretchr/testify/require"
@@ -30,7 +31,7 @@ func testCreateAndGetUser(t *testing.T, store store.Store) {
 	}
 
 	t.Run("GetUserByID", func(t *testing.T) {
-		got, err := store.GetUserByID(user.ID)
+		got, err := store.GetUserByID(context.Background(), user.ID)
 		require.NoError(t, err)
 		require.Equal(t, user.ID, got.ID)
 		require.Equal(t, user.Username, got.Username)
@@ -38,14 +39,14 @@ func testCreateAndGetUser(t *testing.T, store store.Store) {
 	})
 
 	t.Run("GetUserByID nonexistent", func(t *testing.T) {
-		got, err := store.GetUserByID("nonexistent-id")
+		got, err := store.GetUserByID(context.Background(), "nonexistent-id")
 		var nf *model.ErrNotFound
 		require.ErrorAs(t, err, &nf)
 		require.Nil(t, got)
 	})
 
 	t.Run("GetUserByUsername", func(t *testing.T) {
-		got, err := store.GetUserByUsername(user.Username)
+		got, err := store.GetUserByUsername(context.Background(), user.Username)
 		require.NoError(t, err)
 		require.Equal(t, user.ID, got.ID)
 		require.Equal(t, user.Username, got.Username)
@@ -53,14 +54,14 @@ func testCreateAndGetUser(t *testing.T, store store.Store) {
 	})
 
 	t.Run("GetUserByUsername nonexistent", func(t *testing.T) {
-		got, err := store.GetUserByID("nonexistent-username")
+		got, err := store.GetUserByID(context.Background(), "nonexistent-username")
 		var nf *model.ErrNotFound
 		require.ErrorAs(t, err, &nf)
 		require.Nil(t, got)
 	})
 
 	t.Run("GetUserByEmail", func(t *testing.T) {
-		got, err := store.GetUserByEmail(user.Email)
+		got, err := store.GetUserByEmail(context.Background(), user.Email)
 		require.NoError(t, err)
 		require.Equal(t, user.ID, got.ID)
 		require.Equal(t, user.Username, got.Username)
@@ -68,7 +69,7 @@ func testCreateAndGetUser(t *testing.T, store store.Store) {
 	})
 
 	t.Run("GetUserByEmail nonexistent", func(t *testing.T) {
-		got, err := store.GetUserByID("nonexistent-email")
+		got, err := store.GetUserByID(context.Background(), "nonexistent-email")
 		var nf *model.ErrNotFound
 		require.ErrorAs(t, err, &nf)
 		require.Nil(t, got)
@@ -4,6 +4,7 @@
 package storetests
 
 import (
+	"context"
 	"fmt"
 	"sort"
 	"testing"
@@ -16,7 +17,7 @@ import (
 )
 
 func createTestBlocksForCard(t *testing.T, store store.Store, cardID string, num int) []*model.Block {
-	card, err := store.GetBlock(cardID)
+	card, err := store.GetBlock(context.Background(), cardID)
 	require.NoError(t, err)
 	assert.EqualValues(t, model.TypeCard, card.Type)
 
@@ -30,7 +31,7 @@ func createTestBlocksForCard(t *testing.T, store store.Store, cardID string, num
 			ParentID:  card.ID,
 			Title:     fmt.Sprintf("text %d", i),
 		}
-		err := store.InsertBlock(block, card.CreatedBy)
+		err := store.InsertBlock(context.Background(), block, card.CreatedBy)
 		require.NoError(t, err)
 
 		blocks = append(blocks, block)
@@ -50,7 +51,7 @@ func createTestCards(t *testing.T, store store.Store, userID string, boardID str
 			CreatedBy: userID,
 			Title:     fmt.Sprintf("card %d", i),
 		}
-		err := store.InsertBlock(block, userID)
+		err := store.InsertBlock(context.Background(), block, userID)
 		require.NoError(t, err)
 
 		blocks = append(blocks, block)
@@ -69,7 +70,7 @@ func createTestBoards(t *testing.T, store store.Store, teamID string, userID str
 			CreatedBy: userID,
 			Title:     fmt.Sprintf("board %d", i),
 		}
-		boardNew, err := store.InsertBoard(board, userID)
+		boardNew, err := store.InsertBoard(context.Background(), board, userID)
 		require.NoError(t, err)
 
 		boards = append(boards, boardNew)
@@ -79,7 +80,7 @@ func createTestBoards(t *testing.T, store store.Store, teamID string, userID str
 
 //nolint:unparam
 func deleteTestBoard(t *testing.T, store store.Store, boardID string, userID string) {
-	err := store.DeleteBoard(boardID, userID)
+	err := store.DeleteBoard(context.Background(), boardID, userID)
 	require.NoError(t, err)
 }
 
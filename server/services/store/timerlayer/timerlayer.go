@@ -0,0 +1,1210 @@
+// Copyright (c) 2020-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+// Code generated by "make generate" from the Store interface
+// DO NOT EDIT
+
+// To add a method, create an entry in the Store interface and run
+// `make generate`
+
+package timerlayer
+
+import (
+	"context"
+	"time"
+
+	"github.com/mattermost/mattermost-plugin-boards/server/model"
+	"github.com/mattermost/mattermost-plugin-boards/server/services/store"
+
+	mmModel "github.com/mattermost/mattermost/server/public/model"
+)
+
+// Metrics records the duration of the store methods.
+type Metrics interface {
+	ObserveStoreMethodDuration(method, success string, elapsed float64)
+}
+
+// TimerLayer is a store layer recording the duration of the calls to the methods of the store
+// it wraps.
+type TimerLayer struct {
+	store.Store
+	metrics Metrics
+}
+
+func New(childStore store.Store, metrics Metrics) *TimerLayer {
+	return &TimerLayer{
+		Store:   childStore,
+		metrics: metrics,
+	}
+}
+
+func (s *TimerLayer) observe(method string, start time.Time, err error) {
+	success := "true"
+	if err != nil {
+		success = "false"
+	}
+	elapsed := float64(time.Since(start)) / float64(time.Second)
+	s.metrics.ObserveStoreMethodDuration(method, success, elapsed)
+}
+
+func (s *TimerLayer) AddCommentReaction(ctx context.Context, reaction *model.CommentReaction) (*model.CommentReaction, error) {
+	start := time.Now()
+	result, err := s.Store.AddCommentReaction(ctx, reaction)
+	s.observe("AddCommentReaction", start, err)
+	return result, err
+}
+
+func (s *TimerLayer) AddFileUsage(ctx context.Context, teamID string, boardID string, bytes int64, fileCount int64) error {
+	start := time.Now()
+	err := s.Store.AddFileUsage(ctx, teamID, boardID, bytes, fileCount)
+	s.observe("AddFileUsage", start, err)
+	return err
+}
+
+func (s *TimerLayer) AddUpdateCategoryBoard(ctx context.Context, userID string, categoryID string, boardIDs []string) error {
+	start := time.Now()
+	err := s.Store.AddUpdateCategoryBoard(ctx, userID, categoryID, boardIDs)
+	s.observe("AddUpdateCategoryBoard", start, err)
+	return err
+}
+
+func (s *TimerLayer) CanSeeUser(ctx context.Context, seerID string, seenID string) (bool, error) {
+	start := time.Now()
+	result, err := s.Store.CanSeeUser(ctx, seerID, seenID)
+	s.observe("CanSeeUser", start, err)
+	return result, err
+}
+
+func (s *TimerLayer) CountFileInfosWithPath(ctx context.Context, path string) (int64, error) {
+	start := time.Now()
+	result, err := s.Store.CountFileInfosWithPath(ctx, path)
+	s.observe("CountFileInfosWithPath", start, err)
+	return result, err
+}
+
+func (s *TimerLayer) CreateBoardsAndBlocks(ctx context.Context, bab *model.BoardsAndBlocks, userID string) (*model.BoardsAndBlocks, error) {
+	start := time.Now()
+	result, err := s.Store.CreateBoardsAndBlocks(ctx, bab, userID)
+	s.observe("CreateBoardsAndBlocks", start, err)
+	return result, err
+}
+
+func (s *TimerLayer) CreateBoardsAndBlocksWithAdmin(ctx context.Context, bab *model.BoardsAndBlocks, userID string) (*model.BoardsAndBlocks, []*model.BoardMember, error) {
+	start := time.Now()
+	result, resultVar1, err := s.Store.CreateBoardsAndBlocksWithAdmin(ctx, bab, userID)
+	s.observe("CreateBoardsAndBlocksWithAdmin", start, err)
+	return result, resultVar1, err
+}
+
+func (s *TimerLayer) CreateCategory(ctx context.Context, category model.Category) error {
+	start := time.Now()
+	err := s.Store.CreateCategory(ctx, category)
+	s.observe("CreateCategory", start, err)
+	return err
+}
+
+func (s *TimerLayer) CreateNotification(ctx context.Context, notification *model.Notification) (*model.Notification, error) {
+	start := time.Now()
+	result, err := s.Store.CreateNotification(ctx, notification)
+	s.observe("CreateNotification", start, err)
+	return result, err
+}
+
+func (s *TimerLayer) CreateShareLink(ctx context.Context, link *model.ShareLink) (*model.ShareLink, error) {
+	start := time.Now()
+	result, err := s.Store.CreateShareLink(ctx, link)
+	s.observe("CreateShareLink", start, err)
+	return result, err
+}
+
+func (s *TimerLayer) CreateSubscription(ctx context.Context, sub *model.Subscription) (*model.Subscription, error) {
+	start := time.Now()
+	result, err := s.Store.CreateSubscription(ctx, sub)
+	s.observe("CreateSubscription", start, err)
+	return result, err
+}
+
+func (s *TimerLayer) DeleteAuditEntriesBefore(ctx context.Context, before int64) (int64, error) {
+	start := time.Now()
+	result, err := s.Store.DeleteAuditEntriesBefore(ctx, before)
+	s.observe("DeleteAuditEntriesBefore", start, err)
+	return result, err
+}
+
+func (s *TimerLayer) DeleteBlock(ctx context.Context, blockID string, modifiedBy string) error {
+	start := time.Now()
+	err := s.Store.DeleteBlock(ctx, blockID, modifiedBy)
+	s.observe("DeleteBlock", start, err)
+	return err
+}
+
+func (s *TimerLayer) DeleteBlockRecord(ctx context.Context, blockID string, modifiedBy string) error {
+	start := time.Now()
+	err := s.Store.DeleteBlockRecord(ctx, blockID, modifiedBy)
+	s.observe("DeleteBlockRecord", start, err)
+	return err
+}
+
+func (s *TimerLayer) DeleteBlockSuiteDocByCardID(ctx context.Context, cardID string) error {
+	start := time.Now()
+	err := s.Store.DeleteBlockSuiteDocByCardID(ctx, cardID)
+	s.observe("DeleteBlockSuiteDocByCardID", start, err)
+	return err
+}
+
+func (s *TimerLayer) DeleteBoard(ctx context.Context, boardID string, userID string) error {
+	start := time.Now()
+	err := s.Store.DeleteBoard(ctx, boardID, userID)
+	s.observe("DeleteBoard", start, err)
+	return err
+}
+
+func (s *TimerLayer) DeleteBoardRecord(ctx context.Context, boardID string, modifiedBy string) error {
+	start := time.Now()
+	err := s.Store.DeleteBoardRecord(ctx, boardID, modifiedBy)
+	s.observe("DeleteBoardRecord", start, err)
+	return err
+}
+
+func (s *TimerLayer) DeleteBoardsAndBlocks(ctx context.Context, dbab *model.DeleteBoardsAndBlocks, userID string) error {
+	start := time.Now()
+	err := s.Store.DeleteBoardsAndBlocks(ctx, dbab, userID)
+	s.observe("DeleteBoardsAndBlocks", start, err)
+	return err
+}
+
+func (s *TimerLayer) DeleteCategory(ctx context.Context, categoryID string, userID string, teamID string) error {
+	start := time.Now()
+	err := s.Store.DeleteCategory(ctx, categoryID, userID, teamID)
+	s.observe("DeleteCategory", start, err)
+	return err
+}
+
+func (s *TimerLayer) DeleteCommentReaction(ctx context.Context, blockID string, userID string, emojiName string) error {
+	start := time.Now()
+	err := s.Store.DeleteCommentReaction(ctx, blockID, userID, emojiName)
+	s.observe("DeleteCommentReaction", start, err)
+	return err
+}
+
+func (s *TimerLayer) DeleteFileInfo(ctx context.Context, id string) error {
+	start := time.Now()
+	err := s.Store.DeleteFileInfo(ctx, id)
+	s.observe("DeleteFileInfo", start, err)
+	return err
+}
+
+func (s *TimerLayer) DeleteFiles(ctx context.Context, boardID string, fileIDs []string) error {
+	start := time.Now()
+	err := s.Store.DeleteFiles(ctx, boardID, fileIDs)
+	s.observe("DeleteFiles", start, err)
+	return err
+}
+
+func (s *TimerLayer) DeleteMember(ctx context.Context, boardID string, userID string) error {
+	start := time.Now()
+	err := s.Store.DeleteMember(ctx, boardID, userID)
+	s.observe("DeleteMember", start, err)
+	return err
+}
+
+func (s *TimerLayer) DeleteNotificationHint(ctx context.Context, blockID string) error {
+	start := time.Now()
+	err := s.Store.DeleteNotificationHint(ctx, blockID)
+	s.observe("DeleteNotificationHint", start, err)
+	return err
+}
+
+func (s *TimerLayer) DeleteNotificationPreferences(ctx context.Context, userID string, boardID string) error {
+	start := time.Now()
+	err := s.Store.DeleteNotificationPreferences(ctx, userID, boardID)
+	s.observe("DeleteNotificationPreferences", start, err)
+	return err
+}
+
+func (s *TimerLayer) DeleteStorageQuota(ctx context.Context, scope model.StorageQuotaScope, scopeID string) error {
+	start := time.Now()
+	err := s.Store.DeleteStorageQuota(ctx, scope, scopeID)
+	s.observe("DeleteStorageQuota", start, err)
+	return err
+}
+
+func (s *TimerLayer) DeleteSubscription(ctx context.Context, blockID string, subscriberID string) error {
+	start := time.Now()
+	err := s.Store.DeleteSubscription(ctx, blockID, subscriberID)
+	s.observe("DeleteSubscription", start, err)
+	return err
+}
+
+func (s *TimerLayer) DuplicateBlock(ctx context.Context, boardID string, blockID string, userID string, asTemplate bool) ([]*model.Block, error) {
+	start := time.Now()
+	result, err := s.Store.DuplicateBlock(ctx, boardID, blockID, userID, asTemplate)
+	s.observe("DuplicateBlock", start, err)
+	return result, err
+}
+
+func (s *TimerLayer) DuplicateBoard(ctx context.Context, boardID string, userID string, toTeam string, asTemplate bool) (*model.BoardsAndBlocks, []*model.BoardMember, error) {
+	start := time.Now()
+	result, resultVar1, err := s.Store.DuplicateBoard(ctx, boardID, userID, toTeam, asTemplate)
+	s.observe("DuplicateBoard", start, err)
+	return result, resultVar1, err
+}
+
+func (s *TimerLayer) GetActiveUserCount(ctx context.Context, updatedSecondsAgo int64) (int, error) {
+	start := time.Now()
+	result, err := s.Store.GetActiveUserCount(ctx, updatedSecondsAgo)
+	s.observe("GetActiveUserCount", start, err)
+	return result, err
+}
+
+func (s *TimerLayer) GetActiveUserCountsByTeam(ctx context.Context, teamID string, at int64) (model.ActiveUserCounts, map[string]model.ActiveUserCounts, error) {
+	start := time.Now()
+	result, resultVar1, err := s.Store.GetActiveUserCountsByTeam(ctx, teamID, at)
+	s.observe("GetActiveUserCountsByTeam", start, err)
+	return result, resultVar1, err
+}
+
+func (s *TimerLayer) GetAllTeams(ctx context.Context) ([]*model.Team, error) {
+	start := time.Now()
+	result, err := s.Store.GetAllTeams(ctx)
+	s.observe("GetAllTeams", start, err)
+	return result, err
+}
+
+func (s *TimerLayer) GetAuditEntries(ctx context.Context, opts model.QueryAuditEntriesOptions) ([]*model.AuditEntry, bool, error) {
+	start := time.Now()
+	result, resultVar1, err := s.Store.GetAuditEntries(ctx, opts)
+	s.observe("GetAuditEntries", start, err)
+	return result, resultVar1, err
+}
+
+func (s *TimerLayer) GetBlock(ctx context.Context, blockID string) (*model.Block, error) {
+	start := time.Now()
+	result, err := s.Store.GetBlock(ctx, blockID)
+	s.observe("GetBlock", start, err)
+	return result, err
+}
+
+func (s *TimerLayer) GetBlockCountsByType(ctx context.Context) (map[string]int64, error) {
+	start := time.Now()
+	result, err := s.Store.GetBlockCountsByType(ctx)
+	s.observe("GetBlockCountsByType", start, err)
+	return result, err
+}
+
+func (s *TimerLayer) GetBlockCountsByTypeWithOptions(ctx context.Context, opts model.StatisticsOptions) (map[string]int64, error) {
+	start := time.Now()
+	result, err := s.Store.GetBlockCountsByTypeWithOptions(ctx, opts)
+	s.observe("GetBlockCountsByTypeWithOptions", start, err)
+	return result, err
+}
+
+func (s *TimerLayer) GetBlockHistory(ctx context.Context, blockID string, opts model.QueryBlockHistoryOptions) ([]*model.Block, error) {
+	start := time.Now()
+	result, err := s.Store.GetBlockHistory(ctx, blockID, opts)
+	s.observe("GetBlockHistory", start, err)
+	return result, err
+}
+
+func (s *TimerLayer) GetBlockHistoryDescendants(ctx context.Context, boardID string, opts model.QueryBlockHistoryOptions) ([]*model.Block, error) {
+	start := time.Now()
+	result, err := s.Store.GetBlockHistoryDescendants(ctx, boardID, opts)
+	s.observe("GetBlockHistoryDescendants", start, err)
+	return result, err
+}
+
+func (s *TimerLayer) GetBlockHistoryNewestChildren(ctx context.Context, parentID string, opts model.QueryBlockHistoryChildOptions) ([]*model.Block, bool, error) {
+	start := time.Now()
+	result, resultVar1, err := s.Store.GetBlockHistoryNewestChildren(ctx, parentID, opts)
+	s.observe("GetBlockHistoryNewestChildren", start, err)
+	return result, resultVar1, err
+}
+
+func (s *TimerLayer) GetBlockSuiteDocByCardID(ctx context.Context, cardID string) (*model.BlockSuiteDoc, error) {
+	start := time.Now()
+	result, err := s.Store.GetBlockSuiteDocByCardID(ctx, cardID)
+	s.observe("GetBlockSuiteDocByCardID", start, err)
+	return result, err
+}
+
+func (s *TimerLayer) GetBlockSuiteDocInfoByCardID(ctx context.Context, cardID string) (*model.BlockSuiteDocInfo, error) {
+	start := time.Now()
+	result, err := s.Store.GetBlockSuiteDocInfoByCardID(ctx, cardID)
+	s.observe("GetBlockSuiteDocInfoByCardID", start, err)
+	return result, err
+}
+
+func (s *TimerLayer) GetBlockTombstones(ctx context.Context, opts model.QueryBlockChangesOptions) ([]*model.BlockTombstone, error) {
+	start := time.Now()
+	result, err := s.Store.GetBlockTombstones(ctx, opts)
+	s.observe("GetBlockTombstones", start, err)
+	return result, err
+}
+
+func (s *TimerLayer) GetBlocks(ctx context.Context, opts model.QueryBlocksOptions) ([]*model.Block, error) {
+	start := time.Now()
+	result, err := s.Store.GetBlocks(ctx, opts)
+	s.observe("GetBlocks", start, err)
+	return result, err
+}
+
+func (s *TimerLayer) GetBlocksByIDs(ctx context.Context, ids []string) ([]*model.Block, error) {
+	start := time.Now()
+	result, err := s.Store.GetBlocksByIDs(ctx, ids)
+	s.observe("GetBlocksByIDs", start, err)
+	return result, err
+}
+
+func (s *TimerLayer) GetBlocksComplianceHistory(ctx context.Context, opts model.QueryBlocksComplianceHistoryOptions) ([]*model.BlockHistory, bool, error) {
+	start := time.Now()
+	result, resultVar1, err := s.Store.GetBlocksComplianceHistory(ctx, opts)
+	s.observe("GetBlocksComplianceHistory", start, err)
+	return result, resultVar1, err
+}
+
+func (s *TimerLayer) GetBlocksForBoard(ctx context.Context, boardID string) ([]*model.Block, error) {
+	start := time.Now()
+	result, err := s.Store.GetBlocksForBoard(ctx, boardID)
+	s.observe("GetBlocksForBoard", start, err)
+	return result, err
+}
+
+func (s *TimerLayer) GetBlocksWithParent(ctx context.Context, boardID string, parentID string) ([]*model.Block, error) {
+	start := time.Now()
+	result, err := s.Store.GetBlocksWithParent(ctx, boardID, parentID)
+	s.observe("GetBlocksWithParent", start, err)
+	return result, err
+}
+
+func (s *TimerLayer) GetBlocksWithParentAndType(ctx context.Context, boardID string, parentID string, blockType string) ([]*model.Block, error) {
+	start := time.Now()
+	result, err := s.Store.GetBlocksWithParentAndType(ctx, boardID, parentID, blockType)
+	s.observe("GetBlocksWithParentAndType", start, err)
+	return result, err
+}
+
+func (s *TimerLayer) GetBlocksWithType(ctx context.Context, boardID string, blockType string) ([]*model.Block, error) {
+	start := time.Now()
+	result, err := s.Store.GetBlocksWithType(ctx, boardID, blockType)
+	s.observe("GetBlocksWithType", start, err)
+	return result, err
+}
+
+func (s *TimerLayer) GetBoard(ctx context.Context, id string) (*model.Board, error) {
+	start := time.Now()
+	result, err := s.Store.GetBoard(ctx, id)
+	s.observe("GetBoard", start, err)
+	return result, err
+}
+
+func (s *TimerLayer) GetBoardActivity(ctx context.Context, opts model.StatisticsOptions) ([]*model.BoardActivity, error) {
+	start := time.Now()
+	result, err := s.Store.GetBoardActivity(ctx, opts)
+	s.observe("GetBoardActivity", start, err)
+	return result, err
+}
+
+func (s *TimerLayer) GetBoardAndCard(ctx context.Context, block *model.Block) (*model.Board, *model.Block, error) {
+	start := time.Now()
+	result, resultVar1, err := s.Store.GetBoardAndCard(ctx, block)
+	s.observe("GetBoardAndCard", start, err)
+	return result, resultVar1, err
+}
+
+func (s *TimerLayer) GetBoardAndCardByID(ctx context.Context, blockID string) (*model.Board, *model.Block, error) {
+	start := time.Now()
+	result, resultVar1, err := s.Store.GetBoardAndCardByID(ctx, blockID)
+	s.observe("GetBoardAndCardByID", start, err)
+	return result, resultVar1, err
+}
+
+func (s *TimerLayer) GetBoardCount(ctx context.Context, includeDeleted bool) (int64, error) {
+	start := time.Now()
+	result, err := s.Store.GetBoardCount(ctx, includeDeleted)
+	s.observe("GetBoardCount", start, err)
+	return result, err
+}
+
+func (s *TimerLayer) GetBoardFileUsage(ctx context.Context, boardID string) (*model.FileUsage, error) {
+	start := time.Now()
+	result, err := s.Store.GetBoardFileUsage(ctx, boardID)
+	s.observe("GetBoardFileUsage", start, err)
+	return result, err
+}
+
+func (s *TimerLayer) GetBoardForm(ctx context.Context, boardID string) (*model.BoardForm, error) {
+	start := time.Now()
+	result, err := s.Store.GetBoardForm(ctx, boardID)
+	s.observe("GetBoardForm", start, err)
+	return result, err
+}
+
+func (s *TimerLayer) GetBoardHistory(ctx context.Context, boardID string, opts model.QueryBoardHistoryOptions) ([]*model.Board, error) {
+	start := time.Now()
+	result, err := s.Store.GetBoardHistory(ctx, boardID, opts)
+	s.observe("GetBoardHistory", start, err)
+	return result, err
+}
+
+func (s *TimerLayer) GetBoardMemberHistory(ctx context.Context, boardID string, userID string, limit uint64) ([]*model.BoardMemberHistoryEntry, error) {
+	start := time.Now()
+	result, err := s.Store.GetBoardMemberHistory(ctx, boardID, userID, limit)
+	s.observe("GetBoardMemberHistory", start, err)
+	return result, err
+}
+
+func (s *TimerLayer) GetBoardRestorePlan(ctx context.Context, boardID string, at int64) (*model.BoardRestorePlan, error) {
+	start := time.Now()
+	result, err := s.Store.GetBoardRestorePlan(ctx, boardID, at)
+	s.observe("GetBoardRestorePlan", start, err)
+	return result, err
+}
+
+func (s *TimerLayer) GetBoardsComplianceHistory(ctx context.Context, opts model.QueryBoardsComplianceHistoryOptions) ([]*model.BoardHistory, bool, error) {
+	start := time.Now()
+	result, resultVar1, err := s.Store.GetBoardsComplianceHistory(ctx, opts)
+	s.observe("GetBoardsComplianceHistory", start, err)
+	return result, resultVar1, err
+}
+
+func (s *TimerLayer) GetBoardsForCompliance(ctx context.Context, opts model.QueryBoardsForComplianceOptions) ([]*model.Board, bool, error) {
+	start := time.Now()
+	result, resultVar1, err := s.Store.GetBoardsForCompliance(ctx, opts)
+	s.observe("GetBoardsForCompliance", start, err)
+	return result, resultVar1, err
+}
+
+func (s *TimerLayer) GetBoardsForUserAndTeam(ctx context.Context, userID string, teamID string, includePublicBoards bool) ([]*model.Board, error) {
+	start := time.Now()
+	result, err := s.Store.GetBoardsForUserAndTeam(ctx, userID, teamID, includePublicBoards)
+	s.observe("GetBoardsForUserAndTeam", start, err)
+	return result, err
+}
+
+func (s *TimerLayer) GetBoardsInTeamByIds(ctx context.Context, boardIDs []string, teamID string) ([]*model.Board, error) {
+	start := time.Now()
+	result, err := s.Store.GetBoardsInTeamByIds(ctx, boardIDs, teamID)
+	s.observe("GetBoardsInTeamByIds", start, err)
+	return result, err
+}
+
+func (s *TimerLayer) GetCardLimitTimestamp(ctx context.Context) (int64, error) {
+	start := time.Now()
+	result, err := s.Store.GetCardLimitTimestamp(ctx)
+	s.observe("GetCardLimitTimestamp", start, err)
+	return result, err
+}
+
+func (s *TimerLayer) GetCardsCount(ctx context.Context) (int64, error) {
+	start := time.Now()
+	result, err := s.Store.GetCardsCount(ctx)
+	s.observe("GetCardsCount", start, err)
+	return result, err
+}
+
+func (s *TimerLayer) GetCategory(ctx context.Context, id string) (*model.Category, error) {
+	start := time.Now()
+	result, err := s.Store.GetCategory(ctx, id)
+	s.observe("GetCategory", start, err)
+	return result, err
+}
+
+func (s *TimerLayer) GetChangedBlocks(ctx context.Context, opts model.QueryBlockChangesOptions) ([]*model.Block, error) {
+	start := time.Now()
+	result, err := s.Store.GetChangedBlocks(ctx, opts)
+	s.observe("GetChangedBlocks", start, err)
+	return result, err
+}
+
+func (s *TimerLayer) GetChannel(ctx context.Context, teamID string, channelID string) (*mmModel.Channel, error) {
+	start := time.Now()
+	result, err := s.Store.GetChannel(ctx, teamID, channelID)
+	s.observe("GetChannel", start, err)
+	return result, err
+}
+
+func (s *TimerLayer) GetCommentReactions(ctx context.Context, blockID string) ([]*model.CommentReaction, error) {
+	start := time.Now()
+	result, err := s.Store.GetCommentReactions(ctx, blockID)
+	s.observe("GetCommentReactions", start, err)
+	return result, err
+}
+
+func (s *TimerLayer) GetCommentReactionsForBoard(ctx context.Context, boardID string) ([]*model.CommentReaction, error) {
+	start := time.Now()
+	result, err := s.Store.GetCommentReactionsForBoard(ctx, boardID)
+	s.observe("GetCommentReactionsForBoard", start, err)
+	return result, err
+}
+
+func (s *TimerLayer) GetDailyActivity(ctx context.Context, opts model.StatisticsOptions) (map[int64]model.ActivityCounts, error) {
+	start := time.Now()
+	result, err := s.Store.GetDailyActivity(ctx, opts)
+	s.observe("GetDailyActivity", start, err)
+	return result, err
+}
+
+func (s *TimerLayer) GetFileBoardIDs(ctx context.Context, fileIDs []string) (map[string]string, error) {
+	start := time.Now()
+	result, err := s.Store.GetFileBoardIDs(ctx, fileIDs)
+	s.observe("GetFileBoardIDs", start, err)
+	return result, err
+}
+
+func (s *TimerLayer) GetFileIDsReferencedByBlocks(ctx context.Context, fileIDs []string) (map[string]bool, error) {
+	start := time.Now()
+	result, err := s.Store.GetFileIDsReferencedByBlocks(ctx, fileIDs)
+	s.observe("GetFileIDsReferencedByBlocks", start, err)
+	return result, err
+}
+
+func (s *TimerLayer) GetFileInfo(ctx context.Context, id string) (*mmModel.FileInfo, error) {
+	start := time.Now()
+	result, err := s.Store.GetFileInfo(ctx, id)
+	s.observe("GetFileInfo", start, err)
+	return result, err
+}
+
+func (s *TimerLayer) GetFileInfosWithPathPrefix(ctx context.Context, prefix string, createdBefore int64) ([]*mmModel.FileInfo, error) {
+	start := time.Now()
+	result, err := s.Store.GetFileInfosWithPathPrefix(ctx, prefix, createdBefore)
+	s.observe("GetFileInfosWithPathPrefix", start, err)
+	return result, err
+}
+
+func (s *TimerLayer) GetFileScan(ctx context.Context, fileID string) (*model.FileScan, error) {
+	start := time.Now()
+	result, err := s.Store.GetFileScan(ctx, fileID)
+	s.observe("GetFileScan", start, err)
+	return result, err
+}
+
+func (s *TimerLayer) GetFileUsageByTeam(ctx context.Context) ([]*model.FileUsage, error) {
+	start := time.Now()
+	result, err := s.Store.GetFileUsageByTeam(ctx)
+	s.observe("GetFileUsageByTeam", start, err)
+	return result, err
+}
+
+func (s *TimerLayer) GetLicense(ctx context.Context) *mmModel.License {
+	start := time.Now()
+	result := s.Store.GetLicense(ctx)
+	s.observe("GetLicense", start, nil)
+	return result
+}
+
+func (s *TimerLayer) GetMemberForBoard(ctx context.Context, boardID string, userID string) (*model.BoardMember, error) {
+	start := time.Now()
+	result, err := s.Store.GetMemberForBoard(ctx, boardID, userID)
+	s.observe("GetMemberForBoard", start, err)
+	return result, err
+}
+
+func (s *TimerLayer) GetMembersForBoard(ctx context.Context, boardID string) ([]*model.BoardMember, error) {
+	start := time.Now()
+	result, err := s.Store.GetMembersForBoard(ctx, boardID)
+	s.observe("GetMembersForBoard", start, err)
+	return result, err
+}
+
+func (s *TimerLayer) GetMembersForUser(ctx context.Context, userID string) ([]*model.BoardMember, error) {
+	start := time.Now()
+	result, err := s.Store.GetMembersForUser(ctx, userID)
+	s.observe("GetMembersForUser", start, err)
+	return result, err
+}
+
+func (s *TimerLayer) GetNextNotificationHint(ctx context.Context, remove bool) (*model.NotificationHint, error) {
+	start := time.Now()
+	result, err := s.Store.GetNextNotificationHint(ctx, remove)
+	s.observe("GetNextNotificationHint", start, err)
+	return result, err
+}
+
+func (s *TimerLayer) GetNotification(ctx context.Context, userID string, notificationID string) (*model.Notification, error) {
+	start := time.Now()
+	result, err := s.Store.GetNotification(ctx, userID, notificationID)
+	s.observe("GetNotification", start, err)
+	return result, err
+}
+
+func (s *TimerLayer) GetNotificationHint(ctx context.Context, blockID string) (*model.NotificationHint, error) {
+	start := time.Now()
+	result, err := s.Store.GetNotificationHint(ctx, blockID)
+	s.observe("GetNotificationHint", start, err)
+	return result, err
+}
+
+func (s *TimerLayer) GetNotificationHintCount(ctx context.Context) (int, error) {
+	start := time.Now()
+	result, err := s.Store.GetNotificationHintCount(ctx)
+	s.observe("GetNotificationHintCount", start, err)
+	return result, err
+}
+
+func (s *TimerLayer) GetNotificationPreferences(ctx context.Context, userID string, boardID string) (*model.NotificationPreferences, error) {
+	start := time.Now()
+	result, err := s.Store.GetNotificationPreferences(ctx, userID, boardID)
+	s.observe("GetNotificationPreferences", start, err)
+	return result, err
+}
+
+func (s *TimerLayer) GetNotificationPreferencesForBoard(ctx context.Context, boardID string) ([]*model.NotificationPreferences, error) {
+	start := time.Now()
+	result, err := s.Store.GetNotificationPreferencesForBoard(ctx, boardID)
+	s.observe("GetNotificationPreferencesForBoard", start, err)
+	return result, err
+}
+
+func (s *TimerLayer) GetNotificationsForUser(ctx context.Context, userID string, opts model.QueryNotificationsOptions) ([]*model.Notification, error) {
+	start := time.Now()
+	result, err := s.Store.GetNotificationsForUser(ctx, userID, opts)
+	s.observe("GetNotificationsForUser", start, err)
+	return result, err
+}
+
+func (s *TimerLayer) GetRegisteredUserCount(ctx context.Context) (int, error) {
+	start := time.Now()
+	result, err := s.Store.GetRegisteredUserCount(ctx)
+	s.observe("GetRegisteredUserCount", start, err)
+	return result, err
+}
+
+func (s *TimerLayer) GetShareLink(ctx context.Context, linkID string) (*model.ShareLink, error) {
+	start := time.Now()
+	result, err := s.Store.GetShareLink(ctx, linkID)
+	s.observe("GetShareLink", start, err)
+	return result, err
+}
+
+func (s *TimerLayer) GetShareLinkByToken(ctx context.Context, boardID string, token string) (*model.ShareLink, error) {
+	start := time.Now()
+	result, err := s.Store.GetShareLinkByToken(ctx, boardID, token)
+	s.observe("GetShareLinkByToken", start, err)
+	return result, err
+}
+
+func (s *TimerLayer) GetShareLinksForBoard(ctx context.Context, boardID string) ([]*model.ShareLink, error) {
+	start := time.Now()
+	result, err := s.Store.GetShareLinksForBoard(ctx, boardID)
+	s.observe("GetShareLinksForBoard", start, err)
+	return result, err
+}
+
+func (s *TimerLayer) GetSharing(ctx context.Context, rootID string) (*model.Sharing, error) {
+	start := time.Now()
+	result, err := s.Store.GetSharing(ctx, rootID)
+	s.observe("GetSharing", start, err)
+	return result, err
+}
+
+func (s *TimerLayer) GetStorageQuota(ctx context.Context, scope model.StorageQuotaScope, scopeID string) (*model.StorageQuota, error) {
+	start := time.Now()
+	result, err := s.Store.GetStorageQuota(ctx, scope, scopeID)
+	s.observe("GetStorageQuota", start, err)
+	return result, err
+}
+
+func (s *TimerLayer) GetSubTree2(ctx context.Context, boardID string, blockID string, opts model.QuerySubtreeOptions) ([]*model.Block, error) {
+	start := time.Now()
+	result, err := s.Store.GetSubTree2(ctx, boardID, blockID, opts)
+	s.observe("GetSubTree2", start, err)
+	return result, err
+}
+
+func (s *TimerLayer) GetSubscribersCountForBlock(ctx context.Context, blockID string) (int, error) {
+	start := time.Now()
+	result, err := s.Store.GetSubscribersCountForBlock(ctx, blockID)
+	s.observe("GetSubscribersCountForBlock", start, err)
+	return result, err
+}
+
+func (s *TimerLayer) GetSubscribersForBlock(ctx context.Context, blockID string) ([]*model.Subscriber, error) {
+	start := time.Now()
+	result, err := s.Store.GetSubscribersForBlock(ctx, blockID)
+	s.observe("GetSubscribersForBlock", start, err)
+	return result, err
+}
+
+func (s *TimerLayer) GetSubscription(ctx context.Context, blockID string, subscriberID string) (*model.Subscription, error) {
+	start := time.Now()
+	result, err := s.Store.GetSubscription(ctx, blockID, subscriberID)
+	s.observe("GetSubscription", start, err)
+	return result, err
+}
+
+func (s *TimerLayer) GetSubscriptions(ctx context.Context, subscriberID string) ([]*model.Subscription, error) {
+	start := time.Now()
+	result, err := s.Store.GetSubscriptions(ctx, subscriberID)
+	s.observe("GetSubscriptions", start, err)
+	return result, err
+}
+
+func (s *TimerLayer) GetSystemSetting(ctx context.Context, key string) (string, error) {
+	start := time.Now()
+	result, err := s.Store.GetSystemSetting(ctx, key)
+	s.observe("GetSystemSetting", start, err)
+	return result, err
+}
+
+func (s *TimerLayer) GetSystemSettings(ctx context.Context) (map[string]string, error) {
+	start := time.Now()
+	result, err := s.Store.GetSystemSettings(ctx)
+	s.observe("GetSystemSettings", start, err)
+	return result, err
+}
+
+func (s *TimerLayer) GetTeam(ctx context.Context, ID string) (*model.Team, error) {
+	start := time.Now()
+	result, err := s.Store.GetTeam(ctx, ID)
+	s.observe("GetTeam", start, err)
+	return result, err
+}
+
+func (s *TimerLayer) GetTeamBoardAndCardCounts(ctx context.Context, teamID string) ([]*model.TeamStatistics, error) {
+	start := time.Now()
+	result, err := s.Store.GetTeamBoardAndCardCounts(ctx, teamID)
+	s.observe("GetTeamBoardAndCardCounts", start, err)
+	return result, err
+}
+
+func (s *TimerLayer) GetTeamCount(ctx context.Context) (int64, error) {
+	start := time.Now()
+	result, err := s.Store.GetTeamCount(ctx)
+	s.observe("GetTeamCount", start, err)
+	return result, err
+}
+
+func (s *TimerLayer) GetTeamFileUsage(ctx context.Context, teamID string) (*model.FileUsage, error) {
+	start := time.Now()
+	result, err := s.Store.GetTeamFileUsage(ctx, teamID)
+	s.observe("GetTeamFileUsage", start, err)
+	return result, err
+}
+
+func (s *TimerLayer) GetTeamsForUser(ctx context.Context, userID string) ([]*model.Team, error) {
+	start := time.Now()
+	result, err := s.Store.GetTeamsForUser(ctx, userID)
+	s.observe("GetTeamsForUser", start, err)
+	return result, err
+}
+
+func (s *TimerLayer) GetTemplateBoards(ctx context.Context, teamID string, userID string) ([]*model.Board, error) {
+	start := time.Now()
+	result, err := s.Store.GetTemplateBoards(ctx, teamID, userID)
+	s.observe("GetTemplateBoards", start, err)
+	return result, err
+}
+
+func (s *TimerLayer) GetUnreadNotificationCount(ctx context.Context, userID string) (int64, error) {
+	start := time.Now()
+	result, err := s.Store.GetUnreadNotificationCount(ctx, userID)
+	s.observe("GetUnreadNotificationCount", start, err)
+	return result, err
+}
+
+func (s *TimerLayer) GetUsedCardsCount(ctx context.Context) (int64, error) {
+	start := time.Now()
+	result, err := s.Store.GetUsedCardsCount(ctx)
+	s.observe("GetUsedCardsCount", start, err)
+	return result, err
+}
+
+func (s *TimerLayer) GetUserByEmail(ctx context.Context, email string) (*model.User, error) {
+	start := time.Now()
+	result, err := s.Store.GetUserByEmail(ctx, email)
+	s.observe("GetUserByEmail", start, err)
+	return result, err
+}
+
+func (s *TimerLayer) GetUserByID(ctx context.Context, userID string) (*model.User, error) {
+	start := time.Now()
+	result, err := s.Store.GetUserByID(ctx, userID)
+	s.observe("GetUserByID", start, err)
+	return result, err
+}
+
+func (s *TimerLayer) GetUserByUsername(ctx context.Context, username string) (*model.User, error) {
+	start := time.Now()
+	result, err := s.Store.GetUserByUsername(ctx, username)
+	s.observe("GetUserByUsername", start, err)
+	return result, err
+}
+
+func (s *TimerLayer) GetUserCategories(ctx context.Context, userID string, teamID string) ([]model.Category, error) {
+	start := time.Now()
+	result, err := s.Store.GetUserCategories(ctx, userID, teamID)
+	s.observe("GetUserCategories", start, err)
+	return result, err
+}
+
+func (s *TimerLayer) GetUserCategoryBoards(ctx context.Context, userID string, teamID string) ([]model.CategoryBoards, error) {
+	start := time.Now()
+	result, err := s.Store.GetUserCategoryBoards(ctx, userID, teamID)
+	s.observe("GetUserCategoryBoards", start, err)
+	return result, err
+}
+
+func (s *TimerLayer) GetUserPreferences(ctx context.Context, userID string) (mmModel.Preferences, error) {
+	start := time.Now()
+	result, err := s.Store.GetUserPreferences(ctx, userID)
+	s.observe("GetUserPreferences", start, err)
+	return result, err
+}
+
+func (s *TimerLayer) GetUserTimezone(ctx context.Context, userID string) (string, error) {
+	start := time.Now()
+	result, err := s.Store.GetUserTimezone(ctx, userID)
+	s.observe("GetUserTimezone", start, err)
+	return result, err
+}
+
+func (s *TimerLayer) GetUsersByTeam(ctx context.Context, teamID string, asGuestID string, showEmail bool, showName bool) ([]*model.User, error) {
+	start := time.Now()
+	result, err := s.Store.GetUsersByTeam(ctx, teamID, asGuestID, showEmail, showName)
+	s.observe("GetUsersByTeam", start, err)
+	return result, err
+}
+
+func (s *TimerLayer) GetUsersList(ctx context.Context, userIDs []string, showEmail bool, showName bool) ([]*model.User, error) {
+	start := time.Now()
+	result, err := s.Store.GetUsersList(ctx, userIDs, showEmail, showName)
+	s.observe("GetUsersList", start, err)
+	return result, err
+}
+
+func (s *TimerLayer) InsertAuditEntry(ctx context.Context, entry *model.AuditEntry) error {
+	start := time.Now()
+	err := s.Store.InsertAuditEntry(ctx, entry)
+	s.observe("InsertAuditEntry", start, err)
+	return err
+}
+
+func (s *TimerLayer) InsertBlock(ctx context.Context, block *model.Block, userID string) error {
+	start := time.Now()
+	err := s.Store.InsertBlock(ctx, block, userID)
+	s.observe("InsertBlock", start, err)
+	return err
+}
+
+func (s *TimerLayer) InsertBlocks(ctx context.Context, blocks []*model.Block, userID string) error {
+	start := time.Now()
+	err := s.Store.InsertBlocks(ctx, blocks, userID)
+	s.observe("InsertBlocks", start, err)
+	return err
+}
+
+func (s *TimerLayer) InsertBoard(ctx context.Context, board *model.Board, userID string) (*model.Board, error) {
+	start := time.Now()
+	result, err := s.Store.InsertBoard(ctx, board, userID)
+	s.observe("InsertBoard", start, err)
+	return result, err
+}
+
+func (s *TimerLayer) InsertBoardWithAdmin(ctx context.Context, board *model.Board, userID string) (*model.Board, *model.BoardMember, error) {
+	start := time.Now()
+	result, resultVar1, err := s.Store.InsertBoardWithAdmin(ctx, board, userID)
+	s.observe("InsertBoardWithAdmin", start, err)
+	return result, resultVar1, err
+}
+
+func (s *TimerLayer) MarkAllNotificationsRead(ctx context.Context, userID string) error {
+	start := time.Now()
+	err := s.Store.MarkAllNotificationsRead(ctx, userID)
+	s.observe("MarkAllNotificationsRead", start, err)
+	return err
+}
+
+func (s *TimerLayer) MarkNotificationRead(ctx context.Context, userID string, notificationID string) error {
+	start := time.Now()
+	err := s.Store.MarkNotificationRead(ctx, userID, notificationID)
+	s.observe("MarkNotificationRead", start, err)
+	return err
+}
+
+func (s *TimerLayer) PatchBlock(ctx context.Context, blockID string, blockPatch *model.BlockPatch, userID string) error {
+	start := time.Now()
+	err := s.Store.PatchBlock(ctx, blockID, blockPatch, userID)
+	s.observe("PatchBlock", start, err)
+	return err
+}
+
+func (s *TimerLayer) PatchBlocks(ctx context.Context, blockPatches *model.BlockPatchBatch, userID string) error {
+	start := time.Now()
+	err := s.Store.PatchBlocks(ctx, blockPatches, userID)
+	s.observe("PatchBlocks", start, err)
+	return err
+}
+
+func (s *TimerLayer) PatchBoard(ctx context.Context, boardID string, boardPatch *model.BoardPatch, userID string) (*model.Board, error) {
+	start := time.Now()
+	result, err := s.Store.PatchBoard(ctx, boardID, boardPatch, userID)
+	s.observe("PatchBoard", start, err)
+	return result, err
+}
+
+func (s *TimerLayer) PatchBoardsAndBlocks(ctx context.Context, pbab *model.PatchBoardsAndBlocks, userID string) (*model.BoardsAndBlocks, error) {
+	start := time.Now()
+	result, err := s.Store.PatchBoardsAndBlocks(ctx, pbab, userID)
+	s.observe("PatchBoardsAndBlocks", start, err)
+	return result, err
+}
+
+func (s *TimerLayer) PatchUserPreferences(ctx context.Context, userID string, patch model.UserPreferencesPatch) (mmModel.Preferences, error) {
+	start := time.Now()
+	result, err := s.Store.PatchUserPreferences(ctx, userID, patch)
+	s.observe("PatchUserPreferences", start, err)
+	return result, err
+}
+
+func (s *TimerLayer) PostMessage(ctx context.Context, message string, postType string, channelID string) error {
+	start := time.Now()
+	err := s.Store.PostMessage(ctx, message, postType, channelID)
+	s.observe("PostMessage", start, err)
+	return err
+}
+
+func (s *TimerLayer) RemoveDefaultTemplates(ctx context.Context, boards []*model.Board) error {
+	start := time.Now()
+	err := s.Store.RemoveDefaultTemplates(ctx, boards)
+	s.observe("RemoveDefaultTemplates", start, err)
+	return err
+}
+
+func (s *TimerLayer) ReorderCategories(ctx context.Context, userID string, teamID string, newCategoryOrder []string) ([]string, error) {
+	start := time.Now()
+	result, err := s.Store.ReorderCategories(ctx, userID, teamID, newCategoryOrder)
+	s.observe("ReorderCategories", start, err)
+	return result, err
+}
+
+func (s *TimerLayer) ReorderCategoryBoards(ctx context.Context, categoryID string, newBoardsOrder []string) ([]string, error) {
+	start := time.Now()
+	result, err := s.Store.ReorderCategoryBoards(ctx, categoryID, newBoardsOrder)
+	s.observe("ReorderCategoryBoards", start, err)
+	return result, err
+}
+
+func (s *TimerLayer) ReserveFileUsage(ctx context.Context, teamID string, boardID string, bytes int64, teamQuota int64, boardQuota int64) error {
+	start := time.Now()
+	err := s.Store.ReserveFileUsage(ctx, teamID, boardID, bytes, teamQuota, boardQuota)
+	s.observe("ReserveFileUsage", start, err)
+	return err
+}
+
+func (s *TimerLayer) RestoreBoardToTime(ctx context.Context, boardID string, at int64, modifiedBy string) (*model.BoardRestorePlan, error) {
+	start := time.Now()
+	result, err := s.Store.RestoreBoardToTime(ctx, boardID, at, modifiedBy)
+	s.observe("RestoreBoardToTime", start, err)
+	return result, err
+}
+
+func (s *TimerLayer) RestoreFiles(ctx context.Context, boardID string, fileIDs []string) error {
+	start := time.Now()
+	err := s.Store.RestoreFiles(ctx, boardID, fileIDs)
+	s.observe("RestoreFiles", start, err)
+	return err
+}
+
+func (s *TimerLayer) RevokeShareLink(ctx context.Context, linkID string) error {
+	start := time.Now()
+	err := s.Store.RevokeShareLink(ctx, linkID)
+	s.observe("RevokeShareLink", start, err)
+	return err
+}
+
+func (s *TimerLayer) RunDataRetention(ctx context.Context, globalRetentionDate int64, batchSize int64) (int64, error) {
+	start := time.Now()
+	result, err := s.Store.RunDataRetention(ctx, globalRetentionDate, batchSize)
+	s.observe("RunDataRetention", start, err)
+	return result, err
+}
+
+func (s *TimerLayer) SaveFileBoard(ctx context.Context, fileID string, boardID string) error {
+	start := time.Now()
+	err := s.Store.SaveFileBoard(ctx, fileID, boardID)
+	s.observe("SaveFileBoard", start, err)
+	return err
+}
+
+func (s *TimerLayer) SaveFileInfo(ctx context.Context, fileInfo *mmModel.FileInfo) error {
+	start := time.Now()
+	err := s.Store.SaveFileInfo(ctx, fileInfo)
+	s.observe("SaveFileInfo", start, err)
+	return err
+}
+
+func (s *TimerLayer) SaveMember(ctx context.Context, bm *model.BoardMember) (*model.BoardMember, error) {
+	start := time.Now()
+	result, err := s.Store.SaveMember(ctx, bm)
+	s.observe("SaveMember", start, err)
+	return result, err
+}
+
+func (s *TimerLayer) SearchBoardsForUser(ctx context.Context, term string, searchField model.BoardSearchField, userID string, includePublicBoards bool) ([]*model.Board, error) {
+	start := time.Now()
+	result, err := s.Store.SearchBoardsForUser(ctx, term, searchField, userID, includePublicBoards)
+	s.observe("SearchBoardsForUser", start, err)
+	return result, err
+}
+
+func (s *TimerLayer) SearchBoardsForUserInTeam(ctx context.Context, teamID string, term string, userID string) ([]*model.Board, error) {
+	start := time.Now()
+	result, err := s.Store.SearchBoardsForUserInTeam(ctx, teamID, term, userID)
+	s.observe("SearchBoardsForUserInTeam", start, err)
+	return result, err
+}
+
+func (s *TimerLayer) SearchUserChannels(ctx context.Context, teamID string, userID string, query string) ([]*mmModel.Channel, error) {
+	start := time.Now()
+	result, err := s.Store.SearchUserChannels(ctx, teamID, userID, query)
+	s.observe("SearchUserChannels", start, err)
+	return result, err
+}
+
+func (s *TimerLayer) SearchUsersByTeam(ctx context.Context, teamID string, searchQuery string, asGuestID string, excludeBots bool, showEmail bool, showName bool) ([]*model.User, error) {
+	start := time.Now()
+	result, err := s.Store.SearchUsersByTeam(ctx, teamID, searchQuery, asGuestID, excludeBots, showEmail, showName)
+	s.observe("SearchUsersByTeam", start, err)
+	return result, err
+}
+
+func (s *TimerLayer) SendMessage(ctx context.Context, message string, postType string, receipts []string) error {
+	start := time.Now()
+	err := s.Store.SendMessage(ctx, message, postType, receipts)
+	s.observe("SendMessage", start, err)
+	return err
+}
+
+func (s *TimerLayer) SetBoardVisibility(ctx context.Context, userID string, categoryID string, boardID string, visible bool) error {
+	start := time.Now()
+	err := s.Store.SetBoardVisibility(ctx, userID, categoryID, boardID, visible)
+	s.observe("SetBoardVisibility", start, err)
+	return err
+}
+
+func (s *TimerLayer) SetSystemSetting(ctx context.Context, key string, value string) error {
+	start := time.Now()
+	err := s.Store.SetSystemSetting(ctx, key, value)
+	s.observe("SetSystemSetting", start, err)
+	return err
+}
+
+func (s *TimerLayer) SetSystemSettingIfAbsent(ctx context.Context, key string, value string) error {
+	start := time.Now()
+	err := s.Store.SetSystemSettingIfAbsent(ctx, key, value)
+	s.observe("SetSystemSettingIfAbsent", start, err)
+	return err
+}
+
+func (s *TimerLayer) UndeleteBlock(ctx context.Context, blockID string, modifiedBy string) error {
+	start := time.Now()
+	err := s.Store.UndeleteBlock(ctx, blockID, modifiedBy)
+	s.observe("UndeleteBlock", start, err)
+	return err
+}
+
+func (s *TimerLayer) UndeleteBoard(ctx context.Context, boardID string, modifiedBy string) error {
+	start := time.Now()
+	err := s.Store.UndeleteBoard(ctx, boardID, modifiedBy)
+	s.observe("UndeleteBoard", start, err)
+	return err
+}
+
+func (s *TimerLayer) UpdateCardLimitTimestamp(ctx context.Context, cardLimit int) (int64, error) {
+	start := time.Now()
+	result, err := s.Store.UpdateCardLimitTimestamp(ctx, cardLimit)
+	s.observe("UpdateCardLimitTimestamp", start, err)
+	return result, err
+}
+
+func (s *TimerLayer) UpdateCategory(ctx context.Context, category model.Category) error {
+	start := time.Now()
+	err := s.Store.UpdateCategory(ctx, category)
+	s.observe("UpdateCategory", start, err)
+	return err
+}
+
+func (s *TimerLayer) UpdateFileInfoPaths(ctx context.Context, fileInfo *mmModel.FileInfo) error {
+	start := time.Now()
+	err := s.Store.UpdateFileInfoPaths(ctx, fileInfo)
+	s.observe("UpdateFileInfoPaths", start, err)
+	return err
+}
+
+func (s *TimerLayer) UpdateShareLinkLastUsed(ctx context.Context, linkID string, lastUsedAt int64) error {
+	start := time.Now()
+	err := s.Store.UpdateShareLinkLastUsed(ctx, linkID, lastUsedAt)
+	s.observe("UpdateShareLinkLastUsed", start, err)
+	return err
+}
+
+func (s *TimerLayer) UpdateSubscribersNotifiedAt(ctx context.Context, blockID string, notifiedAt int64) error {
+	start := time.Now()
+	err := s.Store.UpdateSubscribersNotifiedAt(ctx, blockID, notifiedAt)
+	s.observe("UpdateSubscribersNotifiedAt", start, err)
+	return err
+}
+
+func (s *TimerLayer) UpsertBlockSuiteDoc(ctx context.Context, doc *model.BlockSuiteDoc) error {
+	start := time.Now()
+	err := s.Store.UpsertBlockSuiteDoc(ctx, doc)
+	s.observe("UpsertBlockSuiteDoc", start, err)
+	return err
+}
+
+func (s *TimerLayer) UpsertBoardForm(ctx context.Context, form *model.BoardForm) (*model.BoardForm, error) {
+	start := time.Now()
+	result, err := s.Store.UpsertBoardForm(ctx, form)
+	s.observe("UpsertBoardForm", start, err)
+	return result, err
+}
+
+func (s *TimerLayer) UpsertFileScan(ctx context.Context, scan *model.FileScan) error {
+	start := time.Now()
+	err := s.Store.UpsertFileScan(ctx, scan)
+	s.observe("UpsertFileScan", start, err)
+	return err
+}
+
+func (s *TimerLayer) UpsertNotificationHint(ctx context.Context, hint *model.NotificationHint, notificationFreq time.Duration) (*model.NotificationHint, error) {
+	start := time.Now()
+	result, err := s.Store.UpsertNotificationHint(ctx, hint, notificationFreq)
+	s.observe("UpsertNotificationHint", start, err)
+	return result, err
+}
+
+func (s *TimerLayer) UpsertNotificationPreferences(ctx context.Context, prefs *model.NotificationPreferences) (*model.NotificationPreferences, error) {
+	start := time.Now()
+	result, err := s.Store.UpsertNotificationPreferences(ctx, prefs)
+	s.observe("UpsertNotificationPreferences", start, err)
+	return result, err
+}
+
+func (s *TimerLayer) UpsertSharing(ctx context.Context, sharing model.Sharing) error {
+	start := time.Now()
+	err := s.Store.UpsertSharing(ctx, sharing)
+	s.observe("UpsertSharing", start, err)
+	return err
+}
+
+func (s *TimerLayer) UpsertStorageQuota(ctx context.Context, quota *model.StorageQuota) error {
+	start := time.Now()
+	err := s.Store.UpsertStorageQuota(ctx, quota)
+	s.observe("UpsertStorageQuota", start, err)
+	return err
+}
+
+func (s *TimerLayer) UpsertTeamSettings(ctx context.Context, team model.Team) error {
+	start := time.Now()
+	err := s.Store.UpsertTeamSettings(ctx, team)
+	s.observe("UpsertTeamSettings", start, err)
+	return err
+}
+
+func (s *TimerLayer) UpsertTeamSignupToken(ctx context.Context, team model.Team) error {
+	start := time.Now()
+	err := s.Store.UpsertTeamSignupToken(ctx, team)
+	s.observe("UpsertTeamSignupToken", start, err)
+	return err
+}
@@ -0,0 +1,1205 @@
+// Copyright (c) 2020-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+// Code generated by "make generate" from the Store interface
+// DO NOT EDIT
+
+// To add a method, create an entry in the Store interface and run
+// `make generate`
+
+package tracinglayer
+
+import (
+	"context"
+	"time"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/mattermost/mattermost-plugin-boards/server/model"
+	"github.com/mattermost/mattermost-plugin-boards/server/services/store"
+	"github.com/mattermost/mattermost-plugin-boards/server/services/tracing"
+
+	mmModel "github.com/mattermost/mattermost/server/public/model"
+)
+
+// TracingLayer is a store layer recording a span for each call to the methods of the store it
+// wraps.
+type TracingLayer struct {
+	store.Store
+}
+
+func New(childStore store.Store) *TracingLayer {
+	return &TracingLayer{
+		Store: childStore,
+	}
+}
+
+func (s *TracingLayer) startSpan(ctx context.Context, method string) (context.Context, trace.Span) {
+	return tracing.StartSpan(ctx, "store."+method,
+		attribute.String("db.system", s.Store.DBType()),
+		attribute.String("store.method", method),
+	)
+}
+
+func (s *TracingLayer) AddCommentReaction(ctx context.Context, reaction *model.CommentReaction) (*model.CommentReaction, error) {
+	ctx, span := s.startSpan(ctx, "AddCommentReaction")
+	result, err := s.Store.AddCommentReaction(ctx, reaction)
+	tracing.EndSpan(span, err)
+	return result, err
+}
+
+func (s *TracingLayer) AddFileUsage(ctx context.Context, teamID string, boardID string, bytes int64, fileCount int64) error {
+	ctx, span := s.startSpan(ctx, "AddFileUsage")
+	err := s.Store.AddFileUsage(ctx, teamID, boardID, bytes, fileCount)
+	tracing.EndSpan(span, err)
+	return err
+}
+
+func (s *TracingLayer) AddUpdateCategoryBoard(ctx context.Context, userID string, categoryID string, boardIDs []string) error {
+	ctx, span := s.startSpan(ctx, "AddUpdateCategoryBoard")
+	err := s.Store.AddUpdateCategoryBoard(ctx, userID, categoryID, boardIDs)
+	tracing.EndSpan(span, err)
+	return err
+}
+
+func (s *TracingLayer) CanSeeUser(ctx context.Context, seerID string, seenID string) (bool, error) {
+	ctx, span := s.startSpan(ctx, "CanSeeUser")
+	result, err := s.Store.CanSeeUser(ctx, seerID, seenID)
+	tracing.EndSpan(span, err)
+	return result, err
+}
+
+func (s *TracingLayer) CountFileInfosWithPath(ctx context.Context, path string) (int64, error) {
+	ctx, span := s.startSpan(ctx, "CountFileInfosWithPath")
+	result, err := s.Store.CountFileInfosWithPath(ctx, path)
+	tracing.EndSpan(span, err)
+	return result, err
+}
+
+func (s *TracingLayer) CreateBoardsAndBlocks(ctx context.Context, bab *model.BoardsAndBlocks, userID string) (*model.BoardsAndBlocks, error) {
+	ctx, span := s.startSpan(ctx, "CreateBoardsAndBlocks")
+	result, err := s.Store.CreateBoardsAndBlocks(ctx, bab, userID)
+	tracing.EndSpan(span, err)
+	return result, err
+}
+
+func (s *TracingLayer) CreateBoardsAndBlocksWithAdmin(ctx context.Context, bab *model.BoardsAndBlocks, userID string) (*model.BoardsAndBlocks, []*model.BoardMember, error) {
+	ctx, span := s.startSpan(ctx, "CreateBoardsAndBlocksWithAdmin")
+	result, resultVar1, err := s.Store.CreateBoardsAndBlocksWithAdmin(ctx, bab, userID)
+	tracing.EndSpan(span, err)
+	return result, resultVar1, err
+}
+
+func (s *TracingLayer) CreateCategory(ctx context.Context, category model.Category) error {
+	ctx, span := s.startSpan(ctx, "CreateCategory")
+	err := s.Store.CreateCategory(ctx, category)
+	tracing.EndSpan(span, err)
+	return err
+}
+
+func (s *TracingLayer) CreateNotification(ctx context.Context, notification *model.Notification) (*model.Notification, error) {
+	ctx, span := s.startSpan(ctx, "CreateNotification")
+	result, err := s.Store.CreateNotification(ctx, notification)
+	tracing.EndSpan(span, err)
+	return result, err
+}
+
+func (s *TracingLayer) CreateShareLink(ctx context.Context, link *model.ShareLink) (*model.ShareLink, error) {
+	ctx, span := s.startSpan(ctx, "CreateShareLink")
+	result, err := s.Store.CreateShareLink(ctx, link)
+	tracing.EndSpan(span, err)
+	return result, err
+}
+
+func (s *TracingLayer) CreateSubscription(ctx context.Context, sub *model.Subscription) (*model.Subscription, error) {
+	ctx, span := s.startSpan(ctx, "CreateSubscription")
+	result, err := s.Store.CreateSubscription(ctx, sub)
+	tracing.EndSpan(span, err)
+	return result, err
+}
+
+func (s *TracingLayer) DeleteAuditEntriesBefore(ctx context.Context, before int64) (int64, error) {
+	ctx, span := s.startSpan(ctx, "DeleteAuditEntriesBefore")
+	result, err := s.Store.DeleteAuditEntriesBefore(ctx, before)
+	tracing.EndSpan(span, err)
+	return result, err
+}
+
+func (s *TracingLayer) DeleteBlock(ctx context.Context, blockID string, modifiedBy string) error {
+	ctx, span := s.startSpan(ctx, "DeleteBlock")
+	err := s.Store.DeleteBlock(ctx, blockID, modifiedBy)
+	tracing.EndSpan(span, err)
+	return err
+}
+
+func (s *TracingLayer) DeleteBlockRecord(ctx context.Context, blockID string, modifiedBy string) error {
+	ctx, span := s.startSpan(ctx, "DeleteBlockRecord")
+	err := s.Store.DeleteBlockRecord(ctx, blockID, modifiedBy)
+	tracing.EndSpan(span, err)
+	return err
+}
+
+func (s *TracingLayer) DeleteBlockSuiteDocByCardID(ctx context.Context, cardID string) error {
+	ctx, span := s.startSpan(ctx, "DeleteBlockSuiteDocByCardID")
+	err := s.Store.DeleteBlockSuiteDocByCardID(ctx, cardID)
+	tracing.EndSpan(span, err)
+	return err
+}
+
+func (s *TracingLayer) DeleteBoard(ctx context.Context, boardID string, userID string) error {
+	ctx, span := s.startSpan(ctx, "DeleteBoard")
+	err := s.Store.DeleteBoard(ctx, boardID, userID)
+	tracing.EndSpan(span, err)
+	return err
+}
+
+func (s *TracingLayer) DeleteBoardRecord(ctx context.Context, boardID string, modifiedBy string) error {
+	ctx, span := s.startSpan(ctx, "DeleteBoardRecord")
+	err := s.Store.DeleteBoardRecord(ctx, boardID, modifiedBy)
+	tracing.EndSpan(span, err)
+	return err
+}
+
+func (s *TracingLayer) DeleteBoardsAndBlocks(ctx context.Context, dbab *model.DeleteBoardsAndBlocks, userID string) error {
+	ctx, span := s.startSpan(ctx, "DeleteBoardsAndBlocks")
+	err := s.Store.DeleteBoardsAndBlocks(ctx, dbab, userID)
+	tracing.EndSpan(span, err)
+	return err
+}
+
+func (s *TracingLayer) DeleteCategory(ctx context.Context, categoryID string, userID string, teamID string) error {
+	ctx, span := s.startSpan(ctx, "DeleteCategory")
+	err := s.Store.DeleteCategory(ctx, categoryID, userID, teamID)
+	tracing.EndSpan(span, err)
+	return err
+}
+
+func (s *TracingLayer) DeleteCommentReaction(ctx context.Context, blockID string, userID string, emojiName string) error {
+	ctx, span := s.startSpan(ctx, "DeleteCommentReaction")
+	err := s.Store.DeleteCommentReaction(ctx, blockID, userID, emojiName)
+	tracing.EndSpan(span, err)
+	return err
+}
+
+func (s *TracingLayer) DeleteFileInfo(ctx context.Context, id string) error {
+	ctx, span := s.startSpan(ctx, "DeleteFileInfo")
+	err := s.Store.DeleteFileInfo(ctx, id)
+	tracing.EndSpan(span, err)
+	return err
+}
+
+func (s *TracingLayer) DeleteFiles(ctx context.Context, boardID string, fileIDs []string) error {
+	ctx, span := s.startSpan(ctx, "DeleteFiles")
+	err := s.Store.DeleteFiles(ctx, boardID, fileIDs)
+	tracing.EndSpan(span, err)
+	return err
+}
+
+func (s *TracingLayer) DeleteMember(ctx context.Context, boardID string, userID string) error {
+	ctx, span := s.startSpan(ctx, "DeleteMember")
+	err := s.Store.DeleteMember(ctx, boardID, userID)
+	tracing.EndSpan(span, err)
+	return err
+}
+
+func (s *TracingLayer) DeleteNotificationHint(ctx context.Context, blockID string) error {
+	ctx, span := s.startSpan(ctx, "DeleteNotificationHint")
+	err := s.Store.DeleteNotificationHint(ctx, blockID)
+	tracing.EndSpan(span, err)
+	return err
+}
+
+func (s *TracingLayer) DeleteNotificationPreferences(ctx context.Context, userID string, boardID string) error {
+	ctx, span := s.startSpan(ctx, "DeleteNotificationPreferences")
+	err := s.Store.DeleteNotificationPreferences(ctx, userID, boardID)
+	tracing.EndSpan(span, err)
+	return err
+}
+
+func (s *TracingLayer) DeleteStorageQuota(ctx context.Context, scope model.StorageQuotaScope, scopeID string) error {
+	ctx, span := s.startSpan(ctx, "DeleteStorageQuota")
+	err := s.Store.DeleteStorageQuota(ctx, scope, scopeID)
+	tracing.EndSpan(span, err)
+	return err
+}
+
+func (s *TracingLayer) DeleteSubscription(ctx context.Context, blockID string, subscriberID string) error {
+	ctx, span := s.startSpan(ctx, "DeleteSubscription")
+	err := s.Store.DeleteSubscription(ctx, blockID, subscriberID)
+	tracing.EndSpan(span, err)
+	return err
+}
+
+func (s *TracingLayer) DuplicateBlock(ctx context.Context, boardID string, blockID string, userID string, asTemplate bool) ([]*model.Block, error) {
+	ctx, span := s.startSpan(ctx, "DuplicateBlock")
+	result, err := s.Store.DuplicateBlock(ctx, boardID, blockID, userID, asTemplate)
+	tracing.EndSpan(span, err)
+	return result, err
+}
+
+func (s *TracingLayer) DuplicateBoard(ctx context.Context, boardID string, userID string, toTeam string, asTemplate bool) (*model.BoardsAndBlocks, []*model.BoardMember, error) {
+	ctx, span := s.startSpan(ctx, "DuplicateBoard")
+	result, resultVar1, err := s.Store.DuplicateBoard(ctx, boardID, userID, toTeam, asTemplate)
+	tracing.EndSpan(span, err)
+	return result, resultVar1, err
+}
+
+func (s *TracingLayer) GetActiveUserCount(ctx context.Context, updatedSecondsAgo int64) (int, error) {
+	ctx, span := s.startSpan(ctx, "GetActiveUserCount")
+	result, err := s.Store.GetActiveUserCount(ctx, updatedSecondsAgo)
+	tracing.EndSpan(span, err)
+	return result, err
+}
+
+func (s *TracingLayer) GetActiveUserCountsByTeam(ctx context.Context, teamID string, at int64) (model.ActiveUserCounts, map[string]model.ActiveUserCounts, error) {
+	ctx, span := s.startSpan(ctx, "GetActiveUserCountsByTeam")
+	result, resultVar1, err := s.Store.GetActiveUserCountsByTeam(ctx, teamID, at)
+	tracing.EndSpan(span, err)
+	return result, resultVar1, err
+}
+
+func (s *TracingLayer) GetAllTeams(ctx context.Context) ([]*model.Team, error) {
+	ctx, span := s.startSpan(ctx, "GetAllTeams")
+	result, err := s.Store.GetAllTeams(ctx)
+	tracing.EndSpan(span, err)
+	return result, err
+}
+
+func (s *TracingLayer) GetAuditEntries(ctx context.Context, opts model.QueryAuditEntriesOptions) ([]*model.AuditEntry, bool, error) {
+	ctx, span := s.startSpan(ctx, "GetAuditEntries")
+	result, resultVar1, err := s.Store.GetAuditEntries(ctx, opts)
+	tracing.EndSpan(span, err)
+	return result, resultVar1, err
+}
+
+func (s *TracingLayer) GetBlock(ctx context.Context, blockID string) (*model.Block, error) {
+	ctx, span := s.startSpan(ctx, "GetBlock")
+	result, err := s.Store.GetBlock(ctx, blockID)
+	tracing.EndSpan(span, err)
+	return result, err
+}
+
+func (s *TracingLayer) GetBlockCountsByType(ctx context.Context) (map[string]int64, error) {
+	ctx, span := s.startSpan(ctx, "GetBlockCountsByType")
+	result, err := s.Store.GetBlockCountsByType(ctx)
+	tracing.EndSpan(span, err)
+	return result, err
+}
+
+func (s *TracingLayer) GetBlockCountsByTypeWithOptions(ctx context.Context, opts model.StatisticsOptions) (map[string]int64, error) {
+	ctx, span := s.startSpan(ctx, "GetBlockCountsByTypeWithOptions")
+	result, err := s.Store.GetBlockCountsByTypeWithOptions(ctx, opts)
+	tracing.EndSpan(span, err)
+	return result, err
+}
+
+func (s *TracingLayer) GetBlockHistory(ctx context.Context, blockID string, opts model.QueryBlockHistoryOptions) ([]*model.Block, error) {
+	ctx, span := s.startSpan(ctx, "GetBlockHistory")
+	result, err := s.Store.GetBlockHistory(ctx, blockID, opts)
+	tracing.EndSpan(span, err)
+	return result, err
+}
+
+func (s *TracingLayer) GetBlockHistoryDescendants(ctx context.Context, boardID string, opts model.QueryBlockHistoryOptions) ([]*model.Block, error) {
+	ctx, span := s.startSpan(ctx, "GetBlockHistoryDescendants")
+	result, err := s.Store.GetBlockHistoryDescendants(ctx, boardID, opts)
+	tracing.EndSpan(span, err)
+	return result, err
+}
+
+func (s *TracingLayer) GetBlockHistoryNewestChildren(ctx context.Context, parentID string, opts model.QueryBlockHistoryChildOptions) ([]*model.Block, bool, error) {
+	ctx, span := s.startSpan(ctx, "GetBlockHistoryNewestChildren")
+	result, resultVar1, err := s.Store.GetBlockHistoryNewestChildren(ctx, parentID, opts)
+	tracing.EndSpan(span, err)
+	return result, resultVar1, err
+}
+
+func (s *TracingLayer) GetBlockSuiteDocByCardID(ctx context.Context, cardID string) (*model.BlockSuiteDoc, error) {
+	ctx, span := s.startSpan(ctx, "GetBlockSuiteDocByCardID")
+	result, err := s.Store.GetBlockSuiteDocByCardID(ctx, cardID)
+	tracing.EndSpan(span, err)
+	return result, err
+}
+
+func (s *TracingLayer) GetBlockSuiteDocInfoByCardID(ctx context.Context, cardID string) (*model.BlockSuiteDocInfo, error) {
+	ctx, span := s.startSpan(ctx, "GetBlockSuiteDocInfoByCardID")
+	result, err := s.Store.GetBlockSuiteDocInfoByCardID(ctx, cardID)
+	tracing.EndSpan(span, err)
+	return result, err
+}
+
+func (s *TracingLayer) GetBlockTombstones(ctx context.Context, opts model.QueryBlockChangesOptions) ([]*model.BlockTombstone, error) {
+	ctx, span := s.startSpan(ctx, "GetBlockTombstones")
+	result, err := s.Store.GetBlockTombstones(ctx, opts)
+	tracing.EndSpan(span, err)
+	return result, err
+}
+
+func (s *TracingLayer) GetBlocks(ctx context.Context, opts model.QueryBlocksOptions) ([]*model.Block, error) {
+	ctx, span := s.startSpan(ctx, "GetBlocks")
+	result, err := s.Store.GetBlocks(ctx, opts)
+	tracing.EndSpan(span, err)
+	return result, err
+}
+
+func (s *TracingLayer) GetBlocksByIDs(ctx context.Context, ids []string) ([]*model.Block, error) {
+	ctx, span := s.startSpan(ctx, "GetBlocksByIDs")
+	result, err := s.Store.GetBlocksByIDs(ctx, ids)
+	tracing.EndSpan(span, err)
+	return result, err
+}
+
+func (s *TracingLayer) GetBlocksComplianceHistory(ctx context.Context, opts model.QueryBlocksComplianceHistoryOptions) ([]*model.BlockHistory, bool, error) {
+	ctx, span := s.startSpan(ctx, "GetBlocksComplianceHistory")
+	result, resultVar1, err := s.Store.GetBlocksComplianceHistory(ctx, opts)
+	tracing.EndSpan(span, err)
+	return result, resultVar1, err
+}
+
+func (s *TracingLayer) GetBlocksForBoard(ctx context.Context, boardID string) ([]*model.Block, error) {
+	ctx, span := s.startSpan(ctx, "GetBlocksForBoard")
+	result, err := s.Store.GetBlocksForBoard(ctx, boardID)
+	tracing.EndSpan(span, err)
+	return result, err
+}
+
+func (s *TracingLayer) GetBlocksWithParent(ctx context.Context, boardID string, parentID string) ([]*model.Block, error) {
+	ctx, span := s.startSpan(ctx, "GetBlocksWithParent")
+	result, err := s.Store.GetBlocksWithParent(ctx, boardID, parentID)
+	tracing.EndSpan(span, err)
+	return result, err
+}
+
+func (s *TracingLayer) GetBlocksWithParentAndType(ctx context.Context, boardID string, parentID string, blockType string) ([]*model.Block, error) {
+	ctx, span := s.startSpan(ctx, "GetBlocksWithParentAndType")
+	result, err := s.Store.GetBlocksWithParentAndType(ctx, boardID, parentID, blockType)
+	tracing.EndSpan(span, err)
+	return result, err
+}
+
+func (s *TracingLayer) GetBlocksWithType(ctx context.Context, boardID string, blockType string) ([]*model.Block, error) {
+	ctx, span := s.startSpan(ctx, "GetBlocksWithType")
+	result, err := s.Store.GetBlocksWithType(ctx, boardID, blockType)
+	tracing.EndSpan(span, err)
+	return result, err
+}
+
+func (s *TracingLayer) GetBoard(ctx context.Context, id string) (*model.Board, error) {
+	ctx, span := s.startSpan(ctx, "GetBoard")
+	result, err := s.Store.GetBoard(ctx, id)
+	tracing.EndSpan(span, err)
+	return result, err
+}
+
+func (s *TracingLayer) GetBoardActivity(ctx context.Context, opts model.StatisticsOptions) ([]*model.BoardActivity, error) {
+	ctx, span := s.startSpan(ctx, "GetBoardActivity")
+	result, err := s.Store.GetBoardActivity(ctx, opts)
+	tracing.EndSpan(span, err)
+	return result, err
+}
+
+func (s *TracingLayer) GetBoardAndCard(ctx context.Context, block *model.Block) (*model.Board, *model.Block, error) {
+	ctx, span := s.startSpan(ctx, "GetBoardAndCard")
+	result, resultVar1, err := s.Store.GetBoardAndCard(ctx, block)
+	tracing.EndSpan(span, err)
+	return result, resultVar1, err
+}
+
+func (s *TracingLayer) GetBoardAndCardByID(ctx context.Context, blockID string) (*model.Board, *model.Block, error) {
+	ctx, span := s.startSpan(ctx, "GetBoardAndCardByID")
+	result, resultVar1, err := s.Store.GetBoardAndCardByID(ctx, blockID)
+	tracing.EndSpan(span, err)
+	return result, resultVar1, err
+}
+
+func (s *TracingLayer) GetBoardCount(ctx context.Context, includeDeleted bool) (int64, error) {
+	ctx, span := s.startSpan(ctx, "GetBoardCount")
+	result, err := s.Store.GetBoardCount(ctx, includeDeleted)
+	tracing.EndSpan(span, err)
+	return result, err
+}
+
+func (s *TracingLayer) GetBoardFileUsage(ctx context.Context, boardID string) (*model.FileUsage, error) {
+	ctx, span := s.startSpan(ctx, "GetBoardFileUsage")
+	result, err := s.Store.GetBoardFileUsage(ctx, boardID)
+	tracing.EndSpan(span, err)
+	return result, err
+}
+
+func (s *TracingLayer) GetBoardForm(ctx context.Context, boardID string) (*model.BoardForm, error) {
+	ctx, span := s.startSpan(ctx, "GetBoardForm")
+	result, err := s.Store.GetBoardForm(ctx, boardID)
+	tracing.EndSpan(span, err)
+	return result, err
+}
+
+func (s *TracingLayer) GetBoardHistory(ctx context.Context, boardID string, opts model.QueryBoardHistoryOptions) ([]*model.Board, error) {
+	ctx, span := s.startSpan(ctx, "GetBoardHistory")
+	result, err := s.Store.GetBoardHistory(ctx, boardID, opts)
+	tracing.EndSpan(span, err)
+	return result, err
+}
+
+func (s *TracingLayer) GetBoardMemberHistory(ctx context.Context, boardID string, userID string, limit uint64) ([]*model.BoardMemberHistoryEntry, error) {
+	ctx, span := s.startSpan(ctx, "GetBoardMemberHistory")
+	result, err := s.Store.GetBoardMemberHistory(ctx, boardID, userID, limit)
+	tracing.EndSpan(span, err)
+	return result, err
+}
+
+func (s *TracingLayer) GetBoardRestorePlan(ctx context.Context, boardID string, at int64) (*model.BoardRestorePlan, error) {
+	ctx, span := s.startSpan(ctx, "GetBoardRestorePlan")
+	result, err := s.Store.GetBoardRestorePlan(ctx, boardID, at)
+	tracing.EndSpan(span, err)
+	return result, err
+}
+
+func (s *TracingLayer) GetBoardsComplianceHistory(ctx context.Context, opts model.QueryBoardsComplianceHistoryOptions) ([]*model.BoardHistory, bool, error) {
+	ctx, span := s.startSpan(ctx, "GetBoardsComplianceHistory")
+	result, resultVar1, err := s.Store.GetBoardsComplianceHistory(ctx, opts)
+	tracing.EndSpan(span, err)
+	return result, resultVar1, err
+}
+
+func (s *TracingLayer) GetBoardsForCompliance(ctx context.Context, opts model.QueryBoardsForComplianceOptions) ([]*model.Board, bool, error) {
+	ctx, span := s.startSpan(ctx, "GetBoardsForCompliance")
+	result, resultVar1, err := s.Store.GetBoardsForCompliance(ctx, opts)
+	tracing.EndSpan(span, err)
+	return result, resultVar1, err
+}
+
+func (s *TracingLayer) GetBoardsForUserAndTeam(ctx context.Context, userID string, teamID string, includePublicBoards bool) ([]*model.Board, error) {
+	ctx, span := s.startSpan(ctx, "GetBoardsForUserAndTeam")
+	result, err := s.Store.GetBoardsForUserAndTeam(ctx, userID, teamID, includePublicBoards)
+	tracing.EndSpan(span, err)
+	return result, err
+}
+
+func (s *TracingLayer) GetBoardsInTeamByIds(ctx context.Context, boardIDs []string, teamID string) ([]*model.Board, error) {
+	ctx, span := s.startSpan(ctx, "GetBoardsInTeamByIds")
+	result, err := s.Store.GetBoardsInTeamByIds(ctx, boardIDs, teamID)
+	tracing.EndSpan(span, err)
+	return result, err
+}
+
+func (s *TracingLayer) GetCardLimitTimestamp(ctx context.Context) (int64, error) {
+	ctx, span := s.startSpan(ctx, "GetCardLimitTimestamp")
+	result, err := s.Store.GetCardLimitTimestamp(ctx)
+	tracing.EndSpan(span, err)
+	return result, err
+}
+
+func (s *TracingLayer) GetCardsCount(ctx context.Context) (int64, error) {
+	ctx, span := s.startSpan(ctx, "GetCardsCount")
+	result, err := s.Store.GetCardsCount(ctx)
+	tracing.EndSpan(span, err)
+	return result, err
+}
+
+func (s *TracingLayer) GetCategory(ctx context.Context, id string) (*model.Category, error) {
+	ctx, span := s.startSpan(ctx, "GetCategory")
+	result, err := s.Store.GetCategory(ctx, id)
+	tracing.EndSpan(span, err)
+	return result, err
+}
+
+func (s *TracingLayer) GetChangedBlocks(ctx context.Context, opts model.QueryBlockChangesOptions) ([]*model.Block, error) {
+	ctx, span := s.startSpan(ctx, "GetChangedBlocks")
+	result, err := s.Store.GetChangedBlocks(ctx, opts)
+	tracing.EndSpan(span, err)
+	return result, err
+}
+
+func (s *TracingLayer) GetChannel(ctx context.Context, teamID string, channelID string) (*mmModel.Channel, error) {
+	ctx, span := s.startSpan(ctx, "GetChannel")
+	result, err := s.Store.GetChannel(ctx, teamID, channelID)
+	tracing.EndSpan(span, err)
+	return result, err
+}
+
+func (s *TracingLayer) GetCommentReactions(ctx context.Context, blockID string) ([]*model.CommentReaction, error) {
+	ctx, span := s.startSpan(ctx, "GetCommentReactions")
+	result, err := s.Store.GetCommentReactions(ctx, blockID)
+	tracing.EndSpan(span, err)
+	return result, err
+}
+
+func (s *TracingLayer) GetCommentReactionsForBoard(ctx context.Context, boardID string) ([]*model.CommentReaction, error) {
+	ctx, span := s.startSpan(ctx, "GetCommentReactionsForBoard")
+	result, err := s.Store.GetCommentReactionsForBoard(ctx, boardID)
+	tracing.EndSpan(span, err)
+	return result, err
+}
+
+func (s *TracingLayer) GetDailyActivity(ctx context.Context, opts model.StatisticsOptions) (map[int64]model.ActivityCounts, error) {
+	ctx, span := s.startSpan(ctx, "GetDailyActivity")
+	result, err := s.Store.GetDailyActivity(ctx, opts)
+	tracing.EndSpan(span, err)
+	return result, err
+}
+
+func (s *TracingLayer) GetFileBoardIDs(ctx context.Context, fileIDs []string) (map[string]string, error) {
+	ctx, span := s.startSpan(ctx, "GetFileBoardIDs")
+	result, err := s.Store.GetFileBoardIDs(ctx, fileIDs)
+	tracing.EndSpan(span, err)
+	return result, err
+}
+
+func (s *TracingLayer) GetFileIDsReferencedByBlocks(ctx context.Context, fileIDs []string) (map[string]bool, error) {
+	ctx, span := s.startSpan(ctx, "GetFileIDsReferencedByBlocks")
+	result, err := s.Store.GetFileIDsReferencedByBlocks(ctx, fileIDs)
+	tracing.EndSpan(span, err)
+	return result, err
+}
+
+func (s *TracingLayer) GetFileInfo(ctx context.Context, id string) (*mmModel.FileInfo, error) {
+	ctx, span := s.startSpan(ctx, "GetFileInfo")
+	result, err := s.Store.GetFileInfo(ctx, id)
+	tracing.EndSpan(span, err)
+	return result, err
+}
+
+func (s *TracingLayer) GetFileInfosWithPathPrefix(ctx context.Context, prefix string, createdBefore int64) ([]*mmModel.FileInfo, error) {
+	ctx, span := s.startSpan(ctx, "GetFileInfosWithPathPrefix")
+	result, err := s.Store.GetFileInfosWithPathPrefix(ctx, prefix, createdBefore)
+	tracing.EndSpan(span, err)
+	return result, err
+}
+
+func (s *TracingLayer) GetFileScan(ctx context.Context, fileID string) (*model.FileScan, error) {
+	ctx, span := s.startSpan(ctx, "GetFileScan")
+	result, err := s.Store.GetFileScan(ctx, fileID)
+	tracing.EndSpan(span, err)
+	return result, err
+}
+
+func (s *TracingLayer) GetFileUsageByTeam(ctx context.Context) ([]*model.FileUsage, error) {
+	ctx, span := s.startSpan(ctx, "GetFileUsageByTeam")
+	result, err := s.Store.GetFileUsageByTeam(ctx)
+	tracing.EndSpan(span, err)
+	return result, err
+}
+
+func (s *TracingLayer) GetLicense(ctx context.Context) *mmModel.License {
+	ctx, span := s.startSpan(ctx, "GetLicense")
+	result := s.Store.GetLicense(ctx)
+	tracing.EndSpan(span, nil)
+	return result
+}
+
+func (s *TracingLayer) GetMemberForBoard(ctx context.Context, boardID string, userID string) (*model.BoardMember, error) {
+	ctx, span := s.startSpan(ctx, "GetMemberForBoard")
+	result, err := s.Store.GetMemberForBoard(ctx, boardID, userID)
+	tracing.EndSpan(span, err)
+	return result, err
+}
+
+func (s *TracingLayer) GetMembersForBoard(ctx context.Context, boardID string) ([]*model.BoardMember, error) {
+	ctx, span := s.startSpan(ctx, "GetMembersForBoard")
+	result, err := s.Store.GetMembersForBoard(ctx, boardID)
+	tracing.EndSpan(span, err)
+	return result, err
+}
+
+func (s *TracingLayer) GetMembersForUser(ctx context.Context, userID string) ([]*model.BoardMember, error) {
+	ctx, span := s.startSpan(ctx, "GetMembersForUser")
+	result, err := s.Store.GetMembersForUser(ctx, userID)
+	tracing.EndSpan(span, err)
+	return result, err
+}
+
+func (s *TracingLayer) GetNextNotificationHint(ctx context.Context, remove bool) (*model.NotificationHint, error) {
+	ctx, span := s.startSpan(ctx, "GetNextNotificationHint")
+	result, err := s.Store.GetNextNotificationHint(ctx, remove)
+	tracing.EndSpan(span, err)
+	return result, err
+}
+
+func (s *TracingLayer) GetNotification(ctx context.Context, userID string, notificationID string) (*model.Notification, error) {
+	ctx, span := s.startSpan(ctx, "GetNotification")
+	result, err := s.Store.GetNotification(ctx, userID, notificationID)
+	tracing.EndSpan(span, err)
+	return result, err
+}
+
+func (s *TracingLayer) GetNotificationHint(ctx context.Context, blockID string) (*model.NotificationHint, error) {
+	ctx, span := s.startSpan(ctx, "GetNotificationHint")
+	result, err := s.Store.GetNotificationHint(ctx, blockID)
+	tracing.EndSpan(span, err)
+	return result, err
+}
+
+func (s *TracingLayer) GetNotificationHintCount(ctx context.Context) (int, error) {
+	ctx, span := s.startSpan(ctx, "GetNotificationHintCount")
+	result, err := s.Store.GetNotificationHintCount(ctx)
+	tracing.EndSpan(span, err)
+	return result, err
+}
+
+func (s *TracingLayer) GetNotificationPreferences(ctx context.Context, userID string, boardID string) (*model.NotificationPreferences, error) {
+	ctx, span := s.startSpan(ctx, "GetNotificationPreferences")
+	result, err := s.Store.GetNotificationPreferences(ctx, userID, boardID)
+	tracing.EndSpan(span, err)
+	return result, err
+}
+
+func (s *TracingLayer) GetNotificationPreferencesForBoard(ctx context.Context, boardID string) ([]*model.NotificationPreferences, error) {
+	ctx, span := s.startSpan(ctx, "GetNotificationPreferencesForBoard")
+	result, err := s.Store.GetNotificationPreferencesForBoard(ctx, boardID)
+	tracing.EndSpan(span, err)
+	return result, err
+}
+
+func (s *TracingLayer) GetNotificationsForUser(ctx context.Context, userID string, opts model.QueryNotificationsOptions) ([]*model.Notification, error) {
+	ctx, span := s.startSpan(ctx, "GetNotificationsForUser")
+	result, err := s.Store.GetNotificationsForUser(ctx, userID, opts)
+	tracing.EndSpan(span, err)
+	return result, err
+}
+
+func (s *TracingLayer) GetRegisteredUserCount(ctx context.Context) (int, error) {
+	ctx, span := s.startSpan(ctx, "GetRegisteredUserCount")
+	result, err := s.Store.GetRegisteredUserCount(ctx)
+	tracing.EndSpan(span, err)
+	return result, err
+}
+
+func (s *TracingLayer) GetShareLink(ctx context.Context, linkID string) (*model.ShareLink, error) {
+	ctx, span := s.startSpan(ctx, "GetShareLink")
+	result, err := s.Store.GetShareLink(ctx, linkID)
+	tracing.EndSpan(span, err)
+	return result, err
+}
+
+func (s *TracingLayer) GetShareLinkByToken(ctx context.Context, boardID string, token string) (*model.ShareLink, error) {
+	ctx, span := s.startSpan(ctx, "GetShareLinkByToken")
+	result, err := s.Store.GetShareLinkByToken(ctx, boardID, token)
+	tracing.EndSpan(span, err)
+	return result, err
+}
+
+func (s *TracingLayer) GetShareLinksForBoard(ctx context.Context, boardID string) ([]*model.ShareLink, error) {
+	ctx, span := s.startSpan(ctx, "GetShareLinksForBoard")
+	result, err := s.Store.GetShareLinksForBoard(ctx, boardID)
+	tracing.EndSpan(span, err)
+	return result, err
+}
+
+func (s *TracingLayer) GetSharing(ctx context.Context, rootID string) (*model.Sharing, error) {
+	ctx, span := s.startSpan(ctx, "GetSharing")
+	result, err := s.Store.GetSharing(ctx, rootID)
+	tracing.EndSpan(span, err)
+	return result, err
+}
+
+func (s *TracingLayer) GetStorageQuota(ctx context.Context, scope model.StorageQuotaScope, scopeID string) (*model.StorageQuota, error) {
+	ctx, span := s.startSpan(ctx, "GetStorageQuota")
+	result, err := s.Store.GetStorageQuota(ctx, scope, scopeID)
+	tracing.EndSpan(span, err)
+	return result, err
+}
+
+func (s *TracingLayer) GetSubTree2(ctx context.Context, boardID string, blockID string, opts model.QuerySubtreeOptions) ([]*model.Block, error) {
+	ctx, span := s.startSpan(ctx, "GetSubTree2")
+	result, err := s.Store.GetSubTree2(ctx, boardID, blockID, opts)
+	tracing.EndSpan(span, err)
+	return result, err
+}
+
+func (s *TracingLayer) GetSubscribersCountForBlock(ctx context.Context, blockID string) (int, error) {
+	ctx, span := s.startSpan(ctx, "GetSubscribersCountForBlock")
+	result, err := s.Store.GetSubscribersCountForBlock(ctx, blockID)
+	tracing.EndSpan(span, err)
+	return result, err
+}
+
+func (s *TracingLayer) GetSubscribersForBlock(ctx context.Context, blockID string) ([]*model.Subscriber, error) {
+	ctx, span := s.startSpan(ctx, "GetSubscribersForBlock")
+	result, err := s.Store.GetSubscribersForBlock(ctx, blockID)
+	tracing.EndSpan(span, err)
+	return result, err
+}
+
+func (s *TracingLayer) GetSubscription(ctx context.Context, blockID string, subscriberID string) (*model.Subscription, error) {
+	ctx, span := s.startSpan(ctx, "GetSubscription")
+	result, err := s.Store.GetSubscription(ctx, blockID, subscriberID)
+	tracing.EndSpan(span, err)
+	return result, err
+}
+
+func (s *TracingLayer) GetSubscriptions(ctx context.Context, subscriberID string) ([]*model.Subscription, error) {
+	ctx, span := s.startSpan(ctx, "GetSubscriptions")
+	result, err := s.Store.GetSubscriptions(ctx, subscriberID)
+	tracing.EndSpan(span, err)
+	return result, err
+}
+
+func (s *TracingLayer) GetSystemSetting(ctx context.Context, key string) (string, error) {
+	ctx, span := s.startSpan(ctx, "GetSystemSetting")
+	result, err := s.Store.GetSystemSetting(ctx, key)
+	tracing.EndSpan(span, err)
+	return result, err
+}
+
+func (s *TracingLayer) GetSystemSettings(ctx context.Context) (map[string]string, error) {
+	ctx, span := s.startSpan(ctx, "GetSystemSettings")
+	result, err := s.Store.GetSystemSettings(ctx)
+	tracing.EndSpan(span, err)
+	return result, err
+}
+
+func (s *TracingLayer) GetTeam(ctx context.Context, ID string) (*model.Team, error) {
+	ctx, span := s.startSpan(ctx, "GetTeam")
+	result, err := s.Store.GetTeam(ctx, ID)
+	tracing.EndSpan(span, err)
+	return result, err
+}
+
+func (s *TracingLayer) GetTeamBoardAndCardCounts(ctx context.Context, teamID string) ([]*model.TeamStatistics, error) {
+	ctx, span := s.startSpan(ctx, "GetTeamBoardAndCardCounts")
+	result, err := s.Store.GetTeamBoardAndCardCounts(ctx, teamID)
+	tracing.EndSpan(span, err)
+	return result, err
+}
+
+func (s *TracingLayer) GetTeamCount(ctx context.Context) (int64, error) {
+	ctx, span := s.startSpan(ctx, "GetTeamCount")
+	result, err := s.Store.GetTeamCount(ctx)
+	tracing.EndSpan(span, err)
+	return result, err
+}
+
+func (s *TracingLayer) GetTeamFileUsage(ctx context.Context, teamID string) (*model.FileUsage, error) {
+	ctx, span := s.startSpan(ctx, "GetTeamFileUsage")
+	result, err := s.Store.GetTeamFileUsage(ctx, teamID)
+	tracing.EndSpan(span, err)
+	return result, err
+}
+
+func (s *TracingLayer) GetTeamsForUser(ctx context.Context, userID string) ([]*model.Team, error) {
+	ctx, span := s.startSpan(ctx, "GetTeamsForUser")
+	result, err := s.Store.GetTeamsForUser(ctx, userID)
+	tracing.EndSpan(span, err)
+	return result, err
+}
+
+func (s *TracingLayer) GetTemplateBoards(ctx context.Context, teamID string, userID string) ([]*model.Board, error) {
+	ctx, span := s.startSpan(ctx, "GetTemplateBoards")
+	result, err := s.Store.GetTemplateBoards(ctx, teamID, userID)
+	tracing.EndSpan(span, err)
+	return result, err
+}
+
+func (s *TracingLayer) GetUnreadNotificationCount(ctx context.Context, userID string) (int64, error) {
+	ctx, span := s.startSpan(ctx, "GetUnreadNotificationCount")
+	result, err := s.Store.GetUnreadNotificationCount(ctx, userID)
+	tracing.EndSpan(span, err)
+	return result, err
+}
+
+func (s *TracingLayer) GetUsedCardsCount(ctx context.Context) (int64, error) {
+	ctx, span := s.startSpan(ctx, "GetUsedCardsCount")
+	result, err := s.Store.GetUsedCardsCount(ctx)
+	tracing.EndSpan(span, err)
+	return result, err
+}
+
+func (s *TracingLayer) GetUserByEmail(ctx context.Context, email string) (*model.User, error) {
+	ctx, span := s.startSpan(ctx, "GetUserByEmail")
+	result, err := s.Store.GetUserByEmail(ctx, email)
+	tracing.EndSpan(span, err)
+	return result, err
+}
+
+func (s *TracingLayer) GetUserByID(ctx context.Context, userID string) (*model.User, error) {
+	ctx, span := s.startSpan(ctx, "GetUserByID")
+	result, err := s.Store.GetUserByID(ctx, userID)
+	tracing.EndSpan(span, err)
+	return result, err
+}
+
+func (s *TracingLayer) GetUserByUsername(ctx context.Context, username string) (*model.User, error) {
+	ctx, span := s.startSpan(ctx, "GetUserByUsername")
+	result, err := s.Store.GetUserByUsername(ctx, username)
+	tracing.EndSpan(span, err)
+	return result, err
+}
+
+func (s *TracingLayer) GetUserCategories(ctx context.Context, userID string, teamID string) ([]model.Category, error) {
+	ctx, span := s.startSpan(ctx, "GetUserCategories")
+	result, err := s.Store.GetUserCategories(ctx, userID, teamID)
+	tracing.EndSpan(span, err)
+	return result, err
+}
+
+func (s *TracingLayer) GetUserCategoryBoards(ctx context.Context, userID string, teamID string) ([]model.CategoryBoards, error) {
+	ctx, span := s.startSpan(ctx, "GetUserCategoryBoards")
+	result, err := s.Store.GetUserCategoryBoards(ctx, userID, teamID)
+	tracing.EndSpan(span, err)
+	return result, err
+}
+
+func (s *TracingLayer) GetUserPreferences(ctx context.Context, userID string) (mmModel.Preferences, error) {
+	ctx, span := s.startSpan(ctx, "GetUserPreferences")
+	result, err := s.Store.GetUserPreferences(ctx, userID)
+	tracing.EndSpan(span, err)
+	return result, err
+}
+
+func (s *TracingLayer) GetUserTimezone(ctx context.Context, userID string) (string, error) {
+	ctx, span := s.startSpan(ctx, "GetUserTimezone")
+	result, err := s.Store.GetUserTimezone(ctx, userID)
+	tracing.EndSpan(span, err)
+	return result, err
+}
+
+func (s *TracingLayer) GetUsersByTeam(ctx context.Context, teamID string, asGuestID string, showEmail bool, showName bool) ([]*model.User, error) {
+	ctx, span := s.startSpan(ctx, "GetUsersByTeam")
+	result, err := s.Store.GetUsersByTeam(ctx, teamID, asGuestID, showEmail, showName)
+	tracing.EndSpan(span, err)
+	return result, err
+}
+
+func (s *TracingLayer) GetUsersList(ctx context.Context, userIDs []string, showEmail bool, showName bool) ([]*model.User, error) {
+	ctx, span := s.startSpan(ctx, "GetUsersList")
+	result, err := s.Store.GetUsersList(ctx, userIDs, showEmail, showName)
+	tracing.EndSpan(span, err)
+	return result, err
+}
+
+func (s *TracingLayer) InsertAuditEntry(ctx context.Context, entry *model.AuditEntry) error {
+	ctx, span := s.startSpan(ctx, "InsertAuditEntry")
+	err := s.Store.InsertAuditEntry(ctx, entry)
+	tracing.EndSpan(span, err)
+	return err
+}
+
+func (s *TracingLayer) InsertBlock(ctx context.Context, block *model.Block, userID string) error {
+	ctx, span := s.startSpan(ctx, "InsertBlock")
+	err := s.Store.InsertBlock(ctx, block, userID)
+	tracing.EndSpan(span, err)
+	return err
+}
+
+func (s *TracingLayer) InsertBlocks(ctx context.Context, blocks []*model.Block, userID string) error {
+	ctx, span := s.startSpan(ctx, "InsertBlocks")
+	err := s.Store.InsertBlocks(ctx, blocks, userID)
+	tracing.EndSpan(span, err)
+	return err
+}
+
+func (s *TracingLayer) InsertBoard(ctx context.Context, board *model.Board, userID string) (*model.Board, error) {
+	ctx, span := s.startSpan(ctx, "InsertBoard")
+	result, err := s.Store.InsertBoard(ctx, board, userID)
+	tracing.EndSpan(span, err)
+	return result, err
+}
+
+func (s *TracingLayer) InsertBoardWithAdmin(ctx context.Context, board *model.Board, userID string) (*model.Board, *model.BoardMember, error) {
+	ctx, span := s.startSpan(ctx, "InsertBoardWithAdmin")
+	result, resultVar1, err := s.Store.InsertBoardWithAdmin(ctx, board, userID)
+	tracing.EndSpan(span, err)
+	return result, resultVar1, err
+}
+
+func (s *TracingLayer) MarkAllNotificationsRead(ctx context.Context, userID string) error {
+	ctx, span := s.startSpan(ctx, "MarkAllNotificationsRead")
+	err := s.Store.MarkAllNotificationsRead(ctx, userID)
+	tracing.EndSpan(span, err)
+	return err
+}
+
+func (s *TracingLayer) MarkNotificationRead(ctx context.Context, userID string, notificationID string) error {
+	ctx, span := s.startSpan(ctx, "MarkNotificationRead")
+	err := s.Store.MarkNotificationRead(ctx, userID, notificationID)
+	tracing.EndSpan(span, err)
+	return err
+}
+
+func (s *TracingLayer) PatchBlock(ctx context.Context, blockID string, blockPatch *model.BlockPatch, userID string) error {
+	ctx, span := s.startSpan(ctx, "PatchBlock")
+	err := s.Store.PatchBlock(ctx, blockID, blockPatch, userID)
+	tracing.EndSpan(span, err)
+	return err
+}
+
+func (s *TracingLayer) PatchBlocks(ctx context.Context, blockPatches *model.BlockPatchBatch, userID string) error {
+	ctx, span := s.startSpan(ctx, "PatchBlocks")
+	err := s.Store.PatchBlocks(ctx, blockPatches, userID)
+	tracing.EndSpan(span, err)
+	return err
+}
+
+func (s *TracingLayer) PatchBoard(ctx context.Context, boardID string, boardPatch *model.BoardPatch, userID string) (*model.Board, error) {
+	ctx, span := s.startSpan(ctx, "PatchBoard")
+	result, err := s.Store.PatchBoard(ctx, boardID, boardPatch, userID)
+	tracing.EndSpan(span, err)
+	return result, err
+}
+
+func (s *TracingLayer) PatchBoardsAndBlocks(ctx context.Context, pbab *model.PatchBoardsAndBlocks, userID string) (*model.BoardsAndBlocks, error) {
+	ctx, span := s.startSpan(ctx, "PatchBoardsAndBlocks")
+	result, err := s.Store.PatchBoardsAndBlocks(ctx, pbab, userID)
+	tracing.EndSpan(span, err)
+	return result, err
+}
+
+func (s *TracingLayer) PatchUserPreferences(ctx context.Context, userID string, patch model.UserPreferencesPatch) (mmModel.Preferences, error) {
+	ctx, span := s.startSpan(ctx, "PatchUserPreferences")
+	result, err := s.Store.PatchUserPreferences(ctx, userID, patch)
+	tracing.EndSpan(span, err)
+	return result, err
+}
+
+func (s *TracingLayer) PostMessage(ctx context.Context, message string, postType string, channelID string) error {
+	ctx, span := s.startSpan(ctx, "PostMessage")
+	err := s.Store.PostMessage(ctx, message, postType, channelID)
+	tracing.EndSpan(span, err)
+	return err
+}
+
+func (s *TracingLayer) RemoveDefaultTemplates(ctx context.Context, boards []*model.Board) error {
+	ctx, span := s.startSpan(ctx, "RemoveDefaultTemplates")
+	err := s.Store.RemoveDefaultTemplates(ctx, boards)
+	tracing.EndSpan(span, err)
+	return err
+}
+
+func (s *TracingLayer) ReorderCategories(ctx context.Context, userID string, teamID string, newCategoryOrder []string) ([]string, error) {
+	ctx, span := s.startSpan(ctx, "ReorderCategories")
+	result, err := s.Store.ReorderCategories(ctx, userID, teamID, newCategoryOrder)
+	tracing.EndSpan(span, err)
+	return result, err
+}
+
+func (s *TracingLayer) ReorderCategoryBoards(ctx context.Context, categoryID string, newBoardsOrder []string) ([]string, error) {
+	ctx, span := s.startSpan(ctx, "ReorderCategoryBoards")
+	result, err := s.Store.ReorderCategoryBoards(ctx, categoryID, newBoardsOrder)
+	tracing.EndSpan(span, err)
+	return result, err
+}
+
+func (s *TracingLayer) ReserveFileUsage(ctx context.Context, teamID string, boardID string, bytes int64, teamQuota int64, boardQuota int64) error {
+	ctx, span := s.startSpan(ctx, "ReserveFileUsage")
+	err := s.Store.ReserveFileUsage(ctx, teamID, boardID, bytes, teamQuota, boardQuota)
+	tracing.EndSpan(span, err)
+	return err
+}
+
+func (s *TracingLayer) RestoreBoardToTime(ctx context.Context, boardID string, at int64, modifiedBy string) (*model.BoardRestorePlan, error) {
+	ctx, span := s.startSpan(ctx, "RestoreBoardToTime")
+	result, err := s.Store.RestoreBoardToTime(ctx, boardID, at, modifiedBy)
+	tracing.EndSpan(span, err)
+	return result, err
+}
+
+func (s *TracingLayer) RestoreFiles(ctx context.Context, boardID string, fileIDs []string) error {
+	ctx, span := s.startSpan(ctx, "RestoreFiles")
+	err := s.Store.RestoreFiles(ctx, boardID, fileIDs)
+	tracing.EndSpan(span, err)
+	return err
+}
+
+func (s *TracingLayer) RevokeShareLink(ctx context.Context, linkID string) error {
+	ctx, span := s.startSpan(ctx, "RevokeShareLink")
+	err := s.Store.RevokeShareLink(ctx, linkID)
+	tracing.EndSpan(span, err)
+	return err
+}
+
+func (s *TracingLayer) RunDataRetention(ctx context.Context, globalRetentionDate int64, batchSize int64) (int64, error) {
+	ctx, span := s.startSpan(ctx, "RunDataRetention")
+	result, err := s.Store.RunDataRetention(ctx, globalRetentionDate, batchSize)
+	tracing.EndSpan(span, err)
+	return result, err
+}
+
+func (s *TracingLayer) SaveFileBoard(ctx context.Context, fileID string, boardID string) error {
+	ctx, span := s.startSpan(ctx, "SaveFileBoard")
+	err := s.Store.SaveFileBoard(ctx, fileID, boardID)
+	tracing.EndSpan(span, err)
+	return err
+}
+
+func (s *TracingLayer) SaveFileInfo(ctx context.Context, fileInfo *mmModel.FileInfo) error {
+	ctx, span := s.startSpan(ctx, "SaveFileInfo")
+	err := s.Store.SaveFileInfo(ctx, fileInfo)
+	tracing.EndSpan(span, err)
+	return err
+}
+
+func (s *TracingLayer) SaveMember(ctx context.Context, bm *model.BoardMember) (*model.BoardMember, error) {
+	ctx, span := s.startSpan(ctx, "SaveMember")
+	result, err := s.Store.SaveMember(ctx, bm)
+	tracing.EndSpan(span, err)
+	return result, err
+}
+
+func (s *TracingLayer) SearchBoardsForUser(ctx context.Context, term string, searchField model.BoardSearchField, userID string, includePublicBoards bool) ([]*model.Board, error) {
+	ctx, span := s.startSpan(ctx, "SearchBoardsForUser")
+	result, err := s.Store.SearchBoardsForUser(ctx, term, searchField, userID, includePublicBoards)
+	tracing.EndSpan(span, err)
+	return result, err
+}
+
+func (s *TracingLayer) SearchBoardsForUserInTeam(ctx context.Context, teamID string, term string, userID string) ([]*model.Board, error) {
+	ctx, span := s.startSpan(ctx, "SearchBoardsForUserInTeam")
+	result, err := s.Store.SearchBoardsForUserInTeam(ctx, teamID, term, userID)
+	tracing.EndSpan(span, err)
+	return result, err
+}
+
+func (s *TracingLayer) SearchUserChannels(ctx context.Context, teamID string, userID string, query string) ([]*mmModel.Channel, error) {
+	ctx, span := s.startSpan(ctx, "SearchUserChannels")
+	result, err := s.Store.SearchUserChannels(ctx, teamID, userID, query)
+	tracing.EndSpan(span, err)
+	return result, err
+}
+
+func (s *TracingLayer) SearchUsersByTeam(ctx context.Context, teamID string, searchQuery string, asGuestID string, excludeBots bool, showEmail bool, showName bool) ([]*model.User, error) {
+	ctx, span := s.startSpan(ctx, "SearchUsersByTeam")
+	result, err := s.Store.SearchUsersByTeam(ctx, teamID, searchQuery, asGuestID, excludeBots, showEmail, showName)
+	tracing.EndSpan(span, err)
+	return result, err
+}
+
+func (s *TracingLayer) SendMessage(ctx context.Context, message string, postType string, receipts []string) error {
+	ctx, span := s.startSpan(ctx, "SendMessage")
+	err := s.Store.SendMessage(ctx, message, postType, receipts)
+	tracing.EndSpan(span, err)
+	return err
+}
+
+func (s *TracingLayer) SetBoardVisibility(ctx context.Context, userID string, categoryID string, boardID string, visible bool) error {
+	ctx, span := s.startSpan(ctx, "SetBoardVisibility")
+	err := s.Store.SetBoardVisibility(ctx, userID, categoryID, boardID, visible)
+	tracing.EndSpan(span, err)
+	return err
+}
+
+func (s *TracingLayer) SetSystemSetting(ctx context.Context, key string, value string) error {
+	ctx, span := s.startSpan(ctx, "SetSystemSetting")
+	err := s.Store.SetSystemSetting(ctx, key, value)
+	tracing.EndSpan(span, err)
+	return err
+}
+
+func (s *TracingLayer) SetSystemSettingIfAbsent(ctx context.Context, key string, value string) error {
+	ctx, span := s.startSpan(ctx, "SetSystemSettingIfAbsent")
+	err := s.Store.SetSystemSettingIfAbsent(ctx, key, value)
+	tracing.EndSpan(span, err)
+	return err
+}
+
+func (s *TracingLayer) UndeleteBlock(ctx context.Context, blockID string, modifiedBy string) error {
+	ctx, span := s.startSpan(ctx, "UndeleteBlock")
+	err := s.Store.UndeleteBlock(ctx, blockID, modifiedBy)
+	tracing.EndSpan(span, err)
+	return err
+}
+
+func (s *TracingLayer) UndeleteBoard(ctx context.Context, boardID string, modifiedBy string) error {
+	ctx, span := s.startSpan(ctx, "UndeleteBoard")
+	err := s.Store.UndeleteBoard(ctx, boardID, modifiedBy)
+	tracing.EndSpan(span, err)
+	return err
+}
+
+func (s *TracingLayer) UpdateCardLimitTimestamp(ctx context.Context, cardLimit int) (int64, error) {
+	ctx, span := s.startSpan(ctx, "UpdateCardLimitTimestamp")
+	result, err := s.Store.UpdateCardLimitTimestamp(ctx, cardLimit)
+	tracing.EndSpan(span, err)
+	return result, err
+}
+
+func (s *TracingLayer) UpdateCategory(ctx context.Context, category model.Category) error {
+	ctx, span := s.startSpan(ctx, "UpdateCategory")
+	err := s.Store.UpdateCategory(ctx, category)
+	tracing.EndSpan(span, err)
+	return err
+}
+
+func (s *TracingLayer) UpdateFileInfoPaths(ctx context.Context, fileInfo *mmModel.FileInfo) error {
+	ctx, span := s.startSpan(ctx, "UpdateFileInfoPaths")
+	err := s.Store.UpdateFileInfoPaths(ctx, fileInfo)
+	tracing.EndSpan(span, err)
+	return err
+}
+
+func (s *TracingLayer) UpdateShareLinkLastUsed(ctx context.Context, linkID string, lastUsedAt int64) error {
+	ctx, span := s.startSpan(ctx, "UpdateShareLinkLastUsed")
+	err := s.Store.UpdateShareLinkLastUsed(ctx, linkID, lastUsedAt)
+	tracing.EndSpan(span, err)
+	return err
+}
+
+func (s *TracingLayer) UpdateSubscribersNotifiedAt(ctx context.Context, blockID string, notifiedAt int64) error {
+	ctx, span := s.startSpan(ctx, "UpdateSubscribersNotifiedAt")
+	err := s.Store.UpdateSubscribersNotifiedAt(ctx, blockID, notifiedAt)
+	tracing.EndSpan(span, err)
+	return err
+}
+
+func (s *TracingLayer) UpsertBlockSuiteDoc(ctx context.Context, doc *model.BlockSuiteDoc) error {
+	ctx, span := s.startSpan(ctx, "UpsertBlockSuiteDoc")
+	err := s.Store.UpsertBlockSuiteDoc(ctx, doc)
+	tracing.EndSpan(span, err)
+	return err
+}
+
+func (s *TracingLayer) UpsertBoardForm(ctx context.Context, form *model.BoardForm) (*model.BoardForm, error) {
+	ctx, span := s.startSpan(ctx, "UpsertBoardForm")
+	result, err := s.Store.UpsertBoardForm(ctx, form)
+	tracing.EndSpan(span, err)
+	return result, err
+}
+
+func (s *TracingLayer) UpsertFileScan(ctx context.Context, scan *model.FileScan) error {
+	ctx, span := s.startSpan(ctx, "UpsertFileScan")
+	err := s.Store.UpsertFileScan(ctx, scan)
+	tracing.EndSpan(span, err)
+	return err
+}
+
+func (s *TracingLayer) UpsertNotificationHint(ctx context.Context, hint *model.NotificationHint, notificationFreq time.Duration) (*model.NotificationHint, error) {
+	ctx, span := s.startSpan(ctx, "UpsertNotificationHint")
+	result, err := s.Store.UpsertNotificationHint(ctx, hint, notificationFreq)
+	tracing.EndSpan(span, err)
+	return result, err
+}
+
+func (s *TracingLayer) UpsertNotificationPreferences(ctx context.Context, prefs *model.NotificationPreferences) (*model.NotificationPreferences, error) {
+	ctx, span := s.startSpan(ctx, "UpsertNotificationPreferences")
+	result, err := s.Store.UpsertNotificationPreferences(ctx, prefs)
+	tracing.EndSpan(span, err)
+	return result, err
+}
+
+func (s *TracingLayer) UpsertSharing(ctx context.Context, sharing model.Sharing) error {
+	ctx, span := s.startSpan(ctx, "UpsertSharing")
+	err := s.Store.UpsertSharing(ctx, sharing)
+	tracing.EndSpan(span, err)
+	return err
+}
+
+func (s *TracingLayer) UpsertStorageQuota(ctx context.Context, quota *model.StorageQuota) error {
+	ctx, span := s.startSpan(ctx, "UpsertStorageQuota")
+	err := s.Store.UpsertStorageQuota(ctx, quota)
+	tracing.EndSpan(span, err)
+	return err
+}
+
+func (s *TracingLayer) UpsertTeamSettings(ctx context.Context, team model.Team) error {
+	ctx, span := s.startSpan(ctx, "UpsertTeamSettings")
+	err := s.Store.UpsertTeamSettings(ctx, team)
+	tracing.EndSpan(span, err)
+	return err
+}
+
+func (s *TracingLayer) UpsertTeamSignupToken(ctx context.Context, team model.Team) error {
+	ctx, span := s.startSpan(ctx, "UpsertTeamSignupToken")
+	err := s.Store.UpsertTeamSignupToken(ctx, team)
+	tracing.EndSpan(span, err)
+	return err
+}
@@ -0,0 +1,157 @@
+// Copyright (c) 2020-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package tracing
+
+import (
+	"context"
+	"fmt"
+	"sync/atomic"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracehttp"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	semconv "go.opentelemetry.io/otel/semconv/v1.26.0"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/mattermost/mattermost-plugin-boards/server/services/config"
+
+	"github.com/mattermost/mattermost/server/public/shared/mlog"
+)
+
+const (
+	TracerName  = "github.com/mattermost/mattermost-plugin-boards"
+	ServiceName = "focalboard"
+
+	KeyTraceID = "trace_id"
+	KeySpanID  = "span_id"
+
+	DefaultEndpoint = "localhost:4318"
+)
+
+var enabled atomic.Bool
+
+type loggerContextKey struct{}
+
+// Enabled returns true when the spans are exported, so that callers can skip building costly
+// attributes otherwise.
+func Enabled() bool {
+	return enabled.Load()
+}
+
+// Tracing exports the spans of the server to an OpenTelemetry collector over OTLP.
+type Tracing struct {
+	provider *sdktrace.TracerProvider
+}
+
+// New installs the tracer provider selected by the configuration. Spans are not recorded unless
+// tracing is enabled.
+func New(cfg *config.Configuration, version string) (*Tracing, error) {
+	if !cfg.TracingEnabled {
+		return &Tracing{}, nil
+	}
+
+	endpoint := cfg.TracingEndpoint
+	if endpoint == "" {
+		endpoint = DefaultEndpoint
+	}
+	options := []otlptracehttp.Option{otlptracehttp.WithEndpoint(endpoint)}
+	if cfg.TracingInsecure {
+		options = append(options, otlptracehttp.WithInsecure())
+	}
+	exporter, err := otlptracehttp.New(context.Background(), options...)
+	if err != nil {
+		return nil, fmt.Errorf("unable to create the trace exporter: %w", err)
+	}
+
+	ratio := cfg.TracingSampleRatio
+	if ratio <= 0 || ratio > 1 {
+		ratio = 1
+	}
+
+	provider := sdktrace.NewTracerProvider(
+		sdktrace.WithBatcher(exporter),
+		sdktrace.WithSampler(sdktrace.ParentBased(sdktrace.TraceIDRatioBased(ratio))),
+		sdktrace.WithResource(resource.NewWithAttributes(
+			semconv.SchemaURL,
+			semconv.ServiceName(ServiceName),
+			semconv.ServiceVersion(version),
+		)),
+	)
+	otel.SetTracerProvider(provider)
+	otel.SetTextMapPropagator(propagation.NewCompositeTextMapPropagator(propagation.TraceContext{}, propagation.Baggage{}))
+	enabled.Store(true)
+
+	return &Tracing{provider: provider}, nil
+}
+
+// Shutdown flushes the spans not exported yet.
+func (t *Tracing) Shutdown(ctx context.Context) error {
+	if t == nil || t.provider == nil {
+		return nil
+	}
+	enabled.Store(false)
+	return t.provider.Shutdown(ctx)
+}
+
+// Tracer returns the tracer of the server.
+func Tracer() trace.Tracer {
+	return otel.Tracer(TracerName)
+}
+
+// StartSpan starts a span, child of the span of the context if any.
+func StartSpan(ctx context.Context, name string, attributes ...attribute.KeyValue) (context.Context, trace.Span) {
+	return Tracer().Start(ctx, name, trace.WithAttributes(attributes...))
+}
+
+// EndSpan ends a span, recording the error if any.
+func EndSpan(span trace.Span, err error) {
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+	span.End()
+}
+
+// TraceID returns the ID of the trace of the context, or an empty string if there is none.
+func TraceID(ctx context.Context) string {
+	spanContext := trace.SpanContextFromContext(ctx)
+	if !spanContext.HasTraceID() {
+		return ""
+	}
+	return spanContext.TraceID().String()
+}
+
+// LogFields returns the fields identifying the span of the context in the logs.
+func LogFields(ctx context.Context) []mlog.Field {
+	spanContext := trace.SpanContextFromContext(ctx)
+	if !spanContext.IsValid() {
+		return nil
+	}
+	return []mlog.Field{
+		mlog.String(KeyTraceID, spanContext.TraceID().String()),
+		mlog.String(KeySpanID, spanContext.SpanID().String()),
+	}
+}
+
+// ContextWithLogger returns a copy of the context holding the logger of its request, which logs
+// the fields identifying the span of the context.
+func ContextWithLogger(ctx context.Context, logger mlog.LoggerIFace) context.Context {
+	if fields := LogFields(ctx); len(fields) > 0 {
+		logger = logger.With(fields...)
+	}
+	return context.WithValue(ctx, loggerContextKey{}, logger)
+}
+
+// Logger returns the logger of the request of the context, or the fallback logger if the
+// context holds none.
+func Logger(ctx context.Context, fallback mlog.LoggerIFace) mlog.LoggerIFace {
+	if logger, ok := ctx.Value(loggerContextKey{}).(mlog.LoggerIFace); ok {
+		return logger
+	}
+	return fallback
+}
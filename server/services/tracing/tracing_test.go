@@ -0,0 +1,80 @@
+// Copyright (c) 2020-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package tracing
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+
+	"github.com/mattermost/mattermost-plugin-boards/server/services/config"
+
+	"github.com/mattermost/mattermost/server/public/shared/mlog"
+)
+
+func TestNew(t *testing.T) {
+	t.Run("disabled", func(t *testing.T) {
+		tracing, err := New(&config.Configuration{}, "1.0.0")
+		require.NoError(t, err)
+		assert.False(t, Enabled())
+		require.NoError(t, tracing.Shutdown(context.Background()))
+	})
+
+	t.Run("nil tracing shuts down", func(t *testing.T) {
+		var tracing *Tracing
+		require.NoError(t, tracing.Shutdown(context.Background()))
+	})
+}
+
+func TestSpans(t *testing.T) {
+	exporter := tracetest.NewInMemoryExporter()
+	provider := sdktrace.NewTracerProvider(sdktrace.WithSyncer(exporter))
+	previous := otel.GetTracerProvider()
+	otel.SetTracerProvider(provider)
+	defer otel.SetTracerProvider(previous)
+
+	t.Run("no span in context", func(t *testing.T) {
+		assert.Empty(t, TraceID(context.Background()))
+		assert.Empty(t, LogFields(context.Background()))
+	})
+
+	t.Run("child spans share the trace", func(t *testing.T) {
+		exporter.Reset()
+
+		ctx, parent := StartSpan(context.Background(), "parent")
+		traceID := TraceID(ctx)
+		require.NotEmpty(t, traceID)
+		require.Len(t, LogFields(ctx), 2)
+
+		childCtx, child := StartSpan(ctx, "child")
+		assert.Equal(t, traceID, TraceID(childCtx))
+		EndSpan(child, errors.New("failed"))
+		EndSpan(parent, nil)
+
+		spans := exporter.GetSpans()
+		require.Len(t, spans, 2)
+		assert.Equal(t, "child", spans[0].Name)
+		assert.Equal(t, codes.Error, spans[0].Status.Code)
+		assert.Equal(t, spans[1].SpanContext.SpanID(), spans[0].Parent.SpanID())
+		assert.Equal(t, codes.Unset, spans[1].Status.Code)
+	})
+
+	t.Run("request logger", func(t *testing.T) {
+		fallback := mlog.CreateConsoleTestLogger(t)
+		assert.Equal(t, mlog.LoggerIFace(fallback), Logger(context.Background(), fallback))
+
+		ctx, span := StartSpan(context.Background(), "request")
+		defer span.End()
+
+		logger := Logger(ContextWithLogger(ctx, fallback), fallback)
+		assert.NotEqual(t, mlog.LoggerIFace(fallback), logger)
+	})
+}
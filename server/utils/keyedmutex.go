@@ -0,0 +1,51 @@
+// Copyright (c) 2020-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package utils
+
+import (
+	"sync"
+)
+
+type keyedMutexEntry struct {
+	mux  sync.Mutex
+	refs int
+}
+
+// KeyedMutex is a set of mutexes identified by key. Entries are only kept while locked or
+// waited for, so any number of keys can be used.
+type KeyedMutex struct {
+	mux     sync.Mutex
+	entries map[string]*keyedMutexEntry
+}
+
+// NewKeyedMutex creates an empty KeyedMutex.
+func NewKeyedMutex() *KeyedMutex {
+	return &KeyedMutex{
+		entries: make(map[string]*keyedMutexEntry),
+	}
+}
+
+// Lock locks the mutex of the key and returns the function unlocking it.
+func (km *KeyedMutex) Lock(key string) func() {
+	km.mux.Lock()
+	entry, ok := km.entries[key]
+	if !ok {
+		entry = &keyedMutexEntry{}
+		km.entries[key] = entry
+	}
+	entry.refs++
+	km.mux.Unlock()
+
+	entry.mux.Lock()
+	return func() {
+		entry.mux.Unlock()
+
+		km.mux.Lock()
+		defer km.mux.Unlock()
+		entry.refs--
+		if entry.refs == 0 {
+			delete(km.entries, key)
+		}
+	}
+}
@@ -0,0 +1,53 @@
+// Copyright (c) 2020-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package utils
+
+import (
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestKeyedMutex(t *testing.T) {
+	km := NewKeyedMutex()
+
+	unlockA := km.Lock("a")
+
+	// keys are locked independently.
+	unlockB := km.Lock("b")
+	unlockB()
+
+	locked := make(chan struct{})
+	go func() {
+		unlock := km.Lock("a")
+		close(locked)
+		unlock()
+	}()
+
+	select {
+	case <-locked:
+		t.Fatal("the mutex of the key was locked twice")
+	case <-time.After(50 * time.Millisecond):
+	}
+
+	unlockA()
+	<-locked
+
+	// entries are dropped once unlocked.
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			km.Lock("c")()
+		}()
+	}
+	wg.Wait()
+
+	km.mux.Lock()
+	defer km.mux.Unlock()
+	assert.Empty(t, km.entries)
+}
@@ -0,0 +1,69 @@
+// Copyright (c) 2020-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package utils
+
+import (
+	"sync"
+	"time"
+)
+
+// rateLimiterPruneSize is the number of tracked keys above which expired windows are pruned.
+const rateLimiterPruneSize = 10000
+
+type rateWindow struct {
+	start time.Time
+	count int
+}
+
+// RateLimiter is a fixed window rate limiter allowing a number of events per key within a
+// window of time. The number of events is given on each call, so that it follows the
+// configuration. State is kept in memory, so limits apply per server.
+type RateLimiter struct {
+	window time.Duration
+
+	mux     sync.Mutex
+	windows map[string]*rateWindow
+	now     func() time.Time
+}
+
+// NewRateLimiter creates a RateLimiter counting the events per key within `window`.
+func NewRateLimiter(window time.Duration) *RateLimiter {
+	return &RateLimiter{
+		window:  window,
+		windows: make(map[string]*rateWindow),
+		now:     time.Now,
+	}
+}
+
+// Allow records an event for the key and returns false if the key is over `limit` events
+// within the current window.
+func (rl *RateLimiter) Allow(key string, limit int) bool {
+	rl.mux.Lock()
+	defer rl.mux.Unlock()
+
+	now := rl.now()
+	if len(rl.windows) > rateLimiterPruneSize {
+		rl.prune(now)
+	}
+
+	w, ok := rl.windows[key]
+	if !ok || now.Sub(w.start) >= rl.window {
+		rl.windows[key] = &rateWindow{start: now, count: 1}
+		return true
+	}
+
+	if w.count >= limit {
+		return false
+	}
+	w.count++
+	return true
+}
+
+func (rl *RateLimiter) prune(now time.Time) {
+	for key, w := range rl.windows {
+		if now.Sub(w.start) >= rl.window {
+			delete(rl.windows, key)
+		}
+	}
+}
@@ -0,0 +1,50 @@
+// Copyright (c) 2020-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package utils
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRateLimiter(t *testing.T) {
+	now := time.Now()
+	rl := NewRateLimiter(time.Minute)
+	rl.now = func() time.Time { return now }
+
+	assert.True(t, rl.Allow("a", 2))
+	assert.True(t, rl.Allow("a", 2))
+	assert.False(t, rl.Allow("a", 2))
+
+	// keys are limited independently.
+	assert.True(t, rl.Allow("b", 2))
+
+	// a new window starts once the previous one has elapsed.
+	now = now.Add(time.Minute)
+	assert.True(t, rl.Allow("a", 2))
+	assert.True(t, rl.Allow("a", 2))
+	assert.False(t, rl.Allow("a", 2))
+
+	// the limit of each call applies, so that it can change within a window.
+	assert.True(t, rl.Allow("a", 3))
+	assert.False(t, rl.Allow("a", 3))
+	assert.False(t, rl.Allow("a", 1))
+}
+
+func TestRateLimiterPrune(t *testing.T) {
+	now := time.Now()
+	rl := NewRateLimiter(time.Minute)
+	rl.now = func() time.Time { return now }
+
+	for i := 0; i <= rateLimiterPruneSize; i++ {
+		rl.Allow(NewID(IDTypeNone), 1)
+	}
+	assert.Len(t, rl.windows, rateLimiterPruneSize+1)
+
+	now = now.Add(time.Minute)
+	assert.True(t, rl.Allow("a", 1))
+	assert.Len(t, rl.windows, 1)
+}
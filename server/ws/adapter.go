@@ -5,6 +5,8 @@
 package ws
 
 import (
+	"context"
+
 	"github.com/mattermost/mattermost-plugin-boards/server/model"
 )
 
@@ -25,11 +27,13 @@ const (
 	websocketActionUpdateCardLimitTimestamp = "UPDATE_CARD_LIMIT_TIMESTAMP"
 	websocketActionReorderCategories        = "REORDER_CATEGORIES"
 	websocketActionReorderCategoryBoards    = "REORDER_CATEGORY_BOARDS"
+	websocketActionUpdateNotificationsCount = "UPDATE_NOTIFICATIONS_UNREAD_COUNT"
+	websocketActionUpdateCommentReaction    = "UPDATE_COMMENT_REACTION"
 )
 
 type Store interface {
-	GetBlock(blockID string) (*model.Block, error)
-	GetMembersForBoard(boardID string) ([]*model.BoardMember, error)
+	GetBlock(ctx context.Context, blockID string) (*model.Block, error)
+	GetMembersForBoard(ctx context.Context, boardID string) ([]*model.BoardMember, error)
 }
 
 type Adapter interface {
@@ -46,4 +50,6 @@ type Adapter interface {
 	BroadcastSubscriptionChange(teamID string, subscription *model.Subscription)
 	BroadcastCategoryReorder(teamID, userID string, categoryOrder []string)
 	BroadcastCategoryBoardsReorder(teamID, userID, categoryID string, boardsOrder []string)
+	BroadcastNotificationsUnreadCount(userID string, unreadCount int64)
+	BroadcastCommentReactionChange(teamID string, reaction *model.CommentReaction)
 }
@@ -42,6 +42,13 @@ type UpdateSubscription struct {
 	Subscription *model.Subscription `json:"subscription"`
 }
 
+// UpdateCommentReactionMsg is sent when a reaction is added to or removed from a comment.
+type UpdateCommentReactionMsg struct {
+	Action   string                 `json:"action"`
+	TeamID   string                 `json:"teamId"`
+	Reaction *model.CommentReaction `json:"reaction"`
+}
+
 // UpdateClientConfig is sent on block updates.
 type UpdateClientConfig struct {
 	Action       string             `json:"action"`
@@ -56,11 +63,12 @@ type UpdateCardLimitTimestamp struct {
 
 // WebsocketCommand is an incoming command from the client.
 type WebsocketCommand struct {
-	Action    string   `json:"action"`
-	TeamID    string   `json:"teamId"`
-	Token     string   `json:"token"`
-	ReadToken string   `json:"readToken"`
-	BlockIDs  []string `json:"blockIds"`
+	Action        string   `json:"action"`
+	TeamID        string   `json:"teamId"`
+	Token         string   `json:"token"`
+	ReadToken     string   `json:"readToken"`
+	SharePassword string   `json:"sharePassword"`
+	BlockIDs      []string `json:"blockIds"`
 }
 
 type CategoryReorderMessage struct {
@@ -75,3 +83,10 @@ type CategoryBoardReorderMessage struct {
 	BoardOrder []string `json:"BoardOrder"`
 	TeamID     string   `json:"teamId"`
 }
+
+// UpdateNotificationsUnreadCount is sent to a user when the number of their unread in-app
+// notifications changes.
+type UpdateNotificationsUnreadCount struct {
+	Action      string `json:"action"`
+	UnreadCount int64  `json:"unreadCount"`
+}
@@ -5,6 +5,7 @@
 package mocks
 
 import (
+	context "context"
 	reflect "reflect"
 
 	gomock "github.com/golang/mock/gomock"
@@ -35,31 +36,31 @@ func (m *MockStore) EXPECT() *MockStoreMockRecorder {
 }
 
 // GetBlock mocks base method.
-func (m *MockStore) GetBlock(arg0 string) (*model.Block, error) {
+func (m *MockStore) GetBlock(arg0 context.Context, arg1 string) (*model.Block, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetBlock", arg0)
+	ret := m.ctrl.Call(m, "GetBlock", arg0, arg1)
 	ret0, _ := ret[0].(*model.Block)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetBlock indicates an expected call of GetBlock.
-func (mr *MockStoreMockRecorder) GetBlock(arg0 interface{}) *gomock.Call {
+func (mr *MockStoreMockRecorder) GetBlock(arg0, arg1 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBlock", reflect.TypeOf((*MockStore)(nil).GetBlock), arg0)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBlock", reflect.TypeOf((*MockStore)(nil).GetBlock), arg0, arg1)
 }
 
 // GetMembersForBoard mocks base method.
-func (m *MockStore) GetMembersForBoard(arg0 string) ([]*model.BoardMember, error) {
+func (m *MockStore) GetMembersForBoard(arg0 context.Context, arg1 string) ([]*model.BoardMember, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetMembersForBoard", arg0)
+	ret := m.ctrl.Call(m, "GetMembersForBoard", arg0, arg1)
 	ret0, _ := ret[0].([]*model.BoardMember)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetMembersForBoard indicates an expected call of GetMembersForBoard.
-func (mr *MockStoreMockRecorder) GetMembersForBoard(arg0 interface{}) *gomock.Call {
+func (mr *MockStoreMockRecorder) GetMembersForBoard(arg0, arg1 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMembersForBoard", reflect.TypeOf((*MockStore)(nil).GetMembersForBoard), arg0)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMembersForBoard", reflect.TypeOf((*MockStore)(nil).GetMembersForBoard), arg0, arg1)
 }
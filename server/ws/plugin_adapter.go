@@ -5,6 +5,7 @@
 package ws
 
 import (
+	"context"
 	"fmt"
 	"strings"
 	"sync"
@@ -104,6 +105,14 @@ func (pa *PluginAdapter) GetListenersByBlock(blockID string) []*PluginAdapterCli
 	return pa.listenersByBlock[blockID]
 }
 
+// ListenerCount returns the number of connected listeners.
+func (pa *PluginAdapter) ListenerCount() int {
+	pa.listenersMU.RLock()
+	defer pa.listenersMU.RUnlock()
+
+	return len(pa.listeners)
+}
+
 func (pa *PluginAdapter) addListener(pac *PluginAdapterClient) {
 	pa.listenersMU.Lock()
 	defer pa.listenersMU.Unlock()
@@ -220,7 +229,7 @@ func (pa *PluginAdapter) getUserIDsForTeamAndBoard(teamID, boardID string, ensur
 		}
 	}
 
-	members, err := pa.store.GetMembersForBoard(boardID)
+	members, err := pa.store.GetMembersForBoard(context.Background(), boardID)
 	if err != nil {
 		pa.logger.Error("error getting members for board",
 			mlog.String("method", "getUserIDsForTeamAndBoard"),
@@ -321,6 +330,10 @@ func commandFromRequest(req *mmModel.WebSocketRequest) (*WebsocketCommand, error
 		c.ReadToken = readToken.(string)
 	}
 
+	if sharePassword, ok := req.Data["sharePassword"].(string); ok {
+		c.SharePassword = sharePassword
+	}
+
 	if blockIDs, ok := req.Data["blockIds"]; ok {
 		c.BlockIDs = blockIDs.([]string)
 	}
@@ -549,6 +562,29 @@ func (pa *PluginAdapter) BroadcastCategoryBoardsReorder(teamID, userID, category
 	pa.sendUserMessageSkipCluster(message.Action, payload, userID)
 }
 
+func (pa *PluginAdapter) BroadcastNotificationsUnreadCount(userID string, unreadCount int64) {
+	pa.logger.Debug("BroadcastNotificationsUnreadCount",
+		mlog.String("userID", userID),
+		mlog.Int("unreadCount", unreadCount),
+	)
+
+	message := UpdateNotificationsUnreadCount{
+		Action:      websocketActionUpdateNotificationsCount,
+		UnreadCount: unreadCount,
+	}
+	payload := utils.StructToMap(message)
+	go func() {
+		clusterMessage := &ClusterMessage{
+			Payload: payload,
+			UserID:  userID,
+		}
+
+		pa.sendMessageToCluster(clusterMessage)
+	}()
+
+	pa.sendUserMessageSkipCluster(message.Action, payload, userID)
+}
+
 func (pa *PluginAdapter) BroadcastCategoryBoardChange(teamID, userID string, boardCategories []*model.BoardCategoryWebsocketData) {
 	pa.logger.Debug(
 		"BroadcastCategoryBoardChange",
@@ -664,6 +700,23 @@ func (pa *PluginAdapter) BroadcastSubscriptionChange(teamID string, subscription
 	pa.sendTeamMessage(websocketActionUpdateSubscription, teamID, utils.StructToMap(message))
 }
 
+func (pa *PluginAdapter) BroadcastCommentReactionChange(teamID string, reaction *model.CommentReaction) {
+	pa.logger.Debug("BroadcastingCommentReactionChange",
+		mlog.String("teamID", teamID),
+		mlog.String("boardID", reaction.BoardID),
+		mlog.String("blockID", reaction.BlockID),
+		mlog.String("userID", reaction.UserID),
+	)
+
+	message := UpdateCommentReactionMsg{
+		Action:   websocketActionUpdateCommentReaction,
+		TeamID:   teamID,
+		Reaction: reaction,
+	}
+
+	pa.sendBoardMessage(teamID, reaction.BoardID, utils.StructToMap(message))
+}
+
 func (pa *PluginAdapter) BroadcastCardLimitTimestampChange(cardLimitTimestamp int64) {
 	pa.logger.Debug("BroadcastCardLimitTimestampChange",
 		mlog.Int("cardLimitTimestamp", cardLimitTimestamp),
@@ -4,6 +4,7 @@
 package ws
 
 import (
+	"context"
 	"encoding/json"
 	"net/http"
 	"sync"
@@ -243,7 +244,7 @@ func (ws *Server) isCommandReadTokenValid(command WebsocketCommand) bool {
 	boardID := ""
 	// all the blocks must be part of the same board
 	for _, blockID := range command.BlockIDs {
-		block, err := ws.store.GetBlock(blockID)
+		block, err := ws.store.GetBlock(context.Background(), blockID)
 		if err != nil {
 			return false
 		}
@@ -259,7 +260,11 @@ func (ws *Server) isCommandReadTokenValid(command WebsocketCommand) bool {
 	}
 
 	// the read token must be valid for the board
-	isValid, err := ws.auth.IsValidReadToken(boardID, command.ReadToken)
+	access := model.ReadTokenAccess{
+		Password: command.SharePassword,
+		BlockIDs: command.BlockIDs,
+	}
+	isValid, err := ws.auth.IsValidReadToken(context.Background(), boardID, command.ReadToken, access)
 	if err != nil {
 		ws.logger.Error(`ERROR when checking token validity`,
 			mlog.String("teamID", command.TeamID),
@@ -271,6 +276,14 @@ func (ws *Server) isCommandReadTokenValid(command WebsocketCommand) bool {
 	return isValid
 }
 
+// ListenerCount returns the number of connected listeners, authenticated
+// or not.
+func (ws *Server) ListenerCount() int {
+	ws.mu.RLock()
+	defer ws.mu.RUnlock()
+	return len(ws.listeners)
+}
+
 // addListener adds a listener to the websocket server. The listener
 // should not receive any update from the server until it subscribes
 // itself to some entity changes. Adding a listener to the server
@@ -455,7 +468,7 @@ func (ws *Server) getListenerForUser(teamID, userID string) *websocketSession {
 // getListenersForTeamAndBoard returns the listeners subscribed to a
 // team changes and members of a given board.
 func (ws *Server) getListenersForTeamAndBoard(teamID, boardID string, ensureUsers ...string) []*websocketSession {
-	members, err := ws.store.GetMembersForBoard(boardID)
+	members, err := ws.store.GetMembersForBoard(context.Background(), boardID)
 	if err != nil {
 		ws.logger.Error("error getting members for board",
 			mlog.String("method", "getListenersForTeamAndBoard"),
@@ -586,6 +599,34 @@ func (ws *Server) BroadcastCategoryReorder(teamID, userID string, categoryOrder
 	}
 }
 
+func (ws *Server) BroadcastNotificationsUnreadCount(userID string, unreadCount int64) {
+	message := UpdateNotificationsUnreadCount{
+		Action:      websocketActionUpdateNotificationsCount,
+		UnreadCount: unreadCount,
+	}
+
+	ws.mu.RLock()
+	listeners := make([]*websocketSession, 0)
+	for listener := range ws.listeners {
+		if listener.userID == userID {
+			listeners = append(listeners, listener)
+		}
+	}
+	ws.mu.RUnlock()
+
+	for _, listener := range listeners {
+		ws.logger.Debug("Broadcast notifications unread count",
+			mlog.String("userID", userID),
+			mlog.Stringer("remoteAddr", listener.conn.RemoteAddr()),
+		)
+
+		if err := listener.WriteJSON(message); err != nil {
+			ws.logger.Error("broadcast notifications unread count error", mlog.Err(err))
+			listener.conn.Close()
+		}
+	}
+}
+
 func (ws *Server) BroadcastCategoryBoardsReorder(teamID, userID, categoryID string, boardOrder []string) {
 	message := CategoryBoardReorderMessage{
 		Action:     websocketActionReorderCategoryBoards,
@@ -726,6 +767,35 @@ func (ws *Server) BroadcastMemberChange(teamID, boardID string, member *model.Bo
 	}
 }
 
+func (ws *Server) BroadcastCommentReactionChange(teamID string, reaction *model.CommentReaction) {
+	message := UpdateCommentReactionMsg{
+		Action:   websocketActionUpdateCommentReaction,
+		TeamID:   teamID,
+		Reaction: reaction,
+	}
+
+	listeners := ws.getListenersForTeamAndBoard(teamID, reaction.BoardID)
+	ws.logger.Trace("listener(s) for teamID and boardID",
+		mlog.Int("listener_count", len(listeners)),
+		mlog.String("teamID", teamID),
+		mlog.String("boardID", reaction.BoardID),
+	)
+
+	for _, listener := range listeners {
+		ws.logger.Debug("Broadcast comment reaction change",
+			mlog.String("teamID", teamID),
+			mlog.String("blockID", reaction.BlockID),
+			mlog.Stringer("remoteAddr", listener.conn.RemoteAddr()),
+		)
+
+		err := listener.WriteJSON(message)
+		if err != nil {
+			ws.logger.Error("broadcast error", mlog.Err(err))
+			listener.conn.Close()
+		}
+	}
+}
+
 func (ws *Server) BroadcastMemberDelete(teamID, boardID, userID string) {
 	message := UpdateMemberMsg{
 		Action: websocketActionDeleteMember,
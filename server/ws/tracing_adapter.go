@@ -0,0 +1,102 @@
+// Copyright (c) 2020-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package ws
+
+import (
+	"context"
+
+	"go.opentelemetry.io/otel/attribute"
+
+	"github.com/mattermost/mattermost-plugin-boards/server/model"
+	"github.com/mattermost/mattermost-plugin-boards/server/services/tracing"
+)
+
+// TracingAdapter is an adapter recording a span for each broadcast of the adapter it wraps.
+type TracingAdapter struct {
+	Adapter
+}
+
+func NewTracingAdapter(adapter Adapter) *TracingAdapter {
+	return &TracingAdapter{Adapter: adapter}
+}
+
+func (ta *TracingAdapter) trace(method string, attributes ...attribute.KeyValue) func() {
+	_, span := tracing.StartSpan(context.Background(), "ws."+method, attributes...)
+	return func() { span.End() }
+}
+
+func (ta *TracingAdapter) BroadcastBlockChange(teamID string, block *model.Block) {
+	defer ta.trace("BroadcastBlockChange", attribute.String("team_id", teamID), attribute.String("board_id", block.BoardID), attribute.String("block_id", block.ID))()
+	ta.Adapter.BroadcastBlockChange(teamID, block)
+}
+
+func (ta *TracingAdapter) BroadcastBlockDelete(teamID, blockID, boardID string) {
+	defer ta.trace("BroadcastBlockDelete", attribute.String("team_id", teamID), attribute.String("board_id", boardID), attribute.String("block_id", blockID))()
+	ta.Adapter.BroadcastBlockDelete(teamID, blockID, boardID)
+}
+
+func (ta *TracingAdapter) BroadcastBoardChange(teamID string, board *model.Board) {
+	defer ta.trace("BroadcastBoardChange", attribute.String("team_id", teamID), attribute.String("board_id", board.ID))()
+	ta.Adapter.BroadcastBoardChange(teamID, board)
+}
+
+func (ta *TracingAdapter) BroadcastBoardDelete(teamID, boardID string) {
+	defer ta.trace("BroadcastBoardDelete", attribute.String("team_id", teamID), attribute.String("board_id", boardID))()
+	ta.Adapter.BroadcastBoardDelete(teamID, boardID)
+}
+
+func (ta *TracingAdapter) BroadcastMemberChange(teamID, boardID string, member *model.BoardMember) {
+	defer ta.trace("BroadcastMemberChange", attribute.String("team_id", teamID), attribute.String("board_id", boardID))()
+	ta.Adapter.BroadcastMemberChange(teamID, boardID, member)
+}
+
+func (ta *TracingAdapter) BroadcastMemberDelete(teamID, boardID, userID string) {
+	defer ta.trace("BroadcastMemberDelete", attribute.String("team_id", teamID), attribute.String("board_id", boardID))()
+	ta.Adapter.BroadcastMemberDelete(teamID, boardID, userID)
+}
+
+func (ta *TracingAdapter) BroadcastConfigChange(clientConfig model.ClientConfig) {
+	defer ta.trace("BroadcastConfigChange")()
+	ta.Adapter.BroadcastConfigChange(clientConfig)
+}
+
+func (ta *TracingAdapter) BroadcastCategoryChange(category model.Category) {
+	defer ta.trace("BroadcastCategoryChange", attribute.String("team_id", category.TeamID))()
+	ta.Adapter.BroadcastCategoryChange(category)
+}
+
+func (ta *TracingAdapter) BroadcastCategoryBoardChange(teamID, userID string, blockCategory []*model.BoardCategoryWebsocketData) {
+	defer ta.trace("BroadcastCategoryBoardChange", attribute.String("team_id", teamID))()
+	ta.Adapter.BroadcastCategoryBoardChange(teamID, userID, blockCategory)
+}
+
+func (ta *TracingAdapter) BroadcastCardLimitTimestampChange(cardLimitTimestamp int64) {
+	defer ta.trace("BroadcastCardLimitTimestampChange")()
+	ta.Adapter.BroadcastCardLimitTimestampChange(cardLimitTimestamp)
+}
+
+func (ta *TracingAdapter) BroadcastSubscriptionChange(teamID string, subscription *model.Subscription) {
+	defer ta.trace("BroadcastSubscriptionChange", attribute.String("team_id", teamID))()
+	ta.Adapter.BroadcastSubscriptionChange(teamID, subscription)
+}
+
+func (ta *TracingAdapter) BroadcastCategoryReorder(teamID, userID string, categoryOrder []string) {
+	defer ta.trace("BroadcastCategoryReorder", attribute.String("team_id", teamID))()
+	ta.Adapter.BroadcastCategoryReorder(teamID, userID, categoryOrder)
+}
+
+func (ta *TracingAdapter) BroadcastCategoryBoardsReorder(teamID, userID, categoryID string, boardsOrder []string) {
+	defer ta.trace("BroadcastCategoryBoardsReorder", attribute.String("team_id", teamID))()
+	ta.Adapter.BroadcastCategoryBoardsReorder(teamID, userID, categoryID, boardsOrder)
+}
+
+func (ta *TracingAdapter) BroadcastNotificationsUnreadCount(userID string, unreadCount int64) {
+	defer ta.trace("BroadcastNotificationsUnreadCount")()
+	ta.Adapter.BroadcastNotificationsUnreadCount(userID, unreadCount)
+}
+
+func (ta *TracingAdapter) BroadcastCommentReactionChange(teamID string, reaction *model.CommentReaction) {
+	defer ta.trace("BroadcastCommentReactionChange", attribute.String("team_id", teamID))()
+	ta.Adapter.BroadcastCommentReactionChange(teamID, reaction)
+}